@@ -0,0 +1,18 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DeletionFailedError is returned when an asynchronous deletion job fails.
+// Reasons lists the resources that blocked the deletion, as reported by the
+// Cloud Controller.
+type DeletionFailedError struct {
+	JobGUID string
+	Reasons []string
+}
+
+func (e DeletionFailedError) Error() string {
+	return fmt.Sprintf("deletion job %s failed: %s", e.JobGUID, strings.Join(e.Reasons, "; "))
+}
@@ -0,0 +1,9 @@
+package actionerror
+
+// LoggingUnavailableError is returned when the targeted platform does not
+// advertise a logging endpoint, so application logs cannot be read.
+type LoggingUnavailableError struct{}
+
+func (LoggingUnavailableError) Error() string {
+	return "logging endpoint not available"
+}
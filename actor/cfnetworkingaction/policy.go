@@ -16,18 +16,54 @@ type Policy struct {
 	Protocol        string
 	StartPort       int
 	EndPort         int
+
+	// DestinationSpaceName and DestinationOrgName are set when the destination
+	// app is not in the space the policies were listed for.
+	DestinationSpaceName string
+	DestinationOrgName   string
+
+	// DestinationNotVisible is set when the user cannot see the destination
+	// app, in which case DestinationName is its GUID.
+	DestinationNotVisible bool
 }
 
-func (actor Actor) AddNetworkPolicy(spaceGUID, srcAppName, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
+// destination is an app outside of the listed space that policies point to.
+type destination struct {
+	name      string
+	spaceName string
+	orgName   string
+}
+
+// GetSpaceGUIDByNameAndOrganizationName returns the GUID of the space with
+// the given name in the organization with the given name.
+func (actor Actor) GetSpaceGUIDByNameAndOrganizationName(spaceName string, orgName string) (string, Warnings, error) {
 	var allWarnings Warnings
 
-	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, spaceGUID)
+	org, warnings, err := actor.V3Actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	space, warnings, err := actor.V3Actor.GetSpaceByNameAndOrganization(spaceName, org.GUID)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	return space.GUID, allWarnings, nil
+}
+
+func (actor Actor) AddNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
+	var allWarnings Warnings
+
+	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, srcSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
 	}
 
-	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, spaceGUID)
+	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, destSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
@@ -71,15 +107,19 @@ func (actor Actor) NetworkPoliciesBySpace(spaceGUID string) ([]Policy, Warnings,
 		appNameByGuid[app.GUID] = app.Name
 	}
 
-	var policies []Policy
-	emptyPolicy := Policy{}
+	var spacePolicies []cfnetv1.Policy
 	for _, v1Policy := range v1Policies {
-		policy := actor.transformPolicy(appNameByGuid, v1Policy)
-		if policy != emptyPolicy {
-			policies = append(policies, policy)
+		if _, ok := appNameByGuid[v1Policy.Source.ID]; ok {
+			spacePolicies = append(spacePolicies, v1Policy)
 		}
 	}
 
+	policies, transformWarnings, err := actor.transformPolicies(appNameByGuid, spacePolicies)
+	allWarnings = append(allWarnings, transformWarnings...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
 	return policies, allWarnings, nil
 }
 
@@ -112,30 +152,32 @@ func (actor Actor) NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName
 		return []Policy{}, allWarnings, err
 	}
 
-	var policies []Policy
-	emptyPolicy := Policy{}
+	var appPolicies []cfnetv1.Policy
 	for _, v1Policy := range v1Policies {
 		if v1Policy.Source.ID == appGUID {
-			policy := actor.transformPolicy(appNameByGuid, v1Policy)
-			if policy != emptyPolicy {
-				policies = append(policies, policy)
-			}
+			appPolicies = append(appPolicies, v1Policy)
 		}
 	}
 
+	policies, transformWarnings, err := actor.transformPolicies(appNameByGuid, appPolicies)
+	allWarnings = append(allWarnings, transformWarnings...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
 	return policies, allWarnings, nil
 }
 
-func (actor Actor) RemoveNetworkPolicy(spaceGUID, srcAppName, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
+func (actor Actor) RemoveNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
 	var allWarnings Warnings
 
-	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, spaceGUID)
+	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, srcSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
 	}
 
-	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, spaceGUID)
+	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, destSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
@@ -169,17 +211,108 @@ func (actor Actor) RemoveNetworkPolicy(spaceGUID, srcAppName, destAppName, proto
 	return allWarnings, PolicyDoesNotExistError{}
 }
 
-func (Actor) transformPolicy(appNameByGuid map[string]string, v1Policy cfnetv1.Policy) Policy {
-	srcName, srcOk := appNameByGuid[v1Policy.Source.ID]
-	dstName, dstOk := appNameByGuid[v1Policy.Destination.ID]
-	if srcOk && dstOk {
-		return Policy{
-			SourceName:      srcName,
-			DestinationName: dstName,
-			Protocol:        string(v1Policy.Destination.Protocol),
-			StartPort:       v1Policy.Destination.Ports.Start,
-			EndPort:         v1Policy.Destination.Ports.End,
+// transformPolicies converts policies whose source apps are in appNameByGuid,
+// looking up the destination apps that are not.
+func (actor Actor) transformPolicies(appNameByGuid map[string]string, v1Policies []cfnetv1.Policy) ([]Policy, Warnings, error) {
+	var otherAppGUIDs []string
+	seen := map[string]bool{}
+	for _, v1Policy := range v1Policies {
+		destGUID := v1Policy.Destination.ID
+		if _, ok := appNameByGuid[destGUID]; !ok && !seen[destGUID] {
+			seen[destGUID] = true
+			otherAppGUIDs = append(otherAppGUIDs, destGUID)
 		}
 	}
-	return Policy{}
+
+	destinations, warnings, err := actor.getDestinations(otherAppGUIDs)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var policies []Policy
+	for _, v1Policy := range v1Policies {
+		policy := Policy{
+			SourceName: appNameByGuid[v1Policy.Source.ID],
+			Protocol:   string(v1Policy.Destination.Protocol),
+			StartPort:  v1Policy.Destination.Ports.Start,
+			EndPort:    v1Policy.Destination.Ports.End,
+		}
+
+		destGUID := v1Policy.Destination.ID
+		if destName, ok := appNameByGuid[destGUID]; ok {
+			policy.DestinationName = destName
+		} else if dest, ok := destinations[destGUID]; ok {
+			policy.DestinationName = dest.name
+			policy.DestinationSpaceName = dest.spaceName
+			policy.DestinationOrgName = dest.orgName
+		} else {
+			policy.DestinationName = destGUID
+			policy.DestinationNotVisible = true
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, warnings, nil
+}
+
+// getDestinations returns the apps with the given GUIDs that the user can
+// see, along with the names of their spaces and organizations.
+func (actor Actor) getDestinations(appGUIDs []string) (map[string]destination, Warnings, error) {
+	if len(appGUIDs) == 0 {
+		return nil, nil, nil
+	}
+
+	var allWarnings Warnings
+
+	apps, warnings, err := actor.V3Actor.GetApplicationsByGUIDs(appGUIDs...)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil || len(apps) == 0 {
+		return nil, allWarnings, err
+	}
+
+	var spaceGUIDs []string
+	for _, app := range apps {
+		spaceGUIDs = append(spaceGUIDs, app.SpaceGUID)
+	}
+
+	spaces, warnings, err := actor.V3Actor.GetSpacesByGUIDs(spaceGUIDs...)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var orgGUIDs []string
+	for _, space := range spaces {
+		orgGUIDs = append(orgGUIDs, space.OrganizationGUID)
+	}
+
+	orgNameByGUID := map[string]string{}
+	if len(orgGUIDs) > 0 {
+		orgs, warnings, err := actor.V3Actor.GetOrganizationsByGUIDs(orgGUIDs...)
+		allWarnings = append(allWarnings, Warnings(warnings)...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		for _, org := range orgs {
+			orgNameByGUID[org.GUID] = org.Name
+		}
+	}
+
+	spaceByGUID := map[string]destination{}
+	for _, space := range spaces {
+		spaceByGUID[space.GUID] = destination{
+			spaceName: space.Name,
+			orgName:   orgNameByGUID[space.OrganizationGUID],
+		}
+	}
+
+	destinations := map[string]destination{}
+	for _, app := range apps {
+		dest := spaceByGUID[app.SpaceGUID]
+		dest.name = app.Name
+		destinations[app.GUID] = dest
+	}
+
+	return destinations, allWarnings, nil
 }
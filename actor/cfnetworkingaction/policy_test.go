@@ -37,15 +37,52 @@ var _ = Describe("Policy", func() {
 		actor = NewActor(fakeNetworkingClient, fakeV3Actor)
 	})
 
+	Describe("GetSpaceGUIDByNameAndOrganizationName", func() {
+		var spaceGUID string
+
+		BeforeEach(func() {
+			fakeV3Actor.GetOrganizationByNameReturns(v3action.Organization{GUID: "orgGUID"}, []string{"GetOrganizationByNameWarning"}, nil)
+			fakeV3Actor.GetSpaceByNameAndOrganizationReturns(v3action.Space{GUID: "spaceGUID"}, []string{"GetSpaceByNameAndOrganizationWarning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			spaceGUID, warnings, executeErr = actor.GetSpaceGUIDByNameAndOrganizationName("some-space", "some-org")
+		})
+
+		It("returns the space GUID", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(spaceGUID).To(Equal("spaceGUID"))
+			Expect(warnings).To(Equal(Warnings([]string{"GetOrganizationByNameWarning", "GetSpaceByNameAndOrganizationWarning"})))
+
+			Expect(fakeV3Actor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+			spaceName, orgGUID := fakeV3Actor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("orgGUID"))
+		})
+
+		Context("when the org cannot be found", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetOrganizationByNameReturns(v3action.Organization{}, []string{"GetOrganizationByNameWarning"}, v3action.OrganizationNotFoundError{Name: "some-org"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(v3action.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(Equal(Warnings([]string{"GetOrganizationByNameWarning"})))
+				Expect(fakeV3Actor.GetSpaceByNameAndOrganizationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("AddNetworkPolicy", func() {
 		JustBeforeEach(func() {
 			spaceGuid := "space"
 			srcApp := "appA"
+			destSpaceGuid := "destination-space"
 			destApp := "appB"
 			protocol := "tcp"
 			startPort := 8080
 			endPort := 8090
-			warnings, executeErr = actor.AddNetworkPolicy(spaceGuid, srcApp, destApp, protocol, startPort, endPort)
+			warnings, executeErr = actor.AddNetworkPolicy(spaceGuid, srcApp, destSpaceGuid, destApp, protocol, startPort, endPort)
 		})
 
 		It("creates policies", func() {
@@ -59,7 +96,7 @@ var _ = Describe("Policy", func() {
 
 			destAppName, spaceGUID := fakeV3Actor.GetApplicationByNameAndSpaceArgsForCall(1)
 			Expect(destAppName).To(Equal("appB"))
-			Expect(spaceGUID).To(Equal("space"))
+			Expect(spaceGUID).To(Equal("destination-space"))
 
 			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
 			Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
@@ -341,6 +378,88 @@ var _ = Describe("Policy", func() {
 			Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(BeNil())
 		})
 
+		Context("when policies point to apps in other spaces", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{{
+					Source: cfnetv1.PolicySource{
+						ID: "appAGUID",
+					},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appDGUID",
+						Protocol: "tcp",
+						Ports: cfnetv1.Ports{
+							Start: 8080,
+							End:   8080,
+						},
+					},
+				}, {
+					Source: cfnetv1.PolicySource{
+						ID: "appAGUID",
+					},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "hiddenAppGUID",
+						Protocol: "udp",
+						Ports: cfnetv1.Ports{
+							Start: 53,
+							End:   53,
+						},
+					},
+				}}, nil)
+
+				fakeV3Actor.GetApplicationsByGUIDsReturns([]v3action.Application{
+					{Name: "appD", GUID: "appDGUID", SpaceGUID: "spaceDGUID"},
+				}, []string{"GetApplicationsByGUIDsWarning"}, nil)
+				fakeV3Actor.GetSpacesByGUIDsReturns([]v3action.Space{
+					{Name: "spaceD", GUID: "spaceDGUID", OrganizationGUID: "orgDGUID"},
+				}, []string{"GetSpacesByGUIDsWarning"}, nil)
+				fakeV3Actor.GetOrganizationsByGUIDsReturns([]v3action.Organization{
+					{Name: "orgD", GUID: "orgDGUID"},
+				}, []string{"GetOrganizationsByGUIDsWarning"}, nil)
+			})
+
+			It("lists the destinations with their space and org, and the GUIDs of destinations that cannot be seen", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(policies).To(Equal([]Policy{{
+					SourceName:           "appA",
+					DestinationName:      "appD",
+					Protocol:             "tcp",
+					StartPort:            8080,
+					EndPort:              8080,
+					DestinationSpaceName: "spaceD",
+					DestinationOrgName:   "orgD",
+				}, {
+					SourceName:            "appA",
+					DestinationName:       "hiddenAppGUID",
+					Protocol:              "udp",
+					StartPort:             53,
+					EndPort:               53,
+					DestinationNotVisible: true,
+				}}))
+				Expect(warnings).To(Equal(Warnings([]string{
+					"GetApplicationsBySpaceWarning",
+					"GetApplicationsByGUIDsWarning",
+					"GetSpacesByGUIDsWarning",
+					"GetOrganizationsByGUIDsWarning",
+				})))
+
+				Expect(fakeV3Actor.GetApplicationsByGUIDsCallCount()).To(Equal(1))
+				Expect(fakeV3Actor.GetApplicationsByGUIDsArgsForCall(0)).To(Equal([]string{"appDGUID", "hiddenAppGUID"}))
+				Expect(fakeV3Actor.GetSpacesByGUIDsArgsForCall(0)).To(Equal([]string{"spaceDGUID"}))
+				Expect(fakeV3Actor.GetOrganizationsByGUIDsArgsForCall(0)).To(Equal([]string{"orgDGUID"}))
+			})
+
+			Context("when looking up the other apps fails", func() {
+				BeforeEach(func() {
+					fakeV3Actor.GetApplicationsByGUIDsReturns(nil, []string{"GetApplicationsByGUIDsWarning"}, errors.New("cherry"))
+				})
+
+				It("returns a sensible error", func() {
+					Expect(executeErr).To(MatchError("cherry"))
+					Expect(warnings).To(Equal(Warnings([]string{"GetApplicationsBySpaceWarning", "GetApplicationsByGUIDsWarning"})))
+				})
+			})
+		})
+
 		Context("when getting the applications fails", func() {
 			BeforeEach(func() {
 				fakeV3Actor.GetApplicationsBySpaceReturns([]v3action.Application{}, []string{"GetApplicationsBySpaceWarning"}, errors.New("banana"))
@@ -385,11 +504,12 @@ var _ = Describe("Policy", func() {
 		JustBeforeEach(func() {
 			spaceGuid := "space"
 			srcApp := "appA"
+			destSpaceGuid := "destination-space"
 			destApp := "appB"
 			protocol := "udp"
 			startPort := 123
 			endPort := 345
-			warnings, executeErr = actor.RemoveNetworkPolicy(spaceGuid, srcApp, destApp, protocol, startPort, endPort)
+			warnings, executeErr = actor.RemoveNetworkPolicy(spaceGuid, srcApp, destSpaceGuid, destApp, protocol, startPort, endPort)
 		})
 		It("removes policies", func() {
 			Expect(warnings).To(Equal(Warnings([]string{"v3ActorWarningA", "v3ActorWarningB"})))
@@ -402,7 +522,7 @@ var _ = Describe("Policy", func() {
 
 			destAppName, spaceGUID := fakeV3Actor.GetApplicationByNameAndSpaceArgsForCall(1)
 			Expect(destAppName).To(Equal("appB"))
-			Expect(spaceGUID).To(Equal("space"))
+			Expect(spaceGUID).To(Equal("destination-space"))
 
 			Expect(fakeNetworkingClient.ListPoliciesCallCount()).To(Equal(1))
 
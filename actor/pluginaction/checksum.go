@@ -1,8 +1,53 @@
 package pluginaction
 
-import "code.cloudfoundry.org/cli/util/configv3"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
 
-func (actor Actor) ValidateFileChecksum(path string, checksum string) bool {
-	plugin := configv3.Plugin{Location: path}
-	return plugin.CalculateSHA1() == checksum
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// ChecksumMismatchError is returned when a plugin binary does not match the
+// expected checksum.
+type ChecksumMismatchError struct {
+	Expected string
+	Actual   string
+}
+
+func (e ChecksumMismatchError) Error() string {
+	return "checksum mismatch: expected " + e.Expected + ", got " + e.Actual
+}
+
+// ValidateFileChecksum returns a ChecksumMismatchError when the file at path
+// does not match checksum. A 64 character checksum is compared with the
+// file's SHA-256, any other with its SHA-1.
+func (actor Actor) ValidateFileChecksum(path string, checksum string) error {
+	var actual string
+	if len(checksum) == sha256.Size*2 {
+		actual = computeFileSHA256(path)
+	} else {
+		actual = configv3.Plugin{Location: path}.CalculateSHA1()
+	}
+
+	if !strings.EqualFold(actual, checksum) {
+		return ChecksumMismatchError{Expected: checksum, Actual: actual}
+	}
+	return nil
+}
+
+func computeFileSHA256(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return "N/A"
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "N/A"
+	}
+	return hex.EncodeToString(hash.Sum(nil))
 }
@@ -38,15 +38,34 @@ var _ = Describe("Checksums", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
-		Context("when the checksums match", func() {
-			It("returns true", func() {
-				Expect(actor.ValidateFileChecksum(file.Name(), "0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33")).To(BeTrue())
+		Context("when the SHA-1 checksums match", func() {
+			It("returns nil", func() {
+				Expect(actor.ValidateFileChecksum(file.Name(), "0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33")).To(Succeed())
 			})
 		})
 
-		Context("when the checksums do not match", func() {
-			It("returns false", func() {
-				Expect(actor.ValidateFileChecksum(file.Name(), "blah")).To(BeFalse())
+		Context("when the SHA-256 checksums match", func() {
+			It("returns nil", func() {
+				Expect(actor.ValidateFileChecksum(file.Name(), "2C26B46B68FFC68FF99B453C1D30413413422D706483BFA0F98A5E886266E7AE")).To(Succeed())
+			})
+		})
+
+		Context("when the SHA-1 checksums do not match", func() {
+			It("returns a ChecksumMismatchError with both checksums", func() {
+				Expect(actor.ValidateFileChecksum(file.Name(), "blah")).To(MatchError(ChecksumMismatchError{
+					Expected: "blah",
+					Actual:   "0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33",
+				}))
+			})
+		})
+
+		Context("when the SHA-256 checksums do not match", func() {
+			It("returns a ChecksumMismatchError with both checksums", func() {
+				expected := "0000000000000000000000000000000000000000000000000000000000000000"
+				Expect(actor.ValidateFileChecksum(file.Name(), expected)).To(MatchError(ChecksumMismatchError{
+					Expected: expected,
+					Actual:   "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+				}))
 			})
 		})
 	})
@@ -10,6 +10,7 @@ type OutdatedPlugin struct {
 	Name           string
 	CurrentVersion string
 	LatestVersion  string
+	RepositoryName string
 }
 
 // GettingPluginRepositoryError is returned when there's an error
@@ -26,7 +27,12 @@ func (e GettingPluginRepositoryError) Error() string {
 func (actor Actor) GetOutdatedPlugins() ([]OutdatedPlugin, error) {
 	var outdatedPlugins []OutdatedPlugin
 
-	repoPlugins := map[string]string{}
+	type repoPlugin struct {
+		version        string
+		repositoryName string
+	}
+
+	repoPlugins := map[string]repoPlugin{}
 	for _, repo := range actor.config.PluginRepositories() {
 		repository, err := actor.client.GetPluginRepository(repo.URL)
 		if err != nil {
@@ -34,24 +40,21 @@ func (actor Actor) GetOutdatedPlugins() ([]OutdatedPlugin, error) {
 		}
 
 		for _, plugin := range repository.Plugins {
-			existingVersion, exist := repoPlugins[plugin.Name]
-			if exist {
-				if lessThan(existingVersion, plugin.Version) {
-					repoPlugins[plugin.Name] = plugin.Version
-				}
-			} else {
-				repoPlugins[plugin.Name] = plugin.Version
+			existing, exist := repoPlugins[plugin.Name]
+			if !exist || lessThan(existing.version, plugin.Version) {
+				repoPlugins[plugin.Name] = repoPlugin{version: plugin.Version, repositoryName: repo.Name}
 			}
 		}
 	}
 
 	for _, installedPlugin := range actor.config.Plugins() {
-		repoVersion, exist := repoPlugins[installedPlugin.Name]
-		if exist && lessThan(installedPlugin.Version.String(), repoVersion) {
+		latest, exist := repoPlugins[installedPlugin.Name]
+		if exist && lessThan(installedPlugin.Version.String(), latest.version) {
 			outdatedPlugins = append(outdatedPlugins, OutdatedPlugin{
 				Name:           installedPlugin.Name,
 				CurrentVersion: installedPlugin.Version.String(),
-				LatestVersion:  repoVersion,
+				LatestVersion:  latest.version,
+				RepositoryName: latest.repositoryName,
 			})
 		}
 	}
@@ -59,13 +62,16 @@ func (actor Actor) GetOutdatedPlugins() ([]OutdatedPlugin, error) {
 	return outdatedPlugins, nil
 }
 
+// lessThan compares versions tolerantly so that loose versions published by
+// some plugins, such as "1.0" or "v1.2.3", are compared as semver. Versions
+// that cannot be parsed at all are never less than another.
 func lessThan(version1 string, version2 string) bool {
-	v1, err := semver.Make(version1)
+	v1, err := semver.ParseTolerant(version1)
 	if err != nil {
 		return false
 	}
 
-	v2, err := semver.Make(version2)
+	v2, err := semver.ParseTolerant(version2)
 	if err != nil {
 		return false
 	}
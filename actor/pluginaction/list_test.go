@@ -84,8 +84,34 @@ var _ = Describe("Plugin actions", func() {
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(outdatedPlugins).To(Equal([]OutdatedPlugin{
-						{Name: "plugin-1", CurrentVersion: "1.0.0", LatestVersion: "2.0.0"},
-						{Name: "plugin-2", CurrentVersion: "1.0.0", LatestVersion: "2.0.0"},
+						{Name: "plugin-1", CurrentVersion: "1.0.0", LatestVersion: "2.0.0", RepositoryName: "CF-Community"},
+						{Name: "plugin-2", CurrentVersion: "1.0.0", LatestVersion: "2.0.0", RepositoryName: "Coo Plugins"},
+					}))
+				})
+			})
+
+			Context("when a repository publishes loose versions", func() {
+				BeforeEach(func() {
+					fakePluginClient.GetPluginRepositoryStub = nil
+					fakePluginClient.GetPluginRepositoryReturns(plugin.PluginRepository{
+						Plugins: []plugin.Plugin{
+							{Name: "plugin-1", Version: "1.1"},
+							{Name: "plugin-2", Version: "v1.0"},
+						},
+					}, nil)
+
+					fakeConfig.PluginsReturns([]configv3.Plugin{
+						{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1, Minor: 0, Build: 0}},
+						{Name: "plugin-2", Version: configv3.PluginVersion{Major: 1, Minor: 0, Build: 0}},
+					})
+				})
+
+				It("compares them as semantic versions", func() {
+					outdatedPlugins, err := actor.GetOutdatedPlugins()
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(outdatedPlugins).To(Equal([]OutdatedPlugin{
+						{Name: "plugin-1", CurrentVersion: "1.0.0", LatestVersion: "1.1", RepositoryName: "CF-Community"},
 					}))
 				})
 			})
@@ -9,9 +9,11 @@ import (
 )
 
 type PluginInfo struct {
-	Name     string
-	Version  string
-	URL      string
+	Name    string
+	Version string
+	URL     string
+	// Checksum is the SHA-256 of the binary when the repository provides one
+	// and its SHA-1 otherwise.
 	Checksum string
 }
 
@@ -116,7 +118,11 @@ func (actor Actor) getPluginInfoFromRepositoryForPlatform(pluginName string, plu
 		if plugin.Name == pluginName {
 			for _, pluginBinary := range plugin.Binaries {
 				if pluginBinary.Platform == platform {
-					return PluginInfo{Name: plugin.Name, Version: plugin.Version, URL: pluginBinary.URL, Checksum: pluginBinary.Checksum}, nil
+					checksum := pluginBinary.SHA256
+					if checksum == "" {
+						checksum = pluginBinary.Checksum
+					}
+					return PluginInfo{Name: plugin.Name, Version: plugin.Version, URL: pluginBinary.URL, Checksum: checksum}, nil
 				}
 			}
 			pluginFoundWithIncompatibleBinary = true
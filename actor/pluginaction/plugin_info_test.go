@@ -47,7 +47,7 @@ var _ = Describe("plugin info actions", func() {
 								Version: "1.2.3",
 								Binaries: []plugin.PluginBinary{
 									{Platform: "osx", URL: "http://some-darwin-url", Checksum: "somechecksum"},
-									{Platform: "win64", URL: "http://some-windows-url", Checksum: "anotherchecksum"},
+									{Platform: "win64", URL: "http://some-windows-url", Checksum: "anotherchecksum", SHA256: "some-sha256"},
 									{Platform: "linux64", URL: "http://some-linux-url", Checksum: "lastchecksum"},
 								},
 							},
@@ -96,8 +96,17 @@ var _ = Describe("plugin info actions", func() {
 						Expect(pluginInfo.Name).To(Equal("some-plugin"))
 						Expect(pluginInfo.Version).To(Equal("1.2.3"))
 						Expect(pluginInfo.URL).To(Equal("http://some-darwin-url"))
+						Expect(pluginInfo.Checksum).To(Equal("somechecksum"))
 						Expect(repos).To(ConsistOf("some-repo"))
 					})
+
+					Context("when the repository provides a SHA-256 for the binary", func() {
+						It("returns the SHA-256 as the checksum", func() {
+							pluginInfo, _, err := actor.GetPluginInfoFromRepositoriesForPlatform("some-plugin", []configv3.PluginRepository{{Name: "some-repo", URL: "some-url"}}, "win64")
+							Expect(err).ToNot(HaveOccurred())
+							Expect(pluginInfo.Checksum).To(Equal("some-sha256"))
+						})
+					})
 				})
 			})
 		})
@@ -0,0 +1,57 @@
+package pluginaction
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// pluginConfigFilename is the plugin config stored alongside the plugin
+// binaries in the plugin home.
+const pluginConfigFilename = "config.json"
+
+// GetOrphanedPluginBinaries returns the paths of the files in the plugin home
+// that no installed plugin refers to, such as binaries left behind when a
+// plugin's config entry was removed by hand. Directories are ignored.
+func (actor Actor) GetOrphanedPluginBinaries() ([]string, error) {
+	pluginHome := actor.config.PluginHome()
+	files, err := ioutil.ReadDir(pluginHome)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	referenced := map[string]bool{}
+	for _, plugin := range actor.config.Plugins() {
+		referenced[filepath.Clean(plugin.Location)] = true
+	}
+
+	var orphans []string
+	for _, file := range files {
+		if file.IsDir() || file.Name() == pluginConfigFilename {
+			continue
+		}
+
+		path := filepath.Join(pluginHome, file.Name())
+		if !referenced[path] {
+			orphans = append(orphans, path)
+		}
+	}
+
+	sort.Strings(orphans)
+	return orphans, nil
+}
+
+// RemovePluginBinaries removes the provided plugin binaries.
+func (actor Actor) RemovePluginBinaries(paths []string) error {
+	for _, path := range paths {
+		err := os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package pluginaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Prune actions", func() {
+	var (
+		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
+		pluginHome string
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		actor = NewActor(fakeConfig, nil)
+
+		var err error
+		pluginHome, err = ioutil.TempDir("", "")
+		Expect(err).ToNot(HaveOccurred())
+		fakeConfig.PluginHomeReturns(pluginHome)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(pluginHome)).To(Succeed())
+	})
+
+	Describe("GetOrphanedPluginBinaries", func() {
+		BeforeEach(func() {
+			for _, name := range []string{"config.json", "installed-plugin", "orphan-b", "orphan-a"} {
+				Expect(ioutil.WriteFile(filepath.Join(pluginHome, name), nil, 0600)).To(Succeed())
+			}
+			Expect(os.Mkdir(filepath.Join(pluginHome, "temp123"), 0700)).To(Succeed())
+
+			fakeConfig.PluginsReturns([]configv3.Plugin{
+				{Name: "installed-plugin", Location: filepath.Join(pluginHome, "installed-plugin")},
+			})
+		})
+
+		It("returns the files that no installed plugin refers to", func() {
+			orphans, err := actor.GetOrphanedPluginBinaries()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(orphans).To(Equal([]string{
+				filepath.Join(pluginHome, "orphan-a"),
+				filepath.Join(pluginHome, "orphan-b"),
+			}))
+		})
+
+		Context("when the plugin home does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginHomeReturns(filepath.Join(pluginHome, "does-not-exist"))
+			})
+
+			It("returns no binaries", func() {
+				orphans, err := actor.GetOrphanedPluginBinaries()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(orphans).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("RemovePluginBinaries", func() {
+		It("removes the binaries", func() {
+			path := filepath.Join(pluginHome, "orphan")
+			Expect(ioutil.WriteFile(path, nil, 0600)).To(Succeed())
+
+			Expect(actor.RemovePluginBinaries([]string{path, filepath.Join(pluginHome, "already-gone")})).To(Succeed())
+			Expect(path).ToNot(BeAnExistingFile())
+		})
+	})
+})
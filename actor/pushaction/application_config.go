@@ -1,8 +1,10 @@
 package pushaction
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -27,6 +29,12 @@ type ApplicationConfig struct {
 	Path               string
 
 	TargetedSpaceGUID string
+
+	// StagingTimeout and StartupTimeout are how long to wait for the
+	// application to stage and start. Zero means the configured default
+	// should be used.
+	StagingTimeout time.Duration
+	StartupTimeout time.Duration
 }
 
 func (config ApplicationConfig) CreatingApplication() bool {
@@ -37,6 +45,25 @@ func (config ApplicationConfig) UpdatingApplication() bool {
 	return !config.CreatingApplication()
 }
 
+// RoutesToUnbind returns the routes currently bound to the application that
+// are not among its desired routes.
+func (config ApplicationConfig) RoutesToUnbind() []v2action.Route {
+	var routes []v2action.Route
+	for _, currentRoute := range config.CurrentRoutes {
+		desired := false
+		for _, desiredRoute := range config.DesiredRoutes {
+			if desiredRoute.GUID == currentRoute.GUID {
+				desired = true
+				break
+			}
+		}
+		if !desired {
+			routes = append(routes, currentRoute)
+		}
+	}
+	return routes
+}
+
 func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]ApplicationConfig, Warnings, error) {
 	var configs []ApplicationConfig
 	var warnings Warnings
@@ -51,6 +78,8 @@ func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string,
 		config := ApplicationConfig{
 			TargetedSpaceGUID: spaceGUID,
 			Path:              absPath,
+			StagingTimeout:    time.Duration(app.StagingTimeout) * time.Minute,
+			StartupTimeout:    time.Duration(app.HealthCheckTimeout) * time.Second,
 		}
 
 		log.Infoln("searching for app", app.Name)
@@ -93,7 +122,7 @@ func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string,
 		}
 
 		var routeWarnings Warnings
-		config, routeWarnings, err = actor.configureRoutes(app.Routes, orgGUID, spaceGUID, config)
+		config, routeWarnings, err = actor.configureRoutes(app, orgGUID, spaceGUID, config)
 		warnings = append(warnings, routeWarnings...)
 		if err != nil {
 			log.Errorln("determining routes:", err)
@@ -112,15 +141,29 @@ func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string,
 	return configs, warnings, nil
 }
 
-func (actor Actor) configureRoutes(routesInManifest []string, orgGUID string, spaceGUID string, config ApplicationConfig) (ApplicationConfig, Warnings, error) {
-	if len(routesInManifest) > 0 {
+func (actor Actor) configureRoutes(app manifest.Application, orgGUID string, spaceGUID string, config ApplicationConfig) (ApplicationConfig, Warnings, error) {
+	switch {
+	case app.NoRoute:
+		log.Debug("no-route set, removing all routes")
+		config.DesiredRoutes = nil
+		return config, nil, nil
+	case len(app.Routes) > 0:
 		var warnings Warnings
 		var err error
-		config.DesiredRoutes, warnings, err = actor.CalculateRoutes(routesInManifest, orgGUID, spaceGUID, config.CurrentRoutes)
+		config.DesiredRoutes, warnings, err = actor.CalculateRoutes(app.Routes, orgGUID, spaceGUID, config.CurrentRoutes)
 		return config, warnings, err
+	case app.RandomRoute && len(config.CurrentRoutes) > 0:
+		log.Debug("random-route set and app already has routes, keeping them")
+		config.DesiredRoutes = config.CurrentRoutes
+		return config, nil, nil
+	}
+
+	host := config.DesiredApplication.Name
+	if app.RandomRoute {
+		host = fmt.Sprintf("%s-%s", host, actor.WordGenerator.Babble())
 	}
 
-	defaultRoute, warnings, err := actor.GetRouteWithDefaultDomain(config.DesiredApplication.Name, orgGUID, spaceGUID, config.CurrentRoutes)
+	defaultRoute, warnings, err := actor.GetRouteWithDefaultDomain(host, orgGUID, spaceGUID, config.CurrentRoutes)
 	if err != nil {
 		log.Errorln("getting default route:", err)
 		return config, warnings, err
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/pushaction"
@@ -13,6 +14,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/words/generator/generatorfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -61,6 +63,26 @@ var _ = Describe("Application Config", func() {
 				})
 			})
 		})
+
+		Describe("RoutesToUnbind", func() {
+			It("returns the current routes that are not desired", func() {
+				config := ApplicationConfig{
+					CurrentRoutes: []v2action.Route{{GUID: "route-guid-1"}, {GUID: "route-guid-2"}},
+					DesiredRoutes: []v2action.Route{{GUID: "route-guid-2"}, {Host: "new-route"}},
+				}
+				Expect(config.RoutesToUnbind()).To(ConsistOf(v2action.Route{GUID: "route-guid-1"}))
+			})
+
+			Context("when all current routes are desired", func() {
+				It("returns no routes", func() {
+					config := ApplicationConfig{
+						CurrentRoutes: []v2action.Route{{GUID: "route-guid-1"}},
+						DesiredRoutes: []v2action.Route{{GUID: "route-guid-1"}},
+					}
+					Expect(config.RoutesToUnbind()).To(BeEmpty())
+				})
+			})
+		})
 	})
 
 	Describe("ConvertToApplicationConfigs", func() {
@@ -280,6 +302,19 @@ var _ = Describe("Application Config", func() {
 			})
 		})
 
+		Context("when the manifest specifies timeouts", func() {
+			BeforeEach(func() {
+				manifestApps[0].StagingTimeout = 30
+				manifestApps[0].HealthCheckTimeout = 90
+			})
+
+			It("sets the staging and startup timeouts on the config", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(firstConfig.StagingTimeout).To(Equal(30 * time.Minute))
+				Expect(firstConfig.StartupTimeout).To(Equal(90 * time.Second))
+			})
+		})
+
 		Context("when retrieving the application errors", func() {
 			var expectedErr error
 
@@ -613,6 +648,70 @@ var _ = Describe("Application Config", func() {
 			})
 		})
 
+		Context("when no-route is set", func() {
+			var existingRoute v2action.Route
+
+			BeforeEach(func() {
+				manifestApps[0].NoRoute = true
+
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid", Name: appName}, nil, nil)
+				existingRoute = v2action.Route{GUID: "route-guid", Host: appName, Domain: domain, SpaceGUID: spaceGUID}
+				fakeV2Actor.GetApplicationRoutesReturns([]v2action.Route{existingRoute}, v2action.Warnings{"app-route-warnings"}, nil)
+			})
+
+			It("removes all routes from the desired routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(firstConfig.DesiredRoutes).To(BeEmpty())
+				Expect(firstConfig.RoutesToUnbind()).To(ConsistOf(existingRoute))
+
+				Expect(fakeV2Actor.FindRouteBoundToSpaceWithSettingsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when random-route is set", func() {
+			var fakeWordGenerator *generatorfakes.FakeWordGenerator
+
+			BeforeEach(func() {
+				manifestApps[0].RandomRoute = true
+
+				fakeWordGenerator = new(generatorfakes.FakeWordGenerator)
+				fakeWordGenerator.BabbleReturns("random-words")
+				actor.WordGenerator = fakeWordGenerator
+			})
+
+			Context("when the app has no routes", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, actionerror.ApplicationNotFoundError{})
+					fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(v2action.Route{}, v2action.Warnings{"get-route-warnings"}, v2action.RouteNotFoundError{})
+				})
+
+				It("adds a route with a random hostname to the desired routes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.DesiredRoutes).To(ConsistOf(v2action.Route{
+						Domain:    domain,
+						Host:      "some-app-random-words",
+						SpaceGUID: spaceGUID,
+					}))
+				})
+			})
+
+			Context("when the app already has routes", func() {
+				var existingRoute v2action.Route
+
+				BeforeEach(func() {
+					fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid", Name: appName}, nil, nil)
+					existingRoute = v2action.Route{GUID: "route-guid", Host: "some-app-old-words", Domain: domain, SpaceGUID: spaceGUID}
+					fakeV2Actor.GetApplicationRoutesReturns([]v2action.Route{existingRoute}, nil, nil)
+				})
+
+				It("keeps the existing routes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.DesiredRoutes).To(ConsistOf(existingRoute))
+					Expect(fakeWordGenerator.BabbleCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when scanning for files", func() {
 			Context("given a directory", func() {
 				Context("when scanning is successful", func() {
@@ -59,6 +59,20 @@ func (actor Actor) Apply(config ApplicationConfig, progressBar ProgressBar) (<-c
 			eventStream <- CreatedRoutes
 		}
 
+		if len(config.RoutesToUnbind()) > 0 {
+			eventStream <- UnbindingRoutes
+			var unboundRoutes bool
+			config, unboundRoutes, warnings, err = actor.UnbindRoutes(config)
+			warningsStream <- warnings
+			if err != nil {
+				errorStream <- err
+				return
+			}
+			if unboundRoutes {
+				eventStream <- UnboundRoutes
+			}
+		}
+
 		var boundRoutes bool
 		config, boundRoutes, warnings, err = actor.BindRoutes(config)
 		warningsStream <- warnings
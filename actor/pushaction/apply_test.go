@@ -337,6 +337,50 @@ var _ = Describe("Apply", func() {
 			})
 		})
 
+		Context("when there are routes to unbind", func() {
+			BeforeEach(func() {
+				config.DesiredRoutes = nil
+				config.CurrentRoutes = []v2action.Route{{Host: "old-route", GUID: "old-route-guid"}}
+			})
+
+			Context("when unbinding the routes is successful", func() {
+				BeforeEach(func() {
+					fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-route-warnings-1", "unbind-route-warnings-2"}, nil)
+				})
+
+				It("sends the UnbindingRoutes and UnboundRoutes events", func() {
+					Eventually(eventStream).Should(Receive(Equal(ConfiguringRoutes)))
+					Eventually(warningsStream).Should(Receive())
+					Eventually(eventStream).Should(Receive(Equal(UnbindingRoutes)))
+					Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warnings-1", "unbind-route-warnings-2")))
+					Eventually(eventStream).Should(Receive(Equal(UnboundRoutes)))
+
+					Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(1))
+					routeGUID, appGUID := fakeV2Actor.UnbindRouteFromApplicationArgsForCall(0)
+					Expect(routeGUID).To(Equal("old-route-guid"))
+					Expect(appGUID).To(Equal("some-app-guid"))
+				})
+			})
+
+			Context("when unbinding the routes errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("dios mio")
+					fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-route-warnings-1", "unbind-route-warnings-2"}, expectedErr)
+				})
+
+				It("sends warnings and errors, then stops", func() {
+					Eventually(eventStream).Should(Receive(Equal(ConfiguringRoutes)))
+					Eventually(warningsStream).Should(Receive())
+					Eventually(eventStream).Should(Receive(Equal(UnbindingRoutes)))
+					Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warnings-1", "unbind-route-warnings-2")))
+					Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+					Consistently(eventStream).ShouldNot(Receive())
+				})
+			})
+		})
+
 		Context("when the route creation errors", func() {
 			var expectedErr error
 
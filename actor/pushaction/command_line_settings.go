@@ -21,7 +21,9 @@ type CommandLineSettings struct {
 	Instances          types.NullInt
 	Memory             uint64
 	Name               string
+	NoRoute            bool
 	ProvidedAppPath    string
+	RandomRoute        bool
 	StackName          string
 }
 
@@ -77,6 +79,14 @@ func (settings CommandLineSettings) OverrideManifestSettings(app manifest.Applic
 		app.Name = settings.Name
 	}
 
+	if settings.NoRoute {
+		app.NoRoute = true
+	}
+
+	if settings.RandomRoute {
+		app.RandomRoute = true
+	}
+
 	if settings.ProvidedAppPath != "" {
 		app.Path = settings.absoluteProvidedAppPath()
 	}
@@ -93,7 +103,7 @@ func (settings CommandLineSettings) OverrideManifestSettings(app manifest.Applic
 
 func (settings CommandLineSettings) String() string {
 	return fmt.Sprintf(
-		"App Name: '%s', Buildpack IsSet: %t, Buildpack: '%s', Command IsSet: %t, Command: '%s', CurrentDirectory: '%s', Disk Quota: '%d', Docker Image: '%s', Health Check Timeout: '%d', Health Check Type: '%s', Instances IsSet: %t, Instances: '%d', Memory: '%d', Provided App Path: '%s', Stack: '%s'",
+		"App Name: '%s', Buildpack IsSet: %t, Buildpack: '%s', Command IsSet: %t, Command: '%s', CurrentDirectory: '%s', Disk Quota: '%d', Docker Image: '%s', Health Check Timeout: '%d', Health Check Type: '%s', Instances IsSet: %t, Instances: '%d', Memory: '%d', No Route: %t, Provided App Path: '%s', Random Route: %t, Stack: '%s'",
 		settings.Name,
 		settings.Buildpack.IsSet,
 		settings.Buildpack.Value,
@@ -107,7 +117,9 @@ func (settings CommandLineSettings) String() string {
 		settings.Instances.IsSet,
 		settings.Instances.Value,
 		settings.Memory,
+		settings.NoRoute,
 		settings.ProvidedAppPath,
+		settings.RandomRoute,
 		settings.StackName,
 	)
 }
@@ -147,6 +147,26 @@ var _ = Describe("CommandLineSettings", func() {
 			manifest.Application{Name: "steve"},
 			manifest.Application{Name: "steve"},
 		),
+		Entry("overrides no route",
+			CommandLineSettings{NoRoute: true},
+			manifest.Application{},
+			manifest.Application{NoRoute: true},
+		),
+		Entry("passes through no route",
+			CommandLineSettings{},
+			manifest.Application{NoRoute: true},
+			manifest.Application{NoRoute: true},
+		),
+		Entry("overrides random route",
+			CommandLineSettings{RandomRoute: true},
+			manifest.Application{},
+			manifest.Application{RandomRoute: true},
+		),
+		Entry("passes through random route",
+			CommandLineSettings{},
+			manifest.Application{RandomRoute: true},
+			manifest.Application{RandomRoute: true},
+		),
 		Entry("overrides stack name",
 			CommandLineSettings{StackName: "not-steve"},
 			manifest.Application{StackName: "steve"},
@@ -18,7 +18,8 @@ func (e NoDomainsFoundError) Error() string {
 }
 
 // DefaultDomain looks up the shared and then private domains and returns back
-// the first one in the list as the default.
+// the first one in the list as the default. Internal domains are never used
+// as the default since they are not reachable from outside the platform.
 func (actor Actor) DefaultDomain(orgGUID string) (v2action.Domain, Warnings, error) {
 	log.Infoln("getting org domains for org GUID:", orgGUID)
 	domains, warnings, err := actor.V2Actor.GetOrganizationDomains(orgGUID)
@@ -27,11 +28,16 @@ func (actor Actor) DefaultDomain(orgGUID string) (v2action.Domain, Warnings, err
 		return v2action.Domain{}, Warnings(warnings), err
 	}
 
-	if len(domains) == 0 {
-		log.Error("no domains found")
-		return v2action.Domain{}, Warnings(warnings), NoDomainsFoundError{OrganizationGUID: orgGUID}
+	for _, domain := range domains {
+		if domain.Internal {
+			log.WithField("domain", domain.Name).Debug("skipping internal domain")
+			continue
+		}
+
+		log.Debugf("selecting first domain as default domain: %#v", domain)
+		return domain, Warnings(warnings), nil
 	}
 
-	log.Debugf("selecting first domain as default domain: %#v", domains)
-	return domains[0], Warnings(warnings), nil
+	log.Error("no domains found")
+	return v2action.Domain{}, Warnings(warnings), NoDomainsFoundError{OrganizationGUID: orgGUID}
 }
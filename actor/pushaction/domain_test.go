@@ -72,6 +72,46 @@ var _ = Describe("Domains", func() {
 			})
 		})
 
+		Context("when the first domain is internal", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{
+					{
+						Name:     "apps.internal",
+						GUID:     "some-internal-domain-guid",
+						Internal: true,
+					},
+					{
+						Name: "shared-domain.com",
+						GUID: "some-shared-domain-guid",
+					},
+				}, nil, nil)
+			})
+
+			It("returns the first domain that is not internal", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(defaultDomain).To(Equal(v2action.Domain{
+					Name: "shared-domain.com",
+					GUID: "some-shared-domain-guid",
+				}))
+			})
+		})
+
+		Context("when only internal domains exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{
+					{
+						Name:     "apps.internal",
+						GUID:     "some-internal-domain-guid",
+						Internal: true,
+					},
+				}, nil, nil)
+			})
+
+			It("returns a NoDomainsFoundError", func() {
+				Expect(executeErr).To(MatchError(NoDomainsFoundError{OrganizationGUID: orgGUID}))
+			})
+		})
+
 		Context("no domains exist", func() {
 			BeforeEach(func() {
 				fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{}, v2action.Warnings{"private-domain-warnings", "shared-domain-warnings"}, nil)
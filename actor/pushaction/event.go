@@ -7,6 +7,8 @@ const (
 	CreatedApplication   Event = "created application"
 	UpdatedApplication   Event = "updated application"
 	ConfiguringRoutes    Event = "configuring routes"
+	UnbindingRoutes      Event = "unbinding routes"
+	UnboundRoutes        Event = "unbound routes"
 	CreatedRoutes        Event = "created routes"
 	BoundRoutes          Event = "bound routes"
 	ConfiguringServices  Event = "configuring services"
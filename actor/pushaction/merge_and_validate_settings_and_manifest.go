@@ -3,6 +3,7 @@ package pushaction
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"code.cloudfoundry.org/cli/util/manifest"
 	log "github.com/sirupsen/logrus"
@@ -36,6 +37,37 @@ func (e AppNotFoundInManifestError) Error() string {
 	return fmt.Sprintf("specfied app: %s not found in manifest", e.Name)
 }
 
+type InvalidStagingTimeoutError struct {
+	AppName string
+	Timeout int
+}
+
+func (e InvalidStagingTimeoutError) Error() string {
+	return fmt.Sprintf("invalid staging timeout for app %s: %d", e.AppName, e.Timeout)
+}
+
+// PropertyCombinationError is returned when an app is given properties that
+// cannot be used together.
+type PropertyCombinationError struct {
+	AppName    string
+	Properties []string
+}
+
+func (e PropertyCombinationError) Error() string {
+	return fmt.Sprintf("app %s cannot use the combination of properties: %s", e.AppName, strings.Join(e.Properties, ", "))
+}
+
+// SidecarMemoryExceedsProcessMemoryError is returned when a sidecar reserves
+// more memory than the app's processes are given.
+type SidecarMemoryExceedsProcessMemoryError struct {
+	AppName     string
+	SidecarName string
+}
+
+func (e SidecarMemoryExceedsProcessMemoryError) Error() string {
+	return fmt.Sprintf("memory of sidecar %s exceeds the process memory of app %s", e.SidecarName, e.AppName)
+}
+
 func (actor Actor) MergeAndValidateSettingsAndManifests(settings CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error) {
 	var mergedApps []manifest.Application
 
@@ -123,6 +155,20 @@ func (Actor) validateMergedSettings(apps []manifest.Application) error {
 			log.WithField("path", app.Path).Error("app path does not exist")
 			return NonexistentAppPathError{Path: app.Path}
 		}
+		if app.StagingTimeout < 0 {
+			log.WithField("stagingTimeout", app.StagingTimeout).Error("staging timeout is negative")
+			return InvalidStagingTimeoutError{AppName: app.Name, Timeout: app.StagingTimeout}
+		}
+		if app.NoRoute && len(app.Routes) > 0 {
+			log.WithField("appName", app.Name).Error("no-route and routes are both set")
+			return PropertyCombinationError{AppName: app.Name, Properties: []string{"no-route", "routes"}}
+		}
+		for _, sidecar := range app.Sidecars {
+			if app.Memory.IsSet && sidecar.Memory.IsSet && sidecar.Memory.Value > app.Memory.Value {
+				log.WithField("sidecar", sidecar.Name).Error("sidecar memory exceeds process memory")
+				return SidecarMemoryExceedsProcessMemoryError{AppName: app.Name, SidecarName: sidecar.Name}
+			}
+		}
 	}
 	return nil
 }
@@ -181,6 +181,31 @@ var _ = Describe("MergeAndValidateSettingsAndManifest", func() {
 		})
 	})
 
+	Context("when a sidecar reserves no more memory than the process has", func() {
+		var apps []manifest.Application
+
+		BeforeEach(func() {
+			cmdSettings = CommandLineSettings{
+				CurrentDirectory: currentDirectory,
+			}
+
+			apps = []manifest.Application{{
+				Name:   "some-app",
+				Memory: types.NullByteSizeInMb{IsSet: true, Value: 256},
+				Sidecars: []manifest.Sidecar{
+					{Name: "some-sidecar", Memory: types.NullByteSizeInMb{IsSet: true, Value: 256}},
+				},
+			}}
+		})
+
+		It("keeps the sidecars on the merged app", func() {
+			mergedApps, err := actor.MergeAndValidateSettingsAndManifests(cmdSettings, apps)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mergedApps).To(HaveLen(1))
+			Expect(mergedApps[0].Sidecars).To(Equal(apps[0].Sidecars))
+		})
+	})
+
 	DescribeTable("validation errors",
 		func(settings CommandLineSettings, apps []manifest.Application, expectedErr error) {
 			_, err := actor.MergeAndValidateSettingsAndManifests(settings, apps)
@@ -191,6 +216,24 @@ var _ = Describe("MergeAndValidateSettingsAndManifest", func() {
 		Entry("MissingNameError", CommandLineSettings{}, []manifest.Application{{}}, MissingNameError{}),
 		Entry("NonexistentAppPathError", CommandLineSettings{Name: "some-name", ProvidedAppPath: "does-not-exist"}, nil, NonexistentAppPathError{Path: "does-not-exist"}),
 		Entry("NonexistentAppPathError", CommandLineSettings{}, []manifest.Application{{Name: "some-name", Path: "does-not-exist"}}, NonexistentAppPathError{Path: "does-not-exist"}),
+		Entry("InvalidStagingTimeoutError", CommandLineSettings{}, []manifest.Application{{Name: "some-name", Path: ".", StagingTimeout: -1}}, InvalidStagingTimeoutError{AppName: "some-name", Timeout: -1}),
+		Entry("PropertyCombinationError",
+			CommandLineSettings{},
+			[]manifest.Application{{Name: "some-name", Path: ".", NoRoute: true, Routes: []string{"some-route.com"}}},
+			PropertyCombinationError{AppName: "some-name", Properties: []string{"no-route", "routes"}}),
+		Entry("PropertyCombinationError",
+			CommandLineSettings{NoRoute: true},
+			[]manifest.Application{{Name: "some-name", Path: ".", Routes: []string{"some-route.com"}}},
+			PropertyCombinationError{AppName: "some-name", Properties: []string{"no-route", "routes"}}),
+		Entry("SidecarMemoryExceedsProcessMemoryError",
+			CommandLineSettings{},
+			[]manifest.Application{{
+				Name:     "some-name",
+				Path:     ".",
+				Memory:   types.NullByteSizeInMb{IsSet: true, Value: 128},
+				Sidecars: []manifest.Sidecar{{Name: "some-sidecar", Memory: types.NullByteSizeInMb{IsSet: true, Value: 256}}},
+			}},
+			SidecarMemoryExceedsProcessMemoryError{AppName: "some-name", SidecarName: "some-sidecar"}),
 		Entry("CommandLineOptionsWithMultipleAppsError",
 			CommandLineSettings{Buildpack: types.FilteredString{IsSet: true}},
 			[]manifest.Application{{Name: "some-name-1"}, {Name: "some-name-2"}},
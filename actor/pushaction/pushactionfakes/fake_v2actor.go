@@ -324,6 +324,20 @@ type FakeV2Actor struct {
 		result1 string
 		result2 error
 	}
+	UnbindRouteFromApplicationStub        func(routeGUID string, appGUID string) (v2action.Warnings, error)
+	unbindRouteFromApplicationMutex       sync.RWMutex
+	unbindRouteFromApplicationArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+	}
+	unbindRouteFromApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	unbindRouteFromApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1480,6 +1494,58 @@ func (fake *FakeV2Actor) ZipDirectoryResourcesReturnsOnCall(i int, result1 strin
 	}{result1, result2}
 }
 
+func (fake *FakeV2Actor) UnbindRouteFromApplication(routeGUID string, appGUID string) (v2action.Warnings, error) {
+	fake.unbindRouteFromApplicationMutex.Lock()
+	ret, specificReturn := fake.unbindRouteFromApplicationReturnsOnCall[len(fake.unbindRouteFromApplicationArgsForCall)]
+	fake.unbindRouteFromApplicationArgsForCall = append(fake.unbindRouteFromApplicationArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+	}{routeGUID, appGUID})
+	fake.recordInvocation("UnbindRouteFromApplication", []interface{}{routeGUID, appGUID})
+	fake.unbindRouteFromApplicationMutex.Unlock()
+	if fake.UnbindRouteFromApplicationStub != nil {
+		return fake.UnbindRouteFromApplicationStub(routeGUID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.unbindRouteFromApplicationReturns.result1, fake.unbindRouteFromApplicationReturns.result2
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationCallCount() int {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return len(fake.unbindRouteFromApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationArgsForCall(i int) (string, string) {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return fake.unbindRouteFromApplicationArgsForCall[i].routeGUID, fake.unbindRouteFromApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	fake.unbindRouteFromApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	if fake.unbindRouteFromApplicationReturnsOnCall == nil {
+		fake.unbindRouteFromApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.unbindRouteFromApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1525,6 +1591,8 @@ func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	defer fake.zipArchiveResourcesMutex.RUnlock()
 	fake.zipDirectoryResourcesMutex.RLock()
 	defer fake.zipDirectoryResourcesMutex.RUnlock()
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
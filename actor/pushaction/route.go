@@ -37,6 +37,38 @@ func (actor Actor) BindRoutes(config ApplicationConfig) (ApplicationConfig, bool
 	return config, boundRoutes, allWarnings, nil
 }
 
+// UnbindRoutes unbinds the routes that are bound to the application but not
+// desired. The routes themselves are left in the space.
+func (actor Actor) UnbindRoutes(config ApplicationConfig) (ApplicationConfig, bool, Warnings, error) {
+	log.Info("unbinding routes")
+
+	var unboundRoutes bool
+	var allWarnings Warnings
+
+	routesToUnbind := config.RoutesToUnbind()
+	for _, route := range routesToUnbind {
+		log.Debugf("unbinding route: %#v", route)
+		warnings, err := actor.V2Actor.UnbindRouteFromApplication(route.GUID, config.DesiredApplication.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("unbinding route:", err)
+			return ApplicationConfig{}, false, allWarnings, err
+		}
+		unboundRoutes = true
+	}
+	log.Debug("unbinding routes complete")
+
+	var remainingRoutes []v2action.Route
+	for _, route := range config.CurrentRoutes {
+		if !actor.routeInListByGUID(route, routesToUnbind) {
+			remainingRoutes = append(remainingRoutes, route)
+		}
+	}
+	config.CurrentRoutes = remainingRoutes
+
+	return config, unboundRoutes, allWarnings, nil
+}
+
 func (actor Actor) CalculateRoutes(routes []string, orgGUID string, spaceGUID string, existingRoutes []v2action.Route) ([]v2action.Route, Warnings, error) {
 	calculatedRoutes, unknownRoutes := actor.spitExistingRoutes(existingRoutes, routes)
 	possibleDomains, err := actor.generatePossibleDomains(unknownRoutes)
@@ -117,6 +117,90 @@ var _ = Describe("Routes", func() {
 		})
 	})
 
+	Describe("UnbindRoutes", func() {
+		var (
+			config ApplicationConfig
+
+			returnedConfig ApplicationConfig
+			unboundRoutes  bool
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			config = ApplicationConfig{
+				DesiredApplication: Application{
+					Application: v2action.Application{
+						GUID: "some-app-guid",
+					}},
+			}
+		})
+
+		JustBeforeEach(func() {
+			returnedConfig, unboundRoutes, warnings, executeErr = actor.UnbindRoutes(config)
+		})
+
+		Context("when routes need to be unbound from the application", func() {
+			BeforeEach(func() {
+				config.CurrentRoutes = []v2action.Route{
+					{GUID: "some-route-guid-1", Host: "some-route-1"},
+					{GUID: "some-route-guid-2", Host: "some-route-2"},
+					{GUID: "some-route-guid-3", Host: "some-route-3"},
+				}
+				config.DesiredRoutes = []v2action.Route{
+					{GUID: "some-route-guid-2", Host: "some-route-2"},
+					{GUID: "some-route-guid-4", Host: "some-route-4"},
+				}
+			})
+
+			Context("when the unbinding is successful", func() {
+				BeforeEach(func() {
+					fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-route-warning"}, nil)
+				})
+
+				It("only unbinds the routes that are not desired", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("unbind-route-warning", "unbind-route-warning"))
+					Expect(unboundRoutes).To(BeTrue())
+
+					Expect(returnedConfig.CurrentRoutes).To(ConsistOf(v2action.Route{GUID: "some-route-guid-2", Host: "some-route-2"}))
+
+					Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(2))
+
+					routeGUID, appGUID := fakeV2Actor.UnbindRouteFromApplicationArgsForCall(0)
+					Expect(routeGUID).To(Equal("some-route-guid-1"))
+					Expect(appGUID).To(Equal("some-app-guid"))
+
+					routeGUID, appGUID = fakeV2Actor.UnbindRouteFromApplicationArgsForCall(1)
+					Expect(routeGUID).To(Equal("some-route-guid-3"))
+					Expect(appGUID).To(Equal("some-app-guid"))
+				})
+			})
+
+			Context("when the unbinding errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("oh my")
+					fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-route-warning"}, expectedErr)
+				})
+
+				It("returns the warnings and error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("unbind-route-warning"))
+				})
+			})
+		})
+
+		Context("when no routes need to be unbound", func() {
+			It("returns false", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(unboundRoutes).To(BeFalse())
+				Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("CalculateRoutes", func() {
 		var (
 			routes         []string
@@ -0,0 +1,22 @@
+package v2action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+type AppUsageEvent ccv2.AppUsageEvent
+
+// GetAppUsageEvents calls eventFunc, oldest first, for each app usage event
+// created after the event with the given GUID. When since is not zero, events
+// created before since are skipped.
+func (actor Actor) GetAppUsageEvents(afterGUID string, since time.Time, eventFunc func(AppUsageEvent) error) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.GetAppUsageEvents(afterGUID, func(event ccv2.AppUsageEvent) error {
+		if event.CreatedAt.Before(since) {
+			return nil
+		}
+		return eventFunc(AppUsageEvent(event))
+	})
+	return Warnings(warnings), err
+}
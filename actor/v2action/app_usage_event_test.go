@@ -0,0 +1,94 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("App Usage Event Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetAppUsageEvents", func() {
+		var (
+			since      time.Time
+			events     []AppUsageEvent
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			since = time.Time{}
+			events = nil
+
+			fakeCloudControllerClient.GetAppUsageEventsStub = func(_ string, eventFunc func(ccv2.AppUsageEvent) error) (ccv2.Warnings, error) {
+				for _, event := range []ccv2.AppUsageEvent{
+					{GUID: "event-guid-1", CreatedAt: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+					{GUID: "event-guid-2", CreatedAt: time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC)},
+				} {
+					if err := eventFunc(event); err != nil {
+						return ccv2.Warnings{"event-warning"}, err
+					}
+				}
+				return ccv2.Warnings{"event-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.GetAppUsageEvents("some-guid", since, func(event AppUsageEvent) error {
+				events = append(events, event)
+				return nil
+			})
+		})
+
+		It("passes every event to eventFunc and returns warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("event-warning"))
+			Expect(events).To(HaveLen(2))
+
+			Expect(fakeCloudControllerClient.GetAppUsageEventsCallCount()).To(Equal(1))
+			afterGUID, _ := fakeCloudControllerClient.GetAppUsageEventsArgsForCall(0)
+			Expect(afterGUID).To(Equal("some-guid"))
+		})
+
+		Context("when since is provided", func() {
+			BeforeEach(func() {
+				since = time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC)
+			})
+
+			It("skips events created before since", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].GUID).To(Equal("event-guid-2"))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("boom")
+				fakeCloudControllerClient.GetAppUsageEventsStub = nil
+				fakeCloudControllerClient.GetAppUsageEventsReturns(ccv2.Warnings{"event-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("event-warning"))
+			})
+		})
+	})
+})
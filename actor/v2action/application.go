@@ -55,6 +55,12 @@ func (application Application) StagingCompleted() bool {
 	return application.PackageState == ccv2.ApplicationPackageStaged
 }
 
+// NeverStaged returns true if no bits have been uploaded for the
+// application, so it has no droplet and has never been staged.
+func (application Application) NeverStaged() bool {
+	return application.PackageUpdatedAt.IsZero() && !application.StagingCompleted()
+}
+
 // StagingFailed returns true if staging the application failed.
 func (application Application) StagingFailed() bool {
 	return application.PackageState == ccv2.ApplicationPackageFailed
@@ -242,7 +248,7 @@ func (actor Actor) StartApplication(app Application, client NOAAClient, config C
 		defer close(appState)
 		defer close(allWarnings)
 		defer close(errs)
-		defer client.Close() // automatic close to prevent stale clients
+		defer closeLogs(client) // automatic close to prevent stale clients
 
 		if app.PackageState != ccv2.ApplicationPackageStaged {
 			appState <- ApplicationStateStaging
@@ -279,7 +285,7 @@ func (actor Actor) RestartApplication(app Application, client NOAAClient, config
 		defer close(appState)
 		defer close(allWarnings)
 		defer close(errs)
-		defer client.Close() // automatic close to prevent stale clients
+		defer closeLogs(client) // automatic close to prevent stale clients
 
 		if app.Started() {
 			appState <- ApplicationStateStopping
@@ -331,7 +337,7 @@ func (actor Actor) RestageApplication(app Application, client NOAAClient, config
 		defer close(appState)
 		defer close(allWarnings)
 		defer close(errs)
-		defer client.Close() // automatic close to prevent stale clients
+		defer closeLogs(client) // automatic close to prevent stale clients
 
 		appState <- ApplicationStateStaging
 		restagedApp, warnings, err := actor.CloudControllerClient.RestageApplication(ccv2.Application{
@@ -420,7 +426,7 @@ func (actor Actor) waitForApplicationStageAndStart(app Application, client NOAAC
 		return
 	}
 
-	client.Close() // Explicit close to stop logs from displaying on the screen
+	closeLogs(client) // Explicit close to stop logs from displaying on the screen
 	appState <- ApplicationStateStarting
 
 	err = actor.pollStartup(app, config, allWarnings)
@@ -110,6 +110,41 @@ func (actor Actor) GetApplicationInstancesWithStatsByApplication(guid string) ([
 	return returnedInstances, allWarnings, err
 }
 
+// GetApplicationInstanceStatsByApplication returns the instances of the
+// application from the instance stats endpoint alone. Unlike
+// GetApplicationInstancesWithStatsByApplication it makes a single request, so
+// it is suited to polling, but the instances have no details and Since is
+// derived from the instance uptime.
+func (actor Actor) GetApplicationInstanceStatsByApplication(guid string) ([]ApplicationInstanceWithStats, Warnings, error) {
+	appInstanceStats, warnings, err := actor.CloudControllerClient.GetApplicationInstanceStatusesByApplication(guid)
+
+	switch err.(type) {
+	case ccerror.ResourceNotFoundError, ccerror.ApplicationStoppedStatsError:
+		return nil, Warnings(warnings), ApplicationInstancesNotFoundError{ApplicationGUID: guid}
+	case nil:
+		// continue
+	default:
+		return nil, Warnings(warnings), err
+	}
+
+	now := time.Now()
+	returnedInstances := []ApplicationInstanceWithStats{}
+	for id, appInstanceStat := range appInstanceStats {
+		returnedInstance := newApplicationInstanceWithStats(id)
+		returnedInstance.setStats(appInstanceStat)
+		returnedInstance.State = ApplicationInstanceState(appInstanceStat.State)
+		if appInstanceStat.Uptime > 0 {
+			returnedInstance.Since = float64(now.Add(-time.Duration(appInstanceStat.Uptime) * time.Second).Unix())
+		}
+
+		returnedInstances = append(returnedInstances, returnedInstance)
+	}
+
+	sort.Slice(returnedInstances, func(i int, j int) bool { return returnedInstances[i].ID < returnedInstances[j].ID })
+
+	return returnedInstances, Warnings(warnings), nil
+}
+
 func combineStatsAndInstances(appInstanceStats map[int]ccv2.ApplicationInstanceStatus, appInstances map[int]ApplicationInstance) []ApplicationInstanceWithStats {
 	returnedInstances := []ApplicationInstanceWithStats{}
 	seenStatuses := make(map[int]bool, len(appInstanceStats))
@@ -38,6 +38,77 @@ var _ = Describe("Application Instance With Stats Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationInstanceStatsByApplication", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationReturns(
+					map[int]ccv2.ApplicationInstanceStatus{
+						1: {ID: 1, State: ccv2.ApplicationInstanceCrashed},
+						0: {
+							ID:          0,
+							CPU:         100,
+							Memory:      100,
+							MemoryQuota: 200,
+							Disk:        50,
+							DiskQuota:   100,
+							State:       ccv2.ApplicationInstanceRunning,
+							Uptime:      60,
+						},
+					},
+					ccv2.Warnings{"stats-warning-1", "stats-warning-2"},
+					nil)
+			})
+
+			It("returns the application instances from the stats endpoint only", func() {
+				instances, warnings, err := actor.GetApplicationInstanceStatsByApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("stats-warning-1", "stats-warning-2"))
+
+				Expect(instances).To(HaveLen(2))
+				Expect(instances[0].ID).To(Equal(0))
+				Expect(instances[0].State).To(Equal(ApplicationInstanceState(ccv2.ApplicationInstanceRunning)))
+				Expect(instances[0].CPU).To(Equal(100.0))
+				Expect(instances[0].Memory).To(Equal(100))
+				Expect(instances[0].MemoryQuota).To(Equal(200))
+				Expect(instances[0].TimeSinceCreation()).To(BeTemporally("~", time.Now().Add(-time.Minute), 2*time.Second))
+				Expect(instances[1].ID).To(Equal(1))
+				Expect(instances[1].State).To(Equal(ApplicationInstanceState(ccv2.ApplicationInstanceCrashed)))
+				Expect(instances[1].Since).To(BeZero())
+
+				Expect(fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app is stopped", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationReturns(nil, ccv2.Warnings{"stats-warning"}, ccerror.ApplicationStoppedStatsError{})
+			})
+
+			It("returns an ApplicationInstancesNotFoundError", func() {
+				_, warnings, err := actor.GetApplicationInstanceStatsByApplication("some-app-guid")
+				Expect(err).To(MatchError(ApplicationInstancesNotFoundError{ApplicationGUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("stats-warning"))
+			})
+		})
+
+		Context("when getting the stats fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("stats are broken")
+				fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationReturns(nil, ccv2.Warnings{"stats-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationInstanceStatsByApplication("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("stats-warning"))
+			})
+		})
+	})
+
 	Describe("GetApplicationInstancesWithStatsByApplication", func() {
 		Context("when the application exists", func() {
 			BeforeEach(func() {
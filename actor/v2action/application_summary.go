@@ -8,6 +8,10 @@ type ApplicationSummary struct {
 	IsolationSegment string
 	RunningInstances []ApplicationInstanceWithStats
 	Routes           []Route
+
+	// LastUploadedBy is the name of whoever last uploaded the application. It
+	// is only set by callers that look it up, and is not displayed otherwise.
+	LastUploadedBy string
 }
 
 func (app ApplicationSummary) StartingOrRunningInstanceCount() int {
@@ -60,12 +64,16 @@ func (actor Actor) GetApplicationSummaryByNameAndSpace(name string, spaceGUID st
 	}
 	applicationSummary.Routes = routes
 
-	stack, warnings, err := actor.GetStack(app.StackGUID)
-	allWarnings = append(allWarnings, warnings...)
-	if err != nil {
-		return ApplicationSummary{}, allWarnings, err
+	// apps created without bits, such as docker apps created through the v3
+	// API, may not have a stack yet
+	if app.StackGUID != "" {
+		stack, warnings, err := actor.GetStack(app.StackGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ApplicationSummary{}, allWarnings, err
+		}
+		applicationSummary.Stack = stack
 	}
-	applicationSummary.Stack = stack
 
 	return applicationSummary, allWarnings, nil
 }
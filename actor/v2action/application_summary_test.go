@@ -204,6 +204,11 @@ var _ = Describe("Application Summary Actions", func() {
 
 			Context("when the app has stack information", func() {
 				BeforeEach(func() {
+					app.StackGUID = "some-stack-guid"
+					fakeCloudControllerClient.GetApplicationsReturns(
+						[]ccv2.Application{app},
+						ccv2.Warnings{"app-warning"},
+						nil)
 					fakeCloudControllerClient.GetStackReturns(
 						ccv2.Stack{Name: "some-stack"},
 						ccv2.Warnings{"get-application-stack-warning"},
@@ -217,6 +222,24 @@ var _ = Describe("Application Summary Actions", func() {
 					Expect(app.Stack).To(Equal(Stack{Name: "some-stack"}))
 				})
 
+				Context("when the app has no stack", func() {
+					BeforeEach(func() {
+						app.StackGUID = ""
+						fakeCloudControllerClient.GetApplicationsReturns(
+							[]ccv2.Application{app},
+							ccv2.Warnings{"app-warning"},
+							nil)
+					})
+
+					It("does not look up the stack", func() {
+						app, warnings, err := actor.GetApplicationSummaryByNameAndSpace("some-app", "some-space-guid")
+						Expect(err).ToNot(HaveOccurred())
+						Expect(warnings).To(ConsistOf("app-warning"))
+						Expect(app.Stack).To(Equal(Stack{}))
+						Expect(fakeCloudControllerClient.GetStackCallCount()).To(Equal(0))
+					})
+				})
+
 				Context("when an error is encountered while getting stack", func() {
 					var expectedErr error
 
@@ -132,6 +132,23 @@ var _ = Describe("Application Actions", func() {
 			})
 		})
 
+		Describe("NeverStaged", func() {
+			Context("when no bits have been uploaded", func() {
+				It("returns true", func() {
+					app.PackageState = ccv2.ApplicationPackagePending
+					Expect(app.NeverStaged()).To(BeTrue())
+				})
+			})
+
+			Context("when bits have been uploaded", func() {
+				It("returns false", func() {
+					app.PackageState = ccv2.ApplicationPackagePending
+					app.PackageUpdatedAt = time.Now()
+					Expect(app.NeverStaged()).To(BeFalse())
+				})
+			})
+		})
+
 		Describe("StagingFailed", func() {
 			Context("when staging the application fails", func() {
 				It("returns true", func() {
@@ -1237,6 +1254,36 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("StartApplication without a logging client", func() {
+		var fakeConfig *v2actionfakes.FakeConfig
+
+		BeforeEach(func() {
+			fakeConfig = new(v2actionfakes.FakeConfig)
+			fakeConfig.StagingTimeoutReturns(time.Minute)
+
+			fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{
+				GUID:      "some-app-guid",
+				Name:      "some-app",
+				Instances: types.NullInt{Value: 0, IsSet: true},
+			}, ccv2.Warnings{"state-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationReturns(ccv2.Application{
+				GUID:         "some-app-guid",
+				PackageState: ccv2.ApplicationPackageStaged,
+			}, ccv2.Warnings{"app-warning"}, nil)
+		})
+
+		It("starts the app without streaming logs", func() {
+			messages, logErrs, appState, warnings, errs := actor.StartApplication(Application{GUID: "some-app-guid", Name: "some-app"}, nil, fakeConfig)
+			Expect(messages).To(BeNil())
+			Expect(logErrs).To(BeNil())
+
+			Eventually(appState).Should(Receive(Equal(ApplicationStateStaging)))
+			Eventually(warnings).Should(Receive(Equal("state-warning")))
+			Eventually(warnings).Should(Receive(Equal("app-warning")))
+			Eventually(errs).Should(BeClosed())
+		})
+	})
+
 	Describe("UpdateApplication", func() {
 		Context("when the update is successful", func() {
 			var expectedApp ccv2.Application
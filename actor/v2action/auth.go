@@ -1,23 +1,66 @@
 package v2action
 
-import "fmt"
+import (
+	"fmt"
 
-// Authenticate authenticates the user in UAA and sets the returned tokens in
-// the config.
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
+
+// LoginPrompt represents a credential the authentication server asks for when
+// logging in.
+type LoginPrompt uaa.LoginPrompt
+
+// LoginPromptTypePassword is the type of prompts whose input should be
+// hidden.
+const LoginPromptTypePassword = "password"
+
+// GetLoginPrompts returns the credentials, keyed by name, that the
+// authentication server asks for when logging in.
+func (actor Actor) GetLoginPrompts() (map[string]LoginPrompt, error) {
+	uaaPrompts, err := actor.UAAClient.GetLoginPrompts()
+	if err != nil {
+		return nil, err
+	}
+
+	prompts := map[string]LoginPrompt{}
+	for name, prompt := range uaaPrompts {
+		prompts[name] = LoginPrompt(prompt)
+	}
+	return prompts, nil
+}
+
+// Authenticate authenticates the user or client in UAA and sets the returned
+// tokens in the config. With the password grant, credentials holds the
+// username, the password and any other values UAA prompts for, such as an MFA
+// code. With the client credentials grant, it holds the client_id and
+// client_secret, which are stored so the token can be renewed once it
+// expires.
 //
 // It unsets the currently targeted org and space whether authentication
 // succeeds or not.
-func (actor Actor) Authenticate(config Config, username string, password string) error {
+func (actor Actor) Authenticate(config Config, credentials map[string]string, grantType constant.GrantType) error {
 	config.UnsetOrganizationInformation()
 	config.UnsetSpaceInformation()
 
-	accessToken, refreshToken, err := actor.UAAClient.Authenticate(username, password)
+	accessToken, refreshToken, err := actor.UAAClient.Authenticate(credentials, grantType)
 	if err != nil {
 		config.SetTokenInformation("", "", "")
+		config.SetUAAIssuer("")
 		return err
 	}
 
 	accessToken = fmt.Sprintf("bearer %s", accessToken)
 	config.SetTokenInformation(accessToken, refreshToken, "")
+
+	// The issuer identifies the foundation the tokens belong to; opaque
+	// tokens have none, which turns off the foundation check.
+	claims, _ := uaa.DecodeAccessToken(accessToken)
+	config.SetUAAIssuer(claims.Issuer)
+
+	if grantType == constant.GrantTypeClientCredentials {
+		config.SetUAAClientCredentials(credentials["client_id"], credentials["client_secret"])
+	}
+	config.SetUAAGrantType(string(grantType))
 	return nil
 }
@@ -1,10 +1,13 @@
 package v2action_test
 
 import (
+	"encoding/base64"
 	"errors"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -22,11 +25,57 @@ var _ = Describe("Auth Actions", func() {
 		fakeConfig = new(v2actionfakes.FakeConfig)
 	})
 
+	Describe("GetLoginPrompts", func() {
+		Context("when the UAA client returns prompts", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetLoginPromptsReturns(map[string]uaa.LoginPrompt{
+					"username": {Type: "text", DisplayName: "Email"},
+					"password": {Type: "password", DisplayName: "Password"},
+				}, nil)
+			})
+
+			It("returns the prompts", func() {
+				prompts, err := actor.GetLoginPrompts()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(prompts).To(Equal(map[string]LoginPrompt{
+					"username": {Type: "text", DisplayName: "Email"},
+					"password": {Type: "password", DisplayName: "Password"},
+				}))
+			})
+		})
+
+		Context("when the UAA client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some error")
+				fakeUAAClient.GetLoginPromptsReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				_, err := actor.GetLoginPrompts()
+				Expect(err).To(MatchError(expectedErr))
+			})
+		})
+	})
+
 	Describe("Authenticate", func() {
-		var actualErr error
+		var (
+			credentials map[string]string
+			grantType   constant.GrantType
+			actualErr   error
+		)
+
+		BeforeEach(func() {
+			credentials = map[string]string{
+				"username": "some-username",
+				"password": "some-password",
+			}
+			grantType = constant.GrantTypePassword
+		})
 
 		JustBeforeEach(func() {
-			actualErr = actor.Authenticate(fakeConfig, "some-username", "some-password")
+			actualErr = actor.Authenticate(fakeConfig, credentials, grantType)
 		})
 
 		Context("when no API errors occur", func() {
@@ -42,9 +91,9 @@ var _ = Describe("Auth Actions", func() {
 				Expect(actualErr).NotTo(HaveOccurred())
 
 				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(1))
-				username, password := fakeUAAClient.AuthenticateArgsForCall(0)
-				Expect(username).To(Equal("some-username"))
-				Expect(password).To(Equal("some-password"))
+				passedCredentials, passedGrantType := fakeUAAClient.AuthenticateArgsForCall(0)
+				Expect(passedCredentials).To(Equal(credentials))
+				Expect(passedGrantType).To(Equal(constant.GrantTypePassword))
 
 				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
 				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
@@ -54,6 +103,51 @@ var _ = Describe("Auth Actions", func() {
 
 				Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
 				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+
+				Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal("password"))
+
+				Expect(fakeConfig.SetUAAIssuerCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAIssuerArgsForCall(0)).To(BeEmpty())
+			})
+
+			Context("when the access token is a JWT", func() {
+				BeforeEach(func() {
+					claims := base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"https://uaa.example.com/oauth/token"}`))
+					fakeUAAClient.AuthenticateReturns("header."+claims+".signature", "some-refresh-token", nil)
+				})
+
+				It("stores the issuer of the token", func() {
+					Expect(actualErr).NotTo(HaveOccurred())
+					Expect(fakeConfig.SetUAAIssuerCallCount()).To(Equal(1))
+					Expect(fakeConfig.SetUAAIssuerArgsForCall(0)).To(Equal("https://uaa.example.com/oauth/token"))
+				})
+			})
+
+			Context("when using the client credentials grant", func() {
+				BeforeEach(func() {
+					credentials = map[string]string{
+						"client_id":     "some-client",
+						"client_secret": "some-secret",
+					}
+					grantType = constant.GrantTypeClientCredentials
+				})
+
+				It("stores the client credentials and grant type so the token can be renewed", func() {
+					Expect(actualErr).NotTo(HaveOccurred())
+
+					_, passedGrantType := fakeUAAClient.AuthenticateArgsForCall(0)
+					Expect(passedGrantType).To(Equal(constant.GrantTypeClientCredentials))
+
+					Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(1))
+					client, secret := fakeConfig.SetUAAClientCredentialsArgsForCall(0)
+					Expect(client).To(Equal("some-client"))
+					Expect(secret).To(Equal("some-secret"))
+
+					Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+					Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal("client_credentials"))
+				})
 			})
 		})
 
@@ -73,9 +167,9 @@ var _ = Describe("Auth Actions", func() {
 				Expect(actualErr).To(MatchError(expectedErr))
 
 				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(1))
-				username, password := fakeUAAClient.AuthenticateArgsForCall(0)
-				Expect(username).To(Equal("some-username"))
-				Expect(password).To(Equal("some-password"))
+				passedCredentials, passedGrantType := fakeUAAClient.AuthenticateArgsForCall(0)
+				Expect(passedCredentials).To(Equal(credentials))
+				Expect(passedGrantType).To(Equal(constant.GrantTypePassword))
 
 				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
 				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
@@ -85,6 +179,8 @@ var _ = Describe("Auth Actions", func() {
 
 				Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
 				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(0))
 			})
 		})
 	})
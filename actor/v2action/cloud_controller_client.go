@@ -23,6 +23,8 @@ type CloudControllerClient interface {
 	GetApplicationInstanceStatusesByApplication(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error)
 	GetApplicationRoutes(appGUID string, queries ...ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
 	GetApplications(queries ...ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error)
+	GetAppUsageEvents(afterGUID string, eventFunc func(ccv2.AppUsageEvent) error) (ccv2.Warnings, error)
+	GetLatestEvents(limit int, queries ...ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error)
 	GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error)
 	GetOrganizationPrivateDomains(orgGUID string, queries ...ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error)
@@ -36,6 +38,8 @@ type CloudControllerClient interface {
 	GetServiceBindings(queries ...ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
 	GetServiceInstance(serviceInstanceGUID string) (ccv2.ServiceInstance, ccv2.Warnings, error)
 	GetServiceInstances(queries ...ccv2.Query) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
+	GetServiceInstanceSharedTos(serviceInstanceGUID string) ([]ccv2.ServiceInstanceSharedTo, ccv2.Warnings, error)
+	GetServiceUsageEvents(afterGUID string, eventFunc func(ccv2.ServiceUsageEvent) error) (ccv2.Warnings, error)
 	GetSharedDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	GetSharedDomains(queries ...ccv2.Query) ([]ccv2.Domain, ccv2.Warnings, error)
 	GetSpaceQuota(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error)
@@ -53,6 +57,7 @@ type CloudControllerClient interface {
 	ResourceMatch(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error)
 	RestageApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	TargetCF(settings ccv2.TargetSettings) (ccv2.Warnings, error)
+	UnbindRouteFromApplication(routeGUID string, appGUID string) (ccv2.Warnings, error)
 	UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	UploadApplicationPackage(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
 
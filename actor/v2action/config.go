@@ -6,17 +6,23 @@ import "time"
 
 type Config interface {
 	AccessToken() string
+	CACert() []byte
 	PollingInterval() time.Duration
 	RefreshToken() string
 	SSHOAuthClient() string
 	SetAccessToken(accessToken string)
+	SetCACert(caCert []byte)
 	SetRefreshToken(refreshToken string)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
+	SetUAAClientCredentials(client string, secret string)
+	SetUAAGrantType(grantType string)
+	SetUAAIssuer(issuer string)
 	SkipSSLValidation() bool
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
 	Target() string
+	UAAGrantType() string
 	UnsetOrganizationInformation()
 	UnsetSpaceInformation()
 	Verbose() (bool, []string)
@@ -0,0 +1,117 @@
+package v2action
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+const (
+	// EventTypeAppCrash is the type of the event recorded when an instance of
+	// an application crashes.
+	EventTypeAppCrash = "app.crash"
+
+	// EventTypeAppDropletCreate is the type of the event recorded when a
+	// droplet is created for an application.
+	EventTypeAppDropletCreate = "audit.app.droplet.create"
+
+	// EventTypeAppUploadBits is the type of the event recorded when the
+	// package of an application is uploaded.
+	EventTypeAppUploadBits = "audit.app.upload-bits"
+)
+
+// Event represents a CLI Event.
+type Event ccv2.Event
+
+// ApplicationCrash describes a crash of an application instance.
+type ApplicationCrash struct {
+	Timestamp       time.Time
+	Index           int
+	ExitStatus      int
+	ExitDescription string
+	Reason          string
+}
+
+// ApplicationUploadEventNotFoundError is returned when no upload event is
+// recorded for an application, for instance because its events were pruned.
+type ApplicationUploadEventNotFoundError struct {
+	ApplicationGUID string
+}
+
+func (e ApplicationUploadEventNotFoundError) Error() string {
+	return fmt.Sprintf("No upload event found for application '%s'.", e.ApplicationGUID)
+}
+
+// ApplicationCrashNotFoundError is returned when no crash is recorded for an
+// application.
+type ApplicationCrashNotFoundError struct {
+	ApplicationGUID string
+}
+
+func (e ApplicationCrashNotFoundError) Error() string {
+	return fmt.Sprintf("No crash found for application '%s'.", e.ApplicationGUID)
+}
+
+// GetApplicationLastUploadEvent returns the most recent package upload or
+// droplet creation event of the application with the provided GUID.
+func (actor Actor) GetApplicationLastUploadEvent(appGUID string) (Event, Warnings, error) {
+	events, warnings, err := actor.CloudControllerClient.GetLatestEvents(1,
+		ccv2.Query{
+			Filter:   ccv2.ActeeFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{appGUID},
+		},
+		ccv2.Query{
+			Filter:   ccv2.TypeFilter,
+			Operator: ccv2.InOperator,
+			Values:   []string{EventTypeAppDropletCreate, EventTypeAppUploadBits},
+		},
+	)
+	if err != nil {
+		return Event{}, Warnings(warnings), err
+	}
+
+	if len(events) == 0 {
+		return Event{}, Warnings(warnings), ApplicationUploadEventNotFoundError{ApplicationGUID: appGUID}
+	}
+
+	return Event(events[0]), Warnings(warnings), nil
+}
+
+// GetApplicationLastCrash returns the most recent crash of an instance of the
+// application with the provided GUID.
+func (actor Actor) GetApplicationLastCrash(appGUID string) (ApplicationCrash, Warnings, error) {
+	events, warnings, err := actor.CloudControllerClient.GetLatestEvents(1,
+		ccv2.Query{
+			Filter:   ccv2.ActeeFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{appGUID},
+		},
+		ccv2.Query{
+			Filter:   ccv2.TypeFilter,
+			Operator: ccv2.EqualOperator,
+			Values:   []string{EventTypeAppCrash},
+		},
+	)
+	if err != nil {
+		return ApplicationCrash{}, Warnings(warnings), err
+	}
+
+	if len(events) == 0 {
+		return ApplicationCrash{}, Warnings(warnings), ApplicationCrashNotFoundError{ApplicationGUID: appGUID}
+	}
+
+	event := events[0]
+	crash := ApplicationCrash{Timestamp: event.Timestamp}
+	if index, ok := event.Metadata["index"].(float64); ok {
+		crash.Index = int(index)
+	}
+	if exitStatus, ok := event.Metadata["exit_status"].(float64); ok {
+		crash.ExitStatus = int(exitStatus)
+	}
+	crash.ExitDescription, _ = event.Metadata["exit_description"].(string)
+	crash.Reason, _ = event.Metadata["reason"].(string)
+
+	return crash, Warnings(warnings), nil
+}
@@ -0,0 +1,175 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Event Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetApplicationLastUploadEvent", func() {
+		var (
+			event      Event
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			event, warnings, executeErr = actor.GetApplicationLastUploadEvent("some-app-guid")
+		})
+
+		Context("when an upload event exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetLatestEventsReturns(
+					[]ccv2.Event{{GUID: "event-guid", ActorName: "some-user"}},
+					ccv2.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("returns the most recent upload event and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(event).To(Equal(Event{GUID: "event-guid", ActorName: "some-user"}))
+
+				Expect(fakeCloudControllerClient.GetLatestEventsCallCount()).To(Equal(1))
+				limit, queries := fakeCloudControllerClient.GetLatestEventsArgsForCall(0)
+				Expect(limit).To(Equal(1))
+				Expect(queries).To(ConsistOf(
+					ccv2.Query{
+						Filter:   ccv2.ActeeFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"some-app-guid"},
+					},
+					ccv2.Query{
+						Filter:   ccv2.TypeFilter,
+						Operator: ccv2.InOperator,
+						Values:   []string{"audit.app.droplet.create", "audit.app.upload-bits"},
+					},
+				))
+			})
+		})
+
+		Context("when no upload event exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetLatestEventsReturns(nil, ccv2.Warnings{"warning-1"}, nil)
+			})
+
+			It("returns an ApplicationUploadEventNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ApplicationUploadEventNotFoundError{ApplicationGUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when getting the events fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get events error")
+				fakeCloudControllerClient.GetLatestEventsReturns(nil, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetApplicationLastCrash", func() {
+		var (
+			crash      ApplicationCrash
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			crash, warnings, executeErr = actor.GetApplicationLastCrash("some-app-guid")
+		})
+
+		Context("when a crash event exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetLatestEventsReturns(
+					[]ccv2.Event{{
+						GUID:      "event-guid",
+						Timestamp: time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC),
+						Metadata: map[string]interface{}{
+							"index":            float64(2),
+							"exit_status":      float64(137),
+							"exit_description": "APP/PROC/WEB: Exited with status 137",
+							"reason":           "CRASHED",
+						},
+					}},
+					ccv2.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("returns the most recent crash and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(crash).To(Equal(ApplicationCrash{
+					Timestamp:       time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC),
+					Index:           2,
+					ExitStatus:      137,
+					ExitDescription: "APP/PROC/WEB: Exited with status 137",
+					Reason:          "CRASHED",
+				}))
+
+				Expect(fakeCloudControllerClient.GetLatestEventsCallCount()).To(Equal(1))
+				limit, queries := fakeCloudControllerClient.GetLatestEventsArgsForCall(0)
+				Expect(limit).To(Equal(1))
+				Expect(queries).To(ConsistOf(
+					ccv2.Query{
+						Filter:   ccv2.ActeeFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"some-app-guid"},
+					},
+					ccv2.Query{
+						Filter:   ccv2.TypeFilter,
+						Operator: ccv2.EqualOperator,
+						Values:   []string{"app.crash"},
+					},
+				))
+			})
+		})
+
+		Context("when no crash event exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetLatestEventsReturns(nil, ccv2.Warnings{"warning-1"}, nil)
+			})
+
+			It("returns an ApplicationCrashNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ApplicationCrashNotFoundError{ApplicationGUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when getting the events fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get events error")
+				fakeCloudControllerClient.GetLatestEventsReturns(nil, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package v2action
 import (
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"github.com/cloudfoundry/noaa"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
@@ -61,7 +62,14 @@ func NewLogMessage(message string, messageType int, timestamp time.Time, sourceT
 	}
 }
 
+// GetStreamingLogs tails the logs of the given application. When client is
+// nil, because the platform has no logging endpoint, both returned channels
+// are nil so callers selecting on them simply never receive anything.
 func (Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error) {
+	if client == nil {
+		return nil, nil
+	}
+
 	// Do not pass in token because client should have a TokenRefresher set
 	eventStream, errStream := client.TailingLogs(appGUID, "")
 
@@ -107,6 +115,10 @@ func (Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Config)
 }
 
 func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, config Config) ([]LogMessage, Warnings, error) {
+	if client == nil {
+		return nil, nil, actionerror.LoggingUnavailableError{}
+	}
+
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return nil, allWarnings, err
@@ -117,6 +129,55 @@ func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spa
 		return nil, allWarnings, err
 	}
 
+	return convertRecentLogs(noaaMessages), allWarnings, nil
+}
+
+// GetRecentLogsForApplication returns the recent logs of the application
+// with the provided GUID, oldest first. It gives up with a NOAATimeoutError
+// if the logs are not received within timeout.
+func (actor Actor) GetRecentLogsForApplication(appGUID string, client NOAAClient, timeout time.Duration) ([]LogMessage, error) {
+	if client == nil {
+		return nil, actionerror.LoggingUnavailableError{}
+	}
+
+	type recentLogs struct {
+		messages []*events.LogMessage
+		err      error
+	}
+
+	results := make(chan recentLogs, 1)
+	go func() {
+		messages, err := client.RecentLogs(appGUID, "")
+		results <- recentLogs{messages: messages, err: err}
+	}()
+
+	select {
+	case result := <-results:
+		if result.err != nil {
+			return nil, result.err
+		}
+		return convertRecentLogs(result.messages), nil
+	case <-time.After(timeout):
+		return nil, NOAATimeoutError{}
+	}
+}
+
+func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, Warnings, error) {
+	if client == nil {
+		return nil, nil, nil, actionerror.LoggingUnavailableError{}
+	}
+
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	messages, logErrs := actor.GetStreamingLogs(app.GUID, client, config)
+
+	return messages, logErrs, allWarnings, err
+}
+
+func convertRecentLogs(noaaMessages []*events.LogMessage) []LogMessage {
 	noaaMessages = noaa.SortRecent(noaaMessages)
 
 	var logMessages []LogMessage
@@ -131,16 +192,12 @@ func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spa
 		})
 	}
 
-	return logMessages, allWarnings, nil
+	return logMessages
 }
 
-func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, Warnings, error) {
-	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
-	if err != nil {
-		return nil, nil, allWarnings, err
+// closeLogs closes the given log client, if there is one.
+func closeLogs(client NOAAClient) {
+	if client != nil {
+		client.Close()
 	}
-
-	messages, logErrs := actor.GetStreamingLogs(app.GUID, client, config)
-
-	return messages, logErrs, allWarnings, err
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -311,6 +312,75 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("GetRecentLogsForApplication", func() {
+		var (
+			messages   []LogMessage
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			messages, executeErr = actor.GetRecentLogsForApplication("some-app-guid", fakeNOAAClient, 50*time.Millisecond)
+		})
+
+		Context("when NOAA returns logs", func() {
+			BeforeEach(func() {
+				outMessage := events.LogMessage_OUT
+				ts1 := int64(10)
+				ts2 := int64(20)
+				sourceType := "APP/PROC/WEB"
+
+				fakeNOAAClient.RecentLogsReturns([]*events.LogMessage{
+					{Message: []byte("message-2"), MessageType: &outMessage, Timestamp: &ts2, SourceType: &sourceType},
+					{Message: []byte("message-1"), MessageType: &outMessage, Timestamp: &ts1, SourceType: &sourceType},
+				}, nil)
+			})
+
+			It("returns the logs of the application, oldest first", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("message-1"))
+				Expect(messages[1].Message()).To(Equal("message-2"))
+
+				Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(1))
+				appGUID, _ := fakeNOAAClient.RecentLogsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when NOAA errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("ZOMG")
+				fakeNOAAClient.RecentLogsReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+
+		Context("when NOAA does not respond within the timeout", func() {
+			var unblock chan bool
+
+			BeforeEach(func() {
+				unblock = make(chan bool)
+				fakeNOAAClient.RecentLogsStub = func(string, string) ([]*events.LogMessage, error) {
+					<-unblock
+					return nil, nil
+				}
+			})
+
+			AfterEach(func() {
+				close(unblock)
+			})
+
+			It("returns a NOAATimeoutError", func() {
+				Expect(executeErr).To(MatchError(NOAATimeoutError{}))
+			})
+		})
+	})
+
 	Describe("GetStreamingLogsForApplicationByNameAndSpace", func() {
 		Context("when the application can be found", func() {
 			var (
@@ -429,4 +499,25 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+	Context("when there is no logging client", func() {
+		It("GetStreamingLogs returns nil channels", func() {
+			messages, errs := actor.GetStreamingLogs("some-app-guid", nil, fakeConfig)
+			Expect(messages).To(BeNil())
+			Expect(errs).To(BeNil())
+		})
+
+		It("GetRecentLogsForApplicationByNameAndSpace returns a LoggingUnavailableError", func() {
+			_, _, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", nil, fakeConfig)
+			Expect(err).To(MatchError(actionerror.LoggingUnavailableError{}))
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+		})
+
+		It("GetStreamingLogsForApplicationByNameAndSpace returns a LoggingUnavailableError", func() {
+			messages, logErrs, _, err := actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", nil, fakeConfig)
+			Expect(err).To(MatchError(actionerror.LoggingUnavailableError{}))
+			Expect(messages).To(BeNil())
+			Expect(logErrs).To(BeNil())
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+		})
+	})
 })
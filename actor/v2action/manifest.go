@@ -5,19 +5,40 @@ import (
 	"code.cloudfoundry.org/cli/util/manifest"
 )
 
-func (actor Actor) CreateApplicationManifestByNameAndSpace(appName string, spaceGUID string, pathToFile string) (Warnings, error) {
+// CreateApplicationManifestByNameAndSpace writes the current settings of the
+// app to pathToFile. Any provided processes are written to the manifest's
+// processes block.
+func (actor Actor) CreateApplicationManifestByNameAndSpace(appName string, spaceGUID string, pathToFile string, processes []manifest.Process) (Warnings, error) {
+	manifestApp, warnings, err := actor.GetApplicationManifestByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+	manifestApp.Processes = processes
+
+	err = manifest.WriteApplicationManifest(manifestApp, pathToFile)
+	return warnings, err
+}
+
+// WriteApplicationManifests writes the provided apps, in the given order, to
+// pathToFile as a single multi-application manifest.
+func (Actor) WriteApplicationManifests(manifestApps []manifest.Application, pathToFile string) error {
+	return manifest.WriteApplicationManifests(manifestApps, pathToFile)
+}
 
+// GetApplicationManifestByNameAndSpace returns the current settings of the
+// app in the form a manifest would describe them.
+func (actor Actor) GetApplicationManifestByNameAndSpace(appName string, spaceGUID string) (manifest.Application, Warnings, error) {
 	var allWarnings Warnings
 	applicationSummary, appSummaryWarnings, err := actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID)
 	allWarnings = append(allWarnings, appSummaryWarnings...)
 	if err != nil {
-		return allWarnings, err
+		return manifest.Application{}, allWarnings, err
 	}
 
 	serviceInstances, serviceWarnings, err := actor.GetServiceInstancesByApplication(applicationSummary.GUID)
 	allWarnings = append(allWarnings, serviceWarnings...)
 	if err != nil {
-		return allWarnings, err
+		return manifest.Application{}, allWarnings, err
 	}
 
 	var routes []string
@@ -55,6 +76,5 @@ func (actor Actor) CreateApplicationManifestByNameAndSpace(appName string, space
 		}
 	}
 
-	err = manifest.WriteApplicationManifest(manifestApp, pathToFile)
-	return allWarnings, err
+	return manifestApp, allWarnings, nil
 }
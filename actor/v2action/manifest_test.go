@@ -5,10 +5,12 @@ import (
 	"io/ioutil"
 	"os"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifest"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -27,12 +29,17 @@ var _ = Describe("Manifest Actions", func() {
 
 	Describe("CreateApplicationManifestByNameAndSpace", func() {
 		var (
+			processes      []manifest.Process
 			createWarnings Warnings
 			createErr      error
 		)
 
+		BeforeEach(func() {
+			processes = nil
+		})
+
 		JustBeforeEach(func() {
-			createWarnings, createErr = actor.CreateApplicationManifestByNameAndSpace("some-app", "some-space-guid", manifestFilePath)
+			createWarnings, createErr = actor.CreateApplicationManifestByNameAndSpace("some-app", "some-space-guid", manifestFilePath, processes)
 		})
 
 		Context("when getting the application summary errors", func() {
@@ -369,6 +376,32 @@ var _ = Describe("Manifest Actions", func() {
 								})
 							})
 						})
+
+						Context("when processes are provided", func() {
+							BeforeEach(func() {
+								processes = []manifest.Process{
+									{
+										Type:                         "worker",
+										HealthCheckType:              "http",
+										HealthCheckHTTPEndpoint:      "/healthy",
+										HealthCheckInvocationTimeout: 5,
+										HealthCheckTimeout:           90,
+									},
+								}
+							})
+
+							It("includes the processes block in the manifest", func() {
+								manifestBytes, err := ioutil.ReadFile(manifestFilePath)
+								Expect(err).NotTo(HaveOccurred())
+								Expect(string(manifestBytes)).To(ContainSubstring(`  processes:
+  - type: worker
+    health-check-http-endpoint: /healthy
+    health-check-invocation-timeout: 5
+    health-check-type: http
+    timeout: 90
+`))
+							})
+						})
 					})
 				})
 
@@ -392,4 +425,93 @@ var _ = Describe("Manifest Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationManifestByNameAndSpace", func() {
+		var (
+			manifestApp manifest.Application
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			manifestApp, warnings, executeErr = actor.GetApplicationManifestByNameAndSpace("some-app", "some-space-guid")
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"some-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+			})
+		})
+
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{{
+						GUID:            "some-app-guid",
+						Name:            "some-app",
+						Instances:       types.NullInt{Value: 2, IsSet: true},
+						Memory:          1024,
+						DiskQuota:       512,
+						HealthCheckType: ccv2.ApplicationHealthCheckPort,
+					}},
+					ccv2.Warnings{"some-app-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceBindingsReturns(
+					[]ccv2.ServiceBinding{{ServiceInstanceGUID: "service-1-guid"}},
+					ccv2.Warnings{"some-service-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceInstanceReturns(ccv2.ServiceInstance{Name: "service-1"}, nil, nil)
+			})
+
+			It("returns the app's settings as a manifest application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warning", "some-service-warning"))
+
+				Expect(manifestApp.Name).To(Equal("some-app"))
+				Expect(manifestApp.Instances).To(Equal(types.NullInt{Value: 2, IsSet: true}))
+				Expect(manifestApp.Memory).To(Equal(types.NullByteSizeInMb{Value: 1024, IsSet: true}))
+				Expect(manifestApp.DiskQuota).To(Equal(types.NullByteSizeInMb{Value: 512, IsSet: true}))
+				Expect(manifestApp.HealthCheckType).To(BeEmpty())
+				Expect(manifestApp.Services).To(ConsistOf("service-1"))
+			})
+		})
+	})
+
+	Describe("WriteApplicationManifests", func() {
+		var executeErr error
+
+		BeforeEach(func() {
+			manifestFile, err := ioutil.TempFile("", "manifest-test-")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifestFile.Close()).To(Succeed())
+			manifestFilePath = manifestFile.Name()
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(manifestFilePath)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.WriteApplicationManifests([]manifest.Application{
+				{Name: "app-1"},
+				{Name: "app-2"},
+			}, manifestFilePath)
+		})
+
+		It("writes every app to a single manifest", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			manifestBytes, err := ioutil.ReadFile(manifestFilePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(manifestBytes)).To(Equal(`applications:
+- name: app-1
+- name: app-2
+`))
+		})
+	})
 })
@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -46,6 +47,23 @@ func (actor Actor) GetOrganization(guid string) (Organization, Warnings, error)
 	return Organization(org), Warnings(warnings), err
 }
 
+// GetOrganizations returns all the organizations visible to the current user,
+// sorted by name.
+func (actor Actor) GetOrganizations() ([]Organization, Warnings, error) {
+	ccv2Orgs, warnings, err := actor.CloudControllerClient.GetOrganizations()
+	if err != nil {
+		return []Organization{}, Warnings(warnings), err
+	}
+
+	orgs := make([]Organization, len(ccv2Orgs))
+	for i, ccv2Org := range ccv2Orgs {
+		orgs[i] = Organization(ccv2Org)
+	}
+	sort.Slice(orgs, func(i int, j int) bool { return orgs[i].Name < orgs[j].Name })
+
+	return orgs, Warnings(warnings), nil
+}
+
 // GetOrganizationByName returns an Organization based off of the name given.
 func (actor Actor) GetOrganizationByName(orgName string) (Organization, Warnings, error) {
 	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(ccv2.Query{
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -67,6 +68,17 @@ type Route struct {
 	Path      string
 	Port      types.NullInt
 	SpaceGUID string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// LastUpdated returns the time the route was last updated, or the time it was
+// created if it has never been updated.
+func (r Route) LastUpdated() time.Time {
+	if r.UpdatedAt.After(r.CreatedAt) {
+		return r.UpdatedAt
+	}
+	return r.CreatedAt
 }
 
 // String formats the route in a human readable format.
@@ -96,6 +108,13 @@ func (actor Actor) BindRouteToApplication(routeGUID string, appGUID string) (War
 	return Warnings(warnings), err
 }
 
+// UnbindRouteFromApplication unbinds the route from the application, leaving
+// the route in the space.
+func (actor Actor) UnbindRouteFromApplication(routeGUID string, appGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UnbindRouteFromApplication(routeGUID, appGUID)
+	return Warnings(warnings), err
+}
+
 func (actor Actor) CreateRoute(route Route, generatePort bool) (Route, Warnings, error) {
 	if route.Path != "" && !strings.HasPrefix(route.Path, "/") {
 		route.Path = fmt.Sprintf("/%s", route.Path)
@@ -312,6 +331,8 @@ func CCToActorRoute(ccv2Route ccv2.Route, domain Domain) Route {
 		Path:      ccv2Route.Path,
 		Port:      ccv2Route.Port,
 		SpaceGUID: ccv2Route.SpaceGUID,
+		CreatedAt: ccv2Route.CreatedAt,
+		UpdatedAt: ccv2Route.UpdatedAt,
 	}
 }
 
@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
@@ -45,6 +46,27 @@ var _ = Describe("Route Actions", func() {
 			Entry("has domain, port", "", "domain.com", "", types.NullInt{IsSet: true, Value: 3333}, "domain.com:3333"),
 			Entry("has host, domain, path, port", "host", "domain.com", "/path", types.NullInt{IsSet: true, Value: 3333}, "host.domain.com:3333/path"),
 		)
+
+		Describe("LastUpdated", func() {
+			var createdAt time.Time
+
+			BeforeEach(func() {
+				createdAt = time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC)
+			})
+
+			Context("when the route has been updated", func() {
+				It("returns the update time", func() {
+					updatedAt := createdAt.Add(time.Hour)
+					Expect(Route{CreatedAt: createdAt, UpdatedAt: updatedAt}.LastUpdated()).To(Equal(updatedAt))
+				})
+			})
+
+			Context("when the route has never been updated", func() {
+				It("returns the creation time", func() {
+					Expect(Route{CreatedAt: createdAt}.LastUpdated()).To(Equal(createdAt))
+				})
+			})
+		})
 	})
 
 	Describe("BindRouteToApplication", func() {
@@ -104,6 +126,44 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("UnbindRouteFromApplication", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UnbindRouteFromApplicationReturns(
+					ccv2.Warnings{"unbind warning"},
+					nil)
+			})
+
+			It("unbinds the route from the application and returns all warnings", func() {
+				warnings, err := actor.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("unbind warning"))
+
+				Expect(fakeCloudControllerClient.UnbindRouteFromApplicationCallCount()).To(Equal(1))
+				routeGUID, appGUID := fakeCloudControllerClient.UnbindRouteFromApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("unbind route failed")
+				fakeCloudControllerClient.UnbindRouteFromApplicationReturns(
+					ccv2.Warnings{"unbind warning"},
+					expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("unbind warning"))
+			})
+		})
+	})
+
 	Describe("CreateRoute", func() {
 		Context("when no errors are encountered", func() {
 			BeforeEach(func() {
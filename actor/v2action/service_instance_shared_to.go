@@ -0,0 +1,22 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// ServiceInstanceSharedTo represents a space a service instance has been
+// shared into.
+type ServiceInstanceSharedTo ccv2.ServiceInstanceSharedTo
+
+// GetServiceInstanceSharedTos returns the spaces the service instance has
+// been shared into.
+func (actor Actor) GetServiceInstanceSharedTos(serviceInstanceGUID string) ([]ServiceInstanceSharedTo, Warnings, error) {
+	sharedTos, warnings, err := actor.CloudControllerClient.GetServiceInstanceSharedTos(serviceInstanceGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var serviceInstanceSharedTos []ServiceInstanceSharedTo
+	for _, sharedTo := range sharedTos {
+		serviceInstanceSharedTos = append(serviceInstanceSharedTos, ServiceInstanceSharedTo(sharedTo))
+	}
+	return serviceInstanceSharedTos, Warnings(warnings), nil
+}
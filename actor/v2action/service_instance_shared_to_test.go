@@ -0,0 +1,72 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Instance Shared To Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetServiceInstanceSharedTos", func() {
+		var (
+			sharedTos  []ServiceInstanceSharedTo
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			sharedTos, warnings, executeErr = actor.GetServiceInstanceSharedTos("some-service-instance-guid")
+		})
+
+		Context("when the service instance is shared", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstanceSharedTosReturns(
+					[]ccv2.ServiceInstanceSharedTo{
+						{SpaceGUID: "space-guid", SpaceName: "some-space", OrganizationName: "some-org", BoundAppCount: 3},
+					},
+					ccv2.Warnings{"shared-to-warning"},
+					nil)
+			})
+
+			It("returns the spaces it is shared into and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("shared-to-warning"))
+				Expect(sharedTos).To(Equal([]ServiceInstanceSharedTo{
+					{SpaceGUID: "space-guid", SpaceName: "some-space", OrganizationName: "some-org", BoundAppCount: 3},
+				}))
+
+				Expect(fakeCloudControllerClient.GetServiceInstanceSharedTosCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceInstanceSharedTosArgsForCall(0)).To(Equal("some-service-instance-guid"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("shared-to-error")
+				fakeCloudControllerClient.GetServiceInstanceSharedTosReturns(nil, ccv2.Warnings{"shared-to-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("shared-to-warning"))
+			})
+		})
+	})
+})
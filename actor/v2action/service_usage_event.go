@@ -0,0 +1,22 @@
+package v2action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+type ServiceUsageEvent ccv2.ServiceUsageEvent
+
+// GetServiceUsageEvents calls eventFunc, oldest first, for each service usage
+// event created after the event with the given GUID. When since is not zero,
+// events created before since are skipped.
+func (actor Actor) GetServiceUsageEvents(afterGUID string, since time.Time, eventFunc func(ServiceUsageEvent) error) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.GetServiceUsageEvents(afterGUID, func(event ccv2.ServiceUsageEvent) error {
+		if event.CreatedAt.Before(since) {
+			return nil
+		}
+		return eventFunc(ServiceUsageEvent(event))
+	})
+	return Warnings(warnings), err
+}
@@ -0,0 +1,56 @@
+package v2action_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Usage Event Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetServiceUsageEvents", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceUsageEventsStub = func(_ string, eventFunc func(ccv2.ServiceUsageEvent) error) (ccv2.Warnings, error) {
+				for _, event := range []ccv2.ServiceUsageEvent{
+					{GUID: "event-guid-1", CreatedAt: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+					{GUID: "event-guid-2", CreatedAt: time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC)},
+				} {
+					if err := eventFunc(event); err != nil {
+						return nil, err
+					}
+				}
+				return ccv2.Warnings{"event-warning"}, nil
+			}
+		})
+
+		It("passes events created at or after since to eventFunc", func() {
+			var events []ServiceUsageEvent
+			warnings, err := actor.GetServiceUsageEvents("some-guid", time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC), func(event ServiceUsageEvent) error {
+				events = append(events, event)
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("event-warning"))
+			Expect(events).To(Equal([]ServiceUsageEvent{
+				{GUID: "event-guid-2", CreatedAt: time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC)},
+			}))
+
+			afterGUID, _ := fakeCloudControllerClient.GetServiceUsageEventsArgsForCall(0)
+			Expect(afterGUID).To(Equal("some-guid"))
+		})
+	})
+})
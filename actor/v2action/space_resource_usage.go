@@ -0,0 +1,134 @@
+package v2action
+
+import (
+	"sort"
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// ResourceUsageWorkerPoolSize is the number of applications whose instance
+// stats are fetched concurrently. It is kept small so that reporting on a
+// large space does not flood the Cloud Controller with requests.
+const ResourceUsageWorkerPoolSize = 4
+
+// ApplicationResourceUsage is the combined resource usage of the instances of
+// an application.
+type ApplicationResourceUsage struct {
+	// Name is the name of the application.
+	Name string
+
+	// GUID is the unique application identifier.
+	GUID string
+
+	// State is the desired state of the application.
+	State ccv2.ApplicationState
+
+	// Instances is the requested number of instances.
+	Instances int
+
+	// RunningInstances is the number of instances that are running.
+	RunningInstances int
+
+	// MemoryUsed and MemoryAllocated are the total memory used and allowed by
+	// the instances, in bytes.
+	MemoryUsed      int
+	MemoryAllocated int
+
+	// DiskUsed and DiskAllocated are the total disk used and allowed by the
+	// instances, in bytes.
+	DiskUsed      int
+	DiskAllocated int
+
+	// AverageCPU is the mean CPU utilization of the running instances.
+	AverageCPU float64
+
+	// StatsAvailable is false when the application is stopped or its stats
+	// could not be retrieved, in which case the usage fields are zero.
+	StatsAvailable bool
+
+	// StatsError is the error returned when retrieving the stats of a
+	// started application, if any.
+	StatsError error
+}
+
+// GetSpaceResourceUsage returns the resource usage of every application in
+// the space, sorted by name. Stopped applications, and applications whose
+// stats cannot be retrieved, are returned without stats rather than failing
+// the whole report.
+func (actor Actor) GetSpaceResourceUsage(spaceGUID string) ([]ApplicationResourceUsage, Warnings, error) {
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+	allWarnings := warnings
+
+	usages := make([]ApplicationResourceUsage, len(apps))
+	usageWarnings := make([]Warnings, len(apps))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < ResourceUsageWorkerPoolSize; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				usages[index], usageWarnings[index] = actor.getApplicationResourceUsage(apps[index])
+			}
+		}()
+	}
+
+	for i := range apps {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, warnings := range usageWarnings {
+		allWarnings = append(allWarnings, warnings...)
+	}
+
+	sort.Slice(usages, func(i int, j int) bool { return usages[i].Name < usages[j].Name })
+
+	return usages, allWarnings, nil
+}
+
+func (actor Actor) getApplicationResourceUsage(app Application) (ApplicationResourceUsage, Warnings) {
+	usage := ApplicationResourceUsage{
+		Name:      app.Name,
+		GUID:      app.GUID,
+		State:     app.State,
+		Instances: app.Instances.Value,
+	}
+
+	if !app.Started() {
+		return usage, nil
+	}
+
+	instances, warnings, err := actor.GetApplicationInstanceStatsByApplication(app.GUID)
+	if err != nil {
+		if _, ok := err.(ApplicationInstancesNotFoundError); !ok {
+			usage.StatsError = err
+		}
+		return usage, warnings
+	}
+
+	var totalCPU float64
+	for _, instance := range instances {
+		usage.MemoryUsed += instance.Memory
+		usage.MemoryAllocated += instance.MemoryQuota
+		usage.DiskUsed += instance.Disk
+		usage.DiskAllocated += instance.DiskQuota
+
+		if instance.State == ApplicationInstanceState(ccv2.ApplicationInstanceRunning) {
+			usage.RunningInstances++
+			totalCPU += instance.CPU
+		}
+	}
+	if usage.RunningInstances > 0 {
+		usage.AverageCPU = totalCPU / float64(usage.RunningInstances)
+	}
+	usage.StatsAvailable = true
+
+	return usage, warnings
+}
@@ -0,0 +1,135 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Resource Usage Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetSpaceResourceUsage", func() {
+		var (
+			usages   []ApplicationResourceUsage
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			usages, warnings, err = actor.GetSpaceResourceUsage("some-space-guid")
+		})
+
+		Context("when the space has apps", func() {
+			var statsErr error
+
+			BeforeEach(func() {
+				statsErr = errors.New("stats are broken")
+
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{
+						{Name: "web", GUID: "web-guid", State: ccv2.ApplicationStarted, Instances: types.NullInt{Value: 3, IsSet: true}},
+						{Name: "broken", GUID: "broken-guid", State: ccv2.ApplicationStarted, Instances: types.NullInt{Value: 1, IsSet: true}},
+						{Name: "idle", GUID: "idle-guid", State: ccv2.ApplicationStopped, Instances: types.NullInt{Value: 2, IsSet: true}},
+						{Name: "crashed", GUID: "crashed-guid", State: ccv2.ApplicationStarted, Instances: types.NullInt{Value: 1, IsSet: true}},
+					},
+					ccv2.Warnings{"apps-warning"},
+					nil)
+
+				fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationStub = func(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error) {
+					switch guid {
+					case "web-guid":
+						return map[int]ccv2.ApplicationInstanceStatus{
+							0: {ID: 0, State: ccv2.ApplicationInstanceRunning, CPU: 0.2, Memory: 100, MemoryQuota: 400, Disk: 10, DiskQuota: 40},
+							1: {ID: 1, State: ccv2.ApplicationInstanceRunning, CPU: 0.4, Memory: 200, MemoryQuota: 400, Disk: 20, DiskQuota: 40},
+							2: {ID: 2, State: ccv2.ApplicationInstanceCrashed, MemoryQuota: 400, DiskQuota: 40},
+						}, ccv2.Warnings{"web-warning"}, nil
+					case "broken-guid":
+						return nil, ccv2.Warnings{"broken-warning"}, statsErr
+					case "crashed-guid":
+						return nil, nil, ccerror.ApplicationStoppedStatsError{}
+					}
+					Fail("unexpected stats request for " + guid)
+					return nil, nil, nil
+				}
+			})
+
+			It("returns the usage of every app sorted by name and all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("apps-warning", "web-warning", "broken-warning"))
+
+				Expect(usages).To(HaveLen(4))
+				Expect(usages[0]).To(Equal(ApplicationResourceUsage{
+					Name:       "broken",
+					GUID:       "broken-guid",
+					State:      ccv2.ApplicationStarted,
+					Instances:  1,
+					StatsError: statsErr,
+				}))
+				Expect(usages[1]).To(Equal(ApplicationResourceUsage{
+					Name:      "crashed",
+					GUID:      "crashed-guid",
+					State:     ccv2.ApplicationStarted,
+					Instances: 1,
+				}))
+				Expect(usages[2]).To(Equal(ApplicationResourceUsage{
+					Name:      "idle",
+					GUID:      "idle-guid",
+					State:     ccv2.ApplicationStopped,
+					Instances: 2,
+				}))
+
+				Expect(usages[3].Name).To(Equal("web"))
+				Expect(usages[3].StatsAvailable).To(BeTrue())
+				Expect(usages[3].Instances).To(Equal(3))
+				Expect(usages[3].RunningInstances).To(Equal(2))
+				Expect(usages[3].MemoryUsed).To(Equal(300))
+				Expect(usages[3].MemoryAllocated).To(Equal(1200))
+				Expect(usages[3].DiskUsed).To(Equal(30))
+				Expect(usages[3].DiskAllocated).To(Equal(120))
+				Expect(usages[3].AverageCPU).To(BeNumerically("~", 0.3, 0.0001))
+			})
+
+			It("does not request stats for stopped apps", func() {
+				Expect(fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationCallCount()).To(Equal(3))
+			})
+
+			It("filters the apps by space", func() {
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.SpaceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Values:   []string{"some-space-guid"},
+				}))
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("apps are broken")
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("apps-warning"))
+			})
+		})
+	})
+})
@@ -1,13 +1,17 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"bytes"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
 
 type TargetSettings ccv2.TargetSettings
 
 // SetTarget targets the Cloud Controller using the client and sets target
 // information in the actor based on the response.
 func (actor Actor) SetTarget(config Config, settings TargetSettings) (Warnings, error) {
-	if config.Target() == settings.URL && config.SkipSSLValidation() == settings.SkipSSLValidation {
+	if config.Target() == settings.URL && config.SkipSSLValidation() == settings.SkipSSLValidation && bytes.Equal(config.CACert(), settings.CACert) {
 		return nil, nil
 	}
 
@@ -25,7 +29,9 @@ func (actor Actor) SetTarget(config Config, settings TargetSettings) (Warnings,
 		actor.CloudControllerClient.RoutingEndpoint(),
 		settings.SkipSSLValidation,
 	)
+	config.SetCACert(settings.CACert)
 	config.SetTokenInformation("", "", "")
+	config.SetUAAIssuer("")
 
 	return Warnings(warnings), nil
 }
@@ -33,7 +39,9 @@ func (actor Actor) SetTarget(config Config, settings TargetSettings) (Warnings,
 // ClearTarget clears target information from the actor.
 func (Actor) ClearTarget(config Config) {
 	config.SetTargetInformation("", "", "", "", "", "", false)
+	config.SetCACert(nil)
 	config.SetTokenInformation("", "", "")
+	config.SetUAAIssuer("")
 }
 
 // ClearTarget clears the targeted org and space in the config.
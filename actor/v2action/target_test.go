@@ -75,6 +75,37 @@ var _ = Describe("Targeting", func() {
 			Expect(sslDisabled).To(Equal(skipSSLValidation))
 		})
 
+		Context("when a CA cert is provided", func() {
+			BeforeEach(func() {
+				settings.CACert = []byte("some-ca-cert")
+			})
+
+			It("connects and stores the CA cert for the new target", func() {
+				_, err := actor.SetTarget(fakeConfig, settings)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.TargetCFArgsForCall(0).CACert).To(Equal([]byte("some-ca-cert")))
+
+				Expect(fakeConfig.SetCACertCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetCACertArgsForCall(0)).To(Equal([]byte("some-ca-cert")))
+			})
+		})
+
+		Context("when no CA cert is provided", func() {
+			BeforeEach(func() {
+				fakeConfig.CACertReturns([]byte("previous-target-ca-cert"))
+			})
+
+			It("removes the CA cert of the previous target", func() {
+				_, err := actor.SetTarget(fakeConfig, settings)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeConfig.SetCACertCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetCACertArgsForCall(0)).To(BeEmpty())
+			})
+		})
+
 		It("clears all the token information", func() {
 			_, err := actor.SetTarget(fakeConfig, settings)
 			Expect(err).ToNot(HaveOccurred())
@@ -85,6 +116,9 @@ var _ = Describe("Targeting", func() {
 			Expect(accessToken).To(BeEmpty())
 			Expect(refreshToken).To(BeEmpty())
 			Expect(sshOAuthClient).To(BeEmpty())
+
+			Expect(fakeConfig.SetUAAIssuerCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetUAAIssuerArgsForCall(0)).To(BeEmpty())
 		})
 
 		Context("when setting the same API and skip SSL configuration", func() {
@@ -104,6 +138,19 @@ var _ = Describe("Targeting", func() {
 
 				Expect(fakeCloudControllerClient.TargetCFCallCount()).To(BeZero())
 			})
+
+			Context("when the CA cert changes", func() {
+				BeforeEach(func() {
+					settings.CACert = []byte("some-ca-cert")
+				})
+
+				It("targets the API again", func() {
+					_, err := actor.SetTarget(fakeConfig, settings)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(1))
+				})
+			})
 		})
 	})
 
@@ -121,6 +168,9 @@ var _ = Describe("Targeting", func() {
 			Expect(doppler).To(BeEmpty())
 			Expect(routing).To(BeEmpty())
 			Expect(sslDisabled).To(BeFalse())
+
+			Expect(fakeConfig.SetCACertCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetCACertArgsForCall(0)).To(BeNil())
 		})
 
 		It("clears all the token information", func() {
@@ -132,6 +182,9 @@ var _ = Describe("Targeting", func() {
 			Expect(accessToken).To(BeEmpty())
 			Expect(refreshToken).To(BeEmpty())
 			Expect(sshOAuthClient).To(BeEmpty())
+
+			Expect(fakeConfig.SetUAAIssuerCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetUAAIssuerArgsForCall(0)).To(BeEmpty())
 		})
 	})
 
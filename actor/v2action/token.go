@@ -1,5 +1,20 @@
 package v2action
 
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+// TokenClaims are the decoded claims of an access token.
+type TokenClaims uaa.TokenClaims
+
+// Expiry returns when the token expires, or the zero time when the token
+// does not say.
+func (claims TokenClaims) Expiry() time.Time {
+	return uaa.TokenClaims(claims).Expiry()
+}
+
 func (actor Actor) RefreshAccessToken(refreshToken string) (string, error) {
 	tokens, err := actor.UAAClient.RefreshAccessToken(refreshToken)
 	if err != nil {
@@ -11,3 +26,10 @@ func (actor Actor) RefreshAccessToken(refreshToken string) (string, error) {
 
 	return tokens.AuthorizationToken(), nil
 }
+
+// DecodeAccessToken decodes the claims of the access token locally, without
+// verifying its signature.
+func (Actor) DecodeAccessToken(accessToken string) (TokenClaims, error) {
+	claims, err := uaa.DecodeAccessToken(accessToken)
+	return TokenClaims(claims), err
+}
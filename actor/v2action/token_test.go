@@ -1,7 +1,9 @@
 package v2action_test
 
 import (
+	"encoding/base64"
 	"errors"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
@@ -70,4 +72,18 @@ var _ = Describe("Token Actions", func() {
 			})
 		})
 	})
+
+	Describe("DecodeAccessToken", func() {
+		It("decodes the claims of the token", func() {
+			claims, err := actor.DecodeAccessToken("bearer header." + base64.RawURLEncoding.EncodeToString([]byte(`{"user_name":"some-user","exp":1500000000}`)) + ".signature")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(claims.UserName).To(Equal("some-user"))
+			Expect(claims.Expiry()).To(Equal(time.Unix(1500000000, 0)))
+		})
+
+		It("returns a TokenNotJWTError for opaque tokens", func() {
+			_, err := actor.DecodeAccessToken("bearer some-opaque-token")
+			Expect(err).To(MatchError(uaa.TokenNotJWTError{}))
+		})
+	})
 })
@@ -1,12 +1,17 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/uaa"
+import (
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
 
 //go:generate counterfeiter . UAAClient
 
 type UAAClient interface {
-	Authenticate(username string, password string) (string, string, error)
+	Authenticate(credentials map[string]string, grantType constant.GrantType) (string, string, error)
 	CreateUser(username string, password string, origin string) (uaa.User, error)
+	GetLoginPrompts() (map[string]uaa.LoginPrompt, error)
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
+	ListUsers(username string) ([]uaa.User, error)
 	RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error)
 }
@@ -1,6 +1,10 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
 
 // User represents a CLI user.
 type User ccv2.User
@@ -16,3 +20,20 @@ func (actor Actor) CreateUser(username string, password string, origin string) (
 
 	return User(ccUser), Warnings(ccWarnings), err
 }
+
+// GetUserOrigins returns the sorted origins, such as uaa or ldap, in which a
+// UAA user with the given username exists.
+func (actor Actor) GetUserOrigins(username string) ([]string, error) {
+	uaaUsers, err := actor.UAAClient.ListUsers(username)
+	if err != nil {
+		return nil, err
+	}
+
+	origins := make([]string, 0, len(uaaUsers))
+	for _, uaaUser := range uaaUsers {
+		origins = append(origins, uaaUser.Origin)
+	}
+	sort.Strings(origins)
+
+	return origins, nil
+}
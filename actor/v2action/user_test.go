@@ -121,4 +121,28 @@ var _ = Describe("User Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetUserOrigins", func() {
+		It("returns the sorted origins of the users with the username", func() {
+			fakeUAAClient.ListUsersReturns([]uaa.User{
+				{ID: "ldap-id", Origin: "ldap"},
+				{ID: "uaa-id", Origin: "uaa"},
+				{ID: "saml-id", Origin: "saml"},
+			}, nil)
+
+			origins, err := actor.GetUserOrigins("some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(origins).To(Equal([]string{"ldap", "saml", "uaa"}))
+
+			Expect(fakeUAAClient.ListUsersCallCount()).To(Equal(1))
+			Expect(fakeUAAClient.ListUsersArgsForCall(0)).To(Equal("some-user"))
+		})
+
+		It("returns the error when listing the users fails", func() {
+			fakeUAAClient.ListUsersReturns(nil, errors.New("uaa error"))
+
+			_, err := actor.GetUserOrigins("some-user")
+			Expect(err).To(MatchError("uaa error"))
+		})
+	})
 })
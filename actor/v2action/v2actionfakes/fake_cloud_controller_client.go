@@ -810,6 +810,79 @@ type FakeCloudControllerClient struct {
 	tokenEndpointReturnsOnCall map[int]struct {
 		result1 string
 	}
+	GetAppUsageEventsStub        func(afterGUID string, eventFunc func(ccv2.AppUsageEvent) error) (ccv2.Warnings, error)
+	getAppUsageEventsMutex       sync.RWMutex
+	getAppUsageEventsArgsForCall []struct {
+		afterGUID string
+		eventFunc func(ccv2.AppUsageEvent) error
+	}
+	getAppUsageEventsReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	getAppUsageEventsReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	GetServiceUsageEventsStub        func(afterGUID string, eventFunc func(ccv2.ServiceUsageEvent) error) (ccv2.Warnings, error)
+	getServiceUsageEventsMutex       sync.RWMutex
+	getServiceUsageEventsArgsForCall []struct {
+		afterGUID string
+		eventFunc func(ccv2.ServiceUsageEvent) error
+	}
+	getServiceUsageEventsReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	getServiceUsageEventsReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	GetLatestEventsStub        func(limit int, queries ...ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error)
+	getLatestEventsMutex       sync.RWMutex
+	getLatestEventsArgsForCall []struct {
+		limit   int
+		queries []ccv2.Query
+	}
+	getLatestEventsReturns struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getLatestEventsReturnsOnCall map[int]struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetServiceInstanceSharedTosStub        func(serviceInstanceGUID string) ([]ccv2.ServiceInstanceSharedTo, ccv2.Warnings, error)
+	getServiceInstanceSharedTosMutex       sync.RWMutex
+	getServiceInstanceSharedTosArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	getServiceInstanceSharedTosReturns struct {
+		result1 []ccv2.ServiceInstanceSharedTo
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceInstanceSharedTosReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceInstanceSharedTo
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UnbindRouteFromApplicationStub        func(routeGUID string, appGUID string) (ccv2.Warnings, error)
+	unbindRouteFromApplicationMutex       sync.RWMutex
+	unbindRouteFromApplicationArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+	}
+	unbindRouteFromApplicationReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	unbindRouteFromApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -3745,6 +3818,271 @@ func (fake *FakeCloudControllerClient) TokenEndpointReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) GetAppUsageEvents(afterGUID string, eventFunc func(ccv2.AppUsageEvent) error) (ccv2.Warnings, error) {
+	fake.getAppUsageEventsMutex.Lock()
+	ret, specificReturn := fake.getAppUsageEventsReturnsOnCall[len(fake.getAppUsageEventsArgsForCall)]
+	fake.getAppUsageEventsArgsForCall = append(fake.getAppUsageEventsArgsForCall, struct {
+		afterGUID string
+		eventFunc func(ccv2.AppUsageEvent) error
+	}{afterGUID, eventFunc})
+	fake.recordInvocation("GetAppUsageEvents", []interface{}{afterGUID, eventFunc})
+	fake.getAppUsageEventsMutex.Unlock()
+	if fake.GetAppUsageEventsStub != nil {
+		return fake.GetAppUsageEventsStub(afterGUID, eventFunc)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAppUsageEventsReturns.result1, fake.getAppUsageEventsReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsCallCount() int {
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	return len(fake.getAppUsageEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsArgsForCall(i int) (string, func(ccv2.AppUsageEvent) error) {
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	return fake.getAppUsageEventsArgsForCall[i].afterGUID, fake.getAppUsageEventsArgsForCall[i].eventFunc
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsReturns(result1 ccv2.Warnings, result2 error) {
+	fake.GetAppUsageEventsStub = nil
+	fake.getAppUsageEventsReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.GetAppUsageEventsStub = nil
+	if fake.getAppUsageEventsReturnsOnCall == nil {
+		fake.getAppUsageEventsReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.getAppUsageEventsReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEvents(afterGUID string, eventFunc func(ccv2.ServiceUsageEvent) error) (ccv2.Warnings, error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	ret, specificReturn := fake.getServiceUsageEventsReturnsOnCall[len(fake.getServiceUsageEventsArgsForCall)]
+	fake.getServiceUsageEventsArgsForCall = append(fake.getServiceUsageEventsArgsForCall, struct {
+		afterGUID string
+		eventFunc func(ccv2.ServiceUsageEvent) error
+	}{afterGUID, eventFunc})
+	fake.recordInvocation("GetServiceUsageEvents", []interface{}{afterGUID, eventFunc})
+	fake.getServiceUsageEventsMutex.Unlock()
+	if fake.GetServiceUsageEventsStub != nil {
+		return fake.GetServiceUsageEventsStub(afterGUID, eventFunc)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getServiceUsageEventsReturns.result1, fake.getServiceUsageEventsReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsCallCount() int {
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	return len(fake.getServiceUsageEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsArgsForCall(i int) (string, func(ccv2.ServiceUsageEvent) error) {
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	return fake.getServiceUsageEventsArgsForCall[i].afterGUID, fake.getServiceUsageEventsArgsForCall[i].eventFunc
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsReturns(result1 ccv2.Warnings, result2 error) {
+	fake.GetServiceUsageEventsStub = nil
+	fake.getServiceUsageEventsReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.GetServiceUsageEventsStub = nil
+	if fake.getServiceUsageEventsReturnsOnCall == nil {
+		fake.getServiceUsageEventsReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.getServiceUsageEventsReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetLatestEvents(limit int, queries ...ccv2.Query) ([]ccv2.Event, ccv2.Warnings, error) {
+	fake.getLatestEventsMutex.Lock()
+	ret, specificReturn := fake.getLatestEventsReturnsOnCall[len(fake.getLatestEventsArgsForCall)]
+	fake.getLatestEventsArgsForCall = append(fake.getLatestEventsArgsForCall, struct {
+		limit   int
+		queries []ccv2.Query
+	}{limit, queries})
+	fake.recordInvocation("GetLatestEvents", []interface{}{limit, queries})
+	fake.getLatestEventsMutex.Unlock()
+	if fake.GetLatestEventsStub != nil {
+		return fake.GetLatestEventsStub(limit, queries...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getLatestEventsReturns.result1, fake.getLatestEventsReturns.result2, fake.getLatestEventsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetLatestEventsCallCount() int {
+	fake.getLatestEventsMutex.RLock()
+	defer fake.getLatestEventsMutex.RUnlock()
+	return len(fake.getLatestEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetLatestEventsArgsForCall(i int) (int, []ccv2.Query) {
+	fake.getLatestEventsMutex.RLock()
+	defer fake.getLatestEventsMutex.RUnlock()
+	return fake.getLatestEventsArgsForCall[i].limit, fake.getLatestEventsArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetLatestEventsReturns(result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.GetLatestEventsStub = nil
+	fake.getLatestEventsReturns = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetLatestEventsReturnsOnCall(i int, result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.GetLatestEventsStub = nil
+	if fake.getLatestEventsReturnsOnCall == nil {
+		fake.getLatestEventsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Event
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getLatestEventsReturnsOnCall[i] = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTos(serviceInstanceGUID string) ([]ccv2.ServiceInstanceSharedTo, ccv2.Warnings, error) {
+	fake.getServiceInstanceSharedTosMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceSharedTosReturnsOnCall[len(fake.getServiceInstanceSharedTosArgsForCall)]
+	fake.getServiceInstanceSharedTosArgsForCall = append(fake.getServiceInstanceSharedTosArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("GetServiceInstanceSharedTos", []interface{}{serviceInstanceGUID})
+	fake.getServiceInstanceSharedTosMutex.Unlock()
+	if fake.GetServiceInstanceSharedTosStub != nil {
+		return fake.GetServiceInstanceSharedTosStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceSharedTosReturns.result1, fake.getServiceInstanceSharedTosReturns.result2, fake.getServiceInstanceSharedTosReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosCallCount() int {
+	fake.getServiceInstanceSharedTosMutex.RLock()
+	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
+	return len(fake.getServiceInstanceSharedTosArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosArgsForCall(i int) string {
+	fake.getServiceInstanceSharedTosMutex.RLock()
+	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
+	return fake.getServiceInstanceSharedTosArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosReturns(result1 []ccv2.ServiceInstanceSharedTo, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedTosStub = nil
+	fake.getServiceInstanceSharedTosReturns = struct {
+		result1 []ccv2.ServiceInstanceSharedTo
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosReturnsOnCall(i int, result1 []ccv2.ServiceInstanceSharedTo, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceInstanceSharedTosStub = nil
+	if fake.getServiceInstanceSharedTosReturnsOnCall == nil {
+		fake.getServiceInstanceSharedTosReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceInstanceSharedTo
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceSharedTosReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceInstanceSharedTo
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplication(routeGUID string, appGUID string) (ccv2.Warnings, error) {
+	fake.unbindRouteFromApplicationMutex.Lock()
+	ret, specificReturn := fake.unbindRouteFromApplicationReturnsOnCall[len(fake.unbindRouteFromApplicationArgsForCall)]
+	fake.unbindRouteFromApplicationArgsForCall = append(fake.unbindRouteFromApplicationArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+	}{routeGUID, appGUID})
+	fake.recordInvocation("UnbindRouteFromApplication", []interface{}{routeGUID, appGUID})
+	fake.unbindRouteFromApplicationMutex.Unlock()
+	if fake.UnbindRouteFromApplicationStub != nil {
+		return fake.UnbindRouteFromApplicationStub(routeGUID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.unbindRouteFromApplicationReturns.result1, fake.unbindRouteFromApplicationReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplicationCallCount() int {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return len(fake.unbindRouteFromApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplicationArgsForCall(i int) (string, string) {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return fake.unbindRouteFromApplicationArgsForCall[i].routeGUID, fake.unbindRouteFromApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplicationReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	fake.unbindRouteFromApplicationReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UnbindRouteFromApplicationReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	if fake.unbindRouteFromApplicationReturnsOnCall == nil {
+		fake.unbindRouteFromApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.unbindRouteFromApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -3860,6 +4198,16 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.routingEndpointMutex.RUnlock()
 	fake.tokenEndpointMutex.RLock()
 	defer fake.tokenEndpointMutex.RUnlock()
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	fake.getLatestEventsMutex.RLock()
+	defer fake.getLatestEventsMutex.RUnlock()
+	fake.getServiceInstanceSharedTosMutex.RLock()
+	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -126,6 +126,45 @@ type FakeConfig struct {
 		result1 bool
 		result2 []string
 	}
+	UAAGrantTypeStub        func() string
+	uaaGrantTypeMutex       sync.RWMutex
+	uaaGrantTypeArgsForCall []struct{}
+	uaaGrantTypeReturns     struct {
+		result1 string
+	}
+	uaaGrantTypeReturnsOnCall map[int]struct {
+		result1 string
+	}
+	SetUAAClientCredentialsStub        func(client string, secret string)
+	setUAAClientCredentialsMutex       sync.RWMutex
+	setUAAClientCredentialsArgsForCall []struct {
+		client string
+		secret string
+	}
+	SetUAAGrantTypeStub        func(grantType string)
+	setUAAGrantTypeMutex       sync.RWMutex
+	setUAAGrantTypeArgsForCall []struct {
+		grantType string
+	}
+	SetUAAIssuerStub        func(issuer string)
+	setUAAIssuerMutex       sync.RWMutex
+	setUAAIssuerArgsForCall []struct {
+		issuer string
+	}
+	CACertStub        func() []byte
+	caCertMutex       sync.RWMutex
+	caCertArgsForCall []struct{}
+	caCertReturns     struct {
+		result1 []byte
+	}
+	caCertReturnsOnCall map[int]struct {
+		result1 []byte
+	}
+	SetCACertStub        func(caCert []byte)
+	setCACertMutex       sync.RWMutex
+	setCACertArgsForCall []struct {
+		caCert []byte
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -629,6 +668,188 @@ func (fake *FakeConfig) VerboseReturnsOnCall(i int, result1 bool, result2 []stri
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) UAAGrantType() string {
+	fake.uaaGrantTypeMutex.Lock()
+	ret, specificReturn := fake.uaaGrantTypeReturnsOnCall[len(fake.uaaGrantTypeArgsForCall)]
+	fake.uaaGrantTypeArgsForCall = append(fake.uaaGrantTypeArgsForCall, struct{}{})
+	fake.recordInvocation("UAAGrantType", []interface{}{})
+	fake.uaaGrantTypeMutex.Unlock()
+	if fake.UAAGrantTypeStub != nil {
+		return fake.UAAGrantTypeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.uaaGrantTypeReturns.result1
+}
+
+func (fake *FakeConfig) UAAGrantTypeCallCount() int {
+	fake.uaaGrantTypeMutex.RLock()
+	defer fake.uaaGrantTypeMutex.RUnlock()
+	return len(fake.uaaGrantTypeArgsForCall)
+}
+
+func (fake *FakeConfig) UAAGrantTypeReturns(result1 string) {
+	fake.UAAGrantTypeStub = nil
+	fake.uaaGrantTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAGrantTypeReturnsOnCall(i int, result1 string) {
+	fake.UAAGrantTypeStub = nil
+	if fake.uaaGrantTypeReturnsOnCall == nil {
+		fake.uaaGrantTypeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uaaGrantTypeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) SetUAAClientCredentials(client string, secret string) {
+	fake.setUAAClientCredentialsMutex.Lock()
+	fake.setUAAClientCredentialsArgsForCall = append(fake.setUAAClientCredentialsArgsForCall, struct {
+		client string
+		secret string
+	}{client, secret})
+	fake.recordInvocation("SetUAAClientCredentials", []interface{}{client, secret})
+	fake.setUAAClientCredentialsMutex.Unlock()
+	if fake.SetUAAClientCredentialsStub != nil {
+		fake.SetUAAClientCredentialsStub(client, secret)
+	}
+}
+
+func (fake *FakeConfig) SetUAAClientCredentialsCallCount() int {
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	return len(fake.setUAAClientCredentialsArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAClientCredentialsArgsForCall(i int) (string, string) {
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	return fake.setUAAClientCredentialsArgsForCall[i].client, fake.setUAAClientCredentialsArgsForCall[i].secret
+}
+
+func (fake *FakeConfig) SetUAAGrantType(grantType string) {
+	fake.setUAAGrantTypeMutex.Lock()
+	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
+		grantType string
+	}{grantType})
+	fake.recordInvocation("SetUAAGrantType", []interface{}{grantType})
+	fake.setUAAGrantTypeMutex.Unlock()
+	if fake.SetUAAGrantTypeStub != nil {
+		fake.SetUAAGrantTypeStub(grantType)
+	}
+}
+
+func (fake *FakeConfig) SetUAAGrantTypeCallCount() int {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return len(fake.setUAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAGrantTypeArgsForCall(i int) string {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return fake.setUAAGrantTypeArgsForCall[i].grantType
+}
+
+func (fake *FakeConfig) SetUAAIssuer(issuer string) {
+	fake.setUAAIssuerMutex.Lock()
+	fake.setUAAIssuerArgsForCall = append(fake.setUAAIssuerArgsForCall, struct {
+		issuer string
+	}{issuer})
+	fake.recordInvocation("SetUAAIssuer", []interface{}{issuer})
+	fake.setUAAIssuerMutex.Unlock()
+	if fake.SetUAAIssuerStub != nil {
+		fake.SetUAAIssuerStub(issuer)
+	}
+}
+
+func (fake *FakeConfig) SetUAAIssuerCallCount() int {
+	fake.setUAAIssuerMutex.RLock()
+	defer fake.setUAAIssuerMutex.RUnlock()
+	return len(fake.setUAAIssuerArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAIssuerArgsForCall(i int) string {
+	fake.setUAAIssuerMutex.RLock()
+	defer fake.setUAAIssuerMutex.RUnlock()
+	return fake.setUAAIssuerArgsForCall[i].issuer
+}
+
+func (fake *FakeConfig) CACert() []byte {
+	fake.caCertMutex.Lock()
+	ret, specificReturn := fake.caCertReturnsOnCall[len(fake.caCertArgsForCall)]
+	fake.caCertArgsForCall = append(fake.caCertArgsForCall, struct{}{})
+	fake.recordInvocation("CACert", []interface{}{})
+	fake.caCertMutex.Unlock()
+	if fake.CACertStub != nil {
+		return fake.CACertStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.caCertReturns.result1
+}
+
+func (fake *FakeConfig) CACertCallCount() int {
+	fake.caCertMutex.RLock()
+	defer fake.caCertMutex.RUnlock()
+	return len(fake.caCertArgsForCall)
+}
+
+func (fake *FakeConfig) CACertReturns(result1 []byte) {
+	fake.CACertStub = nil
+	fake.caCertReturns = struct {
+		result1 []byte
+	}{result1}
+}
+
+func (fake *FakeConfig) CACertReturnsOnCall(i int, result1 []byte) {
+	fake.CACertStub = nil
+	if fake.caCertReturnsOnCall == nil {
+		fake.caCertReturnsOnCall = make(map[int]struct {
+			result1 []byte
+		})
+	}
+	fake.caCertReturnsOnCall[i] = struct {
+		result1 []byte
+	}{result1}
+}
+
+func (fake *FakeConfig) SetCACert(caCert []byte) {
+	var caCertCopy []byte
+	if caCert != nil {
+		caCertCopy = make([]byte, len(caCert))
+		copy(caCertCopy, caCert)
+	}
+	fake.setCACertMutex.Lock()
+	fake.setCACertArgsForCall = append(fake.setCACertArgsForCall, struct {
+		caCert []byte
+	}{caCertCopy})
+	fake.recordInvocation("SetCACert", []interface{}{caCertCopy})
+	fake.setCACertMutex.Unlock()
+	if fake.SetCACertStub != nil {
+		fake.SetCACertStub(caCert)
+	}
+}
+
+func (fake *FakeConfig) SetCACertCallCount() int {
+	fake.setCACertMutex.RLock()
+	defer fake.setCACertMutex.RUnlock()
+	return len(fake.setCACertArgsForCall)
+}
+
+func (fake *FakeConfig) SetCACertArgsForCall(i int) []byte {
+	fake.setCACertMutex.RLock()
+	defer fake.setCACertMutex.RUnlock()
+	return fake.setCACertArgsForCall[i].caCert
+}
+
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -662,6 +883,18 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.unsetSpaceInformationMutex.RUnlock()
 	fake.verboseMutex.RLock()
 	defer fake.verboseMutex.RUnlock()
+	fake.uaaGrantTypeMutex.RLock()
+	defer fake.uaaGrantTypeMutex.RUnlock()
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	fake.setUAAIssuerMutex.RLock()
+	defer fake.setUAAIssuerMutex.RUnlock()
+	fake.caCertMutex.RLock()
+	defer fake.caCertMutex.RUnlock()
+	fake.setCACertMutex.RLock()
+	defer fake.setCACertMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -6,25 +6,10 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
 )
 
 type FakeUAAClient struct {
-	AuthenticateStub        func(username string, password string) (string, string, error)
-	authenticateMutex       sync.RWMutex
-	authenticateArgsForCall []struct {
-		username string
-		password string
-	}
-	authenticateReturns struct {
-		result1 string
-		result2 string
-		result3 error
-	}
-	authenticateReturnsOnCall map[int]struct {
-		result1 string
-		result2 string
-		result3 error
-	}
 	CreateUserStub        func(username string, password string, origin string) (uaa.User, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -67,63 +52,48 @@ type FakeUAAClient struct {
 		result1 uaa.RefreshedTokens
 		result2 error
 	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
-}
-
-func (fake *FakeUAAClient) Authenticate(username string, password string) (string, string, error) {
-	fake.authenticateMutex.Lock()
-	ret, specificReturn := fake.authenticateReturnsOnCall[len(fake.authenticateArgsForCall)]
-	fake.authenticateArgsForCall = append(fake.authenticateArgsForCall, struct {
-		username string
-		password string
-	}{username, password})
-	fake.recordInvocation("Authenticate", []interface{}{username, password})
-	fake.authenticateMutex.Unlock()
-	if fake.AuthenticateStub != nil {
-		return fake.AuthenticateStub(username, password)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+	AuthenticateStub        func(credentials map[string]string, grantType constant.GrantType) (string, string, error)
+	authenticateMutex       sync.RWMutex
+	authenticateArgsForCall []struct {
+		credentials map[string]string
+		grantType   constant.GrantType
 	}
-	return fake.authenticateReturns.result1, fake.authenticateReturns.result2, fake.authenticateReturns.result3
-}
-
-func (fake *FakeUAAClient) AuthenticateCallCount() int {
-	fake.authenticateMutex.RLock()
-	defer fake.authenticateMutex.RUnlock()
-	return len(fake.authenticateArgsForCall)
-}
-
-func (fake *FakeUAAClient) AuthenticateArgsForCall(i int) (string, string) {
-	fake.authenticateMutex.RLock()
-	defer fake.authenticateMutex.RUnlock()
-	return fake.authenticateArgsForCall[i].username, fake.authenticateArgsForCall[i].password
-}
-
-func (fake *FakeUAAClient) AuthenticateReturns(result1 string, result2 string, result3 error) {
-	fake.AuthenticateStub = nil
-	fake.authenticateReturns = struct {
+	authenticateReturns struct {
 		result1 string
 		result2 string
 		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeUAAClient) AuthenticateReturnsOnCall(i int, result1 string, result2 string, result3 error) {
-	fake.AuthenticateStub = nil
-	if fake.authenticateReturnsOnCall == nil {
-		fake.authenticateReturnsOnCall = make(map[int]struct {
-			result1 string
-			result2 string
-			result3 error
-		})
 	}
-	fake.authenticateReturnsOnCall[i] = struct {
+	authenticateReturnsOnCall map[int]struct {
 		result1 string
 		result2 string
 		result3 error
-	}{result1, result2, result3}
+	}
+	GetLoginPromptsStub        func() (map[string]uaa.LoginPrompt, error)
+	getLoginPromptsMutex       sync.RWMutex
+	getLoginPromptsArgsForCall []struct{}
+	getLoginPromptsReturns     struct {
+		result1 map[string]uaa.LoginPrompt
+		result2 error
+	}
+	getLoginPromptsReturnsOnCall map[int]struct {
+		result1 map[string]uaa.LoginPrompt
+		result2 error
+	}
+	ListUsersStub        func(username string) ([]uaa.User, error)
+	listUsersMutex       sync.RWMutex
+	listUsersArgsForCall []struct {
+		username string
+	}
+	listUsersReturns struct {
+		result1 []uaa.User
+		result2 error
+	}
+	listUsersReturnsOnCall map[int]struct {
+		result1 []uaa.User
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
 }
 
 func (fake *FakeUAAClient) CreateUser(username string, password string, origin string) (uaa.User, error) {
@@ -282,17 +252,170 @@ func (fake *FakeUAAClient) RefreshAccessTokenReturnsOnCall(i int, result1 uaa.Re
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) Authenticate(credentials map[string]string, grantType constant.GrantType) (string, string, error) {
+	fake.authenticateMutex.Lock()
+	ret, specificReturn := fake.authenticateReturnsOnCall[len(fake.authenticateArgsForCall)]
+	fake.authenticateArgsForCall = append(fake.authenticateArgsForCall, struct {
+		credentials map[string]string
+		grantType   constant.GrantType
+	}{credentials, grantType})
+	fake.recordInvocation("Authenticate", []interface{}{credentials, grantType})
+	fake.authenticateMutex.Unlock()
+	if fake.AuthenticateStub != nil {
+		return fake.AuthenticateStub(credentials, grantType)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.authenticateReturns.result1, fake.authenticateReturns.result2, fake.authenticateReturns.result3
+}
+
+func (fake *FakeUAAClient) AuthenticateCallCount() int {
+	fake.authenticateMutex.RLock()
+	defer fake.authenticateMutex.RUnlock()
+	return len(fake.authenticateArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthenticateArgsForCall(i int) (map[string]string, constant.GrantType) {
+	fake.authenticateMutex.RLock()
+	defer fake.authenticateMutex.RUnlock()
+	return fake.authenticateArgsForCall[i].credentials, fake.authenticateArgsForCall[i].grantType
+}
+
+func (fake *FakeUAAClient) AuthenticateReturns(result1 string, result2 string, result3 error) {
+	fake.AuthenticateStub = nil
+	fake.authenticateReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthenticateReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.AuthenticateStub = nil
+	if fake.authenticateReturnsOnCall == nil {
+		fake.authenticateReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.authenticateReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) GetLoginPrompts() (map[string]uaa.LoginPrompt, error) {
+	fake.getLoginPromptsMutex.Lock()
+	ret, specificReturn := fake.getLoginPromptsReturnsOnCall[len(fake.getLoginPromptsArgsForCall)]
+	fake.getLoginPromptsArgsForCall = append(fake.getLoginPromptsArgsForCall, struct{}{})
+	fake.recordInvocation("GetLoginPrompts", []interface{}{})
+	fake.getLoginPromptsMutex.Unlock()
+	if fake.GetLoginPromptsStub != nil {
+		return fake.GetLoginPromptsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getLoginPromptsReturns.result1, fake.getLoginPromptsReturns.result2
+}
+
+func (fake *FakeUAAClient) GetLoginPromptsCallCount() int {
+	fake.getLoginPromptsMutex.RLock()
+	defer fake.getLoginPromptsMutex.RUnlock()
+	return len(fake.getLoginPromptsArgsForCall)
+}
+
+func (fake *FakeUAAClient) GetLoginPromptsReturns(result1 map[string]uaa.LoginPrompt, result2 error) {
+	fake.GetLoginPromptsStub = nil
+	fake.getLoginPromptsReturns = struct {
+		result1 map[string]uaa.LoginPrompt
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) GetLoginPromptsReturnsOnCall(i int, result1 map[string]uaa.LoginPrompt, result2 error) {
+	fake.GetLoginPromptsStub = nil
+	if fake.getLoginPromptsReturnsOnCall == nil {
+		fake.getLoginPromptsReturnsOnCall = make(map[int]struct {
+			result1 map[string]uaa.LoginPrompt
+			result2 error
+		})
+	}
+	fake.getLoginPromptsReturnsOnCall[i] = struct {
+		result1 map[string]uaa.LoginPrompt
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) ListUsers(username string) ([]uaa.User, error) {
+	fake.listUsersMutex.Lock()
+	ret, specificReturn := fake.listUsersReturnsOnCall[len(fake.listUsersArgsForCall)]
+	fake.listUsersArgsForCall = append(fake.listUsersArgsForCall, struct {
+		username string
+	}{username})
+	fake.recordInvocation("ListUsers", []interface{}{username})
+	fake.listUsersMutex.Unlock()
+	if fake.ListUsersStub != nil {
+		return fake.ListUsersStub(username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listUsersReturns.result1, fake.listUsersReturns.result2
+}
+
+func (fake *FakeUAAClient) ListUsersCallCount() int {
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
+	return len(fake.listUsersArgsForCall)
+}
+
+func (fake *FakeUAAClient) ListUsersArgsForCall(i int) string {
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
+	return fake.listUsersArgsForCall[i].username
+}
+
+func (fake *FakeUAAClient) ListUsersReturns(result1 []uaa.User, result2 error) {
+	fake.ListUsersStub = nil
+	fake.listUsersReturns = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) ListUsersReturnsOnCall(i int, result1 []uaa.User, result2 error) {
+	fake.ListUsersStub = nil
+	if fake.listUsersReturnsOnCall == nil {
+		fake.listUsersReturnsOnCall = make(map[int]struct {
+			result1 []uaa.User
+			result2 error
+		})
+	}
+	fake.listUsersReturnsOnCall[i] = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.authenticateMutex.RLock()
-	defer fake.authenticateMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
 	defer fake.getSSHPasscodeMutex.RUnlock()
 	fake.refreshAccessTokenMutex.RLock()
 	defer fake.refreshAccessTokenMutex.RUnlock()
+	fake.authenticateMutex.RLock()
+	defer fake.authenticateMutex.RUnlock()
+	fake.getLoginPromptsMutex.RLock()
+	defer fake.getLoginPromptsMutex.RUnlock()
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
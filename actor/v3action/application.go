@@ -3,6 +3,7 @@ package v3action
 import (
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -15,6 +16,8 @@ type Application struct {
 	GUID      string
 	State     string
 	Lifecycle AppLifecycle
+	SpaceGUID string
+	Metadata  *Metadata
 }
 
 type AppLifecycle struct {
@@ -97,6 +100,7 @@ func (actor Actor) GetApplicationByNameAndSpace(appName string, spaceGUID string
 			Type: AppLifecycleType(apps[0].Lifecycle.Type),
 			Data: AppLifecycleData(apps[0].Lifecycle.Data),
 		},
+		Metadata: (*Metadata)(apps[0].Metadata),
 	}, Warnings(warnings), nil
 }
 
@@ -125,6 +129,67 @@ func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warn
 	return apps, Warnings(warnings), nil
 }
 
+// GetApplicationsBySpaceAndLabelSelector returns the applications in a space
+// that match the label selector, ordered by name. An empty selector matches
+// all applications.
+func (actor Actor) GetApplicationsBySpaceAndLabelSelector(spaceGUID string, labelSelector string) ([]Application, Warnings, error) {
+	query := url.Values{
+		ccv3.SpaceGUIDFilter: []string{spaceGUID},
+		ccv3.OrderBy:         []string{ccv3.NameOrder},
+	}
+	if labelSelector != "" {
+		query.Set(ccv3.LabelSelectorFilter, labelSelector)
+	}
+
+	ccv3Apps, warnings, err := actor.CloudControllerClient.GetApplications(query)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	apps := make([]Application, len(ccv3Apps))
+	for i, ccv3App := range ccv3Apps {
+		apps[i] = Application{
+			Name:  ccv3App.Name,
+			GUID:  ccv3App.GUID,
+			State: ccv3App.State,
+			Lifecycle: AppLifecycle{
+				Type: AppLifecycleType(ccv3App.Lifecycle.Type),
+				Data: AppLifecycleData(ccv3App.Lifecycle.Data),
+			},
+			SpaceGUID: spaceGUID,
+			Metadata:  (*Metadata)(ccv3App.Metadata),
+		}
+	}
+	return apps, Warnings(warnings), nil
+}
+
+// GetApplicationsByGUIDs returns the applications with the given GUIDs,
+// including the GUIDs of their spaces. Applications the user cannot see are
+// left out.
+func (actor Actor) GetApplicationsByGUIDs(appGUIDs ...string) ([]Application, Warnings, error) {
+	ccv3Apps, warnings, err := actor.CloudControllerClient.GetApplications(url.Values{
+		ccv3.GUIDFilter: []string{strings.Join(appGUIDs, ",")},
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	apps := make([]Application, len(ccv3Apps))
+	for i, ccv3App := range ccv3Apps {
+		apps[i] = Application{
+			Name:  ccv3App.Name,
+			GUID:  ccv3App.GUID,
+			State: ccv3App.State,
+			Lifecycle: AppLifecycle{
+				Type: AppLifecycleType(ccv3App.Lifecycle.Type),
+				Data: AppLifecycleData(ccv3App.Lifecycle.Data),
+			},
+			SpaceGUID: ccv3App.Relationships[ccv3.SpaceRelationship].GUID,
+		}
+	}
+	return apps, Warnings(warnings), nil
+}
+
 // CreateApplicationInSpace creates and returns the application with the given
 // name in the given space.
 func (actor Actor) CreateApplicationInSpace(app Application, spaceGUID string) (Application, Warnings, error) {
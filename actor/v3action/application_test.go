@@ -180,6 +180,56 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationsByGUIDs", func() {
+		Context("when the applications exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						{
+							GUID: "some-app-guid-1",
+							Name: "some-app-1",
+							Relationships: ccv3.Relationships{
+								ccv3.SpaceRelationship: ccv3.Relationship{GUID: "some-space-guid"},
+							},
+						},
+					},
+					ccv3.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+			})
+
+			It("returns the applications with their spaces and warnings", func() {
+				apps, warnings, err := actor.GetApplicationsByGUIDs("some-app-guid-1", "some-app-guid-2")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(
+					Application{
+						GUID:      "some-app-guid-1",
+						Name:      "some-app-1",
+						SpaceGUID: "some-space-guid",
+					},
+				))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.GUIDFilter: []string{"some-app-guid-1,some-app-guid-2"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the warnings and the error", func() {
+				_, warnings, err := actor.GetApplicationsByGUIDs("some-app-guid")
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
 	Describe("GetApplicationsBySpace", func() {
 		Context("when the there are applications in the space", func() {
 			BeforeEach(func() {
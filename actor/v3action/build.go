@@ -2,11 +2,38 @@ package v3action
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 )
 
+// Build represents a V3 actor build.
+type Build ccv3.Build
+
+// ErrorCode returns the code of the staging error of a failed build, for
+// example NoAppDetectedError, or "" if the build has no error code. The cloud
+// controller reports build errors as 'CODE - description'.
+func (build Build) ErrorCode() string {
+	parts := strings.SplitN(build.Error, " - ", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	return parts[0]
+}
+
+// BuildNotFoundError is returned when an application has no build with the
+// requested GUID.
+type BuildNotFoundError struct {
+	GUID string
+}
+
+func (e BuildNotFoundError) Error() string {
+	return fmt.Sprintf("Build with GUID %s not found", e.GUID)
+}
+
 type StagingTimeoutError struct {
 	AppName string
 	Timeout time.Duration
@@ -16,6 +43,52 @@ func (StagingTimeoutError) Error() string {
 	return "Timed out waiting for package to stage"
 }
 
+// GetApplicationBuilds returns the builds of an application, newest first.
+func (actor Actor) GetApplicationBuilds(appName string, spaceGUID string) ([]Build, Warnings, error) {
+	return actor.getApplicationBuilds(appName, spaceGUID, url.Values{})
+}
+
+// GetApplicationBuild returns the build of an application with the given
+// GUID.
+func (actor Actor) GetApplicationBuild(appName string, spaceGUID string, buildGUID string) (Build, Warnings, error) {
+	builds, warnings, err := actor.getApplicationBuilds(appName, spaceGUID, url.Values{
+		ccv3.GUIDFilter: []string{buildGUID},
+	})
+	if err != nil {
+		return Build{}, warnings, err
+	}
+
+	if len(builds) == 0 {
+		return Build{}, warnings, BuildNotFoundError{GUID: buildGUID}
+	}
+
+	return builds[0], warnings, nil
+}
+
+func (actor Actor) getApplicationBuilds(appName string, spaceGUID string, query url.Values) ([]Build, Warnings, error) {
+	allWarnings := Warnings{}
+	application, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	query.Set(ccv3.AppGUIDFilter, application.GUID)
+	query.Set(ccv3.OrderBy, ccv3.CreatedAtDescendingOrder)
+	ccv3Builds, apiWarnings, err := actor.CloudControllerClient.GetBuilds(query)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var builds []Build
+	for _, ccv3Build := range ccv3Builds {
+		builds = append(builds, Build(ccv3Build))
+	}
+
+	return builds, allWarnings, nil
+}
+
 func (actor Actor) StagePackage(packageGUID string, appName string) (<-chan Droplet, <-chan Warnings, <-chan error) {
 	dropletStream := make(chan Droplet)
 	warningsStream := make(chan Warnings)
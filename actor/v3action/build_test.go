@@ -2,6 +2,7 @@ package v3action_test
 
 import (
 	"errors"
+	"net/url"
 	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
@@ -24,6 +25,131 @@ var _ = Describe("Build Actions", func() {
 		actor = NewActor(fakeCloudControllerClient, fakeConfig)
 	})
 
+	Describe("Build", func() {
+		Describe("ErrorCode", func() {
+			It("returns the code of the staging error", func() {
+				build := Build{Error: "NoAppDetectedError - An app was not successfully detected by any available buildpack"}
+				Expect(build.ErrorCode()).To(Equal("NoAppDetectedError"))
+			})
+
+			It("returns an empty string when the error has no code", func() {
+				Expect(Build{}.ErrorCode()).To(BeEmpty())
+				Expect(Build{Error: "something went wrong"}.ErrorCode()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("GetApplicationBuilds", func() {
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "some-app-guid"}},
+					ccv3.Warnings{"get-applications-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetBuildsReturns(
+					[]ccv3.Build{
+						{GUID: "some-build-guid-1", State: ccv3.BuildStateStaged, DropletGUID: "some-droplet-guid"},
+						{GUID: "some-build-guid-2", State: ccv3.BuildStateFailed, Error: "some-error"},
+					},
+					ccv3.Warnings{"get-builds-warning"},
+					nil,
+				)
+			})
+
+			It("returns the app's builds, newest first, and all warnings", func() {
+				builds, warnings, err := actor.GetApplicationBuilds("some-app-name", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-builds-warning"))
+				Expect(builds).To(Equal([]Build{
+					{GUID: "some-build-guid-1", State: ccv3.BuildStateStaged, DropletGUID: "some-droplet-guid"},
+					{GUID: "some-build-guid-2", State: ccv3.BuildStateFailed, Error: "some-error"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.NameFilter:      []string{"some-app-name"},
+					ccv3.SpaceGUIDFilter: []string{"some-space-guid"},
+				}))
+				Expect(fakeCloudControllerClient.GetBuildsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetBuildsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.AppGUIDFilter: []string{"some-app-guid"},
+					ccv3.OrderBy:       []string{ccv3.CreatedAtDescendingOrder},
+				}))
+			})
+
+			Context("when getting the builds fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetBuildsReturns(nil, ccv3.Warnings{"get-builds-warning"}, errors.New("builds error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					_, warnings, err := actor.GetApplicationBuilds("some-app-name", "some-space-guid")
+					Expect(err).To(MatchError("builds error"))
+					Expect(warnings).To(ConsistOf("get-applications-warning", "get-builds-warning"))
+				})
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-applications-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and the warnings", func() {
+				_, warnings, err := actor.GetApplicationBuilds("some-app-name", "some-space-guid")
+				Expect(err).To(MatchError(ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-applications-warning"))
+				Expect(fakeCloudControllerClient.GetBuildsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetApplicationBuild", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-applications-warning"},
+				nil,
+			)
+		})
+
+		Context("when the app has the build", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildsReturns(
+					[]ccv3.Build{{GUID: "some-build-guid", State: ccv3.BuildStateFailed, Error: "some-error"}},
+					ccv3.Warnings{"get-builds-warning"},
+					nil,
+				)
+			})
+
+			It("returns the build and all warnings", func() {
+				build, warnings, err := actor.GetApplicationBuild("some-app-name", "some-space-guid", "some-build-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-builds-warning"))
+				Expect(build).To(Equal(Build{GUID: "some-build-guid", State: ccv3.BuildStateFailed, Error: "some-error"}))
+
+				Expect(fakeCloudControllerClient.GetBuildsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.GUIDFilter:    []string{"some-build-guid"},
+					ccv3.AppGUIDFilter: []string{"some-app-guid"},
+					ccv3.OrderBy:       []string{ccv3.CreatedAtDescendingOrder},
+				}))
+			})
+		})
+
+		Context("when the app does not have the build", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildsReturns(nil, ccv3.Warnings{"get-builds-warning"}, nil)
+			})
+
+			It("returns a BuildNotFoundError and all warnings", func() {
+				_, warnings, err := actor.GetApplicationBuild("some-app-name", "some-space-guid", "some-build-guid")
+				Expect(err).To(MatchError(BuildNotFoundError{GUID: "some-build-guid"}))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-builds-warning"))
+			})
+		})
+	})
+
 	Describe("StagePackage", func() {
 		var (
 			dropletStream  <-chan Droplet
@@ -3,6 +3,7 @@ package v3action
 import (
 	"net/url"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 )
 
@@ -13,6 +14,7 @@ type CloudControllerClient interface {
 	AssignSpaceToIsolationSegment(spaceGUID string, isolationSegmentGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	CloudControllerAPIVersion() string
 	CreateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
+	CreateApplicationDeployment(appGUID string, dropletGUID string) (string, ccv3.Warnings, error)
 	CreateApplicationProcessScale(appGUID string, process ccv3.Process) (ccv3.Warnings, error)
 	CreateApplicationTask(appGUID string, task ccv3.Task) (ccv3.Task, ccv3.Warnings, error)
 	CreateBuild(build ccv3.Build) (ccv3.Build, ccv3.Warnings, error)
@@ -21,31 +23,48 @@ type CloudControllerClient interface {
 	DeleteApplication(guid string) (string, ccv3.Warnings, error)
 	DeleteApplicationProcessInstance(appGUID string, processType string, instanceIndex int) (ccv3.Warnings, error)
 	DeleteIsolationSegment(guid string) (ccv3.Warnings, error)
+	DeleteOrganization(orgGUID string) (string, ccv3.Warnings, error)
+	DeleteSpace(spaceGUID string) (string, ccv3.Warnings, error)
+	DownloadDroplet(dropletGUID string, progressReader cloudcontroller.ProgressReader) ([]byte, ccv3.Warnings, error)
 	EntitleIsolationSegmentToOrganizations(isoGUID string, orgGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	GetApplicationDropletCurrent(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
 	GetApplicationDroplets(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error)
 	GetApplicationProcessByType(appGUID string, processType string) (ccv3.Process, ccv3.Warnings, error)
 	GetApplicationProcesses(appGUID string) ([]ccv3.Process, ccv3.Warnings, error)
+	GetApplicationSidecars(appGUID string) ([]ccv3.Sidecar, ccv3.Warnings, error)
 	GetApplicationTasks(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error)
 	GetApplications(query url.Values) ([]ccv3.Application, ccv3.Warnings, error)
 	GetBuild(guid string) (ccv3.Build, ccv3.Warnings, error)
+	GetBuilds(query url.Values) ([]ccv3.Build, ccv3.Warnings, error)
+	GetDeployment(guid string) (ccv3.Deployment, ccv3.Warnings, error)
 	GetDroplet(guid string) (ccv3.Droplet, ccv3.Warnings, error)
 	GetIsolationSegment(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error)
+	GetIsolationSegmentSpaces(isolationSegmentGUID string) (ccv3.RelationshipList, ccv3.Warnings, error)
 	GetIsolationSegmentOrganizationsByIsolationSegment(isolationSegmentGUID string) ([]ccv3.Organization, ccv3.Warnings, error)
 	GetIsolationSegments(query url.Values) ([]ccv3.IsolationSegment, ccv3.Warnings, error)
+	GetJob(jobURL string) (ccv3.Job, ccv3.Warnings, error)
 	GetOrganizationDefaultIsolationSegment(orgGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	GetOrganizations(query url.Values) ([]ccv3.Organization, ccv3.Warnings, error)
 	GetPackages(query url.Values) ([]ccv3.Package, ccv3.Warnings, error)
 	GetPackage(guid string) (ccv3.Package, ccv3.Warnings, error)
 	GetProcessInstances(processGUID string) ([]ccv3.Instance, ccv3.Warnings, error)
 	GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
+	GetSpaces(query url.Values) ([]ccv3.Space, ccv3.Warnings, error)
 	PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string) (ccv3.Warnings, error)
 	PatchOrganizationDefaultIsolationSegment(orgGUID string, isolationSegmentGUID string) (ccv3.Warnings, error)
 	PollJob(jobURL string) (ccv3.Warnings, error)
 	RevokeIsolationSegmentFromOrganization(isolationSegmentGUID string, organizationGUID string) (ccv3.Warnings, error)
 	SetApplicationDroplet(appGUID string, dropletGUID string) (ccv3.Relationship, ccv3.Warnings, error)
+	ShareServiceInstanceToSpaces(serviceInstanceGUID string, spaceGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
 	StartApplication(appGUID string) (ccv3.Application, ccv3.Warnings, error)
 	StopApplication(appGUID string) (ccv3.Warnings, error)
+	UnshareServiceInstanceFromSpace(serviceInstanceGUID string, spaceGUID string) (ccv3.Warnings, error)
 	UpdateApplication(app ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
+	UpdateApplicationMetadata(appGUID string, metadata ccv3.Metadata) (ccv3.Metadata, ccv3.Warnings, error)
+	UpdateOrganizationMetadata(orgGUID string, metadata ccv3.Metadata) (ccv3.Metadata, ccv3.Warnings, error)
+	UpdateProcess(process ccv3.Process) (ccv3.Warnings, error)
+	UpdateSpaceMetadata(spaceGUID string, metadata ccv3.Metadata) (ccv3.Metadata, ccv3.Warnings, error)
+	UpdateSpaceApplyManifest(spaceGUID string, rawManifest []byte) (string, ccv3.Warnings, error)
 	UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
 	UploadPackage(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error)
 }
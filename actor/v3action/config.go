@@ -5,6 +5,7 @@ import "time"
 //go:generate counterfeiter . Config
 
 type Config interface {
+	OverallPollingTimeout() time.Duration
 	PollingInterval() time.Duration
 	StartupTimeout() time.Duration
 	StagingTimeout() time.Duration
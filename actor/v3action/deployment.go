@@ -0,0 +1,47 @@
+package v3action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// DeploymentCanceledError is returned when a deployment is canceled before
+// all of the app's instances run the new droplet.
+type DeploymentCanceledError struct {
+}
+
+func (DeploymentCanceledError) Error() string {
+	return "Deployment was canceled"
+}
+
+// CreateDeployment starts a rolling deployment of the droplet to the app and
+// returns the deployment's GUID.
+func (actor Actor) CreateDeployment(appGUID string, dropletGUID string) (string, Warnings, error) {
+	deploymentGUID, warnings, err := actor.CloudControllerClient.CreateApplicationDeployment(appGUID, dropletGUID)
+	return deploymentGUID, Warnings(warnings), err
+}
+
+// PollDeployment waits until the deployment has replaced all of the app's
+// instances, sending the warnings of every request to warningsChannel.
+func (actor Actor) PollDeployment(deploymentGUID string, warningsChannel chan<- Warnings) error {
+	timeout := time.Now().Add(actor.Config.StartupTimeout())
+	for time.Now().Before(timeout) {
+		deployment, warnings, err := actor.CloudControllerClient.GetDeployment(deploymentGUID)
+		warningsChannel <- Warnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		switch deployment.State {
+		case ccv3.DeploymentStateDeployed:
+			return nil
+		case ccv3.DeploymentStateCanceled:
+			return DeploymentCanceledError{}
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return StartupTimeoutError{}
+}
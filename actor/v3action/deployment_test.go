@@ -0,0 +1,124 @@
+package v3action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deployment Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+	})
+
+	Describe("CreateDeployment", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateApplicationDeploymentReturns("some-deployment-guid", ccv3.Warnings{"create-deployment-warning"}, nil)
+		})
+
+		It("creates a deployment of the droplet", func() {
+			deploymentGUID, warnings, err := actor.CreateDeployment("some-app-guid", "some-droplet-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("create-deployment-warning"))
+			Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+
+			appGUID, dropletGUID := fakeCloudControllerClient.CreateApplicationDeploymentArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(dropletGUID).To(Equal("some-droplet-guid"))
+		})
+	})
+
+	Describe("PollDeployment", func() {
+		var (
+			warningsChannel chan Warnings
+			allWarnings     Warnings
+			funcDone        chan interface{}
+			err             error
+		)
+
+		BeforeEach(func() {
+			fakeConfig.StartupTimeoutReturns(time.Second)
+			fakeConfig.PollingIntervalReturns(0)
+
+			warningsChannel = make(chan Warnings)
+			funcDone = make(chan interface{})
+			allWarnings = Warnings{}
+			go func() {
+				for {
+					select {
+					case warnings := <-warningsChannel:
+						allWarnings = append(allWarnings, warnings...)
+					case <-funcDone:
+						return
+					}
+				}
+			}()
+		})
+
+		JustBeforeEach(func() {
+			err = actor.PollDeployment("some-deployment-guid", warningsChannel)
+			funcDone <- nil
+		})
+
+		Context("when the deployment finishes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(0, ccv3.Deployment{State: ccv3.DeploymentStateDeploying}, ccv3.Warnings{"get-deployment-warning-1"}, nil)
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(1, ccv3.Deployment{State: ccv3.DeploymentStateDeployed}, ccv3.Warnings{"get-deployment-warning-2"}, nil)
+			})
+
+			It("polls until the deployment is deployed", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(allWarnings).To(ConsistOf("get-deployment-warning-1", "get-deployment-warning-2"))
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when the deployment is canceled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(ccv3.Deployment{State: ccv3.DeploymentStateCanceled}, ccv3.Warnings{"get-deployment-warning"}, nil)
+			})
+
+			It("returns a DeploymentCanceledError", func() {
+				Expect(err).To(MatchError(DeploymentCanceledError{}))
+			})
+		})
+
+		Context("when getting the deployment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get deployment error")
+				fakeCloudControllerClient.GetDeploymentReturns(ccv3.Deployment{}, ccv3.Warnings{"get-deployment-warning"}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(allWarnings).To(ConsistOf("get-deployment-warning"))
+			})
+		})
+
+		Context("when the deployment does not finish before the startup timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.StartupTimeoutReturns(0)
+			})
+
+			It("returns a StartupTimeoutError", func() {
+				Expect(err).To(MatchError(StartupTimeoutError{}))
+			})
+		})
+	})
+})
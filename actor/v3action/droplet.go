@@ -1,6 +1,7 @@
 package v3action
 
 import (
+	"fmt"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -38,7 +39,37 @@ func (a AssignDropletError) Error() string {
 	return a.Message
 }
 
-// SetApplicationDroplet sets the droplet for an application.
+// DropletNotFoundError is returned when a requested droplet, or the current
+// droplet of an application, cannot be found.
+type DropletNotFoundError struct {
+	AppName string
+	GUID    string
+}
+
+func (e DropletNotFoundError) Error() string {
+	switch {
+	case e.AppName != "":
+		return fmt.Sprintf("App %s does not have a current droplet", e.AppName)
+	case e.GUID != "":
+		return fmt.Sprintf("Droplet with GUID %s not found", e.GUID)
+	default:
+		return "Droplet not found"
+	}
+}
+
+// InvalidDropletStateError is returned when a droplet in the FAILED or EXPIRED
+// state is assigned as the current droplet of an application.
+type InvalidDropletStateError struct {
+	GUID  string
+	State DropletState
+}
+
+func (e InvalidDropletStateError) Error() string {
+	return fmt.Sprintf("Droplet %s is in %s state", e.GUID, e.State)
+}
+
+// SetApplicationDroplet sets the droplet for an application. Droplets in the
+// FAILED or EXPIRED state are refused.
 func (actor Actor) SetApplicationDroplet(appName string, spaceGUID string, dropletGUID string) (Warnings, error) {
 	allWarnings := Warnings{}
 	application, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
@@ -46,7 +77,25 @@ func (actor Actor) SetApplicationDroplet(appName string, spaceGUID string, dropl
 	if err != nil {
 		return allWarnings, err
 	}
-	_, apiWarnings, err := actor.CloudControllerClient.SetApplicationDroplet(application.GUID, dropletGUID)
+
+	droplet, apiWarnings, err := actor.CloudControllerClient.GetDroplet(dropletGUID)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.DropletNotFoundError); ok {
+			return allWarnings, DropletNotFoundError{GUID: dropletGUID}
+		}
+		return allWarnings, err
+	}
+
+	switch DropletState(droplet.State) {
+	case DropletStateFailed, DropletStateExpired:
+		return allWarnings, InvalidDropletStateError{
+			GUID:  dropletGUID,
+			State: DropletState(droplet.State),
+		}
+	}
+
+	_, apiWarnings, err = actor.CloudControllerClient.SetApplicationDroplet(application.GUID, dropletGUID)
 	actorWarnings := Warnings(apiWarnings)
 	allWarnings = append(allWarnings, actorWarnings...)
 
@@ -81,6 +130,35 @@ func (actor Actor) GetApplicationDroplets(appName string, spaceGUID string) ([]D
 	return droplets, allWarnings, err
 }
 
+// DownloadCurrentDropletByAppName returns the bits and GUID of the current
+// droplet of an application. If provided, the progressReader is used to
+// report the progress of the download.
+func (actor Actor) DownloadCurrentDropletByAppName(appName string, spaceGUID string, progressReader ProgressReader) ([]byte, string, Warnings, error) {
+	allWarnings := Warnings{}
+	application, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, "", allWarnings, err
+	}
+
+	droplet, apiWarnings, err := actor.CloudControllerClient.GetApplicationDropletCurrent(application.GUID)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.DropletNotFoundError); ok {
+			return nil, "", allWarnings, DropletNotFoundError{AppName: appName}
+		}
+		return nil, "", allWarnings, err
+	}
+
+	bits, apiWarnings, err := actor.CloudControllerClient.DownloadDroplet(droplet.GUID, progressReader)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return nil, "", allWarnings, err
+	}
+
+	return bits, droplet.GUID, allWarnings, nil
+}
+
 func (actor Actor) convertCCToActorDroplet(ccv3Droplet ccv3.Droplet) Droplet {
 	var buildpacks []Buildpack
 	for _, ccv3Buildpack := range ccv3Droplet.Buildpacks {
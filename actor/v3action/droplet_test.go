@@ -8,8 +8,10 @@ import (
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -35,6 +37,12 @@ var _ = Describe("Droplet Actions", func() {
 					nil,
 				)
 
+				fakeCloudControllerClient.GetDropletReturns(
+					ccv3.Droplet{GUID: "some-droplet-guid", State: ccv3.DropletStateStaged},
+					ccv3.Warnings{"get-droplet-warning"},
+					nil,
+				)
+
 				fakeCloudControllerClient.SetApplicationDropletReturns(
 					ccv3.Relationship{GUID: "some-droplet-guid"},
 					ccv3.Warnings{"set-application-droplet-warning"},
@@ -46,7 +54,10 @@ var _ = Describe("Droplet Actions", func() {
 				warnings, err := actor.SetApplicationDroplet("some-app-name", "some-space-guid", "some-droplet-guid")
 
 				Expect(err).ToNot(HaveOccurred())
-				Expect(warnings).To(ConsistOf("get-applications-warning", "set-application-droplet-warning"))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-droplet-warning", "set-application-droplet-warning"))
+
+				Expect(fakeCloudControllerClient.GetDropletCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
 
 				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
 				queryURL := fakeCloudControllerClient.GetApplicationsArgsForCall(0)
@@ -81,6 +92,55 @@ var _ = Describe("Droplet Actions", func() {
 			})
 		})
 
+		Context("when the droplet cannot be assigned", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						{GUID: "some-app-guid"},
+					},
+					ccv3.Warnings{"get-applications-warning"},
+					nil,
+				)
+			})
+
+			Context("when the droplet does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetDropletReturns(
+						ccv3.Droplet{},
+						ccv3.Warnings{"get-droplet-warning"},
+						ccerror.DropletNotFoundError{},
+					)
+				})
+
+				It("returns a DropletNotFoundError and all warnings", func() {
+					warnings, err := actor.SetApplicationDroplet("some-app-name", "some-space-guid", "some-droplet-guid")
+
+					Expect(err).To(MatchError(DropletNotFoundError{GUID: "some-droplet-guid"}))
+					Expect(warnings).To(ConsistOf("get-applications-warning", "get-droplet-warning"))
+					Expect(fakeCloudControllerClient.SetApplicationDropletCallCount()).To(Equal(0))
+				})
+			})
+
+			DescribeTable("when the droplet is in an unusable state",
+				func(state ccv3.DropletState) {
+					fakeCloudControllerClient.GetDropletReturns(
+						ccv3.Droplet{GUID: "some-droplet-guid", State: state},
+						ccv3.Warnings{"get-droplet-warning"},
+						nil,
+					)
+
+					warnings, err := actor.SetApplicationDroplet("some-app-name", "some-space-guid", "some-droplet-guid")
+
+					Expect(err).To(MatchError(InvalidDropletStateError{GUID: "some-droplet-guid", State: DropletState(state)}))
+					Expect(warnings).To(ConsistOf("get-applications-warning", "get-droplet-warning"))
+					Expect(fakeCloudControllerClient.SetApplicationDropletCallCount()).To(Equal(0))
+				},
+
+				Entry("FAILED", ccv3.DropletStateFailed),
+				Entry("EXPIRED", ccv3.DropletStateExpired),
+			)
+		})
+
 		Context("when setting the droplet fails", func() {
 			var expectedErr error
 			BeforeEach(func() {
@@ -256,4 +316,91 @@ var _ = Describe("Droplet Actions", func() {
 			})
 		})
 	})
+
+	Describe("DownloadCurrentDropletByAppName", func() {
+		var (
+			fakeProgressReader ProgressReader
+			bits               []byte
+			dropletGUID        string
+			warnings           Warnings
+			executeErr         error
+		)
+
+		BeforeEach(func() {
+			fakeProgressReader = new(cloudcontrollerfakes.FakeProgressReader)
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{
+					{GUID: "some-app-guid"},
+				},
+				ccv3.Warnings{"get-applications-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+				ccv3.Droplet{GUID: "some-droplet-guid"},
+				ccv3.Warnings{"get-current-droplet-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.DownloadDropletReturns(
+				[]byte("some-droplet-bits"),
+				ccv3.Warnings{"download-droplet-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			bits, dropletGUID, warnings, executeErr = actor.DownloadCurrentDropletByAppName("some-app-name", "some-space-guid", fakeProgressReader)
+		})
+
+		Context("when there are no client errors", func() {
+			It("returns the bits of the current droplet and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(bits).To(Equal([]byte("some-droplet-bits")))
+				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning", "download-droplet-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
+
+				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(1))
+				guid, progressReader := fakeCloudControllerClient.DownloadDropletArgsForCall(0)
+				Expect(guid).To(Equal("some-droplet-guid"))
+				Expect(progressReader).To(Equal(fakeProgressReader))
+			})
+		})
+
+		Context("when the app does not have a current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+					ccv3.Droplet{},
+					ccv3.Warnings{"get-current-droplet-warning"},
+					ccerror.DropletNotFoundError{},
+				)
+			})
+
+			It("returns a DropletNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(DropletNotFoundError{AppName: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning"))
+				Expect(fakeCloudControllerClient.DownloadDropletCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when downloading the droplet fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("download error")
+				fakeCloudControllerClient.DownloadDropletReturns(
+					nil,
+					ccv3.Warnings{"download-droplet-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-applications-warning", "get-current-droplet-warning", "download-droplet-warning"))
+			})
+		})
+	})
 })
@@ -3,16 +3,46 @@ package v3action
 import (
 	"fmt"
 	"net/url"
+	"sort"
+	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 )
 
 type IsolationSegmentSummary struct {
+	GUID         string
 	Name         string
 	EntitledOrgs []string
 }
 
+// SharedIsolationSegmentName is the name of the isolation segment apps run on
+// when neither their space nor their organization has one.
+const SharedIsolationSegmentName = "shared"
+
+// IsolationSegmentSource describes how a space gets its effective isolation
+// segment.
+type IsolationSegmentSource string
+
+const (
+	// IsolationSegmentAssignedToSpace means the isolation segment is assigned
+	// to the space itself.
+	IsolationSegmentAssignedToSpace IsolationSegmentSource = "space"
+	// IsolationSegmentOrganizationDefault means the space has no isolation
+	// segment and inherits its organization's default one.
+	IsolationSegmentOrganizationDefault IsolationSegmentSource = "org-default"
+	// IsolationSegmentShared means neither the space nor its organization has
+	// an isolation segment, so the shared one is used.
+	IsolationSegmentShared IsolationSegmentSource = "shared"
+)
+
+// SpaceIsolationSegment is the isolation segment a space's apps run on and
+// where it comes from.
+type SpaceIsolationSegment struct {
+	IsolationSegment
+	Source IsolationSegmentSource
+}
+
 // IsolationSegment represents a V3 actor IsolationSegment.
 type IsolationSegment ccv3.IsolationSegment
 
@@ -36,6 +66,19 @@ func (e IsolationSegmentAlreadyExistsError) Error() string {
 	return fmt.Sprintf("Isolation Segment '%s' already exists.", e.Name)
 }
 
+// IsolationSegmentAssignedToSpacesError is returned when revoking an
+// organization's entitlement to an isolation segment that some of its spaces
+// are still assigned to.
+type IsolationSegmentAssignedToSpacesError struct {
+	IsolationSegmentName string
+	OrganizationName     string
+	SpaceNames           []string
+}
+
+func (e IsolationSegmentAssignedToSpacesError) Error() string {
+	return fmt.Sprintf("Isolation Segment '%s' is still assigned to spaces in organization '%s': %s", e.IsolationSegmentName, e.OrganizationName, strings.Join(e.SpaceNames, ", "))
+}
+
 // GetEffectiveIsolationSegmentBySpace returns the space's effective isolation
 // segment.
 //
@@ -71,6 +114,56 @@ func (actor Actor) GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefa
 	return IsolationSegment(isolationSegment), allWarnings, err
 }
 
+// GetSpaceIsolationSegment returns the isolation segment the space's apps run
+// on. It is the one assigned to the space, else the organization's default
+// one, else the shared one; the Source of the result says which.
+func (actor Actor) GetSpaceIsolationSegment(spaceGUID string, orgGUID string) (SpaceIsolationSegment, Warnings, error) {
+	spaceRelationship, warnings, err := actor.CloudControllerClient.GetSpaceIsolationSegment(spaceGUID)
+	allWarnings := append(Warnings{}, warnings...)
+	if err != nil {
+		return SpaceIsolationSegment{}, allWarnings, err
+	}
+
+	effectiveGUID := spaceRelationship.GUID
+	source := IsolationSegmentAssignedToSpace
+	if effectiveGUID == "" {
+		orgRelationship, warnings, err := actor.CloudControllerClient.GetOrganizationDefaultIsolationSegment(orgGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SpaceIsolationSegment{}, allWarnings, err
+		}
+
+		effectiveGUID = orgRelationship.GUID
+		source = IsolationSegmentOrganizationDefault
+	}
+
+	if effectiveGUID == "" {
+		return SpaceIsolationSegment{
+			IsolationSegment: IsolationSegment{Name: SharedIsolationSegmentName},
+			Source:           IsolationSegmentShared,
+		}, allWarnings, nil
+	}
+
+	isolationSegments, warnings, err := actor.CloudControllerClient.GetIsolationSegments(url.Values{
+		ccv3.GUIDFilter: []string{effectiveGUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceIsolationSegment{}, allWarnings, err
+	}
+
+	for _, isolationSegment := range isolationSegments {
+		if isolationSegment.GUID == effectiveGUID {
+			return SpaceIsolationSegment{
+				IsolationSegment: IsolationSegment(isolationSegment),
+				Source:           source,
+			}, allWarnings, nil
+		}
+	}
+
+	return SpaceIsolationSegment{}, allWarnings, IsolationSegmentNotFoundError{Name: effectiveGUID}
+}
+
 // CreateIsolationSegmentByName creates a given isolation segment.
 func (actor Actor) CreateIsolationSegmentByName(isolationSegment IsolationSegment) (Warnings, error) {
 	_, warnings, err := actor.CloudControllerClient.CreateIsolationSegment(ccv3.IsolationSegment(isolationSegment))
@@ -107,10 +200,17 @@ func (actor Actor) EntitleIsolationSegmentToOrganizationByName(isolationSegmentN
 		return allWarnings, err
 	}
 
-	_, apiWarnings, err := actor.CloudControllerClient.EntitleIsolationSegmentToOrganizations(isolationSegment.GUID, []string{organization.GUID})
+	apiWarnings, err := actor.EntitleIsolationSegmentToOrganization(isolationSegment, organization)
 	return append(allWarnings, apiWarnings...), err
 }
 
+// EntitleIsolationSegmentToOrganization entitles the given organization to
+// use the isolation segment.
+func (actor Actor) EntitleIsolationSegmentToOrganization(isolationSegment IsolationSegment, org Organization) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.EntitleIsolationSegmentToOrganizations(isolationSegment.GUID, []string{org.GUID})
+	return Warnings(warnings), err
+}
+
 func (actor Actor) AssignIsolationSegmentToSpaceByNameAndSpace(isolationSegmentName string, spaceGUID string) (Warnings, error) {
 	seg, warnings, err := actor.GetIsolationSegmentByName(isolationSegmentName)
 	if err != nil {
@@ -147,6 +247,7 @@ func (actor Actor) GetIsolationSegmentSummaries() ([]IsolationSegmentSummary, Wa
 
 	for _, isolationSegment := range isolationSegments {
 		isolationSegmentSummary := IsolationSegmentSummary{
+			GUID:         isolationSegment.GUID,
 			Name:         isolationSegment.Name,
 			EntitledOrgs: []string{},
 		}
@@ -197,12 +298,62 @@ func (actor Actor) RevokeIsolationSegmentFromOrganizationByName(isolationSegment
 		return allWarnings, err
 	}
 
-	apiWarnings, err := actor.CloudControllerClient.RevokeIsolationSegmentFromOrganization(segment.GUID, org.GUID)
+	apiWarnings, err := actor.RevokeIsolationSegmentFromOrganization(segment, org)
 
 	allWarnings = append(allWarnings, apiWarnings...)
 	return allWarnings, err
 }
 
+// RevokeIsolationSegmentFromOrganization revokes the organization's
+// entitlement to the isolation segment. It refuses with an
+// IsolationSegmentAssignedToSpacesError while any space in the organization is
+// still assigned to the isolation segment.
+func (actor Actor) RevokeIsolationSegmentFromOrganization(isolationSegment IsolationSegment, org Organization) (Warnings, error) {
+	spaceNames, warnings, err := actor.getOrganizationSpacesAssignedToIsolationSegment(isolationSegment.GUID, org.GUID)
+	allWarnings := append(Warnings{}, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	if len(spaceNames) > 0 {
+		return allWarnings, IsolationSegmentAssignedToSpacesError{
+			IsolationSegmentName: isolationSegment.Name,
+			OrganizationName:     org.Name,
+			SpaceNames:           spaceNames,
+		}
+	}
+
+	apiWarnings, err := actor.CloudControllerClient.RevokeIsolationSegmentFromOrganization(isolationSegment.GUID, org.GUID)
+	return append(allWarnings, apiWarnings...), err
+}
+
+// getOrganizationSpacesAssignedToIsolationSegment returns the sorted names of
+// the organization's spaces that are assigned to the isolation segment.
+func (actor Actor) getOrganizationSpacesAssignedToIsolationSegment(isolationSegmentGUID string, orgGUID string) ([]string, Warnings, error) {
+	relationships, warnings, err := actor.CloudControllerClient.GetIsolationSegmentSpaces(isolationSegmentGUID)
+	allWarnings := append(Warnings{}, warnings...)
+	if err != nil || len(relationships.GUIDs) == 0 {
+		return nil, allWarnings, err
+	}
+
+	spaces, warnings, err := actor.CloudControllerClient.GetSpaces(url.Values{
+		ccv3.GUIDFilter:             []string{strings.Join(relationships.GUIDs, ",")},
+		ccv3.OrganizationGUIDFilter: []string{orgGUID},
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var spaceNames []string
+	for _, space := range spaces {
+		spaceNames = append(spaceNames, space.Name)
+	}
+	sort.Strings(spaceNames)
+
+	return spaceNames, allWarnings, nil
+}
+
 // SetOrganizationDefaultIsolationSegment sets a default isolation segment on
 // an organization.
 func (actor Actor) SetOrganizationDefaultIsolationSegment(orgGUID string, isoSegGUID string) (Warnings, error) {
@@ -409,6 +409,153 @@ var _ = Describe("Isolation Segment Actions", func() {
 		})
 	})
 
+	Describe("GetSpaceIsolationSegment", func() {
+		var (
+			spaceIsolationSegment SpaceIsolationSegment
+			warnings              Warnings
+			executeErr            error
+		)
+
+		JustBeforeEach(func() {
+			spaceIsolationSegment, warnings, executeErr = actor.GetSpaceIsolationSegment("some-space-guid", "some-org-guid")
+		})
+
+		Context("when the space has an isolation segment", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceIsolationSegmentReturns(
+					ccv3.Relationship{GUID: "some-iso-guid"},
+					ccv3.Warnings{"space-iso-warning"},
+					nil)
+				fakeCloudControllerClient.GetIsolationSegmentsReturns(
+					[]ccv3.IsolationSegment{{GUID: "some-iso-guid", Name: "some-iso"}},
+					ccv3.Warnings{"get-isos-warning"},
+					nil)
+			})
+
+			It("returns the space's isolation segment and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("space-iso-warning", "get-isos-warning"))
+				Expect(spaceIsolationSegment).To(Equal(SpaceIsolationSegment{
+					IsolationSegment: IsolationSegment{GUID: "some-iso-guid", Name: "some-iso"},
+					Source:           IsolationSegmentAssignedToSpace,
+				}))
+
+				Expect(fakeCloudControllerClient.GetSpaceIsolationSegmentArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetIsolationSegmentsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetIsolationSegmentsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.GUIDFilter: []string{"some-iso-guid"},
+				}))
+			})
+
+			Context("when the isolation segment is not in the list of isolation segments", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetIsolationSegmentsReturns(nil, ccv3.Warnings{"get-isos-warning"}, nil)
+				})
+
+				It("returns an IsolationSegmentNotFoundError and all warnings", func() {
+					Expect(executeErr).To(MatchError(IsolationSegmentNotFoundError{Name: "some-iso-guid"}))
+					Expect(warnings).To(ConsistOf("space-iso-warning", "get-isos-warning"))
+				})
+			})
+
+			Context("when listing the isolation segments fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetIsolationSegmentsReturns(nil, ccv3.Warnings{"get-isos-warning"}, errors.New("list error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("list error"))
+					Expect(warnings).To(ConsistOf("space-iso-warning", "get-isos-warning"))
+				})
+			})
+		})
+
+		Context("when the space does not have an isolation segment", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceIsolationSegmentReturns(
+					ccv3.Relationship{},
+					ccv3.Warnings{"space-iso-warning"},
+					nil)
+			})
+
+			Context("when the organization has a default isolation segment", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentReturns(
+						ccv3.Relationship{GUID: "some-default-iso-guid"},
+						ccv3.Warnings{"org-iso-warning"},
+						nil)
+					fakeCloudControllerClient.GetIsolationSegmentsReturns(
+						[]ccv3.IsolationSegment{{GUID: "some-default-iso-guid", Name: "some-default-iso"}},
+						ccv3.Warnings{"get-isos-warning"},
+						nil)
+				})
+
+				It("returns the organization's default isolation segment and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("space-iso-warning", "org-iso-warning", "get-isos-warning"))
+					Expect(spaceIsolationSegment).To(Equal(SpaceIsolationSegment{
+						IsolationSegment: IsolationSegment{GUID: "some-default-iso-guid", Name: "some-default-iso"},
+						Source:           IsolationSegmentOrganizationDefault,
+					}))
+
+					Expect(fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentArgsForCall(0)).To(Equal("some-org-guid"))
+					Expect(fakeCloudControllerClient.GetIsolationSegmentsArgsForCall(0)).To(Equal(url.Values{
+						ccv3.GUIDFilter: []string{"some-default-iso-guid"},
+					}))
+				})
+			})
+
+			Context("when the organization does not have a default isolation segment", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentReturns(
+						ccv3.Relationship{},
+						ccv3.Warnings{"org-iso-warning"},
+						nil)
+				})
+
+				It("returns the shared isolation segment and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("space-iso-warning", "org-iso-warning"))
+					Expect(spaceIsolationSegment).To(Equal(SpaceIsolationSegment{
+						IsolationSegment: IsolationSegment{Name: "shared"},
+						Source:           IsolationSegmentShared,
+					}))
+
+					Expect(fakeCloudControllerClient.GetIsolationSegmentsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting the organization's default isolation segment fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetOrganizationDefaultIsolationSegmentReturns(
+						ccv3.Relationship{},
+						ccv3.Warnings{"org-iso-warning"},
+						errors.New("org error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("org error"))
+					Expect(warnings).To(ConsistOf("space-iso-warning", "org-iso-warning"))
+				})
+			})
+		})
+
+		Context("when getting the space's isolation segment fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceIsolationSegmentReturns(
+					ccv3.Relationship{},
+					ccv3.Warnings{"space-iso-warning"},
+					errors.New("space error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("space error"))
+				Expect(warnings).To(ConsistOf("space-iso-warning"))
+			})
+		})
+	})
+
 	Describe("GetIsolationSegmentByName", func() {
 		Context("when the isolation segment exists", func() {
 			BeforeEach(func() {
@@ -548,10 +695,12 @@ var _ = Describe("Isolation Segment Actions", func() {
 					Expect(err).ToNot(HaveOccurred())
 					Expect(isoSummaries).To(ConsistOf([]IsolationSegmentSummary{
 						{
+							GUID:         "iso-guid-1",
 							Name:         "iso-seg-1",
 							EntitledOrgs: []string{},
 						},
 						{
+							GUID:         "iso-guid-2",
 							Name:         "iso-seg-2",
 							EntitledOrgs: []string{"iso-2-org-1", "iso-2-org-2"},
 						},
@@ -682,6 +831,132 @@ var _ = Describe("Isolation Segment Actions", func() {
 
 	})
 
+	Describe("EntitleIsolationSegmentToOrganization", func() {
+		It("entitles the organization and returns the warnings", func() {
+			fakeCloudControllerClient.EntitleIsolationSegmentToOrganizationsReturns(ccv3.RelationshipList{}, ccv3.Warnings{"entitle-warning"}, nil)
+
+			warnings, err := actor.EntitleIsolationSegmentToOrganization(IsolationSegment{GUID: "iso-guid"}, Organization{GUID: "org-guid"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("entitle-warning"))
+
+			Expect(fakeCloudControllerClient.EntitleIsolationSegmentToOrganizationsCallCount()).To(Equal(1))
+			isoGUID, orgGUIDs := fakeCloudControllerClient.EntitleIsolationSegmentToOrganizationsArgsForCall(0)
+			Expect(isoGUID).To(Equal("iso-guid"))
+			Expect(orgGUIDs).To(Equal([]string{"org-guid"}))
+		})
+	})
+
+	Describe("RevokeIsolationSegmentFromOrganization", func() {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = actor.RevokeIsolationSegmentFromOrganization(
+				IsolationSegment{Name: "iso-1", GUID: "iso-guid"},
+				Organization{Name: "org-1", GUID: "org-guid"},
+			)
+		})
+
+		Context("when no spaces are assigned to the isolation segment", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetIsolationSegmentSpacesReturns(ccv3.RelationshipList{}, ccv3.Warnings{"get-spaces-relationship-warning"}, nil)
+				fakeCloudControllerClient.RevokeIsolationSegmentFromOrganizationReturns(ccv3.Warnings{"revoke-warning"}, nil)
+			})
+
+			It("revokes the entitlement without looking up spaces", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-spaces-relationship-warning", "revoke-warning"))
+
+				Expect(fakeCloudControllerClient.GetIsolationSegmentSpacesArgsForCall(0)).To(Equal("iso-guid"))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.RevokeIsolationSegmentFromOrganizationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when spaces are assigned to the isolation segment", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetIsolationSegmentSpacesReturns(ccv3.RelationshipList{GUIDs: []string{"space-guid-1", "space-guid-2", "space-guid-3"}}, ccv3.Warnings{"get-spaces-relationship-warning"}, nil)
+			})
+
+			Context("when none of them are in the organization", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"get-spaces-warning"}, nil)
+					fakeCloudControllerClient.RevokeIsolationSegmentFromOrganizationReturns(ccv3.Warnings{"revoke-warning"}, nil)
+				})
+
+				It("revokes the entitlement", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-spaces-relationship-warning", "get-spaces-warning", "revoke-warning"))
+
+					Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(url.Values{
+						ccv3.GUIDFilter:             []string{"space-guid-1,space-guid-2,space-guid-3"},
+						ccv3.OrganizationGUIDFilter: []string{"org-guid"},
+					}))
+
+					Expect(fakeCloudControllerClient.RevokeIsolationSegmentFromOrganizationCallCount()).To(Equal(1))
+					isoGUID, orgGUID := fakeCloudControllerClient.RevokeIsolationSegmentFromOrganizationArgsForCall(0)
+					Expect(isoGUID).To(Equal("iso-guid"))
+					Expect(orgGUID).To(Equal("org-guid"))
+				})
+			})
+
+			Context("when some of them are in the organization", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpacesReturns([]ccv3.Space{
+						{Name: "space-b", GUID: "space-guid-1"},
+						{Name: "space-a", GUID: "space-guid-3"},
+					}, ccv3.Warnings{"get-spaces-warning"}, nil)
+				})
+
+				It("refuses to revoke the entitlement and lists the spaces", func() {
+					Expect(err).To(MatchError(IsolationSegmentAssignedToSpacesError{
+						IsolationSegmentName: "iso-1",
+						OrganizationName:     "org-1",
+						SpaceNames:           []string{"space-a", "space-b"},
+					}))
+					Expect(warnings).To(ConsistOf("get-spaces-relationship-warning", "get-spaces-warning"))
+
+					Expect(fakeCloudControllerClient.RevokeIsolationSegmentFromOrganizationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting the spaces fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get spaces error")
+					fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"get-spaces-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-spaces-relationship-warning", "get-spaces-warning"))
+
+					Expect(fakeCloudControllerClient.RevokeIsolationSegmentFromOrganizationCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when getting the isolation segment's spaces fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get relationship error")
+				fakeCloudControllerClient.GetIsolationSegmentSpacesReturns(ccv3.RelationshipList{}, ccv3.Warnings{"get-spaces-relationship-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-spaces-relationship-warning"))
+
+				Expect(fakeCloudControllerClient.RevokeIsolationSegmentFromOrganizationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("SetOrganizationDefaultIsolationSegment", func() {
 		Context("when the assignment is successful", func() {
 			BeforeEach(func() {
@@ -0,0 +1,95 @@
+package v3action
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// PollDeletionJob polls the deletion job at jobURL until it finishes. API
+// warnings, and the job's own warnings as the Cloud Controller reports them,
+// are sent on the warnings stream so that progress can be displayed while the
+// job runs. When the job fails, a DeletionFailedError listing the resources
+// that blocked the deletion is sent on the error stream.
+func (actor Actor) PollDeletionJob(jobURL string) (<-chan Warnings, <-chan error) {
+	warningsStream := make(chan Warnings)
+	errorStream := make(chan error)
+
+	go func() {
+		defer close(warningsStream)
+		defer close(errorStream)
+
+		var (
+			job          ccv3.Job
+			seenWarnings int
+		)
+
+		timeout := time.Now().Add(actor.Config.OverallPollingTimeout())
+		for time.Now().Before(timeout) {
+			var (
+				warnings ccv3.Warnings
+				err      error
+			)
+			job, warnings, err = actor.CloudControllerClient.GetJob(jobURL)
+
+			allWarnings := Warnings(warnings)
+			for ; seenWarnings < len(job.Warnings); seenWarnings++ {
+				allWarnings = append(allWarnings, job.Warnings[seenWarnings].Detail)
+			}
+			warningsStream <- allWarnings
+
+			if err != nil {
+				errorStream <- err
+				return
+			}
+
+			switch {
+			case job.Failed():
+				errorStream <- actionerror.DeletionFailedError{
+					JobGUID: job.GUID,
+					Reasons: deletionFailureReasons(job.Errors),
+				}
+				return
+			case job.Complete():
+				return
+			}
+
+			time.Sleep(actor.Config.PollingInterval())
+		}
+
+		errorStream <- ccerror.JobTimeoutError{
+			JobGUID: job.GUID,
+			Timeout: actor.Config.OverallPollingTimeout(),
+		}
+	}()
+
+	return warningsStream, errorStream
+}
+
+// deletionFailureReasons returns the resources that blocked a deletion. The
+// Cloud Controller nests the failures of the spaces inside an org, and of the
+// resources inside a space, under summary lines that only say the parent
+// could not be deleted; those are dropped so that only the blocking
+// resources remain.
+func deletionFailureReasons(jobErrors []ccv3.ErrorDetails) []string {
+	var reasons []string
+	for _, jobError := range jobErrors {
+		var leaves []string
+		for _, line := range strings.Split(jobError.Detail, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasSuffix(line, "one or more resources within could not be deleted.") {
+				continue
+			}
+			leaves = append(leaves, line)
+		}
+
+		if len(leaves) == 0 {
+			leaves = []string{strings.TrimSpace(jobError.Detail)}
+		}
+		reasons = append(reasons, leaves...)
+	}
+	return reasons
+}
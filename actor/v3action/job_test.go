@@ -0,0 +1,158 @@
+package v3action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Job Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		fakeConfig.OverallPollingTimeoutReturns(time.Minute)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+	})
+
+	Describe("PollDeletionJob", func() {
+		var (
+			warnings []Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings = nil
+			err = nil
+
+			warningsStream, errorStream := actor.PollDeletionJob("/v3/jobs/some-job-guid")
+			for warningsStream != nil || errorStream != nil {
+				select {
+				case w, ok := <-warningsStream:
+					if !ok {
+						warningsStream = nil
+						continue
+					}
+					warnings = append(warnings, w)
+				case e, ok := <-errorStream:
+					if !ok {
+						errorStream = nil
+						continue
+					}
+					err = e
+				}
+			}
+		})
+
+		Context("when the job completes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturnsOnCall(0, ccv3.Job{
+					GUID:     "some-job-guid",
+					State:    ccv3.JobStateProcessing,
+					Warnings: []ccv3.JobWarning{{Detail: "deleting apps"}},
+				}, ccv3.Warnings{"api-warning-1"}, nil)
+				fakeCloudControllerClient.GetJobReturnsOnCall(1, ccv3.Job{
+					GUID:  "some-job-guid",
+					State: ccv3.JobStateComplete,
+					Warnings: []ccv3.JobWarning{
+						{Detail: "deleting apps"},
+						{Detail: "deleting service instances"},
+					},
+				}, ccv3.Warnings{"api-warning-2"}, nil)
+			})
+
+			It("streams each new job warning once, as it arrives", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(Equal([]Warnings{
+					{"api-warning-1", "deleting apps"},
+					{"api-warning-2", "deleting service instances"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetJobCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetJobArgsForCall(0)).To(Equal("/v3/jobs/some-job-guid"))
+				Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the job fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetJobReturns(ccv3.Job{
+					GUID:  "some-job-guid",
+					State: ccv3.JobStateFailed,
+					Errors: []ccv3.ErrorDetails{{
+						Detail: "Deletion of organization some-org failed because one or more resources within could not be deleted.\n\n" +
+							"\tDeletion of space some-space failed because one or more resources within could not be deleted.\n\n" +
+							"\tThe service broker rejected the request to delete service instance some-instance.\n" +
+							"\tThe service broker rejected the request to delete service instance other-instance.",
+					}},
+				}, ccv3.Warnings{"api-warning"}, nil)
+			})
+
+			It("returns a DeletionFailedError listing the blocking resources", func() {
+				Expect(err).To(MatchError(actionerror.DeletionFailedError{
+					JobGUID: "some-job-guid",
+					Reasons: []string{
+						"The service broker rejected the request to delete service instance some-instance.",
+						"The service broker rejected the request to delete service instance other-instance.",
+					},
+				}))
+				Expect(warnings).To(Equal([]Warnings{{"api-warning"}}))
+			})
+
+			Context("when the error has no nested resources", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetJobReturns(ccv3.Job{
+						GUID:   "some-job-guid",
+						State:  ccv3.JobStateFailed,
+						Errors: []ccv3.ErrorDetails{{Detail: "something went wrong"}},
+					}, nil, nil)
+				})
+
+				It("returns the error detail as the reason", func() {
+					Expect(err).To(MatchError(actionerror.DeletionFailedError{
+						JobGUID: "some-job-guid",
+						Reasons: []string{"something went wrong"},
+					}))
+				})
+			})
+		})
+
+		Context("when getting the job fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get job failed")
+				fakeCloudControllerClient.GetJobReturns(ccv3.Job{}, ccv3.Warnings{"api-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(Equal([]Warnings{{"api-warning"}}))
+			})
+		})
+
+		Context("when the polling timeout is reached", func() {
+			BeforeEach(func() {
+				fakeConfig.OverallPollingTimeoutReturns(0)
+			})
+
+			It("returns a JobTimeoutError", func() {
+				Expect(err).To(MatchError(ccerror.JobTimeoutError{}))
+				Expect(fakeCloudControllerClient.GetJobCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package v3action
 import (
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
 )
@@ -60,7 +61,14 @@ func NewLogMessage(message string, messageType int, timestamp time.Time, sourceT
 	}
 }
 
+// GetStreamingLogs tails the logs of the given application. When client is
+// nil, because the platform has no logging endpoint, both returned channels
+// are nil so callers selecting on them simply never receive anything.
 func (Actor) GetStreamingLogs(appGUID string, client NOAAClient) (<-chan *LogMessage, <-chan error) {
+	if client == nil {
+		return nil, nil
+	}
+
 	// Do not pass in token because client should have a TokenRefresher set
 	eventStream, errStream := client.TailingLogs(appGUID, "")
 
@@ -106,6 +114,10 @@ func (Actor) GetStreamingLogs(appGUID string, client NOAAClient) (<-chan *LogMes
 }
 
 func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient) (<-chan *LogMessage, <-chan error, Warnings, error) {
+	if client == nil {
+		return nil, nil, nil, actionerror.LoggingUnavailableError{}
+	}
+
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return nil, nil, allWarnings, err
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -329,4 +330,19 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+	Context("when there is no logging client", func() {
+		It("GetStreamingLogs returns nil channels", func() {
+			messages, errs := actor.GetStreamingLogs("some-app-guid", nil)
+			Expect(messages).To(BeNil())
+			Expect(errs).To(BeNil())
+		})
+
+		It("GetStreamingLogsForApplicationByNameAndSpace returns a LoggingUnavailableError", func() {
+			messages, logErrs, _, err := actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", nil)
+			Expect(err).To(MatchError(actionerror.LoggingUnavailableError{}))
+			Expect(messages).To(BeNil())
+			Expect(logErrs).To(BeNil())
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+		})
+	})
 })
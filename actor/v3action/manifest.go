@@ -0,0 +1,17 @@
+package v3action
+
+// ApplyApplicationManifest applies the raw manifest to the apps in the space
+// and waits for the resulting job to finish.
+func (actor Actor) ApplyApplicationManifest(spaceGUID string, rawManifest []byte) (Warnings, error) {
+	var allWarnings Warnings
+
+	jobURL, warnings, err := actor.CloudControllerClient.UpdateSpaceApplyManifest(spaceGUID, rawManifest)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	pollWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, pollWarnings...)
+	return allWarnings, err
+}
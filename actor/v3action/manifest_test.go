@@ -0,0 +1,89 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("ApplyApplicationManifest", func() {
+		var (
+			rawManifest []byte
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			rawManifest = []byte("applications:\n- name: some-app\n")
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplyApplicationManifest("some-space-guid", rawManifest)
+		})
+
+		Context("when applying the manifest succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateSpaceApplyManifestReturns("some-job-url", ccv3.Warnings{"apply-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+			})
+
+			It("applies the manifest and waits for the job", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("apply-warning", "poll-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateSpaceApplyManifestCallCount()).To(Equal(1))
+				spaceGUID, passedManifest := fakeCloudControllerClient.UpdateSpaceApplyManifestArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(passedManifest).To(Equal(rawManifest))
+
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal("some-job-url"))
+			})
+		})
+
+		Context("when applying the manifest fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("apply error")
+				fakeCloudControllerClient.UpdateSpaceApplyManifestReturns("", ccv3.Warnings{"apply-warning"}, expectedErr)
+			})
+
+			It("returns the error and the warnings without polling", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("apply-warning"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the job fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("job error")
+				fakeCloudControllerClient.UpdateSpaceApplyManifestReturns("some-job-url", ccv3.Warnings{"apply-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("apply-warning", "poll-warning"))
+			})
+		})
+	})
+})
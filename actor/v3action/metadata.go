@@ -0,0 +1,97 @@
+package v3action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Metadata represents the user-defined metadata of a V3 resource.
+type Metadata ccv3.Metadata
+
+// GetOrganizationLabels returns the labels of the organization with the given
+// name.
+func (actor Actor) GetOrganizationLabels(orgName string) (map[string]types.NullString, Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return nil, warnings, err
+	}
+	return labelsOf((*Metadata)(org.Metadata)), warnings, nil
+}
+
+// GetSpaceLabels returns the labels of the space with the given name in the
+// given organization.
+func (actor Actor) GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, Warnings, error) {
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+	return labelsOf(space.Metadata), warnings, nil
+}
+
+// GetApplicationLabels returns the labels of the application with the given
+// name in the given space.
+func (actor Actor) GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+	return labelsOf(app.Metadata), warnings, nil
+}
+
+// UpdateOrganizationLabelsByOrganizationName sets the given labels on the
+// organization with the given name. Labels that are not set are removed;
+// labels that are not given are left alone.
+func (actor Actor) UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, updateWarnings, err := actor.CloudControllerClient.UpdateOrganizationMetadata(org.GUID, ccv3.Metadata{Labels: labels})
+	allWarnings = append(allWarnings, updateWarnings...)
+	return allWarnings, err
+}
+
+// UpdateSpaceLabelsBySpaceName sets the given labels on the space with the
+// given name in the given organization. Labels that are not set are removed;
+// labels that are not given are left alone.
+func (actor Actor) UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (Warnings, error) {
+	var allWarnings Warnings
+
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, updateWarnings, err := actor.CloudControllerClient.UpdateSpaceMetadata(space.GUID, ccv3.Metadata{Labels: labels})
+	allWarnings = append(allWarnings, updateWarnings...)
+	return allWarnings, err
+}
+
+// UpdateApplicationLabelsByApplicationName sets the given labels on the
+// application with the given name in the given space. Labels that are not set
+// are removed; labels that are not given are left alone.
+func (actor Actor) UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, updateWarnings, err := actor.CloudControllerClient.UpdateApplicationMetadata(app.GUID, ccv3.Metadata{Labels: labels})
+	allWarnings = append(allWarnings, updateWarnings...)
+	return allWarnings, err
+}
+
+func labelsOf(metadata *Metadata) map[string]types.NullString {
+	if metadata == nil || metadata.Labels == nil {
+		return map[string]types.NullString{}
+	}
+	return metadata.Labels
+}
@@ -0,0 +1,354 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Metadata Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		labels                    map[string]types.NullString
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+		labels = map[string]types.NullString{
+			"env":  types.NewNullString("prod"),
+			"tier": {},
+		}
+	})
+
+	Describe("GetOrganizationLabels", func() {
+		Context("when the org has labels", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{{
+						Name:     "some-org",
+						GUID:     "some-org-guid",
+						Metadata: &ccv3.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("prod")}},
+					}},
+					ccv3.Warnings{"get-org-warning"},
+					nil,
+				)
+			})
+
+			It("returns the labels and all warnings", func() {
+				orgLabels, warnings, err := actor.GetOrganizationLabels("some-org")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+				Expect(orgLabels).To(Equal(map[string]types.NullString{"env": types.NewNullString("prod")}))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.NameFilter: []string{"some-org"},
+				}))
+			})
+		})
+
+		Context("when the org has no metadata", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns([]ccv3.Organization{{Name: "some-org"}}, nil, nil)
+			})
+
+			It("returns no labels", func() {
+				orgLabels, _, err := actor.GetOrganizationLabels("some-org")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(orgLabels).To(BeEmpty())
+			})
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError and all warnings", func() {
+				_, warnings, err := actor.GetOrganizationLabels("some-org")
+				Expect(err).To(MatchError(OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+			})
+		})
+	})
+
+	Describe("GetSpaceLabels", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{
+					Name:     "some-space",
+					GUID:     "some-space-guid",
+					Metadata: &ccv3.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("dev")}},
+				}},
+				ccv3.Warnings{"get-space-warning"},
+				nil,
+			)
+		})
+
+		It("returns the labels of the space in the org", func() {
+			spaceLabels, warnings, err := actor.GetSpaceLabels("some-space", "some-org-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-space-warning"))
+			Expect(spaceLabels).To(Equal(map[string]types.NullString{"env": types.NewNullString("dev")}))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(url.Values{
+				ccv3.NameFilter:             []string{"some-space"},
+				ccv3.OrganizationGUIDFilter: []string{"some-org-guid"},
+			}))
+		})
+	})
+
+	Describe("GetApplicationLabels", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv3.Application{{
+					Name:     "some-app",
+					GUID:     "some-app-guid",
+					Metadata: &ccv3.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("dev")}},
+				}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+		})
+
+		It("returns the labels of the app in the space", func() {
+			appLabels, warnings, err := actor.GetApplicationLabels("some-app", "some-space-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning"))
+			Expect(appLabels).To(Equal(map[string]types.NullString{"env": types.NewNullString("dev")}))
+		})
+	})
+
+	Describe("UpdateOrganizationLabelsByOrganizationName", func() {
+		Context("when the org exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{{Name: "some-org", GUID: "some-org-guid"}},
+					ccv3.Warnings{"get-org-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateOrganizationMetadataReturns(
+					ccv3.Metadata{},
+					ccv3.Warnings{"update-warning"},
+					nil,
+				)
+			})
+
+			It("updates the labels of the org and returns all warnings", func() {
+				warnings, err := actor.UpdateOrganizationLabelsByOrganizationName("some-org", labels)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateOrganizationMetadataCallCount()).To(Equal(1))
+				orgGUID, metadata := fakeCloudControllerClient.UpdateOrganizationMetadataArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(metadata).To(Equal(ccv3.Metadata{Labels: labels}))
+			})
+		})
+
+		Context("when updating the labels fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("invalid label")
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{{Name: "some-org", GUID: "some-org-guid"}},
+					ccv3.Warnings{"get-org-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateOrganizationMetadataReturns(
+					ccv3.Metadata{},
+					ccv3.Warnings{"update-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.UpdateOrganizationLabelsByOrganizationName("some-org", labels)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-org-warning", "update-warning"))
+			})
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("does not update any labels", func() {
+				warnings, err := actor.UpdateOrganizationLabelsByOrganizationName("some-org", labels)
+				Expect(err).To(MatchError(OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+				Expect(fakeCloudControllerClient.UpdateOrganizationMetadataCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceLabelsBySpaceName", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{Name: "some-space", GUID: "some-space-guid"}},
+				ccv3.Warnings{"get-space-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateSpaceMetadataReturns(ccv3.Metadata{}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates the labels of the space and returns all warnings", func() {
+			warnings, err := actor.UpdateSpaceLabelsBySpaceName("some-space", "some-org-guid", labels)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-space-warning", "update-warning"))
+
+			spaceGUID, metadata := fakeCloudControllerClient.UpdateSpaceMetadataArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(metadata).To(Equal(ccv3.Metadata{Labels: labels}))
+		})
+	})
+
+	Describe("UpdateApplicationLabelsByApplicationName", func() {
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateApplicationMetadataReturns(ccv3.Metadata{}, ccv3.Warnings{"update-warning"}, nil)
+			})
+
+			It("updates the labels of the app and returns all warnings", func() {
+				warnings, err := actor.UpdateApplicationLabelsByApplicationName("some-app", "some-space-guid", labels)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "update-warning"))
+
+				appGUID, metadata := fakeCloudControllerClient.UpdateApplicationMetadataArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(metadata).To(Equal(ccv3.Metadata{Labels: labels}))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				_, err := actor.UpdateApplicationLabelsByApplicationName("some-app", "some-space-guid", labels)
+				Expect(err).To(MatchError(ApplicationNotFoundError{Name: "some-app"}))
+				Expect(fakeCloudControllerClient.UpdateApplicationMetadataCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetOrganizationsByLabelSelector", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv3.Organization{{Name: "org-1", GUID: "org-guid-1"}},
+				ccv3.Warnings{"get-orgs-warning"},
+				nil,
+			)
+		})
+
+		It("passes the selector and orders the orgs by name", func() {
+			orgs, warnings, err := actor.GetOrganizationsByLabelSelector("env in (prod,staging)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-orgs-warning"))
+			Expect(orgs).To(Equal([]Organization{{Name: "org-1", GUID: "org-guid-1"}}))
+
+			Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(Equal(url.Values{
+				ccv3.OrderBy:             []string{ccv3.NameOrder},
+				ccv3.LabelSelectorFilter: []string{"env in (prod,staging)"},
+			}))
+		})
+
+		It("does not filter when the selector is empty", func() {
+			_, _, err := actor.GetOrganizationsByLabelSelector("")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(Equal(url.Values{
+				ccv3.OrderBy: []string{ccv3.NameOrder},
+			}))
+		})
+	})
+
+	Describe("GetSpacesByOrganizationAndLabelSelector", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{Name: "space-1", GUID: "space-guid-1"}},
+				ccv3.Warnings{"get-spaces-warning"},
+				nil,
+			)
+		})
+
+		It("passes the org and the selector", func() {
+			spaces, warnings, err := actor.GetSpacesByOrganizationAndLabelSelector("some-org-guid", "env=prod")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-spaces-warning"))
+			Expect(spaces).To(Equal([]Space{{Name: "space-1", GUID: "space-guid-1"}}))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(url.Values{
+				ccv3.OrganizationGUIDFilter: []string{"some-org-guid"},
+				ccv3.OrderBy:                []string{ccv3.NameOrder},
+				ccv3.LabelSelectorFilter:    []string{"env=prod"},
+			}))
+		})
+	})
+
+	Describe("GetApplicationsBySpaceAndLabelSelector", func() {
+		Context("when getting the apps succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{
+						Name:     "app-1",
+						GUID:     "app-guid-1",
+						State:    "STARTED",
+						Metadata: &ccv3.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("prod")}},
+					}},
+					ccv3.Warnings{"get-apps-warning"},
+					nil,
+				)
+			})
+
+			It("passes the space and the selector and returns the apps with their labels", func() {
+				apps, warnings, err := actor.GetApplicationsBySpaceAndLabelSelector("some-space-guid", "env=prod")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+				Expect(apps).To(Equal([]Application{{
+					Name:      "app-1",
+					GUID:      "app-guid-1",
+					State:     "STARTED",
+					SpaceGUID: "some-space-guid",
+					Metadata:  &Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("prod")}},
+				}}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.SpaceGUIDFilter:     []string{"some-space-guid"},
+					ccv3.OrderBy:             []string{ccv3.NameOrder},
+					ccv3.LabelSelectorFilter: []string{"env=prod"},
+				}))
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("bad selector")
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationsBySpaceAndLabelSelector("some-space-guid", "env=prod")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+			})
+		})
+	})
+})
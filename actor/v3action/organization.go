@@ -3,6 +3,7 @@ package v3action
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 )
@@ -20,6 +21,16 @@ func (e OrganizationNotFoundError) Error() string {
 	return fmt.Sprintf("Organization '%s' not found.", e.Name)
 }
 
+// OrganizationsNotFoundError represents the error that occurs when some of
+// the requested organizations are not found.
+type OrganizationsNotFoundError struct {
+	Names []string
+}
+
+func (e OrganizationsNotFoundError) Error() string {
+	return fmt.Sprintf("Organizations not found: %s", strings.Join(e.Names, ", "))
+}
+
 // GetOrganizationByName returns the organization with the given name.
 func (actor Actor) GetOrganizationByName(name string) (Organization, Warnings, error) {
 	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(url.Values{
@@ -35,3 +46,90 @@ func (actor Actor) GetOrganizationByName(name string) (Organization, Warnings, e
 
 	return Organization(orgs[0]), Warnings(warnings), nil
 }
+
+// GetOrganizationsByLabelSelector returns the organizations that match the
+// label selector, ordered by name. An empty selector matches all
+// organizations.
+func (actor Actor) GetOrganizationsByLabelSelector(labelSelector string) ([]Organization, Warnings, error) {
+	query := url.Values{
+		ccv3.OrderBy: []string{ccv3.NameOrder},
+	}
+	if labelSelector != "" {
+		query.Set(ccv3.LabelSelectorFilter, labelSelector)
+	}
+
+	ccv3Orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(query)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	orgs := make([]Organization, len(ccv3Orgs))
+	for i, ccv3Org := range ccv3Orgs {
+		orgs[i] = Organization(ccv3Org)
+	}
+	return orgs, Warnings(warnings), nil
+}
+
+// DeleteOrganization starts the asynchronous deletion of the organization
+// with the given name and returns the URL of the deletion job. Use
+// PollDeletionJob to follow its progress.
+func (actor Actor) DeleteOrganization(orgName string) (string, Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	jobURL, deleteWarnings, err := actor.CloudControllerClient.DeleteOrganization(org.GUID)
+	allWarnings = append(allWarnings, deleteWarnings...)
+	return jobURL, allWarnings, err
+}
+
+// GetOrganizationsByNames returns the organizations with the given names, in
+// the order given. If any of them do not exist, it returns an
+// OrganizationsNotFoundError naming all of the missing organizations.
+func (actor Actor) GetOrganizationsByNames(names []string) ([]Organization, Warnings, error) {
+	var (
+		allWarnings Warnings
+		orgs        []Organization
+		missing     []string
+	)
+
+	for _, name := range names {
+		org, warnings, err := actor.GetOrganizationByName(name)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(OrganizationNotFoundError); ok {
+			missing = append(missing, name)
+			continue
+		}
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		orgs = append(orgs, org)
+	}
+
+	if len(missing) > 0 {
+		return nil, allWarnings, OrganizationsNotFoundError{Names: missing}
+	}
+
+	return orgs, allWarnings, nil
+}
+
+// GetOrganizationsByGUIDs returns the organizations with the given GUIDs.
+// Organizations the user cannot see are left out.
+func (actor Actor) GetOrganizationsByGUIDs(orgGUIDs ...string) ([]Organization, Warnings, error) {
+	ccv3Orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(url.Values{
+		ccv3.GUIDFilter: []string{strings.Join(orgGUIDs, ",")},
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	orgs := make([]Organization, len(ccv3Orgs))
+	for i, ccv3Org := range ccv3Orgs {
+		orgs[i] = Organization(ccv3Org)
+	}
+	return orgs, Warnings(warnings), nil
+}
@@ -102,4 +102,164 @@ var _ = Describe("Organization Actions", func() {
 			Expect(query).To(Equal(expectedQuery))
 		})
 	})
+
+	Describe("GetOrganizationsByNames", func() {
+		var (
+			orgs     []Organization
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			orgs, warnings, err = actor.GetOrganizationsByNames([]string{"org-1", "org-2", "org-3"})
+		})
+
+		Context("when all the orgs exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsStub = func(query url.Values) ([]ccv3.Organization, ccv3.Warnings, error) {
+					name := query.Get(ccv3.NameFilter)
+					return []ccv3.Organization{{Name: name, GUID: name + "-guid"}}, ccv3.Warnings{name + "-warning"}, nil
+				}
+			})
+
+			It("returns the orgs in the order given and all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(orgs).To(Equal([]Organization{
+					{Name: "org-1", GUID: "org-1-guid"},
+					{Name: "org-2", GUID: "org-2-guid"},
+					{Name: "org-3", GUID: "org-3-guid"},
+				}))
+				Expect(warnings).To(ConsistOf("org-1-warning", "org-2-warning", "org-3-warning"))
+			})
+		})
+
+		Context("when some of the orgs do not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsStub = func(query url.Values) ([]ccv3.Organization, ccv3.Warnings, error) {
+					name := query.Get(ccv3.NameFilter)
+					if name == "org-2" {
+						return []ccv3.Organization{{Name: name, GUID: name + "-guid"}}, ccv3.Warnings{name + "-warning"}, nil
+					}
+					return nil, ccv3.Warnings{name + "-warning"}, nil
+				}
+			})
+
+			It("returns an OrganizationsNotFoundError naming every missing org", func() {
+				Expect(err).To(MatchError(OrganizationsNotFoundError{Names: []string{"org-1", "org-3"}}))
+				Expect(orgs).To(BeNil())
+				Expect(warnings).To(ConsistOf("org-1-warning", "org-2-warning", "org-3-warning"))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"some-warning"}, expectedError)
+			})
+
+			It("stops and returns the warnings and the error", func() {
+				Expect(err).To(MatchError(expectedError))
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("GetOrganizationsByGUIDs", func() {
+		Context("when the orgs exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{{Name: "org-1", GUID: "org-1-guid"}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the orgs and warnings", func() {
+				orgs, warnings, err := actor.GetOrganizationsByGUIDs("org-1-guid", "org-2-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(orgs).To(Equal([]Organization{{Name: "org-1", GUID: "org-1-guid"}}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(Equal(url.Values{
+					ccv3.GUIDFilter: []string{"org-1-guid,org-2-guid"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the warnings and the error", func() {
+				_, warnings, err := actor.GetOrganizationsByGUIDs("org-1-guid")
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteOrganization", func() {
+		Context("when the org exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{{Name: "some-org-name", GUID: "some-org-guid"}},
+					ccv3.Warnings{"get-org-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.DeleteOrganizationReturns(
+					"/v3/jobs/some-job-guid",
+					ccv3.Warnings{"delete-org-warning"},
+					nil,
+				)
+			})
+
+			It("starts deleting the org and returns the job URL and all warnings", func() {
+				jobURL, warnings, err := actor.DeleteOrganization("some-org-name")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal("/v3/jobs/some-job-guid"))
+				Expect(warnings).To(ConsistOf("get-org-warning", "delete-org-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteOrganizationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+			})
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError and does not delete anything", func() {
+				_, warnings, err := actor.DeleteOrganization("some-org-name")
+				Expect(err).To(MatchError(OrganizationNotFoundError{Name: "some-org-name"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+				Expect(fakeCloudControllerClient.DeleteOrganizationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when deleting the org fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete failed")
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{{Name: "some-org-name", GUID: "some-org-guid"}},
+					ccv3.Warnings{"get-org-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.DeleteOrganizationReturns("", ccv3.Warnings{"delete-org-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.DeleteOrganization("some-org-name")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-org-warning", "delete-org-warning"))
+			})
+		})
+	})
 })
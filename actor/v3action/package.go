@@ -152,6 +152,35 @@ func (actor Actor) createAndUploadBitsPackageByApplicationNameAndSpace(appName s
 	return Package(pkg), allWarnings, err
 }
 
+// NoReadyPackageError is returned when an application has no package that
+// can be staged.
+type NoReadyPackageError struct {
+	AppName string
+}
+
+func (e NoReadyPackageError) Error() string {
+	return fmt.Sprintf("App %s has no package ready to stage", e.AppName)
+}
+
+// GetNewestReadyPackageForApplication returns the most recently created
+// package of an app that is ready to be staged.
+func (actor Actor) GetNewestReadyPackageForApplication(app Application) (Package, Warnings, error) {
+	ccv3Packages, warnings, err := actor.CloudControllerClient.GetPackages(url.Values{
+		ccv3.AppGUIDFilter: []string{app.GUID},
+		ccv3.StatesFilter:  []string{string(ccv3.PackageStateReady)},
+		ccv3.OrderBy:       []string{ccv3.CreatedAtDescendingOrder},
+	})
+	if err != nil {
+		return Package{}, Warnings(warnings), err
+	}
+
+	if len(ccv3Packages) == 0 {
+		return Package{}, Warnings(warnings), NoReadyPackageError{AppName: app.Name}
+	}
+
+	return Package(ccv3Packages[0]), Warnings(warnings), nil
+}
+
 // GetApplicationPackages returns a list of package of an app.
 func (actor *Actor) GetApplicationPackages(appName string, spaceGUID string) ([]Package, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
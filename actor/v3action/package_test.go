@@ -41,6 +41,58 @@ var _ = Describe("Package Actions", func() {
 		actor = NewActor(fakeCloudControllerClient, fakeConfig)
 	})
 
+	Describe("GetNewestReadyPackageForApplication", func() {
+		Context("when the app has a ready package", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(
+					[]ccv3.Package{{GUID: "some-package-guid", State: ccv3.PackageStateReady}},
+					ccv3.Warnings{"get-packages-warning"},
+					nil,
+				)
+			})
+
+			It("returns the newest ready package", func() {
+				pkg, warnings, err := actor.GetNewestReadyPackageForApplication(Application{Name: "some-app", GUID: "some-app-guid"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+				Expect(pkg).To(Equal(Package{GUID: "some-package-guid", State: ccv3.PackageStateReady}))
+
+				Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(Equal(url.Values{
+					ccv3.AppGUIDFilter: []string{"some-app-guid"},
+					ccv3.StatesFilter:  []string{"READY"},
+					ccv3.OrderBy:       []string{"-created_at"},
+				}))
+			})
+		})
+
+		Context("when the app has no ready package", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"get-packages-warning"}, nil)
+			})
+
+			It("returns a NoReadyPackageError", func() {
+				_, warnings, err := actor.GetNewestReadyPackageForApplication(Application{Name: "some-app", GUID: "some-app-guid"})
+				Expect(err).To(MatchError(NoReadyPackageError{AppName: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+			})
+		})
+
+		Context("when getting the packages fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get packages error")
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"get-packages-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetNewestReadyPackageForApplication(Application{Name: "some-app", GUID: "some-app-guid"})
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+			})
+		})
+	})
+
 	Describe("GetApplicationPackages", func() {
 		Context("when there are no client errors", func() {
 			BeforeEach(func() {
@@ -2,6 +2,7 @@ package v3action
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -13,6 +14,8 @@ type Process ccv3.Process
 // ProcessNotFoundError is returned when the proccess type cannot be found
 type ProcessNotFoundError struct {
 	ProcessType string
+	// ValidProcessTypes is set when the types the app does have are known.
+	ValidProcessTypes []string
 }
 
 func (e ProcessNotFoundError) Error() string {
@@ -31,3 +34,91 @@ func (actor Actor) ScaleProcessByApplication(appGUID string, process Process) (W
 
 	return allWarnings, nil
 }
+
+// GetApplicationProcessTypes returns the process types of an application,
+// with web first and the rest in alphabetical order.
+func (actor Actor) GetApplicationProcessTypes(appGUID string) ([]string, Warnings, error) {
+	ccv3Processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var processSummaries ProcessSummaries
+	for _, ccv3Process := range ccv3Processes {
+		processSummaries = append(processSummaries, ProcessSummary{Process: Process(ccv3Process)})
+	}
+	processSummaries.Sort()
+
+	var processTypes []string
+	for _, processSummary := range processSummaries {
+		processTypes = append(processTypes, processSummary.Type)
+	}
+
+	return processTypes, allWarnings, nil
+}
+
+// RestartApplicationProcess terminates every instance of the given process
+// type and waits for them to be recreated. The application's other processes
+// are left running.
+func (actor Actor) RestartApplicationProcess(appGUID string, processType string, warningsChannel chan<- Warnings) error {
+	ccv3Processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
+	warningsChannel <- Warnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var (
+		process    ccv3.Process
+		found      bool
+		validTypes ProcessSummaries
+	)
+	for _, ccv3Process := range ccv3Processes {
+		if ccv3Process.Type == processType {
+			process = ccv3Process
+			found = true
+		}
+		validTypes = append(validTypes, ProcessSummary{Process: Process(ccv3Process)})
+	}
+
+	if !found {
+		validTypes.Sort()
+		notFoundErr := ProcessNotFoundError{ProcessType: processType}
+		for _, validType := range validTypes {
+			notFoundErr.ValidProcessTypes = append(notFoundErr.ValidProcessTypes, validType.Type)
+		}
+		return notFoundErr
+	}
+
+	instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+	warningsChannel <- Warnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	for _, instance := range instances {
+		warnings, err = actor.CloudControllerClient.DeleteApplicationProcessInstance(appGUID, processType, instance.Index)
+		warningsChannel <- Warnings(warnings)
+		if err != nil {
+			if _, ok := err.(ccerror.InstanceNotFoundError); ok {
+				continue
+			}
+			return err
+		}
+	}
+
+	timeout := time.Now().Add(actor.Config.StartupTimeout())
+	for time.Now().Before(timeout) {
+		ready, err := actor.processStatus(process, warningsChannel)
+		if err != nil {
+			return err
+		}
+
+		if ready {
+			return nil
+		}
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return StartupTimeoutError{}
+}
@@ -5,12 +5,15 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
 )
 
 type ProcessHealthCheck struct {
-	ProcessType     string
-	HealthCheckType string
-	Endpoint        string
+	ProcessType       string
+	HealthCheckType   string
+	Endpoint          string
+	Timeout           types.NullUint64
+	InvocationTimeout types.NullUint64
 }
 
 type ProcessHealthChecks []ProcessHealthCheck
@@ -65,9 +68,11 @@ func (actor Actor) GetApplicationProcessHealthChecksByNameAndSpace(appName strin
 	var processHealthChecks ProcessHealthChecks
 	for _, ccv3Process := range ccv3Processes {
 		processHealthCheck := ProcessHealthCheck{
-			ProcessType:     ccv3Process.Type,
-			HealthCheckType: ccv3Process.HealthCheck.Type,
-			Endpoint:        ccv3Process.HealthCheck.Data.Endpoint,
+			ProcessType:       ccv3Process.Type,
+			HealthCheckType:   ccv3Process.HealthCheck.Type,
+			Endpoint:          ccv3Process.HealthCheck.Data.Endpoint,
+			Timeout:           ccv3Process.HealthCheck.Data.Timeout,
+			InvocationTimeout: ccv3Process.HealthCheck.Data.InvocationTimeout,
 		}
 		processHealthChecks = append(processHealthChecks, processHealthCheck)
 	}
@@ -112,3 +117,43 @@ func (actor Actor) SetApplicationProcessHealthCheckTypeByNameAndSpace(appName st
 
 	return app, allWarnings, nil
 }
+
+// UpdateProcessHealthCheckByTypeAndApplication changes the health check of
+// the given process type of an app. Settings left empty or unset in
+// healthCheck keep their current values, except that changing the type away
+// from 'http' clears the endpoint.
+func (actor Actor) UpdateProcessHealthCheckByTypeAndApplication(processType string, appGUID string, healthCheck ProcessHealthCheck) (Warnings, error) {
+	process, warnings, err := actor.CloudControllerClient.GetApplicationProcessByType(appGUID, processType)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+			return allWarnings, ProcessNotFoundError{ProcessType: processType}
+		}
+		return allWarnings, err
+	}
+
+	if healthCheck.HealthCheckType != "" {
+		process.HealthCheck.Type = healthCheck.HealthCheckType
+		if healthCheck.HealthCheckType != "http" {
+			process.HealthCheck.Data.Endpoint = ""
+		}
+	}
+
+	if healthCheck.Endpoint != "" {
+		if process.HealthCheck.Type != "http" {
+			return allWarnings, HTTPHealthCheckInvalidError{}
+		}
+		process.HealthCheck.Data.Endpoint = healthCheck.Endpoint
+	}
+
+	if healthCheck.Timeout.IsSet {
+		process.HealthCheck.Data.Timeout = healthCheck.Timeout
+	}
+	if healthCheck.InvocationTimeout.IsSet {
+		process.HealthCheck.Data.InvocationTimeout = healthCheck.InvocationTimeout
+	}
+
+	warnings, err = actor.CloudControllerClient.UpdateProcess(process)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	return allWarnings, err
+}
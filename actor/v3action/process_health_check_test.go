@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -350,4 +351,137 @@ var _ = Describe("Process Health Check Actions", func() {
 			})
 		})
 	})
+
+	Describe("UpdateProcessHealthCheckByTypeAndApplication", func() {
+		var (
+			healthCheck ProcessHealthCheck
+			warnings    Warnings
+			err         error
+		)
+
+		BeforeEach(func() {
+			healthCheck = ProcessHealthCheck{}
+			fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+				ccv3.Process{
+					GUID: "some-process-guid",
+					Type: constant.ProcessTypeWeb,
+					HealthCheck: ccv3.ProcessHealthCheck{
+						Type: "http",
+						Data: ccv3.ProcessHealthCheckData{
+							Endpoint: "/old",
+							Timeout:  types.NullUint64{Value: 60, IsSet: true},
+						},
+					},
+				},
+				ccv3.Warnings{"get-process-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateProcessReturns(ccv3.Warnings{"update-process-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.UpdateProcessHealthCheckByTypeAndApplication(constant.ProcessTypeWeb, "some-app-guid", healthCheck)
+		})
+
+		Context("when every setting is provided", func() {
+			BeforeEach(func() {
+				healthCheck = ProcessHealthCheck{
+					HealthCheckType:   "http",
+					Endpoint:          "/health",
+					Timeout:           types.NullUint64{Value: 120, IsSet: true},
+					InvocationTimeout: types.NullUint64{Value: 5, IsSet: true},
+				}
+			})
+
+			It("updates the process with the new settings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-process-warning", "update-process-warning"))
+
+				appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal(constant.ProcessTypeWeb))
+
+				Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateProcessArgsForCall(0)).To(Equal(ccv3.Process{
+					GUID: "some-process-guid",
+					Type: constant.ProcessTypeWeb,
+					HealthCheck: ccv3.ProcessHealthCheck{
+						Type: "http",
+						Data: ccv3.ProcessHealthCheckData{
+							Endpoint:          "/health",
+							Timeout:           types.NullUint64{Value: 120, IsSet: true},
+							InvocationTimeout: types.NullUint64{Value: 5, IsSet: true},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when only the timeout is provided", func() {
+			BeforeEach(func() {
+				healthCheck = ProcessHealthCheck{Timeout: types.NullUint64{Value: 120, IsSet: true}}
+			})
+
+			It("keeps the current type and endpoint", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				process := fakeCloudControllerClient.UpdateProcessArgsForCall(0)
+				Expect(process.HealthCheck.Type).To(Equal("http"))
+				Expect(process.HealthCheck.Data.Endpoint).To(Equal("/old"))
+				Expect(process.HealthCheck.Data.Timeout).To(Equal(types.NullUint64{Value: 120, IsSet: true}))
+				Expect(process.HealthCheck.Data.InvocationTimeout.IsSet).To(BeFalse())
+			})
+		})
+
+		Context("when the type changes away from http", func() {
+			BeforeEach(func() {
+				healthCheck = ProcessHealthCheck{HealthCheckType: "port"}
+			})
+
+			It("clears the endpoint", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				process := fakeCloudControllerClient.UpdateProcessArgsForCall(0)
+				Expect(process.HealthCheck.Type).To(Equal("port"))
+				Expect(process.HealthCheck.Data.Endpoint).To(BeEmpty())
+			})
+		})
+
+		Context("when an endpoint is provided for a non-http health check", func() {
+			BeforeEach(func() {
+				healthCheck = ProcessHealthCheck{HealthCheckType: "port", Endpoint: "/health"}
+			})
+
+			It("returns an HTTPHealthCheckInvalidError without updating the process", func() {
+				Expect(err).To(MatchError(HTTPHealthCheckInvalidError{}))
+				Expect(warnings).To(ConsistOf("get-process-warning"))
+				Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(ccv3.Process{}, ccv3.Warnings{"get-process-warning"}, ccerror.ProcessNotFoundError{})
+			})
+
+			It("returns a ProcessNotFoundError", func() {
+				Expect(err).To(MatchError(ProcessNotFoundError{ProcessType: constant.ProcessTypeWeb}))
+				Expect(warnings).To(ConsistOf("get-process-warning"))
+			})
+		})
+
+		Context("when updating the process fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("update-error")
+				fakeCloudControllerClient.UpdateProcessReturns(ccv3.Warnings{"update-process-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-process-warning", "update-process-warning"))
+			})
+		})
+	})
 })
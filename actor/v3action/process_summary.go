@@ -98,3 +98,21 @@ func (actor Actor) getProcessSummariesForApp(appGUID string) (ProcessSummaries,
 
 	return processSummaries, allWarnings, nil
 }
+
+// GetApplicationProcessSummariesByNameAndSpace returns the processes of an
+// application, with web first, along with the stats of their instances.
+func (actor Actor) GetApplicationProcessSummariesByNameAndSpace(appName string, spaceGUID string) (ProcessSummaries, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	processSummaries, warnings, err := actor.getProcessSummariesForApp(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	processSummaries.Sort()
+	return processSummaries, allWarnings, nil
+}
@@ -2,6 +2,7 @@ package v3action_test
 
 import (
 	"errors"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -94,4 +95,263 @@ var _ = Describe("Process Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationProcessTypes", func() {
+		Context("when getting the processes succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{{Type: "worker"}, {Type: constant.ProcessTypeWeb}, {Type: "clock"}},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+			})
+
+			It("returns the process types with web first", func() {
+				processTypes, warnings, err := actor.GetApplicationProcessTypes("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+				Expect(processTypes).To(Equal([]string{constant.ProcessTypeWeb, "clock", "worker"}))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when getting the processes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get processes error")
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-processes-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationProcessTypes("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+			})
+		})
+	})
+
+	Describe("GetApplicationProcessSummariesByNameAndSpace", func() {
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app-name", GUID: "some-app-guid"}},
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{
+						{GUID: "worker-guid", Type: "worker", Command: "some-worker-command"},
+						{GUID: "web-guid", Type: constant.ProcessTypeWeb, Command: "some-web-command"},
+					},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesStub = func(processGUID string) ([]ccv3.Instance, ccv3.Warnings, error) {
+					if processGUID == "web-guid" {
+						return []ccv3.Instance{{Index: 0, State: "RUNNING"}}, ccv3.Warnings{"get-web-instances-warning"}, nil
+					}
+					return []ccv3.Instance{{Index: 0, State: "CRASHED", Details: "some-crash-reason"}}, ccv3.Warnings{"get-worker-instances-warning"}, nil
+				}
+			})
+
+			It("returns the processes with web first and their instance stats", func() {
+				summaries, warnings, err := actor.GetApplicationProcessSummariesByNameAndSpace("some-app-name", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-processes-warning", "get-web-instances-warning", "get-worker-instances-warning"))
+				Expect(summaries).To(Equal(ProcessSummaries{
+					{
+						Process:         Process{GUID: "web-guid", Type: constant.ProcessTypeWeb, Command: "some-web-command"},
+						InstanceDetails: []Instance{{Index: 0, State: "RUNNING"}},
+					},
+					{
+						Process:         Process{GUID: "worker-guid", Type: "worker", Command: "some-worker-command"},
+						InstanceDetails: []Instance{{Index: 0, State: "CRASHED", Details: "some-crash-reason"}},
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and the warnings", func() {
+				_, warnings, err := actor.GetApplicationProcessSummariesByNameAndSpace("some-app-name", "some-space-guid")
+				Expect(err).To(MatchError(ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+
+		Context("when getting the instance stats fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get instances error")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app-name", GUID: "some-app-guid"}},
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{{GUID: "web-guid", Type: constant.ProcessTypeWeb}},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationProcessSummariesByNameAndSpace("some-app-name", "some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-processes-warning", "get-instances-warning"))
+			})
+		})
+	})
+
+	Describe("RestartApplicationProcess", func() {
+		var (
+			fakeConfig      *v3actionfakes.FakeConfig
+			warningsChannel chan Warnings
+			allWarnings     Warnings
+			funcDone        chan interface{}
+			err             error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v3actionfakes.FakeConfig)
+			fakeConfig.StartupTimeoutReturns(time.Second)
+			fakeConfig.PollingIntervalReturns(0)
+			actor = NewActor(fakeCloudControllerClient, fakeConfig)
+
+			warningsChannel = make(chan Warnings)
+			funcDone = make(chan interface{})
+			allWarnings = Warnings{}
+			go func() {
+				for {
+					select {
+					case warnings := <-warningsChannel:
+						allWarnings = append(allWarnings, warnings...)
+					case <-funcDone:
+						return
+					}
+				}
+			}()
+
+			fakeCloudControllerClient.GetApplicationProcessesReturns(
+				[]ccv3.Process{{GUID: "web-guid", Type: constant.ProcessTypeWeb}, {GUID: "worker-guid", Type: "worker"}},
+				ccv3.Warnings{"get-processes-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			err = actor.RestartApplicationProcess("some-app-guid", "worker", warningsChannel)
+			funcDone <- nil
+		})
+
+		Context("when the process type does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{{Type: "clock"}, {Type: constant.ProcessTypeWeb}},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+			})
+
+			It("returns a ProcessNotFoundError listing the valid process types", func() {
+				Expect(err).To(MatchError(ProcessNotFoundError{
+					ProcessType:       "worker",
+					ValidProcessTypes: []string{constant.ProcessTypeWeb, "clock"},
+				}))
+				Expect(allWarnings).To(ConsistOf("get-processes-warning"))
+				Expect(fakeCloudControllerClient.DeleteApplicationProcessInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the process has instances", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0,
+					[]ccv3.Instance{{Index: 0, State: "RUNNING"}, {Index: 1, State: "RUNNING"}},
+					ccv3.Warnings{"get-instances-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+					[]ccv3.Instance{{Index: 0, State: "STARTING"}, {Index: 1, State: "STARTING"}},
+					ccv3.Warnings{"poll-warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(2,
+					[]ccv3.Instance{{Index: 0, State: "RUNNING"}, {Index: 1, State: "STARTING"}},
+					ccv3.Warnings{"poll-warning-2"},
+					nil,
+				)
+				fakeCloudControllerClient.DeleteApplicationProcessInstanceReturns(ccv3.Warnings{"delete-warning"}, nil)
+			})
+
+			It("terminates every instance of the process and waits for it to run again", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(allWarnings).To(ConsistOf("get-processes-warning", "get-instances-warning", "delete-warning", "delete-warning", "poll-warning-1", "poll-warning-2"))
+
+				Expect(fakeCloudControllerClient.DeleteApplicationProcessInstanceCallCount()).To(Equal(2))
+				appGUID, processType, index := fakeCloudControllerClient.DeleteApplicationProcessInstanceArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal("worker"))
+				Expect(index).To(Equal(0))
+				_, _, index = fakeCloudControllerClient.DeleteApplicationProcessInstanceArgsForCall(1)
+				Expect(index).To(Equal(1))
+
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(3))
+				for i := 0; i < 3; i++ {
+					Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(i)).To(Equal("worker-guid"))
+				}
+			})
+
+			Context("when an instance has already gone away", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.DeleteApplicationProcessInstanceReturnsOnCall(0, nil, ccerror.InstanceNotFoundError{})
+				})
+
+				It("carries on with the remaining instances", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.DeleteApplicationProcessInstanceCallCount()).To(Equal(2))
+				})
+			})
+
+			Context("when terminating an instance fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("delete error")
+					fakeCloudControllerClient.DeleteApplicationProcessInstanceReturns(ccv3.Warnings{"delete-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(allWarnings).To(ConsistOf("get-processes-warning", "get-instances-warning", "delete-warning"))
+					Expect(fakeCloudControllerClient.DeleteApplicationProcessInstanceCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the instances do not come back before the startup timeout", func() {
+				BeforeEach(func() {
+					fakeConfig.StartupTimeoutReturns(time.Millisecond)
+					fakeConfig.PollingIntervalReturns(time.Millisecond * 2)
+					fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(2,
+						[]ccv3.Instance{{Index: 0, State: "STARTING"}},
+						nil,
+						nil,
+					)
+				})
+
+				It("returns a StartupTimeoutError", func() {
+					Expect(err).To(MatchError(StartupTimeoutError{}))
+				})
+			})
+		})
+	})
 })
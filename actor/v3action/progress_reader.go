@@ -0,0 +1,10 @@
+package v3action
+
+import "io"
+
+// ProgressReader reports the progress of a download.
+type ProgressReader interface {
+	Wrap(io.Reader) io.ReadCloser
+	Start(int64)
+	Finish()
+}
@@ -0,0 +1,42 @@
+package v3action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+)
+
+// ServiceInstanceSharingFeatureFlag is the feature flag that has to be enabled
+// for service instances to be shared between spaces.
+const ServiceInstanceSharingFeatureFlag = "service_instance_sharing"
+
+// FeatureFlagDisabledError is returned when an action relies on a feature
+// flag that is disabled.
+type FeatureFlagDisabledError struct {
+	FeatureFlag string
+}
+
+func (e FeatureFlagDisabledError) Error() string {
+	return fmt.Sprintf("Feature flag '%s' is disabled.", e.FeatureFlag)
+}
+
+// ShareServiceInstanceToSpace shares the service instance into the space.
+func (actor Actor) ShareServiceInstanceToSpace(serviceInstanceGUID string, spaceGUID string) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.ShareServiceInstanceToSpaces(serviceInstanceGUID, []string{spaceGUID})
+	return Warnings(warnings), convertServiceInstanceSharingError(err)
+}
+
+// UnshareServiceInstanceFromSpace stops sharing the service instance into the
+// space. Bindings of apps in that space to the service instance are deleted
+// by the Cloud Controller.
+func (actor Actor) UnshareServiceInstanceFromSpace(serviceInstanceGUID string, spaceGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UnshareServiceInstanceFromSpace(serviceInstanceGUID, spaceGUID)
+	return Warnings(warnings), convertServiceInstanceSharingError(err)
+}
+
+func convertServiceInstanceSharingError(err error) error {
+	if _, ok := err.(ccerror.FeatureDisabledError); ok {
+		return FeatureFlagDisabledError{FeatureFlag: ServiceInstanceSharingFeatureFlag}
+	}
+	return err
+}
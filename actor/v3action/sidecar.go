@@ -0,0 +1,20 @@
+package v3action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+// Sidecar represents a V3 actor sidecar.
+type Sidecar ccv3.Sidecar
+
+// GetApplicationSidecars returns the sidecars configured for the app.
+func (actor Actor) GetApplicationSidecars(appGUID string) ([]Sidecar, Warnings, error) {
+	ccSidecars, warnings, err := actor.CloudControllerClient.GetApplicationSidecars(appGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var sidecars []Sidecar
+	for _, ccSidecar := range ccSidecars {
+		sidecars = append(sidecars, Sidecar(ccSidecar))
+	}
+	return sidecars, Warnings(warnings), nil
+}
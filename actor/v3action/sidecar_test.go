@@ -0,0 +1,84 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sidecar Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetApplicationSidecars", func() {
+		var (
+			sidecars []Sidecar
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			sidecars, warnings, err = actor.GetApplicationSidecars("some-app-guid")
+		})
+
+		Context("when getting the sidecars succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(
+					[]ccv3.Sidecar{
+						{
+							GUID:         "sidecar-guid",
+							Name:         "auth-proxy",
+							Command:      "./proxy",
+							ProcessTypes: []string{"web"},
+							MemoryInMB:   types.NullUint64{IsSet: true, Value: 64},
+						},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil)
+			})
+
+			It("returns the sidecars and all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(sidecars).To(Equal([]Sidecar{
+					{
+						GUID:         "sidecar-guid",
+						Name:         "auth-proxy",
+						Command:      "./proxy",
+						ProcessTypes: []string{"web"},
+						MemoryInMB:   types.NullUint64{IsSet: true, Value: 64},
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationSidecarsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationSidecarsArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when getting the sidecars fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(nil, ccv3.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+})
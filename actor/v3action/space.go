@@ -1,5 +1,122 @@
 package v3action
 
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Space represents a V3 actor space.
+type Space struct {
+	Name             string
+	GUID             string
+	OrganizationGUID string
+	Metadata         *Metadata
+}
+
+// SpaceNotFoundError represents the error that occurs when the space is not
+// found.
+type SpaceNotFoundError struct {
+	Name string
+}
+
+func (e SpaceNotFoundError) Error() string {
+	return fmt.Sprintf("Space '%s' not found.", e.Name)
+}
+
+// DeleteSpaceByNameAndOrganizationName starts the asynchronous deletion of
+// the space with the given name in the given organization and returns the URL
+// of the deletion job. Use PollDeletionJob to follow its progress.
+func (actor Actor) DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (string, Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+
+	jobURL, deleteWarnings, err := actor.CloudControllerClient.DeleteSpace(space.GUID)
+	allWarnings = append(allWarnings, deleteWarnings...)
+	return jobURL, allWarnings, err
+}
+
+// GetSpaceByNameAndOrganization returns the space with the given name in the
+// given organization.
+func (actor Actor) GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (Space, Warnings, error) {
+	spaces, warnings, err := actor.CloudControllerClient.GetSpaces(url.Values{
+		ccv3.NameFilter:             []string{spaceName},
+		ccv3.OrganizationGUIDFilter: []string{orgGUID},
+	})
+	if err != nil {
+		return Space{}, Warnings(warnings), err
+	}
+
+	if len(spaces) == 0 {
+		return Space{}, Warnings(warnings), SpaceNotFoundError{Name: spaceName}
+	}
+
+	return newSpace(spaces[0]), Warnings(warnings), nil
+}
+
+// GetSpacesByGUIDs returns the spaces with the given GUIDs. Spaces the user
+// cannot see are left out.
+func (actor Actor) GetSpacesByGUIDs(spaceGUIDs ...string) ([]Space, Warnings, error) {
+	ccv3Spaces, warnings, err := actor.CloudControllerClient.GetSpaces(url.Values{
+		ccv3.GUIDFilter: []string{strings.Join(spaceGUIDs, ",")},
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	spaces := make([]Space, len(ccv3Spaces))
+	for i, ccv3Space := range ccv3Spaces {
+		spaces[i] = newSpace(ccv3Space)
+	}
+	return spaces, Warnings(warnings), nil
+}
+
+// GetSpacesByOrganizationAndLabelSelector returns the spaces in an
+// organization that match the label selector, ordered by name. An empty
+// selector matches all spaces.
+func (actor Actor) GetSpacesByOrganizationAndLabelSelector(orgGUID string, labelSelector string) ([]Space, Warnings, error) {
+	query := url.Values{
+		ccv3.OrganizationGUIDFilter: []string{orgGUID},
+		ccv3.OrderBy:                []string{ccv3.NameOrder},
+	}
+	if labelSelector != "" {
+		query.Set(ccv3.LabelSelectorFilter, labelSelector)
+	}
+
+	ccv3Spaces, warnings, err := actor.CloudControllerClient.GetSpaces(query)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	spaces := make([]Space, len(ccv3Spaces))
+	for i, ccv3Space := range ccv3Spaces {
+		spaces[i] = newSpace(ccv3Space)
+	}
+	return spaces, Warnings(warnings), nil
+}
+
+func newSpace(space ccv3.Space) Space {
+	return Space{
+		Name:             space.Name,
+		GUID:             space.GUID,
+		OrganizationGUID: space.Relationships[ccv3.OrganizationRelationship].GUID,
+		Metadata:         (*Metadata)(space.Metadata),
+	}
+}
+
 // ResetSpaceIsolationSegment disassociates a space from an isolation segment.
 //
 // If the space's organization has a default isolation segment, return its
@@ -2,6 +2,7 @@ package v3action_test
 
 import (
 	"errors"
+	"net/url"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -22,6 +23,88 @@ var _ = Describe("Space", func() {
 		actor = NewActor(fakeCloudControllerClient, fakeConfig)
 	})
 
+	Describe("GetSpaceByNameAndOrganization", func() {
+		Context("when the space exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{{
+						Name: "some-space",
+						GUID: "some-space-guid",
+						Relationships: ccv3.Relationships{
+							ccv3.OrganizationRelationship: ccv3.Relationship{GUID: "some-org-guid"},
+						},
+					}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the space and warnings", func() {
+				space, warnings, err := actor.GetSpaceByNameAndOrganization("some-space", "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(space).To(Equal(Space{Name: "some-space", GUID: "some-space-guid", OrganizationGUID: "some-org-guid"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(url.Values{
+					ccv3.NameFilter:             []string{"some-space"},
+					ccv3.OrganizationGUIDFilter: []string{"some-org-guid"},
+				}))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"some-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError and warnings", func() {
+				_, warnings, err := actor.GetSpaceByNameAndOrganization("some-space", "some-org-guid")
+				Expect(err).To(MatchError(SpaceNotFoundError{Name: "some-space"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the warnings and the error", func() {
+				_, warnings, err := actor.GetSpaceByNameAndOrganization("some-space", "some-org-guid")
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("GetSpacesByGUIDs", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{
+					Name: "some-space",
+					GUID: "some-space-guid",
+					Relationships: ccv3.Relationships{
+						ccv3.OrganizationRelationship: ccv3.Relationship{GUID: "some-org-guid"},
+					},
+				}},
+				ccv3.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("returns the spaces with their organizations and warnings", func() {
+			spaces, warnings, err := actor.GetSpacesByGUIDs("some-space-guid", "other-space-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spaces).To(Equal([]Space{{Name: "some-space", GUID: "some-space-guid", OrganizationGUID: "some-org-guid"}}))
+			Expect(warnings).To(ConsistOf("some-warning"))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(url.Values{
+				ccv3.GUIDFilter: []string{"some-space-guid,other-space-guid"},
+			}))
+		})
+	})
+
 	Describe("ResetSpaceIsolationSegment", func() {
 		Context("when the organization does not have a default isolation segment", func() {
 			BeforeEach(func() {
@@ -145,4 +228,89 @@ var _ = Describe("Space", func() {
 			})
 		})
 	})
+
+	Describe("DeleteSpaceByNameAndOrganizationName", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv3.Organization{{Name: "some-org-name", GUID: "some-org-guid"}},
+				ccv3.Warnings{"get-org-warning"},
+				nil,
+			)
+		})
+
+		Context("when the space exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{{Name: "some-space-name", GUID: "some-space-guid"}},
+					ccv3.Warnings{"get-space-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.DeleteSpaceReturns(
+					"/v3/jobs/some-job-guid",
+					ccv3.Warnings{"delete-space-warning"},
+					nil,
+				)
+			})
+
+			It("starts deleting the space and returns the job URL and all warnings", func() {
+				jobURL, warnings, err := actor.DeleteSpaceByNameAndOrganizationName("some-space-name", "some-org-name")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal("/v3/jobs/some-job-guid"))
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-space-warning", "delete-space-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(Equal(url.Values{
+					ccv3.NameFilter:             []string{"some-space-name"},
+					ccv3.OrganizationGUIDFilter: []string{"some-org-guid"},
+				}))
+				Expect(fakeCloudControllerClient.DeleteSpaceCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteSpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"get-space-warning"}, nil)
+			})
+
+			It("returns a SpaceNotFoundError and does not delete anything", func() {
+				_, warnings, err := actor.DeleteSpaceByNameAndOrganizationName("some-space-name", "some-org-name")
+				Expect(err).To(MatchError(SpaceNotFoundError{Name: "some-space-name"}))
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-space-warning"))
+				Expect(fakeCloudControllerClient.DeleteSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				_, warnings, err := actor.DeleteSpaceByNameAndOrganizationName("some-space-name", "some-org-name")
+				Expect(err).To(MatchError(OrganizationNotFoundError{Name: "some-org-name"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when deleting the space fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete failed")
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{{Name: "some-space-name", GUID: "some-space-guid"}},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.DeleteSpaceReturns("", ccv3.Warnings{"delete-space-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.DeleteSpaceByNameAndOrganizationName("some-space-name", "some-org-name")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-org-warning", "delete-space-warning"))
+			})
+		})
+	})
 })
@@ -27,12 +27,34 @@ func (e TaskWorkersUnavailableError) Error() string {
 // TaskNotFoundError is returned when no tasks matching the filters are found.
 type TaskNotFoundError struct {
 	SequenceID int
+	// GUID is set instead of SequenceID when the task was looked up by GUID.
+	GUID string
+	// Name is set instead of SequenceID when a running task was looked up by
+	// name.
+	Name string
 }
 
 func (e TaskNotFoundError) Error() string {
+	switch {
+	case e.GUID != "":
+		return fmt.Sprintf("Task %s not found.", e.GUID)
+	case e.Name != "":
+		return fmt.Sprintf("Running task named %s not found.", e.Name)
+	}
 	return fmt.Sprintf("Task sequence ID %d not found.", e.SequenceID)
 }
 
+// MultipleRunningTasksFoundError is returned when a running task is looked up
+// by name and several running tasks share that name.
+type MultipleRunningTasksFoundError struct {
+	Name        string
+	SequenceIDs []int
+}
+
+func (e MultipleRunningTasksFoundError) Error() string {
+	return fmt.Sprintf("Multiple running tasks named %s found: %v", e.Name, e.SequenceIDs)
+}
+
 // RunTask runs the provided command in the application environment associated
 // with the provided application GUID.
 func (actor Actor) RunTask(appGUID string, task Task) (Task, Warnings, error) {
@@ -88,6 +110,75 @@ func (actor Actor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID str
 	return Task(tasks[0]), Warnings(warnings), nil
 }
 
+// GetTaskBySequenceIDOrGUIDAndApplication returns the application's task
+// identified by either its sequence ID or its GUID.
+func (actor Actor) GetTaskBySequenceIDOrGUIDAndApplication(id string, appGUID string) (Task, Warnings, error) {
+	sequenceID, err := strconv.Atoi(id)
+	if err == nil {
+		return actor.GetTaskBySequenceIDAndApplication(sequenceID, appGUID)
+	}
+
+	query := url.Values{
+		"guids": []string{id},
+	}
+
+	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID, query)
+	if err != nil {
+		return Task{}, Warnings(warnings), err
+	}
+
+	if len(tasks) == 0 {
+		return Task{}, Warnings(warnings), TaskNotFoundError{GUID: id}
+	}
+
+	return Task(tasks[0]), Warnings(warnings), nil
+}
+
+// GetRunningTasksByNameAndApplication returns the application's running tasks
+// with the provided name, sorted by sequence ID.
+func (actor Actor) GetRunningTasksByNameAndApplication(name string, appGUID string) ([]Task, Warnings, error) {
+	query := url.Values{
+		"names":  []string{name},
+		"states": []string{"RUNNING"},
+	}
+
+	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID, query)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	runningTasks := []Task{}
+	for _, task := range tasks {
+		runningTasks = append(runningTasks, Task(task))
+	}
+	sort.Slice(runningTasks, func(i int, j int) bool { return runningTasks[i].SequenceID < runningTasks[j].SequenceID })
+
+	return runningTasks, Warnings(warnings), nil
+}
+
+// GetRunningTaskByNameAndApplication returns the application's only running
+// task with the provided name. A MultipleRunningTasksFoundError is returned
+// when the name is shared by several running tasks.
+func (actor Actor) GetRunningTaskByNameAndApplication(name string, appGUID string) (Task, Warnings, error) {
+	tasks, warnings, err := actor.GetRunningTasksByNameAndApplication(name, appGUID)
+	if err != nil {
+		return Task{}, warnings, err
+	}
+
+	switch len(tasks) {
+	case 0:
+		return Task{}, warnings, TaskNotFoundError{Name: name}
+	case 1:
+		return tasks[0], warnings, nil
+	}
+
+	sequenceIDs := make([]int, len(tasks))
+	for i, task := range tasks {
+		sequenceIDs[i] = task.SequenceID
+	}
+	return Task{}, warnings, MultipleRunningTasksFoundError{Name: name, SequenceIDs: sequenceIDs}
+}
+
 func (actor Actor) TerminateTask(taskGUID string) (Task, Warnings, error) {
 	task, warnings, err := actor.CloudControllerClient.UpdateTask(taskGUID)
 	return Task(task), Warnings(warnings), err
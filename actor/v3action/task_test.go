@@ -264,6 +264,133 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("GetTaskBySequenceIDOrGUIDAndApplication", func() {
+		var task1 ccv3.Task
+
+		BeforeEach(func() {
+			task1 = ccv3.Task{
+				GUID:       "task-1-guid",
+				SequenceID: 1,
+			}
+			fakeCloudControllerClient.GetApplicationTasksReturns(
+				[]ccv3.Task{task1},
+				ccv3.Warnings{"get-task-warning-1"},
+				nil,
+			)
+		})
+
+		Context("when given a sequence ID", func() {
+			It("looks the task up by sequence ID", func() {
+				task, warnings, err := actor.GetTaskBySequenceIDOrGUIDAndApplication("1", "some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task).To(Equal(Task(task1)))
+				Expect(warnings).To(ConsistOf("get-task-warning-1"))
+
+				appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{"sequence_ids": []string{"1"}}))
+			})
+		})
+
+		Context("when given a GUID", func() {
+			It("looks the task up by GUID", func() {
+				task, warnings, err := actor.GetTaskBySequenceIDOrGUIDAndApplication("task-1-guid", "some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task).To(Equal(Task(task1)))
+				Expect(warnings).To(ConsistOf("get-task-warning-1"))
+
+				appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{"guids": []string{"task-1-guid"}}))
+			})
+
+			Context("when the task is not found", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationTasksReturns(
+						[]ccv3.Task{},
+						ccv3.Warnings{"get-task-warning-1"},
+						nil,
+					)
+				})
+
+				It("returns a TaskNotFoundError and warnings", func() {
+					_, warnings, err := actor.GetTaskBySequenceIDOrGUIDAndApplication("task-1-guid", "some-app-guid")
+					Expect(err).To(MatchError(TaskNotFoundError{GUID: "task-1-guid"}))
+					Expect(warnings).To(ConsistOf("get-task-warning-1"))
+				})
+			})
+		})
+	})
+
+	Describe("GetRunningTaskByNameAndApplication", func() {
+		Context("when exactly one running task has the name", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]ccv3.Task{{GUID: "task-3-guid", SequenceID: 3, Name: "some-task"}},
+					ccv3.Warnings{"get-task-warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the task and warnings", func() {
+				task, warnings, err := actor.GetRunningTaskByNameAndApplication("some-task", "some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task.GUID).To(Equal("task-3-guid"))
+				Expect(warnings).To(ConsistOf("get-task-warning-1"))
+
+				appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{
+					"names":  []string{"some-task"},
+					"states": []string{"RUNNING"},
+				}))
+			})
+		})
+
+		Context("when no running task has the name", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(nil, ccv3.Warnings{"get-task-warning-1"}, nil)
+			})
+
+			It("returns a TaskNotFoundError and warnings", func() {
+				_, warnings, err := actor.GetRunningTaskByNameAndApplication("some-task", "some-app-guid")
+				Expect(err).To(MatchError(TaskNotFoundError{Name: "some-task"}))
+				Expect(warnings).To(ConsistOf("get-task-warning-1"))
+			})
+		})
+
+		Context("when several running tasks have the name", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]ccv3.Task{
+						{GUID: "task-5-guid", SequenceID: 5, Name: "some-task"},
+						{GUID: "task-3-guid", SequenceID: 3, Name: "some-task"},
+					},
+					ccv3.Warnings{"get-task-warning-1"},
+					nil,
+				)
+			})
+
+			It("returns a MultipleRunningTasksFoundError with the sorted sequence IDs", func() {
+				_, warnings, err := actor.GetRunningTaskByNameAndApplication("some-task", "some-app-guid")
+				Expect(err).To(MatchError(MultipleRunningTasksFoundError{Name: "some-task", SequenceIDs: []int{3, 5}}))
+				Expect(warnings).To(ConsistOf("get-task-warning-1"))
+			})
+		})
+
+		Context("when getting the tasks errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(nil, ccv3.Warnings{"get-task-warning-1"}, errors.New("get-tasks-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetRunningTaskByNameAndApplication("some-task", "some-app-guid")
+				Expect(err).To(MatchError("get-tasks-error"))
+				Expect(warnings).To(ConsistOf("get-task-warning-1"))
+			})
+		})
+	})
+
 	Describe("TerminateTask", func() {
 		Context("when the task exists", func() {
 			var returnedTask ccv3.Task
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 )
 
@@ -573,6 +574,280 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	DownloadDropletStub        func(dropletGUID string, progressReader cloudcontroller.ProgressReader) ([]byte, ccv3.Warnings, error)
+	downloadDropletMutex       sync.RWMutex
+	downloadDropletArgsForCall []struct {
+		dropletGUID    string
+		progressReader cloudcontroller.ProgressReader
+	}
+	downloadDropletReturns struct {
+		result1 []byte
+		result2 ccv3.Warnings
+		result3 error
+	}
+	downloadDropletReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetApplicationDropletCurrentStub        func(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
+	getApplicationDropletCurrentMutex       sync.RWMutex
+	getApplicationDropletCurrentArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationDropletCurrentReturns struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationDropletCurrentReturnsOnCall map[int]struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	CreateApplicationDeploymentStub        func(appGUID string, dropletGUID string) (string, ccv3.Warnings, error)
+	createApplicationDeploymentMutex       sync.RWMutex
+	createApplicationDeploymentArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	createApplicationDeploymentReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationDeploymentReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetDeploymentStub        func(guid string) (ccv3.Deployment, ccv3.Warnings, error)
+	getDeploymentMutex       sync.RWMutex
+	getDeploymentArgsForCall []struct {
+		guid string
+	}
+	getDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateSpaceApplyManifestStub        func(spaceGUID string, rawManifest []byte) (string, ccv3.Warnings, error)
+	updateSpaceApplyManifestMutex       sync.RWMutex
+	updateSpaceApplyManifestArgsForCall []struct {
+		spaceGUID   string
+		rawManifest []byte
+	}
+	updateSpaceApplyManifestReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateSpaceApplyManifestReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetBuildsStub        func(query url.Values) ([]ccv3.Build, ccv3.Warnings, error)
+	getBuildsMutex       sync.RWMutex
+	getBuildsArgsForCall []struct {
+		query url.Values
+	}
+	getBuildsReturns struct {
+		result1 []ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getBuildsReturnsOnCall map[int]struct {
+		result1 []ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetIsolationSegmentSpacesStub        func(isolationSegmentGUID string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	getIsolationSegmentSpacesMutex       sync.RWMutex
+	getIsolationSegmentSpacesArgsForCall []struct {
+		isolationSegmentGUID string
+	}
+	getIsolationSegmentSpacesReturns struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getIsolationSegmentSpacesReturnsOnCall map[int]struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetSpacesStub        func(query url.Values) ([]ccv3.Space, ccv3.Warnings, error)
+	getSpacesMutex       sync.RWMutex
+	getSpacesArgsForCall []struct {
+		query url.Values
+	}
+	getSpacesReturns struct {
+		result1 []ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getSpacesReturnsOnCall map[int]struct {
+		result1 []ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}
+	ShareServiceInstanceToSpacesStub        func(serviceInstanceGUID string, spaceGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error)
+	shareServiceInstanceToSpacesMutex       sync.RWMutex
+	shareServiceInstanceToSpacesArgsForCall []struct {
+		serviceInstanceGUID string
+		spaceGUIDs          []string
+	}
+	shareServiceInstanceToSpacesReturns struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
+	shareServiceInstanceToSpacesReturnsOnCall map[int]struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UnshareServiceInstanceFromSpaceStub        func(serviceInstanceGUID string, spaceGUID string) (ccv3.Warnings, error)
+	unshareServiceInstanceFromSpaceMutex       sync.RWMutex
+	unshareServiceInstanceFromSpaceArgsForCall []struct {
+		serviceInstanceGUID string
+		spaceGUID           string
+	}
+	unshareServiceInstanceFromSpaceReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	unshareServiceInstanceFromSpaceReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	UpdateProcessStub        func(process ccv3.Process) (ccv3.Warnings, error)
+	updateProcessMutex       sync.RWMutex
+	updateProcessArgsForCall []struct {
+		process ccv3.Process
+	}
+	updateProcessReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	updateProcessReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	GetApplicationSidecarsStub        func(appGUID string) ([]ccv3.Sidecar, ccv3.Warnings, error)
+	getApplicationSidecarsMutex       sync.RWMutex
+	getApplicationSidecarsArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationSidecarsReturns struct {
+		result1 []ccv3.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationSidecarsReturnsOnCall map[int]struct {
+		result1 []ccv3.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	DeleteOrganizationStub        func(orgGUID string) (string, ccv3.Warnings, error)
+	deleteOrganizationMutex       sync.RWMutex
+	deleteOrganizationArgsForCall []struct {
+		orgGUID string
+	}
+	deleteOrganizationReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteOrganizationReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	DeleteSpaceStub        func(spaceGUID string) (string, ccv3.Warnings, error)
+	deleteSpaceMutex       sync.RWMutex
+	deleteSpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	deleteSpaceReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteSpaceReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetJobStub        func(jobURL string) (ccv3.Job, ccv3.Warnings, error)
+	getJobMutex       sync.RWMutex
+	getJobArgsForCall []struct {
+		jobURL string
+	}
+	getJobReturns struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getJobReturnsOnCall map[int]struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateApplicationMetadataStub        func(appGUID string, metadata ccv3.Metadata) (ccv3.Metadata, ccv3.Warnings, error)
+	updateApplicationMetadataMutex       sync.RWMutex
+	updateApplicationMetadataArgsForCall []struct {
+		appGUID  string
+		metadata ccv3.Metadata
+	}
+	updateApplicationMetadataReturns struct {
+		result1 ccv3.Metadata
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateApplicationMetadataReturnsOnCall map[int]struct {
+		result1 ccv3.Metadata
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateOrganizationMetadataStub        func(orgGUID string, metadata ccv3.Metadata) (ccv3.Metadata, ccv3.Warnings, error)
+	updateOrganizationMetadataMutex       sync.RWMutex
+	updateOrganizationMetadataArgsForCall []struct {
+		orgGUID  string
+		metadata ccv3.Metadata
+	}
+	updateOrganizationMetadataReturns struct {
+		result1 ccv3.Metadata
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateOrganizationMetadataReturnsOnCall map[int]struct {
+		result1 ccv3.Metadata
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateSpaceMetadataStub        func(spaceGUID string, metadata ccv3.Metadata) (ccv3.Metadata, ccv3.Warnings, error)
+	updateSpaceMetadataMutex       sync.RWMutex
+	updateSpaceMetadataArgsForCall []struct {
+		spaceGUID string
+		metadata  ccv3.Metadata
+	}
+	updateSpaceMetadataReturns struct {
+		result1 ccv3.Metadata
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateSpaceMetadataReturnsOnCall map[int]struct {
+		result1 ccv3.Metadata
+		result2 ccv3.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -2611,6 +2886,990 @@ func (fake *FakeCloudControllerClient) UploadPackageReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DownloadDroplet(dropletGUID string, progressReader cloudcontroller.ProgressReader) ([]byte, ccv3.Warnings, error) {
+	fake.downloadDropletMutex.Lock()
+	ret, specificReturn := fake.downloadDropletReturnsOnCall[len(fake.downloadDropletArgsForCall)]
+	fake.downloadDropletArgsForCall = append(fake.downloadDropletArgsForCall, struct {
+		dropletGUID    string
+		progressReader cloudcontroller.ProgressReader
+	}{dropletGUID, progressReader})
+	fake.recordInvocation("DownloadDroplet", []interface{}{dropletGUID, progressReader})
+	fake.downloadDropletMutex.Unlock()
+	if fake.DownloadDropletStub != nil {
+		return fake.DownloadDropletStub(dropletGUID, progressReader)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.downloadDropletReturns.result1, fake.downloadDropletReturns.result2, fake.downloadDropletReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletCallCount() int {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	return len(fake.downloadDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletArgsForCall(i int) (string, cloudcontroller.ProgressReader) {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	return fake.downloadDropletArgsForCall[i].dropletGUID, fake.downloadDropletArgsForCall[i].progressReader
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletReturns(result1 []byte, result2 ccv3.Warnings, result3 error) {
+	fake.DownloadDropletStub = nil
+	fake.downloadDropletReturns = struct {
+		result1 []byte
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletReturnsOnCall(i int, result1 []byte, result2 ccv3.Warnings, result3 error) {
+	fake.DownloadDropletStub = nil
+	if fake.downloadDropletReturnsOnCall == nil {
+		fake.downloadDropletReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.downloadDropletReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDropletCurrent(appGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
+	fake.getApplicationDropletCurrentMutex.Lock()
+	ret, specificReturn := fake.getApplicationDropletCurrentReturnsOnCall[len(fake.getApplicationDropletCurrentArgsForCall)]
+	fake.getApplicationDropletCurrentArgsForCall = append(fake.getApplicationDropletCurrentArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationDropletCurrent", []interface{}{appGUID})
+	fake.getApplicationDropletCurrentMutex.Unlock()
+	if fake.GetApplicationDropletCurrentStub != nil {
+		return fake.GetApplicationDropletCurrentStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationDropletCurrentReturns.result1, fake.getApplicationDropletCurrentReturns.result2, fake.getApplicationDropletCurrentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDropletCurrentCallCount() int {
+	fake.getApplicationDropletCurrentMutex.RLock()
+	defer fake.getApplicationDropletCurrentMutex.RUnlock()
+	return len(fake.getApplicationDropletCurrentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDropletCurrentArgsForCall(i int) string {
+	fake.getApplicationDropletCurrentMutex.RLock()
+	defer fake.getApplicationDropletCurrentMutex.RUnlock()
+	return fake.getApplicationDropletCurrentArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDropletCurrentReturns(result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationDropletCurrentStub = nil
+	fake.getApplicationDropletCurrentReturns = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDropletCurrentReturnsOnCall(i int, result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationDropletCurrentStub = nil
+	if fake.getApplicationDropletCurrentReturnsOnCall == nil {
+		fake.getApplicationDropletCurrentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Droplet
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationDropletCurrentReturnsOnCall[i] = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeployment(appGUID string, dropletGUID string) (string, ccv3.Warnings, error) {
+	fake.createApplicationDeploymentMutex.Lock()
+	ret, specificReturn := fake.createApplicationDeploymentReturnsOnCall[len(fake.createApplicationDeploymentArgsForCall)]
+	fake.createApplicationDeploymentArgsForCall = append(fake.createApplicationDeploymentArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("CreateApplicationDeployment", []interface{}{appGUID, dropletGUID})
+	fake.createApplicationDeploymentMutex.Unlock()
+	if fake.CreateApplicationDeploymentStub != nil {
+		return fake.CreateApplicationDeploymentStub(appGUID, dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createApplicationDeploymentReturns.result1, fake.createApplicationDeploymentReturns.result2, fake.createApplicationDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentCallCount() int {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return len(fake.createApplicationDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentArgsForCall(i int) (string, string) {
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	return fake.createApplicationDeploymentArgsForCall[i].appGUID, fake.createApplicationDeploymentArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	fake.createApplicationDeploymentReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.CreateApplicationDeploymentStub = nil
+	if fake.createApplicationDeploymentReturnsOnCall == nil {
+		fake.createApplicationDeploymentReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationDeploymentReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeployment(guid string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.getDeploymentMutex.Lock()
+	ret, specificReturn := fake.getDeploymentReturnsOnCall[len(fake.getDeploymentArgsForCall)]
+	fake.getDeploymentArgsForCall = append(fake.getDeploymentArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetDeployment", []interface{}{guid})
+	fake.getDeploymentMutex.Unlock()
+	if fake.GetDeploymentStub != nil {
+		return fake.GetDeploymentStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getDeploymentReturns.result1, fake.getDeploymentReturns.result2, fake.getDeploymentReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentCallCount() int {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return len(fake.getDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentArgsForCall(i int) string {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return fake.getDeploymentArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	fake.getDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.GetDeploymentStub = nil
+	if fake.getDeploymentReturnsOnCall == nil {
+		fake.getDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifest(spaceGUID string, rawManifest []byte) (string, ccv3.Warnings, error) {
+	var rawManifestCopy []byte
+	if rawManifest != nil {
+		rawManifestCopy = make([]byte, len(rawManifest))
+		copy(rawManifestCopy, rawManifest)
+	}
+	fake.updateSpaceApplyManifestMutex.Lock()
+	ret, specificReturn := fake.updateSpaceApplyManifestReturnsOnCall[len(fake.updateSpaceApplyManifestArgsForCall)]
+	fake.updateSpaceApplyManifestArgsForCall = append(fake.updateSpaceApplyManifestArgsForCall, struct {
+		spaceGUID   string
+		rawManifest []byte
+	}{spaceGUID, rawManifestCopy})
+	fake.recordInvocation("UpdateSpaceApplyManifest", []interface{}{spaceGUID, rawManifestCopy})
+	fake.updateSpaceApplyManifestMutex.Unlock()
+	if fake.UpdateSpaceApplyManifestStub != nil {
+		return fake.UpdateSpaceApplyManifestStub(spaceGUID, rawManifest)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateSpaceApplyManifestReturns.result1, fake.updateSpaceApplyManifestReturns.result2, fake.updateSpaceApplyManifestReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestCallCount() int {
+	fake.updateSpaceApplyManifestMutex.RLock()
+	defer fake.updateSpaceApplyManifestMutex.RUnlock()
+	return len(fake.updateSpaceApplyManifestArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestArgsForCall(i int) (string, []byte) {
+	fake.updateSpaceApplyManifestMutex.RLock()
+	defer fake.updateSpaceApplyManifestMutex.RUnlock()
+	return fake.updateSpaceApplyManifestArgsForCall[i].spaceGUID, fake.updateSpaceApplyManifestArgsForCall[i].rawManifest
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateSpaceApplyManifestStub = nil
+	fake.updateSpaceApplyManifestReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceApplyManifestReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateSpaceApplyManifestStub = nil
+	if fake.updateSpaceApplyManifestReturnsOnCall == nil {
+		fake.updateSpaceApplyManifestReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateSpaceApplyManifestReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetBuilds(query url.Values) ([]ccv3.Build, ccv3.Warnings, error) {
+	fake.getBuildsMutex.Lock()
+	ret, specificReturn := fake.getBuildsReturnsOnCall[len(fake.getBuildsArgsForCall)]
+	fake.getBuildsArgsForCall = append(fake.getBuildsArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetBuilds", []interface{}{query})
+	fake.getBuildsMutex.Unlock()
+	if fake.GetBuildsStub != nil {
+		return fake.GetBuildsStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getBuildsReturns.result1, fake.getBuildsReturns.result2, fake.getBuildsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetBuildsCallCount() int {
+	fake.getBuildsMutex.RLock()
+	defer fake.getBuildsMutex.RUnlock()
+	return len(fake.getBuildsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetBuildsArgsForCall(i int) url.Values {
+	fake.getBuildsMutex.RLock()
+	defer fake.getBuildsMutex.RUnlock()
+	return fake.getBuildsArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetBuildsReturns(result1 []ccv3.Build, result2 ccv3.Warnings, result3 error) {
+	fake.GetBuildsStub = nil
+	fake.getBuildsReturns = struct {
+		result1 []ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetBuildsReturnsOnCall(i int, result1 []ccv3.Build, result2 ccv3.Warnings, result3 error) {
+	fake.GetBuildsStub = nil
+	if fake.getBuildsReturnsOnCall == nil {
+		fake.getBuildsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Build
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildsReturnsOnCall[i] = struct {
+		result1 []ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentSpaces(isolationSegmentGUID string) (ccv3.RelationshipList, ccv3.Warnings, error) {
+	fake.getIsolationSegmentSpacesMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentSpacesReturnsOnCall[len(fake.getIsolationSegmentSpacesArgsForCall)]
+	fake.getIsolationSegmentSpacesArgsForCall = append(fake.getIsolationSegmentSpacesArgsForCall, struct {
+		isolationSegmentGUID string
+	}{isolationSegmentGUID})
+	fake.recordInvocation("GetIsolationSegmentSpaces", []interface{}{isolationSegmentGUID})
+	fake.getIsolationSegmentSpacesMutex.Unlock()
+	if fake.GetIsolationSegmentSpacesStub != nil {
+		return fake.GetIsolationSegmentSpacesStub(isolationSegmentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentSpacesReturns.result1, fake.getIsolationSegmentSpacesReturns.result2, fake.getIsolationSegmentSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentSpacesCallCount() int {
+	fake.getIsolationSegmentSpacesMutex.RLock()
+	defer fake.getIsolationSegmentSpacesMutex.RUnlock()
+	return len(fake.getIsolationSegmentSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentSpacesArgsForCall(i int) string {
+	fake.getIsolationSegmentSpacesMutex.RLock()
+	defer fake.getIsolationSegmentSpacesMutex.RUnlock()
+	return fake.getIsolationSegmentSpacesArgsForCall[i].isolationSegmentGUID
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentSpacesReturns(result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.GetIsolationSegmentSpacesStub = nil
+	fake.getIsolationSegmentSpacesReturns = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetIsolationSegmentSpacesReturnsOnCall(i int, result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.GetIsolationSegmentSpacesStub = nil
+	if fake.getIsolationSegmentSpacesReturnsOnCall == nil {
+		fake.getIsolationSegmentSpacesReturnsOnCall = make(map[int]struct {
+			result1 ccv3.RelationshipList
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentSpacesReturnsOnCall[i] = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaces(query url.Values) ([]ccv3.Space, ccv3.Warnings, error) {
+	fake.getSpacesMutex.Lock()
+	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
+	fake.getSpacesArgsForCall = append(fake.getSpacesArgsForCall, struct {
+		query url.Values
+	}{query})
+	fake.recordInvocation("GetSpaces", []interface{}{query})
+	fake.getSpacesMutex.Unlock()
+	if fake.GetSpacesStub != nil {
+		return fake.GetSpacesStub(query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpacesReturns.result1, fake.getSpacesReturns.result2, fake.getSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesCallCount() int {
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	return len(fake.getSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesArgsForCall(i int) url.Values {
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	return fake.getSpacesArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesReturns(result1 []ccv3.Space, result2 ccv3.Warnings, result3 error) {
+	fake.GetSpacesStub = nil
+	fake.getSpacesReturns = struct {
+		result1 []ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpacesReturnsOnCall(i int, result1 []ccv3.Space, result2 ccv3.Warnings, result3 error) {
+	fake.GetSpacesStub = nil
+	if fake.getSpacesReturnsOnCall == nil {
+		fake.getSpacesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Space
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getSpacesReturnsOnCall[i] = struct {
+		result1 []ccv3.Space
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpaces(serviceInstanceGUID string, spaceGUIDs []string) (ccv3.RelationshipList, ccv3.Warnings, error) {
+	var spaceGUIDsCopy []string
+	if spaceGUIDs != nil {
+		spaceGUIDsCopy = make([]string, len(spaceGUIDs))
+		copy(spaceGUIDsCopy, spaceGUIDs)
+	}
+	fake.shareServiceInstanceToSpacesMutex.Lock()
+	ret, specificReturn := fake.shareServiceInstanceToSpacesReturnsOnCall[len(fake.shareServiceInstanceToSpacesArgsForCall)]
+	fake.shareServiceInstanceToSpacesArgsForCall = append(fake.shareServiceInstanceToSpacesArgsForCall, struct {
+		serviceInstanceGUID string
+		spaceGUIDs          []string
+	}{serviceInstanceGUID, spaceGUIDsCopy})
+	fake.recordInvocation("ShareServiceInstanceToSpaces", []interface{}{serviceInstanceGUID, spaceGUIDsCopy})
+	fake.shareServiceInstanceToSpacesMutex.Unlock()
+	if fake.ShareServiceInstanceToSpacesStub != nil {
+		return fake.ShareServiceInstanceToSpacesStub(serviceInstanceGUID, spaceGUIDs)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.shareServiceInstanceToSpacesReturns.result1, fake.shareServiceInstanceToSpacesReturns.result2, fake.shareServiceInstanceToSpacesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesCallCount() int {
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	return len(fake.shareServiceInstanceToSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesArgsForCall(i int) (string, []string) {
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	return fake.shareServiceInstanceToSpacesArgsForCall[i].serviceInstanceGUID, fake.shareServiceInstanceToSpacesArgsForCall[i].spaceGUIDs
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesReturns(result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.ShareServiceInstanceToSpacesStub = nil
+	fake.shareServiceInstanceToSpacesReturns = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesReturnsOnCall(i int, result1 ccv3.RelationshipList, result2 ccv3.Warnings, result3 error) {
+	fake.ShareServiceInstanceToSpacesStub = nil
+	if fake.shareServiceInstanceToSpacesReturnsOnCall == nil {
+		fake.shareServiceInstanceToSpacesReturnsOnCall = make(map[int]struct {
+			result1 ccv3.RelationshipList
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.shareServiceInstanceToSpacesReturnsOnCall[i] = struct {
+		result1 ccv3.RelationshipList
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpace(serviceInstanceGUID string, spaceGUID string) (ccv3.Warnings, error) {
+	fake.unshareServiceInstanceFromSpaceMutex.Lock()
+	ret, specificReturn := fake.unshareServiceInstanceFromSpaceReturnsOnCall[len(fake.unshareServiceInstanceFromSpaceArgsForCall)]
+	fake.unshareServiceInstanceFromSpaceArgsForCall = append(fake.unshareServiceInstanceFromSpaceArgsForCall, struct {
+		serviceInstanceGUID string
+		spaceGUID           string
+	}{serviceInstanceGUID, spaceGUID})
+	fake.recordInvocation("UnshareServiceInstanceFromSpace", []interface{}{serviceInstanceGUID, spaceGUID})
+	fake.unshareServiceInstanceFromSpaceMutex.Unlock()
+	if fake.UnshareServiceInstanceFromSpaceStub != nil {
+		return fake.UnshareServiceInstanceFromSpaceStub(serviceInstanceGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.unshareServiceInstanceFromSpaceReturns.result1, fake.unshareServiceInstanceFromSpaceReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpaceCallCount() int {
+	fake.unshareServiceInstanceFromSpaceMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpaceMutex.RUnlock()
+	return len(fake.unshareServiceInstanceFromSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpaceArgsForCall(i int) (string, string) {
+	fake.unshareServiceInstanceFromSpaceMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpaceMutex.RUnlock()
+	return fake.unshareServiceInstanceFromSpaceArgsForCall[i].serviceInstanceGUID, fake.unshareServiceInstanceFromSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpaceReturns(result1 ccv3.Warnings, result2 error) {
+	fake.UnshareServiceInstanceFromSpaceStub = nil
+	fake.unshareServiceInstanceFromSpaceReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UnshareServiceInstanceFromSpaceReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.UnshareServiceInstanceFromSpaceStub = nil
+	if fake.unshareServiceInstanceFromSpaceReturnsOnCall == nil {
+		fake.unshareServiceInstanceFromSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.unshareServiceInstanceFromSpaceReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcess(process ccv3.Process) (ccv3.Warnings, error) {
+	fake.updateProcessMutex.Lock()
+	ret, specificReturn := fake.updateProcessReturnsOnCall[len(fake.updateProcessArgsForCall)]
+	fake.updateProcessArgsForCall = append(fake.updateProcessArgsForCall, struct {
+		process ccv3.Process
+	}{process})
+	fake.recordInvocation("UpdateProcess", []interface{}{process})
+	fake.updateProcessMutex.Unlock()
+	if fake.UpdateProcessStub != nil {
+		return fake.UpdateProcessStub(process)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateProcessReturns.result1, fake.updateProcessReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessCallCount() int {
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
+	return len(fake.updateProcessArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessArgsForCall(i int) ccv3.Process {
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
+	return fake.updateProcessArgsForCall[i].process
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessReturns(result1 ccv3.Warnings, result2 error) {
+	fake.UpdateProcessStub = nil
+	fake.updateProcessReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.UpdateProcessStub = nil
+	if fake.updateProcessReturnsOnCall == nil {
+		fake.updateProcessReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.updateProcessReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecars(appGUID string) ([]ccv3.Sidecar, ccv3.Warnings, error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	ret, specificReturn := fake.getApplicationSidecarsReturnsOnCall[len(fake.getApplicationSidecarsArgsForCall)]
+	fake.getApplicationSidecarsArgsForCall = append(fake.getApplicationSidecarsArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationSidecars", []interface{}{appGUID})
+	fake.getApplicationSidecarsMutex.Unlock()
+	if fake.GetApplicationSidecarsStub != nil {
+		return fake.GetApplicationSidecarsStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationSidecarsReturns.result1, fake.getApplicationSidecarsReturns.result2, fake.getApplicationSidecarsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsCallCount() int {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	return len(fake.getApplicationSidecarsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsArgsForCall(i int) string {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	return fake.getApplicationSidecarsArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsReturns(result1 []ccv3.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationSidecarsStub = nil
+	fake.getApplicationSidecarsReturns = struct {
+		result1 []ccv3.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsReturnsOnCall(i int, result1 []ccv3.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationSidecarsStub = nil
+	if fake.getApplicationSidecarsReturnsOnCall == nil {
+		fake.getApplicationSidecarsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSidecarsReturnsOnCall[i] = struct {
+		result1 []ccv3.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganization(orgGUID string) (string, ccv3.Warnings, error) {
+	fake.deleteOrganizationMutex.Lock()
+	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
+	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("DeleteOrganization", []interface{}{orgGUID})
+	fake.deleteOrganizationMutex.Unlock()
+	if fake.DeleteOrganizationStub != nil {
+		return fake.DeleteOrganizationStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteOrganizationReturns.result1, fake.deleteOrganizationReturns.result2, fake.deleteOrganizationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationCallCount() int {
+	fake.deleteOrganizationMutex.RLock()
+	defer fake.deleteOrganizationMutex.RUnlock()
+	return len(fake.deleteOrganizationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationArgsForCall(i int) string {
+	fake.deleteOrganizationMutex.RLock()
+	defer fake.deleteOrganizationMutex.RUnlock()
+	return fake.deleteOrganizationArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteOrganizationStub = nil
+	fake.deleteOrganizationReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteOrganizationReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteOrganizationStub = nil
+	if fake.deleteOrganizationReturnsOnCall == nil {
+		fake.deleteOrganizationReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteOrganizationReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpace(spaceGUID string) (string, ccv3.Warnings, error) {
+	fake.deleteSpaceMutex.Lock()
+	ret, specificReturn := fake.deleteSpaceReturnsOnCall[len(fake.deleteSpaceArgsForCall)]
+	fake.deleteSpaceArgsForCall = append(fake.deleteSpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("DeleteSpace", []interface{}{spaceGUID})
+	fake.deleteSpaceMutex.Unlock()
+	if fake.DeleteSpaceStub != nil {
+		return fake.DeleteSpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteSpaceReturns.result1, fake.deleteSpaceReturns.result2, fake.deleteSpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceCallCount() int {
+	fake.deleteSpaceMutex.RLock()
+	defer fake.deleteSpaceMutex.RUnlock()
+	return len(fake.deleteSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceArgsForCall(i int) string {
+	fake.deleteSpaceMutex.RLock()
+	defer fake.deleteSpaceMutex.RUnlock()
+	return fake.deleteSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteSpaceStub = nil
+	fake.deleteSpaceReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteSpaceReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteSpaceStub = nil
+	if fake.deleteSpaceReturnsOnCall == nil {
+		fake.deleteSpaceReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteSpaceReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetJob(jobURL string) (ccv3.Job, ccv3.Warnings, error) {
+	fake.getJobMutex.Lock()
+	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
+	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
+		jobURL string
+	}{jobURL})
+	fake.recordInvocation("GetJob", []interface{}{jobURL})
+	fake.getJobMutex.Unlock()
+	if fake.GetJobStub != nil {
+		return fake.GetJobStub(jobURL)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getJobReturns.result1, fake.getJobReturns.result2, fake.getJobReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetJobCallCount() int {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return len(fake.getJobArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetJobArgsForCall(i int) string {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return fake.getJobArgsForCall[i].jobURL
+}
+
+func (fake *FakeCloudControllerClient) GetJobReturns(result1 ccv3.Job, result2 ccv3.Warnings, result3 error) {
+	fake.GetJobStub = nil
+	fake.getJobReturns = struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetJobReturnsOnCall(i int, result1 ccv3.Job, result2 ccv3.Warnings, result3 error) {
+	fake.GetJobStub = nil
+	if fake.getJobReturnsOnCall == nil {
+		fake.getJobReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Job
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getJobReturnsOnCall[i] = struct {
+		result1 ccv3.Job
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationMetadata(appGUID string, metadata ccv3.Metadata) (ccv3.Metadata, ccv3.Warnings, error) {
+	fake.updateApplicationMetadataMutex.Lock()
+	ret, specificReturn := fake.updateApplicationMetadataReturnsOnCall[len(fake.updateApplicationMetadataArgsForCall)]
+	fake.updateApplicationMetadataArgsForCall = append(fake.updateApplicationMetadataArgsForCall, struct {
+		appGUID  string
+		metadata ccv3.Metadata
+	}{appGUID, metadata})
+	fake.recordInvocation("UpdateApplicationMetadata", []interface{}{appGUID, metadata})
+	fake.updateApplicationMetadataMutex.Unlock()
+	if fake.UpdateApplicationMetadataStub != nil {
+		return fake.UpdateApplicationMetadataStub(appGUID, metadata)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateApplicationMetadataReturns.result1, fake.updateApplicationMetadataReturns.result2, fake.updateApplicationMetadataReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationMetadataCallCount() int {
+	fake.updateApplicationMetadataMutex.RLock()
+	defer fake.updateApplicationMetadataMutex.RUnlock()
+	return len(fake.updateApplicationMetadataArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationMetadataArgsForCall(i int) (string, ccv3.Metadata) {
+	fake.updateApplicationMetadataMutex.RLock()
+	defer fake.updateApplicationMetadataMutex.RUnlock()
+	return fake.updateApplicationMetadataArgsForCall[i].appGUID, fake.updateApplicationMetadataArgsForCall[i].metadata
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationMetadataReturns(result1 ccv3.Metadata, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationMetadataStub = nil
+	fake.updateApplicationMetadataReturns = struct {
+		result1 ccv3.Metadata
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationMetadataReturnsOnCall(i int, result1 ccv3.Metadata, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationMetadataStub = nil
+	if fake.updateApplicationMetadataReturnsOnCall == nil {
+		fake.updateApplicationMetadataReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Metadata
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationMetadataReturnsOnCall[i] = struct {
+		result1 ccv3.Metadata
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationMetadata(orgGUID string, metadata ccv3.Metadata) (ccv3.Metadata, ccv3.Warnings, error) {
+	fake.updateOrganizationMetadataMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationMetadataReturnsOnCall[len(fake.updateOrganizationMetadataArgsForCall)]
+	fake.updateOrganizationMetadataArgsForCall = append(fake.updateOrganizationMetadataArgsForCall, struct {
+		orgGUID  string
+		metadata ccv3.Metadata
+	}{orgGUID, metadata})
+	fake.recordInvocation("UpdateOrganizationMetadata", []interface{}{orgGUID, metadata})
+	fake.updateOrganizationMetadataMutex.Unlock()
+	if fake.UpdateOrganizationMetadataStub != nil {
+		return fake.UpdateOrganizationMetadataStub(orgGUID, metadata)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateOrganizationMetadataReturns.result1, fake.updateOrganizationMetadataReturns.result2, fake.updateOrganizationMetadataReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationMetadataCallCount() int {
+	fake.updateOrganizationMetadataMutex.RLock()
+	defer fake.updateOrganizationMetadataMutex.RUnlock()
+	return len(fake.updateOrganizationMetadataArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationMetadataArgsForCall(i int) (string, ccv3.Metadata) {
+	fake.updateOrganizationMetadataMutex.RLock()
+	defer fake.updateOrganizationMetadataMutex.RUnlock()
+	return fake.updateOrganizationMetadataArgsForCall[i].orgGUID, fake.updateOrganizationMetadataArgsForCall[i].metadata
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationMetadataReturns(result1 ccv3.Metadata, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateOrganizationMetadataStub = nil
+	fake.updateOrganizationMetadataReturns = struct {
+		result1 ccv3.Metadata
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationMetadataReturnsOnCall(i int, result1 ccv3.Metadata, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateOrganizationMetadataStub = nil
+	if fake.updateOrganizationMetadataReturnsOnCall == nil {
+		fake.updateOrganizationMetadataReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Metadata
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateOrganizationMetadataReturnsOnCall[i] = struct {
+		result1 ccv3.Metadata
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceMetadata(spaceGUID string, metadata ccv3.Metadata) (ccv3.Metadata, ccv3.Warnings, error) {
+	fake.updateSpaceMetadataMutex.Lock()
+	ret, specificReturn := fake.updateSpaceMetadataReturnsOnCall[len(fake.updateSpaceMetadataArgsForCall)]
+	fake.updateSpaceMetadataArgsForCall = append(fake.updateSpaceMetadataArgsForCall, struct {
+		spaceGUID string
+		metadata  ccv3.Metadata
+	}{spaceGUID, metadata})
+	fake.recordInvocation("UpdateSpaceMetadata", []interface{}{spaceGUID, metadata})
+	fake.updateSpaceMetadataMutex.Unlock()
+	if fake.UpdateSpaceMetadataStub != nil {
+		return fake.UpdateSpaceMetadataStub(spaceGUID, metadata)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateSpaceMetadataReturns.result1, fake.updateSpaceMetadataReturns.result2, fake.updateSpaceMetadataReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceMetadataCallCount() int {
+	fake.updateSpaceMetadataMutex.RLock()
+	defer fake.updateSpaceMetadataMutex.RUnlock()
+	return len(fake.updateSpaceMetadataArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceMetadataArgsForCall(i int) (string, ccv3.Metadata) {
+	fake.updateSpaceMetadataMutex.RLock()
+	defer fake.updateSpaceMetadataMutex.RUnlock()
+	return fake.updateSpaceMetadataArgsForCall[i].spaceGUID, fake.updateSpaceMetadataArgsForCall[i].metadata
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceMetadataReturns(result1 ccv3.Metadata, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateSpaceMetadataStub = nil
+	fake.updateSpaceMetadataReturns = struct {
+		result1 ccv3.Metadata
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceMetadataReturnsOnCall(i int, result1 ccv3.Metadata, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateSpaceMetadataStub = nil
+	if fake.updateSpaceMetadataReturnsOnCall == nil {
+		fake.updateSpaceMetadataReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Metadata
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateSpaceMetadataReturnsOnCall[i] = struct {
+		result1 ccv3.Metadata
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2690,6 +3949,42 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateTaskMutex.RUnlock()
 	fake.uploadPackageMutex.RLock()
 	defer fake.uploadPackageMutex.RUnlock()
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	fake.getApplicationDropletCurrentMutex.RLock()
+	defer fake.getApplicationDropletCurrentMutex.RUnlock()
+	fake.createApplicationDeploymentMutex.RLock()
+	defer fake.createApplicationDeploymentMutex.RUnlock()
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	fake.updateSpaceApplyManifestMutex.RLock()
+	defer fake.updateSpaceApplyManifestMutex.RUnlock()
+	fake.getBuildsMutex.RLock()
+	defer fake.getBuildsMutex.RUnlock()
+	fake.getIsolationSegmentSpacesMutex.RLock()
+	defer fake.getIsolationSegmentSpacesMutex.RUnlock()
+	fake.getSpacesMutex.RLock()
+	defer fake.getSpacesMutex.RUnlock()
+	fake.shareServiceInstanceToSpacesMutex.RLock()
+	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	fake.unshareServiceInstanceFromSpaceMutex.RLock()
+	defer fake.unshareServiceInstanceFromSpaceMutex.RUnlock()
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	fake.deleteOrganizationMutex.RLock()
+	defer fake.deleteOrganizationMutex.RUnlock()
+	fake.deleteSpaceMutex.RLock()
+	defer fake.deleteSpaceMutex.RUnlock()
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	fake.updateApplicationMetadataMutex.RLock()
+	defer fake.updateApplicationMetadataMutex.RUnlock()
+	fake.updateOrganizationMetadataMutex.RLock()
+	defer fake.updateOrganizationMetadataMutex.RUnlock()
+	fake.updateSpaceMetadataMutex.RLock()
+	defer fake.updateSpaceMetadataMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
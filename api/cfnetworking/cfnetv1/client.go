@@ -91,6 +91,10 @@ type Config struct {
 	// AppVersion is the version of the application/process using the client.
 	AppVersion string
 
+	// CACert is the PEM encoded CA certificates trusted in addition to the
+	// system's when verifying the server's certificate chain.
+	CACert []byte
+
 	// DialTimeout is the DNS timeout used to make all requests to the Cloud
 	// Controller.
 	DialTimeout time.Duration
@@ -116,6 +120,7 @@ func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
 
 	connection := cfnetworking.NewConnection(cfnetworking.Config{
+		CACert:            config.CACert,
 		DialTimeout:       config.DialTimeout,
 		SkipSSLValidation: config.SkipSSLValidation,
 	})
@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cfnetworking/networkerror"
+	"code.cloudfoundry.org/cli/util/cacert"
+	"code.cloudfoundry.org/cli/util/timings"
 )
 
 // NetworkingConnection represents a connection to the Cloud Controller
@@ -23,6 +25,7 @@ type NetworkingConnection struct {
 
 // Config is for configuring a NetworkingConnection.
 type Config struct {
+	CACert            []byte
 	DialTimeout       time.Duration
 	SkipSSLValidation bool
 }
@@ -33,6 +36,7 @@ func NewConnection(config Config) *NetworkingConnection {
 	tr := &http.Transport{
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: config.SkipSSLValidation,
+			RootCAs:            cacert.NewCertPool(config.CACert),
 		},
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
@@ -42,7 +46,7 @@ func NewConnection(config Config) *NetworkingConnection {
 	}
 
 	return &NetworkingConnection{
-		HTTPClient: &http.Client{Transport: tr},
+		HTTPClient: &http.Client{Transport: timings.NewTransport(tr)},
 	}
 }
 
@@ -139,7 +139,7 @@ func (logger *RequestLogger) displaySortedHeaders(headers http.Header) error {
 
 	for _, key := range keys {
 		for _, value := range headers[key] {
-			err := logger.output.DisplayHeader(key, redactHeaders(key, value))
+			err := logger.output.DisplayHeader(key, value)
 			if err != nil {
 				return err
 			}
@@ -147,10 +147,3 @@ func (logger *RequestLogger) displaySortedHeaders(headers http.Header) error {
 	}
 	return nil
 }
-
-func redactHeaders(key string, value string) string {
-	if key == "Authorization" {
-		return "[PRIVATE DATA HIDDEN]"
-	}
-	return value
-}
@@ -0,0 +1,17 @@
+package ccerror
+
+import "fmt"
+
+// CloudControllerUnavailableError is returned when the Cloud Controller, or
+// the load balancer in front of it, responds with a 502 or 503 that does not
+// contain a Cloud Controller error. This usually happens while the Cloud
+// Controller is being upgraded or is in maintenance mode.
+type CloudControllerUnavailableError struct {
+	StatusCode  int
+	URL         string
+	RawResponse []byte
+}
+
+func (e CloudControllerUnavailableError) Error() string {
+	return fmt.Sprintf("Cloud Controller at %s is temporarily unavailable (status code %d)", e.URL, e.StatusCode)
+}
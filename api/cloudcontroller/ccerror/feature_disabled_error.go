@@ -0,0 +1,11 @@
+package ccerror
+
+// FeatureDisabledError is returned when the request relies on a feature flag
+// that is disabled on the Cloud Controller.
+type FeatureDisabledError struct {
+	Message string
+}
+
+func (e FeatureDisabledError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,12 @@
+package ccerror
+
+// FoundationMismatchError is returned when the access token was issued by a
+// different UAA than the one the targeted Cloud Controller uses.
+type FoundationMismatchError struct {
+	Issuer      string
+	UAAEndpoint string
+}
+
+func (FoundationMismatchError) Error() string {
+	return "The API endpoint now points at a different foundation than your session was created for"
+}
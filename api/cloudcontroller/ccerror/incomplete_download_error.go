@@ -0,0 +1,13 @@
+package ccerror
+
+import "fmt"
+
+// IncompleteDownloadError is returned when a download ends before the number
+// of bytes advertised by the response's Content-Length has been received.
+type IncompleteDownloadError struct {
+	ExpectedBytes int64
+}
+
+func (e IncompleteDownloadError) Error() string {
+	return fmt.Sprintf("download incomplete: expected %d bytes", e.ExpectedBytes)
+}
@@ -0,0 +1,11 @@
+package ccerror
+
+// RoutePortTakenError is returned when creating a route with a port that is
+// already in use on the domain's router group.
+type RoutePortTakenError struct {
+	Message string
+}
+
+func (e RoutePortTakenError) Error() string {
+	return e.Message
+}
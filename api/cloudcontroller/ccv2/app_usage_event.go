@@ -0,0 +1,127 @@
+package ccv2
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// AppUsageEvent represents a Cloud Controller App Usage Event.
+type AppUsageEvent struct {
+	GUID      string
+	CreatedAt time.Time
+
+	State         string
+	PreviousState string
+
+	AppGUID   string
+	AppName   string
+	SpaceGUID string
+	SpaceName string
+	OrgGUID   string
+
+	InstanceCount         int
+	PreviousInstanceCount int
+
+	MemoryInMBPerInstance         int
+	PreviousMemoryInMBPerInstance int
+
+	BuildpackGUID string
+	BuildpackName string
+
+	PackageState         string
+	PreviousPackageState string
+
+	ProcessType string
+	TaskGUID    string
+	TaskName    string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller App Usage Event response.
+func (event *AppUsageEvent) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			State                         string `json:"state"`
+			PreviousState                 string `json:"previous_state"`
+			AppGUID                       string `json:"app_guid"`
+			AppName                       string `json:"app_name"`
+			SpaceGUID                     string `json:"space_guid"`
+			SpaceName                     string `json:"space_name"`
+			OrgGUID                       string `json:"org_guid"`
+			InstanceCount                 int    `json:"instance_count"`
+			PreviousInstanceCount         int    `json:"previous_instance_count"`
+			MemoryInMBPerInstance         int    `json:"memory_in_mb_per_instance"`
+			PreviousMemoryInMBPerInstance int    `json:"previous_memory_in_mb_per_instance"`
+			BuildpackGUID                 string `json:"buildpack_guid"`
+			BuildpackName                 string `json:"buildpack_name"`
+			PackageState                  string `json:"package_state"`
+			PreviousPackageState          string `json:"previous_package_state"`
+			ProcessType                   string `json:"process_type"`
+			TaskGUID                      string `json:"task_guid"`
+			TaskName                      string `json:"task_name"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccEvent); err != nil {
+		return err
+	}
+
+	event.GUID = ccEvent.Metadata.GUID
+	event.CreatedAt = ccEvent.Metadata.CreatedAt
+	event.State = ccEvent.Entity.State
+	event.PreviousState = ccEvent.Entity.PreviousState
+	event.AppGUID = ccEvent.Entity.AppGUID
+	event.AppName = ccEvent.Entity.AppName
+	event.SpaceGUID = ccEvent.Entity.SpaceGUID
+	event.SpaceName = ccEvent.Entity.SpaceName
+	event.OrgGUID = ccEvent.Entity.OrgGUID
+	event.InstanceCount = ccEvent.Entity.InstanceCount
+	event.PreviousInstanceCount = ccEvent.Entity.PreviousInstanceCount
+	event.MemoryInMBPerInstance = ccEvent.Entity.MemoryInMBPerInstance
+	event.PreviousMemoryInMBPerInstance = ccEvent.Entity.PreviousMemoryInMBPerInstance
+	event.BuildpackGUID = ccEvent.Entity.BuildpackGUID
+	event.BuildpackName = ccEvent.Entity.BuildpackName
+	event.PackageState = ccEvent.Entity.PackageState
+	event.PreviousPackageState = ccEvent.Entity.PreviousPackageState
+	event.ProcessType = ccEvent.Entity.ProcessType
+	event.TaskGUID = ccEvent.Entity.TaskGUID
+	event.TaskName = ccEvent.Entity.TaskName
+	return nil
+}
+
+// GetAppUsageEvents pages through the app usage events created after the
+// event with the provided GUID (all events if afterGUID is empty), oldest
+// first. eventFunc is called for each event as its page arrives; returning an
+// error from eventFunc stops the pagination.
+func (client *Client) GetAppUsageEvents(afterGUID string, eventFunc func(AppUsageEvent) error) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppUsageEventsRequest,
+		Query:       usageEventsQuery(afterGUID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.paginate(request, AppUsageEvent{}, func(item interface{}) error {
+		if event, ok := item.(AppUsageEvent); ok {
+			return eventFunc(event)
+		}
+		return ccerror.UnknownObjectInListError{
+			Expected:   AppUsageEvent{},
+			Unexpected: item,
+		}
+	})
+}
+
+// usageEventsQuery returns the query parameters shared by the usage event
+// endpoints.
+func usageEventsQuery(afterGUID string) url.Values {
+	query := url.Values{}
+	if afterGUID != "" {
+		query.Set("after_guid", afterGUID)
+	}
+	return query
+}
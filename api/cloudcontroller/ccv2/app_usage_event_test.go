@@ -0,0 +1,170 @@
+package ccv2_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("App Usage Event", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetAppUsageEvents", func() {
+		var (
+			events     []AppUsageEvent
+			eventFunc  func(AppUsageEvent) error
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			events = nil
+			eventFunc = func(event AppUsageEvent) error {
+				events = append(events, event)
+				return nil
+			}
+		})
+
+		Context("when results are paginated", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/app_usage_events?after_guid=some-guid&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "event-guid-1",
+								"created_at": "2017-01-02T03:04:05Z"
+							},
+							"entity": {
+								"state": "STARTED",
+								"previous_state": "STOPPED",
+								"app_guid": "app-guid-1",
+								"app_name": "app-1",
+								"space_guid": "space-guid",
+								"space_name": "space",
+								"org_guid": "org-guid",
+								"instance_count": 2,
+								"previous_instance_count": 1,
+								"memory_in_mb_per_instance": 256,
+								"previous_memory_in_mb_per_instance": 128,
+								"buildpack_guid": "buildpack-guid",
+								"buildpack_name": "ruby_buildpack",
+								"package_state": "STAGED",
+								"previous_package_state": "PENDING",
+								"process_type": "web"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "event-guid-2",
+								"created_at": "2017-01-03T03:04:05Z"
+							},
+							"entity": {
+								"state": "TASK_STARTED",
+								"app_guid": "app-guid-1",
+								"task_guid": "task-guid",
+								"task_name": "migrate"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/app_usage_events", "after_guid=some-guid"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/app_usage_events", "after_guid=some-guid&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			JustBeforeEach(func() {
+				warnings, executeErr = client.GetAppUsageEvents("some-guid", eventFunc)
+			})
+
+			It("calls eventFunc for every event and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(events).To(Equal([]AppUsageEvent{
+					{
+						GUID:                          "event-guid-1",
+						CreatedAt:                     time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC),
+						State:                         "STARTED",
+						PreviousState:                 "STOPPED",
+						AppGUID:                       "app-guid-1",
+						AppName:                       "app-1",
+						SpaceGUID:                     "space-guid",
+						SpaceName:                     "space",
+						OrgGUID:                       "org-guid",
+						InstanceCount:                 2,
+						PreviousInstanceCount:         1,
+						MemoryInMBPerInstance:         256,
+						PreviousMemoryInMBPerInstance: 128,
+						BuildpackGUID:                 "buildpack-guid",
+						BuildpackName:                 "ruby_buildpack",
+						PackageState:                  "STAGED",
+						PreviousPackageState:          "PENDING",
+						ProcessType:                   "web",
+					},
+					{
+						GUID:      "event-guid-2",
+						CreatedAt: time.Date(2017, 1, 3, 3, 4, 5, 0, time.UTC),
+						State:     "TASK_STARTED",
+						AppGUID:   "app-guid-1",
+						TaskGUID:  "task-guid",
+						TaskName:  "migrate",
+					},
+				}))
+			})
+
+			Context("when eventFunc returns an error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("stop")
+					eventFunc = func(AppUsageEvent) error {
+						return expectedErr
+					}
+				})
+
+				It("stops paginating and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("warning-1"))
+				})
+			})
+		})
+
+		Context("when no after GUID is provided", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/app_usage_events", ""),
+						RespondWith(http.StatusOK, `{"next_url": null, "resources": []}`),
+					),
+				)
+			})
+
+			It("requests all events", func() {
+				_, err := client.GetAppUsageEvents("", eventFunc)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(events).To(BeEmpty())
+			})
+		})
+	})
+})
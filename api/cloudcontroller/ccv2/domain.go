@@ -15,6 +15,10 @@ type Domain struct {
 	Name            string
 	RouterGroupGUID string
 	RouterGroupType constant.RouterGroupType
+
+	// Internal is true for domains that are only routable from other apps
+	// over the container network (e.g. apps.internal).
+	Internal bool
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Domain response.
@@ -25,6 +29,7 @@ func (domain *Domain) UnmarshalJSON(data []byte) error {
 			Name            string `json:"name"`
 			RouterGroupGUID string `json:"router_group_guid"`
 			RouterGroupType string `json:"router_group_type"`
+			Internal        bool   `json:"internal"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccDomain); err != nil {
@@ -35,6 +40,7 @@ func (domain *Domain) UnmarshalJSON(data []byte) error {
 	domain.Name = ccDomain.Entity.Name
 	domain.RouterGroupGUID = ccDomain.Entity.RouterGroupGUID
 	domain.RouterGroupType = constant.RouterGroupType(ccDomain.Entity.RouterGroupType)
+	domain.Internal = ccDomain.Entity.Internal
 	return nil
 }
 
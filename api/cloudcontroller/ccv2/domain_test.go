@@ -179,7 +179,8 @@ var _ = Describe("Domain", func() {
 							"entity": {
 								"name": "domain-name-4",
 								"router_group_guid": "some-router-group-guid-4",
-								"router_group_type": "http"
+								"router_group_type": "http",
+								"internal": true
 							}
 						}
 					]
@@ -229,6 +230,7 @@ var _ = Describe("Domain", func() {
 						Name:            "domain-name-4",
 						RouterGroupGUID: "some-router-group-guid-4",
 						RouterGroupType: constant.HTTPRouterGroup,
+						Internal:        true,
 					},
 				}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
@@ -64,6 +64,22 @@ var _ = Describe("Error Wrapper", func() {
 			})
 		})
 
+		Context("when the load balancer responds with a 502 or 503", func() {
+			BeforeEach(func() {
+				serverResponseCode = http.StatusBadGateway
+				response = "<html><body><h1>502 Bad Gateway</h1></body></html>"
+			})
+
+			It("returns a CloudControllerUnavailableError", func() {
+				_, _, err := client.GetApplications()
+				Expect(err).To(MatchError(ccerror.CloudControllerUnavailableError{
+					StatusCode:  http.StatusBadGateway,
+					URL:         server.URL(),
+					RawResponse: []byte(response),
+				}))
+			})
+		})
+
 		Context("when the error is from the cloud controller", func() {
 			Context("(400) Bad Request", func() {
 				BeforeEach(func() {
@@ -102,6 +118,22 @@ var _ = Describe("Error Wrapper", func() {
 					})
 				})
 
+				Context("when a route port taken error is encountered", func() {
+					BeforeEach(func() {
+						response = `{
+								"description": "The port is taken: 1024",
+								"error_code": "CF-RoutePortTaken"
+							}`
+					})
+
+					It("returns a RoutePortTakenError", func() {
+						_, _, err := client.GetApplications()
+						Expect(err).To(MatchError(ccerror.RoutePortTakenError{
+							Message: "The port is taken: 1024",
+						}))
+					})
+				})
+
 				Context("when an instances error is encountered", func() {
 					BeforeEach(func() {
 						response = `{
@@ -0,0 +1,108 @@
+package ccv2
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Event represents a Cloud Controller audit Event.
+type Event struct {
+	GUID      string
+	Type      string
+	Timestamp time.Time
+
+	ActorGUID string
+	ActorType string
+	ActorName string
+
+	ActeeGUID string
+	ActeeType string
+	ActeeName string
+
+	// Metadata holds the type specific details of the event, such as the
+	// exit status of a crashed instance.
+	Metadata map[string]interface{}
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Event response.
+func (event *Event) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Type      string    `json:"type"`
+			Timestamp time.Time `json:"timestamp"`
+			Actor     string    `json:"actor"`
+			ActorType string    `json:"actor_type"`
+			ActorName string    `json:"actor_name"`
+			Actee     string    `json:"actee"`
+			ActeeType string    `json:"actee_type"`
+			ActeeName string    `json:"actee_name"`
+
+			Metadata map[string]interface{} `json:"metadata"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccEvent); err != nil {
+		return err
+	}
+
+	event.GUID = ccEvent.Metadata.GUID
+	event.Type = ccEvent.Entity.Type
+	event.Timestamp = ccEvent.Entity.Timestamp
+	event.ActorGUID = ccEvent.Entity.Actor
+	event.ActorType = ccEvent.Entity.ActorType
+	event.ActorName = ccEvent.Entity.ActorName
+	event.ActeeGUID = ccEvent.Entity.Actee
+	event.ActeeType = ccEvent.Entity.ActeeType
+	event.ActeeName = ccEvent.Entity.ActeeName
+	event.Metadata = ccEvent.Entity.Metadata
+	return nil
+}
+
+// GetLatestEvents returns, newest first, up to limit of the most recent
+// events matching the provided queries. Only a single page is requested.
+func (client *Client) GetLatestEvents(limit int, queries ...Query) ([]Event, Warnings, error) {
+	query := FormatQueryParameters(queries)
+	query.Set("order-direction", "desc")
+	query.Set("results-per-page", strconv.Itoa(limit))
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetEventsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := NewPaginatedResources(Event{})
+	response := cloudcontroller.Response{
+		Result: page,
+	}
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, response.Warnings, err
+	}
+
+	items, err := page.Resources()
+	if err != nil {
+		return nil, response.Warnings, err
+	}
+
+	var events []Event
+	for _, item := range items {
+		event, ok := item.(Event)
+		if !ok {
+			return nil, response.Warnings, ccerror.UnknownObjectInListError{
+				Expected:   Event{},
+				Unexpected: item,
+			}
+		}
+		events = append(events, event)
+	}
+
+	return events, response.Warnings, nil
+}
@@ -0,0 +1,121 @@
+package ccv2_test
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Event", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetLatestEvents", func() {
+		var (
+			events     []Event
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = client.GetLatestEvents(1,
+				Query{Filter: ActeeFilter, Operator: EqualOperator, Values: []string{"app-guid"}},
+				Query{Filter: TypeFilter, Operator: InOperator, Values: []string{"audit.app.droplet.create", "audit.app.upload-bits"}},
+			)
+		})
+
+		Context("when the cloud controller returns events", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": "/v2/events?page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "event-guid"
+							},
+							"entity": {
+								"type": "audit.app.droplet.create",
+								"actor": "user-guid",
+								"actor_type": "user",
+								"actor_name": "some-user",
+								"actee": "app-guid",
+								"actee_type": "app",
+								"actee_name": "some-app",
+								"timestamp": "2017-01-02T03:04:05Z",
+								"metadata": {
+									"exit_status": 1
+								}
+							}
+						}
+					]
+				}`
+				query := url.Values{
+					"q":                []string{"actee:app-guid", "type IN audit.app.droplet.create,audit.app.upload-bits"},
+					"order-direction":  []string{"desc"},
+					"results-per-page": []string{"1"},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", query.Encode()),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the events of the first page and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(events).To(Equal([]Event{{
+					GUID:      "event-guid",
+					Type:      "audit.app.droplet.create",
+					Timestamp: time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC),
+					ActorGUID: "user-guid",
+					ActorType: "user",
+					ActorName: "some-user",
+					ActeeGUID: "app-guid",
+					ActeeType: "app",
+					ActeeName: "some-app",
+					Metadata:  map[string]interface{}{"exit_status": float64(1)},
+				}}))
+				Expect(server.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
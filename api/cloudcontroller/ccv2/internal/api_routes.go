@@ -16,6 +16,7 @@ import (
 // The const name should always be the const value + Request.
 const (
 	DeleteOrganizationRequest              = "DeleteOrganization"
+	DeleteRouteAppRequest                  = "DeleteRouteApp"
 	DeleteRouteRequest                     = "DeleteRoute"
 	DeleteRunningSecurityGroupSpaceRequest = "DeleteRunningSecurityGroupSpace"
 	DeleteSecurityGroupSpaceRequest        = "DeleteSecurityGroupSpace"
@@ -27,6 +28,8 @@ const (
 	GetAppRoutesRequest                    = "GetAppRoutes"
 	GetAppsRequest                         = "GetApps"
 	GetAppStatsRequest                     = "GetAppStats"
+	GetAppUsageEventsRequest               = "GetAppUsageEvents"
+	GetEventsRequest                       = "GetEvents"
 	GetInfoRequest                         = "GetInfo"
 	GetJobRequest                          = "GetJob"
 	GetOrganizationPrivateDomainsRequest   = "GetOrganizationPrivateDomains"
@@ -44,7 +47,9 @@ const (
 	GetSecurityGroupStagingSpacesRequest   = "GetSecurityGroupStagingSpaces"
 	GetServiceBindingsRequest              = "GetServiceBindings"
 	GetServiceInstanceRequest              = "GetServiceInstance"
+	GetServiceInstanceSharedToRequest      = "GetServiceInstanceSharedTo"
 	GetServiceInstancesRequest             = "GetServiceInstances"
+	GetServiceUsageEventsRequest           = "GetServiceUsageEvents"
 	GetSharedDomainRequest                 = "GetSharedDomain"
 	GetSharedDomainsRequest                = "GetSharedDomains"
 	GetSpaceQuotaDefinitionRequest         = "GetSpaceQuotaDefinition"
@@ -81,6 +86,8 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/apps/:app_guid/restage", Method: http.MethodPost, Name: PostAppRestageRequest},
 	{Path: "/v2/apps/:app_guid/routes", Method: http.MethodGet, Name: GetAppRoutesRequest},
 	{Path: "/v2/apps/:app_guid/stats", Method: http.MethodGet, Name: GetAppStatsRequest},
+	{Path: "/v2/app_usage_events", Method: http.MethodGet, Name: GetAppUsageEventsRequest},
+	{Path: "/v2/events", Method: http.MethodGet, Name: GetEventsRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: GetJobRequest},
 	{Path: "/v2/organizations", Method: http.MethodGet, Name: GetOrganizationsRequest},
@@ -95,6 +102,7 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/routes/:route_guid", Method: http.MethodDelete, Name: DeleteRouteRequest},
 	{Path: "/v2/routes/:route_guid/apps", Method: http.MethodGet, Name: GetRouteAppsRequest},
 	{Path: "/v2/routes/:route_guid/apps/:app_guid", Method: http.MethodPut, Name: PutBindRouteAppRequest},
+	{Path: "/v2/routes/:route_guid/apps/:app_guid", Method: http.MethodDelete, Name: DeleteRouteAppRequest},
 	{Path: "/v2/routes/:route_guid/route_mappings", Method: http.MethodGet, Name: GetRouteRouteMappingsRequest},
 	{Path: "/v2/routes/reserved/domain/:domain_guid", Method: http.MethodGet, Name: GetRouteReservedRequest},
 	{Path: "/v2/routes/reserved/domain/:domain_guid/host/:host", Method: http.MethodGet, Name: GetRouteReservedDeprecatedRequest},
@@ -110,6 +118,8 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodDelete, Name: DeleteServiceBindingRequest},
 	{Path: "/v2/service_instances", Method: http.MethodGet, Name: GetServiceInstancesRequest},
 	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodGet, Name: GetServiceInstanceRequest},
+	{Path: "/v2/service_instances/:service_instance_guid/shared_to", Method: http.MethodGet, Name: GetServiceInstanceSharedToRequest},
+	{Path: "/v2/service_usage_events", Method: http.MethodGet, Name: GetServiceUsageEventsRequest},
 	{Path: "/v2/shared_domains", Method: http.MethodGet, Name: GetSharedDomainsRequest},
 	{Path: "/v2/shared_domains/:shared_domain_guid", Method: http.MethodGet, Name: GetSharedDomainRequest},
 	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodGet, Name: GetSpaceQuotaDefinitionRequest},
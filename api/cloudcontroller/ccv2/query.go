@@ -13,6 +13,8 @@ type QueryFilter string
 type QueryOperator string
 
 const (
+	// ActeeFilter is the name of the 'actee' filter.
+	ActeeFilter QueryFilter = "actee"
 	// AppGUIDFilter is the name of the 'app_guid' filter.
 	AppGUIDFilter QueryFilter = "app_guid"
 	// DomainGUIDFilter is the name of the 'domain_guid' filter.
@@ -30,6 +32,8 @@ const (
 	NameFilter QueryFilter = "name"
 	// HostFilter is the name of the 'host' filter.
 	HostFilter QueryFilter = "host"
+	// TypeFilter is the name of the 'type' filter.
+	TypeFilter QueryFilter = "type"
 )
 
 const (
@@ -30,6 +30,10 @@ type requestOptions struct {
 
 	// Body is the request body
 	Body io.ReadSeeker
+
+	// Idempotent marks the request as safe to retry; see
+	// cloudcontroller.Request.
+	Idempotent bool
 }
 
 // newHTTPRequest returns a constructed HTTP.Request with some defaults.
@@ -66,5 +70,7 @@ func (client Client) newHTTPRequest(passedRequest requestOptions) (*cloudcontrol
 	}
 
 	// Make sure the body is the same as the one in the request
-	return cloudcontroller.NewRequest(request, passedRequest.Body), nil
+	ccRequest := cloudcontroller.NewRequest(request, passedRequest.Body)
+	ccRequest.Idempotent = passedRequest.Idempotent
+	return ccRequest, nil
 }
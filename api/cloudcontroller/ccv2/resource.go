@@ -67,6 +67,7 @@ func (client *Client) ResourceMatch(resourcesToMatch []Resource) ([]Resource, Wa
 
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.PutResourceMatch,
+		Idempotent:  true,
 		Body:        bytes.NewReader(body),
 	})
 	if err != nil {
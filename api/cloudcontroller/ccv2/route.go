@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -22,6 +23,13 @@ type Route struct {
 	Port       types.NullInt `json:"port,omitempty"`
 	DomainGUID string        `json:"domain_guid"`
 	SpaceGUID  string        `json:"space_guid"`
+
+	// CreatedAt is the time the route was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the time the route was last updated. It is zero if the
+	// route has never been updated.
+	UpdatedAt time.Time `json:"-"`
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Route response.
@@ -46,6 +54,10 @@ func (route *Route) UnmarshalJSON(data []byte) error {
 	route.Port = ccRoute.Entity.Port
 	route.DomainGUID = ccRoute.Entity.DomainGUID
 	route.SpaceGUID = ccRoute.Entity.SpaceGUID
+	route.CreatedAt = ccRoute.Metadata.CreatedAt
+	if ccRoute.Metadata.UpdatedAt != nil {
+		route.UpdatedAt = *ccRoute.Metadata.UpdatedAt
+	}
 	return nil
 }
 
@@ -53,6 +65,7 @@ func (route *Route) UnmarshalJSON(data []byte) error {
 func (client *Client) BindRouteToApplication(routeGUID string, appGUID string) (Route, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.PutBindRouteAppRequest,
+		Idempotent:  true,
 		URIParams: map[string]string{
 			"app_guid":   appGUID,
 			"route_guid": routeGUID,
@@ -71,6 +84,25 @@ func (client *Client) BindRouteToApplication(routeGUID string, appGUID string) (
 	return route, response.Warnings, err
 }
 
+// UnbindRouteFromApplication unbinds the given route from the given
+// application. The route itself is left in place.
+func (client *Client) UnbindRouteFromApplication(routeGUID string, appGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteRouteAppRequest,
+		URIParams: map[string]string{
+			"app_guid":   appGUID,
+			"route_guid": routeGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // CreateRoute creates the route with the given properties; SpaceGUID and
 // DomainGUID are required. Set generatePort true to generate a random port on
 // the cloud controller. generatePort takes precedence over manually specified
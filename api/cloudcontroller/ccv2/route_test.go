@@ -2,6 +2,7 @@ package ccv2_test
 
 import (
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -88,6 +89,54 @@ var _ = Describe("Route", func() {
 		})
 	})
 
+	Describe("UnbindRouteFromApplication", func() {
+		Context("when route unbinding is successful", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/routes/some-route-guid/apps/some-app-guid"),
+						RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the warnings", func() {
+				warnings, err := client.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cc returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/routes/some-route-guid/apps/some-app-guid"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				warnings, err := client.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("CreateRoute", func() {
 		Context("when route creation is successful", func() {
 			Context("when generate port is true", func() {
@@ -601,7 +650,8 @@ var _ = Describe("Route", func() {
 					{
 						"metadata": {
 							"guid": "route-guid-1",
-							"updated_at": null
+							"created_at": "2017-06-01T10:00:00Z",
+							"updated_at": "2017-06-30T15:04:05Z"
 						},
 						"entity": {
 							"host": "host-1",
@@ -614,6 +664,7 @@ var _ = Describe("Route", func() {
 					{
 						"metadata": {
 							"guid": "route-guid-2",
+							"created_at": "2017-06-01T10:00:00Z",
 							"updated_at": null
 						},
 						"entity": {
@@ -686,6 +737,8 @@ var _ = Describe("Route", func() {
 						Port:       types.NullInt{IsSet: false},
 						DomainGUID: "some-http-domain",
 						SpaceGUID:  "some-space-guid-1",
+						CreatedAt:  time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC),
+						UpdatedAt:  time.Date(2017, 6, 30, 15, 4, 5, 0, time.UTC),
 					},
 					{
 						GUID:       "route-guid-2",
@@ -694,6 +747,7 @@ var _ = Describe("Route", func() {
 						Port:       types.NullInt{IsSet: true, Value: 3333},
 						DomainGUID: "some-tcp-domain",
 						SpaceGUID:  "some-space-guid-1",
+						CreatedAt:  time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC),
 					},
 					{
 						GUID:       "route-guid-3",
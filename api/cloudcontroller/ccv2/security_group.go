@@ -74,6 +74,7 @@ func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 func (client *Client) AssociateSpaceWithRunningSecurityGroup(securityGroupGUID string, spaceGUID string) (Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.PutRunningSecurityGroupSpaceRequest,
+		Idempotent:  true,
 		URIParams: Params{
 			"security_group_guid": securityGroupGUID,
 			"space_guid":          spaceGUID,
@@ -93,6 +94,7 @@ func (client *Client) AssociateSpaceWithRunningSecurityGroup(securityGroupGUID s
 func (client *Client) AssociateSpaceWithStagingSecurityGroup(securityGroupGUID string, spaceGUID string) (Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.PutStagingSecurityGroupSpaceRequest,
+		Idempotent:  true,
 		URIParams: Params{
 			"security_group_guid": securityGroupGUID,
 			"space_guid":          spaceGUID,
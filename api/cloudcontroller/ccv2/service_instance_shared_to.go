@@ -0,0 +1,71 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServiceInstanceSharedTo represents a space a service instance has been
+// shared into.
+type ServiceInstanceSharedTo struct {
+	// SpaceGUID is the GUID of the space the instance is shared into.
+	SpaceGUID string
+
+	// SpaceName is the name of the space the instance is shared into.
+	SpaceName string
+
+	// OrganizationName is the name of the organization of that space.
+	OrganizationName string
+
+	// BoundAppCount is the number of apps in that space bound to the
+	// instance.
+	BoundAppCount int
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller shared to response.
+func (sharedTo *ServiceInstanceSharedTo) UnmarshalJSON(data []byte) error {
+	var ccSharedTo struct {
+		SpaceGUID        string `json:"space_guid"`
+		SpaceName        string `json:"space_name"`
+		OrganizationName string `json:"organization_name"`
+		BoundAppCount    int    `json:"bound_app_count"`
+	}
+	if err := json.Unmarshal(data, &ccSharedTo); err != nil {
+		return err
+	}
+
+	sharedTo.SpaceGUID = ccSharedTo.SpaceGUID
+	sharedTo.SpaceName = ccSharedTo.SpaceName
+	sharedTo.OrganizationName = ccSharedTo.OrganizationName
+	sharedTo.BoundAppCount = ccSharedTo.BoundAppCount
+	return nil
+}
+
+// GetServiceInstanceSharedTos returns the spaces the service instance has
+// been shared into.
+func (client *Client) GetServiceInstanceSharedTos(serviceInstanceGUID string) ([]ServiceInstanceSharedTo, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceInstanceSharedToRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSharedToList []ServiceInstanceSharedTo
+	warnings, err := client.paginate(request, ServiceInstanceSharedTo{}, func(item interface{}) error {
+		if sharedTo, ok := item.(ServiceInstanceSharedTo); ok {
+			fullSharedToList = append(fullSharedToList, sharedTo)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServiceInstanceSharedTo{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSharedToList, warnings, err
+}
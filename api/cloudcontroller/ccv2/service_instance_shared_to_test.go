@@ -0,0 +1,90 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Instance Shared To", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServiceInstanceSharedTos", func() {
+		Context("when the cc api returns no errors", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/service_instances/some-instance-guid/shared_to?page=2",
+					"resources": [
+						{
+							"space_guid": "space-guid-1",
+							"space_name": "space-1",
+							"organization_name": "org-1",
+							"bound_app_count": 2
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"space_guid": "space-guid-2",
+							"space_name": "space-2",
+							"organization_name": "org-2",
+							"bound_app_count": 0
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_instances/some-instance-guid/shared_to"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_instances/some-instance-guid/shared_to", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns all the spaces the instance is shared into and all warnings", func() {
+				sharedTos, warnings, err := client.GetServiceInstanceSharedTos("some-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+				Expect(sharedTos).To(Equal([]ServiceInstanceSharedTo{
+					{SpaceGUID: "space-guid-1", SpaceName: "space-1", OrganizationName: "org-1", BoundAppCount: 2},
+					{SpaceGUID: "space-guid-2", SpaceName: "space-2", OrganizationName: "org-2", BoundAppCount: 0},
+				}))
+			})
+		})
+
+		Context("when the cc api returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 60004,
+					"description": "The service instance could not be found: some-instance-guid",
+					"error_code": "CF-ServiceInstanceNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_instances/some-instance-guid/shared_to"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetServiceInstanceSharedTos("some-instance-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The service instance could not be found: some-instance-guid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package ccv2
+
+import (
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServiceUsageEvent represents a Cloud Controller Service Usage Event.
+type ServiceUsageEvent struct {
+	GUID      string
+	CreatedAt time.Time
+
+	State string
+
+	OrgGUID   string
+	SpaceGUID string
+	SpaceName string
+
+	ServiceInstanceGUID string
+	ServiceInstanceName string
+	ServiceInstanceType string
+
+	ServicePlanGUID string
+	ServicePlanName string
+
+	ServiceGUID  string
+	ServiceLabel string
+
+	ServiceBrokerGUID string
+	ServiceBrokerName string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Usage Event
+// response.
+func (event *ServiceUsageEvent) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			State               string `json:"state"`
+			OrgGUID             string `json:"org_guid"`
+			SpaceGUID           string `json:"space_guid"`
+			SpaceName           string `json:"space_name"`
+			ServiceInstanceGUID string `json:"service_instance_guid"`
+			ServiceInstanceName string `json:"service_instance_name"`
+			ServiceInstanceType string `json:"service_instance_type"`
+			ServicePlanGUID     string `json:"service_plan_guid"`
+			ServicePlanName     string `json:"service_plan_name"`
+			ServiceGUID         string `json:"service_guid"`
+			ServiceLabel        string `json:"service_label"`
+			ServiceBrokerGUID   string `json:"service_broker_guid"`
+			ServiceBrokerName   string `json:"service_broker_name"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccEvent); err != nil {
+		return err
+	}
+
+	event.GUID = ccEvent.Metadata.GUID
+	event.CreatedAt = ccEvent.Metadata.CreatedAt
+	event.State = ccEvent.Entity.State
+	event.OrgGUID = ccEvent.Entity.OrgGUID
+	event.SpaceGUID = ccEvent.Entity.SpaceGUID
+	event.SpaceName = ccEvent.Entity.SpaceName
+	event.ServiceInstanceGUID = ccEvent.Entity.ServiceInstanceGUID
+	event.ServiceInstanceName = ccEvent.Entity.ServiceInstanceName
+	event.ServiceInstanceType = ccEvent.Entity.ServiceInstanceType
+	event.ServicePlanGUID = ccEvent.Entity.ServicePlanGUID
+	event.ServicePlanName = ccEvent.Entity.ServicePlanName
+	event.ServiceGUID = ccEvent.Entity.ServiceGUID
+	event.ServiceLabel = ccEvent.Entity.ServiceLabel
+	event.ServiceBrokerGUID = ccEvent.Entity.ServiceBrokerGUID
+	event.ServiceBrokerName = ccEvent.Entity.ServiceBrokerName
+	return nil
+}
+
+// GetServiceUsageEvents pages through the service usage events created after
+// the event with the provided GUID (all events if afterGUID is empty), oldest
+// first. eventFunc is called for each event as its page arrives; returning an
+// error from eventFunc stops the pagination.
+func (client *Client) GetServiceUsageEvents(afterGUID string, eventFunc func(ServiceUsageEvent) error) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServiceUsageEventsRequest,
+		Query:       usageEventsQuery(afterGUID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.paginate(request, ServiceUsageEvent{}, func(item interface{}) error {
+		if event, ok := item.(ServiceUsageEvent); ok {
+			return eventFunc(event)
+		}
+		return ccerror.UnknownObjectInListError{
+			Expected:   ServiceUsageEvent{},
+			Unexpected: item,
+		}
+	})
+}
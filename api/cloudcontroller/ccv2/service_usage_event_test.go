@@ -0,0 +1,115 @@
+package ccv2_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Usage Event", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServiceUsageEvents", func() {
+		var events []ServiceUsageEvent
+
+		BeforeEach(func() {
+			events = nil
+		})
+
+		collect := func(event ServiceUsageEvent) error {
+			events = append(events, event)
+			return nil
+		}
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "event-guid-1",
+								"created_at": "2017-01-02T03:04:05Z"
+							},
+							"entity": {
+								"state": "CREATED",
+								"org_guid": "org-guid",
+								"space_guid": "space-guid",
+								"space_name": "space",
+								"service_instance_guid": "instance-guid",
+								"service_instance_name": "instance",
+								"service_instance_type": "managed_service_instance",
+								"service_plan_guid": "plan-guid",
+								"service_plan_name": "small",
+								"service_guid": "service-guid",
+								"service_label": "mysql",
+								"service_broker_guid": "broker-guid",
+								"service_broker_name": "broker"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_usage_events", "after_guid=some-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("calls eventFunc for every event and returns warnings", func() {
+				warnings, err := client.GetServiceUsageEvents("some-guid", collect)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(events).To(Equal([]ServiceUsageEvent{{
+					GUID:                "event-guid-1",
+					CreatedAt:           time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC),
+					State:               "CREATED",
+					OrgGUID:             "org-guid",
+					SpaceGUID:           "space-guid",
+					SpaceName:           "space",
+					ServiceInstanceGUID: "instance-guid",
+					ServiceInstanceName: "instance",
+					ServiceInstanceType: "managed_service_instance",
+					ServicePlanGUID:     "plan-guid",
+					ServicePlanName:     "small",
+					ServiceGUID:         "service-guid",
+					ServiceLabel:        "mysql",
+					ServiceBrokerGUID:   "broker-guid",
+					ServiceBrokerName:   "broker",
+				}}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/service_usage_events"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.GetServiceUsageEvents("", collect)
+				Expect(err).To(MatchError(ccerror.ForbiddenError{Message: "You are not authorized to perform the requested action"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -24,6 +24,7 @@ type Application struct {
 	GUID          string        `json:"guid,omitempty"`
 	State         string        `json:"state,omitempty"`
 	Lifecycle     AppLifecycle  `json:"lifecycle,omitempty"`
+	Metadata      *Metadata     `json:"metadata,omitempty"`
 }
 
 type AppLifecycle struct {
@@ -3,8 +3,10 @@ package ccv3
 import (
 	"bytes"
 	"encoding/json"
+	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
 
@@ -23,6 +25,8 @@ type Build struct {
 	PackageGUID string
 	State       BuildState
 	DropletGUID string
+	// Buildpacks are the names of the buildpacks requested for the build.
+	Buildpacks []string
 }
 
 func (b Build) MarshalJSON() ([]byte, error) {
@@ -49,6 +53,11 @@ func (b *Build) UnmarshalJSON(data []byte) error {
 		Droplet struct {
 			GUID string `json:"guid"`
 		} `json:"droplet"`
+		Lifecycle struct {
+			Data struct {
+				Buildpacks []string `json:"buildpacks"`
+			} `json:"data"`
+		} `json:"lifecycle"`
 	}
 
 	if err := json.Unmarshal(data, &ccBuild); err != nil {
@@ -61,6 +70,7 @@ func (b *Build) UnmarshalJSON(data []byte) error {
 	b.PackageGUID = ccBuild.Package.GUID
 	b.State = ccBuild.State
 	b.DropletGUID = ccBuild.Droplet.GUID
+	b.Buildpacks = ccBuild.Lifecycle.Data.Buildpacks
 
 	return nil
 }
@@ -108,3 +118,29 @@ func (client *Client) GetBuild(guid string) (Build, Warnings, error) {
 
 	return responseBuild, response.Warnings, err
 }
+
+// GetBuilds lists builds with optional filters.
+func (client *Client) GetBuilds(query url.Values) ([]Build, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetBuildsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullBuildsList []Build
+	warnings, err := client.paginate(request, Build{}, func(item interface{}) error {
+		if build, ok := item.(Build); ok {
+			fullBuildsList = append(fullBuildsList, build)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Build{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullBuildsList, warnings, err
+}
@@ -1,7 +1,9 @@
 package ccv3_test
 
 import (
+	"fmt"
 	"net/http"
+	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -187,4 +189,131 @@ var _ = Describe("Build", func() {
 			})
 		})
 	})
+
+	Describe("GetBuilds", func() {
+		Context("when the builds exist", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/builds?app_guids=some-app-guid&order_by=-created_at&page=2"
+						}
+					},
+					"resources": [
+						{
+							"created_at": "2017-08-16T00:18:24Z",
+							"guid": "some-build-guid-1",
+							"state": "STAGED",
+							"error": null,
+							"lifecycle": {
+								"type": "buildpack",
+								"data": {
+									"buildpacks": ["some-buildpack"],
+									"stack": "some-stack"
+								}
+							},
+							"droplet": {
+								"guid": "some-droplet-guid"
+							}
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"created_at": "2017-08-15T00:18:24Z",
+							"guid": "some-build-guid-2",
+							"state": "FAILED",
+							"error": "NoAppDetectedError - An app was not successfully detected by any available buildpack",
+							"lifecycle": {
+								"type": "buildpack",
+								"data": {
+									"buildpacks": [],
+									"stack": "some-stack"
+								}
+							},
+							"droplet": null
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/builds", "app_guids=some-app-guid&order_by=-created_at"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/builds", "app_guids=some-app-guid&order_by=-created_at&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the builds of every page and all warnings", func() {
+				builds, warnings, err := client.GetBuilds(url.Values{
+					AppGUIDFilter: []string{"some-app-guid"},
+					OrderBy:       []string{CreatedAtDescendingOrder},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(builds).To(Equal([]Build{
+					{
+						CreatedAt:   "2017-08-16T00:18:24Z",
+						GUID:        "some-build-guid-1",
+						State:       BuildStateStaged,
+						DropletGUID: "some-droplet-guid",
+						Buildpacks:  []string{"some-buildpack"},
+					},
+					{
+						CreatedAt:  "2017-08-15T00:18:24Z",
+						GUID:       "some-build-guid-2",
+						State:      BuildStateFailed,
+						Error:      "NoAppDetectedError - An app was not successfully detected by any available buildpack",
+						Buildpacks: []string{},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: command presence",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/builds"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetBuilds(nil)
+				Expect(err).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
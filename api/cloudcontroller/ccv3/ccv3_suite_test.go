@@ -106,6 +106,9 @@ func SetupV3Response() {
 			"builds": {
 				"href": "SERVER_URL/v3/builds"
 			},
+			"deployments": {
+				"href": "SERVER_URL/v3/deployments"
+			},
 			"organizations": {
 				"href": "SERVER_URL/v3/organizations"
 			},
@@ -118,6 +121,9 @@ func SetupV3Response() {
 			"processes": {
 				"href": "SERVER_URL/v3/processes"
 			},
+			"service_instances": {
+				"href": "SERVER_URL/v3/service_instances"
+			},
 			"droplets": {
 				"href": "SERVER_URL/v3/droplets"
 			}
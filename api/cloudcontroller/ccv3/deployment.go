@@ -0,0 +1,111 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+type DeploymentState string
+
+const (
+	DeploymentStateDeploying DeploymentState = "DEPLOYING"
+	DeploymentStateDeployed  DeploymentState = "DEPLOYED"
+	DeploymentStateCanceled  DeploymentState = "CANCELED"
+)
+
+// Deployment represents a rolling replacement of an app's instances with
+// instances running a new droplet.
+type Deployment struct {
+	GUID        string
+	State       DeploymentState
+	AppGUID     string
+	DropletGUID string
+	CreatedAt   string
+}
+
+func (d Deployment) MarshalJSON() ([]byte, error) {
+	var ccDeployment struct {
+		Droplet struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+		Relationships Relationships `json:"relationships"`
+	}
+
+	ccDeployment.Droplet.GUID = d.DropletGUID
+	ccDeployment.Relationships = Relationships{
+		ApplicationRelationship: Relationship{GUID: d.AppGUID},
+	}
+
+	return json.Marshal(ccDeployment)
+}
+
+func (d *Deployment) UnmarshalJSON(data []byte) error {
+	var ccDeployment struct {
+		GUID      string          `json:"guid"`
+		State     DeploymentState `json:"state"`
+		CreatedAt string          `json:"created_at"`
+		Droplet   struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+		Relationships Relationships `json:"relationships"`
+	}
+
+	if err := json.Unmarshal(data, &ccDeployment); err != nil {
+		return err
+	}
+
+	d.GUID = ccDeployment.GUID
+	d.State = ccDeployment.State
+	d.CreatedAt = ccDeployment.CreatedAt
+	d.DropletGUID = ccDeployment.Droplet.GUID
+	d.AppGUID = ccDeployment.Relationships[ApplicationRelationship].GUID
+
+	return nil
+}
+
+// CreateApplicationDeployment starts a rolling deployment of the given droplet
+// to the app and returns the deployment's GUID.
+func (client *Client) CreateApplicationDeployment(appGUID string, dropletGUID string) (string, Warnings, error) {
+	bodyBytes, err := json.Marshal(Deployment{AppGUID: appGUID, DropletGUID: dropletGUID})
+	if err != nil {
+		return "", nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostApplicationDeploymentRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	var responseDeployment Deployment
+	response := cloudcontroller.Response{
+		Result: &responseDeployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDeployment.GUID, response.Warnings, err
+}
+
+// GetDeployment gets the deployment with the given GUID.
+func (client *Client) GetDeployment(guid string) (Deployment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDeploymentRequest,
+		URIParams:   internal.Params{"deployment_guid": guid},
+	})
+	if err != nil {
+		return Deployment{}, nil, err
+	}
+
+	var responseDeployment Deployment
+	response := cloudcontroller.Response{
+		Result: &responseDeployment,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDeployment, response.Warnings, err
+}
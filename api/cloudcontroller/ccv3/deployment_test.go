@@ -0,0 +1,170 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Deployment", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateApplicationDeployment", func() {
+		Context("when the deployment is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"state": "DEPLOYING",
+					"droplet": {
+						"guid": "some-droplet-guid"
+					},
+					"relationships": {
+						"app": {
+							"data": {
+								"guid": "some-app-guid"
+							}
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"droplet": map[string]interface{}{
+						"guid": "some-droplet-guid",
+					},
+					"relationships": map[string]interface{}{
+						"app": map[string]interface{}{
+							"data": map[string]interface{}{
+								"guid": "some-app-guid",
+							},
+						},
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the deployment GUID and warnings", func() {
+				deploymentGUID, warnings, err := client.CreateApplicationDeployment("some-app-guid", "some-droplet-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		Context("when cc returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "I can't even",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateApplicationDeployment("some-app-guid", "some-droplet-guid")
+				Expect(err).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "I can't even",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetDeployment", func() {
+		Context("when the deployment exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"state": "DEPLOYED",
+					"created_at": "2017-11-08T22:26:02Z",
+					"droplet": {
+						"guid": "some-droplet-guid"
+					},
+					"relationships": {
+						"app": {
+							"data": {
+								"guid": "some-app-guid"
+							}
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the deployment and warnings", func() {
+				deployment, warnings, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(deployment).To(Equal(Deployment{
+					GUID:        "some-deployment-guid",
+					State:       DeploymentStateDeployed,
+					AppGUID:     "some-app-guid",
+					DropletGUID: "some-droplet-guid",
+					CreatedAt:   "2017-11-08T22:26:02Z",
+				}))
+			})
+		})
+
+		Context("when the deployment does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Deployment not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/deployments/some-deployment-guid"),
+						RespondWith(http.StatusNotFound, response),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError", func() {
+				_, _, err := client.GetDeployment("some-deployment-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Deployment not found"}))
+			})
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package ccv3
 
 import (
+	"io"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -75,3 +76,47 @@ func (client *Client) GetDroplet(dropletGUID string) (Droplet, Warnings, error)
 
 	return responseDroplet, response.Warnings, err
 }
+
+// GetApplicationDropletCurrent returns the current droplet for a given app.
+func (client *Client) GetApplicationDropletCurrent(appGUID string) (Droplet, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetApplicationDropletCurrentRequest,
+		URIParams:   map[string]string{"app_guid": appGUID},
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	var responseDroplet Droplet
+	response := cloudcontroller.Response{
+		Result: &responseDroplet,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDroplet, response.Warnings, err
+}
+
+// DownloadDroplet returns the bits of the given droplet. If provided, the
+// progressReader is used to report the progress of the download.
+func (client *Client) DownloadDroplet(dropletGUID string, progressReader cloudcontroller.ProgressReader) ([]byte, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDropletDownloadRequest,
+		URIParams:   map[string]string{"droplet_guid": dropletGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := cloudcontroller.Response{
+		ProgressReader: progressReader,
+	}
+	err = client.connection.Make(request, &response)
+	incomplete := err == nil && response.HTTPResponse.ContentLength > int64(len(response.RawResponse))
+	if err == io.ErrUnexpectedEOF || incomplete {
+		return nil, response.Warnings, ccerror.IncompleteDownloadError{
+			ExpectedBytes: response.HTTPResponse.ContentLength,
+		}
+	}
+
+	return response.RawResponse, response.Warnings, err
+}
@@ -2,10 +2,13 @@ package ccv3_test
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -232,4 +235,137 @@ var _ = Describe("Droplet", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationDropletCurrent", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-guid",
+					"state": "STAGED",
+					"stack": "some-stack",
+					"created_at": "2016-03-28T23:39:34Z"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets/current"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the current droplet and all warnings", func() {
+				droplet, warnings, err := client.GetApplicationDropletCurrent("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(droplet).To(Equal(Droplet{
+					GUID:      "some-guid",
+					Stack:     "some-stack",
+					State:     "STAGED",
+					CreatedAt: "2016-03-28T23:39:34Z",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the app does not have a current droplet", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets/current"),
+						RespondWith(http.StatusNotFound, response),
+					),
+				)
+			})
+
+			It("returns a DropletNotFoundError", func() {
+				_, _, err := client.GetApplicationDropletCurrent("some-app-guid")
+				Expect(err).To(MatchError(ccerror.DropletNotFoundError{}))
+			})
+		})
+	})
+
+	Describe("DownloadDroplet", func() {
+		var fakeProgressReader *cloudcontrollerfakes.FakeProgressReader
+
+		BeforeEach(func() {
+			fakeProgressReader = new(cloudcontrollerfakes.FakeProgressReader)
+			fakeProgressReader.WrapStub = func(reader io.Reader) io.ReadCloser {
+				return ioutil.NopCloser(reader)
+			}
+		})
+
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-guid/download"),
+						RespondWith(http.StatusOK, "some-droplet-bits", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the droplet bits and all warnings", func() {
+				bits, warnings, err := client.DownloadDroplet("some-guid", fakeProgressReader)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(bits).To(Equal([]byte("some-droplet-bits")))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeProgressReader.StartCallCount()).To(Equal(1))
+				Expect(fakeProgressReader.StartArgsForCall(0)).To(BeEquivalentTo(len("some-droplet-bits")))
+				Expect(fakeProgressReader.FinishCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the response is shorter than its content length", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-guid/download"),
+						RespondWith(http.StatusOK, "some-droplet-bits", http.Header{"Content-Length": {"100"}}),
+					),
+				)
+			})
+
+			It("returns an IncompleteDownloadError", func() {
+				_, _, err := client.DownloadDroplet("some-guid", fakeProgressReader)
+				Expect(err).To(MatchError(ccerror.IncompleteDownloadError{ExpectedBytes: 100}))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Droplet not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-guid/download"),
+						RespondWith(http.StatusNotFound, response),
+					),
+				)
+			})
+
+			It("returns the error without reporting progress", func() {
+				_, _, err := client.DownloadDroplet("some-guid", fakeProgressReader)
+				Expect(err).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(fakeProgressReader.StartCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -2,6 +2,7 @@ package ccv3
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -30,11 +31,31 @@ func (e *errorWrapper) Make(request *cloudcontroller.Request, passedResponse *cl
 	err := e.connection.Make(request, passedResponse)
 
 	if rawHTTPStatusErr, ok := err.(ccerror.RawHTTPStatusError); ok {
+		if isUnavailable(rawHTTPStatusErr) {
+			return ccerror.CloudControllerUnavailableError{
+				StatusCode:  rawHTTPStatusErr.StatusCode,
+				URL:         fmt.Sprintf("%s://%s", request.URL.Scheme, request.URL.Host),
+				RawResponse: rawHTTPStatusErr.RawResponse,
+			}
+		}
 		return convert(rawHTTPStatusErr)
 	}
 	return err
 }
 
+// isUnavailable returns true for 502 and 503 responses that do not contain a
+// Cloud Controller error, such as the HTML pages served by a load balancer
+// while the Cloud Controller is unavailable.
+func isUnavailable(rawHTTPStatusErr ccerror.RawHTTPStatusError) bool {
+	if rawHTTPStatusErr.StatusCode != http.StatusBadGateway &&
+		rawHTTPStatusErr.StatusCode != http.StatusServiceUnavailable {
+		return false
+	}
+
+	var body map[string]interface{}
+	return json.Unmarshal(rawHTTPStatusErr.RawResponse, &body) != nil
+}
+
 func convert(rawHTTPStatusErr ccerror.RawHTTPStatusError) error {
 	// Try to unmarshal the raw error into a CC error. If unmarshaling fails,
 	// return the raw error.
@@ -68,6 +89,9 @@ func convert(rawHTTPStatusErr ccerror.RawHTTPStatusError) error {
 		}
 		return ccerror.UnauthorizedError{Message: firstErr.Detail}
 	case http.StatusForbidden: // 403
+		if firstErr.Title == "CF-FeatureDisabled" {
+			return ccerror.FeatureDisabledError{Message: firstErr.Detail}
+		}
 		return ccerror.ForbiddenError{Message: firstErr.Detail}
 	case http.StatusNotFound: // 404
 		return handleNotFound(firstErr)
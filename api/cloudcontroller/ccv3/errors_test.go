@@ -67,6 +67,21 @@ var _ = Describe("Error Wrapper", func() {
 				})
 			})
 
+			Context("and the raw status is 502 or 503", func() {
+				BeforeEach(func() {
+					serverResponseCode = http.StatusServiceUnavailable
+					serverResponse = "<html><body><h1>503 Service Unavailable</h1></body></html>"
+				})
+
+				It("returns a CloudControllerUnavailableError", func() {
+					Expect(makeError).To(MatchError(ccerror.CloudControllerUnavailableError{
+						StatusCode:  http.StatusServiceUnavailable,
+						URL:         server.URL(),
+						RawResponse: []byte(serverResponse),
+					}))
+				})
+			})
+
 			Context("and the raw status is another error", func() {
 				BeforeEach(func() {
 					serverResponseCode = http.StatusTeapot
@@ -138,6 +153,25 @@ var _ = Describe("Error Wrapper", func() {
 				It("returns a ForbiddenError", func() {
 					Expect(makeError).To(MatchError(ccerror.ForbiddenError{Message: "SomeCC Error Message"}))
 				})
+
+				Context("when a feature flag is disabled", func() {
+					BeforeEach(func() {
+						serverResponse = `
+{
+  "errors": [
+    {
+      "code": 330002,
+      "detail": "Feature Disabled: service_instance_sharing",
+      "title": "CF-FeatureDisabled"
+    }
+  ]
+}`
+					})
+
+					It("returns a FeatureDisabledError", func() {
+						Expect(makeError).To(MatchError(ccerror.FeatureDisabledError{Message: "Feature Disabled: service_instance_sharing"}))
+					})
+				})
 			})
 
 			Context("(404) Not Found", func() {
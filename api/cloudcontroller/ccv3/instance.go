@@ -18,6 +18,8 @@ type Instance struct {
 	MemoryQuota uint64
 	DiskUsage   uint64
 	DiskQuota   uint64
+	// Details explains the state of the instance, such as why it crashed.
+	Details string
 }
 
 // UnmarshalJSON helps unmarshal a V3 Cloud Controller Instance response.
@@ -33,6 +35,7 @@ func (instance *Instance) UnmarshalJSON(data []byte) error {
 		DiskQuota uint64 `json:"disk_quota"`
 		Index     int    `json:"index"`
 		Uptime    int    `json:"uptime"`
+		Details   string `json:"details"`
 	}
 	if err := json.Unmarshal(data, &inputInstance); err != nil {
 		return err
@@ -47,6 +50,7 @@ func (instance *Instance) UnmarshalJSON(data []byte) error {
 	instance.DiskQuota = inputInstance.DiskQuota
 	instance.Index = inputInstance.Index
 	instance.Uptime = inputInstance.Uptime
+	instance.Details = inputInstance.Details
 
 	return nil
 }
@@ -88,7 +88,8 @@ var _ = Describe("Instance", func() {
 							"uptime": 123
 						},
 						{
-							"state": "RUNNING",
+							"state": "CRASHED",
+							"details": "Exited with status 1",
 							"usage": {
 								"cpu": 0.02,
 								"mem": 8000000,
@@ -125,7 +126,8 @@ var _ = Describe("Instance", func() {
 						Uptime:      123,
 					},
 					Instance{
-						State:       "RUNNING",
+						State:       "CRASHED",
+						Details:     "Exited with status 1",
 						CPU:         0.02,
 						MemoryUsage: 8000000,
 						DiskUsage:   16000000,
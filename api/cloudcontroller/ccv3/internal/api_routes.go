@@ -15,14 +15,23 @@ const (
 	DeleteApplicationRequest                              = "DeleteApplication"
 	DeleteIsolationSegmentRelationshipOrganizationRequest = "DeleteIsolationSegmentRelationshipOrganization"
 	DeleteIsolationSegmentRequest                         = "DeleteIsolationSegment"
+	DeleteOrganizationRequest                             = "DeleteOrganization"
+	DeleteServiceInstanceRelationshipsSharedSpaceRequest  = "DeleteServiceInstanceRelationshipsSharedSpace"
+	DeleteSpaceRequest                                    = "DeleteSpace"
 	GetAppDropletsRequest                                 = "GetAppDroplets"
+	GetApplicationDropletCurrentRequest                   = "GetApplicationDropletCurrent"
 	GetAppProcessesRequest                                = "GetAppProcesses"
+	GetAppSidecarsRequest                                 = "GetAppSidecars"
 	GetAppTasksRequest                                    = "GetAppTasks"
 	GetApplicationProcessByTypeRequest                    = "GetApplicationProcessByType"
 	GetAppsRequest                                        = "GetApps"
 	GetBuildRequest                                       = "GetBuild"
+	GetBuildsRequest                                      = "GetBuilds"
+	GetDeploymentRequest                                  = "GetDeployment"
+	GetDropletDownloadRequest                             = "GetDropletDownload"
 	GetDropletRequest                                     = "GetDroplet"
 	GetIsolationSegmentOrganizationsRequest               = "GetIsolationSegmentRelationshipOrganizations"
+	GetIsolationSegmentRelationshipSpacesRequest          = "GetIsolationSegmentRelationshipSpaces"
 	GetIsolationSegmentRequest                            = "GetIsolationSegment"
 	GetIsolationSegmentsRequest                           = "GetIsolationSegments"
 	GetOrganizationDefaultIsolationSegmentRequest         = "GetOrganizationDefaultIsolationSegment"
@@ -31,31 +40,40 @@ const (
 	GetPackagesRequest                                    = "GetPackages"
 	GetProcessInstancesRequest                            = "GetProcessInstances"
 	GetSpaceRelationshipIsolationSegmentRequest           = "GetSpaceRelationshipIsolationSegmentRequest"
+	GetSpacesRequest                                      = "GetSpaces"
 	PatchApplicationCurrentDropletRequest                 = "PatchApplicationCurrentDroplet"
 	PatchApplicationProcessHealthCheckRequest             = "PatchApplicationProcessHealthCheck"
 	PatchApplicationRequest                               = "PatchApplicationRequest"
 	PatchOrganizationDefaultIsolationSegmentRequest       = "PatchOrganizationDefaultIsolationSegmentRequest"
+	PatchOrganizationRequest                              = "PatchOrganization"
+	PatchProcessRequest                                   = "PatchProcess"
 	PatchSpaceRelationshipIsolationSegmentRequest         = "PatchSpaceRelationshipIsolationSegmentRequest"
+	PatchSpaceRequest                                     = "PatchSpace"
 	PostAppTasksRequest                                   = "PostAppTasks"
 	PostApplicationProcessScaleRequest                    = "PostApplicationProcessScale"
 	PostApplicationRequest                                = "PostApplicationRequest"
 	PostApplicationStartRequest                           = "PostApplicationStart"
 	PostApplicationStopRequest                            = "PostApplicationStop"
+	PostApplicationDeploymentRequest                      = "PostApplicationDeployment"
 	PostBuildRequest                                      = "PostBuild"
 	PostIsolationSegmentRelationshipOrganizationsRequest  = "PostIsolationSegmentRelationshipOrganizations"
 	PostIsolationSegmentsRequest                          = "PostIsolationSegments"
 	PostPackageRequest                                    = "PostPackageRequest"
+	PostServiceInstanceRelationshipsSharedSpacesRequest   = "PostServiceInstanceRelationshipsSharedSpaces"
+	PostSpaceActionApplyManifestRequest                   = "PostSpaceActionApplyManifest"
 	PutTaskCancelRequest                                  = "PutTaskCancelRequest"
 )
 
 const (
 	AppsResource              = "apps"
 	BuildsResource            = "builds"
+	DeploymentsResource       = "deployments"
 	DropletsResource          = "droplets"
 	IsolationSegmentsResource = "isolation_segments"
 	OrgsResource              = "organizations"
 	PackagesResource          = "packages"
 	ProcessesResource         = "processes"
+	ServiceInstancesResource  = "service_instances"
 	SpacesResource            = "spaces"
 	TasksResource             = "tasks"
 )
@@ -63,37 +81,53 @@ const (
 // APIRoutes is a list of routes used by the router to construct request URLs.
 var APIRoutes = []Route{
 	{Path: "/", Method: http.MethodGet, Name: GetAppsRequest, Resource: AppsResource},
+	{Path: "/", Method: http.MethodGet, Name: GetBuildsRequest, Resource: BuildsResource},
 	{Path: "/", Method: http.MethodGet, Name: GetIsolationSegmentsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/", Method: http.MethodGet, Name: GetOrgsRequest, Resource: OrgsResource},
 	{Path: "/", Method: http.MethodGet, Name: GetPackagesRequest, Resource: PackagesResource},
+	{Path: "/", Method: http.MethodGet, Name: GetSpacesRequest, Resource: SpacesResource},
 	{Path: "/", Method: http.MethodPost, Name: PostApplicationRequest, Resource: AppsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostBuildRequest, Resource: BuildsResource},
+	{Path: "/", Method: http.MethodPost, Name: PostApplicationDeploymentRequest, Resource: DeploymentsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostIsolationSegmentsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/", Method: http.MethodPost, Name: PostPackageRequest, Resource: PackagesResource},
 	{Path: "/:app_guid", Method: http.MethodDelete, Name: DeleteApplicationRequest, Resource: AppsResource},
 	{Path: "/:isolation_segment_guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRequest, Resource: IsolationSegmentsResource},
+	{Path: "/:organization_guid", Method: http.MethodDelete, Name: DeleteOrganizationRequest, Resource: OrgsResource},
+	{Path: "/:space_guid", Method: http.MethodDelete, Name: DeleteSpaceRequest, Resource: SpacesResource},
 	{Path: "/:build_guid", Method: http.MethodGet, Name: GetBuildRequest, Resource: BuildsResource},
+	{Path: "/:deployment_guid", Method: http.MethodGet, Name: GetDeploymentRequest, Resource: DeploymentsResource},
 	{Path: "/:isolation_segment_guid", Method: http.MethodGet, Name: GetIsolationSegmentRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:package_guid", Method: http.MethodGet, Name: GetPackageRequest, Resource: PackagesResource},
 	{Path: "/:process_guid", Method: http.MethodPatch, Name: PatchApplicationProcessHealthCheckRequest, Resource: ProcessesResource},
+	{Path: "/:process_guid", Method: http.MethodPatch, Name: PatchProcessRequest, Resource: ProcessesResource},
 	{Path: "/:app_guid", Method: http.MethodPatch, Name: PatchApplicationRequest, Resource: AppsResource},
+	{Path: "/:organization_guid", Method: http.MethodPatch, Name: PatchOrganizationRequest, Resource: OrgsResource},
+	{Path: "/:space_guid", Method: http.MethodPatch, Name: PatchSpaceRequest, Resource: SpacesResource},
 	{Path: "/:app_guid/actions/start", Method: http.MethodPost, Name: PostApplicationStartRequest, Resource: AppsResource},
 	{Path: "/:app_guid/actions/stop", Method: http.MethodPost, Name: PostApplicationStopRequest, Resource: AppsResource},
 	{Path: "/:task_guid/cancel", Method: http.MethodPut, Name: PutTaskCancelRequest, Resource: TasksResource},
 	{Path: "/:app_guid/droplets", Method: http.MethodGet, Name: GetAppDropletsRequest, Resource: AppsResource},
+	{Path: "/:app_guid/droplets/current", Method: http.MethodGet, Name: GetApplicationDropletCurrentRequest, Resource: AppsResource},
 	{Path: "/:droplet_guid", Method: http.MethodGet, Name: GetDropletRequest, Resource: DropletsResource},
+	{Path: "/:droplet_guid/download", Method: http.MethodGet, Name: GetDropletDownloadRequest, Resource: DropletsResource},
 	{Path: "/:isolation_segment_guid/organizations", Method: http.MethodGet, Name: GetIsolationSegmentOrganizationsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:app_guid/processes", Method: http.MethodGet, Name: GetAppProcessesRequest, Resource: AppsResource},
 	{Path: "/:app_guid/processes/:type", Method: http.MethodGet, Name: GetApplicationProcessByTypeRequest, Resource: AppsResource},
 	{Path: "/:app_guid/processes/:type/actions/scale", Method: http.MethodPost, Name: PostApplicationProcessScaleRequest, Resource: AppsResource},
 	{Path: "/:app_guid/processes/:type/instances/:index", Method: http.MethodDelete, Name: DeleteApplicationProcessInstanceRequest, Resource: AppsResource},
+	{Path: "/:app_guid/sidecars", Method: http.MethodGet, Name: GetAppSidecarsRequest, Resource: AppsResource},
 	{Path: "/:app_guid/relationships/current_droplet", Method: http.MethodPatch, Name: PatchApplicationCurrentDropletRequest, Resource: AppsResource},
 	{Path: "/:organization_guid/relationships/default_isolation_segment", Method: http.MethodGet, Name: GetOrganizationDefaultIsolationSegmentRequest, Resource: OrgsResource},
 	{Path: "/:organization_guid/relationships/default_isolation_segment", Method: http.MethodPatch, Name: PatchOrganizationDefaultIsolationSegmentRequest, Resource: OrgsResource},
+	{Path: "/:space_guid/actions/apply_manifest", Method: http.MethodPost, Name: PostSpaceActionApplyManifestRequest, Resource: SpacesResource},
 	{Path: "/:space_guid/relationships/isolation_segment", Method: http.MethodGet, Name: GetSpaceRelationshipIsolationSegmentRequest, Resource: SpacesResource},
 	{Path: "/:space_guid/relationships/isolation_segment", Method: http.MethodPatch, Name: PatchSpaceRelationshipIsolationSegmentRequest, Resource: SpacesResource},
+	{Path: "/:isolation_segment_guid/relationships/spaces", Method: http.MethodGet, Name: GetIsolationSegmentRelationshipSpacesRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:isolation_segment_guid/relationships/organizations", Method: http.MethodPost, Name: PostIsolationSegmentRelationshipOrganizationsRequest, Resource: IsolationSegmentsResource},
 	{Path: "/:isolation_segment_guid/relationships/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteIsolationSegmentRelationshipOrganizationRequest, Resource: IsolationSegmentsResource},
+	{Path: "/:service_instance_guid/relationships/shared_spaces", Method: http.MethodPost, Name: PostServiceInstanceRelationshipsSharedSpacesRequest, Resource: ServiceInstancesResource},
+	{Path: "/:service_instance_guid/relationships/shared_spaces/:space_guid", Method: http.MethodDelete, Name: DeleteServiceInstanceRelationshipsSharedSpaceRequest, Resource: ServiceInstancesResource},
 	{Path: "/:process_guid/stats", Method: http.MethodGet, Name: GetProcessInstancesRequest, Resource: ProcessesResource},
 	{Path: "/:app_guid/tasks", Method: http.MethodGet, Name: GetAppTasksRequest, Resource: AppsResource},
 	{Path: "/:app_guid/tasks", Method: http.MethodPost, Name: PostAppTasksRequest, Resource: AppsResource},
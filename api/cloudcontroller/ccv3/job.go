@@ -27,11 +27,17 @@ type ErrorDetails struct {
 	Code   int    `json:"code"`
 }
 
+// JobWarning is a warning the Cloud Controller reported while running a job.
+type JobWarning struct {
+	Detail string `json:"detail"`
+}
+
 // Job represents a Cloud Controller Job.
 type Job struct {
-	Errors []ErrorDetails `json:"errors"`
-	GUID   string         `json:"guid"`
-	State  JobState       `json:"state"`
+	Errors   []ErrorDetails `json:"errors"`
+	GUID     string         `json:"guid"`
+	State    JobState       `json:"state"`
+	Warnings []JobWarning   `json:"warnings"`
 }
 
 // Complete returns true when the job has completed successfully.
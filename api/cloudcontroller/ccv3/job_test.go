@@ -55,6 +55,11 @@ var _ = Describe("Job", func() {
 						"updated_at": "2016-06-08T16:41:27Z",
 						"operation": "app.delete",
 						"state": "PROCESSING",
+						"warnings": [
+							{
+								"detail": "deleting service instance some-service"
+							}
+						],
 						"links": {
 							"self": {
 								"href": "/v3/jobs/job-guid"
@@ -77,6 +82,7 @@ var _ = Describe("Job", func() {
 				Expect(warnings).To(ConsistOf(Warnings{"warning-1", "warning-2"}))
 				Expect(job.GUID).To(Equal("job-guid"))
 				Expect(job.State).To(Equal(JobStateProcessing))
+				Expect(job.Warnings).To(Equal([]JobWarning{{Detail: "deleting service instance some-service"}}))
 			})
 		})
 
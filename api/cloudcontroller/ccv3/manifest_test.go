@@ -0,0 +1,89 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Manifest", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("UpdateSpaceApplyManifest", func() {
+		var rawManifest []byte
+
+		BeforeEach(func() {
+			rawManifest = []byte("applications:\n- name: some-app\n")
+		})
+
+		Context("when the manifest is accepted", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/spaces/some-space-guid/actions/apply_manifest"),
+						VerifyHeaderKV("Content-Type", "application/x-yaml"),
+						VerifyBody(rawManifest),
+						RespondWith(http.StatusAccepted, ``,
+							http.Header{
+								"X-Cf-Warnings": {"some-warning"},
+								"Location":      {"/v3/jobs/some-job-guid"},
+							},
+						),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				jobURL, warnings, err := client.UpdateSpaceApplyManifest("some-space-guid", rawManifest)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal("/v3/jobs/some-job-guid"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: command presence",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/spaces/some-space-guid/actions/apply_manifest"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"some-warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateSpaceApplyManifest("some-space-guid", rawManifest)
+				Expect(err).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,65 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Metadata represents the user-defined metadata of a Cloud Controller V3
+// resource.
+type Metadata struct {
+	// Labels are key/value pairs used to select resources. When updating, a
+	// label that is not set is removed from the resource.
+	Labels map[string]types.NullString `json:"labels,omitempty"`
+}
+
+// UpdateOrganizationMetadata merges the given metadata into the
+// organization's metadata.
+func (client *Client) UpdateOrganizationMetadata(orgGUID string, metadata Metadata) (Metadata, Warnings, error) {
+	return client.updateMetadata(internal.PatchOrganizationRequest, internal.Params{"organization_guid": orgGUID}, metadata)
+}
+
+// UpdateSpaceMetadata merges the given metadata into the space's metadata.
+func (client *Client) UpdateSpaceMetadata(spaceGUID string, metadata Metadata) (Metadata, Warnings, error) {
+	return client.updateMetadata(internal.PatchSpaceRequest, internal.Params{"space_guid": spaceGUID}, metadata)
+}
+
+// UpdateApplicationMetadata merges the given metadata into the application's
+// metadata.
+func (client *Client) UpdateApplicationMetadata(appGUID string, metadata Metadata) (Metadata, Warnings, error) {
+	return client.updateMetadata(internal.PatchApplicationRequest, internal.Params{"app_guid": appGUID}, metadata)
+}
+
+func (client *Client) updateMetadata(requestName string, uriParams internal.Params, metadata Metadata) (Metadata, Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		Metadata Metadata `json:"metadata"`
+	}{
+		Metadata: metadata,
+	})
+	if err != nil {
+		return Metadata{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Metadata{}, nil, err
+	}
+
+	var responseResource struct {
+		Metadata Metadata `json:"metadata"`
+	}
+	response := cloudcontroller.Response{
+		Result: &responseResource,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseResource.Metadata, response.Warnings, err
+}
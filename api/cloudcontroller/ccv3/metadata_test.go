@@ -0,0 +1,184 @@
+package ccv3_test
+
+import (
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Metadata", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("listing resources with labels", func() {
+		BeforeEach(func() {
+			response := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"name": "org-name-1",
+			"guid": "org-guid-1",
+			"metadata": {
+				"labels": {
+					"env": "prod"
+				}
+			}
+		}
+	]
+}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/organizations", "label_selector=env+in+%28prod%29"),
+					RespondWith(http.StatusOK, response, nil),
+				),
+			)
+		})
+
+		It("passes the label selector and returns the labels of each resource", func() {
+			organizations, _, err := client.GetOrganizations(url.Values{
+				LabelSelectorFilter: []string{"env in (prod)"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(organizations).To(ConsistOf(
+				Organization{
+					Name: "org-name-1",
+					GUID: "org-guid-1",
+					Metadata: &Metadata{
+						Labels: map[string]types.NullString{"env": types.NewNullString("prod")},
+					},
+				},
+			))
+		})
+	})
+
+	Describe("UpdateOrganizationMetadata", func() {
+		Context("when the organization exists", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"env":  "prod",
+							"tier": nil,
+						},
+					},
+				}
+				response := `{
+	"name": "some-org",
+	"guid": "some-org-guid",
+	"metadata": {
+		"labels": {
+			"env": "prod",
+			"owner": "team-a"
+		}
+	}
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/organizations/some-org-guid"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sets and removes the labels and returns the resulting metadata", func() {
+				metadata, warnings, err := client.UpdateOrganizationMetadata("some-org-guid", Metadata{
+					Labels: map[string]types.NullString{
+						"env":  types.NewNullString("prod"),
+						"tier": {},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(metadata).To(Equal(Metadata{
+					Labels: map[string]types.NullString{
+						"env":   types.NewNullString("prod"),
+						"owner": types.NewNullString("team-a"),
+					},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10008,
+      "detail": "Metadata label key error: 'a b' contains invalid characters",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/organizations/some-org-guid"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateOrganizationMetadata("some-org-guid", Metadata{
+					Labels: map[string]types.NullString{"a b": types.NewNullString("c")},
+				})
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Metadata label key error: 'a b' contains invalid characters",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceMetadata", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/v3/spaces/some-space-guid"),
+					VerifyJSON(`{"metadata": {"labels": {"env": "prod"}}}`),
+					RespondWith(http.StatusOK, `{"metadata": {"labels": {"env": "prod"}}}`, nil),
+				),
+			)
+		})
+
+		It("updates the space's labels", func() {
+			metadata, _, err := client.UpdateSpaceMetadata("some-space-guid", Metadata{
+				Labels: map[string]types.NullString{"env": types.NewNullString("prod")},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata.Labels).To(HaveKeyWithValue("env", types.NewNullString("prod")))
+		})
+	})
+
+	Describe("UpdateApplicationMetadata", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid"),
+					VerifyJSON(`{"metadata": {"labels": {"env": "prod"}}}`),
+					RespondWith(http.StatusOK, `{"metadata": {"labels": {"env": "prod"}}}`, nil),
+				),
+			)
+		})
+
+		It("updates the application's labels", func() {
+			metadata, _, err := client.UpdateApplicationMetadata("some-app-guid", Metadata{
+				Labels: map[string]types.NullString{"env": types.NewNullString("prod")},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata.Labels).To(HaveKeyWithValue("env", types.NewNullString("prod")))
+		})
+	})
+})
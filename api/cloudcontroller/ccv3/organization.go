@@ -3,14 +3,16 @@ package ccv3
 import (
 	"net/url"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
 
 // Organization represents a Cloud Controller V3 Organization.
 type Organization struct {
-	Name string `json:"name"`
-	GUID string `json:"guid"`
+	Name     string    `json:"name"`
+	GUID     string    `json:"guid"`
+	Metadata *Metadata `json:"metadata,omitempty"`
 }
 
 // GetOrganizations lists organizations with optional filters.
@@ -65,3 +67,20 @@ func (client *Client) GetIsolationSegmentOrganizationsByIsolationSegment(isolati
 
 	return fullOrgsList, warnings, err
 }
+
+// DeleteOrganization starts the asynchronous deletion of the organization and
+// everything in it. It returns the URL of the deletion job.
+func (client *Client) DeleteOrganization(orgGUID string) (string, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteOrganizationRequest,
+		URIParams:   internal.Params{"organization_guid": orgGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.ResourceLocationURL, response.Warnings, err
+}
@@ -232,4 +232,66 @@ var _ = Describe("Organizations", func() {
 			})
 		})
 	})
+
+	Describe("DeleteOrganization", func() {
+		Context("when the org is deleted successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/organizations/some-org-guid"),
+						RespondWith(http.StatusAccepted, ``,
+							http.Header{
+								"X-Cf-Warnings": {"some-warning"},
+								"Location":      {"/v3/jobs/some-location"},
+							},
+						),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				jobURL, warnings, err := client.DeleteOrganization("some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(jobURL).To(Equal("/v3/jobs/some-location"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		Context("when deleting the org returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: command presence",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/organizations/some-org-guid"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"some-warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.DeleteOrganization("some-org-guid")
+				Expect(err).To(MatchError(ccerror.V3UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V3ErrorResponse: ccerror.V3ErrorResponse{
+						Errors: []ccerror.V3Error{
+							{
+								Code:   10008,
+								Detail: "The request is semantically invalid: command presence",
+								Title:  "CF-UnprocessableEntity",
+							},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
 })
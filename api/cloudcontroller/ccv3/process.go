@@ -14,6 +14,7 @@ import (
 type Process struct {
 	GUID        string             `json:"guid"`
 	Type        string             `json:"type"`
+	Command     string             `json:"command"`
 	HealthCheck ProcessHealthCheck `json:"health_check"`
 	Instances   types.NullInt      `json:"instances"`
 	MemoryInMB  types.NullUint64   `json:"memory_in_mb"`
@@ -27,6 +28,12 @@ type ProcessHealthCheck struct {
 
 type ProcessHealthCheckData struct {
 	Endpoint string `json:"endpoint"`
+	// Timeout is the number of seconds allowed between starting the process
+	// and its first healthy response.
+	Timeout types.NullUint64 `json:"timeout"`
+	// InvocationTimeout is the number of seconds a single health check may
+	// take before it is considered failed.
+	InvocationTimeout types.NullUint64 `json:"invocation_timeout"`
 }
 
 func (p Process) MarshalJSON() ([]byte, error) {
@@ -34,7 +41,9 @@ func (p Process) MarshalJSON() ([]byte, error) {
 		HealthCheck struct {
 			Type string `json:"type"`
 			Data struct {
-				Endpoint interface{} `json:"endpoint"`
+				Endpoint          interface{} `json:"endpoint"`
+				Timeout           interface{} `json:"timeout,omitempty"`
+				InvocationTimeout interface{} `json:"invocation_timeout,omitempty"`
 			} `json:"data"`
 		} `json:"health_check"`
 	}
@@ -43,6 +52,12 @@ func (p Process) MarshalJSON() ([]byte, error) {
 	if p.HealthCheck.Data.Endpoint != "" {
 		ccProcess.HealthCheck.Data.Endpoint = p.HealthCheck.Data.Endpoint
 	}
+	if p.HealthCheck.Data.Timeout.IsSet {
+		ccProcess.HealthCheck.Data.Timeout = p.HealthCheck.Data.Timeout.Value
+	}
+	if p.HealthCheck.Data.InvocationTimeout.IsSet {
+		ccProcess.HealthCheck.Data.InvocationTimeout = p.HealthCheck.Data.InvocationTimeout.Value
+	}
 	return json.Marshal(ccProcess)
 }
 
@@ -119,6 +134,28 @@ func (client *Client) PatchApplicationProcessHealthCheck(processGUID string, pro
 	return response.Warnings, err
 }
 
+// UpdateProcess updates the health check of the process with the given GUID,
+// including its start and invocation timeouts when they are set.
+func (client *Client) UpdateProcess(process Process) (Warnings, error) {
+	body, err := json.Marshal(process)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchProcessRequest,
+		Body:        bytes.NewReader(body),
+		URIParams:   internal.Params{"process_guid": process.GUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // CreateApplicationProcessScale updates process instances count, memory or disk
 func (client *Client) CreateApplicationProcessScale(appGUID string, process Process) (Warnings, error) {
 	ccProcessScale := struct {
@@ -46,6 +46,7 @@ var _ = Describe("Process", func() {
 							{
 								"guid": "process-2-guid",
 								"type": "worker",
+								"command": "bundle exec rake work",
 								"memory_in_mb": 64,
 								"health_check": {
                   "type": "http",
@@ -105,17 +106,24 @@ var _ = Describe("Process", func() {
 					Process{
 						GUID:       "process-2-guid",
 						Type:       "worker",
+						Command:    "bundle exec rake work",
 						MemoryInMB: types.NullUint64{Value: 64, IsSet: true},
 						HealthCheck: ProcessHealthCheck{
 							Type: "http",
-							Data: ProcessHealthCheckData{Endpoint: "/health"},
+							Data: ProcessHealthCheckData{
+								Endpoint: "/health",
+								Timeout:  types.NullUint64{Value: 60, IsSet: true},
+							},
 						},
 					},
 					Process{
-						GUID:        "process-3-guid",
-						Type:        "console",
-						MemoryInMB:  types.NullUint64{Value: 128, IsSet: true},
-						HealthCheck: ProcessHealthCheck{Type: "process"},
+						GUID:       "process-3-guid",
+						Type:       "console",
+						MemoryInMB: types.NullUint64{Value: 128, IsSet: true},
+						HealthCheck: ProcessHealthCheck{
+							Type: "process",
+							Data: ProcessHealthCheckData{Timeout: types.NullUint64{Value: 90, IsSet: true}},
+						},
 					},
 				))
 				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
@@ -190,7 +198,10 @@ var _ = Describe("Process", func() {
 					MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
 					HealthCheck: ProcessHealthCheck{
 						Type: "http",
-						Data: ProcessHealthCheckData{Endpoint: "/health"}},
+						Data: ProcessHealthCheckData{
+							Endpoint: "/health",
+							Timeout:  types.NullUint64{Value: 90, IsSet: true},
+						}},
 				}))
 			})
 		})
@@ -408,6 +419,111 @@ var _ = Describe("Process", func() {
 		})
 	})
 
+	Describe("UpdateProcess", func() {
+		var (
+			process  Process
+			warnings []string
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = client.UpdateProcess(process)
+		})
+
+		Context("when the timeouts are set", func() {
+			BeforeEach(func() {
+				process = Process{
+					GUID: "some-process-guid",
+					HealthCheck: ProcessHealthCheck{
+						Type: "http",
+						Data: ProcessHealthCheckData{
+							Endpoint:          "/health",
+							Timeout:           types.NullUint64{Value: 120, IsSet: true},
+							InvocationTimeout: types.NullUint64{Value: 5, IsSet: true},
+						},
+					},
+				}
+				expectedBody := `{
+					"health_check": {
+						"type": "http",
+						"data": {
+							"endpoint": "/health",
+							"timeout": 120,
+							"invocation_timeout": 5
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						VerifyJSON(expectedBody),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("sends the health check with the timeouts", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the timeouts are not set", func() {
+			BeforeEach(func() {
+				process = Process{
+					GUID:        "some-process-guid",
+					HealthCheck: ProcessHealthCheck{Type: "port"},
+				}
+				expectedBody := `{
+					"health_check": {
+						"type": "port",
+						"data": {
+							"endpoint": null
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						VerifyJSON(expectedBody),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("omits the timeouts", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				process = Process{GUID: "some-process-guid"}
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("CreateApplicationProcessScale", func() {
 		var passedProcess Process
 
@@ -11,9 +11,17 @@ const (
 	OrganizationGUIDFilter = "organization_guids"
 	// SpaceGUIDFilter is a query paramater for listing objects by Space GUID.
 	SpaceGUIDFilter = "space_guids"
+	// StatesFilter is a query paramater for listing objects by state.
+	StatesFilter = "states"
+	// LabelSelectorFilter is a query paramater for listing objects by a
+	// selector on their metadata labels.
+	LabelSelectorFilter = "label_selector"
 
 	// OrderBy is a query paramater to specify how to order objects.
 	OrderBy = "order_by"
 	// NameOrder is value for a query paramater when ordering by name.
 	NameOrder = "name"
+	// CreatedAtDescendingOrder is value for a query paramater when ordering by
+	// creation time, newest first.
+	CreatedAtDescendingOrder = "-created_at"
 )
@@ -92,4 +92,122 @@ var _ = Describe("RelationshipList", func() {
 			})
 		})
 	})
+
+	Describe("GetIsolationSegmentSpaces", func() {
+		Context("when the isolation segment is assigned to spaces", func() {
+			BeforeEach(func() {
+				response := `{
+					"data": [
+						{
+							"guid": "space-guid-1"
+						},
+						{
+							"guid": "space-guid-2"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/isolation_segments/some-iso-guid/relationships/spaces"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the space GUIDs and warnings", func() {
+				relationships, warnings, err := client.GetIsolationSegmentSpaces("some-iso-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(relationships).To(Equal(RelationshipList{
+					GUIDs: []string{"space-guid-1", "space-guid-2"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Isolation segment not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/isolation_segments/some-iso-guid/relationships/spaces"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetIsolationSegmentSpaces("some-iso-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Isolation segment not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("ShareServiceInstanceToSpaces", func() {
+		Context("when the share is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"data": [
+						{
+							"guid": "some-space-guid"
+						}
+					]
+				}`
+
+				requestBody := map[string][]map[string]string{
+					"data": {{"guid": "some-space-guid"}},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_instances/some-service-instance-guid/relationships/shared_spaces"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the shared spaces and warnings", func() {
+				relationships, warnings, err := client.ShareServiceInstanceToSpaces("some-service-instance-guid", []string{"some-space-guid"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(relationships).To(Equal(RelationshipList{
+					GUIDs: []string{"some-space-guid"},
+				}))
+			})
+		})
+
+		Context("when service instance sharing is disabled", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 330002,
+							"detail": "Feature Disabled: service_instance_sharing",
+							"title": "CF-FeatureDisabled"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/service_instances/some-service-instance-guid/relationships/shared_spaces"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.ShareServiceInstanceToSpaces("some-service-instance-guid", []string{"some-space-guid"})
+				Expect(err).To(MatchError(ccerror.FeatureDisabledError{Message: "Feature Disabled: service_instance_sharing"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
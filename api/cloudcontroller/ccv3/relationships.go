@@ -3,8 +3,9 @@ package ccv3
 type RelationshipType string
 
 const (
-	ApplicationRelationship RelationshipType = "app"
-	SpaceRelationship       RelationshipType = "space"
+	ApplicationRelationship  RelationshipType = "app"
+	OrganizationRelationship RelationshipType = "organization"
+	SpaceRelationship        RelationshipType = "space"
 )
 
 // Relationships is a map of RelationshipTypes to Relationship.
@@ -28,6 +28,10 @@ type requestOptions struct {
 	URL string
 	// Body is the content of the request.
 	Body io.ReadSeeker
+
+	// Idempotent marks the request as safe to retry; see
+	// cloudcontroller.Request.
+	Idempotent bool
 }
 
 // newHTTPRequest returns a constructed HTTP.Request with some defaults.
@@ -65,5 +69,7 @@ func (client *Client) newHTTPRequest(passedRequest requestOptions) (*cloudcontro
 		request.Header.Set("Content-Type", "application/json")
 	}
 
-	return cloudcontroller.NewRequest(request, passedRequest.Body), nil
+	ccRequest := cloudcontroller.NewRequest(request, passedRequest.Body)
+	ccRequest.Idempotent = passedRequest.Idempotent
+	return ccRequest, nil
 }
@@ -0,0 +1,68 @@
+package ccv3
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Sidecar represents a Cloud Controller V3 sidecar, an additional process
+// that runs alongside the given process types of an app.
+type Sidecar struct {
+	GUID         string
+	Name         string
+	Command      string
+	ProcessTypes []string
+	// MemoryInMB is the part of the process memory reserved for the sidecar.
+	MemoryInMB types.NullUint64
+}
+
+func (s *Sidecar) UnmarshalJSON(data []byte) error {
+	var ccSidecar struct {
+		GUID         string           `json:"guid"`
+		Name         string           `json:"name"`
+		Command      string           `json:"command"`
+		ProcessTypes []string         `json:"process_types"`
+		MemoryInMB   types.NullUint64 `json:"memory_in_mb"`
+	}
+
+	if err := json.Unmarshal(data, &ccSidecar); err != nil {
+		return err
+	}
+
+	s.GUID = ccSidecar.GUID
+	s.Name = ccSidecar.Name
+	s.Command = ccSidecar.Command
+	s.ProcessTypes = ccSidecar.ProcessTypes
+	s.MemoryInMB = ccSidecar.MemoryInMB
+
+	return nil
+}
+
+// GetApplicationSidecars lists the sidecars of the given app.
+func (client *Client) GetApplicationSidecars(appGUID string) ([]Sidecar, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppSidecarsRequest,
+		URIParams:   map[string]string{"app_guid": appGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSidecarsList []Sidecar
+	warnings, err := client.paginate(request, Sidecar{}, func(item interface{}) error {
+		if sidecar, ok := item.(Sidecar); ok {
+			fullSidecarsList = append(fullSidecarsList, sidecar)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Sidecar{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSidecarsList, warnings, err
+}
@@ -0,0 +1,119 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Sidecar", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationSidecars", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`
+					{
+						"pagination": {
+							"next": {
+								"href": "%s/v3/apps/some-app-guid/sidecars?page=2"
+							}
+						},
+						"resources": [
+							{
+								"guid": "sidecar-1-guid",
+								"name": "auth-proxy",
+								"command": "./proxy",
+								"process_types": ["web", "worker"],
+								"memory_in_mb": 64
+							}
+						]
+					}`, server.URL())
+				response2 := `
+					{
+						"pagination": {
+							"next": null
+						},
+						"resources": [
+							{
+								"guid": "sidecar-2-guid",
+								"name": "log-shipper",
+								"command": "./ship",
+								"process_types": ["web"],
+								"memory_in_mb": null
+							}
+						]
+					}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/sidecars", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns a list of sidecars associated with the application and all warnings", func() {
+				sidecars, warnings, err := client.GetApplicationSidecars("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(sidecars).To(ConsistOf(
+					Sidecar{
+						GUID:         "sidecar-1-guid",
+						Name:         "auth-proxy",
+						Command:      "./proxy",
+						ProcessTypes: []string{"web", "worker"},
+						MemoryInMB:   types.NullUint64{Value: 64, IsSet: true},
+					},
+					Sidecar{
+						GUID:         "sidecar-2-guid",
+						Name:         "log-shipper",
+						Command:      "./ship",
+						ProcessTypes: []string{"web"},
+					},
+				))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusNotFound, response),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				_, _, err := client.GetApplicationSidecars("some-app-guid")
+				Expect(err).To(MatchError(ccerror.ApplicationNotFoundError{}))
+			})
+		})
+	})
+})
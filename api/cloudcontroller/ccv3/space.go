@@ -0,0 +1,60 @@
+package ccv3
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Space represents a Cloud Controller V3 Space.
+type Space struct {
+	Name          string        `json:"name"`
+	GUID          string        `json:"guid"`
+	Relationships Relationships `json:"relationships"`
+	Metadata      *Metadata     `json:"metadata,omitempty"`
+}
+
+// GetSpaces lists spaces with optional filters.
+func (client *Client) GetSpaces(query url.Values) ([]Space, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpacesRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullSpacesList []Space
+	warnings, err := client.paginate(request, Space{}, func(item interface{}) error {
+		if space, ok := item.(Space); ok {
+			fullSpacesList = append(fullSpacesList, space)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Space{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSpacesList, warnings, err
+}
+
+// DeleteSpace starts the asynchronous deletion of the space and everything in
+// it. It returns the URL of the deletion job.
+func (client *Client) DeleteSpace(spaceGUID string) (string, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteSpaceRequest,
+		URIParams:   internal.Params{"space_guid": spaceGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return response.ResourceLocationURL, response.Warnings, err
+}
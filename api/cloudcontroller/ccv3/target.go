@@ -10,6 +10,10 @@ import (
 // TargetSettings represents configuration for establishing a connection to the
 // Cloud Controller server.
 type TargetSettings struct {
+	// CACert is the PEM encoded CA certificates trusted in addition to the
+	// system's when verifying the server's certificate chain.
+	CACert []byte
+
 	// DialTimeout is the DNS timeout used to make all requests to the Cloud
 	// Controller.
 	DialTimeout time.Duration
@@ -33,6 +37,7 @@ func (client *Client) TargetCF(settings TargetSettings) (Warnings, error) {
 	client.cloudControllerURL = settings.URL
 
 	client.connection = cloudcontroller.NewConnection(cloudcontroller.Config{
+		CACert:            settings.CACert,
 		DialTimeout:       settings.DialTimeout,
 		SkipSSLValidation: settings.SkipSSLValidation,
 	})
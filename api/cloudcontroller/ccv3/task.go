@@ -20,6 +20,13 @@ type Task struct {
 	CreatedAt  string `json:"created_at,omitempty"`
 	MemoryInMB uint64 `json:"memory_in_mb,omitempty"`
 	DiskInMB   uint64 `json:"disk_in_mb,omitempty"`
+	// Result is set by the Cloud Controller once the task has finished.
+	Result *TaskResult `json:"result,omitempty"`
+}
+
+// TaskResult represents the outcome of a finished Cloud Controller V3 Task.
+type TaskResult struct {
+	FailureReason string `json:"failure_reason"`
 }
 
 // CreateApplicationTask runs a command in the Application environment
@@ -12,4 +12,10 @@ const (
 	MinVersionV3                 = "3.27.0"
 	MinVersionRunTaskV3          = "3.0.0"
 	MinVersionIsolationSegmentV3 = "3.11.0"
+	MinVersionDeploymentsV3      = "3.57.0"
+	MinVersionApplyManifestV3    = "3.32.0"
+	MinVersionShareServiceV3     = "3.36.0"
+	MinVersionSidecarsV3         = "3.60.0"
+	MinVersionAsyncDeletionV3    = "3.42.0"
+	MinVersionMetadataV3         = "3.63.0"
 )
@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -13,6 +14,8 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/util/cacert"
+	"code.cloudfoundry.org/cli/util/timings"
 )
 
 // CloudControllerConnection represents a connection to the Cloud Controller
@@ -24,6 +27,7 @@ type CloudControllerConnection struct {
 
 // Config is for configuring a CloudControllerConnection.
 type Config struct {
+	CACert            []byte
 	DialTimeout       time.Duration
 	SkipSSLValidation bool
 }
@@ -34,6 +38,7 @@ func NewConnection(config Config) *CloudControllerConnection {
 	tr := &http.Transport{
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: config.SkipSSLValidation,
+			RootCAs:            cacert.NewCertPool(config.CACert),
 		},
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
@@ -43,7 +48,7 @@ func NewConnection(config Config) *CloudControllerConnection {
 	}
 
 	return &CloudControllerConnection{
-		HTTPClient: &http.Client{Transport: tr},
+		HTTPClient: &http.Client{Transport: timings.NewTransport(tr)},
 	}
 }
 
@@ -59,7 +64,14 @@ func (connection *CloudControllerConnection) Make(request *Request, passedRespon
 		return connection.processRequestErrors(request.Request, err)
 	}
 
-	return connection.populateResponse(response, passedResponse)
+	body := response.Body
+	if passedResponse.ProgressReader != nil && response.StatusCode < 400 {
+		passedResponse.ProgressReader.Start(response.ContentLength)
+		defer passedResponse.ProgressReader.Finish()
+		body = passedResponse.ProgressReader.Wrap(response.Body)
+	}
+
+	return connection.populateResponse(response, passedResponse, body)
 }
 
 func (*CloudControllerConnection) processRequestErrors(request *http.Request, err error) error {
@@ -82,7 +94,7 @@ func (*CloudControllerConnection) processRequestErrors(request *http.Request, er
 	}
 }
 
-func (connection *CloudControllerConnection) populateResponse(response *http.Response, passedResponse *Response) error {
+func (connection *CloudControllerConnection) populateResponse(response *http.Response, passedResponse *Response, body io.ReadCloser) error {
 	passedResponse.HTTPResponse = response
 
 	// The cloud controller returns warnings with key "X-Cf-Warnings", and the
@@ -99,8 +111,8 @@ func (connection *CloudControllerConnection) populateResponse(response *http.Res
 		passedResponse.ResourceLocationURL = resourceLocationURL
 	}
 
-	rawBytes, err := ioutil.ReadAll(response.Body)
-	defer response.Body.Close()
+	rawBytes, err := ioutil.ReadAll(body)
+	defer body.Close()
 	if err != nil {
 		return err
 	}
@@ -1,13 +1,17 @@
 package cloudcontroller_test
 
 import (
+	"encoding/pem"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"runtime"
 	"strings"
 
 	. "code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -109,6 +113,30 @@ var _ = Describe("Cloud Controller Connection", func() {
 
 				Expect(response.HTTPResponse.Status).To(Equal("200 OK"))
 			})
+
+			Context("when a progress reader is provided", func() {
+				var fakeProgressReader *cloudcontrollerfakes.FakeProgressReader
+
+				BeforeEach(func() {
+					fakeProgressReader = new(cloudcontrollerfakes.FakeProgressReader)
+					fakeProgressReader.WrapStub = func(reader io.Reader) io.ReadCloser {
+						return ioutil.NopCloser(reader)
+					}
+				})
+
+				It("reports the progress of reading the body", func() {
+					response := Response{ProgressReader: fakeProgressReader}
+
+					err := connection.Make(request, &response)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.RawResponse).To(Equal([]byte("{}")))
+
+					Expect(fakeProgressReader.StartCallCount()).To(Equal(1))
+					Expect(fakeProgressReader.StartArgsForCall(0)).To(BeEquivalentTo(2))
+					Expect(fakeProgressReader.WrapCallCount()).To(Equal(1))
+					Expect(fakeProgressReader.FinishCallCount()).To(Equal(1))
+				})
+			})
 		})
 
 		Describe("Response Headers", func() {
@@ -210,6 +238,30 @@ var _ = Describe("Cloud Controller Connection", func() {
 						Expect(err).To(MatchError(ccerror.UnverifiedServerError{URL: server.URL()}))
 					})
 				})
+
+				Context("when the server's certificate is signed by the configured CA", func() {
+					BeforeEach(func() {
+						server.AppendHandlers(
+							CombineHandlers(
+								VerifyRequest(http.MethodGet, "/v2/foo"),
+								RespondWith(http.StatusOK, "{}"),
+							),
+						)
+
+						caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.HTTPTestServer.Certificate().Raw})
+						connection = NewConnection(Config{CACert: caCert})
+					})
+
+					It("trusts the server", func() {
+						req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+						Expect(err).ToNot(HaveOccurred())
+						request := &Request{Request: req}
+
+						var response Response
+						err = connection.Make(request, &response)
+						Expect(err).ToNot(HaveOccurred())
+					})
+				})
 			})
 
 			Context("when the server's certificate does not match the hostname", func() {
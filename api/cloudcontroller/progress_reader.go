@@ -0,0 +1,12 @@
+package cloudcontroller
+
+import "io"
+
+//go:generate counterfeiter . ProgressReader
+
+// ProgressReader reports the progress of reading a response body.
+type ProgressReader interface {
+	Wrap(io.Reader) io.ReadCloser
+	Start(int64)
+	Finish()
+}
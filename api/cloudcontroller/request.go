@@ -9,6 +9,10 @@ import (
 type Request struct {
 	*http.Request
 
+	// Idempotent marks a request other than a GET or HEAD, such as a PUT, as
+	// safe to retry after a transient failure.
+	Idempotent bool
+
 	body io.ReadSeeker
 }
 
@@ -20,6 +20,10 @@ type Response struct {
 
 	// ResourceLocationURL represents the Location header value
 	ResourceLocationURL string
+
+	// ProgressReader, when set, reports the progress of reading a successful
+	// response body.
+	ProgressReader ProgressReader
 }
 
 func (r *Response) reset() {
@@ -0,0 +1,100 @@
+package wrapper
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/util/ratelimit"
+)
+
+// RateLimit is a wrapper that delays requests when the Cloud Controller
+// reports that few requests remain in the current rate limit window, and
+// retries requests rejected with a 429 once the limit resets.
+type RateLimit struct {
+	throttle   *ratelimit.Throttle
+	outputs    []RequestLoggerOutput
+	connection cloudcontroller.Connection
+}
+
+// NewRateLimit returns a pointer to a RateLimit wrapper that tracks the rate
+// limit in the given throttle. Each delay is recorded in the given outputs.
+func NewRateLimit(throttle *ratelimit.Throttle, outputs ...RequestLoggerOutput) *RateLimit {
+	return &RateLimit{
+		throttle: throttle,
+		outputs:  outputs,
+	}
+}
+
+// Wrap sets the connection in the RateLimit and returns itself.
+func (limit *RateLimit) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	limit.connection = innerconnection
+	return limit
+}
+
+// Make waits as long as the throttle requires before making the request,
+// and retries it if it is rejected with a 429.
+func (limit *RateLimit) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	var err error
+
+	for i := 0; i < ratelimit.MaxRetries+1; i++ {
+		if wait := limit.throttle.Delay(time.Now()); wait > 0 {
+			limit.display(request, fmt.Sprintf("Delaying %s %s by %s to stay under the rate limit",
+				request.Method, request.URL.RequestURI(), wait))
+			time.Sleep(wait)
+		}
+
+		err = limit.connection.Make(request, passedResponse)
+
+		response := passedResponse.HTTPResponse
+		if response == nil {
+			return err
+		}
+		limit.throttle.Update(response.Header)
+
+		if err == nil || response.StatusCode != http.StatusTooManyRequests || i == ratelimit.MaxRetries {
+			return err
+		}
+
+		wait, ok := ratelimit.RetryDelay(response.Header, time.Now())
+		if !ok {
+			return err
+		}
+
+		// Reset the request body prior to the next retry
+		resetErr := request.ResetBody()
+		if resetErr != nil {
+			if _, ok := resetErr.(ccerror.PipeSeekError); ok {
+				return ccerror.PipeSeekError{Err: err}
+			}
+			return resetErr
+		}
+
+		limit.display(request, fmt.Sprintf("Rate limit exceeded, retrying %s %s in %s (attempt %d of %d)",
+			request.Method, request.URL.RequestURI(), wait, i+1, ratelimit.MaxRetries))
+		time.Sleep(wait)
+	}
+	return err
+}
+
+func (limit *RateLimit) display(request *cloudcontroller.Request, message string) {
+	for _, output := range limit.outputs {
+		displayErr := output.Start()
+		if displayErr != nil {
+			output.HandleInternalError(displayErr)
+			continue
+		}
+
+		displayErr = output.DisplayType("THROTTLE", time.Now())
+		if displayErr == nil {
+			displayErr = output.DisplayMessage(message)
+		}
+		if displayErr != nil {
+			output.HandleInternalError(displayErr)
+		}
+
+		_ = output.Stop()
+	}
+}
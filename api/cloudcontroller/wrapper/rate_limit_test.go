@@ -0,0 +1,146 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	"code.cloudfoundry.org/cli/util/ratelimit"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rate Limit", func() {
+	var (
+		rawRequestBody string
+		request        *cloudcontroller.Request
+		response       *cloudcontroller.Response
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		fakeOutput     *wrapperfakes.FakeRequestLoggerOutput
+		throttle       *ratelimit.Throttle
+		wrapper        cloudcontroller.Connection
+		tooManyErr     ccerror.RawHTTPStatusError
+	)
+
+	respondWith := func(statusCode int, header http.Header, err error) func(*cloudcontroller.Request, *cloudcontroller.Response) error {
+		return func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			body, readErr := ioutil.ReadAll(req.Body)
+			Expect(readErr).ToNot(HaveOccurred())
+			Expect(string(body)).To(Equal(rawRequestBody))
+
+			passedResponse.HTTPResponse = &http.Response{StatusCode: statusCode, Header: header}
+			return err
+		}
+	}
+
+	rateLimitHeader := func(remaining int, reset time.Time) http.Header {
+		return http.Header{
+			"X-Ratelimit-Remaining": {strconv.Itoa(remaining)},
+			"X-Ratelimit-Reset":     {strconv.FormatInt(reset.Unix(), 10)},
+		}
+	}
+
+	BeforeEach(func() {
+		rawRequestBody = "banana pants"
+		body := strings.NewReader(rawRequestBody)
+		req, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana?q=1", body)
+		Expect(err).NotTo(HaveOccurred())
+		request = cloudcontroller.NewRequest(req, body)
+		response = &cloudcontroller.Response{}
+
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeOutput = new(wrapperfakes.FakeRequestLoggerOutput)
+		throttle = ratelimit.NewThrottle(20)
+		wrapper = NewRateLimit(throttle, fakeOutput).Wrap(fakeConnection)
+		tooManyErr = ccerror.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}
+	})
+
+	It("makes the request without delay when no rate limit is known", func() {
+		fakeConnection.MakeStub = respondWith(http.StatusOK, http.Header{}, nil)
+
+		err := wrapper.Make(request, response)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		Expect(fakeOutput.StartCallCount()).To(Equal(0))
+	})
+
+	It("records the rate limit of the response in the throttle", func() {
+		reset := time.Now().Add(time.Hour)
+		fakeConnection.MakeStub = respondWith(http.StatusOK, rateLimitHeader(0, reset), nil)
+
+		err := wrapper.Make(request, response)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(throttle.Delay(time.Now())).To(Equal(ratelimit.MaxWait))
+	})
+
+	Context("when few requests remain", func() {
+		BeforeEach(func() {
+			throttle.Update(rateLimitHeader(19, time.Now().Add(2*time.Second)))
+			fakeConnection.MakeStub = respondWith(http.StatusOK, http.Header{}, nil)
+		})
+
+		It("delays the request and records the delay", func() {
+			startTime := time.Now()
+			err := wrapper.Make(request, response)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(time.Since(startTime)).To(BeNumerically(">", 0))
+
+			Expect(fakeOutput.StartCallCount()).To(Equal(1))
+			name, _ := fakeOutput.DisplayTypeArgsForCall(0)
+			Expect(name).To(Equal("THROTTLE"))
+			Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(MatchRegexp(`^Delaying POST /banana\?q=1 by .+ to stay under the rate limit$`))
+			Expect(fakeOutput.StopCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the request is rejected with a 429", func() {
+		It("retries once the limit resets and records the retry", func() {
+			fakeConnection.MakeStub = func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				if fakeConnection.MakeCallCount() == 1 {
+					return respondWith(http.StatusTooManyRequests, rateLimitHeader(0, time.Now().Add(-time.Second)), tooManyErr)(req, passedResponse)
+				}
+				return respondWith(http.StatusCreated, http.Header{}, nil)(req, passedResponse)
+			}
+
+			err := wrapper.Make(request, response)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+
+			Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(1))
+			Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("Rate limit exceeded, retrying POST /banana?q=1 in 0s (attempt 1 of 3)"))
+		})
+
+		It("gives up after MaxRetries retries", func() {
+			fakeConnection.MakeStub = respondWith(http.StatusTooManyRequests, http.Header{"Retry-After": {"0"}}, tooManyErr)
+
+			err := wrapper.Make(request, response)
+			Expect(err).To(MatchError(tooManyErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(ratelimit.MaxRetries + 1))
+		})
+
+		It("does not retry when the limit resets later than MaxWait", func() {
+			fakeConnection.MakeStub = respondWith(http.StatusTooManyRequests, http.Header{"Retry-After": {"3600"}}, tooManyErr)
+
+			err := wrapper.Make(request, response)
+			Expect(err).To(MatchError(tooManyErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			Expect(fakeOutput.StartCallCount()).To(Equal(0))
+		})
+	})
+
+	It("does not retry other errors", func() {
+		expectedErr := ccerror.RawHTTPStatusError{StatusCode: http.StatusServiceUnavailable}
+		fakeConnection.MakeStub = respondWith(http.StatusServiceUnavailable, http.Header{"Retry-After": {"0"}}, expectedErr)
+
+		err := wrapper.Make(request, response)
+		Expect(err).To(MatchError(expectedErr))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
+})
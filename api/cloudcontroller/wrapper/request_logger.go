@@ -137,6 +137,11 @@ func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Res
 	if err != nil {
 		return err
 	}
+
+	contentType := passedResponse.HTTPResponse.Header.Get("Content-Type")
+	if contentType != "" && !strings.Contains(contentType, "json") && !strings.HasPrefix(contentType, "text/") {
+		return logger.output.DisplayMessage(fmt.Sprintf("[%s Content Hidden]", strings.Split(contentType, ";")[0]))
+	}
 	return logger.output.DisplayJSONBody(passedResponse.RawResponse)
 }
 
@@ -149,7 +154,7 @@ func (logger *RequestLogger) displaySortedHeaders(headers http.Header) error {
 
 	for _, key := range keys {
 		for _, value := range headers[key] {
-			err := logger.output.DisplayHeader(key, redactHeaders(key, value))
+			err := logger.output.DisplayHeader(key, value)
 			if err != nil {
 				return err
 			}
@@ -157,10 +162,3 @@ func (logger *RequestLogger) displaySortedHeaders(headers http.Header) error {
 	}
 	return nil
 }
-
-func redactHeaders(key string, value string) string {
-	if key == "Authorization" {
-		return "[PRIVATE DATA HIDDEN]"
-	}
-	return value
-}
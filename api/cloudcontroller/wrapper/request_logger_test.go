@@ -97,15 +97,15 @@ var _ = Describe("Request Logger", func() {
 
 		Context("when an authorization header is in the request", func() {
 			BeforeEach(func() {
-				request.Header = http.Header{"Authorization": []string{"should not be shown"}}
+				request.Header = http.Header{"Authorization": []string{"bearer some-token"}}
 			})
 
-			It("redacts the contents of the authorization header", func() {
+			It("leaves redacting the authorization header to the output", func() {
 				Expect(makeErr).NotTo(HaveOccurred())
 				Expect(fakeOutput.DisplayHeaderCallCount()).To(Equal(1))
 				key, value := fakeOutput.DisplayHeaderArgsForCall(0)
 				Expect(key).To(Equal("Authorization"))
-				Expect(value).To(Equal("[PRIVATE DATA HIDDEN]"))
+				Expect(value).To(Equal("bearer some-token"))
 			})
 		})
 
@@ -224,6 +224,20 @@ var _ = Describe("Request Logger", func() {
 				Expect(fakeOutput.DisplayJSONBodyCallCount()).To(BeNumerically(">=", 1))
 				Expect(fakeOutput.DisplayJSONBodyArgsForCall(0)).To(Equal([]byte("some-response-body")))
 			})
+
+			Context("when the response's Content-Type is binary", func() {
+				BeforeEach(func() {
+					response.HTTPResponse.Header.Set("Content-Type", "application/octet-stream")
+				})
+
+				It("hides the response body", func() {
+					Expect(makeErr).NotTo(HaveOccurred())
+
+					Expect(fakeOutput.DisplayJSONBodyCallCount()).To(Equal(0))
+					Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(1))
+					Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("[application/octet-stream Content Hidden]"))
+				})
+			})
 		})
 
 		Context("when the request is unsuccessful", func() {
@@ -1,23 +1,47 @@
 package wrapper
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
 	"net/http"
+	"strconv"
+	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// MaxRetryAfter caps how long a Retry-After header can delay a retry.
+const MaxRetryAfter = time.Minute
+
+// RetryRequest is a wrapper that retries idempotent requests that failed with
+// a 502, 503 or 504 status code, or because the connection was reset.
 type RetryRequest struct {
 	maxRetries int
+	backoff    time.Duration
+	outputs    []RequestLoggerOutput
 	connection cloudcontroller.Connection
 }
 
-// NewRetryRequest returns a pointer to a RetryRequest wrapper.
+// NewRetryRequest returns a pointer to a RetryRequest wrapper that retries
+// immediately.
 func NewRetryRequest(maxRetries int) *RetryRequest {
+	return NewRetryRequestWithBackoff(maxRetries, 0)
+}
+
+// NewRetryRequestWithBackoff returns a pointer to a RetryRequest wrapper that
+// waits before retrying, doubling the wait after every attempt and adding
+// jitter so that many clients do not retry in lockstep. A Retry-After header
+// in the response takes precedence over the backoff. Each retry is recorded
+// in the given outputs.
+func NewRetryRequestWithBackoff(maxRetries int, backoff time.Duration, outputs ...RequestLoggerOutput) *RetryRequest {
 	return &RetryRequest{
 		maxRetries: maxRetries,
+		backoff:    backoff,
+		outputs:    outputs,
 	}
 }
 
@@ -27,7 +51,7 @@ func (retry *RetryRequest) Wrap(innerconnection cloudcontroller.Connection) clou
 	return retry
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it is idempotent and failed transiently.
 func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
 	var err error
 
@@ -37,7 +61,7 @@ func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse
 			return nil
 		}
 
-		if retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
+		if i == retry.maxRetries || !isIdempotent(request) || !isTransient(passedResponse.HTTPResponse, err) {
 			break
 		}
 
@@ -49,17 +73,101 @@ func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse
 			}
 			return resetErr
 		}
+
+		wait := retry.wait(i, passedResponse.HTTPResponse)
+		retry.display(request, i+1, wait, err)
+		time.Sleep(wait)
 	}
 	return err
 }
 
-// skipRetry if the request method is POST, or not one of the following http
-// status codes: 500, 502, 503, 504.
-func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
-	return httpMethod == http.MethodPost ||
-		response != nil &&
-			response.StatusCode != http.StatusInternalServerError &&
-			response.StatusCode != http.StatusBadGateway &&
-			response.StatusCode != http.StatusServiceUnavailable &&
-			response.StatusCode != http.StatusGatewayTimeout
+// wait returns how long to wait before the given retry attempt, starting at
+// 0. It is the Retry-After header when present, otherwise a random duration
+// between half and all of the exponential backoff.
+func (retry *RetryRequest) wait(attempt int, response *http.Response) time.Duration {
+	if wait, ok := retryAfter(response); ok {
+		return wait
+	}
+
+	backoff := retry.backoff << uint(attempt)
+	if backoff <= 0 {
+		return 0
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+func (retry *RetryRequest) display(request *cloudcontroller.Request, attempt int, wait time.Duration, err error) {
+	for _, output := range retry.outputs {
+		displayErr := output.Start()
+		if displayErr != nil {
+			output.HandleInternalError(displayErr)
+			continue
+		}
+
+		displayErr = output.DisplayType("RETRY", time.Now())
+		if displayErr == nil {
+			displayErr = output.DisplayMessage(fmt.Sprintf("Retrying %s %s in %s (attempt %d of %d): %s",
+				request.Method, request.URL.RequestURI(), wait, attempt, retry.maxRetries, err))
+		}
+		if displayErr != nil {
+			output.HandleInternalError(displayErr)
+		}
+
+		_ = output.Stop()
+	}
+}
+
+// isIdempotent returns true for GET and HEAD requests, and requests that are
+// explicitly marked as idempotent.
+func isIdempotent(request *cloudcontroller.Request) bool {
+	return request.Method == http.MethodGet ||
+		request.Method == http.MethodHead ||
+		request.Idempotent
+}
+
+// isTransient returns true if the response indicates that the Cloud
+// Controller is temporarily unavailable, or the connection was reset before
+// a response was received.
+func isTransient(response *http.Response, err error) bool {
+	if response != nil {
+		return response.StatusCode == http.StatusBadGateway ||
+			response.StatusCode == http.StatusServiceUnavailable ||
+			response.StatusCode == http.StatusGatewayTimeout
+	}
+
+	if requestErr, ok := err.(ccerror.RequestError); ok {
+		return errors.Is(requestErr.Err, syscall.ECONNRESET) ||
+			errors.Is(requestErr.Err, io.EOF)
+	}
+	return false
+}
+
+// retryAfter returns the delay requested by the Retry-After header of the
+// response, given in seconds or as an HTTP date.
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		wait = time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+	} else {
+		return 0, false
+	}
+
+	if wait > MaxRetryAfter {
+		wait = MaxRetryAfter
+	}
+	return wait, true
 }
@@ -3,13 +3,19 @@ package wrapper_test
 import (
 	"errors"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
 	"strings"
+	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -17,13 +23,14 @@ import (
 
 var _ = Describe("Retry Request", func() {
 	DescribeTable("number of retries",
-		func(requestMethod string, responseStatusCode int, expectedNumberOfRetries int) {
+		func(requestMethod string, idempotent bool, responseStatusCode int, expectedNumberOfRetries int) {
 			rawRequestBody := "banana pants"
 			body := strings.NewReader(rawRequestBody)
 
 			req, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", body)
 			Expect(err).NotTo(HaveOccurred())
 			request := cloudcontroller.NewRequest(req, body)
+			request.Idempotent = idempotent
 
 			response := &cloudcontroller.Response{
 				HTTPResponse: &http.Response{
@@ -49,17 +56,23 @@ var _ = Describe("Retry Request", func() {
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
 		},
 
-		Entry("maxRetries for Non-Post (500) Internal Server Error", http.MethodGet, http.StatusInternalServerError, 3),
-		Entry("maxRetries for Non-Post (502) Bad Gateway", http.MethodGet, http.StatusBadGateway, 3),
-		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
-		Entry("maxRetries for Non-Post (504) Gateway Timeout", http.MethodGet, http.StatusGatewayTimeout, 3),
+		Entry("1 for Get (500) Internal Server Error", http.MethodGet, false, http.StatusInternalServerError, 1),
+		Entry("maxRetries for Get (502) Bad Gateway", http.MethodGet, false, http.StatusBadGateway, 3),
+		Entry("maxRetries for Get (503) Service Unavailable", http.MethodGet, false, http.StatusServiceUnavailable, 3),
+		Entry("maxRetries for Get (504) Gateway Timeout", http.MethodGet, false, http.StatusGatewayTimeout, 3),
+		Entry("maxRetries for Head (503) Service Unavailable", http.MethodHead, false, http.StatusServiceUnavailable, 3),
 
-		Entry("1 for Post (500) Internal Server Error", http.MethodPost, http.StatusInternalServerError, 1),
-		Entry("1 for Post (502) Bad Gateway", http.MethodPost, http.StatusBadGateway, 1),
-		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
-		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
+		Entry("1 for Post (500) Internal Server Error", http.MethodPost, false, http.StatusInternalServerError, 1),
+		Entry("1 for Post (502) Bad Gateway", http.MethodPost, false, http.StatusBadGateway, 1),
+		Entry("1 for Post (503) Service Unavailable", http.MethodPost, false, http.StatusServiceUnavailable, 1),
+		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, false, http.StatusGatewayTimeout, 1),
 
-		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
+		Entry("1 for Put (503) Service Unavailable", http.MethodPut, false, http.StatusServiceUnavailable, 1),
+		Entry("maxRetries for idempotent Put (503) Service Unavailable", http.MethodPut, true, http.StatusServiceUnavailable, 3),
+		Entry("1 for Delete (503) Service Unavailable", http.MethodDelete, false, http.StatusServiceUnavailable, 1),
+		Entry("1 for Patch (502) Bad Gateway", http.MethodPatch, false, http.StatusBadGateway, 1),
+
+		Entry("1 for Get 4XX Errors", http.MethodGet, false, http.StatusNotFound, 1),
 	)
 
 	It("does not retry on success", func() {
@@ -80,6 +93,129 @@ var _ = Describe("Retry Request", func() {
 		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 	})
 
+	Context("when a backoff is provided", func() {
+		var (
+			request        *cloudcontroller.Request
+			response       *cloudcontroller.Response
+			fakeConnection *cloudcontrollerfakes.FakeConnection
+		)
+
+		BeforeEach(func() {
+			req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			request = cloudcontroller.NewRequest(req, nil)
+			response = &cloudcontroller.Response{
+				HTTPResponse: &http.Response{},
+			}
+			fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		})
+
+		It("waits before retrying a request that failed with a 503", func() {
+			response.HTTPResponse.StatusCode = http.StatusServiceUnavailable
+			fakeConnection.MakeReturns(ccerror.CloudControllerUnavailableError{StatusCode: http.StatusServiceUnavailable})
+
+			startTime := time.Now()
+			err := NewRetryRequestWithBackoff(2, 10*time.Millisecond).Wrap(fakeConnection).Make(request, response)
+			Expect(err).To(MatchError(ccerror.CloudControllerUnavailableError{StatusCode: http.StatusServiceUnavailable}))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+			// With jitter, each wait is between half and all of the backoff.
+			Expect(time.Since(startTime)).To(BeNumerically(">=", 15*time.Millisecond))
+		})
+
+		It("does not retry other 5XX errors", func() {
+			response.HTTPResponse.StatusCode = http.StatusInternalServerError
+			fakeConnection.MakeReturns(ccerror.RawHTTPStatusError{StatusCode: http.StatusInternalServerError})
+
+			startTime := time.Now()
+			err := NewRetryRequestWithBackoff(2, time.Minute).Wrap(fakeConnection).Make(request, response)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			Expect(time.Since(startTime)).To(BeNumerically("<", time.Minute))
+		})
+
+		Context("when the response has a Retry-After header", func() {
+			BeforeEach(func() {
+				response.HTTPResponse.StatusCode = http.StatusServiceUnavailable
+				response.HTTPResponse.Header = http.Header{"Retry-After": {"1"}}
+				fakeConnection.MakeReturns(ccerror.CloudControllerUnavailableError{StatusCode: http.StatusServiceUnavailable})
+			})
+
+			It("waits for the requested time instead of the backoff", func() {
+				startTime := time.Now()
+				err := NewRetryRequestWithBackoff(1, time.Minute).Wrap(fakeConnection).Make(request, response)
+				Expect(err).To(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				Expect(time.Since(startTime)).To(BeNumerically(">=", time.Second))
+				Expect(time.Since(startTime)).To(BeNumerically("<", time.Minute))
+			})
+		})
+	})
+
+	Context("when the request fails without a response", func() {
+		var (
+			request        *cloudcontroller.Request
+			response       *cloudcontroller.Response
+			fakeConnection *cloudcontrollerfakes.FakeConnection
+		)
+
+		BeforeEach(func() {
+			req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			request = cloudcontroller.NewRequest(req, nil)
+			response = &cloudcontroller.Response{}
+			fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		})
+
+		It("retries when the connection was reset", func() {
+			resetErr := ccerror.RequestError{Err: &url.Error{
+				Op:  "Get",
+				URL: "https://foo.bar.com/banana",
+				Err: &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)},
+			}}
+			fakeConnection.MakeReturns(resetErr)
+
+			err := NewRetryRequest(2).Wrap(fakeConnection).Make(request, response)
+			Expect(err).To(MatchError(resetErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+		})
+
+		It("does not retry other request errors", func() {
+			requestErr := ccerror.RequestError{Err: errors.New("no such host")}
+			fakeConnection.MakeReturns(requestErr)
+
+			err := NewRetryRequest(2).Wrap(fakeConnection).Make(request, response)
+			Expect(err).To(MatchError(requestErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when outputs are provided", func() {
+		It("records each retry attempt", func() {
+			req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana?q=1", nil)
+			Expect(err).NotTo(HaveOccurred())
+			request := cloudcontroller.NewRequest(req, nil)
+			response := &cloudcontroller.Response{
+				HTTPResponse: &http.Response{StatusCode: http.StatusBadGateway},
+			}
+
+			fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+			fakeConnection.MakeReturnsOnCall(0, ccerror.CloudControllerUnavailableError{StatusCode: http.StatusBadGateway})
+			fakeConnection.MakeReturnsOnCall(1, nil)
+
+			fakeOutput := new(wrapperfakes.FakeRequestLoggerOutput)
+			err = NewRetryRequestWithBackoff(2, 0, fakeOutput).Wrap(fakeConnection).Make(request, response)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+
+			Expect(fakeOutput.StartCallCount()).To(Equal(1))
+			name, _ := fakeOutput.DisplayTypeArgsForCall(0)
+			Expect(name).To(Equal("RETRY"))
+			Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(1))
+			Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(HavePrefix("Retrying GET /banana?q=1 in 0s (attempt 1 of 2): "))
+			Expect(fakeOutput.StopCallCount()).To(Equal(1))
+		})
+	})
+
 	Context("when a PipeSeekError is returned from ResetBody", func() {
 		var (
 			expectedErr error
@@ -97,7 +233,7 @@ var _ = Describe("Retry Request", func() {
 			request = cloudcontroller.NewRequest(req, body)
 			response = &cloudcontroller.Response{
 				HTTPResponse: &http.Response{
-					StatusCode: http.StatusInternalServerError,
+					StatusCode: http.StatusServiceUnavailable,
 				},
 			}
 
@@ -1,6 +1,11 @@
 package wrapper
 
 import (
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -23,12 +28,33 @@ type TokenCache interface {
 	SetRefreshToken(token string)
 }
 
+//go:generate counterfeiter . FoundationStore
+
+// FoundationStore provides the issuer of the stored tokens and the UAA of the
+// targeted foundation.
+type FoundationStore interface {
+	FoundationCheckDisabled() bool
+	UAAEndpoint() string
+	UAAIssuer() string
+}
+
+// accessTokenRefreshWindow is how close to expiring an access token has to be
+// before it is refreshed ahead of a request.
+const accessTokenRefreshWindow = time.Minute
+
 // UAAAuthentication wraps connections and adds authentication headers to all
 // requests
 type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
 	cache      TokenCache
+	foundation FoundationStore
+
+	foundationCheck    sync.Once
+	foundationCheckErr error
+
+	refreshLock    sync.Mutex
+	refreshedToken string
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -51,25 +77,58 @@ func (t *UAAAuthentication) SetClient(client UAAClient) {
 	t.client = client
 }
 
+// SetFoundationStore sets the store the wrapper uses to check that the stored
+// tokens belong to the targeted foundation.
+func (t *UAAAuthentication) SetFoundationStore(store FoundationStore) {
+	t.foundation = store
+}
+
 // Make adds authentication headers to the passed in request and then calls the
 // wrapped connection's Make. If the client is not set on the wrapper, it will
 // not add any header or handle any authentication errors.
+//
+// The access token is refreshed ahead of the request when it is about to
+// expire, or when the Cloud Controller rejects it, after which the request is replayed
+// once. A token this wrapper has just refreshed is not refreshed again unless
+// it has itself expired, which only happens during long-running commands.
+//
+// When a foundation store is set, the first request checks that the tokens
+// were issued by the UAA of the targeted foundation and fails with a
+// FoundationMismatchError otherwise, without sending the tokens.
 func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
 	if t.client == nil {
 		return t.connection.Make(request, passedResponse)
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	t.foundationCheck.Do(func() {
+		t.foundationCheckErr = t.checkFoundation()
+	})
+	if t.foundationCheckErr != nil {
+		return t.foundationCheckErr
+	}
+
+	accessToken := t.currentToken()
+	if uaa.AccessTokenExpiresWithin(accessToken, accessTokenRefreshWindow) {
+		err := t.refreshToken(accessToken)
+		if err != nil {
+			return err
+		}
+		accessToken = t.currentToken()
+	}
+
+	request.Header.Set("Authorization", accessToken)
 
 	requestErr := t.connection.Make(request, passedResponse)
 	if _, ok := requestErr.(ccerror.InvalidAuthTokenError); ok {
-		tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		err := t.refreshToken(accessToken)
 		if err != nil {
 			return err
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
+		refreshedToken := t.currentToken()
+		if refreshedToken == accessToken {
+			return requestErr
+		}
 
 		if request.Body != nil {
 			err = request.ResetBody()
@@ -80,9 +139,78 @@ func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedRespons
 				return err
 			}
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", refreshedToken)
 		requestErr = t.connection.Make(request, passedResponse)
 	}
 
 	return requestErr
 }
+
+// checkFoundation compares the host of the stored tokens' issuer with the
+// host of the targeted UAA. Sessions without a recorded issuer, such as those
+// created by older versions of the CLI, are not checked.
+func (t *UAAAuthentication) checkFoundation() error {
+	if t.foundation == nil || t.foundation.FoundationCheckDisabled() {
+		return nil
+	}
+
+	issuer := t.foundation.UAAIssuer()
+	uaaEndpoint := t.foundation.UAAEndpoint()
+	if issuer == "" || uaaEndpoint == "" {
+		return nil
+	}
+
+	issuerURL, err := url.Parse(issuer)
+	if err != nil || issuerURL.Host == "" {
+		return nil
+	}
+	uaaURL, err := url.Parse(uaaEndpoint)
+	if err != nil || uaaURL.Host == "" {
+		return nil
+	}
+
+	if !strings.EqualFold(issuerURL.Host, uaaURL.Host) {
+		return ccerror.FoundationMismatchError{
+			Issuer:      issuer,
+			UAAEndpoint: uaaEndpoint,
+		}
+	}
+
+	return nil
+}
+
+func (t *UAAAuthentication) currentToken() string {
+	t.refreshLock.Lock()
+	defer t.refreshLock.Unlock()
+	return t.cache.AccessToken()
+}
+
+// refreshToken replaces staleToken in the cache with a new access token,
+// unless a concurrent request already replaced it. Concurrent requests
+// rejected with the same token therefore share a single refresh. A token that
+// was refreshed by this wrapper is only refreshed again once it is about to
+// expire; a freshly refreshed token that is rejected will not be fixed by
+// another refresh.
+func (t *UAAAuthentication) refreshToken(staleToken string) error {
+	t.refreshLock.Lock()
+	defer t.refreshLock.Unlock()
+
+	if t.cache.AccessToken() != staleToken {
+		return nil
+	}
+
+	if staleToken == t.refreshedToken && !uaa.AccessTokenExpiresWithin(staleToken, accessTokenRefreshWindow) {
+		return nil
+	}
+
+	tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return err
+	}
+
+	t.refreshedToken = tokens.AuthorizationToken()
+	t.cache.SetAccessToken(t.refreshedToken)
+	t.cache.SetRefreshToken(tokens.RefreshToken)
+
+	return nil
+}
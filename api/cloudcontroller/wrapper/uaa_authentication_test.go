@@ -1,10 +1,14 @@
 package wrapper_test
 
 import (
+	"encoding/base64"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -191,5 +195,196 @@ var _ = Describe("UAA Authentication", func() {
 				})
 			})
 		})
+
+		Context("when the token expires within a minute", func() {
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken(tokenExpiringAt(time.Now().Add(30 * time.Second)))
+				fakeClient.RefreshAccessTokenReturns(uaa.RefreshedTokens{AccessToken: "foobar-2", Type: "bearer"}, nil)
+			})
+
+			It("refreshes the token before making the request", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal("bearer foobar-2"))
+			})
+
+			Context("when the refreshed token expires during a long-running command", func() {
+				BeforeEach(func() {
+					expiringToken := strings.TrimPrefix(tokenExpiringAt(time.Now().Add(30*time.Second)), "bearer ")
+					fakeClient.RefreshAccessTokenReturns(uaa.RefreshedTokens{AccessToken: expiringToken, Type: "bearer"}, nil)
+				})
+
+				It("refreshes the token again", func() {
+					Expect(wrapper.Make(request, nil)).To(Succeed())
+					Expect(wrapper.Make(&cloudcontroller.Request{Request: &http.Request{Header: http.Header{}}}, nil)).To(Succeed())
+
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(2))
+				})
+			})
+
+			Context("when refreshing fails", func() {
+				BeforeEach(func() {
+					fakeClient.RefreshAccessTokenReturns(uaa.RefreshedTokens{}, errors.New("refresh error"))
+				})
+
+				It("returns the error without making the request", func() {
+					err := wrapper.Make(request, nil)
+					Expect(err).To(MatchError("refresh error"))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the token is valid for more than a minute", func() {
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken(tokenExpiringAt(time.Now().Add(time.Hour)))
+			})
+
+			It("does not refresh the token", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the token is rejected by several requests", func() {
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken("what")
+				fakeClient.RefreshAccessTokenReturns(uaa.RefreshedTokens{AccessToken: "foobar-2", Type: "bearer"}, nil)
+				fakeConnection.MakeStub = func(request *cloudcontroller.Request, _ *cloudcontroller.Response) error {
+					if request.Header.Get("Authorization") == "what" {
+						return ccerror.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+			})
+
+			It("refreshes the token only once", func() {
+				Expect(wrapper.Make(request, nil)).To(Succeed())
+				Expect(wrapper.Make(&cloudcontroller.Request{Request: &http.Request{Header: http.Header{}}}, nil)).To(Succeed())
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+			})
+
+			Context("when the requests are made in parallel", func() {
+				It("shares a single refresh between them", func() {
+					var wg sync.WaitGroup
+					for i := 0; i < 10; i++ {
+						wg.Add(1)
+						go func() {
+							defer GinkgoRecover()
+							defer wg.Done()
+							Expect(wrapper.Make(&cloudcontroller.Request{Request: &http.Request{Header: http.Header{}}}, nil)).To(Succeed())
+						}()
+					}
+					wg.Wait()
+
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when a concurrent request already refreshed the token", func() {
+				BeforeEach(func() {
+					fakeConnection.MakeStub = func(request *cloudcontroller.Request, _ *cloudcontroller.Response) error {
+						if request.Header.Get("Authorization") == "what" {
+							inMemoryCache.SetAccessToken("bearer refreshed-elsewhere")
+							return ccerror.InvalidAuthTokenError{}
+						}
+						return nil
+					}
+				})
+
+				It("resends the request with the new token without refreshing", func() {
+					Expect(wrapper.Make(request, nil)).To(Succeed())
+
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+					resentRequest, _ := fakeConnection.MakeArgsForCall(1)
+					Expect(resentRequest.Header.Get("Authorization")).To(Equal("bearer refreshed-elsewhere"))
+				})
+			})
+
+			Context("when the refreshed token is rejected too", func() {
+				BeforeEach(func() {
+					fakeConnection.MakeReturns(ccerror.InvalidAuthTokenError{})
+					fakeConnection.MakeStub = nil
+				})
+
+				It("returns the error without refreshing again", func() {
+					Expect(wrapper.Make(request, nil)).To(MatchError(ccerror.InvalidAuthTokenError{}))
+					Expect(wrapper.Make(&cloudcontroller.Request{Request: &http.Request{Header: http.Header{}}}, nil)).To(MatchError(ccerror.InvalidAuthTokenError{}))
+
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+				})
+			})
+		})
+
+		Context("when a foundation store is set", func() {
+			var fakeStore *wrapperfakes.FakeFoundationStore
+
+			BeforeEach(func() {
+				fakeStore = new(wrapperfakes.FakeFoundationStore)
+				fakeStore.UAAIssuerReturns("https://uaa.example.com/oauth/token")
+				fakeStore.UAAEndpointReturns("https://UAA.example.com")
+				inner.SetFoundationStore(fakeStore)
+			})
+
+			Context("when the issuer belongs to the targeted UAA", func() {
+				It("makes the request", func() {
+					Expect(wrapper.Make(request, nil)).To(Succeed())
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the issuer belongs to a different UAA", func() {
+				BeforeEach(func() {
+					fakeStore.UAAEndpointReturns("https://uaa.other-foundation.com")
+				})
+
+				It("returns a FoundationMismatchError without making any request", func() {
+					expectedErr := ccerror.FoundationMismatchError{
+						Issuer:      "https://uaa.example.com/oauth/token",
+						UAAEndpoint: "https://uaa.other-foundation.com",
+					}
+					Expect(wrapper.Make(request, nil)).To(MatchError(expectedErr))
+					Expect(wrapper.Make(request, nil)).To(MatchError(expectedErr))
+
+					Expect(fakeConnection.MakeCallCount()).To(Equal(0))
+					Expect(fakeStore.UAAIssuerCallCount()).To(Equal(1))
+				})
+
+				Context("when the check is disabled", func() {
+					BeforeEach(func() {
+						fakeStore.FoundationCheckDisabledReturns(true)
+					})
+
+					It("makes the request", func() {
+						Expect(wrapper.Make(request, nil)).To(Succeed())
+						Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when no issuer was recorded at login", func() {
+				BeforeEach(func() {
+					fakeStore.UAAIssuerReturns("")
+				})
+
+				It("makes the request", func() {
+					Expect(wrapper.Make(request, nil)).To(Succeed())
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				})
+			})
+		})
 	})
 })
+
+func tokenExpiringAt(expiresAt time.Time) string {
+	claims := fmt.Sprintf(`{"exp":%d}`, expiresAt.Unix())
+	return "bearer header." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature"
+}
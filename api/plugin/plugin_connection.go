@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+	"code.cloudfoundry.org/cli/util/timings"
 )
 
 // PluginConnection represents a connection to a plugin repo.
@@ -35,7 +36,7 @@ func NewConnection(skipSSLValidation bool, dialTimeout time.Duration) *PluginCon
 	}
 
 	return &PluginConnection{
-		HTTPClient: &http.Client{Transport: tr},
+		HTTPClient: &http.Client{Transport: timings.NewTransport(tr)},
 	}
 }
 
@@ -15,6 +15,7 @@ type PluginBinary struct {
 	Platform string `json:"platform"`
 	URL      string `json:"url"`
 	Checksum string `json:"checksum"`
+	SHA256   string `json:"sha256"`
 }
 
 type Plugin struct {
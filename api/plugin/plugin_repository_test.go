@@ -30,7 +30,7 @@ var _ = Describe("PluginRepository", func() {
 							"name": "plugin-1",
 							"description": "useful plugin for useful things",
 							"version": "1.0.0",
-							"binaries": [{"platform":"osx","url":"http://some-url","checksum":"somechecksum"},{"platform":"win64","url":"http://another-url","checksum":"anotherchecksum"},{"platform":"linux64","url":"http://last-url","checksum":"lastchecksum"}]
+							"binaries": [{"platform":"osx","url":"http://some-url","checksum":"somechecksum"},{"platform":"win64","url":"http://another-url","checksum":"anotherchecksum","sha256":"anothersha256"},{"platform":"linux64","url":"http://last-url","checksum":"lastchecksum"}]
 						},
 						{
 							"name": "plugin-2",
@@ -58,7 +58,7 @@ var _ = Describe("PluginRepository", func() {
 							Version:     "1.0.0",
 							Binaries: []PluginBinary{
 								{Platform: "osx", URL: "http://some-url", Checksum: "somechecksum"},
-								{Platform: "win64", URL: "http://another-url", Checksum: "anotherchecksum"},
+								{Platform: "win64", URL: "http://another-url", Checksum: "anotherchecksum", SHA256: "anothersha256"},
 								{Platform: "linux64", URL: "http://last-url", Checksum: "lastchecksum"},
 							},
 						},
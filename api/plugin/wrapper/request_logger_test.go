@@ -100,15 +100,15 @@ var _ = Describe("Request Logger", func() {
 
 		Context("when an authorization header is in the request", func() {
 			BeforeEach(func() {
-				request.Header = http.Header{"Authorization": []string{"should not be shown"}}
+				request.Header = http.Header{"Authorization": []string{"bearer some-token"}}
 			})
 
-			It("redacts the contents of the authorization header", func() {
+			It("leaves redacting the authorization header to the output", func() {
 				Expect(makeErr).NotTo(HaveOccurred())
 				Expect(fakeOutput.DisplayHeaderCallCount()).To(Equal(1))
 				key, value := fakeOutput.DisplayHeaderArgsForCall(0)
 				Expect(key).To(Equal("Authorization"))
-				Expect(value).To(Equal("[PRIVATE DATA HIDDEN]"))
+				Expect(value).To(Equal("bearer some-token"))
 			})
 		})
 
@@ -0,0 +1,70 @@
+package uaa
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// TokenClaims are the claims of an access token that identify who it was
+// issued to and what it allows.
+type TokenClaims struct {
+	UserName  string   `json:"user_name"`
+	UserID    string   `json:"user_id"`
+	ClientID  string   `json:"client_id"`
+	Scopes    []string `json:"scope"`
+	Issuer    string   `json:"iss"`
+	ExpiresAt int64    `json:"exp"`
+}
+
+// Expiry returns when the token expires, or the zero time when the token
+// does not say.
+func (claims TokenClaims) Expiry() time.Time {
+	if claims.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.ExpiresAt, 0)
+}
+
+// DecodeAccessToken decodes the claims of an access token, in the
+// "<type> <JWT>" form stored in the config. The signature is not verified;
+// the claims are only meant to be displayed. Opaque tokens return a
+// TokenNotJWTError.
+func DecodeAccessToken(accessToken string) (TokenClaims, error) {
+	fields := strings.Fields(accessToken)
+	if len(fields) == 0 {
+		return TokenClaims{}, TokenNotJWTError{}
+	}
+
+	segments := strings.Split(fields[len(fields)-1], ".")
+	if len(segments) != 3 {
+		return TokenClaims{}, TokenNotJWTError{}
+	}
+
+	rawClaims, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return TokenClaims{}, TokenNotJWTError{}
+	}
+
+	var claims TokenClaims
+	err = json.Unmarshal(rawClaims, &claims)
+	if err != nil {
+		return TokenClaims{}, TokenNotJWTError{}
+	}
+
+	return claims, nil
+}
+
+// AccessTokenExpiresWithin reports whether the access token, in the
+// "<type> <JWT>" form stored in the config, expires within the given
+// duration. Tokens whose expiry cannot be read are treated as not expiring;
+// the server rejects them if they are no longer valid.
+func AccessTokenExpiresWithin(accessToken string, duration time.Duration) bool {
+	claims, err := DecodeAccessToken(accessToken)
+	if err != nil || claims.ExpiresAt == 0 {
+		return false
+	}
+
+	return time.Until(claims.Expiry()) < duration
+}
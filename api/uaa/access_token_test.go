@@ -0,0 +1,70 @@
+package uaa_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AccessTokenExpiresWithin", func() {
+	tokenExpiringAt := func(expiresAt time.Time) string {
+		claims := fmt.Sprintf(`{"user_name":"some-user","exp":%d}`, expiresAt.Unix())
+		return "bearer header." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature"
+	}
+
+	It("returns false when the token is valid for longer than the duration", func() {
+		Expect(AccessTokenExpiresWithin(tokenExpiringAt(time.Now().Add(time.Hour)), time.Minute)).To(BeFalse())
+	})
+
+	It("returns true when the token expires within the duration", func() {
+		Expect(AccessTokenExpiresWithin(tokenExpiringAt(time.Now().Add(30*time.Second)), time.Minute)).To(BeTrue())
+	})
+
+	It("returns true when the token has already expired", func() {
+		Expect(AccessTokenExpiresWithin(tokenExpiringAt(time.Now().Add(-time.Hour)), time.Minute)).To(BeTrue())
+	})
+
+	DescribeTable("returns false when the expiry cannot be read",
+		func(token string) {
+			Expect(AccessTokenExpiresWithin(token, time.Minute)).To(BeFalse())
+		},
+		Entry("empty token", ""),
+		Entry("opaque token", "bearer some-opaque-token"),
+		Entry("undecodable claims", "bearer header.!!!.signature"),
+		Entry("claims without exp", "bearer header."+base64.RawURLEncoding.EncodeToString([]byte(`{"user_name":"some-user"}`))+".signature"),
+	)
+})
+
+var _ = Describe("DecodeAccessToken", func() {
+	It("decodes the claims of the token", func() {
+		claims := `{"user_name":"some-user","user_id":"some-user-id","client_id":"cf","scope":["openid","cloud_controller.read"],"iss":"https://uaa.example.com/oauth/token","exp":1500000000}`
+		decoded, err := DecodeAccessToken("bearer header." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded).To(Equal(TokenClaims{
+			UserName:  "some-user",
+			UserID:    "some-user-id",
+			ClientID:  "cf",
+			Scopes:    []string{"openid", "cloud_controller.read"},
+			Issuer:    "https://uaa.example.com/oauth/token",
+			ExpiresAt: 1500000000,
+		}))
+		Expect(decoded.Expiry()).To(Equal(time.Unix(1500000000, 0)))
+	})
+
+	DescribeTable("returns a TokenNotJWTError when the token is not a JWT",
+		func(token string) {
+			_, err := DecodeAccessToken(token)
+			Expect(err).To(MatchError(TokenNotJWTError{}))
+		},
+		Entry("empty token", ""),
+		Entry("opaque token", "bearer some-opaque-token"),
+		Entry("undecodable claims", "bearer header.!!!.signature"),
+		Entry("claims that are not JSON", "bearer header."+base64.RawURLEncoding.EncodeToString([]byte("not-json"))+".signature"),
+	)
+})
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strings"
 
+	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/api/uaa/internal"
 )
 
@@ -15,13 +16,17 @@ type AuthResponse struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
-// Authenticate sends a username and password to UAA then returns an access
-// token and a refresh token.
-func (client Client) Authenticate(username string, password string) (string, string, error) {
+// Authenticate sends the credentials to UAA then returns an access token and
+// a refresh token. With the password grant, credentials holds the username,
+// the password and the values of any other prompts UAA advertises, such as an
+// MFA code. With the client credentials grant, it holds the client_id and
+// client_secret. Client credential grants do not return a refresh token.
+func (client Client) Authenticate(credentials map[string]string, grantType constant.GrantType) (string, string, error) {
 	requestBody := url.Values{}
-	requestBody.Set("username", username)
-	requestBody.Set("password", password)
-	requestBody.Set("grant_type", "password")
+	for key, value := range credentials {
+		requestBody.Set(key, value)
+	}
+	requestBody.Set("grant_type", string(grantType))
 
 	request, err := client.newRequest(requestOptions{
 		RequestName: internal.PostOAuthTokenRequest,
@@ -33,7 +38,9 @@ func (client Client) Authenticate(username string, password string) (string, str
 	if err != nil {
 		return "", "", err
 	}
-	request.SetBasicAuth(client.id, client.secret)
+	if grantType != constant.GrantTypeClientCredentials {
+		request.SetBasicAuth(client.id, client.secret)
+	}
 
 	responseBody := AuthResponse{}
 	response := Response{
@@ -10,13 +10,15 @@ import (
 	"runtime"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/api/uaa/internal"
 )
 
 // Client is the UAA client
 type Client struct {
-	id     string
-	secret string
+	id        string
+	secret    string
+	grantType constant.GrantType
 
 	connection Connection
 	router     *internal.Router
@@ -35,12 +37,21 @@ type Config struct {
 	// infinite.
 	DialTimeout time.Duration
 
+	// CACert is the PEM encoded CA certificates trusted in addition to the
+	// system's when verifying the server's certificate chain.
+	CACert []byte
+
 	// ClientID is the UAA client ID the client will use.
 	ClientID string
 
 	// ClientSecret is the UAA client secret the client will use.
 	ClientSecret string
 
+	// GrantType is the grant type the access token was obtained with. Tokens
+	// obtained with the client credentials grant have no refresh token, so they
+	// are renewed by repeating that grant.
+	GrantType constant.GrantType
+
 	// SkipSSLValidation controls whether a client verifies the server's
 	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
 	// any certificate presented by the server and any host name in that
@@ -62,10 +73,11 @@ func NewClient(config Config) *Client {
 	)
 
 	client := Client{
-		id:     config.ClientID,
-		secret: config.ClientSecret,
+		id:        config.ClientID,
+		secret:    config.ClientSecret,
+		grantType: config.GrantType,
 
-		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout),
+		connection: NewConnection(config.SkipSSLValidation, config.CACert, config.DialTimeout),
 		userAgent:  userAgent,
 	}
 	client.WrapConnection(NewErrorWrapper())
@@ -0,0 +1,13 @@
+package constant
+
+// GrantType is the OAuth grant type used to obtain an access token from UAA.
+type GrantType string
+
+const (
+	// GrantTypeClientCredentials is used by (non-user) service accounts, which
+	// authenticate as UAA clients.
+	GrantTypeClientCredentials GrantType = "client_credentials"
+	// GrantTypePassword is used by users, who authenticate with a username and
+	// password.
+	GrantTypePassword GrantType = "password"
+)
@@ -0,0 +1,2 @@
+// Package constant contains types and constants used by the uaa package.
+package constant
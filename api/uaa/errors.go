@@ -88,3 +88,11 @@ type InvalidSCIMResourceError struct {
 func (e InvalidSCIMResourceError) Error() string {
 	return e.Message
 }
+
+// TokenNotJWTError is returned when an access token cannot be decoded
+// because it is opaque rather than a JWT.
+type TokenNotJWTError struct{}
+
+func (TokenNotJWTError) Error() string {
+	return "access token is not a JWT"
+}
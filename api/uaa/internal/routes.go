@@ -5,9 +5,11 @@ import (
 )
 
 const (
-	GetSSHPasscodeRequest = "GetSSHPasscode"
-	PostOAuthTokenRequest = "PostOAuthToken"
-	PostUserRequest       = "PostUser"
+	GetLoginPromptsRequest = "GetLoginPrompts"
+	GetSSHPasscodeRequest  = "GetSSHPasscode"
+	GetUsersRequest        = "GetUsers"
+	PostOAuthTokenRequest  = "PostOAuthToken"
+	PostUserRequest        = "PostUser"
 )
 
 const (
@@ -17,6 +19,8 @@ const (
 
 // APIRoutes is a list of routes used by the router to construct request URLs.
 var APIRoutes = []Route{
+	{Path: "/login", Method: http.MethodGet, Name: GetLoginPromptsRequest, Resource: AuthorizationResource},
+	{Path: "/Users", Method: http.MethodGet, Name: GetUsersRequest, Resource: UAAResource},
 	{Path: "/Users", Method: http.MethodPost, Name: PostUserRequest, Resource: UAAResource},
 	{Path: "/oauth/authorize", Method: http.MethodGet, Name: GetSSHPasscodeRequest, Resource: UAAResource},
 	{Path: "/oauth/token", Method: http.MethodPost, Name: PostOAuthTokenRequest, Resource: AuthorizationResource},
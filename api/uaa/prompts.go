@@ -0,0 +1,50 @@
+package uaa
+
+import (
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// LoginPrompt represents a piece of information the authentication server
+// asks for when logging in.
+type LoginPrompt struct {
+	// Type is the kind of input, either "text" or "password".
+	Type string
+	// DisplayName is the human readable label for the prompt.
+	DisplayName string
+}
+
+// GetLoginPrompts returns the prompts, keyed by credential name, that the
+// authentication server expects to be filled in when logging in.
+func (client *Client) GetLoginPrompts() (map[string]LoginPrompt, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetLoginPromptsRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var info struct {
+		Prompts map[string][]string `json:"prompts"`
+	}
+	response := Response{
+		Result: &info,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	prompts := map[string]LoginPrompt{}
+	for name, values := range info.Prompts {
+		if len(values) != 2 {
+			continue
+		}
+		prompts[name] = LoginPrompt{
+			Type:        values[0],
+			DisplayName: values[1],
+		}
+	}
+
+	return prompts, nil
+}
@@ -0,0 +1,74 @@
+package uaa_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Prompts", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestUAAClientAndStore()
+	})
+
+	Describe("GetLoginPrompts", func() {
+		Context("when no errors occur", func() {
+			BeforeEach(func() {
+				response := `{
+					"prompts": {
+						"username": ["text", "Email"],
+						"password": ["password", "Password"],
+						"passcode": ["password", "Temporary Authentication Code"],
+						"malformed": ["text"]
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestAuthorizationResource),
+						VerifyRequest(http.MethodGet, "/login"),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the well formed prompts", func() {
+				prompts, err := client.GetLoginPrompts()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(prompts).To(Equal(map[string]LoginPrompt{
+					"username": {Type: "text", DisplayName: "Email"},
+					"password": {Type: "password", DisplayName: "Password"},
+					"passcode": {Type: "password", DisplayName: "Temporary Authentication Code"},
+				}))
+			})
+		})
+
+		Context("when an error occurs", func() {
+			var response string
+
+			BeforeEach(func() {
+				response = `{
+					"error": "some-error",
+					"error_description": "some-description"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestAuthorizationResource),
+						VerifyRequest(http.MethodGet, "/login"),
+						RespondWith(http.StatusBadRequest, response),
+					))
+			})
+
+			It("returns the error", func() {
+				_, err := client.GetLoginPrompts()
+				Expect(err).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusBadRequest,
+					RawResponse: []byte(response),
+				}))
+			})
+		})
+	})
+})
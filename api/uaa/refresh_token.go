@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strings"
 
+	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/api/uaa/internal"
 )
 
@@ -21,14 +22,21 @@ func (refreshTokenResponse RefreshedTokens) AuthorizationToken() string {
 	return fmt.Sprintf("%s %s", refreshTokenResponse.Type, refreshTokenResponse.AccessToken)
 }
 
-// RefreshAccessToken refreshes the current access token.
+// RefreshAccessToken refreshes the current access token. Tokens obtained with
+// the client credentials grant are replaced by repeating that grant, since UAA
+// does not issue refresh tokens for them.
 func (client *Client) RefreshAccessToken(refreshToken string) (RefreshedTokens, error) {
-	body := strings.NewReader(url.Values{
+	values := url.Values{
 		"client_id":     {client.id},
 		"client_secret": {client.secret},
-		"grant_type":    {"refresh_token"},
-		"refresh_token": {refreshToken},
-	}.Encode())
+	}
+	if client.grantType == constant.GrantTypeClientCredentials {
+		values.Set("grant_type", string(constant.GrantTypeClientCredentials))
+	} else {
+		values.Set("grant_type", "refresh_token")
+		values.Set("refresh_token", refreshToken)
+	}
+	body := strings.NewReader(values.Encode())
 
 	request, err := client.newRequest(requestOptions{
 		RequestName: internal.PostOAuthTokenRequest,
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -62,4 +63,36 @@ var _ = Describe("UAA Client", func() {
 			Expect(server.ReceivedRequests()).To(HaveLen(2))
 		})
 	})
+
+	Describe("RefreshAccessToken with the client credentials grant", func() {
+		BeforeEach(func() {
+			SetupBootstrapResponse()
+			client = NewClient(Config{
+				AppName:           "CF CLI UAA API Test",
+				AppVersion:        "Unknown",
+				ClientID:          "client-id",
+				ClientSecret:      "client-secret",
+				GrantType:         constant.GrantTypeClientCredentials,
+				SkipSSLValidation: true,
+			})
+			Expect(client.SetupResources(TestSuiteFakeStore, server.URL())).To(Succeed())
+
+			server.AppendHandlers(
+				CombineHandlers(
+					verifyRequestHost(TestAuthorizationResource),
+					VerifyRequest(http.MethodPost, "/oauth/token"),
+					VerifyBody([]byte("client_id=client-id&client_secret=client-secret&grant_type=client_credentials")),
+					RespondWith(http.StatusOK, `{"access_token": "I-ACCESS-TOKEN", "token_type": "bearer"}`),
+				))
+		})
+
+		It("repeats the client credentials grant instead of using a refresh token", func() {
+			token, err := client.RefreshAccessToken("")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token).To(Equal(RefreshedTokens{
+				AccessToken: "I-ACCESS-TOKEN",
+				Type:        "bearer",
+			}))
+		})
+	})
 })
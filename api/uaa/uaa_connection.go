@@ -10,6 +10,9 @@ import (
 	"net/http"
 	"net/url"
 	"time"
+
+	"code.cloudfoundry.org/cli/util/cacert"
+	"code.cloudfoundry.org/cli/util/timings"
 )
 
 // UAAConnection represents the connection to UAA
@@ -18,10 +21,11 @@ type UAAConnection struct {
 }
 
 // NewConnection returns a pointer to a new UAA Connection
-func NewConnection(skipSSLValidation bool, dialTimeout time.Duration) *UAAConnection {
+func NewConnection(skipSSLValidation bool, caCert []byte, dialTimeout time.Duration) *UAAConnection {
 	tr := &http.Transport{
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: skipSSLValidation,
+			RootCAs:            cacert.NewCertPool(caCert),
 		},
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
@@ -32,7 +36,7 @@ func NewConnection(skipSSLValidation bool, dialTimeout time.Duration) *UAAConnec
 
 	return &UAAConnection{
 		HTTPClient: &http.Client{
-			Transport: tr,
+			Transport: timings.NewTransport(tr),
 			CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
 				// This prevents redirects. When making a request to /oauth/authorize,
 				// the client should not follow redirects in order to obtain the ssh
@@ -22,7 +22,7 @@ var _ = Describe("UAA Connection", func() {
 	)
 
 	BeforeEach(func() {
-		connection = NewConnection(true, 0)
+		connection = NewConnection(true, nil, 0)
 	})
 
 	Describe("Make", func() {
@@ -99,7 +99,7 @@ var _ = Describe("UAA Connection", func() {
 		Describe("Errors", func() {
 			Context("when the server does not exist", func() {
 				BeforeEach(func() {
-					connection = NewConnection(false, 0)
+					connection = NewConnection(false, nil, 0)
 				})
 
 				It("returns a RequestError", func() {
@@ -125,7 +125,7 @@ var _ = Describe("UAA Connection", func() {
 							),
 						)
 
-						connection = NewConnection(false, 0)
+						connection = NewConnection(false, nil, 0)
 					})
 
 					It("returns a UnverifiedServerError", func() {
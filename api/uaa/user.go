@@ -3,14 +3,18 @@ package uaa
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"code.cloudfoundry.org/cli/api/uaa/internal"
 )
 
 // User represents an UAA user account.
 type User struct {
-	ID string
+	ID     string
+	Origin string
 }
 
 // newUserRequestBody represents the body of the request.
@@ -37,6 +41,14 @@ type newUserResponse struct {
 	ID string `json:"id"`
 }
 
+// usersResponse represents the HTTP JSON response of a user search.
+type usersResponse struct {
+	Resources []struct {
+		ID     string `json:"id"`
+		Origin string `json:"origin"`
+	} `json:"resources"`
+}
+
 // CreateUser creates a new UAA user account with the provided password.
 func (client *Client) CreateUser(user string, password string, origin string) (User, error) {
 	userRequest := newUserRequestBody{
@@ -83,3 +95,35 @@ func (client *Client) CreateUser(user string, password string, origin string) (U
 
 	return User{ID: userResponse.ID}, nil
 }
+
+// ListUsers returns the UAA user accounts with the provided username, one for
+// each origin the username exists in.
+func (client *Client) ListUsers(username string) ([]User, error) {
+	filter := fmt.Sprintf(`userName eq "%s"`, strings.Replace(username, `"`, `\"`, -1))
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetUsersRequest,
+		Query: url.Values{
+			"filter":     {filter},
+			"attributes": {"id,origin"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result usersResponse
+	response := Response{
+		Result: &result,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]User, 0, len(result.Resources))
+	for _, resource := range result.Resources {
+		users = append(users, User{ID: resource.ID, Origin: resource.Origin})
+	}
+	return users, nil
+}
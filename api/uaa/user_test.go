@@ -93,4 +93,52 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("ListUsers", func() {
+		Context("when no errors occur", func() {
+			BeforeEach(func() {
+				response := `{
+					"resources": [
+						{ "id": "uaa-user-id", "origin": "uaa" },
+						{ "id": "ldap-user-id", "origin": "ldap" }
+					]
+				}`
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/Users", "attributes=id%2Corigin&filter=userName+eq+%22some-user%22"),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the user in every origin", func() {
+				users, err := client.ListUsers("some-user")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(users).To(Equal([]User{
+					{ID: "uaa-user-id", Origin: "uaa"},
+					{ID: "ldap-user-id", Origin: "ldap"},
+				}))
+			})
+		})
+
+		Context("when an error occurs", func() {
+			BeforeEach(func() {
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/Users"),
+						RespondWith(http.StatusTeapot, `{}`),
+					))
+			})
+
+			It("returns the error", func() {
+				_, err := client.ListUsers("some-user")
+				Expect(err).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusTeapot,
+					RawResponse: []byte(`{}`),
+				}))
+			})
+		})
+	})
 })
@@ -5,6 +5,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 )
@@ -26,12 +28,19 @@ type TokenCache interface {
 	SetRefreshToken(token string)
 }
 
+// accessTokenRefreshWindow is how close to expiring an access token has to be
+// before it is refreshed ahead of a request.
+const accessTokenRefreshWindow = time.Minute
+
 // UAAAuthentication wraps connections and adds authentication headers to all
 // requests
 type UAAAuthentication struct {
 	connection uaa.Connection
 	client     UAAClient
 	cache      TokenCache
+
+	refreshLock    sync.Mutex
+	refreshedToken string
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -56,6 +65,11 @@ func (t *UAAAuthentication) SetClient(client UAAClient) {
 
 // Make adds authentication headers to the passed in request and then calls the
 // wrapped connection's Make
+//
+// The access token is refreshed ahead of the request when it is about to
+// expire, or when UAA rejects it, after which the request is replayed
+// once. A token this wrapper has just refreshed is not refreshed again unless
+// it has itself expired, which only happens during long-running commands.
 func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Response) error {
 	if t.client == nil {
 		return t.connection.Make(request, passedResponse)
@@ -78,28 +92,75 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Resp
 		}
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	accessToken := t.currentToken()
+	if uaa.AccessTokenExpiresWithin(accessToken, accessTokenRefreshWindow) {
+		err = t.refreshToken(accessToken)
+		if err != nil {
+			return err
+		}
+		accessToken = t.currentToken()
+	}
+
+	request.Header.Set("Authorization", accessToken)
 
 	err = t.connection.Make(request, passedResponse)
 	if _, ok := err.(uaa.InvalidAuthTokenError); ok {
-		tokens, refreshErr := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		refreshErr := t.refreshToken(accessToken)
 		if refreshErr != nil {
 			return refreshErr
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
+		refreshedToken := t.currentToken()
+		if refreshedToken == accessToken {
+			return err
+		}
 
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", refreshedToken)
 		return t.connection.Make(request, passedResponse)
 	}
 
 	return err
 }
 
+func (t *UAAAuthentication) currentToken() string {
+	t.refreshLock.Lock()
+	defer t.refreshLock.Unlock()
+	return t.cache.AccessToken()
+}
+
+// refreshToken replaces staleToken in the cache with a new access token,
+// unless a concurrent request already replaced it. Concurrent requests
+// rejected with the same token therefore share a single refresh. A token that
+// was refreshed by this wrapper is only refreshed again once it is about to
+// expire; a freshly refreshed token that is rejected will not be fixed by
+// another refresh.
+func (t *UAAAuthentication) refreshToken(staleToken string) error {
+	t.refreshLock.Lock()
+	defer t.refreshLock.Unlock()
+
+	if t.cache.AccessToken() != staleToken {
+		return nil
+	}
+
+	if staleToken == t.refreshedToken && !uaa.AccessTokenExpiresWithin(staleToken, accessTokenRefreshWindow) {
+		return nil
+	}
+
+	tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return err
+	}
+
+	t.refreshedToken = tokens.AuthorizationToken()
+	t.cache.SetAccessToken(t.refreshedToken)
+	t.cache.SetRefreshToken(tokens.RefreshToken)
+
+	return nil
+}
+
 // The authentication header is not added to token refresh requests or login
 // requests.
 func skipAuthenticationHeader(request *http.Request, body []byte) bool {
@@ -108,5 +169,6 @@ func skipAuthenticationHeader(request *http.Request, body []byte) bool {
 	return strings.Contains(request.URL.String(), "/oauth/token") &&
 		request.Method == http.MethodPost &&
 		(strings.Contains(stringBody, "grant_type=refresh_token") ||
-			strings.Contains(stringBody, "grant_type=password"))
+			strings.Contains(stringBody, "grant_type=password") ||
+			strings.Contains(stringBody, "grant_type=client_credentials"))
 }
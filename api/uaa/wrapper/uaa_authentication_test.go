@@ -1,12 +1,14 @@
 package wrapper_test
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
@@ -162,6 +164,45 @@ var _ = Describe("UAA Authentication", func() {
 			})
 		})
 
+		Context("when the token expires within a minute", func() {
+			BeforeEach(func() {
+				request = &http.Request{
+					Header: http.Header{},
+				}
+				claims := fmt.Sprintf(`{"exp":%d}`, time.Now().Add(30*time.Second).Unix())
+				inMemoryCache.SetAccessToken("bearer header." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature")
+				fakeClient.RefreshAccessTokenReturns(uaa.RefreshedTokens{AccessToken: "foobar-2", Type: "bearer"}, nil)
+			})
+
+			It("refreshes the token before making the request", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(authenticatedRequest.Header.Get("Authorization")).To(Equal("bearer foobar-2"))
+			})
+		})
+
+		Context("when the token is rejected by several requests", func() {
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken("what")
+				fakeClient.RefreshAccessTokenReturns(uaa.RefreshedTokens{AccessToken: "foobar-2", Type: "bearer"}, nil)
+				fakeConnection.MakeReturns(uaa.InvalidAuthTokenError{})
+			})
+
+			It("refreshes the token only once", func() {
+				err := wrapper.Make(&http.Request{Header: http.Header{}}, nil)
+				Expect(err).To(MatchError(uaa.InvalidAuthTokenError{}))
+				err = wrapper.Make(&http.Request{Header: http.Header{}}, nil)
+				Expect(err).To(MatchError(uaa.InvalidAuthTokenError{}))
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+			})
+		})
+
 		Context("when refreshing the token", func() {
 			var originalAuthHeader string
 			BeforeEach(func() {
@@ -213,5 +254,28 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(request.Header.Get("Authorization")).To(Equal(originalAuthHeader))
 			})
 		})
+
+		Context("when logging in with client credentials", func() {
+			BeforeEach(func() {
+				body := strings.NewReader(url.Values{
+					"grant_type": {"client_credentials"},
+				}.Encode())
+
+				request, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/token", server.URL()), body)
+				Expect(err).NotTo(HaveOccurred())
+
+				inMemoryCache.SetAccessToken("some-access-token")
+
+				err = wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not add an 'Authorization' header", func() {
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+				request, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(request.Header.Get("Authorization")).To(BeEmpty())
+			})
+		})
 	})
 })
@@ -61,6 +61,24 @@ type FakePushActor struct {
 	mapManifestRouteReturns struct {
 		result1 error
 	}
+	ProcessRemotePathStub        func(url string, checksum string, f func(string) error) error
+	processRemotePathMutex       sync.RWMutex
+	processRemotePathArgsForCall []struct {
+		url      string
+		checksum string
+		f        func(string) error
+	}
+	processRemotePathReturns struct {
+		result1 error
+	}
+	ResumeUploadStub        func(jobURL string) error
+	resumeUploadMutex       sync.RWMutex
+	resumeUploadArgsForCall []struct {
+		jobURL string
+	}
+	resumeUploadReturns struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -255,6 +273,74 @@ func (fake *FakePushActor) MapManifestRouteReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakePushActor) ProcessRemotePath(url string, checksum string, f func(string) error) error {
+	fake.processRemotePathMutex.Lock()
+	fake.processRemotePathArgsForCall = append(fake.processRemotePathArgsForCall, struct {
+		url      string
+		checksum string
+		f        func(string) error
+	}{url, checksum, f})
+	fake.recordInvocation("ProcessRemotePath", []interface{}{url, checksum, f})
+	fake.processRemotePathMutex.Unlock()
+	if fake.ProcessRemotePathStub != nil {
+		return fake.ProcessRemotePathStub(url, checksum, f)
+	} else {
+		return fake.processRemotePathReturns.result1
+	}
+}
+
+func (fake *FakePushActor) ProcessRemotePathCallCount() int {
+	fake.processRemotePathMutex.RLock()
+	defer fake.processRemotePathMutex.RUnlock()
+	return len(fake.processRemotePathArgsForCall)
+}
+
+func (fake *FakePushActor) ProcessRemotePathArgsForCall(i int) (string, string, func(string) error) {
+	fake.processRemotePathMutex.RLock()
+	defer fake.processRemotePathMutex.RUnlock()
+	return fake.processRemotePathArgsForCall[i].url, fake.processRemotePathArgsForCall[i].checksum, fake.processRemotePathArgsForCall[i].f
+}
+
+func (fake *FakePushActor) ProcessRemotePathReturns(result1 error) {
+	fake.ProcessRemotePathStub = nil
+	fake.processRemotePathReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePushActor) ResumeUpload(jobURL string) error {
+	fake.resumeUploadMutex.Lock()
+	fake.resumeUploadArgsForCall = append(fake.resumeUploadArgsForCall, struct {
+		jobURL string
+	}{jobURL})
+	fake.recordInvocation("ResumeUpload", []interface{}{jobURL})
+	fake.resumeUploadMutex.Unlock()
+	if fake.ResumeUploadStub != nil {
+		return fake.ResumeUploadStub(jobURL)
+	} else {
+		return fake.resumeUploadReturns.result1
+	}
+}
+
+func (fake *FakePushActor) ResumeUploadCallCount() int {
+	fake.resumeUploadMutex.RLock()
+	defer fake.resumeUploadMutex.RUnlock()
+	return len(fake.resumeUploadArgsForCall)
+}
+
+func (fake *FakePushActor) ResumeUploadArgsForCall(i int) string {
+	fake.resumeUploadMutex.RLock()
+	defer fake.resumeUploadMutex.RUnlock()
+	return fake.resumeUploadArgsForCall[i].jobURL
+}
+
+func (fake *FakePushActor) ResumeUploadReturns(result1 error) {
+	fake.ResumeUploadStub = nil
+	fake.resumeUploadReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -268,6 +354,10 @@ func (fake *FakePushActor) Invocations() map[string][][]interface{} {
 	defer fake.validateAppParamsMutex.RUnlock()
 	fake.mapManifestRouteMutex.RLock()
 	defer fake.mapManifestRouteMutex.RUnlock()
+	fake.processRemotePathMutex.RLock()
+	defer fake.processRemotePathMutex.RUnlock()
+	fake.resumeUploadMutex.RLock()
+	defer fake.resumeUploadMutex.RUnlock()
 	return fake.invocations
 }
 
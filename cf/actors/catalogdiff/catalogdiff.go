@@ -0,0 +1,125 @@
+package catalogdiff
+
+import (
+	"sort"
+	"strconv"
+
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+)
+
+// The kinds of Change.
+const (
+	Added   = "added"
+	Removed = "removed"
+	Changed = "changed"
+)
+
+// Change describes a difference between the services and plans Cloud
+// Foundry knows about and the catalog a service broker currently advertises.
+// Plan is empty when the change applies to the service itself.
+type Change struct {
+	Change  string   `json:"change"`
+	Service string   `json:"service"`
+	Plan    string   `json:"plan,omitempty"`
+	Details []string `json:"details,omitempty"`
+}
+
+// Diff compares the services and plans registered for a broker with
+// the broker's catalog. Services and plans are matched by the broker-provided
+// unique id, so renames are reported as changes rather than as a removal and
+// an addition.
+func Diff(current []models.ServiceOffering, catalog models.ServiceBrokerCatalog) []Change {
+	changes := []Change{}
+
+	currentServices := map[string]models.ServiceOffering{}
+	for _, service := range current {
+		currentServices[service.UniqueID] = service
+	}
+
+	catalogServiceIDs := map[string]bool{}
+	for _, catalogService := range catalog.Services {
+		catalogServiceIDs[catalogService.ID] = true
+
+		service, found := currentServices[catalogService.ID]
+		if !found {
+			changes = append(changes, Change{Change: Added, Service: catalogService.Name})
+			for _, plan := range catalogService.Plans {
+				changes = append(changes, Change{Change: Added, Service: catalogService.Name, Plan: plan.Name})
+			}
+			continue
+		}
+
+		var details []string
+		details = appendFieldChange(details, T("name"), service.Label, catalogService.Name)
+		details = appendFieldChange(details, T("description"), service.Description, catalogService.Description)
+		if len(details) > 0 {
+			changes = append(changes, Change{Change: Changed, Service: catalogService.Name, Details: details})
+		}
+
+		changes = append(changes, diffPlans(catalogService.Name, service.Plans, catalogService.Plans)...)
+	}
+
+	for _, service := range current {
+		if catalogServiceIDs[service.UniqueID] {
+			continue
+		}
+		changes = append(changes, Change{Change: Removed, Service: service.Label})
+		for _, plan := range service.Plans {
+			changes = append(changes, Change{Change: Removed, Service: service.Label, Plan: plan.Name})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Service < changes[j].Service
+	})
+
+	return changes
+}
+
+func diffPlans(serviceName string, current []models.ServicePlanFields, catalogPlans []models.ServiceBrokerCatalogPlan) []Change {
+	var changes []Change
+
+	currentPlans := map[string]models.ServicePlanFields{}
+	for _, plan := range current {
+		currentPlans[plan.UniqueID] = plan
+	}
+
+	catalogPlanIDs := map[string]bool{}
+	for _, catalogPlan := range catalogPlans {
+		catalogPlanIDs[catalogPlan.ID] = true
+
+		plan, found := currentPlans[catalogPlan.ID]
+		if !found {
+			changes = append(changes, Change{Change: Added, Service: serviceName, Plan: catalogPlan.Name})
+			continue
+		}
+
+		var details []string
+		details = appendFieldChange(details, T("name"), plan.Name, catalogPlan.Name)
+		details = appendFieldChange(details, T("description"), plan.Description, catalogPlan.Description)
+		details = appendFieldChange(details, T("free"), strconv.FormatBool(plan.Free), strconv.FormatBool(catalogPlan.Free))
+		if len(details) > 0 {
+			changes = append(changes, Change{Change: Changed, Service: serviceName, Plan: catalogPlan.Name, Details: details})
+		}
+	}
+
+	for _, plan := range current {
+		if !catalogPlanIDs[plan.UniqueID] {
+			changes = append(changes, Change{Change: Removed, Service: serviceName, Plan: plan.Name})
+		}
+	}
+
+	return changes
+}
+
+func appendFieldChange(details []string, field, from, to string) []string {
+	if from == to {
+		return details
+	}
+	return append(details, T("{{.Field}}: '{{.From}}' -> '{{.To}}'", map[string]interface{}{
+		"Field": field,
+		"From":  from,
+		"To":    to,
+	}))
+}
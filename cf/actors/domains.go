@@ -0,0 +1,98 @@
+package actors
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/models"
+)
+
+// DomainDetails is a domain together with the organizations a private domain
+// is shared with and the router group of a TCP domain.
+type DomainDetails struct {
+	models.DomainFields
+	SharedOrganizations []models.OrganizationFields
+	RouterGroup         *models.RouterGroup
+}
+
+// DomainDetailsFetcher joins the shared and private domains of an org with
+// the details that are only available from separate endpoints.
+type DomainDetailsFetcher struct {
+	domainRepo     api.DomainRepository
+	routingAPIRepo api.RoutingAPIRepository
+}
+
+func NewDomainDetailsFetcher(domainRepo api.DomainRepository, routingAPIRepo api.RoutingAPIRepository) *DomainDetailsFetcher {
+	return &DomainDetailsFetcher{
+		domainRepo:     domainRepo,
+		routingAPIRepo: routingAPIRepo,
+	}
+}
+
+// ListDomainsForOrg returns the domains visible to the org sorted by name.
+func (fetcher *DomainDetailsFetcher) ListDomainsForOrg(orgGUID string) ([]DomainDetails, error) {
+	domains := []DomainDetails{}
+	domainFields := []models.DomainFields{}
+	err := fetcher.domainRepo.ListDomainsForOrg(orgGUID, func(domain models.DomainFields) bool {
+		domains = append(domains, DomainDetails{DomainFields: domain})
+		domainFields = append(domainFields, domain)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, domain := range domains {
+		if domain.Shared {
+			continue
+		}
+
+		sharedOrgs := []models.OrganizationFields{}
+		err = fetcher.domainRepo.ListSharedOrganizations(domain.GUID, func(org models.OrganizationFields) bool {
+			sharedOrgs = append(sharedOrgs, org)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(sharedOrgs, func(i, j int) bool { return sharedOrgs[i].Name < sharedOrgs[j].Name })
+		domains[i].SharedOrganizations = sharedOrgs
+	}
+
+	routerGroups, err := fetcher.RouterGroupsForDomains(domainFields)
+	if err != nil {
+		return nil, err
+	}
+	for i, domain := range domains {
+		if routerGroup, ok := routerGroups[domain.RouterGroupGUID]; ok {
+			domains[i].RouterGroup = &routerGroup
+		}
+	}
+
+	sort.Slice(domains, func(i, j int) bool { return domains[i].Name < domains[j].Name })
+	return domains, nil
+}
+
+// RouterGroupsForDomains returns the router groups keyed by GUID. Router
+// groups are only requested when one of the domains has one, since the
+// routing API is not deployed on every foundation.
+func (fetcher *DomainDetailsFetcher) RouterGroupsForDomains(domains []models.DomainFields) (map[string]models.RouterGroup, error) {
+	routerGroups := map[string]models.RouterGroup{}
+
+	hasRouterGroups := false
+	for _, domain := range domains {
+		hasRouterGroups = hasRouterGroups || domain.RouterGroupGUID != ""
+	}
+	if !hasRouterGroups {
+		return routerGroups, nil
+	}
+
+	err := fetcher.routingAPIRepo.ListRouterGroups(func(routerGroup models.RouterGroup) bool {
+		routerGroups[routerGroup.GUID] = routerGroup
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return routerGroups, nil
+}
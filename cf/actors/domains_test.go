@@ -0,0 +1,110 @@
+package actors_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/cf/actors"
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/models"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DomainDetailsFetcher", func() {
+	var (
+		fakeDomainRepository     *apifakes.FakeDomainRepository
+		fakeRoutingAPIRepository *apifakes.FakeRoutingAPIRepository
+		fetcher                  *DomainDetailsFetcher
+
+		domainFields []models.DomainFields
+		domains      []DomainDetails
+		err          error
+	)
+
+	BeforeEach(func() {
+		fakeDomainRepository = new(apifakes.FakeDomainRepository)
+		fakeRoutingAPIRepository = new(apifakes.FakeRoutingAPIRepository)
+		fetcher = NewDomainDetailsFetcher(fakeDomainRepository, fakeRoutingAPIRepository)
+
+		domainFields = []models.DomainFields{
+			{GUID: "private-guid", Name: "private.example.com", OwningOrganizationGUID: "org-guid"},
+			{GUID: "tcp-guid", Name: "tcp.example.com", Shared: true, RouterGroupGUID: "router-group-guid", RouterGroupType: "tcp"},
+			{GUID: "shared-guid", Name: "example.com", Shared: true},
+		}
+		fakeDomainRepository.ListDomainsForOrgStub = func(orgGUID string, cb func(models.DomainFields) bool) error {
+			for _, domain := range domainFields {
+				cb(domain)
+			}
+			return nil
+		}
+		fakeDomainRepository.ListSharedOrganizationsStub = func(domainGUID string, cb func(models.OrganizationFields) bool) error {
+			cb(models.OrganizationFields{GUID: "org2-guid", Name: "org2"})
+			cb(models.OrganizationFields{GUID: "org1-guid", Name: "org1"})
+			return nil
+		}
+		fakeRoutingAPIRepository.ListRouterGroupsStub = func(cb func(models.RouterGroup) bool) error {
+			cb(models.RouterGroup{GUID: "router-group-guid", Name: "default-tcp", Type: "tcp"})
+			return nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		domains, err = fetcher.ListDomainsForOrg("org-guid")
+	})
+
+	It("returns the domains sorted by name with their shared orgs and router groups", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(domains).To(HaveLen(3))
+
+		Expect(domains[0].Name).To(Equal("example.com"))
+		Expect(domains[0].SharedOrganizations).To(BeNil())
+		Expect(domains[0].RouterGroup).To(BeNil())
+
+		Expect(domains[1].Name).To(Equal("private.example.com"))
+		Expect(domains[1].SharedOrganizations).To(Equal([]models.OrganizationFields{
+			{GUID: "org1-guid", Name: "org1"},
+			{GUID: "org2-guid", Name: "org2"},
+		}))
+
+		Expect(domains[2].Name).To(Equal("tcp.example.com"))
+		Expect(domains[2].RouterGroup).To(Equal(&models.RouterGroup{GUID: "router-group-guid", Name: "default-tcp", Type: "tcp"}))
+
+		Expect(fakeDomainRepository.ListDomainsForOrgCallCount()).To(Equal(1))
+		Expect(fakeDomainRepository.ListSharedOrganizationsCallCount()).To(Equal(1))
+		domainGUID, _ := fakeDomainRepository.ListSharedOrganizationsArgsForCall(0)
+		Expect(domainGUID).To(Equal("private-guid"))
+	})
+
+	Context("when no domain has a router group", func() {
+		BeforeEach(func() {
+			domainFields = domainFields[:1]
+		})
+
+		It("does not query the routing API", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeRoutingAPIRepository.ListRouterGroupsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when listing the shared organizations fails", func() {
+		BeforeEach(func() {
+			fakeDomainRepository.ListSharedOrganizationsStub = nil
+			fakeDomainRepository.ListSharedOrganizationsReturns(errors.New("shared-orgs-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("shared-orgs-error"))
+		})
+	})
+
+	Context("when listing the router groups fails", func() {
+		BeforeEach(func() {
+			fakeRoutingAPIRepository.ListRouterGroupsStub = nil
+			fakeRoutingAPIRepository.ListRouterGroupsReturns(errors.New("router-groups-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("router-groups-error"))
+		})
+	})
+})
@@ -0,0 +1,118 @@
+package purgereport
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/api/applications"
+	"code.cloudfoundry.org/cli/cf/errors"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+// ListLimit is the number of service instances listed individually in a
+// report. Only counts are shown for the rest.
+const ListLimit = 50
+
+// Report describes the records a purge removes from Cloud Foundry's
+// database.
+type Report struct {
+	ServiceInstanceCount int        `json:"service_instance_count"`
+	ServiceBindingCount  int        `json:"service_binding_count"`
+	ServiceKeyCount      int        `json:"service_key_count"`
+	ServiceInstances     []Instance `json:"service_instances"`
+}
+
+type Instance struct {
+	Name            string   `json:"name"`
+	GUID            string   `json:"guid"`
+	BoundApps       []string `json:"bound_apps"`
+	ServiceKeyCount int      `json:"service_key_count"`
+}
+
+// Build counts the bindings and keys of the given instances. The names of
+// bound apps are looked up for the first ListLimit instances only.
+func Build(instances []models.ServiceInstance, appRepo applications.Repository) (Report, error) {
+	report := Report{
+		ServiceInstanceCount: len(instances),
+		ServiceInstances:     []Instance{},
+	}
+	appNames := map[string]string{}
+
+	for index, instance := range instances {
+		report.ServiceBindingCount += len(instance.ServiceBindings)
+		report.ServiceKeyCount += len(instance.ServiceKeys)
+
+		if index >= ListLimit {
+			continue
+		}
+
+		boundApps := []string{}
+		for _, binding := range instance.ServiceBindings {
+			name, ok := appNames[binding.AppGUID]
+			if !ok {
+				app, err := appRepo.GetApp(binding.AppGUID)
+				if httpErr, isHTTPErr := err.(errors.HTTPError); isHTTPErr && httpErr.StatusCode() == http.StatusNotFound {
+					name = binding.AppGUID
+				} else if err != nil {
+					return Report{}, err
+				} else {
+					name = app.Name
+				}
+				appNames[binding.AppGUID] = name
+			}
+			boundApps = append(boundApps, name)
+		}
+
+		report.ServiceInstances = append(report.ServiceInstances, Instance{
+			Name:            instance.Name,
+			GUID:            instance.GUID,
+			BoundApps:       boundApps,
+			ServiceKeyCount: len(instance.ServiceKeys),
+		})
+	}
+
+	return report, nil
+}
+
+// Print writes the report as text, listing the first ListLimit instances in a
+// table.
+func Print(ui terminal.UI, report Report) error {
+	ui.Say(T("The following will be removed from Cloud Foundry's database:"))
+	ui.Say(T("  service instances: {{.Count}}", map[string]interface{}{"Count": report.ServiceInstanceCount}))
+	ui.Say(T("  service bindings: {{.Count}}", map[string]interface{}{"Count": report.ServiceBindingCount}))
+	ui.Say(T("  service keys: {{.Count}}", map[string]interface{}{"Count": report.ServiceKeyCount}))
+
+	if len(report.ServiceInstances) == 0 {
+		return nil
+	}
+
+	ui.Say("")
+	table := ui.Table([]string{T("service instance"), T("bound apps"), T("service keys")})
+	for _, instance := range report.ServiceInstances {
+		table.Add(instance.Name, strings.Join(instance.BoundApps, ", "), strconv.Itoa(instance.ServiceKeyCount))
+	}
+	err := table.Print()
+	if err != nil {
+		return err
+	}
+
+	if remaining := report.ServiceInstanceCount - len(report.ServiceInstances); remaining > 0 {
+		ui.Say(T("... and {{.Count}} more service instances", map[string]interface{}{"Count": remaining}))
+	}
+	return nil
+}
+
+// PrintJSON writes the report as indented JSON.
+func PrintJSON(ui terminal.UI, report Report) error {
+	jsonBytes, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	ui.Say(string(jsonBytes))
+	return nil
+}
@@ -3,6 +3,7 @@ package actors
 import (
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -12,6 +13,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/appfiles"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/gofileutils/fileutils"
 )
 
@@ -21,25 +23,31 @@ const windowsPathPrefix = `\\?\`
 
 type PushActor interface {
 	UploadApp(appGUID string, zipFile *os.File, presentFiles []resources.AppFileResource) error
+	ResumeUpload(jobURL string) error
 	ProcessPath(dirOrZipFile string, f func(string) error) error
+	ProcessRemotePath(url string, checksum string, f func(string) error) error
 	GatherFiles(localFiles []models.AppFileFields, appDir string, uploadDir string, useCache bool) ([]resources.AppFileResource, bool, error)
 	ValidateAppParams(apps []models.AppParams) []error
 	MapManifestRoute(routeName string, app models.Application, appParamsFromContext models.AppParams) error
 }
 
 type PushActorImpl struct {
+	ui          terminal.UI
 	appBitsRepo applicationbits.Repository
 	appfiles    appfiles.AppFiles
 	zipper      appfiles.Zipper
 	routeActor  RouteActor
+	httpClient  *http.Client
 }
 
-func NewPushActor(appBitsRepo applicationbits.Repository, zipper appfiles.Zipper, appfiles appfiles.AppFiles, routeActor RouteActor) PushActor {
+func NewPushActor(ui terminal.UI, appBitsRepo applicationbits.Repository, zipper appfiles.Zipper, appfiles appfiles.AppFiles, routeActor RouteActor, httpClient *http.Client) PushActor {
 	return PushActorImpl{
+		ui:          ui,
 		appBitsRepo: appBitsRepo,
 		appfiles:    appfiles,
 		zipper:      zipper,
 		routeActor:  routeActor,
+		httpClient:  httpClient,
 	}
 }
 
@@ -179,6 +187,12 @@ func (actor PushActorImpl) UploadApp(appGUID string, zipFile *os.File, presentFi
 	return actor.appBitsRepo.UploadBits(appGUID, zipFile, presentFiles)
 }
 
+// ResumeUpload waits for the job of an upload that was accepted but could not
+// be followed to completion.
+func (actor PushActorImpl) ResumeUpload(jobURL string) error {
+	return actor.appBitsRepo.WaitForUpload(jobURL)
+}
+
 func (actor PushActorImpl) ValidateAppParams(apps []models.AppParams) []error {
 	errs := []error{}
 
@@ -1,12 +1,17 @@
 package actors_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/actors/actorsfakes"
@@ -15,12 +20,15 @@ import (
 	"code.cloudfoundry.org/cli/cf/appfiles"
 	"code.cloudfoundry.org/cli/cf/appfiles/appfilesfakes"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/terminal/terminalfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
 )
 
 var _ = Describe("Push Actor", func() {
 	var (
+		ui           *terminalfakes.FakeUI
 		appBitsRepo  *applicationbitsfakes.FakeApplicationBitsRepository
 		appFiles     *appfilesfakes.FakeAppFiles
 		fakezipper   *appfilesfakes.FakeZipper
@@ -33,11 +41,12 @@ var _ = Describe("Push Actor", func() {
 	)
 
 	BeforeEach(func() {
+		ui = new(terminalfakes.FakeUI)
 		appBitsRepo = new(applicationbitsfakes.FakeApplicationBitsRepository)
 		appFiles = new(appfilesfakes.FakeAppFiles)
 		fakezipper = new(appfilesfakes.FakeZipper)
 		routeActor = new(actorsfakes.FakeRouteActor)
-		actor = actors.NewPushActor(appBitsRepo, fakezipper, appFiles, routeActor)
+		actor = actors.NewPushActor(ui, appBitsRepo, fakezipper, appFiles, routeActor, http.DefaultClient)
 		fixturesDir = filepath.Join("..", "..", "fixtures", "applications")
 		allFiles = []models.AppFileFields{
 			{Path: "example-app/.cfignore"},
@@ -283,7 +292,7 @@ var _ = Describe("Push Actor", func() {
 
 		BeforeEach(func() {
 			zipper := &appfiles.ApplicationZipper{}
-			actor = actors.NewPushActor(appBitsRepo, zipper, appFiles, routeActor)
+			actor = actors.NewPushActor(ui, appBitsRepo, zipper, appFiles, routeActor, http.DefaultClient)
 		})
 
 		Context("when given a zip file", func() {
@@ -334,7 +343,7 @@ var _ = Describe("Push Actor", func() {
 				e := errors.New("some-error")
 				fakezipper.UnzipReturns(e)
 				fakezipper.IsZipFileReturns(true)
-				actor = actors.NewPushActor(appBitsRepo, fakezipper, appFiles, routeActor)
+				actor = actors.NewPushActor(ui, appBitsRepo, fakezipper, appFiles, routeActor, http.DefaultClient)
 
 				f := func(_ string) error {
 					return nil
@@ -556,4 +565,200 @@ var _ = Describe("Push Actor", func() {
 			Expect(actualAppParams).To(Equal(appParamsFromContext))
 		})
 	})
+	Describe("ProcessRemotePath", func() {
+		var (
+			server      *ghttp.Server
+			zipContents []byte
+			checksum    string
+			calledWith  string
+			processErr  error
+		)
+
+		BeforeEach(func() {
+			actor = actors.NewPushActor(ui, appBitsRepo, &appfiles.ApplicationZipper{}, appFiles, routeActor, http.DefaultClient)
+
+			var err error
+			zipContents, err = ioutil.ReadFile(filepath.Join(fixturesDir, "example-app.zip"))
+			Expect(err).NotTo(HaveOccurred())
+			sum := sha256.Sum256(zipContents)
+			checksum = hex.EncodeToString(sum[:])
+
+			server = ghttp.NewServer()
+			calledWith = ""
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		processRemotePath := func(url string, checksum string) {
+			processErr = actor.ProcessRemotePath(url, checksum, func(appDir string) error {
+				calledWith = appDir
+				for _, file := range allFiles {
+					_, err := os.Stat(filepath.Join(appDir, file.Path))
+					Expect(err).NotTo(HaveOccurred())
+				}
+				return nil
+			})
+		}
+
+		Context("when given a URL of a zip file", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/app.zip"),
+					ghttp.RespondWith(http.StatusOK, zipContents),
+				))
+			})
+
+			It("downloads and extracts the archive and cleans it up afterwards", func() {
+				processRemotePath(server.URL()+"/app.zip", "")
+				Expect(processErr).NotTo(HaveOccurred())
+				Expect(calledWith).NotTo(BeEmpty())
+
+				_, err := os.Stat(calledWith)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+
+			It("accepts a matching checksum", func() {
+				processRemotePath(server.URL()+"/app.zip", checksum)
+				Expect(processErr).NotTo(HaveOccurred())
+				Expect(calledWith).NotTo(BeEmpty())
+			})
+
+			It("fails without calling the callback when the checksum does not match", func() {
+				processRemotePath(server.URL()+"/app.zip", "bad-checksum")
+				Expect(processErr).To(MatchError(fmt.Sprintf("SHA-256 checksum mismatch for %s/app.zip: expected bad-checksum, got %s", server.URL(), checksum)))
+				Expect(calledWith).To(BeEmpty())
+			})
+		})
+
+		Context("when the download fails", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(ghttp.RespondWith(http.StatusNotFound, ""))
+			})
+
+			It("returns an error", func() {
+				processRemotePath(server.URL()+"/app.zip", "")
+				Expect(processErr).To(MatchError(fmt.Sprintf("Error downloading %s/app.zip: 404 Not Found", server.URL())))
+				Expect(calledWith).To(BeEmpty())
+			})
+		})
+
+		Context("when the CLI is interrupted during the download", func() {
+			var (
+				originalNotifyInterrupt func(chan<- os.Signal, ...os.Signal)
+				interrupt               chan<- os.Signal
+			)
+
+			BeforeEach(func() {
+				originalNotifyInterrupt = actors.NotifyInterrupt
+				actors.NotifyInterrupt = func(c chan<- os.Signal, _ ...os.Signal) {
+					interrupt = c
+				}
+
+				server.AppendHandlers(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+					w.(http.Flusher).Flush()
+					interrupt <- os.Interrupt
+					select {
+					case <-r.Context().Done():
+					case <-time.After(5 * time.Second):
+					}
+				})
+			})
+
+			AfterEach(func() {
+				actors.NotifyInterrupt = originalNotifyInterrupt
+			})
+
+			It("cancels the download and returns an error without calling the callback", func() {
+				processRemotePath(server.URL()+"/app.zip", "")
+				Expect(processErr).To(MatchError(fmt.Sprintf("Interrupted while fetching %s/app.zip", server.URL())))
+				Expect(calledWith).To(BeEmpty())
+			})
+		})
+
+		Context("when the URL does not point to a zip file", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(ghttp.RespondWith(http.StatusOK, "not a zip"))
+			})
+
+			It("returns an error", func() {
+				processRemotePath(server.URL()+"/app.zip", "")
+				Expect(processErr).To(MatchError(fmt.Sprintf("%s/app.zip is not a zip archive", server.URL())))
+			})
+		})
+
+		Context("when given a git URL", func() {
+			It("fails when a checksum is given", func() {
+				processRemotePath("git+https://example.com/app.git", checksum)
+				Expect(processErr).To(MatchError("--sha256 cannot be used with a git URL"))
+			})
+
+			Context("when git is not installed", func() {
+				var originalGitCommand string
+
+				BeforeEach(func() {
+					originalGitCommand = actors.GitCommand
+					actors.GitCommand = "some-missing-git-binary"
+				})
+
+				AfterEach(func() {
+					actors.GitCommand = originalGitCommand
+				})
+
+				It("returns an error saying git is required", func() {
+					processRemotePath("git+https://example.com/app.git", "")
+					Expect(processErr).To(MatchError("Pushing from a git URL requires git to be installed and on your PATH"))
+				})
+			})
+
+			Context("when git is installed", func() {
+				var repoDir string
+
+				BeforeEach(func() {
+					if _, err := exec.LookPath("git"); err != nil {
+						Skip("git is not installed")
+					}
+
+					var err error
+					repoDir, err = ioutil.TempDir("", "git-app")
+					Expect(err).NotTo(HaveOccurred())
+
+					err = exec.Command("cp", "-R", filepath.Join(fixturesDir, "example-app"), repoDir).Run()
+					Expect(err).NotTo(HaveOccurred())
+
+					for _, args := range [][]string{
+						{"init", "--quiet"},
+						{"add", "--all", "--force"},
+						{"-c", "user.name=some-user", "-c", "user.email=some-user@example.com", "commit", "--quiet", "-m", "some-message"},
+						{"tag", "v1.0"},
+					} {
+						command := exec.Command("git", args...)
+						command.Dir = repoDir
+						Expect(command.Run()).To(Succeed())
+					}
+				})
+
+				AfterEach(func() {
+					os.RemoveAll(repoDir)
+				})
+
+				It("clones the ref into a temporary directory and cleans it up afterwards", func() {
+					processRemotePath("git+file://"+repoDir+"#v1.0", "")
+					Expect(processErr).NotTo(HaveOccurred())
+					Expect(calledWith).NotTo(BeEmpty())
+
+					_, err := os.Stat(calledWith)
+					Expect(os.IsNotExist(err)).To(BeTrue())
+				})
+
+				It("returns an error when the ref does not exist", func() {
+					processRemotePath("git+file://"+repoDir+"#some-missing-ref", "")
+					Expect(processErr).To(HaveOccurred())
+					Expect(processErr.Error()).To(HavePrefix("Error cloning file://" + repoDir))
+				})
+			})
+		})
+	})
 })
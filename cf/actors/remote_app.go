@@ -0,0 +1,209 @@
+package actors
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/formatters"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+const gitURLPrefix = "git+"
+
+// GitCommand is the git binary used to clone apps pushed from a git URL.
+var GitCommand = "git"
+
+// RemoteAppDownloadTimeout is how long downloading a remote app archive may
+// take before it is abandoned.
+const RemoteAppDownloadTimeout = 15 * time.Minute
+
+// NotifyInterrupt relays the signals that cancel downloading or cloning a
+// remote app. It is a variable so that tests can replace it.
+var NotifyInterrupt = signal.Notify
+
+var downloadProgressInterval = 5 * time.Second
+
+// IsRemoteAppPath returns true when path is an http(s) URL of an app
+// archive or a git+ URL of a repository rather than a local path.
+func IsRemoteAppPath(path string) bool {
+	return strings.HasPrefix(path, "http://") ||
+		strings.HasPrefix(path, "https://") ||
+		strings.HasPrefix(path, gitURLPrefix)
+}
+
+// ProcessRemotePath downloads the zip archive at url, or shallow clones the
+// repository at a git+ url, into a temporary location and calls the provided
+// callback with the directory of the app files. When checksum is set, the
+// archive's SHA-256 must match it.
+//
+// The temporary location is removed after the callback has been executed or
+// when any step fails. Interrupting the CLI while the app is being downloaded
+// or cloned cancels it and returns an error, so that the caller's cleanup
+// still runs.
+func (actor PushActorImpl) ProcessRemotePath(url string, checksum string, f func(string) error) error {
+	tempDir, err := ioutil.TempDir("", "remote-app")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempDir)
+
+	appDir := filepath.Join(tempDir, "app")
+	if strings.HasPrefix(url, gitURLPrefix) && checksum != "" {
+		return errors.New(T("--sha256 cannot be used with a git URL"))
+	}
+
+	ctx, stop := cancelOnInterrupt()
+	if strings.HasPrefix(url, gitURLPrefix) {
+		err = cloneGitRepo(ctx, url, appDir)
+	} else {
+		err = actor.downloadAppArchive(ctx, url, checksum, tempDir, appDir)
+	}
+	interrupted := ctx.Err() != nil
+	stop()
+
+	if interrupted {
+		return errors.New(T("Interrupted while fetching {{.URL}}", map[string]interface{}{"URL": url}))
+	}
+	if err != nil {
+		return err
+	}
+
+	return f(appDir)
+}
+
+func (actor PushActorImpl) downloadAppArchive(ctx context.Context, url string, checksum string, tempDir string, appDir string) error {
+	actor.ui.Say(T("Downloading app archive from {{.URL}}...", map[string]interface{}{"URL": terminal.EntityNameColor(url)}))
+
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := actor.httpClient.Do(request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return errors.New(T("Error downloading {{.URL}}: {{.Status}}", map[string]interface{}{
+			"URL":    url,
+			"Status": response.Status,
+		}))
+	}
+
+	archivePath := filepath.Join(tempDir, "app.zip")
+	archive, err := os.Create(archivePath)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	hash := sha256.New()
+	progress := &downloadProgress{ui: actor.ui}
+	_, err = io.Copy(io.MultiWriter(archive, hash, progress), response.Body)
+	if err != nil {
+		return err
+	}
+	progress.done()
+
+	if checksum != "" {
+		actual := hex.EncodeToString(hash.Sum(nil))
+		if !strings.EqualFold(actual, checksum) {
+			return errors.New(T("SHA-256 checksum mismatch for {{.URL}}: expected {{.Expected}}, got {{.Actual}}", map[string]interface{}{
+				"URL":      url,
+				"Expected": checksum,
+				"Actual":   actual,
+			}))
+		}
+	}
+
+	if !actor.zipper.IsZipFile(archivePath) {
+		return errors.New(T("{{.URL}} is not a zip archive", map[string]interface{}{"URL": url}))
+	}
+
+	return actor.zipper.Unzip(archivePath, appDir)
+}
+
+// cloneGitRepo shallow clones a git+ URL into dir. A ref following '#' in
+// the URL names the branch or tag to clone.
+func cloneGitRepo(ctx context.Context, url string, dir string) error {
+	gitPath, err := exec.LookPath(GitCommand)
+	if err != nil {
+		return errors.New(T("Pushing from a git URL requires git to be installed and on your PATH"))
+	}
+
+	repoURL := strings.TrimPrefix(url, gitURLPrefix)
+	args := []string{"clone", "--quiet", "--depth", "1"}
+	if i := strings.Index(repoURL, "#"); i != -1 {
+		args = append(args, "--branch", repoURL[i+1:])
+		repoURL = repoURL[:i]
+	}
+	args = append(args, repoURL, dir)
+
+	output, err := exec.CommandContext(ctx, gitPath, args...).CombinedOutput()
+	if err != nil {
+		return errors.New(T("Error cloning {{.URL}}: {{.Error}}\n{{.Output}}", map[string]interface{}{
+			"URL":    repoURL,
+			"Error":  err.Error(),
+			"Output": strings.TrimSpace(string(output)),
+		}))
+	}
+
+	return os.RemoveAll(filepath.Join(dir, ".git"))
+}
+
+// cancelOnInterrupt returns a context that is cancelled when the CLI receives
+// SIGHUP, SIGINT, SIGQUIT or SIGTERM before the returned function is called.
+func cancelOnInterrupt() (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sig := make(chan os.Signal, 10)
+	NotifyInterrupt(sig, syscall.SIGHUP, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGTERM, os.Interrupt)
+
+	go func() {
+		select {
+		case <-sig:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(sig)
+		cancel()
+	}
+}
+
+type downloadProgress struct {
+	ui        terminal.UI
+	written   int64
+	lastShown time.Time
+}
+
+func (progress *downloadProgress) Write(p []byte) (int, error) {
+	progress.written += int64(len(p))
+	if time.Since(progress.lastShown) >= downloadProgressInterval {
+		progress.ui.PrintCapturingNoOutput("\r%s downloaded...", formatters.ByteSize(progress.written))
+		progress.lastShown = time.Now()
+	}
+	return len(p), nil
+}
+
+func (progress *downloadProgress) done() {
+	//The spaces are there to ensure we overwrite the entire line
+	progress.ui.PrintCapturingNoOutput("\r                             ")
+	progress.ui.Say(T("\rDone downloading {{.Size}}", map[string]interface{}{"Size": formatters.ByteSize(progress.written)}))
+}
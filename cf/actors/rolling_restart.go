@@ -0,0 +1,111 @@
+package actors
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/appinstances"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+// RollingRestarter recreates an app's instances one at a time, waiting for
+// each replacement to be running before moving on, so the app keeps serving
+// traffic while its instances pick up changes that only apply at startup.
+type RollingRestarter struct {
+	ui               terminal.UI
+	appInstancesRepo appinstances.Repository
+
+	StartupTimeout time.Duration
+	PingerThrottle time.Duration
+}
+
+func NewRollingRestarter(ui terminal.UI, appInstancesRepo appinstances.Repository, startupTimeout time.Duration, pingerThrottle time.Duration) *RollingRestarter {
+	return &RollingRestarter{
+		ui:               ui,
+		appInstancesRepo: appInstancesRepo,
+		StartupTimeout:   startupTimeout,
+		PingerThrottle:   pingerThrottle,
+	}
+}
+
+// HasRunningInstances reports whether any instance of the app is running.
+func (restarter *RollingRestarter) HasRunningInstances(app models.Application) (bool, error) {
+	if app.State != models.ApplicationStateStarted {
+		return false, nil
+	}
+
+	instances, err := restarter.appInstancesRepo.GetInstances(app.GUID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, instance := range instances {
+		if instance.State == models.InstanceRunning {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Restart recreates every instance of a started app. Stopped apps have no
+// instances to restart.
+func (restarter *RollingRestarter) Restart(app models.Application) error {
+	if app.State != models.ApplicationStateStarted {
+		return nil
+	}
+
+	instances, err := restarter.appInstancesRepo.GetInstances(app.GUID)
+	if err != nil {
+		return err
+	}
+
+	for index := range instances {
+		restarter.ui.Say(T("Restarting instance {{.Index}} of {{.Total}}...", map[string]interface{}{
+			"Index": index + 1,
+			"Total": len(instances),
+		}))
+
+		restartedAt := time.Now().Truncate(time.Second)
+		err = restarter.appInstancesRepo.DeleteInstance(app.GUID, index)
+		if err != nil {
+			return err
+		}
+
+		err = restarter.waitForReplacement(app, index, restartedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	restarter.ui.Ok()
+	return nil
+}
+
+func (restarter *RollingRestarter) waitForReplacement(app models.Application, index int, restartedAt time.Time) error {
+	startTime := time.Now()
+	for {
+		instances, err := restarter.appInstancesRepo.GetInstances(app.GUID)
+		if err != nil {
+			return err
+		}
+
+		if index < len(instances) {
+			instance := instances[index]
+			if instance.State == models.InstanceRunning && !instance.Since.Before(restartedAt) {
+				return nil
+			}
+		}
+
+		if time.Since(startTime) >= restarter.StartupTimeout {
+			return fmt.Errorf(T("Instance {{.Index}} of {{.AppName}} did not start within {{.Timeout}}", map[string]interface{}{
+				"Index":   index,
+				"AppName": app.Name,
+				"Timeout": restarter.StartupTimeout,
+			}))
+		}
+
+		time.Sleep(restarter.PingerThrottle)
+	}
+}
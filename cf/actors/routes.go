@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -30,13 +31,15 @@ type RouteActor interface {
 
 type routeActor struct {
 	ui         terminal.UI
+	config     coreconfig.Reader
 	routeRepo  api.RouteRepository
 	domainRepo api.DomainRepository
 }
 
-func NewRouteActor(ui terminal.UI, routeRepo api.RouteRepository, domainRepo api.DomainRepository) routeActor {
+func NewRouteActor(ui terminal.UI, config coreconfig.Reader, routeRepo api.RouteRepository, domainRepo api.DomainRepository) routeActor {
 	return routeActor{
 		ui:         ui,
+		config:     config,
 		routeRepo:  routeRepo,
 		domainRepo: domainRepo,
 	}
@@ -67,6 +70,10 @@ func (routeActor routeActor) FindOrCreateRoute(hostname string, domain models.Do
 
 	switch err.(type) {
 	case nil:
+		if route.Space.GUID != "" && route.Space.GUID != routeActor.config.SpaceFields().GUID {
+			return models.Route{}, routeInOtherSpaceError(route.URL(), route.Space.Name)
+		}
+
 		routeActor.ui.Say(
 			T("Using route {{.RouteURL}}",
 				map[string]interface{}{
@@ -77,6 +84,20 @@ func (routeActor routeActor) FindOrCreateRoute(hostname string, domain models.Do
 		if useRandomPort && domain.RouterGroupType == tcp {
 			route, err = routeActor.CreateRandomTCPRoute(domain)
 		} else {
+			// Routes in spaces the user cannot read are not returned by Find,
+			// so check whether the route is reserved before trying to create
+			// it. Reservations are only looked up by host and path.
+			if port == 0 {
+				var exists bool
+				exists, err = routeActor.routeRepo.CheckIfExists(hostname, domain, path)
+				if err != nil {
+					return models.Route{}, err
+				}
+				if exists {
+					return models.Route{}, routeInOtherSpaceError(domain.URLForHostAndPath(hostname, path, port), "")
+				}
+			}
+
 			routeActor.ui.Say(
 				T("Creating route {{.Hostname}}...",
 					map[string]interface{}{
@@ -94,6 +115,19 @@ func (routeActor routeActor) FindOrCreateRoute(hostname string, domain models.Do
 	return route, err
 }
 
+// routeInOtherSpaceError is returned before the app bits are uploaded when
+// the route an app would be bound to belongs to another space. spaceName is
+// empty when the user cannot read the space that owns the route.
+func routeInOtherSpaceError(url string, spaceName string) error {
+	tip := T("TIP: Change the hostname with --hostname HOSTNAME or use --random-route to generate a new route and then push again.")
+	if spaceName == "" {
+		return errors.New(T("Route {{.URL}} is already in use in another space.",
+			map[string]interface{}{"URL": url}) + "\n" + tip)
+	}
+	return errors.New(T("Route {{.URL}} is already in use in another space ({{.SpaceName}}).",
+		map[string]interface{}{"URL": url, "SpaceName": spaceName}) + "\n" + tip)
+}
+
 func (routeActor routeActor) BindRoute(app models.Application, route models.Route) error {
 	if !app.HasRoute(route) {
 		routeActor.ui.Say(T(
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/errors/errorsfakes"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/terminal/terminalfakes"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -28,7 +29,7 @@ var _ = Describe("Routes", func() {
 		fakeUI = &terminalfakes.FakeUI{}
 		fakeRouteRepository = new(apifakes.FakeRouteRepository)
 		fakeDomainRepository = new(apifakes.FakeDomainRepository)
-		routeActor = NewRouteActor(fakeUI, fakeRouteRepository, fakeDomainRepository)
+		routeActor = NewRouteActor(fakeUI, testconfig.NewRepositoryWithDefaults(), fakeRouteRepository, fakeDomainRepository)
 	})
 
 	Describe("CreateRandomTCPRoute", func() {
@@ -117,6 +118,32 @@ var _ = Describe("Routes", func() {
 				output, _ := fakeUI.SayArgsForCall(0)
 				Expect(output).To(MatchRegexp("Using route.*hostname.foo.com/path"))
 			})
+
+			Context("when the route belongs to another space", func() {
+				BeforeEach(func() {
+					expectedRoute.Space = models.SpaceFields{GUID: "other-space-guid", Name: "other-space"}
+					fakeRouteRepository.FindReturns(expectedRoute, nil)
+				})
+
+				It("returns an error naming the space", func() {
+					_, err := routeActor.FindOrCreateRoute(expectedHostname, expectedDomain, expectedPath, 0, false)
+					Expect(err).To(MatchError("Route hostname.foo.com/path is already in use in another space (other-space).\nTIP: Change the hostname with --hostname HOSTNAME or use --random-route to generate a new route and then push again."))
+					Expect(fakeUI.SayCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the route belongs to the targeted space", func() {
+				BeforeEach(func() {
+					expectedRoute.Space = testconfig.NewRepositoryWithDefaults().SpaceFields()
+					fakeRouteRepository.FindReturns(expectedRoute, nil)
+				})
+
+				It("uses the route", func() {
+					route, err := routeActor.FindOrCreateRoute(expectedHostname, expectedDomain, expectedPath, 0, false)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(route).To(Equal(expectedRoute))
+				})
+			})
 		})
 
 		Context("the route does not exist", func() {
@@ -175,6 +202,36 @@ var _ = Describe("Routes", func() {
 				})
 			})
 
+			Context("when the route is reserved in a space the user cannot read", func() {
+				BeforeEach(func() {
+					fakeRouteRepository.CheckIfExistsReturns(true, nil)
+				})
+
+				It("returns an error without creating the route", func() {
+					_, err := routeActor.FindOrCreateRoute(expectedHostname, expectedDomain, expectedPath, 0, false)
+					Expect(err).To(MatchError("Route hostname.foo.com/path is already in use in another space.\nTIP: Change the hostname with --hostname HOSTNAME or use --random-route to generate a new route and then push again."))
+
+					Expect(fakeRouteRepository.CheckIfExistsCallCount()).To(Equal(1))
+					hostname, domain, path := fakeRouteRepository.CheckIfExistsArgsForCall(0)
+					Expect(hostname).To(Equal(expectedHostname))
+					Expect(domain).To(Equal(expectedDomain))
+					Expect(path).To(Equal(expectedPath))
+					Expect(fakeRouteRepository.CreateCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when checking whether the route is reserved fails", func() {
+				BeforeEach(func() {
+					fakeRouteRepository.CheckIfExistsReturns(false, errors.New("check-error"))
+				})
+
+				It("returns the error", func() {
+					_, err := routeActor.FindOrCreateRoute(expectedHostname, expectedDomain, expectedPath, 0, false)
+					Expect(err).To(MatchError("check-error"))
+					Expect(fakeRouteRepository.CreateCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("with a path", func() {
 				BeforeEach(func() {
 					fakeRouteRepository.CreateReturns(expectedRoute, nil)
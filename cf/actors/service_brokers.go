@@ -0,0 +1,80 @@
+package actors
+
+import (
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+)
+
+// ServiceBrokerNameResolver finds the brokers offering the plans of service
+// instances. Offerings and brokers are each listed once for all instances
+// rather than looked up per instance.
+type ServiceBrokerNameResolver struct {
+	config      coreconfig.Reader
+	serviceRepo api.ServiceRepository
+	brokerRepo  api.ServiceBrokerRepository
+}
+
+func NewServiceBrokerNameResolver(config coreconfig.Reader, serviceRepo api.ServiceRepository, brokerRepo api.ServiceBrokerRepository) *ServiceBrokerNameResolver {
+	return &ServiceBrokerNameResolver{
+		config:      config,
+		serviceRepo: serviceRepo,
+		brokerRepo:  brokerRepo,
+	}
+}
+
+// BrokerNames returns the broker name of each managed instance keyed by the
+// GUID of its service offering. Offerings that are no longer available in the
+// targeted space are looked up individually. Brokers the user cannot see are
+// left out.
+func (resolver *ServiceBrokerNameResolver) BrokerNames(instances []models.ServiceInstance) (map[string]string, error) {
+	offeringBrokers := map[string]string{}
+	for _, instance := range instances {
+		if !instance.IsUserProvided() && instance.ServiceOffering.GUID != "" {
+			offeringBrokers[instance.ServiceOffering.GUID] = ""
+		}
+	}
+
+	brokerNames := map[string]string{}
+	if len(offeringBrokers) == 0 {
+		return brokerNames, nil
+	}
+
+	offerings, err := resolver.serviceRepo.GetServiceOfferingsForSpace(resolver.config.SpaceFields().GUID)
+	if err != nil {
+		return nil, err
+	}
+	for _, offering := range offerings {
+		if _, ok := offeringBrokers[offering.GUID]; ok {
+			offeringBrokers[offering.GUID] = offering.BrokerGUID
+		}
+	}
+
+	for offeringGUID, brokerGUID := range offeringBrokers {
+		if brokerGUID != "" {
+			continue
+		}
+		offering, err := resolver.serviceRepo.GetServiceOfferingByGUID(offeringGUID)
+		if err != nil {
+			return nil, err
+		}
+		offeringBrokers[offeringGUID] = offering.BrokerGUID
+	}
+
+	brokers := map[string]string{}
+	err = resolver.brokerRepo.ListServiceBrokers(func(broker models.ServiceBroker) bool {
+		brokers[broker.GUID] = broker.Name
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for offeringGUID, brokerGUID := range offeringBrokers {
+		if name, ok := brokers[brokerGUID]; ok {
+			brokerNames[offeringGUID] = name
+		}
+	}
+
+	return brokerNames, nil
+}
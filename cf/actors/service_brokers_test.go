@@ -0,0 +1,128 @@
+package actors_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/cf/actors"
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/models"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServiceBrokerNameResolver", func() {
+	var (
+		serviceRepo *apifakes.FakeServiceRepository
+		brokerRepo  *apifakes.FakeServiceBrokerRepository
+		resolver    *ServiceBrokerNameResolver
+
+		instances   []models.ServiceInstance
+		brokerNames map[string]string
+		err         error
+	)
+
+	managedInstance := func(name string, offeringGUID string) models.ServiceInstance {
+		instance := models.ServiceInstance{}
+		instance.Name = name
+		instance.ServicePlan = models.ServicePlanFields{GUID: name + "-plan-guid"}
+		instance.ServiceOffering = models.ServiceOfferingFields{GUID: offeringGUID}
+		return instance
+	}
+
+	BeforeEach(func() {
+		serviceRepo = new(apifakes.FakeServiceRepository)
+		brokerRepo = new(apifakes.FakeServiceBrokerRepository)
+		resolver = NewServiceBrokerNameResolver(testconfig.NewRepositoryWithDefaults(), serviceRepo, brokerRepo)
+
+		instances = []models.ServiceInstance{
+			managedInstance("instance-1", "offering-1-guid"),
+			managedInstance("instance-2", "offering-1-guid"),
+			managedInstance("instance-3", "offering-2-guid"),
+			{ServiceInstanceFields: models.ServiceInstanceFields{Name: "user-provided"}},
+		}
+
+		serviceRepo.GetServiceOfferingsForSpaceReturns(models.ServiceOfferings{
+			{ServiceOfferingFields: models.ServiceOfferingFields{GUID: "offering-1-guid", BrokerGUID: "broker-1-guid"}},
+			{ServiceOfferingFields: models.ServiceOfferingFields{GUID: "offering-2-guid", BrokerGUID: "broker-2-guid"}},
+			{ServiceOfferingFields: models.ServiceOfferingFields{GUID: "unused-offering-guid", BrokerGUID: "broker-3-guid"}},
+		}, nil)
+
+		brokerRepo.ListServiceBrokersStub = func(callback func(models.ServiceBroker) bool) error {
+			callback(models.ServiceBroker{GUID: "broker-1-guid", Name: "broker-1"})
+			callback(models.ServiceBroker{GUID: "broker-2-guid", Name: "broker-2"})
+			return nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		brokerNames, err = resolver.BrokerNames(instances)
+	})
+
+	It("resolves the broker names with one listing of offerings and brokers", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(brokerNames).To(Equal(map[string]string{
+			"offering-1-guid": "broker-1",
+			"offering-2-guid": "broker-2",
+		}))
+
+		Expect(serviceRepo.GetServiceOfferingsForSpaceCallCount()).To(Equal(1))
+		Expect(serviceRepo.GetServiceOfferingsForSpaceArgsForCall(0)).To(Equal("my-space-guid"))
+		Expect(serviceRepo.GetServiceOfferingByGUIDCallCount()).To(Equal(0))
+		Expect(brokerRepo.ListServiceBrokersCallCount()).To(Equal(1))
+	})
+
+	Context("when an offering is no longer available in the space", func() {
+		BeforeEach(func() {
+			instances = append(instances, managedInstance("instance-4", "retired-offering-guid"))
+			serviceRepo.GetServiceOfferingByGUIDReturns(models.ServiceOffering{
+				ServiceOfferingFields: models.ServiceOfferingFields{GUID: "retired-offering-guid", BrokerGUID: "broker-1-guid"},
+			}, nil)
+		})
+
+		It("looks the offering up by GUID", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(brokerNames).To(HaveKeyWithValue("retired-offering-guid", "broker-1"))
+			Expect(serviceRepo.GetServiceOfferingByGUIDCallCount()).To(Equal(1))
+			Expect(serviceRepo.GetServiceOfferingByGUIDArgsForCall(0)).To(Equal("retired-offering-guid"))
+		})
+	})
+
+	Context("when the user cannot see a broker", func() {
+		BeforeEach(func() {
+			brokerRepo.ListServiceBrokersStub = func(callback func(models.ServiceBroker) bool) error {
+				callback(models.ServiceBroker{GUID: "broker-1-guid", Name: "broker-1"})
+				return nil
+			}
+		})
+
+		It("leaves the broker name out", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(brokerNames).To(Equal(map[string]string{"offering-1-guid": "broker-1"}))
+		})
+	})
+
+	Context("when there are only user-provided instances", func() {
+		BeforeEach(func() {
+			instances = instances[3:]
+		})
+
+		It("does not make any requests", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(brokerNames).To(BeEmpty())
+			Expect(serviceRepo.GetServiceOfferingsForSpaceCallCount()).To(Equal(0))
+			Expect(brokerRepo.ListServiceBrokersCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when listing the brokers fails", func() {
+		BeforeEach(func() {
+			brokerRepo.ListServiceBrokersStub = nil
+			brokerRepo.ListServiceBrokersReturns(errors.New("list-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("list-error"))
+		})
+	})
+})
@@ -2,12 +2,15 @@ package servicebuilder
 
 import (
 	"errors"
+	"sync"
 
 	"code.cloudfoundry.org/cli/cf/actors/planbuilder"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/models"
 )
 
+const maxConcurrentPlanRequests = 10
+
 //go:generate counterfeiter . ServiceBuilder
 
 type ServiceBuilder interface {
@@ -53,16 +56,12 @@ func (builder Builder) GetAllServicesWithPlans() ([]models.ServiceOffering, erro
 		return []models.ServiceOffering{}, err
 	}
 
-	var plans []models.ServicePlanFields
-	for index, service := range services {
-		plans, err = builder.planBuilder.GetPlansForService(service.GUID)
-		if err != nil {
-			return []models.ServiceOffering{}, err
-		}
-		services[index].Plans = plans
+	err = builder.attachPlansToServices(services)
+	if err != nil {
+		return []models.ServiceOffering{}, err
 	}
 
-	return services, err
+	return services, nil
 }
 
 func (builder Builder) GetServicesForSpace(spaceGUID string) ([]models.ServiceOffering, error) {
@@ -75,11 +74,9 @@ func (builder Builder) GetServicesForSpaceWithPlans(spaceGUID string) ([]models.
 		return []models.ServiceOffering{}, err
 	}
 
-	for index, service := range services {
-		services[index].Plans, err = builder.planBuilder.GetPlansForService(service.GUID)
-		if err != nil {
-			return []models.ServiceOffering{}, err
-		}
+	err = builder.attachPlansToServices(services)
+	if err != nil {
+		return []models.ServiceOffering{}, err
 	}
 
 	return services, nil
@@ -226,6 +223,34 @@ func (builder Builder) GetServicesVisibleToOrg(orgName string) ([]models.Service
 	return builder.attachServicesToPlans(visiblePlans)
 }
 
+// attachPlansToServices fetches the plans of every service concurrently, with
+// at most maxConcurrentPlanRequests requests in flight, so that listing a
+// large marketplace does not wait on one plan request at a time.
+func (builder Builder) attachPlansToServices(services []models.ServiceOffering) error {
+	errs := make([]error, len(services))
+	semaphore := make(chan struct{}, maxConcurrentPlanRequests)
+
+	var wg sync.WaitGroup
+	for index := range services {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			services[index].Plans, errs[index] = builder.planBuilder.GetPlansForService(services[index].GUID)
+		}(index)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (builder Builder) attachPlansToServiceForOrg(service models.ServiceOffering, orgName string) (models.ServiceOffering, error) {
 	plans, err := builder.planBuilder.GetPlansForServiceForOrg(service.GUID, orgName)
 	if err != nil {
@@ -149,6 +149,42 @@ var _ = Describe("Service Builder", func() {
 			Expect(len(services)).To(Equal(2))
 			Expect(services[0].Plans[0]).To(Equal(plan1))
 		})
+
+		Context("when there are many services", func() {
+			BeforeEach(func() {
+				serviceRepo.GetAllServiceOfferingsReturns([]models.ServiceOffering{service1, service2}, nil)
+				planBuilder.GetPlansForServiceStub = func(serviceGUID string) ([]models.ServicePlanFields, error) {
+					switch serviceGUID {
+					case "service-guid1":
+						return []models.ServicePlanFields{plan1, plan2}, nil
+					default:
+						return []models.ServicePlanFields{plan3}, nil
+					}
+				}
+			})
+
+			It("attaches the plans to the service they belong to", func() {
+				services, err := serviceBuilder.GetAllServicesWithPlans()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(planBuilder.GetPlansForServiceCallCount()).To(Equal(2))
+				Expect(services[0].GUID).To(Equal("service-guid1"))
+				Expect(services[0].Plans).To(Equal([]models.ServicePlanFields{plan1, plan2}))
+				Expect(services[1].GUID).To(Equal("service-guid2"))
+				Expect(services[1].Plans).To(Equal([]models.ServicePlanFields{plan3}))
+			})
+		})
+
+		Context("when fetching plans fails", func() {
+			BeforeEach(func() {
+				planBuilder.GetPlansForServiceReturns(nil, errors.New("plans-error"))
+			})
+
+			It("returns the error", func() {
+				_, err := serviceBuilder.GetAllServicesWithPlans()
+				Expect(err).To(MatchError("plans-error"))
+			})
+		})
 	})
 
 	Describe(".GetServiceByNameWithPlans", func() {
@@ -0,0 +1,53 @@
+package actors
+
+import (
+	"code.cloudfoundry.org/cli/cf/api/spacequotas"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+)
+
+// SpaceQuotaResolver looks up space quotas by name in the targeted org.
+// Space quotas belong to a single org, so a quota of another org can never
+// be assigned to or read for the targeted org's spaces.
+type SpaceQuotaResolver struct {
+	config    coreconfig.Reader
+	quotaRepo spacequotas.SpaceQuotaRepository
+}
+
+func NewSpaceQuotaResolver(config coreconfig.Reader, quotaRepo spacequotas.SpaceQuotaRepository) *SpaceQuotaResolver {
+	return &SpaceQuotaResolver{
+		config:    config,
+		quotaRepo: quotaRepo,
+	}
+}
+
+// FindByName returns the space quota of the targeted org with the given
+// name. When the org has no such quota but another org the user can see
+// does, the error says so instead of only reporting the quota as missing.
+func (resolver *SpaceQuotaResolver) FindByName(name string) (models.SpaceQuota, error) {
+	org := resolver.config.OrganizationFields()
+
+	quota, err := resolver.quotaRepo.FindByNameAndOrgGUID(name, org.GUID)
+	if _, ok := err.(*errors.ModelNotFoundError); !ok {
+		return quota, err
+	}
+
+	quotas, listErr := resolver.quotaRepo.FindAllByName(name)
+	if listErr != nil {
+		return models.SpaceQuota{}, err
+	}
+
+	for _, otherQuota := range quotas {
+		if otherQuota.OrgGUID != org.GUID {
+			return models.SpaceQuota{}, errors.New(T("Space quota {{.QuotaName}} belongs to another org. Only space quotas of org {{.OrgName}} can be used.",
+				map[string]interface{}{
+					"QuotaName": name,
+					"OrgName":   org.Name,
+				}))
+		}
+	}
+
+	return models.SpaceQuota{}, err
+}
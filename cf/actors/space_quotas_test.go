@@ -0,0 +1,94 @@
+package actors_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/cf/actors"
+	"code.cloudfoundry.org/cli/cf/api/spacequotas/spacequotasfakes"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/models"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SpaceQuotaResolver", func() {
+	var (
+		fakeQuotaRepository *spacequotasfakes.FakeSpaceQuotaRepository
+		resolver            *SpaceQuotaResolver
+
+		quota models.SpaceQuota
+		err   error
+	)
+
+	BeforeEach(func() {
+		fakeQuotaRepository = new(spacequotasfakes.FakeSpaceQuotaRepository)
+		resolver = NewSpaceQuotaResolver(testconfig.NewRepositoryWithDefaults(), fakeQuotaRepository)
+	})
+
+	JustBeforeEach(func() {
+		quota, err = resolver.FindByName("my-quota")
+	})
+
+	Context("when the targeted org has the quota", func() {
+		BeforeEach(func() {
+			fakeQuotaRepository.FindByNameAndOrgGUIDReturns(models.SpaceQuota{GUID: "my-quota-guid", Name: "my-quota"}, nil)
+		})
+
+		It("returns the quota of the targeted org", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(quota.GUID).To(Equal("my-quota-guid"))
+
+			name, orgGUID := fakeQuotaRepository.FindByNameAndOrgGUIDArgsForCall(0)
+			Expect(name).To(Equal("my-quota"))
+			Expect(orgGUID).To(Equal("my-org-guid"))
+			Expect(fakeQuotaRepository.FindAllByNameCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when looking up the quota fails", func() {
+		BeforeEach(func() {
+			fakeQuotaRepository.FindByNameAndOrgGUIDReturns(models.SpaceQuota{}, errors.New("find-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("find-error"))
+		})
+	})
+
+	Context("when the targeted org does not have the quota", func() {
+		var notFoundErr error
+
+		BeforeEach(func() {
+			notFoundErr = cferrors.NewModelNotFoundError("Space Quota", "my-quota")
+			fakeQuotaRepository.FindByNameAndOrgGUIDReturns(models.SpaceQuota{}, notFoundErr)
+		})
+
+		Context("and another org has a quota with the name", func() {
+			BeforeEach(func() {
+				fakeQuotaRepository.FindAllByNameReturns([]models.SpaceQuota{{GUID: "their-quota-guid", Name: "my-quota", OrgGUID: "their-org-guid"}}, nil)
+			})
+
+			It("returns an error saying the quota belongs to another org", func() {
+				Expect(err).To(MatchError("Space quota my-quota belongs to another org. Only space quotas of org my-org can be used."))
+				Expect(fakeQuotaRepository.FindAllByNameArgsForCall(0)).To(Equal("my-quota"))
+			})
+		})
+
+		Context("and no org has a quota with the name", func() {
+			It("returns the not found error", func() {
+				Expect(err).To(Equal(notFoundErr))
+			})
+		})
+
+		Context("and listing the quotas of other orgs fails", func() {
+			BeforeEach(func() {
+				fakeQuotaRepository.FindAllByNameReturns(nil, errors.New("list-error"))
+			})
+
+			It("returns the not found error", func() {
+				Expect(err).To(Equal(notFoundErr))
+			})
+		})
+	})
+})
@@ -26,13 +26,14 @@ type FakeBuildpackRepository struct {
 	listBuildpacksReturns struct {
 		result1 error
 	}
-	CreateStub        func(name string, position *int, enabled *bool, locked *bool) (createdBuildpack models.Buildpack, apiErr error)
+	CreateStub        func(name string, position *int, enabled *bool, locked *bool, stack string) (createdBuildpack models.Buildpack, apiErr error)
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
 		name     string
 		position *int
 		enabled  *bool
 		locked   *bool
+		stack    string
 	}
 	createReturns struct {
 		result1 models.Buildpack
@@ -55,6 +56,16 @@ type FakeBuildpackRepository struct {
 		result1 models.Buildpack
 		result2 error
 	}
+	FindByNameAndStackStub        func(name string, stack string) (models.Buildpack, error)
+	findByNameAndStackMutex       sync.RWMutex
+	findByNameAndStackArgsForCall []struct {
+		name  string
+		stack string
+	}
+	findByNameAndStackReturns struct {
+		result1 models.Buildpack
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -126,18 +137,19 @@ func (fake *FakeBuildpackRepository) ListBuildpacksReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeBuildpackRepository) Create(name string, position *int, enabled *bool, locked *bool) (createdBuildpack models.Buildpack, apiErr error) {
+func (fake *FakeBuildpackRepository) Create(name string, position *int, enabled *bool, locked *bool, stack string) (createdBuildpack models.Buildpack, apiErr error) {
 	fake.createMutex.Lock()
 	fake.createArgsForCall = append(fake.createArgsForCall, struct {
 		name     string
 		position *int
 		enabled  *bool
 		locked   *bool
-	}{name, position, enabled, locked})
-	fake.recordInvocation("Create", []interface{}{name, position, enabled, locked})
+		stack    string
+	}{name, position, enabled, locked, stack})
+	fake.recordInvocation("Create", []interface{}{name, position, enabled, locked, stack})
 	fake.createMutex.Unlock()
 	if fake.CreateStub != nil {
-		return fake.CreateStub(name, position, enabled, locked)
+		return fake.CreateStub(name, position, enabled, locked, stack)
 	} else {
 		return fake.createReturns.result1, fake.createReturns.result2
 	}
@@ -149,10 +161,10 @@ func (fake *FakeBuildpackRepository) CreateCallCount() int {
 	return len(fake.createArgsForCall)
 }
 
-func (fake *FakeBuildpackRepository) CreateArgsForCall(i int) (string, *int, *bool, *bool) {
+func (fake *FakeBuildpackRepository) CreateArgsForCall(i int) (string, *int, *bool, *bool, string) {
 	fake.createMutex.RLock()
 	defer fake.createMutex.RUnlock()
-	return fake.createArgsForCall[i].name, fake.createArgsForCall[i].position, fake.createArgsForCall[i].enabled, fake.createArgsForCall[i].locked
+	return fake.createArgsForCall[i].name, fake.createArgsForCall[i].position, fake.createArgsForCall[i].enabled, fake.createArgsForCall[i].locked, fake.createArgsForCall[i].stack
 }
 
 func (fake *FakeBuildpackRepository) CreateReturns(result1 models.Buildpack, result2 error) {
@@ -230,6 +242,41 @@ func (fake *FakeBuildpackRepository) UpdateReturns(result1 models.Buildpack, res
 	}{result1, result2}
 }
 
+func (fake *FakeBuildpackRepository) FindByNameAndStack(name string, stack string) (models.Buildpack, error) {
+	fake.findByNameAndStackMutex.Lock()
+	fake.findByNameAndStackArgsForCall = append(fake.findByNameAndStackArgsForCall, struct {
+		name  string
+		stack string
+	}{name, stack})
+	fake.recordInvocation("FindByNameAndStack", []interface{}{name, stack})
+	fake.findByNameAndStackMutex.Unlock()
+	if fake.FindByNameAndStackStub != nil {
+		return fake.FindByNameAndStackStub(name, stack)
+	} else {
+		return fake.findByNameAndStackReturns.result1, fake.findByNameAndStackReturns.result2
+	}
+}
+
+func (fake *FakeBuildpackRepository) FindByNameAndStackCallCount() int {
+	fake.findByNameAndStackMutex.RLock()
+	defer fake.findByNameAndStackMutex.RUnlock()
+	return len(fake.findByNameAndStackArgsForCall)
+}
+
+func (fake *FakeBuildpackRepository) FindByNameAndStackArgsForCall(i int) (string, string) {
+	fake.findByNameAndStackMutex.RLock()
+	defer fake.findByNameAndStackMutex.RUnlock()
+	return fake.findByNameAndStackArgsForCall[i].name, fake.findByNameAndStackArgsForCall[i].stack
+}
+
+func (fake *FakeBuildpackRepository) FindByNameAndStackReturns(result1 models.Buildpack, result2 error) {
+	fake.FindByNameAndStackStub = nil
+	fake.findByNameAndStackReturns = struct {
+		result1 models.Buildpack
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildpackRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -243,6 +290,8 @@ func (fake *FakeBuildpackRepository) Invocations() map[string][][]interface{} {
 	defer fake.deleteMutex.RUnlock()
 	fake.updateMutex.RLock()
 	defer fake.updateMutex.RUnlock()
+	fake.findByNameAndStackMutex.RLock()
+	defer fake.findByNameAndStackMutex.RUnlock()
 	return fake.invocations
 }
 
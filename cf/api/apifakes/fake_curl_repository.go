@@ -2,6 +2,7 @@
 package apifakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/cf/api"
@@ -21,6 +22,19 @@ type FakeCurlRepository struct {
 		result2 string
 		result3 error
 	}
+	RequestStreamStub        func(method string, path string, header string, body io.Reader) (string, io.ReadCloser, error)
+	requestStreamMutex       sync.RWMutex
+	requestStreamArgsForCall []struct {
+		method string
+		path   string
+		header string
+		body   io.Reader
+	}
+	requestStreamReturns struct {
+		result1 string
+		result2 io.ReadCloser
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -63,11 +77,51 @@ func (fake *FakeCurlRepository) RequestReturns(result1 string, result2 string, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCurlRepository) RequestStream(method string, path string, header string, body io.Reader) (string, io.ReadCloser, error) {
+	fake.requestStreamMutex.Lock()
+	fake.requestStreamArgsForCall = append(fake.requestStreamArgsForCall, struct {
+		method string
+		path   string
+		header string
+		body   io.Reader
+	}{method, path, header, body})
+	fake.recordInvocation("RequestStream", []interface{}{method, path, header, body})
+	fake.requestStreamMutex.Unlock()
+	if fake.RequestStreamStub != nil {
+		return fake.RequestStreamStub(method, path, header, body)
+	} else {
+		return fake.requestStreamReturns.result1, fake.requestStreamReturns.result2, fake.requestStreamReturns.result3
+	}
+}
+
+func (fake *FakeCurlRepository) RequestStreamCallCount() int {
+	fake.requestStreamMutex.RLock()
+	defer fake.requestStreamMutex.RUnlock()
+	return len(fake.requestStreamArgsForCall)
+}
+
+func (fake *FakeCurlRepository) RequestStreamArgsForCall(i int) (string, string, string, io.Reader) {
+	fake.requestStreamMutex.RLock()
+	defer fake.requestStreamMutex.RUnlock()
+	return fake.requestStreamArgsForCall[i].method, fake.requestStreamArgsForCall[i].path, fake.requestStreamArgsForCall[i].header, fake.requestStreamArgsForCall[i].body
+}
+
+func (fake *FakeCurlRepository) RequestStreamReturns(result1 string, result2 io.ReadCloser, result3 error) {
+	fake.RequestStreamStub = nil
+	fake.requestStreamReturns = struct {
+		result1 string
+		result2 io.ReadCloser
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCurlRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.requestMutex.RLock()
 	defer fake.requestMutex.RUnlock()
+	fake.requestStreamMutex.RLock()
+	defer fake.requestStreamMutex.RUnlock()
 	return fake.invocations
 }
 
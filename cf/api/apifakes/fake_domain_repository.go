@@ -56,11 +56,12 @@ type FakeDomainRepository struct {
 		result1 models.DomainFields
 		result2 error
 	}
-	CreateSharedDomainStub        func(domainName string, routerGroupGUID string) (apiErr error)
+	CreateSharedDomainStub        func(domainName string, routerGroupGUID string, internal bool) (apiErr error)
 	createSharedDomainMutex       sync.RWMutex
 	createSharedDomainArgsForCall []struct {
 		domainName      string
 		routerGroupGUID string
+		internal        bool
 	}
 	createSharedDomainReturns struct {
 		result1 error
@@ -91,6 +92,15 @@ type FakeDomainRepository struct {
 		result1 models.DomainFields
 		result2 error
 	}
+	ListSharedOrganizationsStub        func(domainGUID string, cb func(models.OrganizationFields) bool) error
+	listSharedOrganizationsMutex       sync.RWMutex
+	listSharedOrganizationsArgsForCall []struct {
+		domainGUID string
+		cb         func(models.OrganizationFields) bool
+	}
+	listSharedOrganizationsReturns struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -267,16 +277,17 @@ func (fake *FakeDomainRepository) CreateReturns(result1 models.DomainFields, res
 	}{result1, result2}
 }
 
-func (fake *FakeDomainRepository) CreateSharedDomain(domainName string, routerGroupGUID string) (apiErr error) {
+func (fake *FakeDomainRepository) CreateSharedDomain(domainName string, routerGroupGUID string, internal bool) (apiErr error) {
 	fake.createSharedDomainMutex.Lock()
 	fake.createSharedDomainArgsForCall = append(fake.createSharedDomainArgsForCall, struct {
 		domainName      string
 		routerGroupGUID string
-	}{domainName, routerGroupGUID})
-	fake.recordInvocation("CreateSharedDomain", []interface{}{domainName, routerGroupGUID})
+		internal        bool
+	}{domainName, routerGroupGUID, internal})
+	fake.recordInvocation("CreateSharedDomain", []interface{}{domainName, routerGroupGUID, internal})
 	fake.createSharedDomainMutex.Unlock()
 	if fake.CreateSharedDomainStub != nil {
-		return fake.CreateSharedDomainStub(domainName, routerGroupGUID)
+		return fake.CreateSharedDomainStub(domainName, routerGroupGUID, internal)
 	} else {
 		return fake.createSharedDomainReturns.result1
 	}
@@ -288,10 +299,10 @@ func (fake *FakeDomainRepository) CreateSharedDomainCallCount() int {
 	return len(fake.createSharedDomainArgsForCall)
 }
 
-func (fake *FakeDomainRepository) CreateSharedDomainArgsForCall(i int) (string, string) {
+func (fake *FakeDomainRepository) CreateSharedDomainArgsForCall(i int) (string, string, bool) {
 	fake.createSharedDomainMutex.RLock()
 	defer fake.createSharedDomainMutex.RUnlock()
-	return fake.createSharedDomainArgsForCall[i].domainName, fake.createSharedDomainArgsForCall[i].routerGroupGUID
+	return fake.createSharedDomainArgsForCall[i].domainName, fake.createSharedDomainArgsForCall[i].routerGroupGUID, fake.createSharedDomainArgsForCall[i].internal
 }
 
 func (fake *FakeDomainRepository) CreateSharedDomainReturns(result1 error) {
@@ -402,6 +413,40 @@ func (fake *FakeDomainRepository) FirstOrDefaultReturns(result1 models.DomainFie
 	}{result1, result2}
 }
 
+func (fake *FakeDomainRepository) ListSharedOrganizations(domainGUID string, cb func(models.OrganizationFields) bool) error {
+	fake.listSharedOrganizationsMutex.Lock()
+	fake.listSharedOrganizationsArgsForCall = append(fake.listSharedOrganizationsArgsForCall, struct {
+		domainGUID string
+		cb         func(models.OrganizationFields) bool
+	}{domainGUID, cb})
+	fake.recordInvocation("ListSharedOrganizations", []interface{}{domainGUID, cb})
+	fake.listSharedOrganizationsMutex.Unlock()
+	if fake.ListSharedOrganizationsStub != nil {
+		return fake.ListSharedOrganizationsStub(domainGUID, cb)
+	} else {
+		return fake.listSharedOrganizationsReturns.result1
+	}
+}
+
+func (fake *FakeDomainRepository) ListSharedOrganizationsCallCount() int {
+	fake.listSharedOrganizationsMutex.RLock()
+	defer fake.listSharedOrganizationsMutex.RUnlock()
+	return len(fake.listSharedOrganizationsArgsForCall)
+}
+
+func (fake *FakeDomainRepository) ListSharedOrganizationsArgsForCall(i int) (string, func(models.OrganizationFields) bool) {
+	fake.listSharedOrganizationsMutex.RLock()
+	defer fake.listSharedOrganizationsMutex.RUnlock()
+	return fake.listSharedOrganizationsArgsForCall[i].domainGUID, fake.listSharedOrganizationsArgsForCall[i].cb
+}
+
+func (fake *FakeDomainRepository) ListSharedOrganizationsReturns(result1 error) {
+	fake.ListSharedOrganizationsStub = nil
+	fake.listSharedOrganizationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDomainRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -423,6 +468,8 @@ func (fake *FakeDomainRepository) Invocations() map[string][][]interface{} {
 	defer fake.deleteSharedDomainMutex.RUnlock()
 	fake.firstOrDefaultMutex.RLock()
 	defer fake.firstOrDefaultMutex.RUnlock()
+	fake.listSharedOrganizationsMutex.RLock()
+	defer fake.listSharedOrganizationsMutex.RUnlock()
 	return fake.invocations
 }
 
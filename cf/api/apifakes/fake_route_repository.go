@@ -101,6 +101,33 @@ type FakeRouteRepository struct {
 	deleteReturns struct {
 		result1 error
 	}
+	ListDestinationsStub        func(routeGUID string) ([]models.RouteDestination, error)
+	listDestinationsMutex       sync.RWMutex
+	listDestinationsArgsForCall []struct {
+		routeGUID string
+	}
+	listDestinationsReturns struct {
+		result1 []models.RouteDestination
+		result2 error
+	}
+	AddDestinationStub        func(routeGUID string, destination models.RouteDestination) error
+	addDestinationMutex       sync.RWMutex
+	addDestinationArgsForCall []struct {
+		routeGUID   string
+		destination models.RouteDestination
+	}
+	addDestinationReturns struct {
+		result1 error
+	}
+	ReplaceDestinationsStub        func(routeGUID string, destinations []models.RouteDestination) error
+	replaceDestinationsMutex       sync.RWMutex
+	replaceDestinationsArgsForCall []struct {
+		routeGUID    string
+		destinations []models.RouteDestination
+	}
+	replaceDestinationsReturns struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -422,6 +449,113 @@ func (fake *FakeRouteRepository) DeleteReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeRouteRepository) ListDestinations(routeGUID string) ([]models.RouteDestination, error) {
+	fake.listDestinationsMutex.Lock()
+	fake.listDestinationsArgsForCall = append(fake.listDestinationsArgsForCall, struct {
+		routeGUID string
+	}{routeGUID})
+	fake.recordInvocation("ListDestinations", []interface{}{routeGUID})
+	fake.listDestinationsMutex.Unlock()
+	if fake.ListDestinationsStub != nil {
+		return fake.ListDestinationsStub(routeGUID)
+	} else {
+		return fake.listDestinationsReturns.result1, fake.listDestinationsReturns.result2
+	}
+}
+
+func (fake *FakeRouteRepository) ListDestinationsCallCount() int {
+	fake.listDestinationsMutex.RLock()
+	defer fake.listDestinationsMutex.RUnlock()
+	return len(fake.listDestinationsArgsForCall)
+}
+
+func (fake *FakeRouteRepository) ListDestinationsArgsForCall(i int) string {
+	fake.listDestinationsMutex.RLock()
+	defer fake.listDestinationsMutex.RUnlock()
+	return fake.listDestinationsArgsForCall[i].routeGUID
+}
+
+func (fake *FakeRouteRepository) ListDestinationsReturns(result1 []models.RouteDestination, result2 error) {
+	fake.ListDestinationsStub = nil
+	fake.listDestinationsReturns = struct {
+		result1 []models.RouteDestination
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRouteRepository) AddDestination(routeGUID string, destination models.RouteDestination) error {
+	fake.addDestinationMutex.Lock()
+	fake.addDestinationArgsForCall = append(fake.addDestinationArgsForCall, struct {
+		routeGUID   string
+		destination models.RouteDestination
+	}{routeGUID, destination})
+	fake.recordInvocation("AddDestination", []interface{}{routeGUID, destination})
+	fake.addDestinationMutex.Unlock()
+	if fake.AddDestinationStub != nil {
+		return fake.AddDestinationStub(routeGUID, destination)
+	} else {
+		return fake.addDestinationReturns.result1
+	}
+}
+
+func (fake *FakeRouteRepository) AddDestinationCallCount() int {
+	fake.addDestinationMutex.RLock()
+	defer fake.addDestinationMutex.RUnlock()
+	return len(fake.addDestinationArgsForCall)
+}
+
+func (fake *FakeRouteRepository) AddDestinationArgsForCall(i int) (string, models.RouteDestination) {
+	fake.addDestinationMutex.RLock()
+	defer fake.addDestinationMutex.RUnlock()
+	return fake.addDestinationArgsForCall[i].routeGUID, fake.addDestinationArgsForCall[i].destination
+}
+
+func (fake *FakeRouteRepository) AddDestinationReturns(result1 error) {
+	fake.AddDestinationStub = nil
+	fake.addDestinationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRouteRepository) ReplaceDestinations(routeGUID string, destinations []models.RouteDestination) error {
+	var destinationsCopy []models.RouteDestination
+	if destinations != nil {
+		destinationsCopy = make([]models.RouteDestination, len(destinations))
+		copy(destinationsCopy, destinations)
+	}
+	fake.replaceDestinationsMutex.Lock()
+	fake.replaceDestinationsArgsForCall = append(fake.replaceDestinationsArgsForCall, struct {
+		routeGUID    string
+		destinations []models.RouteDestination
+	}{routeGUID, destinationsCopy})
+	fake.recordInvocation("ReplaceDestinations", []interface{}{routeGUID, destinationsCopy})
+	fake.replaceDestinationsMutex.Unlock()
+	if fake.ReplaceDestinationsStub != nil {
+		return fake.ReplaceDestinationsStub(routeGUID, destinations)
+	} else {
+		return fake.replaceDestinationsReturns.result1
+	}
+}
+
+func (fake *FakeRouteRepository) ReplaceDestinationsCallCount() int {
+	fake.replaceDestinationsMutex.RLock()
+	defer fake.replaceDestinationsMutex.RUnlock()
+	return len(fake.replaceDestinationsArgsForCall)
+}
+
+func (fake *FakeRouteRepository) ReplaceDestinationsArgsForCall(i int) (string, []models.RouteDestination) {
+	fake.replaceDestinationsMutex.RLock()
+	defer fake.replaceDestinationsMutex.RUnlock()
+	return fake.replaceDestinationsArgsForCall[i].routeGUID, fake.replaceDestinationsArgsForCall[i].destinations
+}
+
+func (fake *FakeRouteRepository) ReplaceDestinationsReturns(result1 error) {
+	fake.ReplaceDestinationsStub = nil
+	fake.replaceDestinationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRouteRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -443,6 +577,12 @@ func (fake *FakeRouteRepository) Invocations() map[string][][]interface{} {
 	defer fake.unbindMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.listDestinationsMutex.RLock()
+	defer fake.listDestinationsMutex.RUnlock()
+	fake.addDestinationMutex.RLock()
+	defer fake.addDestinationMutex.RUnlock()
+	fake.replaceDestinationsMutex.RLock()
+	defer fake.replaceDestinationsMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/models"
 )
 
 type FakeRouteServiceBindingRepository struct {
@@ -19,16 +20,26 @@ type FakeRouteServiceBindingRepository struct {
 	bindReturns struct {
 		result1 error
 	}
-	UnbindStub        func(instanceGUID, routeGUID string, userProvided bool) error
+	UnbindStub        func(instanceGUID string, routeGUID string, userProvided bool, parameters string) error
 	unbindMutex       sync.RWMutex
 	unbindArgsForCall []struct {
 		instanceGUID string
 		routeGUID    string
 		userProvided bool
+		parameters   string
 	}
 	unbindReturns struct {
 		result1 error
 	}
+	ListForRoutesStub        func(routeGUIDs []string) ([]models.RouteServiceBinding, error)
+	listForRoutesMutex       sync.RWMutex
+	listForRoutesArgsForCall []struct {
+		routeGUIDs []string
+	}
+	listForRoutesReturns struct {
+		result1 []models.RouteServiceBinding
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -69,17 +80,18 @@ func (fake *FakeRouteServiceBindingRepository) BindReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeRouteServiceBindingRepository) Unbind(instanceGUID string, routeGUID string, userProvided bool) error {
+func (fake *FakeRouteServiceBindingRepository) Unbind(instanceGUID string, routeGUID string, userProvided bool, parameters string) error {
 	fake.unbindMutex.Lock()
 	fake.unbindArgsForCall = append(fake.unbindArgsForCall, struct {
 		instanceGUID string
 		routeGUID    string
 		userProvided bool
-	}{instanceGUID, routeGUID, userProvided})
-	fake.recordInvocation("Unbind", []interface{}{instanceGUID, routeGUID, userProvided})
+		parameters   string
+	}{instanceGUID, routeGUID, userProvided, parameters})
+	fake.recordInvocation("Unbind", []interface{}{instanceGUID, routeGUID, userProvided, parameters})
 	fake.unbindMutex.Unlock()
 	if fake.UnbindStub != nil {
-		return fake.UnbindStub(instanceGUID, routeGUID, userProvided)
+		return fake.UnbindStub(instanceGUID, routeGUID, userProvided, parameters)
 	} else {
 		return fake.unbindReturns.result1
 	}
@@ -91,10 +103,10 @@ func (fake *FakeRouteServiceBindingRepository) UnbindCallCount() int {
 	return len(fake.unbindArgsForCall)
 }
 
-func (fake *FakeRouteServiceBindingRepository) UnbindArgsForCall(i int) (string, string, bool) {
+func (fake *FakeRouteServiceBindingRepository) UnbindArgsForCall(i int) (string, string, bool, string) {
 	fake.unbindMutex.RLock()
 	defer fake.unbindMutex.RUnlock()
-	return fake.unbindArgsForCall[i].instanceGUID, fake.unbindArgsForCall[i].routeGUID, fake.unbindArgsForCall[i].userProvided
+	return fake.unbindArgsForCall[i].instanceGUID, fake.unbindArgsForCall[i].routeGUID, fake.unbindArgsForCall[i].userProvided, fake.unbindArgsForCall[i].parameters
 }
 
 func (fake *FakeRouteServiceBindingRepository) UnbindReturns(result1 error) {
@@ -104,6 +116,45 @@ func (fake *FakeRouteServiceBindingRepository) UnbindReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeRouteServiceBindingRepository) ListForRoutes(routeGUIDs []string) ([]models.RouteServiceBinding, error) {
+	var routeGUIDsCopy []string
+	if routeGUIDs != nil {
+		routeGUIDsCopy = make([]string, len(routeGUIDs))
+		copy(routeGUIDsCopy, routeGUIDs)
+	}
+	fake.listForRoutesMutex.Lock()
+	fake.listForRoutesArgsForCall = append(fake.listForRoutesArgsForCall, struct {
+		routeGUIDs []string
+	}{routeGUIDsCopy})
+	fake.recordInvocation("ListForRoutes", []interface{}{routeGUIDsCopy})
+	fake.listForRoutesMutex.Unlock()
+	if fake.ListForRoutesStub != nil {
+		return fake.ListForRoutesStub(routeGUIDs)
+	} else {
+		return fake.listForRoutesReturns.result1, fake.listForRoutesReturns.result2
+	}
+}
+
+func (fake *FakeRouteServiceBindingRepository) ListForRoutesCallCount() int {
+	fake.listForRoutesMutex.RLock()
+	defer fake.listForRoutesMutex.RUnlock()
+	return len(fake.listForRoutesArgsForCall)
+}
+
+func (fake *FakeRouteServiceBindingRepository) ListForRoutesArgsForCall(i int) []string {
+	fake.listForRoutesMutex.RLock()
+	defer fake.listForRoutesMutex.RUnlock()
+	return fake.listForRoutesArgsForCall[i].routeGUIDs
+}
+
+func (fake *FakeRouteServiceBindingRepository) ListForRoutesReturns(result1 []models.RouteServiceBinding, result2 error) {
+	fake.ListForRoutesStub = nil
+	fake.listForRoutesReturns = struct {
+		result1 []models.RouteServiceBinding
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRouteServiceBindingRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -111,6 +162,8 @@ func (fake *FakeRouteServiceBindingRepository) Invocations() map[string][][]inte
 	defer fake.bindMutex.RUnlock()
 	fake.unbindMutex.RLock()
 	defer fake.unbindMutex.RUnlock()
+	fake.listForRoutesMutex.RLock()
+	defer fake.listForRoutesMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -174,6 +174,60 @@ type FakeServiceRepository struct {
 		result1 int
 		result2 error
 	}
+	GetServiceInstanceCountForOrgStub        func(orgGUID string) (int, error)
+	getServiceInstanceCountForOrgMutex       sync.RWMutex
+	getServiceInstanceCountForOrgArgsForCall []struct {
+		orgGUID string
+	}
+	getServiceInstanceCountForOrgReturns struct {
+		result1 int
+		result2 error
+	}
+	GetServiceInstanceCountForSpaceStub        func(spaceGUID string) (int, error)
+	getServiceInstanceCountForSpaceMutex       sync.RWMutex
+	getServiceInstanceCountForSpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getServiceInstanceCountForSpaceReturns struct {
+		result1 int
+		result2 error
+	}
+	GetServiceInstanceSharedFromStub        func(instanceGUID string) (models.ServiceInstanceSharedFrom, error)
+	getServiceInstanceSharedFromMutex       sync.RWMutex
+	getServiceInstanceSharedFromArgsForCall []struct {
+		instanceGUID string
+	}
+	getServiceInstanceSharedFromReturns struct {
+		result1 models.ServiceInstanceSharedFrom
+		result2 error
+	}
+	GetServiceInstanceSharedTosStub        func(instanceGUID string) ([]models.ServiceInstanceSharedTo, error)
+	getServiceInstanceSharedTosMutex       sync.RWMutex
+	getServiceInstanceSharedTosArgsForCall []struct {
+		instanceGUID string
+	}
+	getServiceInstanceSharedTosReturns struct {
+		result1 []models.ServiceInstanceSharedTo
+		result2 error
+	}
+	UpgradeServiceInstanceStub        func(instanceGUID string, maintenanceVersion string) error
+	upgradeServiceInstanceMutex       sync.RWMutex
+	upgradeServiceInstanceArgsForCall []struct {
+		instanceGUID       string
+		maintenanceVersion string
+	}
+	upgradeServiceInstanceReturns struct {
+		result1 error
+	}
+	ListServiceInstancesForServicePlanStub        func(planGUID string) ([]models.ServiceInstance, error)
+	listServiceInstancesForServicePlanMutex       sync.RWMutex
+	listServiceInstancesForServicePlanArgsForCall []struct {
+		planGUID string
+	}
+	listServiceInstancesForServicePlanReturns struct {
+		result1 []models.ServiceInstance
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -801,6 +855,210 @@ func (fake *FakeServiceRepository) MigrateServicePlanFromV1ToV2Returns(result1 i
 	}{result1, result2}
 }
 
+func (fake *FakeServiceRepository) GetServiceInstanceCountForOrg(orgGUID string) (int, error) {
+	fake.getServiceInstanceCountForOrgMutex.Lock()
+	fake.getServiceInstanceCountForOrgArgsForCall = append(fake.getServiceInstanceCountForOrgArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetServiceInstanceCountForOrg", []interface{}{orgGUID})
+	fake.getServiceInstanceCountForOrgMutex.Unlock()
+	if fake.GetServiceInstanceCountForOrgStub != nil {
+		return fake.GetServiceInstanceCountForOrgStub(orgGUID)
+	} else {
+		return fake.getServiceInstanceCountForOrgReturns.result1, fake.getServiceInstanceCountForOrgReturns.result2
+	}
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceCountForOrgCallCount() int {
+	fake.getServiceInstanceCountForOrgMutex.RLock()
+	defer fake.getServiceInstanceCountForOrgMutex.RUnlock()
+	return len(fake.getServiceInstanceCountForOrgArgsForCall)
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceCountForOrgArgsForCall(i int) string {
+	fake.getServiceInstanceCountForOrgMutex.RLock()
+	defer fake.getServiceInstanceCountForOrgMutex.RUnlock()
+	return fake.getServiceInstanceCountForOrgArgsForCall[i].orgGUID
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceCountForOrgReturns(result1 int, result2 error) {
+	fake.GetServiceInstanceCountForOrgStub = nil
+	fake.getServiceInstanceCountForOrgReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceCountForSpace(spaceGUID string) (int, error) {
+	fake.getServiceInstanceCountForSpaceMutex.Lock()
+	fake.getServiceInstanceCountForSpaceArgsForCall = append(fake.getServiceInstanceCountForSpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetServiceInstanceCountForSpace", []interface{}{spaceGUID})
+	fake.getServiceInstanceCountForSpaceMutex.Unlock()
+	if fake.GetServiceInstanceCountForSpaceStub != nil {
+		return fake.GetServiceInstanceCountForSpaceStub(spaceGUID)
+	} else {
+		return fake.getServiceInstanceCountForSpaceReturns.result1, fake.getServiceInstanceCountForSpaceReturns.result2
+	}
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceCountForSpaceCallCount() int {
+	fake.getServiceInstanceCountForSpaceMutex.RLock()
+	defer fake.getServiceInstanceCountForSpaceMutex.RUnlock()
+	return len(fake.getServiceInstanceCountForSpaceArgsForCall)
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceCountForSpaceArgsForCall(i int) string {
+	fake.getServiceInstanceCountForSpaceMutex.RLock()
+	defer fake.getServiceInstanceCountForSpaceMutex.RUnlock()
+	return fake.getServiceInstanceCountForSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceCountForSpaceReturns(result1 int, result2 error) {
+	fake.GetServiceInstanceCountForSpaceStub = nil
+	fake.getServiceInstanceCountForSpaceReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceSharedFrom(instanceGUID string) (models.ServiceInstanceSharedFrom, error) {
+	fake.getServiceInstanceSharedFromMutex.Lock()
+	fake.getServiceInstanceSharedFromArgsForCall = append(fake.getServiceInstanceSharedFromArgsForCall, struct {
+		instanceGUID string
+	}{instanceGUID})
+	fake.recordInvocation("GetServiceInstanceSharedFrom", []interface{}{instanceGUID})
+	fake.getServiceInstanceSharedFromMutex.Unlock()
+	if fake.GetServiceInstanceSharedFromStub != nil {
+		return fake.GetServiceInstanceSharedFromStub(instanceGUID)
+	} else {
+		return fake.getServiceInstanceSharedFromReturns.result1, fake.getServiceInstanceSharedFromReturns.result2
+	}
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceSharedFromCallCount() int {
+	fake.getServiceInstanceSharedFromMutex.RLock()
+	defer fake.getServiceInstanceSharedFromMutex.RUnlock()
+	return len(fake.getServiceInstanceSharedFromArgsForCall)
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceSharedFromArgsForCall(i int) string {
+	fake.getServiceInstanceSharedFromMutex.RLock()
+	defer fake.getServiceInstanceSharedFromMutex.RUnlock()
+	return fake.getServiceInstanceSharedFromArgsForCall[i].instanceGUID
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceSharedFromReturns(result1 models.ServiceInstanceSharedFrom, result2 error) {
+	fake.GetServiceInstanceSharedFromStub = nil
+	fake.getServiceInstanceSharedFromReturns = struct {
+		result1 models.ServiceInstanceSharedFrom
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceSharedTos(instanceGUID string) ([]models.ServiceInstanceSharedTo, error) {
+	fake.getServiceInstanceSharedTosMutex.Lock()
+	fake.getServiceInstanceSharedTosArgsForCall = append(fake.getServiceInstanceSharedTosArgsForCall, struct {
+		instanceGUID string
+	}{instanceGUID})
+	fake.recordInvocation("GetServiceInstanceSharedTos", []interface{}{instanceGUID})
+	fake.getServiceInstanceSharedTosMutex.Unlock()
+	if fake.GetServiceInstanceSharedTosStub != nil {
+		return fake.GetServiceInstanceSharedTosStub(instanceGUID)
+	} else {
+		return fake.getServiceInstanceSharedTosReturns.result1, fake.getServiceInstanceSharedTosReturns.result2
+	}
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceSharedTosCallCount() int {
+	fake.getServiceInstanceSharedTosMutex.RLock()
+	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
+	return len(fake.getServiceInstanceSharedTosArgsForCall)
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceSharedTosArgsForCall(i int) string {
+	fake.getServiceInstanceSharedTosMutex.RLock()
+	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
+	return fake.getServiceInstanceSharedTosArgsForCall[i].instanceGUID
+}
+
+func (fake *FakeServiceRepository) GetServiceInstanceSharedTosReturns(result1 []models.ServiceInstanceSharedTo, result2 error) {
+	fake.GetServiceInstanceSharedTosStub = nil
+	fake.getServiceInstanceSharedTosReturns = struct {
+		result1 []models.ServiceInstanceSharedTo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceRepository) UpgradeServiceInstance(instanceGUID string, maintenanceVersion string) error {
+	fake.upgradeServiceInstanceMutex.Lock()
+	fake.upgradeServiceInstanceArgsForCall = append(fake.upgradeServiceInstanceArgsForCall, struct {
+		instanceGUID       string
+		maintenanceVersion string
+	}{instanceGUID, maintenanceVersion})
+	fake.recordInvocation("UpgradeServiceInstance", []interface{}{instanceGUID, maintenanceVersion})
+	fake.upgradeServiceInstanceMutex.Unlock()
+	if fake.UpgradeServiceInstanceStub != nil {
+		return fake.UpgradeServiceInstanceStub(instanceGUID, maintenanceVersion)
+	} else {
+		return fake.upgradeServiceInstanceReturns.result1
+	}
+}
+
+func (fake *FakeServiceRepository) UpgradeServiceInstanceCallCount() int {
+	fake.upgradeServiceInstanceMutex.RLock()
+	defer fake.upgradeServiceInstanceMutex.RUnlock()
+	return len(fake.upgradeServiceInstanceArgsForCall)
+}
+
+func (fake *FakeServiceRepository) UpgradeServiceInstanceArgsForCall(i int) (string, string) {
+	fake.upgradeServiceInstanceMutex.RLock()
+	defer fake.upgradeServiceInstanceMutex.RUnlock()
+	return fake.upgradeServiceInstanceArgsForCall[i].instanceGUID, fake.upgradeServiceInstanceArgsForCall[i].maintenanceVersion
+}
+
+func (fake *FakeServiceRepository) UpgradeServiceInstanceReturns(result1 error) {
+	fake.UpgradeServiceInstanceStub = nil
+	fake.upgradeServiceInstanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeServiceRepository) ListServiceInstancesForServicePlan(planGUID string) ([]models.ServiceInstance, error) {
+	fake.listServiceInstancesForServicePlanMutex.Lock()
+	fake.listServiceInstancesForServicePlanArgsForCall = append(fake.listServiceInstancesForServicePlanArgsForCall, struct {
+		planGUID string
+	}{planGUID})
+	fake.recordInvocation("ListServiceInstancesForServicePlan", []interface{}{planGUID})
+	fake.listServiceInstancesForServicePlanMutex.Unlock()
+	if fake.ListServiceInstancesForServicePlanStub != nil {
+		return fake.ListServiceInstancesForServicePlanStub(planGUID)
+	} else {
+		return fake.listServiceInstancesForServicePlanReturns.result1, fake.listServiceInstancesForServicePlanReturns.result2
+	}
+}
+
+func (fake *FakeServiceRepository) ListServiceInstancesForServicePlanCallCount() int {
+	fake.listServiceInstancesForServicePlanMutex.RLock()
+	defer fake.listServiceInstancesForServicePlanMutex.RUnlock()
+	return len(fake.listServiceInstancesForServicePlanArgsForCall)
+}
+
+func (fake *FakeServiceRepository) ListServiceInstancesForServicePlanArgsForCall(i int) string {
+	fake.listServiceInstancesForServicePlanMutex.RLock()
+	defer fake.listServiceInstancesForServicePlanMutex.RUnlock()
+	return fake.listServiceInstancesForServicePlanArgsForCall[i].planGUID
+}
+
+func (fake *FakeServiceRepository) ListServiceInstancesForServicePlanReturns(result1 []models.ServiceInstance, result2 error) {
+	fake.ListServiceInstancesForServicePlanStub = nil
+	fake.listServiceInstancesForServicePlanReturns = struct {
+		result1 []models.ServiceInstance
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeServiceRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -840,6 +1098,18 @@ func (fake *FakeServiceRepository) Invocations() map[string][][]interface{} {
 	defer fake.getServiceInstanceCountForServicePlanMutex.RUnlock()
 	fake.migrateServicePlanFromV1ToV2Mutex.RLock()
 	defer fake.migrateServicePlanFromV1ToV2Mutex.RUnlock()
+	fake.getServiceInstanceCountForOrgMutex.RLock()
+	defer fake.getServiceInstanceCountForOrgMutex.RUnlock()
+	fake.getServiceInstanceCountForSpaceMutex.RLock()
+	defer fake.getServiceInstanceCountForSpaceMutex.RUnlock()
+	fake.getServiceInstanceSharedFromMutex.RLock()
+	defer fake.getServiceInstanceSharedFromMutex.RUnlock()
+	fake.getServiceInstanceSharedTosMutex.RLock()
+	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
+	fake.upgradeServiceInstanceMutex.RLock()
+	defer fake.upgradeServiceInstanceMutex.RUnlock()
+	fake.listServiceInstancesForServicePlanMutex.RLock()
+	defer fake.listServiceInstancesForServicePlanMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -13,6 +13,15 @@ type OldFakeBuildpackRepository struct {
 	FindByNameBuildpack   models.Buildpack
 	FindByNameAPIResponse error
 
+	FindByNameAndStackArgs struct {
+		Name  string
+		Stack string
+	}
+	FindByNameAndStackReturns struct {
+		Buildpack models.Buildpack
+		Error     error
+	}
+
 	CreateBuildpackExists bool
 	CreateBuildpack       models.Buildpack
 	CreateAPIResponse     error
@@ -47,12 +56,18 @@ func (repo *OldFakeBuildpackRepository) FindByName(name string) (buildpack model
 	return
 }
 
-func (repo *OldFakeBuildpackRepository) Create(name string, position *int, enabled *bool, locked *bool) (createdBuildpack models.Buildpack, apiErr error) {
+func (repo *OldFakeBuildpackRepository) FindByNameAndStack(name, stack string) (models.Buildpack, error) {
+	repo.FindByNameAndStackArgs.Name = name
+	repo.FindByNameAndStackArgs.Stack = stack
+	return repo.FindByNameAndStackReturns.Buildpack, repo.FindByNameAndStackReturns.Error
+}
+
+func (repo *OldFakeBuildpackRepository) Create(name string, position *int, enabled *bool, locked *bool, stack string) (createdBuildpack models.Buildpack, apiErr error) {
 	if repo.CreateBuildpackExists {
 		return repo.CreateBuildpack, errors.NewHTTPError(400, errors.BuildpackNameTaken, "Buildpack already exists")
 	}
 
-	repo.CreateBuildpack = models.Buildpack{Name: name, Position: position, Enabled: enabled, Locked: locked}
+	repo.CreateBuildpack = models.Buildpack{GUID: name + "-guid", Name: name, Position: position, Enabled: enabled, Locked: locked, Stack: stack}
 	return repo.CreateBuildpack, repo.CreateAPIResponse
 }
 
@@ -1,10 +1,17 @@
 package apifakes
 
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
 type OldFakeCurlRepository struct {
 	Method         string
 	Path           string
 	Header         string
 	Body           string
+	BodySeekable   bool
 	ResponseHeader string
 	ResponseBody   string
 	Error          error
@@ -21,3 +28,20 @@ func (repo *OldFakeCurlRepository) Request(method, path, header, body string) (r
 	apiErr = repo.Error
 	return
 }
+
+func (repo *OldFakeCurlRepository) RequestStream(method, path, header string, body io.Reader) (resHeaders string, resBody io.ReadCloser, apiErr error) {
+	repo.Method = method
+	repo.Path = path
+	repo.Header = header
+	repo.Body = ""
+	_, repo.BodySeekable = body.(io.Seeker)
+	if body != nil {
+		bodyBytes, _ := ioutil.ReadAll(body)
+		repo.Body = string(bodyBytes)
+	}
+
+	if repo.Error != nil {
+		return "", nil, repo.Error
+	}
+	return repo.ResponseHeader, ioutil.NopCloser(strings.NewReader(repo.ResponseBody)), nil
+}
@@ -26,6 +26,7 @@ const (
 type Repository interface {
 	GetApplicationFiles(appFilesRequest []resources.AppFileResource) ([]resources.AppFileResource, error)
 	UploadBits(appGUID string, zipFile *os.File, presentFiles []resources.AppFileResource) (apiErr error)
+	WaitForUpload(jobURL string) error
 }
 
 type CloudControllerApplicationBitsRepository struct {
@@ -83,6 +84,13 @@ func (repo CloudControllerApplicationBitsRepository) UploadBits(appGUID string,
 	return
 }
 
+// WaitForUpload waits for the job started by an earlier upload to finish. It
+// lets an upload whose job could not be polled be resumed without sending the
+// bits again.
+func (repo CloudControllerApplicationBitsRepository) WaitForUpload(jobURL string) error {
+	return repo.gateway.WaitForJob(jobURL, repo.config.AccessToken(), DefaultAppUploadBitsTimeout)
+}
+
 func (repo CloudControllerApplicationBitsRepository) GetApplicationFiles(appFilesToCheck []resources.AppFileResource) ([]resources.AppFileResource, error) {
 	integrityFieldsJSON, err := json.Marshal(mapAppFilesToIntegrityFields(appFilesToCheck))
 	if err != nil {
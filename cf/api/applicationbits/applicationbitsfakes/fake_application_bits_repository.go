@@ -29,6 +29,14 @@ type FakeApplicationBitsRepository struct {
 	uploadBitsReturns struct {
 		result1 error
 	}
+	WaitForUploadStub        func(jobURL string) error
+	waitForUploadMutex       sync.RWMutex
+	waitForUploadArgsForCall []struct {
+		jobURL string
+	}
+	waitForUploadReturns struct {
+		result1 error
+	}
 }
 
 func (fake *FakeApplicationBitsRepository) GetApplicationFiles(appFilesRequest []resources.AppFileResource) ([]resources.AppFileResource, error) {
@@ -108,4 +116,36 @@ func (fake *FakeApplicationBitsRepository) UploadBitsReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeApplicationBitsRepository) WaitForUpload(jobURL string) error {
+	fake.waitForUploadMutex.Lock()
+	fake.waitForUploadArgsForCall = append(fake.waitForUploadArgsForCall, struct {
+		jobURL string
+	}{jobURL})
+	fake.waitForUploadMutex.Unlock()
+	if fake.WaitForUploadStub != nil {
+		return fake.WaitForUploadStub(jobURL)
+	} else {
+		return fake.waitForUploadReturns.result1
+	}
+}
+
+func (fake *FakeApplicationBitsRepository) WaitForUploadCallCount() int {
+	fake.waitForUploadMutex.RLock()
+	defer fake.waitForUploadMutex.RUnlock()
+	return len(fake.waitForUploadArgsForCall)
+}
+
+func (fake *FakeApplicationBitsRepository) WaitForUploadArgsForCall(i int) string {
+	fake.waitForUploadMutex.RLock()
+	defer fake.waitForUploadMutex.RUnlock()
+	return fake.waitForUploadArgsForCall[i].jobURL
+}
+
+func (fake *FakeApplicationBitsRepository) WaitForUploadReturns(result1 error) {
+	fake.WaitForUploadStub = nil
+	fake.waitForUploadReturns = struct {
+		result1 error
+	}{result1}
+}
+
 var _ applicationbits.Repository = new(FakeApplicationBitsRepository)
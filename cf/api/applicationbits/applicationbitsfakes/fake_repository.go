@@ -29,6 +29,14 @@ type FakeRepository struct {
 	uploadBitsReturns struct {
 		result1 error
 	}
+	WaitForUploadStub        func(jobURL string) error
+	waitForUploadMutex       sync.RWMutex
+	waitForUploadArgsForCall []struct {
+		jobURL string
+	}
+	waitForUploadReturns struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -112,6 +120,39 @@ func (fake *FakeRepository) UploadBitsReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeRepository) WaitForUpload(jobURL string) error {
+	fake.waitForUploadMutex.Lock()
+	fake.waitForUploadArgsForCall = append(fake.waitForUploadArgsForCall, struct {
+		jobURL string
+	}{jobURL})
+	fake.recordInvocation("WaitForUpload", []interface{}{jobURL})
+	fake.waitForUploadMutex.Unlock()
+	if fake.WaitForUploadStub != nil {
+		return fake.WaitForUploadStub(jobURL)
+	} else {
+		return fake.waitForUploadReturns.result1
+	}
+}
+
+func (fake *FakeRepository) WaitForUploadCallCount() int {
+	fake.waitForUploadMutex.RLock()
+	defer fake.waitForUploadMutex.RUnlock()
+	return len(fake.waitForUploadArgsForCall)
+}
+
+func (fake *FakeRepository) WaitForUploadArgsForCall(i int) string {
+	fake.waitForUploadMutex.RLock()
+	defer fake.waitForUploadMutex.RUnlock()
+	return fake.waitForUploadArgsForCall[i].jobURL
+}
+
+func (fake *FakeRepository) WaitForUploadReturns(result1 error) {
+	fake.WaitForUploadStub = nil
+	fake.waitForUploadReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -119,6 +160,8 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationFilesMutex.RUnlock()
 	fake.uploadBitsMutex.RLock()
 	defer fake.uploadBitsMutex.RUnlock()
+	fake.waitForUploadMutex.RLock()
+	defer fake.waitForUploadMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -27,6 +28,14 @@ type Repository interface {
 	Delete(appGUID string) (apiErr error)
 	ReadEnv(guid string) (*models.Environment, error)
 	CreateRestageRequest(guid string) (apiErr error)
+	GetProcess(appGUID string, processType string) (models.Process, error)
+	ScaleProcess(appGUID string, processType string, params models.AppParams) (models.Process, error)
+	GetV3App(appGUID string) (models.V3Application, error)
+	ListProcesses(appGUID string) ([]models.Process, error)
+	ListSidecars(appGUID string) ([]models.Sidecar, error)
+	GetCurrentDropletGUID(appGUID string) (string, error)
+	GetDeployedRevision(appGUID string) (models.Revision, error)
+	ListAppsByBuildpack(buildpackName string) ([]models.Application, error)
 }
 
 type CloudControllerRepository struct {
@@ -134,3 +143,173 @@ func (repo CloudControllerRepository) CreateRestageRequest(guid string) error {
 	path := fmt.Sprintf("/v2/apps/%s/restage", guid)
 	return repo.gateway.CreateResource(repo.config.APIEndpoint(), path, strings.NewReader(""), nil)
 }
+
+func (repo CloudControllerRepository) GetProcess(appGUID string, processType string) (models.Process, error) {
+	path := fmt.Sprintf("%s/v3/apps/%s/processes/%s", repo.config.APIEndpoint(), appGUID, processType)
+	resource := new(resources.ProcessResource)
+
+	err := repo.gateway.GetResource(path, resource)
+	if err != nil {
+		return models.Process{}, processError(err, processType)
+	}
+
+	return resource.ToModel(), nil
+}
+
+// ScaleProcess changes the instances, memory and disk of a process in a
+// single request, so either all of them are applied or none are.
+func (repo CloudControllerRepository) ScaleProcess(appGUID string, processType string, params models.AppParams) (models.Process, error) {
+	data, err := json.Marshal(resources.NewProcessScaleResourceFromAppParams(params))
+	if err != nil {
+		return models.Process{}, fmt.Errorf("%s: %s", T("Failed to marshal JSON"), err.Error())
+	}
+
+	path := fmt.Sprintf("/v3/apps/%s/processes/%s/actions/scale", appGUID, processType)
+	resource := new(resources.ProcessResource)
+	err = repo.gateway.CreateResource(repo.config.APIEndpoint(), path, bytes.NewReader(data), resource)
+	if err != nil {
+		return models.Process{}, processError(err, processType)
+	}
+
+	return resource.ToModel(), nil
+}
+
+func (repo CloudControllerRepository) GetV3App(appGUID string) (models.V3Application, error) {
+	path := fmt.Sprintf("%s/v3/apps/%s", repo.config.APIEndpoint(), appGUID)
+	resource := new(resources.V3ApplicationResource)
+
+	err := repo.gateway.GetResource(path, resource)
+	if err != nil {
+		return models.V3Application{}, err
+	}
+
+	return resource.ToModel(), nil
+}
+
+// ListProcesses returns every process of the app. Apps have a handful of
+// process types, so a single page of the maximum size holds them all.
+func (repo CloudControllerRepository) ListProcesses(appGUID string) ([]models.Process, error) {
+	path := fmt.Sprintf("%s/v3/apps/%s/processes?per_page=5000", repo.config.APIEndpoint(), appGUID)
+	resource := new(resources.ProcessesResource)
+
+	err := repo.gateway.GetResource(path, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	processes := []models.Process{}
+	for _, process := range resource.Resources {
+		processes = append(processes, process.ToModel())
+	}
+	return processes, nil
+}
+
+// ListSidecars returns the sidecars of the app. Cloud Controllers that
+// predate sidecars have no such endpoint, so their apps have none.
+func (repo CloudControllerRepository) ListSidecars(appGUID string) ([]models.Sidecar, error) {
+	path := fmt.Sprintf("%s/v3/apps/%s/sidecars?per_page=5000", repo.config.APIEndpoint(), appGUID)
+	resource := new(resources.SidecarsResource)
+
+	err := repo.gateway.GetResource(path, resource)
+	if isNotFound(err) {
+		return []models.Sidecar{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sidecars := []models.Sidecar{}
+	for _, sidecar := range resource.Resources {
+		sidecars = append(sidecars, sidecar.ToModel())
+	}
+	return sidecars, nil
+}
+
+// GetCurrentDropletGUID returns the empty string when the app has not been
+// staged yet.
+func (repo CloudControllerRepository) GetCurrentDropletGUID(appGUID string) (string, error) {
+	path := fmt.Sprintf("%s/v3/apps/%s/droplets/current", repo.config.APIEndpoint(), appGUID)
+	resource := new(resources.DropletResource)
+
+	err := repo.gateway.GetResource(path, resource)
+	if isNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return resource.GUID, nil
+}
+
+// GetDeployedRevision returns the latest revision the app's running
+// instances were started with, including its environment variables. It
+// returns a revision with an empty GUID when the Cloud Controller does not
+// keep revisions or the app has none deployed.
+func (repo CloudControllerRepository) GetDeployedRevision(appGUID string) (models.Revision, error) {
+	path := fmt.Sprintf("%s/v3/apps/%s/revisions/deployed?per_page=5000", repo.config.APIEndpoint(), appGUID)
+	revisions := new(resources.RevisionsResource)
+
+	err := repo.gateway.GetResource(path, revisions)
+	if isNotFound(err) {
+		return models.Revision{}, nil
+	}
+	if err != nil {
+		return models.Revision{}, err
+	}
+
+	var latest *resources.RevisionResource
+	for i := range revisions.Resources {
+		if latest == nil || revisions.Resources[i].Version > latest.Version {
+			latest = &revisions.Resources[i]
+		}
+	}
+	if latest == nil {
+		return models.Revision{}, nil
+	}
+
+	path = fmt.Sprintf("%s/v3/revisions/%s/environment_variables", repo.config.APIEndpoint(), latest.GUID)
+	environment := new(resources.RevisionEnvironmentResource)
+
+	err = repo.gateway.GetResource(path, environment)
+	if isNotFound(err) {
+		return models.Revision{}, nil
+	}
+	if err != nil {
+		return models.Revision{}, err
+	}
+
+	return models.Revision{
+		GUID:        latest.GUID,
+		Version:     latest.Version,
+		CreatedAt:   latest.CreatedAt,
+		Environment: environment.Var,
+	}, nil
+}
+
+// ListAppsByBuildpack returns the apps, in every space visible to the user,
+// whose buildpack is explicitly set to buildpackName.
+func (repo CloudControllerRepository) ListAppsByBuildpack(buildpackName string) ([]models.Application, error) {
+	apps := []models.Application{}
+	err := repo.gateway.ListPaginatedResources(
+		repo.config.APIEndpoint(),
+		fmt.Sprintf("/v2/apps?q=%s", url.QueryEscape("buildpack:"+buildpackName)),
+		resources.ApplicationResource{},
+		func(resource interface{}) bool {
+			apps = append(apps, resource.(resources.ApplicationResource).ToModel())
+			return true
+		})
+	return apps, err
+}
+
+func isNotFound(err error) bool {
+	httpErr, ok := err.(errors.HTTPError)
+	return ok && httpErr.StatusCode() == http.StatusNotFound
+}
+
+func processError(err error, processType string) error {
+	if isNotFound(err) {
+		return errors.NewModelNotFoundError("Process", processType)
+	}
+	return err
+}
@@ -89,6 +89,43 @@ var _ = Describe("ApplicationsRepository", func() {
 		})
 	})
 
+	Describe(".ListAppsByBuildpack", func() {
+		It("returns the apps whose buildpack is set to the given name", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/apps?q=buildpack%3Amy-buildpack",
+				Response: singleAppResponse,
+			})
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+			apps, err := repo.ListAppsByBuildpack("my-buildpack")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apps).To(HaveLen(1))
+			Expect(apps[0].Name).To(Equal("My App"))
+		})
+
+		It("returns the error when the query is not supported", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/apps?q=buildpack%3Amy-buildpack",
+				Response: testnet.TestResponse{
+					Status: http.StatusBadRequest,
+					Body:   `{"code": 10005, "description": "The query parameter is invalid: buildpack is not a valid query filter", "error_code": "CF-BadQueryParameter"}`,
+				},
+			})
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+			_, err := repo.ListAppsByBuildpack("my-buildpack")
+
+			Expect(handler).To(HaveAllRequestsCalled())
+			httpErr, ok := err.(errors.HTTPError)
+			Expect(ok).To(BeTrue())
+			Expect(httpErr.ErrorCode()).To(Equal(errors.BadQueryParameter))
+		})
+	})
+
 	Describe("Create", func() {
 		var (
 			ccServer  *ghttp.Server
@@ -385,6 +422,270 @@ var _ = Describe("ApplicationsRepository", func() {
 		})
 	})
 
+	Describe("GetProcess", func() {
+		It("returns the process of the given type", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v3/apps/my-app-guid/processes/worker",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   `{"guid":"process-guid","type":"worker","instances":2,"memory_in_mb":512,"disk_in_mb":1024}`,
+				},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+
+			process, err := repo.GetProcess("my-app-guid", "worker")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(process).To(Equal(models.Process{
+				GUID:       "process-guid",
+				Type:       "worker",
+				Instances:  2,
+				MemoryInMB: 512,
+				DiskInMB:   1024,
+			}))
+		})
+
+		It("returns a ModelNotFoundError when the app has no process of that type", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v3/apps/my-app-guid/processes/worker",
+				Response: testnet.TestResponse{Status: http.StatusNotFound, Body: `{"errors":[{"code":10010,"title":"CF-ResourceNotFound"}]}`},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+
+			_, err := repo.GetProcess("my-app-guid", "worker")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(err).To(BeAssignableToTypeOf(&errors.ModelNotFoundError{}))
+		})
+	})
+
+	Describe("GetV3App", func() {
+		It("returns the lifecycle of the app", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v3/apps/my-app-guid",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body: `{
+						"guid": "my-app-guid",
+						"name": "my-app",
+						"state": "STARTED",
+						"lifecycle": {"type": "buildpack", "data": {"buildpacks": ["java_buildpack", "nodejs_buildpack"], "stack": "cflinuxfs2"}}
+					}`,
+				},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+
+			app, err := repo.GetV3App("my-app-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(app).To(Equal(models.V3Application{
+				GUID:          "my-app-guid",
+				Name:          "my-app",
+				State:         "STARTED",
+				LifecycleType: "buildpack",
+				Buildpacks:    []string{"java_buildpack", "nodejs_buildpack"},
+				Stack:         "cflinuxfs2",
+			}))
+		})
+	})
+
+	Describe("ListProcesses", func() {
+		It("returns every process of the app", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v3/apps/my-app-guid/processes?per_page=5000",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body: `{"resources": [
+						{"guid":"web-guid","type":"web","instances":2,"memory_in_mb":512,"disk_in_mb":1024,"health_check":{"type":"http","data":{"endpoint":"/health"}}},
+						{"guid":"worker-guid","type":"worker","instances":1,"memory_in_mb":256,"disk_in_mb":512,"health_check":{"type":"process","data":{}}}
+					]}`,
+				},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+
+			processes, err := repo.ListProcesses("my-app-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(processes).To(Equal([]models.Process{
+				{GUID: "web-guid", Type: "web", Instances: 2, MemoryInMB: 512, DiskInMB: 1024, HealthCheckType: "http", HealthCheckHTTPEndpoint: "/health"},
+				{GUID: "worker-guid", Type: "worker", Instances: 1, MemoryInMB: 256, DiskInMB: 512, HealthCheckType: "process"},
+			}))
+		})
+	})
+
+	Describe("ListSidecars", func() {
+		It("returns the sidecars of the app", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v3/apps/my-app-guid/sidecars?per_page=5000",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   `{"resources": [{"guid":"sidecar-guid","name":"auth","command":"./auth","process_types":["web"],"memory_in_mb":64}]}`,
+				},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+
+			sidecars, err := repo.ListSidecars("my-app-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sidecars).To(Equal([]models.Sidecar{
+				{GUID: "sidecar-guid", Name: "auth", Command: "./auth", ProcessTypes: []string{"web"}, MemoryInMB: 64},
+			}))
+		})
+
+		It("returns no sidecars when the Cloud Controller does not support them", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v3/apps/my-app-guid/sidecars?per_page=5000",
+				Response: testnet.TestResponse{Status: http.StatusNotFound, Body: `{"errors":[{"code":10000,"title":"CF-NotFound"}]}`},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+
+			sidecars, err := repo.ListSidecars("my-app-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sidecars).To(BeEmpty())
+		})
+	})
+
+	Describe("GetCurrentDropletGUID", func() {
+		It("returns the guid of the current droplet", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v3/apps/my-app-guid/droplets/current",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{"guid":"droplet-guid"}`},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+
+			dropletGUID, err := repo.GetCurrentDropletGUID("my-app-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dropletGUID).To(Equal("droplet-guid"))
+		})
+
+		It("returns an empty guid when the app has not been staged", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v3/apps/my-app-guid/droplets/current",
+				Response: testnet.TestResponse{Status: http.StatusNotFound, Body: `{"errors":[{"code":10010,"title":"CF-ResourceNotFound"}]}`},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+
+			dropletGUID, err := repo.GetCurrentDropletGUID("my-app-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dropletGUID).To(BeEmpty())
+		})
+	})
+
+	Describe("GetDeployedRevision", func() {
+		It("returns the latest deployed revision with its environment variables", func() {
+			revisionsRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v3/apps/my-app-guid/revisions/deployed?per_page=5000",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{"resources":[
+					{"guid":"revision-1-guid","version":1,"created_at":"2026-01-01T10:00:00Z"},
+					{"guid":"revision-2-guid","version":2,"created_at":"2026-01-02T10:00:00Z"}
+				]}`},
+			})
+			environmentRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v3/revisions/revision-2-guid/environment_variables",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{"var":{"FOO":"bar"}}`},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{revisionsRequest, environmentRequest})
+			defer ts.Close()
+
+			revision, err := repo.GetDeployedRevision("my-app-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision.GUID).To(Equal("revision-2-guid"))
+			Expect(revision.Version).To(Equal(2))
+			Expect(revision.CreatedAt).To(Equal(time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)))
+			Expect(revision.Environment).To(Equal(map[string]interface{}{"FOO": "bar"}))
+		})
+
+		It("returns an empty revision when the Cloud Controller does not keep revisions", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v3/apps/my-app-guid/revisions/deployed?per_page=5000",
+				Response: testnet.TestResponse{Status: http.StatusNotFound, Body: `{"errors":[{"code":10000,"title":"CF-NotFound"}]}`},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+
+			revision, err := repo.GetDeployedRevision("my-app-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision.GUID).To(BeEmpty())
+		})
+
+		It("returns an empty revision when the app has no deployed revision", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v3/apps/my-app-guid/revisions/deployed?per_page=5000",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{"resources":[]}`},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+
+			revision, err := repo.GetDeployedRevision("my-app-guid")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision.GUID).To(BeEmpty())
+		})
+	})
+
+	Describe("ScaleProcess", func() {
+		It("changes every requested dimension in a single request", func() {
+			request := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:  "POST",
+				Path:    "/v3/apps/my-app-guid/processes/worker/actions/scale",
+				Matcher: testnet.RequestBodyMatcher(`{"instances":5,"memory_in_mb":1024}`),
+				Response: testnet.TestResponse{
+					Status: http.StatusAccepted,
+					Body:   `{"guid":"process-guid","type":"worker","instances":5,"memory_in_mb":1024,"disk_in_mb":1024}`,
+				},
+			})
+
+			ts, handler, repo := createAppRepo([]testnet.TestRequest{request})
+			defer ts.Close()
+
+			instances := 5
+			memory := int64(1024)
+			process, err := repo.ScaleProcess("my-app-guid", "worker", models.AppParams{
+				InstanceCount: &instances,
+				Memory:        &memory,
+			})
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(process.Instances).To(Equal(5))
+			Expect(process.MemoryInMB).To(Equal(int64(1024)))
+		})
+	})
+
 	It("deletes applications", func() {
 		deleteApplicationRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
 			Method:   "DELETE",
@@ -81,6 +81,81 @@ type FakeRepository struct {
 	createRestageRequestReturns struct {
 		result1 error
 	}
+	GetProcessStub        func(appGUID string, processType string) (models.Process, error)
+	getProcessMutex       sync.RWMutex
+	getProcessArgsForCall []struct {
+		appGUID     string
+		processType string
+	}
+	getProcessReturns struct {
+		result1 models.Process
+		result2 error
+	}
+	ScaleProcessStub        func(appGUID string, processType string, params models.AppParams) (models.Process, error)
+	scaleProcessMutex       sync.RWMutex
+	scaleProcessArgsForCall []struct {
+		appGUID     string
+		processType string
+		params      models.AppParams
+	}
+	scaleProcessReturns struct {
+		result1 models.Process
+		result2 error
+	}
+	GetV3AppStub        func(appGUID string) (models.V3Application, error)
+	getV3AppMutex       sync.RWMutex
+	getV3AppArgsForCall []struct {
+		appGUID string
+	}
+	getV3AppReturns struct {
+		result1 models.V3Application
+		result2 error
+	}
+	ListProcessesStub        func(appGUID string) ([]models.Process, error)
+	listProcessesMutex       sync.RWMutex
+	listProcessesArgsForCall []struct {
+		appGUID string
+	}
+	listProcessesReturns struct {
+		result1 []models.Process
+		result2 error
+	}
+	ListSidecarsStub        func(appGUID string) ([]models.Sidecar, error)
+	listSidecarsMutex       sync.RWMutex
+	listSidecarsArgsForCall []struct {
+		appGUID string
+	}
+	listSidecarsReturns struct {
+		result1 []models.Sidecar
+		result2 error
+	}
+	GetCurrentDropletGUIDStub        func(appGUID string) (string, error)
+	getCurrentDropletGUIDMutex       sync.RWMutex
+	getCurrentDropletGUIDArgsForCall []struct {
+		appGUID string
+	}
+	getCurrentDropletGUIDReturns struct {
+		result1 string
+		result2 error
+	}
+	ListAppsByBuildpackStub        func(buildpackName string) ([]models.Application, error)
+	listAppsByBuildpackMutex       sync.RWMutex
+	listAppsByBuildpackArgsForCall []struct {
+		buildpackName string
+	}
+	listAppsByBuildpackReturns struct {
+		result1 []models.Application
+		result2 error
+	}
+	GetDeployedRevisionStub        func(appGUID string) (models.Revision, error)
+	getDeployedRevisionMutex       sync.RWMutex
+	getDeployedRevisionArgsForCall []struct {
+		appGUID string
+	}
+	getDeployedRevisionReturns struct {
+		result1 models.Revision
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -357,6 +432,281 @@ func (fake *FakeRepository) CreateRestageRequestReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeRepository) GetProcess(appGUID string, processType string) (models.Process, error) {
+	fake.getProcessMutex.Lock()
+	fake.getProcessArgsForCall = append(fake.getProcessArgsForCall, struct {
+		appGUID     string
+		processType string
+	}{appGUID, processType})
+	fake.recordInvocation("GetProcess", []interface{}{appGUID, processType})
+	fake.getProcessMutex.Unlock()
+	if fake.GetProcessStub != nil {
+		return fake.GetProcessStub(appGUID, processType)
+	} else {
+		return fake.getProcessReturns.result1, fake.getProcessReturns.result2
+	}
+}
+
+func (fake *FakeRepository) GetProcessCallCount() int {
+	fake.getProcessMutex.RLock()
+	defer fake.getProcessMutex.RUnlock()
+	return len(fake.getProcessArgsForCall)
+}
+
+func (fake *FakeRepository) GetProcessArgsForCall(i int) (string, string) {
+	fake.getProcessMutex.RLock()
+	defer fake.getProcessMutex.RUnlock()
+	return fake.getProcessArgsForCall[i].appGUID, fake.getProcessArgsForCall[i].processType
+}
+
+func (fake *FakeRepository) GetProcessReturns(result1 models.Process, result2 error) {
+	fake.GetProcessStub = nil
+	fake.getProcessReturns = struct {
+		result1 models.Process
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ScaleProcess(appGUID string, processType string, params models.AppParams) (models.Process, error) {
+	fake.scaleProcessMutex.Lock()
+	fake.scaleProcessArgsForCall = append(fake.scaleProcessArgsForCall, struct {
+		appGUID     string
+		processType string
+		params      models.AppParams
+	}{appGUID, processType, params})
+	fake.recordInvocation("ScaleProcess", []interface{}{appGUID, processType, params})
+	fake.scaleProcessMutex.Unlock()
+	if fake.ScaleProcessStub != nil {
+		return fake.ScaleProcessStub(appGUID, processType, params)
+	} else {
+		return fake.scaleProcessReturns.result1, fake.scaleProcessReturns.result2
+	}
+}
+
+func (fake *FakeRepository) ScaleProcessCallCount() int {
+	fake.scaleProcessMutex.RLock()
+	defer fake.scaleProcessMutex.RUnlock()
+	return len(fake.scaleProcessArgsForCall)
+}
+
+func (fake *FakeRepository) ScaleProcessArgsForCall(i int) (string, string, models.AppParams) {
+	fake.scaleProcessMutex.RLock()
+	defer fake.scaleProcessMutex.RUnlock()
+	return fake.scaleProcessArgsForCall[i].appGUID, fake.scaleProcessArgsForCall[i].processType, fake.scaleProcessArgsForCall[i].params
+}
+
+func (fake *FakeRepository) ScaleProcessReturns(result1 models.Process, result2 error) {
+	fake.ScaleProcessStub = nil
+	fake.scaleProcessReturns = struct {
+		result1 models.Process
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetV3App(appGUID string) (models.V3Application, error) {
+	fake.getV3AppMutex.Lock()
+	fake.getV3AppArgsForCall = append(fake.getV3AppArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetV3App", []interface{}{appGUID})
+	fake.getV3AppMutex.Unlock()
+	if fake.GetV3AppStub != nil {
+		return fake.GetV3AppStub(appGUID)
+	} else {
+		return fake.getV3AppReturns.result1, fake.getV3AppReturns.result2
+	}
+}
+
+func (fake *FakeRepository) GetV3AppCallCount() int {
+	fake.getV3AppMutex.RLock()
+	defer fake.getV3AppMutex.RUnlock()
+	return len(fake.getV3AppArgsForCall)
+}
+
+func (fake *FakeRepository) GetV3AppArgsForCall(i int) string {
+	fake.getV3AppMutex.RLock()
+	defer fake.getV3AppMutex.RUnlock()
+	return fake.getV3AppArgsForCall[i].appGUID
+}
+
+func (fake *FakeRepository) GetV3AppReturns(result1 models.V3Application, result2 error) {
+	fake.GetV3AppStub = nil
+	fake.getV3AppReturns = struct {
+		result1 models.V3Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListProcesses(appGUID string) ([]models.Process, error) {
+	fake.listProcessesMutex.Lock()
+	fake.listProcessesArgsForCall = append(fake.listProcessesArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("ListProcesses", []interface{}{appGUID})
+	fake.listProcessesMutex.Unlock()
+	if fake.ListProcessesStub != nil {
+		return fake.ListProcessesStub(appGUID)
+	} else {
+		return fake.listProcessesReturns.result1, fake.listProcessesReturns.result2
+	}
+}
+
+func (fake *FakeRepository) ListProcessesCallCount() int {
+	fake.listProcessesMutex.RLock()
+	defer fake.listProcessesMutex.RUnlock()
+	return len(fake.listProcessesArgsForCall)
+}
+
+func (fake *FakeRepository) ListProcessesArgsForCall(i int) string {
+	fake.listProcessesMutex.RLock()
+	defer fake.listProcessesMutex.RUnlock()
+	return fake.listProcessesArgsForCall[i].appGUID
+}
+
+func (fake *FakeRepository) ListProcessesReturns(result1 []models.Process, result2 error) {
+	fake.ListProcessesStub = nil
+	fake.listProcessesReturns = struct {
+		result1 []models.Process
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListSidecars(appGUID string) ([]models.Sidecar, error) {
+	fake.listSidecarsMutex.Lock()
+	fake.listSidecarsArgsForCall = append(fake.listSidecarsArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("ListSidecars", []interface{}{appGUID})
+	fake.listSidecarsMutex.Unlock()
+	if fake.ListSidecarsStub != nil {
+		return fake.ListSidecarsStub(appGUID)
+	} else {
+		return fake.listSidecarsReturns.result1, fake.listSidecarsReturns.result2
+	}
+}
+
+func (fake *FakeRepository) ListSidecarsCallCount() int {
+	fake.listSidecarsMutex.RLock()
+	defer fake.listSidecarsMutex.RUnlock()
+	return len(fake.listSidecarsArgsForCall)
+}
+
+func (fake *FakeRepository) ListSidecarsArgsForCall(i int) string {
+	fake.listSidecarsMutex.RLock()
+	defer fake.listSidecarsMutex.RUnlock()
+	return fake.listSidecarsArgsForCall[i].appGUID
+}
+
+func (fake *FakeRepository) ListSidecarsReturns(result1 []models.Sidecar, result2 error) {
+	fake.ListSidecarsStub = nil
+	fake.listSidecarsReturns = struct {
+		result1 []models.Sidecar
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetCurrentDropletGUID(appGUID string) (string, error) {
+	fake.getCurrentDropletGUIDMutex.Lock()
+	fake.getCurrentDropletGUIDArgsForCall = append(fake.getCurrentDropletGUIDArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetCurrentDropletGUID", []interface{}{appGUID})
+	fake.getCurrentDropletGUIDMutex.Unlock()
+	if fake.GetCurrentDropletGUIDStub != nil {
+		return fake.GetCurrentDropletGUIDStub(appGUID)
+	} else {
+		return fake.getCurrentDropletGUIDReturns.result1, fake.getCurrentDropletGUIDReturns.result2
+	}
+}
+
+func (fake *FakeRepository) GetCurrentDropletGUIDCallCount() int {
+	fake.getCurrentDropletGUIDMutex.RLock()
+	defer fake.getCurrentDropletGUIDMutex.RUnlock()
+	return len(fake.getCurrentDropletGUIDArgsForCall)
+}
+
+func (fake *FakeRepository) GetCurrentDropletGUIDArgsForCall(i int) string {
+	fake.getCurrentDropletGUIDMutex.RLock()
+	defer fake.getCurrentDropletGUIDMutex.RUnlock()
+	return fake.getCurrentDropletGUIDArgsForCall[i].appGUID
+}
+
+func (fake *FakeRepository) GetCurrentDropletGUIDReturns(result1 string, result2 error) {
+	fake.GetCurrentDropletGUIDStub = nil
+	fake.getCurrentDropletGUIDReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListAppsByBuildpack(buildpackName string) ([]models.Application, error) {
+	fake.listAppsByBuildpackMutex.Lock()
+	fake.listAppsByBuildpackArgsForCall = append(fake.listAppsByBuildpackArgsForCall, struct {
+		buildpackName string
+	}{buildpackName})
+	fake.recordInvocation("ListAppsByBuildpack", []interface{}{buildpackName})
+	fake.listAppsByBuildpackMutex.Unlock()
+	if fake.ListAppsByBuildpackStub != nil {
+		return fake.ListAppsByBuildpackStub(buildpackName)
+	} else {
+		return fake.listAppsByBuildpackReturns.result1, fake.listAppsByBuildpackReturns.result2
+	}
+}
+
+func (fake *FakeRepository) ListAppsByBuildpackCallCount() int {
+	fake.listAppsByBuildpackMutex.RLock()
+	defer fake.listAppsByBuildpackMutex.RUnlock()
+	return len(fake.listAppsByBuildpackArgsForCall)
+}
+
+func (fake *FakeRepository) ListAppsByBuildpackArgsForCall(i int) string {
+	fake.listAppsByBuildpackMutex.RLock()
+	defer fake.listAppsByBuildpackMutex.RUnlock()
+	return fake.listAppsByBuildpackArgsForCall[i].buildpackName
+}
+
+func (fake *FakeRepository) ListAppsByBuildpackReturns(result1 []models.Application, result2 error) {
+	fake.ListAppsByBuildpackStub = nil
+	fake.listAppsByBuildpackReturns = struct {
+		result1 []models.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetDeployedRevision(appGUID string) (models.Revision, error) {
+	fake.getDeployedRevisionMutex.Lock()
+	fake.getDeployedRevisionArgsForCall = append(fake.getDeployedRevisionArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetDeployedRevision", []interface{}{appGUID})
+	fake.getDeployedRevisionMutex.Unlock()
+	if fake.GetDeployedRevisionStub != nil {
+		return fake.GetDeployedRevisionStub(appGUID)
+	} else {
+		return fake.getDeployedRevisionReturns.result1, fake.getDeployedRevisionReturns.result2
+	}
+}
+
+func (fake *FakeRepository) GetDeployedRevisionCallCount() int {
+	fake.getDeployedRevisionMutex.RLock()
+	defer fake.getDeployedRevisionMutex.RUnlock()
+	return len(fake.getDeployedRevisionArgsForCall)
+}
+
+func (fake *FakeRepository) GetDeployedRevisionArgsForCall(i int) string {
+	fake.getDeployedRevisionMutex.RLock()
+	defer fake.getDeployedRevisionMutex.RUnlock()
+	return fake.getDeployedRevisionArgsForCall[i].appGUID
+}
+
+func (fake *FakeRepository) GetDeployedRevisionReturns(result1 models.Revision, result2 error) {
+	fake.GetDeployedRevisionStub = nil
+	fake.getDeployedRevisionReturns = struct {
+		result1 models.Revision
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -376,6 +726,22 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.readEnvMutex.RUnlock()
 	fake.createRestageRequestMutex.RLock()
 	defer fake.createRestageRequestMutex.RUnlock()
+	fake.getProcessMutex.RLock()
+	defer fake.getProcessMutex.RUnlock()
+	fake.scaleProcessMutex.RLock()
+	defer fake.scaleProcessMutex.RUnlock()
+	fake.getV3AppMutex.RLock()
+	defer fake.getV3AppMutex.RUnlock()
+	fake.listProcessesMutex.RLock()
+	defer fake.listProcessesMutex.RUnlock()
+	fake.listSidecarsMutex.RLock()
+	defer fake.listSidecarsMutex.RUnlock()
+	fake.getCurrentDropletGUIDMutex.RLock()
+	defer fake.getCurrentDropletGUIDMutex.RUnlock()
+	fake.listAppsByBuildpackMutex.RLock()
+	defer fake.listAppsByBuildpackMutex.RUnlock()
+	fake.getDeployedRevisionMutex.RLock()
+	defer fake.getDeployedRevisionMutex.RUnlock()
 	return fake.invocations
 }
 
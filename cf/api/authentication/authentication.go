@@ -13,6 +13,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/errors"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/util/cacert"
 )
 
 //go:generate counterfeiter . TokenRefresher
@@ -59,6 +60,7 @@ func (uaa UAARepository) Authorize(token string) (string, error) {
 			DisableKeepAlives: true,
 			TLSClientConfig: &tls.Config{
 				InsecureSkipVerify: uaa.config.IsSSLDisabled(),
+				RootCAs:            cacert.NewCertPool([]byte(uaa.config.CACert())),
 			},
 			Proxy:               http.ProxyFromEnvironment,
 			TLSHandshakeTimeout: 10 * time.Second,
@@ -125,6 +127,9 @@ func (uaa UAARepository) Authenticate(credentials map[string]string) error {
 		if ok {
 			switch {
 			case httpError.StatusCode() == http.StatusUnauthorized:
+				if _, ok := credentials["passcode"]; ok && httpError.Description() != "" {
+					return errors.New(T("Passcode was rejected: {{.Description}}", map[string]interface{}{"Description": httpError.Description()}))
+				}
 				return errors.New(T("Credentials were rejected, please try again."))
 			case httpError.StatusCode() >= http.StatusInternalServerError:
 				return errors.New(T("The targeted API endpoint could not be reached."))
@@ -134,6 +139,10 @@ func (uaa UAARepository) Authenticate(credentials map[string]string) error {
 		return err
 	}
 
+	// The issuer identifies the foundation the session belongs to so that
+	// later commands can detect a retargeted API endpoint.
+	uaa.config.SetUAAIssuer(coreconfig.NewTokenInfo(uaa.config.AccessToken()).Issuer)
+
 	return nil
 }
 
@@ -158,8 +167,13 @@ var knownAuthPromptTypes = map[string]coreconfig.AuthPromptType{
 func (r *LoginResource) parsePrompts() (prompts map[string]coreconfig.AuthPrompt) {
 	prompts = make(map[string]coreconfig.AuthPrompt)
 	for key, val := range r.Prompts {
+		promptType, ok := knownAuthPromptTypes[val[0]]
+		if !ok {
+			promptType = coreconfig.AuthPromptType(val[0])
+		}
+
 		prompts[key] = coreconfig.AuthPrompt{
-			Type:        knownAuthPromptTypes[val[0]],
+			Type:        promptType,
 			DisplayName: val[1],
 		}
 	}
@@ -187,6 +201,14 @@ func (uaa UAARepository) RefreshAuthToken() (string, error) {
 		"scope":         {""},
 	}
 
+	// Client credential tokens come without a refresh token; a new one is
+	// requested with the service account's client credentials instead.
+	if uaa.config.UAAGrantType() == "client_credentials" {
+		data = url.Values{
+			"grant_type": {"client_credentials"},
+		}
+	}
+
 	apiErr := uaa.getAuthToken(data)
 	updatedToken := uaa.config.AccessToken()
 
@@ -50,6 +50,27 @@ var _ = Describe("AuthenticationRepository", func() {
 			config.SetUAAOAuthClient("cf")
 		}
 
+		Describe("authenticating with a passcode", func() {
+			Context("when the passcode is rejected", func() {
+				BeforeEach(func() {
+					setupTestServer(testnet.TestRequest{
+						Method: "POST",
+						Path:   "/oauth/token",
+						Response: testnet.TestResponse{
+							Status: http.StatusUnauthorized,
+							Body:   `{"error": "unauthorized", "error_description": "Invalid passcode"}`,
+						},
+					})
+				})
+
+				It("returns the description UAA gave", func() {
+					err := auth.Authenticate(map[string]string{"passcode": "some-passcode"})
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(err).To(MatchError("Passcode was rejected: Invalid passcode"))
+				})
+			})
+		})
+
 		Describe("authenticating", func() {
 			var err error
 
@@ -72,6 +93,34 @@ var _ = Describe("AuthenticationRepository", func() {
 					Expect(config.AccessToken()).To(Equal("BEARER my_access_token"))
 					Expect(config.RefreshToken()).To(Equal("my_refresh_token"))
 				})
+
+				It("does not record an issuer for an opaque token", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(config.UAAIssuer()).To(BeEmpty())
+				})
+
+				Context("when the access token is a JWT", func() {
+					BeforeEach(func() {
+						testServer.Close()
+						setupTestServer(testnet.TestRequest{
+							Method: "POST",
+							Path:   "/oauth/token",
+							Response: testnet.TestResponse{
+								Status: http.StatusOK,
+								Body: `{
+  "access_token": "header.eyJpc3MiOiJodHRwczovL3VhYS5leGFtcGxlLmNvbS9vYXV0aC90b2tlbiJ9.signature",
+  "token_type": "bearer",
+  "refresh_token": "my_refresh_token"
+}`,
+							},
+						})
+					})
+
+					It("records the token's issuer in the config", func() {
+						Expect(err).NotTo(HaveOccurred())
+						Expect(config.UAAIssuer()).To(Equal("https://uaa.example.com/oauth/token"))
+					})
+				})
 			})
 
 			Describe("when login fails", func() {
@@ -174,6 +223,29 @@ var _ = Describe("AuthenticationRepository", func() {
 				})
 			})
 
+			Describe("when UAA advertises a prompt type the CLI does not know", func() {
+				BeforeEach(func() {
+					setupTestServer(testnet.TestRequest{
+						Method: "GET",
+						Path:   "/login",
+						Response: testnet.TestResponse{
+							Status: http.StatusOK,
+							Body:   `{"prompts": {"token": ["hardware", "Hardware token"]}}`,
+						},
+					})
+				})
+
+				It("keeps the prompt's type so the caller can report it", func() {
+					Expect(apiErr).NotTo(HaveOccurred())
+					Expect(prompts).To(Equal(map[string]coreconfig.AuthPrompt{
+						"token": {
+							DisplayName: "Hardware token",
+							Type:        coreconfig.AuthPromptType("hardware"),
+						},
+					}))
+				})
+			})
+
 			Describe("when the login info API fails", func() {
 				BeforeEach(func() {
 					setupTestServer(loginServerLoginFailureRequest)
@@ -221,6 +293,38 @@ var _ = Describe("AuthenticationRepository", func() {
 					Expect(apiErr).NotTo(BeNil())
 				})
 			})
+
+			Context("when a service account is logged in", func() {
+				BeforeEach(func() {
+					setupTestServer(testnet.TestRequest{
+						Method: "POST",
+						Path:   "/oauth/token",
+						Header: http.Header{
+							"accept":        {"application/json"},
+							"content-type":  {"application/x-www-form-urlencoded"},
+							"authorization": {"Basic " + base64.StdEncoding.EncodeToString([]byte("some-client:some-secret"))},
+						},
+						Matcher: func(request *http.Request) {
+							Expect(request.ParseForm()).To(Succeed())
+							Expect(request.Form.Get("grant_type")).To(Equal("client_credentials"))
+							Expect(request.Form.Get("refresh_token")).To(BeEmpty())
+						},
+						Response: testnet.TestResponse{
+							Status: http.StatusOK,
+							Body:   `{"access_token": "new-access-token", "token_type": "bearer"}`,
+						},
+					})
+					config.SetUAAOAuthClient("some-client")
+					config.SetUAAOAuthClientSecret("some-secret")
+					config.SetUAAGrantType("client_credentials")
+				})
+
+				It("requests a new token with the client credentials", func() {
+					Expect(apiErr).NotTo(HaveOccurred())
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(config.AccessToken()).To(Equal("bearer new-access-token"))
+				})
+			})
 		})
 	})
 
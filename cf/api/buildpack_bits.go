@@ -32,17 +32,26 @@ type BuildpackBitsRepository interface {
 	CreateBuildpackZipFile(buildpackPath string) (*os.File, string, error)
 }
 
+// maxBuildpackUploadAttempts bounds how many times an upload is attempted
+// when the platform responds with a gateway error.
+const maxBuildpackUploadAttempts = 4
+
 type CloudControllerBuildpackBitsRepository struct {
 	config       coreconfig.Reader
 	gateway      net.Gateway
 	zipper       appfiles.Zipper
 	TrustedCerts []tls.Certificate
+
+	// UploadRetryBackoff is the wait before the first upload retry; it
+	// doubles after each subsequent failure.
+	UploadRetryBackoff time.Duration
 }
 
 func NewCloudControllerBuildpackBitsRepository(config coreconfig.Reader, gateway net.Gateway, zipper appfiles.Zipper) (repo CloudControllerBuildpackBitsRepository) {
 	repo.config = config
 	repo.gateway = gateway
 	repo.zipper = zipper
+	repo.UploadRetryBackoff = time.Second
 	return
 }
 
@@ -259,11 +268,43 @@ func (repo CloudControllerBuildpackBitsRepository) UploadBuildpack(buildpack mod
 		buildpackFile.Close()
 		os.Remove(buildpackFile.Name())
 	}()
-	return repo.performMultiPartUpload(
-		fmt.Sprintf("%s/v2/buildpacks/%s/bits", repo.config.APIEndpoint(), buildpack.GUID),
-		"buildpack",
-		buildpackName,
-		buildpackFile)
+
+	url := fmt.Sprintf("%s/v2/buildpacks/%s/bits", repo.config.APIEndpoint(), buildpack.GUID)
+	backoff := repo.UploadRetryBackoff
+
+	var err error
+	for attempt := 1; attempt <= maxBuildpackUploadAttempts; attempt++ {
+		if _, err = buildpackFile.Seek(0, 0); err != nil {
+			return err
+		}
+
+		err = repo.performMultiPartUpload(url, "buildpack", buildpackName, buildpackFile)
+		if !isGatewayError(err) {
+			return err
+		}
+
+		if attempt < maxBuildpackUploadAttempts {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+	return err
+}
+
+// isGatewayError returns true for errors caused by a load balancer or router
+// failing to reach the Cloud Controller, which are worth retrying.
+func isGatewayError(err error) bool {
+	httpErr, ok := err.(errors.HTTPError)
+	if !ok {
+		return false
+	}
+
+	switch httpErr.StatusCode() {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
 }
 
 func (repo CloudControllerBuildpackBitsRepository) performMultiPartUpload(url string, fieldName string, fileName string, body io.Reader) error {
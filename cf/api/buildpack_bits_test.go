@@ -17,6 +17,7 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/appfiles"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/net"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
@@ -207,6 +208,69 @@ var _ = Describe("BuildpackBitsRepository", func() {
 				Expect(testServerHandler).To(HaveAllRequestsCalled())
 			})
 		})
+
+		Context("when the upload fails with a gateway error", func() {
+			BeforeEach(func() {
+				zipFileName = "example-buildpack.zip"
+				repo.UploadRetryBackoff = 0
+			})
+
+			It("retries the upload", func() {
+				testServer.Close()
+				testServer, testServerHandler = testnet.NewServer([]testnet.TestRequest{
+					badGatewayBuildpackRequest(),
+					badGatewayBuildpackRequest(),
+					uploadBuildpackRequest(),
+				})
+				configRepo.SetAPIEndpoint(testServer.URL)
+
+				apiErr := repo.UploadBuildpack(buildpack, zipFile, zipFileName)
+
+				Expect(apiErr).NotTo(HaveOccurred())
+				Expect(testServerHandler).To(HaveAllRequestsCalled())
+			})
+
+			It("gives up after a bounded number of attempts", func() {
+				testServer.Close()
+				testServer, testServerHandler = testnet.NewServer([]testnet.TestRequest{
+					badGatewayBuildpackRequest(),
+					badGatewayBuildpackRequest(),
+					badGatewayBuildpackRequest(),
+					badGatewayBuildpackRequest(),
+				})
+				configRepo.SetAPIEndpoint(testServer.URL)
+
+				apiErr := repo.UploadBuildpack(buildpack, zipFile, zipFileName)
+
+				Expect(apiErr).To(HaveOccurred())
+				Expect(apiErr.(errors.HTTPError).StatusCode()).To(Equal(http.StatusBadGateway))
+				Expect(testServerHandler).To(HaveAllRequestsCalled())
+			})
+		})
+
+		Context("when the upload fails with another error", func() {
+			BeforeEach(func() {
+				zipFileName = "example-buildpack.zip"
+				repo.UploadRetryBackoff = 0
+			})
+
+			It("does not retry the upload", func() {
+				testServer.Close()
+				testServer, testServerHandler = testnet.NewServer([]testnet.TestRequest{
+					{
+						Method:   "PUT",
+						Path:     "/v2/buildpacks/my-cool-buildpack-guid/bits",
+						Response: testnet.TestResponse{Status: http.StatusBadRequest},
+					},
+				})
+				configRepo.SetAPIEndpoint(testServer.URL)
+
+				apiErr := repo.UploadBuildpack(buildpack, zipFile, zipFileName)
+
+				Expect(apiErr).To(HaveOccurred())
+				Expect(testServerHandler.CallCount).To(Equal(1))
+			})
+		})
 	})
 })
 
@@ -274,3 +338,11 @@ func uploadBuildpackRequest() testnet.TestRequest {
 		},
 	}
 }
+
+func badGatewayBuildpackRequest() testnet.TestRequest {
+	return testnet.TestRequest{
+		Method:   "PUT",
+		Path:     "/v2/buildpacks/my-cool-buildpack-guid/bits",
+		Response: testnet.TestResponse{Status: http.StatusBadGateway},
+	}
+}
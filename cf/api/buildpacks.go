@@ -18,8 +18,9 @@ import (
 
 type BuildpackRepository interface {
 	FindByName(name string) (buildpack models.Buildpack, apiErr error)
+	FindByNameAndStack(name, stack string) (buildpack models.Buildpack, apiErr error)
 	ListBuildpacks(func(models.Buildpack) bool) error
-	Create(name string, position *int, enabled *bool, locked *bool) (createdBuildpack models.Buildpack, apiErr error)
+	Create(name string, position *int, enabled *bool, locked *bool, stack string) (createdBuildpack models.Buildpack, apiErr error)
 	Delete(buildpackGUID string) (apiErr error)
 	Update(buildpack models.Buildpack) (updatedBuildpack models.Buildpack, apiErr error)
 }
@@ -63,8 +64,45 @@ func (repo CloudControllerBuildpackRepository) FindByName(name string) (buildpac
 	return
 }
 
-func (repo CloudControllerBuildpackRepository) Create(name string, position *int, enabled *bool, locked *bool) (createdBuildpack models.Buildpack, apiErr error) {
-	entity := resources.BuildpackEntity{Name: name, Position: position, Enabled: enabled, Locked: locked}
+// FindByNameAndStack returns the buildpack with the given name and stack. When
+// stack is empty, an AmbiguousModelError is returned if more than one
+// buildpack has the given name.
+func (repo CloudControllerBuildpackRepository) FindByNameAndStack(name, stack string) (buildpack models.Buildpack, apiErr error) {
+	path := fmt.Sprintf("%s?q=%s", buildpacksPath, url.QueryEscape("name:"+name))
+	if stack != "" {
+		path = fmt.Sprintf("%s&q=%s", path, url.QueryEscape("stack:"+stack))
+	}
+
+	numBuildpacks := 0
+	apiErr = repo.gateway.ListPaginatedResources(
+		repo.config.APIEndpoint(),
+		path,
+		resources.BuildpackResource{},
+		func(resource interface{}) bool {
+			numBuildpacks++
+			if numBuildpacks > 1 {
+				return false
+			}
+			buildpack = resource.(resources.BuildpackResource).ToFields()
+			return true
+		})
+	if apiErr != nil {
+		return
+	}
+
+	switch {
+	case numBuildpacks > 1:
+		return models.Buildpack{}, errors.NewAmbiguousModelError("Buildpack", name)
+	case numBuildpacks == 0 && stack != "":
+		return models.Buildpack{}, errors.NewModelNotFoundError("Buildpack", name+" "+T("with stack")+" "+stack)
+	case numBuildpacks == 0:
+		return models.Buildpack{}, errors.NewModelNotFoundError("Buildpack", name)
+	}
+	return
+}
+
+func (repo CloudControllerBuildpackRepository) Create(name string, position *int, enabled *bool, locked *bool, stack string) (createdBuildpack models.Buildpack, apiErr error) {
+	entity := resources.BuildpackEntity{Name: name, Position: position, Enabled: enabled, Locked: locked, Stack: stack}
 	body, err := json.Marshal(entity)
 	if err != nil {
 		apiErr = fmt.Errorf("%s: %s", T("Could not serialize information"), err.Error())
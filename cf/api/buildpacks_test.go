@@ -156,6 +156,76 @@ var _ = Describe("Buildpacks repo", func() {
 		})
 	})
 
+	Describe("finding buildpacks by name and stack", func() {
+		It("filters by name and stack", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/buildpacks?q=name%3ABuildpack1&q=stack%3Acflinuxfs2",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body: `{"resources": [
+					  {
+						  "metadata": {
+							  "guid": "buildpack1-guid"
+						  },
+						  "entity": {
+							  "name": "Buildpack1",
+							  "stack": "cflinuxfs2",
+							  "position": 10
+						  }
+					  }
+					  ]
+				  }`}}))
+
+			buildpack, apiErr := repo.FindByNameAndStack("Buildpack1", "cflinuxfs2")
+
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+
+			Expect(buildpack.Name).To(Equal("Buildpack1"))
+			Expect(buildpack.Stack).To(Equal("cflinuxfs2"))
+			Expect(buildpack.GUID).To(Equal("buildpack1-guid"))
+		})
+
+		It("returns an AmbiguousModelError when no stack is given and multiple buildpacks match", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/buildpacks?q=name%3ABuildpack1",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body: `{"resources": [
+					  {
+						  "metadata": { "guid": "buildpack1-guid" },
+						  "entity": { "name": "Buildpack1", "stack": "cflinuxfs2" }
+					  },
+					  {
+						  "metadata": { "guid": "buildpack2-guid" },
+						  "entity": { "name": "Buildpack1", "stack": "windows2012R2" }
+					  }
+					  ]
+				  }`}}))
+
+			_, apiErr := repo.FindByNameAndStack("Buildpack1", "")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).To(BeAssignableToTypeOf(&errors.AmbiguousModelError{}))
+		})
+
+		It("returns a ModelNotFoundError when the buildpack is not found", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/buildpacks?q=name%3ABuildpack1&q=stack%3Acflinuxfs2",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   `{"resources": []}`,
+				},
+			}))
+
+			_, apiErr := repo.FindByNameAndStack("Buildpack1", "cflinuxfs2")
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).To(BeAssignableToTypeOf(&errors.ModelNotFoundError{}))
+		})
+	})
+
 	Describe("creating buildpacks", func() {
 		It("returns an error when the buildpack has an invalid name", func() {
 			setupTestServer(testnet.TestRequest{
@@ -171,7 +241,7 @@ var _ = Describe("Buildpacks repo", func() {
 				}})
 
 			one := 1
-			createdBuildpack, apiErr := repo.Create("name with space", &one, nil, nil)
+			createdBuildpack, apiErr := repo.Create("name with space", &one, nil, nil, "")
 			Expect(apiErr).To(HaveOccurred())
 			Expect(createdBuildpack).To(Equal(models.Buildpack{}))
 			Expect(apiErr.(errors.HTTPError).ErrorCode()).To(Equal("290003"))
@@ -197,7 +267,7 @@ var _ = Describe("Buildpacks repo", func() {
 			}))
 
 			position := 999
-			created, apiErr := repo.Create("my-cool-buildpack", &position, nil, nil)
+			created, apiErr := repo.Create("my-cool-buildpack", &position, nil, nil, "")
 
 			Expect(handler).To(HaveAllRequestsCalled())
 			Expect(apiErr).NotTo(HaveOccurred())
@@ -228,7 +298,7 @@ var _ = Describe("Buildpacks repo", func() {
 
 			position := 999
 			enabled := true
-			created, apiErr := repo.Create("my-cool-buildpack", &position, &enabled, nil)
+			created, apiErr := repo.Create("my-cool-buildpack", &position, &enabled, nil, "")
 
 			Expect(handler).To(HaveAllRequestsCalled())
 			Expect(apiErr).NotTo(HaveOccurred())
@@ -237,6 +307,33 @@ var _ = Describe("Buildpacks repo", func() {
 			Expect(created.Name).To(Equal("my-cool-buildpack"))
 			Expect(999).To(Equal(*created.Position))
 		})
+
+		It("sets the stack when creating a buildpack", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:  "POST",
+				Path:    "/v2/buildpacks",
+				Matcher: testnet.RequestBodyMatcher(`{"name":"my-cool-buildpack","position":999,"stack":"cflinuxfs2"}`),
+				Response: testnet.TestResponse{
+					Status: http.StatusCreated,
+					Body: `{
+					"metadata": {
+						"guid": "my-cool-buildpack-guid"
+					},
+					"entity": {
+						"name": "my-cool-buildpack",
+						"position":999,
+						"stack":"cflinuxfs2"
+					}
+				}`},
+			}))
+
+			position := 999
+			created, apiErr := repo.Create("my-cool-buildpack", &position, nil, nil, "cflinuxfs2")
+
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(created.Stack).To(Equal("cflinuxfs2"))
+		})
 	})
 
 	It("deletes buildpacks", func() {
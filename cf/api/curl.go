@@ -3,6 +3,7 @@ package api
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httputil"
@@ -19,6 +20,7 @@ import (
 
 type CurlRepository interface {
 	Request(method, path, header, body string) (resHeaders string, resBody string, apiErr error)
+	RequestStream(method, path, header string, body io.Reader) (resHeaders string, resBody io.ReadCloser, apiErr error)
 }
 
 type CloudControllerCurlRepository struct {
@@ -73,6 +75,44 @@ func (repo CloudControllerCurlRepository) Request(method, path, headerString, bo
 	return
 }
 
+// RequestStream performs the request without buffering the request or the
+// response body. A body that implements io.Seeker is rewound if the request
+// has to be sent again; any other body is streamed and cannot be resent. The
+// caller is responsible for closing the returned body.
+func (repo CloudControllerCurlRepository) RequestStream(method, path, headerString string, body io.Reader) (string, io.ReadCloser, error) {
+	url := fmt.Sprintf("%s/%s", repo.config.APIEndpoint(), strings.TrimLeft(path, "/"))
+
+	if method == "" && body != nil {
+		method = "POST"
+	}
+
+	seekableBody, _ := body.(io.ReadSeeker)
+	req, err := repo.gateway.NewRequest(method, url, repo.config.AccessToken(), seekableBody)
+	if err != nil {
+		return "", nil, err
+	}
+	if body != nil && seekableBody == nil {
+		req.HTTPReq.Body = ioutil.NopCloser(body)
+	}
+
+	err = mergeHeaders(req.HTTPReq.Header, headerString)
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %s", T("Error parsing headers"), err.Error())
+	}
+
+	res, err := repo.gateway.PerformRequest(req)
+	if _, ok := err.(errors.HTTPError); ok {
+		err = nil
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	headerBytes, _ := httputil.DumpResponse(res, false)
+	return string(headerBytes), res.Body, nil
+}
+
 func mergeHeaders(destination http.Header, headerString string) (err error) {
 	headerString = strings.TrimSpace(headerString)
 	headerString += "\n\n"
@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"io/ioutil"
 	"net/http"
 	"strings"
 	"time"
@@ -174,6 +175,101 @@ var _ = Describe("CloudControllerCurlRepository ", func() {
 		Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
 	})
 
+	Describe("RequestStream", func() {
+		It("streams the request body and returns the response body unread", func() {
+			ccServer := ghttp.NewServer()
+			defer ccServer.Close()
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v3/endpoint"),
+					ghttp.VerifyBody([]byte(`{"some":"body"}`)),
+					ghttp.RespondWith(http.StatusOK, "some-binary-bits", http.Header{"Content-Type": {"application/octet-stream"}}),
+				),
+			)
+
+			deps := newCurlDependencies()
+			deps.config.SetAPIEndpoint(ccServer.URL())
+
+			repo := NewCloudControllerCurlRepository(deps.config, deps.gateway)
+			headers, body, err := repo.RequestStream("PUT", "/v3/endpoint", "", strings.NewReader(`{"some":"body"}`))
+			Expect(err).NotTo(HaveOccurred())
+			defer body.Close()
+
+			Expect(headers).To(ContainSubstring("200"))
+			Expect(headers).To(ContainSubstring("application/octet-stream"))
+
+			bodyBytes, err := ioutil.ReadAll(body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(bodyBytes)).To(Equal("some-binary-bits"))
+		})
+
+		It("returns error responses without an error", func() {
+			ccServer := ghttp.NewServer()
+			defer ccServer.Close()
+			ccServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusNotFound, `{"code":10000}`),
+			)
+
+			deps := newCurlDependencies()
+			deps.config.SetAPIEndpoint(ccServer.URL())
+
+			repo := NewCloudControllerCurlRepository(deps.config, deps.gateway)
+			headers, body, err := repo.RequestStream("", "/v3/endpoint", "", nil)
+			Expect(err).NotTo(HaveOccurred())
+			defer body.Close()
+
+			Expect(headers).To(ContainSubstring("404"))
+		})
+
+		Context("when the access token has expired", func() {
+			var (
+				ccServer *ghttp.Server
+				repo     CloudControllerCurlRepository
+			)
+
+			BeforeEach(func() {
+				ccServer = ghttp.NewServer()
+				ccServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyHeaderKV("Authorization", "BEARER my_access_token"),
+						ghttp.VerifyBody([]byte(`{"some":"body"}`)),
+						ghttp.RespondWith(http.StatusUnauthorized, `{"code":1000,"description":"Invalid Auth Token"}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyHeaderKV("Authorization", "bearer new-access-token"),
+						ghttp.VerifyBody([]byte(`{"some":"body"}`)),
+						ghttp.RespondWith(http.StatusOK, "{}"),
+					),
+				)
+
+				deps := newCurlDependencies()
+				deps.config.SetAPIEndpoint(ccServer.URL())
+				deps.gateway.SetTokenRefresher(stubTokenRefresher{token: "bearer new-access-token"})
+				repo = NewCloudControllerCurlRepository(deps.config, deps.gateway)
+			})
+
+			AfterEach(func() {
+				ccServer.Close()
+			})
+
+			It("sends a seekable body again with the refreshed token", func() {
+				headers, body, err := repo.RequestStream("PUT", "/v3/endpoint", "", strings.NewReader(`{"some":"body"}`))
+				Expect(err).NotTo(HaveOccurred())
+				defer body.Close()
+
+				Expect(headers).To(ContainSubstring("200"))
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(2))
+			})
+
+			It("returns an error instead of sending a streamed body again", func() {
+				_, _, err := repo.RequestStream("PUT", "/v3/endpoint", "", ioutil.NopCloser(strings.NewReader(`{"some":"body"}`)))
+				Expect(err).To(MatchError(ContainSubstring("could not be sent again because its body was streamed")))
+
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+	})
+
 	It("uses GET as the default method when a body is not provided", func() {
 		ccServer := ghttp.NewServer()
 		ccServer.AppendHandlers(
@@ -212,6 +308,14 @@ func newCurlDependencies() (deps curlDependencies) {
 	return
 }
 
+type stubTokenRefresher struct {
+	token string
+}
+
+func (refresher stubTokenRefresher) RefreshAuthToken() (string, error) {
+	return refresher.token, nil
+}
+
 func removeWhitespace(body string) string {
 	body = strings.Replace(body, " ", "", -1)
 	body = strings.Replace(body, "\n", "", -1)
@@ -19,11 +19,12 @@ import (
 
 type DomainRepository interface {
 	ListDomainsForOrg(orgGUID string, cb func(models.DomainFields) bool) error
+	ListSharedOrganizations(domainGUID string, cb func(models.OrganizationFields) bool) error
 	FindSharedByName(name string) (domain models.DomainFields, apiErr error)
 	FindPrivateByName(name string) (domain models.DomainFields, apiErr error)
 	FindByNameInOrg(name string, owningOrgGUID string) (domain models.DomainFields, apiErr error)
 	Create(domainName string, owningOrgGUID string) (createdDomain models.DomainFields, apiErr error)
-	CreateSharedDomain(domainName string, routerGroupGUID string) (apiErr error)
+	CreateSharedDomain(domainName string, routerGroupGUID string, internal bool) (apiErr error)
 	Delete(domainGUID string) (apiErr error)
 	DeleteSharedDomain(domainGUID string) (apiErr error)
 	FirstOrDefault(orgGUID string, name *string) (domain models.DomainFields, error error)
@@ -61,6 +62,16 @@ func (repo CloudControllerDomainRepository) listDomains(path string, cb func(mod
 		})
 }
 
+func (repo CloudControllerDomainRepository) ListSharedOrganizations(domainGUID string, cb func(models.OrganizationFields) bool) error {
+	return repo.gateway.ListPaginatedResources(
+		repo.config.APIEndpoint(),
+		fmt.Sprintf("/v2/private_domains/%s/shared_organizations", domainGUID),
+		resources.OrganizationResource{},
+		func(resource interface{}) bool {
+			return cb(resource.(resources.OrganizationResource).ToFields())
+		})
+}
+
 func (repo CloudControllerDomainRepository) isOrgDomain(orgGUID string, domain models.DomainFields) bool {
 	return orgGUID == domain.OwningOrganizationGUID || domain.Shared
 }
@@ -136,11 +147,12 @@ func (repo CloudControllerDomainRepository) Create(domainName string, owningOrgG
 	return
 }
 
-func (repo CloudControllerDomainRepository) CreateSharedDomain(domainName string, routerGroupGUID string) error {
+func (repo CloudControllerDomainRepository) CreateSharedDomain(domainName string, routerGroupGUID string, internal bool) error {
 	data, err := json.Marshal(resources.DomainEntity{
 		Name:            domainName,
 		RouterGroupGUID: routerGroupGUID,
 		Wildcard:        true,
+		Internal:        internal,
 	})
 	if err != nil {
 		return err
@@ -179,6 +191,9 @@ func (repo CloudControllerDomainRepository) FirstOrDefault(orgGUID string, name
 func (repo CloudControllerDomainRepository) defaultDomain(orgGUID string) (models.DomainFields, error) {
 	var foundDomain *models.DomainFields
 	err := repo.ListDomainsForOrg(orgGUID, func(domain models.DomainFields) bool {
+		if domain.Internal {
+			return true
+		}
 		foundDomain = &domain
 		return !domain.Shared
 	})
@@ -73,6 +73,37 @@ var _ = Describe("DomainRepository", func() {
 		})
 	})
 
+	Describe("listing the organizations a private domain is shared with", func() {
+		BeforeEach(func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/private_domains/domain-guid/shared_organizations",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{
+					"resources": [
+						{ "metadata": { "guid": "org1-guid" }, "entity": { "name": "org1" } },
+						{ "metadata": { "guid": "org2-guid" }, "entity": { "name": "org2" } }
+					]
+				}`},
+			}))
+		})
+
+		It("returns the shared organizations", func() {
+			orgs := []models.OrganizationFields{}
+			err := repo.ListSharedOrganizations("domain-guid", func(org models.OrganizationFields) bool {
+				orgs = append(orgs, org)
+				return true
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(orgs).To(HaveLen(2))
+			Expect(orgs[0].GUID).To(Equal("org1-guid"))
+			Expect(orgs[0].Name).To(Equal("org1"))
+			Expect(orgs[1].GUID).To(Equal("org2-guid"))
+			Expect(orgs[1].Name).To(Equal("org2"))
+			Expect(handler).To(HaveAllRequestsCalled())
+		})
+	})
+
 	Describe("getting default domain", func() {
 		BeforeEach(func() {
 			setupTestServer(firstPagePrivateDomainsRequest, secondPagePrivateDomainsRequest, firstPageSharedDomainsRequest, secondPageSharedDomainsRequest)
@@ -84,6 +115,36 @@ var _ = Describe("DomainRepository", func() {
 			Expect(apiErr).NotTo(HaveOccurred())
 			Expect(domain.GUID).To(Equal("shared-domain1-guid"))
 		})
+
+		Context("when the first shared domain is internal", func() {
+			BeforeEach(func() {
+				setupTestServer(firstPagePrivateDomainsRequest, secondPagePrivateDomainsRequest, apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/shared_domains",
+					Response: testnet.TestResponse{Status: http.StatusOK, Body: `
+					{
+						"resources": [
+							{
+							  "metadata": { "guid": "internal-domain-guid" },
+							  "entity": { "name": "apps.internal", "internal": true }
+							},
+							{
+							  "metadata": { "guid": "shared-domain1-guid" },
+							  "entity": { "name": "sharedexample.com" }
+							}
+						]
+					}`},
+				}))
+			})
+
+			It("skips the internal domain", func() {
+				domain, apiErr := repo.FirstOrDefault("my-org-guid", nil)
+
+				Expect(apiErr).NotTo(HaveOccurred())
+				Expect(domain.GUID).To(Equal("shared-domain1-guid"))
+				Expect(domain.Internal).To(BeFalse())
+			})
+		})
 	})
 
 	It("finds a shared domain by name", func() {
@@ -312,7 +373,7 @@ var _ = Describe("DomainRepository", func() {
 					}`}}),
 				)
 
-				apiErr := repo.CreateSharedDomain("example.com", "")
+				apiErr := repo.CreateSharedDomain("example.com", "", false)
 
 				Expect(handler).To(HaveAllRequestsCalled())
 				Expect(apiErr).NotTo(HaveOccurred())
@@ -331,7 +392,26 @@ var _ = Describe("DomainRepository", func() {
 					}`}}),
 				)
 
-				apiErr := repo.CreateSharedDomain("example.com", "tcp-group")
+				apiErr := repo.CreateSharedDomain("example.com", "tcp-group", false)
+
+				Expect(handler).To(HaveAllRequestsCalled())
+				Expect(apiErr).NotTo(HaveOccurred())
+			})
+
+			It("creates an internal shared domain", func() {
+				setupTestServer(
+					apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+						Method:  "POST",
+						Path:    "/v2/shared_domains",
+						Matcher: testnet.RequestBodyMatcher(`{"name":"apps.internal", "internal": true, "wildcard": true}`),
+						Response: testnet.TestResponse{Status: http.StatusCreated, Body: `
+					{
+						"metadata": { "guid": "abc-123" },
+						"entity": { "name": "apps.internal", "internal": true }
+					}`}}),
+				)
+
+				apiErr := repo.CreateSharedDomain("apps.internal", "", true)
 
 				Expect(handler).To(HaveAllRequestsCalled())
 				Expect(apiErr).NotTo(HaveOccurred())
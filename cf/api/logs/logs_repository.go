@@ -1,6 +1,10 @@
 package logs
 
-import "time"
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/cf/i18n"
+)
 
 //go:generate counterfeiter . Loggable
 type Loggable interface {
@@ -17,6 +21,14 @@ type Repository interface {
 	Close()
 }
 
+// LoggingUnavailableError is returned when the targeted platform does not
+// advertise a doppler endpoint, so logs cannot be retrieved.
+type LoggingUnavailableError struct{}
+
+func (LoggingUnavailableError) Error() string {
+	return T("Logging is not available on this platform")
+}
+
 const defaultBufferTime time.Duration = 25 * time.Millisecond
 
 func max(a, b int) int {
@@ -1,12 +1,9 @@
 package logs
 
 import (
-	"errors"
 	"fmt"
 	"time"
 
-	. "code.cloudfoundry.org/cli/cf/i18n"
-
 	"code.cloudfoundry.org/cli/cf/api/authentication"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 
@@ -51,6 +48,10 @@ func loggableMessagesFromNoaaMessages(messages []*events.LogMessage) []Loggable
 }
 
 func (repo *NoaaLogsRepository) RecentLogsFor(appGUID string) ([]Loggable, error) {
+	if repo.config.DopplerEndpoint() == "" {
+		return nil, LoggingUnavailableError{}
+	}
+
 	logs, err := repo.consumer.RecentLogs(appGUID, repo.config.AccessToken())
 
 	if err != nil {
@@ -63,9 +64,11 @@ func (repo *NoaaLogsRepository) TailLogsFor(appGUID string, onConnect func(), lo
 	ticker := time.NewTicker(repo.BufferTime)
 	retryTimer := newUnstartedTimer()
 
-	endpoint := repo.config.DopplerEndpoint()
-	if endpoint == "" {
-		errChan <- errors.New(T("No doppler loggregator endpoint found. Cannot retrieve logs."))
+	if repo.config.DopplerEndpoint() == "" {
+		ticker.Stop()
+		errChan <- LoggingUnavailableError{}
+		close(logChan)
+		close(errChan)
 		return
 	}
 
@@ -47,6 +47,18 @@ var _ = Describe("logs with noaa repository", func() {
 	})
 
 	Describe("RecentLogsFor", func() {
+		Context("when the doppler endpoint is empty", func() {
+			BeforeEach(func() {
+				config.SetDopplerEndpoint("")
+			})
+
+			It("returns a LoggingUnavailableError without connecting", func() {
+				_, err := repo.RecentLogsFor("app-guid")
+				Expect(err).To(Equal(logs.LoggingUnavailableError{}))
+				Expect(fakeNoaaConsumer.RecentLogsCallCount()).To(BeZero())
+			})
+		})
+
 		Context("when an error does not occur", func() {
 			var msg1, msg2, msg3 *events.LogMessage
 
@@ -90,6 +102,21 @@ var _ = Describe("logs with noaa repository", func() {
 			Eventually(logChan).Should(BeClosed())
 		})
 
+		Context("when the doppler endpoint is empty", func() {
+			BeforeEach(func() {
+				config.SetDopplerEndpoint("")
+				errChan = make(chan error)
+				logChan = make(chan logs.Loggable)
+			})
+
+			It("returns a LoggingUnavailableError without connecting", func() {
+				go repo.TailLogsFor("app-guid", func() {}, logChan, errChan)
+
+				Eventually(errChan).Should(Receive(Equal(logs.LoggingUnavailableError{})))
+				Expect(fakeNoaaConsumer.TailingLogsCallCount()).To(BeZero())
+			})
+		})
+
 		Context("when an error occurs", func() {
 			var (
 				e       chan error
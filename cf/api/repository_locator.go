@@ -2,7 +2,6 @@ package api
 
 import (
 	"crypto/tls"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -31,6 +30,8 @@ import (
 	"code.cloudfoundry.org/cli/cf/net"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/util/cacert"
+	"code.cloudfoundry.org/cli/util/proxy"
 	"github.com/cloudfoundry/noaa/consumer"
 )
 
@@ -61,7 +62,8 @@ type RepositoryLocator struct {
 	logsRepo                        logs.Repository
 	authTokenRepo                   ServiceAuthTokenRepository
 	serviceBrokerRepo               ServiceBrokerRepository
-	servicePlanRepo                 CloudControllerServicePlanRepository
+	serviceBrokerCatalogRepo        ServiceBrokerCatalogRepository
+	servicePlanRepo                 ServicePlanRepository
 	servicePlanVisibilityRepo       ServicePlanVisibilityRepository
 	userProvidedServiceInstanceRepo UserProvidedServiceInstanceRepository
 	buildpackRepo                   BuildpackRepository
@@ -100,6 +102,7 @@ func NewRepositoryLocator(config coreconfig.ReadWriter, gatewaysByName map[strin
 	loc.endpointRepo = NewEndpointRepository(cloudControllerGateway)
 
 	tlsConfig := net.NewTLSConfig([]tls.Certificate{}, config.IsSSLDisabled())
+	tlsConfig.RootCAs = cacert.NewCertPool([]byte(config.CACert()))
 
 	var noaaRetryTimeout time.Duration
 	convertedTime, err := strconv.Atoi(envDialTimeout)
@@ -109,7 +112,10 @@ func NewRepositoryLocator(config coreconfig.ReadWriter, gatewaysByName map[strin
 		noaaRetryTimeout = time.Duration(convertedTime) * 3 * time.Second
 	}
 
-	consumer := consumer.New(config.DopplerEndpoint(), tlsConfig, http.ProxyFromEnvironment)
+	proxyFunc := proxy.FromEnvironment().ProxyFunc(func(message string) {
+		logger.Println(message)
+	})
+	consumer := consumer.New(config.DopplerEndpoint(), tlsConfig, proxyFunc)
 	consumer.SetDebugPrinter(terminal.DebugPrinter{Logger: logger})
 	loc.logsRepo = logs.NewNoaaLogsRepository(config, consumer, loc.authRepo, noaaRetryTimeout)
 
@@ -124,6 +130,7 @@ func NewRepositoryLocator(config coreconfig.ReadWriter, gatewaysByName map[strin
 	loc.serviceKeyRepo = NewCloudControllerServiceKeyRepository(config, cloudControllerGateway)
 	loc.serviceBindingRepo = NewCloudControllerServiceBindingRepository(config, cloudControllerGateway)
 	loc.serviceBrokerRepo = NewCloudControllerServiceBrokerRepository(config, cloudControllerGateway)
+	loc.serviceBrokerCatalogRepo = NewBrokerServiceBrokerCatalogRepository(tlsConfig, net.NewRequestDumper(logger))
 	loc.servicePlanRepo = NewCloudControllerServicePlanRepository(config, cloudControllerGateway)
 	loc.servicePlanVisibilityRepo = NewCloudControllerServicePlanVisibilityRepository(config, cloudControllerGateway)
 	loc.serviceSummaryRepo = NewCloudControllerServiceSummaryRepository(config, cloudControllerGateway)
@@ -372,6 +379,20 @@ func (locator RepositoryLocator) GetServiceBrokerRepository() ServiceBrokerRepos
 	return locator.serviceBrokerRepo
 }
 
+func (locator RepositoryLocator) SetServiceBrokerCatalogRepository(repo ServiceBrokerCatalogRepository) RepositoryLocator {
+	locator.serviceBrokerCatalogRepo = repo
+	return locator
+}
+
+func (locator RepositoryLocator) GetServiceBrokerCatalogRepository() ServiceBrokerCatalogRepository {
+	return locator.serviceBrokerCatalogRepo
+}
+
+func (locator RepositoryLocator) SetServicePlanRepository(repo ServicePlanRepository) RepositoryLocator {
+	locator.servicePlanRepo = repo
+	return locator
+}
+
 func (locator RepositoryLocator) GetServicePlanRepository() ServicePlanRepository {
 	return locator.servicePlanRepo
 }
@@ -38,9 +38,16 @@ type AppFileResource struct {
 	Mode string `json:"mode"`
 }
 
+// ApplicationMetadata is an app's metadata, which also records when the app
+// was last updated.
+type ApplicationMetadata struct {
+	Metadata
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
 type ApplicationResource struct {
-	Resource
-	Entity ApplicationEntity
+	Metadata ApplicationMetadata
+	Entity   ApplicationEntity
 }
 
 type DockerCredentials struct {
@@ -140,6 +147,7 @@ func NewApplicationEntityFromAppParams(app models.AppParams) ApplicationEntity {
 func (resource ApplicationResource) ToFields() (app models.ApplicationFields) {
 	entity := resource.Entity
 	app.GUID = resource.Metadata.GUID
+	app.UpdatedAt = resource.Metadata.UpdatedAt
 
 	if entity.Name != nil {
 		app.Name = *entity.Name
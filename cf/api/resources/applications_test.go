@@ -36,6 +36,24 @@ var _ = Describe("Application resources", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*applicationModel.PackageUpdatedAt).To(Equal(timestamp))
 		})
+
+		It("Adds an updatedAt timestamp", func() {
+			err := json.Unmarshal([]byte(`
+			{
+				"metadata": {
+					"guid":"application-1-guid",
+					"updated_at": "2013-10-07T16:51:07+00:00"
+				},
+				"entity": {}
+			}`), &resource)
+
+			Expect(err).NotTo(HaveOccurred())
+
+			applicationModel := resource.ToModel()
+			timestamp, err := time.Parse(eventTimestampFormat, "2013-10-07T16:51:07+00:00")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*applicationModel.UpdatedAt).To(Equal(timestamp))
+		})
 	})
 
 	Describe("NewApplicationEntityFromAppParams", func() {
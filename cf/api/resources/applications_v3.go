@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/models"
+)
+
+type V3ApplicationResource struct {
+	GUID      string `json:"guid"`
+	Name      string `json:"name"`
+	State     string `json:"state"`
+	Lifecycle struct {
+		Type string `json:"type"`
+		Data struct {
+			Buildpacks []string `json:"buildpacks"`
+			Stack      string   `json:"stack"`
+		} `json:"data"`
+	} `json:"lifecycle"`
+}
+
+func (resource V3ApplicationResource) ToModel() models.V3Application {
+	return models.V3Application{
+		GUID:          resource.GUID,
+		Name:          resource.Name,
+		State:         resource.State,
+		LifecycleType: resource.Lifecycle.Type,
+		Buildpacks:    resource.Lifecycle.Data.Buildpacks,
+		Stack:         resource.Lifecycle.Data.Stack,
+	}
+}
+
+type SidecarResource struct {
+	GUID         string   `json:"guid"`
+	Name         string   `json:"name"`
+	Command      string   `json:"command"`
+	ProcessTypes []string `json:"process_types"`
+	MemoryInMB   int64    `json:"memory_in_mb"`
+}
+
+type SidecarsResource struct {
+	Resources []SidecarResource `json:"resources"`
+}
+
+func (resource SidecarResource) ToModel() models.Sidecar {
+	return models.Sidecar{
+		GUID:         resource.GUID,
+		Name:         resource.Name,
+		Command:      resource.Command,
+		ProcessTypes: resource.ProcessTypes,
+		MemoryInMB:   resource.MemoryInMB,
+	}
+}
+
+type DropletResource struct {
+	GUID string `json:"guid"`
+}
+
+type RevisionResource struct {
+	GUID      string    `json:"guid"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type RevisionsResource struct {
+	Resources []RevisionResource `json:"resources"`
+}
+
+type RevisionEnvironmentResource struct {
+	Var map[string]interface{} `json:"var"`
+}
@@ -9,6 +9,7 @@ type BuildpackResource struct {
 
 type BuildpackEntity struct {
 	Name     string `json:"name"`
+	Stack    string `json:"stack,omitempty"`
 	Position *int   `json:"position,omitempty"`
 	Enabled  *bool  `json:"enabled,omitempty"`
 	Key      string `json:"key,omitempty"`
@@ -20,6 +21,7 @@ func (resource BuildpackResource) ToFields() models.Buildpack {
 	return models.Buildpack{
 		GUID:     resource.Metadata.GUID,
 		Name:     resource.Entity.Name,
+		Stack:    resource.Entity.Stack,
 		Position: resource.Entity.Position,
 		Enabled:  resource.Entity.Enabled,
 		Key:      resource.Entity.Key,
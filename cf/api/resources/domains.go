@@ -14,6 +14,7 @@ type DomainEntity struct {
 	RouterGroupGUID        string `json:"router_group_guid,omitempty"`
 	RouterGroupType        string `json:"router_group_type,omitempty"`
 	Wildcard               bool   `json:"wildcard"`
+	Internal               bool   `json:"internal,omitempty"`
 }
 
 func (resource DomainResource) ToFields() models.DomainFields {
@@ -25,5 +26,6 @@ func (resource DomainResource) ToFields() models.DomainFields {
 		Shared:                 !privateDomain,
 		RouterGroupGUID:        resource.Entity.RouterGroupGUID,
 		RouterGroupType:        resource.Entity.RouterGroupType,
+		Internal:               resource.Entity.Internal,
 	}
 }
@@ -0,0 +1,49 @@
+package resources
+
+import "code.cloudfoundry.org/cli/cf/models"
+
+type ProcessResource struct {
+	GUID       string `json:"guid"`
+	Type       string `json:"type"`
+	Instances  int    `json:"instances"`
+	MemoryInMB int64  `json:"memory_in_mb"`
+	DiskInMB   int64  `json:"disk_in_mb"`
+
+	HealthCheck struct {
+		Type string `json:"type"`
+		Data struct {
+			Endpoint string `json:"endpoint"`
+		} `json:"data"`
+	} `json:"health_check"`
+}
+
+type ProcessesResource struct {
+	Resources []ProcessResource `json:"resources"`
+}
+
+type ProcessScaleResource struct {
+	Instances  *int   `json:"instances,omitempty"`
+	MemoryInMB *int64 `json:"memory_in_mb,omitempty"`
+	DiskInMB   *int64 `json:"disk_in_mb,omitempty"`
+}
+
+func NewProcessScaleResourceFromAppParams(params models.AppParams) ProcessScaleResource {
+	return ProcessScaleResource{
+		Instances:  params.InstanceCount,
+		MemoryInMB: params.Memory,
+		DiskInMB:   params.DiskQuota,
+	}
+}
+
+func (resource ProcessResource) ToModel() models.Process {
+	return models.Process{
+		GUID:       resource.GUID,
+		Type:       resource.Type,
+		Instances:  resource.Instances,
+		MemoryInMB: resource.MemoryInMB,
+		DiskInMB:   resource.DiskInMB,
+
+		HealthCheckType:         resource.HealthCheck.Type,
+		HealthCheckHTTPEndpoint: resource.HealthCheck.Data.Endpoint,
+	}
+}
@@ -0,0 +1,35 @@
+package resources
+
+import "code.cloudfoundry.org/cli/cf/models"
+
+type RouteDestinationsResource struct {
+	Destinations []RouteDestinationResource `json:"destinations"`
+}
+
+type RouteDestinationResource struct {
+	GUID     string                      `json:"guid,omitempty"`
+	App      RouteDestinationAppResource `json:"app"`
+	Port     int                         `json:"port,omitempty"`
+	Protocol string                      `json:"protocol,omitempty"`
+}
+
+type RouteDestinationAppResource struct {
+	GUID string `json:"guid"`
+}
+
+func NewRouteDestinationResource(destination models.RouteDestination) RouteDestinationResource {
+	return RouteDestinationResource{
+		App:      RouteDestinationAppResource{GUID: destination.AppGUID},
+		Port:     destination.Port,
+		Protocol: destination.Protocol,
+	}
+}
+
+func (resource RouteDestinationResource) ToModel() models.RouteDestination {
+	return models.RouteDestination{
+		GUID:     resource.GUID,
+		AppGUID:  resource.App.GUID,
+		Port:     resource.Port,
+		Protocol: resource.Protocol,
+	}
+}
@@ -0,0 +1,45 @@
+package resources
+
+import "code.cloudfoundry.org/cli/cf/models"
+
+type RouteServiceBindingResource struct {
+	GUID          string `json:"guid"`
+	LastOperation struct {
+		Type        string `json:"type"`
+		State       string `json:"state"`
+		Description string `json:"description"`
+		CreatedAt   string `json:"created_at"`
+		UpdatedAt   string `json:"updated_at"`
+	} `json:"last_operation"`
+	Relationships struct {
+		Route struct {
+			Data struct {
+				GUID string `json:"guid"`
+			} `json:"data"`
+		} `json:"route"`
+		ServiceInstance struct {
+			Data struct {
+				GUID string `json:"guid"`
+			} `json:"data"`
+		} `json:"service_instance"`
+	} `json:"relationships"`
+}
+
+type RouteServiceBindingsResource struct {
+	Resources []RouteServiceBindingResource `json:"resources"`
+}
+
+func (resource RouteServiceBindingResource) ToModel() models.RouteServiceBinding {
+	return models.RouteServiceBinding{
+		GUID:                resource.GUID,
+		RouteGUID:           resource.Relationships.Route.Data.GUID,
+		ServiceInstanceGUID: resource.Relationships.ServiceInstance.Data.GUID,
+		LastOperation: models.LastOperationFields{
+			Type:        resource.LastOperation.Type,
+			State:       resource.LastOperation.State,
+			Description: resource.LastOperation.Description,
+			CreatedAt:   resource.LastOperation.CreatedAt,
+			UpdatedAt:   resource.LastOperation.UpdatedAt,
+		},
+	}
+}
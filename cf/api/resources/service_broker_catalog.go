@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/cf/models"
+)
+
+type ServiceBrokerCatalogResource struct {
+	Services []ServiceBrokerCatalogServiceResource `json:"services"`
+}
+
+type ServiceBrokerCatalogServiceResource struct {
+	ID          string                             `json:"id"`
+	Name        string                             `json:"name"`
+	Description string                             `json:"description"`
+	Plans       []ServiceBrokerCatalogPlanResource `json:"plans"`
+}
+
+type ServiceBrokerCatalogPlanResource struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Free        *bool  `json:"free"`
+}
+
+func (resource ServiceBrokerCatalogResource) ToModel() models.ServiceBrokerCatalog {
+	catalog := models.ServiceBrokerCatalog{}
+	for _, service := range resource.Services {
+		catalogService := models.ServiceBrokerCatalogService{
+			ID:          service.ID,
+			Name:        service.Name,
+			Description: service.Description,
+		}
+		for _, plan := range service.Plans {
+			catalogService.Plans = append(catalogService.Plans, models.ServiceBrokerCatalogPlan{
+				ID:          plan.ID,
+				Name:        plan.Name,
+				Description: plan.Description,
+				// Plans are free unless the broker says otherwise.
+				Free: plan.Free == nil || *plan.Free,
+			})
+		}
+		catalog.Services = append(catalog.Services, catalogService)
+	}
+	return catalog
+}
+
+// Validate returns the problems Cloud Foundry would report when importing
+// the catalog.
+func (resource ServiceBrokerCatalogResource) Validate() []string {
+	var problems []string
+	serviceIDs := map[string]bool{}
+	planIDs := map[string]bool{}
+
+	for index, service := range resource.Services {
+		serviceRef := service.Name
+		if serviceRef == "" {
+			serviceRef = "#" + strconv.Itoa(index+1)
+			problems = append(problems, "service "+serviceRef+": name is required")
+		}
+		if service.ID == "" {
+			problems = append(problems, "service "+serviceRef+": id is required")
+		} else if serviceIDs[service.ID] {
+			problems = append(problems, "service "+serviceRef+": id "+service.ID+" is not unique")
+		}
+		serviceIDs[service.ID] = true
+
+		if len(service.Plans) == 0 {
+			problems = append(problems, "service "+serviceRef+": at least one plan is required")
+		}
+		for planIndex, plan := range service.Plans {
+			planRef := plan.Name
+			if planRef == "" {
+				planRef = "#" + strconv.Itoa(planIndex+1)
+				problems = append(problems, "service "+serviceRef+" plan "+planRef+": name is required")
+			}
+			if plan.ID == "" {
+				problems = append(problems, "service "+serviceRef+" plan "+planRef+": id is required")
+			} else if planIDs[plan.ID] {
+				problems = append(problems, "service "+serviceRef+" plan "+planRef+": id "+plan.ID+" is not unique")
+			}
+			planIDs[plan.ID] = true
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,33 @@
+package resources
+
+import "code.cloudfoundry.org/cli/cf/models"
+
+type ServiceInstanceSharedFromResource struct {
+	SpaceGUID        string `json:"space_guid"`
+	SpaceName        string `json:"space_name"`
+	OrganizationName string `json:"organization_name"`
+}
+
+func (resource ServiceInstanceSharedFromResource) ToModel() models.ServiceInstanceSharedFrom {
+	return models.ServiceInstanceSharedFrom{
+		SpaceGUID:        resource.SpaceGUID,
+		SpaceName:        resource.SpaceName,
+		OrganizationName: resource.OrganizationName,
+	}
+}
+
+type ServiceInstanceSharedToResource struct {
+	SpaceGUID        string `json:"space_guid"`
+	SpaceName        string `json:"space_name"`
+	OrganizationName string `json:"organization_name"`
+	BoundAppCount    int    `json:"bound_app_count"`
+}
+
+func (resource ServiceInstanceSharedToResource) ToModel() models.ServiceInstanceSharedTo {
+	return models.ServiceInstanceSharedTo{
+		SpaceGUID:        resource.SpaceGUID,
+		SpaceName:        resource.SpaceName,
+		OrganizationName: resource.OrganizationName,
+		BoundAppCount:    resource.BoundAppCount,
+	}
+}
@@ -23,19 +23,23 @@ type LastOperation struct {
 type ServiceInstanceEntity struct {
 	Name            string                   `json:"name"`
 	DashboardURL    string                   `json:"dashboard_url"`
+	RouteServiceURL string                   `json:"route_service_url"`
 	Tags            []string                 `json:"tags"`
 	ServiceBindings []ServiceBindingResource `json:"service_bindings"`
 	ServiceKeys     []ServiceKeyResource     `json:"service_keys"`
 	ServicePlan     ServicePlanResource      `json:"service_plan"`
 	LastOperation   LastOperation            `json:"last_operation"`
+	MaintenanceInfo ServicePlanMaintenance   `json:"maintenance_info"`
 }
 
 func (resource ServiceInstanceResource) ToFields() models.ServiceInstanceFields {
 	return models.ServiceInstanceFields{
-		GUID:         resource.Metadata.GUID,
-		Name:         resource.Entity.Name,
-		Tags:         resource.Entity.Tags,
-		DashboardURL: resource.Entity.DashboardURL,
+		GUID:               resource.Metadata.GUID,
+		Name:               resource.Entity.Name,
+		Tags:               resource.Entity.Tags,
+		DashboardURL:       resource.Entity.DashboardURL,
+		RouteServiceURL:    resource.Entity.RouteServiceURL,
+		MaintenanceVersion: resource.Entity.MaintenanceInfo.Version,
 		LastOperation: models.LastOperationFields{
 			Type:        resource.Entity.LastOperation.Type,
 			State:       resource.Entity.LastOperation.State,
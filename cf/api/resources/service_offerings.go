@@ -18,10 +18,12 @@ type ServiceOfferingResource struct {
 
 type ServiceOfferingEntity struct {
 	Label        string                `json:"label"`
+	UniqueID     string                `json:"unique_id"`
 	Version      string                `json:"version"`
 	Description  string                `json:"description"`
 	Provider     string                `json:"provider"`
 	BrokerGUID   string                `json:"service_broker_guid"`
+	BrokerName   string                `json:"service_broker_name"`
 	Requires     []string              `json:"requires"`
 	ServicePlans []ServicePlanResource `json:"service_plans"`
 	Extra        ServiceOfferingExtra
@@ -38,7 +40,9 @@ func (resource ServiceOfferingResource) ToFields() models.ServiceOfferingFields
 		Provider:         resource.Entity.Provider,
 		Description:      resource.Entity.Description,
 		BrokerGUID:       resource.Entity.BrokerGUID,
+		BrokerName:       resource.Entity.BrokerName,
 		GUID:             resource.Metadata.GUID,
+		UniqueID:         resource.Entity.UniqueID,
 		DocumentationURL: resource.Entity.Extra.DocumentationURL,
 		Requires:         resource.Entity.Requires,
 	}
@@ -1,7 +1,9 @@
 package resources
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"code.cloudfoundry.org/cli/cf/models"
 )
@@ -17,8 +19,25 @@ type ServicePlanEntity struct {
 	Public              bool
 	Active              bool
 	Description         string                  `json:"description"`
+	UniqueID            string                  `json:"unique_id"`
 	ServiceOfferingGUID string                  `json:"service_guid"`
 	ServiceOffering     ServiceOfferingResource `json:"service"`
+	Extra               ServicePlanExtra        `json:"extra"`
+	MaintenanceInfo     ServicePlanMaintenance  `json:"maintenance_info"`
+}
+
+type ServicePlanExtra struct {
+	Costs []ServicePlanCost `json:"costs"`
+}
+
+type ServicePlanCost struct {
+	Amount map[string]float64 `json:"amount"`
+	Unit   string             `json:"unit"`
+}
+
+type ServicePlanMaintenance struct {
+	Version     string `json:"version"`
+	Description string `json:"description"`
 }
 
 type ServicePlanDescription struct {
@@ -29,12 +48,21 @@ type ServicePlanDescription struct {
 
 func (resource ServicePlanResource) ToFields() (fields models.ServicePlanFields) {
 	fields.GUID = resource.Metadata.GUID
+	fields.UniqueID = resource.Entity.UniqueID
 	fields.Name = resource.Entity.Name
 	fields.Free = resource.Entity.Free
 	fields.Description = resource.Entity.Description
 	fields.Public = resource.Entity.Public
 	fields.Active = resource.Entity.Active
 	fields.ServiceOfferingGUID = resource.Entity.ServiceOfferingGUID
+	fields.MaintenanceVersion = resource.Entity.MaintenanceInfo.Version
+	fields.MaintenanceDescription = resource.Entity.MaintenanceInfo.Description
+	for _, cost := range resource.Entity.Extra.Costs {
+		fields.Costs = append(fields.Costs, models.ServicePlanCost{
+			Amount: cost.Amount,
+			Unit:   cost.Unit,
+		})
+	}
 	return
 }
 
@@ -45,6 +73,31 @@ func (planDesc ServicePlanDescription) String() string {
 	return fmt.Sprintf("%s %s %s", planDesc.ServiceLabel, planDesc.ServiceProvider, planDesc.ServicePlanName) // v1 plan
 }
 
+type servicePlanExtra ServicePlanExtra
+
+// UnmarshalJSON decodes the plan's extra field, which the Cloud Controller
+// returns as a JSON-encoded string provided by the service broker. Extra
+// values that are not valid JSON are ignored.
+func (resource *ServicePlanExtra) UnmarshalJSON(rawData []byte) error {
+	if string(rawData) == "null" {
+		return nil
+	}
+
+	unquoted, err := strconv.Unquote(string(rawData))
+	if err != nil {
+		return err
+	}
+
+	extra := servicePlanExtra{}
+	if json.Unmarshal([]byte(unquoted), &extra) != nil {
+		return nil
+	}
+
+	*resource = ServicePlanExtra(extra)
+
+	return nil
+}
+
 type ServiceMigrateV1ToV2Response struct {
 	ChangedCount int `json:"changed_count"`
 }
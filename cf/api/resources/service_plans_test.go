@@ -0,0 +1,83 @@
+package resources_test
+
+import (
+	"encoding/json"
+
+	. "code.cloudfoundry.org/cli/cf/api/resources"
+	"code.cloudfoundry.org/cli/cf/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServicePlanResource", func() {
+	var resource ServicePlanResource
+
+	BeforeEach(func() {
+		resource = ServicePlanResource{}
+	})
+
+	Describe("#ToFields", func() {
+		Context("when the plan has costs and maintenance info", func() {
+			BeforeEach(func() {
+				err := json.Unmarshal([]byte(`
+    {
+      "metadata": {
+        "guid": "fake-plan-guid"
+      },
+      "entity": {
+        "name": "fake-plan-name",
+        "free": false,
+        "description": "fake-description",
+        "service_guid": "fake-service-guid",
+        "extra": "{\"costs\":[{\"amount\":{\"usd\":99.5,\"eur\":89},\"unit\":\"MONTHLY\"}]}",
+        "maintenance_info": {
+          "version": "2.1.0"
+        }
+      }
+    }`), &resource)
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("unmarshalls the costs and maintenance version", func() {
+				fields := resource.ToFields()
+
+				Expect(fields.GUID).To(Equal("fake-plan-guid"))
+				Expect(fields.Name).To(Equal("fake-plan-name"))
+				Expect(fields.Free).To(BeFalse())
+				Expect(fields.ServiceOfferingGUID).To(Equal("fake-service-guid"))
+				Expect(fields.MaintenanceVersion).To(Equal("2.1.0"))
+				Expect(fields.Costs).To(Equal([]models.ServicePlanCost{
+					{Amount: map[string]float64{"usd": 99.5, "eur": 89}, Unit: "MONTHLY"},
+				}))
+			})
+		})
+
+		Context("when the plan extra is not valid JSON", func() {
+			BeforeEach(func() {
+				err := json.Unmarshal([]byte(`
+    {
+      "metadata": {
+        "guid": "fake-plan-guid"
+      },
+      "entity": {
+        "name": "fake-plan-name",
+        "free": true,
+        "extra": "not json"
+      }
+    }`), &resource)
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("ignores the extra field", func() {
+				fields := resource.ToFields()
+
+				Expect(fields.Free).To(BeTrue())
+				Expect(fields.Costs).To(BeEmpty())
+				Expect(fields.MaintenanceVersion).To(BeEmpty())
+			})
+		})
+	})
+})
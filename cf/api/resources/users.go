@@ -16,6 +16,7 @@ type UAAUserResources struct {
 	Resources []struct {
 		ID       string
 		Username string
+		Origin   string
 	}
 }
 
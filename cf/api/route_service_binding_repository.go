@@ -5,9 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"strings"
 
+	"code.cloudfoundry.org/cli/cf/api/resources"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/net"
 )
 
@@ -15,7 +19,8 @@ import (
 
 type RouteServiceBindingRepository interface {
 	Bind(instanceGUID, routeGUID string, userProvided bool, parameters string) error
-	Unbind(instanceGUID, routeGUID string, userProvided bool) error
+	Unbind(instanceGUID, routeGUID string, userProvided bool, parameters string) error
+	ListForRoutes(routeGUIDs []string) ([]models.RouteServiceBinding, error)
 }
 
 type CloudControllerRouteServiceBindingRepository struct {
@@ -36,23 +41,9 @@ func (repo CloudControllerRouteServiceBindingRepository) Bind(
 	userProvided bool,
 	opaqueParams string,
 ) error {
-	var rs io.ReadSeeker
-	if opaqueParams != "" {
-		opaqueJSON := json.RawMessage(opaqueParams)
-		s := struct {
-			Parameters *json.RawMessage `json:"parameters"`
-		}{
-			&opaqueJSON,
-		}
-
-		jsonBytes, err := json.Marshal(s)
-		if err != nil {
-			return err
-		}
-
-		rs = bytes.NewReader(jsonBytes)
-	} else {
-		rs = strings.NewReader("")
+	rs, err := parametersBody(opaqueParams)
+	if err != nil {
+		return err
 	}
 
 	return repo.gateway.UpdateResourceSync(
@@ -62,9 +53,62 @@ func (repo CloudControllerRouteServiceBindingRepository) Bind(
 	)
 }
 
-func (repo CloudControllerRouteServiceBindingRepository) Unbind(instanceGUID, routeGUID string, userProvided bool) error {
+func (repo CloudControllerRouteServiceBindingRepository) Unbind(instanceGUID, routeGUID string, userProvided bool, opaqueParams string) error {
 	path := getPath(instanceGUID, routeGUID, userProvided)
-	return repo.gateway.DeleteResource(repo.config.APIEndpoint(), path)
+	if opaqueParams == "" {
+		return repo.gateway.DeleteResource(repo.config.APIEndpoint(), path)
+	}
+
+	rs, err := parametersBody(opaqueParams)
+	if err != nil {
+		return err
+	}
+	return repo.gateway.DeleteResourceWithBody(repo.config.APIEndpoint(), path, rs)
+}
+
+// ListForRoutes returns the route service bindings of the given routes,
+// including the state of their last operation. It returns no bindings when
+// the Cloud Controller does not report route service bindings.
+func (repo CloudControllerRouteServiceBindingRepository) ListForRoutes(routeGUIDs []string) ([]models.RouteServiceBinding, error) {
+	if len(routeGUIDs) == 0 {
+		return []models.RouteServiceBinding{}, nil
+	}
+
+	path := fmt.Sprintf("%s/v3/service_route_bindings?route_guids=%s&per_page=5000", repo.config.APIEndpoint(), strings.Join(routeGUIDs, ","))
+	resource := new(resources.RouteServiceBindingsResource)
+
+	err := repo.gateway.GetResource(path, resource)
+	if httpErr, ok := err.(errors.HTTPError); ok && httpErr.StatusCode() == http.StatusNotFound {
+		return []models.RouteServiceBinding{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := []models.RouteServiceBinding{}
+	for _, binding := range resource.Resources {
+		bindings = append(bindings, binding.ToModel())
+	}
+	return bindings, nil
+}
+
+func parametersBody(opaqueParams string) (io.ReadSeeker, error) {
+	if opaqueParams == "" {
+		return strings.NewReader(""), nil
+	}
+
+	opaqueJSON := json.RawMessage(opaqueParams)
+	s := struct {
+		Parameters *json.RawMessage `json:"parameters"`
+	}{
+		&opaqueJSON,
+	}
+
+	jsonBytes, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(jsonBytes), nil
 }
 
 func getPath(instanceGUID, routeGUID string, userProvided bool) string {
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/net"
 
 	"code.cloudfoundry.org/cli/cf/terminal/terminalfakes"
@@ -128,7 +129,7 @@ var _ = Describe("RouteServiceBindingsRepository", func() {
 					ghttp.RespondWith(http.StatusNoContent, nil),
 				),
 			)
-			err := routeServiceBindingRepo.Unbind(serviceInstanceGUID, routeGUID, false)
+			err := routeServiceBindingRepo.Unbind(serviceInstanceGUID, routeGUID, false, "")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
 		})
@@ -140,7 +141,20 @@ var _ = Describe("RouteServiceBindingsRepository", func() {
 					ghttp.RespondWith(http.StatusNoContent, nil),
 				),
 			)
-			err := routeServiceBindingRepo.Unbind(serviceInstanceGUID, routeGUID, true)
+			err := routeServiceBindingRepo.Unbind(serviceInstanceGUID, routeGUID, true, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("deletes the service binding with the provided body wrapped in parameters", func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", fmt.Sprintf("/v2/service_instances/%s/routes/%s", serviceInstanceGUID, routeGUID)),
+					ghttp.VerifyJSON(`{"parameters":{"some":"json"}}`),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+			err := routeServiceBindingRepo.Unbind(serviceInstanceGUID, routeGUID, false, `{"some":"json"}`)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
 		})
@@ -156,7 +170,7 @@ var _ = Describe("RouteServiceBindingsRepository", func() {
 			})
 
 			It("returns an HTTPError", func() {
-				err := routeServiceBindingRepo.Unbind(serviceInstanceGUID, routeGUID, false)
+				err := routeServiceBindingRepo.Unbind(serviceInstanceGUID, routeGUID, false, "")
 				Expect(err).To(HaveOccurred())
 				httpErr, ok := err.(errors.HTTPError)
 				Expect(ok).To(BeTrue())
@@ -166,4 +180,56 @@ var _ = Describe("RouteServiceBindingsRepository", func() {
 			})
 		})
 	})
+
+	Describe("ListForRoutes", func() {
+		It("returns the bindings of the routes with their last operation", func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v3/service_route_bindings", "route_guids=route-1-guid,route-2-guid&per_page=5000"),
+					ghttp.RespondWith(http.StatusOK, `{"resources":[{
+						"guid":"binding-guid",
+						"last_operation":{"type":"create","state":"in progress","description":"configuring"},
+						"relationships":{
+							"route":{"data":{"guid":"route-1-guid"}},
+							"service_instance":{"data":{"guid":"service-instance-guid"}}
+						}
+					}]}`),
+				),
+			)
+
+			bindings, err := routeServiceBindingRepo.ListForRoutes([]string{"route-1-guid", "route-2-guid"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bindings).To(Equal([]models.RouteServiceBinding{{
+				GUID:                "binding-guid",
+				RouteGUID:           "route-1-guid",
+				ServiceInstanceGUID: "service-instance-guid",
+				LastOperation: models.LastOperationFields{
+					Type:        "create",
+					State:       "in progress",
+					Description: "configuring",
+				},
+			}}))
+		})
+
+		It("does not make a request when there are no routes", func() {
+			bindings, err := routeServiceBindingRepo.ListForRoutes(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bindings).To(BeEmpty())
+			Expect(ccServer.ReceivedRequests()).To(BeEmpty())
+		})
+
+		Context("when the Cloud Controller does not report route service bindings", func() {
+			BeforeEach(func() {
+				ccServer.AppendHandlers(
+					ghttp.RespondWith(http.StatusNotFound, `{"errors":[{"code":10000,"title":"CF-NotFound"}]}`),
+				)
+			})
+
+			It("returns no bindings", func() {
+				bindings, err := routeServiceBindingRepo.ListForRoutes([]string{"route-1-guid"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bindings).To(BeEmpty())
+			})
+		})
+	})
 })
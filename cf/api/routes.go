@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/url"
 	"strings"
 
@@ -27,6 +28,9 @@ type RouteRepository interface {
 	Bind(routeGUID, appGUID string) (apiErr error)
 	Unbind(routeGUID, appGUID string) (apiErr error)
 	Delete(routeGUID string) (apiErr error)
+	ListDestinations(routeGUID string) ([]models.RouteDestination, error)
+	AddDestination(routeGUID string, destination models.RouteDestination) error
+	ReplaceDestinations(routeGUID string, destinations []models.RouteDestination) error
 }
 
 type CloudControllerRouteRepository struct {
@@ -206,3 +210,41 @@ func (repo CloudControllerRouteRepository) Delete(routeGUID string) (apiErr erro
 	path := fmt.Sprintf("/v2/routes/%s", routeGUID)
 	return repo.gateway.DeleteResource(repo.config.APIEndpoint(), path)
 }
+
+func (repo CloudControllerRouteRepository) ListDestinations(routeGUID string) ([]models.RouteDestination, error) {
+	resource := new(resources.RouteDestinationsResource)
+	err := repo.gateway.GetResource(fmt.Sprintf("%s/v3/routes/%s/destinations", repo.config.APIEndpoint(), routeGUID), resource)
+	if err != nil {
+		return nil, err
+	}
+
+	destinations := []models.RouteDestination{}
+	for _, destinationResource := range resource.Destinations {
+		destinations = append(destinations, destinationResource.ToModel())
+	}
+	return destinations, nil
+}
+
+func (repo CloudControllerRouteRepository) AddDestination(routeGUID string, destination models.RouteDestination) error {
+	return repo.writeDestinations(routeGUID, []models.RouteDestination{destination}, repo.gateway.CreateResource)
+}
+
+// ReplaceDestinations replaces every destination of the route with the given
+// list; destinations that are left out are unmapped.
+func (repo CloudControllerRouteRepository) ReplaceDestinations(routeGUID string, destinations []models.RouteDestination) error {
+	return repo.writeDestinations(routeGUID, destinations, repo.gateway.PatchResource)
+}
+
+func (repo CloudControllerRouteRepository) writeDestinations(routeGUID string, destinations []models.RouteDestination, write func(string, string, io.ReadSeeker, ...interface{}) error) error {
+	body := resources.RouteDestinationsResource{Destinations: []resources.RouteDestinationResource{}}
+	for _, destination := range destinations {
+		body.Destinations = append(body.Destinations, resources.NewRouteDestinationResource(destination))
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	return write(repo.config.APIEndpoint(), fmt.Sprintf("/v3/routes/%s/destinations", routeGUID), bytes.NewReader(data))
+}
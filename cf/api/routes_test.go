@@ -644,6 +644,87 @@ var _ = Describe("route repository", func() {
 
 	})
 
+	Describe("route destinations", func() {
+		var ccServer *ghttp.Server
+		BeforeEach(func() {
+			ccServer = ghttp.NewServer()
+			configRepo.SetAPIEndpoint(ccServer.URL())
+		})
+
+		AfterEach(func() {
+			if ccServer != nil {
+				ccServer.Close()
+			}
+		})
+
+		Describe("ListDestinations", func() {
+			BeforeEach(func() {
+				ccServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/v3/routes/my-route-guid/destinations"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"destinations": [
+								{ "guid": "destination-1-guid", "app": { "guid": "app-1-guid" }, "port": 8080, "protocol": "http2" },
+								{ "guid": "destination-2-guid", "app": { "guid": "app-2-guid" }, "port": 8080, "protocol": "http1" }
+							]
+						}`),
+					),
+				)
+			})
+
+			It("returns the route's destinations", func() {
+				destinations, err := repo.ListDestinations("my-route-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(destinations).To(Equal([]models.RouteDestination{
+					{GUID: "destination-1-guid", AppGUID: "app-1-guid", Port: 8080, Protocol: "http2"},
+					{GUID: "destination-2-guid", AppGUID: "app-2-guid", Port: 8080, Protocol: "http1"},
+				}))
+			})
+		})
+
+		Describe("AddDestination", func() {
+			BeforeEach(func() {
+				ccServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/v3/routes/my-route-guid/destinations"),
+						ghttp.VerifyJSON(`{"destinations": [{ "app": { "guid": "app-1-guid" }, "protocol": "http2" }]}`),
+						ghttp.RespondWith(http.StatusOK, `{"destinations": []}`),
+					),
+				)
+			})
+
+			It("adds the destination to the route", func() {
+				err := repo.AddDestination("my-route-guid", models.RouteDestination{AppGUID: "app-1-guid", Protocol: "http2"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Describe("ReplaceDestinations", func() {
+			BeforeEach(func() {
+				ccServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PATCH", "/v3/routes/my-route-guid/destinations"),
+						ghttp.VerifyJSON(`{"destinations": [
+							{ "app": { "guid": "app-1-guid" }, "port": 8080, "protocol": "http2" },
+							{ "app": { "guid": "app-2-guid" }, "protocol": "http1" }
+						]}`),
+						ghttp.RespondWith(http.StatusOK, `{"destinations": []}`),
+					),
+				)
+			})
+
+			It("replaces the route's destinations", func() {
+				err := repo.ReplaceDestinations("my-route-guid", []models.RouteDestination{
+					{GUID: "destination-1-guid", AppGUID: "app-1-guid", Port: 8080, Protocol: "http2"},
+					{AppGUID: "app-2-guid", Protocol: "http1"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+	})
+
 	Describe("Delete routes", func() {
 		It("deletes routes", func() {
 			ts, handler = testnet.NewServer([]testnet.TestRequest{
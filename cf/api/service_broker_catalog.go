@@ -0,0 +1,82 @@
+package api
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/api/resources"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/net"
+)
+
+// brokerAPIVersion is the Open Service Broker API version sent when fetching
+// a catalog directly from a broker.
+const brokerAPIVersion = "2.13"
+
+//go:generate counterfeiter . ServiceBrokerCatalogRepository
+
+type ServiceBrokerCatalogRepository interface {
+	FetchCatalog(brokerURL, username, password string) (catalog models.ServiceBrokerCatalog, apiErr error)
+}
+
+type BrokerServiceBrokerCatalogRepository struct {
+	httpClient net.HTTPClientInterface
+}
+
+func NewBrokerServiceBrokerCatalogRepository(tlsConfig *tls.Config, dumper net.RequestDumper) (repo BrokerServiceBrokerCatalogRepository) {
+	repo.httpClient = net.NewHTTPClient(&http.Transport{
+		TLSClientConfig: tlsConfig,
+		Proxy:           http.ProxyFromEnvironment,
+	}, dumper)
+	return
+}
+
+func (repo BrokerServiceBrokerCatalogRepository) FetchCatalog(brokerURL, username, password string) (models.ServiceBrokerCatalog, error) {
+	catalogURL := strings.TrimSuffix(brokerURL, "/") + "/v2/catalog"
+	request, err := http.NewRequest("GET", catalogURL, nil)
+	if err != nil {
+		return models.ServiceBrokerCatalog{}, err
+	}
+	request.SetBasicAuth(username, password)
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("X-Broker-API-Version", brokerAPIVersion)
+
+	repo.httpClient.DumpRequest(request)
+	response, err := repo.httpClient.Do(request)
+	if err != nil {
+		return models.ServiceBrokerCatalog{}, net.WrapNetworkErrors(request.URL.Host, err)
+	}
+	defer response.Body.Close()
+	repo.httpClient.DumpResponse(response)
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return models.ServiceBrokerCatalog{}, err
+	}
+
+	switch {
+	case response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden:
+		return models.ServiceBrokerCatalog{}, errors.NewServiceBrokerAuthenticationError(brokerURL, response.StatusCode)
+	case response.StatusCode != http.StatusOK:
+		return models.ServiceBrokerCatalog{}, errors.NewHTTPError(response.StatusCode, "", string(body))
+	}
+
+	var resource resources.ServiceBrokerCatalogResource
+	err = json.Unmarshal(body, &resource)
+	if err != nil {
+		return models.ServiceBrokerCatalog{}, errors.NewServiceBrokerCatalogValidationError([]string{
+			fmt.Sprintf("response is not valid JSON: %s", err.Error()),
+		})
+	}
+
+	if problems := resource.Validate(); len(problems) > 0 {
+		return models.ServiceBrokerCatalog{}, errors.NewServiceBrokerCatalogValidationError(problems)
+	}
+
+	return resource.ToModel(), nil
+}
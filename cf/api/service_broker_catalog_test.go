@@ -0,0 +1,136 @@
+package api_test
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ServiceBrokerCatalogRepository", func() {
+	var (
+		broker *ghttp.Server
+		repo   BrokerServiceBrokerCatalogRepository
+	)
+
+	BeforeEach(func() {
+		broker = ghttp.NewServer()
+		repo = NewBrokerServiceBrokerCatalogRepository(net.NewTLSConfig([]tls.Certificate{}, true), net.NewRequestDumper(new(tracefakes.FakePrinter)))
+	})
+
+	AfterEach(func() {
+		broker.Close()
+	})
+
+	Describe("FetchCatalog", func() {
+		Context("when the broker returns a valid catalog", func() {
+			BeforeEach(func() {
+				broker.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/v2/catalog"),
+						ghttp.VerifyBasicAuth("broker-user", "broker-password"),
+						ghttp.VerifyHeaderKV("X-Broker-API-Version", "2.13"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"services": [
+								{
+									"id": "mysql-id",
+									"name": "mysql",
+									"description": "MySQL databases",
+									"plans": [
+										{"id": "small-id", "name": "small", "description": "A small database"},
+										{"id": "large-id", "name": "large", "description": "A large database", "free": false}
+									]
+								}
+							]
+						}`),
+					),
+				)
+			})
+
+			It("returns the catalog", func() {
+				catalog, err := repo.FetchCatalog(broker.URL()+"/", "broker-user", "broker-password")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(broker.ReceivedRequests()).To(HaveLen(1))
+				Expect(catalog).To(Equal(models.ServiceBrokerCatalog{
+					Services: []models.ServiceBrokerCatalogService{
+						{
+							ID:          "mysql-id",
+							Name:        "mysql",
+							Description: "MySQL databases",
+							Plans: []models.ServiceBrokerCatalogPlan{
+								{ID: "small-id", Name: "small", Description: "A small database", Free: true},
+								{ID: "large-id", Name: "large", Description: "A large database", Free: false},
+							},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when the broker rejects the credentials", func() {
+			BeforeEach(func() {
+				broker.AppendHandlers(ghttp.RespondWith(http.StatusUnauthorized, `{}`))
+			})
+
+			It("returns a ServiceBrokerAuthenticationError", func() {
+				_, err := repo.FetchCatalog(broker.URL(), "broker-user", "wrong-password")
+				Expect(err).To(MatchError(errors.NewServiceBrokerAuthenticationError(broker.URL(), http.StatusUnauthorized)))
+			})
+		})
+
+		Context("when the broker returns another error status", func() {
+			BeforeEach(func() {
+				broker.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, `something broke`))
+			})
+
+			It("returns an HTTPError", func() {
+				_, err := repo.FetchCatalog(broker.URL(), "broker-user", "broker-password")
+				Expect(err).To(HaveOccurred())
+				httpErr, ok := err.(errors.HTTPError)
+				Expect(ok).To(BeTrue())
+				Expect(httpErr.StatusCode()).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		Context("when the catalog is not valid JSON", func() {
+			BeforeEach(func() {
+				broker.AppendHandlers(ghttp.RespondWith(http.StatusOK, `<html></html>`))
+			})
+
+			It("returns a ServiceBrokerCatalogValidationError", func() {
+				_, err := repo.FetchCatalog(broker.URL(), "broker-user", "broker-password")
+				Expect(err).To(BeAssignableToTypeOf(&errors.ServiceBrokerCatalogValidationError{}))
+				Expect(err.Error()).To(ContainSubstring("response is not valid JSON"))
+			})
+		})
+
+		Context("when the catalog would be rejected by Cloud Foundry", func() {
+			BeforeEach(func() {
+				broker.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{
+					"services": [
+						{"id": "mysql-id", "name": "mysql", "plans": []},
+						{"id": "mysql-id", "name": "other", "plans": [{"name": "small"}]}
+					]
+				}`))
+			})
+
+			It("returns every validation problem", func() {
+				_, err := repo.FetchCatalog(broker.URL(), "broker-user", "broker-password")
+				Expect(err).To(BeAssignableToTypeOf(&errors.ServiceBrokerCatalogValidationError{}))
+				Expect(err.(*errors.ServiceBrokerCatalogValidationError).Problems).To(ConsistOf(
+					"service mysql: at least one plan is required",
+					"service other: id mysql-id is not unique",
+					"service other plan small: id is required",
+				))
+			})
+		})
+	})
+})
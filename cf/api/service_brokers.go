@@ -95,13 +95,24 @@ func (repo CloudControllerServiceBrokerRepository) Create(name, url, username, p
 	return repo.gateway.CreateResource(repo.config.APIEndpoint(), path, bytes.NewReader(bs))
 }
 
+// Update changes the URL and credentials of the service broker. Only the
+// fields that are set are sent, leaving the others unchanged.
 func (repo CloudControllerServiceBrokerRepository) Update(serviceBroker models.ServiceBroker) (apiErr error) {
 	path := fmt.Sprintf("/v2/service_brokers/%s", serviceBroker.GUID)
-	body := fmt.Sprintf(
-		`{"broker_url":"%s","auth_username":"%s","auth_password":"%s"}`,
-		serviceBroker.URL, serviceBroker.Username, serviceBroker.Password,
-	)
-	return repo.gateway.UpdateResource(repo.config.APIEndpoint(), path, strings.NewReader(body))
+	args := struct {
+		URL      string `json:"broker_url,omitempty"`
+		Username string `json:"auth_username,omitempty"`
+		Password string `json:"auth_password,omitempty"`
+	}{
+		serviceBroker.URL,
+		serviceBroker.Username,
+		serviceBroker.Password,
+	}
+	bs, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+	return repo.gateway.UpdateResource(repo.config.APIEndpoint(), path, bytes.NewReader(bs))
 }
 
 func (repo CloudControllerServiceBrokerRepository) Rename(guid, name string) (apiErr error) {
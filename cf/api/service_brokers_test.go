@@ -306,6 +306,23 @@ var _ = Describe("Service Brokers Repo", func() {
 			Expect(handler).To(HaveAllRequestsCalled())
 			Expect(apiErr).NotTo(HaveOccurred())
 		})
+
+		It("only sends the fields that are set", func() {
+			req := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "PUT",
+				Path:     "/v2/service_brokers/my-guid",
+				Matcher:  testnet.RequestBodyMatcher(`{"auth_password":"update-password"}`),
+				Response: testnet.TestResponse{Status: http.StatusOK},
+			})
+
+			ts, handler, repo := createServiceBrokerRepo(req)
+			defer ts.Close()
+
+			apiErr := repo.Update(models.ServiceBroker{GUID: "my-guid", Password: "update-password"})
+
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+		})
 	})
 
 	Describe("Rename", func() {
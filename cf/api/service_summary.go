@@ -22,18 +22,23 @@ func (resource ServiceInstancesSummaries) ToModels() []models.ServiceInstance {
 		servicePlan := models.ServicePlanFields{}
 		servicePlan.Name = planSummary.Name
 		servicePlan.GUID = planSummary.GUID
+		servicePlan.MaintenanceVersion = planSummary.MaintenanceInfo.Version
 
 		offeringSummary := planSummary.ServiceOffering
 		serviceOffering := models.ServiceOfferingFields{}
+		serviceOffering.GUID = offeringSummary.GUID
 		serviceOffering.Label = offeringSummary.Label
 		serviceOffering.Provider = offeringSummary.Provider
 		serviceOffering.Version = offeringSummary.Version
 
 		instance := models.ServiceInstance{}
+		instance.GUID = instanceSummary.GUID
 		instance.Name = instanceSummary.Name
 		instance.LastOperation.Type = instanceSummary.LastOperation.Type
 		instance.LastOperation.State = instanceSummary.LastOperation.State
 		instance.LastOperation.Description = instanceSummary.LastOperation.Description
+		instance.LastOperation.UpdatedAt = instanceSummary.LastOperation.UpdatedAt
+		instance.MaintenanceVersion = instanceSummary.MaintenanceInfo.Version
 		instance.ApplicationNames = applicationNames
 		instance.ServicePlan = servicePlan
 		instance.ServiceOffering = serviceOffering
@@ -66,21 +71,30 @@ type LastOperationSummary struct {
 	Type        string `json:"type"`
 	State       string `json:"state"`
 	Description string `json:"description"`
+	UpdatedAt   string `json:"updated_at"`
 }
 
 type ServiceInstanceSummary struct {
-	Name          string
-	LastOperation LastOperationSummary `json:"last_operation"`
-	ServicePlan   ServicePlanSummary   `json:"service_plan"`
+	GUID            string
+	Name            string
+	LastOperation   LastOperationSummary   `json:"last_operation"`
+	ServicePlan     ServicePlanSummary     `json:"service_plan"`
+	MaintenanceInfo MaintenanceInfoSummary `json:"maintenance_info"`
 }
 
 type ServicePlanSummary struct {
 	Name            string
 	GUID            string
 	ServiceOffering ServiceOfferingSummary `json:"service"`
+	MaintenanceInfo MaintenanceInfoSummary `json:"maintenance_info"`
+}
+
+type MaintenanceInfoSummary struct {
+	Version string `json:"version"`
 }
 
 type ServiceOfferingSummary struct {
+	GUID     string
 	Label    string
 	Provider string
 	Version  string
@@ -45,11 +45,18 @@ var _ = Describe("ServiceSummaryRepository", func() {
 					  "last_operation": {
 						  "type": "create",
 						  "state": "in progress",
-							"description": "50% done"
+							"description": "50% done",
+							"updated_at": "2017-06-01T10:00:00Z"
 					  },
+						"maintenance_info": {
+							"version": "1.0.0"
+						},
 						"service_plan": {
 							"guid": "service-plan-guid",
 							"name": "spark",
+							"maintenance_info": {
+								"version": "2.0.0"
+							},
 							"service": {
 								"guid": "service-offering-guid",
 								"label": "cleardb",
@@ -80,12 +87,16 @@ var _ = Describe("ServiceSummaryRepository", func() {
 		Expect(1).To(Equal(len(serviceInstances)))
 
 		instance1 := serviceInstances[0]
+		Expect(instance1.GUID).To(Equal("my-service-instance-guid"))
 		Expect(instance1.Name).To(Equal("my-service-instance"))
 		Expect(instance1.LastOperation.Type).To(Equal("create"))
 		Expect(instance1.LastOperation.State).To(Equal("in progress"))
 		Expect(instance1.LastOperation.Description).To(Equal("50% done"))
+		Expect(instance1.LastOperation.UpdatedAt).To(Equal("2017-06-01T10:00:00Z"))
 		Expect(instance1.ServicePlan.Name).To(Equal("spark"))
-		Expect(instance1.ServiceOffering.Label).To(Equal("cleardb"))
+		Expect(instance1.ServicePlan.MaintenanceVersion).To(Equal("2.0.0"))
+		Expect(instance1.MaintenanceVersion).To(Equal("1.0.0"))
+		Expect(instance1.ServiceOffering.GUID).To(Equal("service-offering-guid"))
 		Expect(instance1.ServiceOffering.Label).To(Equal("cleardb"))
 		Expect(instance1.ServiceOffering.Provider).To(Equal("cleardb-provider"))
 		Expect(instance1.ServiceOffering.Version).To(Equal("n/a"))
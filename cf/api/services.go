@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -30,13 +31,19 @@ type ServiceRepository interface {
 	PurgeServiceInstance(instance models.ServiceInstance) error
 	CreateServiceInstance(name, planGUID string, params map[string]interface{}, tags []string) (apiErr error)
 	UpdateServiceInstance(instanceGUID, planGUID string, params map[string]interface{}, tags []string) (apiErr error)
+	UpgradeServiceInstance(instanceGUID, maintenanceVersion string) (apiErr error)
+	ListServiceInstancesForServicePlan(planGUID string) ([]models.ServiceInstance, error)
 	RenameService(instance models.ServiceInstance, newName string) (apiErr error)
 	DeleteService(instance models.ServiceInstance) (apiErr error)
 	FindServicePlanByDescription(planDescription resources.ServicePlanDescription) (planGUID string, apiErr error)
 	ListServicesFromBroker(brokerGUID string) (services []models.ServiceOffering, err error)
 	ListServicesFromManyBrokers(brokerGUIDs []string) (services []models.ServiceOffering, err error)
 	GetServiceInstanceCountForServicePlan(v1PlanGUID string) (count int, apiErr error)
+	GetServiceInstanceCountForOrg(orgGUID string) (count int, apiErr error)
+	GetServiceInstanceCountForSpace(spaceGUID string) (count int, apiErr error)
 	MigrateServicePlanFromV1ToV2(v1PlanGUID, v2PlanGUID string) (changedCount int, apiErr error)
+	GetServiceInstanceSharedFrom(instanceGUID string) (models.ServiceInstanceSharedFrom, error)
+	GetServiceInstanceSharedTos(instanceGUID string) ([]models.ServiceInstanceSharedTo, error)
 }
 
 type CloudControllerServiceRepository struct {
@@ -184,6 +191,20 @@ func (repo CloudControllerServiceRepository) UpdateServiceInstance(instanceGUID,
 	return
 }
 
+func (repo CloudControllerServiceRepository) UpgradeServiceInstance(instanceGUID, maintenanceVersion string) error {
+	path := fmt.Sprintf("/v2/service_instances/%s?accepts_incomplete=true", instanceGUID)
+	request := models.ServiceInstanceUpgradeRequest{
+		MaintenanceInfo: models.ServiceInstanceMaintenanceInfo{Version: maintenanceVersion},
+	}
+
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	return repo.gateway.UpdateResource(repo.config.APIEndpoint(), path, bytes.NewReader(jsonBytes))
+}
+
 func (repo CloudControllerServiceRepository) RenameService(instance models.ServiceInstance, newName string) (apiErr error) {
 	body := fmt.Sprintf(`{"name":"%s"}`, newName)
 	path := fmt.Sprintf("/v2/service_instances/%s?accepts_incomplete=true", instance.GUID)
@@ -291,6 +312,58 @@ func (repo CloudControllerServiceRepository) ListServicesFromBroker(brokerGUID s
 	return
 }
 
+// ListServiceInstancesForServicePlan returns every instance of the plan, across
+// all spaces, with its bindings and service keys.
+func (repo CloudControllerServiceRepository) ListServiceInstancesForServicePlan(planGUID string) ([]models.ServiceInstance, error) {
+	instances := []models.ServiceInstance{}
+	err := repo.gateway.ListPaginatedResources(
+		repo.config.APIEndpoint(),
+		fmt.Sprintf("/v2/service_plans/%s/service_instances?inline-relations-depth=1", planGUID),
+		resources.ServiceInstanceResource{},
+		func(resource interface{}) bool {
+			if instance, ok := resource.(resources.ServiceInstanceResource); ok {
+				instances = append(instances, instance.ToModel())
+			}
+			return true
+		})
+	return instances, err
+}
+
+// GetServiceInstanceSharedFrom returns the space the service instance was
+// shared from. The space GUID is empty when the instance was not shared into
+// the current space.
+func (repo CloudControllerServiceRepository) GetServiceInstanceSharedFrom(instanceGUID string) (models.ServiceInstanceSharedFrom, error) {
+	resource := new(resources.ServiceInstanceSharedFromResource)
+	err := repo.gateway.GetResource(repo.config.APIEndpoint()+fmt.Sprintf("/v2/service_instances/%s/shared_from", instanceGUID), resource)
+	if httpErr, ok := err.(errors.HTTPError); ok && httpErr.StatusCode() == http.StatusNotFound {
+		return models.ServiceInstanceSharedFrom{}, nil
+	}
+	if err != nil {
+		return models.ServiceInstanceSharedFrom{}, err
+	}
+	return resource.ToModel(), nil
+}
+
+// GetServiceInstanceSharedTos returns the spaces the service instance has
+// been shared into.
+func (repo CloudControllerServiceRepository) GetServiceInstanceSharedTos(instanceGUID string) ([]models.ServiceInstanceSharedTo, error) {
+	sharedTos := []models.ServiceInstanceSharedTo{}
+	err := repo.gateway.ListPaginatedResources(
+		repo.config.APIEndpoint(),
+		fmt.Sprintf("/v2/service_instances/%s/shared_to", instanceGUID),
+		resources.ServiceInstanceSharedToResource{},
+		func(resource interface{}) bool {
+			if sharedTo, ok := resource.(resources.ServiceInstanceSharedToResource); ok {
+				sharedTos = append(sharedTos, sharedTo.ToModel())
+			}
+			return true
+		})
+	if httpErr, ok := err.(errors.HTTPError); ok && httpErr.StatusCode() == http.StatusNotFound {
+		return []models.ServiceInstanceSharedTo{}, nil
+	}
+	return sharedTos, err
+}
+
 func (repo CloudControllerServiceRepository) MigrateServicePlanFromV1ToV2(v1PlanGUID, v2PlanGUID string) (changedCount int, apiErr error) {
 	path := fmt.Sprintf("/v2/service_plans/%s/service_instances", v1PlanGUID)
 	body := strings.NewReader(fmt.Sprintf(`{"service_plan_guid":"%s"}`, v2PlanGUID))
@@ -312,3 +385,23 @@ func (repo CloudControllerServiceRepository) GetServiceInstanceCountForServicePl
 	count = response.TotalResults
 	return
 }
+
+// GetServiceInstanceCountForOrg counts the managed service instances in an
+// org, which is what the org quota's total_services limit applies to.
+func (repo CloudControllerServiceRepository) GetServiceInstanceCountForOrg(orgGUID string) (count int, apiErr error) {
+	path := fmt.Sprintf("%s/v2/service_instances?q=%s&results-per-page=1", repo.config.APIEndpoint(), url.QueryEscape("organization_guid:"+orgGUID))
+	response := new(resources.PaginatedServiceInstanceResources)
+	apiErr = repo.gateway.GetResource(path, response)
+	count = response.TotalResults
+	return
+}
+
+// GetServiceInstanceCountForSpace counts the managed service instances in a
+// space, which is what the space quota's total_services limit applies to.
+func (repo CloudControllerServiceRepository) GetServiceInstanceCountForSpace(spaceGUID string) (count int, apiErr error) {
+	path := fmt.Sprintf("%s/v2/spaces/%s/service_instances?results-per-page=1", repo.config.APIEndpoint(), spaceGUID)
+	response := new(resources.PaginatedServiceInstanceResources)
+	apiErr = repo.gateway.GetResource(path, response)
+	count = response.TotalResults
+	return
+}
@@ -460,6 +460,36 @@ var _ = Describe("Services Repo", func() {
 		})
 	})
 
+	Describe("UpgradeServiceInstance", func() {
+		It("sends the maintenance info version", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "PUT",
+				Path:     "/v2/service_instances/instance-guid?accepts_incomplete=true",
+				Matcher:  testnet.RequestBodyMatcher(`{"maintenance_info": {"version": "2.0.0"}}`),
+				Response: testnet.TestResponse{Status: http.StatusAccepted},
+			}))
+
+			err := repo.UpgradeServiceInstance("instance-guid", "2.0.0")
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the upgrade fails", func() {
+			It("returns the error", func() {
+				setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method:   "PUT",
+					Path:     "/v2/service_instances/instance-guid?accepts_incomplete=true",
+					Matcher:  testnet.RequestBodyMatcher(`{"maintenance_info": {"version": "2.0.0"}}`),
+					Response: testnet.TestResponse{Status: http.StatusUnprocessableEntity},
+				}))
+
+				err := repo.UpgradeServiceInstance("instance-guid", "2.0.0")
+				Expect(testHandler).To(HaveAllRequestsCalled())
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("finding service instances by name", func() {
 		It("returns the service instance", func() {
 			setupTestServer(findServiceInstanceReq, serviceOfferingReq)
@@ -989,6 +1019,178 @@ var _ = Describe("Services Repo", func() {
 		})
 	})
 
+	Describe("GetServiceInstanceCountForOrg", func() {
+		It("returns the number of managed service instances in the org", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     fmt.Sprintf("/v2/service_instances?q=%s&results-per-page=1", url.QueryEscape("organization_guid:my-org-guid")),
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{"total_results": 7, "total_pages": 7, "resources": []}`},
+			}))
+
+			count, err := repo.GetServiceInstanceCountForOrg("my-org-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(7))
+		})
+
+		It("returns the API error when one occurs", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     fmt.Sprintf("/v2/service_instances?q=%s&results-per-page=1", url.QueryEscape("organization_guid:my-org-guid")),
+				Response: testnet.TestResponse{Status: http.StatusInternalServerError},
+			}))
+
+			_, err := repo.GetServiceInstanceCountForOrg("my-org-guid")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("GetServiceInstanceCountForSpace", func() {
+		It("returns the number of managed service instances in the space", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/spaces/my-space-guid/service_instances?results-per-page=1",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{"total_results": 3, "total_pages": 3, "resources": []}`},
+			}))
+
+			count, err := repo.GetServiceInstanceCountForSpace("my-space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(3))
+		})
+	})
+
+	Describe("GetServiceInstanceSharedFrom", func() {
+		It("returns the space the service instance was shared from", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/service_instances/my-instance-guid/shared_from",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{
+					"space_guid": "source-space-guid",
+					"space_name": "source-space",
+					"organization_name": "source-org"
+				}`},
+			}))
+
+			sharedFrom, err := repo.GetServiceInstanceSharedFrom("my-instance-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sharedFrom).To(Equal(models.ServiceInstanceSharedFrom{
+				SpaceGUID:        "source-space-guid",
+				SpaceName:        "source-space",
+				OrganizationName: "source-org",
+			}))
+		})
+
+		It("returns nothing when the service instance was not shared into the space", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/service_instances/my-instance-guid/shared_from",
+				Response: testnet.TestResponse{Status: http.StatusNoContent},
+			}))
+
+			sharedFrom, err := repo.GetServiceInstanceSharedFrom("my-instance-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sharedFrom).To(Equal(models.ServiceInstanceSharedFrom{}))
+		})
+
+		It("returns nothing when the API does not support service instance sharing", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/service_instances/my-instance-guid/shared_from",
+				Response: testnet.TestResponse{Status: http.StatusNotFound},
+			}))
+
+			sharedFrom, err := repo.GetServiceInstanceSharedFrom("my-instance-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sharedFrom).To(Equal(models.ServiceInstanceSharedFrom{}))
+		})
+	})
+
+	Describe("GetServiceInstanceSharedTos", func() {
+		It("returns the spaces the service instance is shared into", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/service_instances/my-instance-guid/shared_to",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{
+					"total_results": 1,
+					"resources": [
+						{
+							"space_guid": "other-space-guid",
+							"space_name": "other-space",
+							"organization_name": "other-org",
+							"bound_app_count": 2
+						}
+					]
+				}`},
+			}))
+
+			sharedTos, err := repo.GetServiceInstanceSharedTos("my-instance-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sharedTos).To(Equal([]models.ServiceInstanceSharedTo{{
+				SpaceGUID:        "other-space-guid",
+				SpaceName:        "other-space",
+				OrganizationName: "other-org",
+				BoundAppCount:    2,
+			}}))
+		})
+
+		It("returns the API error when one occurs", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/service_instances/my-instance-guid/shared_to",
+				Response: testnet.TestResponse{Status: http.StatusInternalServerError},
+			}))
+
+			_, err := repo.GetServiceInstanceSharedTos("my-instance-guid")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ListServiceInstancesForServicePlan", func() {
+		It("returns the instances of the plan with their bindings and keys", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/service_plans/my-plan-guid/service_instances?inline-relations-depth=1",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{
+					"total_results": 1,
+					"resources": [
+						{
+							"metadata": {"guid": "my-instance-guid"},
+							"entity": {
+								"name": "my-instance",
+								"service_bindings": [
+									{"metadata": {"guid": "binding-guid"}, "entity": {"app_guid": "app-guid"}}
+								],
+								"service_keys": [
+									{"metadata": {"guid": "key-guid"}, "entity": {"name": "my-key"}}
+								]
+							}
+						}
+					]
+				}`},
+			}))
+
+			instances, err := repo.ListServiceInstancesForServicePlan("my-plan-guid")
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instances).To(HaveLen(1))
+			Expect(instances[0].GUID).To(Equal("my-instance-guid"))
+			Expect(instances[0].Name).To(Equal("my-instance"))
+			Expect(instances[0].ServiceBindings).To(HaveLen(1))
+			Expect(instances[0].ServiceBindings[0].AppGUID).To(Equal("app-guid"))
+			Expect(instances[0].ServiceKeys).To(HaveLen(1))
+		})
+
+		It("returns the API error when one occurs", func() {
+			setupTestServer(apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/service_plans/my-plan-guid/service_instances?inline-relations-depth=1",
+				Response: testnet.TestResponse{Status: http.StatusInternalServerError},
+			}))
+
+			_, err := repo.ListServiceInstancesForServicePlan("my-plan-guid")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("finding a service plan", func() {
 		var planDescription resources.ServicePlanDescription
 
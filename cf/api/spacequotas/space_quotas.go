@@ -18,6 +18,8 @@ type SpaceQuotaRepository interface {
 	FindByOrg(guid string) (quota []models.SpaceQuota, apiErr error)
 	FindByGUID(guid string) (quota models.SpaceQuota, apiErr error)
 	FindByNameAndOrgGUID(spaceQuotaName string, orgGUID string) (quota models.SpaceQuota, apiErr error)
+	FindAllByName(name string) (quotas []models.SpaceQuota, apiErr error)
+	ListSpaces(quotaGUID string) (spaces []models.SpaceFields, apiErr error)
 
 	AssociateSpaceWithQuota(spaceGUID string, quotaGUID string) error
 	UnassignQuotaFromSpace(spaceGUID string, quotaGUID string) error
@@ -83,6 +85,38 @@ func (repo CloudControllerSpaceQuotaRepository) FindByOrg(guid string) ([]models
 	return quotas, nil
 }
 
+// FindAllByName returns the space quotas with the given name in every org
+// the user can see.
+func (repo CloudControllerSpaceQuotaRepository) FindAllByName(name string) ([]models.SpaceQuota, error) {
+	quotas, apiErr := repo.findAllWithPath("/v2/space_quota_definitions")
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	var matching []models.SpaceQuota
+	for _, quota := range quotas {
+		if quota.Name == name {
+			matching = append(matching, quota)
+		}
+	}
+	return matching, nil
+}
+
+func (repo CloudControllerSpaceQuotaRepository) ListSpaces(quotaGUID string) ([]models.SpaceFields, error) {
+	var spaces []models.SpaceFields
+	apiErr := repo.gateway.ListPaginatedResources(
+		repo.config.APIEndpoint(),
+		fmt.Sprintf("/v2/space_quota_definitions/%s/spaces", quotaGUID),
+		resources.SpaceResource{},
+		func(resource interface{}) bool {
+			if sr, ok := resource.(resources.SpaceResource); ok {
+				spaces = append(spaces, sr.ToFields())
+			}
+			return true
+		})
+	return spaces, apiErr
+}
+
 func (repo CloudControllerSpaceQuotaRepository) FindByGUID(guid string) (quota models.SpaceQuota, apiErr error) {
 	quotas, apiErr := repo.FindByOrg(repo.config.OrganizationFields().GUID)
 	if apiErr != nil {
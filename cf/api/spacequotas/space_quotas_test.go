@@ -372,6 +372,67 @@ var _ = Describe("CloudControllerQuotaRepository", func() {
 		})
 	})
 
+	Describe("FindAllByName", func() {
+		BeforeEach(func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v2/space_quota_definitions"),
+					ghttp.RespondWith(http.StatusOK, `{
+						"resources": [
+							{
+								"metadata": { "guid": "my-quota-guid" },
+								"entity": { "name": "my-quota", "organization_guid": "my-org-guid" }
+							},
+							{
+								"metadata": { "guid": "other-quota-guid" },
+								"entity": { "name": "other-quota", "organization_guid": "my-org-guid" }
+							},
+							{
+								"metadata": { "guid": "their-quota-guid" },
+								"entity": { "name": "my-quota", "organization_guid": "their-org-guid" }
+							}
+						]
+					}`),
+				),
+			)
+		})
+
+		It("returns the quotas with the name in every org", func() {
+			quotas, err := repo.FindAllByName("my-quota")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quotas).To(HaveLen(2))
+			Expect(quotas[0].GUID).To(Equal("my-quota-guid"))
+			Expect(quotas[0].OrgGUID).To(Equal("my-org-guid"))
+			Expect(quotas[1].GUID).To(Equal("their-quota-guid"))
+			Expect(quotas[1].OrgGUID).To(Equal("their-org-guid"))
+		})
+	})
+
+	Describe("ListSpaces", func() {
+		BeforeEach(func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v2/space_quota_definitions/my-quota-guid/spaces"),
+					ghttp.RespondWith(http.StatusOK, `{
+						"resources": [
+							{ "metadata": { "guid": "space-1-guid" }, "entity": { "name": "space-1" } },
+							{ "metadata": { "guid": "space-2-guid" }, "entity": { "name": "space-2" } }
+						]
+					}`),
+				),
+			)
+		})
+
+		It("returns the spaces the quota is assigned to", func() {
+			spaces, err := repo.ListSpaces("my-quota-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spaces).To(Equal([]models.SpaceFields{
+				{GUID: "space-1-guid", Name: "space-1"},
+				{GUID: "space-2-guid", Name: "space-2"},
+			}))
+		})
+	})
+
 	Describe("AssociateSpaceWithQuota", func() {
 		BeforeEach(func() {
 			ccServer.AppendHandlers(
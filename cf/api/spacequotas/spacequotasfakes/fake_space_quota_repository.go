@@ -88,6 +88,24 @@ type FakeSpaceQuotaRepository struct {
 	deleteReturns struct {
 		result1 error
 	}
+	FindAllByNameStub        func(name string) ([]models.SpaceQuota, error)
+	findAllByNameMutex       sync.RWMutex
+	findAllByNameArgsForCall []struct {
+		name string
+	}
+	findAllByNameReturns struct {
+		result1 []models.SpaceQuota
+		result2 error
+	}
+	ListSpacesStub        func(quotaGUID string) ([]models.SpaceFields, error)
+	listSpacesMutex       sync.RWMutex
+	listSpacesArgsForCall []struct {
+		quotaGUID string
+	}
+	listSpacesReturns struct {
+		result1 []models.SpaceFields
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -396,6 +414,74 @@ func (fake *FakeSpaceQuotaRepository) DeleteReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSpaceQuotaRepository) FindAllByName(name string) ([]models.SpaceQuota, error) {
+	fake.findAllByNameMutex.Lock()
+	fake.findAllByNameArgsForCall = append(fake.findAllByNameArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("FindAllByName", []interface{}{name})
+	fake.findAllByNameMutex.Unlock()
+	if fake.FindAllByNameStub != nil {
+		return fake.FindAllByNameStub(name)
+	} else {
+		return fake.findAllByNameReturns.result1, fake.findAllByNameReturns.result2
+	}
+}
+
+func (fake *FakeSpaceQuotaRepository) FindAllByNameCallCount() int {
+	fake.findAllByNameMutex.RLock()
+	defer fake.findAllByNameMutex.RUnlock()
+	return len(fake.findAllByNameArgsForCall)
+}
+
+func (fake *FakeSpaceQuotaRepository) FindAllByNameArgsForCall(i int) string {
+	fake.findAllByNameMutex.RLock()
+	defer fake.findAllByNameMutex.RUnlock()
+	return fake.findAllByNameArgsForCall[i].name
+}
+
+func (fake *FakeSpaceQuotaRepository) FindAllByNameReturns(result1 []models.SpaceQuota, result2 error) {
+	fake.FindAllByNameStub = nil
+	fake.findAllByNameReturns = struct {
+		result1 []models.SpaceQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSpaceQuotaRepository) ListSpaces(quotaGUID string) ([]models.SpaceFields, error) {
+	fake.listSpacesMutex.Lock()
+	fake.listSpacesArgsForCall = append(fake.listSpacesArgsForCall, struct {
+		quotaGUID string
+	}{quotaGUID})
+	fake.recordInvocation("ListSpaces", []interface{}{quotaGUID})
+	fake.listSpacesMutex.Unlock()
+	if fake.ListSpacesStub != nil {
+		return fake.ListSpacesStub(quotaGUID)
+	} else {
+		return fake.listSpacesReturns.result1, fake.listSpacesReturns.result2
+	}
+}
+
+func (fake *FakeSpaceQuotaRepository) ListSpacesCallCount() int {
+	fake.listSpacesMutex.RLock()
+	defer fake.listSpacesMutex.RUnlock()
+	return len(fake.listSpacesArgsForCall)
+}
+
+func (fake *FakeSpaceQuotaRepository) ListSpacesArgsForCall(i int) string {
+	fake.listSpacesMutex.RLock()
+	defer fake.listSpacesMutex.RUnlock()
+	return fake.listSpacesArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeSpaceQuotaRepository) ListSpacesReturns(result1 []models.SpaceFields, result2 error) {
+	fake.ListSpacesStub = nil
+	fake.listSpacesReturns = struct {
+		result1 []models.SpaceFields
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSpaceQuotaRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -417,6 +503,10 @@ func (fake *FakeSpaceQuotaRepository) Invocations() map[string][][]interface{} {
 	defer fake.updateMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.findAllByNameMutex.RLock()
+	defer fake.findAllByNameMutex.RUnlock()
+	fake.listSpacesMutex.RLock()
+	defer fake.listSpacesMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -19,6 +19,7 @@ type StackRepository interface {
 	FindByName(name string) (stack models.Stack, apiErr error)
 	FindByGUID(guid string) (models.Stack, error)
 	FindAll() (stacks []models.Stack, apiErr error)
+	CountApps(stackGUID string, orgGUID string, spaceGUID string) (int, error)
 }
 
 type CloudControllerStackRepository struct {
@@ -69,6 +70,28 @@ func (repo CloudControllerStackRepository) FindAll() (stacks []models.Stack, api
 	return repo.findAllWithPath("/v2/stacks")
 }
 
+// CountApps returns the number of apps using the stack in the given space, or
+// in the given org when spaceGUID is empty.
+func (repo CloudControllerStackRepository) CountApps(stackGUID string, orgGUID string, spaceGUID string) (int, error) {
+	var path string
+	if spaceGUID != "" {
+		path = fmt.Sprintf("/v2/spaces/%s/apps?q=%s&results-per-page=100", spaceGUID, url.QueryEscape("stack_guid:"+stackGUID))
+	} else {
+		path = fmt.Sprintf("/v2/apps?q=%s&q=%s&results-per-page=100", url.QueryEscape("stack_guid:"+stackGUID), url.QueryEscape("organization_guid:"+orgGUID))
+	}
+
+	count := 0
+	apiErr := repo.gateway.ListPaginatedResources(
+		repo.config.APIEndpoint(),
+		path,
+		resources.ApplicationResource{},
+		func(resource interface{}) bool {
+			count++
+			return true
+		})
+	return count, apiErr
+}
+
 func (repo CloudControllerStackRepository) findAllWithPath(path string) ([]models.Stack, error) {
 	var stacks []models.Stack
 	apiErr := repo.gateway.ListPaginatedResources(
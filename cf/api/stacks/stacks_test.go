@@ -171,6 +171,81 @@ var _ = Describe("StacksRepo", func() {
 		})
 	})
 
+	Describe("CountApps", func() {
+		Context("when a space is given", func() {
+			BeforeEach(func() {
+				testServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/v2/spaces/space-guid/apps", "q=stack_guid:stack-guid&results-per-page=100"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"next_url": "/v2/spaces/space-guid/apps?q=stack_guid:stack-guid&results-per-page=100&page=2",
+							"resources": [
+								{ "metadata": { "guid": "app-guid-1" }, "entity": { "name": "app-1" } },
+								{ "metadata": { "guid": "app-guid-2" }, "entity": { "name": "app-2" } }
+							]
+						}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/v2/spaces/space-guid/apps", "q=stack_guid:stack-guid&results-per-page=100&page=2"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"resources": [
+								{ "metadata": { "guid": "app-guid-3" }, "entity": { "name": "app-3" } }
+							]
+						}`),
+					),
+				)
+			})
+
+			It("counts the apps in the space across all pages", func() {
+				count, err := repo.CountApps("stack-guid", "org-guid", "space-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(Equal(3))
+				Expect(testServer.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+
+		Context("when no space is given", func() {
+			BeforeEach(func() {
+				testServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/v2/apps", "q=stack_guid:stack-guid&q=organization_guid:org-guid&results-per-page=100"),
+						ghttp.RespondWith(http.StatusOK, `{
+							"resources": [
+								{ "metadata": { "guid": "app-guid-1" }, "entity": { "name": "app-1" } }
+							]
+						}`),
+					),
+				)
+			})
+
+			It("counts the apps in the org", func() {
+				count, err := repo.CountApps("stack-guid", "org-guid", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(Equal(1))
+			})
+		})
+
+		Context("when the user is not permitted to list apps", func() {
+			BeforeEach(func() {
+				testServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/v2/spaces/space-guid/apps"),
+						ghttp.RespondWith(http.StatusForbidden, `{
+							"code": 10003,
+							"description": "You are not authorized to perform the requested action"
+						}`),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				_, err := repo.CountApps("stack-guid", "org-guid", "space-guid")
+				Expect(err).To(HaveOccurred())
+				Expect(err.(errors.HTTPError).StatusCode()).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
 	Describe("FindByGUID", func() {
 		Context("when a stack with that GUID can be found", func() {
 			BeforeEach(func() {
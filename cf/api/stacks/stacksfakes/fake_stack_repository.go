@@ -34,6 +34,17 @@ type FakeStackRepository struct {
 		result1 []models.Stack
 		result2 error
 	}
+	CountAppsStub        func(stackGUID string, orgGUID string, spaceGUID string) (int, error)
+	countAppsMutex       sync.RWMutex
+	countAppsArgsForCall []struct {
+		stackGUID string
+		orgGUID   string
+		spaceGUID string
+	}
+	countAppsReturns struct {
+		result1 int
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -132,6 +143,42 @@ func (fake *FakeStackRepository) FindAllReturns(result1 []models.Stack, result2
 	}{result1, result2}
 }
 
+func (fake *FakeStackRepository) CountApps(stackGUID string, orgGUID string, spaceGUID string) (int, error) {
+	fake.countAppsMutex.Lock()
+	fake.countAppsArgsForCall = append(fake.countAppsArgsForCall, struct {
+		stackGUID string
+		orgGUID   string
+		spaceGUID string
+	}{stackGUID, orgGUID, spaceGUID})
+	fake.recordInvocation("CountApps", []interface{}{stackGUID, orgGUID, spaceGUID})
+	fake.countAppsMutex.Unlock()
+	if fake.CountAppsStub != nil {
+		return fake.CountAppsStub(stackGUID, orgGUID, spaceGUID)
+	} else {
+		return fake.countAppsReturns.result1, fake.countAppsReturns.result2
+	}
+}
+
+func (fake *FakeStackRepository) CountAppsCallCount() int {
+	fake.countAppsMutex.RLock()
+	defer fake.countAppsMutex.RUnlock()
+	return len(fake.countAppsArgsForCall)
+}
+
+func (fake *FakeStackRepository) CountAppsArgsForCall(i int) (string, string, string) {
+	fake.countAppsMutex.RLock()
+	defer fake.countAppsMutex.RUnlock()
+	return fake.countAppsArgsForCall[i].stackGUID, fake.countAppsArgsForCall[i].orgGUID, fake.countAppsArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeStackRepository) CountAppsReturns(result1 int, result2 error) {
+	fake.CountAppsStub = nil
+	fake.countAppsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -141,6 +188,8 @@ func (fake *FakeStackRepository) Invocations() map[string][][]interface{} {
 	defer fake.findByGUIDMutex.RUnlock()
 	fake.findAllMutex.RLock()
 	defer fake.findAllMutex.RUnlock()
+	fake.countAppsMutex.RLock()
+	defer fake.countAppsMutex.RUnlock()
 	return fake.invocations
 }
 
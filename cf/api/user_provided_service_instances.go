@@ -16,6 +16,7 @@ type UserProvidedServiceInstanceRepository interface {
 	Create(name, drainURL string, routeServiceURL string, params map[string]interface{}) (apiErr error)
 	Update(serviceInstanceFields models.ServiceInstanceFields) (apiErr error)
 	GetSummaries() (models.UserProvidedServiceSummary, error)
+	Get(guid string) (models.UserProvidedService, error)
 }
 
 type CCUserProvidedServiceInstanceRepository struct {
@@ -77,3 +78,16 @@ func (repo CCUserProvidedServiceInstanceRepository) GetSummaries() (models.UserP
 
 	return model, nil
 }
+
+func (repo CCUserProvidedServiceInstanceRepository) Get(guid string) (models.UserProvidedService, error) {
+	path := fmt.Sprintf("%s/v2/user_provided_service_instances/%s", repo.config.APIEndpoint(), guid)
+
+	model := models.UserProvidedServiceEntity{}
+
+	apiErr := repo.gateway.GetResource(path, &model)
+	if apiErr != nil {
+		return models.UserProvidedService{}, apiErr
+	}
+
+	return model.UserProvidedService, nil
+}
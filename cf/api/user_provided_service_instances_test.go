@@ -182,6 +182,44 @@ var _ = Describe("UserProvidedServiceRepository", func() {
 		})
 	})
 
+	Context("Get()", func() {
+		It("returns the user provided service instance with the given guid", func() {
+			req := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/user_provided_service_instances/my-instance-guid",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `
+{
+   "metadata": {
+      "guid": "my-instance-guid"
+   },
+   "entity": {
+      "name": "my-upsi",
+      "credentials": {
+         "password": "admin",
+         "username": "admin"
+      },
+      "space_guid": "my-space-guid",
+      "type": "user_provided_service_instance",
+      "syslog_drain_url": "syslog://example.com",
+      "route_service_url": "https://route.example.com",
+      "tags": ["tag1", "tag2"]
+   }
+}`},
+			})
+
+			ts, handler, repo := createUserProvidedServiceInstanceRepo([]testnet.TestRequest{req})
+			defer ts.Close()
+
+			instance, apiErr := repo.Get("my-instance-guid")
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(instance.Name).To(Equal("my-upsi"))
+			Expect(instance.Credentials).To(HaveKeyWithValue("username", "admin"))
+			Expect(instance.SysLogDrainURL).To(Equal("syslog://example.com"))
+			Expect(instance.RouteServiceURL).To(Equal("https://route.example.com"))
+			Expect(instance.Tags).To(Equal([]string{"tag1", "tag2"}))
+		})
+	})
 })
 
 func createUserProvidedServiceInstanceRepo(req []testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo UserProvidedServiceInstanceRepository) {
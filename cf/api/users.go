@@ -86,7 +86,7 @@ func (repo CloudControllerUserRepository) FindAllByUsername(username string) (us
 	}
 
 	usernameFilter := neturl.QueryEscape(fmt.Sprintf(`userName Eq "%s"`, username))
-	path := fmt.Sprintf("%s/Users?attributes=id,userName&filter=%s", uaaEndpoint, usernameFilter)
+	path := fmt.Sprintf("%s/Users?attributes=id,userName,origin&filter=%s", uaaEndpoint, usernameFilter)
 	users, apiErr = repo.updateOrFindUsersWithUAAPath([]models.UserFields{}, path)
 
 	if apiErr != nil {
@@ -185,6 +185,7 @@ func (repo CloudControllerUserRepository) updateOrFindUsersWithUAAPath(ccUsers [
 		updatedUsers = append(updatedUsers, models.UserFields{
 			GUID:     uaaResource.ID,
 			Username: uaaResource.Username,
+			Origin:   uaaResource.Origin,
 			IsAdmin:  ccUserFields.IsAdmin,
 		})
 	}
@@ -251,6 +251,45 @@ var _ = Describe("UserRepository", func() {
 		})
 	})
 
+	Describe("FindAllByUsername", func() {
+		Context("when users with the username exist in several origins", func() {
+			BeforeEach(func() {
+				uaaServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/Users", fmt.Sprintf("attributes=id,userName,origin&filter=%s", url.QueryEscape(`userName Eq "alice"`))),
+						ghttp.RespondWith(http.StatusOK, `{
+							"resources": [
+							{ "id": "uaa-guid", "userName": "alice", "origin": "uaa" },
+							{ "id": "ldap-guid", "userName": "alice", "origin": "ldap" }
+							]}`),
+					),
+				)
+			})
+
+			It("returns the users with their origins", func() {
+				users, err := client.FindAllByUsername("alice")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(users).To(Equal([]models.UserFields{
+					{GUID: "uaa-guid", Username: "alice", Origin: "uaa"},
+					{GUID: "ldap-guid", Username: "alice", Origin: "ldap"},
+				}))
+			})
+		})
+
+		Context("when no user has the username", func() {
+			BeforeEach(func() {
+				uaaServer.AppendHandlers(
+					ghttp.RespondWith(http.StatusOK, `{"resources": []}`),
+				)
+			})
+
+			It("returns a ModelNotFoundError", func() {
+				_, err := client.FindAllByUsername("alice")
+				Expect(err).To(BeAssignableToTypeOf(&errors.ModelNotFoundError{}))
+			})
+		})
+	})
+
 	Describe("ListUsersInOrgForRoleWithNoUAA", func() {
 		Context("when there are users in the given org with the given role", func() {
 			BeforeEach(func() {
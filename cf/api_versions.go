@@ -3,6 +3,8 @@ package cf
 import "github.com/blang/semver"
 
 var (
+	RouteDestinationProtocolMinimumAPIVersion, _        = semver.Make("2.150.0")
+	ProcessScaleMinimumAPIVersion, _                    = semver.Make("2.92.0")
 	ReservedRoutePortsMinimumAPIVersion, _              = semver.Make("2.55.0") // #112023051
 	TCPRoutingMinimumAPIVersion, _                      = semver.Make("2.53.0") // #111475922
 	MultipleAppPortsMinimumAPIVersion, _                = semver.Make("2.51.0")
@@ -12,6 +14,8 @@ var (
 	OrgAppInstanceLimitMinimumAPIVersion, _             = semver.Make("2.33.0")
 	ListUsersInOrgOrSpaceWithoutUAAMinimumAPIVersion, _ = semver.Make("2.21.0")
 	UpdateServicePlanMinimumAPIVersion, _               = semver.Make("2.16.0")
+	UpgradeServiceInstanceMinimumAPIVersion, _          = semver.Make("2.133.0")
+	InternalDomainMinimumAPIVersion, _                  = semver.Make("2.115.0")
 
 	ServiceAuthTokenMaximumAPIVersion, _ = semver.Make("2.46.0")
 	SpaceScopedMaximumAPIVersion, _      = semver.Make("2.47.0")
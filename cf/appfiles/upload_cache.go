@@ -0,0 +1,138 @@
+package appfiles
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/resources"
+	"code.cloudfoundry.org/cli/cf/models"
+)
+
+// UploadCacheMaxAge is how long the files prepared for an app upload are
+// reused. Older entries are ignored because the Cloud Controller may have
+// evicted the resources they were matched against.
+const UploadCacheMaxAge = time.Hour
+
+// UploadCache keeps the zip built for an app upload and the files the Cloud
+// Controller already had, keyed by app GUID and a digest of the app files, so
+// that retrying the upload of unchanged files skips zipping and resource
+// matching. A cache with an empty Dir keeps nothing.
+type UploadCache struct {
+	Dir string
+}
+
+// UploadCacheEntry is the work saved for one app upload.
+type UploadCacheEntry struct {
+	ZipPath      string
+	PresentFiles []resources.AppFileResource
+}
+
+func NewUploadCache(dir string) UploadCache {
+	return UploadCache{Dir: dir}
+}
+
+// Digest returns a digest of the paths, sizes, modes and SHA-1s of the app
+// files, independent of their order.
+func Digest(files []models.AppFileFields) string {
+	sorted := make([]models.AppFileFields, len(files))
+	copy(sorted, files)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Path < sorted[j].Path })
+
+	hash := sha256.New()
+	for _, file := range sorted {
+		fmt.Fprintf(hash, "%s\x00%s\x00%d\x00%s\n", file.Path, file.Sha1, file.Size, file.Mode)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Load returns the entry saved for the app files with the given digest, if it
+// is complete and younger than UploadCacheMaxAge.
+func (cache UploadCache) Load(appGUID string, digest string) (UploadCacheEntry, bool) {
+	if cache.Dir == "" {
+		return UploadCacheEntry{}, false
+	}
+
+	zipPath, manifestPath := cache.paths(appGUID, digest)
+
+	manifestInfo, err := os.Stat(manifestPath)
+	if err != nil || time.Since(manifestInfo.ModTime()) > UploadCacheMaxAge {
+		return UploadCacheEntry{}, false
+	}
+	if _, err = os.Stat(zipPath); err != nil {
+		return UploadCacheEntry{}, false
+	}
+
+	raw, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		return UploadCacheEntry{}, false
+	}
+
+	var presentFiles []resources.AppFileResource
+	if err = json.Unmarshal(raw, &presentFiles); err != nil {
+		return UploadCacheEntry{}, false
+	}
+
+	return UploadCacheEntry{ZipPath: zipPath, PresentFiles: presentFiles}, true
+}
+
+// CreateZip removes the app's previous entries and creates the file the zip
+// of the app files with the given digest should be written to. The entry is
+// not loaded until Save is called.
+func (cache UploadCache) CreateZip(appGUID string, digest string) (*os.File, error) {
+	err := cache.Remove(appGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(cache.Dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	zipPath, _ := cache.paths(appGUID, digest)
+	return os.OpenFile(zipPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+}
+
+// Save completes the entry started by CreateZip by recording the files the
+// Cloud Controller already has.
+func (cache UploadCache) Save(appGUID string, digest string, presentFiles []resources.AppFileResource) error {
+	raw, err := json.Marshal(presentFiles)
+	if err != nil {
+		return err
+	}
+
+	_, manifestPath := cache.paths(appGUID, digest)
+	return ioutil.WriteFile(manifestPath, raw, 0600)
+}
+
+// Remove removes every entry of the app.
+func (cache UploadCache) Remove(appGUID string) error {
+	if cache.Dir == "" {
+		return nil
+	}
+
+	matches, err := filepath.Glob(filepath.Join(cache.Dir, appGUID+"-*"))
+	if err != nil {
+		return err
+	}
+
+	for _, match := range matches {
+		err = os.Remove(match)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cache UploadCache) paths(appGUID string, digest string) (string, string) {
+	base := filepath.Join(cache.Dir, appGUID+"-"+digest)
+	return base + ".zip", base + ".json"
+}
@@ -0,0 +1,125 @@
+package appfiles_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/resources"
+	"code.cloudfoundry.org/cli/cf/appfiles"
+	"code.cloudfoundry.org/cli/cf/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UploadCache", func() {
+	var (
+		cacheDir     string
+		cache        appfiles.UploadCache
+		digest       string
+		presentFiles []resources.AppFileResource
+	)
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = ioutil.TempDir("", "upload-cache")
+		Expect(err).NotTo(HaveOccurred())
+
+		cache = appfiles.NewUploadCache(filepath.Join(cacheDir, "push-cache"))
+		digest = appfiles.Digest([]models.AppFileFields{{Path: "app.rb", Sha1: "some-sha", Size: 10}})
+		presentFiles = []resources.AppFileResource{{Path: "Gemfile", Sha1: "other-sha", Size: 20, Mode: "0644"}}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	saveEntry := func(appGUID string) {
+		zipFile, err := cache.CreateZip(appGUID, digest)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = zipFile.WriteString("zip contents")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zipFile.Close()).To(Succeed())
+
+		Expect(cache.Save(appGUID, digest, presentFiles)).To(Succeed())
+	}
+
+	Describe("Digest", func() {
+		It("does not depend on the order of the files", func() {
+			files := []models.AppFileFields{{Path: "a", Sha1: "1"}, {Path: "b", Sha1: "2"}}
+			reversed := []models.AppFileFields{files[1], files[0]}
+			Expect(appfiles.Digest(files)).To(Equal(appfiles.Digest(reversed)))
+		})
+
+		It("changes when a file changes", func() {
+			files := []models.AppFileFields{{Path: "a", Sha1: "1"}}
+			changed := []models.AppFileFields{{Path: "a", Sha1: "2"}}
+			Expect(appfiles.Digest(files)).NotTo(Equal(appfiles.Digest(changed)))
+		})
+	})
+
+	Describe("Load", func() {
+		It("returns a saved entry", func() {
+			saveEntry("some-app-guid")
+
+			entry, ok := cache.Load("some-app-guid", digest)
+			Expect(ok).To(BeTrue())
+			Expect(entry.PresentFiles).To(Equal(presentFiles))
+
+			contents, err := ioutil.ReadFile(entry.ZipPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("zip contents"))
+		})
+
+		It("does not return an entry for different files", func() {
+			saveEntry("some-app-guid")
+
+			_, ok := cache.Load("some-app-guid", appfiles.Digest(nil))
+			Expect(ok).To(BeFalse())
+		})
+
+		It("does not return an entry that was never saved", func() {
+			_, err := cache.CreateZip("some-app-guid", digest)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, ok := cache.Load("some-app-guid", digest)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("does not return an entry older than the maximum age", func() {
+			saveEntry("some-app-guid")
+
+			old := time.Now().Add(-appfiles.UploadCacheMaxAge - time.Minute)
+			manifests, err := filepath.Glob(filepath.Join(cacheDir, "push-cache", "*.json"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifests).To(HaveLen(1))
+			Expect(os.Chtimes(manifests[0], old, old)).To(Succeed())
+
+			_, ok := cache.Load("some-app-guid", digest)
+			Expect(ok).To(BeFalse())
+		})
+
+		Context("when the cache has no directory", func() {
+			It("returns nothing", func() {
+				_, ok := appfiles.UploadCache{}.Load("some-app-guid", digest)
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Remove", func() {
+		It("removes only the entries of the app", func() {
+			saveEntry("some-app-guid")
+			saveEntry("other-app-guid")
+
+			Expect(cache.Remove("some-app-guid")).To(Succeed())
+
+			_, ok := cache.Load("some-app-guid", digest)
+			Expect(ok).To(BeFalse())
+			_, ok = cache.Load("other-app-guid", digest)
+			Expect(ok).To(BeTrue())
+		})
+	})
+})
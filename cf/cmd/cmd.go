@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 
 	"path/filepath"
@@ -20,8 +21,10 @@ import (
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/plugin/models"
 	"code.cloudfoundry.org/cli/plugin/rpc"
 	"code.cloudfoundry.org/cli/util/spellcheck"
+	"code.cloudfoundry.org/cli/util/timings"
 
 	netrpc "net/rpc"
 )
@@ -47,6 +50,8 @@ func Main(traceEnv string, args []string) {
 	newArgs, isVerbose := handleVerbose(args)
 	args = newArgs
 
+	args, terminal.UserDisabledColors = handleNoColor(args)
+
 	errFunc := func(err error) {
 		if err != nil {
 			ui := terminal.NewUI(
@@ -98,7 +103,7 @@ func Main(traceEnv string, args []string) {
 		if err != nil {
 			usage := cmdRegistry.CommandUsage(cmdName)
 			deps.UI.Failed(T("Incorrect Usage") + "\n\n" + err.Error() + "\n\n" + usage)
-			os.Exit(1)
+			exit(1)
 		}
 
 		cmd = cmd.SetDependency(deps, false)
@@ -107,30 +112,30 @@ func Main(traceEnv string, args []string) {
 		requirementsFactory := requirements.NewFactory(deps.Config, deps.RepoLocator)
 		reqs, reqErr := cmd.Requirements(requirementsFactory, flagContext)
 		if reqErr != nil {
-			os.Exit(1)
+			exit(1)
 		}
 
 		for _, req := range reqs {
 			err = req.Execute()
 			if err != nil {
 				deps.UI.Failed(err.Error())
-				os.Exit(1)
+				exit(1)
 			}
 		}
 
 		err = cmd.Execute(flagContext)
 		if err != nil {
 			deps.UI.Failed(err.Error())
-			os.Exit(1)
+			exit(1)
 		}
 
 		err = warningsCollector.PrintWarnings()
 		if err != nil {
 			deps.UI.Failed(err.Error())
-			os.Exit(1)
+			exit(1)
 		}
 
-		os.Exit(0)
+		exit(0)
 	}
 
 	//non core command, try plugin command
@@ -138,7 +143,7 @@ func Main(traceEnv string, args []string) {
 	rpcService, err := rpc.NewRpcService(deps.TeePrinter, deps.TeePrinter, deps.Config, deps.RepoLocator, rpc.NewCommandRunner(), deps.Logger, Writer, server)
 	if err != nil {
 		deps.UI.Say(T("Error initializing RPC service: ") + err.Error())
-		os.Exit(1)
+		exit(1)
 	}
 
 	pluginPath := filepath.Join(confighelpers.PluginRepoDir(), ".cf", "plugins")
@@ -151,16 +156,63 @@ func Main(traceEnv string, args []string) {
 	)
 	pluginList := pluginConfig.Plugins()
 
+	rpcService.SetInvocationContext(plugin_models.InvocationContext{
+		Verbose:          isVerbose,
+		ColorEnabled:     terminal.ColorsEnabled(),
+		Locale:           deps.Config.Locale(),
+		TraceDestination: traceDestination(isVerbose, traceEnv, traceConfigVal),
+	})
+
 	ran := rpc.RunMethodIfExists(rpcService, args[1:], pluginList)
 	if !ran {
 		deps.UI.Say("'" + args[1] + T("' is not a registered command. See 'cf help -a'"))
-		suggestCommands(cmdName, deps.UI, append(cmdRegistry.ListCommands(), pluginConfig.ListCommands()...))
-		os.Exit(1)
+		suggestCommands(cmdName, deps.UI,
+			append(cmdRegistry.ListCommands(), cmdRegistry.ListAliases()...),
+			append(pluginConfig.ListCommands(), pluginConfig.ListAliases()...),
+		)
+		exit(1)
 	}
 }
 
-func suggestCommands(cmdName string, ui terminal.UI, cmdsList []string) {
-	cmdSuggester := spellcheck.NewCommandSuggester(cmdsList)
+// exit displays the timings footer, when it was requested, before exiting.
+func exit(code int) {
+	timings.Display(trace.LoggingToStdout)
+	os.Exit(code)
+}
+
+// traceDestination mirrors trace.NewLogger: a trace file path takes
+// precedence, otherwise "stdout" when tracing to the terminal. The path is
+// made absolute, since plugins may run in another working directory.
+func traceDestination(isVerbose bool, boolsOrPaths ...string) string {
+	destination := ""
+	if isVerbose {
+		destination = "stdout"
+	}
+
+	var targets []string
+	for _, val := range boolsOrPaths {
+		targets = append(targets, strings.Split(val, ",")...)
+	}
+
+	for _, val := range targets {
+		val = strings.TrimSpace(val)
+		b, err := strconv.ParseBool(val)
+		if err != nil && val != "" {
+			if path, absErr := filepath.Abs(val); absErr == nil {
+				return path
+			}
+			return val
+		}
+		if b {
+			destination = "stdout"
+		}
+	}
+
+	return destination
+}
+
+func suggestCommands(cmdName string, ui terminal.UI, builtinCmds []string, pluginCmds []string) {
+	cmdSuggester := spellcheck.NewRankedCommandSuggester(builtinCmds, pluginCmds)
 	recommendedCmds := cmdSuggester.Recommend(cmdName)
 	if len(recommendedCmds) != 0 {
 		ui.Say("\n" + T("Did you mean?"))
@@ -204,6 +256,16 @@ func handleHelp(args []string) []string {
 	}
 }
 
+func handleNoColor(args []string) ([]string, bool) {
+	for i, arg := range args {
+		if arg == "--no-color" {
+			return append(args[:i], args[i+1:]...), true
+		}
+	}
+
+	return args, false
+}
+
 func handleVerbose(args []string) ([]string, bool) {
 	var verbose bool
 	idx := -1
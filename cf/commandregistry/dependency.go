@@ -47,6 +47,7 @@ type Dependency struct {
 	WordGenerator      generator.WordGenerator
 	AppZipper          appfiles.Zipper
 	AppFiles           appfiles.AppFiles
+	AppUploadCache     appfiles.UploadCache
 	PushActor          actors.PushActor
 	RouteActor         actors.RouteActor
 	ChecksumUtil       util.Sha1Checksum
@@ -143,9 +144,12 @@ func NewDependency(writer io.Writer, logger trace.Printer, envDialTimeout string
 
 	deps.AppZipper = appfiles.ApplicationZipper{}
 	deps.AppFiles = appfiles.ApplicationFiles{}
+	if configPath != "" {
+		deps.AppUploadCache = appfiles.NewUploadCache(filepath.Join(filepath.Dir(configPath), "push-cache"))
+	}
 
-	deps.RouteActor = actors.NewRouteActor(deps.UI, deps.RepoLocator.GetRouteRepository(), deps.RepoLocator.GetDomainRepository())
-	deps.PushActor = actors.NewPushActor(deps.RepoLocator.GetApplicationBitsRepository(), deps.AppZipper, deps.AppFiles, deps.RouteActor)
+	deps.RouteActor = actors.NewRouteActor(deps.UI, deps.Config, deps.RepoLocator.GetRouteRepository(), deps.RepoLocator.GetDomainRepository())
+	deps.PushActor = actors.NewPushActor(deps.UI, deps.RepoLocator.GetApplicationBitsRepository(), deps.AppZipper, deps.AppFiles, deps.RouteActor, deps.Gateways["cloud-controller"].HTTPClient(actors.RemoteAppDownloadTimeout))
 
 	deps.ChecksumUtil = util.NewSha1Checksum("")
 
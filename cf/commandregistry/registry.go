@@ -9,7 +9,6 @@ import (
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
-	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
 
 	. "code.cloudfoundry.org/cli/cf/terminal"
@@ -20,25 +19,13 @@ var Commands = NewRegistry()
 
 func initI18nFunc() bool {
 	config, err := configv3.LoadConfig()
-
-	var configErrTemplate string
 	if err != nil {
-		if ce, ok := err.(translatableerror.EmptyConfigError); ok {
-			configErrTemplate = ce.Error()
-		} else {
-			fmt.Println(FailureColor("FAILED"))
-			fmt.Println("Error read/writing config: ", err.Error())
-			os.Exit(1)
-		}
+		fmt.Println(FailureColor("FAILED"))
+		fmt.Println("Error read/writing config: ", err.Error())
+		os.Exit(1)
 	}
 
 	T = Init(config)
-
-	if err != nil {
-		fmt.Fprintf(os.Stderr, fmt.Sprintf("%s\n", T(configErrTemplate, map[string]interface{}{
-			"FilePath": configv3.ConfigFilePath(),
-		})))
-	}
 	return true
 }
 
@@ -103,6 +90,17 @@ func (r *registry) ListCommands() []string {
 	return keys
 }
 
+func (r *registry) ListAliases() []string {
+	aliases := []string{}
+	for alias := range r.alias {
+		if alias != "" {
+			aliases = append(aliases, alias)
+		}
+	}
+
+	return aliases
+}
+
 func (r *registry) SetCommand(cmd Command) {
 	r.cmd[cmd.MetaData().Name] = cmd
 }
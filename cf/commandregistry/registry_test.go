@@ -117,6 +117,13 @@ var _ = Describe("CommandRegistry", func() {
 			Expect(cmds).To(ContainElement("this-is-a-really-long-command-name-123123123123123123123"))
 			Expect(cmds).To(ContainElement("fake-command"))
 		})
+
+		It("shows all the aliases in registry", func() {
+			aliases := commandregistry.Commands.ListAliases()
+			Expect(aliases).To(ContainElement("fc1"))
+			Expect(aliases).To(ContainElement("fc2"))
+			Expect(aliases).ToNot(ContainElement(""))
+		})
 	})
 
 	Describe("SetCommand()", func() {
@@ -3,6 +3,7 @@ package applicationfakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands/application"
@@ -61,6 +62,28 @@ type FakeStarter struct {
 		result1 models.Application
 		result2 error
 	}
+	SetStagingTimeoutInMinutesStub        func(timeout int)
+	setStagingTimeoutInMinutesMutex       sync.RWMutex
+	setStagingTimeoutInMinutesArgsForCall []struct {
+		timeout int
+	}
+	TimeoutsStub        func() (time.Duration, time.Duration)
+	timeoutsMutex       sync.RWMutex
+	timeoutsArgsForCall []struct{}
+	timeoutsReturns     struct {
+		result1 time.Duration
+		result2 time.Duration
+	}
+	SetWaitForHTTPPathStub        func(path string)
+	setWaitForHTTPPathMutex       sync.RWMutex
+	setWaitForHTTPPathArgsForCall []struct {
+		path string
+	}
+	SetNoLogsOnFailureStub        func(noLogsOnFailure bool)
+	setNoLogsOnFailureMutex       sync.RWMutex
+	setNoLogsOnFailureArgsForCall []struct {
+		noLogsOnFailure bool
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -252,6 +275,104 @@ func (fake *FakeStarter) ApplicationStartReturns(result1 models.Application, res
 	}{result1, result2}
 }
 
+func (fake *FakeStarter) SetStagingTimeoutInMinutes(timeout int) {
+	fake.setStagingTimeoutInMinutesMutex.Lock()
+	fake.setStagingTimeoutInMinutesArgsForCall = append(fake.setStagingTimeoutInMinutesArgsForCall, struct {
+		timeout int
+	}{timeout})
+	fake.recordInvocation("SetStagingTimeoutInMinutes", []interface{}{timeout})
+	fake.setStagingTimeoutInMinutesMutex.Unlock()
+	if fake.SetStagingTimeoutInMinutesStub != nil {
+		fake.SetStagingTimeoutInMinutesStub(timeout)
+	}
+}
+
+func (fake *FakeStarter) SetStagingTimeoutInMinutesCallCount() int {
+	fake.setStagingTimeoutInMinutesMutex.RLock()
+	defer fake.setStagingTimeoutInMinutesMutex.RUnlock()
+	return len(fake.setStagingTimeoutInMinutesArgsForCall)
+}
+
+func (fake *FakeStarter) SetStagingTimeoutInMinutesArgsForCall(i int) int {
+	fake.setStagingTimeoutInMinutesMutex.RLock()
+	defer fake.setStagingTimeoutInMinutesMutex.RUnlock()
+	return fake.setStagingTimeoutInMinutesArgsForCall[i].timeout
+}
+
+func (fake *FakeStarter) Timeouts() (time.Duration, time.Duration) {
+	fake.timeoutsMutex.Lock()
+	fake.timeoutsArgsForCall = append(fake.timeoutsArgsForCall, struct{}{})
+	fake.recordInvocation("Timeouts", []interface{}{})
+	fake.timeoutsMutex.Unlock()
+	if fake.TimeoutsStub != nil {
+		return fake.TimeoutsStub()
+	} else {
+		return fake.timeoutsReturns.result1, fake.timeoutsReturns.result2
+	}
+}
+
+func (fake *FakeStarter) TimeoutsCallCount() int {
+	fake.timeoutsMutex.RLock()
+	defer fake.timeoutsMutex.RUnlock()
+	return len(fake.timeoutsArgsForCall)
+}
+
+func (fake *FakeStarter) TimeoutsReturns(result1 time.Duration, result2 time.Duration) {
+	fake.TimeoutsStub = nil
+	fake.timeoutsReturns = struct {
+		result1 time.Duration
+		result2 time.Duration
+	}{result1, result2}
+}
+
+func (fake *FakeStarter) SetWaitForHTTPPath(path string) {
+	fake.setWaitForHTTPPathMutex.Lock()
+	fake.setWaitForHTTPPathArgsForCall = append(fake.setWaitForHTTPPathArgsForCall, struct {
+		path string
+	}{path})
+	fake.recordInvocation("SetWaitForHTTPPath", []interface{}{path})
+	fake.setWaitForHTTPPathMutex.Unlock()
+	if fake.SetWaitForHTTPPathStub != nil {
+		fake.SetWaitForHTTPPathStub(path)
+	}
+}
+
+func (fake *FakeStarter) SetWaitForHTTPPathCallCount() int {
+	fake.setWaitForHTTPPathMutex.RLock()
+	defer fake.setWaitForHTTPPathMutex.RUnlock()
+	return len(fake.setWaitForHTTPPathArgsForCall)
+}
+
+func (fake *FakeStarter) SetWaitForHTTPPathArgsForCall(i int) string {
+	fake.setWaitForHTTPPathMutex.RLock()
+	defer fake.setWaitForHTTPPathMutex.RUnlock()
+	return fake.setWaitForHTTPPathArgsForCall[i].path
+}
+
+func (fake *FakeStarter) SetNoLogsOnFailure(noLogsOnFailure bool) {
+	fake.setNoLogsOnFailureMutex.Lock()
+	fake.setNoLogsOnFailureArgsForCall = append(fake.setNoLogsOnFailureArgsForCall, struct {
+		noLogsOnFailure bool
+	}{noLogsOnFailure})
+	fake.recordInvocation("SetNoLogsOnFailure", []interface{}{noLogsOnFailure})
+	fake.setNoLogsOnFailureMutex.Unlock()
+	if fake.SetNoLogsOnFailureStub != nil {
+		fake.SetNoLogsOnFailureStub(noLogsOnFailure)
+	}
+}
+
+func (fake *FakeStarter) SetNoLogsOnFailureCallCount() int {
+	fake.setNoLogsOnFailureMutex.RLock()
+	defer fake.setNoLogsOnFailureMutex.RUnlock()
+	return len(fake.setNoLogsOnFailureArgsForCall)
+}
+
+func (fake *FakeStarter) SetNoLogsOnFailureArgsForCall(i int) bool {
+	fake.setNoLogsOnFailureMutex.RLock()
+	defer fake.setNoLogsOnFailureMutex.RUnlock()
+	return fake.setNoLogsOnFailureArgsForCall[i].noLogsOnFailure
+}
+
 func (fake *FakeStarter) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -267,6 +388,14 @@ func (fake *FakeStarter) Invocations() map[string][][]interface{} {
 	defer fake.setStartTimeoutInSecondsMutex.RUnlock()
 	fake.applicationStartMutex.RLock()
 	defer fake.applicationStartMutex.RUnlock()
+	fake.setStagingTimeoutInMinutesMutex.RLock()
+	defer fake.setStagingTimeoutInMinutesMutex.RUnlock()
+	fake.timeoutsMutex.RLock()
+	defer fake.timeoutsMutex.RUnlock()
+	fake.setWaitForHTTPPathMutex.RLock()
+	defer fake.setWaitForHTTPPathMutex.RUnlock()
+	fake.setNoLogsOnFailureMutex.RLock()
+	defer fake.setNoLogsOnFailureMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -1,6 +1,8 @@
 package application
 
 import (
+	"strings"
+
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -8,16 +10,18 @@ import (
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
 type DeleteApp struct {
-	ui        terminal.UI
-	config    coreconfig.Reader
-	appRepo   applications.Repository
-	routeRepo api.RouteRepository
-	appReq    requirements.ApplicationRequirement
+	ui             terminal.UI
+	config         coreconfig.Reader
+	appRepo        applications.Repository
+	appSummaryRepo api.AppSummaryRepository
+	routeRepo      api.RouteRepository
+	appReq         requirements.ApplicationRequirement
 }
 
 func init() {
@@ -61,6 +65,7 @@ func (cmd *DeleteApp) SetDependency(deps commandregistry.Dependency, pluginCall
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
+	cmd.appSummaryRepo = deps.RepoLocator.GetAppSummaryRepository()
 	cmd.routeRepo = deps.RepoLocator.GetRouteRepository()
 	return cmd
 }
@@ -68,6 +73,29 @@ func (cmd *DeleteApp) SetDependency(deps commandregistry.Dependency, pluginCall
 func (cmd *DeleteApp) Execute(c flags.FlagContext) error {
 	appName := c.Args()[0]
 
+	app, err := cmd.appRepo.Read(appName)
+	_, appNotFound := err.(*errors.ModelNotFoundError)
+	if err != nil && !appNotFound {
+		return err
+	}
+
+	var routesToDelete, sharedRoutes []models.RouteSummary
+	if !appNotFound {
+		if c.Bool("r") {
+			routesToDelete, sharedRoutes, err = cmd.partitionRoutes(app)
+			if err != nil {
+				return err
+			}
+		}
+
+		summary, err := cmd.appSummaryRepo.GetSummary(app.GUID)
+		if err != nil {
+			return err
+		}
+
+		cmd.displayCascadePreview(appName, c.Bool("r"), routesToDelete, sharedRoutes, summary.Services)
+	}
+
 	if !c.Bool("f") {
 		response := cmd.ui.ConfirmDelete(T("app"), appName)
 		if !response {
@@ -82,24 +110,16 @@ func (cmd *DeleteApp) Execute(c flags.FlagContext) error {
 			"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 			"Username":  terminal.EntityNameColor(cmd.config.Username())}))
 
-	app, err := cmd.appRepo.Read(appName)
-
-	switch err.(type) {
-	case nil: // no error
-	case *errors.ModelNotFoundError:
+	if appNotFound {
 		cmd.ui.Ok()
 		cmd.ui.Warn(T("App {{.AppName}} does not exist.", map[string]interface{}{"AppName": appName}))
 		return nil
-	default:
-		return err
 	}
 
-	if c.Bool("r") {
-		for _, route := range app.Routes {
-			err = cmd.routeRepo.Delete(route.GUID)
-			if err != nil {
-				return err
-			}
+	for _, route := range routesToDelete {
+		err = cmd.routeRepo.Delete(route.GUID)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -109,5 +129,75 @@ func (cmd *DeleteApp) Execute(c flags.FlagContext) error {
 	}
 
 	cmd.ui.Ok()
+
+	if c.Bool("r") {
+		cmd.ui.Say("")
+		cmd.ui.Say(T("Deleted routes: {{.Routes}}", map[string]interface{}{"Routes": routeURLs(routesToDelete)}))
+		if len(sharedRoutes) > 0 {
+			cmd.ui.Say(T("Kept routes mapped to other apps: {{.Routes}}", map[string]interface{}{"Routes": routeURLs(sharedRoutes)}))
+		}
+	}
 	return nil
 }
+
+// partitionRoutes splits the app's routes into those mapped only to this app,
+// which are safe to delete, and those also mapped to other apps.
+func (cmd *DeleteApp) partitionRoutes(app models.Application) ([]models.RouteSummary, []models.RouteSummary, error) {
+	var exclusive, shared []models.RouteSummary
+	for _, routeSummary := range app.Routes {
+		route, err := cmd.routeRepo.Find(routeSummary.Host, routeSummary.Domain, routeSummary.Path, routeSummary.Port)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if mappedToOtherApps(route, app.GUID) {
+			shared = append(shared, routeSummary)
+		} else {
+			exclusive = append(exclusive, routeSummary)
+		}
+	}
+
+	return exclusive, shared, nil
+}
+
+func mappedToOtherApps(route models.Route, appGUID string) bool {
+	for _, app := range route.Apps {
+		if app.GUID != appGUID {
+			return true
+		}
+	}
+	return false
+}
+
+func (cmd *DeleteApp) displayCascadePreview(appName string, deleteRoutes bool, routesToDelete []models.RouteSummary, sharedRoutes []models.RouteSummary, services []models.ServicePlanSummary) {
+	cmd.ui.Say(T("Deleting app {{.AppName}} will also:", map[string]interface{}{"AppName": terminal.EntityNameColor(appName)}))
+
+	if deleteRoutes {
+		cmd.ui.Say(T("  delete routes: {{.Routes}}", map[string]interface{}{"Routes": routeURLs(routesToDelete)}))
+		if len(sharedRoutes) > 0 {
+			cmd.ui.Say(T("  keep routes mapped to other apps: {{.Routes}}", map[string]interface{}{"Routes": routeURLs(sharedRoutes)}))
+		}
+	}
+
+	serviceNames := []string{}
+	for _, service := range services {
+		serviceNames = append(serviceNames, service.Name)
+	}
+	cmd.ui.Say(T("  remove service bindings: {{.Services}}", map[string]interface{}{"Services": joinOrNone(serviceNames)}))
+	cmd.ui.Say("")
+}
+
+func routeURLs(routes []models.RouteSummary) string {
+	urls := []string{}
+	for _, route := range routes {
+		urls = append(urls, route.URL())
+	}
+	return joinOrNone(urls)
+}
+
+func joinOrNone(items []string) string {
+	if len(items) == 0 {
+		return T("none")
+	}
+	return strings.Join(items, ", ")
+}
@@ -24,6 +24,7 @@ var _ = Describe("delete app command", func() {
 		app                 models.Application
 		configRepo          coreconfig.Repository
 		appRepo             *applicationsfakes.FakeRepository
+		appSummaryRepo      *apifakes.FakeAppSummaryRepository
 		routeRepo           *apifakes.FakeRouteRepository
 		requirementsFactory *requirementsfakes.FakeFactory
 		deps                commandregistry.Dependency
@@ -33,6 +34,7 @@ var _ = Describe("delete app command", func() {
 		deps.UI = ui
 		deps.Config = configRepo
 		deps.RepoLocator = deps.RepoLocator.SetApplicationRepository(appRepo)
+		deps.RepoLocator = deps.RepoLocator.SetAppSummaryRepository(appSummaryRepo)
 		deps.RepoLocator = deps.RepoLocator.SetRouteRepository(routeRepo)
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("delete").SetDependency(deps, pluginCall))
 	}
@@ -44,6 +46,7 @@ var _ = Describe("delete app command", func() {
 
 		ui = &testterm.FakeUI{}
 		appRepo = new(applicationsfakes.FakeRepository)
+		appSummaryRepo = new(apifakes.FakeAppSummaryRepository)
 		routeRepo = new(apifakes.FakeRouteRepository)
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 
@@ -100,6 +103,55 @@ var _ = Describe("delete app command", func() {
 				))
 			})
 
+			Context("when the app has service bindings", func() {
+				BeforeEach(func() {
+					appSummaryRepo.GetSummaryReturns(models.Application{
+						Services: []models.ServicePlanSummary{
+							{Name: "my-db"},
+							{Name: "my-cache"},
+						},
+					}, nil)
+				})
+
+				It("previews the bindings that will be removed before prompting", func() {
+					ui.Inputs = []string{"y"}
+
+					runCommand("app-to-delete")
+
+					Expect(appSummaryRepo.GetSummaryArgsForCall(0)).To(Equal("app-to-delete-guid"))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Deleting app app-to-delete will also:"},
+						[]string{"remove service bindings: my-db, my-cache"},
+					))
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"delete routes"}))
+				})
+
+				It("still prints the preview when the -f flag is provided", func() {
+					runCommand("-f", "app-to-delete")
+
+					Expect(ui.Prompts).To(BeEmpty())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"remove service bindings: my-db, my-cache"},
+						[]string{"Deleting", "app-to-delete"},
+						[]string{"OK"},
+					))
+				})
+			})
+
+			Context("when fetching the app summary fails", func() {
+				BeforeEach(func() {
+					appSummaryRepo.GetSummaryReturns(models.Application{}, errors.New("summary-error"))
+				})
+
+				It("fails before prompting and does not delete the app", func() {
+					runCommand("app-to-delete")
+
+					Expect(ui.Prompts).To(BeEmpty())
+					Expect(appRepo.DeleteCallCount()).To(BeZero())
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}, []string{"summary-error"}))
+				})
+			})
+
 			It("does not prompt when the -f flag is provided", func() {
 				runCommand("-f", "app-to-delete")
 
@@ -123,9 +175,8 @@ var _ = Describe("delete app command", func() {
 					route2.GUID = "the-second-route-guid"
 					route2.Host = "my-app-is-bad.com"
 
-					appRepo.ReadReturns(models.Application{
-						Routes: []models.RouteSummary{route1, route2},
-					}, nil)
+					app.Routes = []models.RouteSummary{route1, route2}
+					appRepo.ReadReturns(app, nil)
 				})
 
 				Context("when the -r flag is provided", func() {
@@ -137,6 +188,73 @@ var _ = Describe("delete app command", func() {
 							Expect(routeRepo.DeleteArgsForCall(0)).To(Equal("the-first-route-guid"))
 							Expect(routeRepo.DeleteArgsForCall(1)).To(Equal("the-second-route-guid"))
 						})
+
+						It("reports the deleted routes", func() {
+							runCommand("-f", "-r", "app-to-delete")
+
+							Expect(ui.Outputs()).To(ContainSubstrings(
+								[]string{"OK"},
+								[]string{"Deleted routes: my-app-is-good.com", "my-app-is-bad.com"},
+							))
+							Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"Kept routes"}))
+						})
+					})
+
+					Context("when a route is also mapped to another app", func() {
+						BeforeEach(func() {
+							routeRepo.FindStub = func(host string, domain models.DomainFields, path string, port int) (models.Route, error) {
+								if host == "my-app-is-bad.com" {
+									return models.Route{
+										Apps: []models.ApplicationFields{{GUID: "app-to-delete-guid"}, {GUID: "other-app-guid"}},
+									}, nil
+								}
+								return models.Route{
+									Apps: []models.ApplicationFields{{GUID: "app-to-delete-guid"}},
+								}, nil
+							}
+						})
+
+						It("only deletes the routes mapped exclusively to the app", func() {
+							runCommand("-f", "-r", "app-to-delete")
+
+							Expect(routeRepo.FindCallCount()).To(Equal(2))
+							Expect(routeRepo.DeleteCallCount()).To(Equal(1))
+							Expect(routeRepo.DeleteArgsForCall(0)).To(Equal("the-first-route-guid"))
+						})
+
+						It("previews which routes will be deleted and which will be kept", func() {
+							runCommand("-f", "-r", "app-to-delete")
+
+							Expect(ui.Outputs()).To(ContainSubstrings(
+								[]string{"delete routes: my-app-is-good.com"},
+								[]string{"keep routes mapped to other apps: my-app-is-bad.com"},
+								[]string{"remove service bindings: none"},
+							))
+						})
+
+						It("reports which routes were deleted and which were kept", func() {
+							runCommand("-f", "-r", "app-to-delete")
+
+							Expect(ui.Outputs()).To(ContainSubstrings(
+								[]string{"OK"},
+								[]string{"Deleted routes: my-app-is-good.com"},
+								[]string{"Kept routes mapped to other apps: my-app-is-bad.com"},
+							))
+						})
+					})
+
+					Context("when finding a route fails", func() {
+						BeforeEach(func() {
+							routeRepo.FindReturns(models.Route{}, errors.New("find-error"))
+						})
+
+						It("fails without deleting anything", func() {
+							runCommand("-f", "-r", "app-to-delete")
+
+							Expect(routeRepo.DeleteCallCount()).To(BeZero())
+							Expect(appRepo.DeleteCallCount()).To(BeZero())
+							Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}, []string{"find-error"}))
+						})
 					})
 
 					Context("when deleting routes fails", func() {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -15,10 +16,11 @@ import (
 )
 
 type DisableSSH struct {
-	ui      terminal.UI
-	config  coreconfig.Reader
-	appReq  requirements.ApplicationRequirement
-	appRepo applications.Repository
+	ui        terminal.UI
+	config    coreconfig.Reader
+	appReq    requirements.ApplicationRequirement
+	appRepo   applications.Repository
+	restarter *actors.RollingRestarter
 }
 
 func init() {
@@ -26,12 +28,16 @@ func init() {
 }
 
 func (cmd *DisableSSH) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["restart"] = &flags.BoolFlag{Name: "restart", Usage: T("Restart running instances one at a time so the change takes effect without downtime")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "disable-ssh",
 		Description: T("Disable ssh for the application"),
 		Usage: []string{
-			T("CF_NAME disable-ssh APP_NAME"),
+			T("CF_NAME disable-ssh APP_NAME [--restart]"),
 		},
+		Flags: fs,
 	}
 }
 
@@ -56,6 +62,7 @@ func (cmd *DisableSSH) SetDependency(deps commandregistry.Dependency, pluginCall
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
+	cmd.restarter = actors.NewRollingRestarter(deps.UI, deps.RepoLocator.GetAppInstancesRepository(), DefaultStartupTimeout, DefaultPingerThrottle)
 	return cmd
 }
 
@@ -64,6 +71,9 @@ func (cmd *DisableSSH) Execute(fc flags.FlagContext) error {
 
 	if !app.EnableSSH {
 		cmd.ui.Say(fmt.Sprintf(T("ssh support is already disabled")+" for '%s'", app.Name))
+		if fc.Bool("restart") {
+			return cmd.restarter.Restart(app)
+		}
 		return nil
 	}
 
@@ -85,5 +95,5 @@ func (cmd *DisableSSH) Execute(fc flags.FlagContext) error {
 	} else {
 		return errors.New(T("ssh support is not disabled for ") + app.Name)
 	}
-	return nil
+	return applySSHChange(cmd.ui, cmd.restarter, app, fc.Bool("restart"), "disable-ssh")
 }
@@ -2,6 +2,9 @@ package application_test
 
 import (
 	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/appinstances/appinstancesfakes"
 
 	"code.cloudfoundry.org/cli/cf/api/applications/applicationsfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -23,6 +26,7 @@ var _ = Describe("disable-ssh command", func() {
 		ui                  *testterm.FakeUI
 		requirementsFactory *requirementsfakes.FakeFactory
 		appRepo             *applicationsfakes.FakeRepository
+		appInstancesRepo    *appinstancesfakes.FakeAppInstancesRepository
 		configRepo          coreconfig.Repository
 		deps                commandregistry.Dependency
 	)
@@ -32,12 +36,14 @@ var _ = Describe("disable-ssh command", func() {
 		configRepo = testconfig.NewRepositoryWithDefaults()
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 		appRepo = new(applicationsfakes.FakeRepository)
+		appInstancesRepo = new(appinstancesfakes.FakeAppInstancesRepository)
 	})
 
 	updateCommandDependency := func(pluginCall bool) {
 		deps.UI = ui
 		deps.Config = configRepo
 		deps.RepoLocator = deps.RepoLocator.SetApplicationRepository(appRepo)
+		deps.RepoLocator = deps.RepoLocator.SetAppInstancesRepository(appInstancesRepo)
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("disable-ssh").SetDependency(deps, pluginCall))
 	}
 
@@ -98,6 +104,30 @@ var _ = Describe("disable-ssh command", func() {
 				runCommand("my-app")
 
 				Expect(ui.Outputs()).To(ContainSubstrings([]string{"ssh support is already disabled for 'my-app'"}))
+				Expect(appInstancesRepo.DeleteInstanceCallCount()).To(BeZero())
+			})
+
+			Context("when --restart is provided and the app is started", func() {
+				BeforeEach(func() {
+					app.State = models.ApplicationStateStarted
+					applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+					applicationReq.GetApplicationReturns(app)
+					requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+					appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+						{State: models.InstanceRunning, Since: time.Now().Add(time.Hour)},
+					}, nil)
+				})
+
+				It("restarts the running instances", func() {
+					runCommand("my-app", "--restart")
+
+					Expect(appInstancesRepo.DeleteInstanceCallCount()).To(Equal(1))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Restarting instance 1 of 1"},
+						[]string{"OK"},
+					))
+				})
 			})
 		})
 
@@ -124,6 +154,84 @@ var _ = Describe("disable-ssh command", func() {
 				})
 			})
 
+			Context("when the update succeeds and the app is started", func() {
+				BeforeEach(func() {
+					app.State = models.ApplicationStateStarted
+					applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+					applicationReq.GetApplicationReturns(app)
+					requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+					updatedApp := app
+					updatedApp.EnableSSH = false
+					appRepo.UpdateReturns(updatedApp, nil)
+				})
+
+				Context("when the app has running instances", func() {
+					BeforeEach(func() {
+						appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+							{State: models.InstanceRunning, Since: time.Now().Add(time.Hour)},
+							{State: models.InstanceRunning, Since: time.Now().Add(time.Hour)},
+						}, nil)
+					})
+
+					It("tells the user a restart is required", func() {
+						runCommand("my-app")
+
+						Expect(appInstancesRepo.DeleteInstanceCallCount()).To(BeZero())
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"TIP: restart required", "cf disable-ssh my-app --restart"},
+						))
+					})
+
+					Context("when --restart is provided", func() {
+						It("restarts each instance one at a time", func() {
+							runCommand("my-app", "--restart")
+
+							Expect(appInstancesRepo.DeleteInstanceCallCount()).To(Equal(2))
+							guid, index := appInstancesRepo.DeleteInstanceArgsForCall(0)
+							Expect(guid).To(Equal("my-app-guid"))
+							Expect(index).To(Equal(0))
+							_, index = appInstancesRepo.DeleteInstanceArgsForCall(1)
+							Expect(index).To(Equal(1))
+							Expect(ui.Outputs()).To(ContainSubstrings(
+								[]string{"Restarting instance 1 of 2"},
+								[]string{"Restarting instance 2 of 2"},
+							))
+							Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"TIP: restart required"}))
+						})
+
+						Context("when restarting an instance fails", func() {
+							BeforeEach(func() {
+								appInstancesRepo.DeleteInstanceReturns(errors.New("delete-error"))
+							})
+
+							It("notifies the user", func() {
+								runCommand("my-app", "--restart")
+
+								Expect(ui.Outputs()).To(ContainSubstrings(
+									[]string{"FAILED"},
+									[]string{"delete-error"},
+								))
+							})
+						})
+					})
+				})
+
+				Context("when the app has no running instances", func() {
+					BeforeEach(func() {
+						appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+							{State: models.InstanceCrashed},
+						}, nil)
+					})
+
+					It("does not suggest a restart", func() {
+						runCommand("my-app")
+
+						Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"TIP: restart required"}))
+					})
+				})
+			})
+
 			Context("Update fails", func() {
 				It("notifies user of any api error", func() {
 					appRepo.UpdateReturns(models.Application{}, errors.New("Error updating app."))
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -15,10 +17,11 @@ import (
 )
 
 type EnableSSH struct {
-	ui      terminal.UI
-	config  coreconfig.Reader
-	appReq  requirements.ApplicationRequirement
-	appRepo applications.Repository
+	ui        terminal.UI
+	config    coreconfig.Reader
+	appReq    requirements.ApplicationRequirement
+	appRepo   applications.Repository
+	restarter *actors.RollingRestarter
 }
 
 func init() {
@@ -26,12 +29,16 @@ func init() {
 }
 
 func (cmd *EnableSSH) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["restart"] = &flags.BoolFlag{Name: "restart", Usage: T("Restart running instances one at a time so the change takes effect without downtime")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "enable-ssh",
 		Description: T("Enable ssh for the application"),
 		Usage: []string{
-			T("CF_NAME enable-ssh APP_NAME"),
+			T("CF_NAME enable-ssh APP_NAME [--restart]"),
 		},
+		Flags: fs,
 	}
 }
 
@@ -56,6 +63,7 @@ func (cmd *EnableSSH) SetDependency(deps commandregistry.Dependency, pluginCall
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
+	cmd.restarter = actors.NewRollingRestarter(deps.UI, deps.RepoLocator.GetAppInstancesRepository(), DefaultStartupTimeout, DefaultPingerThrottle)
 	return cmd
 }
 
@@ -66,6 +74,9 @@ func (cmd *EnableSSH) Execute(fc flags.FlagContext) error {
 		cmd.ui.Say(T("ssh support is already enabled for '{{.AppName}}'", map[string]interface{}{
 			"AppName": app.Name,
 		}))
+		if fc.Bool("restart") {
+			return cmd.restarter.Restart(app)
+		}
 		return nil
 	}
 
@@ -85,5 +96,25 @@ func (cmd *EnableSSH) Execute(fc flags.FlagContext) error {
 	} else {
 		return errors.New(T("ssh support is not enabled for ") + app.Name)
 	}
+	return applySSHChange(cmd.ui, cmd.restarter, app, fc.Bool("restart"), "enable-ssh")
+}
+
+// applySSHChange makes an updated ssh setting take effect on the app's running
+// instances, either by restarting them or by telling the user it is needed.
+func applySSHChange(ui terminal.UI, restarter *actors.RollingRestarter, app models.Application, restart bool, commandName string) error {
+	if restart {
+		return restarter.Restart(app)
+	}
+
+	running, err := restarter.HasRunningInstances(app)
+	if err != nil || !running {
+		return nil
+	}
+
+	ui.Say(T("TIP: restart required. Running instances of {{.AppName}} keep the previous ssh setting until they are restarted. Use '{{.Command}}' to restart them one at a time.",
+		map[string]interface{}{
+			"AppName": app.Name,
+			"Command": terminal.CommandColor(fmt.Sprintf("%s %s %s --restart", cf.Name, commandName, app.Name)),
+		}))
 	return nil
 }
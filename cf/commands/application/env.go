@@ -4,22 +4,26 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 
+	"code.cloudfoundry.org/cli/cf/api/appinstances"
 	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
 type Env struct {
-	ui      terminal.UI
-	config  coreconfig.Reader
-	appRepo applications.Repository
+	ui               terminal.UI
+	config           coreconfig.Reader
+	appRepo          applications.Repository
+	appInstancesRepo appinstances.Repository
 }
 
 func init() {
@@ -27,13 +31,17 @@ func init() {
 }
 
 func (cmd *Env) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["running"] = &flags.BoolFlag{Name: "running", Usage: T("Compare the desired user-provided env variables with the ones last applied to running instances, and list the instances started before the app was last updated")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "env",
 		ShortName:   "e",
 		Description: T("Show all env variables for an app"),
 		Usage: []string{
-			T("CF_NAME env APP_NAME"),
+			T("CF_NAME env APP_NAME [--running]"),
 		},
+		Flags: fs,
 	}
 }
 
@@ -55,6 +63,7 @@ func (cmd *Env) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
+	cmd.appInstancesRepo = deps.RepoLocator.GetAppInstancesRepository()
 	return cmd
 }
 
@@ -76,6 +85,10 @@ func (cmd *Env) Execute(c flags.FlagContext) error {
 		return err
 	}
 
+	if c.Bool("running") {
+		return cmd.displayRunningInstancesEnvironment(app, env.Environment)
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
@@ -181,3 +194,124 @@ func (cmd *Env) displayStagingEnvironment(envVars map[string]interface{}) {
 		cmd.ui.Say("%s: %v", key, envVars[key])
 	}
 }
+
+// displayRunningInstancesEnvironment compares the desired user-provided env
+// variables with the ones of the app's deployed revision. The Cloud
+// Controller does not expose the env of individual instances, so the
+// comparison is with the env last applied to the app, and instances started
+// before the app was last updated are listed as possibly out of date.
+func (cmd *Env) displayRunningInstancesEnvironment(app models.Application, desired map[string]interface{}) error {
+	revision, err := cmd.appRepo.GetDeployedRevision(app.GUID)
+	if err != nil {
+		return err
+	}
+
+	instances, err := cmd.appInstancesRepo.GetInstances(app.GUID)
+	if err != nil && app.State != models.ApplicationStateStopped {
+		return err
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if revision.GUID == "" {
+		cmd.ui.Say(T("The env variables last applied to running instances are not available from this Cloud Controller."))
+		cmd.ui.Say("")
+		cmd.displayUserProvidedEnvironment(desired)
+	} else {
+		cmd.ui.Say(terminal.EntityNameColor(T("Desired vs last applied (revision {{.Version}}, deployed {{.DeployedAt}}):",
+			map[string]interface{}{
+				"Version":    revision.Version,
+				"DeployedAt": revision.CreatedAt.Local().Format("2006-01-02 03:04:05 PM"),
+			})))
+		cmd.ui.Say(T("Per-instance env variables are not available; this compares the desired env variables with the ones the app was last deployed with."))
+		cmd.ui.Say("")
+		err = cmd.displayEnvironmentDifferences(desired, revision.Environment)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.ui.Say("")
+	return cmd.displayOutdatedInstances(app.UpdatedAt, instances)
+}
+
+func (cmd *Env) displayEnvironmentDifferences(desired map[string]interface{}, applied map[string]interface{}) error {
+	keys := []string{}
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	for key := range applied {
+		if _, ok := desired[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		cmd.ui.Say(T("No user-defined env variables have been set"))
+		return nil
+	}
+	sort.Strings(keys)
+
+	table := cmd.ui.Table([]string{T("variable"), T("desired"), T("last applied"), T("difference")})
+	for _, key := range keys {
+		desiredValue, isDesired := desired[key]
+		appliedValue, isApplied := applied[key]
+
+		var desiredText, appliedText, difference string
+		if isDesired {
+			desiredText = fmt.Sprintf("%v", desiredValue)
+		}
+		if isApplied {
+			appliedText = fmt.Sprintf("%v", appliedValue)
+		}
+
+		switch {
+		case !isApplied:
+			difference = terminal.WarningColor(T("not applied"))
+		case !isDesired:
+			difference = terminal.WarningColor(T("removed"))
+		case desiredText != appliedText:
+			difference = terminal.WarningColor(T("changed"))
+		}
+
+		table.Add(key, desiredText, appliedText, difference)
+	}
+	return table.Print()
+}
+
+func (cmd *Env) displayOutdatedInstances(updatedAt *time.Time, instances []models.AppInstanceFields) error {
+	if len(instances) == 0 {
+		cmd.ui.Say(T("There are no running instances."))
+		return nil
+	}
+	if updatedAt == nil {
+		cmd.ui.Say(T("The time the app was last updated is unknown, so instances started before it cannot be listed."))
+		return nil
+	}
+
+	lastUpdated := updatedAt.Local().Format("2006-01-02 03:04:05 PM")
+
+	table := cmd.ui.Table([]string{"", T("state"), T("since")})
+	outdated := 0
+	for index, instance := range instances {
+		if instance.Since.IsZero() || !instance.Since.Before(*updatedAt) {
+			continue
+		}
+		outdated++
+		table.Add(
+			fmt.Sprintf("#%d", index),
+			string(instance.State),
+			instance.Since.Format("2006-01-02 03:04:05 PM"),
+		)
+	}
+
+	if outdated == 0 {
+		cmd.ui.Say(T("All instances were started after the app was last updated at {{.UpdatedAt}}.",
+			map[string]interface{}{"UpdatedAt": lastUpdated}))
+		return nil
+	}
+
+	cmd.ui.Say(T("Instances started before the app was last updated at {{.UpdatedAt}} may not have the desired env variables; restart them to apply it:",
+		map[string]interface{}{"UpdatedAt": lastUpdated}))
+	return table.Print()
+}
@@ -1,6 +1,9 @@
 package application_test
 
 import (
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/appinstances/appinstancesfakes"
 	"code.cloudfoundry.org/cli/cf/api/applications/applicationsfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -22,6 +25,7 @@ var _ = Describe("env command", func() {
 		ui                  *testterm.FakeUI
 		app                 models.Application
 		appRepo             *applicationsfakes.FakeRepository
+		appInstancesRepo    *appinstancesfakes.FakeRepository
 		configRepo          coreconfig.Repository
 		requirementsFactory *requirementsfakes.FakeFactory
 		deps                commandregistry.Dependency
@@ -31,6 +35,7 @@ var _ = Describe("env command", func() {
 		deps.UI = ui
 		deps.Config = configRepo
 		deps.RepoLocator = deps.RepoLocator.SetApplicationRepository(appRepo)
+		deps.RepoLocator = deps.RepoLocator.SetAppInstancesRepository(appInstancesRepo)
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("env").SetDependency(deps, pluginCall))
 	}
 
@@ -41,6 +46,7 @@ var _ = Describe("env command", func() {
 		app.Name = "my-app"
 		appRepo = new(applicationsfakes.FakeRepository)
 		appRepo.ReadReturns(app, nil)
+		appInstancesRepo = new(appinstancesfakes.FakeRepository)
 
 		configRepo = testconfig.NewRepositoryWithDefaults()
 		requirementsFactory = new(requirementsfakes.FakeFactory)
@@ -224,4 +230,121 @@ var _ = Describe("env command", func() {
 			Expect(ui.Outputs()).To(ContainSubstrings([]string{"you're drunk"}))
 		})
 	})
+
+	Context("when the --running flag is passed", func() {
+		var updatedAt time.Time
+
+		BeforeEach(func() {
+			updatedAt = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+			app = models.Application{}
+			app.Name = "my-app"
+			app.GUID = "the-app-guid"
+			app.State = models.ApplicationStateStarted
+			app.UpdatedAt = &updatedAt
+			appRepo.ReadReturns(app, nil)
+
+			appRepo.ReadEnvReturns(&models.Environment{
+				Environment: map[string]interface{}{
+					"same-key":    "same-value",
+					"changed-key": "new-value",
+					"added-key":   "added-value",
+				},
+			}, nil)
+
+			appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+				{State: models.InstanceRunning, Since: updatedAt.Add(-time.Hour)},
+				{State: models.InstanceRunning, Since: updatedAt.Add(time.Hour)},
+			}, nil)
+		})
+
+		Context("when the deployed revision is available", func() {
+			BeforeEach(func() {
+				appRepo.GetDeployedRevisionReturns(models.Revision{
+					GUID:      "revision-guid",
+					Version:   3,
+					CreatedAt: updatedAt.Add(-2 * time.Hour),
+					Environment: map[string]interface{}{
+						"same-key":    "same-value",
+						"changed-key": "old-value",
+						"removed-key": "removed-value",
+					},
+				}, nil)
+			})
+
+			It("compares the desired env variables with the last applied ones", func() {
+				Expect(runCommand("my-app", "--running")).To(BeTrue())
+				Expect(appRepo.GetDeployedRevisionArgsForCall(0)).To(Equal("the-app-guid"))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"OK"},
+					[]string{"Desired vs last applied", "revision 3"},
+					[]string{"Per-instance env variables are not available"},
+					[]string{"variable", "desired", "last applied", "difference"},
+					[]string{"added-key", "added-value", "not applied"},
+					[]string{"changed-key", "new-value", "old-value", "changed"},
+					[]string{"removed-key", "removed-value", "removed"},
+					[]string{"same-key", "same-value", "same-value"},
+				))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"Running Environment Variable Groups:"}))
+			})
+
+			It("lists the instances started before the app was last updated", func() {
+				runCommand("my-app", "--running")
+				Expect(appInstancesRepo.GetInstancesArgsForCall(0)).To(Equal("the-app-guid"))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Instances started before the app was last updated"},
+					[]string{"#0", "running"},
+				))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"#1"}))
+			})
+
+			Context("when every instance was started after the last update", func() {
+				BeforeEach(func() {
+					appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+						{State: models.InstanceRunning, Since: updatedAt.Add(time.Hour)},
+					}, nil)
+				})
+
+				It("says so", func() {
+					runCommand("my-app", "--running")
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"All instances were started after the app was last updated"},
+					))
+				})
+			})
+		})
+
+		Context("when the deployed revision is not available", func() {
+			It("says the comparison is not available and shows the desired env variables", func() {
+				Expect(runCommand("my-app", "--running")).To(BeTrue())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"The env variables last applied to running instances are not available"},
+					[]string{"User-Provided:"},
+					[]string{"added-key", ":", "added-value"},
+					[]string{"Instances started before the app was last updated"},
+				))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"Desired vs last applied"}))
+			})
+		})
+
+		Context("when the app is stopped", func() {
+			BeforeEach(func() {
+				app.State = models.ApplicationStateStopped
+				appRepo.ReadReturns(app, nil)
+				appInstancesRepo.GetInstancesReturns(nil, errors.New("app is stopped"))
+			})
+
+			It("says there are no running instances", func() {
+				Expect(runCommand("my-app", "--running")).To(BeTrue())
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"There are no running instances."}))
+			})
+		})
+
+		Context("when reading the instances fails for a started app", func() {
+			It("returns the error", func() {
+				appInstancesRepo.GetInstancesReturns(nil, errors.New("instances unavailable"))
+				Expect(runCommand("my-app", "--running")).To(BeFalse())
+			})
+		})
+	})
 })
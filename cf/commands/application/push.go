@@ -16,6 +16,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/api/authentication"
+	"code.cloudfoundry.org/cli/cf/api/resources"
 	"code.cloudfoundry.org/cli/cf/api/stacks"
 	"code.cloudfoundry.org/cli/cf/appfiles"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -48,6 +49,8 @@ type Push struct {
 	routeActor    actors.RouteActor
 	zipper        appfiles.Zipper
 	appfiles      appfiles.AppFiles
+	uploadCache   appfiles.UploadCache
+	uploadRetries int
 }
 
 func init() {
@@ -64,18 +67,25 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 	fs["k"] = &flags.StringFlag{ShortName: "k", Usage: T("Disk limit (e.g. 256M, 1024M, 1G)")}
 	fs["m"] = &flags.StringFlag{ShortName: "m", Usage: T("Memory limit (e.g. 256M, 1024M, 1G)")}
 	fs["hostname"] = &flags.StringFlag{Name: "hostname", ShortName: "n", Usage: T("Hostname (e.g. my-subdomain)")}
-	fs["p"] = &flags.StringFlag{ShortName: "p", Usage: T("Path to app directory or to a zip file of the contents of the app directory")}
+	fs["p"] = &flags.StringFlag{ShortName: "p", Usage: T("Path to app directory or to a zip file of the contents of the app directory, or an http(s) URL of a zip file or git+ URL of a repository (e.g. git+https://example.com/app.git#v1.0)")}
 	fs["s"] = &flags.StringFlag{ShortName: "s", Usage: T("Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)")}
 	fs["t"] = &flags.StringFlag{ShortName: "t", Usage: T("Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app")}
 	fs["docker-image"] = &flags.StringFlag{Name: "docker-image", ShortName: "o", Usage: T("Docker-image to be used (e.g. user/docker-image-name)")}
 	fs["docker-username"] = &flags.StringFlag{Name: "docker-username", Usage: T("Repository username; used with password from environment variable CF_DOCKER_PASSWORD")}
 	fs["health-check-type"] = &flags.StringFlag{Name: "health-check-type", ShortName: "u", Usage: T("Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')")}
 	fs["no-hostname"] = &flags.BoolFlag{Name: "no-hostname", Usage: T("Map the root domain to this app")}
+	fs["no-logs-on-failure"] = &flags.BoolFlag{Name: "no-logs-on-failure", Usage: T("Do not display the recent logs and last crash of the app when it fails to stage or start")}
 	fs["no-manifest"] = &flags.BoolFlag{Name: "no-manifest", Usage: T("Ignore manifest file")}
 	fs["no-route"] = &flags.BoolFlag{Name: "no-route", Usage: T("Do not map a route to this app and remove routes from previous pushes of this app")}
 	fs["no-start"] = &flags.BoolFlag{Name: "no-start", Usage: T("Do not start an app after pushing")}
 	fs["random-route"] = &flags.BoolFlag{Name: "random-route", Usage: T("Create a random route for this app")}
+	fs["retries"] = &flags.IntFlag{Name: "retries", Usage: T("Number of times to retry uploading the app files if the upload fails, reusing the files already prepared")}
 	fs["route-path"] = &flags.StringFlag{Name: "route-path", Usage: T("Path for the route")}
+	fs["sha256"] = &flags.StringFlag{Name: "sha256", Usage: T("Expected SHA-256 checksum of the zip file downloaded from the -p URL")}
+	fs["wait-for-http"] = &flags.StringFlag{Name: "wait-for-http", Usage: T("After the app is running, wait until a GET of this path on its first HTTP route returns a 2xx status (uses the startup timeout)")}
+	fs["var"] = &flags.StringSliceFlag{Name: "var", Usage: T("Variable key value pair for variable substitution (e.g. name=app1); can specify multiple times. Takes precedence over --vars-file and --vars-env")}
+	fs["vars-file"] = &flags.StringSliceFlag{Name: "vars-file", Usage: T("Path to a variable substitution file for manifest; can specify multiple times, later files take precedence over earlier ones and over --vars-env")}
+	fs["vars-env"] = &flags.StringFlag{Name: "vars-env", Usage: T("Resolve manifest variables not set with --var or --vars-file from environment variables; ((name)) reads PREFIX_name")}
 	// Hidden:true to hide app-ports for release #117189491
 	fs["app-ports"] = &flags.StringFlag{Name: "app-ports", Usage: T("Comma delimited list of ports the application may listen on"), Hidden: true}
 
@@ -84,7 +94,7 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 		ShortName:   "p",
 		Description: T("Push a new app or sync changes to an existing app"),
 		// strings.Replace \\n with newline so this string matches the new usage string but still gets displayed correctly
-		Usage: []string{strings.Replace(T("cf push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\\n\\n   cf push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\\n\\n   cf push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"), "\\n", "\n", -1)},
+		Usage: []string{strings.Replace(T("cf push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--retries N]\\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH | -p URL [--sha256 CHECKSUM]] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH] [--vars-env PREFIX] [--wait-for-http STATUS_PATH]\\n\\n   cf push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH] [--vars-env PREFIX] [--wait-for-http STATUS_PATH]\\n\\n   cf push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start] [--retries N]\\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH] [--vars-env PREFIX] [--wait-for-http STATUS_PATH]"), "\\n", "\n", -1)},
 		Flags: fs,
 	}
 }
@@ -100,6 +110,10 @@ func (cmd *Push) Requirements(requirementsFactory requirements.Factory, fc flags
 
 	reqs = append(reqs, usageReq)
 
+	if fc.Int("retries") < 0 {
+		return nil, errors.New(T("Incorrect Usage: --retries must not be negative") + "\n\n" + commandregistry.Commands.CommandUsage("push"))
+	}
+
 	if fc.String("route-path") != "" {
 		reqs = append(reqs, requirementsFactory.NewMinAPIVersionRequirement("Option '--route-path'", cf.RoutePathMinimumAPIVersion))
 	}
@@ -147,11 +161,14 @@ func (cmd *Push) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 	cmd.routeActor = deps.RouteActor
 	cmd.zipper = deps.AppZipper
 	cmd.appfiles = deps.AppFiles
+	cmd.uploadCache = deps.AppUploadCache
 
 	return cmd
 }
 
 func (cmd *Push) Execute(c flags.FlagContext) error {
+	cmd.uploadRetries = c.Int("retries")
+
 	appsFromManifest, err := cmd.getAppParamsFromManifest(c)
 	if err != nil {
 		return err
@@ -272,7 +289,11 @@ func (cmd *Push) Execute(c flags.FlagContext) error {
 		}
 
 		if appParams.DockerImage == nil {
-			err = cmd.actor.ProcessPath(*appParams.Path, cmd.processPathCallback(*appParams.Path, app))
+			if actors.IsRemoteAppPath(*appParams.Path) {
+				err = cmd.actor.ProcessRemotePath(*appParams.Path, c.String("sha256"), cmd.processPathCallback(*appParams.Path, app))
+			} else {
+				err = cmd.actor.ProcessPath(*appParams.Path, cmd.processPathCallback(*appParams.Path, app))
+			}
 			if err != nil {
 				return errors.New(
 					T("Error processing app files: {{.Error}}",
@@ -534,9 +555,28 @@ func (cmd *Push) fetchStackGUID(appParams *models.AppParams) error {
 
 	cmd.ui.Ok()
 	appParams.StackGUID = &stack.GUID
+
+	if isDeprecatedStack(stack.Name) {
+		cmd.ui.Warn(T("Stack {{.StackName}} is deprecated. Use -s or the stack manifest attribute to push {{.AppName}} to a supported stack.",
+			map[string]interface{}{
+				"StackName": stack.Name,
+				"AppName":   *appParams.Name,
+			}))
+	}
 	return nil
 }
 
+// isDeprecatedStack returns true when the stack is listed in the comma
+// separated $CF_STACK_DEPRECATION_WARNINGS environment variable.
+func isDeprecatedStack(stackName string) bool {
+	for _, deprecatedStack := range strings.Split(os.Getenv("CF_STACK_DEPRECATION_WARNINGS"), ",") {
+		if deprecatedStack = strings.TrimSpace(deprecatedStack); deprecatedStack != "" && strings.EqualFold(stackName, deprecatedStack) {
+			return true
+		}
+	}
+	return false
+}
+
 func (cmd *Push) restart(app models.Application, params models.AppParams, c flags.FlagContext) error {
 	if app.State != T("stopped") {
 		cmd.ui.Say("")
@@ -552,6 +592,20 @@ func (cmd *Push) restart(app models.Application, params models.AppParams, c flag
 	if params.HealthCheckTimeout != nil {
 		cmd.appStarter.SetStartTimeoutInSeconds(*params.HealthCheckTimeout)
 	}
+	if params.StagingTimeout != nil {
+		cmd.appStarter.SetStagingTimeoutInMinutes(*params.StagingTimeout)
+	}
+	if c.String("wait-for-http") != "" {
+		cmd.appStarter.SetWaitForHTTPPath(c.String("wait-for-http"))
+	}
+	cmd.appStarter.SetNoLogsOnFailure(c.Bool("no-logs-on-failure"))
+
+	stagingTimeout, startupTimeout := cmd.appStarter.Timeouts()
+	cmd.ui.Say(T("Staging timeout: {{.StagingTimeout}}, startup timeout: {{.StartupTimeout}}",
+		map[string]interface{}{
+			"StagingTimeout": stagingTimeout,
+			"StartupTimeout": startupTimeout,
+		}))
 
 	_, err := cmd.appStarter.ApplicationStart(app, cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name)
 	if err != nil {
@@ -586,6 +640,18 @@ func (cmd *Push) getAppParamsFromManifest(c flags.FlagContext) ([]models.AppPara
 		return nil, errors.New(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
 	}
 
+	sources, err := manifestVariableSources(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sources) > 0 {
+		err = m.InterpolateVariables(sources)
+		if err != nil {
+			return nil, errors.New(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+		}
+	}
+
 	apps, err := m.Applications()
 	if err != nil {
 		return nil, errors.New(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
@@ -596,6 +662,36 @@ func (cmd *Push) getAppParamsFromManifest(c flags.FlagContext) ([]models.AppPara
 	return apps, nil
 }
 
+// manifestVariableSources returns the sources for ((name)) manifest variables
+// in the order they are consulted: --var, then --vars-file with later files
+// first, then --vars-env.
+func manifestVariableSources(c flags.FlagContext) ([]manifest.VariableSource, error) {
+	var sources []manifest.VariableSource
+
+	if len(c.StringSlice("var")) > 0 {
+		vars, err := manifest.NewVarFlagVariables(c.StringSlice("var"))
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, vars)
+	}
+
+	varsFiles := c.StringSlice("vars-file")
+	for i := len(varsFiles) - 1; i >= 0; i-- {
+		vars, err := manifest.ReadVariablesFile(varsFiles[i])
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, vars)
+	}
+
+	if c.String("vars-env") != "" {
+		sources = append(sources, manifest.EnvironmentVariables{Prefix: c.String("vars-env")})
+	}
+
+	return sources, nil
+}
+
 func (cmd *Push) createAppSetFromContextAndManifest(contextApp models.AppParams, manifestApps []models.AppParams) ([]models.AppParams, error) {
 	var err error
 	var apps []models.AppParams
@@ -797,6 +893,10 @@ func (cmd *Push) getAppParamsFromContext(c flags.FlagContext) (models.AppParams,
 		appParams.Path = &path
 	}
 
+	if c.String("sha256") != "" && !actors.IsRemoteAppPath(c.String("p")) {
+		return models.AppParams{}, errors.New(T("Option '--sha256' can only be used with a URL passed to '-p'"))
+	}
+
 	if c.String("s") != "" {
 		stackName := c.String("s")
 		appParams.StackName = &stackName
@@ -839,11 +939,61 @@ func (cmd Push) ValidateContextAndAppParams(appsFromManifest []models.AppParams,
 }
 
 func (cmd *Push) uploadApp(appGUID, appDir, appDirOrZipFile string, localFiles []models.AppFileFields) error {
-	uploadDir, err := ioutil.TempDir("", "apps")
+	digest := appfiles.Digest(localFiles)
+
+	zipFile, remoteFiles := cmd.loadPreparedUpload(appGUID, appDir, digest)
+	cached := zipFile != nil
+	if !cached {
+		var err error
+		zipFile, remoteFiles, cached, err = cmd.prepareUpload(appGUID, appDir, digest, localFiles)
+		if err != nil {
+			return err
+		}
+	}
+	defer func() {
+		zipFile.Close()
+		if !cached {
+			os.Remove(zipFile.Name())
+		}
+	}()
+
+	err := cmd.uploadWithRetries(appGUID, zipFile, remoteFiles)
 	if err != nil {
 		return err
 	}
 
+	return cmd.uploadCache.Remove(appGUID)
+}
+
+// loadPreparedUpload returns the zip and matched files saved in the upload
+// cache by an earlier push of the same app files, or a nil file if there are
+// none.
+func (cmd *Push) loadPreparedUpload(appGUID, appDir, digest string) (*os.File, []resources.AppFileResource) {
+	entry, ok := cmd.uploadCache.Load(appGUID, digest)
+	if !ok {
+		return nil, nil
+	}
+
+	zipFile, err := os.Open(entry.ZipPath)
+	if err != nil {
+		return nil, nil
+	}
+
+	cmd.ui.Say(T("Reusing the app files prepared for an earlier upload of {{.Path}}", map[string]interface{}{"Path": appDir}))
+	return zipFile, entry.PresentFiles
+}
+
+// prepareUpload matches the app files against the Cloud Controller's resource
+// cache and zips the ones it does not have. The zip is written to the upload
+// cache when possible so that a failed upload can be retried without repeating
+// this work.
+func (cmd *Push) prepareUpload(appGUID, appDir, digest string, localFiles []models.AppFileFields) (*os.File, []resources.AppFileResource, bool, error) {
+	uploadDir, err := ioutil.TempDir("", "apps")
+	if err != nil {
+		return nil, nil, false, err
+	}
+	defer os.RemoveAll(uploadDir)
+
 	remoteFiles, hasFileToUpload, err := cmd.actor.GatherFiles(localFiles, appDir, uploadDir, true)
 
 	if httpError, isHTTPError := err.(errors.HTTPError); isHTTPError && httpError.StatusCode() == 504 {
@@ -852,47 +1002,118 @@ func (cmd *Push) uploadApp(appGUID, appDir, appDirOrZipFile string, localFiles [
 	}
 
 	if err != nil {
-		return err
+		return nil, nil, false, err
 	}
 
-	zipFile, err := ioutil.TempFile("", "uploads")
-	if err != nil {
-		return err
+	zipFile, cached := cmd.createZipFile(appGUID, digest)
+	if zipFile == nil {
+		zipFile, err = ioutil.TempFile("", "uploads")
+		if err != nil {
+			return nil, nil, false, err
+		}
 	}
-	defer func() {
+
+	err = cmd.zipAppFiles(appDir, uploadDir, zipFile, hasFileToUpload)
+	if err != nil {
 		zipFile.Close()
 		os.Remove(zipFile.Name())
-	}()
+		return nil, nil, false, err
+	}
 
-	if hasFileToUpload {
-		err = cmd.zipper.Zip(uploadDir, zipFile)
-		if err != nil {
-			if emptyDirErr, ok := err.(*errors.EmptyDirError); ok {
-				return emptyDirErr
+	if cached && cmd.uploadCache.Save(appGUID, digest, remoteFiles) != nil {
+		cached = false
+	}
+
+	return zipFile, remoteFiles, cached, nil
+}
+
+// createZipFile returns the upload cache file the zip should be written to, or
+// nil if the cache cannot be used.
+func (cmd *Push) createZipFile(appGUID, digest string) (*os.File, bool) {
+	if cmd.uploadCache.Dir == "" {
+		return nil, false
+	}
+
+	zipFile, err := cmd.uploadCache.CreateZip(appGUID, digest)
+	if err != nil {
+		return nil, false
+	}
+	return zipFile, true
+}
+
+func (cmd *Push) zipAppFiles(appDir, uploadDir string, zipFile *os.File, hasFileToUpload bool) error {
+	if !hasFileToUpload {
+		return nil
+	}
+
+	err := cmd.zipper.Zip(uploadDir, zipFile)
+	if err != nil {
+		if emptyDirErr, ok := err.(*errors.EmptyDirError); ok {
+			return emptyDirErr
+		}
+		return fmt.Errorf("%s: %s", T("Error zipping application"), err.Error())
+	}
+
+	zipFileSize, err := cmd.zipper.GetZipSize(zipFile)
+	if err != nil {
+		return err
+	}
+
+	zipFileCount := cmd.appfiles.CountFiles(uploadDir)
+	if zipFileCount > 0 {
+		cmd.ui.Say(T("Uploading app files from: {{.Path}}", map[string]interface{}{"Path": appDir}))
+		cmd.ui.Say(T("Uploading {{.ZipFileBytes}}, {{.FileCount}} files",
+			map[string]interface{}{
+				"ZipFileBytes": formatters.ByteSize(zipFileSize),
+				"FileCount":    zipFileCount}))
+	}
+	return nil
+}
+
+// uploadWithRetries uploads the zip up to cmd.uploadRetries more times after
+// a failure. When the Cloud Controller accepted the bits but the job
+// processing them could not be polled, later attempts resume polling that job
+// instead of sending the bits again.
+func (cmd *Push) uploadWithRetries(appGUID string, zipFile *os.File, presentFiles []resources.AppFileResource) error {
+	var jobURL string
+	for attempt := 1; ; attempt++ {
+		var err error
+		if jobURL != "" {
+			err = cmd.actor.ResumeUpload(jobURL)
+		} else {
+			_, err = zipFile.Seek(0, os.SEEK_SET)
+			if err != nil {
+				return err
 			}
-			return fmt.Errorf("%s: %s", T("Error zipping application"), err.Error())
+			err = cmd.actor.UploadApp(appGUID, zipFile, presentFiles)
 		}
 
-		var zipFileSize int64
-		zipFileSize, err = cmd.zipper.GetZipSize(zipFile)
-		if err != nil {
+		if err == nil {
+			return nil
+		}
+		if attempt > cmd.uploadRetries || !isRetryableUploadError(err) {
 			return err
 		}
 
-		zipFileCount := cmd.appfiles.CountFiles(uploadDir)
-		if zipFileCount > 0 {
-			cmd.ui.Say(T("Uploading app files from: {{.Path}}", map[string]interface{}{"Path": appDir}))
-			cmd.ui.Say(T("Uploading {{.ZipFileBytes}}, {{.FileCount}} files",
-				map[string]interface{}{
-					"ZipFileBytes": formatters.ByteSize(zipFileSize),
-					"FileCount":    zipFileCount}))
+		jobURL = ""
+		if pollingErr, ok := err.(*errors.AsyncJobPollingError); ok {
+			jobURL = pollingErr.JobURL
+			cmd.ui.Warn(T("Checking on the upload failed: {{.Error}}\nResuming ({{.Attempt}} of {{.Retries}} retries)...",
+				map[string]interface{}{"Error": err.Error(), "Attempt": attempt, "Retries": cmd.uploadRetries}))
+		} else {
+			cmd.ui.Warn(T("Uploading failed: {{.Error}}\nRetrying ({{.Attempt}} of {{.Retries}} retries)...",
+				map[string]interface{}{"Error": err.Error(), "Attempt": attempt, "Retries": cmd.uploadRetries}))
 		}
 	}
+}
 
-	err = os.RemoveAll(uploadDir)
-	if err != nil {
-		return err
+// isRetryableUploadError returns false for errors the Cloud Controller
+// reports about the request itself, which would fail again the same way.
+func isRetryableUploadError(err error) bool {
+	if pollingErr, ok := err.(*errors.AsyncJobPollingError); ok {
+		err = pollingErr.Err
 	}
 
-	return cmd.actor.UploadApp(appGUID, zipFile, remoteFiles)
+	httpErr, ok := err.(errors.HTTPError)
+	return !ok || httpErr.StatusCode() >= 500
 }
@@ -1,9 +1,11 @@
 package application_test
 
 import (
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/actors/actorsfakes"
@@ -12,6 +14,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/api/authentication/authenticationfakes"
 	"code.cloudfoundry.org/cli/cf/api/resources"
 	"code.cloudfoundry.org/cli/cf/api/stacks/stacksfakes"
+	cfappfiles "code.cloudfoundry.org/cli/cf/appfiles"
 	"code.cloudfoundry.org/cli/cf/appfiles/appfilesfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands/application"
@@ -194,6 +197,16 @@ var _ = Describe("Push Command", func() {
 			})
 		})
 
+		Context("when --retries is negative", func() {
+			It("returns an error", func() {
+				err := flagContext.Parse("app-name", "--retries", "-1")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = cmd.Requirements(requirementsFactory, flagContext)
+				Expect(err).To(MatchError(ContainSubstring("Incorrect Usage: --retries must not be negative")))
+			})
+		})
+
 		Context("when --app-ports is passed in", func() {
 			BeforeEach(func() {
 				err := flagContext.Parse("app-name", "--app-ports", "the-app-port")
@@ -642,6 +655,7 @@ var _ = Describe("Push Command", func() {
 							}, nil
 						}
 						args = []string{"-t", "111", "app-name"}
+						starter.TimeoutsReturns(15*time.Minute, 111*time.Second)
 					})
 
 					It("doesn't error", func() {
@@ -670,6 +684,25 @@ var _ = Describe("Push Command", func() {
 						Expect(orgName).To(Equal(configRepo.OrganizationFields().Name))
 						Expect(spaceName).To(Equal(configRepo.SpaceFields().Name))
 						Expect(starter.SetStartTimeoutInSecondsArgsForCall(0)).To(Equal(111))
+						Expect(starter.SetNoLogsOnFailureCallCount()).To(Equal(1))
+						Expect(starter.SetNoLogsOnFailureArgsForCall(0)).To(BeFalse())
+					})
+
+					It("displays the effective timeouts before starting the app", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(terminal.Decolorize(string(output.Contents()))).To(ContainSubstring("Staging timeout: 15m0s, startup timeout: 1m51s"))
+					})
+
+					Context("when --no-logs-on-failure is provided", func() {
+						BeforeEach(func() {
+							args = []string{"--no-logs-on-failure", "app-name"}
+						})
+
+						It("tells the starter not to display logs on failure", func() {
+							Expect(executeErr).NotTo(HaveOccurred())
+							Expect(starter.SetNoLogsOnFailureCallCount()).To(Equal(1))
+							Expect(starter.SetNoLogsOnFailureArgsForCall(0)).To(BeTrue())
+						})
 					})
 				})
 
@@ -1250,6 +1283,50 @@ var _ = Describe("Push Command", func() {
 					})
 				})
 
+				Context("when a URL is specified with the -p flag", func() {
+					BeforeEach(func() {
+						actor.ProcessRemotePathStub = func(url string, checksum string, cb func(string) error) error {
+							return cb("/tmp/remote-app/app")
+						}
+						args = []string{"-p", "https://example.com/app.zip", "--sha256", "some-checksum", "app-with-path"}
+					})
+
+					It("pushes the contents of the downloaded archive", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						Expect(actor.ProcessPathCallCount()).To(Equal(0))
+						Expect(actor.ProcessRemotePathCallCount()).To(Equal(1))
+						url, checksum, _ := actor.ProcessRemotePathArgsForCall(0)
+						Expect(url).To(Equal("https://example.com/app.zip"))
+						Expect(checksum).To(Equal("some-checksum"))
+
+						_, appDir, _, _ := actor.GatherFilesArgsForCall(0)
+						Expect(appDir).To(Equal("/tmp/remote-app/app"))
+					})
+
+					Context("when fetching the URL fails", func() {
+						BeforeEach(func() {
+							actor.ProcessRemotePathStub = nil
+							actor.ProcessRemotePathReturns(errors.New("download-error"))
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError("Error processing app files: download-error"))
+						})
+					})
+				})
+
+				Context("when --sha256 is specified without a URL", func() {
+					BeforeEach(func() {
+						args = []string{"-p", "../some/path-to/an-app/file.zip", "--sha256", "some-checksum", "app-with-path"}
+					})
+
+					It("returns an error", func() {
+						Expect(executeErr).To(MatchError("Option '--sha256' can only be used with a URL passed to '-p'"))
+						Expect(actor.ProcessPathCallCount()).To(Equal(0))
+					})
+				})
+
 				Context("when no flags are specified", func() {
 					BeforeEach(func() {
 						m := &manifest.Manifest{
@@ -1298,6 +1375,56 @@ var _ = Describe("Push Command", func() {
 					})
 				})
 
+				Context("when the manifest uses variables", func() {
+					BeforeEach(func() {
+						m := &manifest.Manifest{
+							Path: "manifest.yml",
+							Data: generic.NewMap(map[interface{}]interface{}{
+								"applications": []interface{}{
+									generic.NewMap(map[interface{}]interface{}{
+										"name":      "((app-name))",
+										"instances": "((instances))",
+									}),
+								},
+							}),
+						}
+						manifestRepo.ReadManifestReturns(m, nil)
+						Expect(os.Setenv("PUSH_TEST_app-name", "env-app")).To(Succeed())
+						Expect(os.Setenv("PUSH_TEST_instances", "4")).To(Succeed())
+					})
+
+					AfterEach(func() {
+						Expect(os.Unsetenv("PUSH_TEST_app-name")).To(Succeed())
+						Expect(os.Unsetenv("PUSH_TEST_instances")).To(Succeed())
+					})
+
+					Context("when --vars-env is passed", func() {
+						BeforeEach(func() {
+							args = []string{"--vars-env", "PUSH_TEST", "--var", "instances=2"}
+						})
+
+						It("resolves variables from --var before the environment", func() {
+							Expect(executeErr).NotTo(HaveOccurred())
+							Expect(appRepo.CreateCallCount()).To(Equal(1))
+							params := appRepo.CreateArgsForCall(0)
+							Expect(*params.Name).To(Equal("env-app"))
+							Expect(*params.InstanceCount).To(Equal(2))
+						})
+					})
+
+					Context("when a variable cannot be resolved", func() {
+						BeforeEach(func() {
+							args = []string{"--var", "instances=2"}
+						})
+
+						It("lists the missing variables and the sources consulted", func() {
+							Expect(executeErr).To(HaveOccurred())
+							Expect(executeErr.Error()).To(ContainSubstring("Unable to resolve manifest variables: app-name"))
+							Expect(executeErr.Error()).To(ContainSubstring("Sources consulted, in order: --var"))
+						})
+					})
+				})
+
 				Context("when the current directory does not contain a manifest", func() {
 					BeforeEach(func() {
 						deps.UI = uiWithContents
@@ -1721,6 +1848,34 @@ var _ = Describe("Push Command", func() {
 				})
 			})
 
+			Context("when the manifest provides a staging timeout", func() {
+				BeforeEach(func() {
+					m := &manifest.Manifest{
+						Path: "manifest.yml",
+						Data: generic.NewMap(map[interface{}]interface{}{
+							"applications": []interface{}{
+								generic.NewMap(map[interface{}]interface{}{
+									"name":            "manifest-app-name",
+									"staging-timeout": 30,
+									"timeout":         360,
+								}),
+							},
+						}),
+					}
+					manifestRepo.ReadManifestReturns(m, nil)
+
+					args = []string{"existing-app"}
+				})
+
+				It("uses the staging and startup timeouts from the manifest", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					Expect(starter.SetStagingTimeoutInMinutesCallCount()).To(Equal(1))
+					Expect(starter.SetStagingTimeoutInMinutesArgsForCall(0)).To(Equal(30))
+					Expect(starter.SetStartTimeoutInSecondsArgsForCall(0)).To(Equal(360))
+				})
+			})
+
 			Context("when the app is already stopped", func() {
 				BeforeEach(func() {
 					existingApp.State = "stopped"
@@ -1772,6 +1927,35 @@ var _ = Describe("Push Command", func() {
 				})
 			})
 
+			Context("when the requested stack is deprecated", func() {
+				var originalDeprecatedStacks string
+
+				BeforeEach(func() {
+					originalDeprecatedStacks = os.Getenv("CF_STACK_DEPRECATION_WARNINGS")
+					Expect(os.Setenv("CF_STACK_DEPRECATION_WARNINGS", "windows2012R2, cflinuxfs2")).To(Succeed())
+
+					appRepo.ReadReturns(existingApp, nil)
+					appRepo.UpdateReturns(existingApp, nil)
+					stackRepo.FindByNameReturns(models.Stack{
+						Name: "cflinuxfs2",
+						GUID: "cflinuxfs2-guid",
+					}, nil)
+
+					args = []string{"-s", "cflinuxfs2", "existing-app"}
+				})
+
+				AfterEach(func() {
+					Expect(os.Setenv("CF_STACK_DEPRECATION_WARNINGS", originalDeprecatedStacks)).To(Succeed())
+				})
+
+				It("warns that the stack is deprecated and pushes the app", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(output).To(gbytes.Say("Using stack cflinuxfs2"))
+					Expect(output).To(gbytes.Say("Stack cflinuxfs2 is deprecated\\. Use -s or the stack manifest attribute to push existing-app to a supported stack\\."))
+					Expect(appRepo.UpdateCallCount()).To(Equal(1))
+				})
+			})
+
 			Context("when the app has a route bound", func() {
 				BeforeEach(func() {
 					domain := models.DomainFields{
@@ -1980,6 +2164,117 @@ var _ = Describe("Push Command", func() {
 				})
 			})
 
+			Context("when --retries is passed", func() {
+				BeforeEach(func() {
+					args = []string{"app", "--retries", "2"}
+				})
+
+				Context("when the upload fails and then succeeds", func() {
+					BeforeEach(func() {
+						actor.UploadAppStub = func(string, *os.File, []resources.AppFileResource) error {
+							if actor.UploadAppCallCount() == 1 {
+								return errors.NewHTTPError(502, "", "bad gateway")
+							}
+							return nil
+						}
+					})
+
+					It("uploads the app again", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(actor.UploadAppCallCount()).To(Equal(2))
+						Expect(terminal.Decolorize(string(output.Contents()))).To(ContainSubstring("Retrying (1 of 2 retries)..."))
+					})
+				})
+
+				Context("when the upload is accepted but its job cannot be polled", func() {
+					BeforeEach(func() {
+						actor.UploadAppReturns(errors.NewAsyncJobPollingError("https://api.example.com/v2/jobs/some-job-guid", errors.NewHTTPError(503, "", "unavailable")))
+					})
+
+					It("resumes polling the job instead of uploading again", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(actor.UploadAppCallCount()).To(Equal(1))
+						Expect(actor.ResumeUploadCallCount()).To(Equal(1))
+						Expect(actor.ResumeUploadArgsForCall(0)).To(Equal("https://api.example.com/v2/jobs/some-job-guid"))
+					})
+				})
+
+				Context("when the upload keeps failing", func() {
+					BeforeEach(func() {
+						actor.UploadAppReturns(errors.New("Boom!"))
+					})
+
+					It("gives up after the retries", func() {
+						Expect(executeErr).To(HaveOccurred())
+						Expect(executeErr.Error()).To(ContainSubstring("Boom!"))
+						Expect(actor.UploadAppCallCount()).To(Equal(3))
+					})
+				})
+
+				Context("when the Cloud Controller rejects the upload", func() {
+					BeforeEach(func() {
+						actor.UploadAppReturns(errors.NewHTTPError(422, "", "invalid"))
+					})
+
+					It("does not retry", func() {
+						Expect(executeErr).To(HaveOccurred())
+						Expect(actor.UploadAppCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when the app files were prepared by an earlier push that failed to upload them", func() {
+				var cacheDir string
+
+				BeforeEach(func() {
+					var err error
+					cacheDir, err = ioutil.TempDir("", "push-cache")
+					Expect(err).NotTo(HaveOccurred())
+					deps.AppUploadCache = cfappfiles.NewUploadCache(cacheDir)
+
+					digest := cfappfiles.Digest([]models.AppFileFields{{Path: "some-path"}})
+					zipFile, err := deps.AppUploadCache.CreateZip("existing-app-guid", digest)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(zipFile.Close()).To(Succeed())
+					Expect(deps.AppUploadCache.Save("existing-app-guid", digest, []resources.AppFileResource{{Path: "cached-path"}})).To(Succeed())
+
+					args = []string{"app"}
+				})
+
+				AfterEach(func() {
+					Expect(os.RemoveAll(cacheDir)).To(Succeed())
+				})
+
+				It("uploads the prepared files without gathering them again", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(actor.GatherFilesCallCount()).To(Equal(0))
+
+					Expect(actor.UploadAppCallCount()).To(Equal(1))
+					_, _, presentFiles := actor.UploadAppArgsForCall(0)
+					Expect(presentFiles).To(Equal([]resources.AppFileResource{{Path: "cached-path"}}))
+				})
+
+				It("removes the prepared files after uploading them", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					entries, err := ioutil.ReadDir(cacheDir)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(entries).To(BeEmpty())
+				})
+			})
+
+			Context("when the upload fails and --retries is not passed", func() {
+				BeforeEach(func() {
+					actor.UploadAppReturns(errors.NewHTTPError(502, "", "bad gateway"))
+					args = []string{"app"}
+				})
+
+				It("does not retry", func() {
+					Expect(executeErr).To(HaveOccurred())
+					Expect(actor.UploadAppCallCount()).To(Equal(1))
+				})
+			})
+
 			Context("when no name and no manifest is given", func() {
 				BeforeEach(func() {
 					manifestRepo.ReadManifestReturns(manifest.NewEmptyManifest(), errors.New("No such manifest"))
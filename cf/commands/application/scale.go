@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -33,12 +34,13 @@ func (cmd *Scale) MetaData() commandregistry.CommandMetadata {
 	fs["k"] = &flags.StringFlag{ShortName: "k", Usage: T("Disk limit (e.g. 256M, 1024M, 1G)")}
 	fs["m"] = &flags.StringFlag{ShortName: "m", Usage: T("Memory limit (e.g. 256M, 1024M, 1G)")}
 	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force restart of app without prompt")}
+	fs["process"] = &flags.StringFlag{Name: "process", Usage: T("App process to scale (Default: web)")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "scale",
 		Description: T("Change or view the instance count, disk space limit, and memory limit for an app"),
 		Usage: []string{
-			T("CF_NAME scale APP_NAME [-i INSTANCES] [-k DISK] [-m MEMORY] [-f]"),
+			T("CF_NAME scale APP_NAME [--process PROCESS] [-i INSTANCES] [-k DISK] [-m MEMORY] [-f]"),
 		},
 		Flags: fs,
 	}
@@ -58,6 +60,10 @@ func (cmd *Scale) Requirements(requirementsFactory requirements.Factory, fc flag
 		cmd.appReq,
 	}
 
+	if fc.IsSet("process") {
+		reqs = append(reqs, requirementsFactory.NewMinAPIVersionRequirement("Option '--process'", cf.ProcessScaleMinimumAPIVersion))
+	}
+
 	return reqs, nil
 }
 
@@ -78,6 +84,16 @@ var bytesInAMegabyte int64 = 1024 * 1024
 
 func (cmd *Scale) Execute(c flags.FlagContext) error {
 	currentApp := cmd.appReq.GetApplication()
+	processType := c.String("process")
+	if processType == "" {
+		processType = models.ProcessTypeWeb
+	}
+
+	current, err := cmd.currentScale(currentApp, processType)
+	if err != nil {
+		return err
+	}
+
 	if !anyFlagsSet(c) {
 		cmd.ui.Say(T("Showing current scale of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
 			map[string]interface{}{
@@ -89,9 +105,12 @@ func (cmd *Scale) Execute(c flags.FlagContext) error {
 		cmd.ui.Ok()
 		cmd.ui.Say("")
 
-		cmd.ui.Say("%s %s", terminal.HeaderColor(T("memory:")), formatters.ByteSize(currentApp.Memory*bytesInAMegabyte))
-		cmd.ui.Say("%s %s", terminal.HeaderColor(T("disk:")), formatters.ByteSize(currentApp.DiskQuota*bytesInAMegabyte))
-		cmd.ui.Say("%s %d", terminal.HeaderColor(T("instances:")), currentApp.InstanceCount)
+		if processType != models.ProcessTypeWeb {
+			cmd.ui.Say("%s %s", terminal.HeaderColor(T("process:")), processType)
+		}
+		cmd.ui.Say("%s %s", terminal.HeaderColor(T("memory:")), formatters.ByteSize(current.MemoryInMB*bytesInAMegabyte))
+		cmd.ui.Say("%s %s", terminal.HeaderColor(T("disk:")), formatters.ByteSize(current.DiskInMB*bytesInAMegabyte))
+		cmd.ui.Say("%s %d", terminal.HeaderColor(T("instances:")), current.Instances)
 
 		return nil
 	}
@@ -130,8 +149,15 @@ func (cmd *Scale) Execute(c flags.FlagContext) error {
 		params.InstanceCount = &instances
 	}
 
-	if shouldRestart && !cmd.confirmRestart(c, currentApp.Name) {
-		return nil
+	if shouldRestart && !c.Bool("f") {
+		err = cmd.displayScaleChanges(processType, current, params)
+		if err != nil {
+			return err
+		}
+
+		if !cmd.confirmRestart(currentApp.Name) {
+			return nil
+		}
 	}
 
 	cmd.ui.Say(T("Scaling app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
@@ -142,7 +168,14 @@ func (cmd *Scale) Execute(c flags.FlagContext) error {
 			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
 		}))
 
-	updatedApp, err := cmd.appRepo.Update(currentApp.GUID, params)
+	// Every dimension is sent in one request so a failure cannot leave the
+	// app partially scaled.
+	updatedApp := currentApp
+	if processType == models.ProcessTypeWeb {
+		updatedApp, err = cmd.appRepo.Update(currentApp.GUID, params)
+	} else {
+		_, err = cmd.appRepo.ScaleProcess(currentApp.GUID, processType, params)
+	}
 	if err != nil {
 		return err
 	}
@@ -158,11 +191,48 @@ func (cmd *Scale) Execute(c flags.FlagContext) error {
 	return nil
 }
 
-func (cmd *Scale) confirmRestart(context flags.FlagContext, appName string) bool {
-	if context.Bool("f") {
-		return true
+// currentScale returns the scale of the given process type. The web process
+// is read from the app itself so the common case needs no extra request.
+func (cmd *Scale) currentScale(app models.Application, processType string) (models.Process, error) {
+	if processType == models.ProcessTypeWeb {
+		return models.Process{
+			Type:       models.ProcessTypeWeb,
+			Instances:  app.InstanceCount,
+			MemoryInMB: app.Memory,
+			DiskInMB:   app.DiskQuota,
+		}, nil
+	}
+
+	return cmd.appRepo.GetProcess(app.GUID, processType)
+}
+
+func (cmd *Scale) displayScaleChanges(processType string, current models.Process, params models.AppParams) error {
+	requested := current
+	if params.InstanceCount != nil {
+		requested.Instances = *params.InstanceCount
+	}
+	if params.Memory != nil {
+		requested.MemoryInMB = *params.Memory
 	}
+	if params.DiskQuota != nil {
+		requested.DiskInMB = *params.DiskQuota
+	}
+
+	cmd.ui.Say(T("Scaling process {{.ProcessType}}:", map[string]interface{}{"ProcessType": terminal.EntityNameColor(processType)}))
+	table := cmd.ui.Table([]string{"", T("current"), T("requested")})
+	table.Add(T("memory"), formatters.ByteSize(current.MemoryInMB*bytesInAMegabyte), formatters.ByteSize(requested.MemoryInMB*bytesInAMegabyte))
+	table.Add(T("disk"), formatters.ByteSize(current.DiskInMB*bytesInAMegabyte), formatters.ByteSize(requested.DiskInMB*bytesInAMegabyte))
+	table.Add(T("instances"), fmt.Sprintf("%d", current.Instances), fmt.Sprintf("%d", requested.Instances))
+
+	err := table.Print()
+	if err != nil {
+		return err
+	}
+	cmd.ui.Say("")
+	return nil
+}
 
+func (cmd *Scale) confirmRestart(appName string) bool {
 	result := cmd.ui.Confirm(T("This will cause the app to restart. Are you sure you want to scale {{.AppName}}?",
 		map[string]interface{}{"AppName": terminal.EntityNameColor(appName)}))
 	cmd.ui.Say("")
@@ -1,10 +1,12 @@
 package application_test
 
 import (
+	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/api/applications/applicationsfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands/application/applicationfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
@@ -102,6 +104,16 @@ var _ = Describe("scale command", func() {
 		It("does not require any flags", func() {
 			Expect(testcmd.RunCLICommand("scale", []string{"my-app"}, requirementsFactory, updateCommandDependency, false, ui)).To(BeTrue())
 		})
+
+		It("requires a minimum API version when a process is specified", func() {
+			requirementsFactory.NewMinAPIVersionRequirementReturns(requirements.Failing{Message: "api version too low"})
+
+			Expect(testcmd.RunCLICommand("scale", []string{"--process", "worker", "my-app"}, requirementsFactory, updateCommandDependency, false, ui)).To(BeFalse())
+
+			feature, requiredVersion := requirementsFactory.NewMinAPIVersionRequirementArgsForCall(0)
+			Expect(feature).To(Equal("Option '--process'"))
+			Expect(requiredVersion).To(Equal(cf.ProcessScaleMinimumAPIVersion))
+		})
 	})
 
 	Describe("scaling an app", func() {
@@ -168,6 +180,18 @@ var _ = Describe("scale command", func() {
 				Expect(*params.DiskQuota).To(Equal(int64(2048)))
 			})
 
+			It("shows the current and requested scale before asking", func() {
+				testcmd.RunCLICommand("scale", []string{"-i", "5", "-m", "512M", "my-app"}, requirementsFactory, updateCommandDependency, false, ui)
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Scaling process", "web"},
+					[]string{"current", "requested"},
+					[]string{"memory", "256M", "512M"},
+					[]string{"disk", "1G", "1G"},
+					[]string{"instances", "42", "5"},
+				))
+			})
+
 			It("does not scale the memory and disk limits if they are not specified", func() {
 				testcmd.RunCLICommand("scale", []string{"-i", "5", "my-app"}, requirementsFactory, updateCommandDependency, false, ui)
 
@@ -195,5 +219,81 @@ var _ = Describe("scale command", func() {
 				Expect(params.InstanceCount).To(BeNil())
 			})
 		})
+
+		Context("when a process is specified", func() {
+			BeforeEach(func() {
+				requirementsFactory.NewMinAPIVersionRequirementReturns(requirements.Passing{})
+				appRepo.GetProcessReturns(models.Process{
+					GUID:       "worker-guid",
+					Type:       "worker",
+					Instances:  2,
+					MemoryInMB: 128,
+					DiskInMB:   512,
+				}, nil)
+				ui.Inputs = []string{"yes"}
+			})
+
+			It("prints the scale of that process when no flags are specified", func() {
+				testcmd.RunCLICommand("scale", []string{"--process", "worker", "my-app"}, requirementsFactory, updateCommandDependency, false, ui)
+
+				appGUID, processType := appRepo.GetProcessArgsForCall(0)
+				Expect(appGUID).To(Equal("my-app-guid"))
+				Expect(processType).To(Equal("worker"))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"process", "worker"},
+					[]string{"memory", "128M"},
+					[]string{"disk", "512M"},
+					[]string{"instances", "2"},
+				))
+			})
+
+			It("scales every dimension of the process in a single update", func() {
+				testcmd.RunCLICommand("scale", []string{"--process", "worker", "-i", "5", "-m", "1G", "-k", "2G", "my-app"}, requirementsFactory, updateCommandDependency, false, ui)
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Scaling process", "worker"},
+					[]string{"memory", "128M", "1G"},
+					[]string{"disk", "512M", "2G"},
+					[]string{"instances", "2", "5"},
+				))
+
+				Expect(appRepo.UpdateCallCount()).To(Equal(0))
+				Expect(appRepo.ScaleProcessCallCount()).To(Equal(1))
+				appGUID, processType, params := appRepo.ScaleProcessArgsForCall(0)
+				Expect(appGUID).To(Equal("my-app-guid"))
+				Expect(processType).To(Equal("worker"))
+				Expect(*params.InstanceCount).To(Equal(5))
+				Expect(*params.Memory).To(Equal(int64(1024)))
+				Expect(*params.DiskQuota).To(Equal(int64(2048)))
+
+				Expect(restarter.ApplicationRestartCallCount()).To(Equal(1))
+			})
+
+			Context("when the app has no process of that type", func() {
+				BeforeEach(func() {
+					appRepo.GetProcessReturns(models.Process{}, errors.NewModelNotFoundError("Process", "worker"))
+				})
+
+				It("fails without scaling", func() {
+					testcmd.RunCLICommand("scale", []string{"--process", "worker", "-i", "5", "my-app"}, requirementsFactory, updateCommandDependency, false, ui)
+
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}, []string{"Process", "worker", "not found"}))
+					Expect(appRepo.ScaleProcessCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when scaling the process fails", func() {
+				BeforeEach(func() {
+					appRepo.ScaleProcessReturns(models.Process{}, errors.New("scale-error"))
+				})
+
+				It("does not restart the app", func() {
+					testcmd.RunCLICommand("scale", []string{"--process", "worker", "-i", "5", "-m", "1G", "my-app"}, requirementsFactory, updateCommandDependency, false, ui)
+
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}, []string{"scale-error"}))
+					Expect(restarter.ApplicationRestartCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 })
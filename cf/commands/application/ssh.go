@@ -8,29 +8,39 @@ import (
 
 	"golang.org/x/crypto/ssh"
 
+	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/api/appinstances"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/net"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
 	"code.cloudfoundry.org/cli/cf/ssh/options"
 	sshTerminal "code.cloudfoundry.org/cli/cf/ssh/terminal"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/cf/trace"
 )
 
 type SSH struct {
-	ui            terminal.UI
-	config        coreconfig.Reader
-	gateway       net.Gateway
-	appReq        requirements.ApplicationRequirement
-	sshCodeGetter commands.SSHCodeGetter
-	opts          *options.SSHOptions
-	secureShell   sshCmd.SecureShell
+	ui               terminal.UI
+	config           coreconfig.Reader
+	gateway          net.Gateway
+	appReq           requirements.ApplicationRequirement
+	appInstancesRepo appinstances.Repository
+	sshCodeGetter    commands.SSHCodeGetter
+	opts             *options.SSHOptions
+	secureShell      sshCmd.SecureShell
+	logger           trace.Printer
 }
 
+// SSHExit ends the process with the exit status of the remote command. It is
+// a variable so that tests can replace it.
+var SSHExit = os.Exit
+
 type sshInfo struct {
 	SSHEndpoint            string `json:"app_ssh_endpoint"`
 	SSHEndpointFingerprint string `json:"app_ssh_host_key_fingerprint"`
@@ -43,6 +53,8 @@ func init() {
 func (cmd *SSH) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["L"] = &flags.StringSliceFlag{ShortName: "L", Usage: T("Local port forward specification. This flag can be defined more than once.")}
+	fs["R"] = &flags.StringSliceFlag{ShortName: "R", Usage: T("Remote port forward specification, if the SSH proxy allows it. This flag can be defined more than once.")}
+	fs["D"] = &flags.StringSliceFlag{ShortName: "D", Usage: T("Dynamic port forward specification starting a local SOCKS proxy. This flag can be defined more than once.")}
 	fs["command"] = &flags.StringSliceFlag{Name: "command", ShortName: "c", Usage: T("Command to run. This flag can be defined more than once.")}
 	fs["app-instance-index"] = &flags.IntFlag{Name: "app-instance-index", ShortName: "i", Usage: T("Application instance index")}
 	fs["skip-host-validation"] = &flags.BoolFlag{Name: "skip-host-validation", ShortName: "k", Usage: T("Skip host key validation")}
@@ -55,7 +67,7 @@ func (cmd *SSH) MetaData() commandregistry.CommandMetadata {
 		Name:        "ssh",
 		Description: T("SSH to an application container instance"),
 		Usage: []string{
-			T("CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [--skip-host-validation] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty]"),
+			T("CF_NAME ssh APP_NAME [-i app-instance-index] [-c command] [-L [bind_address:]port:host:hostport] [-R [bind_address:]port:host:hostport] [-D [bind_address:]port] [--skip-host-validation] [--skip-remote-execution] [--request-pseudo-tty] [--force-pseudo-tty] [--disable-pseudo-tty]"),
 		},
 		Flags: fs,
 	}
@@ -90,6 +102,8 @@ func (cmd *SSH) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.gateway = deps.Gateways["cloud-controller"]
+	cmd.appInstancesRepo = deps.RepoLocator.GetAppInstancesRepository()
+	cmd.logger = deps.Logger
 
 	if deps.WildcardDependency != nil {
 		cmd.secureShell = deps.WildcardDependency.(sshCmd.SecureShell)
@@ -128,7 +142,7 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 	//init secureShell if it is not already set by SetDependency() with fakes
 	if cmd.secureShell == nil {
 		cmd.secureShell = sshCmd.NewSecureShell(
-			sshCmd.DefaultSecureDialer(),
+			sshCmd.DefaultSecureDialer(cmd.logger),
 			sshTerminal.DefaultHelper(),
 			sshCmd.DefaultListenerFactory(),
 			30*time.Second,
@@ -141,6 +155,14 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 
 	err = cmd.secureShell.Connect(cmd.opts)
 	if err != nil {
+		if cmd.instancesPredateSSHChange(app) {
+			return errors.New(T("Error opening SSH connection: ") + err.Error() + "\n" +
+				T("ssh was enabled for '{{.AppName}}' after its running instances started, and they keep the previous setting until restarted. Use '{{.Command}}' to restart them.",
+					map[string]interface{}{
+						"AppName": app.Name,
+						"Command": terminal.CommandColor(cf.Name + " enable-ssh " + app.Name + " --restart"),
+					}))
+		}
 		return errors.New(T("Error opening SSH connection: ") + err.Error())
 	}
 	defer cmd.secureShell.Close()
@@ -150,6 +172,13 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 		return errors.New(T("Error forwarding port: ") + err.Error())
 	}
 
+	err = cmd.secureShell.RemotePortForward()
+	if err != nil {
+		return errors.New(T("Error forwarding port: ") + err.Error())
+	}
+
+	cmd.printForwards()
+
 	if cmd.opts.SkipRemoteExecution {
 		err = cmd.secureShell.Wait()
 	} else {
@@ -165,7 +194,10 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 					"ExitCode": exitStatus,
 				}))
 			}
-			os.Exit(exitStatus)
+			// os.Exit skips deferred calls, so release the connection and
+			// any forwarded ports before handing back the remote status.
+			_ = cmd.secureShell.Close()
+			SSHExit(exitStatus)
 		} else {
 			return errors.New(T("Error: ") + err.Error())
 		}
@@ -173,6 +205,52 @@ func (cmd *SSH) Execute(fc flags.FlagContext) error {
 	return nil
 }
 
+func (cmd *SSH) printForwards() {
+	if len(cmd.opts.ForwardSpecs) == 0 && len(cmd.opts.RemoteForwardSpecs) == 0 && len(cmd.opts.DynamicForwardAddresses) == 0 {
+		return
+	}
+
+	cmd.ui.Say(T("Port forwarding established:"))
+	for _, forwardSpec := range cmd.opts.ForwardSpecs {
+		cmd.ui.Say(T("  local {{.ListenAddress}} -> {{.ConnectAddress}}", map[string]interface{}{
+			"ListenAddress":  forwardSpec.ListenAddress,
+			"ConnectAddress": forwardSpec.ConnectAddress,
+		}))
+	}
+	for _, forwardSpec := range cmd.opts.RemoteForwardSpecs {
+		cmd.ui.Say(T("  remote {{.ListenAddress}} -> {{.ConnectAddress}}", map[string]interface{}{
+			"ListenAddress":  forwardSpec.ListenAddress,
+			"ConnectAddress": forwardSpec.ConnectAddress,
+		}))
+	}
+	for _, address := range cmd.opts.DynamicForwardAddresses {
+		cmd.ui.Say(T("  SOCKS proxy {{.ListenAddress}}", map[string]interface{}{
+			"ListenAddress": address,
+		}))
+	}
+}
+
+// instancesPredateSSHChange reports whether ssh is enabled for the app but
+// some of its running instances were started before the app was last updated,
+// which is when the ssh setting would have changed.
+func (cmd *SSH) instancesPredateSSHChange(app models.Application) bool {
+	if !app.EnableSSH || app.UpdatedAt == nil {
+		return false
+	}
+
+	instances, err := cmd.appInstancesRepo.GetInstances(app.GUID)
+	if err != nil {
+		return false
+	}
+
+	for _, instance := range instances {
+		if instance.State == models.InstanceRunning && instance.Since.Before(*app.UpdatedAt) {
+			return true
+		}
+	}
+	return false
+}
+
 func (cmd *SSH) getSSHEndpointInfo() (sshInfo, error) {
 	info := sshInfo{}
 	err := cmd.gateway.GetResource(cmd.config.APIEndpoint()+"/v2/info", &info)
@@ -4,10 +4,15 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"time"
 
+	"golang.org/x/crypto/ssh"
+
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/appinstances/appinstancesfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/application"
 	"code.cloudfoundry.org/cli/cf/commands/commandsfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -38,7 +43,8 @@ var _ = Describe("SSH command", func() {
 		deps                commandregistry.Dependency
 		ccGateway           net.Gateway
 
-		fakeSecureShell *sshfakes.FakeSecureShell
+		fakeSecureShell  *sshfakes.FakeSecureShell
+		appInstancesRepo *appinstancesfakes.FakeAppInstancesRepository
 	)
 
 	BeforeEach(func() {
@@ -46,6 +52,7 @@ var _ = Describe("SSH command", func() {
 		configRepo = testconfig.NewRepositoryWithDefaults()
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 		deps.Gateways = make(map[string]net.Gateway)
+		appInstancesRepo = new(appinstancesfakes.FakeAppInstancesRepository)
 
 		//save original command and restore later
 		originalSSHCodeGetter = commandregistry.Commands.FindCommand("ssh-code")
@@ -67,6 +74,7 @@ var _ = Describe("SSH command", func() {
 	updateCommandDependency := func(pluginCall bool) {
 		deps.UI = ui
 		deps.Config = configRepo
+		deps.RepoLocator = deps.RepoLocator.SetAppInstancesRepository(appInstancesRepo)
 
 		//inject fake 'sshCodeGetter' into registry
 		commandregistry.Register(sshCodeGetter)
@@ -290,6 +298,54 @@ var _ = Describe("SSH command", func() {
 					))
 
 				})
+
+				Context("when ssh was enabled after the running instances started", func() {
+					BeforeEach(func() {
+						updatedAt := time.Now()
+						currentApp.UpdatedAt = &updatedAt
+						applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+						applicationReq.GetApplicationReturns(currentApp)
+						requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+						appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+							{State: models.InstanceRunning, Since: updatedAt.Add(-time.Hour)},
+						}, nil)
+					})
+
+					It("explains that the instances need to be restarted", func() {
+						fakeSecureShell.ConnectReturns(errors.New("dial errorrr"))
+
+						runCommand("my-app")
+
+						Expect(appInstancesRepo.GetInstancesArgsForCall(0)).To(Equal("my-app-guid"))
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"Error opening SSH connection", "dial error"},
+							[]string{"ssh was enabled for 'my-app' after its running instances started", "cf enable-ssh my-app --restart"},
+						))
+					})
+				})
+
+				Context("when the running instances started after ssh was enabled", func() {
+					BeforeEach(func() {
+						updatedAt := time.Now()
+						currentApp.UpdatedAt = &updatedAt
+						applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+						applicationReq.GetApplicationReturns(currentApp)
+						requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+						appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+							{State: models.InstanceRunning, Since: updatedAt.Add(time.Minute)},
+						}, nil)
+					})
+
+					It("does not suggest a restart", func() {
+						fakeSecureShell.ConnectReturns(errors.New("dial errorrr"))
+
+						runCommand("my-app")
+
+						Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"ssh was enabled for"}))
+					})
+				})
 			})
 
 			Context("Error port forwarding when -L is provided", func() {
@@ -305,6 +361,47 @@ var _ = Describe("SSH command", func() {
 				})
 			})
 
+			Context("Error port forwarding when -R is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.RemotePortForwardReturns(errors.New("tcpip-forward request denied by peer"))
+
+					runCommand("my-app", "-R", "9000:localhost:3000")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error forwarding port", "tcpip-forward request denied by peer"},
+					))
+					Expect(fakeSecureShell.InteractiveSessionCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when port forwards are requested", func() {
+				It("prints a summary of the established forwards", func() {
+					runCommand("my-app", "-N",
+						"-L", "8080:localhost:8080", "-L", "9090:localhost:9090",
+						"-R", "9000:localhost:3000",
+						"-D", "1080",
+					)
+
+					Expect(fakeSecureShell.LocalPortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.RemotePortForwardCallCount()).To(Equal(1))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Port forwarding established:"},
+						[]string{"local localhost:8080 -> localhost:8080"},
+						[]string{"local localhost:9090 -> localhost:9090"},
+						[]string{"remote localhost:9000 -> localhost:3000"},
+						[]string{"SOCKS proxy localhost:1080"},
+					))
+				})
+			})
+
+			Context("when no port forwards are requested", func() {
+				It("does not print a forwarding summary", func() {
+					runCommand("my-app", "-N")
+
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Port forwarding established"}))
+				})
+			})
+
 			Context("when -N is provided", func() {
 				It("calls secureShell.Wait()", func() {
 					fakeSecureShell.ConnectReturns(nil)
@@ -342,6 +439,35 @@ var _ = Describe("SSH command", func() {
 
 				})
 			})
+
+			Context("when the remote command exits", func() {
+				var (
+					exitCalls            int
+					closeCallsAtExitTime int
+				)
+
+				BeforeEach(func() {
+					exitCalls = 0
+					closeCallsAtExitTime = 0
+					application.SSHExit = func(int) {
+						exitCalls++
+						closeCallsAtExitTime = fakeSecureShell.CloseCallCount()
+					}
+
+					fakeSecureShell.InteractiveSessionReturns(&ssh.ExitError{})
+				})
+
+				AfterEach(func() {
+					application.SSHExit = os.Exit
+				})
+
+				It("closes the connection before exiting with the remote status", func() {
+					runCommand("my-app", "-k")
+
+					Expect(exitCalls).To(Equal(1))
+					Expect(closeCallsAtExitTime).To(Equal(1))
+				})
+			})
 		})
 	})
 })
@@ -14,6 +14,8 @@ import (
 	"sync/atomic"
 
 	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/appevents"
 	"code.cloudfoundry.org/cli/cf/api/appinstances"
 	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/api/logs"
@@ -24,14 +26,25 @@ import (
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/readiness"
 )
 
 const (
 	DefaultStagingTimeout = 15 * time.Minute
 	DefaultStartupTimeout = 5 * time.Minute
 	DefaultPingerThrottle = 5 * time.Second
+
+	// DefaultRecentLogsTimeout caps how long fetching the recent logs of an
+	// app that failed to start can delay the already failed command.
+	DefaultRecentLogsTimeout = 5 * time.Second
 )
 
+// FailureLogLines is the number of staging and app log lines displayed when
+// an app fails to stage or start.
+const FailureLogLines = 25
+
+const EventTypeAppCrash = "app.crash"
+
 const LogMessageTypeStaging = "STG"
 
 //go:generate counterfeiter . StagingWatcher
@@ -45,6 +58,10 @@ type StagingWatcher interface {
 type Starter interface {
 	commandregistry.Command
 	SetStartTimeoutInSeconds(timeout int)
+	SetStagingTimeoutInMinutes(timeout int)
+	SetWaitForHTTPPath(path string)
+	SetNoLogsOnFailure(noLogsOnFailure bool)
+	Timeouts() (stagingTimeout time.Duration, startupTimeout time.Duration)
 	ApplicationStart(app models.Application, orgName string, spaceName string) (updatedApp models.Application, err error)
 }
 
@@ -56,11 +73,17 @@ type Start struct {
 	appRepo          applications.Repository
 	logRepo          logs.Repository
 	appInstancesRepo appinstances.Repository
+	appSummaryRepo   api.AppSummaryRepository
+	appEventsRepo    appevents.Repository
+	readinessURL     string
 
 	LogServerConnectionTimeout time.Duration
 	StartupTimeout             time.Duration
 	StagingTimeout             time.Duration
 	PingerThrottle             time.Duration
+	RecentLogsTimeout          time.Duration
+	WaitForHTTPPath            string
+	NoLogsOnFailure            bool
 }
 
 func init() {
@@ -68,13 +91,17 @@ func init() {
 }
 
 func (cmd *Start) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["no-logs-on-failure"] = &flags.BoolFlag{Name: "no-logs-on-failure", Usage: T("Do not display the recent logs and last crash of the app when it fails to stage or start")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "start",
 		ShortName:   "st",
 		Description: T("Start an app"),
 		Usage: []string{
-			T("CF_NAME start APP_NAME"),
+			T("CF_NAME start APP_NAME [--no-logs-on-failure]"),
 		},
+		Flags: fs,
 	}
 }
 
@@ -101,8 +128,13 @@ func (cmd *Start) SetDependency(deps commandregistry.Dependency, pluginCall bool
 	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
 	cmd.appInstancesRepo = deps.RepoLocator.GetAppInstancesRepository()
 	cmd.logRepo = deps.RepoLocator.GetLogsRepository()
+	cmd.appSummaryRepo = deps.RepoLocator.GetAppSummaryRepository()
+	cmd.appEventsRepo = deps.RepoLocator.GetAppEventsRepository()
 	cmd.LogServerConnectionTimeout = 20 * time.Second
 	cmd.PingerThrottle = DefaultPingerThrottle
+	cmd.RecentLogsTimeout = DefaultRecentLogsTimeout
+	cmd.WaitForHTTPPath = ""
+	cmd.NoLogsOnFailure = false
 
 	if os.Getenv("CF_STAGING_TIMEOUT") != "" {
 		duration, err := strconv.ParseInt(os.Getenv("CF_STAGING_TIMEOUT"), 10, 64)
@@ -134,6 +166,7 @@ func (cmd *Start) SetDependency(deps commandregistry.Dependency, pluginCall bool
 }
 
 func (cmd *Start) Execute(c flags.FlagContext) error {
+	cmd.NoLogsOnFailure = c.Bool("no-logs-on-failure")
 	_, err := cmd.ApplicationStart(cmd.appReq.GetApplication(), cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name)
 	return err
 }
@@ -144,6 +177,15 @@ func (cmd *Start) ApplicationStart(app models.Application, orgName, spaceName st
 		return models.Application{}, nil
 	}
 
+	cmd.readinessURL = ""
+	if cmd.WaitForHTTPPath != "" {
+		url, err := cmd.httpReadinessURL(app)
+		if err != nil {
+			return models.Application{}, err
+		}
+		cmd.readinessURL = url
+	}
+
 	return cmd.WatchStaging(app, orgName, spaceName, func(app models.Application) (models.Application, error) {
 		cmd.ui.Say(T("Starting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
 			map[string]interface{}{
@@ -187,14 +229,23 @@ func (cmd *Start) WatchStaging(app models.Application, orgName, spaceName string
 	cmd.ui.Say("")
 
 	if !isStaged {
+		cmd.displayFailureDetails(updatedApp)
 		return models.Application{}, fmt.Errorf("%s failed to stage within %f minutes", app.Name, cmd.StagingTimeout.Minutes())
 	}
 
 	if app.InstanceCount > 0 {
 		err = cmd.waitForOneRunningInstance(updatedApp)
 		if err != nil {
+			cmd.displayFailureDetails(updatedApp)
 			return models.Application{}, err
 		}
+
+		if cmd.readinessURL != "" {
+			err = cmd.waitForHTTPReadiness(cmd.readinessURL)
+			if err != nil {
+				return models.Application{}, err
+			}
+		}
 		cmd.ui.Say(terminal.HeaderColor(T("\nApp started\n")))
 		cmd.ui.Say("")
 	} else {
@@ -234,6 +285,28 @@ func (cmd *Start) SetStartTimeoutInSeconds(timeout int) {
 	cmd.StartupTimeout = time.Duration(timeout) * time.Second
 }
 
+func (cmd *Start) SetStagingTimeoutInMinutes(timeout int) {
+	cmd.StagingTimeout = time.Duration(timeout) * time.Minute
+}
+
+// SetWaitForHTTPPath makes the start wait, once an instance is running,
+// until a GET of path on the app's first HTTP route returns a 2xx status.
+func (cmd *Start) SetWaitForHTTPPath(path string) {
+	cmd.WaitForHTTPPath = path
+}
+
+// SetNoLogsOnFailure stops the start from displaying the recent logs and last
+// crash of the app when it fails to stage or start.
+func (cmd *Start) SetNoLogsOnFailure(noLogsOnFailure bool) {
+	cmd.NoLogsOnFailure = noLogsOnFailure
+}
+
+// Timeouts returns how long the command waits for an application to stage and
+// to start.
+func (cmd *Start) Timeouts() (time.Duration, time.Duration) {
+	return cmd.StagingTimeout, cmd.StartupTimeout
+}
+
 type ConnectionType int
 
 const (
@@ -286,6 +359,11 @@ func (cmd *Start) TailStagingLogs(app models.Application, stopChan chan bool, st
 
 		case err, ok := <-e:
 			if ok {
+				if _, unavailable := err.(logs.LoggingUnavailableError); unavailable {
+					cmd.ui.Warn(T("staging logs unavailable on this platform"))
+					once.Do(startWaitDone)
+					return
+				}
 				if connectionStatus.Load() != ConnectionWasClosed {
 					cmd.ui.Warn(T("Warning: error tailing logs"))
 					cmd.ui.Say("%s", err)
@@ -329,6 +407,7 @@ func (cmd *Start) waitForInstancesToStage(app models.Application) (bool, error)
 
 	if app.PackageState == "FAILED" {
 		cmd.ui.Say("")
+		cmd.displayFailureDetails(app)
 		if app.StagingFailedReason == "NoAppDetectedError" {
 			return false, errors.New(T(`{{.Err}}
 			
@@ -391,6 +470,114 @@ func (cmd *Start) waitForOneRunningInstance(app models.Application) error {
 	}
 }
 
+// displayFailureDetails displays the last staging and app log lines and the
+// most recent crash of the app, so that the cause of a failed start can be
+// seen without running further commands. Failing to get either is only
+// warned about.
+func (cmd *Start) displayFailureDetails(app models.Application) {
+	if cmd.NoLogsOnFailure {
+		return
+	}
+
+	messages, err := cmd.recentLogs(app.GUID)
+	if err != nil {
+		cmd.ui.Warn(T("Unable to retrieve recent logs: {{.Error}}", map[string]interface{}{"Error": err.Error()}))
+	} else {
+		var lines []logs.Loggable
+		for _, msg := range messages {
+			if msg.GetSourceName() == LogMessageTypeStaging || strings.HasPrefix(msg.GetSourceName(), "APP") {
+				lines = append(lines, msg)
+			}
+		}
+		if len(lines) > FailureLogLines {
+			lines = lines[len(lines)-FailureLogLines:]
+		}
+
+		if len(lines) > 0 {
+			cmd.ui.Say(T("Last {{.Count}} lines of staging and app logs:", map[string]interface{}{"Count": len(lines)}))
+			for _, msg := range lines {
+				cmd.ui.Say("%s", msg.ToLog(time.Local))
+			}
+			cmd.ui.Say("")
+		}
+	}
+
+	events, err := cmd.appEventsRepo.RecentEvents(app.GUID, 50)
+	if err != nil {
+		cmd.ui.Warn(T("Unable to retrieve the last crash: {{.Error}}", map[string]interface{}{"Error": err.Error()}))
+		return
+	}
+
+	for _, event := range events {
+		if event.Name == EventTypeAppCrash {
+			cmd.ui.Say(T("Last crash:"))
+			cmd.ui.Say("%s  %s", event.Timestamp.Local().Format("2006-01-02T15:04:05.00-0700"), event.Description)
+			cmd.ui.Say("")
+			return
+		}
+	}
+}
+
+// recentLogs returns the recent logs of the app, giving up after
+// RecentLogsTimeout so that an unresponsive log server does not hang the
+// command.
+func (cmd *Start) recentLogs(appGUID string) ([]logs.Loggable, error) {
+	type result struct {
+		messages []logs.Loggable
+		err      error
+	}
+
+	results := make(chan result, 1)
+	go func() {
+		messages, err := cmd.logRepo.RecentLogsFor(appGUID)
+		results <- result{messages: messages, err: err}
+	}()
+
+	select {
+	case r := <-results:
+		return r.messages, r.err
+	case <-time.After(cmd.RecentLogsTimeout):
+		return nil, errors.New(T("timed out after {{.Timeout}}", map[string]interface{}{"Timeout": cmd.RecentLogsTimeout}))
+	}
+}
+
+// httpReadinessURL returns the URL to poll for --wait-for-http. TCP routes
+// are skipped because they are not served over HTTP.
+func (cmd *Start) httpReadinessURL(app models.Application) (string, error) {
+	summary, err := cmd.appSummaryRepo.GetSummary(app.GUID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, route := range summary.Routes {
+		if route.Port == 0 {
+			return readiness.URL(route.URL(), cmd.WaitForHTTPPath), nil
+		}
+	}
+
+	return "", errors.New(T("App {{.AppName}} has no HTTP route. --wait-for-http cannot be used with apps that only have TCP routes or no routes.",
+		map[string]interface{}{"AppName": app.Name}))
+}
+
+func (cmd *Start) waitForHTTPReadiness(url string) error {
+	cmd.ui.Say(T("Waiting for {{.URL}} to return a 2xx status...", map[string]interface{}{"URL": url}))
+
+	poller := readiness.NewPoller(cmd.config.IsSSLDisabled(), cmd.PingerThrottle, cmd.StartupTimeout)
+	err := poller.Poll(url, func(attempt readiness.Attempt) {
+		if attempt.Err != nil {
+			cmd.ui.Say(T("GET {{.URL}}: {{.Error}}", map[string]interface{}{"URL": attempt.URL, "Error": attempt.Err.Error()}))
+			return
+		}
+		cmd.ui.Say(T("GET {{.URL}}: {{.StatusCode}}", map[string]interface{}{"URL": attempt.URL, "StatusCode": attempt.StatusCode}))
+	})
+	if timeoutErr, ok := err.(readiness.TimeoutError); ok {
+		return errors.New(T("{{.URL}} did not return a 2xx status within {{.Timeout}}",
+			map[string]interface{}{"URL": timeoutErr.URL, "Timeout": timeoutErr.Timeout}))
+	}
+
+	return err
+}
+
 type instanceCount struct {
 	running         int
 	starting        int
@@ -1,7 +1,10 @@
 package application_test
 
 import (
+	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	. "code.cloudfoundry.org/cli/cf/commands/application"
@@ -15,6 +18,8 @@ import (
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/models"
 
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/appevents/appeventsfakes"
 	"code.cloudfoundry.org/cli/cf/api/appinstances/appinstancesfakes"
 	"code.cloudfoundry.org/cli/cf/api/applications/applicationsfakes"
 	"code.cloudfoundry.org/cli/cf/api/logs"
@@ -31,6 +36,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
 )
 
 var _ = Describe("start command", func() {
@@ -46,6 +52,8 @@ var _ = Describe("start command", func() {
 
 		appInstancesRepo   *appinstancesfakes.FakeAppInstancesRepository
 		appRepo            *applicationsfakes.FakeRepository
+		appSummaryRepo     *apifakes.FakeAppSummaryRepository
+		appEventsRepo      *appeventsfakes.FakeRepository
 		originalAppCommand commandregistry.Command
 		deps               commandregistry.Dependency
 		displayApp         *applicationfakes.FakeAppDisplayer
@@ -57,6 +65,8 @@ var _ = Describe("start command", func() {
 		deps.RepoLocator = deps.RepoLocator.SetLogsRepository(logsRepo)
 		deps.RepoLocator = deps.RepoLocator.SetApplicationRepository(appRepo)
 		deps.RepoLocator = deps.RepoLocator.SetAppInstancesRepository(appInstancesRepo)
+		deps.RepoLocator = deps.RepoLocator.SetAppSummaryRepository(appSummaryRepo)
+		deps.RepoLocator = deps.RepoLocator.SetAppEventsRepository(appEventsRepo)
 
 		//inject fake 'Start' into registry
 		commandregistry.Register(displayApp)
@@ -96,6 +106,8 @@ var _ = Describe("start command", func() {
 
 		appInstancesRepo = new(appinstancesfakes.FakeAppInstancesRepository)
 		appRepo = new(applicationsfakes.FakeRepository)
+		appSummaryRepo = new(apifakes.FakeAppSummaryRepository)
+		appEventsRepo = new(appeventsfakes.FakeRepository)
 
 		displayApp = new(applicationfakes.FakeAppDisplayer)
 
@@ -378,6 +390,85 @@ var _ = Describe("start command", func() {
 			))
 		})
 
+		Context("when waiting for an HTTP route to be ready", func() {
+			var (
+				server *ghttp.Server
+				cmd    *Start
+			)
+
+			BeforeEach(func() {
+				server = ghttp.NewTLSServer()
+				configRepo.SetSSLDisabled(true)
+
+				appRepo.UpdateReturns(defaultAppForStart, nil)
+				appRepo.GetAppReturns(defaultAppForStart, nil)
+				appInstancesRepo.GetInstancesStub = getInstance
+
+				updateCommandDependency(logRepo)
+				cmd = commandregistry.Commands.FindCommand("start").(*Start)
+				cmd.StartupTimeout = 500 * time.Millisecond
+				cmd.PingerThrottle = 10 * time.Millisecond
+				cmd.SetWaitForHTTPPath("/ready")
+			})
+
+			AfterEach(func() {
+				server.Close()
+			})
+
+			Context("when the app has an HTTP route", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/ready"),
+							ghttp.RespondWith(http.StatusServiceUnavailable, nil),
+						),
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/ready"),
+							ghttp.RespondWith(http.StatusOK, nil),
+						),
+					)
+
+					appSummaryRepo.GetSummaryReturns(models.Application{
+						Routes: []models.RouteSummary{
+							{Domain: models.DomainFields{Name: "tcp.example.com"}, Port: 1024},
+							{Domain: models.DomainFields{Name: strings.TrimPrefix(server.URL(), "https://")}},
+						},
+					}, nil)
+				})
+
+				It("polls the route until it returns a 2xx status", func() {
+					_, err := cmd.ApplicationStart(defaultAppForStart, "some-org", "some-space")
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(appSummaryRepo.GetSummaryArgsForCall(0)).To(Equal("my-app-guid"))
+					Expect(server.ReceivedRequests()).To(HaveLen(2))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"1 of 2 instances running"},
+						[]string{"Waiting for", "/ready to return a 2xx status..."},
+						[]string{"GET", "/ready: 503"},
+						[]string{"GET", "/ready: 200"},
+						[]string{"OK"},
+					))
+				})
+			})
+
+			Context("when the app only has TCP routes", func() {
+				BeforeEach(func() {
+					appSummaryRepo.GetSummaryReturns(models.Application{
+						Routes: []models.RouteSummary{
+							{Domain: models.DomainFields{Name: "tcp.example.com"}, Port: 1024},
+						},
+					}, nil)
+				})
+
+				It("returns an error without starting the app", func() {
+					_, err := cmd.ApplicationStart(defaultAppForStart, "some-org", "some-space")
+					Expect(err).To(MatchError("App my-app has no HTTP route. --wait-for-http cannot be used with apps that only have TCP routes or no routes."))
+					Expect(appRepo.UpdateCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		It("starts an app, when given the app's name", func() {
 			ui, appRepo, _ := startAppWithInstancesAndErrors(defaultAppForStart, requirementsFactory)
 
@@ -577,6 +668,25 @@ var _ = Describe("start command", func() {
 			))
 		})
 
+		It("displays the recent staging logs when staging fails", func() {
+			defaultAppForStart.PackageState = "FAILED"
+			defaultAppForStart.StagingFailedReason = "AWWW, FAILED"
+
+			message := new(logsfakes.FakeLoggable)
+			message.GetSourceNameReturns("STG")
+			message.ToLogReturns("compilation failed")
+			logRepo.RecentLogsForReturns([]logs.Loggable{message}, nil)
+
+			ui, _, _ := startAppWithInstancesAndErrors(defaultAppForStart, requirementsFactory)
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Last 1 lines of staging and app logs:"},
+				[]string{"compilation failed"},
+				[]string{"FAILED"},
+				[]string{"AWWW, FAILED"},
+			))
+		})
+
 		It("displays an TIP about needing to push from source directory when staging fails with NoAppDetectedError", func() {
 			defaultAppForStart.PackageState = "FAILED"
 			defaultAppForStart.StagingFailedReason = "NoAppDetectedError"
@@ -680,6 +790,173 @@ var _ = Describe("start command", func() {
 			})
 		})
 
+		Context("when the app fails to start", func() {
+			var args []string
+
+			BeforeEach(func() {
+				args = []string{"my-app"}
+
+				crashed := models.AppInstanceFields{State: models.InstanceCrashed}
+				defaultInstanceResponses = [][]models.AppInstanceFields{{crashed, crashed}}
+
+				appRepo.UpdateReturns(defaultAppForStart, nil)
+				appRepo.GetAppReturns(defaultAppForStart, nil)
+				appInstancesRepo.GetInstancesStub = getInstance
+
+				applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+				applicationReq.GetApplicationReturns(defaultAppForStart)
+				requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+				var recentLogs []logs.Loggable
+				for _, source := range []string{"RTR", "STG", "APP/PROC/WEB/0", "CELL/0"} {
+					message := new(logsfakes.FakeLoggable)
+					message.GetSourceNameReturns(source)
+					message.ToLogReturns("recent " + source + " log")
+					recentLogs = append(recentLogs, message)
+				}
+				logRepo.RecentLogsForReturns(recentLogs, nil)
+
+				appEventsRepo.RecentEventsReturns([]models.EventFields{
+					{Name: "audit.app.update", Description: "state: STARTED"},
+					{Name: "app.crash", Description: "index: 1, reason: CRASHED, exit_description: out of memory, exit_status: 137"},
+				}, nil)
+			})
+
+			It("displays the recent staging and app logs and the last crash", func() {
+				callStart(args)
+
+				Expect(logRepo.RecentLogsForArgsForCall(0)).To(Equal("my-app-guid"))
+				appGUID, _ := appEventsRepo.RecentEventsArgsForCall(0)
+				Expect(appGUID).To(Equal("my-app-guid"))
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Last 2 lines of staging and app logs:"},
+					[]string{"recent STG log"},
+					[]string{"recent APP/PROC/WEB/0 log"},
+					[]string{"Last crash:"},
+					[]string{"reason: CRASHED, exit_description: out of memory, exit_status: 137"},
+					[]string{"FAILED"},
+					[]string{"Start unsuccessful"},
+				))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"recent RTR log"}))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"recent CELL/0 log"}))
+			})
+
+			Context("when there are more than 25 log lines", func() {
+				BeforeEach(func() {
+					var recentLogs []logs.Loggable
+					for i := 0; i < 30; i++ {
+						message := new(logsfakes.FakeLoggable)
+						message.GetSourceNameReturns("STG")
+						message.ToLogReturns(fmt.Sprintf("staging line %d.", i))
+						recentLogs = append(recentLogs, message)
+					}
+					logRepo.RecentLogsForReturns(recentLogs, nil)
+				})
+
+				It("displays only the last 25", func() {
+					callStart(args)
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Last 25 lines of staging and app logs:"},
+						[]string{"staging line 5."},
+						[]string{"staging line 29."},
+					))
+					Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"staging line 4."}))
+				})
+			})
+
+			Context("when fetching the recent logs fails", func() {
+				BeforeEach(func() {
+					logRepo.RecentLogsForReturns(nil, errors.New("logs are broken"))
+				})
+
+				It("warns and still displays the last crash", func() {
+					callStart(args)
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Unable to retrieve recent logs: logs are broken"},
+						[]string{"Last crash:"},
+						[]string{"Start unsuccessful"},
+					))
+				})
+			})
+
+			Context("when fetching the recent logs hangs", func() {
+				var unblock chan struct{}
+
+				BeforeEach(func() {
+					unblock = make(chan struct{})
+					logRepo.RecentLogsForStub = func(string) ([]logs.Loggable, error) {
+						<-unblock
+						return nil, nil
+					}
+				})
+
+				AfterEach(func() {
+					close(unblock)
+				})
+
+				It("gives up after the timeout", func() {
+					updateCommandDependency(logRepo)
+					cmd := commandregistry.Commands.FindCommand("start").(*Start)
+					cmd.StartupTimeout = 500 * time.Millisecond
+					cmd.PingerThrottle = 10 * time.Millisecond
+					cmd.RecentLogsTimeout = 50 * time.Millisecond
+					commandregistry.Register(cmd)
+
+					testcmd.RunCLICommandWithoutDependency("start", args, requirementsFactory, ui)
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Unable to retrieve recent logs: timed out after 50ms"},
+						[]string{"Last crash:"},
+					))
+				})
+			})
+
+			Context("when fetching the app events fails", func() {
+				BeforeEach(func() {
+					appEventsRepo.RecentEventsReturns(nil, errors.New("events are broken"))
+				})
+
+				It("warns and still fails with the start error", func() {
+					callStart(args)
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"recent STG log"},
+						[]string{"Unable to retrieve the last crash: events are broken"},
+						[]string{"Start unsuccessful"},
+					))
+				})
+			})
+
+			Context("when the app has not crashed", func() {
+				BeforeEach(func() {
+					appEventsRepo.RecentEventsReturns(nil, nil)
+				})
+
+				It("does not display a crash", func() {
+					callStart(args)
+					Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"Last crash:"}))
+				})
+			})
+
+			Context("when --no-logs-on-failure is provided", func() {
+				BeforeEach(func() {
+					args = []string{"--no-logs-on-failure", "my-app"}
+				})
+
+				It("displays only the start error", func() {
+					callStart(args)
+
+					Expect(logRepo.RecentLogsForCallCount()).To(Equal(0))
+					Expect(appEventsRepo.RecentEventsCallCount()).To(Equal(0))
+					Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"Last crash:"}))
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"Start unsuccessful"}))
+				})
+			})
+		})
+
 		Context("when an app instance is starting", func() {
 			It("reports any additional details", func() {
 				appInstance := models.AppInstanceFields{
@@ -807,5 +1084,25 @@ var _ = Describe("start command", func() {
 				[]string{"Ooops"},
 			))
 		})
+
+		It("warns once and keeps starting the app when logging is unavailable", func() {
+			appRepo.ReadReturns(defaultAppForStart, nil)
+
+			logRepo.TailLogsForStub = func(appGUID string, onConnect func(), logChan chan<- logs.Loggable, errChan chan<- error) {
+				errChan <- logs.LoggingUnavailableError{}
+			}
+
+			applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+			applicationReq.GetApplicationReturns(defaultAppForStart)
+			requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+			callStart([]string{"my-app"})
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"staging logs unavailable on this platform"},
+				[]string{"Starting app", "my-app"},
+			))
+			Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"error tailing logs"}))
+		})
 	})
 })
@@ -0,0 +1,99 @@
+package buildpack
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+type ShowBuildpack struct {
+	ui            terminal.UI
+	config        coreconfig.Reader
+	buildpackRepo api.BuildpackRepository
+}
+
+func init() {
+	commandregistry.Register(&ShowBuildpack{})
+}
+
+func (cmd *ShowBuildpack) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["stack"] = &flags.StringFlag{Name: "stack", Usage: T("Specify stack to disambiguate buildpacks with the same name")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "buildpack",
+		Description: T("Show information for a buildpack"),
+		Usage: []string{
+			T("CF_NAME buildpack BUILDPACK [--stack STACK]"),
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *ShowBuildpack) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	usageReq := requirements.NewUsageRequirement(commandregistry.CLICommandUsagePresenter(cmd), "",
+		func() bool {
+			return len(fc.Args()) != 1
+		},
+	)
+
+	reqs := []requirements.Requirement{
+		usageReq,
+		requirementsFactory.NewLoginRequirement(),
+	}
+
+	return reqs, nil
+}
+
+func (cmd *ShowBuildpack) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.buildpackRepo = deps.RepoLocator.GetBuildpackRepository()
+	return cmd
+}
+
+func (cmd *ShowBuildpack) Execute(c flags.FlagContext) error {
+	buildpackName := c.Args()[0]
+
+	cmd.ui.Say(T("Getting info for buildpack {{.BuildpackName}} as {{.Username}}...",
+		map[string]interface{}{
+			"BuildpackName": terminal.EntityNameColor(buildpackName),
+			"Username":      terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	buildpack, err := cmd.buildpackRepo.FindByNameAndStack(buildpackName, c.String("stack"))
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	position := ""
+	if buildpack.Position != nil {
+		position = strconv.Itoa(*buildpack.Position)
+	}
+	enabled := ""
+	if buildpack.Enabled != nil {
+		enabled = strconv.FormatBool(*buildpack.Enabled)
+	}
+	locked := ""
+	if buildpack.Locked != nil {
+		locked = strconv.FormatBool(*buildpack.Locked)
+	}
+
+	table := cmd.ui.Table([]string{"", ""})
+	table.Add(T("name:"), buildpack.Name)
+	table.Add(T("stack:"), buildpack.Stack)
+	table.Add(T("position:"), position)
+	table.Add(T("enabled:"), enabled)
+	table.Add(T("locked:"), locked)
+	table.Add(T("filename:"), buildpack.Filename)
+	return table.Print()
+}
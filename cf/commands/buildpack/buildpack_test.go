@@ -0,0 +1,93 @@
+package buildpack_test
+
+import (
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ShowBuildpack", func() {
+	var (
+		ui                  *testterm.FakeUI
+		buildpackRepo       *apifakes.OldFakeBuildpackRepository
+		requirementsFactory *requirementsfakes.FakeFactory
+		deps                commandregistry.Dependency
+	)
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = testconfig.NewRepositoryWithDefaults()
+		deps.RepoLocator = deps.RepoLocator.SetBuildpackRepository(buildpackRepo)
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("buildpack").SetDependency(deps, pluginCall))
+	}
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		buildpackRepo = new(apifakes.OldFakeBuildpackRepository)
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("buildpack", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	It("fails with usage when no buildpack name is provided", func() {
+		Expect(runCommand()).To(BeFalse())
+		Expect(requirementsFactory.NewLoginRequirementCallCount()).To(Equal(1))
+	})
+
+	It("fails requirements when login fails", func() {
+		requirementsFactory.NewLoginRequirementReturns(requirements.Failing{Message: "not logged in"})
+		Expect(runCommand("my-buildpack")).To(BeFalse())
+	})
+
+	It("shows the buildpack", func() {
+		p := 3
+		t := true
+		f := false
+		buildpackRepo.FindByNameAndStackReturns.Buildpack = models.Buildpack{
+			Name:     "my-buildpack",
+			Stack:    "cflinuxfs2",
+			Position: &p,
+			Enabled:  &t,
+			Locked:   &f,
+			Filename: "my-buildpack.zip",
+		}
+
+		Expect(runCommand("my-buildpack", "--stack", "cflinuxfs2")).To(BeTrue())
+
+		Expect(buildpackRepo.FindByNameAndStackArgs.Name).To(Equal("my-buildpack"))
+		Expect(buildpackRepo.FindByNameAndStackArgs.Stack).To(Equal("cflinuxfs2"))
+		Expect(ui.Outputs()).To(ContainSubstrings(
+			[]string{"Getting info for buildpack", "my-buildpack"},
+			[]string{"OK"},
+			[]string{"name:", "my-buildpack"},
+			[]string{"stack:", "cflinuxfs2"},
+			[]string{"position:", "3"},
+			[]string{"enabled:", "true"},
+			[]string{"locked:", "false"},
+			[]string{"filename:", "my-buildpack.zip"},
+		))
+	})
+
+	It("fails when multiple buildpacks share the name", func() {
+		buildpackRepo.FindByNameAndStackReturns.Error = errors.NewAmbiguousModelError("Buildpack", "my-buildpack")
+
+		Expect(runCommand("my-buildpack")).To(BeFalse())
+		Expect(ui.Outputs()).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"Multiple Buildpacks named my-buildpack found"},
+		))
+	})
+})
@@ -1,6 +1,7 @@
 package buildpack
 
 import (
+	"encoding/json"
 	"errors"
 	"strconv"
 
@@ -23,13 +24,27 @@ func init() {
 	commandregistry.Register(&ListBuildpacks{})
 }
 
+type buildpackJSON struct {
+	Name     string `json:"name"`
+	Stack    string `json:"stack"`
+	Position *int   `json:"position"`
+	Enabled  *bool  `json:"enabled"`
+	Locked   *bool  `json:"locked"`
+	Filename string `json:"filename"`
+}
+
 func (cmd *ListBuildpacks) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["stack"] = &flags.StringFlag{Name: "stack", Usage: T("Only list buildpacks for the given stack")}
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Output the buildpacks as JSON")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "buildpacks",
 		Description: T("List all buildpacks"),
 		Usage: []string{
-			T("CF_NAME buildpacks"),
+			T("CF_NAME buildpacks [--stack STACK] [--json]"),
 		},
+		Flags: fs,
 	}
 }
 
@@ -56,12 +71,21 @@ func (cmd *ListBuildpacks) SetDependency(deps commandregistry.Dependency, plugin
 }
 
 func (cmd *ListBuildpacks) Execute(c flags.FlagContext) error {
+	stack := c.String("stack")
+	if c.Bool("json") {
+		return cmd.listBuildpacksJSON(stack)
+	}
+
 	cmd.ui.Say(T("Getting buildpacks...\n"))
 
-	table := cmd.ui.Table([]string{"buildpack", T("position"), T("enabled"), T("locked"), T("filename")})
+	table := cmd.ui.Table([]string{"buildpack", T("position"), T("enabled"), T("locked"), T("filename"), T("stack")})
 	noBuildpacks := true
 
 	apiErr := cmd.buildpackRepo.ListBuildpacks(func(buildpack models.Buildpack) bool {
+		if stack != "" && buildpack.Stack != stack {
+			return true
+		}
+
 		position := ""
 		if buildpack.Position != nil {
 			position = strconv.Itoa(*buildpack.Position)
@@ -80,6 +104,7 @@ func (cmd *ListBuildpacks) Execute(c flags.FlagContext) error {
 			enabled,
 			locked,
 			buildpack.Filename,
+			buildpack.Stack,
 		)
 		noBuildpacks = false
 		return true
@@ -98,3 +123,34 @@ func (cmd *ListBuildpacks) Execute(c flags.FlagContext) error {
 	}
 	return nil
 }
+
+func (cmd *ListBuildpacks) listBuildpacksJSON(stack string) error {
+	buildpacks := []buildpackJSON{}
+
+	apiErr := cmd.buildpackRepo.ListBuildpacks(func(buildpack models.Buildpack) bool {
+		if stack != "" && buildpack.Stack != stack {
+			return true
+		}
+
+		buildpacks = append(buildpacks, buildpackJSON{
+			Name:     buildpack.Name,
+			Stack:    buildpack.Stack,
+			Position: buildpack.Position,
+			Enabled:  buildpack.Enabled,
+			Locked:   buildpack.Locked,
+			Filename: buildpack.Filename,
+		})
+		return true
+	})
+	if apiErr != nil {
+		return errors.New(T("Failed fetching buildpacks.\n{{.Error}}", map[string]interface{}{"Error": apiErr.Error()}))
+	}
+
+	jsonBytes, err := json.MarshalIndent(buildpacks, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say(string(jsonBytes))
+	return nil
+}
@@ -1,6 +1,9 @@
 package buildpack_test
 
 import (
+	"encoding/json"
+	"strings"
+
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/flags"
@@ -97,6 +100,68 @@ var _ = Describe("ListBuildpacks", func() {
 			))
 		})
 
+		Context("when the --stack flag is provided", func() {
+			BeforeEach(func() {
+				buildpackRepo.Buildpacks = []models.Buildpack{
+					{Name: "Buildpack-1", Stack: "cflinuxfs2"},
+					{Name: "Buildpack-2", Stack: "windows2012R2"},
+				}
+			})
+
+			It("only lists buildpacks for that stack", func() {
+				runCommand("--stack", "windows2012R2")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"buildpack", "position", "stack"},
+					[]string{"Buildpack-2", "windows2012R2"},
+				))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Buildpack-1"}))
+			})
+
+			It("tells the user if no build packs exist for the stack", func() {
+				runCommand("--stack", "other-stack")
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"No buildpacks found"}))
+			})
+		})
+
+		Context("when the --json flag is provided", func() {
+			BeforeEach(func() {
+				p1 := 1
+				t := true
+				f := false
+				buildpackRepo.Buildpacks = []models.Buildpack{
+					{Name: "Buildpack-1", Stack: "cflinuxfs2", Position: &p1, Enabled: &t, Locked: &f, Filename: "buildpack-1.zip"},
+					{Name: "Buildpack-2", Stack: "windows2012R2"},
+				}
+			})
+
+			It("outputs the buildpacks as JSON", func() {
+				runCommand("--json")
+
+				var output []map[string]interface{}
+				Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &output)).To(Succeed())
+				Expect(output).To(Equal([]map[string]interface{}{
+					{"name": "Buildpack-1", "stack": "cflinuxfs2", "position": float64(1), "enabled": true, "locked": false, "filename": "buildpack-1.zip"},
+					{"name": "Buildpack-2", "stack": "windows2012R2", "position": nil, "enabled": nil, "locked": nil, "filename": ""},
+				}))
+			})
+
+			It("filters by stack when --stack is also provided", func() {
+				runCommand("--json", "--stack", "windows2012R2")
+
+				var output []map[string]interface{}
+				Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &output)).To(Succeed())
+				Expect(output).To(HaveLen(1))
+				Expect(output[0]["name"]).To(Equal("Buildpack-2"))
+			})
+
+			It("outputs an empty array when there are no buildpacks", func() {
+				buildpackRepo.Buildpacks = nil
+				runCommand("--json")
+				Expect(strings.Join(ui.Outputs(), "\n")).To(Equal("[]"))
+			})
+		})
+
 		It("tells the user if no build packs exist", func() {
 			runCommand()
 			Expect(ui.Outputs()).To(ContainSubstrings(
@@ -30,12 +30,13 @@ func (cmd *CreateBuildpack) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["enable"] = &flags.BoolFlag{Name: "enable", Usage: T("Enable the buildpack to be used for staging")}
 	fs["disable"] = &flags.BoolFlag{Name: "disable", Usage: T("Disable the buildpack from being used for staging")}
+	fs["stack"] = &flags.StringFlag{Name: "stack", Usage: T("Stack the buildpack will be associated with")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "create-buildpack",
 		Description: T("Create a buildpack"),
 		Usage: []string{
-			T("CF_NAME create-buildpack BUILDPACK PATH POSITION [--enable|--disable]"),
+			T("CF_NAME create-buildpack BUILDPACK PATH POSITION [--stack STACK] [--enable|--disable]"),
 			T("\n\nTIP:\n"),
 			T("   Path should be a zip file, a url to a zip file, or a local directory. Position is a positive integer, sets priority, and is sorted from lowest to highest."),
 		},
@@ -94,6 +95,13 @@ func (cmd *CreateBuildpack) Execute(c flags.FlagContext) error {
 
 	err = cmd.buildpackBitsRepo.UploadBuildpack(buildpack, buildpackFile, buildpackFileName)
 	if err != nil {
+		// Remove the record so that a rerun is not blocked by the name being taken.
+		if deleteErr := cmd.buildpackRepo.Delete(buildpack.GUID); deleteErr != nil {
+			cmd.ui.Warn(T("Failed to delete buildpack {{.BuildpackName}} after the upload failed: {{.Error}}", map[string]interface{}{
+				"BuildpackName": buildpackName,
+				"Error":         deleteErr.Error(),
+			}))
+		}
 		return err
 	}
 
@@ -127,7 +135,7 @@ func (cmd CreateBuildpack) createBuildpack(buildpackName string, c flags.FlagCon
 		enableOption = &disabled
 	}
 
-	buildpack, apiErr = cmd.buildpackRepo.Create(buildpackName, &position, enableOption, nil)
+	buildpack, apiErr = cmd.buildpackRepo.Create(buildpackName, &position, enableOption, nil, c.String("stack"))
 
 	return
 }
@@ -138,4 +138,36 @@ var _ = Describe("create-buildpack command", func() {
 			[]string{"FAILED"},
 		))
 	})
+
+	It("deletes the created buildpack when uploading the buildpack bits fails", func() {
+		bitsRepo.UploadBuildpackReturns(fmt.Errorf("upload error"))
+
+		testcmd.RunCLICommand("create-buildpack", []string{"my-buildpack", "bogus/path", "5"}, requirementsFactory, updateCommandDependency, false, ui)
+
+		Expect(repo.DeleteBuildpackGUID).To(Equal("my-buildpack-guid"))
+	})
+
+	It("does not delete the buildpack when the upload succeeds", func() {
+		testcmd.RunCLICommand("create-buildpack", []string{"my-buildpack", "my.war", "5"}, requirementsFactory, updateCommandDependency, false, ui)
+
+		Expect(repo.DeleteBuildpackGUID).To(BeEmpty())
+	})
+
+	It("warns the user when deleting the buildpack after a failed upload fails", func() {
+		bitsRepo.UploadBuildpackReturns(fmt.Errorf("upload error"))
+		repo.DeleteAPIResponse = fmt.Errorf("delete error")
+
+		testcmd.RunCLICommand("create-buildpack", []string{"my-buildpack", "bogus/path", "5"}, requirementsFactory, updateCommandDependency, false, ui)
+
+		Expect(ui.Outputs()).To(ContainSubstrings(
+			[]string{"Failed to delete buildpack my-buildpack", "delete error"},
+			[]string{"FAILED"},
+		))
+	})
+
+	It("creates the buildpack with the given stack when given the --stack flag", func() {
+		testcmd.RunCLICommand("create-buildpack", []string{"--stack", "cflinuxfs2", "my-buildpack", "my.war", "5"}, requirementsFactory, updateCommandDependency, false, ui)
+
+		Expect(repo.CreateBuildpack.Stack).To(Equal("cflinuxfs2"))
+	})
 })
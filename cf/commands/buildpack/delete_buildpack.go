@@ -1,7 +1,10 @@
 package buildpack
 
 import (
+	"strings"
+
 	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
@@ -13,6 +16,7 @@ import (
 type DeleteBuildpack struct {
 	ui            terminal.UI
 	buildpackRepo api.BuildpackRepository
+	appRepo       applications.Repository
 }
 
 func init() {
@@ -22,18 +26,20 @@ func init() {
 func (cmd *DeleteBuildpack) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
 	cmd.ui = deps.UI
 	cmd.buildpackRepo = deps.RepoLocator.GetBuildpackRepository()
+	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
 	return cmd
 }
 
 func (cmd *DeleteBuildpack) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
-	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force deletion without confirmation")}
+	fs["force"] = &flags.BoolFlag{Name: "force", ShortName: "f", Usage: T("Force deletion without confirmation and without checking for apps using the buildpack")}
+	fs["verbose"] = &flags.BoolFlag{Name: "verbose", Usage: T("List the names of the apps using the buildpack in the confirmation prompt")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "delete-buildpack",
 		Description: T("Delete a buildpack"),
 		Usage: []string{
-			T("CF_NAME delete-buildpack BUILDPACK [-f]"),
+			T("CF_NAME delete-buildpack BUILDPACK [-f] [--verbose]"),
 		},
 		Flags: fs,
 	}
@@ -62,7 +68,10 @@ func (cmd *DeleteBuildpack) Execute(c flags.FlagContext) error {
 	force := c.Bool("f")
 
 	if !force {
-		answer := cmd.ui.ConfirmDelete("buildpack", buildpackName)
+		answer, err := cmd.confirmDelete(buildpackName, c.Bool("verbose"))
+		if err != nil {
+			return err
+		}
 		if !answer {
 			return nil
 		}
@@ -93,3 +102,46 @@ func (cmd *DeleteBuildpack) Execute(c flags.FlagContext) error {
 	cmd.ui.Ok()
 	return nil
 }
+
+// confirmDelete asks the user to confirm the deletion, warning them in the
+// prompt about the apps that explicitly use the buildpack and would fail to
+// restage once it is deleted.
+func (cmd *DeleteBuildpack) confirmDelete(buildpackName string, verbose bool) (bool, error) {
+	apps, err := cmd.appRepo.ListAppsByBuildpack(buildpackName)
+	if httpErr, ok := err.(errors.HTTPError); ok && httpErr.ErrorCode() == errors.BadQueryParameter {
+		cmd.ui.Say(T("Skipping the check for apps using buildpack {{.BuildpackName}}: not supported by the targeted API.",
+			map[string]interface{}{"BuildpackName": terminal.EntityNameColor(buildpackName)}))
+		return cmd.ui.ConfirmDelete("buildpack", buildpackName), nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if len(apps) == 0 {
+		return cmd.ui.ConfirmDelete("buildpack", buildpackName), nil
+	}
+
+	prompt := T("{{.AppCount}} app(s) explicitly use buildpack {{.BuildpackName}} and will fail to restage once it is deleted.",
+		map[string]interface{}{
+			"AppCount":      len(apps),
+			"BuildpackName": terminal.EntityNameColor(buildpackName),
+		})
+	if verbose {
+		names := make([]string, 0, len(apps))
+		for _, app := range apps {
+			names = append(names, terminal.EntityNameColor(app.Name))
+		}
+		prompt += "\n" + T("Apps: {{.AppNames}}", map[string]interface{}{"AppNames": strings.Join(names, ", ")})
+	}
+	prompt += "\n" + T("Really delete the {{.ModelType}} {{.ModelName}}?",
+		map[string]interface{}{
+			"ModelType": "buildpack",
+			"ModelName": terminal.EntityNameColor(buildpackName),
+		})
+
+	if !cmd.ui.Confirm(prompt) {
+		cmd.ui.Warn(T("Delete cancelled"))
+		return false, nil
+	}
+	return true, nil
+}
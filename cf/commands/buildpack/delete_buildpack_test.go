@@ -2,6 +2,7 @@ package buildpack_test
 
 import (
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/applications/applicationsfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -19,6 +20,7 @@ var _ = Describe("delete-buildpack command", func() {
 	var (
 		ui                  *testterm.FakeUI
 		buildpackRepo       *apifakes.OldFakeBuildpackRepository
+		appRepo             *applicationsfakes.FakeRepository
 		requirementsFactory *requirementsfakes.FakeFactory
 		deps                commandregistry.Dependency
 	)
@@ -26,12 +28,14 @@ var _ = Describe("delete-buildpack command", func() {
 	updateCommandDependency := func(pluginCall bool) {
 		deps.UI = ui
 		deps.RepoLocator = deps.RepoLocator.SetBuildpackRepository(buildpackRepo)
+		deps.RepoLocator = deps.RepoLocator.SetApplicationRepository(appRepo)
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("delete-buildpack").SetDependency(deps, pluginCall))
 	}
 
 	BeforeEach(func() {
 		ui = &testterm.FakeUI{}
 		buildpackRepo = new(apifakes.OldFakeBuildpackRepository)
+		appRepo = new(applicationsfakes.FakeRepository)
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 	})
 
@@ -69,13 +73,97 @@ var _ = Describe("delete-buildpack command", func() {
 
 				Expect(buildpackRepo.DeleteBuildpackGUID).To(Equal("my-buildpack-guid"))
 
+				Expect(appRepo.ListAppsByBuildpackCallCount()).To(Equal(1))
+				Expect(appRepo.ListAppsByBuildpackArgsForCall(0)).To(Equal("my-buildpack"))
 				Expect(ui.Prompts).To(ContainSubstrings([]string{"delete the buildpack my-buildpack"}))
+				Expect(ui.Prompts).ToNot(ContainSubstrings([]string{"explicitly use"}))
 				Expect(ui.Outputs()).To(ContainSubstrings(
 					[]string{"Deleting buildpack", "my-buildpack"},
 					[]string{"OK"},
 				))
 			})
 
+			Context("when apps explicitly use the buildpack", func() {
+				BeforeEach(func() {
+					appRepo.ListAppsByBuildpackReturns([]models.Application{
+						{ApplicationFields: models.ApplicationFields{Name: "app-1"}},
+						{ApplicationFields: models.ApplicationFields{Name: "app-2"}},
+					}, nil)
+				})
+
+				It("includes the number of apps in the confirmation prompt", func() {
+					ui = &testterm.FakeUI{Inputs: []string{"y"}}
+
+					runCommand("my-buildpack")
+
+					Expect(buildpackRepo.DeleteBuildpackGUID).To(Equal("my-buildpack-guid"))
+					Expect(ui.Prompts).To(ContainSubstrings(
+						[]string{"2 app(s) explicitly use buildpack my-buildpack"},
+						[]string{"Really delete the buildpack my-buildpack?"},
+					))
+					Expect(ui.Prompts).ToNot(ContainSubstrings([]string{"app-1"}))
+				})
+
+				Context("when the verbose flag is provided", func() {
+					It("also lists the names of the apps", func() {
+						ui = &testterm.FakeUI{Inputs: []string{"y"}}
+
+						runCommand("--verbose", "my-buildpack")
+
+						Expect(ui.Prompts).To(ContainSubstrings(
+							[]string{"2 app(s) explicitly use buildpack my-buildpack"},
+							[]string{"Apps: app-1, app-2"},
+						))
+					})
+				})
+
+				Context("when the user does not confirm", func() {
+					It("does not delete the buildpack", func() {
+						ui = &testterm.FakeUI{Inputs: []string{"n"}}
+
+						runCommand("my-buildpack")
+
+						Expect(buildpackRepo.DeleteBuildpackGUID).To(BeEmpty())
+						Expect(ui.WarnOutputs).To(ContainSubstrings([]string{"Delete cancelled"}))
+					})
+				})
+			})
+
+			Context("when the targeted API cannot filter apps by buildpack", func() {
+				BeforeEach(func() {
+					appRepo.ListAppsByBuildpackReturns(nil, errors.NewHTTPError(400, errors.BadQueryParameter, "The query parameter is invalid"))
+				})
+
+				It("skips the check with a note and still deletes the buildpack", func() {
+					ui = &testterm.FakeUI{Inputs: []string{"y"}}
+
+					runCommand("my-buildpack")
+
+					Expect(buildpackRepo.DeleteBuildpackGUID).To(Equal("my-buildpack-guid"))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Skipping the check for apps using buildpack my-buildpack"},
+						[]string{"Deleting buildpack", "my-buildpack"},
+						[]string{"OK"},
+					))
+				})
+			})
+
+			Context("when checking for apps using the buildpack fails", func() {
+				BeforeEach(func() {
+					appRepo.ListAppsByBuildpackReturns(nil, errors.New("list failed"))
+				})
+
+				It("fails without deleting the buildpack", func() {
+					runCommand("my-buildpack")
+
+					Expect(buildpackRepo.DeleteBuildpackGUID).To(BeEmpty())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"list failed"},
+					))
+				})
+			})
+
 			Context("when the force flag is provided", func() {
 				It("does not prompt the user to delete the buildback", func() {
 					runCommand("-f", "my-buildpack")
@@ -83,11 +171,19 @@ var _ = Describe("delete-buildpack command", func() {
 					Expect(buildpackRepo.DeleteBuildpackGUID).To(Equal("my-buildpack-guid"))
 
 					Expect(len(ui.Prompts)).To(Equal(0))
+					Expect(appRepo.ListAppsByBuildpackCallCount()).To(Equal(0))
 					Expect(ui.Outputs()).To(ContainSubstrings(
 						[]string{"Deleting buildpack", "my-buildpack"},
 						[]string{"OK"},
 					))
 				})
+
+				It("accepts the long form of the flag", func() {
+					runCommand("--force", "my-buildpack")
+
+					Expect(buildpackRepo.DeleteBuildpackGUID).To(Equal("my-buildpack-guid"))
+					Expect(len(ui.Prompts)).To(Equal(0))
+				})
 			})
 		})
 
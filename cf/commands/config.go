@@ -1,10 +1,14 @@
 package commands
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"sort"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/confighelpers"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/flags"
 	"code.cloudfoundry.org/cli/cf/requirements"
@@ -18,6 +22,39 @@ type ConfigCommands struct {
 	config coreconfig.ReadWriter
 }
 
+// configSetting is a single setting shown by the --list, --json and --get
+// flags. Only settings in this list are ever shown, so tokens and other
+// secrets in the config file cannot leak through them.
+type configSetting struct {
+	Key     string
+	JSONKey string
+	Value   func(cmd *ConfigCommands) (interface{}, error)
+}
+
+var configSettings = []configSetting{
+	{Key: "async-timeout", JSONKey: "async_timeout", Value: func(cmd *ConfigCommands) (interface{}, error) {
+		return cmd.config.AsyncTimeout(), nil
+	}},
+	{Key: "color", JSONKey: "color", Value: func(cmd *ConfigCommands) (interface{}, error) {
+		return cmd.config.ColorEnabled() != "false", nil
+	}},
+	{Key: "config-file", JSONKey: "config_file", Value: func(cmd *ConfigCommands) (interface{}, error) {
+		return confighelpers.DefaultFilePath()
+	}},
+	{Key: "foundation-check", JSONKey: "foundation_check", Value: func(cmd *ConfigCommands) (interface{}, error) {
+		return !cmd.config.FoundationCheckDisabled(), nil
+	}},
+	{Key: "locale", JSONKey: "locale", Value: func(cmd *ConfigCommands) (interface{}, error) {
+		return cmd.config.Locale(), nil
+	}},
+	{Key: "trace", JSONKey: "trace", Value: func(cmd *ConfigCommands) (interface{}, error) {
+		if cmd.config.Trace() == "" {
+			return "false", nil
+		}
+		return cmd.config.Trace(), nil
+	}},
+}
+
 func init() {
 	commandregistry.Register(&ConfigCommands{})
 }
@@ -28,12 +65,20 @@ func (cmd *ConfigCommands) MetaData() commandregistry.CommandMetadata {
 	fs["trace"] = &flags.StringFlag{Name: "trace", Usage: T("Trace HTTP requests")}
 	fs["color"] = &flags.StringFlag{Name: "color", Usage: T("Enable or disable color")}
 	fs["locale"] = &flags.StringFlag{Name: "locale", Usage: T("Set default locale. If LOCALE is 'CLEAR', previous locale is deleted.")}
+	fs["foundation-check"] = &flags.StringFlag{Name: "foundation-check", Usage: T("Enable or disable checking that the API endpoint belongs to the foundation you logged in to")}
+	fs["list"] = &flags.BoolFlag{Name: "list", Usage: T("Show the current values of the settings")}
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Show the current values of the settings as JSON")}
+	fs["get"] = &flags.StringFlag{Name: "get", Usage: T("Print the current value of a single setting")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "config",
 		Description: T("Write default values to the config"),
 		Usage: []string{
-			T("CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"),
+			T("CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)] [--foundation-check (true | false)]"),
+			"\n   ",
+			T("CF_NAME config (--list [--json] | --get KEY)"),
+			"\n\n",
+			T("KEYS:\n   async-timeout, color, config-file, foundation-check, locale, trace"),
 		},
 		Flags: fs,
 	}
@@ -51,8 +96,21 @@ func (cmd *ConfigCommands) SetDependency(deps commandregistry.Dependency, plugin
 }
 
 func (cmd *ConfigCommands) Execute(context flags.FlagContext) error {
-	if !context.IsSet("trace") && !context.IsSet("async-timeout") && !context.IsSet("color") && !context.IsSet("locale") {
+	setting := context.IsSet("trace") || context.IsSet("async-timeout") || context.IsSet("color") || context.IsSet("locale") || context.IsSet("foundation-check")
+	listing := context.Bool("list") || context.Bool("json")
+	getting := context.IsSet("get")
+
+	switch {
+	case !setting && !listing && !getting,
+		setting && (listing || getting),
+		listing && getting:
 		return errors.New(T("Incorrect Usage") + "\n\n" + commandregistry.Commands.CommandUsage("config"))
+	case getting:
+		return cmd.printSetting(context.String("get"))
+	case context.Bool("json"):
+		return cmd.listSettingsJSON()
+	case listing:
+		return cmd.listSettings()
 	}
 
 	if context.IsSet("async-timeout") {
@@ -80,6 +138,18 @@ func (cmd *ConfigCommands) Execute(context flags.FlagContext) error {
 		}
 	}
 
+	if context.IsSet("foundation-check") {
+		value := context.String("foundation-check")
+		switch value {
+		case "true":
+			cmd.config.SetFoundationCheckDisabled(false)
+		case "false":
+			cmd.config.SetFoundationCheckDisabled(true)
+		default:
+			return errors.New(T("Incorrect Usage") + "\n\n" + commandregistry.Commands.CommandUsage("config"))
+		}
+	}
+
 	if context.IsSet("locale") {
 		locale := context.String("locale")
 
@@ -106,3 +176,61 @@ func (cmd *ConfigCommands) Execute(context flags.FlagContext) error {
 	}
 	return nil
 }
+
+func (cmd *ConfigCommands) printSetting(key string) error {
+	for _, setting := range configSettings {
+		if setting.Key != key {
+			continue
+		}
+
+		value, err := setting.Value(cmd)
+		if err != nil {
+			return err
+		}
+		cmd.ui.Say(fmt.Sprint(value))
+		return nil
+	}
+
+	keys := make([]string, 0, len(configSettings))
+	for _, setting := range configSettings {
+		keys = append(keys, setting.Key)
+	}
+	return errors.New(T("Unknown setting '{{.Key}}'. The known settings are: {{.Keys}}", map[string]interface{}{
+		"Key":  key,
+		"Keys": strings.Join(keys, ", "),
+	}))
+}
+
+func (cmd *ConfigCommands) listSettings() error {
+	table := cmd.ui.Table([]string{T("setting"), T("value")})
+
+	for _, setting := range configSettings {
+		value, err := setting.Value(cmd)
+		if err != nil {
+			return err
+		}
+		table.Add(setting.Key, fmt.Sprint(value))
+	}
+
+	return table.Print()
+}
+
+func (cmd *ConfigCommands) listSettingsJSON() error {
+	settingsJSON := map[string]interface{}{}
+
+	for _, setting := range configSettings {
+		value, err := setting.Value(cmd)
+		if err != nil {
+			return err
+		}
+		settingsJSON[setting.JSONKey] = value
+	}
+
+	jsonBytes, err := json.MarshalIndent(settingsJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say(string(jsonBytes))
+	return nil
+}
@@ -1,6 +1,12 @@
 package commands_test
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
@@ -96,6 +102,23 @@ var _ = Describe("config command", func() {
 		})
 	})
 
+	Context("--foundation-check flag", func() {
+		It("stores whether the foundation check is disabled", func() {
+			runCommand("--foundation-check", "false")
+			Expect(configRepo.FoundationCheckDisabled()).To(BeTrue())
+
+			runCommand("--foundation-check", "true")
+			Expect(configRepo.FoundationCheckDisabled()).To(BeFalse())
+		})
+
+		It("fails with usage when a non-bool value is provided", func() {
+			runCommand("--foundation-check", "plaid")
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage"},
+			))
+		})
+	})
+
 	Context("--locale flag", func() {
 		It("stores the locale value when --locale [locale] is provided", func() {
 			runCommand("--locale", "zh-Hans")
@@ -125,4 +148,107 @@ var _ = Describe("config command", func() {
 			})
 		})
 	})
+	Describe("reading settings", func() {
+		var (
+			cfHome     string
+			oldCFHome  string
+			configFile string
+		)
+
+		BeforeEach(func() {
+			var err error
+			cfHome, err = ioutil.TempDir("", "cf-home")
+			Expect(err).ToNot(HaveOccurred())
+			oldCFHome = os.Getenv("CF_HOME")
+			os.Setenv("CF_HOME", cfHome)
+			configFile = filepath.Join(cfHome, ".cf", "config.json")
+
+			configRepo.SetAsyncTimeout(12)
+			configRepo.SetColorEnabled("false")
+			configRepo.SetLocale("fr-FR")
+			configRepo.SetTrace("/tmp/trace.log")
+			configRepo.SetRefreshToken("the-refresh-token")
+		})
+
+		AfterEach(func() {
+			os.Setenv("CF_HOME", oldCFHome)
+			os.RemoveAll(cfHome)
+		})
+
+		Context("--list flag", func() {
+			It("shows each setting and the config file path", func() {
+				runCommand("--list")
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"setting", "value"},
+					[]string{"async-timeout", "12"},
+					[]string{"color", "false"},
+					[]string{"config-file", configFile},
+					[]string{"foundation-check", "true"},
+					[]string{"locale", "fr-FR"},
+					[]string{"trace", "/tmp/trace.log"},
+				))
+			})
+
+			It("never shows tokens", func() {
+				runCommand("--list")
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{configRepo.AccessToken()}))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"the-refresh-token"}))
+			})
+		})
+
+		Context("--json flag", func() {
+			It("shows the settings as JSON", func() {
+				runCommand("--json")
+
+				var settings map[string]interface{}
+				Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &settings)).To(Succeed())
+				Expect(settings).To(Equal(map[string]interface{}{
+					"async_timeout":    float64(12),
+					"color":            false,
+					"config_file":      configFile,
+					"foundation_check": true,
+					"locale":           "fr-FR",
+					"trace":            "/tmp/trace.log",
+				}))
+			})
+
+			It("can be combined with --list", func() {
+				runCommand("--list", "--json")
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{`"locale": "fr-FR"`}))
+			})
+		})
+
+		Context("--get flag", func() {
+			It("prints only the value of the setting", func() {
+				runCommand("--get", "locale")
+				Expect(ui.Outputs()).To(Equal([]string{"fr-FR"}))
+			})
+
+			It("prints the defaults of settings that are not set", func() {
+				configRepo.SetTrace("")
+				runCommand("--get", "trace")
+				Expect(ui.Outputs()).To(Equal([]string{"false"}))
+			})
+
+			It("fails for settings it does not know, including tokens", func() {
+				runCommand("--get", "access-token")
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Unknown setting 'access-token'. The known settings are: async-timeout, color, config-file, foundation-check, locale, trace"},
+				))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{configRepo.AccessToken()}))
+			})
+		})
+
+		It("fails with usage when reading and writing settings at once", func() {
+			runCommand("--list", "--color", "true")
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"Incorrect Usage"}))
+			Expect(configRepo.ColorEnabled()).To(Equal("false"))
+		})
+
+		It("fails with usage when combining --get with --list", func() {
+			runCommand("--get", "color", "--list")
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"Incorrect Usage"}))
+		})
+	})
 })
@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
-	"code.cloudfoundry.org/cli/cf/flagcontext"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 
@@ -36,27 +38,41 @@ func init() {
 func (cmd *Curl) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["i"] = &flags.BoolFlag{ShortName: "i", Usage: T("Include response headers in the output")}
+	fs["include-headers-json"] = &flags.BoolFlag{Name: "include-headers-json", Usage: T("Output a JSON object containing the response status, headers and body")}
 	fs["X"] = &flags.StringFlag{ShortName: "X", Usage: T("HTTP method (GET,POST,PUT,DELETE,etc)")}
 	fs["H"] = &flags.StringSliceFlag{ShortName: "H", Usage: T("Custom headers to include in the request, flag can be specified multiple times")}
-	fs["d"] = &flags.StringFlag{ShortName: "d", Usage: T("HTTP data to include in the request body, or '@' followed by a file name to read the data from")}
+	fs["d"] = &flags.StringFlag{ShortName: "d", Usage: T("HTTP data to include in the request body, '@' followed by a file name to read the data from, or '@-' to read the data from stdin")}
 	fs["output"] = &flags.StringFlag{Name: "output", Usage: T("Write curl body to FILE instead of stdout")}
+	fs["fail"] = &flags.BoolFlag{Name: "fail", Usage: T("Exit with a non-zero status and print the response body to stderr when the response status is 400 or higher")}
+	fs["paginate"] = &flags.BoolFlag{Name: "paginate", Usage: T("Follow pagination links for GET requests and print all resources as a single JSON array")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "curl",
 		Description: T("Executes a request to the targeted API endpoint"),
 		Usage: []string{
-			T(`CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE]
+			T(`CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE] [--include-headers-json] [--fail] [--paginate]
 
    By default 'CF_NAME curl' will perform a GET to the specified PATH. If data
    is provided via -d, a POST will be performed instead, and the Content-Type
    will be set to application/json. You may override headers with -H and the
-   request method with -X.
+   request method with -X. Request bodies given with '-d @FILE' or '-d @-'
+   (stdin) and binary responses are streamed rather than held in memory.
+
+   With --paginate, GET requests that return a paginated list will follow
+   every 'next_url' (v2) or 'pagination.next.href' (v3) link and print the
+   combined 'resources' as a single JSON array.
+
+   With --include-headers-json, the response is written as a single JSON object
+   with 'status', 'headers' and 'body' keys. JSON bodies are embedded as is and
+   any other body is embedded as a string. Combined with --fail, the object is
+   still written before exiting with a non-zero status.
 
    For API documentation, please visit http://apidocs.cloudfoundry.org.`),
 		},
 		Examples: []string{
 			`CF_NAME curl "/v2/apps" -X GET -H "Content-Type: application/x-www-form-urlencoded" -d 'q=name:myapp'`,
 			`CF_NAME curl "/v2/apps" -d @/path/to/file`,
+			`cat app.json | CF_NAME curl "/v3/apps" -X POST -d @-`,
 		},
 		Flags: fs,
 	}
@@ -68,6 +84,10 @@ func (cmd *Curl) Requirements(requirementsFactory requirements.Factory, fc flags
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
+	if fc.Bool("i") && fc.Bool("include-headers-json") {
+		return nil, errors.New(T("Incorrect Usage: '-i' and '--include-headers-json' cannot be used together") + "\n\n" + commandregistry.Commands.CommandUsage("curl"))
+	}
+
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewAPIEndpointRequirement(),
 	}
@@ -88,16 +108,19 @@ func (cmd *Curl) Execute(c flags.FlagContext) error {
 	headers := c.StringSlice("H")
 
 	var method string
-	var body string
+	var body io.Reader
 
 	if c.IsSet("d") {
 		method = "POST"
 
-		jsonBytes, err := flagcontext.GetContentsFromOptionalFlagValue(c.String("d"))
+		requestBody, err := cmd.requestBody(c.String("d"))
 		if err != nil {
 			return err
 		}
-		body = string(jsonBytes)
+		if closer, ok := requestBody.(io.Closer); ok {
+			defer closer.Close()
+		}
+		body = requestBody
 	}
 
 	if c.IsSet("X") {
@@ -106,10 +129,63 @@ func (cmd *Curl) Execute(c flags.FlagContext) error {
 
 	reqHeader := strings.Join(headers, "\n")
 
-	responseHeader, responseBody, apiErr := cmd.curlRepo.Request(method, path, reqHeader, body)
+	responseHeader, responseBodyReader, apiErr := cmd.curlRepo.RequestStream(method, path, reqHeader, body)
 	if apiErr != nil {
 		return errors.New(T("Error creating request:\n{{.Err}}", map[string]interface{}{"Err": apiErr.Error()}))
 	}
+	defer responseBodyReader.Close()
+
+	paginate := c.Bool("paginate") && (method == "" || strings.ToUpper(method) == "GET")
+
+	if c.Bool("include-headers-json") {
+		return cmd.writeResponseJSON(responseHeader, responseBodyReader, reqHeader, c.String("output"), c.Bool("fail"), paginate)
+	}
+
+	if c.Bool("fail") {
+		if statusCode := responseStatusCode(responseHeader); statusCode >= 400 {
+			_, _ = io.Copy(os.Stderr, responseBodyReader)
+			fmt.Fprintln(os.Stderr)
+			return errors.New(T("The server responded with status code {{.StatusCode}}", map[string]interface{}{"StatusCode": statusCode}))
+		}
+	}
+
+	if trace.LoggingToStdout && !cmd.pluginCall && !paginate {
+		return nil
+	}
+
+	if c.String("output") != "" && !paginate {
+		if c.Bool("i") {
+			cmd.ui.Say(responseHeader)
+		}
+
+		err := cmd.writeToFile(responseBodyReader, c.String("output"))
+		if err != nil {
+			return errors.New(T("Error creating request:\n{{.Err}}", map[string]interface{}{"Err": err}))
+		}
+		return nil
+	}
+
+	if !paginate && !cmd.pluginCall && isBinaryContentType(responseContentType(responseHeader)) {
+		if c.Bool("i") {
+			cmd.ui.Say(responseHeader)
+		}
+
+		_, err := io.Copy(cmd.ui.Writer(), responseBodyReader)
+		return err
+	}
+
+	responseBytes, err := ioutil.ReadAll(responseBodyReader)
+	if err != nil {
+		return fmt.Errorf("%s: %s", T("Error reading response"), err.Error())
+	}
+	responseBody := string(responseBytes)
+
+	if paginate {
+		responseBody, apiErr = cmd.followPagination(responseBody, reqHeader, c.Bool("fail"))
+		if apiErr != nil {
+			return apiErr
+		}
+	}
 
 	if trace.LoggingToStdout && !cmd.pluginCall {
 		return nil
@@ -120,7 +196,7 @@ func (cmd *Curl) Execute(c flags.FlagContext) error {
 	}
 
 	if c.String("output") != "" {
-		err := cmd.writeToFile(responseBody, c.String("output"))
+		err := cmd.writeToFile(strings.NewReader(responseBody), c.String("output"))
 		if err != nil {
 			return errors.New(T("Error creating request:\n{{.Err}}", map[string]interface{}{"Err": err}))
 		}
@@ -138,7 +214,223 @@ func (cmd *Curl) Execute(c flags.FlagContext) error {
 	return nil
 }
 
-func (cmd Curl) writeToFile(responseBody, filePath string) (err error) {
+type curlResponseJSON struct {
+	Status  int                 `json:"status"`
+	Headers map[string][]string `json:"headers"`
+	Body    json.RawMessage     `json:"body"`
+}
+
+// writeResponseJSON writes the response status, headers and body as a single
+// JSON object to the output file or the UI. When failOnError is set, a status
+// of 400 or higher is returned as an error after the object has been written.
+func (cmd *Curl) writeResponseJSON(responseHeader string, responseBodyReader io.Reader, reqHeader string, outputFile string, failOnError bool, paginate bool) error {
+	responseBytes, err := ioutil.ReadAll(responseBodyReader)
+	if err != nil {
+		return fmt.Errorf("%s: %s", T("Error reading response"), err.Error())
+	}
+	responseBody := string(responseBytes)
+
+	statusCode := responseStatusCode(responseHeader)
+	if paginate && statusCode < 400 {
+		responseBody, err = cmd.followPagination(responseBody, reqHeader, failOnError)
+		if err != nil {
+			return err
+		}
+	}
+
+	body := json.RawMessage(responseBody)
+	var parsedBody interface{}
+	if json.Unmarshal(body, &parsedBody) != nil {
+		body, err = json.Marshal(responseBody)
+		if err != nil {
+			return err
+		}
+	}
+
+	output, err := json.MarshalIndent(curlResponseJSON{
+		Status:  statusCode,
+		Headers: responseHeaders(responseHeader),
+		Body:    body,
+	}, "", "   ")
+	if err != nil {
+		return err
+	}
+
+	if outputFile != "" {
+		err = cmd.writeToFile(bytes.NewReader(output), outputFile)
+		if err != nil {
+			return errors.New(T("Error creating request:\n{{.Err}}", map[string]interface{}{"Err": err}))
+		}
+	} else if !trace.LoggingToStdout || cmd.pluginCall {
+		cmd.ui.Say(string(output))
+	}
+
+	if failOnError && statusCode >= 400 {
+		return errors.New(T("The server responded with status code {{.StatusCode}}", map[string]interface{}{"StatusCode": statusCode}))
+	}
+	return nil
+}
+
+// requestBody returns a reader for the value of the -d flag. '@-' streams
+// stdin and '@FILE' reads from FILE. Any other value that names an existing
+// file is read from that file, otherwise the value itself is used as the body.
+// Every body but stdin can be rewound, so the request can be sent again after
+// the access token is refreshed.
+func (cmd *Curl) requestBody(data string) (io.Reader, error) {
+	trimmedData := strings.Trim(data, `"'`)
+	if strings.HasPrefix(trimmedData, "@") {
+		fileName := strings.Trim(trimmedData[1:], `"'`)
+		if fileName == "-" {
+			return ioutil.NopCloser(os.Stdin), nil
+		}
+		return os.Open(fileName)
+	}
+
+	if file, err := os.Open(trimmedData); err == nil {
+		if info, statErr := file.Stat(); statErr == nil && !info.IsDir() {
+			return file, nil
+		}
+		file.Close()
+	}
+
+	return strings.NewReader(trimmedData), nil
+}
+
+// followPagination requests every subsequent page of a paginated v2 or v3
+// response and returns the combined resources as a JSON array. Bodies that are
+// not paginated lists are returned unchanged.
+func (cmd *Curl) followPagination(responseBody string, reqHeader string, failOnError bool) (string, error) {
+	nextPath, resources, isPaginated := parsePaginatedResponse(responseBody, cmd.config.APIEndpoint())
+	if !isPaginated {
+		return responseBody, nil
+	}
+
+	for nextPath != "" {
+		pagePath := nextPath
+		responseHeader, body, err := cmd.curlRepo.Request("GET", pagePath, reqHeader, "")
+		if err != nil {
+			return "", errors.New(T("Error creating request:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+		}
+
+		if failOnError {
+			if statusCode := responseStatusCode(responseHeader); statusCode >= 400 {
+				fmt.Fprintln(os.Stderr, body)
+				return "", errors.New(T("The server responded with status code {{.StatusCode}}", map[string]interface{}{"StatusCode": statusCode}))
+			}
+		}
+
+		var pageResources []json.RawMessage
+		nextPath, pageResources, isPaginated = parsePaginatedResponse(body, cmd.config.APIEndpoint())
+		if !isPaginated {
+			return "", errors.New(T("Error following pagination: page {{.Path}} is not a paginated response", map[string]interface{}{"Path": pagePath}))
+		}
+		resources = append(resources, pageResources...)
+	}
+
+	combined, err := json.Marshal(resources)
+	if err != nil {
+		return "", err
+	}
+	return string(combined), nil
+}
+
+type v3Pagination struct {
+	Next *struct {
+		Href string `json:"href"`
+	} `json:"next"`
+}
+
+// parsePaginatedResponse returns the path of the next page (relative to the
+// API endpoint), the resources of the current page, and whether the body was a
+// paginated list at all.
+func parsePaginatedResponse(body string, apiEndpoint string) (string, []json.RawMessage, bool) {
+	var page map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(body), &page); err != nil {
+		return "", nil, false
+	}
+
+	var resources []json.RawMessage
+	if rawResources, ok := page["resources"]; !ok || json.Unmarshal(rawResources, &resources) != nil {
+		return "", nil, false
+	}
+
+	if rawNextURL, ok := page["next_url"]; ok {
+		var nextURL *string
+		if err := json.Unmarshal(rawNextURL, &nextURL); err != nil {
+			return "", nil, false
+		}
+		if nextURL == nil {
+			return "", resources, true
+		}
+		return *nextURL, resources, true
+	}
+
+	if rawPagination, ok := page["pagination"]; ok {
+		var pagination v3Pagination
+		if err := json.Unmarshal(rawPagination, &pagination); err != nil {
+			return "", nil, false
+		}
+		if pagination.Next == nil {
+			return "", resources, true
+		}
+		return strings.TrimPrefix(pagination.Next.Href, strings.TrimRight(apiEndpoint, "/")), resources, true
+	}
+
+	return "", nil, false
+}
+
+// responseContentType extracts the media type of the Content-Type header from
+// a dumped HTTP response. It returns an empty string if there is none.
+func responseContentType(responseHeader string) string {
+	for _, line := range strings.Split(responseHeader, "\n") {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) == 2 && strings.EqualFold(strings.TrimSpace(parts[0]), "Content-Type") {
+			return strings.TrimSpace(strings.SplitN(parts[1], ";", 2)[0])
+		}
+	}
+	return ""
+}
+
+// responseHeaders parses the header lines of a dumped HTTP response into a map
+// keyed by canonical header name. The status line is skipped.
+func responseHeaders(responseHeader string) map[string][]string {
+	headers := map[string][]string{}
+	for _, line := range strings.Split(responseHeader, "\n") {
+		parts := strings.SplitN(strings.TrimRight(line, "\r"), ":", 2)
+		if len(parts) != 2 || strings.HasPrefix(parts[0], "HTTP/") {
+			continue
+		}
+		key := http.CanonicalHeaderKey(strings.TrimSpace(parts[0]))
+		headers[key] = append(headers[key], strings.TrimSpace(parts[1]))
+	}
+	return headers
+}
+
+// isBinaryContentType returns true for content types that should be streamed
+// as they arrive instead of being formatted for display.
+func isBinaryContentType(contentType string) bool {
+	return contentType != "" &&
+		!strings.HasPrefix(contentType, "text/") &&
+		!strings.Contains(contentType, "json")
+}
+
+// responseStatusCode extracts the status code from the status line of a dumped
+// HTTP response. It returns 0 if the status line cannot be parsed.
+func responseStatusCode(responseHeader string) int {
+	statusLine := strings.SplitN(strings.TrimSpace(responseHeader), "\n", 2)[0]
+	fields := strings.Fields(statusLine)
+	if len(fields) < 2 || !strings.HasPrefix(fields[0], "HTTP/") {
+		return 0
+	}
+
+	statusCode, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0
+	}
+	return statusCode
+}
+
+func (cmd Curl) writeToFile(responseBody io.Reader, filePath string) (err error) {
 	if _, err = os.Stat(filePath); os.IsNotExist(err) {
 		err = os.MkdirAll(filepath.Dir(filePath), 0755)
 	}
@@ -147,5 +439,12 @@ func (cmd Curl) writeToFile(responseBody, filePath string) (err error) {
 		return
 	}
 
-	return ioutil.WriteFile(filePath, []byte(responseBody), 0644)
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, responseBody)
+	return
 }
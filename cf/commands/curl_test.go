@@ -1,9 +1,11 @@
 package commands_test
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -168,6 +170,7 @@ var _ = Describe("curl command", func() {
 			runCurlWithInputs([]string{"-d", "body content to upload", "/foo"})
 
 			Expect(curlRepo.Body).To(Equal("body content to upload"))
+			Expect(curlRepo.BodySeekable).To(BeTrue())
 			Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"FAILED"}))
 		})
 
@@ -197,10 +200,75 @@ var _ = Describe("curl command", func() {
 			runCurlWithInputs([]string{"-d", "@" + tempfile.Name(), "/foo"})
 
 			Expect(curlRepo.Body).To(Equal(`{"some":"json"}`))
+			Expect(curlRepo.BodySeekable).To(BeTrue())
 			Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"FAILED"}))
 		})
 	})
 
+	Context("when -d is @-", func() {
+		var (
+			originalStdin *os.File
+			stdinWriter   *os.File
+		)
+
+		BeforeEach(func() {
+			var stdinReader *os.File
+			var err error
+			stdinReader, stdinWriter, err = os.Pipe()
+			Expect(err).NotTo(HaveOccurred())
+
+			originalStdin = os.Stdin
+			os.Stdin = stdinReader
+		})
+
+		AfterEach(func() {
+			os.Stdin = originalStdin
+		})
+
+		It("reads the request body from stdin", func() {
+			_, err := stdinWriter.WriteString(`{"name":"from-stdin"}`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdinWriter.Close()).To(Succeed())
+
+			runCurlWithInputs([]string{"-X", "PUT", "-d", "@-", "/foo"})
+
+			Expect(curlRepo.Method).To(Equal("PUT"))
+			Expect(curlRepo.Body).To(Equal(`{"name":"from-stdin"}`))
+			Expect(curlRepo.BodySeekable).To(BeFalse())
+			Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"FAILED"}))
+		})
+	})
+
+	It("fails when the @-prefixed file does not exist", func() {
+		Expect(runCurlWithInputs([]string{"-d", "@/some/nonexistent/file", "/foo"})).To(BeFalse())
+		Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}))
+	})
+
+	Context("when the response is binary", func() {
+		BeforeEach(func() {
+			curlRepo.ResponseHeader = "HTTP/1.1 200 OK\r\nContent-Type: application/octet-stream\r\n"
+			curlRepo.ResponseBody = "some-binary-bits"
+		})
+
+		It("streams the body to the given --output file", func() {
+			fileutils.TempDir("binary-output", func(tmpDir string, err error) {
+				Expect(err).ToNot(HaveOccurred())
+
+				filePath := filepath.Join(tmpDir, "droplet.tgz")
+				Expect(runCurlWithInputs([]string{"--output", filePath, "/v3/droplets/some-guid/download"})).To(BeTrue())
+
+				contents, err := ioutil.ReadFile(filePath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-binary-bits"))
+			})
+		})
+
+		It("does not print the body through the UI", func() {
+			Expect(runCurlWithInputs([]string{"/v3/droplets/some-guid/download"})).To(BeTrue())
+			Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"some-binary-bits"}))
+		})
+	})
+
 	It("does not print the response when verbose output is enabled", func() {
 		// This is to prevent the response from being printed twice
 
@@ -235,6 +303,162 @@ var _ = Describe("curl command", func() {
 		))
 	})
 
+	Context("when the --fail flag is provided", func() {
+		It("fails when the response status is 400 or higher", func() {
+			curlRepo.ResponseHeader = "HTTP/1.1 404 Not Found\r\nContent-Type: application/json\r\n"
+			curlRepo.ResponseBody = `{"code":10000,"description":"Unknown request"}`
+
+			Expect(runCurlWithInputs([]string{"--fail", "/foo"})).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"The server responded with status code 404"},
+			))
+		})
+
+		It("succeeds when the response status is below 400", func() {
+			curlRepo.ResponseHeader = "HTTP/1.1 200 OK\r\n"
+			curlRepo.ResponseBody = "response for get"
+
+			Expect(runCurlWithInputs([]string{"--fail", "/foo"})).To(BeTrue())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"response for get"}))
+		})
+	})
+
+	Context("when the --include-headers-json flag is provided", func() {
+		BeforeEach(func() {
+			curlRepo.ResponseHeader = "HTTP/1.1 202 Accepted\r\nContent-Type: application/json\r\nLocation: /v3/jobs/some-job-guid\r\nX-Cf-Warnings: warning-1\r\nX-Cf-Warnings: warning-2\r\n"
+			curlRepo.ResponseBody = `{"guid":"some-guid"}`
+		})
+
+		It("prints the status, headers and body as a single JSON object", func() {
+			Expect(runCurlWithInputs([]string{"--include-headers-json", "/foo"})).To(BeTrue())
+
+			var output struct {
+				Status  int                    `json:"status"`
+				Headers map[string][]string    `json:"headers"`
+				Body    map[string]interface{} `json:"body"`
+			}
+			Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &output)).To(Succeed())
+			Expect(output.Status).To(Equal(202))
+			Expect(output.Headers).To(HaveKeyWithValue("Location", []string{"/v3/jobs/some-job-guid"}))
+			Expect(output.Headers).To(HaveKeyWithValue("X-Cf-Warnings", []string{"warning-1", "warning-2"}))
+			Expect(output.Body).To(Equal(map[string]interface{}{"guid": "some-guid"}))
+		})
+
+		It("embeds a non-JSON body as a string", func() {
+			curlRepo.ResponseBody = "plain text"
+			Expect(runCurlWithInputs([]string{"--include-headers-json", "/foo"})).To(BeTrue())
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{`"body": "plain text"`}))
+		})
+
+		It("writes the JSON object to the --output file", func() {
+			fileutils.TempFile("curl-json", func(tempFile *os.File, err error) {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(runCurlWithInputs([]string{"--include-headers-json", "--output", tempFile.Name(), "/foo"})).To(BeTrue())
+				contents, err := ioutil.ReadAll(tempFile)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(ContainSubstring(`"status": 202`))
+				Expect(ui.Outputs()).To(BeEmpty())
+			})
+		})
+
+		Context("when --fail is provided and the response status is 400 or higher", func() {
+			BeforeEach(func() {
+				curlRepo.ResponseHeader = "HTTP/1.1 404 Not Found\r\nContent-Type: application/json\r\n"
+				curlRepo.ResponseBody = `{"code":10000}`
+			})
+
+			It("prints the JSON object and fails", func() {
+				Expect(runCurlWithInputs([]string{"--include-headers-json", "--fail", "/foo"})).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{`"status": 404`},
+					[]string{"FAILED"},
+					[]string{"The server responded with status code 404"},
+				))
+			})
+		})
+
+		It("fails with usage when -i is also provided", func() {
+			Expect(runCurlWithInputs([]string{"--include-headers-json", "-i", "/foo"})).To(BeFalse())
+			Expect(curlRepo.Path).To(BeEmpty())
+		})
+	})
+
+	It("does not fail on an error status when --fail is not provided", func() {
+		curlRepo.ResponseHeader = "HTTP/1.1 500 Internal Server Error\r\n"
+		curlRepo.ResponseBody = "boom"
+
+		Expect(runCurlWithInputs([]string{"/foo"})).To(BeTrue())
+		Expect(ui.Outputs()).To(ContainSubstrings([]string{"boom"}))
+	})
+
+	Context("when the --paginate flag is provided", func() {
+		var pagingRepo *apifakes.FakeCurlRepository
+
+		BeforeEach(func() {
+			pagingRepo = new(apifakes.FakeCurlRepository)
+			deps.RepoLocator = deps.RepoLocator.SetCurlRepository(pagingRepo)
+			config.SetAPIEndpoint("https://api.example.com")
+		})
+
+		runPaginatedCurlWithInputs := func(args []string) bool {
+			return testcmd.RunCLICommand("curl", args, requirementsFactory, func(pluginCall bool) {
+				deps.UI = ui
+				deps.Config = config
+				commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("curl").SetDependency(deps, pluginCall))
+			}, false, ui)
+		}
+
+		It("follows v2 next_url links and prints the combined resources", func() {
+			pagingRepo.RequestStreamReturns("HTTP/1.1 200 OK\r\n", ioutil.NopCloser(strings.NewReader(`{"next_url":"/v2/apps?page=2","resources":[{"name":"app-1"}]}`)), nil)
+			pagingRepo.RequestStub = func(method, path, header, body string) (string, string, error) {
+				switch path {
+				case "/v2/apps?page=2":
+					return "HTTP/1.1 200 OK\r\n", `{"next_url":null,"resources":[{"name":"app-2"}]}`, nil
+				}
+				return "", "", errors.New("unexpected path " + path)
+			}
+
+			Expect(runPaginatedCurlWithInputs([]string{"--paginate", "/v2/apps"})).To(BeTrue())
+			Expect(pagingRepo.RequestStreamCallCount()).To(Equal(1))
+			Expect(pagingRepo.RequestCallCount()).To(Equal(1))
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{`[{"name":"app-1"},{"name":"app-2"}]`}))
+		})
+
+		It("follows v3 pagination.next.href links relative to the API endpoint", func() {
+			pagingRepo.RequestStreamReturns("HTTP/1.1 200 OK\r\n", ioutil.NopCloser(strings.NewReader(`{"pagination":{"next":{"href":"https://api.example.com/v3/apps?page=2"}},"resources":[{"name":"app-1"}]}`)), nil)
+			pagingRepo.RequestStub = func(method, path, header, body string) (string, string, error) {
+				switch path {
+				case "/v3/apps?page=2":
+					return "HTTP/1.1 200 OK\r\n", `{"pagination":{"next":null},"resources":[{"name":"app-2"}]}`, nil
+				}
+				return "", "", errors.New("unexpected path " + path)
+			}
+
+			Expect(runPaginatedCurlWithInputs([]string{"--paginate", "/v3/apps"})).To(BeTrue())
+			Expect(pagingRepo.RequestCallCount()).To(Equal(1))
+			_, path, _, _ := pagingRepo.RequestArgsForCall(0)
+			Expect(path).To(Equal("/v3/apps?page=2"))
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{`[{"name":"app-1"},{"name":"app-2"}]`}))
+		})
+
+		It("passes non-paginated responses through unchanged", func() {
+			pagingRepo.RequestStreamReturns("HTTP/1.1 200 OK\r\n", ioutil.NopCloser(strings.NewReader(`{"name":"app-1"}`)), nil)
+
+			Expect(runPaginatedCurlWithInputs([]string{"--paginate", "/v3/apps/some-guid"})).To(BeTrue())
+			Expect(pagingRepo.RequestCallCount()).To(Equal(0))
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{`{"name":"app-1"}`}))
+		})
+
+		It("does not follow pagination for non-GET requests", func() {
+			pagingRepo.RequestStreamReturns("HTTP/1.1 200 OK\r\n", ioutil.NopCloser(strings.NewReader(`{"next_url":"/v2/apps?page=2","resources":[]}`)), nil)
+
+			Expect(runPaginatedCurlWithInputs([]string{"--paginate", "-X", "POST", "/v2/apps"})).To(BeTrue())
+			Expect(pagingRepo.RequestCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("Whent the content type is JSON", func() {
 		BeforeEach(func() {
 			curlRepo.ResponseHeader = "Content-Type: application/json;charset=utf-8"
@@ -29,11 +29,12 @@ func init() {
 func (cmd *CreateSharedDomain) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["router-group"] = &flags.StringFlag{Name: "router-group", Usage: T("Routes for this domain will be configured only on the specified router group")}
+	fs["internal"] = &flags.BoolFlag{Name: "internal", Usage: T("Applications that use internal routes communicate directly on the container network")}
 	return commandregistry.CommandMetadata{
 		Name:        "create-shared-domain",
 		Description: T("Create a domain that can be used by all orgs (admin-only)"),
 		Usage: []string{
-			T("CF_NAME create-shared-domain DOMAIN [--router-group ROUTER_GROUP]"),
+			T("CF_NAME create-shared-domain DOMAIN [--router-group ROUTER_GROUP | --internal]"),
 		},
 		Flags: fs,
 	}
@@ -45,6 +46,11 @@ func (cmd *CreateSharedDomain) Requirements(requirementsFactory requirements.Fac
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
+	if fc.String("router-group") != "" && fc.Bool("internal") {
+		cmd.ui.Failed(T("Cannot specify router-group together with internal."))
+		return nil, fmt.Errorf("Cannot specify router-group together with internal.")
+	}
+
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewLoginRequirement(),
 	}
@@ -56,6 +62,10 @@ func (cmd *CreateSharedDomain) Requirements(requirementsFactory requirements.Fac
 		}...)
 	}
 
+	if fc.Bool("internal") {
+		reqs = append(reqs, requirementsFactory.NewMinAPIVersionRequirement("Option '--internal'", cf.InternalDomainMinimumAPIVersion))
+	}
+
 	return reqs, nil
 }
 
@@ -99,11 +109,15 @@ func (cmd *CreateSharedDomain) Execute(c flags.FlagContext) error {
 			"DomainName": terminal.EntityNameColor(domainName),
 			"Username":   terminal.EntityNameColor(cmd.config.Username())}))
 
-	err := cmd.domainRepo.CreateSharedDomain(domainName, routerGroup.GUID)
+	err := cmd.domainRepo.CreateSharedDomain(domainName, routerGroup.GUID, c.Bool("internal"))
 	if err != nil {
 		return err
 	}
 
 	cmd.ui.Ok()
+
+	if c.Bool("internal") {
+		cmd.ui.Say(T("TIP: Routes on internal domains are only reachable from other apps over the container network, and need a network policy to receive traffic."))
+	}
 	return nil
 }
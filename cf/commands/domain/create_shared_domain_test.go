@@ -177,6 +177,47 @@ var _ = Describe("CreateSharedDomain", func() {
 					Expect(actualRequirements).To(ContainElement(minAPIVersionRequirement))
 				})
 			})
+
+			Context("when internal flag is set", func() {
+				BeforeEach(func() {
+					flagContext.Parse("domain-name", "--internal")
+				})
+
+				It("does not return a RoutingAPIRequirement", func() {
+					_, err := cmd.Requirements(factory, flagContext)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(factory.NewRoutingAPIRequirementCallCount()).To(Equal(0))
+				})
+
+				It("returns a MinAPIVersionRequirement", func() {
+					expectedVersion, err := semver.Make("2.115.0")
+					Expect(err).NotTo(HaveOccurred())
+
+					actualRequirements, err := cmd.Requirements(factory, flagContext)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(factory.NewMinAPIVersionRequirementCallCount()).To(Equal(1))
+					feature, requiredVersion := factory.NewMinAPIVersionRequirementArgsForCall(0)
+					Expect(feature).To(Equal("Option '--internal'"))
+					Expect(requiredVersion).To(Equal(expectedVersion))
+					Expect(actualRequirements).To(ContainElement(minAPIVersionRequirement))
+				})
+			})
+
+			Context("when both the router-group and internal flags are set", func() {
+				BeforeEach(func() {
+					flagContext.Parse("domain-name", "--router-group", "route-group-name", "--internal")
+				})
+
+				It("fails with an error", func() {
+					_, err := cmd.Requirements(factory, flagContext)
+					Expect(err).To(HaveOccurred())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Cannot specify router-group together with internal."},
+					))
+				})
+			})
 		})
 	})
 
@@ -214,9 +255,10 @@ var _ = Describe("CreateSharedDomain", func() {
 			It("tries to create a shared domain with router group", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(domainRepo.CreateSharedDomainCallCount()).To(Equal(1))
-				domainName, routerGroupGUID := domainRepo.CreateSharedDomainArgsForCall(0)
+				domainName, routerGroupGUID, internal := domainRepo.CreateSharedDomainArgsForCall(0)
 				Expect(domainName).To(Equal("domain-name"))
 				Expect(routerGroupGUID).To(Equal("router-group-guid"))
+				Expect(internal).To(BeFalse())
 			})
 
 			It("prints success message", func() {
@@ -269,9 +311,10 @@ var _ = Describe("CreateSharedDomain", func() {
 			It("tries to create a shared domain without router group", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(domainRepo.CreateSharedDomainCallCount()).To(Equal(1))
-				domainName, routerGroupGUID := domainRepo.CreateSharedDomainArgsForCall(0)
+				domainName, routerGroupGUID, internal := domainRepo.CreateSharedDomainArgsForCall(0)
 				Expect(domainName).To(Equal("domain-name"))
 				Expect(routerGroupGUID).To(Equal(""))
+				Expect(internal).To(BeFalse())
 			})
 
 			It("prints success message", func() {
@@ -282,6 +325,29 @@ var _ = Describe("CreateSharedDomain", func() {
 			})
 		})
 
+		Context("when internal flag is set", func() {
+			BeforeEach(func() {
+				flagContext.Parse("domain-name", "--internal")
+			})
+
+			It("creates an internal shared domain", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(domainRepo.CreateSharedDomainCallCount()).To(Equal(1))
+				domainName, routerGroupGUID, internal := domainRepo.CreateSharedDomainArgsForCall(0)
+				Expect(domainName).To(Equal("domain-name"))
+				Expect(routerGroupGUID).To(Equal(""))
+				Expect(internal).To(BeTrue())
+			})
+
+			It("explains how internal routes are reached", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"OK"},
+					[]string{"TIP: Routes on internal domains are only reachable from other apps over the container network"},
+				))
+			})
+		})
+
 		Context("when creating shared domain returns error", func() {
 			BeforeEach(func() {
 				flagContext.Parse("domain-name")
@@ -1,8 +1,12 @@
 package domain
 
 import (
+	"encoding/json"
 	"errors"
+	"strconv"
+	"strings"
 
+	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -13,11 +17,42 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+type domainJSON struct {
+	GUID            string  `json:"guid"`
+	Name            string  `json:"name"`
+	Status          string  `json:"status"`
+	RouterGroupType string  `json:"router_group_type"`
+	RouterGroupName *string `json:"router_group_name"`
+	Internal        bool    `json:"internal"`
+}
+
+type organizationJSON struct {
+	GUID string `json:"guid"`
+	Name string `json:"name"`
+}
+
+type routerGroupJSON struct {
+	GUID string `json:"guid"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type domainDetailsJSON struct {
+	GUID                   string             `json:"guid"`
+	Name                   string             `json:"name"`
+	Status                 string             `json:"status"`
+	Internal               bool               `json:"internal"`
+	OwningOrganizationGUID *string            `json:"owning_organization_guid"`
+	SharedOrganizations    []organizationJSON `json:"shared_organizations"`
+	RouterGroup            *routerGroupJSON   `json:"router_group"`
+}
+
 type ListDomains struct {
 	ui             terminal.UI
 	config         coreconfig.Reader
 	domainRepo     api.DomainRepository
 	routingAPIRepo api.RoutingAPIRepository
+	domainFetcher  *actors.DomainDetailsFetcher
 }
 
 func init() {
@@ -25,12 +60,17 @@ func init() {
 }
 
 func (cmd *ListDomains) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Output the domains as JSON")}
+	fs["output"] = &flags.StringFlag{Name: "output", Usage: T("Output format; only 'json' is supported. Includes shared organizations and router groups, sorted by name")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "domains",
 		Description: T("List domains in the target org"),
 		Usage: []string{
-			"CF_NAME domains",
+			"CF_NAME domains [--json | --output json]",
 		},
+		Flags: fs,
 	}
 }
 
@@ -56,6 +96,7 @@ func (cmd *ListDomains) SetDependency(deps commandregistry.Dependency, pluginCal
 	cmd.config = deps.Config
 	cmd.domainRepo = deps.RepoLocator.GetDomainRepository()
 	cmd.routingAPIRepo = deps.RepoLocator.GetRoutingAPIRepository()
+	cmd.domainFetcher = actors.NewDomainDetailsFetcher(cmd.domainRepo, cmd.routingAPIRepo)
 
 	return cmd
 }
@@ -63,27 +104,38 @@ func (cmd *ListDomains) SetDependency(deps commandregistry.Dependency, pluginCal
 func (cmd *ListDomains) Execute(c flags.FlagContext) error {
 	org := cmd.config.OrganizationFields()
 
+	if output := c.String("output"); output != "" {
+		if !strings.EqualFold(output, "json") {
+			return errors.New(T("OUTPUT_FORMAT must be \"json\""))
+		}
+		return cmd.listDomainDetailsJSON(org.GUID)
+	}
+
+	if c.Bool("json") {
+		return cmd.listDomainsJSON(org.GUID)
+	}
+
 	cmd.ui.Say(T("Getting domains in org {{.OrgName}} as {{.Username}}...",
 		map[string]interface{}{
 			"OrgName":  terminal.EntityNameColor(org.Name),
 			"Username": terminal.EntityNameColor(cmd.config.Username())}))
 
-	domains, err := cmd.getDomains(org.GUID)
+	domains, routerGroups, err := cmd.getDomainsWithRouterGroups(org.GUID)
 	if err != nil {
 		return errors.New(T("Failed fetching domains.\n{{.Error}}", map[string]interface{}{"Error": err.Error()}))
 	}
 
-	table := cmd.ui.Table([]string{T("name"), T("status"), T("type")})
+	table := cmd.ui.Table([]string{T("name"), T("status"), T("type"), T("router group"), T("internal")})
 
 	for _, domain := range domains {
 		if domain.Shared {
-			table.Add(domain.Name, T("shared"), domain.RouterGroupType)
+			table.Add(domain.Name, T("shared"), domain.RouterGroupType, routerGroups[domain.RouterGroupGUID].Name, strconv.FormatBool(domain.Internal))
 		}
 	}
 
 	for _, domain := range domains {
 		if !domain.Shared {
-			table.Add(domain.Name, T("owned"), domain.RouterGroupType)
+			table.Add(domain.Name, T("owned"), domain.RouterGroupType, routerGroups[domain.RouterGroupGUID].Name, strconv.FormatBool(domain.Internal))
 		}
 	}
 
@@ -98,6 +150,90 @@ func (cmd *ListDomains) Execute(c flags.FlagContext) error {
 	return nil
 }
 
+func (cmd *ListDomains) listDomainsJSON(orgGUID string) error {
+	domains, routerGroups, err := cmd.getDomainsWithRouterGroups(orgGUID)
+	if err != nil {
+		return errors.New(T("Failed fetching domains.\n{{.Error}}", map[string]interface{}{"Error": err.Error()}))
+	}
+
+	domainsJSON := make([]domainJSON, 0, len(domains))
+	for _, domain := range domains {
+		status := "owned"
+		if domain.Shared {
+			status = "shared"
+		}
+
+		var routerGroupName *string
+		if routerGroup, ok := routerGroups[domain.RouterGroupGUID]; ok {
+			routerGroupName = &routerGroup.Name
+		}
+
+		domainsJSON = append(domainsJSON, domainJSON{
+			GUID:            domain.GUID,
+			Name:            domain.Name,
+			Status:          status,
+			RouterGroupType: domain.RouterGroupType,
+			RouterGroupName: routerGroupName,
+			Internal:        domain.Internal,
+		})
+	}
+
+	jsonBytes, err := json.MarshalIndent(domainsJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say(string(jsonBytes))
+	return nil
+}
+
+// listDomainDetailsJSON prints the domains sorted by name, so that the
+// output of consecutive runs can be diffed.
+func (cmd *ListDomains) listDomainDetailsJSON(orgGUID string) error {
+	domains, err := cmd.domainFetcher.ListDomainsForOrg(orgGUID)
+	if err != nil {
+		return errors.New(T("Failed fetching domains.\n{{.Error}}", map[string]interface{}{"Error": err.Error()}))
+	}
+
+	domainsJSON := make([]domainDetailsJSON, 0, len(domains))
+	for _, domain := range domains {
+		domainJSON := domainDetailsJSON{
+			GUID:     domain.GUID,
+			Name:     domain.Name,
+			Status:   "shared",
+			Internal: domain.Internal,
+		}
+
+		if !domain.Shared {
+			owningOrgGUID := domain.OwningOrganizationGUID
+			domainJSON.Status = "owned"
+			domainJSON.OwningOrganizationGUID = &owningOrgGUID
+			domainJSON.SharedOrganizations = make([]organizationJSON, 0, len(domain.SharedOrganizations))
+			for _, org := range domain.SharedOrganizations {
+				domainJSON.SharedOrganizations = append(domainJSON.SharedOrganizations, organizationJSON{GUID: org.GUID, Name: org.Name})
+			}
+		}
+
+		if domain.RouterGroup != nil {
+			domainJSON.RouterGroup = &routerGroupJSON{
+				GUID: domain.RouterGroup.GUID,
+				Name: domain.RouterGroup.Name,
+				Type: domain.RouterGroup.Type,
+			}
+		}
+
+		domainsJSON = append(domainsJSON, domainJSON)
+	}
+
+	jsonBytes, err := json.MarshalIndent(map[string]interface{}{"domains": domainsJSON}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say(string(jsonBytes))
+	return nil
+}
+
 func (cmd *ListDomains) getDomains(orgGUID string) ([]models.DomainFields, error) {
 	domains := []models.DomainFields{}
 	err := cmd.domainRepo.ListDomainsForOrg(orgGUID, func(domain models.DomainFields) bool {
@@ -111,3 +247,19 @@ func (cmd *ListDomains) getDomains(orgGUID string) ([]models.DomainFields, error
 
 	return domains, nil
 }
+
+// getDomainsWithRouterGroups returns the domains of the org together with
+// the router groups of its TCP domains, keyed by GUID.
+func (cmd *ListDomains) getDomainsWithRouterGroups(orgGUID string) ([]models.DomainFields, map[string]models.RouterGroup, error) {
+	domains, err := cmd.getDomains(orgGUID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	routerGroups, err := cmd.domainFetcher.RouterGroupsForDomains(domains)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return domains, routerGroups, nil
+}
@@ -1,7 +1,9 @@
 package domain_test
 
 import (
+	"encoding/json"
 	"errors"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -196,7 +198,7 @@ var _ = Describe("ListDomains", func() {
 					{Shared: false, Name: "Private-domain1"},
 					{Shared: false, Name: "Private-domain2", RouterGroupType: "tcp"},
 					{Shared: true, Name: "Shared-domain1"},
-					{Shared: true, Name: "Shared-domain2", RouterGroupType: "foobar"},
+					{Shared: true, Name: "Shared-domain2", RouterGroupGUID: "router-group-guid", RouterGroupType: "foobar"},
 				}
 			})
 
@@ -214,13 +216,119 @@ var _ = Describe("ListDomains", func() {
 			It("prints the domain information", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(ui.Outputs()).To(BeInDisplayOrder(
-					[]string{"name", "status", "type"},
-					[]string{"Shared-domain1", "shared"},
-					[]string{"Shared-domain2", "shared", "foobar"},
-					[]string{"Private-domain1", "owned"},
-					[]string{"Private-domain2", "owned", "tcp"},
+					[]string{"name", "status", "type", "router group", "internal"},
+					[]string{"Shared-domain1", "shared", "false"},
+					[]string{"Shared-domain2", "shared", "foobar", "my-router-name1", "false"},
+					[]string{"Private-domain1", "owned", "false"},
+					[]string{"Private-domain2", "owned", "tcp", "false"},
 				))
 			})
+
+			Context("when --output json is provided", func() {
+				BeforeEach(func() {
+					domainFields = []models.DomainFields{
+						{GUID: "tcp-guid", Shared: true, Name: "tcp.example.com", RouterGroupGUID: "router-group-guid", RouterGroupType: "tcp"},
+						{GUID: "private-guid", Shared: false, Name: "private.example.com", OwningOrganizationGUID: "my-org-guid"},
+						{GUID: "internal-guid", Shared: true, Name: "apps.internal", Internal: true},
+					}
+					domainRepo.ListSharedOrganizationsStub = func(domainGUID string, cb func(models.OrganizationFields) bool) error {
+						cb(models.OrganizationFields{GUID: "other-org-guid", Name: "other-org"})
+						return nil
+					}
+					Expect(flagContext.Parse("--output", "json")).To(Succeed())
+				})
+
+				It("prints the domains with their details as JSON sorted by name", func() {
+					Expect(err).NotTo(HaveOccurred())
+
+					var output map[string][]map[string]interface{}
+					Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &output)).To(Succeed())
+					Expect(output["domains"]).To(Equal([]map[string]interface{}{
+						{
+							"guid":                     "internal-guid",
+							"name":                     "apps.internal",
+							"status":                   "shared",
+							"internal":                 true,
+							"owning_organization_guid": nil,
+							"shared_organizations":     nil,
+							"router_group":             nil,
+						},
+						{
+							"guid":                     "private-guid",
+							"name":                     "private.example.com",
+							"status":                   "owned",
+							"internal":                 false,
+							"owning_organization_guid": "my-org-guid",
+							"shared_organizations": []interface{}{
+								map[string]interface{}{"guid": "other-org-guid", "name": "other-org"},
+							},
+							"router_group": nil,
+						},
+						{
+							"guid":                     "tcp-guid",
+							"name":                     "tcp.example.com",
+							"status":                   "shared",
+							"internal":                 false,
+							"owning_organization_guid": nil,
+							"shared_organizations":     nil,
+							"router_group": map[string]interface{}{
+								"guid": "router-group-guid",
+								"name": "my-router-name1",
+								"type": "tcp",
+							},
+						},
+					}))
+				})
+
+				Context("when listing the domain details fails", func() {
+					BeforeEach(func() {
+						routingAPIRepo.ListRouterGroupsStub = nil
+						routingAPIRepo.ListRouterGroupsReturns(errors.New("router-groups-err"))
+					})
+
+					It("fails with message", func() {
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("Failed fetching domains."))
+						Expect(err.Error()).To(ContainSubstring("router-groups-err"))
+					})
+				})
+			})
+
+			Context("when an unsupported output format is provided", func() {
+				BeforeEach(func() {
+					Expect(flagContext.Parse("--output", "yaml")).To(Succeed())
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(MatchError(`OUTPUT_FORMAT must be "json"`))
+				})
+			})
+
+			Context("when --json is provided", func() {
+				BeforeEach(func() {
+					domainFields = append(domainFields, models.DomainFields{GUID: "internal-guid", Shared: true, Name: "apps.internal", Internal: true})
+					Expect(flagContext.Parse("--json")).To(Succeed())
+				})
+
+				It("prints the domains as JSON", func() {
+					Expect(err).NotTo(HaveOccurred())
+
+					var domains []map[string]interface{}
+					Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &domains)).To(Succeed())
+					Expect(domains).To(HaveLen(5))
+					Expect(domains[1]).To(Equal(map[string]interface{}{
+						"guid":              "",
+						"name":              "Private-domain2",
+						"status":            "owned",
+						"router_group_type": "tcp",
+						"router_group_name": nil,
+						"internal":          false,
+					}))
+					Expect(domains[3]).To(HaveKeyWithValue("router_group_name", "my-router-name1"))
+					Expect(domains[4]).To(HaveKeyWithValue("status", "shared"))
+					Expect(domains[4]).To(HaveKeyWithValue("internal", true))
+				})
+			})
 		})
 	})
 })
@@ -59,7 +59,12 @@ func (cmd *DisableFeatureFlag) Execute(c flags.FlagContext) error {
 		"FeatureFlag": terminal.EntityNameColor(flag),
 		"Username":    terminal.EntityNameColor(cmd.config.Username())}))
 
-	err := cmd.flagRepo.Update(flag, false)
+	err := checkFeatureFlagExists(cmd.flagRepo, flag)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.flagRepo.Update(flag, false)
 	if err != nil {
 		return err
 	}
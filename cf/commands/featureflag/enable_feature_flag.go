@@ -1,7 +1,9 @@
 package featureflag
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf/api/featureflags"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -10,6 +12,7 @@ import (
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util/spellcheck"
 )
 
 type EnableFeatureFlag struct {
@@ -59,7 +62,12 @@ func (cmd *EnableFeatureFlag) Execute(c flags.FlagContext) error {
 		"FeatureFlag": terminal.EntityNameColor(flag),
 		"Username":    terminal.EntityNameColor(cmd.config.Username())}))
 
-	err := cmd.flagRepo.Update(flag, true)
+	err := checkFeatureFlagExists(cmd.flagRepo, flag)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.flagRepo.Update(flag, true)
 	if err != nil {
 		return err
 	}
@@ -71,3 +79,30 @@ func (cmd *EnableFeatureFlag) Execute(c flags.FlagContext) error {
 		"FeatureFlag": terminal.EntityNameColor(flag)}))
 	return nil
 }
+
+// checkFeatureFlagExists returns an error suggesting the closest known flags
+// when the Cloud Controller does not have a flag with the given name.
+func checkFeatureFlagExists(flagRepo featureflags.FeatureFlagRepository, name string) error {
+	flags, err := flagRepo.List()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(flags))
+	for _, flag := range flags {
+		if flag.Name == name {
+			return nil
+		}
+		names = append(names, flag.Name)
+	}
+
+	message := T("Feature flag {{.FeatureFlag}} does not exist.", map[string]interface{}{
+		"FeatureFlag": name,
+	})
+	if suggestions := spellcheck.NewCommandSuggester(names).Recommend(name); len(suggestions) > 0 {
+		message += " " + T("Did you mean {{.Suggestions}}?", map[string]interface{}{
+			"Suggestions": strings.Join(suggestions, ", "),
+		})
+	}
+	return errors.New(message)
+}
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/api/featureflags/featureflagsfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
 	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
@@ -60,6 +61,10 @@ var _ = Describe("enable-feature-flag command", func() {
 
 	Describe("when logged in", func() {
 		BeforeEach(func() {
+			flagRepo.ListReturns([]models.FeatureFlag{
+				{Name: "user_org_creation"},
+				{Name: "task_creation"},
+			}, nil)
 			flagRepo.UpdateReturns(nil)
 		})
 
@@ -83,12 +88,52 @@ var _ = Describe("enable-feature-flag command", func() {
 			})
 
 			It("fails with an error", func() {
-				runCommand("i-dont-exist")
+				runCommand("user_org_creation")
 				Expect(ui.Outputs()).To(ContainSubstrings(
 					[]string{"FAILED"},
 					[]string{"An error occurred."},
 				))
 			})
 		})
+
+		Context("when the flag does not exist", func() {
+			It("fails and suggests similar flags", func() {
+				runCommand("tsak_creation")
+
+				Expect(flagRepo.UpdateCallCount()).To(Equal(0))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Feature flag tsak_creation does not exist. Did you mean task_creation?"},
+				))
+			})
+
+			It("fails without a suggestion when no flag is similar", func() {
+				runCommand("something_else")
+
+				Expect(flagRepo.UpdateCallCount()).To(Equal(0))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Feature flag something_else does not exist."},
+				))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings(
+					[]string{"Did you mean"},
+				))
+			})
+		})
+
+		Context("when listing the flags fails", func() {
+			BeforeEach(func() {
+				flagRepo.ListReturns(nil, errors.New("list error"))
+			})
+
+			It("fails with an error", func() {
+				runCommand("user_org_creation")
+
+				Expect(flagRepo.UpdateCallCount()).To(Equal(0))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"list error"},
+				))
+			})
+		})
 	})
 })
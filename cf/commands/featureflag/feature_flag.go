@@ -1,6 +1,7 @@
 package featureflag
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"code.cloudfoundry.org/cli/cf/api/featureflags"
@@ -23,12 +24,16 @@ func init() {
 }
 
 func (cmd *ShowFeatureFlag) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Output the feature flag as JSON")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "feature-flag",
 		Description: T("Retrieve an individual feature flag with status"),
 		Usage: []string{
-			T("CF_NAME feature-flag FEATURE_NAME"),
+			T("CF_NAME feature-flag FEATURE_NAME [--json]"),
 		},
+		Flags: fs,
 	}
 }
 
@@ -55,6 +60,21 @@ func (cmd *ShowFeatureFlag) SetDependency(deps commandregistry.Dependency, plugi
 func (cmd *ShowFeatureFlag) Execute(c flags.FlagContext) error {
 	flagName := c.Args()[0]
 
+	if c.Bool("json") {
+		flag, err := cmd.flagRepo.FindByName(flagName)
+		if err != nil {
+			return err
+		}
+
+		jsonBytes, err := json.MarshalIndent(newFeatureFlagJSON(flag), "", "  ")
+		if err != nil {
+			return err
+		}
+
+		cmd.ui.Say(string(jsonBytes))
+		return nil
+	}
+
 	cmd.ui.Say(T("Retrieving status of {{.FeatureFlag}} as {{.Username}}...", map[string]interface{}{
 		"FeatureFlag": terminal.EntityNameColor(flagName),
 		"Username":    terminal.EntityNameColor(cmd.config.Username())}))
@@ -1,7 +1,9 @@
 package featureflag_test
 
 import (
+	"encoding/json"
 	"errors"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf/api/featureflags/featureflagsfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -75,6 +77,22 @@ var _ = Describe("feature-flag command", func() {
 			))
 		})
 
+		Context("when --json is provided", func() {
+			It("prints the feature flag as JSON", func() {
+				runCommand("route_creation", "--json")
+
+				Expect(flagRepo.FindByNameArgsForCall(0)).To(Equal("route_creation"))
+
+				var flag map[string]interface{}
+				Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &flag)).To(Succeed())
+				Expect(flag).To(Equal(map[string]interface{}{
+					"name":          "route_creation",
+					"enabled":       false,
+					"error_message": nil,
+				}))
+			})
+		})
+
 		Context("when an error occurs", func() {
 			BeforeEach(func() {
 				flagRepo.FindByNameReturns(models.FeatureFlag{}, errors.New("An error occurred."))
@@ -1,15 +1,36 @@
 package featureflag
 
 import (
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/cf/api/featureflags"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+type featureFlagJSON struct {
+	Name         string  `json:"name"`
+	Enabled      bool    `json:"enabled"`
+	ErrorMessage *string `json:"error_message"`
+}
+
+func newFeatureFlagJSON(flag models.FeatureFlag) featureFlagJSON {
+	flagJSON := featureFlagJSON{
+		Name:    flag.Name,
+		Enabled: flag.Enabled,
+	}
+	if flag.ErrorMessage != "" {
+		errorMessage := flag.ErrorMessage
+		flagJSON.ErrorMessage = &errorMessage
+	}
+	return flagJSON
+}
+
 type ListFeatureFlags struct {
 	ui       terminal.UI
 	config   coreconfig.ReadWriter
@@ -21,12 +42,16 @@ func init() {
 }
 
 func (cmd *ListFeatureFlags) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Output the feature flags as JSON")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "feature-flags",
 		Description: T("Retrieve list of feature flags with status of each flag-able feature"),
 		Usage: []string{
-			T("CF_NAME feature-flags"),
+			T("CF_NAME feature-flags [--json]"),
 		},
+		Flags: fs,
 	}
 }
 
@@ -54,6 +79,10 @@ func (cmd *ListFeatureFlags) SetDependency(deps commandregistry.Dependency, plug
 }
 
 func (cmd *ListFeatureFlags) Execute(c flags.FlagContext) error {
+	if c.Bool("json") {
+		return cmd.listFeatureFlagsJSON()
+	}
+
 	cmd.ui.Say(T("Retrieving status of all flagged features as {{.Username}}...", map[string]interface{}{
 		"Username": terminal.EntityNameColor(cmd.config.Username())}))
 
@@ -81,6 +110,26 @@ func (cmd *ListFeatureFlags) Execute(c flags.FlagContext) error {
 	return nil
 }
 
+func (cmd *ListFeatureFlags) listFeatureFlagsJSON() error {
+	flags, err := cmd.flagRepo.List()
+	if err != nil {
+		return err
+	}
+
+	flagsJSON := make([]featureFlagJSON, 0, len(flags))
+	for _, flag := range flags {
+		flagsJSON = append(flagsJSON, newFeatureFlagJSON(flag))
+	}
+
+	jsonBytes, err := json.MarshalIndent(flagsJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say(string(jsonBytes))
+	return nil
+}
+
 func (cmd ListFeatureFlags) flagBoolToString(enabled bool) string {
 	if enabled {
 		return "enabled"
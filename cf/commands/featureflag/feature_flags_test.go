@@ -1,7 +1,9 @@
 package featureflag_test
 
 import (
+	"encoding/json"
 	"errors"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf/api/featureflags/featureflagsfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -118,6 +120,28 @@ var _ = Describe("feature-flags command", func() {
 			))
 		})
 
+		Context("when --json is provided", func() {
+			It("prints the feature flags as JSON", func() {
+				runCommand("--json")
+
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"Retrieving status"}))
+
+				var flags []map[string]interface{}
+				Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &flags)).To(Succeed())
+				Expect(flags).To(HaveLen(5))
+				Expect(flags[0]).To(Equal(map[string]interface{}{
+					"name":          "user_org_creation",
+					"enabled":       true,
+					"error_message": "error",
+				}))
+				Expect(flags[1]).To(Equal(map[string]interface{}{
+					"name":          "private_domain_creation",
+					"enabled":       false,
+					"error_message": nil,
+				}))
+			})
+		})
+
 		Context("when an error occurs", func() {
 			BeforeEach(func() {
 				flagRepo.ListReturns(nil, errors.New("An error occurred."))
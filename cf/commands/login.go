@@ -1,9 +1,12 @@
 package commands
 
 import (
+	"encoding/json"
 	"errors"
+	"sort"
 	"strconv"
 
+	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
@@ -41,15 +44,17 @@ func (cmd *Login) MetaData() commandregistry.CommandMetadata {
 	fs["o"] = &flags.StringFlag{ShortName: "o", Usage: T("Org")}
 	fs["s"] = &flags.StringFlag{ShortName: "s", Usage: T("Space")}
 	fs["sso"] = &flags.BoolFlag{Name: "sso", Usage: T("Prompt for a one-time passcode to login")}
-	fs["sso-passcode"] = &flags.StringFlag{Name: "sso-passcode", Usage: T("One-time passcode")}
+	fs["sso-passcode"] = &flags.StringFlag{Name: "sso-passcode", Usage: T("One-time passcode; use '-' to read it from stdin")}
+	fs["origin"] = &flags.StringFlag{Name: "origin", Usage: T("Origin of the identity provider to log in with, when UAA has more than one (e.g. ldap)")}
 	fs["skip-ssl-validation"] = &flags.BoolFlag{Name: "skip-ssl-validation", Usage: T("Skip verification of the API endpoint. Not recommended!")}
+	fs["client-credentials"] = &flags.BoolFlag{Name: "client-credentials", Hidden: true}
 
 	return commandregistry.CommandMetadata{
 		Name:        "login",
 		ShortName:   "l",
 		Description: T("Log user in"),
 		Usage: []string{
-			T("CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n\n"),
+			T("CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE] [--origin ORIGIN]\n\n"),
 			terminal.WarningColor(T("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history")),
 		},
 		Examples: []string{
@@ -58,6 +63,8 @@ func (cmd *Login) MetaData() commandregistry.CommandMetadata {
 			T("CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)"),
 			T("CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)"),
 			T("CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)"),
+			T("CF_NAME login -a https://api.example.com --sso-passcode PASSCODE -o ORG -s SPACE (log in without any prompts)"),
+			T("CF_NAME login -u name@example.com -p pa55woRD --origin ldap (log in with the ldap identity provider)"),
 		},
 		Flags: fs,
 	}
@@ -79,6 +86,11 @@ func (cmd *Login) SetDependency(deps commandregistry.Dependency, pluginCall bool
 }
 
 func (cmd *Login) Execute(c flags.FlagContext) error {
+	if c.Bool("client-credentials") {
+		return errors.New(T("Service account currently cannot be logged in with '{{.CFName}} login'. Use '{{.CFName}} auth CLIENT_ID CLIENT_SECRET --client-credentials' instead.",
+			map[string]interface{}{"CFName": cf.Name}))
+	}
+
 	cmd.config.ClearSession()
 
 	endpoint, skipSSL := cmd.decideEndpoint(c)
@@ -161,16 +173,35 @@ func (cmd Login) authenticateSSO(c flags.FlagContext) error {
 		return err
 	}
 
-	credentials := make(map[string]string)
+	credentials, err := loginCredentials(c)
+	if err != nil {
+		return err
+	}
 	passcode := prompts["passcode"]
 
-	for i := 0; i < maxLoginTries; i++ {
-		if c.IsSet("sso-passcode") && i == 0 {
-			credentials["passcode"] = c.String("sso-passcode")
-		} else {
-			credentials["passcode"] = cmd.ui.AskForPassword(passcode.DisplayName)
+	// A passcode given on the command line is tried once, without falling
+	// back to prompting, so scripted logins fail instead of hanging.
+	if c.IsSet("sso-passcode") {
+		credentials["passcode"] = c.String("sso-passcode")
+		if credentials["passcode"] == "-" {
+			credentials["passcode"] = cmd.ui.Ask(passcode.DisplayName)
+		}
+
+		cmd.ui.Say(T("Authenticating..."))
+		err = cmd.authenticator.Authenticate(credentials)
+		if err != nil {
+			cmd.ui.Say(err.Error())
+			return errors.New(T("Unable to authenticate."))
 		}
 
+		cmd.ui.Ok()
+		cmd.ui.Say("")
+		return nil
+	}
+
+	for i := 0; i < maxLoginTries; i++ {
+		credentials["passcode"] = cmd.ui.AskForPassword(passcode.DisplayName)
+
 		cmd.ui.Say(T("Authenticating..."))
 		err = cmd.authenticator.Authenticate(credentials)
 
@@ -198,7 +229,15 @@ func (cmd Login) authenticate(c flags.FlagContext) error {
 		return err
 	}
 	passwordKeys := []string{}
-	credentials := make(map[string]string)
+	credentials, err := loginCredentials(c)
+	if err != nil {
+		return err
+	}
+
+	err = checkPromptTypes(prompts)
+	if err != nil {
+		return err
+	}
 
 	if value, ok := prompts["username"]; ok {
 		if prompts["username"].Type == coreconfig.AuthPromptTypeText && usernameFlagValue != "" {
@@ -208,7 +247,8 @@ func (cmd Login) authenticate(c flags.FlagContext) error {
 		}
 	}
 
-	for key, prompt := range prompts {
+	for _, key := range promptKeys(prompts) {
+		prompt := prompts[key]
 		if prompt.Type == coreconfig.AuthPromptTypePassword {
 			if key == "passcode" {
 				continue
@@ -255,6 +295,69 @@ func (cmd Login) authenticate(c flags.FlagContext) error {
 	return nil
 }
 
+// checkPromptTypes returns an error when UAA advertises a prompt, such as an
+// MFA code, of a type the CLI cannot render.
+func checkPromptTypes(prompts map[string]coreconfig.AuthPrompt) error {
+	for _, key := range promptKeys(prompts) {
+		switch prompts[key].Type {
+		case coreconfig.AuthPromptTypeText, coreconfig.AuthPromptTypePassword:
+		default:
+			return errors.New(T("Your UAA requires an unsupported prompt '{{.Name}}' of type '{{.Type}}'. Use '{{.CFName}} login --sso' if your UAA supports it.",
+				map[string]interface{}{
+					"Name":   key,
+					"Type":   prompts[key].Type,
+					"CFName": cf.Name,
+				}))
+		}
+	}
+	return nil
+}
+
+// promptKeys returns the names of the prompts in the order they are asked:
+// the username and password first, then any others UAA advertises (such as
+// an MFA code) by name.
+func promptKeys(prompts map[string]coreconfig.AuthPrompt) []string {
+	keys := make([]string, 0, len(prompts))
+	for key := range prompts {
+		keys = append(keys, key)
+	}
+
+	rank := func(key string) int {
+		switch key {
+		case "username":
+			return 0
+		case "password":
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if rank(keys[i]) != rank(keys[j]) {
+			return rank(keys[i]) < rank(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+// loginCredentials returns the credentials every login attempt starts with.
+// The origin is passed to UAA as a login hint to pick the identity provider.
+func loginCredentials(c flags.FlagContext) (map[string]string, error) {
+	credentials := make(map[string]string)
+	if c.String("origin") == "" {
+		return credentials, nil
+	}
+
+	loginHint, err := json.Marshal(map[string]string{"origin": c.String("origin")})
+	if err != nil {
+		return nil, err
+	}
+	credentials["login_hint"] = string(loginHint)
+
+	return credentials, nil
+}
+
 func (cmd Login) setOrganization(c flags.FlagContext) (bool, error) {
 	orgName := c.String("o")
 
@@ -1,8 +1,10 @@
 package commands_test
 
 import (
+	"fmt"
 	"strconv"
 
+	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/api/authentication/authenticationfakes"
 	"code.cloudfoundry.org/cli/cf/api/organizations/organizationsfakes"
 	"code.cloudfoundry.org/cli/cf/api/spaces/spacesfakes"
@@ -457,6 +459,89 @@ var _ = Describe("Login Command", func() {
 						"passcode": "the-one-time-code",
 					}))
 				})
+
+				Context("when the passcode is '-'", func() {
+					It("reads the passcode from stdin", func() {
+						Flags = []string{"--sso-passcode", "-", "-a", "api.example.com"}
+						ui.Inputs = []string{"the-piped-code"}
+
+						testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+						Expect(ui.PasswordPrompts).To(BeEmpty())
+						Expect(authRepo.AuthenticateCallCount()).To(Equal(1))
+						Expect(authRepo.AuthenticateArgsForCall(0)).To(Equal(map[string]string{
+							"passcode": "the-piped-code",
+						}))
+					})
+				})
+
+				Context("when the passcode is rejected", func() {
+					BeforeEach(func() {
+						authRepo.AuthenticateReturns(errors.New("Passcode was rejected: Invalid passcode"))
+					})
+
+					It("shows the error and fails without prompting", func() {
+						Flags = []string{"--sso-passcode", "the-one-time-code", "-a", "api.example.com"}
+
+						execution := testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+						Expect(execution).To(BeFalse())
+
+						Expect(ui.PasswordPrompts).To(BeEmpty())
+						Expect(authRepo.AuthenticateCallCount()).To(Equal(1))
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"Passcode was rejected: Invalid passcode"},
+							[]string{"FAILED"},
+							[]string{"Unable to authenticate."},
+						))
+					})
+				})
+
+				Context("when the --origin flag is provided", func() {
+					It("asks UAA to use that identity provider", func() {
+						Flags = []string{"--sso-passcode", "the-one-time-code", "--origin", "some-origin", "-a", "api.example.com"}
+
+						testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+						Expect(authRepo.AuthenticateCallCount()).To(Equal(1))
+						Expect(authRepo.AuthenticateArgsForCall(0)).To(Equal(map[string]string{
+							"passcode":   "the-one-time-code",
+							"login_hint": `{"origin":"some-origin"}`,
+						}))
+					})
+				})
+			})
+
+			Context("when the user provides the --origin flag with a username and password", func() {
+				It("asks UAA to use that identity provider", func() {
+					Flags = []string{"-u", "the-username", "-p", "the-password", "--origin", "ldap", "-a", "api.example.com"}
+					ui.Inputs = []string{"the-account-number"}
+
+					testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+					Expect(authRepo.AuthenticateCallCount()).To(Equal(1))
+					Expect(authRepo.AuthenticateArgsForCall(0)).To(Equal(map[string]string{
+						"account_number": "the-account-number",
+						"username":       "the-username",
+						"password":       "the-password",
+						"login_hint":     `{"origin":"ldap"}`,
+					}))
+				})
+			})
+
+			Context("when the user provides the --client-credentials flag", func() {
+				It("points the user to cf auth and keeps the current session", func() {
+					Flags = []string{"--client-credentials", "-u", "some-client", "-p", "some-secret", "-a", "api.example.com"}
+					Config.SetAccessToken("some-access-token")
+
+					execution := testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+					Expect(execution).To(BeFalse())
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{fmt.Sprintf("Service account currently cannot be logged in with '%s login'. Use '%s auth CLIENT_ID CLIENT_SECRET --client-credentials' instead.", cf.Name, cf.Name)},
+					))
+					Expect(authRepo.AuthenticateCallCount()).To(Equal(0))
+					Expect(Config.AccessToken()).ToNot(BeEmpty())
+				})
 			})
 
 			Context("when the user does provides both the --sso and --sso-passcode flags", func() {
@@ -543,6 +628,67 @@ var _ = Describe("Login Command", func() {
 				Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}))
 			})
 		})
+
+		Describe("MFA prompts", func() {
+			BeforeEach(func() {
+				authRepo.GetLoginPromptsAndSaveUAAServerURLReturns(map[string]coreconfig.AuthPrompt{
+					"username": {
+						DisplayName: "Email",
+						Type:        coreconfig.AuthPromptTypeText,
+					},
+					"password": {
+						DisplayName: "Password",
+						Type:        coreconfig.AuthPromptTypePassword,
+					},
+					"mfaCode": {
+						DisplayName: "MFA Code ( Register at https://uaa.example.com )",
+						Type:        coreconfig.AuthPromptTypePassword,
+					},
+				}, nil)
+			})
+
+			It("asks for the MFA code after the password and sends it with the credentials", func() {
+				Flags = []string{"-a", "api.example.com", "-u", "the-username"}
+				ui.Inputs = []string{"the-password", "123456"}
+
+				testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+
+				Expect(ui.PasswordPrompts).To(Equal([]string{"Password", "MFA Code ( Register at https://uaa.example.com )"}))
+				Expect(authRepo.AuthenticateCallCount()).To(Equal(1))
+				Expect(authRepo.AuthenticateArgsForCall(0)).To(Equal(map[string]string{
+					"username": "the-username",
+					"password": "the-password",
+					"mfaCode":  "123456",
+				}))
+			})
+
+			Context("when UAA advertises a prompt type the CLI does not support", func() {
+				BeforeEach(func() {
+					authRepo.GetLoginPromptsAndSaveUAAServerURLReturns(map[string]coreconfig.AuthPrompt{
+						"username": {
+							DisplayName: "Email",
+							Type:        coreconfig.AuthPromptTypeText,
+						},
+						"token": {
+							DisplayName: "Hardware token",
+							Type:        coreconfig.AuthPromptType("hardware"),
+						},
+					}, nil)
+				})
+
+				It("fails with a clear error and does not try to authenticate", func() {
+					Flags = []string{"-a", "api.example.com", "-u", "the-username", "-p", "the-password"}
+
+					execution := testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false, ui)
+					Expect(execution).To(BeFalse())
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Your UAA requires an unsupported prompt 'token' of type 'hardware'."},
+					))
+					Expect(authRepo.AuthenticateCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 
 	Describe("updates to the config", func() {
@@ -2,8 +2,10 @@ package organization
 
 import (
 	"fmt"
+	"net/http"
 
 	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/featureflags"
 	"code.cloudfoundry.org/cli/cf/api/organizations"
 	"code.cloudfoundry.org/cli/cf/api/quotas"
@@ -25,6 +27,25 @@ type CreateOrg struct {
 	quotaRepo     quotas.QuotaRepository
 	orgRoleSetter user.OrgRoleSetter
 	flagRepo      featureflags.FeatureFlagRepository
+	domainRepo    api.DomainRepository
+	userRepo      api.UserRepository
+}
+
+// clonedOrgRoles are the org-level roles that --clone-from replicates, in
+// the order they are replicated.
+var clonedOrgRoles = []struct {
+	role models.Role
+	name string
+}{
+	{models.RoleOrgManager, "OrgManager"},
+	{models.RoleBillingManager, "BillingManager"},
+	{models.RoleOrgAuditor, "OrgAuditor"},
+}
+
+// cloneResult is the outcome of copying a single item from the source org.
+type cloneResult struct {
+	item    string
+	skipped bool
 }
 
 func init() {
@@ -34,13 +55,14 @@ func init() {
 func (cmd *CreateOrg) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["q"] = &flags.StringFlag{ShortName: "q", Usage: T("Quota to assign to the newly created org (excluding this option results in assignment of default quota)")}
+	fs["clone-from"] = &flags.StringFlag{Name: "clone-from", Usage: T("Copy the quota, private domains and org roles of an existing org (spaces are not copied)")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "create-org",
 		ShortName:   "co",
 		Description: T("Create an org"),
 		Usage: []string{
-			T("CF_NAME create-org ORG"),
+			T("CF_NAME create-org ORG [-q QUOTA | --clone-from SOURCE_ORG]"),
 		},
 		Flags: fs,
 	}
@@ -52,6 +74,11 @@ func (cmd *CreateOrg) Requirements(requirementsFactory requirements.Factory, fc
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
+	if fc.IsSet("q") && fc.IsSet("clone-from") {
+		cmd.ui.Failed(T("Incorrect Usage: The following arguments cannot be used together: {{.Args}}", map[string]interface{}{"Args": "-q, --clone-from"}) + "\n\n" + commandregistry.Commands.CommandUsage("create-org"))
+		return nil, fmt.Errorf("Incorrect usage: -q and --clone-from cannot be used together")
+	}
+
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewLoginRequirement(),
 	}
@@ -65,6 +92,8 @@ func (cmd *CreateOrg) SetDependency(deps commandregistry.Dependency, pluginCall
 	cmd.orgRepo = deps.RepoLocator.GetOrganizationRepository()
 	cmd.quotaRepo = deps.RepoLocator.GetQuotaRepository()
 	cmd.flagRepo = deps.RepoLocator.GetFeatureFlagRepository()
+	cmd.domainRepo = deps.RepoLocator.GetDomainRepository()
+	cmd.userRepo = deps.RepoLocator.GetUserRepository()
 
 	//get command from registry for dependency
 	commandDep := commandregistry.Commands.FindCommand("set-org-role")
@@ -76,6 +105,17 @@ func (cmd *CreateOrg) SetDependency(deps commandregistry.Dependency, pluginCall
 
 func (cmd *CreateOrg) Execute(c flags.FlagContext) error {
 	name := c.Args()[0]
+
+	var sourceOrg models.Organization
+	sourceOrgName := c.String("clone-from")
+	if sourceOrgName != "" {
+		var err error
+		sourceOrg, err = cmd.orgRepo.FindByName(sourceOrgName)
+		if err != nil {
+			return err
+		}
+	}
+
 	cmd.ui.Say(T("Creating org {{.OrgName}} as {{.Username}}...",
 		map[string]interface{}{
 			"OrgName":  terminal.EntityNameColor(name),
@@ -136,7 +176,136 @@ func (cmd *CreateOrg) Execute(c flags.FlagContext) error {
 		}
 	}
 
+	if sourceOrgName != "" {
+		err = cmd.cloneOrg(sourceOrg, name)
+		if err != nil {
+			return err
+		}
+	}
+
 	cmd.ui.Say(T("\nTIP: Use '{{.Command}}' to target new org",
 		map[string]interface{}{"Command": terminal.CommandColor(cf.Name + " target -o \"" + name + "\"")}))
 	return nil
 }
+
+// cloneOrg copies the quota, private domain shares and org role assignments
+// of sourceOrg into the newly created org. Items the current user is not
+// permitted to copy are skipped and summarized at the end.
+func (cmd *CreateOrg) cloneOrg(sourceOrg models.Organization, name string) error {
+	org, err := cmd.orgRepo.FindByName(name)
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Cloning org {{.SourceOrg}} into org {{.OrgName}} as {{.Username}}...",
+		map[string]interface{}{
+			"SourceOrg": terminal.EntityNameColor(sourceOrg.Name),
+			"OrgName":   terminal.EntityNameColor(name),
+			"Username":  terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	var results []cloneResult
+	record := func(item string, err error) error {
+		if err == nil {
+			results = append(results, cloneResult{item: item})
+			return nil
+		}
+		if isForbidden(err) {
+			results = append(results, cloneResult{item: item, skipped: true})
+			return nil
+		}
+		return err
+	}
+
+	if sourceOrg.QuotaDefinition.GUID != "" {
+		err = cmd.quotaRepo.AssignQuotaToOrg(org.GUID, sourceOrg.QuotaDefinition.GUID)
+		err = record(T("quota {{.QuotaName}}", map[string]interface{}{"QuotaName": sourceOrg.QuotaDefinition.Name}), err)
+		if err != nil {
+			return err
+		}
+	}
+
+	var privateDomains []models.DomainFields
+	err = cmd.domainRepo.ListDomainsForOrg(sourceOrg.GUID, func(domain models.DomainFields) bool {
+		if !domain.Shared {
+			privateDomains = append(privateDomains, domain)
+		}
+		return true
+	})
+	if err != nil {
+		if !isForbidden(err) {
+			return err
+		}
+		results = append(results, cloneResult{item: T("private domains"), skipped: true})
+	}
+	for _, domain := range privateDomains {
+		err = cmd.orgRepo.SharePrivateDomain(org.GUID, domain.GUID)
+		err = record(T("private domain {{.DomainName}}", map[string]interface{}{"DomainName": domain.Name}), err)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, orgRole := range clonedOrgRoles {
+		users, err := cmd.userRepo.ListUsersInOrgForRoleWithNoUAA(sourceOrg.GUID, orgRole.role)
+		if err != nil {
+			if !isForbidden(err) {
+				return err
+			}
+			results = append(results, cloneResult{item: T("{{.Role}} roles", map[string]interface{}{"Role": orgRole.name}), skipped: true})
+		}
+		for _, user := range users {
+			username := user.Username
+			if username == "" {
+				username = user.GUID
+			}
+
+			err = cmd.userRepo.SetOrgRoleByGUID(user.GUID, org.GUID, orgRole.role)
+			err = record(T("role {{.Role}} for user {{.Username}}", map[string]interface{}{"Role": orgRole.name, "Username": username}), err)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return cmd.displayCloneResults(results)
+}
+
+func (cmd *CreateOrg) displayCloneResults(results []cloneResult) error {
+	var skipped []string
+	table := cmd.ui.Table([]string{T("item"), T("result")})
+	for _, result := range results {
+		if result.skipped {
+			skipped = append(skipped, result.item)
+			table.Add(result.item, T("skipped (not authorized)"))
+		} else {
+			table.Add(result.item, T("copied"))
+		}
+	}
+	cmd.ui.Say("")
+	err := table.Print()
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say("")
+	if len(skipped) == 0 {
+		cmd.ui.Say(T("Copied all items."))
+	} else {
+		cmd.ui.Warn(T("Skipped {{.Count}} items because you are not authorized to copy them:", map[string]interface{}{"Count": len(skipped)}))
+		for _, item := range skipped {
+			cmd.ui.Warn("   " + item)
+		}
+	}
+	cmd.ui.Say(T("Spaces and their contents are not cloned."))
+	return nil
+}
+
+func isForbidden(err error) bool {
+	if _, ok := err.(*errors.AccessDeniedError); ok {
+		return true
+	}
+	httpErr, ok := err.(errors.HTTPError)
+	return ok && httpErr.StatusCode() == http.StatusForbidden
+}
@@ -1,12 +1,15 @@
 package organization_test
 
 import (
+	"net/http"
+
 	"code.cloudfoundry.org/cli/cf/commands/user/userfakes"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
 
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/api/featureflags/featureflagsfakes"
 	"code.cloudfoundry.org/cli/cf/api/organizations/organizationsfakes"
 	"code.cloudfoundry.org/cli/cf/api/quotas/quotasfakes"
@@ -31,6 +34,8 @@ var _ = Describe("create-org command", func() {
 		deps                commandregistry.Dependency
 		orgRoleSetter       *userfakes.FakeOrgRoleSetter
 		flagRepo            *featureflagsfakes.FakeFeatureFlagRepository
+		domainRepo          *apifakes.FakeDomainRepository
+		userRepo            *apifakes.FakeUserRepository
 		OriginalCommand     commandregistry.Command
 	)
 
@@ -39,6 +44,8 @@ var _ = Describe("create-org command", func() {
 		deps.RepoLocator = deps.RepoLocator.SetOrganizationRepository(orgRepo)
 		deps.RepoLocator = deps.RepoLocator.SetQuotaRepository(quotaRepo)
 		deps.RepoLocator = deps.RepoLocator.SetFeatureFlagRepository(flagRepo)
+		deps.RepoLocator = deps.RepoLocator.SetDomainRepository(domainRepo)
+		deps.RepoLocator = deps.RepoLocator.SetUserRepository(userRepo)
 		deps.Config = config
 
 		//inject fake 'command dependency' into registry
@@ -55,6 +62,8 @@ var _ = Describe("create-org command", func() {
 		orgRepo = new(organizationsfakes.FakeOrganizationRepository)
 		quotaRepo = new(quotasfakes.FakeQuotaRepository)
 		flagRepo = new(featureflagsfakes.FakeFeatureFlagRepository)
+		domainRepo = new(apifakes.FakeDomainRepository)
+		userRepo = new(apifakes.FakeUserRepository)
 		config.SetAPIVersion("2.36.9")
 
 		orgRoleSetter = new(userfakes.FakeOrgRoleSetter)
@@ -84,6 +93,13 @@ var _ = Describe("create-org command", func() {
 			))
 		})
 
+		It("fails with usage when provided both -q and --clone-from", func() {
+			Expect(runCommand("-q", "some-quota", "--clone-from", "source-org", "my-org")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "-q, --clone-from"},
+			))
+		})
+
 		It("fails when not logged in", func() {
 			requirementsFactory.NewLoginRequirementReturns(requirements.Failing{Message: "not logged in"})
 			Expect(runCommand("my-org")).To(BeFalse())
@@ -222,5 +238,158 @@ var _ = Describe("create-org command", func() {
 				))
 			})
 		})
+
+		Context("when cloning from another org", func() {
+			var forbidden error
+
+			BeforeEach(func() {
+				forbidden = errors.NewHTTPError(http.StatusForbidden, "10003", "You are not authorized to perform the requested action")
+
+				orgRepo.FindByNameStub = func(name string) (models.Organization, error) {
+					if name == "source-org" {
+						return models.Organization{
+							OrganizationFields: models.OrganizationFields{
+								GUID: "source-org-guid",
+								Name: "source-org",
+								QuotaDefinition: models.QuotaFields{
+									GUID: "source-quota-guid",
+									Name: "source-quota",
+								},
+							},
+						}, nil
+					}
+					return models.Organization{
+						OrganizationFields: models.OrganizationFields{GUID: "my-org-guid", Name: name},
+					}, nil
+				}
+
+				domainRepo.ListDomainsForOrgStub = func(orgGUID string, cb func(models.DomainFields) bool) error {
+					for _, domain := range []models.DomainFields{
+						{GUID: "shared-domain-guid", Name: "shared.example.com", Shared: true},
+						{GUID: "private-domain-guid", Name: "private.example.com"},
+						{GUID: "other-domain-guid", Name: "other.example.com"},
+					} {
+						if !cb(domain) {
+							break
+						}
+					}
+					return nil
+				}
+				orgRepo.SharePrivateDomainStub = func(orgGUID string, domainGUID string) error {
+					if domainGUID == "other-domain-guid" {
+						return forbidden
+					}
+					return nil
+				}
+
+				userRepo.ListUsersInOrgForRoleWithNoUAAStub = func(orgGUID string, role models.Role) ([]models.UserFields, error) {
+					switch role {
+					case models.RoleOrgManager:
+						return []models.UserFields{{GUID: "manager-guid", Username: "manager"}}, nil
+					case models.RoleOrgAuditor:
+						return nil, forbidden
+					default:
+						return []models.UserFields{{GUID: "billing-guid"}}, nil
+					}
+				}
+			})
+
+			It("creates the org and copies the quota, private domains and roles of the source org", func() {
+				runCommand("--clone-from", "source-org", "my-org")
+
+				Expect(orgRepo.CreateArgsForCall(0).Name).To(Equal("my-org"))
+
+				Expect(quotaRepo.AssignQuotaToOrgCallCount()).To(Equal(1))
+				orgGUID, quotaGUID := quotaRepo.AssignQuotaToOrgArgsForCall(0)
+				Expect(orgGUID).To(Equal("my-org-guid"))
+				Expect(quotaGUID).To(Equal("source-quota-guid"))
+
+				Expect(domainRepo.ListDomainsForOrgCallCount()).To(Equal(1))
+				listedOrgGUID, _ := domainRepo.ListDomainsForOrgArgsForCall(0)
+				Expect(listedOrgGUID).To(Equal("source-org-guid"))
+				Expect(orgRepo.SharePrivateDomainCallCount()).To(Equal(2))
+				orgGUID, domainGUID := orgRepo.SharePrivateDomainArgsForCall(0)
+				Expect(orgGUID).To(Equal("my-org-guid"))
+				Expect(domainGUID).To(Equal("private-domain-guid"))
+
+				Expect(userRepo.SetOrgRoleByGUIDCallCount()).To(Equal(2))
+				userGUID, orgGUID, role := userRepo.SetOrgRoleByGUIDArgsForCall(0)
+				Expect(userGUID).To(Equal("manager-guid"))
+				Expect(orgGUID).To(Equal("my-org-guid"))
+				Expect(role).To(Equal(models.RoleOrgManager))
+				userGUID, _, role = userRepo.SetOrgRoleByGUIDArgsForCall(1)
+				Expect(userGUID).To(Equal("billing-guid"))
+				Expect(role).To(Equal(models.RoleBillingManager))
+			})
+
+			It("lists the result of every item and summarizes the skipped ones", func() {
+				runCommand("--clone-from", "source-org", "my-org")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Creating org", "my-org"},
+					[]string{"OK"},
+					[]string{"Cloning org source-org into org my-org as my-user..."},
+					[]string{"item", "result"},
+					[]string{"quota source-quota", "copied"},
+					[]string{"private domain private.example.com", "copied"},
+					[]string{"private domain other.example.com", "skipped (not authorized)"},
+					[]string{"role OrgManager for user manager", "copied"},
+					[]string{"role BillingManager for user billing-guid", "copied"},
+					[]string{"OrgAuditor roles", "skipped (not authorized)"},
+					[]string{"Skipped 2 items because you are not authorized to copy them:"},
+					[]string{"private domain other.example.com"},
+					[]string{"OrgAuditor roles"},
+					[]string{"Spaces and their contents are not cloned."},
+					[]string{`TIP: Use 'cf target -o "my-org"' to target new org`},
+				))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"shared.example.com"}))
+			})
+
+			It("says so when everything was copied", func() {
+				orgRepo.SharePrivateDomainStub = nil
+				userRepo.ListUsersInOrgForRoleWithNoUAAStub = nil
+
+				runCommand("--clone-from", "source-org", "my-org")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Copied all items."},
+					[]string{"Spaces and their contents are not cloned."},
+				))
+			})
+
+			It("fails without creating the org when the source org cannot be found", func() {
+				orgRepo.FindByNameStub = nil
+				orgRepo.FindByNameReturns(models.Organization{}, errors.NewModelNotFoundError("Organization", "source-org"))
+
+				runCommand("--clone-from", "source-org", "my-org")
+
+				Expect(orgRepo.CreateCallCount()).To(Equal(0))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"source-org", "not found"},
+				))
+			})
+
+			It("fails when copying an item fails for a reason other than permissions", func() {
+				quotaRepo.AssignQuotaToOrgReturns(errors.New("quota assignment failed"))
+
+				runCommand("--clone-from", "source-org", "my-org")
+
+				Expect(orgRepo.SharePrivateDomainCallCount()).To(Equal(0))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"quota assignment failed"},
+				))
+			})
+
+			It("does not clone into an org that already exists", func() {
+				orgRepo.CreateReturns(errors.NewHTTPError(400, errors.OrganizationNameTaken, "org already exists"))
+
+				runCommand("--clone-from", "source-org", "my-org")
+
+				Expect(quotaRepo.AssignQuotaToOrgCallCount()).To(Equal(0))
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"my-org", "already exists"}))
+			})
+		})
 	})
 })
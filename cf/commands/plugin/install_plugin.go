@@ -19,6 +19,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/plugin"
 	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/downloader"
 	"code.cloudfoundry.org/gofileutils/fileutils"
 
@@ -311,6 +312,7 @@ func (cmd *PluginInstall) runBinaryAndObtainPluginMetadata(pluginSourceFilepath
 
 func (cmd *PluginInstall) runPluginBinary(location string, servicePort string) error {
 	pluginInvocation := exec.Command(location, servicePort, "SendMetadata")
+	pluginInvocation.Env = configv3.SubprocessEnvironment()
 
 	err := pluginInvocation.Run()
 	if err != nil {
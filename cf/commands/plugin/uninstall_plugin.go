@@ -15,6 +15,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	rpcService "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type PluginUninstall struct {
@@ -109,6 +110,7 @@ func (cmd *PluginUninstall) notifyPluginUninstalling(meta pluginconfig.PluginMet
 	defer cmd.rpcService.Stop()
 
 	pluginInvocation := exec.Command(meta.Location, cmd.rpcService.Port(), "CLI-MESSAGE-UNINSTALL")
+	pluginInvocation.Env = configv3.SubprocessEnvironment()
 	pluginInvocation.Stdout = os.Stdout
 
 	return pluginInvocation.Run(), nil
@@ -35,17 +35,27 @@ func (cmd *RepoPlugins) MetaData() commandregistry.CommandMetadata {
 		Name:        T("repo-plugins"),
 		Description: T("List all available plugins in specified repository or in all added repositories"),
 		Usage: []string{
-			T(`CF_NAME repo-plugins [-r REPO_NAME]`),
+			T(`CF_NAME repo-plugins [-r REPO_NAME] [SEARCH_TERM]`),
 		},
 		Examples: []string{
 			"CF_NAME repo-plugins -r PrivateRepo",
+			"CF_NAME repo-plugins -r CF-Community log",
 		},
 		Flags: fs,
 	}
 }
 
 func (cmd *RepoPlugins) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
-	reqs := []requirements.Requirement{}
+	usageReq := requirements.NewUsageRequirement(commandregistry.CLICommandUsagePresenter(cmd),
+		T("Only one search term can be provided"),
+		func() bool {
+			return len(fc.Args()) > 1
+		},
+	)
+
+	reqs := []requirements.Requirement{
+		usageReq,
+	}
 	return reqs, nil
 }
 
@@ -83,7 +93,12 @@ func (cmd *RepoPlugins) Execute(c flags.FlagContext) error {
 
 	repoPlugins, repoError := cmd.pluginRepo.GetPlugins(repos)
 
-	err := cmd.printTable(repoPlugins)
+	var err error
+	if len(c.Args()) > 0 {
+		err = cmd.printMatchingTable(repoPlugins, c.Args()[0])
+	} else {
+		err = cmd.printTable(repoPlugins)
+	}
 
 	cmd.printErrors(repoError)
 
@@ -109,6 +124,28 @@ func (cmd RepoPlugins) printTable(repoPlugins map[string][]clipr.Plugin) error {
 	return nil
 }
 
+// printMatchingTable prints the plugins whose name or description contains
+// searchTerm, ignoring case. Repositories without a match are left out.
+func (cmd RepoPlugins) printMatchingTable(repoPlugins map[string][]clipr.Plugin, searchTerm string) error {
+	term := strings.ToLower(searchTerm)
+	matchingPlugins := map[string][]clipr.Plugin{}
+	for repo, plugins := range repoPlugins {
+		for _, p := range plugins {
+			if strings.Contains(strings.ToLower(p.Name), term) || strings.Contains(strings.ToLower(p.Description), term) {
+				matchingPlugins[repo] = append(matchingPlugins[repo], p)
+			}
+		}
+	}
+
+	if len(matchingPlugins) == 0 {
+		cmd.ui.Say(T("No plugins matching '{{.SearchTerm}}' found.", map[string]interface{}{"SearchTerm": searchTerm}))
+		cmd.ui.Say("")
+		return nil
+	}
+
+	return cmd.printTable(matchingPlugins)
+}
+
 func (cmd RepoPlugins) printErrors(repoError []string) {
 	if len(repoError) > 0 {
 		cmd.ui.Say(terminal.ColorizeBold(T("Logged errors:"), 31))
@@ -140,6 +140,43 @@ var _ = Describe("repo-plugins", func() {
 			})
 		})
 
+		Context("when a search term is provided", func() {
+			BeforeEach(func() {
+				result := make(map[string][]clipr.Plugin)
+				result["repo1"] = []clipr.Plugin{
+					{Name: "log-stream", Description: "Streams logs"},
+					{Name: "top", Description: "Shows LOG volume per app"},
+					{Name: "copy-env", Description: "Copies environment variables"},
+				}
+				result["repo2"] = []clipr.Plugin{
+					{Name: "blue-green-deploy", Description: "Zero downtime deploys"},
+				}
+				fakePluginRepo.GetPluginsReturns(result, []string{})
+			})
+
+			It("lists the plugins whose name or description contains the term, ignoring case", func() {
+				err := callRepoPlugins("Log")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"repo1"}))
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"log-stream"}))
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"top"}))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"copy-env"}))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"repo2"}))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"blue-green-deploy"}))
+			})
+
+			Context("when no plugin matches", func() {
+				It("says so", func() {
+					err := callRepoPlugins("nothing-matches")
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"No plugins matching 'nothing-matches' found."}))
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"log-stream"}))
+				})
+			})
+		})
+
 		Context("If errors are reported back from GetPlugins()", func() {
 			It("informs user about the errors", func() {
 				fakePluginRepo.GetPluginsReturns(nil, []string{
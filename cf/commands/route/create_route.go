@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -144,11 +145,11 @@ func (cmd *CreateRoute) CreateRoute(hostName string, path string, port int, rand
 		var findErr error
 		route, findErr = cmd.routeRepo.Find(hostName, domain, path, port)
 		if findErr != nil {
-			return models.Route{}, err
+			return models.Route{}, portTakenError(err, domain, port)
 		}
 
 		if route.Space.GUID != space.GUID || route.Domain.GUID != domain.GUID {
-			return models.Route{}, err
+			return models.Route{}, portTakenError(err, domain, port)
 		}
 
 		cmd.ui.Ok()
@@ -165,3 +166,15 @@ func (cmd *CreateRoute) CreateRoute(hostName string, path string, port int, rand
 
 	return route, nil
 }
+
+func portTakenError(err error, domain models.DomainFields, port int) error {
+	if httpErr, ok := err.(errors.HTTPError); ok && httpErr.ErrorCode() == errors.RoutePortTaken {
+		return errors.New(T("Port {{.Port}} is already in use on domain {{.DomainName}}. Use --random-port to have a free port assigned instead.",
+			map[string]interface{}{
+				"Port":       port,
+				"DomainName": domain.Name,
+			}))
+	}
+
+	return err
+}
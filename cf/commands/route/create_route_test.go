@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands/route"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
@@ -525,6 +526,23 @@ var _ = Describe("CreateRoute", func() {
 			})
 		})
 
+		Context("when the port is already in use by another route", func() {
+			BeforeEach(func() {
+				routeRepo.CreateInSpaceReturns(models.Route{}, cferrors.NewHTTPError(400, cferrors.RoutePortTaken, "The port is taken: 9090"))
+				routeRepo.FindReturns(models.Route{
+					Domain: models.DomainFields{GUID: "domain-guid"},
+					Space:  models.SpaceFields{GUID: "other-space-guid"},
+					Port:   9090,
+				}, nil)
+			})
+
+			It("returns an error suggesting --random-port", func() {
+				_, err := rc.CreateRoute("", "", 9090, false, domainFields, spaceFields)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Port 9090 is already in use on domain domain-name. Use --random-port to have a free port assigned instead."))
+			})
+		})
+
 		Context("when creating the route succeeds", func() {
 			var route models.Route
 
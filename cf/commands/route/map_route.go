@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
@@ -33,6 +34,7 @@ func (cmd *MapRoute) MetaData() commandregistry.CommandMetadata {
 	fs["path"] = &flags.StringFlag{Name: "path", Usage: T("Path for the HTTP route")}
 	fs["port"] = &flags.IntFlag{Name: "port", Usage: T("Port for the TCP route")}
 	fs["random-port"] = &flags.BoolFlag{Name: "random-port", Usage: T("Create a random port for the TCP route")}
+	fs["app-protocol"] = &flags.StringFlag{Name: "app-protocol", Usage: T("Protocol the app receives traffic on for an HTTP route: http1 or http2")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "map-route",
@@ -43,7 +45,8 @@ func (cmd *MapRoute) MetaData() commandregistry.CommandMetadata {
 			fmt.Sprintf("%s ", T("APP_NAME")),
 			fmt.Sprintf("%s ", T("DOMAIN")),
 			fmt.Sprintf("[--hostname %s] ", T("HOSTNAME")),
-			fmt.Sprintf("[--path %s]\n\n", T("PATH")),
+			fmt.Sprintf("[--path %s] ", T("PATH")),
+			fmt.Sprintf("[--app-protocol %s]\n\n", T("PROTOCOL")),
 			fmt.Sprintf("   %s:\n", T("Map a TCP route")),
 			"      CF_NAME map-route ",
 			fmt.Sprintf("%s ", T("APP_NAME")),
@@ -55,6 +58,7 @@ func (cmd *MapRoute) MetaData() commandregistry.CommandMetadata {
 			"CF_NAME map-route my-app example.com --hostname myhost            # myhost.example.com",
 			"CF_NAME map-route my-app example.com --hostname myhost --path foo # myhost.example.com/foo",
 			"CF_NAME map-route my-app example.com --port 50000                 # example.com:50000",
+			"CF_NAME map-route my-app example.com --hostname myhost --app-protocol http2",
 		},
 		Flags: fs,
 	}
@@ -76,6 +80,19 @@ func (cmd *MapRoute) Requirements(requirementsFactory requirements.Factory, fc f
 		return nil, fmt.Errorf("Cannot specify random-port together with port, hostname and/or path.")
 	}
 
+	if fc.IsSet("app-protocol") {
+		protocol := fc.String("app-protocol")
+		if protocol != models.RouteDestinationProtocolHTTP1 && protocol != models.RouteDestinationProtocolHTTP2 {
+			cmd.ui.Failed(T("Invalid app protocol {{.Protocol}}. Valid values are http1 and http2.", map[string]interface{}{"Protocol": protocol}))
+			return nil, fmt.Errorf("Invalid app protocol %s", protocol)
+		}
+
+		if fc.IsSet("port") || fc.IsSet("random-port") {
+			cmd.ui.Failed(T("Cannot specify app-protocol together with port or random-port."))
+			return nil, fmt.Errorf("Cannot specify app-protocol together with port or random-port.")
+		}
+	}
+
 	appName := fc.Args()[0]
 	domainName := fc.Args()[1]
 
@@ -90,6 +107,10 @@ func (cmd *MapRoute) Requirements(requirementsFactory requirements.Factory, fc f
 		reqs = append(reqs, requirementsFactory.NewMinAPIVersionRequirement("Option '--path'", cf.RoutePathMinimumAPIVersion))
 	}
 
+	if fc.IsSet("app-protocol") {
+		reqs = append(reqs, requirementsFactory.NewMinAPIVersionRequirement("Option '--app-protocol'", cf.RouteDestinationProtocolMinimumAPIVersion))
+	}
+
 	var flag string
 	switch {
 	case fc.IsSet("port"):
@@ -133,6 +154,14 @@ func (cmd *MapRoute) Execute(c flags.FlagContext) error {
 
 	port := c.Int("port")
 	randomPort := c.Bool("random-port")
+
+	if domain.Internal {
+		err := validateInternalRoute(domain, hostName, c.IsSet("port"), randomPort)
+		if err != nil {
+			return err
+		}
+	}
+
 	route, err := cmd.routeCreator.CreateRoute(hostName, path, port, randomPort, domain, cmd.config.SpaceFields())
 	if err != nil {
 		return errors.New(T("Error resolving route:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
@@ -145,11 +174,73 @@ func (cmd *MapRoute) Execute(c flags.FlagContext) error {
 			"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 			"Username":  terminal.EntityNameColor(cmd.config.Username())}))
 
-	err = cmd.routeRepo.Bind(route.GUID, app.GUID)
+	if c.IsSet("app-protocol") {
+		err = cmd.mapDestination(route.GUID, app.GUID, c.String("app-protocol"))
+	} else {
+		err = cmd.routeRepo.Bind(route.GUID, app.GUID)
+	}
 	if err != nil {
 		return err
 	}
 
 	cmd.ui.Ok()
+
+	if domain.Internal {
+		cmd.ui.Say(T("TIP: Internal routes are only reachable over the container network. Use '{{.Command}}' to allow traffic to this app.",
+			map[string]interface{}{"Command": terminal.CommandColor(cf.Name + " add-network-policy SOURCE_APP --destination-app " + app.Name)}))
+	}
 	return nil
 }
+
+// validateInternalRoute rejects routes that only make sense for traffic
+// coming through the routers, since routes on internal domains are resolved
+// by service discovery on the container network instead.
+func validateInternalRoute(domain models.DomainFields, hostName string, port bool, randomPort bool) error {
+	var option string
+	switch {
+	case port:
+		option = "--port"
+	case randomPort:
+		option = "--random-port"
+	case hostName == "*":
+		option = "a wildcard hostname"
+	default:
+		return nil
+	}
+
+	return errors.New(T("Cannot map a route with {{.Option}} to internal domain {{.DomainName}}. Routes on internal domains are only reachable by other apps over the container network, not through the TCP or HTTP routers.",
+		map[string]interface{}{
+			"Option":     option,
+			"DomainName": domain.Name,
+		}))
+}
+
+// mapDestination maps the app to the route with the given protocol. If the app
+// is already a destination of the route, its protocol is updated in place.
+func (cmd *MapRoute) mapDestination(routeGUID string, appGUID string, protocol string) error {
+	destinations, err := cmd.routeRepo.ListDestinations(routeGUID)
+	if err != nil {
+		return err
+	}
+
+	var mapped, changed bool
+	for i, destination := range destinations {
+		if destination.AppGUID != appGUID {
+			continue
+		}
+		mapped = true
+		if destination.Protocol != protocol {
+			destinations[i].Protocol = protocol
+			changed = true
+		}
+	}
+
+	switch {
+	case !mapped:
+		return cmd.routeRepo.AddDestination(routeGUID, models.RouteDestination{AppGUID: appGUID, Protocol: protocol})
+	case changed:
+		return cmd.routeRepo.ReplaceDestinations(routeGUID, destinations)
+	default:
+		return nil
+	}
+}
@@ -120,11 +120,12 @@ var _ = Describe("MapRoute", func() {
 			Expect(usage).To(ContainElement("   --path              Path for the HTTP route"))
 			Expect(usage).To(ContainElement("   --port              Port for the TCP route"))
 			Expect(usage).To(ContainElement("   --random-port       Create a random port for the TCP route"))
+			Expect(usage).To(ContainElement("   --app-protocol      Protocol the app receives traffic on for an HTTP route: http1 or http2"))
 		})
 
 		It("shows the usage", func() {
 			Expect(usage).To(ContainElement("   Map an HTTP route:"))
-			Expect(usage).To(ContainElement("      cf map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH]"))
+			Expect(usage).To(ContainElement("      cf map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--app-protocol PROTOCOL]"))
 
 			Expect(usage).To(ContainElement("   Map a TCP route:"))
 			Expect(usage).To(ContainElement("      cf map-route APP_NAME DOMAIN (--port PORT | --random-port)"))
@@ -277,6 +278,59 @@ var _ = Describe("MapRoute", func() {
 				})
 			})
 
+			Context("when --app-protocol is given", func() {
+				BeforeEach(func() {
+					err := flagContext.Parse("app-name", "domain-name", "--hostname", "host", "--app-protocol", "http2")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns a MinAPIVersionRequirement", func() {
+					expectedVersion, err := semver.Make("2.150.0")
+					Expect(err).NotTo(HaveOccurred())
+
+					actualRequirements, err := cmd.Requirements(factory, flagContext)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(factory.NewMinAPIVersionRequirementCallCount()).To(Equal(1))
+					feature, requiredVersion := factory.NewMinAPIVersionRequirementArgsForCall(0)
+					Expect(feature).To(Equal("Option '--app-protocol'"))
+					Expect(requiredVersion).To(Equal(expectedVersion))
+					Expect(actualRequirements).To(ContainElement(minAPIVersionRequirement))
+				})
+			})
+
+			Context("when --app-protocol is given an invalid protocol", func() {
+				BeforeEach(func() {
+					err := flagContext.Parse("app-name", "domain-name", "--app-protocol", "grpc")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("fails with error", func() {
+					_, err := cmd.Requirements(factory, flagContext)
+					Expect(err).To(HaveOccurred())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Invalid app protocol grpc. Valid values are http1 and http2."},
+					))
+				})
+			})
+
+			Context("when --app-protocol and --port are given", func() {
+				BeforeEach(func() {
+					err := flagContext.Parse("app-name", "domain-name", "--app-protocol", "http2", "--port", "9090")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("fails with error", func() {
+					_, err := cmd.Requirements(factory, flagContext)
+					Expect(err).To(HaveOccurred())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Cannot specify app-protocol together with port or random-port."},
+					))
+				})
+			})
+
 			Context("when passing port with a hostname", func() {
 				BeforeEach(func() {
 					flagContext.Parse("app-name", "example.com", "--port", "8080", "--hostname", "something-else")
@@ -424,6 +478,69 @@ var _ = Describe("MapRoute", func() {
 			})
 		})
 
+		Context("when the domain is internal", func() {
+			var fakeRouteCreator *routefakes.OldFakeRouteCreator
+
+			BeforeEach(func() {
+				fakeDomain.Name = "apps.internal"
+				fakeDomain.Internal = true
+				domainRequirement.GetDomainReturns(fakeDomain)
+
+				var ok bool
+				fakeRouteCreator, ok = fakeCreateRouteCmd.(*routefakes.OldFakeRouteCreator)
+				Expect(ok).To(BeTrue())
+			})
+
+			Context("when a port is passed", func() {
+				BeforeEach(func() {
+					err := flagContext.Parse("app-name", "domain-name", "--port", "60000")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("refuses to create the route and explains why", func() {
+					Expect(err).To(MatchError("Cannot map a route with --port to internal domain apps.internal. Routes on internal domains are only reachable by other apps over the container network, not through the TCP or HTTP routers."))
+					Expect(fakeRouteCreator.CreateRouteCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a random-port is passed", func() {
+				BeforeEach(func() {
+					err := flagContext.Parse("app-name", "domain-name", "--random-port")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("refuses to create the route", func() {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("Cannot map a route with --random-port to internal domain apps.internal."))
+					Expect(fakeRouteCreator.CreateRouteCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a wildcard hostname is passed", func() {
+				BeforeEach(func() {
+					err := flagContext.Parse("app-name", "domain-name", "--hostname", "*")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("refuses to create the route", func() {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("Cannot map a route with a wildcard hostname to internal domain apps.internal."))
+					Expect(fakeRouteCreator.CreateRouteCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a hostname is passed", func() {
+				BeforeEach(func() {
+					err := flagContext.Parse("app-name", "domain-name", "--hostname", "my-app")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("creates the route", func() {
+					Expect(fakeRouteCreator.CreateRouteCallCount()).To(Equal(1))
+				})
+			})
+		})
+
 		Context("when creating the route fails", func() {
 			BeforeEach(func() {
 				fakeRouteCreator, ok := fakeCreateRouteCmd.(*routefakes.OldFakeRouteCreator)
@@ -470,6 +587,27 @@ var _ = Describe("MapRoute", func() {
 						[]string{"OK"},
 					))
 				})
+
+				It("does not suggest a network policy", func() {
+					Expect(ui.Outputs()).ToNot(ContainSubstrings(
+						[]string{"add-network-policy"},
+					))
+				})
+
+				Context("when the domain is internal", func() {
+					BeforeEach(func() {
+						fakeDomain.Internal = true
+						domainRequirement.GetDomainReturns(fakeDomain)
+					})
+
+					It("suggests adding a network policy to the app", func() {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"OK"},
+							[]string{"TIP", "add-network-policy SOURCE_APP --destination-app"},
+						))
+					})
+				})
 			})
 
 			Context("when binding the route fails", func() {
@@ -484,6 +622,87 @@ var _ = Describe("MapRoute", func() {
 			})
 		})
 
+		Context("when --app-protocol is passed", func() {
+			BeforeEach(func() {
+				err := flagContext.Parse("app-name", "domain-name", "--app-protocol", "http2")
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeRouteCreator, ok := fakeCreateRouteCmd.(*routefakes.OldFakeRouteCreator)
+				Expect(ok).To(BeTrue())
+				fakeRouteCreator.CreateRouteReturns(models.Route{GUID: "fake-route-guid"}, nil)
+			})
+
+			It("does not bind the route through the v2 API", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routeRepo.BindCallCount()).To(BeZero())
+			})
+
+			Context("when the app is not yet a destination of the route", func() {
+				BeforeEach(func() {
+					routeRepo.ListDestinationsReturns([]models.RouteDestination{
+						{GUID: "other-destination-guid", AppGUID: "other-app-guid", Protocol: "http1"},
+					}, nil)
+				})
+
+				It("adds a destination with the protocol", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(routeRepo.ListDestinationsArgsForCall(0)).To(Equal("fake-route-guid"))
+					Expect(routeRepo.AddDestinationCallCount()).To(Equal(1))
+					routeGUID, destination := routeRepo.AddDestinationArgsForCall(0)
+					Expect(routeGUID).To(Equal("fake-route-guid"))
+					Expect(destination).To(Equal(models.RouteDestination{AppGUID: "fake-app-guid", Protocol: "http2"}))
+					Expect(routeRepo.ReplaceDestinationsCallCount()).To(BeZero())
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"OK"}))
+				})
+			})
+
+			Context("when the app is already a destination with a different protocol", func() {
+				BeforeEach(func() {
+					routeRepo.ListDestinationsReturns([]models.RouteDestination{
+						{GUID: "other-destination-guid", AppGUID: "other-app-guid", Protocol: "http1"},
+						{GUID: "destination-guid", AppGUID: "fake-app-guid", Port: 8080, Protocol: "http1"},
+					}, nil)
+				})
+
+				It("updates the destination in place", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(routeRepo.AddDestinationCallCount()).To(BeZero())
+					Expect(routeRepo.ReplaceDestinationsCallCount()).To(Equal(1))
+					routeGUID, destinations := routeRepo.ReplaceDestinationsArgsForCall(0)
+					Expect(routeGUID).To(Equal("fake-route-guid"))
+					Expect(destinations).To(Equal([]models.RouteDestination{
+						{GUID: "other-destination-guid", AppGUID: "other-app-guid", Protocol: "http1"},
+						{GUID: "destination-guid", AppGUID: "fake-app-guid", Port: 8080, Protocol: "http2"},
+					}))
+				})
+			})
+
+			Context("when the app is already a destination with the same protocol", func() {
+				BeforeEach(func() {
+					routeRepo.ListDestinationsReturns([]models.RouteDestination{
+						{GUID: "destination-guid", AppGUID: "fake-app-guid", Protocol: "http2"},
+					}, nil)
+				})
+
+				It("does not change the destinations", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(routeRepo.AddDestinationCallCount()).To(BeZero())
+					Expect(routeRepo.ReplaceDestinationsCallCount()).To(BeZero())
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"OK"}))
+				})
+			})
+
+			Context("when listing the destinations fails", func() {
+				BeforeEach(func() {
+					routeRepo.ListDestinationsReturns(nil, errors.New("list-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(err).To(MatchError("list-error"))
+				})
+			})
+		})
+
 		Context("when a hostname is passed", func() {
 			BeforeEach(func() {
 				err := flagContext.Parse("app-name", "domain-name", "-n", "the-hostname")
@@ -0,0 +1,177 @@
+package route
+
+import (
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+type ShowRoute struct {
+	ui        terminal.UI
+	config    coreconfig.Reader
+	routeRepo api.RouteRepository
+	domainReq requirements.DomainRequirement
+}
+
+func init() {
+	commandregistry.Register(&ShowRoute{})
+}
+
+func (cmd *ShowRoute) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["hostname"] = &flags.StringFlag{Name: "hostname", ShortName: "n", Usage: T("Hostname used to identify the HTTP route")}
+	fs["path"] = &flags.StringFlag{Name: "path", Usage: T("Path used to identify the HTTP route")}
+	fs["port"] = &flags.IntFlag{Name: "port", Usage: T("Port used to identify the TCP route")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "route",
+		Description: T("Show route details and mapped destinations"),
+		Usage: []string{
+			fmt.Sprintf("%s:\n", T("Display an HTTP route")),
+			"      CF_NAME route ",
+			fmt.Sprintf("%s ", T("DOMAIN")),
+			fmt.Sprintf("[--hostname %s] ", T("HOSTNAME")),
+			fmt.Sprintf("[--path %s]\n\n", T("PATH")),
+			fmt.Sprintf("   %s:\n", T("Display a TCP route")),
+			"      CF_NAME route ",
+			fmt.Sprintf("%s ", T("DOMAIN")),
+			fmt.Sprintf("--port %s", T("PORT")),
+		},
+		Examples: []string{
+			"CF_NAME route example.com                              # example.com",
+			"CF_NAME route example.com --hostname myhost            # myhost.example.com",
+			"CF_NAME route example.com --hostname myhost --path foo # myhost.example.com/foo",
+			"CF_NAME route example.com --port 5000                  # example.com:5000",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *ShowRoute) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("route"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
+	}
+
+	if fc.IsSet("port") && (fc.IsSet("hostname") || fc.IsSet("path")) {
+		cmd.ui.Failed(T("Cannot specify port together with hostname and/or path."))
+		return nil, fmt.Errorf("Cannot specify port together with hostname and/or path.")
+	}
+
+	cmd.domainReq = requirementsFactory.NewDomainRequirement(fc.Args()[0])
+
+	var reqs []requirements.Requirement
+
+	if fc.String("path") != "" {
+		reqs = append(reqs, requirementsFactory.NewMinAPIVersionRequirement("Option '--path'", cf.RoutePathMinimumAPIVersion))
+	}
+
+	if fc.IsSet("port") {
+		reqs = append(reqs, requirementsFactory.NewMinAPIVersionRequirement("Option '--port'", cf.TCPRoutingMinimumAPIVersion))
+	}
+
+	reqs = append(reqs, []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedOrgRequirement(),
+		cmd.domainReq,
+	}...)
+
+	return reqs, nil
+}
+
+func (cmd *ShowRoute) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.routeRepo = deps.RepoLocator.GetRouteRepository()
+	return cmd
+}
+
+func (cmd *ShowRoute) Execute(c flags.FlagContext) error {
+	host := c.String("hostname")
+	path := c.String("path")
+	port := c.Int("port")
+	domain := cmd.domainReq.GetDomain()
+	url := (&models.RoutePresenter{Host: host, Domain: domain.Name, Path: path, Port: port}).URL()
+
+	cmd.ui.Say(T("Showing route {{.URL}} in org {{.OrgName}} as {{.Username}}...",
+		map[string]interface{}{
+			"URL":      terminal.EntityNameColor(url),
+			"OrgName":  terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"Username": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	route, err := cmd.routeRepo.Find(host, domain, path, port)
+	if err != nil {
+		if _, ok := err.(*cferrors.ModelNotFoundError); ok {
+			return errors.New(T("Route {{.URL}} does not exist.", map[string]interface{}{"URL": url}))
+		}
+		return err
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	var portValue string
+	if route.Port != 0 {
+		portValue = fmt.Sprintf("%d", route.Port)
+	}
+
+	table := cmd.ui.Table([]string{"", ""})
+	table.Add(T("domain:"), domain.Name)
+	table.Add(T("host:"), route.Host)
+	table.Add(T("path:"), route.Path)
+	table.Add(T("port:"), portValue)
+	table.Add(T("space:"), route.Space.Name)
+	table.Add(T("service:"), route.ServiceInstance.Name)
+	err = table.Print()
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Destinations:"))
+
+	if !cmd.config.IsMinAPIVersion(cf.RouteDestinationProtocolMinimumAPIVersion) {
+		table = cmd.ui.Table([]string{T("app")})
+		for _, app := range route.Apps {
+			table.Add(app.Name)
+		}
+		return table.Print()
+	}
+
+	destinations, err := cmd.routeRepo.ListDestinations(route.GUID)
+	if err != nil {
+		return err
+	}
+
+	appNames := map[string]string{}
+	for _, app := range route.Apps {
+		appNames[app.GUID] = app.Name
+	}
+
+	table = cmd.ui.Table([]string{T("app"), T("port"), T("protocol")})
+	for _, destination := range destinations {
+		var destinationPort string
+		if destination.Port != 0 {
+			destinationPort = fmt.Sprintf("%d", destination.Port)
+		}
+
+		appName, ok := appNames[destination.AppGUID]
+		if !ok {
+			appName = destination.AppGUID
+		}
+
+		table.Add(appName, destinationPort, destination.Protocol)
+	}
+	return table.Print()
+}
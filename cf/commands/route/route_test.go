@@ -0,0 +1,252 @@
+package route_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/route"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	"github.com/blang/semver"
+
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ShowRoute", func() {
+	var (
+		ui         *testterm.FakeUI
+		configRepo coreconfig.Repository
+		routeRepo  *apifakes.FakeRouteRepository
+
+		cmd         commandregistry.Command
+		deps        commandregistry.Dependency
+		factory     *requirementsfakes.FakeFactory
+		flagContext flags.FlagContext
+
+		loginRequirement         requirements.Requirement
+		targetedOrgRequirement   *requirementsfakes.FakeTargetedOrgRequirement
+		domainRequirement        *requirementsfakes.FakeDomainRequirement
+		minAPIVersionRequirement requirements.Requirement
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		routeRepo = new(apifakes.FakeRouteRepository)
+		repoLocator := deps.RepoLocator.SetRouteRepository(routeRepo)
+
+		deps = commandregistry.Dependency{
+			UI:          ui,
+			Config:      configRepo,
+			RepoLocator: repoLocator,
+		}
+
+		cmd = &route.ShowRoute{}
+		cmd.SetDependency(deps, false)
+
+		flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+
+		factory = new(requirementsfakes.FakeFactory)
+
+		loginRequirement = &passingRequirement{Name: "login-requirement"}
+		factory.NewLoginRequirementReturns(loginRequirement)
+
+		targetedOrgRequirement = new(requirementsfakes.FakeTargetedOrgRequirement)
+		factory.NewTargetedOrgRequirementReturns(targetedOrgRequirement)
+
+		domainRequirement = new(requirementsfakes.FakeDomainRequirement)
+		factory.NewDomainRequirementReturns(domainRequirement)
+		domainRequirement.GetDomainReturns(models.DomainFields{
+			GUID: "domain-guid",
+			Name: "example.com",
+		})
+
+		minAPIVersionRequirement = &passingRequirement{Name: "min-api-version-requirement"}
+		factory.NewMinAPIVersionRequirementReturns(minAPIVersionRequirement)
+	})
+
+	Describe("Requirements", func() {
+		Context("when not provided exactly one arg", func() {
+			BeforeEach(func() {
+				flagContext.Parse("example.com", "extra")
+			})
+
+			It("fails with usage", func() {
+				_, err := cmd.Requirements(factory, flagContext)
+				Expect(err).To(HaveOccurred())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Incorrect Usage. Requires an argument"},
+				))
+			})
+		})
+
+		Context("when provided exactly one arg", func() {
+			BeforeEach(func() {
+				flagContext.Parse("example.com")
+			})
+
+			It("returns login, targeted org and domain requirements", func() {
+				actualRequirements, err := cmd.Requirements(factory, flagContext)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualRequirements).To(ContainElement(loginRequirement))
+				Expect(actualRequirements).To(ContainElement(targetedOrgRequirement))
+				Expect(actualRequirements).To(ContainElement(domainRequirement))
+				Expect(factory.NewDomainRequirementArgsForCall(0)).To(Equal("example.com"))
+			})
+		})
+
+		Context("when a port is passed", func() {
+			BeforeEach(func() {
+				flagContext.Parse("example.com", "--port", "5000")
+			})
+
+			It("returns a MinAPIVersionRequirement", func() {
+				expectedVersion, err := semver.Make("2.53.0")
+				Expect(err).NotTo(HaveOccurred())
+
+				actualRequirements, err := cmd.Requirements(factory, flagContext)
+				Expect(err).NotTo(HaveOccurred())
+
+				feature, requiredVersion := factory.NewMinAPIVersionRequirementArgsForCall(0)
+				Expect(feature).To(Equal("Option '--port'"))
+				Expect(requiredVersion).To(Equal(expectedVersion))
+				Expect(actualRequirements).To(ContainElement(minAPIVersionRequirement))
+			})
+		})
+
+		Context("when a port is passed with a hostname", func() {
+			BeforeEach(func() {
+				flagContext.Parse("example.com", "--port", "5000", "--hostname", "myhost")
+			})
+
+			It("fails", func() {
+				_, err := cmd.Requirements(factory, flagContext)
+				Expect(err).To(HaveOccurred())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Cannot specify port together with hostname and/or path."},
+				))
+			})
+		})
+	})
+
+	Describe("Execute", func() {
+		var err error
+
+		BeforeEach(func() {
+			err := flagContext.Parse("example.com", "--hostname", "myhost")
+			Expect(err).NotTo(HaveOccurred())
+			cmd.Requirements(factory, flagContext)
+		})
+
+		JustBeforeEach(func() {
+			err = cmd.Execute(flagContext)
+		})
+
+		It("finds the route", func() {
+			Expect(routeRepo.FindCallCount()).To(Equal(1))
+			host, domain, path, port := routeRepo.FindArgsForCall(0)
+			Expect(host).To(Equal("myhost"))
+			Expect(domain.GUID).To(Equal("domain-guid"))
+			Expect(path).To(BeEmpty())
+			Expect(port).To(BeZero())
+		})
+
+		Context("when the route does not exist", func() {
+			BeforeEach(func() {
+				routeRepo.FindReturns(models.Route{}, cferrors.NewModelNotFoundError("Route", "myhost"))
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(MatchError("Route myhost.example.com does not exist."))
+			})
+		})
+
+		Context("when finding the route fails", func() {
+			BeforeEach(func() {
+				routeRepo.FindReturns(models.Route{}, errors.New("find-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("find-error"))
+			})
+		})
+
+		Context("when the route exists", func() {
+			BeforeEach(func() {
+				routeRepo.FindReturns(models.Route{
+					GUID:  "route-guid",
+					Host:  "myhost",
+					Space: models.SpaceFields{Name: "my-space"},
+					Apps: []models.ApplicationFields{
+						{GUID: "app-1-guid", Name: "app-1"},
+						{GUID: "app-2-guid", Name: "app-2"},
+					},
+				}, nil)
+			})
+
+			Context("when the API supports destination protocols", func() {
+				BeforeEach(func() {
+					configRepo.SetAPIVersion("2.150.0")
+					routeRepo.ListDestinationsReturns([]models.RouteDestination{
+						{AppGUID: "app-1-guid", Port: 8080, Protocol: "http2"},
+						{AppGUID: "app-2-guid", Protocol: "http1"},
+					}, nil)
+				})
+
+				It("displays the route and the protocol of each destination", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(routeRepo.ListDestinationsArgsForCall(0)).To(Equal("route-guid"))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Showing route", "myhost.example.com", "my-org", "my-user"},
+						[]string{"OK"},
+						[]string{"domain:", "example.com"},
+						[]string{"host:", "myhost"},
+						[]string{"space:", "my-space"},
+						[]string{"Destinations:"},
+						[]string{"app", "port", "protocol"},
+						[]string{"app-1", "8080", "http2"},
+						[]string{"app-2", "http1"},
+					))
+				})
+
+				Context("when listing destinations fails", func() {
+					BeforeEach(func() {
+						routeRepo.ListDestinationsReturns(nil, errors.New("list-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(err).To(MatchError("list-error"))
+					})
+				})
+			})
+
+			Context("when the API does not support destination protocols", func() {
+				BeforeEach(func() {
+					configRepo.SetAPIVersion("2.100.0")
+				})
+
+				It("lists the mapped apps without protocols", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(routeRepo.ListDestinationsCallCount()).To(BeZero())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Destinations:"},
+						[]string{"app-1"},
+						[]string{"app-2"},
+					))
+				})
+			})
+		})
+	})
+})
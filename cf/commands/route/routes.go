@@ -1,6 +1,7 @@
 package route
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -16,11 +17,41 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+type routeJSON struct {
+	GUID            string            `json:"guid"`
+	Host            string            `json:"host"`
+	Domain          string            `json:"domain"`
+	Path            string            `json:"path"`
+	Port            int               `json:"port"`
+	Space           string            `json:"space"`
+	Apps            []routeAppJSON    `json:"apps"`
+	ServiceInstance *routeServiceJSON `json:"service_instance"`
+}
+
+type routeAppJSON struct {
+	GUID string `json:"guid"`
+	Name string `json:"name"`
+}
+
+type routeServiceJSON struct {
+	GUID            string                         `json:"guid"`
+	Name            string                         `json:"name"`
+	RouteServiceURL string                         `json:"route_service_url"`
+	LastOperation   *routeServiceLastOperationJSON `json:"last_operation,omitempty"`
+}
+
+type routeServiceLastOperationJSON struct {
+	Type        string `json:"type"`
+	State       string `json:"state"`
+	Description string `json:"description"`
+}
+
 type ListRoutes struct {
-	ui         terminal.UI
-	routeRepo  api.RouteRepository
-	domainRepo api.DomainRepository
-	config     coreconfig.Reader
+	ui                      terminal.UI
+	routeRepo               api.RouteRepository
+	domainRepo              api.DomainRepository
+	routeServiceBindingRepo api.RouteServiceBindingRepository
+	config                  coreconfig.Reader
 }
 
 func init() {
@@ -30,13 +61,15 @@ func init() {
 func (cmd *ListRoutes) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["orglevel"] = &flags.BoolFlag{Name: "orglevel", Usage: T("List all the routes for all spaces of current organization")}
+	fs["org-level"] = &flags.BoolFlag{Name: "org-level", Usage: T("Same as --orglevel")}
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Output the routes as JSON")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "routes",
 		ShortName:   "r",
 		Description: T("List all routes in the current space or the current organization"),
 		Usage: []string{
-			"CF_NAME routes [--orglevel]",
+			"CF_NAME routes [--orglevel] [--json]",
 		},
 		Flags: fs,
 	}
@@ -64,11 +97,16 @@ func (cmd *ListRoutes) SetDependency(deps commandregistry.Dependency, pluginCall
 	cmd.config = deps.Config
 	cmd.routeRepo = deps.RepoLocator.GetRouteRepository()
 	cmd.domainRepo = deps.RepoLocator.GetDomainRepository()
+	cmd.routeServiceBindingRepo = deps.RepoLocator.GetRouteServiceBindingRepository()
 	return cmd
 }
 
 func (cmd *ListRoutes) Execute(c flags.FlagContext) error {
-	orglevel := c.Bool("orglevel")
+	orglevel := c.Bool("orglevel") || c.Bool("org-level")
+
+	if c.Bool("json") {
+		return cmd.listRoutesJSON(orglevel)
+	}
 
 	if orglevel {
 		cmd.ui.Say(T("Getting routes for org {{.OrgName}} as {{.Username}} ...\n",
@@ -101,9 +139,17 @@ func (cmd *ListRoutes) Execute(c flags.FlagContext) error {
 		))
 	}
 
-	var routesFound bool
-	cb := func(route models.Route) bool {
-		routesFound = true
+	routes, err := cmd.listRoutes(orglevel)
+	if err != nil {
+		return err
+	}
+
+	bindings, err := cmd.routeServiceBindings(routes)
+	if err != nil {
+		return err
+	}
+
+	for _, route := range routes {
 		appNames := []string{}
 		for _, app := range route.Apps {
 			appNames = append(appNames, app.Name)
@@ -116,35 +162,135 @@ func (cmd *ListRoutes) Execute(c flags.FlagContext) error {
 
 		domain := d[route.Domain.GUID]
 
+		routeType := domain.RouterGroupType
+		if domain.Internal {
+			routeType = T("internal")
+		}
+
 		table.Add(
 			route.Space.Name,
 			route.Host,
 			route.Domain.Name,
 			port,
 			route.Path,
-			domain.RouterGroupType,
+			routeType,
 			strings.Join(appNames, ","),
-			route.ServiceInstance.Name,
+			serviceColumn(route, bindings),
 		)
+	}
+
+	err = table.Print()
+	if err != nil {
+		return err
+	}
+
+	if len(routes) == 0 {
+		cmd.ui.Say(T("No routes found"))
+	}
+	return nil
+}
+
+func (cmd *ListRoutes) listRoutesJSON(orglevel bool) error {
+	routes, err := cmd.listRoutes(orglevel)
+	if err != nil {
+		return err
+	}
+
+	bindings, err := cmd.routeServiceBindings(routes)
+	if err != nil {
+		return err
+	}
+
+	routesJSON := []routeJSON{}
+	for _, route := range routes {
+		apps := make([]routeAppJSON, 0, len(route.Apps))
+		for _, app := range route.Apps {
+			apps = append(apps, routeAppJSON{GUID: app.GUID, Name: app.Name})
+		}
+
+		var serviceInstance *routeServiceJSON
+		if route.ServiceInstance.GUID != "" {
+			serviceInstance = &routeServiceJSON{
+				GUID:            route.ServiceInstance.GUID,
+				Name:            route.ServiceInstance.Name,
+				RouteServiceURL: route.ServiceInstance.RouteServiceURL,
+			}
+			if binding, ok := bindings[route.GUID]; ok && binding.LastOperation.State != "" {
+				serviceInstance.LastOperation = &routeServiceLastOperationJSON{
+					Type:        binding.LastOperation.Type,
+					State:       binding.LastOperation.State,
+					Description: binding.LastOperation.Description,
+				}
+			}
+		}
+
+		routesJSON = append(routesJSON, routeJSON{
+			GUID:            route.GUID,
+			Host:            route.Host,
+			Domain:          route.Domain.Name,
+			Path:            route.Path,
+			Port:            route.Port,
+			Space:           route.Space.Name,
+			Apps:            apps,
+			ServiceInstance: serviceInstance,
+		})
+	}
+
+	jsonBytes, err := json.MarshalIndent(routesJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say(string(jsonBytes))
+	return nil
+}
+
+func (cmd *ListRoutes) listRoutes(orglevel bool) ([]models.Route, error) {
+	routes := []models.Route{}
+	cb := func(route models.Route) bool {
+		routes = append(routes, route)
 		return true
 	}
 
+	var err error
 	if orglevel {
 		err = cmd.routeRepo.ListAllRoutes(cb)
 	} else {
 		err = cmd.routeRepo.ListRoutes(cb)
 	}
 	if err != nil {
-		return errors.New(T("Failed fetching routes.\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+		return nil, errors.New(T("Failed fetching routes.\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
 	}
+	return routes, nil
+}
 
-	err = table.Print()
+// routeServiceBindings returns the bindings between the given routes and
+// their route services, keyed by route GUID.
+func (cmd *ListRoutes) routeServiceBindings(routes []models.Route) (map[string]models.RouteServiceBinding, error) {
+	routeGUIDs := []string{}
+	for _, route := range routes {
+		if route.ServiceInstance.GUID != "" {
+			routeGUIDs = append(routeGUIDs, route.GUID)
+		}
+	}
+
+	bindings, err := cmd.routeServiceBindingRepo.ListForRoutes(routeGUIDs)
 	if err != nil {
-		return err
+		return nil, errors.New(T("Failed fetching route service bindings.\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
 	}
 
-	if !routesFound {
-		cmd.ui.Say(T("No routes found"))
+	bindingsByRoute := map[string]models.RouteServiceBinding{}
+	for _, binding := range bindings {
+		bindingsByRoute[binding.RouteGUID] = binding
 	}
-	return nil
+	return bindingsByRoute, nil
+}
+
+func serviceColumn(route models.Route, bindings map[string]models.RouteServiceBinding) string {
+	binding, ok := bindings[route.GUID]
+	if !ok || binding.LastOperation.State == "" || binding.LastOperation.State == "succeeded" {
+		return route.ServiceInstance.Name
+	}
+
+	return fmt.Sprintf("%s (%s %s)", route.ServiceInstance.Name, binding.LastOperation.Type, binding.LastOperation.State)
 }
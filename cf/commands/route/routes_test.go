@@ -1,7 +1,9 @@
 package route_test
 
 import (
+	"encoding/json"
 	"errors"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -25,6 +27,7 @@ var _ = Describe("routes command", func() {
 	var (
 		ui                  *testterm.FakeUI
 		routeRepo           *apifakes.FakeRouteRepository
+		bindingRepo         *apifakes.FakeRouteServiceBindingRepository
 		domainRepo          *apifakes.FakeDomainRepository
 		configRepo          coreconfig.Repository
 		requirementsFactory *requirementsfakes.FakeFactory
@@ -33,7 +36,7 @@ var _ = Describe("routes command", func() {
 
 	updateCommandDependency := func(pluginCall bool) {
 		deps.UI = ui
-		deps.RepoLocator = deps.RepoLocator.SetRouteRepository(routeRepo).SetDomainRepository(domainRepo)
+		deps.RepoLocator = deps.RepoLocator.SetRouteRepository(routeRepo).SetDomainRepository(domainRepo).SetRouteServiceBindingRepository(bindingRepo)
 		deps.Config = configRepo
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("routes").SetDependency(deps, pluginCall))
 	}
@@ -46,6 +49,7 @@ var _ = Describe("routes command", func() {
 		requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
 		routeRepo = new(apifakes.FakeRouteRepository)
 		domainRepo = new(apifakes.FakeDomainRepository)
+		bindingRepo = new(apifakes.FakeRouteServiceBindingRepository)
 	})
 
 	runCommand := func(args ...string) bool {
@@ -98,6 +102,10 @@ var _ = Describe("routes command", func() {
 					RouterGroupType: "tcp",
 				}
 				cb(tcpDomain)
+				cb(models.DomainFields{
+					GUID:     "internal-domain-guid",
+					Internal: true,
+				})
 				return nil
 			}
 
@@ -106,6 +114,7 @@ var _ = Describe("routes command", func() {
 				app2 := models.ApplicationFields{Name: "bora"}
 
 				route := models.Route{
+					GUID: "route-1-guid",
 					Space: models.SpaceFields{
 						Name: "my-space",
 					},
@@ -140,9 +149,22 @@ var _ = Describe("routes command", func() {
 					Port: 9090,
 				}
 
+				route4 := models.Route{
+					Space: models.SpaceFields{
+						Name: "my-space",
+					},
+					Host: "backend",
+					Domain: models.DomainFields{
+						GUID: "internal-domain-guid",
+						Name: "apps.internal",
+					},
+					Apps: []models.ApplicationFields{app2},
+				}
+
 				cb(route)
 				cb(route2)
 				cb(route3)
+				cb(route4)
 
 				return nil
 			}
@@ -159,7 +181,73 @@ var _ = Describe("routes command", func() {
 			Expect(terminal.Decolorize(ui.Outputs()[3])).To(MatchRegexp(`^my-space\s+hostname-1\s+example.com\s+dora\s+test-service\s*$`))
 			Expect(terminal.Decolorize(ui.Outputs()[4])).To(MatchRegexp(`^my-space\s+hostname-2\s+cookieclicker\.co\s+/foo\s+dora,bora\s*$`))
 			Expect(terminal.Decolorize(ui.Outputs()[5])).To(MatchRegexp(`^my-space\s+cookieclicker\.co\s+9090\s+tcp\s+dora,bora\s*$`))
+			Expect(terminal.Decolorize(ui.Outputs()[6])).To(MatchRegexp(`^my-space\s+backend\s+apps\.internal\s+internal\s+bora\s*$`))
+
+		})
+
+		It("only looks up route service bindings for routes bound to a service instance", func() {
+			runCommand()
+
+			Expect(bindingRepo.ListForRoutesCallCount()).To(Equal(1))
+			Expect(bindingRepo.ListForRoutesArgsForCall(0)).To(Equal([]string{"route-1-guid"}))
+		})
+
+		Context("when the route service binding is in progress", func() {
+			BeforeEach(func() {
+				bindingRepo.ListForRoutesReturns([]models.RouteServiceBinding{{
+					RouteGUID:           "route-1-guid",
+					ServiceInstanceGUID: "service-guid",
+					LastOperation:       models.LastOperationFields{Type: "create", State: "in progress", Description: "binding"},
+				}}, nil)
+			})
+
+			It("shows the state of the binding next to the service", func() {
+				runCommand()
+
+				Expect(terminal.Decolorize(ui.Outputs()[3])).To(MatchRegexp(`^my-space\s+hostname-1\s+example.com\s+dora\s+test-service \(create in progress\)\s*$`))
+			})
 
+			It("includes the last operation of the binding in the JSON output", func() {
+				Expect(runCommand("--json")).To(BeTrue())
+
+				var routes []map[string]interface{}
+				Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &routes)).To(Succeed())
+				Expect(routes[0]).To(HaveKeyWithValue("service_instance", HaveKeyWithValue("last_operation", map[string]interface{}{
+					"type":        "create",
+					"state":       "in progress",
+					"description": "binding",
+				})))
+				Expect(routes[1]).To(HaveKeyWithValue("service_instance", BeNil()))
+			})
+		})
+
+		Context("when the route service binding has succeeded", func() {
+			BeforeEach(func() {
+				bindingRepo.ListForRoutesReturns([]models.RouteServiceBinding{{
+					RouteGUID:     "route-1-guid",
+					LastOperation: models.LastOperationFields{Type: "create", State: "succeeded"},
+				}}, nil)
+			})
+
+			It("shows only the service name", func() {
+				runCommand()
+
+				Expect(terminal.Decolorize(ui.Outputs()[3])).To(MatchRegexp(`^my-space\s+hostname-1\s+example.com\s+dora\s+test-service\s*$`))
+			})
+		})
+
+		Context("when listing route service bindings fails", func() {
+			BeforeEach(func() {
+				bindingRepo.ListForRoutesReturns(nil, errors.New("binding-error"))
+			})
+
+			It("returns an error to the user", func() {
+				Expect(runCommand()).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Failed fetching route service bindings"},
+					[]string{"binding-error"},
+				))
+			})
 		})
 	})
 
@@ -181,8 +269,9 @@ var _ = Describe("routes command", func() {
 				route.Apps = []models.ApplicationFields{app1}
 				route.Space = space1
 				route.ServiceInstance = models.ServiceInstanceFields{
-					Name: "test-service",
-					GUID: "service-guid",
+					Name:            "test-service",
+					GUID:            "service-guid",
+					RouteServiceURL: "https://route-service.example.com",
 				}
 
 				route2 := models.Route{}
@@ -209,6 +298,53 @@ var _ = Describe("routes command", func() {
 				[]string{"space-2", "hostname-2", "cookieclicker.co", "dora", "bora"},
 			))
 		})
+
+		It("lists routes at org level as JSON with --org-level --json", func() {
+			Expect(runCommand("--org-level", "--json")).To(BeTrue())
+			Expect(routeRepo.ListAllRoutesCallCount()).To(Equal(1))
+			Expect(routeRepo.ListRoutesCallCount()).To(Equal(0))
+
+			var routes []map[string]interface{}
+			Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &routes)).To(Succeed())
+			Expect(routes).To(HaveLen(2))
+			Expect(routes[0]).To(HaveKeyWithValue("host", "hostname-1"))
+			Expect(routes[0]).To(HaveKeyWithValue("domain", "example.com"))
+			Expect(routes[0]).To(HaveKeyWithValue("space", "space-1"))
+			Expect(routes[0]).To(HaveKeyWithValue("apps", []interface{}{
+				map[string]interface{}{"guid": "", "name": "dora"},
+			}))
+			Expect(routes[0]).To(HaveKeyWithValue("service_instance", map[string]interface{}{
+				"guid":              "service-guid",
+				"name":              "test-service",
+				"route_service_url": "https://route-service.example.com",
+			}))
+			Expect(routes[1]).To(HaveKeyWithValue("path", "/foo"))
+			Expect(routes[1]).To(HaveKeyWithValue("service_instance", BeNil()))
+		})
+	})
+
+	Context("when a route is not bound to any app", func() {
+		BeforeEach(func() {
+			routeRepo.ListRoutesStub = func(cb func(models.Route) bool) error {
+				cb(models.Route{
+					GUID:   "orphan-guid",
+					Host:   "orphan",
+					Domain: models.DomainFields{Name: "example.com"},
+					Port:   0,
+				})
+				return nil
+			}
+		})
+
+		It("includes the route with an empty apps array in the JSON output", func() {
+			Expect(runCommand("--json")).To(BeTrue())
+			Expect(domainRepo.ListDomainsForOrgCallCount()).To(Equal(0))
+			Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Getting routes"}))
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{`"guid": "orphan-guid"`},
+				[]string{`"apps": []`},
+			))
+		})
 	})
 
 	Context("when there are not routes", func() {
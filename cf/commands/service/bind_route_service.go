@@ -3,6 +3,7 @@ package service
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/api"
@@ -12,11 +13,20 @@ import (
 	"code.cloudfoundry.org/cli/cf/flagcontext"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+const (
+	DefaultRouteServiceBindingPollInterval = 5 * time.Second
+	DefaultRouteServiceBindingWaitTimeout  = 15 * time.Minute
+)
+
 type BindRouteService struct {
+	PollInterval time.Duration
+	WaitTimeout  time.Duration
+
 	ui                      terminal.UI
 	config                  coreconfig.Reader
 	routeRepo               api.RouteRepository
@@ -44,6 +54,10 @@ func (cmd *BindRouteService) MetaData() commandregistry.CommandMetadata {
 		ShortName: "c",
 		Usage:     T("Valid JSON object containing service-specific configuration parameters, provided inline or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."),
 	}
+	fs["wait"] = &flags.BoolFlag{
+		Name:  "wait",
+		Usage: T("Wait for the binding to complete when the service binds asynchronously"),
+	}
 	fs["f"] = &flags.BackwardsCompatibilityFlag{}
 
 	return commandregistry.CommandMetadata{
@@ -51,12 +65,13 @@ func (cmd *BindRouteService) MetaData() commandregistry.CommandMetadata {
 		ShortName:   "brs",
 		Description: T("Bind a service instance to an HTTP route"),
 		Usage: []string{
-			T(`CF_NAME bind-route-service DOMAIN SERVICE_INSTANCE [--hostname HOSTNAME] [--path PATH] [-c PARAMETERS_AS_JSON]`),
+			T(`CF_NAME bind-route-service DOMAIN SERVICE_INSTANCE [--hostname HOSTNAME] [--path PATH] [-c PARAMETERS_AS_JSON] [--wait]`),
 		},
 		Examples: []string{
 			`CF_NAME bind-route-service example.com myratelimiter --hostname myapp --path foo`,
 			`CF_NAME bind-route-service example.com myratelimiter -c file.json`,
 			`CF_NAME bind-route-service example.com myratelimiter -c '{"valid":"json"}'`,
+			`CF_NAME bind-route-service example.com myratelimiter --wait`,
 			``,
 			T(`In Windows PowerShell use double-quoted, escaped JSON: "{\"valid\":\"json\"}"`),
 			T(`In Windows Command Line use single-quoted, escaped JSON: '{\"valid\":\"json\"}'`),
@@ -96,6 +111,8 @@ func (cmd *BindRouteService) SetDependency(deps commandregistry.Dependency, plug
 	cmd.config = deps.Config
 	cmd.routeRepo = deps.RepoLocator.GetRouteRepository()
 	cmd.routeServiceBindingRepo = deps.RepoLocator.GetRouteServiceBindingRepository()
+	cmd.PollInterval = DefaultRouteServiceBindingPollInterval
+	cmd.WaitTimeout = DefaultRouteServiceBindingWaitTimeout
 	return cmd
 }
 
@@ -148,6 +165,71 @@ func (cmd *BindRouteService) Execute(c flags.FlagContext) error {
 		}
 	}
 
+	binding, err := cmd.waitForBinding(route, serviceInstance, c.Bool("wait"))
+	if err != nil {
+		return err
+	}
+
+	if binding.LastOperation.State == "in progress" {
+		cmd.ui.Ok()
+		cmd.ui.Say("")
+		cmd.ui.Say(T("Binding in progress. Use '{{.CFCommand}}' to check the status of the binding, or pass --wait to wait for it.",
+			map[string]interface{}{"CFCommand": terminal.CommandColor(cf.Name + " routes")}))
+		return nil
+	}
+
 	cmd.ui.Ok()
 	return nil
 }
+
+// waitForBinding returns the binding of the service instance to the route.
+// When the broker binds asynchronously and wait is true, it polls until the
+// binding is no longer in progress. A failed binding is returned as an
+// error.
+func (cmd *BindRouteService) waitForBinding(route models.Route, serviceInstance models.ServiceInstance, wait bool) (models.RouteServiceBinding, error) {
+	deadline := time.Now().Add(cmd.WaitTimeout)
+	for {
+		binding, err := cmd.findBinding(route.GUID, serviceInstance.GUID)
+		if err != nil {
+			return models.RouteServiceBinding{}, err
+		}
+
+		switch binding.LastOperation.State {
+		case "failed":
+			return models.RouteServiceBinding{}, errors.New(T("Binding route {{.URL}} to service instance {{.ServiceInstanceName}} failed: {{.Description}}",
+				map[string]interface{}{
+					"URL":                 route.URL(),
+					"ServiceInstanceName": serviceInstance.Name,
+					"Description":         binding.LastOperation.Description,
+				}))
+		case "in progress":
+			if !wait {
+				return binding, nil
+			}
+			if time.Now().Add(cmd.PollInterval).After(deadline) {
+				return models.RouteServiceBinding{}, errors.New(T("Timed out waiting for route {{.URL}} to be bound to service instance {{.ServiceInstanceName}}",
+					map[string]interface{}{
+						"URL":                 route.URL(),
+						"ServiceInstanceName": serviceInstance.Name,
+					}))
+			}
+			time.Sleep(cmd.PollInterval)
+		default:
+			return binding, nil
+		}
+	}
+}
+
+func (cmd *BindRouteService) findBinding(routeGUID string, serviceInstanceGUID string) (models.RouteServiceBinding, error) {
+	bindings, err := cmd.routeServiceBindingRepo.ListForRoutes([]string{routeGUID})
+	if err != nil {
+		return models.RouteServiceBinding{}, err
+	}
+
+	for _, binding := range bindings {
+		if binding.ServiceInstanceGUID == serviceInstanceGUID {
+			return binding, nil
+		}
+	}
+	return models.RouteServiceBinding{}, nil
+}
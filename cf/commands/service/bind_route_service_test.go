@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands/service"
@@ -484,6 +485,78 @@ var _ = Describe("BindRouteService", func() {
 						Expect(runCLIErr.Error()).To(Equal("bind-err"))
 					})
 				})
+
+				Context("when the binding is in progress", func() {
+					var inProgress models.RouteServiceBinding
+
+					BeforeEach(func() {
+						inProgress = models.RouteServiceBinding{
+							ServiceInstanceGUID: "service-instance-guid",
+							LastOperation:       models.LastOperationFields{Type: "create", State: "in progress"},
+						}
+						routeServiceBindingRepo.ListForRoutesReturns([]models.RouteServiceBinding{inProgress}, nil)
+					})
+
+					It("says OK and tells the user the binding is in progress", func() {
+						Expect(runCLIErr).NotTo(HaveOccurred())
+						Expect(routeServiceBindingRepo.ListForRoutesCallCount()).To(Equal(1))
+						Expect(routeServiceBindingRepo.ListForRoutesArgsForCall(0)).To(Equal([]string{"route-guid"}))
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"OK"},
+							[]string{"Binding in progress", "cf routes", "--wait"},
+						))
+					})
+
+					Context("when the --wait flag has been passed", func() {
+						BeforeEach(func() {
+							cmd.(*service.BindRouteService).PollInterval = time.Millisecond
+							flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+							err := flagContext.Parse("domain-name", "service-instance", "--wait")
+							Expect(err).NotTo(HaveOccurred())
+
+							succeeded := inProgress
+							succeeded.LastOperation.State = "succeeded"
+							routeServiceBindingRepo.ListForRoutesStub = func([]string) ([]models.RouteServiceBinding, error) {
+								if routeServiceBindingRepo.ListForRoutesCallCount() == 1 {
+									return []models.RouteServiceBinding{inProgress}, nil
+								}
+								return []models.RouteServiceBinding{succeeded}, nil
+							}
+						})
+
+						It("polls until the binding completes", func() {
+							Expect(runCLIErr).NotTo(HaveOccurred())
+							Expect(routeServiceBindingRepo.ListForRoutesCallCount()).To(Equal(2))
+							Expect(ui.Outputs()).To(ContainSubstrings([]string{"OK"}))
+							Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Binding in progress"}))
+						})
+
+						Context("when the binding does not complete before the timeout", func() {
+							BeforeEach(func() {
+								cmd.(*service.BindRouteService).WaitTimeout = 0
+							})
+
+							It("fails with a timeout error", func() {
+								Expect(runCLIErr).To(HaveOccurred())
+								Expect(runCLIErr.Error()).To(ContainSubstring("Timed out waiting for route"))
+							})
+						})
+					})
+				})
+
+				Context("when the binding failed", func() {
+					BeforeEach(func() {
+						routeServiceBindingRepo.ListForRoutesReturns([]models.RouteServiceBinding{{
+							ServiceInstanceGUID: "service-instance-guid",
+							LastOperation:       models.LastOperationFields{Type: "create", State: "failed", Description: "broker-says-no"},
+						}}, nil)
+					})
+
+					It("fails with the description of the failure", func() {
+						Expect(runCLIErr).To(HaveOccurred())
+						Expect(runCLIErr.Error()).To(ContainSubstring("broker-says-no"))
+					})
+				})
 			})
 		})
 
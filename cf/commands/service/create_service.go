@@ -5,6 +5,9 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/actors/servicebuilder"
 	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/organizations"
+	"code.cloudfoundry.org/cli/cf/api/spacequotas"
+	"code.cloudfoundry.org/cli/cf/api/spaces"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
@@ -21,6 +24,9 @@ type CreateService struct {
 	ui             terminal.UI
 	config         coreconfig.Reader
 	serviceRepo    api.ServiceRepository
+	orgRepo        organizations.OrganizationRepository
+	spaceRepo      spaces.SpaceRepository
+	spaceQuotaRepo spacequotas.SpaceQuotaRepository
 	serviceBuilder servicebuilder.ServiceBuilder
 }
 
@@ -99,6 +105,9 @@ func (cmd *CreateService) SetDependency(deps commandregistry.Dependency, pluginC
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
+	cmd.orgRepo = deps.RepoLocator.GetOrganizationRepository()
+	cmd.spaceRepo = deps.RepoLocator.GetSpaceRepository()
+	cmd.spaceQuotaRepo = deps.RepoLocator.GetSpaceQuotaRepository()
 	cmd.serviceBuilder = deps.ServiceBuilder
 	return cmd
 }
@@ -164,10 +173,78 @@ func (cmd CreateService) CreateService(serviceName, planName, serviceInstanceNam
 		return plan, apiErr
 	}
 
+	apiErr = cmd.checkServiceQuotas(plan)
+	if apiErr != nil {
+		return plan, apiErr
+	}
+
 	apiErr = cmd.serviceRepo.CreateServiceInstance(serviceInstanceName, plan.GUID, params, tags)
 	return plan, apiErr
 }
 
+// checkServiceQuotas fails before the broker is contacted when the org or
+// space quota would reject the new instance. A quota that cannot be fetched
+// is skipped with a warning and the Cloud Controller has the final word.
+func (cmd CreateService) checkServiceQuotas(plan models.ServicePlanFields) error {
+	org, err := cmd.orgRepo.FindByName(cmd.config.OrganizationFields().Name)
+	if err != nil {
+		cmd.warnQuotaUnchecked(err)
+	} else {
+		quota := org.QuotaDefinition
+		if !plan.Free && !quota.NonBasicServicesAllowed {
+			return errors.New(T("Org quota '{{.QuotaName}}' does not allow paid service plans",
+				map[string]interface{}{"QuotaName": quota.Name}))
+		}
+
+		if quota.ServicesLimit != -1 {
+			count, err := cmd.serviceRepo.GetServiceInstanceCountForOrg(org.GUID)
+			if err != nil {
+				cmd.warnQuotaUnchecked(err)
+			} else if count >= quota.ServicesLimit {
+				return errors.New(T("Service instance limit ({{.Limit}}) reached for org quota '{{.QuotaName}}'",
+					map[string]interface{}{"Limit": quota.ServicesLimit, "QuotaName": quota.Name}))
+			}
+		}
+	}
+
+	space, err := cmd.spaceRepo.FindByName(cmd.config.SpaceFields().Name)
+	if err != nil {
+		cmd.warnQuotaUnchecked(err)
+		return nil
+	}
+	if space.SpaceQuotaGUID == "" {
+		return nil
+	}
+
+	quota, err := cmd.spaceQuotaRepo.FindByGUID(space.SpaceQuotaGUID)
+	if err != nil {
+		cmd.warnQuotaUnchecked(err)
+		return nil
+	}
+
+	if !plan.Free && !quota.NonBasicServicesAllowed {
+		return errors.New(T("Space quota '{{.QuotaName}}' does not allow paid service plans",
+			map[string]interface{}{"QuotaName": quota.Name}))
+	}
+
+	if quota.ServicesLimit != -1 {
+		count, err := cmd.serviceRepo.GetServiceInstanceCountForSpace(space.GUID)
+		if err != nil {
+			cmd.warnQuotaUnchecked(err)
+		} else if count >= quota.ServicesLimit {
+			return errors.New(T("Service instance limit ({{.Limit}}) reached for space quota '{{.QuotaName}}'",
+				map[string]interface{}{"Limit": quota.ServicesLimit, "QuotaName": quota.Name}))
+		}
+	}
+
+	return nil
+}
+
+func (cmd CreateService) warnQuotaUnchecked(err error) {
+	cmd.ui.Warn(T("Unable to check the service quota, proceeding anyway: {{.Error}}",
+		map[string]interface{}{"Error": err.Error()}))
+}
+
 func findPlanFromOfferings(offerings models.ServiceOfferings, name string) (plan models.ServicePlanFields, err error) {
 	for _, offering := range offerings {
 		for _, plan := range offering.Plans {
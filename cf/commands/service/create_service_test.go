@@ -6,6 +6,9 @@ import (
 	"os"
 
 	"code.cloudfoundry.org/cli/cf/actors/servicebuilder/servicebuilderfakes"
+	"code.cloudfoundry.org/cli/cf/api/organizations/organizationsfakes"
+	"code.cloudfoundry.org/cli/cf/api/spacequotas/spacequotasfakes"
+	"code.cloudfoundry.org/cli/cf/api/spaces/spacesfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -31,6 +34,9 @@ var _ = Describe("create-service command", func() {
 		config              coreconfig.Repository
 		requirementsFactory *requirementsfakes.FakeFactory
 		serviceRepo         *apifakes.FakeServiceRepository
+		orgRepo             *organizationsfakes.FakeOrganizationRepository
+		spaceRepo           *spacesfakes.FakeSpaceRepository
+		spaceQuotaRepo      *spacequotasfakes.FakeSpaceQuotaRepository
 		serviceBuilder      *servicebuilderfakes.FakeServiceBuilder
 
 		offering1 models.ServiceOffering
@@ -42,6 +48,9 @@ var _ = Describe("create-service command", func() {
 		deps.UI = ui
 		deps.Config = config
 		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
+		deps.RepoLocator = deps.RepoLocator.SetOrganizationRepository(orgRepo)
+		deps.RepoLocator = deps.RepoLocator.SetSpaceRepository(spaceRepo)
+		deps.RepoLocator = deps.RepoLocator.SetSpaceQuotaRepository(spaceQuotaRepo)
 		deps.ServiceBuilder = serviceBuilder
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("create-service").SetDependency(deps, pluginCall))
 	}
@@ -55,6 +64,19 @@ var _ = Describe("create-service command", func() {
 		serviceRepo = new(apifakes.FakeServiceRepository)
 		serviceBuilder = new(servicebuilderfakes.FakeServiceBuilder)
 
+		orgRepo = new(organizationsfakes.FakeOrganizationRepository)
+		org := models.Organization{}
+		org.GUID = "my-org-guid"
+		org.QuotaDefinition = models.QuotaFields{Name: "default", ServicesLimit: -1, NonBasicServicesAllowed: true}
+		orgRepo.FindByNameReturns(org, nil)
+
+		spaceRepo = new(spacesfakes.FakeSpaceRepository)
+		space := models.Space{}
+		space.GUID = "my-space-guid"
+		spaceRepo.FindByNameReturns(space, nil)
+
+		spaceQuotaRepo = new(spacequotasfakes.FakeSpaceQuotaRepository)
+
 		offering1 = models.ServiceOffering{}
 		offering1.Label = "cleardb"
 		offering1.Plans = []models.ServicePlanFields{{
@@ -265,6 +287,119 @@ var _ = Describe("create-service command", func() {
 		})
 	})
 
+	Describe("checking the quotas before creating the service", func() {
+		var org models.Organization
+
+		BeforeEach(func() {
+			org = models.Organization{}
+			org.GUID = "my-org-guid"
+			org.QuotaDefinition = models.QuotaFields{Name: "trial", ServicesLimit: 10, NonBasicServicesAllowed: false}
+			orgRepo.FindByNameReturns(org, nil)
+		})
+
+		It("fails without creating the service when the org quota does not allow paid plans", func() {
+			callCreateService([]string{"cleardb", "expensive", "my-expensive-cleardb-service"})
+
+			Expect(orgRepo.FindByNameArgsForCall(0)).To(Equal("my-org"))
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Org quota 'trial' does not allow paid service plans"},
+			))
+			Expect(serviceRepo.CreateServiceInstanceCallCount()).To(BeZero())
+		})
+
+		It("fails without creating the service when the org has reached its service instance limit", func() {
+			serviceRepo.GetServiceInstanceCountForOrgReturns(10, nil)
+
+			callCreateService([]string{"cleardb", "spark", "my-cleardb-service"})
+
+			Expect(serviceRepo.GetServiceInstanceCountForOrgArgsForCall(0)).To(Equal("my-org-guid"))
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Service instance limit (10) reached for org quota 'trial'"},
+			))
+			Expect(serviceRepo.CreateServiceInstanceCallCount()).To(BeZero())
+		})
+
+		It("creates the service when the org is below its service instance limit", func() {
+			serviceRepo.GetServiceInstanceCountForOrgReturns(9, nil)
+
+			callCreateService([]string{"cleardb", "spark", "my-cleardb-service"})
+
+			Expect(serviceRepo.CreateServiceInstanceCallCount()).To(Equal(1))
+		})
+
+		Context("when the space has a quota", func() {
+			BeforeEach(func() {
+				org.QuotaDefinition = models.QuotaFields{Name: "paid", ServicesLimit: -1, NonBasicServicesAllowed: true}
+				orgRepo.FindByNameReturns(org, nil)
+
+				space := models.Space{}
+				space.GUID = "my-space-guid"
+				space.SpaceQuotaGUID = "space-quota-guid"
+				spaceRepo.FindByNameReturns(space, nil)
+
+				spaceQuotaRepo.FindByGUIDReturns(models.SpaceQuota{Name: "small", ServicesLimit: 2, NonBasicServicesAllowed: false}, nil)
+			})
+
+			It("fails when the space quota does not allow paid plans", func() {
+				callCreateService([]string{"cleardb", "expensive", "my-expensive-cleardb-service"})
+
+				Expect(spaceQuotaRepo.FindByGUIDArgsForCall(0)).To(Equal("space-quota-guid"))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Space quota 'small' does not allow paid service plans"},
+				))
+				Expect(serviceRepo.CreateServiceInstanceCallCount()).To(BeZero())
+			})
+
+			It("fails when the space has reached its service instance limit", func() {
+				serviceRepo.GetServiceInstanceCountForSpaceReturns(2, nil)
+
+				callCreateService([]string{"cleardb", "spark", "my-cleardb-service"})
+
+				Expect(serviceRepo.GetServiceInstanceCountForSpaceArgsForCall(0)).To(Equal("my-space-guid"))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Service instance limit (2) reached for space quota 'small'"},
+				))
+				Expect(serviceRepo.CreateServiceInstanceCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the org quota cannot be fetched", func() {
+			BeforeEach(func() {
+				orgRepo.FindByNameReturns(models.Organization{}, errors.New("quota-error"))
+			})
+
+			It("warns and creates the service anyway", func() {
+				callCreateService([]string{"cleardb", "expensive", "my-expensive-cleardb-service"})
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Unable to check the service quota", "quota-error"},
+					[]string{"OK"},
+				))
+				Expect(serviceRepo.CreateServiceInstanceCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the service instance count cannot be fetched", func() {
+			BeforeEach(func() {
+				serviceRepo.GetServiceInstanceCountForOrgReturns(0, errors.New("count-error"))
+			})
+
+			It("warns and creates the service anyway", func() {
+				callCreateService([]string{"cleardb", "spark", "my-cleardb-service"})
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Unable to check the service quota", "count-error"},
+					[]string{"OK"},
+				))
+				Expect(serviceRepo.CreateServiceInstanceCallCount()).To(Equal(1))
+			})
+		})
+	})
+
 	It("warns the user when the service already exists with the same service plan", func() {
 		serviceRepo.CreateServiceInstanceReturns(errors.NewModelAlreadyExistsError("Service", "my-cleardb-service"))
 
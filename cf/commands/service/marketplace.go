@@ -1,6 +1,7 @@
 package service
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -17,6 +18,28 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+type marketplaceServiceJSON struct {
+	GUID        string                `json:"guid"`
+	Label       string                `json:"label"`
+	Description string                `json:"description"`
+	Broker      string                `json:"broker"`
+	Plans       []marketplacePlanJSON `json:"plans"`
+}
+
+type marketplacePlanJSON struct {
+	GUID               string                    `json:"guid"`
+	Name               string                    `json:"name"`
+	Description        string                    `json:"description"`
+	Free               bool                      `json:"free"`
+	Costs              []marketplacePlanCostJSON `json:"costs"`
+	MaintenanceVersion string                    `json:"maintenance_version,omitempty"`
+}
+
+type marketplacePlanCostJSON struct {
+	Amount map[string]float64 `json:"amount"`
+	Unit   string             `json:"unit"`
+}
+
 type MarketplaceServices struct {
 	ui             terminal.UI
 	config         coreconfig.Reader
@@ -30,6 +53,7 @@ func init() {
 func (cmd *MarketplaceServices) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["s"] = &flags.StringFlag{ShortName: "s", Usage: T("Show plan details for a particular service offering")}
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Output the service offerings and their plans as JSON")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "marketplace",
@@ -37,7 +61,7 @@ func (cmd *MarketplaceServices) MetaData() commandregistry.CommandMetadata {
 		Description: T("List available offerings in the marketplace"),
 		Usage: []string{
 			"CF_NAME marketplace ",
-			fmt.Sprintf("[-s %s] ", T("SERVICE")),
+			fmt.Sprintf("[-s %s] [--json]", T("SERVICE")),
 		},
 		Flags: fs,
 	}
@@ -69,6 +93,10 @@ func (cmd *MarketplaceServices) SetDependency(deps commandregistry.Dependency, p
 func (cmd *MarketplaceServices) Execute(c flags.FlagContext) error {
 	serviceName := c.String("s")
 
+	if c.Bool("json") {
+		return cmd.marketplaceJSON(serviceName)
+	}
+
 	var err error
 	if serviceName != "" {
 		err = cmd.marketplaceByService(serviceName)
@@ -83,23 +111,17 @@ func (cmd *MarketplaceServices) Execute(c flags.FlagContext) error {
 }
 
 func (cmd MarketplaceServices) marketplaceByService(serviceName string) error {
-	var serviceOffering models.ServiceOffering
-	var err error
-
 	if cmd.config.HasSpace() {
 		cmd.ui.Say(T("Getting service plan information for service {{.ServiceName}} as {{.CurrentUser}}...",
 			map[string]interface{}{
 				"ServiceName": terminal.EntityNameColor(serviceName),
 				"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
 			}))
-		serviceOffering, err = cmd.serviceBuilder.GetServiceByNameForSpaceWithPlans(serviceName, cmd.config.SpaceFields().GUID)
 	} else if !cmd.config.IsLoggedIn() {
 		cmd.ui.Say(T("Getting service plan information for service {{.ServiceName}}...", map[string]interface{}{"ServiceName": terminal.EntityNameColor(serviceName)}))
-		serviceOffering, err = cmd.serviceBuilder.GetServiceByNameWithPlans(serviceName)
-	} else {
-		err = errors.New(T("Cannot list plan information for {{.ServiceName}} without a targeted space",
-			map[string]interface{}{"ServiceName": terminal.EntityNameColor(serviceName)}))
 	}
+
+	serviceOffering, err := cmd.getServiceOffering(serviceName)
 	if err != nil {
 		return err
 	}
@@ -112,7 +134,21 @@ func (cmd MarketplaceServices) marketplaceByService(serviceName string) error {
 		return nil
 	}
 
-	table := cmd.ui.Table([]string{T("service plan"), T("description"), T("free or paid")})
+	var showCosts, showMaintenanceVersion bool
+	for _, plan := range serviceOffering.Plans {
+		showCosts = showCosts || len(plan.Costs) > 0
+		showMaintenanceVersion = showMaintenanceVersion || plan.MaintenanceVersion != ""
+	}
+
+	headers := []string{T("service plan"), T("description"), T("free or paid")}
+	if showCosts {
+		headers = append(headers, T("costs"))
+	}
+	if showMaintenanceVersion {
+		headers = append(headers, T("maintenance version"))
+	}
+
+	table := cmd.ui.Table(headers)
 	for _, plan := range serviceOffering.Plans {
 		var freeOrPaid string
 		if plan.Free {
@@ -120,7 +156,15 @@ func (cmd MarketplaceServices) marketplaceByService(serviceName string) error {
 		} else {
 			freeOrPaid = "paid"
 		}
-		table.Add(plan.Name, plan.Description, freeOrPaid)
+
+		row := []string{plan.Name, plan.Description, freeOrPaid}
+		if showCosts {
+			row = append(row, formatPlanCosts(plan.Costs))
+		}
+		if showMaintenanceVersion {
+			row = append(row, plan.MaintenanceVersion)
+		}
+		table.Add(row...)
 	}
 
 	err = table.Print()
@@ -131,9 +175,6 @@ func (cmd MarketplaceServices) marketplaceByService(serviceName string) error {
 }
 
 func (cmd MarketplaceServices) marketplace() error {
-	var serviceOfferings models.ServiceOfferings
-	var err error
-
 	if cmd.config.HasSpace() {
 		cmd.ui.Say(T("Getting services from marketplace in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
 			map[string]interface{}{
@@ -141,13 +182,11 @@ func (cmd MarketplaceServices) marketplace() error {
 				"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 				"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
 			}))
-		serviceOfferings, err = cmd.serviceBuilder.GetServicesForSpaceWithPlans(cmd.config.SpaceFields().GUID)
 	} else if !cmd.config.IsLoggedIn() {
 		cmd.ui.Say(T("Getting all services from marketplace..."))
-		serviceOfferings, err = cmd.serviceBuilder.GetAllServicesWithPlans()
-	} else {
-		err = errors.New(T("Cannot list marketplace services without a targeted space"))
 	}
+
+	serviceOfferings, err := cmd.getServiceOfferings()
 	if err != nil {
 		return err
 	}
@@ -160,7 +199,13 @@ func (cmd MarketplaceServices) marketplace() error {
 		return nil
 	}
 
-	table := cmd.ui.Table([]string{T("service"), T("plans"), T("description")})
+	showBroker := hasDuplicateLabels(serviceOfferings)
+
+	headers := []string{T("service"), T("plans"), T("description")}
+	if showBroker {
+		headers = append(headers, T("broker"))
+	}
+	table := cmd.ui.Table(headers)
 
 	sort.Sort(serviceOfferings)
 	var paidPlanExists bool
@@ -181,7 +226,11 @@ func (cmd MarketplaceServices) marketplace() error {
 
 		planNames = strings.TrimPrefix(planNames, ", ")
 
-		table.Add(offering.Label, planNames, offering.Description)
+		if showBroker {
+			table.Add(offering.Label, planNames, offering.Description, offering.BrokerName)
+		} else {
+			table.Add(offering.Label, planNames, offering.Description)
+		}
 	}
 
 	err = table.Print()
@@ -194,3 +243,111 @@ func (cmd MarketplaceServices) marketplace() error {
 	cmd.ui.Say(T("\nTIP:  Use 'cf marketplace -s SERVICE' to view descriptions of individual plans of a given service."))
 	return nil
 }
+
+func (cmd MarketplaceServices) marketplaceJSON(serviceName string) error {
+	var serviceOfferings models.ServiceOfferings
+	if serviceName != "" {
+		serviceOffering, err := cmd.getServiceOffering(serviceName)
+		if err != nil {
+			return err
+		}
+		if serviceOffering.GUID != "" {
+			serviceOfferings = models.ServiceOfferings{serviceOffering}
+		}
+	} else {
+		var err error
+		serviceOfferings, err = cmd.getServiceOfferings()
+		if err != nil {
+			return err
+		}
+		sort.Sort(serviceOfferings)
+	}
+
+	servicesJSON := []marketplaceServiceJSON{}
+	for _, offering := range serviceOfferings {
+		plans := []marketplacePlanJSON{}
+		for _, plan := range offering.Plans {
+			if plan.Name == "" {
+				continue
+			}
+
+			costs := []marketplacePlanCostJSON{}
+			for _, cost := range plan.Costs {
+				costs = append(costs, marketplacePlanCostJSON{Amount: cost.Amount, Unit: cost.Unit})
+			}
+
+			plans = append(plans, marketplacePlanJSON{
+				GUID:               plan.GUID,
+				Name:               plan.Name,
+				Description:        plan.Description,
+				Free:               plan.Free,
+				Costs:              costs,
+				MaintenanceVersion: plan.MaintenanceVersion,
+			})
+		}
+
+		servicesJSON = append(servicesJSON, marketplaceServiceJSON{
+			GUID:        offering.GUID,
+			Label:       offering.Label,
+			Description: offering.Description,
+			Broker:      offering.BrokerName,
+			Plans:       plans,
+		})
+	}
+
+	jsonBytes, err := json.MarshalIndent(servicesJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say(string(jsonBytes))
+	return nil
+}
+
+func (cmd MarketplaceServices) getServiceOffering(serviceName string) (models.ServiceOffering, error) {
+	if cmd.config.HasSpace() {
+		return cmd.serviceBuilder.GetServiceByNameForSpaceWithPlans(serviceName, cmd.config.SpaceFields().GUID)
+	}
+	if !cmd.config.IsLoggedIn() {
+		return cmd.serviceBuilder.GetServiceByNameWithPlans(serviceName)
+	}
+	return models.ServiceOffering{}, errors.New(T("Cannot list plan information for {{.ServiceName}} without a targeted space",
+		map[string]interface{}{"ServiceName": terminal.EntityNameColor(serviceName)}))
+}
+
+func (cmd MarketplaceServices) getServiceOfferings() (models.ServiceOfferings, error) {
+	if cmd.config.HasSpace() {
+		return cmd.serviceBuilder.GetServicesForSpaceWithPlans(cmd.config.SpaceFields().GUID)
+	}
+	if !cmd.config.IsLoggedIn() {
+		return cmd.serviceBuilder.GetAllServicesWithPlans()
+	}
+	return nil, errors.New(T("Cannot list marketplace services without a targeted space"))
+}
+
+func hasDuplicateLabels(serviceOfferings models.ServiceOfferings) bool {
+	labels := map[string]bool{}
+	for _, offering := range serviceOfferings {
+		if labels[offering.Label] {
+			return true
+		}
+		labels[offering.Label] = true
+	}
+	return false
+}
+
+func formatPlanCosts(costs []models.ServicePlanCost) string {
+	var formatted []string
+	for _, cost := range costs {
+		currencies := make([]string, 0, len(cost.Amount))
+		for currency := range cost.Amount {
+			currencies = append(currencies, currency)
+		}
+		sort.Strings(currencies)
+
+		for _, currency := range currencies {
+			formatted = append(formatted, fmt.Sprintf("%s %.2f/%s", strings.ToUpper(currency), cost.Amount[currency], strings.ToLower(cost.Unit)))
+		}
+	}
+	return strings.Join(formatted, ", ")
+}
@@ -1,6 +1,8 @@
 package service_test
 
 import (
+	"strings"
+
 	"code.cloudfoundry.org/cli/cf/actors/servicebuilder/servicebuilderfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -174,6 +176,120 @@ var _ = Describe("marketplace command", func() {
 						[]string{"service plan", "description", "free or paid"},
 					))
 				})
+
+				Context("when the plans have costs and maintenance info", func() {
+					BeforeEach(func() {
+						serviceWithAPaidPlan.Plans[1].Costs = []models.ServicePlanCost{
+							{Amount: map[string]float64{"usd": 99.5, "eur": 89}, Unit: "MONTHLY"},
+						}
+						serviceWithAPaidPlan.Plans[1].MaintenanceVersion = "2.1.0"
+						serviceBuilder.GetServiceByNameForSpaceWithPlansReturns(serviceWithAPaidPlan, nil)
+					})
+
+					It("displays the costs and maintenance version columns", func() {
+						testcmd.RunCLICommand("marketplace", []string{"-s", "zzz-my-service-offering"}, requirementsFactory, updateCommandDependency, false, ui)
+
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"service plan", "description", "free or paid", "costs", "maintenance version"},
+							[]string{"service-plan-a", "service-plan-a description", "free"},
+							[]string{"service-plan-b", "service-plan-b description", "paid", "EUR 89.00/monthly, USD 99.50/monthly", "2.1.0"},
+						))
+					})
+				})
+
+				Context("when the plans have no costs or maintenance info", func() {
+					It("does not display the costs and maintenance version columns", func() {
+						serviceBuilder.GetServiceByNameForSpaceWithPlansReturns(serviceWithAPaidPlan, nil)
+
+						testcmd.RunCLICommand("marketplace", []string{"-s", "zzz-my-service-offering"}, requirementsFactory, updateCommandDependency, false, ui)
+
+						Expect(ui.Outputs()).ToNot(ContainSubstrings(
+							[]string{"costs"},
+						))
+						Expect(ui.Outputs()).ToNot(ContainSubstrings(
+							[]string{"maintenance version"},
+						))
+					})
+				})
+			})
+
+			Context("when two services share a label", func() {
+				BeforeEach(func() {
+					serviceWithAPaidPlan.BrokerName = "broker-1"
+					otherService := serviceWithAPaidPlan
+					otherService.GUID = "service-2-guid"
+					otherService.BrokerName = "broker-2"
+					serviceBuilder.GetServicesForSpaceWithPlansReturns([]models.ServiceOffering{serviceWithAPaidPlan, otherService}, nil)
+				})
+
+				It("displays the broker of each service", func() {
+					testcmd.RunCLICommand("marketplace", []string{}, requirementsFactory, updateCommandDependency, false, ui)
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"service", "plans", "description", "broker"},
+						[]string{"zzz-my-service-offering", "service offering 1 description", "broker-1"},
+						[]string{"zzz-my-service-offering", "service offering 1 description", "broker-2"},
+					))
+				})
+			})
+
+			Context("when the labels are unique", func() {
+				It("does not display the broker column", func() {
+					testcmd.RunCLICommand("marketplace", []string{}, requirementsFactory, updateCommandDependency, false, ui)
+
+					Expect(ui.Outputs()).ToNot(ContainSubstrings(
+						[]string{"broker"},
+					))
+				})
+			})
+
+			Context("when the user passes the --json flag", func() {
+				BeforeEach(func() {
+					serviceWithAPaidPlan.BrokerName = "broker-1"
+					serviceWithAPaidPlan.Plans[1].GUID = "service-plan-b-guid"
+					serviceWithAPaidPlan.Plans[1].Costs = []models.ServicePlanCost{
+						{Amount: map[string]float64{"usd": 99.5}, Unit: "MONTHLY"},
+					}
+					serviceWithAPaidPlan.Plans[1].MaintenanceVersion = "2.1.0"
+				})
+
+				It("outputs the service offerings as JSON", func() {
+					serviceBuilder.GetServicesForSpaceWithPlansReturns([]models.ServiceOffering{serviceWithAPaidPlan}, nil)
+
+					Expect(testcmd.RunCLICommand("marketplace", []string{"--json"}, requirementsFactory, updateCommandDependency, false, ui)).To(BeTrue())
+
+					Expect(ui.Outputs()).ToNot(ContainSubstrings(
+						[]string{"Getting services from marketplace"},
+					))
+					Expect(strings.Join(ui.Outputs(), "\n")).To(MatchJSON(`[
+						{
+							"guid": "service-1-guid",
+							"label": "zzz-my-service-offering",
+							"description": "service offering 1 description",
+							"broker": "broker-1",
+							"plans": [
+								{"guid": "", "name": "service-plan-a", "description": "service-plan-a description", "free": true, "costs": []},
+								{"guid": "service-plan-b-guid", "name": "service-plan-b", "description": "service-plan-b description", "free": false, "costs": [{"amount": {"usd": 99.5}, "unit": "MONTHLY"}], "maintenance_version": "2.1.0"}
+							]
+						}
+					]`))
+				})
+
+				It("outputs a single service offering when -s is passed", func() {
+					serviceBuilder.GetServiceByNameForSpaceWithPlansReturns(serviceWithAPaidPlan, nil)
+
+					Expect(testcmd.RunCLICommand("marketplace", []string{"-s", "zzz-my-service-offering", "--json"}, requirementsFactory, updateCommandDependency, false, ui)).To(BeTrue())
+
+					Expect(serviceBuilder.GetServiceByNameForSpaceWithPlansCallCount()).To(Equal(1))
+					Expect(strings.Join(ui.Outputs(), "\n")).To(ContainSubstring(`"label": "zzz-my-service-offering"`))
+					Expect(strings.Join(ui.Outputs(), "\n")).To(ContainSubstring(`"maintenance_version": "2.1.0"`))
+				})
+
+				It("outputs an empty list when the service cannot be found", func() {
+					Expect(testcmd.RunCLICommand("marketplace", []string{"-s", "zzz-my-service-offering", "--json"}, requirementsFactory, updateCommandDependency, false, ui)).To(BeTrue())
+
+					Expect(strings.Join(ui.Outputs(), "\n")).To(MatchJSON(`[]`))
+				})
 			})
 		})
 
@@ -4,11 +4,14 @@ import (
 	"fmt"
 
 	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/actors/purgereport"
 	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
@@ -16,6 +19,7 @@ import (
 type PurgeServiceInstance struct {
 	ui          terminal.UI
 	serviceRepo api.ServiceRepository
+	appRepo     applications.Repository
 }
 
 func init() {
@@ -25,12 +29,14 @@ func init() {
 func (cmd *PurgeServiceInstance) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force deletion without confirmation")}
+	fs["dry-run"] = &flags.BoolFlag{Name: "dry-run", Usage: T("List the records that would be removed without purging them")}
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Output the dry run report as JSON")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "purge-service-instance",
 		Description: T("Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"),
 		Usage: []string{
-			T("CF_NAME purge-service-instance SERVICE_INSTANCE"),
+			T("CF_NAME purge-service-instance SERVICE_INSTANCE [--dry-run [--json]]"),
 			"\n\n",
 			cmd.scaryWarningMessage(),
 		},
@@ -44,6 +50,11 @@ func (cmd *PurgeServiceInstance) Requirements(requirementsFactory requirements.F
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
+	if fc.Bool("json") && !fc.Bool("dry-run") {
+		cmd.ui.Failed(T("Incorrect Usage. The --json flag can only be used with --dry-run\n\n") + commandregistry.Commands.CommandUsage("purge-service-instance"))
+		return nil, fmt.Errorf("Incorrect usage: --json requires --dry-run")
+	}
+
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewLoginRequirement(),
 		requirementsFactory.NewMinAPIVersionRequirement("purge-service-instance", cf.RoutePathMinimumAPIVersion),
@@ -55,6 +66,7 @@ func (cmd *PurgeServiceInstance) Requirements(requirementsFactory requirements.F
 func (cmd *PurgeServiceInstance) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
 	cmd.ui = deps.UI
 	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
+	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
 	return cmd
 }
 
@@ -75,7 +87,29 @@ func (cmd *PurgeServiceInstance) Execute(c flags.FlagContext) error {
 		return err
 	}
 
+	dryRun := c.Bool("dry-run")
 	force := c.Bool("f")
+	if dryRun || !force {
+		var report purgereport.Report
+		report, err = purgereport.Build([]models.ServiceInstance{instance}, cmd.appRepo)
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			if c.Bool("json") {
+				return purgereport.PrintJSON(cmd.ui, report)
+			}
+			return purgereport.Print(cmd.ui, report)
+		}
+
+		err = purgereport.Print(cmd.ui, report)
+		if err != nil {
+			return err
+		}
+		cmd.ui.Say("")
+	}
+
 	if !force {
 		cmd.ui.Warn(cmd.scaryWarningMessage())
 		confirmed := cmd.ui.Confirm(T("Really purge service instance {{.InstanceName}} from Cloud Foundry?",
@@ -1,7 +1,10 @@
 package service_test
 
 import (
+	"encoding/json"
 	"errors"
+	"net/http"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands/service"
@@ -14,6 +17,7 @@ import (
 	"github.com/blang/semver"
 
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/applications/applicationsfakes"
 
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
 	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
@@ -29,6 +33,7 @@ var _ = Describe("PurgeServiceInstance", func() {
 		ui          *testterm.FakeUI
 		configRepo  coreconfig.Repository
 		serviceRepo *apifakes.FakeServiceRepository
+		appRepo     *applicationsfakes.FakeRepository
 
 		cmd         commandregistry.Command
 		deps        commandregistry.Dependency
@@ -43,7 +48,8 @@ var _ = Describe("PurgeServiceInstance", func() {
 		ui = &testterm.FakeUI{}
 		configRepo = testconfig.NewRepositoryWithDefaults()
 		serviceRepo = new(apifakes.FakeServiceRepository)
-		repoLocator := deps.RepoLocator.SetServiceRepository(serviceRepo)
+		appRepo = new(applicationsfakes.FakeRepository)
+		repoLocator := deps.RepoLocator.SetServiceRepository(serviceRepo).SetApplicationRepository(appRepo)
 
 		deps = commandregistry.Dependency{
 			UI:          ui,
@@ -110,6 +116,20 @@ var _ = Describe("PurgeServiceInstance", func() {
 				Expect(actualRequirements).To(ContainElement(minAPIVersionRequirement))
 			})
 		})
+
+		Context("when --json is provided without --dry-run", func() {
+			BeforeEach(func() {
+				flagContext.Parse("service-instance", "--json")
+			})
+
+			It("fails with usage", func() {
+				_, err := cmd.Requirements(factory, flagContext)
+				Expect(err).To(HaveOccurred())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Incorrect Usage. The --json flag can only be used with --dry-run"},
+				))
+			})
+		})
 	})
 
 	Describe("Execute", func() {
@@ -187,6 +207,106 @@ var _ = Describe("PurgeServiceInstance", func() {
 			})
 		})
 
+		Context("when the instance has bindings and service keys", func() {
+			BeforeEach(func() {
+				serviceInstance := models.ServiceInstance{}
+				serviceInstance.Name = "service-instance-name"
+				serviceInstance.GUID = "service-instance-guid"
+				serviceInstance.ServiceBindings = []models.ServiceBindingFields{
+					{GUID: "binding-1-guid", AppGUID: "app-1-guid"},
+					{GUID: "binding-2-guid", AppGUID: "app-2-guid"},
+				}
+				serviceInstance.ServiceKeys = []models.ServiceKeyFields{{GUID: "key-guid", Name: "key-name"}}
+				serviceRepo.FindInstanceByNameReturns(serviceInstance, nil)
+
+				appRepo.GetAppStub = func(appGUID string) (models.Application, error) {
+					if appGUID == "app-2-guid" {
+						return models.Application{}, cferrors.NewHTTPError(http.StatusNotFound, "CF-AppNotFound", "not found")
+					}
+					app := models.Application{}
+					app.Name = "app-1"
+					return app, nil
+				}
+			})
+
+			It("displays what will be removed before asking to proceed", func() {
+				ui.Inputs = []string{"n"}
+				Expect(cmd.Execute(flagContext)).To(Succeed())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"The following will be removed from Cloud Foundry's database:"},
+					[]string{"service instances: 1"},
+					[]string{"service bindings: 2"},
+					[]string{"service keys: 1"},
+					[]string{"service instance", "bound apps", "service keys"},
+					[]string{"service-instance-name", "app-1, app-2-guid", "1"},
+					[]string{"WARNING"},
+				))
+				Expect(ui.Prompts).To(ContainSubstrings(
+					[]string{"Really purge service instance service-instance-name from Cloud Foundry?"},
+				))
+			})
+
+			Context("when looking up a bound app fails", func() {
+				BeforeEach(func() {
+					appRepo.GetAppStub = nil
+					appRepo.GetAppReturns(models.Application{}, errors.New("app-error"))
+				})
+
+				It("returns the error without purging", func() {
+					ui.Inputs = []string{"y"}
+					Expect(cmd.Execute(flagContext)).To(MatchError("app-error"))
+					Expect(serviceRepo.PurgeServiceInstanceCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when --dry-run is set", func() {
+				BeforeEach(func() {
+					err := flagContext.Parse("service-instance-name", "--dry-run")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("displays the report without purging or prompting", func() {
+					Expect(cmd.Execute(flagContext)).To(Succeed())
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"service bindings: 2"},
+						[]string{"service-instance-name", "app-1, app-2-guid", "1"},
+					))
+					Expect(ui.Prompts).To(BeEmpty())
+					Expect(serviceRepo.PurgeServiceInstanceCallCount()).To(BeZero())
+				})
+
+				Context("when --json is set", func() {
+					BeforeEach(func() {
+						err := flagContext.Parse("service-instance-name", "--dry-run", "--json")
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("displays the report as JSON", func() {
+						Expect(cmd.Execute(flagContext)).To(Succeed())
+
+						var report map[string]interface{}
+						Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &report)).To(Succeed())
+						Expect(report).To(Equal(map[string]interface{}{
+							"service_instance_count": float64(1),
+							"service_binding_count":  float64(2),
+							"service_key_count":      float64(1),
+							"service_instances": []interface{}{
+								map[string]interface{}{
+									"name":              "service-instance-name",
+									"guid":              "service-instance-guid",
+									"bound_apps":        []interface{}{"app-1", "app-2-guid"},
+									"service_key_count": float64(1),
+								},
+							},
+						}))
+						Expect(serviceRepo.PurgeServiceInstanceCallCount()).To(BeZero())
+					})
+				})
+			})
+		})
+
 		Context("when the instance can not be found", func() {
 			BeforeEach(func() {
 				serviceRepo.FindInstanceByNameReturns(models.ServiceInstance{}, cferrors.NewModelNotFoundError("model-type", "model-name"))
@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/actors/purgereport"
 	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
@@ -15,8 +17,10 @@ import (
 )
 
 type PurgeServiceOffering struct {
-	ui          terminal.UI
-	serviceRepo api.ServiceRepository
+	ui              terminal.UI
+	serviceRepo     api.ServiceRepository
+	servicePlanRepo api.ServicePlanRepository
+	appRepo         applications.Repository
 }
 
 func init() {
@@ -27,12 +31,14 @@ func (cmd *PurgeServiceOffering) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force deletion without confirmation")}
 	fs["p"] = &flags.StringFlag{ShortName: "p", Usage: T("Provider")}
+	fs["dry-run"] = &flags.BoolFlag{Name: "dry-run", Usage: T("List the records that would be removed without purging them")}
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Output the dry run report as JSON")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "purge-service-offering",
 		Description: T("Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker"),
 		Usage: []string{
-			T("CF_NAME purge-service-offering SERVICE [-p PROVIDER]"),
+			T("CF_NAME purge-service-offering SERVICE [-p PROVIDER] [--dry-run [--json]]"),
 			"\n\n",
 			scaryWarningMessage(),
 		},
@@ -46,6 +52,11 @@ func (cmd *PurgeServiceOffering) Requirements(requirementsFactory requirements.F
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
 	}
 
+	if fc.Bool("json") && !fc.Bool("dry-run") {
+		cmd.ui.Failed(T("Incorrect Usage. The --json flag can only be used with --dry-run\n\n") + commandregistry.Commands.CommandUsage("purge-service-offering"))
+		return nil, fmt.Errorf("Incorrect usage: --json requires --dry-run")
+	}
+
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewLoginRequirement(),
 	}
@@ -60,6 +71,8 @@ func (cmd *PurgeServiceOffering) Requirements(requirementsFactory requirements.F
 func (cmd *PurgeServiceOffering) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
 	cmd.ui = deps.UI
 	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
+	cmd.servicePlanRepo = deps.RepoLocator.GetServicePlanRepository()
+	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
 	return cmd
 }
 
@@ -93,7 +106,28 @@ func (cmd *PurgeServiceOffering) Execute(c flags.FlagContext) error {
 		offering = offerings[0]
 	}
 
+	dryRun := c.Bool("dry-run")
 	confirmed := c.Bool("f")
+	if dryRun || !confirmed {
+		report, err := cmd.buildReport(offering)
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			if c.Bool("json") {
+				return purgereport.PrintJSON(cmd.ui, report)
+			}
+			return purgereport.Print(cmd.ui, report)
+		}
+
+		err = purgereport.Print(cmd.ui, report)
+		if err != nil {
+			return err
+		}
+		cmd.ui.Say("")
+	}
+
 	if !confirmed {
 		cmd.ui.Warn(scaryWarningMessage())
 		confirmed = cmd.ui.Confirm(T("Really purge service offering {{.ServiceName}} from Cloud Foundry?",
@@ -115,3 +149,23 @@ func (cmd *PurgeServiceOffering) Execute(c flags.FlagContext) error {
 	cmd.ui.Ok()
 	return nil
 }
+
+// buildReport gathers the instances of every plan of the offering, which
+// are purged along with it.
+func (cmd *PurgeServiceOffering) buildReport(offering models.ServiceOffering) (purgereport.Report, error) {
+	plans, err := cmd.servicePlanRepo.Search(map[string]string{"service_guid": offering.GUID})
+	if err != nil {
+		return purgereport.Report{}, err
+	}
+
+	var instances []models.ServiceInstance
+	for _, plan := range plans {
+		planInstances, err := cmd.serviceRepo.ListServiceInstancesForServicePlan(plan.GUID)
+		if err != nil {
+			return purgereport.Report{}, err
+		}
+		instances = append(instances, planInstances...)
+	}
+
+	return purgereport.Build(instances, cmd.appRepo)
+}
@@ -1,6 +1,10 @@
 package service_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands/service"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -11,6 +15,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
 
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/applications/applicationsfakes"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
 	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
 
@@ -24,6 +29,8 @@ var _ = Describe("PurgeServiceOffering", func() {
 		ui          *testterm.FakeUI
 		configRepo  coreconfig.Repository
 		serviceRepo *apifakes.FakeServiceRepository
+		planRepo    *apifakes.FakeServicePlanRepository
+		appRepo     *applicationsfakes.FakeRepository
 
 		cmd         commandregistry.Command
 		deps        commandregistry.Dependency
@@ -38,7 +45,11 @@ var _ = Describe("PurgeServiceOffering", func() {
 		ui = &testterm.FakeUI{}
 		configRepo = testconfig.NewRepositoryWithDefaults()
 		serviceRepo = new(apifakes.FakeServiceRepository)
-		repoLocator := deps.RepoLocator.SetServiceRepository(serviceRepo)
+		planRepo = new(apifakes.FakeServicePlanRepository)
+		appRepo = new(applicationsfakes.FakeRepository)
+		repoLocator := deps.RepoLocator.SetServiceRepository(serviceRepo).
+			SetServicePlanRepository(planRepo).
+			SetApplicationRepository(appRepo)
 
 		deps = commandregistry.Dependency{
 			UI:          ui,
@@ -186,6 +197,112 @@ var _ = Describe("PurgeServiceOffering", func() {
 					Expect(serviceRepo.PurgeServiceOfferingCallCount()).To(BeZero())
 				})
 			})
+
+			Context("when the offering has service instances", func() {
+				BeforeEach(func() {
+					planRepo.SearchReturns([]models.ServicePlanFields{{GUID: "plan-1-guid"}, {GUID: "plan-2-guid"}}, nil)
+					serviceRepo.ListServiceInstancesForServicePlanStub = func(planGUID string) ([]models.ServiceInstance, error) {
+						if planGUID == "plan-1-guid" {
+							instance := models.ServiceInstance{}
+							instance.Name = "instance-1"
+							instance.ServiceBindings = []models.ServiceBindingFields{{AppGUID: "app-guid"}}
+							instance.ServiceKeys = []models.ServiceKeyFields{{Name: "key-1"}, {Name: "key-2"}}
+							return []models.ServiceInstance{instance}, nil
+						}
+						instance := models.ServiceInstance{}
+						instance.Name = "instance-2"
+						instance.ServiceBindings = []models.ServiceBindingFields{{AppGUID: "app-guid"}}
+						return []models.ServiceInstance{instance}, nil
+					}
+					app := models.Application{}
+					app.Name = "my-app"
+					appRepo.GetAppReturns(app, nil)
+				})
+
+				It("displays what will be removed before asking to proceed", func() {
+					Expect(runCLIErr).NotTo(HaveOccurred())
+
+					Expect(planRepo.SearchArgsForCall(0)).To(Equal(map[string]string{"service_guid": "service-offering-guid"}))
+					Expect(serviceRepo.ListServiceInstancesForServicePlanArgsForCall(0)).To(Equal("plan-1-guid"))
+					Expect(serviceRepo.ListServiceInstancesForServicePlanArgsForCall(1)).To(Equal("plan-2-guid"))
+					Expect(appRepo.GetAppCallCount()).To(Equal(1))
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"service instances: 2"},
+						[]string{"service bindings: 2"},
+						[]string{"service keys: 2"},
+						[]string{"instance-1", "my-app", "2"},
+						[]string{"instance-2", "my-app", "0"},
+						[]string{"WARNING"},
+					))
+					Expect(ui.Prompts).To(ContainSubstrings([]string{"Really purge service offering service-name from Cloud Foundry?"}))
+				})
+
+				Context("when listing the instances fails", func() {
+					BeforeEach(func() {
+						serviceRepo.ListServiceInstancesForServicePlanStub = nil
+						serviceRepo.ListServiceInstancesForServicePlanReturns(nil, errors.New("list-error"))
+					})
+
+					It("fails with error", func() {
+						Expect(runCLIErr).To(MatchError("list-error"))
+						Expect(serviceRepo.PurgeServiceOfferingCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when --dry-run is set", func() {
+				BeforeEach(func() {
+					err := flagContext.Parse("service-name", "--dry-run")
+					Expect(err).NotTo(HaveOccurred())
+
+					var instances []models.ServiceInstance
+					for i := 0; i < 52; i++ {
+						instance := models.ServiceInstance{}
+						instance.Name = fmt.Sprintf("instance-%d", i)
+						instance.ServiceKeys = []models.ServiceKeyFields{{Name: "key"}}
+						instances = append(instances, instance)
+					}
+					planRepo.SearchReturns([]models.ServicePlanFields{{GUID: "plan-guid"}}, nil)
+					serviceRepo.ListServiceInstancesForServicePlanReturns(instances, nil)
+				})
+
+				It("lists the first 50 instances and counts the rest without purging", func() {
+					Expect(runCLIErr).NotTo(HaveOccurred())
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"service instances: 52"},
+						[]string{"service keys: 52"},
+						[]string{"instance-49"},
+						[]string{"... and 2 more service instances"},
+					))
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"instance-50"}))
+					Expect(ui.Prompts).To(BeEmpty())
+					Expect(serviceRepo.PurgeServiceOfferingCallCount()).To(BeZero())
+				})
+
+				Context("when --json is set", func() {
+					BeforeEach(func() {
+						err := flagContext.Parse("service-name", "--dry-run", "--json")
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("displays the report as JSON", func() {
+						Expect(runCLIErr).NotTo(HaveOccurred())
+
+						var report struct {
+							ServiceInstanceCount int           `json:"service_instance_count"`
+							ServiceKeyCount      int           `json:"service_key_count"`
+							ServiceInstances     []interface{} `json:"service_instances"`
+						}
+						Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &report)).To(Succeed())
+						Expect(report.ServiceInstanceCount).To(Equal(52))
+						Expect(report.ServiceKeyCount).To(Equal(52))
+						Expect(report.ServiceInstances).To(HaveLen(50))
+						Expect(serviceRepo.PurgeServiceOfferingCallCount()).To(BeZero())
+					})
+				})
+			})
 		})
 
 		Context("when finding the service offering fails with an error other than 404", func() {
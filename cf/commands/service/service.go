@@ -1,9 +1,13 @@
 package service
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
+	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/flags"
@@ -20,6 +24,8 @@ type ShowService struct {
 	pluginModel        *plugin_models.GetService_Model
 	pluginCall         bool
 	appRepo            applications.Repository
+	upsiRepo           api.UserProvidedServiceInstanceRepository
+	serviceRepo        api.ServiceRepository
 }
 
 func init() {
@@ -29,13 +35,15 @@ func init() {
 func (cmd *ShowService) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["guid"] = &flags.BoolFlag{Name: "guid", Usage: T("Retrieve and display the given service's guid.  All other output for the service is suppressed.")}
+	fs["show-credentials"] = &flags.BoolFlag{Name: "show-credentials", Usage: T("Display the credential values of a user-provided service instance")}
+	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force display of credentials without confirmation")}
 	T("user-provided")
 
 	return commandregistry.CommandMetadata{
 		Name:        "service",
 		Description: T("Show service instance info"),
 		Usage: []string{
-			T("CF_NAME service SERVICE_INSTANCE"),
+			T("CF_NAME service SERVICE_INSTANCE [--guid] [--show-credentials [-f]]"),
 		},
 		Flags: fs,
 	}
@@ -64,6 +72,8 @@ func (cmd *ShowService) SetDependency(deps commandregistry.Dependency, pluginCal
 	cmd.pluginCall = pluginCall
 	cmd.pluginModel = deps.PluginModels.Service
 	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
+	cmd.upsiRepo = deps.RepoLocator.GetUserProvidedServiceInstanceRepository()
+	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
 
 	return cmd
 }
@@ -92,14 +102,10 @@ func (cmd *ShowService) Execute(c flags.FlagContext) error {
 		cmd.ui.Say(T("Service instance: {{.ServiceName}}", map[string]interface{}{"ServiceName": terminal.EntityNameColor(serviceInstance.Name)}))
 
 		if serviceInstance.IsUserProvided() {
-			cmd.ui.Say(T("Service: {{.ServiceDescription}}",
-				map[string]interface{}{
-					"ServiceDescription": terminal.EntityNameColor(T("user-provided")),
-				}))
-			cmd.ui.Say(T("Bound apps: {{.BoundApplications}}",
-				map[string]interface{}{
-					"BoundApplications": terminal.EntityNameColor(strings.Join(boundApps, ",")),
-				}))
+			err := cmd.displayUserProvidedService(serviceInstance, boundApps, c.Bool("show-credentials"), c.Bool("f"))
+			if err != nil {
+				return err
+			}
 		} else {
 			cmd.ui.Say(T("Service: {{.ServiceDescription}}",
 				map[string]interface{}{
@@ -126,6 +132,10 @@ func (cmd *ShowService) Execute(c flags.FlagContext) error {
 				map[string]interface{}{
 					"URL": terminal.EntityNameColor(serviceInstance.DashboardURL),
 				}))
+			err := cmd.displaySharing(serviceInstance)
+			if err != nil {
+				return err
+			}
 			cmd.ui.Say("")
 			cmd.ui.Say(T("Last Operation"))
 			cmd.ui.Say(T("Status: {{.State}}",
@@ -151,6 +161,99 @@ func (cmd *ShowService) Execute(c flags.FlagContext) error {
 	return nil
 }
 
+func (cmd *ShowService) displayUserProvidedService(serviceInstance models.ServiceInstance, boundApps []string, showCredentials bool, force bool) error {
+	userProvidedService, err := cmd.upsiRepo.Get(serviceInstance.GUID)
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say(T("Service: {{.ServiceDescription}}",
+		map[string]interface{}{
+			"ServiceDescription": terminal.EntityNameColor(T("user-provided")),
+		}))
+	cmd.ui.Say(T("Bound apps: {{.BoundApplications}}",
+		map[string]interface{}{
+			"BoundApplications": terminal.EntityNameColor(strings.Join(boundApps, ",")),
+		}))
+	cmd.ui.Say(T("Tags: {{.Tags}}",
+		map[string]interface{}{
+			"Tags": terminal.EntityNameColor(strings.Join(userProvidedService.Tags, ", ")),
+		}))
+	cmd.ui.Say(T("Syslog drain URL: {{.URL}}",
+		map[string]interface{}{
+			"URL": terminal.EntityNameColor(userProvidedService.SysLogDrainURL),
+		}))
+	cmd.ui.Say(T("Route service URL: {{.URL}}",
+		map[string]interface{}{
+			"URL": terminal.EntityNameColor(userProvidedService.RouteServiceURL),
+		}))
+
+	credentialKeys := make([]string, 0, len(userProvidedService.Credentials))
+	for key := range userProvidedService.Credentials {
+		credentialKeys = append(credentialKeys, key)
+	}
+	sort.Strings(credentialKeys)
+	cmd.ui.Say(T("Credential keys: {{.Keys}}",
+		map[string]interface{}{
+			"Keys": terminal.EntityNameColor(strings.Join(credentialKeys, ", ")),
+		}))
+
+	if !showCredentials {
+		return nil
+	}
+
+	if !force && !cmd.ui.Confirm(T("The credentials of this service instance may contain secrets. Really display them?")) {
+		return nil
+	}
+
+	credentials, err := json.MarshalIndent(userProvidedService.Credentials, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Credentials:"))
+	cmd.ui.Say(string(credentials))
+	return nil
+}
+
+// displaySharing shows the space a service instance was shared from when it
+// was shared into the targeted space, and otherwise the spaces it has been
+// shared into.
+func (cmd *ShowService) displaySharing(serviceInstance models.ServiceInstance) error {
+	sharedFrom, err := cmd.serviceRepo.GetServiceInstanceSharedFrom(serviceInstance.GUID)
+	if err != nil {
+		return err
+	}
+
+	if sharedFrom.SpaceGUID != "" {
+		cmd.ui.Say("")
+		cmd.ui.Say(T("This service instance is shared from org {{.OrgName}} / space {{.SpaceName}}.",
+			map[string]interface{}{
+				"OrgName":   terminal.EntityNameColor(sharedFrom.OrganizationName),
+				"SpaceName": terminal.EntityNameColor(sharedFrom.SpaceName),
+			}))
+		return nil
+	}
+
+	sharedTos, err := cmd.serviceRepo.GetServiceInstanceSharedTos(serviceInstance.GUID)
+	if err != nil {
+		return err
+	}
+
+	if len(sharedTos) == 0 {
+		return nil
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Shared with spaces (this space is where the service instance was created):"))
+	table := cmd.ui.Table([]string{T("org"), T("space"), T("bindings")})
+	for _, sharedTo := range sharedTos {
+		table.Add(sharedTo.OrganizationName, sharedTo.SpaceName, strconv.Itoa(sharedTo.BoundAppCount))
+	}
+	return table.Print()
+}
+
 func InstanceStateToStatus(operationType string, state string, isUserProvidedService bool) string {
 	if isUserProvidedService {
 		return ""
@@ -2,6 +2,7 @@ package service_test
 
 import (
 	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/commands/service"
 	"code.cloudfoundry.org/cli/cf/flags"
@@ -11,6 +12,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
 	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
 
+	"errors"
 	"fmt"
 
 	"code.cloudfoundry.org/cli/cf/api/applications/applicationsfakes"
@@ -29,6 +31,8 @@ var _ = Describe("service command", func() {
 		loginRequirement           requirements.Requirement
 		targetedSpaceRequirement   requirements.Requirement
 		serviceInstanceRequirement *requirementsfakes.FakeServiceInstanceRequirement
+		upsiRepo                   *apifakes.FakeUserProvidedServiceInstanceRepository
+		serviceRepo                *apifakes.FakeServiceRepository
 		pluginCall                 bool
 
 		cmd *service.ShowService
@@ -50,10 +54,16 @@ var _ = Describe("service command", func() {
 			return models.Application{}, fmt.Errorf("Called stubbed applications repo GetApp with incorrect app GUID\nExpected \"app1-guid\"\nGot \"%s\"\n", appGUID)
 		}
 
+		upsiRepo = new(apifakes.FakeUserProvidedServiceInstanceRepository)
+		serviceRepo = new(apifakes.FakeServiceRepository)
+
 		deps = commandregistry.Dependency{
 			UI:           ui,
 			PluginModels: &commandregistry.PluginModels{},
-			RepoLocator:  api.RepositoryLocator{}.SetApplicationRepository(appRepo),
+			RepoLocator: api.RepositoryLocator{}.
+				SetApplicationRepository(appRepo).
+				SetUserProvidedServiceInstanceRepository(upsiRepo).
+				SetServiceRepository(serviceRepo),
 		}
 
 		cmd = &service.ShowService{}
@@ -114,7 +124,10 @@ var _ = Describe("service command", func() {
 	})
 
 	Describe("Execute", func() {
-		var serviceInstance models.ServiceInstance
+		var (
+			serviceInstance models.ServiceInstance
+			executeErr      error
+		)
 
 		BeforeEach(func() {
 			serviceInstance = models.ServiceInstance{
@@ -151,7 +164,7 @@ var _ = Describe("service command", func() {
 			serviceInstanceRequirement.GetServiceInstanceReturns(serviceInstance)
 			cmd.SetDependency(deps, pluginCall)
 			cmd.Requirements(reqFactory, flagContext)
-			cmd.Execute(flagContext)
+			executeErr = cmd.Execute(flagContext)
 		})
 
 		Context("when invoked by a plugin", func() {
@@ -207,6 +220,63 @@ var _ = Describe("service command", func() {
 					))
 				})
 
+				It("does not show sharing information when the service instance is not shared", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(serviceRepo.GetServiceInstanceSharedFromArgsForCall(0)).To(Equal("service1-guid"))
+					Expect(serviceRepo.GetServiceInstanceSharedTosArgsForCall(0)).To(Equal("service1-guid"))
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Shared with spaces"}))
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"shared from"}))
+				})
+
+				Context("when the service instance is shared with other spaces", func() {
+					BeforeEach(func() {
+						serviceRepo.GetServiceInstanceSharedTosReturns([]models.ServiceInstanceSharedTo{
+							{SpaceGUID: "space-guid-1", SpaceName: "space-1", OrganizationName: "org-1", BoundAppCount: 2},
+							{SpaceGUID: "space-guid-2", SpaceName: "space-2", OrganizationName: "org-2"},
+						}, nil)
+					})
+
+					It("lists the spaces it is shared with", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(ui.Outputs()).To(BeInDisplayOrder(
+							[]string{"Dashboard: ", "some-url"},
+							[]string{"Shared with spaces", "this space is where the service instance was created"},
+							[]string{"org", "space", "bindings"},
+							[]string{"org-1", "space-1", "2"},
+							[]string{"org-2", "space-2", "0"},
+							[]string{"Last Operation"},
+						))
+					})
+				})
+
+				Context("when the service instance is shared from another space", func() {
+					BeforeEach(func() {
+						serviceRepo.GetServiceInstanceSharedFromReturns(models.ServiceInstanceSharedFrom{
+							SpaceGUID:        "source-space-guid",
+							SpaceName:        "source-space",
+							OrganizationName: "source-org",
+						}, nil)
+					})
+
+					It("shows where the service instance was shared from", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"This service instance is shared from org source-org / space source-space."},
+						))
+						Expect(serviceRepo.GetServiceInstanceSharedTosCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when getting the sharing information fails", func() {
+					BeforeEach(func() {
+						serviceRepo.GetServiceInstanceSharedFromReturns(models.ServiceInstanceSharedFrom{}, errors.New("shared-from-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("shared-from-error"))
+					})
+				})
+
 				Context("when the service instance CreatedAt is empty", func() {
 					BeforeEach(func() {
 						serviceInstance.LastOperation.CreatedAt = ""
@@ -313,16 +383,92 @@ var _ = Describe("service command", func() {
 					},
 				}
 
-				err := flagContext.Parse("service1")
-				Expect(err).NotTo(HaveOccurred())
+				upsiRepo.GetReturns(models.UserProvidedService{
+					Name: "service1",
+					Credentials: map[string]interface{}{
+						"username": "admin",
+						"password": "some-secret",
+					},
+					SysLogDrainURL:  "syslog://example.com",
+					RouteServiceURL: "https://route.example.com",
+					Tags:            []string{"tag1", "tag2"},
+				}, nil)
 			})
 
-			It("shows user provided services", func() {
-				Expect(ui.Outputs()).To(ContainSubstrings(
-					[]string{"Service instance: ", "service1"},
-					[]string{"Service: ", "user-provided"},
-					[]string{"Bound apps: ", "app1"},
-				))
+			Context("when only the service name is specified", func() {
+				BeforeEach(func() {
+					err := flagContext.Parse("service1")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("fetches the user provided service instance", func() {
+					Expect(upsiRepo.GetCallCount()).To(Equal(1))
+					Expect(upsiRepo.GetArgsForCall(0)).To(Equal("service1-guid"))
+				})
+
+				It("shows user provided services", func() {
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Service instance: ", "service1"},
+						[]string{"Service: ", "user-provided"},
+						[]string{"Bound apps: ", "app1"},
+						[]string{"Tags: ", "tag1, tag2"},
+						[]string{"Syslog drain URL: ", "syslog://example.com"},
+						[]string{"Route service URL: ", "https://route.example.com"},
+						[]string{"Credential keys: ", "password, username"},
+					))
+				})
+
+				It("does not show the credential values", func() {
+					Expect(ui.Outputs()).ToNot(ContainSubstrings(
+						[]string{"some-secret"},
+					))
+				})
+			})
+
+			Context("when the --show-credentials flag is provided", func() {
+				BeforeEach(func() {
+					err := flagContext.Parse("service1", "--show-credentials")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				Context("when the user confirms", func() {
+					BeforeEach(func() {
+						ui.Inputs = []string{"y"}
+					})
+
+					It("shows the credential values", func() {
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"Credentials:"},
+							[]string{`"password": "some-secret"`},
+						))
+					})
+				})
+
+				Context("when the user does not confirm", func() {
+					BeforeEach(func() {
+						ui.Inputs = []string{"n"}
+					})
+
+					It("does not show the credential values", func() {
+						Expect(ui.Outputs()).ToNot(ContainSubstrings(
+							[]string{"some-secret"},
+						))
+					})
+				})
+			})
+
+			Context("when the --show-credentials and -f flags are provided", func() {
+				BeforeEach(func() {
+					err := flagContext.Parse("service1", "--show-credentials", "-f")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("shows the credential values without prompting", func() {
+					Expect(ui.Prompts).To(BeEmpty())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{`"password": "some-secret"`},
+					))
+				})
 			})
 		})
 
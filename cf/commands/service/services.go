@@ -1,15 +1,19 @@
 package service
 
 import (
+	"encoding/json"
 	"strings"
 
+	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/plugin/models"
 
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
@@ -18,22 +22,49 @@ type ListServices struct {
 	ui                 terminal.UI
 	config             coreconfig.Reader
 	serviceSummaryRepo api.ServiceSummaryRepository
+	brokerResolver     *actors.ServiceBrokerNameResolver
 	pluginModel        *[]plugin_models.GetServices_Model
 	pluginCall         bool
 }
 
+type serviceInstanceJSON struct {
+	Name             string   `json:"name"`
+	GUID             string   `json:"guid"`
+	Service          string   `json:"service"`
+	Plan             string   `json:"plan"`
+	Broker           string   `json:"broker"`
+	BoundApps        []string `json:"bound_apps"`
+	LastOperation    string   `json:"last_operation"`
+	UpgradeAvailable bool     `json:"upgrade_available"`
+	UpdatedAt        string   `json:"updated_at"`
+}
+
+// serviceFields are the columns --fields can select, in the order they are
+// listed in errors. The default columns are the first six.
+var serviceFields = []string{"name", "service", "plan", "bound_apps", "last_operation", "upgrade_available", "broker", "updated_at"}
+
+var defaultServiceFields = serviceFields[:6]
+
 func init() {
 	commandregistry.Register(&ListServices{})
 }
 
 func (cmd *ListServices) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["fields"] = &flags.StringFlag{Name: "fields", Usage: T("Comma-separated columns to display: {{.Fields}}", map[string]interface{}{"Fields": strings.Join(serviceFields, ", ")})}
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Output all fields of the service instances as JSON")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "services",
 		ShortName:   "s",
 		Description: T("List all service instances in the target space"),
 		Usage: []string{
-			"CF_NAME services",
+			T("CF_NAME services [--fields FIELD,...] [--json]"),
+		},
+		Examples: []string{
+			"CF_NAME services --fields name,plan,broker,updated_at",
 		},
+		Flags: fs,
 	}
 }
 
@@ -58,12 +89,22 @@ func (cmd *ListServices) SetDependency(deps commandregistry.Dependency, pluginCa
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.serviceSummaryRepo = deps.RepoLocator.GetServiceSummaryRepository()
+	cmd.brokerResolver = actors.NewServiceBrokerNameResolver(cmd.config, deps.RepoLocator.GetServiceRepository(), deps.RepoLocator.GetServiceBrokerRepository())
 	cmd.pluginModel = deps.PluginModels.Services
 	cmd.pluginCall = pluginCall
 	return cmd
 }
 
 func (cmd *ListServices) Execute(fc flags.FlagContext) error {
+	fields, err := parseServiceFields(fc.String("fields"))
+	if err != nil {
+		return err
+	}
+
+	if fc.Bool("json") {
+		return cmd.listServicesJSON()
+	}
+
 	cmd.ui.Say(T("Getting services in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
 		map[string]interface{}{
 			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
@@ -77,6 +118,17 @@ func (cmd *ListServices) Execute(fc flags.FlagContext) error {
 		return err
 	}
 
+	var brokerNames map[string]string
+	for _, field := range fields {
+		if field == "broker" {
+			brokerNames, err = cmd.brokerResolver.BrokerNames(serviceInstances)
+			if err != nil {
+				return err
+			}
+			break
+		}
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
@@ -85,26 +137,20 @@ func (cmd *ListServices) Execute(fc flags.FlagContext) error {
 		return nil
 	}
 
-	table := cmd.ui.Table([]string{T("name"), T("service"), T("plan"), T("bound apps"), T("last operation")})
+	headers := make([]string, 0, len(fields))
+	for _, field := range fields {
+		headers = append(headers, serviceFieldHeader(field))
+	}
+	table := cmd.ui.Table(headers)
 
 	for _, instance := range serviceInstances {
-		var serviceColumn string
-		var serviceStatus string
-
-		if instance.IsUserProvided() {
-			serviceColumn = T("user-provided")
-		} else {
-			serviceColumn = instance.ServiceOffering.Label
+		columns := serviceInstanceColumns(instance, brokerNames)
+		row := make([]string, 0, len(fields))
+		for _, field := range fields {
+			row = append(row, columns[field])
 		}
-		serviceStatus = InstanceStateToStatus(instance.LastOperation.Type, instance.LastOperation.State, instance.IsUserProvided())
-
-		table.Add(
-			instance.Name,
-			serviceColumn,
-			instance.ServicePlan.Name,
-			strings.Join(instance.ApplicationNames, ", "),
-			serviceStatus,
-		)
+		table.Add(row...)
+
 		if cmd.pluginCall {
 			s := plugin_models.GetServices_Model{
 				Name: instance.Name,
@@ -135,3 +181,118 @@ func (cmd *ListServices) Execute(fc flags.FlagContext) error {
 	}
 	return nil
 }
+
+func (cmd *ListServices) listServicesJSON() error {
+	serviceInstances, err := cmd.serviceSummaryRepo.GetSummariesInCurrentSpace()
+	if err != nil {
+		return err
+	}
+
+	brokerNames, err := cmd.brokerResolver.BrokerNames(serviceInstances)
+	if err != nil {
+		return err
+	}
+
+	instancesJSON := make([]serviceInstanceJSON, 0, len(serviceInstances))
+	for _, instance := range serviceInstances {
+		columns := serviceInstanceColumns(instance, brokerNames)
+		boundApps := instance.ApplicationNames
+		if boundApps == nil {
+			boundApps = []string{}
+		}
+
+		instancesJSON = append(instancesJSON, serviceInstanceJSON{
+			Name:             instance.Name,
+			GUID:             instance.GUID,
+			Service:          columns["service"],
+			Plan:             columns["plan"],
+			Broker:           columns["broker"],
+			BoundApps:        boundApps,
+			LastOperation:    columns["last_operation"],
+			UpgradeAvailable: instance.UpgradeAvailable(),
+			UpdatedAt:        columns["updated_at"],
+		})
+	}
+
+	jsonBytes, err := json.MarshalIndent(instancesJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say(string(jsonBytes))
+	return nil
+}
+
+// parseServiceFields returns the columns selected with --fields, or the
+// default columns when the flag is not given.
+func parseServiceFields(fieldsFlag string) ([]string, error) {
+	if fieldsFlag == "" {
+		return defaultServiceFields, nil
+	}
+
+	var fields []string
+	for _, field := range strings.Split(fieldsFlag, ",") {
+		field = strings.TrimSpace(field)
+		if !isServiceField(field) {
+			return nil, errors.New(T("Invalid field '{{.Field}}'. Valid fields are: {{.ValidFields}}",
+				map[string]interface{}{
+					"Field":       field,
+					"ValidFields": strings.Join(serviceFields, ", "),
+				}))
+		}
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+func isServiceField(field string) bool {
+	for _, validField := range serviceFields {
+		if field == validField {
+			return true
+		}
+	}
+	return false
+}
+
+func serviceFieldHeader(field string) string {
+	switch field {
+	case "bound_apps":
+		return T("bound apps")
+	case "last_operation":
+		return T("last operation")
+	case "upgrade_available":
+		return T("upgrade available")
+	case "updated_at":
+		return T("updated at")
+	default:
+		return T(field)
+	}
+}
+
+func serviceInstanceColumns(instance models.ServiceInstance, brokerNames map[string]string) map[string]string {
+	serviceColumn := instance.ServiceOffering.Label
+	if instance.IsUserProvided() {
+		serviceColumn = T("user-provided")
+	}
+
+	var upgradeAvailableColumn string
+	if instance.ServicePlan.MaintenanceVersion != "" {
+		if instance.UpgradeAvailable() {
+			upgradeAvailableColumn = T("yes")
+		} else {
+			upgradeAvailableColumn = T("no")
+		}
+	}
+
+	return map[string]string{
+		"name":              instance.Name,
+		"service":           serviceColumn,
+		"plan":              instance.ServicePlan.Name,
+		"bound_apps":        strings.Join(instance.ApplicationNames, ", "),
+		"last_operation":    InstanceStateToStatus(instance.LastOperation.Type, instance.LastOperation.State, instance.IsUserProvided()),
+		"upgrade_available": upgradeAvailableColumn,
+		"broker":            brokerNames[instance.ServiceOffering.GUID],
+		"updated_at":        instance.LastOperation.UpdatedAt,
+	}
+}
@@ -1,7 +1,9 @@
 package service_test
 
 import (
+	"encoding/json"
 	"os"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -30,6 +32,8 @@ var _ = Describe("services", func() {
 		configRepo          coreconfig.Repository
 		requirementsFactory *requirementsfakes.FakeFactory
 		serviceSummaryRepo  *apifakes.OldFakeServiceSummaryRepo
+		serviceRepo         *apifakes.FakeServiceRepository
+		brokerRepo          *apifakes.FakeServiceBrokerRepository
 		deps                commandregistry.Dependency
 	)
 
@@ -37,6 +41,8 @@ var _ = Describe("services", func() {
 		deps.UI = ui
 		deps.Config = configRepo
 		deps.RepoLocator = deps.RepoLocator.SetServiceSummaryRepository(serviceSummaryRepo)
+		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
+		deps.RepoLocator = deps.RepoLocator.SetServiceBrokerRepository(brokerRepo)
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("services").SetDependency(deps, pluginCall))
 	}
 
@@ -48,6 +54,8 @@ var _ = Describe("services", func() {
 		ui = &testterm.FakeUI{}
 		configRepo = testconfig.NewRepositoryWithDefaults()
 		serviceSummaryRepo = new(apifakes.OldFakeServiceSummaryRepo)
+		serviceRepo = new(apifakes.FakeServiceRepository)
+		brokerRepo = new(apifakes.FakeServiceBrokerRepository)
 		targetedOrgRequirement := new(requirementsfakes.FakeTargetedOrgRequirement)
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
@@ -152,6 +160,123 @@ var _ = Describe("services", func() {
 		))
 	})
 
+	Describe("selecting fields", func() {
+		BeforeEach(func() {
+			serviceInstance := models.ServiceInstance{}
+			serviceInstance.Name = "my-service-1"
+			serviceInstance.GUID = "my-service-1-guid"
+			serviceInstance.LastOperation.Type = "create"
+			serviceInstance.LastOperation.State = "succeeded"
+			serviceInstance.LastOperation.UpdatedAt = "2017-06-01T10:00:00Z"
+			serviceInstance.ServicePlan = models.ServicePlanFields{GUID: "spark-guid", Name: "spark"}
+			serviceInstance.ApplicationNames = []string{"cli1", "cli2"}
+			serviceInstance.ServiceOffering = models.ServiceOfferingFields{GUID: "cleardb-guid", Label: "cleardb"}
+
+			userProvidedServiceInstance := models.ServiceInstance{}
+			userProvidedServiceInstance.Name = "my-service-provided-by-user"
+
+			serviceSummaryRepo.GetSummariesInCurrentSpaceInstances = []models.ServiceInstance{serviceInstance, userProvidedServiceInstance}
+
+			serviceRepo.GetServiceOfferingsForSpaceReturns(models.ServiceOfferings{
+				{ServiceOfferingFields: models.ServiceOfferingFields{GUID: "cleardb-guid", BrokerGUID: "my-broker-guid"}},
+			}, nil)
+			brokerRepo.ListServiceBrokersStub = func(callback func(models.ServiceBroker) bool) error {
+				callback(models.ServiceBroker{GUID: "my-broker-guid", Name: "my-broker"})
+				return nil
+			}
+		})
+
+		It("does not look up brokers for the default columns", func() {
+			runCommand()
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"name", "service", "plan", "bound apps", "last operation"},
+				[]string{"my-service-1", "cleardb", "spark", "cli1, cli2", "create succeeded"},
+			))
+			Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"broker"}))
+			Expect(brokerRepo.ListServiceBrokersCallCount()).To(Equal(0))
+		})
+
+		It("displays only the selected columns in the given order", func() {
+			Expect(runCommand("--fields", "name,broker,updated_at,plan")).To(BeTrue())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"name", "broker", "updated at", "plan"},
+				[]string{"my-service-1", "my-broker", "2017-06-01T10:00:00Z", "spark"},
+				[]string{"my-service-provided-by-user"},
+			))
+			Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"cli1, cli2"}))
+			Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"last operation"}))
+		})
+
+		It("fails with the valid fields when a field is unknown", func() {
+			Expect(runCommand("--fields", "name,color")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Invalid field 'color'. Valid fields are: name, service, plan, bound_apps, last_operation, upgrade_available, broker, updated_at"},
+			))
+			Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Getting services"}))
+		})
+
+		It("outputs all fields as JSON regardless of --fields", func() {
+			Expect(runCommand("--json", "--fields", "name")).To(BeTrue())
+			Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Getting services"}))
+
+			var instances []map[string]interface{}
+			Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &instances)).To(Succeed())
+			Expect(instances).To(HaveLen(2))
+			Expect(instances[0]).To(Equal(map[string]interface{}{
+				"name":              "my-service-1",
+				"guid":              "my-service-1-guid",
+				"service":           "cleardb",
+				"plan":              "spark",
+				"broker":            "my-broker",
+				"bound_apps":        []interface{}{"cli1", "cli2"},
+				"last_operation":    "create succeeded",
+				"upgrade_available": false,
+				"updated_at":        "2017-06-01T10:00:00Z",
+			}))
+			Expect(instances[1]["service"]).To(Equal("user-provided"))
+			Expect(instances[1]["bound_apps"]).To(BeEmpty())
+		})
+	})
+
+	Describe("upgrade available", func() {
+		BeforeEach(func() {
+			upToDate := models.ServiceInstance{}
+			upToDate.Name = "up-to-date-service"
+			upToDate.MaintenanceVersion = "2.0.0"
+			upToDate.ServicePlan = models.ServicePlanFields{GUID: "plan-guid", Name: "spark", MaintenanceVersion: "2.0.0"}
+
+			outdated := models.ServiceInstance{}
+			outdated.Name = "outdated-service"
+			outdated.MaintenanceVersion = "1.0.0"
+			outdated.ServicePlan = models.ServicePlanFields{GUID: "plan-guid", Name: "spark", MaintenanceVersion: "2.0.0"}
+
+			userProvided := models.ServiceInstance{}
+			userProvided.Name = "my-service-provided-by-user"
+
+			serviceSummaryRepo.GetSummariesInCurrentSpaceInstances = []models.ServiceInstance{upToDate, outdated, userProvided}
+		})
+
+		It("displays whether an upgrade is available for each service instance", func() {
+			Expect(runCommand()).To(BeTrue())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"name", "service", "plan", "bound apps", "last operation", "upgrade available"},
+				[]string{"up-to-date-service", "spark", "no"},
+				[]string{"outdated-service", "spark", "yes"},
+			))
+		})
+
+		It("includes upgrade availability in the JSON output", func() {
+			Expect(runCommand("--json")).To(BeTrue())
+
+			var instances []map[string]interface{}
+			Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &instances)).To(Succeed())
+			Expect(instances[0]["upgrade_available"]).To(BeFalse())
+			Expect(instances[1]["upgrade_available"]).To(BeTrue())
+			Expect(instances[2]["upgrade_available"]).To(BeFalse())
+		})
+	})
+
 	It("lists no services when none are found", func() {
 		serviceInstances := []models.ServiceInstance{}
 		serviceSummaryRepo.GetSummariesInCurrentSpaceInstances = serviceInstances
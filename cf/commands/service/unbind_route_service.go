@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/cf/flagcontext"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -40,16 +41,21 @@ func (cmd *UnbindRouteService) MetaData() commandregistry.CommandMetadata {
 	fs["hostname"] = &flags.StringFlag{Name: "hostname", ShortName: "n", Usage: T("Hostname used in combination with DOMAIN to specify the route to unbind")}
 	fs["path"] = &flags.StringFlag{Name: "path", Usage: T("Path for HTTP route")}
 	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force unbinding without confirmation")}
+	fs["parameters"] = &flags.StringFlag{
+		ShortName: "c",
+		Usage:     T("Valid JSON object containing service-specific configuration parameters for unbinding, provided inline or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."),
+	}
 
 	return commandregistry.CommandMetadata{
 		Name:        "unbind-route-service",
 		ShortName:   "urs",
 		Description: T("Unbind a service instance from an HTTP route"),
 		Usage: []string{
-			T("CF_NAME unbind-route-service DOMAIN SERVICE_INSTANCE [--hostname HOSTNAME] [--path PATH] [-f]"),
+			T("CF_NAME unbind-route-service DOMAIN SERVICE_INSTANCE [--hostname HOSTNAME] [--path PATH] [-c PARAMETERS_AS_JSON] [-f]"),
 		},
 		Examples: []string{
 			"CF_NAME unbind-route-service example.com myratelimiter --hostname myapp --path foo",
+			`CF_NAME unbind-route-service example.com myratelimiter -c '{"valid":"json"}'`,
 		},
 		Flags: fs,
 	}
@@ -99,6 +105,15 @@ func (cmd *UnbindRouteService) Execute(c flags.FlagContext) error {
 		path = fmt.Sprintf("/%s", path)
 	}
 
+	var parameters string
+	if c.IsSet("parameters") {
+		jsonBytes, err := flagcontext.GetContentsFromFlagValue(c.String("parameters"))
+		if err != nil {
+			return err
+		}
+		parameters = string(jsonBytes)
+	}
+
 	route, err := cmd.routeRepo.Find(host, domain, path, port)
 	if err != nil {
 		return err
@@ -128,7 +143,7 @@ func (cmd *UnbindRouteService) Execute(c flags.FlagContext) error {
 			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
 		}))
 
-	err = cmd.UnbindRoute(route, serviceInstance)
+	err = cmd.unbindRoute(route, serviceInstance, parameters)
 	if err != nil {
 		httpError, ok := err.(errors.HTTPError)
 		if ok && httpError.ErrorCode() == errors.InvalidRelation {
@@ -143,5 +158,9 @@ func (cmd *UnbindRouteService) Execute(c flags.FlagContext) error {
 }
 
 func (cmd *UnbindRouteService) UnbindRoute(route models.Route, serviceInstance models.ServiceInstance) error {
-	return cmd.routeServiceBindingRepo.Unbind(serviceInstance.GUID, route.GUID, serviceInstance.IsUserProvided())
+	return cmd.unbindRoute(route, serviceInstance, "")
+}
+
+func (cmd *UnbindRouteService) unbindRoute(route models.Route, serviceInstance models.ServiceInstance, parameters string) error {
+	return cmd.routeServiceBindingRepo.Unbind(serviceInstance.GUID, route.GUID, serviceInstance.IsUserProvided(), parameters)
 }
@@ -267,6 +267,24 @@ var _ = Describe("UnbindRouteService", func() {
 				It("tries to unbind the route service", func() {
 					Expect(runCLIErr).NotTo(HaveOccurred())
 					Expect(routeServiceBindingRepo.UnbindCallCount()).To(Equal(1))
+					_, routeGUID, _, parameters := routeServiceBindingRepo.UnbindArgsForCall(0)
+					Expect(routeGUID).To(Equal("route-guid"))
+					Expect(parameters).To(Equal(""))
+				})
+
+				Context("when given parameters as JSON", func() {
+					BeforeEach(func() {
+						flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+						err := flagContext.Parse("domain-name", "service-instance", "-c", `{"some":"json"}`)
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("unbinds the route service with the given parameters", func() {
+						Expect(runCLIErr).NotTo(HaveOccurred())
+						Expect(routeServiceBindingRepo.UnbindCallCount()).To(Equal(1))
+						_, _, _, parameters := routeServiceBindingRepo.UnbindArgsForCall(0)
+						Expect(parameters).To(Equal(`{"some":"json"}`))
+					})
 				})
 
 				Context("when unbinding the route service succeeds", func() {
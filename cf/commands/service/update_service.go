@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/actors/planbuilder"
@@ -19,11 +20,19 @@ import (
 	"code.cloudfoundry.org/cli/util/json"
 )
 
+const (
+	DefaultServiceUpgradePollInterval = 5 * time.Second
+	DefaultServiceUpgradeWaitTimeout  = 30 * time.Minute
+)
+
 type UpdateService struct {
 	ui          terminal.UI
 	config      coreconfig.Reader
 	serviceRepo api.ServiceRepository
 	planBuilder planbuilder.PlanBuilder
+
+	PollInterval time.Duration
+	WaitTimeout  time.Duration
 }
 
 func init() {
@@ -31,7 +40,7 @@ func init() {
 }
 
 func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
-	baseUsage := T("CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS]")
+	baseUsage := T("CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS] [--upgrade [-f]]")
 	paramsUsage := T(`   Optionally provide service-specific configuration parameters in a valid JSON object in-line.
    CF_NAME update-service -c '{"name":"value","name":"value"}'
 
@@ -47,11 +56,14 @@ func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
       }
    }`)
 	tagsUsage := T(`   Optionally provide a list of comma-delimited tags that will be written to the VCAP_SERVICES environment variable for any bound applications.`)
+	upgradeUsage := T(`   Use --upgrade to upgrade the service instance to the latest maintenance version of its plan. The upgrade cannot be combined with other changes.`)
 
 	fs := make(map[string]flags.FlagSet)
 	fs["p"] = &flags.StringFlag{ShortName: "p", Usage: T("Change service plan for a service instance")}
 	fs["c"] = &flags.StringFlag{ShortName: "c", Usage: T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering.")}
 	fs["t"] = &flags.StringFlag{ShortName: "t", Usage: T("User provided tags")}
+	fs["upgrade"] = &flags.BoolFlag{Name: "upgrade", Usage: T("Upgrade the service instance to the latest maintenance version of its plan")}
+	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force the upgrade without confirmation")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "update-service",
@@ -62,12 +74,15 @@ func (cmd *UpdateService) MetaData() commandregistry.CommandMetadata {
 			paramsUsage,
 			"\n\n",
 			tagsUsage,
+			"\n\n",
+			upgradeUsage,
 		},
 		Examples: []string{
 			`CF_NAME update-service mydb -p gold`,
 			`CF_NAME update-service mydb -c '{"ram_gb":4}'`,
 			`CF_NAME update-service mydb -c ~/workspace/tmp/instance_config.json`,
 			`CF_NAME update-service mydb -t "list,of, tags"`,
+			`CF_NAME update-service mydb --upgrade`,
 		},
 		Flags: fs,
 	}
@@ -88,6 +103,10 @@ func (cmd *UpdateService) Requirements(requirementsFactory requirements.Factory,
 		reqs = append(reqs, requirementsFactory.NewMinAPIVersionRequirement("Updating a plan", cf.UpdateServicePlanMinimumAPIVersion))
 	}
 
+	if fc.Bool("upgrade") {
+		reqs = append(reqs, requirementsFactory.NewMinAPIVersionRequirement("Option '--upgrade'", cf.UpgradeServiceInstanceMinimumAPIVersion))
+	}
+
 	return reqs, nil
 }
 
@@ -96,6 +115,8 @@ func (cmd *UpdateService) SetDependency(deps commandregistry.Dependency, pluginC
 	cmd.config = deps.Config
 	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
 	cmd.planBuilder = deps.PlanBuilder
+	cmd.PollInterval = DefaultServiceUpgradePollInterval
+	cmd.WaitTimeout = DefaultServiceUpgradeWaitTimeout
 	return cmd
 }
 
@@ -106,6 +127,13 @@ func (cmd *UpdateService) Execute(c flags.FlagContext) error {
 	tagsSet := c.IsSet("t")
 	tagsList := c.String("t")
 
+	if c.Bool("upgrade") {
+		if planName != "" || params != "" || tagsSet {
+			return errors.New(T("The --upgrade flag cannot be combined with -p, -c or -t"))
+		}
+		return cmd.upgrade(c.Args()[0], c.Bool("f"))
+	}
+
 	if planName == "" && params == "" && tagsSet == false {
 		cmd.ui.Ok()
 		cmd.ui.Say(T("No changes were made"))
@@ -146,6 +174,92 @@ func (cmd *UpdateService) Execute(c flags.FlagContext) error {
 	return nil
 }
 
+// upgrade brings the service instance up to the maintenance_info version
+// currently advertised by its plan and waits for the broker to finish.
+func (cmd *UpdateService) upgrade(serviceInstanceName string, force bool) error {
+	serviceInstance, err := cmd.serviceRepo.FindInstanceByName(serviceInstanceName)
+	if err != nil {
+		return err
+	}
+
+	if !serviceInstance.UpgradeAvailable() {
+		cmd.ui.Ok()
+		cmd.ui.Say(T("No upgrade is available for service instance {{.ServiceName}}. It is already at the latest version.",
+			map[string]interface{}{"ServiceName": terminal.EntityNameColor(serviceInstanceName)}))
+		return nil
+	}
+
+	if !force {
+		currentVersion := serviceInstance.MaintenanceVersion
+		if currentVersion == "" {
+			currentVersion = T("unknown")
+		}
+		cmd.ui.Say(T("Service instance {{.ServiceName}} will be upgraded from version {{.FromVersion}} to version {{.ToVersion}}.",
+			map[string]interface{}{
+				"ServiceName": terminal.EntityNameColor(serviceInstanceName),
+				"FromVersion": currentVersion,
+				"ToVersion":   serviceInstance.ServicePlan.MaintenanceVersion,
+			}))
+		if serviceInstance.ServicePlan.MaintenanceDescription != "" {
+			cmd.ui.Say(T("Upgrade details: {{.Description}}",
+				map[string]interface{}{"Description": serviceInstance.ServicePlan.MaintenanceDescription}))
+		}
+
+		if !cmd.ui.Confirm(T("Do you want to upgrade the service instance?")) {
+			cmd.ui.Say(T("Upgrade cancelled"))
+			return nil
+		}
+	}
+
+	cmd.ui.Say(T("Upgrading service instance {{.ServiceName}} as {{.UserName}}...",
+		map[string]interface{}{
+			"ServiceName": terminal.EntityNameColor(serviceInstanceName),
+			"UserName":    terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	err = cmd.serviceRepo.UpgradeServiceInstance(serviceInstance.GUID, serviceInstance.ServicePlan.MaintenanceVersion)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.waitForUpgrade(serviceInstanceName)
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Ok()
+	return nil
+}
+
+// waitForUpgrade polls the service instance until its last operation is no
+// longer in progress. A failed upgrade is returned as an error.
+func (cmd *UpdateService) waitForUpgrade(serviceInstanceName string) error {
+	deadline := time.Now().Add(cmd.WaitTimeout)
+	for {
+		serviceInstance, err := cmd.serviceRepo.FindInstanceByName(serviceInstanceName)
+		if err != nil {
+			return err
+		}
+
+		switch serviceInstance.LastOperation.State {
+		case "failed":
+			return errors.New(T("Upgrade of service instance {{.ServiceName}} failed: {{.Description}}",
+				map[string]interface{}{
+					"ServiceName": serviceInstanceName,
+					"Description": serviceInstance.LastOperation.Description,
+				}))
+		case "in progress":
+			if time.Now().Add(cmd.PollInterval).After(deadline) {
+				return errors.New(T("Timed out waiting for service instance {{.ServiceName}} to be upgraded",
+					map[string]interface{}{"ServiceName": serviceInstanceName}))
+			}
+			time.Sleep(cmd.PollInterval)
+		default:
+			return nil
+		}
+	}
+}
+
 func (cmd *UpdateService) findPlan(serviceInstance models.ServiceInstance, planName string) (plan models.ServicePlanFields, err error) {
 	plans, err := cmd.planBuilder.GetPlansForServiceForOrg(serviceInstance.ServiceOffering.GUID, cmd.config.OrganizationFields().Name)
 	if err != nil {
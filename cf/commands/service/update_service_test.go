@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"os"
+	"time"
 
 	planbuilderfakes "code.cloudfoundry.org/cli/cf/actors/planbuilder/planbuilderfakes"
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
@@ -32,6 +33,7 @@ var _ = Describe("update-service command", func() {
 		planBuilder         *planbuilderfakes.FakePlanBuilder
 		offering1           models.ServiceOffering
 		deps                commandregistry.Dependency
+		waitTimeout         time.Duration
 	)
 
 	updateCommandDependency := func(pluginCall bool) {
@@ -39,7 +41,10 @@ var _ = Describe("update-service command", func() {
 		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
 		deps.Config = config
 		deps.PlanBuilder = planBuilder
-		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("update-service").SetDependency(deps, pluginCall))
+		cmd := commandregistry.Commands.FindCommand("update-service").SetDependency(deps, pluginCall)
+		cmd.(*service.UpdateService).PollInterval = time.Millisecond
+		cmd.(*service.UpdateService).WaitTimeout = waitTimeout
+		commandregistry.Commands.SetCommand(cmd)
 	}
 
 	BeforeEach(func() {
@@ -54,6 +59,7 @@ var _ = Describe("update-service command", func() {
 
 		serviceRepo = new(apifakes.FakeServiceRepository)
 		planBuilder = new(planbuilderfakes.FakePlanBuilder)
+		waitTimeout = time.Minute
 
 		offering1 = models.ServiceOffering{}
 		offering1.Label = "cleardb"
@@ -91,6 +97,23 @@ var _ = Describe("update-service command", func() {
 			Expect(callUpdateService([]string{"cleardb", "spark", "my-cleardb-service"})).To(BeFalse())
 		})
 
+		Context("--upgrade", func() {
+			It("when provided, requires a CC API version > cf.UpgradeServiceInstanceMinimumAPIVersion", func() {
+				cmd := &service.UpdateService{}
+
+				fc := flags.NewFlagContext(cmd.MetaData().Flags)
+				fc.Parse("potato", "--upgrade")
+
+				reqs, err := cmd.Requirements(requirementsFactory, fc)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(reqs).To(ContainElement(requirements.Passing{Type: "minAPIVersionReq"}))
+				feature, requiredVersion := requirementsFactory.NewMinAPIVersionRequirementArgsForCall(0)
+				Expect(feature).To(Equal("Option '--upgrade'"))
+				Expect(requiredVersion.String()).To(Equal("2.133.0"))
+			})
+		})
+
 		Context("-p", func() {
 			It("when provided, requires a CC API version > cf.UpdateServicePlanMinimumAPIVersion", func() {
 				cmd := &service.UpdateService{}
@@ -490,4 +513,180 @@ var _ = Describe("update-service command", func() {
 		})
 
 	})
+
+	Context("when the --upgrade flag is passed", func() {
+		var serviceInstance models.ServiceInstance
+
+		BeforeEach(func() {
+			serviceInstance = models.ServiceInstance{
+				ServiceInstanceFields: models.ServiceInstanceFields{
+					GUID:               "my-service-instance-guid",
+					Name:               "my-service-instance",
+					MaintenanceVersion: "1.0.0",
+				},
+				ServicePlan: models.ServicePlanFields{
+					GUID:                   "plan-guid",
+					Name:                   "spark",
+					MaintenanceVersion:     "2.0.0",
+					MaintenanceDescription: "OS image update",
+				},
+			}
+			serviceRepo.FindInstanceByNameReturns(serviceInstance, nil)
+		})
+
+		Context("when the user confirms the upgrade", func() {
+			BeforeEach(func() {
+				ui.Inputs = []string{"y"}
+			})
+
+			It("shows the versions and upgrades the service instance", func() {
+				Expect(callUpdateService([]string{"--upgrade", "my-service-instance"})).To(BeTrue())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Service instance my-service-instance will be upgraded from version 1.0.0 to version 2.0.0."},
+					[]string{"Upgrade details: OS image update"},
+					[]string{"Upgrading service instance my-service-instance as my-user..."},
+					[]string{"OK"},
+				))
+				Expect(ui.Prompts).To(ContainSubstrings([]string{"Do you want to upgrade the service instance?"}))
+
+				Expect(serviceRepo.UpgradeServiceInstanceCallCount()).To(Equal(1))
+				instanceGUID, version := serviceRepo.UpgradeServiceInstanceArgsForCall(0)
+				Expect(instanceGUID).To(Equal("my-service-instance-guid"))
+				Expect(version).To(Equal("2.0.0"))
+			})
+
+			Context("when the upgrade is asynchronous", func() {
+				BeforeEach(func() {
+					inProgress := serviceInstance
+					inProgress.LastOperation.State = "in progress"
+					succeeded := serviceInstance
+					succeeded.LastOperation.State = "succeeded"
+
+					serviceRepo.FindInstanceByNameStub = func(string) (models.ServiceInstance, error) {
+						switch serviceRepo.FindInstanceByNameCallCount() {
+						case 1:
+							return serviceInstance, nil
+						case 2:
+							return inProgress, nil
+						default:
+							return succeeded, nil
+						}
+					}
+				})
+
+				It("polls until the upgrade completes", func() {
+					Expect(callUpdateService([]string{"--upgrade", "my-service-instance"})).To(BeTrue())
+
+					Expect(serviceRepo.FindInstanceByNameCallCount()).To(Equal(3))
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"OK"}))
+				})
+
+				Context("when the upgrade does not complete before the timeout", func() {
+					BeforeEach(func() {
+						waitTimeout = 0
+					})
+
+					It("fails with a timeout error", func() {
+						Expect(callUpdateService([]string{"--upgrade", "my-service-instance"})).To(BeFalse())
+
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"FAILED"},
+							[]string{"Timed out waiting for service instance my-service-instance to be upgraded"},
+						))
+					})
+				})
+			})
+
+			Context("when the upgrade fails", func() {
+				BeforeEach(func() {
+					failed := serviceInstance
+					failed.LastOperation.State = "failed"
+					failed.LastOperation.Description = "broker exploded"
+
+					serviceRepo.FindInstanceByNameStub = func(string) (models.ServiceInstance, error) {
+						if serviceRepo.FindInstanceByNameCallCount() == 1 {
+							return serviceInstance, nil
+						}
+						return failed, nil
+					}
+				})
+
+				It("returns an error with the operation description", func() {
+					Expect(callUpdateService([]string{"--upgrade", "my-service-instance"})).To(BeFalse())
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Upgrade of service instance my-service-instance failed: broker exploded"},
+					))
+				})
+			})
+
+			Context("when sending the upgrade request fails", func() {
+				BeforeEach(func() {
+					serviceRepo.UpgradeServiceInstanceReturns(errors.New("upgrade-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(callUpdateService([]string{"--upgrade", "my-service-instance"})).To(BeFalse())
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"upgrade-error"},
+					))
+				})
+			})
+		})
+
+		Context("when the user declines the upgrade", func() {
+			BeforeEach(func() {
+				ui.Inputs = []string{"n"}
+			})
+
+			It("does not upgrade the service instance", func() {
+				Expect(callUpdateService([]string{"--upgrade", "my-service-instance"})).To(BeTrue())
+
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Upgrade cancelled"}))
+				Expect(serviceRepo.UpgradeServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the -f flag is passed", func() {
+			It("upgrades without asking for confirmation", func() {
+				Expect(callUpdateService([]string{"--upgrade", "-f", "my-service-instance"})).To(BeTrue())
+
+				Expect(ui.Prompts).To(BeEmpty())
+				Expect(serviceRepo.UpgradeServiceInstanceCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the service instance is already at the latest version", func() {
+			BeforeEach(func() {
+				serviceInstance.MaintenanceVersion = "2.0.0"
+				serviceRepo.FindInstanceByNameReturns(serviceInstance, nil)
+			})
+
+			It("says so and does not upgrade", func() {
+				Expect(callUpdateService([]string{"--upgrade", "my-service-instance"})).To(BeTrue())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"OK"},
+					[]string{"No upgrade is available for service instance my-service-instance. It is already at the latest version."},
+				))
+				Expect(serviceRepo.UpgradeServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when other changes are requested with --upgrade", func() {
+			It("returns an error", func() {
+				Expect(callUpdateService([]string{"--upgrade", "-p", "flare", "my-service-instance"})).To(BeFalse())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"The --upgrade flag cannot be combined with -p, -c or -t"},
+				))
+				Expect(serviceRepo.FindInstanceByNameCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package serviceaccess
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -15,6 +16,22 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+type serviceAccessBrokerJSON struct {
+	Name     string                     `json:"name"`
+	Services []serviceAccessServiceJSON `json:"services"`
+}
+
+type serviceAccessServiceJSON struct {
+	Label string                  `json:"label"`
+	Plans []serviceAccessPlanJSON `json:"plans"`
+}
+
+type serviceAccessPlanJSON struct {
+	Name   string   `json:"name"`
+	Access string   `json:"access"`
+	Orgs   []string `json:"orgs"`
+}
+
 type ServiceAccess struct {
 	ui             terminal.UI
 	config         coreconfig.Reader
@@ -31,12 +48,13 @@ func (cmd *ServiceAccess) MetaData() commandregistry.CommandMetadata {
 	fs["b"] = &flags.StringFlag{ShortName: "b", Usage: T("Access for plans of a particular broker")}
 	fs["e"] = &flags.StringFlag{ShortName: "e", Usage: T("Access for service name of a particular service offering")}
 	fs["o"] = &flags.StringFlag{ShortName: "o", Usage: T("Plans accessible by a particular organization")}
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Output the service access settings as JSON")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "service-access",
 		Description: T("List service access settings"),
 		Usage: []string{
-			"CF_NAME service-access [-b BROKER] [-e SERVICE] [-o ORG]",
+			"CF_NAME service-access [-b BROKER] [-e SERVICE] [-o ORG] [--json]",
 		},
 		Flags: fs,
 	}
@@ -76,6 +94,14 @@ func (cmd *ServiceAccess) Execute(c flags.FlagContext) error {
 	serviceName := c.String("e")
 	orgName := c.String("o")
 
+	if c.Bool("json") {
+		brokers, err := cmd.actor.FilterBrokers(brokerName, serviceName, orgName)
+		if err != nil {
+			return err
+		}
+		return cmd.printJSON(brokers)
+	}
+
 	if brokerName != "" && serviceName != "" && orgName != "" {
 		cmd.ui.Say(T("Getting service access for broker {{.Broker}} and service {{.Service}} and organization {{.Organization}} as {{.Username}}...", map[string]interface{}{
 			"Broker":       terminal.EntityNameColor(brokerName),
@@ -122,6 +148,37 @@ func (cmd *ServiceAccess) Execute(c flags.FlagContext) error {
 	return nil
 }
 
+func (cmd ServiceAccess) printJSON(brokers []models.ServiceBroker) error {
+	brokersJSON := []serviceAccessBrokerJSON{}
+	for _, serviceBroker := range brokers {
+		services := []serviceAccessServiceJSON{}
+		for _, service := range serviceBroker.Services {
+			plans := []serviceAccessPlanJSON{}
+			for _, plan := range service.Plans {
+				orgs := plan.OrgNames
+				if orgs == nil {
+					orgs = []string{}
+				}
+				plans = append(plans, serviceAccessPlanJSON{
+					Name:   plan.Name,
+					Access: cmd.formatAccess(plan.Public, plan.OrgNames),
+					Orgs:   orgs,
+				})
+			}
+			services = append(services, serviceAccessServiceJSON{Label: service.Label, Plans: plans})
+		}
+		brokersJSON = append(brokersJSON, serviceAccessBrokerJSON{Name: serviceBroker.Name, Services: services})
+	}
+
+	jsonBytes, err := json.MarshalIndent(brokersJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say(string(jsonBytes))
+	return nil
+}
+
 func (cmd ServiceAccess) printTable(brokers []models.ServiceBroker) error {
 	for _, serviceBroker := range brokers {
 		cmd.ui.Say(fmt.Sprintf(T("broker: {{.Name}}", map[string]interface{}{"Name": serviceBroker.Name})))
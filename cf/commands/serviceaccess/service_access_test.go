@@ -235,5 +235,45 @@ var _ = Describe("service-access command", func() {
 				Expect(strings.Join(ui.Outputs(), "\n")).To(MatchRegexp(`FAILED\nError finding service brokers`))
 			})
 		})
+
+		Context("when --json is provided", func() {
+			It("prints the brokers, services and plan access as JSON", func() {
+				runCommand("--json", "-b", "brokername1")
+
+				brokerName, serviceName, orgName := actor.FilterBrokersArgsForCall(0)
+				Expect(brokerName).To(Equal("brokername1"))
+				Expect(serviceName).To(BeEmpty())
+				Expect(orgName).To(BeEmpty())
+
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Getting service access"}))
+				Expect(strings.Join(ui.Outputs(), "\n")).To(MatchJSON(`[
+					{
+						"name": "brokername1",
+						"services": [
+							{
+								"label": "my-service-1",
+								"plans": [
+									{"name": "beep", "access": "all", "orgs": []},
+									{"name": "burp", "access": "none", "orgs": []},
+									{"name": "boop", "access": "limited", "orgs": ["fwip", "brzzt"]}
+								]
+							},
+							{
+								"label": "my-service-2",
+								"plans": [
+									{"name": "petaloideous-noncelebration", "access": "none", "orgs": []}
+								]
+							}
+						]
+					},
+					{
+						"name": "brokername2",
+						"services": [
+							{"label": "my-service-3", "plans": []}
+						]
+					}
+				]`))
+			})
+		})
 	})
 })
@@ -1,6 +1,7 @@
 package servicebroker
 
 import (
+	"encoding/json"
 	"sort"
 
 	"code.cloudfoundry.org/cli/cf/api"
@@ -23,20 +24,31 @@ type serviceBrokerTable []serviceBrokerRow
 
 type serviceBrokerRow struct {
 	name string
+	guid string
 	url  string
 }
 
+type serviceBrokerJSON struct {
+	Name string `json:"name"`
+	GUID string `json:"guid"`
+	URL  string `json:"url"`
+}
+
 func init() {
 	commandregistry.Register(&ListServiceBrokers{})
 }
 
 func (cmd *ListServiceBrokers) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Output the service brokers as JSON")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "service-brokers",
 		Description: T("List service brokers"),
 		Usage: []string{
-			"CF_NAME service-brokers",
+			"CF_NAME service-brokers [--json]",
 		},
+		Flags: fs,
 	}
 }
 
@@ -64,6 +76,10 @@ func (cmd *ListServiceBrokers) SetDependency(deps commandregistry.Dependency, pl
 }
 
 func (cmd *ListServiceBrokers) Execute(c flags.FlagContext) error {
+	if c.Bool("json") {
+		return cmd.printJSON()
+	}
+
 	sbTable := serviceBrokerTable{}
 
 	cmd.ui.Say(T("Getting service brokers as {{.Username}}...\n",
@@ -102,6 +118,36 @@ func (cmd *ListServiceBrokers) Execute(c flags.FlagContext) error {
 	return nil
 }
 
+func (cmd *ListServiceBrokers) printJSON() error {
+	sbTable := serviceBrokerTable{}
+	err := cmd.repo.ListServiceBrokers(func(serviceBroker models.ServiceBroker) bool {
+		sbTable = append(sbTable, serviceBrokerRow{
+			name: serviceBroker.Name,
+			guid: serviceBroker.GUID,
+			url:  serviceBroker.URL,
+		})
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.Sort(sbTable)
+
+	brokersJSON := []serviceBrokerJSON{}
+	for _, sb := range sbTable {
+		brokersJSON = append(brokersJSON, serviceBrokerJSON{Name: sb.name, GUID: sb.guid, URL: sb.url})
+	}
+
+	jsonBytes, err := json.MarshalIndent(brokersJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say(string(jsonBytes))
+	return nil
+}
+
 func (a serviceBrokerTable) Len() int           { return len(a) }
 func (a serviceBrokerTable) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a serviceBrokerTable) Less(i, j int) bool { return a[i].name < a[j].name }
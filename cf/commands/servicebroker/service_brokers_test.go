@@ -176,4 +176,28 @@ var _ = Describe("service-brokers command", func() {
 		))
 		Expect(strings.Join(ui.Outputs(), "\n")).To(MatchRegexp(`FAILED\nError finding service brokers`))
 	})
+
+	Context("when --json is provided", func() {
+		It("prints the service brokers as JSON in alphabetical order", func() {
+			repo.ListServiceBrokersStub = func(callback func(models.ServiceBroker) bool) error {
+				callback(models.ServiceBroker{Name: "z-broker", GUID: "z-broker-guid", URL: "http://z.example.com"})
+				callback(models.ServiceBroker{Name: "a-broker", GUID: "a-broker-guid", URL: "http://a.example.com"})
+				return nil
+			}
+
+			Expect(testcmd.RunCLICommand("service-brokers", []string{"--json"}, requirementsFactory, updateCommandDependency, false, ui)).To(BeTrue())
+
+			Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Getting service brokers as"}))
+			Expect(strings.Join(ui.Outputs(), "\n")).To(MatchJSON(`[
+				{"name": "a-broker", "guid": "a-broker-guid", "url": "http://a.example.com"},
+				{"name": "z-broker", "guid": "z-broker-guid", "url": "http://z.example.com"}
+			]`))
+		})
+
+		It("prints an empty list when no service brokers were found", func() {
+			testcmd.RunCLICommand("service-brokers", []string{"--json"}, requirementsFactory, updateCommandDependency, false, ui)
+
+			Expect(strings.Join(ui.Outputs(), "\n")).To(MatchJSON(`[]`))
+		})
+	})
 })
@@ -1,21 +1,28 @@
 package servicebroker
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
+	"code.cloudfoundry.org/cli/cf/actors/catalogdiff"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
 type UpdateServiceBroker struct {
-	ui     terminal.UI
-	config coreconfig.Reader
-	repo   api.ServiceBrokerRepository
+	ui          terminal.UI
+	config      coreconfig.Reader
+	repo        api.ServiceBrokerRepository
+	serviceRepo api.ServiceRepository
+	planRepo    api.ServicePlanRepository
+	catalogRepo api.ServiceBrokerCatalogRepository
 }
 
 func init() {
@@ -23,17 +30,39 @@ func init() {
 }
 
 func (cmd *UpdateServiceBroker) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["dry-run"] = &flags.BoolFlag{Name: "dry-run", Usage: T("Show the changes to services and plans that updating the broker would make, without applying them")}
+	fs["username"] = &flags.StringFlag{Name: "username", Usage: T("Change the username the platform uses to authenticate with the broker")}
+	fs["password"] = &flags.BoolFlag{Name: "password", Usage: T("Prompt for a new password the platform uses to authenticate with the broker, read from stdin when it is not a terminal")}
+	fs["url"] = &flags.StringFlag{Name: "url", Usage: T("Change the URL of the broker")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "update-service-broker",
 		Description: T("Update a service broker"),
 		Usage: []string{
-			T("CF_NAME update-service-broker SERVICE_BROKER USERNAME PASSWORD URL"),
+			T("CF_NAME update-service-broker SERVICE_BROKER USERNAME PASSWORD URL [--dry-run]\n\n"),
+			T("   Change only some of the broker's settings:\n\n"),
+			T("   CF_NAME update-service-broker SERVICE_BROKER [--username USERNAME] [--password] [--url URL]\n\n"),
+			T("   Preview catalog changes using the stored broker URL and username:\n\n"),
+			T("   CF_NAME update-service-broker SERVICE_BROKER --dry-run"),
+		},
+		Examples: []string{
+			"echo \"$BROKER_PASSWORD\" | CF_NAME update-service-broker my-broker --password",
+			"CF_NAME update-service-broker my-broker --url https://broker.example.com",
 		},
+		Flags: fs,
 	}
 }
 
 func (cmd *UpdateServiceBroker) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
-	if len(fc.Args()) != 4 {
+	changesFlags := fc.IsSet("username") || fc.IsSet("password") || fc.IsSet("url")
+
+	if changesFlags && len(fc.Args()) == 4 {
+		cmd.ui.Failed(T("Incorrect Usage. The USERNAME, PASSWORD and URL arguments cannot be used with --username, --password or --url\n\n") + commandregistry.Commands.CommandUsage("update-service-broker"))
+		return nil, fmt.Errorf("Incorrect usage: positional credentials used with flags")
+	}
+
+	if len(fc.Args()) != 4 && !((fc.Bool("dry-run") || changesFlags) && len(fc.Args()) == 1) {
 		cmd.ui.Failed(T("Incorrect Usage. Requires SERVICE_BROKER, USERNAME, PASSWORD, URL as arguments\n\n") + commandregistry.Commands.CommandUsage("update-service-broker"))
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 4)
 	}
@@ -49,6 +78,9 @@ func (cmd *UpdateServiceBroker) SetDependency(deps commandregistry.Dependency, p
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.repo = deps.RepoLocator.GetServiceBrokerRepository()
+	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
+	cmd.planRepo = deps.RepoLocator.GetServicePlanRepository()
+	cmd.catalogRepo = deps.RepoLocator.GetServiceBrokerCatalogRepository()
 	return cmd
 }
 
@@ -58,14 +90,38 @@ func (cmd *UpdateServiceBroker) Execute(c flags.FlagContext) error {
 		return err
 	}
 
+	if c.Bool("dry-run") {
+		return cmd.previewCatalogChanges(serviceBroker, c)
+	}
+
+	var password string
+	if c.Bool("password") {
+		password = cmd.ui.AskForPassword(T("New password for broker {{.Name}}", map[string]interface{}{"Name": serviceBroker.Name}))
+		if password == "" {
+			return errors.New(T("The broker password cannot be empty."))
+		}
+	}
+
 	cmd.ui.Say(T("Updating service broker {{.Name}} as {{.Username}}...",
 		map[string]interface{}{
 			"Name":     terminal.EntityNameColor(serviceBroker.Name),
 			"Username": terminal.EntityNameColor(cmd.config.Username())}))
 
-	serviceBroker.Username = c.Args()[1]
-	serviceBroker.Password = c.Args()[2]
-	serviceBroker.URL = c.Args()[3]
+	if len(c.Args()) == 4 {
+		serviceBroker.Username = c.Args()[1]
+		serviceBroker.Password = c.Args()[2]
+		serviceBroker.URL = c.Args()[3]
+	} else {
+		// Only send the settings being changed, so that the stored ones are
+		// left untouched.
+		serviceBroker = models.ServiceBroker{
+			GUID:     serviceBroker.GUID,
+			Name:     serviceBroker.Name,
+			Username: c.String("username"),
+			Password: password,
+			URL:      c.String("url"),
+		}
+	}
 
 	err = cmd.repo.Update(serviceBroker)
 
@@ -76,3 +132,71 @@ func (cmd *UpdateServiceBroker) Execute(c flags.FlagContext) error {
 	cmd.ui.Ok()
 	return nil
 }
+
+// previewCatalogChanges fetches the broker's catalog and prints how it
+// differs from the services and plans Cloud Foundry currently has for the
+// broker. The Cloud Controller never returns the broker password, so it is
+// prompted for unless all credentials were given as arguments.
+func (cmd *UpdateServiceBroker) previewCatalogChanges(serviceBroker models.ServiceBroker, c flags.FlagContext) error {
+	username, url := serviceBroker.Username, serviceBroker.URL
+	if c.IsSet("username") {
+		username = c.String("username")
+	}
+	if c.IsSet("url") {
+		url = c.String("url")
+	}
+
+	var password string
+	if args := c.Args(); len(args) == 4 {
+		username, password, url = args[1], args[2], args[3]
+	} else {
+		password = cmd.ui.AskForPassword(T("Password for broker user {{.Username}}", map[string]interface{}{"Username": username}))
+	}
+
+	cmd.ui.Say(T("Comparing the catalog of service broker {{.Name}} with Cloud Foundry as {{.Username}}...",
+		map[string]interface{}{
+			"Name":     terminal.EntityNameColor(serviceBroker.Name),
+			"Username": terminal.EntityNameColor(cmd.config.Username())}))
+
+	catalog, err := cmd.catalogRepo.FetchCatalog(url, username, password)
+	if err != nil {
+		return err
+	}
+
+	services, err := cmd.serviceRepo.ListServicesFromBroker(serviceBroker.GUID)
+	if err != nil {
+		return err
+	}
+
+	for index, service := range services {
+		plans, err := cmd.planRepo.Search(map[string]string{"service_guid": service.GUID})
+		if err != nil {
+			return err
+		}
+		services[index].Plans = plans
+	}
+
+	changes := catalogdiff.Diff(services, catalog)
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if len(changes) == 0 {
+		cmd.ui.Say(T("The catalog of service broker {{.Name}} matches the services and plans in Cloud Foundry.",
+			map[string]interface{}{"Name": terminal.EntityNameColor(serviceBroker.Name)}))
+	} else {
+		table := cmd.ui.Table([]string{T("change"), T("service"), T("plan"), T("details")})
+		for _, change := range changes {
+			table.Add(T(change.Change), change.Service, change.Plan, strings.Join(change.Details, ", "))
+		}
+		err = table.Print()
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Dry run: service broker {{.Name}} was not updated.",
+		map[string]interface{}{"Name": terminal.EntityNameColor(serviceBroker.Name)}))
+	return nil
+}
@@ -1,9 +1,12 @@
 package servicebroker_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
@@ -22,12 +25,18 @@ var _ = Describe("update-service-broker command", func() {
 		requirementsFactory *requirementsfakes.FakeFactory
 		configRepo          coreconfig.Repository
 		serviceBrokerRepo   *apifakes.FakeServiceBrokerRepository
+		serviceRepo         *apifakes.FakeServiceRepository
+		servicePlanRepo     *apifakes.FakeServicePlanRepository
+		catalogRepo         *apifakes.FakeServiceBrokerCatalogRepository
 		deps                commandregistry.Dependency
 	)
 
 	updateCommandDependency := func(pluginCall bool) {
 		deps.UI = ui
 		deps.RepoLocator = deps.RepoLocator.SetServiceBrokerRepository(serviceBrokerRepo)
+		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
+		deps.RepoLocator = deps.RepoLocator.SetServicePlanRepository(servicePlanRepo)
+		deps.RepoLocator = deps.RepoLocator.SetServiceBrokerCatalogRepository(catalogRepo)
 		deps.Config = configRepo
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("update-service-broker").SetDependency(deps, pluginCall))
 	}
@@ -37,6 +46,9 @@ var _ = Describe("update-service-broker command", func() {
 		ui = &testterm.FakeUI{}
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 		serviceBrokerRepo = new(apifakes.FakeServiceBrokerRepository)
+		serviceRepo = new(apifakes.FakeServiceRepository)
+		servicePlanRepo = new(apifakes.FakeServicePlanRepository)
+		catalogRepo = new(apifakes.FakeServiceBrokerCatalogRepository)
 	})
 
 	runCommand := func(args ...string) bool {
@@ -53,6 +65,37 @@ var _ = Describe("update-service-broker command", func() {
 			))
 		})
 
+		It("fails with usage when given only the broker name without --dry-run", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+
+			Expect(runCommand("my-broker")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Requires", "arguments"},
+			))
+		})
+
+		It("accepts only the broker name with --dry-run", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+			ui.Inputs = []string{"broker-password"}
+
+			Expect(runCommand("--dry-run", "my-broker")).To(BeTrue())
+		})
+
+		It("accepts only the broker name with --username, --password or --url", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+
+			Expect(runCommand("--url", "new-url", "my-broker")).To(BeTrue())
+		})
+
+		It("fails with usage when given the credentials both as arguments and flags", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+
+			Expect(runCommand("--username", "other-username", "my-broker", "new-username", "new-password", "new-url")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "cannot be used with --username, --password or --url"},
+			))
+		})
+
 		It("fails when not logged in", func() {
 			requirementsFactory.NewLoginRequirementReturns(requirements.Failing{Message: "not logged in"})
 			Expect(runCommand("heeeeeeey", "yooouuuuuuu", "guuuuuuuuys", "ヾ(＠*ー⌒ー*@)ノ")).To(BeFalse())
@@ -87,5 +130,211 @@ var _ = Describe("update-service-broker command", func() {
 
 			Expect(serviceBrokerRepo.UpdateArgsForCall(0)).To(Equal(expectedServiceBroker))
 		})
+
+		Context("when only some settings are changed with flags", func() {
+			BeforeEach(func() {
+				serviceBrokerRepo.FindByNameReturns(models.ServiceBroker{
+					Name:     "my-found-broker",
+					GUID:     "my-found-broker-guid",
+					Username: "stored-username",
+					URL:      "https://stored.example.com",
+				}, nil)
+			})
+
+			It("only updates the changed settings", func() {
+				Expect(runCommand("my-broker", "--url", "https://new.example.com")).To(BeTrue())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Updating service broker", "my-found-broker", "my-user"},
+					[]string{"OK"},
+				))
+				Expect(ui.PasswordPrompts).To(BeEmpty())
+				Expect(serviceBrokerRepo.UpdateArgsForCall(0)).To(Equal(models.ServiceBroker{
+					Name: "my-found-broker",
+					GUID: "my-found-broker-guid",
+					URL:  "https://new.example.com",
+				}))
+			})
+
+			Context("when --password is provided", func() {
+				It("prompts for the new password", func() {
+					ui.Inputs = []string{"new-password"}
+					Expect(runCommand("my-broker", "--password", "--username", "new-username")).To(BeTrue())
+
+					Expect(ui.PasswordPrompts).To(ContainSubstrings([]string{"New password for broker my-found-broker"}))
+					Expect(serviceBrokerRepo.UpdateArgsForCall(0)).To(Equal(models.ServiceBroker{
+						Name:     "my-found-broker",
+						GUID:     "my-found-broker-guid",
+						Username: "new-username",
+						Password: "new-password",
+					}))
+				})
+
+				It("fails when the password is empty", func() {
+					ui.Inputs = []string{""}
+					Expect(runCommand("my-broker", "--password")).To(BeFalse())
+
+					Expect(ui.Outputs()).To(ContainSubstrings([]string{"The broker password cannot be empty."}))
+					Expect(serviceBrokerRepo.UpdateCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when --dry-run is provided", func() {
+			BeforeEach(func() {
+				serviceBrokerRepo.FindByNameReturns(models.ServiceBroker{
+					Name:     "my-found-broker",
+					GUID:     "my-found-broker-guid",
+					Username: "stored-username",
+					URL:      "https://stored.example.com",
+				}, nil)
+
+				catalogRepo.FetchCatalogReturns(models.ServiceBrokerCatalog{
+					Services: []models.ServiceBrokerCatalogService{
+						{
+							ID:          "mysql-id",
+							Name:        "mysql",
+							Description: "MySQL databases",
+							Plans: []models.ServiceBrokerCatalogPlan{
+								{ID: "small-id", Name: "small", Description: "A small database", Free: false},
+								{ID: "large-id", Name: "large", Description: "A large database", Free: false},
+							},
+						},
+						{
+							ID:   "redis-id",
+							Name: "redis",
+							Plans: []models.ServiceBrokerCatalogPlan{
+								{ID: "cache-id", Name: "cache", Free: true},
+							},
+						},
+					},
+				}, nil)
+
+				serviceRepo.ListServicesFromBrokerReturns([]models.ServiceOffering{
+					{ServiceOfferingFields: models.ServiceOfferingFields{GUID: "mysql-guid", UniqueID: "mysql-id", Label: "mysql", Description: "MySQL databases"}},
+					{ServiceOfferingFields: models.ServiceOfferingFields{GUID: "mongo-guid", UniqueID: "mongo-id", Label: "mongo"}},
+				}, nil)
+
+				servicePlanRepo.SearchStub = func(queryParams map[string]string) ([]models.ServicePlanFields, error) {
+					switch queryParams["service_guid"] {
+					case "mysql-guid":
+						return []models.ServicePlanFields{
+							{UniqueID: "small-id", Name: "small", Description: "A small database", Free: true},
+							{UniqueID: "medium-id", Name: "medium", Description: "A medium database"},
+						}, nil
+					case "mongo-guid":
+						return []models.ServicePlanFields{
+							{UniqueID: "shared-id", Name: "shared", Free: true},
+						}, nil
+					}
+					return nil, nil
+				}
+			})
+
+			It("prompts for the password and fetches the catalog with the stored URL and username", func() {
+				ui.Inputs = []string{"broker-password"}
+				runCommand("--dry-run", "my-broker")
+
+				Expect(ui.PasswordPrompts).To(ContainSubstrings([]string{"Password", "stored-username"}))
+				Expect(catalogRepo.FetchCatalogCallCount()).To(Equal(1))
+				url, username, password := catalogRepo.FetchCatalogArgsForCall(0)
+				Expect(url).To(Equal("https://stored.example.com"))
+				Expect(username).To(Equal("stored-username"))
+				Expect(password).To(Equal("broker-password"))
+			})
+
+			It("uses the credentials and URL given as arguments", func() {
+				runCommand("--dry-run", "my-broker", "new-username", "new-password", "https://new.example.com")
+
+				Expect(ui.PasswordPrompts).To(BeEmpty())
+				url, username, password := catalogRepo.FetchCatalogArgsForCall(0)
+				Expect(url).To(Equal("https://new.example.com"))
+				Expect(username).To(Equal("new-username"))
+				Expect(password).To(Equal("new-password"))
+			})
+
+			It("prints the added, removed and changed services and plans without updating the broker", func() {
+				ui.Inputs = []string{"broker-password"}
+				Expect(runCommand("--dry-run", "my-broker")).To(BeTrue())
+
+				Expect(serviceRepo.ListServicesFromBrokerArgsForCall(0)).To(Equal("my-found-broker-guid"))
+				Expect(serviceBrokerRepo.UpdateCallCount()).To(Equal(0))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Comparing the catalog of service broker", "my-found-broker", "my-user"},
+					[]string{"OK"},
+					[]string{"change", "service", "plan", "details"},
+					[]string{"removed", "mongo"},
+					[]string{"removed", "mongo", "shared"},
+					[]string{"changed", "mysql", "small", "free: 'true' -> 'false'"},
+					[]string{"added", "mysql", "large"},
+					[]string{"removed", "mysql", "medium"},
+					[]string{"added", "redis"},
+					[]string{"added", "redis", "cache"},
+					[]string{"Dry run: service broker", "my-found-broker", "was not updated"},
+				))
+			})
+
+			Context("when the catalog matches Cloud Foundry", func() {
+				BeforeEach(func() {
+					catalogRepo.FetchCatalogReturns(models.ServiceBrokerCatalog{}, nil)
+					serviceRepo.ListServicesFromBrokerReturns([]models.ServiceOffering{}, nil)
+				})
+
+				It("says there are no changes", func() {
+					ui.Inputs = []string{"broker-password"}
+					runCommand("--dry-run", "my-broker")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"The catalog of service broker", "matches the services and plans in Cloud Foundry"},
+					))
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"change", "service", "plan", "details"}))
+				})
+			})
+
+			Context("when the broker rejects the credentials", func() {
+				BeforeEach(func() {
+					catalogRepo.FetchCatalogReturns(models.ServiceBrokerCatalog{}, cferrors.NewServiceBrokerAuthenticationError("https://stored.example.com", 401))
+				})
+
+				It("fails with the authentication error", func() {
+					ui.Inputs = []string{"wrong-password"}
+					Expect(runCommand("--dry-run", "my-broker")).To(BeFalse())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Authentication with the service broker", "https://stored.example.com", "401"},
+					))
+					Expect(serviceRepo.ListServicesFromBrokerCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the broker catalog is invalid", func() {
+				BeforeEach(func() {
+					catalogRepo.FetchCatalogReturns(models.ServiceBrokerCatalog{}, cferrors.NewServiceBrokerCatalogValidationError([]string{"service mysql: at least one plan is required"}))
+				})
+
+				It("fails with the validation problems", func() {
+					ui.Inputs = []string{"broker-password"}
+					Expect(runCommand("--dry-run", "my-broker")).To(BeFalse())
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"The service broker catalog is invalid"},
+						[]string{"service mysql: at least one plan is required"},
+					))
+				})
+			})
+
+			Context("when fetching the plans fails", func() {
+				BeforeEach(func() {
+					servicePlanRepo.SearchStub = nil
+					servicePlanRepo.SearchReturns(nil, errors.New("plans-error"))
+				})
+
+				It("fails without printing changes", func() {
+					ui.Inputs = []string{"broker-password"}
+					Expect(runCommand("--dry-run", "my-broker")).To(BeFalse())
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Dry run"}))
+				})
+			})
+		})
 	})
 })
@@ -1,15 +1,16 @@
 package spacequota
 
 import (
-	"errors"
 	"fmt"
 
+	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/api/spacequotas"
 	"code.cloudfoundry.org/cli/cf/api/spaces"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
@@ -19,6 +20,8 @@ type SetSpaceQuota struct {
 	config    coreconfig.Reader
 	spaceRepo spaces.SpaceRepository
 	quotaRepo spacequotas.SpaceQuotaRepository
+
+	quotaResolver *actors.SpaceQuotaResolver
 }
 
 func init() {
@@ -54,6 +57,7 @@ func (cmd *SetSpaceQuota) SetDependency(deps commandregistry.Dependency, pluginC
 	cmd.config = deps.Config
 	cmd.spaceRepo = deps.RepoLocator.GetSpaceRepository()
 	cmd.quotaRepo = deps.RepoLocator.GetSpaceQuotaRepository()
+	cmd.quotaResolver = actors.NewSpaceQuotaResolver(cmd.config, cmd.quotaRepo)
 	return cmd
 }
 
@@ -73,15 +77,33 @@ func (cmd *SetSpaceQuota) Execute(c flags.FlagContext) error {
 		return err
 	}
 
-	if space.SpaceQuotaGUID != "" {
-		return errors.New(T("This space already has an assigned space quota."))
-	}
-
-	quota, err := cmd.quotaRepo.FindByName(quotaName)
+	quota, err := cmd.quotaResolver.FindByName(quotaName)
 	if err != nil {
 		return err
 	}
 
+	switch space.SpaceQuotaGUID {
+	case "":
+	case quota.GUID:
+		cmd.ui.Ok()
+		cmd.ui.Say(T("Space quota {{.QuotaName}} is already assigned to space {{.SpaceName}}.", map[string]interface{}{
+			"QuotaName": terminal.EntityNameColor(quota.Name),
+			"SpaceName": terminal.EntityNameColor(space.Name),
+		}))
+		return nil
+	default:
+		var previousQuota models.SpaceQuota
+		previousQuota, err = cmd.quotaRepo.FindByGUID(space.SpaceQuotaGUID)
+		if err != nil {
+			return err
+		}
+
+		cmd.ui.Say(T("Replacing space quota {{.PreviousQuotaName}} of space {{.SpaceName}}.", map[string]interface{}{
+			"PreviousQuotaName": terminal.EntityNameColor(previousQuota.Name),
+			"SpaceName":         terminal.EntityNameColor(space.Name),
+		}))
+	}
+
 	err = cmd.quotaRepo.AssociateSpaceWithQuota(space.GUID, quota.GUID)
 	if err != nil {
 		return err
@@ -105,7 +105,7 @@ var _ = Describe("set-space-quota command", func() {
 
 		Context("when the space and quota both exist", func() {
 			BeforeEach(func() {
-				quotaRepo.FindByNameReturns(
+				quotaRepo.FindByNameAndOrgGUIDReturns(
 					models.SpaceQuota{
 						Name:                    "quota-name",
 						GUID:                    "quota-guid",
@@ -141,7 +141,7 @@ var _ = Describe("set-space-quota command", func() {
 				})
 			})
 
-			Context("when the space quota was previously assigned to a space", func() {
+			Context("when the space already has the space quota", func() {
 				BeforeEach(func() {
 					spaceRepo.FindByNameReturns(
 						models.Space{
@@ -149,17 +149,57 @@ var _ = Describe("set-space-quota command", func() {
 								Name: "my-space",
 								GUID: "my-space-guid",
 							},
-							SpaceQuotaGUID: "another-quota",
+							SpaceQuotaGUID: "quota-guid",
 						}, nil)
 				})
 
-				It("warns the user that the operation was not performed", func() {
-					Expect(quotaRepo.UpdateCallCount()).To(Equal(0))
+				It("succeeds without assigning the space quota again", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(quotaRepo.AssociateSpaceWithQuotaCallCount()).To(Equal(0))
 					Expect(ui.Outputs()).To(ContainSubstrings(
 						[]string{"Assigning space quota", "to space", "my-user"},
+						[]string{"OK"},
+						[]string{"Space quota quota-name is already assigned to space my-space."},
 					))
-					Expect(executeErr).To(HaveOccurred())
-					Expect(executeErr.Error()).To(Equal("This space already has an assigned space quota."))
+				})
+			})
+
+			Context("when the space has another space quota", func() {
+				BeforeEach(func() {
+					spaceRepo.FindByNameReturns(
+						models.Space{
+							SpaceFields: models.SpaceFields{
+								Name: "my-space",
+								GUID: "my-space-guid",
+							},
+							SpaceQuotaGUID: "another-quota-guid",
+						}, nil)
+					quotaRepo.FindByGUIDReturns(models.SpaceQuota{Name: "another-quota", GUID: "another-quota-guid"}, nil)
+				})
+
+				It("replaces the space quota and shows the previous one", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(quotaRepo.FindByGUIDArgsForCall(0)).To(Equal("another-quota-guid"))
+
+					spaceGUID, quotaGUID := quotaRepo.AssociateSpaceWithQuotaArgsForCall(0)
+					Expect(spaceGUID).To(Equal("my-space-guid"))
+					Expect(quotaGUID).To(Equal("quota-guid"))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Assigning space quota", "to space", "my-user"},
+						[]string{"Replacing space quota another-quota of space my-space."},
+						[]string{"OK"},
+					))
+				})
+
+				Context("when the previous space quota cannot be fetched", func() {
+					BeforeEach(func() {
+						quotaRepo.FindByGUIDReturns(models.SpaceQuota{}, errors.New("find-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("find-error"))
+						Expect(quotaRepo.AssociateSpaceWithQuotaCallCount()).To(Equal(0))
+					})
 				})
 			})
 		})
@@ -194,7 +234,7 @@ var _ = Describe("set-space-quota command", func() {
 					}, nil)
 
 				quotaErr = errors.New("I can't find my quota name!")
-				quotaRepo.FindByNameReturns(models.SpaceQuota{}, quotaErr)
+				quotaRepo.FindByNameAndOrgGUIDReturns(models.SpaceQuota{}, quotaErr)
 			})
 
 			It("prints an error", func() {
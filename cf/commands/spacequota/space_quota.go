@@ -2,7 +2,9 @@ package spacequota
 
 import (
 	"fmt"
+	"strings"
 
+	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/api/spacequotas"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -17,6 +19,7 @@ type SpaceQuota struct {
 	ui             terminal.UI
 	config         coreconfig.Reader
 	spaceQuotaRepo spacequotas.SpaceQuotaRepository
+	quotaResolver  *actors.SpaceQuotaResolver
 }
 
 func init() {
@@ -24,12 +27,16 @@ func init() {
 }
 
 func (cmd *SpaceQuota) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["spaces"] = &flags.BoolFlag{Name: "spaces", Usage: T("List the spaces the space quota is assigned to")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "space-quota",
 		Description: T("Show space quota info"),
 		Usage: []string{
-			T("CF_NAME space-quota SPACE_QUOTA_NAME"),
+			T("CF_NAME space-quota SPACE_QUOTA_NAME [--spaces]"),
 		},
+		Flags: fs,
 	}
 }
 
@@ -51,6 +58,7 @@ func (cmd *SpaceQuota) SetDependency(deps commandregistry.Dependency, pluginCall
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.spaceQuotaRepo = deps.RepoLocator.GetSpaceQuotaRepository()
+	cmd.quotaResolver = actors.NewSpaceQuotaResolver(cmd.config, cmd.spaceQuotaRepo)
 	return cmd
 }
 
@@ -63,12 +71,23 @@ func (cmd *SpaceQuota) Execute(c flags.FlagContext) error {
 			"Username": terminal.EntityNameColor(cmd.config.Username()),
 		}))
 
-	spaceQuota, err := cmd.spaceQuotaRepo.FindByName(name)
+	spaceQuota, err := cmd.quotaResolver.FindByName(name)
 
 	if err != nil {
 		return err
 	}
 
+	var spaceNames []string
+	if c.Bool("spaces") {
+		spaces, err := cmd.spaceQuotaRepo.ListSpaces(spaceQuota.GUID)
+		if err != nil {
+			return err
+		}
+		for _, space := range spaces {
+			spaceNames = append(spaceNames, space.Name)
+		}
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 	var megabytes string
@@ -87,6 +106,13 @@ func (cmd *SpaceQuota) Execute(c flags.FlagContext) error {
 	table.Add(T("non basic services"), formatters.Allowed(spaceQuota.NonBasicServicesAllowed))
 	table.Add(T("app instance limit"), T(spaceQuota.FormattedAppInstanceLimit()))
 	table.Add(T("reserved route ports"), T(spaceQuota.FormattedRoutePortsLimit()))
+	if c.Bool("spaces") {
+		if len(spaceNames) == 0 {
+			table.Add(T("spaces"), T("none"))
+		} else {
+			table.Add(T("spaces"), strings.Join(spaceNames, ", "))
+		}
+	}
 
 	err = table.Print()
 	if err != nil {
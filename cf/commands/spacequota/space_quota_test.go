@@ -65,14 +65,20 @@ var _ = Describe("quotas command", func() {
 	})
 
 	Context("when logged in", func() {
+		var args []string
+
+		BeforeEach(func() {
+			args = []string{"quota-name"}
+		})
+
 		JustBeforeEach(func() {
 			requirementsFactory.NewTargetedOrgRequirementReturns(new(requirementsfakes.FakeTargetedOrgRequirement))
-			runCommand("quota-name")
+			runCommand(args...)
 		})
 
 		Context("when quotas exist", func() {
 			BeforeEach(func() {
-				quotaRepo.FindByNameReturns(
+				quotaRepo.FindByNameAndOrgGUIDReturns(
 					models.SpaceQuota{
 						Name:                    "quota-name",
 						MemoryLimit:             1024,
@@ -87,7 +93,9 @@ var _ = Describe("quotas command", func() {
 			})
 
 			It("lists the specific quota info", func() {
-				Expect(quotaRepo.FindByNameArgsForCall(0)).To(Equal("quota-name"))
+				name, orgGUID := quotaRepo.FindByNameAndOrgGUIDArgsForCall(0)
+					Expect(name).To(Equal("quota-name"))
+					Expect(orgGUID).To(Equal("my-org-guid"))
 				Expect(ui.Outputs()).To(ContainSubstrings(
 					[]string{"Getting space quota quota-name info as", "my-user"},
 					[]string{"OK"},
@@ -99,11 +107,52 @@ var _ = Describe("quotas command", func() {
 					[]string{"app instance limit", "5"},
 					[]string{"reserved route ports", "4"},
 				))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"spaces"}))
+				Expect(quotaRepo.ListSpacesCallCount()).To(Equal(0))
+			})
+
+			Context("when --spaces is provided", func() {
+				BeforeEach(func() {
+					args = []string{"quota-name", "--spaces"}
+					quotaRepo.FindByNameAndOrgGUIDReturns(models.SpaceQuota{GUID: "quota-guid", Name: "quota-name"}, nil)
+					quotaRepo.ListSpacesReturns([]models.SpaceFields{{Name: "space-1"}, {Name: "space-2"}}, nil)
+				})
+
+				It("lists the spaces the quota is assigned to", func() {
+					Expect(quotaRepo.ListSpacesArgsForCall(0)).To(Equal("quota-guid"))
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"OK"},
+						[]string{"spaces", "space-1, space-2"},
+					))
+				})
+
+				Context("when the quota is not assigned to any space", func() {
+					BeforeEach(func() {
+						quotaRepo.ListSpacesReturns(nil, nil)
+					})
+
+					It("says so", func() {
+						Expect(ui.Outputs()).To(ContainSubstrings([]string{"spaces", "none"}))
+					})
+				})
+
+				Context("when listing the spaces fails", func() {
+					BeforeEach(func() {
+						quotaRepo.ListSpacesReturns(nil, errors.New("list-error"))
+					})
+
+					It("prints an error", func() {
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"FAILED"},
+							[]string{"list-error"},
+						))
+					})
+				})
 			})
 
 			Context("when the services are unlimited", func() {
 				BeforeEach(func() {
-					quotaRepo.FindByNameReturns(
+					quotaRepo.FindByNameAndOrgGUIDReturns(
 						models.SpaceQuota{
 							Name:                    "quota-name",
 							MemoryLimit:             1024,
@@ -117,7 +166,9 @@ var _ = Describe("quotas command", func() {
 				})
 
 				It("replaces -1 with unlimited", func() {
-					Expect(quotaRepo.FindByNameArgsForCall(0)).To(Equal("quota-name"))
+					name, orgGUID := quotaRepo.FindByNameAndOrgGUIDArgsForCall(0)
+					Expect(name).To(Equal("quota-name"))
+					Expect(orgGUID).To(Equal("my-org-guid"))
 					Expect(ui.Outputs()).To(ContainSubstrings(
 						[]string{"Getting space quota quota-name info as", "my-user"},
 						[]string{"OK"},
@@ -132,7 +183,7 @@ var _ = Describe("quotas command", func() {
 
 			Context("when the app instances are unlimited", func() {
 				BeforeEach(func() {
-					quotaRepo.FindByNameReturns(
+					quotaRepo.FindByNameAndOrgGUIDReturns(
 						models.SpaceQuota{
 							Name:                    "quota-name",
 							MemoryLimit:             1024,
@@ -146,7 +197,9 @@ var _ = Describe("quotas command", func() {
 				})
 
 				It("replaces -1 with unlimited", func() {
-					Expect(quotaRepo.FindByNameArgsForCall(0)).To(Equal("quota-name"))
+					name, orgGUID := quotaRepo.FindByNameAndOrgGUIDArgsForCall(0)
+					Expect(name).To(Equal("quota-name"))
+					Expect(orgGUID).To(Equal("my-org-guid"))
 					Expect(ui.Outputs()).To(ContainSubstrings(
 						[]string{"Getting space quota quota-name info as", "my-user"},
 						[]string{"OK"},
@@ -162,7 +215,7 @@ var _ = Describe("quotas command", func() {
 		})
 		Context("when an error occurs fetching quotas", func() {
 			BeforeEach(func() {
-				quotaRepo.FindByNameReturns(models.SpaceQuota{}, errors.New("I haz a borken!"))
+				quotaRepo.FindByNameAndOrgGUIDReturns(models.SpaceQuota{}, errors.New("I haz a borken!"))
 			})
 
 			It("prints an error", func() {
@@ -3,6 +3,7 @@ package spacequota
 import (
 	"fmt"
 
+	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/api/spacequotas"
 	"code.cloudfoundry.org/cli/cf/api/spaces"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -18,6 +19,8 @@ type UnsetSpaceQuota struct {
 	config    coreconfig.Reader
 	quotaRepo spacequotas.SpaceQuotaRepository
 	spaceRepo spaces.SpaceRepository
+
+	quotaResolver *actors.SpaceQuotaResolver
 }
 
 func init() {
@@ -53,6 +56,7 @@ func (cmd *UnsetSpaceQuota) SetDependency(deps commandregistry.Dependency, plugi
 	cmd.config = deps.Config
 	cmd.spaceRepo = deps.RepoLocator.GetSpaceRepository()
 	cmd.quotaRepo = deps.RepoLocator.GetSpaceQuotaRepository()
+	cmd.quotaResolver = actors.NewSpaceQuotaResolver(cmd.config, cmd.quotaRepo)
 	return cmd
 }
 
@@ -65,7 +69,7 @@ func (cmd *UnsetSpaceQuota) Execute(c flags.FlagContext) error {
 		return err
 	}
 
-	quota, err := cmd.quotaRepo.FindByName(quotaName)
+	quota, err := cmd.quotaResolver.FindByName(quotaName)
 	if err != nil {
 		return err
 	}
@@ -76,6 +80,21 @@ func (cmd *UnsetSpaceQuota) Execute(c flags.FlagContext) error {
 			"SpaceName": terminal.EntityNameColor(space.Name),
 			"Username":  terminal.EntityNameColor(cmd.config.Username())}))
 
+	if space.SpaceQuotaGUID != quota.GUID {
+		cmd.ui.Ok()
+		if space.SpaceQuotaGUID == "" {
+			cmd.ui.Say(T("Space {{.SpaceName}} has no space quota assigned.", map[string]interface{}{
+				"SpaceName": terminal.EntityNameColor(space.Name),
+			}))
+		} else {
+			cmd.ui.Say(T("Space quota {{.QuotaName}} is not assigned to space {{.SpaceName}}.", map[string]interface{}{
+				"QuotaName": terminal.EntityNameColor(quota.Name),
+				"SpaceName": terminal.EntityNameColor(space.Name),
+			}))
+		}
+		return nil
+	}
+
 	err = cmd.quotaRepo.UnassignQuotaFromSpace(space.GUID, quota.GUID)
 	if err != nil {
 		return err
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/api/spaces/spacesfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
@@ -84,30 +85,97 @@ var _ = Describe("unset-space-quota command", func() {
 			requirementsFactory.NewTargetedOrgRequirementReturns(new(requirementsfakes.FakeTargetedOrgRequirement))
 		})
 
-		It("unassigns a quota from a space", func() {
-			space := models.Space{
-				SpaceFields: models.SpaceFields{
-					Name: "my-space",
-					GUID: "my-space-guid",
-				},
-			}
-
-			quota := models.SpaceQuota{Name: "my-quota", GUID: "my-quota-guid"}
-
-			quotaRepo.FindByNameReturns(quota, nil)
-			spaceRepo.FindByNameReturns(space, nil)
+		Context("when the space has the quota", func() {
+			BeforeEach(func() {
+				quotaRepo.FindByNameAndOrgGUIDReturns(models.SpaceQuota{Name: "my-quota", GUID: "my-quota-guid"}, nil)
+				spaceRepo.FindByNameReturns(models.Space{
+					SpaceFields: models.SpaceFields{
+						Name: "my-space",
+						GUID: "my-space-guid",
+					},
+					SpaceQuotaGUID: "my-quota-guid",
+				}, nil)
+			})
+
+			It("unassigns a quota from a space", func() {
+				Expect(runCommand("my-space", "my-quota")).To(BeTrue())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Unassigning space quota", "my-quota", "my-space", "my-user"},
+					[]string{"OK"},
+				))
+
+				name, orgGUID := quotaRepo.FindByNameAndOrgGUIDArgsForCall(0)
+				Expect(name).To(Equal("my-quota"))
+				Expect(orgGUID).To(Equal("my-org-guid"))
+				spaceGUID, quotaGUID := quotaRepo.UnassignQuotaFromSpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("my-space-guid"))
+				Expect(quotaGUID).To(Equal("my-quota-guid"))
+			})
+		})
 
-			runCommand("my-space", "my-quota")
+		Context("when the space has no quota", func() {
+			BeforeEach(func() {
+				quotaRepo.FindByNameAndOrgGUIDReturns(models.SpaceQuota{Name: "my-quota", GUID: "my-quota-guid"}, nil)
+				spaceRepo.FindByNameReturns(models.Space{
+					SpaceFields: models.SpaceFields{
+						Name: "my-space",
+						GUID: "my-space-guid",
+					},
+				}, nil)
+			})
+
+			It("succeeds without unassigning anything", func() {
+				Expect(runCommand("my-space", "my-quota")).To(BeTrue())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Unassigning space quota", "my-quota", "my-space", "my-user"},
+					[]string{"OK"},
+					[]string{"Space my-space has no space quota assigned."},
+				))
+				Expect(quotaRepo.UnassignQuotaFromSpaceCallCount()).To(Equal(0))
+			})
+		})
 
-			Expect(ui.Outputs()).To(ContainSubstrings(
-				[]string{"Unassigning space quota", "my-quota", "my-space", "my-user"},
-				[]string{"OK"},
-			))
+		Context("when the space has another quota", func() {
+			BeforeEach(func() {
+				quotaRepo.FindByNameAndOrgGUIDReturns(models.SpaceQuota{Name: "my-quota", GUID: "my-quota-guid"}, nil)
+				spaceRepo.FindByNameReturns(models.Space{
+					SpaceFields: models.SpaceFields{
+						Name: "my-space",
+						GUID: "my-space-guid",
+					},
+					SpaceQuotaGUID: "another-quota-guid",
+				}, nil)
+			})
+
+			It("succeeds without unassigning anything", func() {
+				Expect(runCommand("my-space", "my-quota")).To(BeTrue())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"OK"},
+					[]string{"Space quota my-quota is not assigned to space my-space."},
+				))
+				Expect(quotaRepo.UnassignQuotaFromSpaceCallCount()).To(Equal(0))
+			})
+		})
 
-			Expect(quotaRepo.FindByNameArgsForCall(0)).To(Equal("my-quota"))
-			spaceGUID, quotaGUID := quotaRepo.UnassignQuotaFromSpaceArgsForCall(0)
-			Expect(spaceGUID).To(Equal("my-space-guid"))
-			Expect(quotaGUID).To(Equal("my-quota-guid"))
+		Context("when the quota belongs to another org", func() {
+			BeforeEach(func() {
+				quotaRepo.FindByNameAndOrgGUIDReturns(models.SpaceQuota{}, cferrors.NewModelNotFoundError("Space Quota", "my-quota"))
+				quotaRepo.FindAllByNameReturns([]models.SpaceQuota{{Name: "my-quota", OrgGUID: "their-org-guid"}}, nil)
+				spaceRepo.FindByNameReturns(models.Space{SpaceFields: models.SpaceFields{Name: "my-space"}}, nil)
+			})
+
+			It("fails with a clear error", func() {
+				Expect(runCommand("my-space", "my-quota")).To(BeFalse())
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Space quota my-quota belongs to another org. Only space quotas of org my-org can be used."},
+				))
+				Expect(quotaRepo.UnassignQuotaFromSpaceCallCount()).To(Equal(0))
+			})
 		})
 	})
 })
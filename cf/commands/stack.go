@@ -2,12 +2,16 @@ package commands
 
 import (
 	"fmt"
+	"net/http"
+	"strconv"
 
 	"code.cloudfoundry.org/cli/cf/api/stacks"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
@@ -75,10 +79,18 @@ func (cmd *ListStack) Execute(c flags.FlagContext) error {
 				"SpaceName":        terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 				"Username":         terminal.EntityNameColor(cmd.config.Username())}))
 
+		appCount, err := cmd.appCount(stack)
+		if err != nil {
+			return err
+		}
+
 		cmd.ui.Ok()
 		cmd.ui.Say("")
-		table := cmd.ui.Table([]string{T("name"), T("description")})
-		table.Add(stack.Name, stack.Description)
+		table := cmd.ui.Table([]string{"", ""})
+		table.Add(T("guid:"), stack.GUID)
+		table.Add(T("name:"), stack.Name)
+		table.Add(T("description:"), stack.Description)
+		table.Add(T("apps:"), appCount)
 		err = table.Print()
 		if err != nil {
 			return err
@@ -86,3 +98,27 @@ func (cmd *ListStack) Execute(c flags.FlagContext) error {
 	}
 	return nil
 }
+
+// appCount returns the number of apps using the stack in the targeted space,
+// or the targeted org when no space is targeted. When no org is targeted or
+// the user is not permitted to list apps, a note is returned instead.
+func (cmd *ListStack) appCount(stack models.Stack) (string, error) {
+	if !cmd.config.HasOrganization() {
+		return T("(target an org or space to see apps using this stack)"), nil
+	}
+
+	var spaceGUID string
+	if cmd.config.HasSpace() {
+		spaceGUID = cmd.config.SpaceFields().GUID
+	}
+
+	count, err := cmd.stacksRepo.CountApps(stack.GUID, cmd.config.OrganizationFields().GUID, spaceGUID)
+	if err != nil {
+		if httpErr, ok := err.(errors.HTTPError); ok && httpErr.StatusCode() == http.StatusForbidden {
+			return T("(not authorized to list apps)"), nil
+		}
+		return "", err
+	}
+
+	return strconv.Itoa(count), nil
+}
@@ -2,8 +2,10 @@ package commands_test
 
 import (
 	"errors"
+	"net/http"
 
 	"code.cloudfoundry.org/cli/cf/api/stacks/stacksfakes"
+	cferrors "code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
@@ -108,4 +110,94 @@ var _ = Describe("stack command", func() {
 			[]string{"Stack Stack-1 not found"},
 		))
 	})
+
+	Context("when the stack exists", func() {
+		BeforeEach(func() {
+			repo.FindByNameReturns(models.Stack{
+				Name:        "Stack-1",
+				Description: "Stack 1 Description",
+				GUID:        "Stack-1-GUID",
+			}, nil)
+			repo.CountAppsReturns(3, nil)
+		})
+
+		It("displays the stack details and the number of apps in the targeted space using it", func() {
+			testcmd.RunCLICommand("stack", []string{"Stack-1"}, requirementsFactory, updateCommandDependency, false, ui)
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Getting stack 'Stack-1' in org", "my-org", "my-space", "my-user"},
+				[]string{"OK"},
+				[]string{"guid:", "Stack-1-GUID"},
+				[]string{"name:", "Stack-1"},
+				[]string{"description:", "Stack 1 Description"},
+				[]string{"apps:", "3"},
+			))
+
+			Expect(repo.CountAppsCallCount()).To(Equal(1))
+			stackGUID, orgGUID, spaceGUID := repo.CountAppsArgsForCall(0)
+			Expect(stackGUID).To(Equal("Stack-1-GUID"))
+			Expect(orgGUID).To(Equal(config.OrganizationFields().GUID))
+			Expect(spaceGUID).To(Equal(config.SpaceFields().GUID))
+		})
+
+		Context("when only an org is targeted", func() {
+			BeforeEach(func() {
+				config.SetSpaceFields(models.SpaceFields{})
+			})
+
+			It("counts the apps in the targeted org", func() {
+				testcmd.RunCLICommand("stack", []string{"Stack-1"}, requirementsFactory, updateCommandDependency, false, ui)
+
+				_, orgGUID, spaceGUID := repo.CountAppsArgsForCall(0)
+				Expect(orgGUID).To(Equal(config.OrganizationFields().GUID))
+				Expect(spaceGUID).To(BeEmpty())
+			})
+		})
+
+		Context("when no org is targeted", func() {
+			BeforeEach(func() {
+				config.SetOrganizationFields(models.OrganizationFields{})
+				config.SetSpaceFields(models.SpaceFields{})
+			})
+
+			It("skips the app count with a note", func() {
+				testcmd.RunCLICommand("stack", []string{"Stack-1"}, requirementsFactory, updateCommandDependency, false, ui)
+
+				Expect(repo.CountAppsCallCount()).To(Equal(0))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"apps:", "target an org or space"},
+				))
+			})
+		})
+
+		Context("when the user is not permitted to list apps", func() {
+			BeforeEach(func() {
+				repo.CountAppsReturns(0, cferrors.NewHTTPError(http.StatusForbidden, "10003", "You are not authorized to perform the requested action"))
+			})
+
+			It("skips the app count with a note", func() {
+				testcmd.RunCLICommand("stack", []string{"Stack-1"}, requirementsFactory, updateCommandDependency, false, ui)
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"OK"},
+					[]string{"apps:", "not authorized to list apps"},
+				))
+			})
+		})
+
+		Context("when counting the apps fails", func() {
+			BeforeEach(func() {
+				repo.CountAppsReturns(0, errors.New("count error"))
+			})
+
+			It("fails with the error", func() {
+				testcmd.RunCLICommand("stack", []string{"Stack-1"}, requirementsFactory, updateCommandDependency, false, ui)
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"count error"},
+				))
+			})
+		})
+	})
 })
@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/cf/api/stacks"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -16,17 +18,27 @@ type ListStacks struct {
 	stacksRepo stacks.StackRepository
 }
 
+type stackJSON struct {
+	GUID        string `json:"guid"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
 func init() {
 	commandregistry.Register(&ListStacks{})
 }
 
 func (cmd *ListStacks) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["json"] = &flags.BoolFlag{Name: "json", Usage: T("Output the stacks as JSON")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "stacks",
 		Description: T("List all stacks (a stack is a pre-built file system, including an operating system, that can run apps)"),
 		Usage: []string{
-			T("CF_NAME stacks"),
+			T("CF_NAME stacks [--json]"),
 		},
+		Flags: fs,
 	}
 }
 
@@ -54,6 +66,10 @@ func (cmd *ListStacks) SetDependency(deps commandregistry.Dependency, pluginCall
 }
 
 func (cmd *ListStacks) Execute(c flags.FlagContext) error {
+	if c.Bool("json") {
+		return cmd.listStacksJSON()
+	}
+
 	cmd.ui.Say(T("Getting stacks in org {{.OrganizationName}} / space {{.SpaceName}} as {{.Username}}...",
 		map[string]interface{}{"OrganizationName": terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
 			"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
@@ -79,3 +95,27 @@ func (cmd *ListStacks) Execute(c flags.FlagContext) error {
 	}
 	return nil
 }
+
+func (cmd *ListStacks) listStacksJSON() error {
+	stacks, err := cmd.stacksRepo.FindAll()
+	if err != nil {
+		return err
+	}
+
+	stacksJSON := make([]stackJSON, 0, len(stacks))
+	for _, stack := range stacks {
+		stacksJSON = append(stacksJSON, stackJSON{
+			GUID:        stack.GUID,
+			Name:        stack.Name,
+			Description: stack.Description,
+		})
+	}
+
+	jsonBytes, err := json.MarshalIndent(stacksJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say(string(jsonBytes))
+	return nil
+}
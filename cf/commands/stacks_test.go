@@ -1,6 +1,9 @@
 package commands_test
 
 import (
+	"encoding/json"
+	"strings"
+
 	"code.cloudfoundry.org/cli/cf/api/stacks/stacksfakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -93,4 +96,25 @@ var _ = Describe("stacks command", func() {
 			[]string{"Stack-2", "Stack 2 Description"},
 		))
 	})
+
+	It("lists the stacks as JSON when given the --json flag", func() {
+		repo.FindAllReturns([]models.Stack{
+			{GUID: "stack-guid-1", Name: "Stack-1", Description: "Stack 1 Description"},
+			{GUID: "stack-guid-2", Name: "Stack-2", Description: "Stack 2 Description"},
+		}, nil)
+		testcmd.RunCLICommand("stacks", []string{"--json"}, requirementsFactory, updateCommandDependency, false, ui)
+
+		var output []map[string]string
+		Expect(json.Unmarshal([]byte(strings.Join(ui.Outputs(), "\n")), &output)).To(Succeed())
+		Expect(output).To(Equal([]map[string]string{
+			{"guid": "stack-guid-1", "name": "Stack-1", "description": "Stack 1 Description"},
+			{"guid": "stack-guid-2", "name": "Stack-2", "description": "Stack 2 Description"},
+		}))
+	})
+
+	It("outputs an empty JSON array when there are no stacks", func() {
+		testcmd.RunCLICommand("stacks", []string{"--json"}, requirementsFactory, updateCommandDependency, false, ui)
+
+		Expect(ui.Outputs()).To(Equal([]string{"[]"}))
+	})
 })
@@ -2,6 +2,7 @@ package user
 
 import (
 	"fmt"
+	"strings"
 
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
@@ -9,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
@@ -26,12 +28,13 @@ func init() {
 func (cmd *DeleteUser) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force deletion without confirmation")}
+	fs["origin"] = &flags.StringFlag{Name: "origin", Usage: T("Origin of the user to delete, required when users with the same username exist in several origins")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "delete-user",
 		Description: T("Delete a user"),
 		Usage: []string{
-			T("CF_NAME delete-user USERNAME [-f]"),
+			T("CF_NAME delete-user USERNAME [-f] [--origin ORIGIN]"),
 		},
 		Flags: fs,
 	}
@@ -60,6 +63,7 @@ func (cmd *DeleteUser) SetDependency(deps commandregistry.Dependency, pluginCall
 func (cmd *DeleteUser) Execute(c flags.FlagContext) error {
 	username := c.Args()[0]
 	force := c.Bool("f")
+	origin := c.String("origin")
 
 	if !force && !cmd.ui.ConfirmDelete(T("user"), username) {
 		return nil
@@ -72,19 +76,30 @@ func (cmd *DeleteUser) Execute(c flags.FlagContext) error {
 		}))
 
 	users, err := cmd.userRepo.FindAllByUsername(username)
+	if err == nil && origin != "" {
+		users = filterUsersByOrigin(users, origin)
+		if len(users) == 0 {
+			err = errors.NewModelNotFoundError("User", username)
+		}
+	}
 
 	switch err.(type) {
 	case nil:
 		if len(users) > 1 {
 			return fmt.Errorf(T(
-				"Error deleting user {{.Username}} \nMultiple users with that username found. Please use 'cf curl' to delete the user by guid instead.",
+				"Error deleting user {{.Username}} \nMultiple users with that username found in origins {{.Origins}}. Please use '--origin' to choose the user to delete.",
 				map[string]interface{}{
 					"Username": username,
+					"Origins":  strings.Join(userOrigins(users), ", "),
 				}))
 		}
 	case *errors.ModelNotFoundError:
 		cmd.ui.Ok()
-		cmd.ui.Warn(T("User {{.TargetUser}} does not exist.", map[string]interface{}{"TargetUser": username}))
+		if origin != "" {
+			cmd.ui.Warn(T("User {{.TargetUser}} does not exist in origin {{.Origin}}.", map[string]interface{}{"TargetUser": username, "Origin": origin}))
+		} else {
+			cmd.ui.Warn(T("User {{.TargetUser}} does not exist.", map[string]interface{}{"TargetUser": username}))
+		}
 		return nil
 	default:
 		return err
@@ -98,3 +113,21 @@ func (cmd *DeleteUser) Execute(c flags.FlagContext) error {
 	cmd.ui.Ok()
 	return nil
 }
+
+func filterUsersByOrigin(users []models.UserFields, origin string) []models.UserFields {
+	var filtered []models.UserFields
+	for _, user := range users {
+		if strings.EqualFold(user.Origin, origin) {
+			filtered = append(filtered, user)
+		}
+	}
+	return filtered
+}
+
+func userOrigins(users []models.UserFields) []string {
+	origins := make([]string, 0, len(users))
+	for _, user := range users {
+		origins = append(origins, user.Origin)
+	}
+	return origins
+}
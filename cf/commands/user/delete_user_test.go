@@ -112,6 +112,41 @@ var _ = Describe("delete-user command", func() {
 		})
 	})
 
+	Context("when users with the given name exist in several origins", func() {
+		BeforeEach(func() {
+			userRepo.FindAllByUsernameReturns([]models.UserFields{
+				{Username: "user-name", GUID: "uaa-guid", Origin: "uaa"},
+				{Username: "user-name", GUID: "ldap-guid", Origin: "ldap"},
+			}, nil)
+		})
+
+		It("fails and lists the origins", func() {
+			runCommand("-f", "user-name")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Multiple users with that username found in origins uaa, ldap", "--origin"},
+			))
+			Expect(userRepo.DeleteCallCount()).To(BeZero())
+		})
+
+		It("deletes the user in the origin given with --origin", func() {
+			runCommand("-f", "--origin", "ldap", "user-name")
+
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"OK"}))
+			Expect(userRepo.DeleteCallCount()).To(Equal(1))
+			Expect(userRepo.DeleteArgsForCall(0)).To(Equal("ldap-guid"))
+		})
+
+		It("warns when no user exists in the origin given with --origin", func() {
+			runCommand("-f", "--origin", "saml", "user-name")
+
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"OK"}))
+			Expect(ui.WarnOutputs).To(ContainSubstrings([]string{"User user-name does not exist in origin saml."}))
+			Expect(userRepo.DeleteCallCount()).To(BeZero())
+		})
+	})
+
 	Context("when the given user does not exist", func() {
 		BeforeEach(func() {
 			userRepo.FindAllByUsernameReturns(nil, errors.NewModelNotFoundError("User", ""))
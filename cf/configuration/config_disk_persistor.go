@@ -3,6 +3,9 @@ package configuration
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/util/lockedfile"
 )
 
 const (
@@ -26,6 +29,12 @@ type DataInterface interface {
 	JSONUnmarshalV3([]byte) error
 }
 
+// SavedDataMerger is implemented by data that takes the changes other
+// processes saved since it was loaded into account before it is saved.
+type SavedDataMerger interface {
+	MergeSaved(saved []byte)
+}
+
 type DiskPersistor struct {
 	filePath string
 }
@@ -75,16 +84,62 @@ func (dp DiskPersistor) read(data DataInterface) error {
 		return err
 	}
 
+	// An empty file is treated as missing. Older versions of the CLI could
+	// leave one behind when they were interrupted while writing it.
+	if len(jsonBytes) == 0 {
+		return &os.PathError{Op: "read", Path: dp.filePath, Err: os.ErrNotExist}
+	}
+
 	err = data.JSONUnmarshalV3(jsonBytes)
 	return err
 }
 
+// write replaces the file atomically while holding a lock shared with other
+// cf processes, so concurrent invocations never leave a partially written
+// file behind. The temp file is named like the ones the V3 config writer
+// creates, so both clean up after each other.
 func (dp DiskPersistor) write(data DataInterface) error {
+	err := dp.makeDirectory()
+	if err != nil {
+		return err
+	}
+
+	lock, err := lockedfile.Lock(dp.filePath)
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock()
+
+	if merger, ok := data.(SavedDataMerger); ok {
+		saved, readErr := ioutil.ReadFile(dp.filePath)
+		if readErr == nil && len(saved) > 0 {
+			merger.MergeSaved(saved)
+		}
+	}
+
 	bytes, err := data.JSONMarshalV3()
 	if err != nil {
 		return err
 	}
 
-	err = ioutil.WriteFile(dp.filePath, bytes, filePermissions)
+	tempFile, err := ioutil.TempFile(filepath.Dir(dp.filePath), "temp-config")
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(bytes)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tempFile.Name(), filePermissions)
+	}
+	if err == nil {
+		err = lockedfile.Rename(tempFile.Name(), dp.filePath)
+	}
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+	}
 	return err
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	. "code.cloudfoundry.org/cli/cf/configuration"
 	. "github.com/onsi/ginkgo"
@@ -30,6 +31,7 @@ var _ = Describe("DiskPersistor", func() {
 
 	AfterEach(func() {
 		os.Remove(tmpFile.Name())
+		os.Remove(tmpFile.Name() + ".lock")
 	})
 
 	Describe(".Delete", func() {
@@ -54,6 +56,54 @@ var _ = Describe("DiskPersistor", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(string(dataBytes)).To(ContainSubstring(d.Info))
 		})
+
+		Context("when the directory is private to the config file", func() {
+			var configDir string
+
+			BeforeEach(func() {
+				var err error
+				configDir, err = ioutil.TempDir("", "cf-config")
+				Expect(err).ToNot(HaveOccurred())
+				diskPersistor = NewDiskPersistor(filepath.Join(configDir, "config.json"))
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(configDir)
+			})
+
+			It("replaces the file without leaving temp files behind", func() {
+				Expect(diskPersistor.Save(&data{Info: "first"})).To(Succeed())
+				Expect(diskPersistor.Save(&data{Info: "second"})).To(Succeed())
+
+				dataBytes, err := ioutil.ReadFile(filepath.Join(configDir, "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(dataBytes)).To(ContainSubstring("second"))
+
+				tempFileNames, err := filepath.Glob(filepath.Join(configDir, "temp-config?*"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tempFileNames).To(BeEmpty())
+			})
+
+			It("keeps the file private to the user", func() {
+				Expect(diskPersistor.Save(&data{Info: "secret"})).To(Succeed())
+
+				info, err := os.Stat(filepath.Join(configDir, "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+			})
+
+			It("lets the data merge what was saved in the meantime", func() {
+				Expect(diskPersistor.Save(&data{Info: "saved by another process"})).To(Succeed())
+
+				d := &mergingData{}
+				Expect(diskPersistor.Save(d)).To(Succeed())
+				Expect(d.Info).To(Equal("merged saved by another process"))
+
+				dataBytes, err := ioutil.ReadFile(filepath.Join(configDir, "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(dataBytes)).To(ContainSubstring("merged saved by another process"))
+			})
+		})
 	})
 
 	Describe(".Load", func() {
@@ -75,6 +125,18 @@ var _ = Describe("DiskPersistor", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(d.Info).To(Equal("test string"))
 		})
+
+		It("treats an empty file as missing and writes the data to it", func() {
+			d := &data{Info: "default"}
+
+			err := diskPersistor.Load(d)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(d.Info).To(Equal("default"))
+
+			dataBytes, err := ioutil.ReadFile(tmpFile.Name())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(dataBytes)).To(ContainSubstring("default"))
+		})
 	})
 })
 
@@ -89,3 +151,13 @@ func (d *data) JSONMarshalV3() ([]byte, error) {
 func (d *data) JSONUnmarshalV3(data []byte) error {
 	return json.Unmarshal(data, d)
 }
+
+type mergingData struct {
+	data
+}
+
+func (d *mergingData) MergeSaved(saved []byte) {
+	var savedData data
+	Expect(json.Unmarshal(saved, &savedData)).To(Succeed())
+	d.Info = "merged " + savedData.Info
+}
@@ -10,6 +10,9 @@ type TokenInfo struct {
 	Username string `json:"user_name"`
 	Email    string `json:"email"`
 	UserGUID string `json:"user_id"`
+	ClientID string `json:"client_id"`
+	Issuer   string `json:"iss,omitempty"`
+	Expiry   int64  `json:"exp,omitempty"`
 }
 
 func NewTokenInfo(accessToken string) (info TokenInfo) {
@@ -26,6 +26,7 @@ func (a APIConfigRefresher) Refresh() (Warning, error) {
 
 	if endpoint != a.Config.APIEndpoint() {
 		a.Config.ClearSession()
+		a.Config.SetCACert("")
 	}
 
 	a.Config.SetAPIEndpoint(endpoint)
@@ -68,5 +68,44 @@ var _ = Describe("APIConfigRefresher", func() {
 				Expect(warning).To(BeNil())
 			})
 		})
+
+		Context("when the endpoint changes", func() {
+			var (
+				r            APIConfigRefresher
+				config       *coreconfigfakes.FakeReadWriter
+				endpointRepo *coreconfigfakes.FakeEndpointRepository
+			)
+
+			BeforeEach(func() {
+				endpointRepo = new(coreconfigfakes.FakeEndpointRepository)
+				config = new(coreconfigfakes.FakeReadWriter)
+				config.APIEndpointReturns("https://api.old.endpoint.com")
+
+				r = APIConfigRefresher{
+					EndpointRepo: endpointRepo,
+					Config:       config,
+					Endpoint:     "api.some.endpoint.com",
+				}
+			})
+
+			It("removes the CA cert of the previous endpoint", func() {
+				endpointRepo.GetCCInfoReturns(&CCInfo{}, "https://api.some.endpoint.com", nil)
+				_, err := r.Refresh()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(config.SetCACertCallCount()).To(Equal(1))
+				Expect(config.SetCACertArgsForCall(0)).To(BeEmpty())
+			})
+
+			Context("when the endpoint stays the same", func() {
+				It("keeps the CA cert", func() {
+					endpointRepo.GetCCInfoReturns(&CCInfo{}, "https://api.old.endpoint.com", nil)
+					_, err := r.Refresh()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(config.SetCACertCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 })
@@ -29,11 +29,15 @@ type Data struct {
 	AccessToken              string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+	UAAGrantType             string
+	UAAIssuer                string
+	FoundationCheckDisabled  bool
 	SSHOAuthClient           string
 	RefreshToken             string
 	OrganizationFields       models.OrganizationFields
 	SpaceFields              models.SpaceFields
 	SSLDisabled              bool
+	CACert                   string `json:",omitempty"`
 	AsyncTimeout             uint
 	Trace                    string
 	ColorEnabled             string
@@ -41,6 +45,11 @@ type Data struct {
 	PluginRepos              []models.PluginRepo
 	MinCLIVersion            string
 	MinRecommendedCLIVersion string
+
+	// persistedAccessToken and persistedRefreshToken are the tokens in the
+	// config file when the repository last loaded or saved it.
+	persistedAccessToken  string
+	persistedRefreshToken string
 }
 
 func NewData() *Data {
@@ -70,3 +79,28 @@ func (d *Data) JSONUnmarshalV3(input []byte) error {
 
 	return nil
 }
+
+// MergeSaved takes the tokens that another cf process saved since d was
+// loaded, unless d's tokens were changed since. Otherwise a process that did
+// not refresh its tokens would clobber the refresh token just issued to a
+// concurrent process. Tokens saved for a different target are never taken.
+func (d *Data) MergeSaved(saved []byte) {
+	if d.AccessToken != d.persistedAccessToken || d.RefreshToken != d.persistedRefreshToken {
+		return
+	}
+
+	var savedData Data
+	if json.Unmarshal(saved, &savedData) != nil || savedData.ConfigVersion != 3 {
+		return
+	}
+
+	if savedData.Target == d.Target {
+		d.AccessToken = savedData.AccessToken
+		d.RefreshToken = savedData.RefreshToken
+	}
+}
+
+func (d *Data) markPersisted() {
+	d.persistedAccessToken = d.AccessToken
+	d.persistedRefreshToken = d.RefreshToken
+}
@@ -21,6 +21,9 @@ var _ = Describe("V3 Config files", func() {
 		"AccessToken": "the-access-token",
 		"UAAOAuthClient": "cf-oauth-client-id",
 		"UAAOAuthClientSecret": "cf-oauth-client-secret",
+		"UAAGrantType": "client_credentials",
+		"UAAIssuer": "https://uaa.example.com/oauth/token",
+		"FoundationCheckDisabled": true,
 		"SSHOAuthClient": "ssh-oauth-client-id",
 		"RefreshToken": "the-refresh-token",
 		"OrganizationFields": {
@@ -125,6 +128,9 @@ var _ = Describe("V3 Config files", func() {
 				RefreshToken:             "the-refresh-token",
 				UAAOAuthClient:           "cf-oauth-client-id",
 				UAAOAuthClientSecret:     "cf-oauth-client-secret",
+				UAAGrantType:             "client_credentials",
+				UAAIssuer:                "https://uaa.example.com/oauth/token",
+				FoundationCheckDisabled:  true,
 				SSHOAuthClient:           "ssh-oauth-client-id",
 				MinCLIVersion:            "6.0.0",
 				MinRecommendedCLIVersion: "6.9.0",
@@ -176,6 +182,9 @@ var _ = Describe("V3 Config files", func() {
 				RefreshToken:             "the-refresh-token",
 				UAAOAuthClient:           "cf-oauth-client-id",
 				UAAOAuthClientSecret:     "cf-oauth-client-secret",
+				UAAGrantType:             "client_credentials",
+				UAAIssuer:                "https://uaa.example.com/oauth/token",
+				FoundationCheckDisabled:  true,
 				SSHOAuthClient:           "ssh-oauth-client-id",
 				MinCLIVersion:            "6.0.0",
 				MinRecommendedCLIVersion: "6.9.0",
@@ -1,11 +1,13 @@
 package coreconfig
 
 import (
+	"os"
 	"strings"
 	"sync"
 
 	"code.cloudfoundry.org/cli/cf/configuration"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/cacert"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
 )
@@ -67,6 +69,8 @@ type Reader interface {
 	AccessToken() string
 	UAAOAuthClient() string
 	UAAOAuthClientSecret() string
+	UAAGrantType() string
+	UAAIssuer() string
 	SSHOAuthClient() string
 	RefreshToken() string
 
@@ -81,6 +85,7 @@ type Reader interface {
 	UserEmail() string
 	IsLoggedIn() bool
 	IsSSLDisabled() bool
+	CACert() string
 	IsMinAPIVersion(semver.Version) bool
 	IsMinCLIVersion(string) bool
 	MinCLIVersion() string
@@ -89,6 +94,7 @@ type Reader interface {
 
 	AsyncTimeout() uint
 	Trace() string
+	FoundationCheckDisabled() bool
 
 	ColorEnabled() string
 
@@ -113,13 +119,17 @@ type ReadWriter interface {
 	SetAccessToken(string)
 	SetUAAOAuthClient(string)
 	SetUAAOAuthClientSecret(string)
+	SetUAAGrantType(string)
+	SetUAAIssuer(string)
 	SetSSHOAuthClient(string)
 	SetRefreshToken(string)
 	SetOrganizationFields(models.OrganizationFields)
 	SetSpaceFields(models.SpaceFields)
 	SetSSLDisabled(bool)
+	SetCACert(string)
 	SetAsyncTimeout(uint)
 	SetTrace(string)
+	SetFoundationCheckDisabled(bool)
 	SetColorEnabled(string)
 	SetLocale(string)
 	SetPluginRepo(models.PluginRepo)
@@ -142,6 +152,7 @@ func (c *ConfigRepository) init() {
 		if err != nil {
 			c.onError(err)
 		}
+		c.data.markPersisted()
 	})
 }
 
@@ -163,7 +174,9 @@ func (c *ConfigRepository) write(cb func()) {
 	err := c.persistor.Save(c.data)
 	if err != nil {
 		c.onError(err)
+		return
 	}
+	c.data.markPersisted()
 }
 
 // CLOSERS
@@ -229,6 +242,22 @@ func (c *ConfigRepository) HasAPIEndpoint() (hasEndpoint bool) {
 	return
 }
 
+// CACert returns the PEM encoded CA certificates trusted for the target. The
+// file at $CF_CA_CERT takes precedence over the certificates stored by
+// 'cf api --ca-cert'.
+func (c *ConfigRepository) CACert() (caCert string) {
+	if path := os.Getenv("CF_CA_CERT"); path != "" {
+		if override, err := cacert.Load(path); err == nil {
+			return string(override)
+		}
+	}
+
+	c.read(func() {
+		caCert = c.data.CACert
+	})
+	return
+}
+
 func (c *ConfigRepository) AccessToken() (accessToken string) {
 	c.read(func() {
 		accessToken = c.data.AccessToken
@@ -250,6 +279,27 @@ func (c *ConfigRepository) UAAOAuthClientSecret() (clientID string) {
 	return
 }
 
+func (c *ConfigRepository) UAAGrantType() (grantType string) {
+	c.read(func() {
+		grantType = c.data.UAAGrantType
+	})
+	return
+}
+
+func (c *ConfigRepository) UAAIssuer() (issuer string) {
+	c.read(func() {
+		issuer = c.data.UAAIssuer
+	})
+	return
+}
+
+func (c *ConfigRepository) FoundationCheckDisabled() (disabled bool) {
+	c.read(func() {
+		disabled = c.data.FoundationCheckDisabled
+	})
+	return
+}
+
 func (c *ConfigRepository) SSHOAuthClient() (clientID string) {
 	c.read(func() {
 		clientID = c.data.SSHOAuthClient
@@ -294,7 +344,11 @@ func (c *ConfigRepository) UserGUID() (guid string) {
 
 func (c *ConfigRepository) Username() (name string) {
 	c.read(func() {
-		name = NewTokenInfo(c.data.AccessToken).Username
+		info := NewTokenInfo(c.data.AccessToken)
+		name = info.Username
+		if name == "" {
+			name = info.ClientID
+		}
 	})
 	return
 }
@@ -431,8 +485,15 @@ func (c *ConfigRepository) ClearSession() {
 	c.write(func() {
 		c.data.AccessToken = ""
 		c.data.RefreshToken = ""
+		c.data.UAAIssuer = ""
 		c.data.OrganizationFields = models.OrganizationFields{}
 		c.data.SpaceFields = models.SpaceFields{}
+
+		if c.data.UAAGrantType == "client_credentials" {
+			c.data.UAAOAuthClient = "cf"
+			c.data.UAAOAuthClientSecret = ""
+		}
+		c.data.UAAGrantType = ""
 	})
 }
 
@@ -502,6 +563,24 @@ func (c *ConfigRepository) SetUAAOAuthClientSecret(clientID string) {
 	})
 }
 
+func (c *ConfigRepository) SetUAAGrantType(grantType string) {
+	c.write(func() {
+		c.data.UAAGrantType = grantType
+	})
+}
+
+func (c *ConfigRepository) SetUAAIssuer(issuer string) {
+	c.write(func() {
+		c.data.UAAIssuer = issuer
+	})
+}
+
+func (c *ConfigRepository) SetFoundationCheckDisabled(disabled bool) {
+	c.write(func() {
+		c.data.FoundationCheckDisabled = disabled
+	})
+}
+
 func (c *ConfigRepository) SetSSHOAuthClient(clientID string) {
 	c.write(func() {
 		c.data.SSHOAuthClient = clientID
@@ -532,6 +611,12 @@ func (c *ConfigRepository) SetSSLDisabled(disabled bool) {
 	})
 }
 
+func (c *ConfigRepository) SetCACert(caCert string) {
+	c.write(func() {
+		c.data.CACert = caCert
+	})
+}
+
 func (c *ConfigRepository) SetAsyncTimeout(timeout uint) {
 	c.write(func() {
 		c.data.AsyncTimeout = timeout
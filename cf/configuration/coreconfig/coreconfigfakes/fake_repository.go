@@ -318,9 +318,53 @@ type FakeRepository struct {
 	setCLIVersionArgsForCall []struct {
 		arg1 string
 	}
-	CloseStub        func()
-	closeMutex       sync.RWMutex
-	closeArgsForCall []struct{}
+	CloseStub               func()
+	closeMutex              sync.RWMutex
+	closeArgsForCall        []struct{}
+	UAAGrantTypeStub        func() string
+	uaaGrantTypeMutex       sync.RWMutex
+	uaaGrantTypeArgsForCall []struct{}
+	uaaGrantTypeReturns     struct {
+		result1 string
+	}
+	SetUAAGrantTypeStub        func(arg1 string)
+	setUAAGrantTypeMutex       sync.RWMutex
+	setUAAGrantTypeArgsForCall []struct {
+		arg1 string
+	}
+	UAAIssuerStub        func() string
+	uaaIssuerMutex       sync.RWMutex
+	uaaIssuerArgsForCall []struct{}
+	uaaIssuerReturns     struct {
+		result1 string
+	}
+	FoundationCheckDisabledStub        func() bool
+	foundationCheckDisabledMutex       sync.RWMutex
+	foundationCheckDisabledArgsForCall []struct{}
+	foundationCheckDisabledReturns     struct {
+		result1 bool
+	}
+	SetUAAIssuerStub        func(arg1 string)
+	setUAAIssuerMutex       sync.RWMutex
+	setUAAIssuerArgsForCall []struct {
+		arg1 string
+	}
+	SetFoundationCheckDisabledStub        func(arg1 bool)
+	setFoundationCheckDisabledMutex       sync.RWMutex
+	setFoundationCheckDisabledArgsForCall []struct {
+		arg1 bool
+	}
+	CACertStub        func() string
+	caCertMutex       sync.RWMutex
+	caCertArgsForCall []struct{}
+	caCertReturns     struct {
+		result1 string
+	}
+	SetCACertStub        func(arg1 string)
+	setCACertMutex       sync.RWMutex
+	setCACertArgsForCall []struct {
+		arg1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1700,6 +1744,202 @@ func (fake *FakeRepository) CloseCallCount() int {
 	return len(fake.closeArgsForCall)
 }
 
+func (fake *FakeRepository) UAAGrantType() string {
+	fake.uaaGrantTypeMutex.Lock()
+	fake.uaaGrantTypeArgsForCall = append(fake.uaaGrantTypeArgsForCall, struct{}{})
+	fake.recordInvocation("UAAGrantType", []interface{}{})
+	fake.uaaGrantTypeMutex.Unlock()
+	if fake.UAAGrantTypeStub != nil {
+		return fake.UAAGrantTypeStub()
+	} else {
+		return fake.uaaGrantTypeReturns.result1
+	}
+}
+
+func (fake *FakeRepository) UAAGrantTypeCallCount() int {
+	fake.uaaGrantTypeMutex.RLock()
+	defer fake.uaaGrantTypeMutex.RUnlock()
+	return len(fake.uaaGrantTypeArgsForCall)
+}
+
+func (fake *FakeRepository) UAAGrantTypeReturns(result1 string) {
+	fake.UAAGrantTypeStub = nil
+	fake.uaaGrantTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRepository) SetUAAGrantType(arg1 string) {
+	fake.setUAAGrantTypeMutex.Lock()
+	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetUAAGrantType", []interface{}{arg1})
+	fake.setUAAGrantTypeMutex.Unlock()
+	if fake.SetUAAGrantTypeStub != nil {
+		fake.SetUAAGrantTypeStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetUAAGrantTypeCallCount() int {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return len(fake.setUAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeRepository) SetUAAGrantTypeArgsForCall(i int) string {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return fake.setUAAGrantTypeArgsForCall[i].arg1
+}
+
+func (fake *FakeRepository) UAAIssuer() string {
+	fake.uaaIssuerMutex.Lock()
+	fake.uaaIssuerArgsForCall = append(fake.uaaIssuerArgsForCall, struct{}{})
+	fake.recordInvocation("UAAIssuer", []interface{}{})
+	fake.uaaIssuerMutex.Unlock()
+	if fake.UAAIssuerStub != nil {
+		return fake.UAAIssuerStub()
+	} else {
+		return fake.uaaIssuerReturns.result1
+	}
+}
+
+func (fake *FakeRepository) UAAIssuerCallCount() int {
+	fake.uaaIssuerMutex.RLock()
+	defer fake.uaaIssuerMutex.RUnlock()
+	return len(fake.uaaIssuerArgsForCall)
+}
+
+func (fake *FakeRepository) UAAIssuerReturns(result1 string) {
+	fake.UAAIssuerStub = nil
+	fake.uaaIssuerReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRepository) FoundationCheckDisabled() bool {
+	fake.foundationCheckDisabledMutex.Lock()
+	fake.foundationCheckDisabledArgsForCall = append(fake.foundationCheckDisabledArgsForCall, struct{}{})
+	fake.recordInvocation("FoundationCheckDisabled", []interface{}{})
+	fake.foundationCheckDisabledMutex.Unlock()
+	if fake.FoundationCheckDisabledStub != nil {
+		return fake.FoundationCheckDisabledStub()
+	} else {
+		return fake.foundationCheckDisabledReturns.result1
+	}
+}
+
+func (fake *FakeRepository) FoundationCheckDisabledCallCount() int {
+	fake.foundationCheckDisabledMutex.RLock()
+	defer fake.foundationCheckDisabledMutex.RUnlock()
+	return len(fake.foundationCheckDisabledArgsForCall)
+}
+
+func (fake *FakeRepository) FoundationCheckDisabledReturns(result1 bool) {
+	fake.FoundationCheckDisabledStub = nil
+	fake.foundationCheckDisabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeRepository) SetUAAIssuer(arg1 string) {
+	fake.setUAAIssuerMutex.Lock()
+	fake.setUAAIssuerArgsForCall = append(fake.setUAAIssuerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetUAAIssuer", []interface{}{arg1})
+	fake.setUAAIssuerMutex.Unlock()
+	if fake.SetUAAIssuerStub != nil {
+		fake.SetUAAIssuerStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetUAAIssuerCallCount() int {
+	fake.setUAAIssuerMutex.RLock()
+	defer fake.setUAAIssuerMutex.RUnlock()
+	return len(fake.setUAAIssuerArgsForCall)
+}
+
+func (fake *FakeRepository) SetUAAIssuerArgsForCall(i int) string {
+	fake.setUAAIssuerMutex.RLock()
+	defer fake.setUAAIssuerMutex.RUnlock()
+	return fake.setUAAIssuerArgsForCall[i].arg1
+}
+
+func (fake *FakeRepository) SetFoundationCheckDisabled(arg1 bool) {
+	fake.setFoundationCheckDisabledMutex.Lock()
+	fake.setFoundationCheckDisabledArgsForCall = append(fake.setFoundationCheckDisabledArgsForCall, struct {
+		arg1 bool
+	}{arg1})
+	fake.recordInvocation("SetFoundationCheckDisabled", []interface{}{arg1})
+	fake.setFoundationCheckDisabledMutex.Unlock()
+	if fake.SetFoundationCheckDisabledStub != nil {
+		fake.SetFoundationCheckDisabledStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetFoundationCheckDisabledCallCount() int {
+	fake.setFoundationCheckDisabledMutex.RLock()
+	defer fake.setFoundationCheckDisabledMutex.RUnlock()
+	return len(fake.setFoundationCheckDisabledArgsForCall)
+}
+
+func (fake *FakeRepository) SetFoundationCheckDisabledArgsForCall(i int) bool {
+	fake.setFoundationCheckDisabledMutex.RLock()
+	defer fake.setFoundationCheckDisabledMutex.RUnlock()
+	return fake.setFoundationCheckDisabledArgsForCall[i].arg1
+}
+
+func (fake *FakeRepository) CACert() string {
+	fake.caCertMutex.Lock()
+	fake.caCertArgsForCall = append(fake.caCertArgsForCall, struct{}{})
+	fake.recordInvocation("CACert", []interface{}{})
+	fake.caCertMutex.Unlock()
+	if fake.CACertStub != nil {
+		return fake.CACertStub()
+	} else {
+		return fake.caCertReturns.result1
+	}
+}
+
+func (fake *FakeRepository) CACertCallCount() int {
+	fake.caCertMutex.RLock()
+	defer fake.caCertMutex.RUnlock()
+	return len(fake.caCertArgsForCall)
+}
+
+func (fake *FakeRepository) CACertReturns(result1 string) {
+	fake.CACertStub = nil
+	fake.caCertReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRepository) SetCACert(arg1 string) {
+	fake.setCACertMutex.Lock()
+	fake.setCACertArgsForCall = append(fake.setCACertArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetCACert", []interface{}{arg1})
+	fake.setCACertMutex.Unlock()
+	if fake.SetCACertStub != nil {
+		fake.SetCACertStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetCACertCallCount() int {
+	fake.setCACertMutex.RLock()
+	defer fake.setCACertMutex.RUnlock()
+	return len(fake.setCACertArgsForCall)
+}
+
+func (fake *FakeRepository) SetCACertArgsForCall(i int) string {
+	fake.setCACertMutex.RLock()
+	defer fake.setCACertMutex.RUnlock()
+	return fake.setCACertArgsForCall[i].arg1
+}
+
 func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1815,6 +2055,22 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.setCLIVersionMutex.RUnlock()
 	fake.closeMutex.RLock()
 	defer fake.closeMutex.RUnlock()
+	fake.uaaGrantTypeMutex.RLock()
+	defer fake.uaaGrantTypeMutex.RUnlock()
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	fake.uaaIssuerMutex.RLock()
+	defer fake.uaaIssuerMutex.RUnlock()
+	fake.foundationCheckDisabledMutex.RLock()
+	defer fake.foundationCheckDisabledMutex.RUnlock()
+	fake.setUAAIssuerMutex.RLock()
+	defer fake.setUAAIssuerMutex.RUnlock()
+	fake.setFoundationCheckDisabledMutex.RLock()
+	defer fake.setFoundationCheckDisabledMutex.RUnlock()
+	fake.caCertMutex.RLock()
+	defer fake.caCertMutex.RUnlock()
+	fake.setCACertMutex.RLock()
+	defer fake.setCACertMutex.RUnlock()
 	return fake.invocations
 }
 
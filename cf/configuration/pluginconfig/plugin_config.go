@@ -14,6 +14,7 @@ type PluginConfiguration interface {
 	GetPluginPath() string
 	RemovePlugin(string)
 	ListCommands() []string
+	ListAliases() []string
 }
 
 type PluginConfig struct {
@@ -73,6 +74,21 @@ func (c *PluginConfig) ListCommands() []string {
 	return allCommands
 }
 
+func (c *PluginConfig) ListAliases() []string {
+	plugins := c.Plugins()
+	allAliases := []string{}
+
+	for _, plugin := range plugins {
+		for _, command := range plugin.Commands {
+			if command.Alias != "" {
+				allAliases = append(allAliases, command.Alias)
+			}
+		}
+	}
+
+	return allAliases
+}
+
 func (c *PluginConfig) init() {
 	//only read from disk if it was never read
 	c.initOnce.Do(func() {
@@ -31,7 +31,8 @@ var _ = Describe("PluginConfig", func() {
 			plugin1 := PluginMetadata{
 				Commands: []plugin.Command{
 					{
-						Name: "plugin1-command1",
+						Name:  "plugin1-command1",
+						Alias: "p1c1",
 					},
 					{
 						Name: "plugin1-command2",
@@ -48,6 +49,10 @@ var _ = Describe("PluginConfig", func() {
 				"plugin1-command2",
 			}))
 		})
+
+		It("should list the plugin command aliases", func() {
+			Expect(pluginConfig.ListAliases()).To(Equal([]string{"p1c1"}))
+		})
 	})
 
 	Describe("Integration tests", func() {
@@ -37,6 +37,12 @@ type FakePluginConfiguration struct {
 	listCommandsReturns     struct {
 		result1 []string
 	}
+	ListAliasesStub        func() []string
+	listAliasesMutex       sync.RWMutex
+	listAliasesArgsForCall []struct{}
+	listAliasesReturns     struct {
+		result1 []string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -165,6 +171,31 @@ func (fake *FakePluginConfiguration) ListCommandsReturns(result1 []string) {
 	}{result1}
 }
 
+func (fake *FakePluginConfiguration) ListAliases() []string {
+	fake.listAliasesMutex.Lock()
+	fake.listAliasesArgsForCall = append(fake.listAliasesArgsForCall, struct{}{})
+	fake.recordInvocation("ListAliases", []interface{}{})
+	fake.listAliasesMutex.Unlock()
+	if fake.ListAliasesStub != nil {
+		return fake.ListAliasesStub()
+	} else {
+		return fake.listAliasesReturns.result1
+	}
+}
+
+func (fake *FakePluginConfiguration) ListAliasesCallCount() int {
+	fake.listAliasesMutex.RLock()
+	defer fake.listAliasesMutex.RUnlock()
+	return len(fake.listAliasesArgsForCall)
+}
+
+func (fake *FakePluginConfiguration) ListAliasesReturns(result1 []string) {
+	fake.ListAliasesStub = nil
+	fake.listAliasesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakePluginConfiguration) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -178,6 +209,8 @@ func (fake *FakePluginConfiguration) Invocations() map[string][][]interface{} {
 	defer fake.removePluginMutex.RUnlock()
 	fake.listCommandsMutex.RLock()
 	defer fake.listCommandsMutex.RUnlock()
+	fake.listAliasesMutex.RLock()
+	defer fake.listAliasesMutex.RUnlock()
 	return fake.invocations
 }
 
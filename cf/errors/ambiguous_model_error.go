@@ -0,0 +1,24 @@
+package errors
+
+import (
+	. "code.cloudfoundry.org/cli/cf/i18n"
+)
+
+type AmbiguousModelError struct {
+	ModelType string
+	ModelName string
+}
+
+func NewAmbiguousModelError(modelType, name string) error {
+	return &AmbiguousModelError{
+		ModelType: modelType,
+		ModelName: name,
+	}
+}
+
+func (err *AmbiguousModelError) Error() string {
+	return T("Multiple {{.ModelType}}s named {{.ModelName}} found", map[string]interface{}{
+		"ModelType": err.ModelType,
+		"ModelName": err.ModelName,
+	})
+}
@@ -13,6 +13,7 @@ const (
 	UnbindableService                      = "90005"
 	ServiceInstanceAlreadyBoundToSameRoute = "130008"
 	NotStaged                              = "170002"
+	RoutePortTaken                         = "210005"
 	InstancesError                         = "220001"
 	QuotaDefinitionNameTaken               = "240002"
 	BuildpackNameTaken                     = "290001"
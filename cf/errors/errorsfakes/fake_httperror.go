@@ -26,6 +26,12 @@ type FakeHTTPError struct {
 	errorCodeReturns     struct {
 		result1 string
 	}
+	DescriptionStub        func() string
+	descriptionMutex       sync.RWMutex
+	descriptionArgsForCall []struct{}
+	descriptionReturns     struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -105,6 +111,31 @@ func (fake *FakeHTTPError) ErrorCodeReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeHTTPError) Description() string {
+	fake.descriptionMutex.Lock()
+	fake.descriptionArgsForCall = append(fake.descriptionArgsForCall, struct{}{})
+	fake.recordInvocation("Description", []interface{}{})
+	fake.descriptionMutex.Unlock()
+	if fake.DescriptionStub != nil {
+		return fake.DescriptionStub()
+	} else {
+		return fake.descriptionReturns.result1
+	}
+}
+
+func (fake *FakeHTTPError) DescriptionCallCount() int {
+	fake.descriptionMutex.RLock()
+	defer fake.descriptionMutex.RUnlock()
+	return len(fake.descriptionArgsForCall)
+}
+
+func (fake *FakeHTTPError) DescriptionReturns(result1 string) {
+	fake.DescriptionStub = nil
+	fake.descriptionReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeHTTPError) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -114,6 +145,8 @@ func (fake *FakeHTTPError) Invocations() map[string][][]interface{} {
 	defer fake.statusCodeMutex.RUnlock()
 	fake.errorCodeMutex.RLock()
 	defer fake.errorCodeMutex.RUnlock()
+	fake.descriptionMutex.RLock()
+	defer fake.descriptionMutex.RUnlock()
 	return fake.invocations
 }
 
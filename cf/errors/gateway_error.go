@@ -18,3 +18,19 @@ func (err *AsyncTimeoutError) Error() string {
 	return fmt.Sprintf(T("Error: timed out waiting for async job '{{.ErrURL}}' to finish",
 		map[string]interface{}{"ErrURL": err.url}))
 }
+
+// AsyncJobPollingError is returned when a request was accepted by the Cloud
+// Controller but checking on the job it started failed, so the request does
+// not need to be repeated to find out how the job ends.
+type AsyncJobPollingError struct {
+	JobURL string
+	Err    error
+}
+
+func NewAsyncJobPollingError(jobURL string, err error) error {
+	return &AsyncJobPollingError{JobURL: jobURL, Err: err}
+}
+
+func (err *AsyncJobPollingError) Error() string {
+	return err.Err.Error()
+}
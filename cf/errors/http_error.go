@@ -10,8 +10,9 @@ import (
 
 type HTTPError interface {
 	Error() string
-	StatusCode() int   // actual HTTP status code
-	ErrorCode() string // error code returned in response body from CC or UAA
+	StatusCode() int     // actual HTTP status code
+	ErrorCode() string   // error code returned in response body from CC or UAA
+	Description() string // error description returned in response body from CC or UAA
 }
 
 type baseHTTPError struct {
@@ -53,3 +54,7 @@ func (err *baseHTTPError) Error() string {
 func (err *baseHTTPError) ErrorCode() string {
 	return err.apiErrorCode
 }
+
+func (err *baseHTTPError) Description() string {
+	return err.description
+}
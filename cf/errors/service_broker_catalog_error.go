@@ -0,0 +1,43 @@
+package errors
+
+import (
+	"strings"
+
+	. "code.cloudfoundry.org/cli/cf/i18n"
+)
+
+// ServiceBrokerAuthenticationError is returned when a service broker rejects
+// the credentials used to fetch its catalog.
+type ServiceBrokerAuthenticationError struct {
+	URL        string
+	StatusCode int
+}
+
+func NewServiceBrokerAuthenticationError(url string, statusCode int) error {
+	return &ServiceBrokerAuthenticationError{URL: url, StatusCode: statusCode}
+}
+
+func (err *ServiceBrokerAuthenticationError) Error() string {
+	return T("Authentication with the service broker at {{.URL}} failed with status {{.StatusCode}}. Check the broker username and password.",
+		map[string]interface{}{
+			"URL":        err.URL,
+			"StatusCode": err.StatusCode,
+		})
+}
+
+// ServiceBrokerCatalogValidationError is returned when a service broker's
+// catalog cannot be parsed or would be rejected by Cloud Foundry.
+type ServiceBrokerCatalogValidationError struct {
+	Problems []string
+}
+
+func NewServiceBrokerCatalogValidationError(problems []string) error {
+	return &ServiceBrokerCatalogValidationError{Problems: problems}
+}
+
+func (err *ServiceBrokerCatalogValidationError) Error() string {
+	return T("The service broker catalog is invalid:\n{{.Problems}}",
+		map[string]interface{}{
+			"Problems": "  " + strings.Join(err.Problems, "\n  "),
+		})
+}
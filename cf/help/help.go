@@ -237,6 +237,7 @@ func newAppPresenter() appPresenter {
 			CommandSubGroups: [][]cmdPresenter{
 				{
 					presentCommand("routes"),
+					presentCommand("route"),
 					presentCommand("create-route"),
 					presentCommand("check-route"),
 					presentCommand("map-route"),
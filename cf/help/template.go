@@ -16,18 +16,24 @@ func GetHelpTemplate() string {
 {{range .}}   {{.Name}} {{.Description}}
 {{end}}{{end}}{{end}}
 {{.Title "` + T("ENVIRONMENT VARIABLES:") + `"}}
+   CF_CA_CERT=path/to/ca.pem          ` + T("Trust the CA certificate(s) in this PEM file for API requests") + `
    CF_COLOR=false                     ` + T("Do not colorize output") + `
    CF_HOME=path/to/dir/               ` + T("Override path to default config directory") + `
    CF_DIAL_TIMEOUT=5                  ` + T("Max wait time to establish a connection, including name resolution, in seconds") + `
    CF_PLUGIN_HOME=path/to/dir/        ` + T("Override path to default plugin config directory") + `
+   CF_RETRY_COUNT=3                   ` + T("Max number of retries for idempotent API requests that fail transiently, 0 to disable") + `
    CF_STAGING_TIMEOUT=15              ` + T("Max wait time for buildpack staging, in minutes") + `
    CF_STARTUP_TIMEOUT=5               ` + T("Max wait time for app instance startup, in minutes") + `
    CF_TRACE=true                      ` + T("Print API request diagnostics to stdout") + `
    CF_TRACE=path/to/trace.log         ` + T("Append API request diagnostics to a log file") + `
+   CF_TRACE_SHOW_SECRETS=true         ` + T("Do not hide credentials in API request diagnostics") + `
    https_proxy=proxy.example.com:8080 ` + T("Enable HTTP proxying for API requests") + `
+   no_proxy=.example.com,10.0.0.0/8   ` + T("Connect to these domains and IP ranges directly, without the proxy") + `
 
 {{.Title "` + T("GLOBAL OPTIONS:") + `"}}
+   --config                           ` + T("Override path to default config directory for this command only, like CF_HOME") + `
    --help, -h                         ` + T("Show help") + `
+   --no-color                         ` + T("Disable colors, spinners and progress bar animations") + `
    -v                                 ` + T("Print API request diagnostics to stdout") + `
 `
 }
@@ -0,0 +1,186 @@
+package i18n_test
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/resources"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// translatedArgument maps the UI methods that translate one of their
+// arguments to the position of that argument.
+var translatedArgument = map[string]int{
+	"DisplayBoolPrompt":     1,
+	"DisplayHeader":         0,
+	"DisplayPasswordPrompt": 0,
+	"DisplayText":           0,
+	"DisplayTextPrompt":     1,
+	"DisplayTextWithBold":   0,
+	"DisplayTextWithFlavor": 0,
+	"DisplayWarning":        0,
+	"TranslateText":         0,
+}
+
+var _ = Describe("translation resources", func() {
+	var (
+		resourcesDir string
+		englishIDs   map[string]bool
+	)
+
+	BeforeEach(func() {
+		resourcesDir = "resources"
+		englishIDs = map[string]bool{}
+		for _, entry := range readTranslationFile(filepath.Join(resourcesDir, "en-us.all.json")) {
+			englishIDs[entry.ID] = true
+		}
+	})
+
+	It("has an entry for every string the v2 and v3 commands display", func() {
+		excluded := readExclusions("excluded.json")
+
+		var missing []string
+		for _, dir := range []string{"../../command/v2", "../../command/v3"} {
+			for _, text := range displayedStrings(dir) {
+				if !englishIDs[text] && !excluded(text) {
+					missing = append(missing, text)
+				}
+			}
+		}
+		sort.Strings(missing)
+
+		Expect(missing).To(BeEmpty(), "add these strings to every cf/i18n/resources/*.all.json file and run bin/generate-language-resources")
+	})
+
+	It("has every entry in every language", func() {
+		files, err := filepath.Glob(filepath.Join(resourcesDir, "*.all.json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, file := range files {
+			ids := map[string]bool{}
+			for _, entry := range readTranslationFile(file) {
+				ids[entry.ID] = true
+			}
+			Expect(ids).To(Equal(englishIDs), file)
+		}
+	})
+
+	It("embeds the current translation files", func() {
+		files, err := filepath.Glob(filepath.Join(resourcesDir, "*.all.json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, file := range files {
+			raw, err := ioutil.ReadFile(file)
+			Expect(err).ToNot(HaveOccurred())
+
+			embedded, err := resources.Asset("cf/i18n/resources/" + filepath.Base(file))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(embedded).To(Equal(raw), "run bin/generate-language-resources to embed "+file)
+		}
+	})
+})
+
+func readTranslationFile(path string) []ui.TranslationEntry {
+	raw, err := ioutil.ReadFile(path)
+	Expect(err).ToNot(HaveOccurred())
+
+	var entries []ui.TranslationEntry
+	Expect(json.Unmarshal(raw, &entries)).To(Succeed())
+	return entries
+}
+
+// readExclusions returns a function reporting whether a string is excluded
+// from translation by the given exclusion file.
+func readExclusions(path string) func(string) bool {
+	raw, err := ioutil.ReadFile(path)
+	Expect(err).ToNot(HaveOccurred())
+
+	var exclusions struct {
+		ExcludedStrings []string `json:"excludedStrings"`
+		ExcludedRegexps []string `json:"excludedRegexps"`
+	}
+	Expect(json.Unmarshal(raw, &exclusions)).To(Succeed())
+
+	excludedStrings := map[string]bool{}
+	for _, text := range exclusions.ExcludedStrings {
+		excludedStrings[text] = true
+	}
+	var excludedRegexps []*regexp.Regexp
+	for _, expression := range exclusions.ExcludedRegexps {
+		excludedRegexps = append(excludedRegexps, regexp.MustCompile(expression))
+	}
+
+	return func(text string) bool {
+		if excludedStrings[text] {
+			return true
+		}
+		for _, excludedRegexp := range excludedRegexps {
+			if excludedRegexp.MatchString(text) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// displayedStrings returns the string literals passed to the translating UI
+// methods by the non-test Go files under dir.
+func displayedStrings(dir string) []string {
+	var texts []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && strings.HasSuffix(info.Name(), "fakes") {
+			return filepath.SkipDir
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			selector, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			position, ok := translatedArgument[selector.Sel.Name]
+			if !ok || len(call.Args) <= position {
+				return true
+			}
+			literal, ok := call.Args[position].(*ast.BasicLit)
+			if !ok || literal.Kind != token.STRING {
+				return true
+			}
+
+			text, err := strconv.Unquote(literal.Value)
+			Expect(err).ToNot(HaveOccurred())
+			texts = append(texts, text)
+			return true
+		})
+		return nil
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	return texts
+}
@@ -198,6 +198,11 @@ func mapToAppParams(basePath string, yamlMap generic.Map) (models.AppParams, err
 	appParams.Memory = bytesVal(yamlMap, "memory", &errs)
 	appParams.InstanceCount = intVal(yamlMap, "instances", &errs)
 	appParams.HealthCheckTimeout = intVal(yamlMap, "timeout", &errs)
+	appParams.StagingTimeout = intVal(yamlMap, "staging-timeout", &errs)
+	if appParams.StagingTimeout != nil && *appParams.StagingTimeout < 1 {
+		errs = append(errs, fmt.Errorf(T("Invalid value for 'staging-timeout': {{.Timeout}}\nStaging timeout must be a positive number of minutes",
+			map[string]interface{}{"Timeout": *appParams.StagingTimeout})))
+	}
 	appParams.NoRoute = boolVal(yamlMap, "no-route", &errs)
 	appParams.NoHostname = boolOrNil(yamlMap, "no-hostname", &errs)
 	appParams.UseRandomRoute = boolVal(yamlMap, "random-route", &errs)
@@ -100,6 +100,36 @@ var _ = Describe("Manifests", func() {
 		Expect(*apps[0].HealthCheckTimeout).To(Equal(360))
 	})
 
+	It("sets applications' staging timeouts", func() {
+		m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+			"applications": []interface{}{
+				map[interface{}]interface{}{
+					"name":            "bitcoin-miner",
+					"staging-timeout": 30,
+				},
+			},
+		}))
+
+		apps, err := m.Applications()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*apps[0].StagingTimeout).To(Equal(30))
+	})
+
+	It("returns an error when the staging timeout is not positive", func() {
+		m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+			"applications": []interface{}{
+				map[interface{}]interface{}{
+					"name":            "bitcoin-miner",
+					"staging-timeout": 0,
+				},
+			},
+		}))
+
+		_, err := m.Applications()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Invalid value for 'staging-timeout': 0"))
+	})
+
 	It("allows boolean env var values", func() {
 		m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
 			"env": generic.NewMap(map[interface{}]interface{}{
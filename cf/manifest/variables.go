@@ -0,0 +1,176 @@
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/errors"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/util/generic"
+	"gopkg.in/yaml.v2"
+)
+
+// VariableSource resolves ((name)) placeholders in a manifest.
+type VariableSource interface {
+	// Lookup returns the value of the named variable, if the source has it.
+	Lookup(name string) (interface{}, bool)
+
+	// Description names the source in error messages.
+	Description() string
+}
+
+// StaticVariables are variables given on the command line or in a vars file.
+type StaticVariables struct {
+	Values map[string]interface{}
+	Source string
+}
+
+func (v StaticVariables) Lookup(name string) (interface{}, bool) {
+	value, ok := v.Values[name]
+	return value, ok
+}
+
+func (v StaticVariables) Description() string {
+	return v.Source
+}
+
+// NewVarFlagVariables parses the name=value pairs passed with --var. A later
+// pair wins over an earlier one with the same name.
+func NewVarFlagVariables(pairs []string) (StaticVariables, error) {
+	vars := StaticVariables{Values: map[string]interface{}{}, Source: "--var"}
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return StaticVariables{}, errors.New(T("Invalid variable '{{.Pair}}'. Expected name=value.", map[string]interface{}{"Pair": pair}))
+		}
+		vars.Values[parts[0]] = parts[1]
+	}
+	return vars, nil
+}
+
+// ReadVariablesFile reads a YAML file of variable names and values.
+func ReadVariablesFile(path string) (StaticVariables, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return StaticVariables{}, err
+	}
+
+	values := map[string]interface{}{}
+	err = yaml.Unmarshal(raw, &values)
+	if err != nil {
+		return StaticVariables{}, errors.New(T("Invalid vars file {{.Path}}: {{.Err}}", map[string]interface{}{"Path": path, "Err": err.Error()}))
+	}
+
+	return StaticVariables{Values: values, Source: "--vars-file " + path}, nil
+}
+
+// EnvironmentVariables resolves ((name)) from the environment variable
+// PREFIX_name.
+type EnvironmentVariables struct {
+	Prefix string
+
+	// LookupEnv defaults to os.LookupEnv.
+	LookupEnv func(string) (string, bool)
+}
+
+func (v EnvironmentVariables) Lookup(name string) (interface{}, bool) {
+	lookupEnv := v.LookupEnv
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+
+	value, ok := lookupEnv(v.Prefix + "_" + name)
+	return value, ok
+}
+
+func (v EnvironmentVariables) Description() string {
+	return "--vars-env " + v.Prefix + " (" + v.Prefix + "_<name>)"
+}
+
+var variableRegex = regexp.MustCompile(`\(\(([-\w\.]+)\)\)`)
+
+// InterpolateVariables replaces every ((name)) placeholder in the manifest's
+// values with the value from the first source that has it, so sources earlier
+// in the list take precedence. A value that is only a placeholder keeps the
+// type of the variable, for example an integer from a vars file. All
+// placeholders that no source resolves are reported together.
+func (m *Manifest) InterpolateVariables(sources []VariableSource) error {
+	missing := map[string]bool{}
+	m.Data = generic.NewMap(interpolate(m.Data, sources, missing))
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	var names []string
+	for name := range missing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var descriptions []string
+	for _, source := range sources {
+		descriptions = append(descriptions, source.Description())
+	}
+
+	return errors.New(T("Unable to resolve manifest variables: {{.VariableNames}}\nSources consulted, in order: {{.Sources}}",
+		map[string]interface{}{
+			"VariableNames": strings.Join(names, ", "),
+			"Sources":       strings.Join(descriptions, ", "),
+		}))
+}
+
+func interpolate(input interface{}, sources []VariableSource, missing map[string]bool) interface{} {
+	switch input := input.(type) {
+	case string:
+		if match := variableRegex.FindStringSubmatch(input); match != nil && match[0] == input {
+			if value, ok := lookupVariable(match[1], sources); ok {
+				return value
+			}
+			missing[match[1]] = true
+			return input
+		}
+
+		return variableRegex.ReplaceAllStringFunc(input, func(placeholder string) string {
+			name := variableRegex.FindStringSubmatch(placeholder)[1]
+			if value, ok := lookupVariable(name, sources); ok {
+				return fmt.Sprint(value)
+			}
+			missing[name] = true
+			return placeholder
+		})
+	case []interface{}:
+		output := make([]interface{}, len(input))
+		for i, item := range input {
+			output[i] = interpolate(item, sources, missing)
+		}
+		return output
+	case map[interface{}]interface{}:
+		output := make(map[interface{}]interface{}, len(input))
+		for key, value := range input {
+			output[key] = interpolate(value, sources, missing)
+		}
+		return output
+	case generic.Map:
+		output := generic.NewMap()
+		generic.Each(input, func(key, value interface{}) {
+			output.Set(key, interpolate(value, sources, missing))
+		})
+		return output
+	default:
+		return input
+	}
+}
+
+func lookupVariable(name string, sources []VariableSource) (interface{}, bool) {
+	for _, source := range sources {
+		if value, ok := source.Lookup(name); ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,124 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/manifest"
+	"code.cloudfoundry.org/cli/util/generic"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest variables", func() {
+	Describe("InterpolateVariables", func() {
+		var (
+			m       *manifest.Manifest
+			env     map[string]string
+			sources []manifest.VariableSource
+		)
+
+		BeforeEach(func() {
+			m = NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"applications": []interface{}{
+					map[interface{}]interface{}{
+						"name":      "((app-name))",
+						"instances": "((instances))",
+						"host":      "((app-name))-((suffix))",
+						"env": map[interface{}]interface{}{
+							"DB_URL": "((db_url))",
+						},
+					},
+				},
+			}))
+
+			env = map[string]string{
+				"CI_app-name": "env-app",
+				"CI_suffix":   "blue",
+				"CI_db_url":   "postgres://env",
+			}
+
+			flagVars, err := manifest.NewVarFlagVariables([]string{"app-name=flag-app", "instances=1", "instances=2"})
+			Expect(err).NotTo(HaveOccurred())
+
+			sources = []manifest.VariableSource{
+				flagVars,
+				manifest.StaticVariables{
+					Source: "--vars-file vars.yml",
+					Values: map[string]interface{}{"instances": 5, "suffix": "green"},
+				},
+				manifest.EnvironmentVariables{
+					Prefix: "CI",
+					LookupEnv: func(name string) (string, bool) {
+						value, ok := env[name]
+						return value, ok
+					},
+				},
+			}
+		})
+
+		It("resolves each variable from the first source that has it", func() {
+			Expect(m.InterpolateVariables(sources)).To(Succeed())
+
+			apps, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*apps[0].Name).To(Equal("flag-app"))
+			Expect(*apps[0].InstanceCount).To(Equal(2))
+			Expect(apps[0].Hosts).To(Equal([]string{"flag-app-green"}))
+			Expect(*apps[0].EnvironmentVars).To(HaveKeyWithValue("DB_URL", "postgres://env"))
+		})
+
+		It("keeps the type of a value that replaces a whole string", func() {
+			Expect(m.InterpolateVariables(sources[1:])).To(Succeed())
+
+			apps, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*apps[0].InstanceCount).To(Equal(5))
+		})
+
+		Context("when variables cannot be resolved", func() {
+			BeforeEach(func() {
+				env = map[string]string{}
+			})
+
+			It("lists every missing variable and the sources consulted", func() {
+				err := m.InterpolateVariables(sources)
+				Expect(err).To(MatchError("Unable to resolve manifest variables: db_url\nSources consulted, in order: --var, --vars-file vars.yml, --vars-env CI (CI_<name>)"))
+			})
+		})
+	})
+
+	Describe("NewVarFlagVariables", func() {
+		It("returns an error when a pair has no value", func() {
+			_, err := manifest.NewVarFlagVariables([]string{"name"})
+			Expect(err).To(MatchError("Invalid variable 'name'. Expected name=value."))
+		})
+	})
+
+	Describe("ReadVariablesFile", func() {
+		var path string
+
+		BeforeEach(func() {
+			file, err := ioutil.TempFile("", "vars-file")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = file.WriteString("instances: 3\nname: some-app\n")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+			path = file.Name()
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(path)).To(Succeed())
+		})
+
+		It("reads the variables in the file", func() {
+			vars, err := manifest.ReadVariablesFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vars.Description()).To(Equal("--vars-file " + path))
+
+			value, ok := vars.Lookup("instances")
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal(3))
+		})
+	})
+})
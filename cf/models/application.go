@@ -67,6 +67,7 @@ type ApplicationFields struct {
 	SpaceGUID               string
 	StackGUID               string
 	PackageUpdatedAt        *time.Time
+	UpdatedAt               *time.Time
 	PackageState            string
 	StagingFailedReason     string
 	Buildpack               string
@@ -96,6 +97,7 @@ type AppParams struct {
 	HealthCheckType         *string
 	HealthCheckHTTPEndpoint *string
 	HealthCheckTimeout      *int
+	StagingTimeout          *int
 	DockerImage             *string
 	DockerUsername          *string
 	DockerPassword          *string
@@ -170,6 +172,9 @@ func (app *AppParams) Merge(flagContext *AppParams) {
 	if flagContext.HealthCheckTimeout != nil {
 		app.HealthCheckTimeout = flagContext.HealthCheckTimeout
 	}
+	if flagContext.StagingTimeout != nil {
+		app.StagingTimeout = flagContext.StagingTimeout
+	}
 	if flagContext.Hosts != nil {
 		app.Hosts = flagContext.Hosts
 	}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// V3Application is the part of an app only available from the v3 API: its
+// lifecycle, which names the buildpacks it is staged with.
+type V3Application struct {
+	GUID          string
+	Name          string
+	State         string
+	LifecycleType string
+	Buildpacks    []string
+	Stack         string
+}
+
+// Sidecar is a process that runs alongside the processes of the given types
+// in the same container.
+type Sidecar struct {
+	GUID         string
+	Name         string
+	Command      string
+	ProcessTypes []string
+	MemoryInMB   int64
+}
+
+// Revision is a snapshot of the droplet and environment variables an app's
+// instances were started with.
+type Revision struct {
+	GUID        string
+	Version     int
+	CreatedAt   time.Time
+	Environment map[string]interface{}
+}
@@ -7,6 +7,7 @@ type DomainFields struct {
 	RouterGroupGUID        string
 	RouterGroupType        string
 	Shared                 bool
+	Internal               bool
 }
 
 func (model DomainFields) URLForHostAndPath(host, path string, port int) string {
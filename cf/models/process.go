@@ -0,0 +1,14 @@
+package models
+
+const ProcessTypeWeb = "web"
+
+type Process struct {
+	GUID       string
+	Type       string
+	Instances  int
+	MemoryInMB int64
+	DiskInMB   int64
+
+	HealthCheckType         string
+	HealthCheckHTTPEndpoint string
+}
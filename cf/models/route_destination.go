@@ -0,0 +1,13 @@
+package models
+
+const (
+	RouteDestinationProtocolHTTP1 = "http1"
+	RouteDestinationProtocolHTTP2 = "http2"
+)
+
+type RouteDestination struct {
+	GUID     string
+	AppGUID  string
+	Port     int
+	Protocol string
+}
@@ -0,0 +1,11 @@
+package models
+
+// RouteServiceBinding binds a route to the service instance its requests are
+// forwarded to. Its last operation reports whether binding is still in
+// progress.
+type RouteServiceBinding struct {
+	GUID                string
+	RouteGUID           string
+	ServiceInstanceGUID string
+	LastOperation       LastOperationFields
+}
@@ -0,0 +1,19 @@
+package models
+
+type ServiceBrokerCatalog struct {
+	Services []ServiceBrokerCatalogService
+}
+
+type ServiceBrokerCatalogService struct {
+	ID          string
+	Name        string
+	Description string
+	Plans       []ServiceBrokerCatalogPlan
+}
+
+type ServiceBrokerCatalogPlan struct {
+	ID          string
+	Name        string
+	Description string
+	Free        bool
+}
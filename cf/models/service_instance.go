@@ -22,6 +22,14 @@ type ServiceInstanceUpdateRequest struct {
 	Tags     []string               `json:"tags"`
 }
 
+type ServiceInstanceUpgradeRequest struct {
+	MaintenanceInfo ServiceInstanceMaintenanceInfo `json:"maintenance_info"`
+}
+
+type ServiceInstanceMaintenanceInfo struct {
+	Version string `json:"version"`
+}
+
 type ServiceInstanceFields struct {
 	GUID             string
 	Name             string
@@ -32,6 +40,9 @@ type ServiceInstanceFields struct {
 	Params           map[string]interface{}
 	DashboardURL     string
 	Tags             []string
+	// MaintenanceVersion is the maintenance_info version the instance was
+	// last provisioned or upgraded at.
+	MaintenanceVersion string
 }
 
 type ServiceInstance struct {
@@ -45,3 +56,9 @@ type ServiceInstance struct {
 func (inst ServiceInstance) IsUserProvided() bool {
 	return inst.ServicePlan.GUID == ""
 }
+
+// UpgradeAvailable returns true when the instance's plan advertises a
+// maintenance_info version that the instance has not been upgraded to.
+func (inst ServiceInstance) UpgradeAvailable() bool {
+	return inst.ServicePlan.MaintenanceVersion != "" && inst.ServicePlan.MaintenanceVersion != inst.MaintenanceVersion
+}
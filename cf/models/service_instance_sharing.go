@@ -0,0 +1,17 @@
+package models
+
+// ServiceInstanceSharedFrom is the space a service instance was created in,
+// as seen from a space it has been shared into.
+type ServiceInstanceSharedFrom struct {
+	SpaceGUID        string
+	SpaceName        string
+	OrganizationName string
+}
+
+// ServiceInstanceSharedTo is a space a service instance has been shared into.
+type ServiceInstanceSharedTo struct {
+	SpaceGUID        string
+	SpaceName        string
+	OrganizationName string
+	BoundAppCount    int
+}
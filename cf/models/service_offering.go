@@ -2,7 +2,9 @@ package models
 
 type ServiceOfferingFields struct {
 	GUID             string
+	UniqueID         string
 	BrokerGUID       string
+	BrokerName       string
 	Label            string
 	Provider         string
 	Version          string
@@ -1,14 +1,23 @@
 package models
 
 type ServicePlanFields struct {
-	GUID                string
-	Name                string
-	Free                bool
-	Public              bool
-	Description         string
-	Active              bool
-	ServiceOfferingGUID string
-	OrgNames            []string
+	GUID                   string
+	UniqueID               string
+	Name                   string
+	Free                   bool
+	Public                 bool
+	Description            string
+	Active                 bool
+	ServiceOfferingGUID    string
+	OrgNames               []string
+	Costs                  []ServicePlanCost
+	MaintenanceVersion     string
+	MaintenanceDescription string
+}
+
+type ServicePlanCost struct {
+	Amount map[string]float64
+	Unit   string
 }
 
 type ServicePlan struct {
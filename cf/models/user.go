@@ -4,5 +4,6 @@ type UserFields struct {
 	GUID     string
 	Username string
 	Password string
+	Origin   string
 	IsAdmin  bool
 }
@@ -21,6 +21,8 @@ import (
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/util/cacert"
+	"code.cloudfoundry.org/cli/util/ratelimit"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -120,6 +122,10 @@ func (gateway Gateway) UpdateResource(endpoint, apiURL string, body io.ReadSeeke
 	return gateway.createUpdateOrDeleteResource("PUT", endpoint, apiURL, body, false, resource...)
 }
 
+func (gateway Gateway) PatchResource(endpoint, apiURL string, body io.ReadSeeker, resource ...interface{}) error {
+	return gateway.createUpdateOrDeleteResource("PATCH", endpoint, apiURL, body, true, resource...)
+}
+
 func (gateway Gateway) UpdateResourceSync(endpoint, apiURL string, body io.ReadSeeker, resource ...interface{}) error {
 	return gateway.createUpdateOrDeleteResource("PUT", endpoint, apiURL, body, true, resource...)
 }
@@ -132,6 +138,10 @@ func (gateway Gateway) DeleteResource(endpoint, apiURL string) error {
 	return gateway.createUpdateOrDeleteResource("DELETE", endpoint, apiURL, nil, false, &AsyncResource{})
 }
 
+func (gateway Gateway) DeleteResourceWithBody(endpoint, apiURL string, body io.ReadSeeker) error {
+	return gateway.createUpdateOrDeleteResource("DELETE", endpoint, apiURL, body, false, &AsyncResource{})
+}
+
 func (gateway Gateway) ListPaginatedResources(
 	target string,
 	path string,
@@ -328,6 +338,12 @@ func (gateway Gateway) Warnings() []string {
 	return *gateway.warnings
 }
 
+// WaitForJob polls the Cloud Controller job at jobURL until it finishes,
+// fails or the timeout passes. A timeout of zero waits forever.
+func (gateway Gateway) WaitForJob(jobURL, accessToken string, timeout time.Duration) error {
+	return gateway.waitForJob(jobURL, accessToken, timeout)
+}
+
 func (gateway Gateway) waitForJob(jobURL, accessToken string, timeout time.Duration) error {
 	startTime := gateway.Clock()
 	for true {
@@ -339,7 +355,7 @@ func (gateway Gateway) waitForJob(jobURL, accessToken string, timeout time.Durat
 		response := &JobResource{}
 		_, err = gateway.PerformRequestForJSONResponse(request, response)
 		if err != nil {
-			return err
+			return errors.NewAsyncJobPollingError(jobURL, err)
 		}
 
 		switch response.Entity.Status {
@@ -378,6 +394,11 @@ func (gateway Gateway) doRequestHandlingAuth(request *Request) (*http.Response,
 			return rawResponse, err
 		}
 
+		// a streamed body has already been read and cannot be sent again
+		if httpReq.Body != nil && request.SeekableBody == nil {
+			return rawResponse, errors.New(T("The access token was refreshed, but the request could not be sent again because its body was streamed. Run the command again."))
+		}
+
 		// reset the auth token and request body
 		httpReq.Header.Set("Authorization", newToken)
 		if request.SeekableBody != nil {
@@ -393,7 +414,7 @@ func (gateway Gateway) doRequestHandlingAuth(request *Request) (*http.Response,
 }
 
 func (gateway Gateway) doRequestAndHandlerError(request *Request) (*http.Response, error) {
-	rawResponse, err := gateway.doRequest(request.HTTPReq)
+	rawResponse, err := gateway.doRateLimitedRequest(request)
 	if err != nil {
 		return rawResponse, WrapNetworkErrors(request.HTTPReq.URL.Host, err)
 	}
@@ -408,6 +429,50 @@ func (gateway Gateway) doRequestAndHandlerError(request *Request) (*http.Respons
 	return rawResponse, err
 }
 
+// doRateLimitedRequest delays the request when the Cloud Controller reports
+// that few requests remain in the current rate limit window, and retries it
+// if it is rejected with a 429 once the limit resets.
+func (gateway Gateway) doRateLimitedRequest(request *Request) (*http.Response, error) {
+	httpReq := request.HTTPReq
+
+	for i := 0; ; i++ {
+		if wait := ratelimit.Default.Delay(time.Now()); wait > 0 {
+			gateway.traceThrottle(T("Delaying {{.Method}} {{.URL}} by {{.Wait}} to stay under the rate limit",
+				map[string]interface{}{"Method": httpReq.Method, "URL": httpReq.URL.RequestURI(), "Wait": wait}))
+			time.Sleep(wait)
+		}
+
+		rawResponse, err := gateway.doRequest(httpReq)
+		if err != nil {
+			return rawResponse, err
+		}
+		ratelimit.Default.Update(rawResponse.Header)
+
+		if rawResponse.StatusCode != http.StatusTooManyRequests || i == ratelimit.MaxRetries {
+			return rawResponse, nil
+		}
+
+		wait, ok := ratelimit.RetryDelay(rawResponse.Header, time.Now())
+		if !ok || (httpReq.Body != nil && request.SeekableBody == nil) {
+			return rawResponse, nil
+		}
+
+		_ = rawResponse.Body.Close()
+		if request.SeekableBody != nil {
+			_, _ = request.SeekableBody.Seek(0, 0)
+			httpReq.Body = ioutil.NopCloser(request.SeekableBody)
+		}
+
+		gateway.traceThrottle(T("Rate limit exceeded, retrying {{.Method}} {{.URL}} in {{.Wait}} (attempt {{.Attempt}} of {{.MaxRetries}})",
+			map[string]interface{}{"Method": httpReq.Method, "URL": httpReq.URL.RequestURI(), "Wait": wait, "Attempt": i + 1, "MaxRetries": ratelimit.MaxRetries}))
+		time.Sleep(wait)
+	}
+}
+
+func (gateway Gateway) traceThrottle(message string) {
+	gateway.logger.Printf("\n%s [%s]\n%s\n", terminal.HeaderColor(T("THROTTLE:")), time.Now().Format(time.RFC3339), message)
+}
+
 func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error) {
 	var response *http.Response
 	var err error
@@ -454,6 +519,9 @@ func makeHTTPTransport(gateway *Gateway) {
 		TLSClientConfig: NewTLSConfig(gateway.trustedCerts, gateway.config.IsSSLDisabled()),
 		Proxy:           http.ProxyFromEnvironment,
 	}
+
+	tlsConfig := gateway.transport.TLSClientConfig
+	tlsConfig.RootCAs = cacert.AppendToPool(tlsConfig.RootCAs, []byte(gateway.config.CACert()))
 }
 
 func dialTimeout(envDialTimeout string) time.Duration {
@@ -468,3 +536,17 @@ func (gateway *Gateway) SetTrustedCerts(certificates []tls.Certificate) {
 	gateway.trustedCerts = certificates
 	makeHTTPTransport(gateway)
 }
+
+// HTTPClient returns a client for requests to hosts other than the API, such
+// as downloading a remote app archive. It honors the gateway's SSL, CA and
+// proxy settings and gives up on a request after timeout.
+func (gateway Gateway) HTTPClient(timeout time.Duration) *http.Client {
+	if gateway.transport == nil {
+		makeHTTPTransport(&gateway)
+	}
+
+	return &http.Client{
+		Transport: gateway.transport,
+		Timeout:   timeout,
+	}
+}
@@ -3,6 +3,7 @@ package net_test
 import (
 	"bytes"
 	"crypto/tls"
+	"encoding/pem"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"os"
 	"reflect"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
@@ -197,6 +199,86 @@ var _ = Describe("Gateway", func() {
 
 	})
 
+	Describe("rate limiting", func() {
+		var fakePrinter *tracefakes.FakePrinter
+
+		BeforeEach(func() {
+			ccServer = ghttp.NewServer()
+			ccServer.HTTPTestServer.Config.ErrorLog = log.New(&bytes.Buffer{}, "", 0)
+			config.SetAPIEndpoint(ccServer.URL())
+
+			fakePrinter = new(tracefakes.FakePrinter)
+			ccGateway = NewCloudControllerGateway(config, clock, new(terminalfakes.FakeUI), fakePrinter, "")
+		})
+
+		AfterEach(func() {
+			ccServer.Close()
+		})
+
+		Context("when the Cloud Controller rejects a request with a 429", func() {
+			BeforeEach(func() {
+				ccServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/v2/some-endpoint"),
+						ghttp.VerifyBody([]byte(`{"name":"some-name"}`)),
+						ghttp.RespondWith(http.StatusTooManyRequests, `{}`, http.Header{
+							"X-RateLimit-Remaining": {"0"},
+							"X-RateLimit-Reset":     {strconv.FormatInt(time.Now().Add(-time.Second).Unix(), 10)},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/v2/some-endpoint"),
+						ghttp.VerifyBody([]byte(`{"name":"some-name"}`)),
+						ghttp.RespondWith(http.StatusCreated, `{"code": 42}`),
+					),
+				)
+			})
+
+			It("retries the request once the limit resets and traces the retry", func() {
+				response := struct {
+					Code int `json:"code"`
+				}{}
+				request, err := ccGateway.NewRequest("PUT", config.APIEndpoint()+"/v2/some-endpoint", config.AccessToken(), strings.NewReader(`{"name":"some-name"}`))
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = ccGateway.PerformRequestForJSONResponse(request, &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Code).To(Equal(42))
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(2))
+
+				var traced []string
+				for i := 0; i < fakePrinter.PrintfCallCount(); i++ {
+					format, args := fakePrinter.PrintfArgsForCall(i)
+					traced = append(traced, fmt.Sprintf(format, args...))
+				}
+				Expect(traced).To(ContainElement(ContainSubstring("Rate limit exceeded, retrying PUT /v2/some-endpoint in 0s (attempt 1 of 3)")))
+			})
+		})
+
+		Context("when the limit resets later than the longest wait", func() {
+			BeforeEach(func() {
+				ccServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/v2/some-endpoint"),
+						ghttp.RespondWith(http.StatusTooManyRequests, `{"code": 10013, "description": "Rate Limit Exceeded"}`, http.Header{
+							"Retry-After": {"3600"},
+						}),
+					),
+				)
+			})
+
+			It("returns the error without retrying", func() {
+				request, err := ccGateway.NewRequest("GET", config.APIEndpoint()+"/v2/some-endpoint", config.AccessToken(), nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = ccGateway.PerformRequestForJSONResponse(request, nil)
+				Expect(err).To(HaveOccurred())
+				Expect(err.(errors.HTTPError).StatusCode()).To(Equal(http.StatusTooManyRequests))
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+	})
+
 	Describe("CRUD methods", func() {
 		Describe("Delete", func() {
 			var apiServer *httptest.Server
@@ -315,6 +397,10 @@ var _ = Describe("Gateway", func() {
 				case "/v2/foo":
 					fmt.Fprintln(writer, `{ "metadata": { "url": "/v2/jobs/the-job-guid" } }`)
 				case "/v2/jobs/the-job-guid":
+					if jobStatus == "unreachable" {
+						writer.WriteHeader(http.StatusBadGateway)
+						return
+					}
 					fmt.Fprintf(writer, `
 					{
 						"entity": {
@@ -377,6 +463,36 @@ var _ = Describe("Gateway", func() {
 			Expect(apiErr).To(HaveOccurred())
 			Expect(apiErr).To(BeAssignableToTypeOf(errors.NewAsyncTimeoutError("http://some.url")))
 		})
+
+		It("returns the job URL if the job cannot be polled", func() {
+			go func() {
+				statusChannel <- "queued"
+				statusChannel <- "unreachable"
+			}()
+
+			request, _ := ccGateway.NewRequest("GET", config.APIEndpoint()+"/v2/foo", config.AccessToken(), nil)
+			_, apiErr := ccGateway.PerformPollingRequestForJSONResponse(config.APIEndpoint(), request, new(struct{}), 500*time.Millisecond)
+			Expect(apiErr).To(HaveOccurred())
+
+			pollingErr, ok := apiErr.(*errors.AsyncJobPollingError)
+			Expect(ok).To(BeTrue())
+			Expect(pollingErr.JobURL).To(Equal(config.APIEndpoint() + "/v2/jobs/the-job-guid"))
+			httpErr, ok := pollingErr.Err.(errors.HTTPError)
+			Expect(ok).To(BeTrue())
+			Expect(httpErr.StatusCode()).To(Equal(http.StatusBadGateway))
+		})
+
+		Describe("WaitForJob", func() {
+			It("waits for a job started earlier to finish", func() {
+				go func() {
+					statusChannel <- "running"
+					statusChannel <- "finished"
+				}()
+
+				apiErr := ccGateway.WaitForJob(config.APIEndpoint()+"/v2/jobs/the-job-guid", config.AccessToken(), 500*time.Millisecond)
+				Expect(apiErr).NotTo(HaveOccurred())
+			})
+		})
 	})
 
 	Describe("when uploading a file", func() {
@@ -489,6 +605,24 @@ var _ = Describe("Gateway", func() {
 			Expect(config.RefreshToken()).To(Equal("new-refresh-token"))
 		})
 
+		It("returns an error instead of resending a streamed body", func() {
+			apiServer := httptest.NewTLSServer(refreshTokenAPIEndPoint(
+				`{ "code": 1000, "description": "Auth token is invalid" }`,
+				testnet.TestResponse{Status: http.StatusOK}))
+			defer apiServer.Close()
+			ccGateway.SetTrustedCerts(apiServer.TLS.Certificates)
+
+			config, auth := createAuthenticationRepository(apiServer, authServer)
+			ccGateway.SetTokenRefresher(auth)
+			request, apiErr := ccGateway.NewRequest("POST", config.APIEndpoint()+"/v2/foo", config.AccessToken(), nil)
+			Expect(apiErr).NotTo(HaveOccurred())
+			request.HTTPReq.Body = ioutil.NopCloser(strings.NewReader("expected body"))
+
+			_, apiErr = ccGateway.PerformRequest(request)
+			Expect(apiErr).To(MatchError(ContainSubstring("could not be sent again because its body was streamed")))
+			Expect(config.AccessToken()).To(Equal("bearer new-access-token"))
+		})
+
 		It("returns a failure response when token refresh fails after a UAA request", func() {
 			apiServer := httptest.NewTLSServer(refreshTokenAPIEndPoint(
 				`{ "error": "invalid_token", "error_description": "Auth token is invalid" }`,
@@ -596,6 +730,57 @@ var _ = Describe("Gateway", func() {
 
 	})
 
+	Describe("HTTPClient", func() {
+		var apiServer *httptest.Server
+
+		BeforeEach(func() {
+			apiServer = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				time.Sleep(50 * time.Millisecond)
+				fmt.Fprintln(w, `{}`)
+			}))
+			apiServer.Config.ErrorLog = log.New(&bytes.Buffer{}, "", 0)
+		})
+
+		AfterEach(func() {
+			apiServer.Close()
+		})
+
+		It("sets the timeout", func() {
+			Expect(ccGateway.HTTPClient(time.Minute).Timeout).To(Equal(time.Minute))
+		})
+
+		It("validates the server's certificate", func() {
+			_, err := ccGateway.HTTPClient(time.Minute).Get(apiServer.URL)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("trusts the CA stored in the config", func() {
+			caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: apiServer.Certificate().Raw})
+			config.SetCACert(string(caCert))
+
+			response, err := ccGateway.HTTPClient(time.Minute).Get(apiServer.URL)
+			Expect(err).NotTo(HaveOccurred())
+			response.Body.Close()
+		})
+
+		Context("when SSL validation is disabled", func() {
+			BeforeEach(func() {
+				config.SetSSLDisabled(true)
+			})
+
+			It("succeeds", func() {
+				response, err := ccGateway.HTTPClient(time.Minute).Get(apiServer.URL)
+				Expect(err).NotTo(HaveOccurred())
+				response.Body.Close()
+			})
+
+			It("gives up when the server takes longer than the timeout", func() {
+				_, err := ccGateway.HTTPClient(time.Millisecond).Get(apiServer.URL)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("collecting warnings", func() {
 		var (
 			apiServer  *httptest.Server
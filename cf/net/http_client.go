@@ -11,6 +11,7 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/errors"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/util/timings"
 	"golang.org/x/net/websocket"
 )
 
@@ -31,7 +32,7 @@ type client struct {
 var NewHTTPClient = func(tr *http.Transport, dumper RequestDumper) HTTPClientInterface {
 	c := client{
 		&http.Client{
-			Transport: tr,
+			Transport: timings.NewTransport(tr),
 		},
 		dumper,
 	}
@@ -20,6 +20,8 @@ var _ = Describe("SSHOptions", func() {
 		BeforeEach(func() {
 			fc = flags.New()
 			fc.NewStringSliceFlag("L", "", "")
+			fc.NewStringSliceFlag("R", "", "")
+			fc.NewStringSliceFlag("D", "", "")
 			fc.NewStringSliceFlag("command", "c", "")
 			fc.NewIntFlag("app-instance-index", "i", "")
 			fc.NewBoolFlag("skip-host-validation", "k", "")
@@ -281,6 +283,76 @@ var _ = Describe("SSHOptions", func() {
 			})
 		})
 
+		Context("when remote port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name")
+			})
+
+			Context("with and without an explicit bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999:localhost:8888")
+					args = append(args, "-R", "0.0.0.0:9000:localhost:3000")
+				})
+
+				It("sets the remote forward specs", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.RemoteForwardSpecs).To(ConsistOf(
+						options.ForwardSpec{ListenAddress: "localhost:9999", ConnectAddress: "localhost:8888"},
+						options.ForwardSpec{ListenAddress: "0.0.0.0:9000", ConnectAddress: "localhost:3000"},
+					))
+					Expect(opts.ForwardSpecs).To(BeEmpty())
+				})
+			})
+
+			Context("when the argument cannot be parsed", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse remote forwarding argument: "9999"`))
+				})
+			})
+		})
+
+		Context("when dynamic port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name")
+			})
+
+			Context("with a port only", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "1080")
+				})
+
+				It("listens on localhost", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardAddresses).To(ConsistOf("localhost:1080"))
+				})
+			})
+
+			Context("with bind addresses", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "[::1]:1080", "-D", "*:1081")
+				})
+
+				It("sets every dynamic forward address", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardAddresses).To(ConsistOf("[::1]:1080", ":1081"))
+				})
+			})
+
+			Context("when the argument cannot be parsed", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "1080:remote:80")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse dynamic forwarding argument: "1080:remote:80"`))
+				})
+			})
+		})
+
 		Context("when -N is specified", func() {
 			BeforeEach(func() {
 				args = append(args, "app-name", "-N")
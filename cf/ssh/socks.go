@@ -0,0 +1,137 @@
+package sshCmd
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+// A minimal SOCKS5 server (RFC 1928) used for dynamic port forwarding. It
+// supports the CONNECT command without authentication, which is what
+// browsers and most tools configured with a SOCKS proxy use.
+const (
+	socksVersion5 = 0x05
+
+	socksAuthNone         = 0x00
+	socksAuthNoAcceptable = 0xff
+
+	socksCommandConnect = 0x01
+
+	socksAddressIPv4   = 0x01
+	socksAddressDomain = 0x03
+	socksAddressIPv6   = 0x04
+
+	socksReplySucceeded           = 0x00
+	socksReplyGeneralFailure      = 0x01
+	socksReplyCommandNotSupported = 0x07
+	socksReplyAddressNotSupported = 0x08
+)
+
+var errSOCKSReplied = errors.New("socks request rejected")
+
+func (c *secureShell) handleSOCKSConnection(conn net.Conn) {
+	defer conn.Close()
+
+	targetAddr, err := readSOCKSRequest(conn)
+	if err != nil {
+		return
+	}
+
+	target, err := c.secureClient.Dial("tcp", targetAddr)
+	if err != nil {
+		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		_ = writeSOCKSReply(conn, socksReplyGeneralFailure)
+		return
+	}
+	defer target.Close()
+
+	if writeSOCKSReply(conn, socksReplySucceeded) != nil {
+		return
+	}
+
+	pipe(conn, target)
+}
+
+// readSOCKSRequest negotiates the authentication method and returns the
+// address the client asked to connect to. Requests that cannot be served are
+// answered before an error is returned.
+func readSOCKSRequest(conn net.Conn) (string, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return "", err
+	}
+	if header[0] != socksVersion5 {
+		return "", fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return "", err
+	}
+
+	method := byte(socksAuthNoAcceptable)
+	for _, m := range methods {
+		if m == socksAuthNone {
+			method = socksAuthNone
+		}
+	}
+	if _, err := conn.Write([]byte{socksVersion5, method}); err != nil {
+		return "", err
+	}
+	if method == socksAuthNoAcceptable {
+		return "", errSOCKSReplied
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return "", err
+	}
+	if request[1] != socksCommandConnect {
+		_ = writeSOCKSReply(conn, socksReplyCommandNotSupported)
+		return "", errSOCKSReplied
+	}
+
+	var host string
+	switch request[3] {
+	case socksAddressIPv4, socksAddressIPv6:
+		size := net.IPv4len
+		if request[3] == socksAddressIPv6 {
+			size = net.IPv6len
+		}
+		ip := make([]byte, size)
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return "", err
+		}
+		host = net.IP(ip).String()
+	case socksAddressDomain:
+		length := make([]byte, 1)
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return "", err
+		}
+		domain := make([]byte, length[0])
+		if _, err := io.ReadFull(conn, domain); err != nil {
+			return "", err
+		}
+		host = string(domain)
+	default:
+		_ = writeSOCKSReply(conn, socksReplyAddressNotSupported)
+		return "", errSOCKSReplied
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+// writeSOCKSReply sends a reply with an unspecified bound address; clients
+// of a CONNECT request do not use it.
+func writeSOCKSReply(conn net.Conn, reply byte) error {
+	_, err := conn.Write([]byte{socksVersion5, reply, 0x00, socksAddressIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
@@ -23,6 +23,8 @@ import (
 	"code.cloudfoundry.org/cli/cf/ssh/options"
 	"code.cloudfoundry.org/cli/cf/ssh/sigwinch"
 	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/util/proxy"
 	"github.com/docker/docker/pkg/term"
 )
 
@@ -38,6 +40,7 @@ type SecureShell interface {
 	Connect(opts *options.SSHOptions) error
 	InteractiveSession() error
 	LocalPortForward() error
+	RemotePortForward() error
 	Wait() error
 	Close() error
 }
@@ -54,6 +57,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -149,19 +153,72 @@ func (c *secureShell) Close() error {
 
 func (c *secureShell) LocalPortForward() error {
 	for _, forwardSpec := range c.opts.ForwardSpecs {
-		listener, err := c.listenerFactory.Listen("tcp", forwardSpec.ListenAddress)
+		listener, err := c.listenLocal(forwardSpec.ListenAddress)
+		if err != nil {
+			return err
+		}
+
+		connectAddress := forwardSpec.ConnectAddress
+		go c.forwardAcceptLoop(listener, func(conn net.Conn) {
+			c.handleForwardConnection(conn, connectAddress)
+		})
+	}
+
+	for _, address := range c.opts.DynamicForwardAddresses {
+		listener, err := c.listenLocal(address)
 		if err != nil {
 			return err
 		}
+
+		go c.forwardAcceptLoop(listener, c.handleSOCKSConnection)
+	}
+
+	return nil
+}
+
+// RemotePortForward asks the SSH proxy to listen on the app container and
+// forwards the connections it accepts to addresses reachable from here. Not
+// every SSH proxy allows this.
+func (c *secureShell) RemotePortForward() error {
+	for _, forwardSpec := range c.opts.RemoteForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", forwardSpec.ListenAddress)
+		if err != nil {
+			return fmt.Errorf("Remote port forwarding to %s is not available: %s", forwardSpec.ListenAddress, err.Error())
+		}
 		c.localListeners = append(c.localListeners, listener)
 
-		go c.localForwardAcceptLoop(listener, forwardSpec.ConnectAddress)
+		connectAddress := forwardSpec.ConnectAddress
+		go c.forwardAcceptLoop(listener, func(conn net.Conn) {
+			c.handleRemoteForwardConnection(conn, connectAddress)
+		})
 	}
 
 	return nil
 }
 
-func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+func (c *secureShell) listenLocal(address string) (net.Listener, error) {
+	listener, err := c.listenerFactory.Listen("tcp", address)
+	if err != nil {
+		if isAddressInUse(err) {
+			return nil, fmt.Errorf("Local address %s is already in use: %s", address, err.Error())
+		}
+		return nil, err
+	}
+	c.localListeners = append(c.localListeners, listener)
+	return listener, nil
+}
+
+func isAddressInUse(err error) bool {
+	if opErr, ok := err.(*net.OpError); ok {
+		err = opErr.Err
+	}
+	if syscallErr, ok := err.(*os.SyscallError); ok {
+		err = syscallErr.Err
+	}
+	return err == syscall.EADDRINUSE
+}
+
+func (c *secureShell) forwardAcceptLoop(listener net.Listener, handle func(net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -174,7 +231,7 @@ func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handle(conn)
 	}
 }
 
@@ -188,6 +245,23 @@ func (c *secureShell) handleForwardConnection(conn net.Conn, targetAddr string)
 	}
 	defer target.Close()
 
+	pipe(conn, target)
+}
+
+func (c *secureShell) handleRemoteForwardConnection(conn net.Conn, targetAddr string) {
+	defer conn.Close()
+
+	target, err := net.Dial("tcp", targetAddr)
+	if err != nil {
+		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	pipe(conn, target)
+}
+
+func pipe(conn net.Conn, target net.Conn) {
 	wg := &sync.WaitGroup{}
 	wg.Add(2)
 
@@ -454,19 +528,39 @@ func (c *secureShell) getWindowDimensions(terminalFd uintptr) (width int, height
 	return int(winSize.Width), int(winSize.Height)
 }
 
-type secureDialer struct{}
+type secureDialer struct {
+	logger trace.Printer
+}
 
+// Dial opens the SSH connection through the HTTPS proxy in the environment,
+// if one applies to address.
 func (d *secureDialer) Dial(network string, address string, config *ssh.ClientConfig) (SecureClient, error) {
-	client, err := ssh.Dial(network, address, config)
+	dialer := proxy.Dialer{
+		Settings: proxy.FromEnvironment(),
+		Timeout:  config.Timeout,
+	}
+	if d.logger != nil {
+		dialer.Trace = func(message string) {
+			d.logger.Println(message)
+		}
+	}
+
+	conn, err := dialer.Dial(network, address)
 	if err != nil {
 		return nil, err
 	}
 
-	return &secureClient{client: client}, nil
+	clientConn, channels, requests, err := ssh.NewClientConn(conn, address, config)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return &secureClient{client: ssh.NewClient(clientConn, channels, requests)}, nil
 }
 
-func DefaultSecureDialer() SecureDialer {
-	return &secureDialer{}
+func DefaultSecureDialer(logger trace.Printer) SecureDialer {
+	return &secureDialer{logger: logger}
 }
 
 type secureClient struct{ client *ssh.Client }
@@ -477,6 +571,9 @@ func (sc *secureClient) Wait() error    { return sc.client.Wait() }
 func (sc *secureClient) Dial(n, addr string) (net.Conn, error) {
 	return sc.client.Dial(n, addr)
 }
+func (sc *secureClient) Listen(n, addr string) (net.Listener, error) {
+	return sc.client.Listen(n, addr)
+}
 func (sc *secureClient) NewSession() (SecureSession, error) {
 	return sc.client.NewSession()
 }
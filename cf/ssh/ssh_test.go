@@ -21,6 +21,8 @@ import (
 	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
 	"code.cloudfoundry.org/cli/cf/ssh/terminal"
 	"code.cloudfoundry.org/cli/cf/ssh/terminal/terminalfakes"
+	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
+	"code.cloudfoundry.org/cli/util/proxy"
 	"code.cloudfoundry.org/diego-ssh/server"
 	fake_server "code.cloudfoundry.org/diego-ssh/server/fakes"
 	"code.cloudfoundry.org/diego-ssh/test_helpers"
@@ -1080,6 +1082,112 @@ var _ = Describe("SSH", func() {
 			})
 		})
 
+		Context("when the listen address is already in use", func() {
+			BeforeEach(func() {
+				fakeListenerFactory.ListenReturns(nil, &net.OpError{
+					Op:  "listen",
+					Net: "tcp",
+					Err: os.NewSyscallError("bind", syscall.EADDRINUSE),
+				})
+			})
+
+			It("returns an error naming the address", func() {
+				Expect(localForwardError).To(HaveOccurred())
+				Expect(localForwardError.Error()).To(HavePrefix(fmt.Sprintf("Local address %s is already in use: ", localAddress)))
+				Expect(localForwardError.Error()).To(ContainSubstring("address already in use"))
+			})
+		})
+
+		Context("when dynamic port forwarding is requested", func() {
+			var (
+				echoHost string
+				echoPort int
+			)
+
+			BeforeEach(func() {
+				opts = &options.SSHOptions{
+					AppName:                 "app-1",
+					DynamicForwardAddresses: []string{localAddress},
+				}
+
+				tcpAddr := echoListener.Addr().(*net.TCPAddr)
+				echoHost = tcpAddr.IP.String()
+				echoPort = tcpAddr.Port
+			})
+
+			socksHandshake := func(conn net.Conn, command byte) []byte {
+				_, err := conn.Write([]byte{0x05, 0x01, 0x00})
+				Expect(err).NotTo(HaveOccurred())
+
+				method := make([]byte, 2)
+				_, err = io.ReadFull(conn, method)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(method).To(Equal([]byte{0x05, 0x00}))
+
+				request := []byte{0x05, command, 0x00, 0x01}
+				request = append(request, net.ParseIP(echoHost).To4()...)
+				request = append(request, byte(echoPort>>8), byte(echoPort))
+				_, err = conn.Write(request)
+				Expect(err).NotTo(HaveOccurred())
+
+				reply := make([]byte, 10)
+				_, err = io.ReadFull(conn, reply)
+				Expect(err).NotTo(HaveOccurred())
+				return reply
+			}
+
+			It("connects to the requested address through the secure client", func() {
+				Expect(localForwardError).NotTo(HaveOccurred())
+
+				conn, err := net.Dial("tcp", localAddress)
+				Expect(err).NotTo(HaveOccurred())
+				defer conn.Close()
+
+				reply := socksHandshake(conn, 0x01)
+				Expect(reply[1]).To(Equal(byte(0x00)))
+
+				Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+				network, addr := fakeSecureClient.DialArgsForCall(0)
+				Expect(network).To(Equal("tcp"))
+				Expect(addr).To(Equal(echoAddress))
+
+				msg := []byte("Hello through SOCKS\n")
+				_, err = conn.Write(msg)
+				Expect(err).NotTo(HaveOccurred())
+
+				response := make([]byte, len(msg))
+				_, err = io.ReadFull(conn, response)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response).To(Equal(msg))
+			})
+
+			It("rejects commands other than CONNECT", func() {
+				conn, err := net.Dial("tcp", localAddress)
+				Expect(err).NotTo(HaveOccurred())
+				defer conn.Close()
+
+				reply := socksHandshake(conn, 0x02)
+				Expect(reply[1]).To(Equal(byte(0x07)))
+				Expect(fakeSecureClient.DialCallCount()).To(BeZero())
+			})
+
+			Context("when dialing the requested address fails", func() {
+				BeforeEach(func() {
+					fakeSecureClient.DialStub = nil
+					fakeSecureClient.DialReturns(nil, errors.New("boom"))
+				})
+
+				It("replies with a failure", func() {
+					conn, err := net.Dial("tcp", localAddress)
+					Expect(err).NotTo(HaveOccurred())
+					defer conn.Close()
+
+					reply := socksHandshake(conn, 0x01)
+					Expect(reply[1]).To(Equal(byte(0x01)))
+				})
+			})
+		})
+
 		Context("when the client it closed", func() {
 			BeforeEach(func() {
 				fakeListenerFactory.ListenReturns(fakeLocalListener, nil)
@@ -1165,6 +1273,96 @@ var _ = Describe("SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			opts               *options.SSHOptions
+			remoteForwardError error
+
+			targetListener net.Listener
+			remoteListener net.Listener
+		)
+
+		BeforeEach(func() {
+			var err error
+			targetListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+
+			go func() {
+				for {
+					conn, err := targetListener.Accept()
+					if err != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn)
+						conn.Close()
+					}()
+				}
+			}()
+
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			opts = &options.SSHOptions{
+				AppName: "app-1",
+				RemoteForwardSpecs: []options.ForwardSpec{{
+					ListenAddress:  "localhost:9999",
+					ConnectAddress: targetListener.Addr().String(),
+				}},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			remoteForwardError = secureShell.RemotePortForward()
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			targetListener.Close()
+		})
+
+		It("asks the secure client to listen on the remote address", func() {
+			Expect(remoteForwardError).NotTo(HaveOccurred())
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:9999"))
+		})
+
+		It("forwards remote connections to the local connect address", func() {
+			conn, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			msg := []byte("Hello from the app\n")
+			_, err = conn.Write(msg)
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal(msg))
+		})
+
+		Context("when the SSH proxy refuses to listen", func() {
+			BeforeEach(func() {
+				remoteListener.Close()
+				fakeSecureClient.ListenReturns(nil, errors.New("ssh: tcpip-forward request denied by peer"))
+			})
+
+			It("returns an error explaining remote forwarding is unavailable", func() {
+				Expect(remoteForwardError).To(MatchError("Remote port forwarding to localhost:9999 is not available: ssh: tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var opts *options.SSHOptions
 		var waitErr error
@@ -1263,4 +1461,50 @@ var _ = Describe("SSH", func() {
 			Expect(fakeSecureClient.CloseCallCount()).To(Equal(1))
 		})
 	})
+
+	Describe("DefaultSecureDialer", func() {
+		var (
+			fakeLogger   *tracefakes.FakePrinter
+			proxyAddress string
+		)
+
+		BeforeEach(func() {
+			fakeLogger = new(tracefakes.FakePrinter)
+
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			proxyAddress = listener.Addr().String()
+			listener.Close()
+
+			os.Setenv("HTTPS_PROXY", "http://"+proxyAddress)
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("HTTPS_PROXY")
+			os.Unsetenv("NO_PROXY")
+		})
+
+		It("dials the SSH endpoint through the proxy and traces it", func() {
+			_, err := sshCmd.DefaultSecureDialer(fakeLogger).Dial("tcp", "ssh.example.com:2222", &ssh.ClientConfig{})
+			Expect(err).To(BeAssignableToTypeOf(proxy.ProxyConnectError{}))
+			Expect(err.Error()).To(ContainSubstring(proxyAddress))
+
+			Expect(fakeLogger.PrintlnCallCount()).To(Equal(1))
+			Expect(fakeLogger.PrintlnArgsForCall(0)).To(ConsistOf("Connecting to ssh.example.com:2222 through proxy " + proxyAddress))
+		})
+
+		Context("when the SSH endpoint is in NO_PROXY", func() {
+			BeforeEach(func() {
+				os.Setenv("NO_PROXY", "10.0.0.0/8")
+			})
+
+			It("dials the SSH endpoint directly", func() {
+				_, err := sshCmd.DefaultSecureDialer(fakeLogger).Dial("tcp", "10.255.255.1:0", &ssh.ClientConfig{Timeout: time.Millisecond})
+				Expect(err).To(HaveOccurred())
+				Expect(err).NotTo(BeAssignableToTypeOf(proxy.ProxyConnectError{}))
+
+				Expect(fakeLogger.PrintlnArgsForCall(0)).To(ConsistOf("Connecting to 10.255.255.1:0 directly"))
+			})
+		})
+	})
 })
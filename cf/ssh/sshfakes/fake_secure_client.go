@@ -45,6 +45,16 @@ type FakeSecureClient struct {
 	closeReturns     struct {
 		result1 error
 	}
+	ListenStub        func(network string, address string) (net.Listener, error)
+	listenMutex       sync.RWMutex
+	listenArgsForCall []struct {
+		network string
+		address string
+	}
+	listenReturns struct {
+		result1 net.Listener
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -185,6 +195,41 @@ func (fake *FakeSecureClient) CloseReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureClient) Listen(network string, address string) (net.Listener, error) {
+	fake.listenMutex.Lock()
+	fake.listenArgsForCall = append(fake.listenArgsForCall, struct {
+		network string
+		address string
+	}{network, address})
+	fake.recordInvocation("Listen", []interface{}{network, address})
+	fake.listenMutex.Unlock()
+	if fake.ListenStub != nil {
+		return fake.ListenStub(network, address)
+	} else {
+		return fake.listenReturns.result1, fake.listenReturns.result2
+	}
+}
+
+func (fake *FakeSecureClient) ListenCallCount() int {
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
+	return len(fake.listenArgsForCall)
+}
+
+func (fake *FakeSecureClient) ListenArgsForCall(i int) (string, string) {
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
+	return fake.listenArgsForCall[i].network, fake.listenArgsForCall[i].address
+}
+
+func (fake *FakeSecureClient) ListenReturns(result1 net.Listener, result2 error) {
+	fake.ListenStub = nil
+	fake.listenReturns = struct {
+		result1 net.Listener
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecureClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -198,6 +243,8 @@ func (fake *FakeSecureClient) Invocations() map[string][][]interface{} {
 	defer fake.waitMutex.RUnlock()
 	fake.closeMutex.RLock()
 	defer fake.closeMutex.RUnlock()
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
 	return fake.invocations
 }
 
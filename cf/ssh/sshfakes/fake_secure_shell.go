@@ -41,6 +41,12 @@ type FakeSecureShell struct {
 	closeReturns     struct {
 		result1 error
 	}
+	RemotePortForwardStub        func() error
+	remotePortForwardMutex       sync.RWMutex
+	remotePortForwardArgsForCall []struct{}
+	remotePortForwardReturns     struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -178,6 +184,31 @@ func (fake *FakeSecureShell) CloseReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) RemotePortForward() error {
+	fake.remotePortForwardMutex.Lock()
+	fake.remotePortForwardArgsForCall = append(fake.remotePortForwardArgsForCall, struct{}{})
+	fake.recordInvocation("RemotePortForward", []interface{}{})
+	fake.remotePortForwardMutex.Unlock()
+	if fake.RemotePortForwardStub != nil {
+		return fake.RemotePortForwardStub()
+	} else {
+		return fake.remotePortForwardReturns.result1
+	}
+}
+
+func (fake *FakeSecureShell) RemotePortForwardCallCount() int {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return len(fake.remotePortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShell) RemotePortForwardReturns(result1 error) {
+	fake.RemotePortForwardStub = nil
+	fake.remotePortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -191,6 +222,8 @@ func (fake *FakeSecureShell) Invocations() map[string][][]interface{} {
 	defer fake.waitMutex.RUnlock()
 	fake.closeMutex.RLock()
 	defer fake.closeMutex.RUnlock()
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
 	return fake.invocations
 }
 
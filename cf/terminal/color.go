@@ -22,6 +22,10 @@ var (
 	colorize               func(message string, textColor color.Attribute, bold int) string
 	TerminalSupportsColors = isTerminal()
 	UserAskedForColors     = ""
+
+	// UserDisabledColors is set by the global --no-color flag, which takes
+	// precedence over every other color setting.
+	UserDisabledColors = false
 )
 
 func init() {
@@ -29,7 +33,7 @@ func init() {
 }
 
 func InitColorSupport() {
-	if colorsEnabled() {
+	if ColorsEnabled() {
 		colorize = func(message string, textColor color.Attribute, bold int) string {
 			colorPrinter := color.New(textColor)
 			if bold == 1 {
@@ -45,7 +49,14 @@ func InitColorSupport() {
 	}
 }
 
-func colorsEnabled() bool {
+// ColorsEnabled returns true when output is colorized, based on the
+// --no-color flag, $CF_COLOR, the color setting in the config and whether the
+// terminal supports colors.
+func ColorsEnabled() bool {
+	if UserDisabledColors {
+		return false
+	}
+
 	if os.Getenv("CF_COLOR") == "true" {
 		return true
 	}
@@ -61,6 +72,12 @@ func colorsEnabled() bool {
 	return UserAskedForColors != "false" && TerminalSupportsColors
 }
 
+// AnimationsEnabled returns true when output may be animated, which is only
+// the case on a terminal with colors enabled.
+func AnimationsEnabled() bool {
+	return TerminalSupportsColors && ColorsEnabled()
+}
+
 func Colorize(message string, textColor color.Attribute) string {
 	return colorize(message, textColor, 0)
 }
@@ -12,6 +12,7 @@ import (
 var _ = Describe("Terminal colors", func() {
 	BeforeEach(func() {
 		UserAskedForColors = ""
+		UserDisabledColors = false
 	})
 
 	JustBeforeEach(func() {
@@ -89,6 +90,51 @@ var _ = Describe("Terminal colors", func() {
 		})
 	})
 
+	Describe("--no-color", func() {
+		BeforeEach(func() {
+			UserDisabledColors = true
+			TerminalSupportsColors = true
+		})
+
+		AfterEach(func() {
+			UserDisabledColors = false
+		})
+
+		Context("when CF_COLOR is set to 'true' and the user asked for colors", func() {
+			BeforeEach(func() {
+				os.Setenv("CF_COLOR", "true")
+				UserAskedForColors = "true"
+			})
+
+			itDoesntColorize()
+
+			It("disables animations", func() {
+				Expect(AnimationsEnabled()).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("AnimationsEnabled", func() {
+		BeforeEach(func() { os.Setenv("CF_COLOR", "") })
+
+		It("is true when the terminal supports colors", func() {
+			TerminalSupportsColors = true
+			Expect(AnimationsEnabled()).To(BeTrue())
+		})
+
+		It("is false when the terminal doesn't support colors, even if the user asked for colors", func() {
+			TerminalSupportsColors = false
+			UserAskedForColors = "true"
+			Expect(AnimationsEnabled()).To(BeFalse())
+		})
+
+		It("is false when the user turned colors off", func() {
+			TerminalSupportsColors = true
+			UserAskedForColors = "false"
+			Expect(AnimationsEnabled()).To(BeFalse())
+		})
+	})
+
 	var (
 		originalTerminalSupportsColors bool
 	)
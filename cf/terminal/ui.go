@@ -247,6 +247,9 @@ func (ui *terminalUI) ShowConfiguration(config coreconfig.Reader) error {
 }
 
 func (ui *terminalUI) LoadingIndication() {
+	if !AnimationsEnabled() {
+		return
+	}
 	_, _ = ui.printer.Print(".")
 }
 
@@ -3,12 +3,17 @@ package trace
 import (
 	"io"
 	"os"
-	"path/filepath"
 	"strconv"
+	"strings"
 
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/rotatingfile"
 )
 
+// NewLogger returns a Printer tracing to writer when verbose is set, and to
+// the booleans or file paths in boolsOrPaths. Each of them can be a
+// comma-separated list, such as "true,/tmp/cf-trace.log".
 func NewLogger(writer io.Writer, verbose bool, boolsOrPaths ...string) Printer {
 	LoggingToStdout = verbose
 
@@ -16,17 +21,13 @@ func NewLogger(writer io.Writer, verbose bool, boolsOrPaths ...string) Printer {
 
 	stdoutLogger := NewWriterPrinter(writer, true)
 
-	for _, path := range boolsOrPaths {
+	for _, path := range splitTargets(boolsOrPaths) {
 		b, err := strconv.ParseBool(path)
 		LoggingToStdout = LoggingToStdout || b
 
 		if path != "" && err != nil {
-			var file *os.File
-			err = os.MkdirAll(filepath.Dir(path), os.ModeDir|os.ModePerm)
-			if err == nil {
-				file, err = os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
-			}
-
+			var file *rotatingfile.File
+			file, err = rotatingfile.Open(path, fileRotation())
 			if err == nil {
 				printers = append(printers, NewWriterPrinter(file, false))
 			} else {
@@ -44,3 +45,26 @@ func NewLogger(writer io.Writer, verbose bool, boolsOrPaths ...string) Printer {
 
 	return CombinePrinters(printers)
 }
+
+func splitTargets(boolsOrPaths []string) []string {
+	var targets []string
+	for _, value := range boolsOrPaths {
+		for _, target := range strings.Split(value, ",") {
+			targets = append(targets, strings.TrimSpace(target))
+		}
+	}
+	return targets
+}
+
+// fileRotation returns the rotation of trace files set by CF_TRACE_MAX_SIZE
+// and CF_TRACE_MAX_FILES.
+func fileRotation() rotatingfile.Options {
+	config := configv3.Config{
+		ENV: configv3.EnvOverride{
+			CFTraceMaxFiles: os.Getenv("CF_TRACE_MAX_FILES"),
+			CFTraceMaxSize:  os.Getenv("CF_TRACE_MAX_SIZE"),
+		},
+	}
+	maxSize, maxFiles := config.TraceFileRotation()
+	return rotatingfile.Options{MaxSize: maxSize, MaxFiles: maxFiles}
+}
@@ -1,6 +1,8 @@
 package trace_test
 
 import (
+	"bytes"
+	"fmt"
 	"io/ioutil"
 	"path"
 	"runtime"
@@ -171,6 +173,41 @@ var _ = Describe("NewLogger", func() {
 		})
 	})
 
+	It("returns a logger that writes to STDOUT and a file when CF_TRACE is a list of true and a path", func() {
+		fileutils.TempFile("trace_test", func(file *os.File, err error) {
+			logger := NewLogger(buffer, false, fmt.Sprintf("true, %s", file.Name()), "")
+
+			logger.Print("Hello World")
+
+			Expect(buffer).To(gbytes.Say("Hello World"))
+
+			fileContents, _ := ioutil.ReadAll(file)
+			Expect(fileContents).To(ContainSubstring("Hello World"))
+		})
+	})
+
+	It("rotates the trace file when CF_TRACE_MAX_SIZE is set", func() {
+		fileutils.TempDir("trace_test", func(tmpDir string, err error) {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Setenv("CF_TRACE_MAX_SIZE", "1")).To(Succeed())
+			defer os.Unsetenv("CF_TRACE_MAX_SIZE")
+
+			fileName := path.Join(tmpDir, "trace_test")
+			Expect(ioutil.WriteFile(fileName, bytes.Repeat([]byte("a"), 1024*1024), 0600)).To(Succeed())
+
+			logger := NewLogger(buffer, false, fileName, "")
+			logger.Print("Hello World")
+
+			fileContents, err := ioutil.ReadFile(fileName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(fileContents)).To(Equal("Hello World\n"))
+
+			rotatedContents, err := ioutil.ReadFile(fileName + ".1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rotatedContents).To(HaveLen(1024 * 1024))
+		})
+	})
+
 	It("returns a logger that writes to STDOUT when CF_TRACE is a path that cannot be opened", func() {
 		if runtime.GOOS != "windows" {
 			logger := NewLogger(buffer, false, "/dev/null/whoops", "")
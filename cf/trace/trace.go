@@ -2,7 +2,9 @@ package trace
 
 import (
 	"fmt"
+	"os"
 	"regexp"
+	"strconv"
 
 	. "code.cloudfoundry.org/cli/cf/i18n"
 )
@@ -10,9 +12,16 @@ import (
 var LoggingToStdout bool
 
 func Sanitize(input string) string {
+	if showSecrets() {
+		return input
+	}
+
 	re := regexp.MustCompile(`(?m)^Authorization: .*`)
 	sanitized := re.ReplaceAllString(input, "Authorization: "+PrivateDataPlaceholder())
 
+	re = regexp.MustCompile(`(?m)^Set-Cookie: .*`)
+	sanitized = re.ReplaceAllString(sanitized, "Set-Cookie: "+PrivateDataPlaceholder())
+
 	re = regexp.MustCompile(`password=[^&]*&`)
 	sanitized = re.ReplaceAllString(sanitized, "password="+PrivateDataPlaceholder()+"&")
 
@@ -22,11 +31,21 @@ func Sanitize(input string) string {
 	return sanitized
 }
 
+// sanitizeJSON hides the string values of the JSON properties whose name
+// contains propertySubstring. A value cut off by the end of the input, as in
+// a chunk of a streamed body, is hidden up to the end of the input.
 func sanitizeJSON(propertySubstring string, json string) string {
-	regex := regexp.MustCompile(fmt.Sprintf(`(?i)"([^"]*%s[^"]*)":\s*"[^\,]*"`, propertySubstring))
+	regex := regexp.MustCompile(fmt.Sprintf(`(?i)"([^"]*%s[^"]*)":\s*"(?:[^"\\]|\\.)*(?:"|\\?$)`, propertySubstring))
 	return regex.ReplaceAllString(json, fmt.Sprintf(`"$1":"%s"`, PrivateDataPlaceholder()))
 }
 
+// showSecrets reports whether CF_TRACE_SHOW_SECRETS asks for unredacted trace
+// output.
+func showSecrets() bool {
+	show, _ := strconv.ParseBool(os.Getenv("CF_TRACE_SHOW_SECRETS"))
+	return show
+}
+
 func PrivateDataPlaceholder() string {
 	return T("[PRIVATE DATA HIDDEN]")
 }
@@ -1,6 +1,8 @@
 package trace_test
 
 import (
+	"os"
+
 	. "code.cloudfoundry.org/cli/cf/trace"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -191,6 +193,49 @@ Server: Apache-Coyote/1.1
 			Expect(Sanitize(response)).To(Equal(expected))
 		})
 
+		It("hides the cookies set by the response", func() {
+			response := `
+HTTP/1.1 200 OK
+Content-Type: application/json;charset=utf-8
+Set-Cookie: JSESSIONID=some-session-id; Path=/; HttpOnly
+
+{}
+`
+
+			expected := `
+HTTP/1.1 200 OK
+Content-Type: application/json;charset=utf-8
+Set-Cookie: [PRIVATE DATA HIDDEN]
+
+{}
+`
+
+			Expect(Sanitize(response)).To(Equal(expected))
+		})
+
+		It("hides a token cut off by the end of a chunk", func() {
+			Expect(Sanitize(`{"name":"some-name","access_token":"some-tok`)).To(Equal(`{"name":"some-name","access_token":"[PRIVATE DATA HIDDEN]"`))
+		})
+
+		It("only hides the value of the matching property", func() {
+			Expect(Sanitize(`{"token":"some-token"} "some":"quoted, text"`)).To(Equal(`{"token":"[PRIVATE DATA HIDDEN]"} "some":"quoted, text"`))
+		})
+
+		Context("when CF_TRACE_SHOW_SECRETS is true", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_TRACE_SHOW_SECRETS", "true")).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_TRACE_SHOW_SECRETS")).To(Succeed())
+			})
+
+			It("does not hide anything", func() {
+				request := "Authorization: bearer some-token\n\n{\"password\":\"some-password\"}"
+				Expect(Sanitize(request)).To(Equal(request))
+			})
+		})
+
 		Describe("hiding credentials in application environment variables", func() {
 			It("hides the value of any key matching case-insensitive substring 'token'", func() {
 				response := `
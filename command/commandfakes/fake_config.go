@@ -378,6 +378,131 @@ type FakeConfig struct {
 	writePluginConfigReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeprecatedStacksStub        func() []string
+	deprecatedStacksMutex       sync.RWMutex
+	deprecatedStacksArgsForCall []struct{}
+	deprecatedStacksReturns     struct {
+		result1 []string
+	}
+	deprecatedStacksReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	ApplyConfigBundleStub        func(bundle configv3.ConfigBundle)
+	applyConfigBundleMutex       sync.RWMutex
+	applyConfigBundleArgsForCall []struct {
+		bundle configv3.ConfigBundle
+	}
+	ConfigBundleStub        func() configv3.ConfigBundle
+	configBundleMutex       sync.RWMutex
+	configBundleArgsForCall []struct{}
+	configBundleReturns     struct {
+		result1 configv3.ConfigBundle
+	}
+	configBundleReturnsOnCall map[int]struct {
+		result1 configv3.ConfigBundle
+	}
+	UAAGrantTypeStub        func() string
+	uaaGrantTypeMutex       sync.RWMutex
+	uaaGrantTypeArgsForCall []struct{}
+	uaaGrantTypeReturns     struct {
+		result1 string
+	}
+	uaaGrantTypeReturnsOnCall map[int]struct {
+		result1 string
+	}
+	SetUAAClientCredentialsStub        func(client string, secret string)
+	setUAAClientCredentialsMutex       sync.RWMutex
+	setUAAClientCredentialsArgsForCall []struct {
+		client string
+		secret string
+	}
+	SetUAAGrantTypeStub        func(grantType string)
+	setUAAGrantTypeMutex       sync.RWMutex
+	setUAAGrantTypeArgsForCall []struct {
+		grantType string
+	}
+	FoundationCheckDisabledStub        func() bool
+	foundationCheckDisabledMutex       sync.RWMutex
+	foundationCheckDisabledArgsForCall []struct{}
+	foundationCheckDisabledReturns     struct {
+		result1 bool
+	}
+	foundationCheckDisabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	UAAEndpointStub        func() string
+	uaaEndpointMutex       sync.RWMutex
+	uaaEndpointArgsForCall []struct{}
+	uaaEndpointReturns     struct {
+		result1 string
+	}
+	uaaEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
+	UAAIssuerStub        func() string
+	uaaIssuerMutex       sync.RWMutex
+	uaaIssuerArgsForCall []struct{}
+	uaaIssuerReturns     struct {
+		result1 string
+	}
+	uaaIssuerReturnsOnCall map[int]struct {
+		result1 string
+	}
+	SetUAAIssuerStub        func(issuer string)
+	setUAAIssuerMutex       sync.RWMutex
+	setUAAIssuerArgsForCall []struct {
+		issuer string
+	}
+	CustomConfigDirectoryStub        func() string
+	customConfigDirectoryMutex       sync.RWMutex
+	customConfigDirectoryArgsForCall []struct{}
+	customConfigDirectoryReturns     struct {
+		result1 string
+	}
+	customConfigDirectoryReturnsOnCall map[int]struct {
+		result1 string
+	}
+	CACertStub        func() []byte
+	caCertMutex       sync.RWMutex
+	caCertArgsForCall []struct{}
+	caCertReturns     struct {
+		result1 []byte
+	}
+	caCertReturnsOnCall map[int]struct {
+		result1 []byte
+	}
+	CACertOverrideStub        func() []byte
+	caCertOverrideMutex       sync.RWMutex
+	caCertOverrideArgsForCall []struct{}
+	caCertOverrideReturns     struct {
+		result1 []byte
+	}
+	caCertOverrideReturnsOnCall map[int]struct {
+		result1 []byte
+	}
+	SetCACertStub        func(caCert []byte)
+	setCACertMutex       sync.RWMutex
+	setCACertArgsForCall []struct {
+		caCert []byte
+	}
+	RetryCountStub        func() int
+	retryCountMutex       sync.RWMutex
+	retryCountArgsForCall []struct{}
+	retryCountReturns     struct {
+		result1 int
+	}
+	retryCountReturnsOnCall map[int]struct {
+		result1 int
+	}
+	IsTTYStub        func() bool
+	isTTYMutex       sync.RWMutex
+	isTTYArgsForCall []struct{}
+	isTTYReturns     struct {
+		result1 bool
+	}
+	isTTYReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1974,6 +2099,572 @@ func (fake *FakeConfig) WritePluginConfigReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeConfig) DeprecatedStacks() []string {
+	fake.deprecatedStacksMutex.Lock()
+	ret, specificReturn := fake.deprecatedStacksReturnsOnCall[len(fake.deprecatedStacksArgsForCall)]
+	fake.deprecatedStacksArgsForCall = append(fake.deprecatedStacksArgsForCall, struct{}{})
+	fake.recordInvocation("DeprecatedStacks", []interface{}{})
+	fake.deprecatedStacksMutex.Unlock()
+	if fake.DeprecatedStacksStub != nil {
+		return fake.DeprecatedStacksStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deprecatedStacksReturns.result1
+}
+
+func (fake *FakeConfig) DeprecatedStacksCallCount() int {
+	fake.deprecatedStacksMutex.RLock()
+	defer fake.deprecatedStacksMutex.RUnlock()
+	return len(fake.deprecatedStacksArgsForCall)
+}
+
+func (fake *FakeConfig) DeprecatedStacksReturns(result1 []string) {
+	fake.DeprecatedStacksStub = nil
+	fake.deprecatedStacksReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) DeprecatedStacksReturnsOnCall(i int, result1 []string) {
+	fake.DeprecatedStacksStub = nil
+	if fake.deprecatedStacksReturnsOnCall == nil {
+		fake.deprecatedStacksReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.deprecatedStacksReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) ApplyConfigBundle(bundle configv3.ConfigBundle) {
+	fake.applyConfigBundleMutex.Lock()
+	fake.applyConfigBundleArgsForCall = append(fake.applyConfigBundleArgsForCall, struct {
+		bundle configv3.ConfigBundle
+	}{bundle})
+	fake.recordInvocation("ApplyConfigBundle", []interface{}{bundle})
+	fake.applyConfigBundleMutex.Unlock()
+	if fake.ApplyConfigBundleStub != nil {
+		fake.ApplyConfigBundleStub(bundle)
+	}
+}
+
+func (fake *FakeConfig) ApplyConfigBundleCallCount() int {
+	fake.applyConfigBundleMutex.RLock()
+	defer fake.applyConfigBundleMutex.RUnlock()
+	return len(fake.applyConfigBundleArgsForCall)
+}
+
+func (fake *FakeConfig) ApplyConfigBundleArgsForCall(i int) configv3.ConfigBundle {
+	fake.applyConfigBundleMutex.RLock()
+	defer fake.applyConfigBundleMutex.RUnlock()
+	return fake.applyConfigBundleArgsForCall[i].bundle
+}
+
+func (fake *FakeConfig) ConfigBundle() configv3.ConfigBundle {
+	fake.configBundleMutex.Lock()
+	ret, specificReturn := fake.configBundleReturnsOnCall[len(fake.configBundleArgsForCall)]
+	fake.configBundleArgsForCall = append(fake.configBundleArgsForCall, struct{}{})
+	fake.recordInvocation("ConfigBundle", []interface{}{})
+	fake.configBundleMutex.Unlock()
+	if fake.ConfigBundleStub != nil {
+		return fake.ConfigBundleStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.configBundleReturns.result1
+}
+
+func (fake *FakeConfig) ConfigBundleCallCount() int {
+	fake.configBundleMutex.RLock()
+	defer fake.configBundleMutex.RUnlock()
+	return len(fake.configBundleArgsForCall)
+}
+
+func (fake *FakeConfig) ConfigBundleReturns(result1 configv3.ConfigBundle) {
+	fake.ConfigBundleStub = nil
+	fake.configBundleReturns = struct {
+		result1 configv3.ConfigBundle
+	}{result1}
+}
+
+func (fake *FakeConfig) ConfigBundleReturnsOnCall(i int, result1 configv3.ConfigBundle) {
+	fake.ConfigBundleStub = nil
+	if fake.configBundleReturnsOnCall == nil {
+		fake.configBundleReturnsOnCall = make(map[int]struct {
+			result1 configv3.ConfigBundle
+		})
+	}
+	fake.configBundleReturnsOnCall[i] = struct {
+		result1 configv3.ConfigBundle
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAGrantType() string {
+	fake.uaaGrantTypeMutex.Lock()
+	ret, specificReturn := fake.uaaGrantTypeReturnsOnCall[len(fake.uaaGrantTypeArgsForCall)]
+	fake.uaaGrantTypeArgsForCall = append(fake.uaaGrantTypeArgsForCall, struct{}{})
+	fake.recordInvocation("UAAGrantType", []interface{}{})
+	fake.uaaGrantTypeMutex.Unlock()
+	if fake.UAAGrantTypeStub != nil {
+		return fake.UAAGrantTypeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.uaaGrantTypeReturns.result1
+}
+
+func (fake *FakeConfig) UAAGrantTypeCallCount() int {
+	fake.uaaGrantTypeMutex.RLock()
+	defer fake.uaaGrantTypeMutex.RUnlock()
+	return len(fake.uaaGrantTypeArgsForCall)
+}
+
+func (fake *FakeConfig) UAAGrantTypeReturns(result1 string) {
+	fake.UAAGrantTypeStub = nil
+	fake.uaaGrantTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAGrantTypeReturnsOnCall(i int, result1 string) {
+	fake.UAAGrantTypeStub = nil
+	if fake.uaaGrantTypeReturnsOnCall == nil {
+		fake.uaaGrantTypeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uaaGrantTypeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) SetUAAClientCredentials(client string, secret string) {
+	fake.setUAAClientCredentialsMutex.Lock()
+	fake.setUAAClientCredentialsArgsForCall = append(fake.setUAAClientCredentialsArgsForCall, struct {
+		client string
+		secret string
+	}{client, secret})
+	fake.recordInvocation("SetUAAClientCredentials", []interface{}{client, secret})
+	fake.setUAAClientCredentialsMutex.Unlock()
+	if fake.SetUAAClientCredentialsStub != nil {
+		fake.SetUAAClientCredentialsStub(client, secret)
+	}
+}
+
+func (fake *FakeConfig) SetUAAClientCredentialsCallCount() int {
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	return len(fake.setUAAClientCredentialsArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAClientCredentialsArgsForCall(i int) (string, string) {
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	return fake.setUAAClientCredentialsArgsForCall[i].client, fake.setUAAClientCredentialsArgsForCall[i].secret
+}
+
+func (fake *FakeConfig) SetUAAGrantType(grantType string) {
+	fake.setUAAGrantTypeMutex.Lock()
+	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
+		grantType string
+	}{grantType})
+	fake.recordInvocation("SetUAAGrantType", []interface{}{grantType})
+	fake.setUAAGrantTypeMutex.Unlock()
+	if fake.SetUAAGrantTypeStub != nil {
+		fake.SetUAAGrantTypeStub(grantType)
+	}
+}
+
+func (fake *FakeConfig) SetUAAGrantTypeCallCount() int {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return len(fake.setUAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAGrantTypeArgsForCall(i int) string {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return fake.setUAAGrantTypeArgsForCall[i].grantType
+}
+
+func (fake *FakeConfig) FoundationCheckDisabled() bool {
+	fake.foundationCheckDisabledMutex.Lock()
+	ret, specificReturn := fake.foundationCheckDisabledReturnsOnCall[len(fake.foundationCheckDisabledArgsForCall)]
+	fake.foundationCheckDisabledArgsForCall = append(fake.foundationCheckDisabledArgsForCall, struct{}{})
+	fake.recordInvocation("FoundationCheckDisabled", []interface{}{})
+	fake.foundationCheckDisabledMutex.Unlock()
+	if fake.FoundationCheckDisabledStub != nil {
+		return fake.FoundationCheckDisabledStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.foundationCheckDisabledReturns.result1
+}
+
+func (fake *FakeConfig) FoundationCheckDisabledCallCount() int {
+	fake.foundationCheckDisabledMutex.RLock()
+	defer fake.foundationCheckDisabledMutex.RUnlock()
+	return len(fake.foundationCheckDisabledArgsForCall)
+}
+
+func (fake *FakeConfig) FoundationCheckDisabledReturns(result1 bool) {
+	fake.FoundationCheckDisabledStub = nil
+	fake.foundationCheckDisabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) FoundationCheckDisabledReturnsOnCall(i int, result1 bool) {
+	fake.FoundationCheckDisabledStub = nil
+	if fake.foundationCheckDisabledReturnsOnCall == nil {
+		fake.foundationCheckDisabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.foundationCheckDisabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAEndpoint() string {
+	fake.uaaEndpointMutex.Lock()
+	ret, specificReturn := fake.uaaEndpointReturnsOnCall[len(fake.uaaEndpointArgsForCall)]
+	fake.uaaEndpointArgsForCall = append(fake.uaaEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("UAAEndpoint", []interface{}{})
+	fake.uaaEndpointMutex.Unlock()
+	if fake.UAAEndpointStub != nil {
+		return fake.UAAEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.uaaEndpointReturns.result1
+}
+
+func (fake *FakeConfig) UAAEndpointCallCount() int {
+	fake.uaaEndpointMutex.RLock()
+	defer fake.uaaEndpointMutex.RUnlock()
+	return len(fake.uaaEndpointArgsForCall)
+}
+
+func (fake *FakeConfig) UAAEndpointReturns(result1 string) {
+	fake.UAAEndpointStub = nil
+	fake.uaaEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAEndpointReturnsOnCall(i int, result1 string) {
+	fake.UAAEndpointStub = nil
+	if fake.uaaEndpointReturnsOnCall == nil {
+		fake.uaaEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uaaEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAIssuer() string {
+	fake.uaaIssuerMutex.Lock()
+	ret, specificReturn := fake.uaaIssuerReturnsOnCall[len(fake.uaaIssuerArgsForCall)]
+	fake.uaaIssuerArgsForCall = append(fake.uaaIssuerArgsForCall, struct{}{})
+	fake.recordInvocation("UAAIssuer", []interface{}{})
+	fake.uaaIssuerMutex.Unlock()
+	if fake.UAAIssuerStub != nil {
+		return fake.UAAIssuerStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.uaaIssuerReturns.result1
+}
+
+func (fake *FakeConfig) UAAIssuerCallCount() int {
+	fake.uaaIssuerMutex.RLock()
+	defer fake.uaaIssuerMutex.RUnlock()
+	return len(fake.uaaIssuerArgsForCall)
+}
+
+func (fake *FakeConfig) UAAIssuerReturns(result1 string) {
+	fake.UAAIssuerStub = nil
+	fake.uaaIssuerReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAIssuerReturnsOnCall(i int, result1 string) {
+	fake.UAAIssuerStub = nil
+	if fake.uaaIssuerReturnsOnCall == nil {
+		fake.uaaIssuerReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uaaIssuerReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) SetUAAIssuer(issuer string) {
+	fake.setUAAIssuerMutex.Lock()
+	fake.setUAAIssuerArgsForCall = append(fake.setUAAIssuerArgsForCall, struct {
+		issuer string
+	}{issuer})
+	fake.recordInvocation("SetUAAIssuer", []interface{}{issuer})
+	fake.setUAAIssuerMutex.Unlock()
+	if fake.SetUAAIssuerStub != nil {
+		fake.SetUAAIssuerStub(issuer)
+	}
+}
+
+func (fake *FakeConfig) SetUAAIssuerCallCount() int {
+	fake.setUAAIssuerMutex.RLock()
+	defer fake.setUAAIssuerMutex.RUnlock()
+	return len(fake.setUAAIssuerArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAIssuerArgsForCall(i int) string {
+	fake.setUAAIssuerMutex.RLock()
+	defer fake.setUAAIssuerMutex.RUnlock()
+	return fake.setUAAIssuerArgsForCall[i].issuer
+}
+
+func (fake *FakeConfig) CustomConfigDirectory() string {
+	fake.customConfigDirectoryMutex.Lock()
+	ret, specificReturn := fake.customConfigDirectoryReturnsOnCall[len(fake.customConfigDirectoryArgsForCall)]
+	fake.customConfigDirectoryArgsForCall = append(fake.customConfigDirectoryArgsForCall, struct{}{})
+	fake.recordInvocation("CustomConfigDirectory", []interface{}{})
+	fake.customConfigDirectoryMutex.Unlock()
+	if fake.CustomConfigDirectoryStub != nil {
+		return fake.CustomConfigDirectoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.customConfigDirectoryReturns.result1
+}
+
+func (fake *FakeConfig) CustomConfigDirectoryCallCount() int {
+	fake.customConfigDirectoryMutex.RLock()
+	defer fake.customConfigDirectoryMutex.RUnlock()
+	return len(fake.customConfigDirectoryArgsForCall)
+}
+
+func (fake *FakeConfig) CustomConfigDirectoryReturns(result1 string) {
+	fake.CustomConfigDirectoryStub = nil
+	fake.customConfigDirectoryReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CustomConfigDirectoryReturnsOnCall(i int, result1 string) {
+	fake.CustomConfigDirectoryStub = nil
+	if fake.customConfigDirectoryReturnsOnCall == nil {
+		fake.customConfigDirectoryReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.customConfigDirectoryReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CACert() []byte {
+	fake.caCertMutex.Lock()
+	ret, specificReturn := fake.caCertReturnsOnCall[len(fake.caCertArgsForCall)]
+	fake.caCertArgsForCall = append(fake.caCertArgsForCall, struct{}{})
+	fake.recordInvocation("CACert", []interface{}{})
+	fake.caCertMutex.Unlock()
+	if fake.CACertStub != nil {
+		return fake.CACertStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.caCertReturns.result1
+}
+
+func (fake *FakeConfig) CACertCallCount() int {
+	fake.caCertMutex.RLock()
+	defer fake.caCertMutex.RUnlock()
+	return len(fake.caCertArgsForCall)
+}
+
+func (fake *FakeConfig) CACertReturns(result1 []byte) {
+	fake.CACertStub = nil
+	fake.caCertReturns = struct {
+		result1 []byte
+	}{result1}
+}
+
+func (fake *FakeConfig) CACertReturnsOnCall(i int, result1 []byte) {
+	fake.CACertStub = nil
+	if fake.caCertReturnsOnCall == nil {
+		fake.caCertReturnsOnCall = make(map[int]struct {
+			result1 []byte
+		})
+	}
+	fake.caCertReturnsOnCall[i] = struct {
+		result1 []byte
+	}{result1}
+}
+
+func (fake *FakeConfig) CACertOverride() []byte {
+	fake.caCertOverrideMutex.Lock()
+	ret, specificReturn := fake.caCertOverrideReturnsOnCall[len(fake.caCertOverrideArgsForCall)]
+	fake.caCertOverrideArgsForCall = append(fake.caCertOverrideArgsForCall, struct{}{})
+	fake.recordInvocation("CACertOverride", []interface{}{})
+	fake.caCertOverrideMutex.Unlock()
+	if fake.CACertOverrideStub != nil {
+		return fake.CACertOverrideStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.caCertOverrideReturns.result1
+}
+
+func (fake *FakeConfig) CACertOverrideCallCount() int {
+	fake.caCertOverrideMutex.RLock()
+	defer fake.caCertOverrideMutex.RUnlock()
+	return len(fake.caCertOverrideArgsForCall)
+}
+
+func (fake *FakeConfig) CACertOverrideReturns(result1 []byte) {
+	fake.CACertOverrideStub = nil
+	fake.caCertOverrideReturns = struct {
+		result1 []byte
+	}{result1}
+}
+
+func (fake *FakeConfig) CACertOverrideReturnsOnCall(i int, result1 []byte) {
+	fake.CACertOverrideStub = nil
+	if fake.caCertOverrideReturnsOnCall == nil {
+		fake.caCertOverrideReturnsOnCall = make(map[int]struct {
+			result1 []byte
+		})
+	}
+	fake.caCertOverrideReturnsOnCall[i] = struct {
+		result1 []byte
+	}{result1}
+}
+
+func (fake *FakeConfig) SetCACert(caCert []byte) {
+	var caCertCopy []byte
+	if caCert != nil {
+		caCertCopy = make([]byte, len(caCert))
+		copy(caCertCopy, caCert)
+	}
+	fake.setCACertMutex.Lock()
+	fake.setCACertArgsForCall = append(fake.setCACertArgsForCall, struct {
+		caCert []byte
+	}{caCertCopy})
+	fake.recordInvocation("SetCACert", []interface{}{caCertCopy})
+	fake.setCACertMutex.Unlock()
+	if fake.SetCACertStub != nil {
+		fake.SetCACertStub(caCert)
+	}
+}
+
+func (fake *FakeConfig) SetCACertCallCount() int {
+	fake.setCACertMutex.RLock()
+	defer fake.setCACertMutex.RUnlock()
+	return len(fake.setCACertArgsForCall)
+}
+
+func (fake *FakeConfig) SetCACertArgsForCall(i int) []byte {
+	fake.setCACertMutex.RLock()
+	defer fake.setCACertMutex.RUnlock()
+	return fake.setCACertArgsForCall[i].caCert
+}
+
+func (fake *FakeConfig) RetryCount() int {
+	fake.retryCountMutex.Lock()
+	ret, specificReturn := fake.retryCountReturnsOnCall[len(fake.retryCountArgsForCall)]
+	fake.retryCountArgsForCall = append(fake.retryCountArgsForCall, struct{}{})
+	fake.recordInvocation("RetryCount", []interface{}{})
+	fake.retryCountMutex.Unlock()
+	if fake.RetryCountStub != nil {
+		return fake.RetryCountStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.retryCountReturns.result1
+}
+
+func (fake *FakeConfig) RetryCountCallCount() int {
+	fake.retryCountMutex.RLock()
+	defer fake.retryCountMutex.RUnlock()
+	return len(fake.retryCountArgsForCall)
+}
+
+func (fake *FakeConfig) RetryCountReturns(result1 int) {
+	fake.RetryCountStub = nil
+	fake.retryCountReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) RetryCountReturnsOnCall(i int, result1 int) {
+	fake.RetryCountStub = nil
+	if fake.retryCountReturnsOnCall == nil {
+		fake.retryCountReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.retryCountReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) IsTTY() bool {
+	fake.isTTYMutex.Lock()
+	ret, specificReturn := fake.isTTYReturnsOnCall[len(fake.isTTYArgsForCall)]
+	fake.isTTYArgsForCall = append(fake.isTTYArgsForCall, struct{}{})
+	fake.recordInvocation("IsTTY", []interface{}{})
+	fake.isTTYMutex.Unlock()
+	if fake.IsTTYStub != nil {
+		return fake.IsTTYStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isTTYReturns.result1
+}
+
+func (fake *FakeConfig) IsTTYCallCount() int {
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
+	return len(fake.isTTYArgsForCall)
+}
+
+func (fake *FakeConfig) IsTTYReturns(result1 bool) {
+	fake.IsTTYStub = nil
+	fake.isTTYReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) IsTTYReturnsOnCall(i int, result1 bool) {
+	fake.IsTTYStub = nil
+	if fake.isTTYReturnsOnCall == nil {
+		fake.isTTYReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isTTYReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2065,6 +2756,38 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.verboseMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
 	defer fake.writePluginConfigMutex.RUnlock()
+	fake.deprecatedStacksMutex.RLock()
+	defer fake.deprecatedStacksMutex.RUnlock()
+	fake.applyConfigBundleMutex.RLock()
+	defer fake.applyConfigBundleMutex.RUnlock()
+	fake.configBundleMutex.RLock()
+	defer fake.configBundleMutex.RUnlock()
+	fake.uaaGrantTypeMutex.RLock()
+	defer fake.uaaGrantTypeMutex.RUnlock()
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	fake.foundationCheckDisabledMutex.RLock()
+	defer fake.foundationCheckDisabledMutex.RUnlock()
+	fake.uaaEndpointMutex.RLock()
+	defer fake.uaaEndpointMutex.RUnlock()
+	fake.uaaIssuerMutex.RLock()
+	defer fake.uaaIssuerMutex.RUnlock()
+	fake.setUAAIssuerMutex.RLock()
+	defer fake.setUAAIssuerMutex.RUnlock()
+	fake.customConfigDirectoryMutex.RLock()
+	defer fake.customConfigDirectoryMutex.RUnlock()
+	fake.caCertMutex.RLock()
+	defer fake.caCertMutex.RUnlock()
+	fake.caCertOverrideMutex.RLock()
+	defer fake.caCertOverrideMutex.RUnlock()
+	fake.setCACertMutex.RLock()
+	defer fake.setCACertMutex.RUnlock()
+	fake.retryCountMutex.RLock()
+	defer fake.retryCountMutex.RUnlock()
+	fake.isTTYMutex.RLock()
+	defer fake.isTTYMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -12,6 +12,7 @@ var Commands commandList
 
 type commandList struct {
 	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	NoColor          bool `long:"no-color" description:"Disable colors, spinners and progress bar animations"`
 
 	V2Push v2.V2PushCommand `command:"v2-push" description:"Push a new app or sync changes to an existing app"`
 
@@ -37,18 +38,24 @@ type commandList struct {
 	AddNetworkPolicy                   v3.AddNetworkPolicyCommand                   `command:"add-network-policy" description:"Create policy to allow direct network traffic from one app to another"`
 	AllowSpaceSSH                      v2.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	Api                                v2.ApiCommand                                `command:"api" description:"Set or view target api url"`
+	AppUsageEvents                     v2.AppUsageEventsCommand                     `command:"app-usage-events" description:"List app usage events"`
 	Apps                               v2.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
 	App                                v2.AppCommand                                `command:"app" description:"Display health and status for an app"`
+	ApplyManifest                      v3.ApplyManifestCommand                      `command:"apply-manifest" description:"Show how a manifest would change the apps in the target space, then apply it"`
 	Auth                               v2.AuthCommand                               `command:"auth" description:"Authenticate user non-interactively"`
 	BindRouteService                   v2.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
 	BindRunningSecurityGroup           v2.BindRunningSecurityGroupCommand           `command:"bind-running-security-group" description:"Bind a security group to the list of security groups to be used for running applications"`
 	BindSecurityGroup                  v2.BindSecurityGroupCommand                  `command:"bind-security-group" description:"Bind a security group to a particular space, or all existing spaces of an org"`
 	BindService                        v2.BindServiceCommand                        `command:"bind-service" alias:"bs" description:"Bind a service instance to an app"`
 	BindStagingSecurityGroup           v2.BindStagingSecurityGroupCommand           `command:"bind-staging-security-group" description:"Bind a security group to the list of security groups to be used for staging applications"`
+	Buildpack                          v2.BuildpackCommand                          `command:"buildpack" description:"Show information for a buildpack"`
+	Build                              v3.BuildCommand                              `command:"build" description:"Show a build of an app, with hints for staging errors"`
+	Builds                             v3.BuildsCommand                             `command:"builds" description:"List recent builds of an app"`
 	Buildpacks                         v2.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
 	CheckRoute                         v2.CheckRouteCommand                         `command:"check-route" description:"Perform a simple check to determine whether a route currently exists or not"`
 	Config                             v2.ConfigCommand                             `command:"config" description:"Write default values to the config"`
 	CopySource                         v2.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
+	CreateApp                          v3.CreateAppCommand                          `command:"create-app" description:"Create an app without uploading its bits"`
 	CreateAppManifest                  v2.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
 	CreateBuildpack                    v2.CreateBuildpackCommand                    `command:"create-buildpack" description:"Create a buildpack"`
 	CreateDomain                       v2.CreateDomainCommand                       `command:"create-domain" description:"Create a domain in an org for later use"`
@@ -90,19 +97,24 @@ type commandList struct {
 	DisableSSH                         v2.DisableSSHCommand                         `command:"disable-ssh" description:"Disable ssh for the application"`
 	DisallowSpaceSSH                   v2.DisallowSpaceSSHCommand                   `command:"disallow-space-ssh" description:"Disallow SSH access for the space"`
 	Domains                            v2.DomainsCommand                            `command:"domains" description:"List domains in the target org"`
+	DownloadDroplet                    v3.DownloadDropletCommand                    `command:"download-droplet" description:"Download the current droplet of an app"`
+	Droplets                           v3.DropletsCommand                           `command:"droplets" description:"List droplets of an app"`
 	EnableFeatureFlag                  v2.EnableFeatureFlagCommand                  `command:"enable-feature-flag" description:"Allow use of a feature"`
 	EnableOrgIsolation                 v3.EnableOrgIsolationCommand                 `command:"enable-org-isolation" description:"Entitle an organization to an isolation segment"`
 	EnableServiceAccess                v2.EnableServiceAccessCommand                `command:"enable-service-access" description:"Enable access to a service or service plan for one or all orgs"`
 	EnableSSH                          v2.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
 	Env                                v2.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
 	Events                             v2.EventsCommand                             `command:"events" description:"Show recent app events"`
+	ExportConfig                       ExportConfigCommand                          `command:"export-config" description:"Write the CLI configuration and plugin list to a file, without tokens"`
 	FeatureFlags                       v2.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status of each flag-able feature"`
 	FeatureFlag                        v2.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	Files                              v2.FilesCommand                              `command:"files" alias:"f" description:"Print out a list of files in a directory or the contents of a specific file of an app running on the DEA backend"`
 	GetHealthCheck                     v2.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
+	ImportConfig                       ImportConfigCommand                          `command:"import-config" description:"Restore the CLI configuration and reinstall plugins from a file written by export-config"`
 	InstallPlugin                      InstallPluginCommand                         `command:"install-plugin" description:"Install CLI plugin"`
 	IsolationSegments                  v3.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
+	Labels                             v3.LabelsCommand                             `command:"labels" description:"List all labels (key-value pairs) for an API resource"`
 	NetworkPolicies                    v3.NetworkPoliciesCommand                    `command:"network-policies" description:"List direct network traffic policies"`
 	ListPluginRepos                    plugin.ListPluginReposCommand                `command:"list-plugin-repos" description:"List all the added plugin repositories"`
 	Login                              v2.LoginCommand                              `command:"login" alias:"l" description:"Log user in"`
@@ -117,6 +129,7 @@ type commandList struct {
 	Org                                v2.OrgCommand                                `command:"org" description:"Show org info"`
 	Passwd                             v2.PasswdCommand                             `command:"passwd" alias:"pw" description:"Change user password"`
 	Plugins                            plugin.PluginsCommand                        `command:"plugins" description:"List commands of installed plugins"`
+	Processes                          v3.ProcessesCommand                          `command:"processes" description:"List the processes of an app with their instances"`
 	PurgeServiceInstance               v2.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v2.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service and child objects from Cloud Foundry database without making requests to a service broker"`
 	Push                               v2.PushCommand                               `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
@@ -136,6 +149,7 @@ type commandList struct {
 	Restage                            v2.RestageCommand                            `command:"restage" alias:"rg" description:"Recreate the app's executable artifact using the latest pushed app files and the latest environment (variables, service bindings, buildpack, stack, etc.)"`
 	RestartAppInstance                 v2.RestartAppInstanceCommand                 `command:"restart-app-instance" description:"Terminate the running application Instance at the given index and instantiate a new instance of the application with the same index"`
 	Restart                            v2.RestartCommand                            `command:"restart" alias:"rs" description:"Stop all instances of the app, then start them again. This may cause downtime."`
+	Route                              v2.RouteCommand                              `command:"route" description:"Show route details and mapped destinations"`
 	RouterGroups                       v2.RouterGroupsCommand                       `command:"router-groups" description:"List router groups"`
 	Routes                             v2.RoutesCommand                             `command:"routes" alias:"r" description:"List all routes in the current space or the current organization"`
 	RunningEnvironmentVariableGroup    v2.RunningEnvironmentVariableGroupCommand    `command:"running-environment-variable-group" alias:"revg" description:"Retrieve the contents of the running environment variable group"`
@@ -149,10 +163,13 @@ type commandList struct {
 	ServiceBrokers                     v2.ServiceBrokersCommand                     `command:"service-brokers" description:"List service brokers"`
 	ServiceKeys                        v2.ServiceKeysCommand                        `command:"service-keys" alias:"sk" description:"List keys for a service instance"`
 	ServiceKey                         v2.ServiceKeyCommand                         `command:"service-key" description:"Show service key info"`
+	ServiceUsageEvents                 v2.ServiceUsageEventsCommand                 `command:"service-usage-events" description:"List service usage events"`
 	Services                           v2.ServicesCommand                           `command:"services" alias:"s" description:"List all service instances in the target space"`
 	Service                            v2.ServiceCommand                            `command:"service" description:"Show service instance info"`
+	SetDroplet                         v3.SetDropletCommand                         `command:"set-droplet" description:"Set the droplet used to run an app and restart it"`
 	SetEnv                             v2.SetEnvCommand                             `command:"set-env" alias:"se" description:"Set an env variable for an app"`
 	SetHealthCheck                     v2.SetHealthCheckCommand                     `command:"set-health-check" description:"Change type of health check performed on an app"`
+	SetLabel                           v3.SetLabelCommand                           `command:"set-label" description:"Set a label (key-value pairs) for an API resource"`
 	SetOrgDefaultIsolationSegment      v3.SetOrgDefaultIsolationSegmentCommand      `command:"set-org-default-isolation-segment" description:"Set the default isolation segment used for apps in spaces in an org"`
 	SetOrgRole                         v2.SetOrgRoleCommand                         `command:"set-org-role" description:"Assign an org role to a user"`
 	SetQuota                           v2.SetQuotaCommand                           `command:"set-quota" description:"Assign a quota to an org"`
@@ -161,11 +178,14 @@ type commandList struct {
 	SetSpaceQuota                      v2.SetSpaceQuotaCommand                      `command:"set-space-quota" description:"Assign a space quota definition to a space"`
 	SetSpaceRole                       v2.SetSpaceRoleCommand                       `command:"set-space-role" description:"Assign a space role to a user"`
 	SetStagingEnvironmentVariableGroup v2.SetStagingEnvironmentVariableGroupCommand `command:"set-staging-environment-variable-group" alias:"ssevg" description:"Pass parameters as JSON to create a staging environment variable group"`
+	Setup                              v2.SetupCommand                              `command:"setup" description:"Interactively set the API endpoint, log in and target an org and space"`
 	SharePrivateDomain                 v2.SharePrivateDomainCommand                 `command:"share-private-domain" description:"Share a private domain with an org"`
+	ShareService                       v3.ShareServiceCommand                       `command:"share-service" description:"Share a service instance with another space"`
 	SpaceQuotas                        v2.SpaceQuotasCommand                        `command:"space-quotas" description:"List available space resource quotas"`
 	SpaceQuota                         v2.SpaceQuotaCommand                         `command:"space-quota" description:"Show space quota info"`
 	SpaceSSHAllowed                    v2.SpaceSSHAllowedCommand                    `command:"space-ssh-allowed" description:"Reports whether SSH is allowed in a space"`
 	Spaces                             v2.SpacesCommand                             `command:"spaces" description:"List all spaces in an org"`
+	SpaceUsage                         v2.SpaceUsageCommand                         `command:"space-usage" description:"Show the CPU, memory and disk usage of the apps in the targeted space"`
 	SpaceUsers                         v2.SpaceUsersCommand                         `command:"space-users" description:"Show space users by role"`
 	Space                              v2.SpaceCommand                              `command:"space" description:"Show space info"`
 	SSHCode                            v2.SSHCodeCommand                            `command:"ssh-code" description:"Get a one time password for ssh clients"`
@@ -178,6 +198,7 @@ type commandList struct {
 	Start                              v2.StartCommand                              `command:"start" alias:"st" description:"Start an app"`
 	Stop                               v2.StopCommand                               `command:"stop" alias:"sp" description:"Stop an app"`
 	Target                             v2.TargetCommand                             `command:"target" alias:"t" description:"Set or view the targeted org or space"`
+	Task                               v3.TaskCommand                               `command:"task" description:"Display details of a task of an app, including why it failed"`
 	Tasks                              v3.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
 	TerminateTask                      v3.TerminateTaskCommand                      `command:"terminate-task" description:"Terminate a running task of an app"`
 	UnbindRouteService                 v2.UnbindRouteServiceCommand                 `command:"unbind-route-service" alias:"urs" description:"Unbind a service instance from an HTTP route"`
@@ -188,10 +209,12 @@ type commandList struct {
 	UninstallPlugin                    plugin.UninstallPluginCommand                `command:"uninstall-plugin" description:"Uninstall CLI plugin"`
 	UnmapRoute                         v2.UnmapRouteCommand                         `command:"unmap-route" description:"Remove a url route from an app"`
 	UnsetEnv                           v2.UnsetEnvCommand                           `command:"unset-env" description:"Remove an env variable"`
+	UnsetLabel                         v3.UnsetLabelCommand                         `command:"unset-label" description:"Unset a label (key-value pairs) for an API resource"`
 	UnsetOrgRole                       v2.UnsetOrgRoleCommand                       `command:"unset-org-role" description:"Remove an org role from a user"`
 	UnsetSpaceQuota                    v2.UnsetSpaceQuotaCommand                    `command:"unset-space-quota" description:"Unassign a quota from a space"`
 	UnsetSpaceRole                     v2.UnsetSpaceRoleCommand                     `command:"unset-space-role" description:"Remove a space role from a user"`
 	UnsharePrivateDomain               v2.UnsharePrivateDomainCommand               `command:"unshare-private-domain" description:"Unshare a private domain with an org"`
+	UnshareService                     v3.UnshareServiceCommand                     `command:"unshare-service" description:"Unshare a shared service instance from a space"`
 	UpdateBuildpack                    v2.UpdateBuildpackCommand                    `command:"update-buildpack" description:"Update a buildpack"`
 	UpdateQuota                        v2.UpdateQuotaCommand                        `command:"update-quota" description:"Update an existing resource quota"`
 	UpdateSecurityGroup                v2.UpdateSecurityGroupCommand                `command:"update-security-group" description:"Update a security group"`
@@ -236,3 +259,30 @@ func (c commandList) HasAlias(alias string) bool {
 
 	return found
 }
+
+// HelpRequested returns the command name and true if args run a command in
+// the command list with an explicit -h or --help. Such requests should show
+// the command's help even when the other arguments are invalid, so that
+// asking for help always succeeds. The help command itself is left alone,
+// since its argument names the command to describe.
+func (c commandList) HelpRequested(args []string) (string, bool) {
+	if len(args) == 0 || (!c.HasCommand(args[0]) && !c.HasAlias(args[0])) {
+		return "", false
+	}
+
+	helpField, _ := reflect.TypeOf(c).FieldByName("Help")
+	if args[0] == helpField.Tag.Get("command") || args[0] == helpField.Tag.Get("alias") {
+		return "", false
+	}
+
+	for _, arg := range args[1:] {
+		switch arg {
+		case "--":
+			return "", false
+		case "-h", "--help":
+			return args[0], true
+		}
+	}
+
+	return "", false
+}
@@ -1,9 +1,16 @@
 package common_test
 
 import (
+	"reflect"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
 )
 
 var _ = Describe("commandList", func() {
@@ -46,4 +53,82 @@ var _ = Describe("commandList", func() {
 			})
 		})
 	})
+
+	Describe("HelpRequested", func() {
+		It("returns the command when -h or --help follows it", func() {
+			commandName, ok := Commands.HelpRequested([]string{"push", "some-app", "--help"})
+			Expect(ok).To(BeTrue())
+			Expect(commandName).To(Equal("push"))
+
+			commandName, ok = Commands.HelpRequested([]string{"cups", "-h"})
+			Expect(ok).To(BeTrue())
+			Expect(commandName).To(Equal("cups"))
+		})
+
+		It("returns false when help is not requested", func() {
+			_, ok := Commands.HelpRequested([]string{"push", "some-app"})
+			Expect(ok).To(BeFalse())
+		})
+
+		It("returns false when -h or --help comes after --", func() {
+			_, ok := Commands.HelpRequested([]string{"ssh", "some-app", "--", "--help"})
+			Expect(ok).To(BeFalse())
+		})
+
+		It("returns false for commands that are not in the list", func() {
+			_, ok := Commands.HelpRequested([]string{"some-plugin-command", "--help"})
+			Expect(ok).To(BeFalse())
+
+			_, ok = Commands.HelpRequested([]string{"--help"})
+			Expect(ok).To(BeFalse())
+		})
+
+		It("returns false for the help command itself", func() {
+			_, ok := Commands.HelpRequested([]string{"help", "push", "-h"})
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("help for every command", func() {
+		commandType := reflect.TypeOf(Commands)
+		for i := 0; i < commandType.NumField(); i++ {
+			field := commandType.Field(i)
+			commandName := field.Tag.Get("command")
+			alias := field.Tag.Get("alias")
+			if commandName == "" || commandName == "help" {
+				continue
+			}
+
+			It("displays the help for "+commandName+" on stdout without an error", func() {
+				for _, name := range []string{commandName, alias} {
+					if name == "" {
+						continue
+					}
+
+					for _, helpFlag := range []string{"-h", "--help"} {
+						requested, ok := Commands.HelpRequested([]string{name, "not-a-valid-arg", "not-a-valid-arg", helpFlag})
+						Expect(ok).To(BeTrue())
+						Expect(requested).To(Equal(name))
+					}
+				}
+
+				testUI := ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+				fakeConfig := new(commandfakes.FakeConfig)
+				fakeConfig.BinaryNameReturns("faceman")
+
+				cmd := HelpCommand{
+					UI:     testUI,
+					Actor:  sharedaction.NewActor(),
+					Config: fakeConfig,
+				}
+				cmd.OptionalArgs.CommandName = commandName
+
+				Expect(cmd.Execute(nil)).To(Succeed())
+				Expect(testUI.Out).To(Say("NAME:"))
+				Expect(testUI.Out).To(Say("%s", regexp.QuoteMeta(commandName)))
+				Expect(testUI.Out).To(Say("USAGE:"))
+				Expect(testUI.Err.(*Buffer).Contents()).To(BeEmpty())
+			})
+		}
+	})
 })
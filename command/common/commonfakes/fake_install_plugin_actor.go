@@ -143,17 +143,17 @@ type FakeInstallPluginActor struct {
 	uninstallPluginReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ValidateFileChecksumStub        func(path string, checksum string) bool
+	ValidateFileChecksumStub        func(path string, checksum string) error
 	validateFileChecksumMutex       sync.RWMutex
 	validateFileChecksumArgsForCall []struct {
 		path     string
 		checksum string
 	}
 	validateFileChecksumReturns struct {
-		result1 bool
+		result1 error
 	}
 	validateFileChecksumReturnsOnCall map[int]struct {
-		result1 bool
+		result1 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -672,7 +672,7 @@ func (fake *FakeInstallPluginActor) UninstallPluginReturnsOnCall(i int, result1
 	}{result1}
 }
 
-func (fake *FakeInstallPluginActor) ValidateFileChecksum(path string, checksum string) bool {
+func (fake *FakeInstallPluginActor) ValidateFileChecksum(path string, checksum string) error {
 	fake.validateFileChecksumMutex.Lock()
 	ret, specificReturn := fake.validateFileChecksumReturnsOnCall[len(fake.validateFileChecksumArgsForCall)]
 	fake.validateFileChecksumArgsForCall = append(fake.validateFileChecksumArgsForCall, struct {
@@ -702,22 +702,22 @@ func (fake *FakeInstallPluginActor) ValidateFileChecksumArgsForCall(i int) (stri
 	return fake.validateFileChecksumArgsForCall[i].path, fake.validateFileChecksumArgsForCall[i].checksum
 }
 
-func (fake *FakeInstallPluginActor) ValidateFileChecksumReturns(result1 bool) {
+func (fake *FakeInstallPluginActor) ValidateFileChecksumReturns(result1 error) {
 	fake.ValidateFileChecksumStub = nil
 	fake.validateFileChecksumReturns = struct {
-		result1 bool
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeInstallPluginActor) ValidateFileChecksumReturnsOnCall(i int, result1 bool) {
+func (fake *FakeInstallPluginActor) ValidateFileChecksumReturnsOnCall(i int, result1 error) {
 	fake.ValidateFileChecksumStub = nil
 	if fake.validateFileChecksumReturnsOnCall == nil {
 		fake.validateFileChecksumReturnsOnCall = make(map[int]struct {
-			result1 bool
+			result1 error
 		})
 	}
 	fake.validateFileChecksumReturnsOnCall[i] = struct {
-		result1 bool
+		result1 error
 	}{result1}
 }
 
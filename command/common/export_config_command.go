@@ -0,0 +1,52 @@
+package common
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type ExportConfigCommand struct {
+	PathToFile      flag.Path   `short:"p" required:"true" description:"Path of the file to write the configuration bundle to"`
+	usage           interface{} `usage:"CF_NAME export-config -p FILE\n\n   Tokens and plugin binaries are not exported.\n\nEXAMPLES:\n   CF_NAME export-config -p ~/cf-config.json"`
+	relatedCommands interface{} `related_commands:"config, import-config, plugins"`
+	UI              command.UI
+	Config          command.Config
+}
+
+func (cmd *ExportConfigCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd ExportConfigCommand) Execute(args []string) error {
+	cmd.UI.DisplayTextWithFlavor("Exporting CLI configuration to {{.Path}}...", map[string]interface{}{
+		"Path": cmd.PathToFile,
+	})
+
+	bundle := cmd.Config.ConfigBundle()
+	raw, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(cmd.PathToFile.String(), raw, 0600)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	for _, plugin := range bundle.Plugins {
+		if plugin.RepositoryName == "" {
+			cmd.UI.DisplayWarning("Plugin {{.PluginName}} was not installed from a plugin repository and will have to be installed manually after importing.", map[string]interface{}{
+				"PluginName": plugin.Name,
+			})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package common_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-config command", func() {
+	var (
+		cmd        ExportConfigCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+		tempDir    string
+		bundlePath string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "export-config-test")
+		Expect(err).ToNot(HaveOccurred())
+		bundlePath = filepath.Join(tempDir, "cf-config.json")
+
+		cmd = ExportConfigCommand{
+			PathToFile: flag.Path(bundlePath),
+			UI:         testUI,
+			Config:     fakeConfig,
+		}
+
+		fakeConfig.ConfigBundleReturns(configv3.ConfigBundle{
+			BundleVersion: configv3.ConfigBundleVersion,
+			Config:        configv3.CFConfig{Target: "https://api.example.com"},
+			Plugins: []configv3.BundledPlugin{
+				{Name: "from-repo", RepositoryName: "some-repo"},
+				{Name: "from-file"},
+			},
+		})
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("writes the config bundle to the file", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say("Exporting CLI configuration to %s\\.\\.\\.", bundlePath))
+		Expect(testUI.Out).To(Say("OK"))
+
+		raw, err := ioutil.ReadFile(bundlePath)
+		Expect(err).ToNot(HaveOccurred())
+
+		bundle, err := configv3.ParseConfigBundle(raw)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bundle.Config.Target).To(Equal("https://api.example.com"))
+		Expect(bundle.Plugins).To(HaveLen(2))
+	})
+
+	It("makes the file readable only by the user", func() {
+		info, err := os.Stat(bundlePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm() & 0077).To(BeZero())
+	})
+
+	It("warns about plugins that cannot be reinstalled from a repository", func() {
+		Expect(testUI.Err).To(Say("Plugin from-file was not installed from a plugin repository"))
+		Expect(testUI.Err).ToNot(Say("from-repo"))
+	})
+
+	Context("when the file cannot be written", func() {
+		BeforeEach(func() {
+			cmd.PathToFile = flag.Path(filepath.Join(tempDir, "missing-dir", "cf-config.json"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(HaveOccurred())
+		})
+	})
+})
@@ -296,19 +296,25 @@ func (cmd HelpCommand) displayCommand() error {
 
 func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 	return [][]string{
+		{"CF_CA_CERT=path/to/ca.pem", cmd.UI.TranslateText("Trust the CA certificate(s) in this PEM file for API requests")},
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_RETRY_COUNT=3", cmd.UI.TranslateText("Max number of retries for idempotent API requests that fail transiently, 0 to disable")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
+		{"CF_TRACE_SHOW_SECRETS=true", cmd.UI.TranslateText("Do not hide credentials in API request diagnostics")},
 		{"https_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable HTTP proxying for API requests")},
+		{"no_proxy=.example.com,10.0.0.0/8", cmd.UI.TranslateText("Connect to these domains and IP ranges directly, without the proxy")},
 	}
 }
 
 func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
+		{"--config", cmd.UI.TranslateText("Override path to default config directory for this command only, like CF_HOME")},
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
+		{"--no-color", cmd.UI.TranslateText("Disable colors, spinners and progress bar animations")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 	}
 }
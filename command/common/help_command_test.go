@@ -411,7 +411,9 @@ var _ = Describe("help Command", func() {
 			Expect(testUI.Out).To(Say("  install-plugin    list-plugin-repos"))
 
 			Expect(testUI.Out).To(Say("Global options:"))
+			Expect(testUI.Out).To(Say("  --config                           Override path to default config directory for this command only, like CF_HOME"))
 			Expect(testUI.Out).To(Say("  --help, -h                         Show help"))
+			Expect(testUI.Out).To(Say("  --no-color                         Disable colors, spinners and progress bar animations"))
 			Expect(testUI.Out).To(Say("  -v                                 Print API request diagnostics to stdout"))
 
 			Expect(testUI.Out).To(Say("Use 'cf help -a' to see all commands\\."))
@@ -595,16 +597,22 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   enable-diego\\s+enable Diego support for an app"))
 
 				Expect(testUI.Out).To(Say("ENVIRONMENT VARIABLES:"))
+				Expect(testUI.Out).To(Say("   CF_CA_CERT=path/to/ca.pem          Trust the CA certificate\\(s\\) in this PEM file for API requests"))
 				Expect(testUI.Out).To(Say("   CF_COLOR=false                     Do not colorize output"))
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
+				Expect(testUI.Out).To(Say("   CF_RETRY_COUNT=3                   Max number of retries for idempotent API requests that fail transiently, 0 to disable"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
+				Expect(testUI.Out).To(Say("   CF_TRACE_SHOW_SECRETS=true         Do not hide credentials in API request diagnostics"))
 				Expect(testUI.Out).To(Say("   https_proxy=proxy.example.com:8080 Enable HTTP proxying for API requests"))
+				Expect(testUI.Out).To(Say("   no_proxy=.example.com,10.0.0.0/8   Connect to these domains and IP ranges directly, without the proxy"))
 
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
+				Expect(testUI.Out).To(Say("   --config                           Override path to default config directory for this command only, like CF_HOME"))
 				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
+				Expect(testUI.Out).To(Say("   --no-color                         Disable colors, spinners and progress bar animations"))
 				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
 			})
 
@@ -0,0 +1,134 @@
+package common
+
+import (
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type ImportConfigCommand struct {
+	PathToFile      flag.PathWithExistenceCheck `short:"p" required:"true" description:"Path of a configuration bundle written by export-config"`
+	Force           bool                        `short:"f" description:"Force import without confirmation"`
+	usage           interface{}                 `usage:"CF_NAME import-config -p FILE [-f]\n\n   Replaces the current configuration and reinstalls plugins from the repositories they were installed from.\n\nEXAMPLES:\n   CF_NAME import-config -p ~/cf-config.json"`
+	relatedCommands interface{}                 `related_commands:"export-config, install-plugin, login"`
+	UI              command.UI
+	Config          command.Config
+	Actor           InstallPluginActor
+	ProgressBar     plugin.ProxyReader
+}
+
+func (cmd *ImportConfigCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, false))
+
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer(), cmd.UI.AnimationsEnabled())
+
+	return nil
+}
+
+func (cmd ImportConfigCommand) Execute(args []string) error {
+	raw, err := ioutil.ReadFile(string(cmd.PathToFile))
+	if err != nil {
+		return err
+	}
+
+	bundle, err := configv3.ParseConfigBundle(raw)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force && cmd.hasExistingConfig() {
+		really, promptErr := cmd.UI.DisplayBoolPrompt(false, "Importing replaces the current CLI configuration and logs you out. Do you want to continue?")
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !really {
+			cmd.UI.DisplayText("Config import cancelled.")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Importing CLI configuration from {{.Path}}...", map[string]interface{}{
+		"Path": cmd.PathToFile,
+	})
+	cmd.Config.ApplyConfigBundle(bundle)
+	cmd.UI.DisplayOK()
+
+	for _, bundledPlugin := range bundle.Plugins {
+		cmd.UI.DisplayNewline()
+		cmd.reinstallPlugin(bundledPlugin)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Tokens are not imported. Use '{{.Command}}' to log in.", map[string]interface{}{
+		"Command": cmd.Config.BinaryName() + " login",
+	})
+
+	return nil
+}
+
+func (cmd ImportConfigCommand) hasExistingConfig() bool {
+	return cmd.Config.Target() != "" || len(cmd.Config.Plugins()) > 0
+}
+
+// reinstallPlugin installs a bundled plugin from the repository it was
+// originally installed from. Failures are reported as warnings so the
+// remaining plugins are still installed.
+func (cmd ImportConfigCommand) reinstallPlugin(bundledPlugin configv3.BundledPlugin) {
+	if _, installed := cmd.Config.GetPlugin(bundledPlugin.Name); installed {
+		cmd.UI.DisplayText("Plugin {{.PluginName}} is already installed.", map[string]interface{}{
+			"PluginName": bundledPlugin.Name,
+		})
+		return
+	}
+
+	if bundledPlugin.RepositoryName == "" {
+		cmd.UI.DisplayWarning("Plugin {{.PluginName}} was not installed from a plugin repository. Install it manually.", map[string]interface{}{
+			"PluginName": bundledPlugin.Name,
+		})
+		return
+	}
+
+	installCmd := InstallPluginCommand{
+		OptionalArgs:         flag.InstallPluginArgs{PluginNameOrLocation: flag.Path(bundledPlugin.Name)},
+		Force:                true,
+		RegisteredRepository: bundledPlugin.RepositoryName,
+		UI:                   cmd.UI,
+		Config:               cmd.Config,
+		Actor:                cmd.Actor,
+		ProgressBar:          cmd.ProgressBar,
+	}
+
+	err := installCmd.Execute(nil)
+	if err != nil {
+		cmd.UI.DisplayWarning("Could not reinstall plugin {{.PluginName}} from {{.RepositoryName}}: {{.Error}}", map[string]interface{}{
+			"PluginName":     bundledPlugin.Name,
+			"RepositoryName": bundledPlugin.RepositoryName,
+			"Error":          cmd.errorMessage(err),
+		})
+	}
+}
+
+func (cmd ImportConfigCommand) errorMessage(err error) string {
+	translatableErr, ok := err.(translatableerror.TranslatableError)
+	if !ok {
+		return err.Error()
+	}
+
+	return translatableErr.Translate(func(template string, data ...interface{}) string {
+		if len(data) > 0 {
+			if values, ok := data[0].(map[string]interface{}); ok {
+				return cmd.UI.TranslateText(template, values)
+			}
+		}
+		return cmd.UI.TranslateText(template)
+	})
+}
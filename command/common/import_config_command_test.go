@@ -0,0 +1,172 @@
+package common_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("import-config command", func() {
+	var (
+		cmd             ImportConfigCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeActor       *commonfakes.FakeInstallPluginActor
+		fakeProgressBar *pluginfakes.FakeProxyReader
+		executeErr      error
+		tempDir         string
+		bundlePath      string
+	)
+
+	writeBundle := func(contents string) {
+		err := ioutil.WriteFile(bundlePath, []byte(contents), 0600)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeInstallPluginActor)
+		fakeProgressBar = new(pluginfakes.FakeProxyReader)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "import-config-test")
+		Expect(err).ToNot(HaveOccurred())
+		bundlePath = filepath.Join(tempDir, "cf-config.json")
+
+		cmd = ImportConfigCommand{
+			PathToFile:  flag.PathWithExistenceCheck(bundlePath),
+			UI:          testUI,
+			Config:      fakeConfig,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.PluginHomeReturns(filepath.Join(tempDir, "plugins"))
+
+		writeBundle(`{
+  "BundleVersion": 1,
+  "Config": {"Target": "https://api.example.com"},
+  "Plugins": [
+    {"Name": "installed-plugin", "RepositoryName": "some-repo"},
+    {"Name": "local-plugin"},
+    {"Name": "repo-plugin", "RepositoryName": "some-repo"}
+  ]
+}`)
+
+		fakeConfig.GetPluginStub = func(name string) (configv3.Plugin, bool) {
+			return configv3.Plugin{Name: name}, name == "installed-plugin"
+		}
+		fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{}, pluginaction.RepositoryNotRegisteredError{Name: "some-repo"})
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when there is no existing configuration", func() {
+		It("applies the bundle without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Do you want to continue"))
+			Expect(testUI.Out).To(Say("Importing CLI configuration from %s\\.\\.\\.", bundlePath))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeConfig.ApplyConfigBundleCallCount()).To(Equal(1))
+			Expect(fakeConfig.ApplyConfigBundleArgsForCall(0).Config.Target).To(Equal("https://api.example.com"))
+		})
+
+		It("skips plugins that are already installed", func() {
+			Expect(testUI.Out).To(Say("Plugin installed-plugin is already installed\\."))
+		})
+
+		It("warns about plugins that were not installed from a repository", func() {
+			Expect(testUI.Err).To(Say("Plugin local-plugin was not installed from a plugin repository\\. Install it manually\\."))
+		})
+
+		It("reinstalls plugins from their repository and warns when that fails", func() {
+			Expect(fakeActor.GetPluginRepositoryCallCount()).To(Equal(1))
+			Expect(fakeActor.GetPluginRepositoryArgsForCall(0)).To(Equal("some-repo"))
+			Expect(testUI.Err).To(Say("Could not reinstall plugin repo-plugin from some-repo: Plugin repository some-repo not found"))
+		})
+
+		It("tells the user to log in", func() {
+			Expect(testUI.Out).To(Say("Tokens are not imported\\. Use 'faceman login' to log in\\."))
+		})
+	})
+
+	Context("when there is an existing configuration", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.old.example.com")
+		})
+
+		Context("when the user cancels", func() {
+			BeforeEach(func() {
+				input.Write([]byte("n\n"))
+			})
+
+			It("does not change the configuration", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Importing replaces the current CLI configuration and logs you out\\. Do you want to continue\\?"))
+				Expect(testUI.Out).To(Say("Config import cancelled\\."))
+				Expect(fakeConfig.ApplyConfigBundleCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				input.Write([]byte("y\n"))
+			})
+
+			It("applies the bundle", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.ApplyConfigBundleCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when -f is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("applies the bundle without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Do you want to continue"))
+				Expect(fakeConfig.ApplyConfigBundleCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the bundle was written by an unsupported version", func() {
+		BeforeEach(func() {
+			writeBundle(`{"BundleVersion": 99}`)
+		})
+
+		It("returns an UnsupportedConfigBundleVersionError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnsupportedConfigBundleVersionError{
+				Version:          99,
+				SupportedVersion: configv3.ConfigBundleVersion,
+			}))
+			Expect(fakeConfig.ApplyConfigBundleCallCount()).To(Equal(0))
+		})
+	})
+})
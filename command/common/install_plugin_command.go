@@ -30,18 +30,11 @@ type InstallPluginActor interface {
 	InstallPluginFromPath(path string, plugin configv3.Plugin) error
 	IsPluginInstalled(pluginName string) bool
 	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
-	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileChecksum(path string, checksum string) error
 }
 
 const installConfirmationPrompt = "Do you want to install the plugin {{.Path}}?"
 
-type InvalidChecksumError struct {
-}
-
-func (InvalidChecksumError) Error() string {
-	return "Downloaded plugin binary's checksum does not match repo metadata.\nPlease try again or contact the plugin author."
-}
-
 type PluginSource int
 
 const (
@@ -55,7 +48,8 @@ type InstallPluginCommand struct {
 	SkipSSLValidation    bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	Force                bool                   `short:"f" description:"Force install of plugin without confirmation"`
 	RegisteredRepository string                 `short:"r" description:"Restrict search for plugin to this registered repository"`
-	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
+	Checksum             string                 `long:"checksum" description:"SHA-1 or SHA-256 checksum the plugin binary must match"`
+	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [--checksum CHECKSUM] [-f]\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64 --checksum 2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
 	relatedCommands      interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
 	UI                   command.UI
 	Config               command.Config
@@ -68,7 +62,7 @@ func (cmd *InstallPluginCommand) Setup(config command.Config, ui command.UI) err
 	cmd.Config = config
 	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
 
-	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer(), cmd.UI.AnimationsEnabled())
 
 	return nil
 }
@@ -86,11 +80,18 @@ func (cmd InstallPluginCommand) Execute([]string) error {
 		return shared.HandleError(err)
 	}
 
-	tempPluginPath, pluginSource, err := cmd.getPluginBinaryAndSource(tempPluginDir)
+	tempPluginPath, pluginSource, repositoryName, err := cmd.getPluginBinaryAndSource(tempPluginDir)
 	if err != nil {
 		return shared.HandleError(err)
 	}
 
+	if cmd.Checksum != "" {
+		err = cmd.Actor.ValidateFileChecksum(tempPluginPath, cmd.Checksum)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
 	// copy twice when downloading from a URL to keep Windows specific code
 	// isolated to CreateExecutableCopy
 	executablePath, err := cmd.Actor.CreateExecutableCopy(tempPluginPath, tempPluginDir)
@@ -107,6 +108,7 @@ func (cmd InstallPluginCommand) Execute([]string) error {
 	if err != nil {
 		return shared.HandleError(err)
 	}
+	plugin.RepositoryName = repositoryName
 
 	if cmd.Actor.IsPluginInstalled(plugin.Name) {
 		if !cmd.Force && pluginSource != PluginFromRepository {
@@ -163,21 +165,24 @@ func (cmd InstallPluginCommand) uninstallPlugin(plugin configv3.Plugin, rpcServi
 	return nil
 }
 
-func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (string, PluginSource, error) {
+// getPluginBinaryAndSource returns the path of the downloaded or local plugin
+// binary, where it came from and, for plugins found in a repository, the name
+// of that repository.
+func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (string, PluginSource, string, error) {
 	pluginNameOrLocation := cmd.OptionalArgs.PluginNameOrLocation.String()
 
 	switch {
 	case cmd.RegisteredRepository != "":
 		pluginRepository, err := cmd.Actor.GetPluginRepository(cmd.RegisteredRepository)
 		if err != nil {
-			return "", 0, err
+			return "", 0, "", err
 		}
-		path, pluginSource, err := cmd.getPluginFromRepositories(pluginNameOrLocation, []configv3.PluginRepository{pluginRepository}, tempPluginDir)
+		path, pluginSource, repositoryName, err := cmd.getPluginFromRepositories(pluginNameOrLocation, []configv3.PluginRepository{pluginRepository}, tempPluginDir)
 
 		if err != nil {
 			switch pluginErr := err.(type) {
 			case pluginaction.PluginNotFoundInAnyRepositoryError:
-				return "", 0, translatableerror.PluginNotFoundInRepositoryError{
+				return "", 0, "", translatableerror.PluginNotFoundInRepositoryError{
 					BinaryName:     cmd.Config.BinaryName(),
 					PluginName:     pluginNameOrLocation,
 					RepositoryName: cmd.RegisteredRepository,
@@ -187,43 +192,45 @@ func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (
 				// The error wrapped inside pluginErr is handled differently in the case of
 				// a specified repo from that of searching through all repos.  pluginErr.Err
 				// is then processed by shared.HandleError by this function's caller.
-				return "", 0, pluginErr.Err
+				return "", 0, "", pluginErr.Err
 
 			default:
-				return "", 0, err
+				return "", 0, "", err
 			}
 		}
-		return path, pluginSource, nil
+		return path, pluginSource, repositoryName, nil
 
 	case cmd.Actor.FileExists(pluginNameOrLocation):
-		return cmd.getPluginFromLocalFile(pluginNameOrLocation)
+		path, pluginSource, err := cmd.getPluginFromLocalFile(pluginNameOrLocation)
+		return path, pluginSource, "", err
 
 	case util.IsHTTPScheme(pluginNameOrLocation):
-		return cmd.getPluginFromURL(pluginNameOrLocation, tempPluginDir)
+		path, pluginSource, err := cmd.getPluginFromURL(pluginNameOrLocation, tempPluginDir)
+		return path, pluginSource, "", err
 
 	case util.IsUnsupportedURLScheme(pluginNameOrLocation):
-		return "", 0, translatableerror.UnsupportedURLSchemeError{UnsupportedURL: pluginNameOrLocation}
+		return "", 0, "", translatableerror.UnsupportedURLSchemeError{UnsupportedURL: pluginNameOrLocation}
 
 	default:
 		repos := cmd.Config.PluginRepositories()
 		if len(repos) == 0 {
-			return "", 0, translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: pluginNameOrLocation, BinaryName: cmd.Config.BinaryName()}
+			return "", 0, "", translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: pluginNameOrLocation, BinaryName: cmd.Config.BinaryName()}
 		}
 
-		path, pluginSource, err := cmd.getPluginFromRepositories(pluginNameOrLocation, repos, tempPluginDir)
+		path, pluginSource, repositoryName, err := cmd.getPluginFromRepositories(pluginNameOrLocation, repos, tempPluginDir)
 		if err != nil {
 			switch pluginErr := err.(type) {
 			case pluginaction.PluginNotFoundInAnyRepositoryError:
-				return "", 0, translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: pluginNameOrLocation, BinaryName: cmd.Config.BinaryName()}
+				return "", 0, "", translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: pluginNameOrLocation, BinaryName: cmd.Config.BinaryName()}
 
 			case pluginaction.FetchingPluginInfoFromRepositoryError:
-				return "", 0, cmd.handleFetchingPluginInfoFromRepositoriesError(pluginErr)
+				return "", 0, "", cmd.handleFetchingPluginInfoFromRepositoriesError(pluginErr)
 
 			default:
-				return "", 0, err
+				return "", 0, "", err
 			}
 		}
-		return path, pluginSource, nil
+		return path, pluginSource, repositoryName, nil
 	}
 }
 
@@ -285,7 +292,7 @@ func (cmd InstallPluginCommand) getPluginFromURL(pluginLocation string, tempPlug
 	return tempPath, PluginFromURL, err
 }
 
-func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, repos []configv3.PluginRepository, tempPluginDir string) (string, PluginSource, error) {
+func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, repos []configv3.PluginRepository, tempPluginDir string) (string, PluginSource, string, error) {
 	var repoNames []string
 	for _, repo := range repos {
 		repoNames = append(repoNames, repo.Name)
@@ -300,7 +307,7 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 	pluginInfo, repoList, err := cmd.Actor.GetPluginInfoFromRepositoriesForPlatform(pluginName, repos, currentPlatform)
 
 	if err != nil {
-		return "", 0, err
+		return "", 0, "", err
 	}
 
 	cmd.UI.DisplayText("Plugin {{.PluginName}} {{.PluginVersion}} found in: {{.RepositoryName}}", map[string]interface{}{
@@ -327,7 +334,7 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 	}
 
 	if err != nil {
-		return "", 0, err
+		return "", 0, "", err
 	}
 
 	cmd.UI.DisplayText("Starting download of plugin binary from repository {{.RepositoryName}}...", map[string]interface{}{
@@ -336,14 +343,22 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 
 	tempPath, err := cmd.Actor.DownloadExecutableBinaryFromURL(pluginInfo.URL, tempPluginDir, cmd.ProgressBar)
 	if err != nil {
-		return "", 0, err
+		return "", 0, "", err
 	}
 
-	if !cmd.Actor.ValidateFileChecksum(tempPath, pluginInfo.Checksum) {
-		return "", 0, InvalidChecksumError{}
+	err = cmd.Actor.ValidateFileChecksum(tempPath, pluginInfo.Checksum)
+	if err != nil {
+		if mismatchErr, ok := err.(pluginaction.ChecksumMismatchError); ok {
+			return "", 0, "", translatableerror.PluginChecksumMismatchError{
+				Expected:       mismatchErr.Expected,
+				Actual:         mismatchErr.Actual,
+				FromRepository: true,
+			}
+		}
+		return "", 0, "", err
 	}
 
-	return tempPath, PluginFromRepository, err
+	return tempPath, PluginFromRepository, repoList[0], err
 }
 
 func (cmd InstallPluginCommand) installPluginPrompt(template string, templateValues ...map[string]interface{}) error {
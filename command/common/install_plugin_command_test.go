@@ -494,6 +494,44 @@ var _ = Describe("install-plugin command", func() {
 					Expect(pluginDirArg).To(ContainSubstring("temp"))
 				})
 
+				It("does not verify a checksum", func() {
+					Expect(fakeActor.ValidateFileChecksumCallCount()).To(Equal(0))
+				})
+
+				Context("when a checksum is provided", func() {
+					BeforeEach(func() {
+						cmd.Checksum = "some-checksum"
+					})
+
+					It("verifies the downloaded binary before running it", func() {
+						Expect(fakeActor.ValidateFileChecksumCallCount()).To(Equal(1))
+						pathArg, checksumArg := fakeActor.ValidateFileChecksumArgsForCall(0)
+						Expect(pathArg).To(Equal("some-path"))
+						Expect(checksumArg).To(Equal("some-checksum"))
+
+						Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(1))
+					})
+
+					Context("when the checksum does not match", func() {
+						BeforeEach(func() {
+							fakeActor.ValidateFileChecksumReturns(pluginaction.ChecksumMismatchError{
+								Expected: "some-checksum",
+								Actual:   "actual-checksum",
+							})
+						})
+
+						It("returns a PluginChecksumMismatchError without running the binary", func() {
+							Expect(executeErr).To(MatchError(translatableerror.PluginChecksumMismatchError{
+								Expected: "some-checksum",
+								Actual:   "actual-checksum",
+							}))
+
+							Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+							Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+						})
+					})
+				})
+
 				Context("when the plugin is invalid", func() {
 					var returnedErr error
 
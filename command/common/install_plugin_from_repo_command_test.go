@@ -262,11 +262,15 @@ var _ = Describe("install-plugin command", func() {
 
 							Context("when the checksum fails", func() {
 								BeforeEach(func() {
-									fakeActor.ValidateFileChecksumReturns(false)
+									fakeActor.ValidateFileChecksumReturns(pluginaction.ChecksumMismatchError{Expected: "expected-checksum", Actual: "actual-checksum"})
 								})
 
 								It("returns the checksum error", func() {
-									Expect(executeErr).To(MatchError(InvalidChecksumError{}))
+									Expect(executeErr).To(MatchError(translatableerror.PluginChecksumMismatchError{
+										Expected:       "expected-checksum",
+										Actual:         "actual-checksum",
+										FromRepository: true,
+									}))
 
 									Expect(testUI.Out).To(Say("Searching %s for plugin %s\\.\\.\\.", repoName, pluginName))
 									Expect(testUI.Out).To(Say("Plugin %s %s found in: %s", pluginName, downloadedVersionString, repoName))
@@ -285,7 +289,7 @@ var _ = Describe("install-plugin command", func() {
 
 							Context("when the checksum succeeds", func() {
 								BeforeEach(func() {
-									fakeActor.ValidateFileChecksumReturns(true)
+									fakeActor.ValidateFileChecksumReturns(nil)
 								})
 
 								Context("when creating an executable copy errors", func() {
@@ -380,8 +384,9 @@ var _ = Describe("install-plugin command", func() {
 													pathArg, pluginArg := fakeActor.InstallPluginFromPathArgsForCall(0)
 													Expect(pathArg).To(Equal("copy-path"))
 													Expect(pluginArg).To(Equal(configv3.Plugin{
-														Name:    pluginName,
-														Version: pluginVersion,
+														Name:           pluginName,
+														Version:        pluginVersion,
+														RepositoryName: repoName,
 													}))
 												})
 											})
@@ -403,6 +408,12 @@ var _ = Describe("install-plugin command", func() {
 													Expect(testUI.Out).To(Say("OK"))
 													Expect(testUI.Out).To(Say("%s %s successfully installed\\.", pluginName, pluginVersionRegex))
 												})
+
+												It("records the repository the plugin was installed from", func() {
+													Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+													_, pluginArg := fakeActor.InstallPluginFromPathArgsForCall(0)
+													Expect(pluginArg.RepositoryName).To(Equal(repoName))
+												})
 											})
 										})
 									})
@@ -439,11 +450,15 @@ var _ = Describe("install-plugin command", func() {
 
 							Context("when the checksum fails", func() {
 								BeforeEach(func() {
-									fakeActor.ValidateFileChecksumReturns(false)
+									fakeActor.ValidateFileChecksumReturns(pluginaction.ChecksumMismatchError{Expected: "expected-checksum", Actual: "actual-checksum"})
 								})
 
 								It("returns the checksum error", func() {
-									Expect(executeErr).To(MatchError(InvalidChecksumError{}))
+									Expect(executeErr).To(MatchError(translatableerror.PluginChecksumMismatchError{
+										Expected:       "expected-checksum",
+										Actual:         "actual-checksum",
+										FromRepository: true,
+									}))
 
 									Expect(testUI.Out).To(Say("Searching %s for plugin %s\\.\\.\\.", repoName, pluginName))
 									Expect(testUI.Out).To(Say("Plugin %s %s found in: %s", pluginName, downloadedVersionString, repoName))
@@ -456,7 +471,7 @@ var _ = Describe("install-plugin command", func() {
 
 							Context("when the checksum succeeds", func() {
 								BeforeEach(func() {
-									fakeActor.ValidateFileChecksumReturns(true)
+									fakeActor.ValidateFileChecksumReturns(nil)
 								})
 
 								Context("when creating an executable copy errors", func() {
@@ -531,7 +546,7 @@ var _ = Describe("install-plugin command", func() {
 
 				Context("when the -f argument is not given (user is prompted for confirmation)", func() {
 					BeforeEach(func() {
-						fakeActor.ValidateFileChecksumReturns(true)
+						fakeActor.ValidateFileChecksumReturns(nil)
 					})
 
 					Context("when the plugin is already installed", func() {
@@ -788,7 +803,7 @@ var _ = Describe("install-plugin command", func() {
 					BeforeEach(func() {
 						cmd.Force = true
 
-						fakeActor.ValidateFileChecksumReturns(true)
+						fakeActor.ValidateFileChecksumReturns(nil)
 						checksum = helpers.PrefixedRandomName("checksum")
 
 						fakeActor.CreateExecutableCopyReturns("copy-path", nil)
@@ -831,12 +846,16 @@ var _ = Describe("install-plugin command", func() {
 					Context("when the checksum fails", func() {
 						BeforeEach(func() {
 							cmd.Force = false
-							fakeActor.ValidateFileChecksumReturns(false)
+							fakeActor.ValidateFileChecksumReturns(pluginaction.ChecksumMismatchError{Expected: "expected-checksum", Actual: "actual-checksum"})
 							input.Write([]byte("y\n"))
 						})
 
 						It("returns the checksum error", func() {
-							Expect(executeErr).To(MatchError(InvalidChecksumError{}))
+							Expect(executeErr).To(MatchError(translatableerror.PluginChecksumMismatchError{
+								Expected:       "expected-checksum",
+								Actual:         "actual-checksum",
+								FromRepository: true,
+							}))
 
 							Expect(testUI.Out).To(Say("Searching %s for plugin %s\\.\\.\\.", repoName, pluginName))
 							Expect(testUI.Out).To(Say("Plugin %s %s found in: %s", pluginName, downloadedVersionString, repoName))
@@ -941,7 +960,7 @@ var _ = Describe("install-plugin command", func() {
 
 						fakeActor.DownloadExecutableBinaryFromURLReturns(execPath, nil)
 
-						fakeActor.ValidateFileChecksumReturns(true)
+						fakeActor.ValidateFileChecksumReturns(nil)
 						checksum = helpers.PrefixedRandomName("checksum")
 
 						fakeActor.CreateExecutableCopyReturns("copy-path", nil)
@@ -1018,7 +1037,7 @@ var _ = Describe("install-plugin command", func() {
 					BeforeEach(func() {
 						cmd.Force = true
 
-						fakeActor.ValidateFileChecksumReturns(true)
+						fakeActor.ValidateFileChecksumReturns(nil)
 						checksum = helpers.PrefixedRandomName("checksum")
 
 						fakeActor.CreateExecutableCopyReturns("copy-path", nil)
@@ -1057,7 +1076,7 @@ var _ = Describe("install-plugin command", func() {
 
 					BeforeEach(func() {
 						cmd.Force = false
-						fakeActor.ValidateFileChecksumReturns(true)
+						fakeActor.ValidateFileChecksumReturns(nil)
 						checksum = helpers.PrefixedRandomName("checksum")
 
 						fakeActor.CreateExecutableCopyReturns("", errors.New("some-error"))
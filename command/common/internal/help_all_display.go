@@ -5,20 +5,22 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "GETTING STARTED:",
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
-			{"api", "auth"},
+			{"api", "auth", "setup"},
 		},
 	},
 	{
 		CategoryName: "APPS:",
 		CommandList: [][]string{
-			{"apps", "app"},
-			{"push", "scale", "delete", "rename"},
+			{"apps", "app", "processes"},
+			{"create-app", "push", "scale", "delete", "rename"},
 			{"start", "stop", "restart", "restage", "restart-app-instance"},
-			{"run-task", "tasks", "terminate-task"},
+			{"run-task", "task", "tasks", "terminate-task"},
 			{"events", "files", "logs"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
+			{"copy-source", "create-app-manifest", "apply-manifest"},
+			{"builds", "build"},
+			{"droplets", "set-droplet", "download-droplet"},
 			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
 		},
 	},
@@ -30,6 +32,7 @@ var HelpCategoryList = []HelpCategory{
 			{"create-service-key", "service-keys", "service-key", "delete-service-key"},
 			{"bind-service", "unbind-service"},
 			{"bind-route-service", "unbind-route-service"},
+			{"share-service", "unshare-service"},
 			{"create-user-provided-service", "update-user-provided-service"},
 		},
 	},
@@ -43,7 +46,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "SPACES:",
 		CommandList: [][]string{
-			{"spaces", "space"},
+			{"spaces", "space", "space-usage"},
 			{"create-space", "delete-space", "rename-space"},
 			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
 		},
@@ -58,7 +61,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ROUTES:",
 		CommandList: [][]string{
-			{"routes", "create-route", "check-route", "map-route", "unmap-route", "delete-route", "delete-orphaned-routes"},
+			{"routes", "route", "create-route", "check-route", "map-route", "unmap-route", "delete-route", "delete-orphaned-routes"},
 		},
 	},
 	{
@@ -70,7 +73,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "BUILDPACKS:",
 		CommandList: [][]string{
-			{"buildpacks", "create-buildpack", "update-buildpack", "rename-buildpack", "delete-buildpack"},
+			{"buildpacks", "buildpack", "create-buildpack", "update-buildpack", "rename-buildpack", "delete-buildpack"},
 		},
 	},
 	{
@@ -126,6 +129,12 @@ var HelpCategoryList = []HelpCategory{
 			{"isolation-segments", "create-isolation-segment", "delete-isolation-segment", "enable-org-isolation", "disable-org-isolation", "set-org-default-isolation-segment", "reset-org-default-isolation-segment", "set-space-isolation-segment", "reset-space-isolation-segment"},
 		},
 	},
+	{
+		CategoryName: "METADATA:",
+		CommandList: [][]string{
+			{"labels", "set-label", "unset-label"},
+		},
+	},
 	{
 		CategoryName: "FEATURE FLAGS:",
 		CommandList: [][]string{
@@ -136,6 +145,8 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
 			{"curl", "config", "oauth-token", "ssh-code"},
+			{"export-config", "import-config"},
+			{"app-usage-events", "service-usage-events"},
 		},
 	},
 	{
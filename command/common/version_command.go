@@ -1,25 +1,131 @@
 package common
 
-import "code.cloudfoundry.org/cli/command"
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/updatecheck"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . UpdateChecker
+
+type UpdateChecker interface {
+	LatestVersion() (string, error)
+}
 
 type VersionCommand struct {
-	usage  interface{} `usage:"CF_NAME version\n\n   'cf -v' and 'cf --version' are also accepted."`
-	UI     command.UI
-	Config command.Config
+	Output      flag.OutputFormat `long:"output" description:"Output format; only 'json' is supported"`
+	CheckUpdate bool              `long:"check-update" description:"Check whether a newer version of the CLI has been released"`
+	Short       bool              `long:"short" hidden:"true" description:"Only print the version line, as 'cf --version' does"`
+	usage       interface{}       `usage:"CF_NAME version [--check-update] [--output json]\n\n   'cf -v' and 'cf --version' are also accepted, and only print the version line."`
+
+	UI            command.UI
+	Config        command.Config
+	UpdateChecker UpdateChecker
 }
 
 func (cmd *VersionCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
+	cmd.UpdateChecker = updatecheck.NewChecker(updatecheck.DefaultReleaseURL, updatecheck.DefaultTimeout)
 	return nil
 }
 
 func (cmd VersionCommand) Execute(args []string) error {
+	if cmd.Short {
+		cmd.displayVersionLine()
+		return nil
+	}
+
+	latestVersion := ""
+	if cmd.CheckUpdate {
+		var err error
+		latestVersion, err = cmd.UpdateChecker.LatestVersion()
+		if err != nil {
+			cmd.UI.DisplayWarning("Could not check for a newer version: {{.Error}}", map[string]interface{}{
+				"Error": err.Error(),
+			})
+		}
+	}
+
+	if cmd.Output.Format == "json" {
+		return cmd.displayJSON(latestVersion)
+	}
+
+	cmd.displayVersionLine()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("version:"), version.BinaryVersion()},
+		{cmd.UI.TranslateText("commit:"), valueOrUnknown(cmd.UI, version.BinarySHA())},
+		{cmd.UI.TranslateText("build date:"), valueOrUnknown(cmd.UI, version.BinaryBuildDate())},
+		{cmd.UI.TranslateText("go version:"), runtime.Version()},
+		{cmd.UI.TranslateText("platform:"), platform()},
+	}, 3)
+
+	if latestVersion == "" {
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	if updatecheck.IsNewer(latestVersion, version.BinaryVersion()) {
+		cmd.UI.DisplayText("A newer version of the CLI is available: {{.LatestVersion}}", map[string]interface{}{
+			"LatestVersion": latestVersion,
+		})
+	} else {
+		cmd.UI.DisplayText("You are using the latest version of the CLI.")
+	}
+
+	return nil
+}
+
+func (cmd VersionCommand) displayVersionLine() {
 	cmd.UI.DisplayText("{{.BinaryName}} version {{.VersionString}}",
 		map[string]interface{}{
 			"BinaryName":    cmd.Config.BinaryName(),
 			"VersionString": cmd.Config.BinaryVersion(),
 		})
+}
 
-	return nil
+func (cmd VersionCommand) displayJSON(latestVersion string) error {
+	versionJSON := map[string]interface{}{
+		"version":    version.BinaryVersion(),
+		"commit_sha": nilIfEmpty(version.BinarySHA()),
+		"build_date": nilIfEmpty(version.BinaryBuildDate()),
+		"go_version": runtime.Version(),
+		"platform":   platform(),
+	}
+	if cmd.CheckUpdate {
+		versionJSON["latest_version"] = nilIfEmpty(latestVersion)
+		versionJSON["update_available"] = latestVersion != "" && updatecheck.IsNewer(latestVersion, version.BinaryVersion())
+	}
+
+	output, err := json.MarshalIndent(versionJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
+
+func platform() string {
+	return runtime.GOOS + "/" + runtime.GOARCH
+}
+
+func valueOrUnknown(ui command.UI, value string) string {
+	if value == "" {
+		return ui.TranslateText("unknown")
+	}
+	return value
+}
+
+func nilIfEmpty(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
 }
@@ -1,8 +1,13 @@
 package common_test
 
 import (
+	"errors"
+	"runtime"
+
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/util/ui"
 
 	. "github.com/onsi/ginkgo"
@@ -12,10 +17,11 @@ import (
 
 var _ = Describe("Version Command", func() {
 	var (
-		cmd        VersionCommand
-		testUI     *ui.UI
-		fakeConfig *commandfakes.FakeConfig
-		err        error
+		cmd               VersionCommand
+		testUI            *ui.UI
+		fakeConfig        *commandfakes.FakeConfig
+		fakeUpdateChecker *commonfakes.FakeUpdateChecker
+		err               error
 	)
 
 	BeforeEach(func() {
@@ -23,16 +29,127 @@ var _ = Describe("Version Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeConfig.BinaryNameReturns("faceman")
 		fakeConfig.BinaryVersionReturns("0.0.0-invalid-version")
+		fakeUpdateChecker = new(commonfakes.FakeUpdateChecker)
 
 		cmd = VersionCommand{
-			UI:     testUI,
-			Config: fakeConfig,
+			UI:            testUI,
+			Config:        fakeConfig,
+			UpdateChecker: fakeUpdateChecker,
 		}
 	})
 
-	It("displays correct version", func() {
+	JustBeforeEach(func() {
 		err = cmd.Execute(nil)
+	})
+
+	It("displays correct version", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(testUI.Out).To(Say("faceman version 0.0.0-invalid-version"))
 	})
+
+	It("displays the build metadata", func() {
+		Expect(testUI.Out).To(Say(`version:\s+0.0.0-unknown-version`))
+		Expect(testUI.Out).To(Say(`commit:\s+unknown`))
+		Expect(testUI.Out).To(Say(`build date:\s+unknown`))
+		Expect(testUI.Out).To(Say(`go version:\s+%s`, runtime.Version()))
+		Expect(testUI.Out).To(Say(`platform:\s+%s/%s`, runtime.GOOS, runtime.GOARCH))
+	})
+
+	It("does not check for updates", func() {
+		Expect(fakeUpdateChecker.LatestVersionCallCount()).To(Equal(0))
+	})
+
+	Context("when --short is passed, as with 'cf --version'", func() {
+		BeforeEach(func() {
+			cmd.Short = true
+		})
+
+		It("only displays the version line", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal("faceman version 0.0.0-invalid-version\n"))
+		})
+	})
+
+	Context("when --check-update is passed", func() {
+		BeforeEach(func() {
+			cmd.CheckUpdate = true
+		})
+
+		Context("when a newer version is available", func() {
+			BeforeEach(func() {
+				fakeUpdateChecker.LatestVersionReturns("6.40.0", nil)
+			})
+
+			It("reports the newer version", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("A newer version of the CLI is available: 6.40.0"))
+			})
+		})
+
+		Context("when the CLI is up to date", func() {
+			BeforeEach(func() {
+				fakeUpdateChecker.LatestVersionReturns("0.0.0-unknown-version", nil)
+			})
+
+			It("says so", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("You are using the latest version of the CLI."))
+			})
+		})
+
+		Context("when the check fails", func() {
+			BeforeEach(func() {
+				fakeUpdateChecker.LatestVersionReturns("", errors.New("network is unreachable"))
+			})
+
+			It("warns and still displays the version", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Could not check for a newer version: network is unreachable"))
+				Expect(testUI.Out).To(Say("faceman version 0.0.0-invalid-version"))
+				Expect(testUI.Out).ToNot(Say("newer version"))
+			})
+		})
+	})
+
+	Context("when --output json is passed", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: "json"}
+		})
+
+		It("displays the build metadata as JSON", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("faceman version"))
+			Expect(testUI.Out).To(Say(`"build_date": null`))
+			Expect(testUI.Out).To(Say(`"commit_sha": null`))
+			Expect(testUI.Out).To(Say(`"go_version": "%s"`, runtime.Version()))
+			Expect(testUI.Out).To(Say(`"platform": "%s/%s"`, runtime.GOOS, runtime.GOARCH))
+			Expect(testUI.Out).To(Say(`"version": "0.0.0-unknown-version"`))
+		})
+
+		Context("when --check-update is passed", func() {
+			BeforeEach(func() {
+				cmd.CheckUpdate = true
+				fakeUpdateChecker.LatestVersionReturns("6.40.0", nil)
+			})
+
+			It("includes the latest version", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`"latest_version": "6.40.0"`))
+				Expect(testUI.Out).To(Say(`"update_available": true`))
+			})
+		})
+
+		Context("when --check-update is passed and the check fails", func() {
+			BeforeEach(func() {
+				cmd.CheckUpdate = true
+				fakeUpdateChecker.LatestVersionReturns("", errors.New("timeout"))
+			})
+
+			It("outputs null for the latest version", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`"latest_version": null`))
+				Expect(testUI.Out).To(Say(`"update_available": false`))
+			})
+		})
+	})
 })
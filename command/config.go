@@ -14,17 +14,25 @@ type Config interface {
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(name string, url string)
 	APIVersion() string
+	ApplyConfigBundle(bundle configv3.ConfigBundle)
 	BinaryName() string
 	BinaryVersion() string
+	CACert() []byte
+	CACertOverride() []byte
 	ColorEnabled() configv3.ColorSetting
+	ConfigBundle() configv3.ConfigBundle
 	CurrentUser() (configv3.User, error)
+	CustomConfigDirectory() string
+	DeprecatedStacks() []string
 	DialTimeout() time.Duration
 	DockerPassword() string
 	Experimental() bool
+	FoundationCheckDisabled() bool
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	IsTTY() bool
 	Locale() string
 	MinCLIVersion() string
 	OverallPollingTimeout() time.Duration
@@ -34,13 +42,18 @@ type Config interface {
 	PollingInterval() time.Duration
 	RefreshToken() string
 	RemovePlugin(string)
+	RetryCount() int
 	SetAccessToken(token string)
+	SetCACert(caCert []byte)
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
+	SetUAAClientCredentials(client string, secret string)
 	SetUAAEndpoint(uaaEndpoint string)
+	SetUAAGrantType(grantType string)
+	SetUAAIssuer(issuer string)
 	SkipSSLValidation() bool
 	SSHOAuthClient() string
 	StagingTimeout() time.Duration
@@ -48,6 +61,9 @@ type Config interface {
 	Target() string
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
+	UAAEndpoint() string
+	UAAGrantType() string
+	UAAIssuer() string
 	UAAOAuthClient() string
 	UAAOAuthClientSecret() string
 	UnsetOrganizationInformation()
@@ -0,0 +1,34 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+const (
+	AppTypeBuildpack = "buildpack"
+	AppTypeDocker    = "docker"
+)
+
+type AppType struct {
+	Type string
+}
+
+func (AppType) Complete(prefix string) []flags.Completion {
+	return completions([]string{AppTypeBuildpack, AppTypeDocker}, prefix, false)
+}
+
+func (a *AppType) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case AppTypeBuildpack, AppTypeDocker:
+		a.Type = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `APP_TYPE must be "buildpack" or "docker"`,
+		}
+	}
+	return nil
+}
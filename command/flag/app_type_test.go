@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AppType", func() {
+	var appType AppType
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := appType.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'buildpack' when passed 'b'", "b",
+				[]flags.Completion{{Item: "buildpack"}}),
+			Entry("returns 'docker' when passed 'DO'", "DO",
+				[]flags.Completion{{Item: "docker"}}),
+			Entry("completes to both types when passed nothing", "",
+				[]flags.Completion{{Item: "buildpack"}, {Item: "docker"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			appType = AppType{}
+		})
+
+		DescribeTable("downcases and sets the type",
+			func(name string, expectedType string) {
+				err := appType.UnmarshalFlag(name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(appType.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'buildpack' when passed 'buildpack'", "buildpack", "buildpack"),
+			Entry("sets 'docker' when passed 'DoCKer'", "DoCKer", "docker"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := appType.UnmarshalFlag("cnb")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `APP_TYPE must be "buildpack" or "docker"`,
+				}))
+				Expect(appType.Type).To(BeEmpty())
+			})
+		})
+	})
+})
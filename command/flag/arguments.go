@@ -99,6 +99,16 @@ type AppInstance struct {
 	Index   int    `positional-arg-name:"INDEX" required:"true" description:"The index of the application instance"`
 }
 
+type AppBuild struct {
+	AppName   string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	BuildGUID string `positional-arg-name:"BUILD_GUID" required:"true" description:"The guid of the build"`
+}
+
+type AppDroplet struct {
+	AppName     string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	DropletGUID string `positional-arg-name:"DROPLET_GUID" required:"true" description:"The guid of the droplet"`
+}
+
 type OrgSpace struct {
 	Organization string `positional-arg-name:"ORG" required:"true" description:"The organization"`
 	Space        string `positional-arg-name:"SPACE" required:"true" description:"The space"`
@@ -255,6 +265,13 @@ type ServiceBrokerArgs struct {
 	URL           string `positional-arg-name:"URL" required:"true" description:"The URL of the service broker"`
 }
 
+type UpdateServiceBrokerArgs struct {
+	ServiceBroker string `positional-arg-name:"SERVICE_BROKER" required:"true" description:"The service broker name"`
+	Username      string `positional-arg-name:"USERNAME" description:"The username"`
+	Password      string `positional-arg-name:"PASSWORD" description:"The password"`
+	URL           string `positional-arg-name:"URL" description:"The URL of the service broker"`
+}
+
 type RenameServiceBrokerArgs struct {
 	OldServiceBrokerName string `positional-arg-name:"SERVICE_BROKER" required:"true" description:"The old service broker name"`
 	NewServiceBrokerName string `positional-arg-name:"NEW_SERVICE_BROKER" required:"true" description:"The new service broker name"`
@@ -282,6 +299,10 @@ type InstallPluginArgs struct {
 	PluginNameOrLocation Path `positional-arg-name:"PLUGIN_NAME_OR_LOCATION" required:"true" description:"The local path to the plugin, if the plugin exists locally; the URL to the plugin, if the plugin exists online; or the plugin name, if a repo is specified"`
 }
 
+type RepoPluginsArgs struct {
+	SearchTerm string `positional-arg-name:"SEARCH_TERM" description:"Only list plugins whose name or description contains this term"`
+}
+
 type RunTaskArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	Command string `positional-arg-name:"COMMAND" required:"true" description:"The command to execute"`
@@ -289,7 +310,12 @@ type RunTaskArgs struct {
 
 type TerminateTaskArgs struct {
 	AppName    string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
-	SequenceID string `positional-arg-name:"TASK_ID" required:"true" description:"The task's unique sequence ID"`
+	SequenceID string `positional-arg-name:"TASK_ID" required:"true" description:"The task's unique sequence ID, or the name of a running task"`
+}
+
+type TaskArgs struct {
+	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	TaskID  string `positional-arg-name:"TASK_ID" required:"true" description:"The task's sequence ID or GUID"`
 }
 
 type IsolationSegmentName struct {
@@ -301,6 +327,14 @@ type OrgIsolationArgs struct {
 	IsolationSegmentName string `positional-arg-name:"SEGMENT_NAME" required:"true" description:"The isolation segment name"`
 }
 
+// OrgIsolationOrOrgsFileArgs are the arguments of the commands that accept
+// either ORG_NAME SEGMENT_NAME or, with --orgs-file, only SEGMENT_NAME. In the
+// latter case the segment name is parsed into OrganizationName.
+type OrgIsolationOrOrgsFileArgs struct {
+	OrganizationName     string `positional-arg-name:"ORG_NAME" description:"The organization name"`
+	IsolationSegmentName string `positional-arg-name:"SEGMENT_NAME" description:"The isolation segment name"`
+}
+
 type SpaceIsolationArgs struct {
 	SpaceName            string `positional-arg-name:"SPACE_NAME" required:"true" description:"The space name"`
 	IsolationSegmentName string `positional-arg-name:"SEGMENT_NAME" required:"true" description:"The isolation segment name"`
@@ -321,3 +355,20 @@ type AddNetworkPolicyArgs struct {
 type RemoveNetworkPolicyArgs struct {
 	SourceApp string
 }
+
+type SetLabelArgs struct {
+	ResourceType LabelResource `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource: app, org or space"`
+	ResourceName string        `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+	Labels       []Label       `positional-arg-name:"KEY=VALUE" required:"1" description:"The labels to set"`
+}
+
+type UnsetLabelArgs struct {
+	ResourceType LabelResource `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource: app, org or space"`
+	ResourceName string        `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+	LabelKeys    []string      `positional-arg-name:"KEY" required:"1" description:"The keys of the labels to remove"`
+}
+
+type LabelsArgs struct {
+	ResourceType LabelResource `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource: app, org or space"`
+	ResourceName string        `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+}
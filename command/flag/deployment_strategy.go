@@ -0,0 +1,33 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// DeploymentStrategyRolling replaces an app's instances one at a time, so
+// the app keeps serving traffic while it picks up the new droplet.
+const DeploymentStrategyRolling = "rolling"
+
+type DeploymentStrategy struct {
+	Name string
+}
+
+func (DeploymentStrategy) Complete(prefix string) []flags.Completion {
+	return completions([]string{DeploymentStrategyRolling}, prefix, false)
+}
+
+func (d *DeploymentStrategy) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case DeploymentStrategyRolling:
+		d.Name = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `STRATEGY must be "rolling"`,
+		}
+	}
+	return nil
+}
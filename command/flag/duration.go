@@ -0,0 +1,26 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Duration is a positive Go duration, e.g. 90m or 72h.
+type Duration struct {
+	time.Duration
+	IsSet bool
+}
+
+func (d *Duration) UnmarshalFlag(val string) error {
+	parsed, err := time.ParseDuration(val)
+	if err != nil || parsed <= 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `DURATION must be a positive duration with a unit, e.g. "90m" or "72h"`,
+		}
+	}
+	d.Duration = parsed
+	d.IsSet = true
+	return nil
+}
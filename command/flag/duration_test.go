@@ -0,0 +1,44 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Duration", func() {
+	var duration Duration
+
+	BeforeEach(func() {
+		duration = Duration{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when passed a positive duration", func() {
+			It("sets the duration", func() {
+				err := duration.UnmarshalFlag("72h")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(duration).To(Equal(Duration{Duration: 72 * time.Hour, IsSet: true}))
+			})
+		})
+
+		DescribeTable("returns an error",
+			func(input string) {
+				err := duration.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `DURATION must be a positive duration with a unit, e.g. "90m" or "72h"`,
+				}))
+				Expect(duration).To(Equal(Duration{}))
+			},
+			Entry("when passed no unit", "72"),
+			Entry("when passed a negative duration", "-1h"),
+			Entry("when passed zero", "0s"),
+			Entry("when passed anything else", "yesterday"),
+		)
+	})
+})
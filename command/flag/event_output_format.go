@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type EventOutputFormat struct {
+	Format string
+}
+
+func (EventOutputFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"csv", "json"}, prefix, false)
+}
+
+func (o *EventOutputFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "csv", "json":
+		o.Format = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `OUTPUT_FORMAT must be "json" or "csv"`,
+		}
+	}
+	return nil
+}
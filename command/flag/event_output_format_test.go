@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EventOutputFormat", func() {
+	var outputFormat EventOutputFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := outputFormat.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'json' when passed 'j'", "j",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("returns 'csv' when passed 'CS'", "CS",
+				[]flags.Completion{{Item: "csv"}}),
+			Entry("completes to 'csv' and 'json' when passed nothing", "",
+				[]flags.Completion{{Item: "csv"}, {Item: "json"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			outputFormat = EventOutputFormat{}
+		})
+
+		DescribeTable("downcases and sets format",
+			func(format string, expectedFormat string) {
+				err := outputFormat.UnmarshalFlag(format)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outputFormat.Format).To(Equal(expectedFormat))
+			},
+			Entry("sets 'json' when passed 'jSoN'", "jSoN", "json"),
+			Entry("sets 'csv' when passed 'CSV'", "CSV", "csv"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := outputFormat.UnmarshalFlag("yaml")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `OUTPUT_FORMAT must be "json" or "csv"`,
+				}))
+				Expect(outputFormat.Format).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,23 @@
+package flag
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// HealthCheckTimeout is a number of seconds for a health check setting. It
+// stays unset when the flag is not given.
+type HealthCheckTimeout struct {
+	types.NullUint64
+}
+
+func (t *HealthCheckTimeout) UnmarshalFlag(val string) error {
+	err := t.ParseStringValue(val)
+	if err != nil || t.Value == 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "Timeout must be an integer greater than 0",
+		}
+	}
+	return nil
+}
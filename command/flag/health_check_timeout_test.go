@@ -0,0 +1,42 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HealthCheckTimeout", func() {
+	var timeout HealthCheckTimeout
+
+	BeforeEach(func() {
+		timeout = HealthCheckTimeout{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when a positive integer is provided", func() {
+			It("sets the value", func() {
+				err := timeout.UnmarshalFlag("120")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timeout).To(Equal(HealthCheckTimeout{NullUint64: types.NullUint64{Value: 120, IsSet: true}}))
+			})
+		})
+
+		DescribeTable("when the value is not a positive integer",
+			func(val string) {
+				err := timeout.UnmarshalFlag(val)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "Timeout must be an integer greater than 0",
+				}))
+			},
+			Entry("empty", ""),
+			Entry("zero", "0"),
+			Entry("negative", "-10"),
+			Entry("not a number", "abc"),
+		)
+	})
+})
@@ -0,0 +1,57 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+const (
+	LabelResourceApp   = "app"
+	LabelResourceOrg   = "org"
+	LabelResourceSpace = "space"
+)
+
+// LabelResource is the type of resource whose labels are set, removed or
+// listed.
+type LabelResource struct {
+	Type string
+}
+
+func (LabelResource) Complete(prefix string) []flags.Completion {
+	return completions([]string{LabelResourceApp, LabelResourceOrg, LabelResourceSpace}, prefix, false)
+}
+
+func (r *LabelResource) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case LabelResourceApp, LabelResourceOrg, LabelResourceSpace:
+		r.Type = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `RESOURCE must be "app", "org" or "space"`,
+		}
+	}
+	return nil
+}
+
+// Label is a KEY=VALUE label argument. The value may be empty.
+type Label struct {
+	Key   string
+	Value string
+}
+
+func (l *Label) UnmarshalFlag(val string) error {
+	parts := strings.SplitN(val, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "Labels must be in the format KEY=VALUE",
+		}
+	}
+
+	l.Key = parts[0]
+	l.Value = parts[1]
+	return nil
+}
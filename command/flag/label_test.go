@@ -0,0 +1,87 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LabelResource", func() {
+	var resource LabelResource
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := resource.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'space' when passed 'S'", "S",
+				[]flags.Completion{{Item: "space"}}),
+			Entry("completes to all types when passed nothing", "",
+				[]flags.Completion{{Item: "app"}, {Item: "org"}, {Item: "space"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			resource = LabelResource{}
+		})
+
+		DescribeTable("downcases and sets the type",
+			func(name string, expectedType string) {
+				err := resource.UnmarshalFlag(name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resource.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'app' when passed 'app'", "app", "app"),
+			Entry("sets 'org' when passed 'ORG'", "ORG", "org"),
+			Entry("sets 'space' when passed 'Space'", "Space", "space"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := resource.UnmarshalFlag("route")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `RESOURCE must be "app", "org" or "space"`,
+				}))
+				Expect(resource.Type).To(BeEmpty())
+			})
+		})
+	})
+})
+
+var _ = Describe("Label", func() {
+	var label Label
+
+	BeforeEach(func() {
+		label = Label{}
+	})
+
+	DescribeTable("splits the key and the value at the first '='",
+		func(val string, expectedLabel Label) {
+			err := label.UnmarshalFlag(val)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(label).To(Equal(expectedLabel))
+		},
+		Entry("key and value", "env=prod", Label{Key: "env", Value: "prod"}),
+		Entry("empty value", "env=", Label{Key: "env", Value: ""}),
+		Entry("'=' in the value", "env=a=b", Label{Key: "env", Value: "a=b"}),
+	)
+
+	DescribeTable("returns an error",
+		func(val string) {
+			err := label.UnmarshalFlag(val)
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: "Labels must be in the format KEY=VALUE",
+			}))
+		},
+		Entry("when there is no '='", "env"),
+		Entry("when the key is empty", "=prod"),
+	)
+})
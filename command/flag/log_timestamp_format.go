@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogTimestampFormat struct {
+	Format string
+}
+
+func (LogTimestampFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"rfc3339", "epoch", "none"}, prefix, false)
+}
+
+func (f *LogTimestampFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "rfc3339", "epoch", "none":
+		f.Format = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `TIMESTAMP_FORMAT must be "rfc3339", "epoch" or "none"`,
+		}
+	}
+	return nil
+}
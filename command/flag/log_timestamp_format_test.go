@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogTimestampFormat", func() {
+	var timestampFormat LogTimestampFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := timestampFormat.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'rfc3339' when passed 'r'", "r",
+				[]flags.Completion{{Item: "rfc3339"}}),
+			Entry("returns 'epoch' when passed 'EP'", "EP",
+				[]flags.Completion{{Item: "epoch"}}),
+			Entry("completes to all formats when passed nothing", "",
+				[]flags.Completion{{Item: "rfc3339"}, {Item: "epoch"}, {Item: "none"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			timestampFormat = LogTimestampFormat{}
+		})
+
+		DescribeTable("downcases and sets format",
+			func(format string, expectedFormat string) {
+				err := timestampFormat.UnmarshalFlag(format)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timestampFormat.Format).To(Equal(expectedFormat))
+			},
+			Entry("sets 'rfc3339' when passed 'RFC3339'", "RFC3339", "rfc3339"),
+			Entry("sets 'epoch' when passed 'Epoch'", "Epoch", "epoch"),
+			Entry("sets 'none' when passed 'none'", "none", "none"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := timestampFormat.UnmarshalFlag("iso8601")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `TIMESTAMP_FORMAT must be "rfc3339", "epoch" or "none"`,
+				}))
+				Expect(timestampFormat.Format).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type OutputFormat struct {
+	Format string
+}
+
+func (OutputFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"json"}, prefix, false)
+}
+
+func (o *OutputFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "json":
+		o.Format = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `OUTPUT_FORMAT must be "json"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var outputFormat OutputFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := outputFormat.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'json' when passed 'j'", "j",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("returns 'json' when passed 'JS'", "JS",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("completes to 'json' when passed nothing", "",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			outputFormat = OutputFormat{}
+		})
+
+		DescribeTable("downcases and sets format",
+			func(format string, expectedFormat string) {
+				err := outputFormat.UnmarshalFlag(format)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outputFormat.Format).To(Equal(expectedFormat))
+			},
+			Entry("sets 'json' when passed 'json'", "json", "json"),
+			Entry("sets 'json' when passed 'jSoN'", "jSoN", "json"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := outputFormat.UnmarshalFlag("yaml")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `OUTPUT_FORMAT must be "json"`,
+				}))
+				Expect(outputFormat.Format).To(BeEmpty())
+			})
+		})
+	})
+})
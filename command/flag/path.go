@@ -101,6 +101,27 @@ func (p *PathWithExistenceCheckOrURL) UnmarshalFlag(path string) error {
 	return nil
 }
 
+// AppPathOrURL is an app directory or zip file that must exist, an http(s)
+// URL of a zip file, or a git+ URL of a repository.
+type AppPathOrURL string
+
+func (AppPathOrURL) Complete(prefix string) []flags.Completion {
+	return completeWithTilde(prefix)
+}
+
+func (p *AppPathOrURL) UnmarshalFlag(path string) error {
+	if !strings.HasPrefix(path, "git+") {
+		var checked PathWithExistenceCheckOrURL
+		err := checked.UnmarshalFlag(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	*p = AppPathOrURL(path)
+	return nil
+}
+
 type PathWithAt string
 
 func (PathWithAt) Complete(prefix string) []flags.Completion {
@@ -343,6 +343,42 @@ var _ = Describe("path types", func() {
 		})
 	})
 
+	Describe("AppPathOrURL", func() {
+		var appPathOrURL AppPathOrURL
+
+		BeforeEach(func() {
+			appPathOrURL = AppPathOrURL("")
+		})
+
+		Describe("UnmarshalFlag", func() {
+			It("sets the path if it is an http(s) URL", func() {
+				err := appPathOrURL.UnmarshalFlag("https://example.com/app.zip")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(appPathOrURL).To(BeEquivalentTo("https://example.com/app.zip"))
+			})
+
+			It("sets the path if it is a git+ URL", func() {
+				err := appPathOrURL.UnmarshalFlag("git+https://example.com/app.git#v1.0")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(appPathOrURL).To(BeEquivalentTo("git+https://example.com/app.git#v1.0"))
+			})
+
+			It("returns a path does not exist error when the path does not exist", func() {
+				err := appPathOrURL.UnmarshalFlag("./some-dir/some-file")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "The specified path './some-dir/some-file' does not exist.",
+				}))
+			})
+
+			It("sets the path when the path exists", func() {
+				err := appPathOrURL.UnmarshalFlag("abc")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(appPathOrURL).To(BeEquivalentTo("abc"))
+			})
+		})
+	})
+
 	Describe("PathWithAt", func() {
 		var pathWithAt PathWithAt
 
@@ -0,0 +1,10 @@
+package flag
+
+import flags "github.com/jessevdk/go-flags"
+
+// SpaceUsageSort is the column that cf space-usage sorts apps by.
+type SpaceUsageSort string
+
+func (SpaceUsageSort) Complete(prefix string) []flags.Completion {
+	return completions([]string{"memory", "cpu"}, prefix, false)
+}
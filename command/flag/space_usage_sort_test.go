@@ -0,0 +1,31 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SpaceUsageSort", func() {
+	var sortBy SpaceUsageSort
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := sortBy.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+
+			Entry("completes to 'memory' when passed 'm'", "m",
+				[]flags.Completion{{Item: "memory"}}),
+			Entry("completes to 'cpu' when passed 'C'", "C",
+				[]flags.Completion{{Item: "cpu"}}),
+			Entry("returns 'memory' and 'cpu' when passed nothing", "",
+				[]flags.Completion{{Item: "memory"}, {Item: "cpu"}}),
+			Entry("completes to nothing when passed 'disk'", "disk",
+				[]flags.Completion{}),
+		)
+	})
+})
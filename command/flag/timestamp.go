@@ -0,0 +1,24 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Timestamp is an RFC3339 timestamp, e.g. 2017-06-30T15:04:05Z.
+type Timestamp struct {
+	time.Time
+}
+
+func (t *Timestamp) UnmarshalFlag(val string) error {
+	parsed, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `TIMESTAMP must be in RFC3339 format, e.g. "2017-06-30T15:04:05Z"`,
+		}
+	}
+	t.Time = parsed
+	return nil
+}
@@ -0,0 +1,39 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timestamp", func() {
+	var timestamp Timestamp
+
+	BeforeEach(func() {
+		timestamp = Timestamp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		Context("when passed an RFC3339 timestamp", func() {
+			It("sets the time", func() {
+				err := timestamp.UnmarshalFlag("2017-06-30T15:04:05Z")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timestamp.Time).To(Equal(time.Date(2017, 6, 30, 15, 4, 5, 0, time.UTC)))
+			})
+		})
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := timestamp.UnmarshalFlag("yesterday")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `TIMESTAMP must be in RFC3339 format, e.g. "2017-06-30T15:04:05Z"`,
+				}))
+				Expect(timestamp.Time.IsZero()).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,34 @@
+package flag
+
+import (
+	"strconv"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Wait is an option that is on by default and can be turned off with
+// --wait=false. Declare it with `optional:"true" optional-value:"true"` so
+// that a bare --wait is also accepted.
+type Wait struct {
+	IsSet bool
+	Value bool
+}
+
+// Enabled returns true unless the option was explicitly set to false.
+func (w Wait) Enabled() bool {
+	return !w.IsSet || w.Value
+}
+
+func (w *Wait) UnmarshalFlag(val string) error {
+	wait, err := strconv.ParseBool(val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `--wait must be "true" or "false"`,
+		}
+	}
+
+	w.IsSet = true
+	w.Value = wait
+	return nil
+}
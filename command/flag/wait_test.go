@@ -0,0 +1,47 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Wait", func() {
+	var wait Wait
+
+	BeforeEach(func() {
+		wait = Wait{}
+	})
+
+	It("is enabled by default", func() {
+		Expect(wait.Enabled()).To(BeTrue())
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("sets the value",
+			func(input string, enabled bool) {
+				err := wait.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(wait.IsSet).To(BeTrue())
+				Expect(wait.Enabled()).To(Equal(enabled))
+			},
+			Entry("true", "true", true),
+			Entry("false", "false", false),
+			Entry("FALSE", "FALSE", false),
+			Entry("0", "0", false),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := wait.UnmarshalFlag("sometimes")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `--wait must be "true" or "false"`,
+				}))
+				Expect(wait.IsSet).To(BeFalse())
+			})
+		})
+	})
+})
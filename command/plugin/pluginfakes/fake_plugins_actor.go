@@ -20,6 +20,28 @@ type FakePluginsActor struct {
 		result1 []pluginaction.OutdatedPlugin
 		result2 error
 	}
+	GetOrphanedPluginBinariesStub        func() ([]string, error)
+	getOrphanedPluginBinariesMutex       sync.RWMutex
+	getOrphanedPluginBinariesArgsForCall []struct{}
+	getOrphanedPluginBinariesReturns     struct {
+		result1 []string
+		result2 error
+	}
+	getOrphanedPluginBinariesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	RemovePluginBinariesStub        func(paths []string) error
+	removePluginBinariesMutex       sync.RWMutex
+	removePluginBinariesArgsForCall []struct {
+		paths []string
+	}
+	removePluginBinariesReturns struct {
+		result1 error
+	}
+	removePluginBinariesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -67,11 +89,111 @@ func (fake *FakePluginsActor) GetOutdatedPluginsReturnsOnCall(i int, result1 []p
 	}{result1, result2}
 }
 
+func (fake *FakePluginsActor) GetOrphanedPluginBinaries() ([]string, error) {
+	fake.getOrphanedPluginBinariesMutex.Lock()
+	ret, specificReturn := fake.getOrphanedPluginBinariesReturnsOnCall[len(fake.getOrphanedPluginBinariesArgsForCall)]
+	fake.getOrphanedPluginBinariesArgsForCall = append(fake.getOrphanedPluginBinariesArgsForCall, struct{}{})
+	fake.recordInvocation("GetOrphanedPluginBinaries", []interface{}{})
+	fake.getOrphanedPluginBinariesMutex.Unlock()
+	if fake.GetOrphanedPluginBinariesStub != nil {
+		return fake.GetOrphanedPluginBinariesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOrphanedPluginBinariesReturns.result1, fake.getOrphanedPluginBinariesReturns.result2
+}
+
+func (fake *FakePluginsActor) GetOrphanedPluginBinariesCallCount() int {
+	fake.getOrphanedPluginBinariesMutex.RLock()
+	defer fake.getOrphanedPluginBinariesMutex.RUnlock()
+	return len(fake.getOrphanedPluginBinariesArgsForCall)
+}
+
+func (fake *FakePluginsActor) GetOrphanedPluginBinariesReturns(result1 []string, result2 error) {
+	fake.GetOrphanedPluginBinariesStub = nil
+	fake.getOrphanedPluginBinariesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginsActor) GetOrphanedPluginBinariesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.GetOrphanedPluginBinariesStub = nil
+	if fake.getOrphanedPluginBinariesReturnsOnCall == nil {
+		fake.getOrphanedPluginBinariesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.getOrphanedPluginBinariesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginsActor) RemovePluginBinaries(paths []string) error {
+	var pathsCopy []string
+	if paths != nil {
+		pathsCopy = make([]string, len(paths))
+		copy(pathsCopy, paths)
+	}
+	fake.removePluginBinariesMutex.Lock()
+	ret, specificReturn := fake.removePluginBinariesReturnsOnCall[len(fake.removePluginBinariesArgsForCall)]
+	fake.removePluginBinariesArgsForCall = append(fake.removePluginBinariesArgsForCall, struct {
+		paths []string
+	}{pathsCopy})
+	fake.recordInvocation("RemovePluginBinaries", []interface{}{pathsCopy})
+	fake.removePluginBinariesMutex.Unlock()
+	if fake.RemovePluginBinariesStub != nil {
+		return fake.RemovePluginBinariesStub(paths)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.removePluginBinariesReturns.result1
+}
+
+func (fake *FakePluginsActor) RemovePluginBinariesCallCount() int {
+	fake.removePluginBinariesMutex.RLock()
+	defer fake.removePluginBinariesMutex.RUnlock()
+	return len(fake.removePluginBinariesArgsForCall)
+}
+
+func (fake *FakePluginsActor) RemovePluginBinariesArgsForCall(i int) []string {
+	fake.removePluginBinariesMutex.RLock()
+	defer fake.removePluginBinariesMutex.RUnlock()
+	return fake.removePluginBinariesArgsForCall[i].paths
+}
+
+func (fake *FakePluginsActor) RemovePluginBinariesReturns(result1 error) {
+	fake.RemovePluginBinariesStub = nil
+	fake.removePluginBinariesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePluginsActor) RemovePluginBinariesReturnsOnCall(i int, result1 error) {
+	fake.RemovePluginBinariesStub = nil
+	if fake.removePluginBinariesReturnsOnCall == nil {
+		fake.removePluginBinariesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removePluginBinariesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePluginsActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getOutdatedPluginsMutex.RLock()
 	defer fake.getOutdatedPluginsMutex.RUnlock()
+	fake.getOrphanedPluginBinariesMutex.RLock()
+	defer fake.getOrphanedPluginBinariesMutex.RUnlock()
+	fake.removePluginBinariesMutex.RLock()
+	defer fake.removePluginBinariesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -13,13 +13,16 @@ import (
 //go:generate counterfeiter . PluginsActor
 
 type PluginsActor interface {
+	GetOrphanedPluginBinaries() ([]string, error)
 	GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error)
+	RemovePluginBinaries(paths []string) error
 }
 
 type PluginsCommand struct {
 	Checksum          bool        `long:"checksum" description:"Compute and show the sha1 value of the plugin binary file"`
 	Outdated          bool        `long:"outdated" description:"Search the plugin repositories for new versions of installed plugins"`
-	usage             interface{} `usage:"CF_NAME plugins [--checksum | --outdated]"`
+	Prune             bool        `long:"prune" description:"Delete files in the plugin directory that no installed plugin uses"`
+	usage             interface{} `usage:"CF_NAME plugins [--checksum | --outdated | --prune]"`
 	relatedCommands   interface{} `related_commands:"install-plugin, repo-plugins, uninstall-plugin"`
 	SkipSSLValidation bool        `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	UI                command.UI
@@ -39,6 +42,8 @@ func (cmd PluginsCommand) Execute([]string) error {
 	switch {
 	case cmd.Outdated:
 		return cmd.displayOutdatedPlugins()
+	case cmd.Prune:
+		return cmd.pruneOrphanedBinaries()
 	case cmd.Checksum:
 		return cmd.displayPluginChecksums(cmd.Config.Plugins())
 	default:
@@ -77,10 +82,10 @@ func (cmd PluginsCommand) displayOutdatedPlugins() error {
 		return shared.HandleError(err)
 	}
 
-	table := [][]string{{"plugin", "version", "latest version"}}
+	table := [][]string{{"plugin", "version", "latest version", "repository"}}
 
 	for _, plugin := range outdatedPlugins {
-		table = append(table, []string{plugin.Name, plugin.CurrentVersion, plugin.LatestVersion})
+		table = append(table, []string{plugin.Name, plugin.CurrentVersion, plugin.LatestVersion, plugin.RepositoryName})
 	}
 
 	cmd.UI.DisplayNewline()
@@ -94,6 +99,45 @@ func (cmd PluginsCommand) displayOutdatedPlugins() error {
 	return nil
 }
 
+func (cmd PluginsCommand) pruneOrphanedBinaries() error {
+	cmd.UI.DisplayText("Searching {{.PluginHome}} for files not used by any installed plugin...", map[string]interface{}{
+		"PluginHome": cmd.Config.PluginHome(),
+	})
+
+	orphans, err := cmd.Actor.GetOrphanedPluginBinaries()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(orphans) == 0 {
+		cmd.UI.DisplayText("No unused plugin binaries found.")
+		return nil
+	}
+
+	for _, path := range orphans {
+		cmd.UI.DisplayText("{{.Path}}", map[string]interface{}{"Path": path})
+	}
+	cmd.UI.DisplayNewline()
+
+	prune, err := cmd.UI.DisplayBoolPrompt(false, "Really delete these files?")
+	if err != nil {
+		return err
+	}
+	if !prune {
+		cmd.UI.DisplayText("Files have not been deleted.")
+		return nil
+	}
+
+	err = cmd.Actor.RemovePluginBinaries(orphans)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
 func (cmd PluginsCommand) displayPluginCommands(plugins []configv3.Plugin) error {
 	cmd.UI.DisplayText("Listing installed plugins...")
 	table := [][]string{{"plugin", "version", "command name", "command help"}}
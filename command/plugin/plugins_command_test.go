@@ -1,6 +1,7 @@
 package plugin_test
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
 
@@ -212,7 +213,7 @@ var _ = Describe("plugins Command", func() {
 
 						Expect(testUI.Out).To(Say("Searching repo-1, repo-2 for newer versions of installed plugins..."))
 						Expect(testUI.Out).To(Say(""))
-						Expect(testUI.Out).To(Say("plugin\\s+version\\s+latest version\\n\\nUse 'faceman install-plugin' to update a plugin to the latest version\\."))
+						Expect(testUI.Out).To(Say("plugin\\s+version\\s+latest version\\s+repository\\n\\nUse 'faceman install-plugin' to update a plugin to the latest version\\."))
 
 						Expect(fakeActor.GetOutdatedPluginsCallCount()).To(Equal(1))
 					})
@@ -221,8 +222,8 @@ var _ = Describe("plugins Command", func() {
 				Context("when plugins are outdated", func() {
 					BeforeEach(func() {
 						fakeActor.GetOutdatedPluginsReturns([]pluginaction.OutdatedPlugin{
-							{Name: "plugin-1", CurrentVersion: "1.0.0", LatestVersion: "2.0.0"},
-							{Name: "plugin-2", CurrentVersion: "2.0.0", LatestVersion: "3.0.0"},
+							{Name: "plugin-1", CurrentVersion: "1.0.0", LatestVersion: "2.0.0", RepositoryName: "repo-1"},
+							{Name: "plugin-2", CurrentVersion: "2.0.0", LatestVersion: "3.0.0", RepositoryName: "repo-2"},
 						}, nil)
 					})
 
@@ -233,9 +234,9 @@ var _ = Describe("plugins Command", func() {
 
 						Expect(testUI.Out).To(Say("Searching repo-1, repo-2 for newer versions of installed plugins..."))
 						Expect(testUI.Out).To(Say(""))
-						Expect(testUI.Out).To(Say("plugin\\s+version\\s+latest version"))
-						Expect(testUI.Out).To(Say("plugin-1\\s+1.0.0\\s+2.0.0"))
-						Expect(testUI.Out).To(Say("plugin-2\\s+2.0.0\\s+3.0.0"))
+						Expect(testUI.Out).To(Say("plugin\\s+version\\s+latest version\\s+repository"))
+						Expect(testUI.Out).To(Say("plugin-1\\s+1.0.0\\s+2.0.0\\s+repo-1"))
+						Expect(testUI.Out).To(Say("plugin-2\\s+2.0.0\\s+3.0.0\\s+repo-2"))
 						Expect(testUI.Out).To(Say(""))
 						Expect(testUI.Out).To(Say("Use 'faceman install-plugin' to update a plugin to the latest version\\."))
 					})
@@ -243,4 +244,77 @@ var _ = Describe("plugins Command", func() {
 			})
 		})
 	})
+
+	Context("when the --prune flag is provided", func() {
+		var input *Buffer
+
+		BeforeEach(func() {
+			input = NewBuffer()
+			testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+			cmd.UI = testUI
+			cmd.Prune = true
+
+			fakeConfig.PluginHomeReturns("some-plugin-home")
+		})
+
+		Context("when there are no unused files", func() {
+			It("says so without prompting", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Searching some-plugin-home for files not used by any installed plugin\\.\\.\\."))
+				Expect(testUI.Out).To(Say("No unused plugin binaries found\\."))
+				Expect(testUI.Out).NotTo(Say("Really delete"))
+				Expect(fakeActor.RemovePluginBinariesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when there are unused files", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrphanedPluginBinariesReturns([]string{"some-plugin-home/orphan-1", "some-plugin-home/orphan-2"}, nil)
+			})
+
+			Context("when the user confirms", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("y\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("lists and deletes the files", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("some-plugin-home/orphan-1"))
+					Expect(testUI.Out).To(Say("some-plugin-home/orphan-2"))
+					Expect(testUI.Out).To(Say("Really delete these files\\?"))
+					Expect(testUI.Out).To(Say("OK"))
+
+					Expect(fakeActor.RemovePluginBinariesCallCount()).To(Equal(1))
+					Expect(fakeActor.RemovePluginBinariesArgsForCall(0)).To(Equal([]string{"some-plugin-home/orphan-1", "some-plugin-home/orphan-2"}))
+				})
+
+				Context("when deleting fails", func() {
+					BeforeEach(func() {
+						fakeActor.RemovePluginBinariesReturns(errors.New("remove-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("remove-error"))
+					})
+				})
+			})
+
+			Context("when the user declines", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("n\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not delete the files", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Files have not been deleted\\."))
+					Expect(fakeActor.RemovePluginBinariesCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
 })
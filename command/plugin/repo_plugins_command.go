@@ -5,12 +5,14 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 )
 
 type RepoPluginsCommand struct {
-	RegisteredRepository string      `short:"r" description:"Name of a registered repository"`
-	usage                interface{} `usage:"CF_NAME repo-plugins [-r REPO_NAME]\n\nEXAMPLES:\n   CF_NAME repo-plugins -r PrivateRepo"`
-	relatedCommands      interface{} `related_commands:"add-plugin-repo, delete-plugin-repo, install-plugin"`
+	OptionalArgs         flag.RepoPluginsArgs `positional-args:"yes"`
+	RegisteredRepository string               `short:"r" description:"Name of a registered repository"`
+	usage                interface{}          `usage:"CF_NAME repo-plugins [-r REPO_NAME] [SEARCH_TERM]\n\nEXAMPLES:\n   CF_NAME repo-plugins -r PrivateRepo\n   CF_NAME repo-plugins -r CF-Community log"`
+	relatedCommands      interface{}          `related_commands:"add-plugin-repo, delete-plugin-repo, install-plugin"`
 }
 
 func (RepoPluginsCommand) Setup(config command.Config, ui command.UI) error {
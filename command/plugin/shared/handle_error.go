@@ -21,6 +21,8 @@ func HandleError(err error) error {
 
 	case pluginaction.AddPluginRepositoryError:
 		return translatableerror.AddPluginRepositoryError{Name: e.Name, URL: e.URL, Message: e.Message}
+	case pluginaction.ChecksumMismatchError:
+		return translatableerror.PluginChecksumMismatchError{Expected: e.Expected, Actual: e.Actual}
 	case pluginaction.GettingPluginRepositoryError:
 		return translatableerror.GettingPluginRepositoryError{Name: e.Name, Message: e.Message}
 	case pluginaction.NoCompatibleBinaryError:
@@ -45,6 +45,9 @@ var _ = Describe("HandleError", func() {
 		Entry("pluginaction.AddPluginRepositoryError -> AddPluginRepositoryError",
 			pluginaction.AddPluginRepositoryError{Name: "some-repo", URL: "some-URL", Message: "404"},
 			translatableerror.AddPluginRepositoryError{Name: "some-repo", URL: "some-URL", Message: "404"}),
+		Entry("pluginaction.ChecksumMismatchError -> PluginChecksumMismatchError",
+			pluginaction.ChecksumMismatchError{Expected: "expected-checksum", Actual: "actual-checksum"},
+			translatableerror.PluginChecksumMismatchError{Expected: "expected-checksum", Actual: "actual-checksum"}),
 		Entry("pluginaction.GettingPluginRepositoryError -> GettingPluginRepositoryError",
 			pluginaction.GettingPluginRepositoryError{Name: "some-repo", Message: "404"},
 			translatableerror.GettingPluginRepositoryError{Name: "some-repo", Message: "404"}),
@@ -3,29 +3,45 @@ package shared
 import (
 	"io"
 
+	"code.cloudfoundry.org/cli/util/progressbar"
 	pb "gopkg.in/cheggaaa/pb.v1"
 )
 
 // ProgressBarProxyReader wraps a progress bar in a ProxyReader interface.
 type ProgressBarProxyReader struct {
-	writer io.Writer
-	bar    *pb.ProgressBar
+	writer   io.Writer
+	animated bool
+	size     int64
+	bar      *pb.ProgressBar
 }
 
 func (p ProgressBarProxyReader) Wrap(reader io.Reader) io.ReadCloser {
+	if !p.animated {
+		return progressbar.NewPlainTextReader(reader, p.writer, "Downloaded", p.size)
+	}
 	return p.bar.NewProxyReader(reader)
 }
 
 func (p *ProgressBarProxyReader) Start(size int64) {
+	p.size = size
+	if !p.animated {
+		return
+	}
 	p.bar = pb.New(int(size)).SetUnits(pb.U_BYTES)
 	p.bar.Output = p.writer
 	p.bar.Start()
 }
 
 func (p ProgressBarProxyReader) Finish() {
+	if !p.animated {
+		return
+	}
 	p.bar.Finish()
 }
 
-func NewProgressBarProxyReader(writer io.Writer) *ProgressBarProxyReader {
-	return &ProgressBarProxyReader{writer: writer}
+// NewProgressBarProxyReader returns a ProgressBarProxyReader writing to
+// writer. When animated is false, download progress is written as plain text
+// lines instead of an animated bar.
+func NewProgressBarProxyReader(writer io.Writer, animated bool) *ProgressBarProxyReader {
+	return &ProgressBarProxyReader{writer: writer, animated: animated}
 }
@@ -0,0 +1,60 @@
+package shared_test
+
+import (
+	"io/ioutil"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/command/plugin/shared"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ProgressBarProxyReader", func() {
+	var (
+		out         *Buffer
+		proxyReader *ProgressBarProxyReader
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+	})
+
+	Context("when animations are disabled", func() {
+		BeforeEach(func() {
+			proxyReader = NewProgressBarProxyReader(out, false)
+		})
+
+		It("displays the progress as plain text lines", func() {
+			proxyReader.Start(20)
+			reader := proxyReader.Wrap(strings.NewReader(strings.Repeat("a", 20)))
+
+			buffer := make([]byte, 9)
+			_, err := reader.Read(buffer)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Say("%s", `Downloaded 40%\.\.\.\n`))
+
+			rest, err := ioutil.ReadAll(reader)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rest).To(HaveLen(11))
+			Expect(out).To(Say("%s", `Downloaded 100%\.\.\.\n`))
+
+			Expect(reader.Close()).To(Succeed())
+			proxyReader.Finish()
+			Expect(out.Contents()).To(HaveLen(len("Downloaded 40%...\nDownloaded 100%...\n")))
+		})
+
+		Context("when the size is unknown", func() {
+			It("displays nothing", func() {
+				proxyReader.Start(-1)
+				reader := proxyReader.Wrap(strings.NewReader("some-content"))
+
+				_, err := ioutil.ReadAll(reader)
+				Expect(err).ToNot(HaveOccurred())
+				proxyReader.Finish()
+
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -58,6 +58,7 @@ func (r RPCService) Run(path string, command string) error {
 	defer r.rpcService.Stop()
 
 	cmd := exec.Command(path, r.rpcService.Port(), command)
+	cmd.Env = configv3.SubprocessEnvironment()
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 
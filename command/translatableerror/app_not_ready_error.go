@@ -0,0 +1,21 @@
+package translatableerror
+
+import "time"
+
+// AppNotReadyError is returned when the app's readiness URL did not return a
+// 2xx status in time.
+type AppNotReadyError struct {
+	URL     string
+	Timeout time.Duration
+}
+
+func (AppNotReadyError) Error() string {
+	return "{{.URL}} did not return a 2xx status within {{.Timeout}}"
+}
+
+func (e AppNotReadyError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"URL":     e.URL,
+		"Timeout": e.Timeout,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type BuildNotFoundError struct {
+	GUID string
+}
+
+func (BuildNotFoundError) Error() string {
+	return "Build with GUID {{.BuildGUID}} not found."
+}
+
+func (e BuildNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BuildGUID": e.GUID,
+	})
+}
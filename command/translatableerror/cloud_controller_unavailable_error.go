@@ -0,0 +1,15 @@
+package translatableerror
+
+type CloudControllerUnavailableError struct {
+	URL string
+}
+
+func (CloudControllerUnavailableError) Error() string {
+	return "The Cloud Controller at '{{.URL}}' is temporarily unavailable. It may be undergoing an upgrade or maintenance; please try again in a few minutes."
+}
+
+func (e CloudControllerUnavailableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"URL": e.URL,
+	})
+}
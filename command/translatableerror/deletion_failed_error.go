@@ -0,0 +1,20 @@
+package translatableerror
+
+import "strings"
+
+// DeletionFailedError is returned when an asynchronous deletion fails. It
+// lists the resources that blocked the deletion so that they can be cleaned
+// up manually.
+type DeletionFailedError struct {
+	Reasons []string
+}
+
+func (DeletionFailedError) Error() string {
+	return "Deletion failed. Clean up the following resources manually and try again:\n{{.Reasons}}"
+}
+
+func (e DeletionFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Reasons": "   " + strings.Join(e.Reasons, "\n   "),
+	})
+}
@@ -0,0 +1,24 @@
+package translatableerror
+
+type DropletNotFoundError struct {
+	AppName string
+	GUID    string
+}
+
+func (e DropletNotFoundError) Error() string {
+	switch {
+	case e.AppName != "":
+		return "App {{.AppName}} does not have a current droplet."
+	case e.GUID != "":
+		return "Droplet with GUID {{.DropletGUID}} not found."
+	default:
+		return "Droplet not found."
+	}
+}
+
+func (e DropletNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":     e.AppName,
+		"DropletGUID": e.GUID,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// FeatureFlagDisabledError is returned when a command relies on a feature
+// flag that is disabled on the targeted Cloud Foundry.
+type FeatureFlagDisabledError struct {
+	FeatureFlag string
+}
+
+func (FeatureFlagDisabledError) Error() string {
+	return "The {{.FeatureFlag}} feature flag is disabled for this Cloud Foundry platform. Ask an admin to enable it."
+}
+
+func (e FeatureFlagDisabledError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"FeatureFlag": e.FeatureFlag,
+	})
+}
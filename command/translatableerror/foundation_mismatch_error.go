@@ -0,0 +1,14 @@
+package translatableerror
+
+// FoundationMismatchError is returned when the targeted API endpoint belongs
+// to a different foundation than the one the user logged in to.
+type FoundationMismatchError struct {
+}
+
+func (FoundationMismatchError) Error() string {
+	return "The API endpoint now points at a different foundation than your session was created for; please run cf login"
+}
+
+func (e FoundationMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// IncompleteDownloadError is returned when a download ends before all of its
+// content has been received.
+type IncompleteDownloadError struct {
+	ExpectedBytes int64
+}
+
+func (IncompleteDownloadError) Error() string {
+	return "Download incomplete: expected {{.ExpectedBytes}} bytes. Please try again."
+}
+
+func (e IncompleteDownloadError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ExpectedBytes": e.ExpectedBytes,
+	})
+}
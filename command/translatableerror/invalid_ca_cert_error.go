@@ -0,0 +1,17 @@
+package translatableerror
+
+// InvalidCACertError is returned when the file given as a CA certificate does
+// not contain any PEM encoded certificates.
+type InvalidCACertError struct {
+	Path string
+}
+
+func (InvalidCACertError) Error() string {
+	return "The file {{.Path}} does not contain any PEM encoded certificates."
+}
+
+func (e InvalidCACertError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// InvalidDropletStateError is returned when a droplet in the FAILED or EXPIRED
+// state is assigned as the current droplet of an app.
+type InvalidDropletStateError struct {
+	GUID  string
+	State string
+}
+
+func (InvalidDropletStateError) Error() string {
+	return "Droplet {{.DropletGUID}} is in {{.State}} state and cannot be set as the current droplet of an app."
+}
+
+func (e InvalidDropletStateError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"DropletGUID": e.GUID,
+		"State":       e.State,
+	})
+}
@@ -0,0 +1,23 @@
+package translatableerror
+
+import "strings"
+
+// InvalidLabelSelectorError is returned when a label selector cannot be
+// parsed. Column is the position, in characters, of the offending token.
+type InvalidLabelSelectorError struct {
+	Selector string
+	Column   int
+	Reason   string
+}
+
+func (InvalidLabelSelectorError) Error() string {
+	return "Invalid label selector: {{.Reason}}\n   {{.Selector}}\n   {{.Marker}}"
+}
+
+func (e InvalidLabelSelectorError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Reason":   e.Reason,
+		"Selector": e.Selector,
+		"Marker":   strings.Repeat(" ", e.Column) + "^",
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type InvalidStagingTimeoutError struct {
+	AppName string
+	Timeout int
+}
+
+func (InvalidStagingTimeoutError) Error() string {
+	return "Invalid staging-timeout {{.Timeout}} for app {{.AppName}}: must be a positive number of minutes"
+}
+
+func (e InvalidStagingTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+		"Timeout": e.Timeout,
+	})
+}
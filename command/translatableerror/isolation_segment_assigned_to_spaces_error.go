@@ -0,0 +1,23 @@
+package translatableerror
+
+import "strings"
+
+// IsolationSegmentAssignedToSpacesError is returned when revoking an org's
+// entitlement to an isolation segment that some of its spaces still use.
+type IsolationSegmentAssignedToSpacesError struct {
+	IsolationSegmentName string
+	OrganizationName     string
+	SpaceNames           []string
+}
+
+func (IsolationSegmentAssignedToSpacesError) Error() string {
+	return "Cannot revoke org {{.OrgName}}'s entitlement to isolation segment {{.SegmentName}} while it is assigned to these spaces: {{.SpaceNames}}"
+}
+
+func (e IsolationSegmentAssignedToSpacesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"OrgName":     e.OrganizationName,
+		"SegmentName": e.IsolationSegmentName,
+		"SpaceNames":  strings.Join(e.SpaceNames, ", "),
+	})
+}
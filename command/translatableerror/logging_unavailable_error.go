@@ -0,0 +1,14 @@
+package translatableerror
+
+// LoggingUnavailableError is returned when the targeted platform does not
+// provide a logging endpoint.
+type LoggingUnavailableError struct {
+}
+
+func (LoggingUnavailableError) Error() string {
+	return "Logging is not available on this platform"
+}
+
+func (e LoggingUnavailableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// ManifestIncompleteError is returned when a manifest for several apps was
+// written without the apps that could not be retrieved.
+type ManifestIncompleteError struct {
+	AppNames []string
+}
+
+func (ManifestIncompleteError) Error() string {
+	return "The manifest was created without the following apps: {{.AppNames}}"
+}
+
+func (e ManifestIncompleteError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, ", "),
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// NoHTTPRouteError is returned when --wait-for-http is used with an app that
+// has no HTTP route to check.
+type NoHTTPRouteError struct {
+	AppName string
+}
+
+func (NoHTTPRouteError) Error() string {
+	return "App {{.AppName}} has no HTTP route. --wait-for-http cannot be used with apps that only have TCP routes or no routes."
+}
+
+func (e NoHTTPRouteError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
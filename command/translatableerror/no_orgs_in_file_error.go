@@ -0,0 +1,17 @@
+package translatableerror
+
+// NoOrgsInFileError is returned when a file that should list org names does
+// not name any.
+type NoOrgsInFileError struct {
+	Path string
+}
+
+func (NoOrgsInFileError) Error() string {
+	return "No org names found in {{.Path}}"
+}
+
+func (e NoOrgsInFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type NoReadyPackageError struct {
+	AppName string
+}
+
+func (NoReadyPackageError) Error() string {
+	return "App {{.AppName}} has no package ready to stage. Push the app before restaging it."
+}
+
+func (e NoReadyPackageError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
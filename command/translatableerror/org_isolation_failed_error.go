@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// OrgIsolationFailedError is returned when changing the isolation segment
+// entitlement of several orgs failed for some of them.
+type OrgIsolationFailedError struct {
+	OrgNames []string
+}
+
+func (OrgIsolationFailedError) Error() string {
+	return "Isolation segment entitlement could not be changed for orgs: {{.OrgNames}}"
+}
+
+func (e OrgIsolationFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"OrgNames": strings.Join(e.OrgNames, ", "),
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// OrganizationsNotFoundError is returned when some of the organizations named
+// for a bulk operation do not exist.
+type OrganizationsNotFoundError struct {
+	Names []string
+}
+
+func (OrganizationsNotFoundError) Error() string {
+	return "Organizations not found: {{.OrgNames}}. No changes were made."
+}
+
+func (e OrganizationsNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"OrgNames": strings.Join(e.Names, ", "),
+	})
+}
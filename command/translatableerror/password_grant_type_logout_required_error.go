@@ -0,0 +1,17 @@
+package translatableerror
+
+// PasswordGrantTypeLogoutRequiredError is returned when a user tries to
+// authenticate with a password while a service account is logged in.
+type PasswordGrantTypeLogoutRequiredError struct {
+	BinaryName string
+}
+
+func (PasswordGrantTypeLogoutRequiredError) Error() string {
+	return "Service account currently logged in. Use '{{.BinaryName}} logout' to log out service account and try again."
+}
+
+func (e PasswordGrantTypeLogoutRequiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BinaryName": e.BinaryName,
+	})
+}
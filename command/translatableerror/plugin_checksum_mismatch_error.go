@@ -0,0 +1,24 @@
+package translatableerror
+
+// PluginChecksumMismatchError is returned when a downloaded plugin binary
+// does not match the checksum in the repository metadata or the one passed
+// with --checksum.
+type PluginChecksumMismatchError struct {
+	Expected       string
+	Actual         string
+	FromRepository bool
+}
+
+func (e PluginChecksumMismatchError) Error() string {
+	if e.FromRepository {
+		return "Downloaded plugin binary's checksum does not match repo metadata.\nExpected: {{.Expected}}\nActual:   {{.Actual}}\nPlease try again or contact the plugin author."
+	}
+	return "Plugin binary's checksum does not match the provided checksum.\nExpected: {{.Expected}}\nActual:   {{.Actual}}"
+}
+
+func (e PluginChecksumMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Expected": e.Expected,
+		"Actual":   e.Actual,
+	})
+}
@@ -1,16 +1,23 @@
 package translatableerror
 
+import "strings"
+
 // ProcessNotFoundError is returned when a proccess type can't be found
 type ProcessNotFoundError struct {
-	ProcessType string
+	ProcessType       string
+	ValidProcessTypes []string
 }
 
-func (ProcessNotFoundError) Error() string {
+func (e ProcessNotFoundError) Error() string {
+	if len(e.ValidProcessTypes) > 0 {
+		return "Process {{.ProcessType}} not found. Valid process types are: {{.ValidProcessTypes}}"
+	}
 	return "Process {{.ProcessType}} not found"
 }
 
 func (e ProcessNotFoundError) Translate(translate func(string, ...interface{}) string) string {
 	return translate(e.Error(), map[string]interface{}{
-		"ProcessType": e.ProcessType,
+		"ProcessType":       e.ProcessType,
+		"ValidProcessTypes": strings.Join(e.ValidProcessTypes, ", "),
 	})
 }
@@ -0,0 +1,17 @@
+package translatableerror
+
+type RoutePortTakenError struct {
+	Port   int
+	Domain string
+}
+
+func (RoutePortTakenError) Error() string {
+	return "Port {{.Port}} is already in use on domain {{.Domain}}. Use --random-port to have a free port assigned instead."
+}
+
+func (e RoutePortTakenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Port":   e.Port,
+		"Domain": e.Domain,
+	})
+}
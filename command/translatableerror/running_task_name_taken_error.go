@@ -0,0 +1,19 @@
+package translatableerror
+
+// RunningTaskNameTakenError is returned when run-task is asked to fail
+// rather than reuse the name of a running task.
+type RunningTaskNameTakenError struct {
+	TaskName    string
+	SequenceIDs []int
+}
+
+func (RunningTaskNameTakenError) Error() string {
+	return "A task named {{.TaskName}} is already running, with task IDs: {{.SequenceIDs}}"
+}
+
+func (e RunningTaskNameTakenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskName":    e.TaskName,
+		"SequenceIDs": joinSequenceIDs(e.SequenceIDs),
+	})
+}
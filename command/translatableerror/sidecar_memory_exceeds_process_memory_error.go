@@ -0,0 +1,17 @@
+package translatableerror
+
+type SidecarMemoryExceedsProcessMemoryError struct {
+	AppName     string
+	SidecarName string
+}
+
+func (SidecarMemoryExceedsProcessMemoryError) Error() string {
+	return "Sidecar {{.SidecarName}} of app {{.AppName}} requests more memory than the app's processes are given"
+}
+
+func (e SidecarMemoryExceedsProcessMemoryError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":     e.AppName,
+		"SidecarName": e.SidecarName,
+	})
+}
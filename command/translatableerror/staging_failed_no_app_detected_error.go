@@ -1,19 +1,15 @@
 package translatableerror
 
-import "fmt"
-
 type StagingFailedNoAppDetectedError struct {
 	Message    string
 	BinaryName string
 }
 
 func (StagingFailedNoAppDetectedError) Error() string {
-	return "Error staging application: {{.Message}}\n\nTIP: Use '{{.BuildpackCommand}}' to see a list of supported buildpacks."
+	return StagingFailedError{}.Error() + "\n\n" + stagingFailureTips["NoAppDetectedError"]
 }
 
 func (e StagingFailedNoAppDetectedError) Translate(translate func(string, ...interface{}) string) string {
-	return translate(e.Error(), map[string]interface{}{
-		"Message":          e.Message,
-		"BuildpackCommand": fmt.Sprintf("%s buildpacks", e.BinaryName),
-	})
+	tip, tipValues, _ := StagingFailureTip("NoAppDetectedError", e.BinaryName, "")
+	return StagingFailedError{Message: e.Message}.Translate(translate) + "\n\n" + translate(tip, tipValues)
 }
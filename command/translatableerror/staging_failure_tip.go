@@ -0,0 +1,25 @@
+package translatableerror
+
+import "fmt"
+
+// stagingFailureTips maps the error codes the cloud controller reports for
+// failed stagings to a tip on how to resolve them.
+var stagingFailureTips = map[string]string{
+	"NoAppDetectedError":     "TIP: Use '{{.BuildpackCommand}}' to see a list of supported buildpacks.",
+	"BuildpackCompileFailed": "TIP: Use '{{.LogsCommand}}' to see the output of the buildpack's compile step.",
+}
+
+// StagingFailureTip returns the untranslated tip for a staging failure with
+// the given error code together with the values for its template. It returns
+// false when there is no tip for the code.
+func StagingFailureTip(code string, binaryName string, appName string) (string, map[string]interface{}, bool) {
+	tip, ok := stagingFailureTips[code]
+	if !ok {
+		return "", nil, false
+	}
+
+	return tip, map[string]interface{}{
+		"BuildpackCommand": fmt.Sprintf("%s buildpacks", binaryName),
+		"LogsCommand":      fmt.Sprintf("%s logs %s --recent", binaryName, appName),
+	}, true
+}
@@ -0,0 +1,32 @@
+package translatableerror
+
+import (
+	"strconv"
+	"strings"
+)
+
+// TaskNameNotUniqueError is returned when a task is referred to by a name
+// that several running tasks share.
+type TaskNameNotUniqueError struct {
+	TaskName    string
+	SequenceIDs []int
+}
+
+func (TaskNameNotUniqueError) Error() string {
+	return "Multiple running tasks are named {{.TaskName}}, with task IDs: {{.SequenceIDs}}\nTIP: Use the task ID to select one of them."
+}
+
+func (e TaskNameNotUniqueError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskName":    e.TaskName,
+		"SequenceIDs": joinSequenceIDs(e.SequenceIDs),
+	})
+}
+
+func joinSequenceIDs(sequenceIDs []int) string {
+	ids := make([]string, len(sequenceIDs))
+	for i, id := range sequenceIDs {
+		ids[i] = strconv.Itoa(id)
+	}
+	return strings.Join(ids, ", ")
+}
@@ -0,0 +1,14 @@
+package translatableerror
+
+// TokenNotJWTError is returned when the access token is opaque, so its
+// claims cannot be decoded locally.
+type TokenNotJWTError struct {
+}
+
+func (TokenNotJWTError) Error() string {
+	return "The access token is not a JWT and cannot be decoded. UAA is configured to issue opaque tokens."
+}
+
+func (e TokenNotJWTError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
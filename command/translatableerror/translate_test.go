@@ -38,6 +38,7 @@ var _ = Describe("Translatable Errors", func() {
 
 		Entry("AddPluginRepositoryError", AddPluginRepositoryError{}),
 		Entry("APINotFoundError", APINotFoundError{}),
+		Entry("BuildNotFoundError", BuildNotFoundError{}),
 		Entry("APIRequestError", APIRequestError{}),
 		Entry("ApplicationNotFoundError", ApplicationNotFoundError{}),
 		Entry("AppNotFoundInManifestError", AppNotFoundInManifestError{}),
@@ -45,22 +46,33 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("AssignDropletError", AssignDropletError{}),
 		Entry("BadCredentialsError", BadCredentialsError{}),
 		Entry("CFNetworkingEndpointNotFoundError", CFNetworkingEndpointNotFoundError{}),
+		Entry("CloudControllerUnavailableError", CloudControllerUnavailableError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
+		Entry("DeletionFailedError", DeletionFailedError{}),
 		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
+		Entry("DropletNotFoundError", DropletNotFoundError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
 		Entry("FetchingPluginInfoFromRepositoriesError", FetchingPluginInfoFromRepositoriesError{}),
 		Entry("FileChangedError", FileChangedError{}),
+		Entry("FeatureFlagDisabledError", FeatureFlagDisabledError{}),
 		Entry("FileNotFoundError", FileNotFoundError{}),
+		Entry("FoundationMismatchError", FoundationMismatchError{}),
 		Entry("GettingPluginRepositoryError", GettingPluginRepositoryError{}),
 		Entry("HealthCheckTypeUnsupportedError", HealthCheckTypeUnsupportedError{SupportedTypes: []string{"some-type", "another-type"}}),
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
+		Entry("IncompleteDownloadError", IncompleteDownloadError{}),
+		Entry("InvalidDropletStateError", InvalidDropletStateError{}),
+		Entry("InvalidCACertError", InvalidCACertError{}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
+		Entry("InvalidStagingTimeoutError", InvalidStagingTimeoutError{}),
+		Entry("IsolationSegmentAssignedToSpacesError", IsolationSegmentAssignedToSpacesError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
 		Entry("JobFailedError", JobFailedError{}),
 		Entry("JobTimeoutError", JobTimeoutError{}),
 		Entry("JSONSyntaxError", JSONSyntaxError{Err: errors.New("some-error")}),
 		Entry("LifecycleMinimumAPIVersionNotMetError", LifecycleMinimumAPIVersionNotMetError{}),
+		Entry("ManifestIncompleteError", ManifestIncompleteError{}),
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
 		Entry("NetworkPolicyProtocolOrPortNotProvidedError", NetworkPolicyProtocolOrPortNotProvidedError{}),
 		Entry("NoAPISetError", NoAPISetError{}),
@@ -68,14 +80,18 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("NoDomainsFoundError", NoDomainsFoundError{}),
 		Entry("NoMatchingDomainError", NoMatchingDomainError{}),
 		Entry("NoOrganizationTargetedError", NoOrganizationTargetedError{}),
+		Entry("NoOrgsInFileError", NoOrgsInFileError{}),
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
 		Entry("NoSpaceTargetedError", NoSpaceTargetedError{}),
 		Entry("NotLoggedInError", NotLoggedInError{}),
+		Entry("OrgIsolationFailedError", OrgIsolationFailedError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
+		Entry("OrganizationsNotFoundError", OrganizationsNotFoundError{}),
 		Entry("ParseArgumentError", ParseArgumentError{}),
 		Entry("PluginAlreadyInstalledError", PluginAlreadyInstalledError{}),
 		Entry("PluginBinaryRemoveFailedError", PluginBinaryRemoveFailedError{}),
 		Entry("PluginBinaryUninstallError", PluginBinaryUninstallError{}),
+		Entry("PluginChecksumMismatchError", PluginChecksumMismatchError{}),
 		Entry("PluginCommandsConflictError", PluginCommandsConflictError{}),
 		Entry("PluginInvalidError", PluginInvalidError{Err: errors.New("invalid error")}),
 		Entry("PluginInvalidError", PluginInvalidError{}),
@@ -83,14 +99,17 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("PluginNotFoundInRepositoryError", PluginNotFoundInRepositoryError{}),
 		Entry("PluginNotFoundOnDiskOrInAnyRepositoryError", PluginNotFoundOnDiskOrInAnyRepositoryError{}),
 		Entry("PortNotAllowedWithHTTPDomainError", PortNotAllowedWithHTTPDomainError{}),
+		Entry("PropertyCombinationError", PropertyCombinationError{}),
 		Entry("RepositoryNameTakenError", RepositoryNameTakenError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
 		Entry("RequiredFlagsError", RequiredFlagsError{}),
 		Entry("RequiredNameForPushError", RequiredNameForPushError{}),
 		Entry("RouteInDifferentSpaceError", RouteInDifferentSpaceError{}),
 		Entry("RunTaskError", RunTaskError{}),
+		Entry("RunningTaskNameTakenError", RunningTaskNameTakenError{}),
 		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}),
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
+		Entry("SidecarMemoryExceedsProcessMemoryError", SidecarMemoryExceedsProcessMemoryError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
 		Entry("SSLCertError", SSLCertError{}),
 		Entry("StackNotFoundError with name", SpaceNotFoundError{Name: "steve"}),
@@ -99,8 +118,10 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("StagingFailedNoAppDetectedError", StagingFailedNoAppDetectedError{}),
 		Entry("StagingTimeoutError", StagingTimeoutError{}),
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
+		Entry("TaskNameNotUniqueError", TaskNameNotUniqueError{SequenceIDs: []int{3, 5}}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
+		Entry("UnshareServiceBoundAppsError", UnshareServiceBoundAppsError{}),
 		Entry("UnsupportedURLSchemeError", UnsupportedURLSchemeError{}),
 		Entry("UploadFailedError", UploadFailedError{Err: JobFailedError{}}),
 		Entry("V3APIDoesNotExistError", V3APIDoesNotExistError{}),
@@ -121,4 +142,24 @@ var _ = Describe("Translatable Errors", func() {
 			})
 		})
 	})
+
+	Describe("StagingFailedNoAppDetectedError", func() {
+		It("adds the tip for the NoAppDetectedError staging error code", func() {
+			err := StagingFailedNoAppDetectedError{Message: "some-message", BinaryName: "faceman"}
+			Expect(err.Translate(translateFunc)).To(Equal("Error staging application: some-message\n\nTIP: Use 'faceman buildpacks' to see a list of supported buildpacks."))
+		})
+	})
+
+	Describe("StagingFailureTip", func() {
+		It("returns the tip for the BuildpackCompileFailed staging error code", func() {
+			tip, values, ok := StagingFailureTip("BuildpackCompileFailed", "faceman", "some-app")
+			Expect(ok).To(BeTrue())
+			Expect(translateFunc(tip, values)).To(Equal("TIP: Use 'faceman logs some-app --recent' to see the output of the buildpack's compile step."))
+		})
+
+		It("returns false for error codes without a tip", func() {
+			_, _, ok := StagingFailureTip("StagingError", "faceman", "some-app")
+			Expect(ok).To(BeFalse())
+		})
+	})
 })
@@ -0,0 +1,17 @@
+package translatableerror
+
+// UnshareServiceBoundAppsError is returned when unsharing a service instance
+// from a space whose apps are still bound to it, without forcing the unshare.
+type UnshareServiceBoundAppsError struct {
+	ServiceInstanceName string
+}
+
+func (UnshareServiceBoundAppsError) Error() string {
+	return "Service instance {{.ServiceInstanceName}} was not unshared. Use -f to unshare it and delete the bindings."
+}
+
+func (e UnshareServiceBoundAppsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ServiceInstanceName": e.ServiceInstanceName,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type UnsupportedConfigBundleVersionError struct {
+	Version          int
+	SupportedVersion int
+}
+
+func (UnsupportedConfigBundleVersionError) Error() string {
+	return "Config bundle version {{.Version}} is not supported. This version of the CLI reads bundles up to version {{.SupportedVersion}}."
+}
+
+func (e UnsupportedConfigBundleVersionError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Version":          e.Version,
+		"SupportedVersion": e.SupportedVersion,
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 // UI is the interface to STDOUT
 type UI interface {
+	AnimationsEnabled() bool
 	DisplayBoolPrompt(defaultResponse bool, template string, templateValues ...map[string]interface{}) (bool, error)
 	DisplayChangesForPush(changeSet []ui.Change) error
 	DisplayError(err error)
@@ -18,11 +19,14 @@ type UI interface {
 	DisplayKeyValueTableForApp(table [][]string)
 	DisplayKeyValueTableForV3App(table [][]string, crashedProcesses []string)
 	DisplayLogMessage(message ui.LogMessage, displayHeader bool)
+	DisplayLogMessageWithTimestampFormat(message ui.LogMessage, timestampFormat string)
 	DisplayNewline()
 	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
 	DisplayOK()
+	DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error)
 	DisplayTableWithHeader(prefix string, table [][]string, padding int)
 	DisplayText(template string, data ...map[string]interface{})
+	DisplayTextPrompt(defaultResponse string, template string, templateValues ...map[string]interface{}) (string, error)
 	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
 	DisplayTextWithBold(text string, keys ...map[string]interface{})
 	DisplayWarning(formattedString string, keys ...map[string]interface{})
@@ -30,6 +34,7 @@ type UI interface {
 	RequestLoggerFileWriter(filePaths []string) *ui.RequestLoggerFileWriter
 	RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay
 	TranslateText(template string, data ...map[string]interface{}) string
+	Reader() io.Reader
 	UserFriendlyDate(input time.Time) string
 	Writer() io.Writer
 }
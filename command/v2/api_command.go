@@ -7,7 +7,9 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/cacert"
 )
 
 //go:generate counterfeiter . APIActor
@@ -18,11 +20,13 @@ type APIActor interface {
 }
 
 type ApiCommand struct {
-	OptionalArgs      flag.APITarget `positional-args:"yes"`
-	SkipSSLValidation bool           `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
-	Unset             bool           `long:"unset" description:"Remove all api endpoint targeting"`
-	usage             interface{}    `usage:"CF_NAME api [URL]"`
-	relatedCommands   interface{}    `related_commands:"auth, login, target"`
+	OptionalArgs      flag.APITarget              `positional-args:"yes"`
+	CACert            flag.PathWithExistenceCheck `long:"ca-cert" description:"Path to a PEM file with the CA certificate(s) to trust for this API endpoint, in addition to the system's"`
+	SkipSSLValidation bool                        `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
+	Unset             bool                        `long:"unset" description:"Remove all api endpoint targeting"`
+	usage             interface{}                 `usage:"CF_NAME api [URL] [--ca-cert CA_CERT_PATH]"`
+	envCFCACert       interface{}                 `environmentName:"CF_CA_CERT" environmentDescription:"Path to a PEM file with CA certificate(s) to trust instead of the ones set with --ca-cert"`
+	relatedCommands   interface{}                 `related_commands:"auth, login, target"`
 
 	UI     command.UI
 	Actor  APIActor
@@ -60,10 +64,14 @@ func (cmd *ApiCommand) Execute(args []string) error {
 		return nil
 	}
 
-	cmd.UI.DisplayKeyValueTable("", [][]string{
+	table := [][]string{
 		{cmd.UI.TranslateText("api endpoint:"), cmd.Config.Target()},
 		{cmd.UI.TranslateText("api version:"), cmd.Config.APIVersion()},
-	}, 3)
+	}
+	if caCert := cmd.caCertDescription(); caCert != "" {
+		table = append(table, []string{cmd.UI.TranslateText("ca certificate:"), caCert})
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
 
 	user, err := cmd.Config.CurrentUser()
 	if user.Name == "" {
@@ -88,7 +96,13 @@ func (cmd *ApiCommand) setAPI() error {
 
 	apiURL := processURL(cmd.OptionalArgs.URL)
 
-	_, err := cmd.Actor.SetTarget(cmd.Config, v2action.TargetSettings{
+	caCert, err := cmd.caCert()
+	if err != nil {
+		return err
+	}
+
+	_, err = cmd.Actor.SetTarget(cmd.Config, v2action.TargetSettings{
+		CACert:            caCert,
 		URL:               apiURL,
 		SkipSSLValidation: cmd.SkipSSLValidation,
 		DialTimeout:       cmd.Config.DialTimeout(),
@@ -106,6 +120,38 @@ func (cmd *ApiCommand) setAPI() error {
 	return nil
 }
 
+// caCert returns the CA certificate to trust for the new target: the one given
+// with --ca-cert, falling back to $CF_CA_CERT.
+func (cmd *ApiCommand) caCert() ([]byte, error) {
+	if cmd.CACert == "" {
+		return cmd.Config.CACertOverride(), nil
+	}
+
+	caCert, err := cacert.Load(string(cmd.CACert))
+	if err != nil {
+		if _, ok := err.(cacert.InvalidCACertError); ok {
+			return nil, translatableerror.InvalidCACertError{Path: string(cmd.CACert)}
+		}
+		return nil, err
+	}
+	return caCert, nil
+}
+
+// caCertDescription describes the custom CA certificate trusted for the
+// current target, if any.
+func (cmd *ApiCommand) caCertDescription() string {
+	switch {
+	case cmd.Config.CACertOverride() != nil:
+		return cmd.UI.TranslateText("custom (from {{.EnvVar}})", map[string]interface{}{
+			"EnvVar": "CF_CA_CERT",
+		})
+	case len(cmd.Config.CACert()) > 0:
+		return cmd.UI.TranslateText("custom")
+	default:
+		return ""
+	}
+}
+
 func processURL(apiURL string) string {
 	if !strings.HasPrefix(apiURL, "http") {
 		return fmt.Sprintf("https://%s", apiURL)
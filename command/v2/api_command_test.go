@@ -1,14 +1,20 @@
 package v2_test
 
 import (
+	"encoding/pem"
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
+	testnet "code.cloudfoundry.org/cli/util/testhelpers/net"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -69,6 +75,31 @@ var _ = Describe("api Command", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(testUI.Out).To(Say("api endpoint:\\s+some-api-target"))
 				Expect(testUI.Out).To(Say("api version:\\s+some-version"))
+				Expect(testUI.Out).ToNot(Say("ca certificate:"))
+			})
+
+			Context("when a custom CA cert is stored for the target", func() {
+				BeforeEach(func() {
+					fakeConfig.CACertReturns([]byte("some-ca-cert"))
+				})
+
+				It("indicates that a custom CA is in use", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("api version:\\s+some-version"))
+					Expect(testUI.Out).To(Say("ca certificate:\\s+custom"))
+				})
+			})
+
+			Context("when $CF_CA_CERT is set", func() {
+				BeforeEach(func() {
+					fakeConfig.CACertReturns([]byte("some-ca-cert"))
+					fakeConfig.CACertOverrideReturns([]byte("some-ca-cert"))
+				})
+
+				It("indicates that the custom CA comes from the environment", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("ca certificate:\\s+custom \\(from CF_CA_CERT\\)"))
+				})
 			})
 		})
 
@@ -119,6 +150,68 @@ api version:    some-version`,
 					})
 				})
 
+				Context("when --ca-cert is passed", func() {
+					var tmpDir string
+
+					BeforeEach(func() {
+						var tmpErr error
+						tmpDir, tmpErr = ioutil.TempDir("", "api-command")
+						Expect(tmpErr).ToNot(HaveOccurred())
+					})
+
+					AfterEach(func() {
+						Expect(os.RemoveAll(tmpDir)).To(Succeed())
+					})
+
+					Context("when the file contains a PEM encoded certificate", func() {
+						var caCert []byte
+
+						BeforeEach(func() {
+							caCert = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: testnet.MakeSelfSignedTLSCert().Certificate[0]})
+							path := filepath.Join(tmpDir, "ca.pem")
+							Expect(ioutil.WriteFile(path, caCert, 0600)).To(Succeed())
+							cmd.CACert = flag.PathWithExistenceCheck(path)
+						})
+
+						It("sets the target with the CA cert", func() {
+							Expect(err).ToNot(HaveOccurred())
+
+							Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+							_, settings := fakeActor.SetTargetArgsForCall(0)
+							Expect(settings.CACert).To(Equal(caCert))
+						})
+					})
+
+					Context("when the file does not contain a PEM encoded certificate", func() {
+						var path string
+
+						BeforeEach(func() {
+							path = filepath.Join(tmpDir, "ca.pem")
+							Expect(ioutil.WriteFile(path, []byte("not a cert"), 0600)).To(Succeed())
+							cmd.CACert = flag.PathWithExistenceCheck(path)
+						})
+
+						It("returns an InvalidCACertError", func() {
+							Expect(err).To(MatchError(translatableerror.InvalidCACertError{Path: path}))
+							Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+						})
+					})
+				})
+
+				Context("when --ca-cert is not passed and $CF_CA_CERT is set", func() {
+					BeforeEach(func() {
+						fakeConfig.CACertOverrideReturns([]byte("env-ca-cert"))
+					})
+
+					It("sets the target with the CA cert from the environment", func() {
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+						_, settings := fakeActor.SetTargetArgsForCall(0)
+						Expect(settings.CACert).To(Equal([]byte("env-ca-cert")))
+					})
+				})
+
 				Context("when the url has unverified SSL", func() {
 					Context("when --skip-ssl-validation is passed", func() {
 						BeforeEach(func() {
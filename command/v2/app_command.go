@@ -1,30 +1,62 @@
 package v2
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"github.com/cloudfoundry/bytefmt"
 )
 
 //go:generate counterfeiter . AppActor
 
 type AppActor interface {
 	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetApplicationInstanceStatsByApplication(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error)
+	GetApplicationLastUploadEvent(appGUID string) (v2action.Event, v2action.Warnings, error)
 	GetApplicationSummaryByNameAndSpace(name string, spaceGUID string) (v2action.ApplicationSummary, v2action.Warnings, error)
 }
 
+//go:generate counterfeiter . AppActorV3
+
+type AppActorV3 interface {
+	CloudControllerAPIVersion() string
+	GetApplicationSidecars(appGUID string) ([]v3action.Sidecar, v3action.Warnings, error)
+}
+
+// DefaultWatchInterval is the time between polls of cf app --watch.
+const DefaultWatchInterval = 5 * time.Second
+
 type AppCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	GUID            bool         `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
-	usage           interface{}  `usage:"CF_NAME app APP_NAME"`
-	relatedCommands interface{}  `related_commands:"apps, events, logs, map-route, unmap-route, push"`
+	RequiredArgs    flag.AppName  `positional-args:"yes"`
+	GUID            bool          `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
+	JSON            bool          `long:"json" description:"Output the app details, including the GUID of its last upload event, as JSON"`
+	Watch           bool          `long:"watch" description:"Keep polling the app's instances and display their state changes until interrupted with Ctrl-C"`
+	Interval        flag.Duration `long:"interval" description:"Time between polls with --watch, e.g. 10s (Default: 5s)"`
+	usage           interface{}   `usage:"CF_NAME app APP_NAME [--json]\n   CF_NAME app APP_NAME --watch [--interval INTERVAL]"`
+	relatedCommands interface{}   `related_commands:"apps, events, logs, map-route, unmap-route, push"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       AppActor
+	ActorV3     AppActorV3
+
+	// Interrupt stops --watch when it receives a signal.
+	Interrupt <-chan os.Signal
 }
 
 func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
@@ -38,19 +70,52 @@ func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
 	}
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
 
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
+	if cmd.Watch {
+		interrupt := make(chan os.Signal, 1)
+		signal.Notify(interrupt, os.Interrupt)
+		cmd.Interrupt = interrupt
+	}
+
 	return nil
 }
 
 func (cmd AppCommand) Execute(args []string) error {
+	if cmd.Watch && cmd.GUID {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--watch", "--guid"}}
+	}
+	if cmd.Watch && cmd.JSON {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--watch", "--json"}}
+	}
+	if cmd.Interval.IsSet && !cmd.Watch {
+		return translatableerror.RequiredFlagsError{Arg1: "--interval", Arg2: "--watch"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
 	}
 
+	if cmd.Watch {
+		return cmd.watchAppInstances()
+	}
+
 	if cmd.GUID {
 		return cmd.displayAppGUID()
 	}
 
+	if cmd.JSON {
+		return cmd.displayAppJSON()
+	}
+
 	return cmd.displayAppSummary()
 }
 
@@ -87,7 +152,219 @@ func (cmd AppCommand) displayAppSummary() error {
 		return shared.HandleError(err)
 	}
 
+	lastUpload, found, err := cmd.getLastUploadEvent(appSummary.GUID)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	appSummary.LastUploadedBy = cmd.UI.TranslateText("unknown")
+	if found {
+		appSummary.LastUploadedBy = uploaderName(lastUpload)
+	}
+
 	shared.DisplayAppSummary(cmd.UI, appSummary, false)
 
+	sidecars, err := cmd.getSidecars(appSummary.GUID)
+	if err != nil {
+		return err
+	}
+	if len(sidecars) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.displaySidecars(sidecars)
+	}
+
 	return nil
 }
+
+// watchAppInstances polls the instance stats of the app until interrupted,
+// reusing the same session for every poll.
+func (cmd AppCommand) watchAppInstances() error {
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	interval := DefaultWatchInterval
+	if cmd.Interval.IsSet {
+		interval = cmd.Interval.Duration
+	}
+
+	watcher := &appInstanceWatcher{
+		UI:        cmd.UI,
+		IsTTY:     cmd.Config.IsTTY(),
+		Interval:  interval,
+		AppName:   app.Name,
+		OrgName:   cmd.Config.TargetedOrganization().Name,
+		SpaceName: cmd.Config.TargetedSpace().Name,
+		Username:  user.Name,
+	}
+
+	for {
+		instances, warnings, err := cmd.Actor.GetApplicationInstanceStatsByApplication(app.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if _, ok := err.(v2action.ApplicationInstancesNotFoundError); ok {
+			instances, err = nil, nil
+		}
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		watcher.Update(instances, time.Now())
+
+		select {
+		case <-cmd.Interrupt:
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (cmd AppCommand) displaySidecars(sidecars []v3action.Sidecar) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("sidecar"),
+			cmd.UI.TranslateText("process types"),
+			cmd.UI.TranslateText("command"),
+			cmd.UI.TranslateText("memory"),
+		},
+	}
+
+	for _, sidecar := range sidecars {
+		var memory string
+		if sidecar.MemoryInMB.IsSet {
+			memory = bytefmt.ByteSize(sidecar.MemoryInMB.Value * bytefmt.MEGABYTE)
+		}
+		table = append(table, []string{
+			sidecar.Name,
+			strings.Join(sidecar.ProcessTypes, ", "),
+			sidecar.Command,
+			memory,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
+
+func (cmd AppCommand) displayAppJSON() error {
+	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	lastUpload, found, err := cmd.getLastUploadEvent(appSummary.GUID)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	routes := []string{}
+	for _, route := range appSummary.Routes {
+		routes = append(routes, route.String())
+	}
+
+	sidecars, err := cmd.getSidecars(appSummary.GUID)
+	if err != nil {
+		return err
+	}
+
+	sidecarsJSON := []map[string]interface{}{}
+	for _, sidecar := range sidecars {
+		sidecarJSON := map[string]interface{}{
+			"name":          sidecar.Name,
+			"process_types": sidecar.ProcessTypes,
+			"command":       sidecar.Command,
+			"memory_in_mb":  nil,
+		}
+		if sidecar.MemoryInMB.IsSet {
+			sidecarJSON["memory_in_mb"] = sidecar.MemoryInMB.Value
+		}
+		sidecarsJSON = append(sidecarsJSON, sidecarJSON)
+	}
+
+	appJSON := map[string]interface{}{
+		"guid":                   appSummary.GUID,
+		"name":                   appSummary.Name,
+		"requested_state":        strings.ToLower(string(appSummary.State)),
+		"instances":              appSummary.Instances.Value,
+		"running_instances":      appSummary.StartingOrRunningInstanceCount(),
+		"memory_in_mb":           appSummary.Memory,
+		"routes":                 routes,
+		"sidecars":               sidecarsJSON,
+		"last_uploaded":          nil,
+		"last_uploaded_by":       nil,
+		"last_upload_event_guid": nil,
+		"stack":                  appSummary.Stack.Name,
+		"buildpack":              nil,
+		"docker_image":           nil,
+	}
+	if !appSummary.PackageUpdatedAt.IsZero() {
+		appJSON["last_uploaded"] = appSummary.PackageUpdatedAt.UTC().Format(time.RFC3339)
+	}
+	if found {
+		appJSON["last_uploaded_by"] = uploaderName(lastUpload)
+		appJSON["last_upload_event_guid"] = lastUpload.GUID
+	}
+	if appSummary.DockerImage == "" {
+		appJSON["buildpack"] = appSummary.Application.CalculatedBuildpack()
+	} else {
+		appJSON["docker_image"] = appSummary.DockerImage
+	}
+
+	output, err := json.MarshalIndent(appJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
+
+// getSidecars returns the sidecars of the app, or none when the targeted API
+// does not support sidecars.
+func (cmd AppCommand) getSidecars(appGUID string) ([]v3action.Sidecar, error) {
+	if cmd.ActorV3 == nil {
+		return nil, nil
+	}
+	if command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionSidecarsV3) != nil {
+		return nil, nil
+	}
+
+	sidecars, warnings, err := cmd.ActorV3.GetApplicationSidecars(appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return nil, sharedV3.HandleError(err)
+	}
+	return sidecars, nil
+}
+
+// getLastUploadEvent returns the last upload event of the app. found is false
+// when the event is not available, for instance because events were pruned
+// or the user is not allowed to read them.
+func (cmd AppCommand) getLastUploadEvent(appGUID string) (v2action.Event, bool, error) {
+	event, warnings, err := cmd.Actor.GetApplicationLastUploadEvent(appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+
+	switch err.(type) {
+	case nil:
+		return event, true, nil
+	case v2action.ApplicationUploadEventNotFoundError, ccerror.ForbiddenError:
+		return v2action.Event{}, false, nil
+	default:
+		return v2action.Event{}, false, err
+	}
+}
+
+// uploaderName returns the name of the actor of an upload event, falling back
+// to its GUID for actors without a name.
+func uploaderName(event v2action.Event) string {
+	if event.ActorName != "" {
+		return event.ActorName
+	}
+	return event.ActorGUID
+}
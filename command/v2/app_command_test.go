@@ -2,13 +2,18 @@ package v2_test
 
 import (
 	"errors"
+	"os"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
@@ -55,6 +60,39 @@ var _ = Describe("App Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when --watch is provided with --guid", func() {
+		BeforeEach(func() {
+			cmd.Watch = true
+			cmd.GUID = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--watch", "--guid"}}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --watch is provided with --json", func() {
+		BeforeEach(func() {
+			cmd.Watch = true
+			cmd.JSON = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--watch", "--json"}}))
+		})
+	})
+
+	Context("when --interval is provided without --watch", func() {
+		BeforeEach(func() {
+			cmd.Interval = flag.Duration{Duration: time.Second, IsSet: true}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--interval", Arg2: "--watch"}))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
@@ -102,6 +140,125 @@ var _ = Describe("App Command", func() {
 			Expect(testUI.Out).To(Say("Showing health and status for app some-app in org some-org / space some-space as some-user..."))
 		})
 
+		Context("when the --watch flag is provided", func() {
+			var (
+				interrupt chan os.Signal
+				polls     [][]v2action.ApplicationInstanceWithStats
+			)
+
+			BeforeEach(func() {
+				interrupt = make(chan os.Signal, 1)
+				cmd.Watch = true
+				cmd.Interval = flag.Duration{Duration: time.Millisecond, IsSet: true}
+				cmd.Interrupt = interrupt
+
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v2action.Application{Name: "some-app", GUID: "some-app-guid"},
+					v2action.Warnings{"app-warning"},
+					nil)
+
+				running := v2action.ApplicationInstanceWithStats{ID: 1, State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning)}
+				crashed := v2action.ApplicationInstanceWithStats{ID: 1, State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceCrashed)}
+				other := v2action.ApplicationInstanceWithStats{ID: 0, State: v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning)}
+				polls = [][]v2action.ApplicationInstanceWithStats{
+					{other, running},
+					{other, crashed},
+					{other, crashed},
+				}
+
+				fakeActor.GetApplicationInstanceStatsByApplicationStub = func(string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error) {
+					call := fakeActor.GetApplicationInstanceStatsByApplicationCallCount() - 1
+					if call == len(polls)-1 {
+						interrupt <- os.Interrupt
+					}
+					return polls[call], v2action.Warnings{"stats-warning"}, nil
+				}
+			})
+
+			It("polls only the instance stats until interrupted and exits cleanly", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeActor.GetApplicationInstanceStatsByApplicationCallCount()).To(Equal(3))
+				Expect(fakeActor.GetApplicationInstanceStatsByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+
+				Expect(testUI.Err).To(Say("app-warning"))
+				Expect(testUI.Err).To(Say("stats-warning"))
+			})
+
+			Context("when the output is not a TTY", func() {
+				It("displays the instance table once and then appends state changes", func() {
+					Expect(testUI.Out).To(Say(`Watching instances of app some-app in org some-org / space some-space as some-user\.\.\.`))
+					Expect(testUI.Out).To(Say(`state\s+since\s+cpu\s+memory\s+disk\s+last change`))
+					Expect(testUI.Out).To(Say(`#0\s+running`))
+					Expect(testUI.Out).To(Say(`#1\s+running`))
+					Expect(testUI.Out).To(Say(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z\s+#1\s+running -> crashed\n`))
+					Expect(testUI.Out).ToNot(Say("running -> crashed"))
+					Expect(testUI.Out).ToNot(Say("Watching instances"))
+				})
+			})
+
+			Context("when the output is a TTY", func() {
+				BeforeEach(func() {
+					fakeConfig.IsTTYReturns(true)
+				})
+
+				It("redraws the instance table in place with the last state change", func() {
+					Expect(testUI.Out).To(Say(`\x1b\[H\x1b\[2J`))
+					Expect(testUI.Out).To(Say(`Updated \S+, polling every 1ms\. Press Ctrl-C to exit\.`))
+					Expect(testUI.Out).To(Say(`#1\s+running`))
+					Expect(testUI.Out).To(Say(`\x1b\[H\x1b\[2J`))
+					Expect(testUI.Out).To(Say(`#1\s+crashed\s+.*running -> crashed at \S+Z`))
+					Expect(testUI.Out).To(Say(`\x1b\[H\x1b\[2J`))
+					Expect(testUI.Out).To(Say(`#1\s+crashed\s+.*running -> crashed at \S+Z`))
+				})
+			})
+
+			Context("when the app has no running instances", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationInstanceStatsByApplicationStub = func(string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error) {
+						interrupt <- os.Interrupt
+						return nil, nil, v2action.ApplicationInstancesNotFoundError{ApplicationGUID: "some-app-guid"}
+					}
+				})
+
+				It("keeps watching and says so", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("There are no running instances of this app."))
+				})
+			})
+
+			Context("when getting the instance stats fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("stats are broken")
+					fakeActor.GetApplicationInstanceStatsByApplicationStub = nil
+					fakeActor.GetApplicationInstanceStatsByApplicationReturns(nil, nil, expectedErr)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+				})
+			})
+
+			Context("when the app does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"})
+				})
+
+				It("returns an ApplicationNotFoundError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+					Expect(fakeActor.GetApplicationInstanceStatsByApplicationCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when the --guid flag is provided", func() {
 			BeforeEach(func() {
 				cmd.GUID = true
@@ -236,6 +393,267 @@ var _ = Describe("App Command", func() {
 						})
 					})
 
+					Context("when the app has never been staged", func() {
+						BeforeEach(func() {
+							applicationSummary.State = "STOPPED"
+							applicationSummary.PackageState = "PENDING"
+							applicationSummary.PackageUpdatedAt = time.Time{}
+							fakeActor.GetApplicationSummaryByNameAndSpaceReturns(applicationSummary, warnings, nil)
+						})
+
+						It("displays that the app has no droplet", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("name:\\s+some-app"))
+							Expect(testUI.Out).To(Say("requested state:\\s+stopped"))
+							Expect(testUI.Out).To(Say("last uploaded:\\s+never"))
+							Expect(testUI.Out).To(Say("This app has no droplet because it has never been staged. Push the app to upload its bits and stage it."))
+							Expect(testUI.Out).NotTo(Say("There are no running instances of this app"))
+						})
+
+						Context("when the --json flag is provided", func() {
+							BeforeEach(func() {
+								cmd.JSON = true
+							})
+
+							It("outputs null for the last upload", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say(`"last_uploaded": null`))
+							})
+						})
+					})
+
+					Context("when the app has a route on an internal domain", func() {
+						BeforeEach(func() {
+							applicationSummary.Routes = append(applicationSummary.Routes, v2action.Route{
+								Host: "backend",
+								Domain: v2action.Domain{
+									Name:     "apps.internal",
+									Internal: true,
+								},
+							})
+							fakeActor.GetApplicationSummaryByNameAndSpaceReturns(applicationSummary, warnings, nil)
+						})
+
+						It("annotates the internal route", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say("routes:\\s+banana.fruit.com/hi, foobar.com:13, backend.apps.internal \\(internal\\)"))
+						})
+					})
+
+					Context("when looking up the last upload event", func() {
+						BeforeEach(func() {
+							fakeActor.GetApplicationSummaryByNameAndSpaceReturns(applicationSummary, warnings, nil)
+						})
+
+						Context("when the event is found", func() {
+							BeforeEach(func() {
+								fakeActor.GetApplicationLastUploadEventReturns(
+									v2action.Event{GUID: "some-event-guid", ActorGUID: "some-user-guid", ActorName: "some-user"},
+									v2action.Warnings{"event-warning"},
+									nil)
+							})
+
+							It("displays who last uploaded the app and all warnings", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(fakeActor.GetApplicationLastUploadEventCallCount()).To(Equal(1))
+								Expect(fakeActor.GetApplicationLastUploadEventArgsForCall(0)).To(Equal("some-app-guid"))
+
+								Expect(testUI.Out).To(Say("last uploaded:"))
+								Expect(testUI.Out).To(Say("last uploaded by:\\s+some-user"))
+								Expect(testUI.Out).To(Say("stack:\\s+potatos"))
+								Expect(testUI.Err).To(Say("event-warning"))
+							})
+
+							Context("when the actor of the event has no name", func() {
+								BeforeEach(func() {
+									fakeActor.GetApplicationLastUploadEventReturns(
+										v2action.Event{GUID: "some-event-guid", ActorGUID: "some-user-guid"},
+										nil,
+										nil)
+								})
+
+								It("displays the GUID of the actor", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+									Expect(testUI.Out).To(Say("last uploaded by:\\s+some-user-guid"))
+								})
+							})
+						})
+
+						Context("when the event has been pruned", func() {
+							BeforeEach(func() {
+								fakeActor.GetApplicationLastUploadEventReturns(
+									v2action.Event{},
+									v2action.Warnings{"event-warning"},
+									v2action.ApplicationUploadEventNotFoundError{ApplicationGUID: "some-app-guid"})
+							})
+
+							It("displays unknown", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say("last uploaded by:\\s+unknown"))
+								Expect(testUI.Err).To(Say("event-warning"))
+							})
+						})
+
+						Context("when the user cannot read events", func() {
+							BeforeEach(func() {
+								fakeActor.GetApplicationLastUploadEventReturns(v2action.Event{}, nil, ccerror.ForbiddenError{})
+							})
+
+							It("displays unknown", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say("last uploaded by:\\s+unknown"))
+							})
+						})
+
+						Context("when getting the event returns another error", func() {
+							var expectedErr error
+
+							BeforeEach(func() {
+								expectedErr = errors.New("events are broken")
+								fakeActor.GetApplicationLastUploadEventReturns(v2action.Event{}, v2action.Warnings{"event-warning"}, expectedErr)
+							})
+
+							It("returns the error and all warnings", func() {
+								Expect(executeErr).To(MatchError(expectedErr))
+								Expect(testUI.Err).To(Say("event-warning"))
+							})
+						})
+					})
+
+					Context("when the app has sidecars", func() {
+						var fakeActorV3 *v2fakes.FakeAppActorV3
+
+						BeforeEach(func() {
+							applicationSummary.RunningInstances = []v2action.ApplicationInstanceWithStats{}
+							fakeActor.GetApplicationSummaryByNameAndSpaceReturns(applicationSummary, warnings, nil)
+
+							fakeActorV3 = new(v2fakes.FakeAppActorV3)
+							fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionSidecarsV3)
+							fakeActorV3.GetApplicationSidecarsReturns(
+								[]v3action.Sidecar{
+									{
+										Name:         "auth-proxy",
+										Command:      "./proxy",
+										ProcessTypes: []string{"web", "worker"},
+										MemoryInMB:   types.NullUint64{IsSet: true, Value: 64},
+									},
+									{
+										Name:         "log-shipper",
+										Command:      "./ship",
+										ProcessTypes: []string{"web"},
+									},
+								},
+								v3action.Warnings{"sidecar-warning"},
+								nil)
+							cmd.ActorV3 = fakeActorV3
+						})
+
+						It("displays the sidecars with their memory", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say("There are no running instances of this app."))
+							Expect(testUI.Out).To(Say(`sidecar\s+process types\s+command\s+memory`))
+							Expect(testUI.Out).To(Say(`auth-proxy\s+web, worker\s+\./proxy\s+64M`))
+							Expect(testUI.Out).To(Say(`log-shipper\s+web\s+\./ship`))
+							Expect(testUI.Err).To(Say("sidecar-warning"))
+
+							Expect(fakeActorV3.GetApplicationSidecarsCallCount()).To(Equal(1))
+							Expect(fakeActorV3.GetApplicationSidecarsArgsForCall(0)).To(Equal("some-app-guid"))
+						})
+
+						Context("when the --json flag is provided", func() {
+							BeforeEach(func() {
+								cmd.JSON = true
+							})
+
+							It("includes the sidecars", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say(`"sidecars": \[`))
+								Expect(testUI.Out).To(Say(`"command": "\./proxy"`))
+								Expect(testUI.Out).To(Say(`"memory_in_mb": 64`))
+								Expect(testUI.Out).To(Say(`"name": "auth-proxy"`))
+								Expect(testUI.Out).To(Say(`"process_types": \[\s+"web",\s+"worker"\s+\]`))
+								Expect(testUI.Out).To(Say(`"memory_in_mb": null`))
+								Expect(testUI.Out).To(Say(`"name": "log-shipper"`))
+							})
+						})
+
+						Context("when the API does not support sidecars", func() {
+							BeforeEach(func() {
+								fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionApplyManifestV3)
+							})
+
+							It("does not look up or display sidecars", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).ToNot(Say("process types"))
+								Expect(fakeActorV3.GetApplicationSidecarsCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when getting the sidecars fails", func() {
+							BeforeEach(func() {
+								fakeActorV3.GetApplicationSidecarsReturns(nil, v3action.Warnings{"sidecar-warning"}, errors.New("sidecar-error"))
+							})
+
+							It("returns the error and all warnings", func() {
+								Expect(executeErr).To(MatchError("sidecar-error"))
+								Expect(testUI.Err).To(Say("sidecar-warning"))
+							})
+						})
+					})
+
+					Context("when the --json flag is provided", func() {
+						BeforeEach(func() {
+							cmd.JSON = true
+							fakeActor.GetApplicationSummaryByNameAndSpaceReturns(applicationSummary, warnings, nil)
+						})
+
+						Context("when the last upload event is found", func() {
+							BeforeEach(func() {
+								fakeActor.GetApplicationLastUploadEventReturns(
+									v2action.Event{GUID: "some-event-guid", ActorGUID: "some-user-guid", ActorName: "some-user"},
+									nil,
+									nil)
+							})
+
+							It("outputs the app details as JSON", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).ToNot(Say("Showing health and status"))
+								Expect(testUI.Out).To(Say(`"buildpack": "some-buildpack"`))
+								Expect(testUI.Out).To(Say(`"docker_image": null`))
+								Expect(testUI.Out).To(Say(`"guid": "some-app-guid"`))
+								Expect(testUI.Out).To(Say(`"instances": 3`))
+								Expect(testUI.Out).To(Say(`"last_upload_event_guid": "some-event-guid"`))
+								Expect(testUI.Out).To(Say(`"last_uploaded": "1970-01-01T00:00:00Z"`))
+								Expect(testUI.Out).To(Say(`"last_uploaded_by": "some-user"`))
+								Expect(testUI.Out).To(Say(`"memory_in_mb": 128`))
+								Expect(testUI.Out).To(Say(`"name": "some-app"`))
+								Expect(testUI.Out).To(Say(`"requested_state": "started"`))
+								Expect(testUI.Out).To(Say(`"routes": \[\s+"banana.fruit.com/hi",\s+"foobar.com:13"\s+\]`))
+								Expect(testUI.Out).To(Say(`"sidecars": \[\]`))
+								Expect(testUI.Out).To(Say(`"stack": "potatos"`))
+								Expect(testUI.Err).To(Say("app-summary-warning"))
+							})
+						})
+
+						Context("when the last upload event has been pruned", func() {
+							BeforeEach(func() {
+								fakeActor.GetApplicationLastUploadEventReturns(
+									v2action.Event{},
+									nil,
+									v2action.ApplicationUploadEventNotFoundError{ApplicationGUID: "some-app-guid"})
+							})
+
+							It("outputs null for the uploader and the event", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say(`"last_upload_event_guid": null`))
+								Expect(testUI.Out).To(Say(`"last_uploaded_by": null`))
+							})
+						})
+					})
+
 					Context("when the app has running instances", func() {
 						BeforeEach(func() {
 							applicationSummary.RunningInstances = []v2action.ApplicationInstanceWithStats{
@@ -0,0 +1,182 @@
+package v2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+// clearScreen moves the cursor to the top left corner and clears the
+// terminal, so that the instance table can be redrawn in place.
+const clearScreen = "\033[H\033[2J"
+
+// appInstanceWatcher displays the instances of an app each time they are
+// polled. On a TTY the instance table is redrawn in place; otherwise the
+// table is displayed once and only state changes are appended, so that the
+// output can be written to a file.
+type appInstanceWatcher struct {
+	UI       command.UI
+	IsTTY    bool
+	Interval time.Duration
+
+	AppName   string
+	OrgName   string
+	SpaceName string
+	Username  string
+
+	polled      bool
+	states      map[int]v2action.ApplicationInstanceState
+	lastChanges map[int]instanceStateChange
+}
+
+// instanceStateChange is a change in the state of an instance between two
+// polls. From is empty for instances that appeared and To is empty for
+// instances that disappeared.
+type instanceStateChange struct {
+	ID   int
+	From v2action.ApplicationInstanceState
+	To   v2action.ApplicationInstanceState
+	Time time.Time
+}
+
+// Update records the state changes since the previous poll and displays the
+// instances.
+func (w *appInstanceWatcher) Update(instances []v2action.ApplicationInstanceWithStats, now time.Time) {
+	changes := w.recordChanges(instances, now)
+
+	if w.IsTTY {
+		fmt.Fprint(w.UI.Writer(), clearScreen)
+		w.displayHeader()
+		w.UI.DisplayText("Updated {{.Time}}, polling every {{.Interval}}. Press Ctrl-C to exit.", map[string]interface{}{
+			"Time":     zuluTime(now),
+			"Interval": w.Interval,
+		})
+		w.UI.DisplayNewline()
+		w.displayInstances(instances)
+		return
+	}
+
+	if !w.polled {
+		w.polled = true
+		w.displayHeader()
+		w.UI.DisplayNewline()
+		w.displayInstances(instances)
+		return
+	}
+
+	for _, change := range changes {
+		w.UI.DisplayText("{{.Time}}   #{{.Index}}   {{.Change}}", map[string]interface{}{
+			"Time":   zuluTime(change.Time),
+			"Index":  change.ID,
+			"Change": w.describeTransition(change),
+		})
+	}
+}
+
+func (w *appInstanceWatcher) recordChanges(instances []v2action.ApplicationInstanceWithStats, now time.Time) []instanceStateChange {
+	if w.lastChanges == nil {
+		w.lastChanges = map[int]instanceStateChange{}
+	}
+
+	states := map[int]v2action.ApplicationInstanceState{}
+	var changes []instanceStateChange
+	for _, instance := range instances {
+		states[instance.ID] = instance.State
+		if w.states == nil {
+			continue
+		}
+		if previous, found := w.states[instance.ID]; !found || previous != instance.State {
+			changes = append(changes, instanceStateChange{ID: instance.ID, From: previous, To: instance.State, Time: now})
+		}
+	}
+
+	for id, previous := range w.states {
+		if _, found := states[id]; !found {
+			changes = append(changes, instanceStateChange{ID: id, From: previous, Time: now})
+		}
+	}
+	sort.Slice(changes, func(i int, j int) bool { return changes[i].ID < changes[j].ID })
+
+	for _, change := range changes {
+		w.lastChanges[change.ID] = change
+	}
+	w.states = states
+
+	return changes
+}
+
+func (w *appInstanceWatcher) displayHeader() {
+	w.UI.DisplayTextWithFlavor("Watching instances of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   w.AppName,
+		"OrgName":   w.OrgName,
+		"SpaceName": w.SpaceName,
+		"Username":  w.Username,
+	})
+}
+
+func (w *appInstanceWatcher) displayInstances(instances []v2action.ApplicationInstanceWithStats) {
+	if len(instances) == 0 {
+		w.UI.DisplayText("There are no running instances of this app.")
+		return
+	}
+
+	table := [][]string{
+		{
+			"",
+			w.UI.TranslateText("state"),
+			w.UI.TranslateText("since"),
+			w.UI.TranslateText("cpu"),
+			w.UI.TranslateText("memory"),
+			w.UI.TranslateText("disk"),
+			w.UI.TranslateText("last change"),
+		},
+	}
+
+	for _, instance := range instances {
+		var since, lastChange string
+		if instance.Since > 0 {
+			since = zuluTime(instance.TimeSinceCreation())
+		}
+		if change, found := w.lastChanges[instance.ID]; found {
+			lastChange = w.UI.TranslateText("{{.Change}} at {{.Time}}", map[string]interface{}{
+				"Change": w.describeTransition(change),
+				"Time":   zuluTime(change.Time),
+			})
+		}
+
+		table = append(table, []string{
+			fmt.Sprintf("#%d", instance.ID),
+			w.stateName(instance.State),
+			since,
+			fmt.Sprintf("%.1f%%", instance.CPU*100),
+			fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Memory)), bytefmt.ByteSize(uint64(instance.MemoryQuota))),
+			fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Disk)), bytefmt.ByteSize(uint64(instance.DiskQuota))),
+			lastChange,
+		})
+	}
+
+	w.UI.DisplayInstancesTableForApp(table)
+}
+
+func (w *appInstanceWatcher) describeTransition(change instanceStateChange) string {
+	return fmt.Sprintf("%s -> %s", w.stateName(change.From), w.stateName(change.To))
+}
+
+// stateName returns the translated state as displayed by cf app, or "none"
+// for an instance that does not exist.
+func (w *appInstanceWatcher) stateName(state v2action.ApplicationInstanceState) string {
+	if state == "" {
+		return w.UI.TranslateText("none")
+	}
+	return w.UI.TranslateText(strings.ToLower(string(state)))
+}
+
+// zuluTime converts the time to UTC and formats it to ISO8601.
+func zuluTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
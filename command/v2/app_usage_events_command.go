@@ -0,0 +1,148 @@
+package v2
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . AppUsageEventsActor
+
+type AppUsageEventsActor interface {
+	GetAppUsageEvents(afterGUID string, since time.Time, eventFunc func(v2action.AppUsageEvent) error) (v2action.Warnings, error)
+}
+
+type AppUsageEventsCommand struct {
+	AfterGUID       string                 `long:"after-guid" description:"Only show events created after the event with this GUID"`
+	Since           flag.Timestamp         `long:"since" description:"Only show events created at or after this RFC3339 timestamp, e.g. 2017-06-30T15:04:05Z"`
+	Output          flag.EventOutputFormat `long:"output" description:"Output format; 'json' (one event per line) or 'csv'"`
+	Follow          bool                   `long:"follow" description:"Keep polling for new events"`
+	Interval        int                    `long:"interval" default:"30" description:"Number of seconds between polls when following"`
+	usage           interface{}            `usage:"CF_NAME app-usage-events [--after-guid GUID] [--since TIMESTAMP] [--output json|csv] [--follow [--interval SECONDS]]"`
+	relatedCommands interface{}            `related_commands:"service-usage-events"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AppUsageEventsActor
+}
+
+func (cmd *AppUsageEventsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd AppUsageEventsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	printer := newUsageEventPrinter(cmd.UI, cmd.Output.Format, cmd.AfterGUID,
+		[]string{"guid", "created_at", "state", "previous_state", "app_guid", "app_name", "space_guid", "space_name", "org_guid", "instance_count", "previous_instance_count", "memory_in_mb_per_instance", "previous_memory_in_mb_per_instance", "buildpack_guid", "buildpack_name", "package_state", "previous_package_state", "process_type", "task_guid", "task_name"},
+		[]string{"guid", "created at", "state", "app", "space", "instances", "memory"},
+	)
+
+	err = streamUsageEvents(cmd.UI, printer, cmd.Follow, time.Duration(cmd.Interval)*time.Second, func(afterGUID string) (v2action.Warnings, error) {
+		return cmd.Actor.GetAppUsageEvents(afterGUID, cmd.Since.Time, func(event v2action.AppUsageEvent) error {
+			return printer.Print(event.GUID, appUsageEventJSON(event), appUsageEventCSVRow(event), []string{
+				event.GUID,
+				cmd.UI.UserFriendlyDate(event.CreatedAt),
+				event.State,
+				event.AppName,
+				event.SpaceName,
+				strconv.Itoa(event.InstanceCount),
+				strconv.Itoa(event.MemoryInMBPerInstance) + "M",
+			})
+		})
+	})
+
+	return shared.HandleError(err)
+}
+
+type appUsageEventOutput struct {
+	GUID                          string    `json:"guid"`
+	CreatedAt                     time.Time `json:"created_at"`
+	State                         string    `json:"state"`
+	PreviousState                 string    `json:"previous_state,omitempty"`
+	AppGUID                       string    `json:"app_guid"`
+	AppName                       string    `json:"app_name"`
+	SpaceGUID                     string    `json:"space_guid"`
+	SpaceName                     string    `json:"space_name"`
+	OrgGUID                       string    `json:"org_guid"`
+	InstanceCount                 int       `json:"instance_count"`
+	PreviousInstanceCount         int       `json:"previous_instance_count"`
+	MemoryInMBPerInstance         int       `json:"memory_in_mb_per_instance"`
+	PreviousMemoryInMBPerInstance int       `json:"previous_memory_in_mb_per_instance"`
+	BuildpackGUID                 string    `json:"buildpack_guid,omitempty"`
+	BuildpackName                 string    `json:"buildpack_name,omitempty"`
+	PackageState                  string    `json:"package_state,omitempty"`
+	PreviousPackageState          string    `json:"previous_package_state,omitempty"`
+	ProcessType                   string    `json:"process_type,omitempty"`
+	TaskGUID                      string    `json:"task_guid,omitempty"`
+	TaskName                      string    `json:"task_name,omitempty"`
+}
+
+func appUsageEventJSON(event v2action.AppUsageEvent) appUsageEventOutput {
+	return appUsageEventOutput{
+		GUID:                          event.GUID,
+		CreatedAt:                     event.CreatedAt.UTC(),
+		State:                         event.State,
+		PreviousState:                 event.PreviousState,
+		AppGUID:                       event.AppGUID,
+		AppName:                       event.AppName,
+		SpaceGUID:                     event.SpaceGUID,
+		SpaceName:                     event.SpaceName,
+		OrgGUID:                       event.OrgGUID,
+		InstanceCount:                 event.InstanceCount,
+		PreviousInstanceCount:         event.PreviousInstanceCount,
+		MemoryInMBPerInstance:         event.MemoryInMBPerInstance,
+		PreviousMemoryInMBPerInstance: event.PreviousMemoryInMBPerInstance,
+		BuildpackGUID:                 event.BuildpackGUID,
+		BuildpackName:                 event.BuildpackName,
+		PackageState:                  event.PackageState,
+		PreviousPackageState:          event.PreviousPackageState,
+		ProcessType:                   event.ProcessType,
+		TaskGUID:                      event.TaskGUID,
+		TaskName:                      event.TaskName,
+	}
+}
+
+func appUsageEventCSVRow(event v2action.AppUsageEvent) []string {
+	return []string{
+		event.GUID,
+		event.CreatedAt.UTC().Format(time.RFC3339),
+		event.State,
+		event.PreviousState,
+		event.AppGUID,
+		event.AppName,
+		event.SpaceGUID,
+		event.SpaceName,
+		event.OrgGUID,
+		strconv.Itoa(event.InstanceCount),
+		strconv.Itoa(event.PreviousInstanceCount),
+		strconv.Itoa(event.MemoryInMBPerInstance),
+		strconv.Itoa(event.PreviousMemoryInMBPerInstance),
+		event.BuildpackGUID,
+		event.BuildpackName,
+		event.PackageState,
+		event.PreviousPackageState,
+		event.ProcessType,
+		event.TaskGUID,
+		event.TaskName,
+	}
+}
@@ -0,0 +1,208 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("app-usage-events Command", func() {
+	var (
+		cmd             AppUsageEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAppUsageEventsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAppUsageEventsActor)
+
+		cmd = AppUsageEventsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		fakeActor.GetAppUsageEventsStub = func(_ string, _ time.Time, eventFunc func(v2action.AppUsageEvent) error) (v2action.Warnings, error) {
+			for _, event := range []v2action.AppUsageEvent{
+				{
+					GUID:                  "event-guid-1",
+					CreatedAt:             time.Date(2017, 6, 30, 15, 4, 5, 0, time.UTC),
+					State:                 "STARTED",
+					AppName:               "app-1",
+					SpaceName:             "space-1",
+					InstanceCount:         2,
+					MemoryInMBPerInstance: 256,
+				},
+				{
+					GUID:                  "event-guid-2",
+					CreatedAt:             time.Date(2017, 6, 30, 15, 5, 5, 0, time.UTC),
+					State:                 "STOPPED",
+					AppName:               "app-2",
+					SpaceName:             "space-2",
+					InstanceCount:         1,
+					MemoryInMBPerInstance: 1024,
+				},
+			} {
+				if err := eventFunc(event); err != nil {
+					return nil, err
+				}
+			}
+			return v2action.Warnings{"event-warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when no output format is provided", func() {
+		BeforeEach(func() {
+			cmd.AfterGUID = "some-after-guid"
+			cmd.Since.Time = time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+		})
+
+		It("displays the events in a table followed by the last GUID", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("guid\\s+created at\\s+state\\s+app\\s+space\\s+instances\\s+memory"))
+			Expect(testUI.Out).To(Say("event-guid-1\\s+.*\\s+STARTED\\s+app-1\\s+space-1\\s+2\\s+256M"))
+			Expect(testUI.Out).To(Say("event-guid-2\\s+.*\\s+STOPPED\\s+app-2\\s+space-2\\s+1\\s+1024M"))
+			Expect(testUI.Out).To(Say("Last event GUID: event-guid-2"))
+			Expect(testUI.Err).To(Say("event-warning"))
+
+			Expect(fakeActor.GetAppUsageEventsCallCount()).To(Equal(1))
+			afterGUID, since, _ := fakeActor.GetAppUsageEventsArgsForCall(0)
+			Expect(afterGUID).To(Equal("some-after-guid"))
+			Expect(since).To(Equal(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Context("when the output format is json", func() {
+		BeforeEach(func() {
+			cmd.Output.Format = "json"
+		})
+
+		It("writes one JSON object per event and the last GUID to stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`\{"guid":"event-guid-1","created_at":"2017-06-30T15:04:05Z","state":"STARTED",.*"app_name":"app-1",.*"instance_count":2,.*"memory_in_mb_per_instance":256,.*\}\n`))
+			Expect(testUI.Out).To(Say(`\{"guid":"event-guid-2",.*\}\n`))
+			Expect(testUI.Err).To(Say("Last event GUID: event-guid-2"))
+		})
+	})
+
+	Context("when the output format is csv", func() {
+		BeforeEach(func() {
+			cmd.Output.Format = "csv"
+		})
+
+		It("writes a header followed by one row per event", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("guid,created_at,state,previous_state,app_guid,app_name,space_guid,space_name,org_guid,instance_count,"))
+			Expect(testUI.Out).To(Say("event-guid-1,2017-06-30T15:04:05Z,STARTED,,,app-1,,space-1,,2,0,256,0,,,,,,,\n"))
+			Expect(testUI.Out).To(Say("event-guid-2,2017-06-30T15:05:05Z,STOPPED,,,app-2,,space-2,,1,0,1024,0,,,,,,,\n"))
+			Expect(testUI.Err).To(Say("Last event GUID: event-guid-2"))
+		})
+	})
+
+	Context("when there are no events", func() {
+		BeforeEach(func() {
+			cmd.AfterGUID = "some-after-guid"
+			fakeActor.GetAppUsageEventsStub = nil
+			fakeActor.GetAppUsageEventsReturns(nil, nil)
+		})
+
+		It("displays the GUID it was given", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Last event GUID: some-after-guid"))
+		})
+	})
+
+	Context("when getting events fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("get events error")
+			fakeActor.GetAppUsageEventsStub = nil
+			fakeActor.GetAppUsageEventsReturns(v2action.Warnings{"event-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("event-warning"))
+		})
+	})
+
+	Context("when following", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			cmd.Follow = true
+			cmd.Output.Format = "json"
+			expectedErr = errors.New("stop following")
+
+			stub := fakeActor.GetAppUsageEventsStub
+			fakeActor.GetAppUsageEventsStub = func(afterGUID string, since time.Time, eventFunc func(v2action.AppUsageEvent) error) (v2action.Warnings, error) {
+				switch fakeActor.GetAppUsageEventsCallCount() {
+				case 1:
+					return stub(afterGUID, since, eventFunc)
+				case 2:
+					return nil, nil
+				default:
+					return nil, expectedErr
+				}
+			}
+		})
+
+		It("polls from the last GUID until an error occurs", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+
+			Expect(fakeActor.GetAppUsageEventsCallCount()).To(Equal(3))
+			afterGUID, _, _ := fakeActor.GetAppUsageEventsArgsForCall(0)
+			Expect(afterGUID).To(BeEmpty())
+			afterGUID, _, _ = fakeActor.GetAppUsageEventsArgsForCall(1)
+			Expect(afterGUID).To(Equal("event-guid-2"))
+			afterGUID, _, _ = fakeActor.GetAppUsageEventsArgsForCall(2)
+			Expect(afterGUID).To(Equal("event-guid-2"))
+
+			Expect(testUI.Err).To(Say("Last event GUID: event-guid-2"))
+		})
+	})
+})
@@ -1,22 +1,134 @@
 package v2
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 )
 
+//go:generate counterfeiter . AppsActor
+
+type AppsActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationsBySpaceAndLabelSelector(spaceGUID string, labelSelector string) ([]v3action.Application, v3action.Warnings, error)
+}
+
 type AppsCommand struct {
-	usage           interface{} `usage:"CF_NAME apps"`
+	Labels          string      `long:"labels" description:"Only list the apps whose labels match the selector, e.g. 'env in (prod,staging)'"`
+	JSON            bool        `long:"json" description:"Output the apps and their labels as JSON"`
+	usage           interface{} `usage:"CF_NAME apps [--labels SELECTOR] [--json]\n\nEXAMPLES:\n   CF_NAME apps --labels 'env=prod'\n   CF_NAME apps --labels 'tier notin (worker)' --json"`
 	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AppsActor
 }
 
-func (AppsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *AppsCommand) Setup(config command.Config, ui command.UI) error {
+	if cmd.Labels == "" && !cmd.JSON {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config)
+
 	return nil
 }
 
-func (AppsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd AppsCommand) Execute(args []string) error {
+	if cmd.Labels == "" && !cmd.JSON {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := checkLabelSelector(cmd.Labels, cmd.Actor.CloudControllerAPIVersion())
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.JSON {
+		cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+	}
+
+	apps, warnings, err := cmd.Actor.GetApplicationsBySpaceAndLabelSelector(cmd.Config.TargetedSpace().GUID, cmd.Labels)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	if cmd.JSON {
+		return cmd.displayAppsJSON(apps)
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(apps) == 0 {
+		cmd.UI.DisplayText("No apps found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("requested state"),
+		},
+	}
+	for _, app := range apps {
+		table = append(table, []string{
+			app.Name,
+			cmd.UI.TranslateText(strings.ToLower(app.State)),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
 	return nil
 }
+
+func (cmd AppsCommand) displayAppsJSON(apps []v3action.Application) error {
+	appsJSON := []map[string]interface{}{}
+	for _, app := range apps {
+		appsJSON = append(appsJSON, map[string]interface{}{
+			"name":            app.Name,
+			"guid":            app.GUID,
+			"requested_state": strings.ToLower(app.State),
+			"labels":          labelsJSON(app.Metadata),
+		})
+	}
+
+	output, err := json.MarshalIndent(map[string]interface{}{"apps": appsJSON}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
@@ -0,0 +1,165 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apps Command", func() {
+	var (
+		cmd             AppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAppsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAppsActor)
+
+		cmd = AppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.Labels = "env=prod"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the label selector is invalid", func() {
+		BeforeEach(func() {
+			cmd.Labels = "env=prod,"
+		})
+
+		It("returns an InvalidLabelSelectorError pointing at the offending token", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidLabelSelectorError{
+				Selector: "env=prod,",
+				Column:   9,
+				Reason:   "expected a label key",
+			}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.GetApplicationsBySpaceAndLabelSelectorCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrganizationRequired).To(BeTrue())
+			Expect(targetedSpaceRequired).To(BeTrue())
+		})
+	})
+
+	Context("when getting the apps fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("get apps error")
+			fakeActor.GetApplicationsBySpaceAndLabelSelectorReturns(nil, v3action.Warnings{"warning-1"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	Context("when getting the apps succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationsBySpaceAndLabelSelectorReturns(
+				[]v3action.Application{
+					{
+						GUID:  "app-guid-1",
+						Name:  "app-1",
+						State: "STARTED",
+						Metadata: &v3action.Metadata{Labels: map[string]types.NullString{
+							"env": types.NewNullString("prod"),
+						}},
+					},
+				},
+				v3action.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("lists the matching apps in the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			spaceGUID, labelSelector := fakeActor.GetApplicationsBySpaceAndLabelSelectorArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(labelSelector).To(Equal("env=prod"))
+
+			Expect(testUI.Out).To(Say("Getting apps in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`name\s+requested state`))
+			Expect(testUI.Out).To(Say(`app-1\s+started`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		Context("when --json is provided", func() {
+			BeforeEach(func() {
+				cmd.JSON = true
+			})
+
+			It("outputs the name, guid, state and labels of each app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				var output map[string]interface{}
+				Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &output)).To(Succeed())
+				Expect(output).To(Equal(map[string]interface{}{
+					"apps": []interface{}{
+						map[string]interface{}{
+							"name":            "app-1",
+							"guid":            "app-guid-1",
+							"requested_state": "started",
+							"labels":          map[string]interface{}{"env": "prod"},
+						},
+					},
+				}))
+			})
+		})
+	})
+
+	Context("when no apps match the selector", func() {
+		It("displays that no apps were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No apps found"))
+		})
+	})
+})
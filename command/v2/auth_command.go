@@ -4,21 +4,25 @@ import (
 	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
 //go:generate counterfeiter . AuthActor
 
 type AuthActor interface {
-	Authenticate(config v2action.Config, username string, password string) error
+	Authenticate(config v2action.Config, credentials map[string]string, grantType constant.GrantType) error
 }
 
 type AuthCommand struct {
-	RequiredArgs    flag.Authentication `positional-args:"yes"`
-	usage           interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
-	relatedCommands interface{}         `related_commands:"api, login, target"`
+	RequiredArgs      flag.Authentication `positional-args:"yes"`
+	ClientCredentials bool                `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
+	MFACode           string              `long:"mfa-code" description:"Multi-factor authentication code, when UAA requires one"`
+	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD [--mfa-code CODE]\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME auth name@example.com \"my password\" --mfa-code 123456 (when UAA requires multi-factor authentication)\n   CF_NAME auth my-client my-client-secret --client-credentials"`
+	relatedCommands   interface{}         `related_commands:"api, login, target"`
 
 	UI     command.UI
 	Config command.Config
@@ -39,11 +43,36 @@ func (cmd *AuthCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd AuthCommand) Execute(args []string) error {
+	if cmd.ClientCredentials && cmd.MFACode != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--client-credentials", "--mfa-code"},
+		}
+	}
+
 	err := command.WarnAPIVersionCheck(cmd.Config, cmd.UI)
 	if err != nil {
 		return err
 	}
 
+	grantType := constant.GrantTypePassword
+	credentials := map[string]string{
+		"username": cmd.RequiredArgs.Username,
+		"password": cmd.RequiredArgs.Password,
+	}
+	if cmd.ClientCredentials {
+		grantType = constant.GrantTypeClientCredentials
+		credentials = map[string]string{
+			"client_id":     cmd.RequiredArgs.Username,
+			"client_secret": cmd.RequiredArgs.Password,
+		}
+	} else if cmd.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+		return translatableerror.PasswordGrantTypeLogoutRequiredError{BinaryName: cmd.Config.BinaryName()}
+	}
+
+	if cmd.MFACode != "" {
+		credentials["mfaCode"] = cmd.MFACode
+	}
+
 	cmd.UI.DisplayTextWithFlavor(
 		"API endpoint: {{.Endpoint}}",
 		map[string]interface{}{
@@ -51,7 +80,7 @@ func (cmd AuthCommand) Execute(args []string) error {
 		})
 	cmd.UI.DisplayText("Authenticating...")
 
-	err = cmd.Actor.Authenticate(cmd.Config, cmd.RequiredArgs.Username, cmd.RequiredArgs.Password)
+	err = cmd.Actor.Authenticate(cmd.Config, credentials, grantType)
 	if err != nil {
 		return shared.HandleError(err)
 	}
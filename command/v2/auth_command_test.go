@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
@@ -69,10 +70,84 @@ var _ = Describe("auth Command", func() {
 			Expect(testUI.Out).To(Say("Use '%s target' to view or set your target org and space", binaryName))
 
 			Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
-			config, username, password := fakeActor.AuthenticateArgsForCall(0)
+			config, credentials, grantType := fakeActor.AuthenticateArgsForCall(0)
 			Expect(config).To(Equal(fakeConfig))
-			Expect(username).To(Equal(testUsername))
-			Expect(password).To(Equal(testPassword))
+			Expect(credentials).To(Equal(map[string]string{
+				"username": testUsername,
+				"password": testPassword,
+			}))
+			Expect(grantType).To(Equal(constant.GrantTypePassword))
+		})
+
+		Context("when the --mfa-code flag is provided", func() {
+			BeforeEach(func() {
+				cmd.MFACode = "123456"
+			})
+
+			It("sends the MFA code with the credentials", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				_, credentials, _ := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{
+					"username": testUsername,
+					"password": testPassword,
+					"mfaCode":  "123456",
+				}))
+			})
+
+			Context("when the --client-credentials flag is also provided", func() {
+				BeforeEach(func() {
+					cmd.ClientCredentials = true
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+						Args: []string{"--client-credentials", "--mfa-code"},
+					}))
+					Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the --client-credentials flag is provided", func() {
+			BeforeEach(func() {
+				cmd.ClientCredentials = true
+			})
+
+			It("authenticates with the client credentials grant", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				_, credentials, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{
+					"client_id":     testUsername,
+					"client_secret": testPassword,
+				}))
+				Expect(grantType).To(Equal(constant.GrantTypeClientCredentials))
+			})
+		})
+
+		Context("when a service account is already logged in", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAGrantTypeReturns("client_credentials")
+			})
+
+			It("requires the service account to log out before authenticating with a password", func() {
+				Expect(err).To(MatchError(translatableerror.PasswordGrantTypeLogoutRequiredError{BinaryName: binaryName}))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			})
+
+			Context("when the --client-credentials flag is provided", func() {
+				BeforeEach(func() {
+					cmd.ClientCredentials = true
+				})
+
+				It("authenticates as the new client", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				})
+			})
 		})
 	})
 
@@ -0,0 +1,25 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type BuildpackCommand struct {
+	RequiredArgs    flag.BuildpackName `positional-args:"yes"`
+	Stack           string             `long:"stack" description:"Specify stack to disambiguate buildpacks with the same name"`
+	usage           interface{}        `usage:"CF_NAME buildpack BUILDPACK [--stack STACK]"`
+	relatedCommands interface{}        `related_commands:"buildpacks, update-buildpack"`
+}
+
+func (BuildpackCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (BuildpackCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
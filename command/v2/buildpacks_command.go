@@ -8,8 +8,10 @@ import (
 )
 
 type BuildpacksCommand struct {
-	usage           interface{} `usage:"CF_NAME buildpacks"`
-	relatedCommands interface{} `related_commands:"push"`
+	Stack           string      `long:"stack" description:"Only list buildpacks for the given stack"`
+	JSON            bool        `long:"json" description:"Output the buildpacks as JSON"`
+	usage           interface{} `usage:"CF_NAME buildpacks [--stack STACK] [--json]"`
+	relatedCommands interface{} `related_commands:"buildpack, push"`
 }
 
 func (BuildpacksCommand) Setup(config command.Config, ui command.UI) error {
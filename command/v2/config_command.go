@@ -9,11 +9,15 @@ import (
 )
 
 type ConfigCommand struct {
-	AsyncTimeout int               `long:"async-timeout" description:"Timeout for async HTTP requests"`
-	Color        flag.Color        `long:"color" description:"Enable or disable color"`
-	Locale       flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
-	Trace        flag.PathWithBool `long:"trace" description:"Trace HTTP requests"`
-	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"`
+	AsyncTimeout    int               `long:"async-timeout" description:"Timeout for async HTTP requests"`
+	Color           flag.Color        `long:"color" description:"Enable or disable color"`
+	FoundationCheck string            `long:"foundation-check" description:"Enable or disable checking that the API endpoint belongs to the foundation you logged in to"`
+	Get             string            `long:"get" description:"Print the current value of a single setting"`
+	JSON            bool              `long:"json" description:"Show the current values of the settings as JSON"`
+	List            bool              `long:"list" description:"Show the current values of the settings"`
+	Locale          flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
+	Trace           flag.PathWithBool `long:"trace" description:"Trace HTTP requests"`
+	usage           interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)] [--foundation-check (true | false)]\n   CF_NAME config (--list [--json] | --get KEY)\n\nKEYS:\n   async-timeout, color, config-file, foundation-check, locale, trace"`
 }
 
 func (ConfigCommand) Setup(config command.Config, ui command.UI) error {
@@ -3,30 +3,47 @@ package v2
 import (
 	"fmt"
 	"os"
+	"sort"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/manifest"
 )
 
 //go:generate counterfeiter . CreateAppManifestActor
 
 type CreateAppManifestActor interface {
-	CreateApplicationManifestByNameAndSpace(appName string, spaceGUID string, filePath string) (v2action.Warnings, error)
+	CreateApplicationManifestByNameAndSpace(appName string, spaceGUID string, filePath string, processes []manifest.Process) (v2action.Warnings, error)
+	GetApplicationManifestByNameAndSpace(appName string, spaceGUID string) (manifest.Application, v2action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	WriteApplicationManifests(manifestApps []manifest.Application, pathToFile string) error
+}
+
+//go:generate counterfeiter . CreateAppManifestActorV3
+
+type CreateAppManifestActorV3 interface {
+	GetApplicationProcessHealthChecksByNameAndSpace(appName string, spaceGUID string) ([]v3action.ProcessHealthCheck, v3action.Warnings, error)
 }
 
 type CreateAppManifestCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	FilePath        flag.Path    `short:"p" description:"Specify a path for file creation. If path not specified, manifest file is created in current working directory."`
-	usage           interface{}  `usage:"CF_NAME create-app-manifest APP_NAME [-p /path/to/<app-name>_manifest.yml]"`
-	relatedCommands interface{}  `related_commands:"apps, push"`
+	RequiredArgs    flag.OptionalAppName `positional-args:"yes"`
+	All             bool                 `long:"all" description:"Create a single manifest for all apps in the targeted space"`
+	FilePath        flag.Path            `short:"p" description:"Specify a path for file creation. If path not specified, manifest file is created in current working directory."`
+	usage           interface{}          `usage:"CF_NAME create-app-manifest APP_NAME [-p /path/to/<app-name>_manifest.yml]\n   CF_NAME create-app-manifest --all [-p /path/to/<space-name>_manifest.yml]"`
+	relatedCommands interface{}          `related_commands:"apps, push"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       CreateAppManifestActor
+	ActorV3     CreateAppManifestActorV3
 }
 
 func (cmd *CreateAppManifestCommand) Setup(config command.Config, ui command.UI) error {
@@ -40,10 +57,28 @@ func (cmd *CreateAppManifestCommand) Setup(config command.Config, ui command.UI)
 	}
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
 
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
 	return nil
 }
 
 func (cmd CreateAppManifestCommand) Execute(args []string) error {
+	if cmd.All && cmd.RequiredArgs.AppName != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"APP_NAME", "--all"},
+		}
+	}
+	if !cmd.All && cmd.RequiredArgs.AppName == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	}
+
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -54,6 +89,10 @@ func (cmd CreateAppManifestCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.All {
+		return cmd.createSpaceManifest(user.Name)
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Creating an app manifest from current settings of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
 		"OrgName":   cmd.Config.TargetedOrganization().Name,
@@ -65,7 +104,13 @@ func (cmd CreateAppManifestCommand) Execute(args []string) error {
 	if manifestPath == "" {
 		manifestPath = fmt.Sprintf(".%s%s_manifest.yml", string(os.PathSeparator), cmd.RequiredArgs.AppName)
 	}
-	warnings, err := cmd.Actor.CreateApplicationManifestByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, manifestPath)
+
+	processes, err := cmd.getProcesses(cmd.RequiredArgs.AppName)
+	if err != nil {
+		return err
+	}
+
+	warnings, err := cmd.Actor.CreateApplicationManifestByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, manifestPath, processes)
 
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -79,3 +124,130 @@ func (cmd CreateAppManifestCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// createSpaceManifest writes a single manifest for every app in the targeted
+// space, ordered by app name. Apps that cannot be retrieved are left out with
+// a warning, and a ManifestIncompleteError is returned once the remaining
+// apps have been written.
+func (cmd CreateAppManifestCommand) createSpaceManifest(username string) error {
+	space := cmd.Config.TargetedSpace()
+
+	cmd.UI.DisplayTextWithFlavor("Creating an app manifest from current settings of all apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": space.Name,
+		"Username":  username,
+	})
+
+	manifestPath := cmd.FilePath.String()
+	if manifestPath == "" {
+		manifestPath = fmt.Sprintf(".%s%s_manifest.yml", string(os.PathSeparator), space.Name)
+	}
+
+	apps, warnings, err := cmd.Actor.GetApplicationsBySpace(space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	sort.Slice(apps, func(i int, j int) bool { return apps[i].Name < apps[j].Name })
+
+	var (
+		manifestApps []manifest.Application
+		skippedApps  []string
+	)
+	for _, app := range apps {
+		manifestApp, err := cmd.getApplicationManifest(app.Name, space.GUID)
+		if err != nil {
+			cmd.UI.DisplayWarning("Unable to add app {{.AppName}} to the manifest: {{.Error}}", map[string]interface{}{
+				"AppName": app.Name,
+				"Error":   sharedV3.TranslatedErrorMessage(cmd.UI, err),
+			})
+			skippedApps = append(skippedApps, app.Name)
+			continue
+		}
+		manifestApps = append(manifestApps, manifestApp)
+	}
+
+	err = cmd.Actor.WriteApplicationManifests(manifestApps, manifestPath)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(skippedApps) > 0 {
+		cmd.UI.DisplayText("Manifest file created at {{.FilePath}}", map[string]interface{}{
+			"FilePath": manifestPath,
+		})
+		return translatableerror.ManifestIncompleteError{AppNames: skippedApps}
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Manifest file created successfully at {{.FilePath}}", map[string]interface{}{
+		"FilePath": manifestPath,
+	})
+
+	return nil
+}
+
+// getApplicationManifest returns the app's settings exactly as they are
+// written for a single app by create-app-manifest APP_NAME.
+func (cmd CreateAppManifestCommand) getApplicationManifest(appName string, spaceGUID string) (manifest.Application, error) {
+	processes, err := cmd.getProcesses(appName)
+	if err != nil {
+		return manifest.Application{}, err
+	}
+
+	manifestApp, warnings, err := cmd.Actor.GetApplicationManifestByNameAndSpace(appName, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return manifest.Application{}, shared.HandleError(err)
+	}
+	manifestApp.Processes = processes
+
+	return manifestApp, nil
+}
+
+// getProcesses returns the processes block of the app's manifest, which is
+// empty when the V3 API is not available.
+func (cmd CreateAppManifestCommand) getProcesses(appName string) ([]manifest.Process, error) {
+	if cmd.ActorV3 == nil {
+		return nil, nil
+	}
+
+	healthChecks, warnings, err := cmd.ActorV3.GetApplicationProcessHealthChecksByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return nil, sharedV3.HandleError(err)
+	}
+	return customProcessHealthChecks(healthChecks), nil
+}
+
+// customProcessHealthChecks returns the non-web processes whose health check
+// differs from the default, in the form a manifest processes block describes
+// them. The web process is already covered by the top level app settings.
+func customProcessHealthChecks(healthChecks []v3action.ProcessHealthCheck) []manifest.Process {
+	var processes []manifest.Process
+	for _, healthCheck := range healthChecks {
+		if healthCheck.ProcessType == constant.ProcessTypeWeb {
+			continue
+		}
+		if healthCheck.HealthCheckType == "process" &&
+			healthCheck.Endpoint == "" &&
+			!healthCheck.Timeout.IsSet &&
+			!healthCheck.InvocationTimeout.IsSet {
+			continue
+		}
+
+		process := manifest.Process{
+			Type:                    healthCheck.ProcessType,
+			HealthCheckType:         healthCheck.HealthCheckType,
+			HealthCheckHTTPEndpoint: healthCheck.Endpoint,
+		}
+		if healthCheck.Timeout.IsSet {
+			process.HealthCheckTimeout = int(healthCheck.Timeout.Value)
+		}
+		if healthCheck.InvocationTimeout.IsSet {
+			process.HealthCheckInvocationTimeout = int(healthCheck.InvocationTimeout.Value)
+		}
+		processes = append(processes, process)
+	}
+	return processes
+}
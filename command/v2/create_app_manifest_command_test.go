@@ -7,12 +7,15 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -54,6 +57,30 @@ var _ = Describe("create-app-manifest Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when neither APP_NAME nor --all is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when both APP_NAME and --all are provided", func() {
+		BeforeEach(func() {
+			cmd.All = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"APP_NAME", "--all"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
@@ -107,10 +134,72 @@ var _ = Describe("create-app-manifest Command", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 
 				Expect(fakeActor.CreateApplicationManifestByNameAndSpaceCallCount()).To(Equal(1))
-				appArg, spaceArg, pathArg := fakeActor.CreateApplicationManifestByNameAndSpaceArgsForCall(0)
+				appArg, spaceArg, pathArg, processesArg := fakeActor.CreateApplicationManifestByNameAndSpaceArgsForCall(0)
 				Expect(appArg).To(Equal("some-app"))
 				Expect(spaceArg).To(Equal("some-space-guid"))
 				Expect(pathArg).To(Equal("some-file-path"))
+				Expect(processesArg).To(BeEmpty())
+			})
+
+			Context("when the v3 API is available", func() {
+				var fakeActorV3 *v2fakes.FakeCreateAppManifestActorV3
+
+				BeforeEach(func() {
+					fakeActorV3 = new(v2fakes.FakeCreateAppManifestActorV3)
+					cmd.ActorV3 = fakeActorV3
+				})
+
+				Context("when getting the process health checks errors", func() {
+					BeforeEach(func() {
+						fakeActorV3.GetApplicationProcessHealthChecksByNameAndSpaceReturns(nil, v3action.Warnings{"v3-warning"}, errors.New("some-v3-error"))
+					})
+
+					It("returns the error, prints warnings and does not create the manifest", func() {
+						Expect(testUI.Err).To(Say("v3-warning"))
+						Expect(executeErr).To(MatchError("some-v3-error"))
+						Expect(fakeActor.CreateApplicationManifestByNameAndSpaceCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when getting the process health checks succeeds", func() {
+					BeforeEach(func() {
+						fakeActorV3.GetApplicationProcessHealthChecksByNameAndSpaceReturns(
+							[]v3action.ProcessHealthCheck{
+								{ProcessType: "web", HealthCheckType: "http", Endpoint: "/web"},
+								{ProcessType: "clock", HealthCheckType: "process"},
+								{ProcessType: "worker", HealthCheckType: "http", Endpoint: "/healthy", InvocationTimeout: types.NullUint64{IsSet: true, Value: 5}},
+								{ProcessType: "console", HealthCheckType: "process", Timeout: types.NullUint64{IsSet: true, Value: 90}},
+							},
+							v3action.Warnings{"v3-warning"},
+							nil)
+					})
+
+					It("passes the non-web processes with custom health checks to the manifest", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Err).To(Say("v3-warning"))
+
+						Expect(fakeActorV3.GetApplicationProcessHealthChecksByNameAndSpaceCallCount()).To(Equal(1))
+						appName, spaceGUID := fakeActorV3.GetApplicationProcessHealthChecksByNameAndSpaceArgsForCall(0)
+						Expect(appName).To(Equal("some-app"))
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+
+						Expect(fakeActor.CreateApplicationManifestByNameAndSpaceCallCount()).To(Equal(1))
+						_, _, _, processesArg := fakeActor.CreateApplicationManifestByNameAndSpaceArgsForCall(0)
+						Expect(processesArg).To(Equal([]manifest.Process{
+							{
+								Type:                         "worker",
+								HealthCheckType:              "http",
+								HealthCheckHTTPEndpoint:      "/healthy",
+								HealthCheckInvocationTimeout: 5,
+							},
+							{
+								Type:               "console",
+								HealthCheckType:    "process",
+								HealthCheckTimeout: 90,
+							},
+						}))
+					})
+				})
 			})
 
 			Context("when no filepath is provided", func() {
@@ -126,10 +215,142 @@ var _ = Describe("create-app-manifest Command", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
 
 					Expect(fakeActor.CreateApplicationManifestByNameAndSpaceCallCount()).To(Equal(1))
-					appArg, spaceArg, pathArg := fakeActor.CreateApplicationManifestByNameAndSpaceArgsForCall(0)
+					appArg, spaceArg, pathArg, processesArg := fakeActor.CreateApplicationManifestByNameAndSpaceArgsForCall(0)
 					Expect(appArg).To(Equal("some-app"))
 					Expect(spaceArg).To(Equal("some-space-guid"))
 					Expect(pathArg).To(Equal(fmt.Sprintf(".%ssome-app_manifest.yml", string(os.PathSeparator))))
+					Expect(processesArg).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when --all is provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.AppName = ""
+				cmd.All = true
+			})
+
+			Context("when getting the apps in the space fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationsBySpaceReturns(nil, v2action.Warnings{"apps-warning"}, errors.New("some-error"))
+				})
+
+				It("returns the error, prints warnings and does not write a manifest", func() {
+					Expect(executeErr).To(MatchError("some-error"))
+					Expect(testUI.Err).To(Say("apps-warning"))
+					Expect(fakeActor.WriteApplicationManifestsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting the apps in the space succeeds", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationsBySpaceReturns(
+						[]v2action.Application{{Name: "app-b"}, {Name: "app-c"}, {Name: "app-a"}},
+						v2action.Warnings{"apps-warning"},
+						nil)
+					fakeActor.GetApplicationManifestByNameAndSpaceStub = func(appName string, _ string) (manifest.Application, v2action.Warnings, error) {
+						return manifest.Application{Name: appName, DockerImage: appName + "-image"}, v2action.Warnings{appName + "-warning"}, nil
+					}
+				})
+
+				It("writes every app to a single manifest in alphabetical order", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("Creating an app manifest from current settings of all apps in org some-org / space some-space as some-user..."))
+					Expect(testUI.Err).To(Say("apps-warning"))
+					Expect(testUI.Err).To(Say("app-a-warning"))
+					Expect(testUI.Err).To(Say("app-b-warning"))
+					Expect(testUI.Err).To(Say("app-c-warning"))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Manifest file created successfully at some-file-path"))
+
+					Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+					Expect(fakeActor.GetApplicationManifestByNameAndSpaceCallCount()).To(Equal(3))
+					_, spaceGUID := fakeActor.GetApplicationManifestByNameAndSpaceArgsForCall(0)
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+
+					Expect(fakeActor.WriteApplicationManifestsCallCount()).To(Equal(1))
+					manifestApps, pathArg := fakeActor.WriteApplicationManifestsArgsForCall(0)
+					Expect(manifestApps).To(Equal([]manifest.Application{
+						{Name: "app-a", DockerImage: "app-a-image"},
+						{Name: "app-b", DockerImage: "app-b-image"},
+						{Name: "app-c", DockerImage: "app-c-image"},
+					}))
+					Expect(pathArg).To(Equal("some-file-path"))
+					Expect(fakeActor.CreateApplicationManifestByNameAndSpaceCallCount()).To(Equal(0))
+				})
+
+				Context("when the v3 API is available", func() {
+					var fakeActorV3 *v2fakes.FakeCreateAppManifestActorV3
+
+					BeforeEach(func() {
+						fakeActorV3 = new(v2fakes.FakeCreateAppManifestActorV3)
+						cmd.ActorV3 = fakeActorV3
+						fakeActorV3.GetApplicationProcessHealthChecksByNameAndSpaceReturns(
+							[]v3action.ProcessHealthCheck{
+								{ProcessType: "worker", HealthCheckType: "port"},
+							},
+							nil,
+							nil)
+					})
+
+					It("includes the processes of each app", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActorV3.GetApplicationProcessHealthChecksByNameAndSpaceCallCount()).To(Equal(3))
+						manifestApps, _ := fakeActor.WriteApplicationManifestsArgsForCall(0)
+						for _, manifestApp := range manifestApps {
+							Expect(manifestApp.Processes).To(Equal([]manifest.Process{
+								{Type: "worker", HealthCheckType: "port"},
+							}))
+						}
+					})
+				})
+
+				Context("when some apps cannot be retrieved", func() {
+					BeforeEach(func() {
+						fakeActor.GetApplicationManifestByNameAndSpaceStub = func(appName string, _ string) (manifest.Application, v2action.Warnings, error) {
+							if appName == "app-b" {
+								return manifest.Application{}, nil, errors.New("some-env-error")
+							}
+							return manifest.Application{Name: appName}, nil, nil
+						}
+					})
+
+					It("writes the other apps, warns about the skipped ones and returns a ManifestIncompleteError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ManifestIncompleteError{AppNames: []string{"app-b"}}))
+
+						Expect(testUI.Err).To(Say("Unable to add app app-b to the manifest: some-env-error"))
+						Expect(testUI.Out).ToNot(Say("OK"))
+						Expect(testUI.Out).To(Say("Manifest file created at some-file-path"))
+
+						Expect(fakeActor.WriteApplicationManifestsCallCount()).To(Equal(1))
+						manifestApps, _ := fakeActor.WriteApplicationManifestsArgsForCall(0)
+						Expect(manifestApps).To(Equal([]manifest.Application{{Name: "app-a"}, {Name: "app-c"}}))
+					})
+				})
+
+				Context("when writing the manifest fails", func() {
+					BeforeEach(func() {
+						fakeActor.WriteApplicationManifestsReturns(manifest.ManifestCreationError{Err: errors.New("some-write-error")})
+					})
+
+					It("returns a translatable ManifestCreationError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ManifestCreationError{Err: errors.New("some-write-error")}))
+					})
+				})
+
+				Context("when no filepath is provided", func() {
+					BeforeEach(func() {
+						cmd.FilePath = ""
+					})
+
+					It("creates the manifest in the current directory as <space-name>_manifest.yml", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						_, pathArg := fakeActor.WriteApplicationManifestsArgsForCall(0)
+						Expect(pathArg).To(Equal(fmt.Sprintf(".%ssome-space_manifest.yml", string(os.PathSeparator))))
+					})
 				})
 			})
 		})
@@ -10,8 +10,9 @@ import (
 
 type CreateOrgCommand struct {
 	RequiredArgs    flag.Organization `positional-args:"yes"`
+	CloneFrom       string            `long:"clone-from" description:"Copy the quota, private domains and org roles of an existing org (spaces are not copied)"`
 	Quota           string            `short:"q" description:"Quota to assign to the newly created org (excluding this option results in assignment of default quota)"`
-	usage           interface{}       `usage:"CF_NAME create-org ORG"`
+	usage           interface{}       `usage:"CF_NAME create-org ORG [-q QUOTA | --clone-from SOURCE_ORG]"`
 	relatedCommands interface{}       `related_commands:"create-space, orgs, quotas, set-org-role"`
 }
 
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
@@ -100,6 +101,9 @@ func (cmd CreateRouteCommand) Execute(args []string) error {
 			cmd.UI.DisplayOK()
 			return nil
 		}
+		if _, ok := err.(ccerror.RoutePortTakenError); ok {
+			return translatableerror.RoutePortTakenError{Port: cmd.Port.Value, Domain: cmd.RequiredArgs.Domain}
+		}
 
 		return shared.HandleError(err)
 	}
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
@@ -335,6 +336,23 @@ var _ = Describe("Create Route Command", func() {
 				})
 			})
 
+			Context("when creating route returns a RoutePortTakenError", func() {
+				BeforeEach(func() {
+					cmd.Port = flag.Port{NullInt: types.NullInt{Value: 1024, IsSet: true}}
+
+					fakeActor.CreateRouteWithExistenceCheckReturns(
+						v2action.Route{},
+						v2action.Warnings{"create-route-warning-1"},
+						ccerror.RoutePortTakenError{Message: "The port is taken: 1024"},
+					)
+				})
+
+				It("returns a RoutePortTakenError suggesting --random-port", func() {
+					Expect(executeErr).To(MatchError(translatableerror.RoutePortTakenError{Port: 1024, Domain: "some-domain"}))
+					Expect(testUI.Err).To(Say("create-route-warning-1"))
+				})
+			})
+
 			Context("when creating route returns a generic error", func() {
 				var createRouteErr error
 				BeforeEach(func() {
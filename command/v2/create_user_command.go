@@ -1,6 +1,7 @@
 package v2
 
 import (
+	"io/ioutil"
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -16,12 +17,14 @@ import (
 
 type CreateUserActor interface {
 	CreateUser(username string, password string, origin string) (v2action.User, v2action.Warnings, error)
+	GetUserOrigins(username string) ([]string, error)
 }
 
 type CreateUserCommand struct {
 	Args            flag.CreateUser `positional-args:"yes"`
 	Origin          string          `long:"origin" description:"Origin for mapping a user account to a user in an external identity provider"`
-	usage           interface{}     `usage:"CF_NAME create-user USERNAME PASSWORD\n   CF_NAME create-user USERNAME --origin ORIGIN\n\nEXAMPLES:\n   cf create-user j.smith@example.com S3cr3t                  # internal user\n   cf create-user j.smith@example.com --origin ldap           # LDAP user\n   cf create-user j.smith@example.com --origin provider-alias # SAML or OpenID Connect federated user"`
+	PasswordStdin   bool            `long:"password-stdin" description:"Read the password from stdin instead of the command line, stripping one trailing newline"`
+	usage           interface{}     `usage:"CF_NAME create-user USERNAME PASSWORD\n   CF_NAME create-user USERNAME --password-stdin\n   CF_NAME create-user USERNAME --origin ORIGIN\n\nEXAMPLES:\n   cf create-user j.smith@example.com S3cr3t                  # internal user\n   cf create-user j.smith@example.com --password-stdin < pass # internal user, password read from a file\n   cf create-user j.smith@example.com --origin ldap           # LDAP user\n   cf create-user j.smith@example.com --origin provider-alias # SAML or OpenID Connect federated user"`
 	relatedCommands interface{}     `related_commands:"passwd, set-org-role, set-space-role"`
 
 	UI          command.UI
@@ -50,7 +53,18 @@ func (cmd *CreateUserCommand) Execute(args []string) error {
 	// empty string and a passed in empty string.
 	var password string
 
-	if (cmd.Origin == "" || strings.ToLower(cmd.Origin) == "uaa") && cmd.Args.Password == nil {
+	if cmd.PasswordStdin {
+		if cmd.Args.Password != nil {
+			return translatableerror.ArgumentCombinationError{
+				Args: []string{"PASSWORD", "--password-stdin"},
+			}
+		}
+		if !cmd.isUAAOrigin() {
+			return translatableerror.ArgumentCombinationError{
+				Args: []string{"--origin", "--password-stdin"},
+			}
+		}
+	} else if cmd.isUAAOrigin() && cmd.Args.Password == nil {
 		return translatableerror.RequiredArgumentError{
 			ArgumentName: "PASSWORD",
 		}
@@ -67,6 +81,13 @@ func (cmd *CreateUserCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.PasswordStdin {
+		password, err = cmd.readPassword()
+		if err != nil {
+			return err
+		}
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Creating user {{.TargetUser}}...", map[string]interface{}{
 		"TargetUser": cmd.Args.Username,
 	})
@@ -76,15 +97,24 @@ func (cmd *CreateUserCommand) Execute(args []string) error {
 
 	if err != nil {
 		if _, ok := err.(uaa.ConflictError); ok {
-			cmd.UI.DisplayWarning("user {{.User}} already exists", map[string]interface{}{
-				"User": cmd.Args.Username,
-			})
+			if origins, lookupErr := cmd.Actor.GetUserOrigins(cmd.Args.Username); lookupErr == nil && len(origins) > 0 {
+				cmd.UI.DisplayWarning("user {{.User}} already exists in origins: {{.Origins}}", map[string]interface{}{
+					"User":    cmd.Args.Username,
+					"Origins": strings.Join(origins, ", "),
+				})
+			} else {
+				cmd.UI.DisplayWarning("user {{.User}} already exists", map[string]interface{}{
+					"User": cmd.Args.Username,
+				})
+			}
 		} else {
 			cmd.UI.DisplayTextWithFlavor("Error creating user {{.User}}.", map[string]interface{}{
 				"User": cmd.Args.Username,
 			})
 			return err
 		}
+	} else {
+		cmd.displayOtherOrigins()
 	}
 
 	cmd.UI.DisplayOK()
@@ -95,3 +125,55 @@ func (cmd *CreateUserCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// isUAAOrigin returns true when the user is created in the UAA itself, and
+// therefore needs a password.
+func (cmd *CreateUserCommand) isUAAOrigin() bool {
+	return cmd.Origin == "" || strings.ToLower(cmd.Origin) == "uaa"
+}
+
+// readPassword reads the password from stdin until EOF, so that it does not
+// appear in the shell history or the process list. One trailing newline, as
+// added by echo or a text editor, is not part of the password.
+func (cmd *CreateUserCommand) readPassword() (string, error) {
+	input, err := ioutil.ReadAll(cmd.UI.Reader())
+	if err != nil {
+		return "", err
+	}
+
+	password := string(input)
+	if strings.HasSuffix(password, "\r\n") {
+		return strings.TrimSuffix(password, "\r\n"), nil
+	}
+	return strings.TrimSuffix(password, "\n"), nil
+}
+
+// displayOtherOrigins warns when users with the same username exist in other
+// origins, since they are separate accounts with separate roles. The lookup
+// is best effort; it needs the scim.read scope.
+func (cmd *CreateUserCommand) displayOtherOrigins() {
+	origins, err := cmd.Actor.GetUserOrigins(cmd.Args.Username)
+	if err != nil {
+		return
+	}
+
+	createdOrigin := strings.ToLower(cmd.Origin)
+	if createdOrigin == "" {
+		createdOrigin = "uaa"
+	}
+
+	var otherOrigins []string
+	for _, origin := range origins {
+		if strings.ToLower(origin) != createdOrigin {
+			otherOrigins = append(otherOrigins, origin)
+		}
+	}
+	if len(otherOrigins) == 0 {
+		return
+	}
+
+	cmd.UI.DisplayWarning("User {{.User}} also exists in origins: {{.Origins}}", map[string]interface{}{
+		"User":    cmd.Args.Username,
+		"Origins": strings.Join(otherOrigins, ", "),
+	})
+}
@@ -2,6 +2,7 @@ package v2_test
 
 import (
 	"errors"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -119,6 +120,49 @@ var _ = Describe("create-user Command", func() {
 			})
 		})
 
+		Context("when --password-stdin is provided", func() {
+			BeforeEach(func() {
+				cmd.Args.Password = nil
+				cmd.PasswordStdin = true
+				testUI.In = strings.NewReader("some stdin password\r\n")
+			})
+
+			It("creates the user with the password read from stdin", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.CreateUserCallCount()).To(Equal(1))
+				_, password, _ := fakeActor.CreateUserArgsForCall(0)
+				Expect(password).To(Equal("some stdin password"))
+			})
+
+			Context("when the password is also provided as an argument", func() {
+				BeforeEach(func() {
+					password := "some-password"
+					cmd.Args.Password = &password
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+						Args: []string{"PASSWORD", "--password-stdin"},
+					}))
+					Expect(fakeActor.CreateUserCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the origin is not UAA", func() {
+				BeforeEach(func() {
+					cmd.Origin = "ldap"
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+						Args: []string{"--origin", "--password-stdin"},
+					}))
+					Expect(fakeActor.CreateUserCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when no errors occur", func() {
 			BeforeEach(func() {
 				fakeActor.CreateUserReturns(
@@ -141,6 +185,32 @@ var _ = Describe("create-user Command", func() {
 				Expect(testUI.Out).To(Say("OK"))
 				Expect(testUI.Out).To(Say("TIP: Assign roles with 'faceman set-org-role' and 'faceman set-space-role'."))
 				Expect(testUI.Err).To(Say("warning"))
+				Expect(testUI.Err).ToNot(Say("also exists"))
+			})
+
+			Context("when the username also exists in other origins", func() {
+				BeforeEach(func() {
+					fakeActor.GetUserOriginsReturns([]string{"ldap", "some-origin", "uaa"}, nil)
+				})
+
+				It("warns about the users in the other origins", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.GetUserOriginsCallCount()).To(Equal(1))
+					Expect(fakeActor.GetUserOriginsArgsForCall(0)).To(Equal("some-user"))
+					Expect(testUI.Err).To(Say("User some-user also exists in origins: ldap, uaa"))
+				})
+			})
+
+			Context("when looking up the origins fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetUserOriginsReturns(nil, errors.New("insufficient scope"))
+				})
+
+				It("still creates the user", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("OK"))
+				})
 			})
 		})
 
@@ -180,6 +250,17 @@ var _ = Describe("create-user Command", func() {
 					Expect(testUI.Err).To(Say("warning-2"))
 					Expect(testUI.Err).To(Say("user some-user already exists"))
 				})
+
+				Context("when the origins of the existing users are found", func() {
+					BeforeEach(func() {
+						fakeActor.GetUserOriginsReturns([]string{"ldap", "uaa"}, nil)
+					})
+
+					It("displays the origins the user exists in", func() {
+						Expect(executeErr).To(BeNil())
+						Expect(testUI.Err).To(Say("user some-user already exists in origins: ldap, uaa"))
+					})
+				})
 			})
 		})
 	})
@@ -12,10 +12,13 @@ type CurlCommand struct {
 	RequiredArgs          flag.APIPath    `positional-args:"yes"`
 	CustomHeaders         []string        `short:"H" description:"Custom headers to include in the request, flag can be specified multiple times"`
 	HTTPMethod            string          `short:"X" description:"HTTP method (GET,POST,PUT,DELETE,etc)"`
-	HTTPData              flag.PathWithAt `short:"d" description:"HTTP data to include in the request body, or '@' followed by a file name to read the data from"`
+	HTTPData              flag.PathWithAt `short:"d" description:"HTTP data to include in the request body, '@' followed by a file name to read the data from, or '@-' to read the data from stdin"`
 	IncludeReponseHeaders bool            `short:"i" description:"Include response headers in the output"`
 	OutputFile            flag.Path       `long:"output" description:"Write curl body to FILE instead of stdout"`
-	usage                 interface{}     `usage:"CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE]\n\n   By default 'CF_NAME curl' will perform a GET to the specified PATH. If data\n   is provided via -d, a POST will be performed instead, and the Content-Type\n   will be set to application/json. You may override headers with -H and the\n   request method with -X.\n\n   For API documentation, please visit http://apidocs.cloudfoundry.org.\n\nEXAMPLES:\n   CF_NAME curl \"/v2/apps\" -X GET -H \"Content-Type: application/x-www-form-urlencoded\" -d 'q=name:myapp'\n   CF_NAME curl \"/v2/apps\" -d @/path/to/file"`
+	IncludeHeadersJSON    bool            `long:"include-headers-json" description:"Output a JSON object containing the response status, headers and body"`
+	Fail                  bool            `long:"fail" description:"Exit with a non-zero status and print the response body to stderr when the response status is 400 or higher"`
+	Paginate              bool            `long:"paginate" description:"Follow pagination links for GET requests and print all resources as a single JSON array"`
+	usage                 interface{}     `usage:"CF_NAME curl PATH [-iv] [-X METHOD] [-H HEADER] [-d DATA] [--output FILE] [--include-headers-json] [--fail] [--paginate]\n\n   By default 'CF_NAME curl' will perform a GET to the specified PATH. If data\n   is provided via -d, a POST will be performed instead, and the Content-Type\n   will be set to application/json. You may override headers with -H and the\n   request method with -X. Request bodies given with '-d @FILE' or '-d @-'\n   (stdin) and binary responses are streamed rather than held in memory.\n\n   With --paginate, GET requests that return a paginated list will follow\n   every 'next_url' (v2) or 'pagination.next.href' (v3) link and print the\n   combined 'resources' as a single JSON array.\n\n   With --include-headers-json, the response is written as a single JSON object\n   with 'status', 'headers' and 'body' keys. JSON bodies are embedded as is and\n   any other body is embedded as a string. Combined with --fail, the object is\n   still written before exiting with a non-zero status.\n\n   For API documentation, please visit http://apidocs.cloudfoundry.org.\n\nEXAMPLES:\n   CF_NAME curl \"/v2/apps\" -X GET -H \"Content-Type: application/x-www-form-urlencoded\" -d 'q=name:myapp'\n   CF_NAME curl \"/v2/apps\" -d @/path/to/file\n   cat app.json | CF_NAME curl \"/v3/apps\" -X POST -d @-"`
 }
 
 func (CurlCommand) Setup(config command.Config, ui command.UI) error {
@@ -10,8 +10,9 @@ import (
 
 type DeleteBuildpackCommand struct {
 	RequiredArgs    flag.BuildpackName `positional-args:"yes"`
-	Force           bool               `short:"f" description:"Force deletion without confirmation"`
-	usage           interface{}        `usage:"CF_NAME delete-buildpack BUILDPACK [-f]"`
+	Force           bool               `short:"f" long:"force" description:"Force deletion without confirmation and without checking for apps using the buildpack"`
+	Verbose         bool               `long:"verbose" description:"List the names of the apps using the buildpack in the confirmation prompt"`
+	usage           interface{}        `usage:"CF_NAME delete-buildpack BUILDPACK [-f] [--verbose]"`
 	relatedCommands interface{}        `related_commands:"buildpacks"`
 }
 
@@ -3,9 +3,13 @@ package v2
 import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 )
 
 //go:generate counterfeiter . DeleteOrganizationActor
@@ -15,15 +19,25 @@ type DeleteOrganizationActor interface {
 	ClearOrganizationAndSpace(config v2action.Config)
 }
 
+//go:generate counterfeiter . DeleteOrganizationActorV3
+
+type DeleteOrganizationActorV3 interface {
+	CloudControllerAPIVersion() string
+	DeleteOrganization(orgName string) (string, v3action.Warnings, error)
+	PollDeletionJob(jobURL string) (<-chan v3action.Warnings, <-chan error)
+}
+
 type DeleteOrgCommand struct {
 	RequiredArgs flag.Organization `positional-args:"yes"`
 	Force        bool              `short:"f" description:"Force deletion without confirmation"`
-	usage        interface{}       `usage:"CF_NAME delete-org ORG [-f]"`
+	Wait         flag.Wait         `long:"wait" optional:"true" optional-value:"true" description:"Wait for the deletion to finish, use --wait=false to return as soon as it has started"`
+	usage        interface{}       `usage:"CF_NAME delete-org ORG [-f] [--wait=false]"`
 
 	Config      command.Config
 	UI          command.UI
 	SharedActor command.SharedActor
 	Actor       DeleteOrganizationActor
+	ActorV3     DeleteOrganizationActorV3
 }
 
 func (cmd *DeleteOrgCommand) Setup(config command.Config, ui command.UI) error {
@@ -37,6 +51,15 @@ func (cmd *DeleteOrgCommand) Setup(config command.Config, ui command.UI) error {
 	}
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
 
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
 	return nil
 }
 
@@ -46,6 +69,11 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	async, err := cmd.useAsyncDeletion()
+	if err != nil {
+		return err
+	}
+
 	user, err := cmd.Config.CurrentUser()
 	if err != nil {
 		return err
@@ -70,6 +98,10 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 		"Username": user.Name,
 	})
 
+	if async {
+		return cmd.deleteAsync()
+	}
+
 	warnings, err := cmd.Actor.DeleteOrganization(cmd.RequiredArgs.Organization)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -91,3 +123,60 @@ func (cmd *DeleteOrgCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// useAsyncDeletion returns true when the org can be deleted through a v3
+// deletion job. --wait=false requires one.
+func (cmd DeleteOrgCommand) useAsyncDeletion() (bool, error) {
+	var err error
+	if cmd.ActorV3 == nil {
+		err = translatableerror.MinimumAPIVersionNotMetError{
+			Command:        "Option '--wait=false'",
+			MinimumVersion: ccversion.MinVersionAsyncDeletionV3,
+		}
+	} else {
+		err = command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionAsyncDeletionV3, "Option '--wait=false'")
+	}
+
+	if err != nil {
+		if cmd.Wait.Enabled() {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (cmd DeleteOrgCommand) deleteAsync() error {
+	jobURL, warnings, err := cmd.ActorV3.DeleteOrganization(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+		if !cmd.Wait.Enabled() {
+			cmd.UI.DisplayText("Deletion of org {{.OrgName}} has started. Follow its progress at: {{.JobURL}}", map[string]interface{}{
+				"OrgName": cmd.RequiredArgs.Organization,
+				"JobURL":  jobURL,
+			})
+			break
+		}
+
+		jobWarnings, jobErrs := cmd.ActorV3.PollDeletionJob(jobURL)
+		err = sharedV3.PollDeletion(cmd.UI, jobWarnings, jobErrs)
+		if err != nil {
+			return err
+		}
+	case v3action.OrganizationNotFoundError:
+		cmd.UI.DisplayText("Org {{.OrgName}} does not exist.", map[string]interface{}{
+			"OrgName": cmd.RequiredArgs.Organization,
+		})
+	default:
+		return sharedV3.HandleError(err)
+	}
+
+	if cmd.Config.TargetedOrganization().Name == cmd.RequiredArgs.Organization {
+		cmd.Actor.ClearOrganizationAndSpace(cmd.Config)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -3,9 +3,13 @@ package v2_test
 import (
 	"errors"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
@@ -268,4 +272,165 @@ var _ = Describe("delete-org Command", func() {
 			})
 		})
 	})
+
+	Context("when the v3 actor is not available", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when --wait=false is provided", func() {
+			BeforeEach(func() {
+				cmd.Wait = flag.Wait{IsSet: true, Value: false}
+			})
+
+			It("returns a MinimumAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					Command:        "Option '--wait=false'",
+					MinimumVersion: ccversion.MinVersionAsyncDeletionV3,
+				}))
+				Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the v3 actor is available", func() {
+		var (
+			fakeActorV3 *v2fakes.FakeDeleteOrganizationActorV3
+			warnings    chan v3action.Warnings
+			errs        chan error
+		)
+
+		BeforeEach(func() {
+			fakeActorV3 = new(v2fakes.FakeDeleteOrganizationActorV3)
+			cmd.ActorV3 = fakeActorV3
+			cmd.Force = true
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when the API does not support asynchronous deletion", func() {
+			BeforeEach(func() {
+				fakeActorV3.CloudControllerAPIVersionReturns("3.41.0")
+			})
+
+			It("deletes the org with the v2 API", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(1))
+				Expect(fakeActorV3.DeleteOrganizationCallCount()).To(Equal(0))
+			})
+
+			Context("when --wait=false is provided", func() {
+				BeforeEach(func() {
+					cmd.Wait = flag.Wait{IsSet: true, Value: false}
+				})
+
+				It("returns a MinimumAPIVersionNotMetError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+						Command:        "Option '--wait=false'",
+						CurrentVersion: "3.41.0",
+						MinimumVersion: ccversion.MinVersionAsyncDeletionV3,
+					}))
+					Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the API supports asynchronous deletion", func() {
+			BeforeEach(func() {
+				fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionAsyncDeletionV3)
+				fakeActorV3.DeleteOrganizationReturns("some-job-url", v3action.Warnings{"delete-warning"}, nil)
+
+				warnings = make(chan v3action.Warnings)
+				errs = make(chan error)
+				fakeActorV3.PollDeletionJobStub = func(string) (<-chan v3action.Warnings, <-chan error) {
+					go func() {
+						defer close(warnings)
+						defer close(errs)
+						warnings <- v3action.Warnings{"job-warning-1"}
+						warnings <- v3action.Warnings{"job-warning-2"}
+					}()
+					return warnings, errs
+				}
+			})
+
+			It("deletes the org, streaming the job's warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.DeleteOrganizationCallCount()).To(Equal(0))
+				Expect(fakeActorV3.DeleteOrganizationCallCount()).To(Equal(1))
+				Expect(fakeActorV3.DeleteOrganizationArgsForCall(0)).To(Equal("some-org"))
+				Expect(fakeActorV3.PollDeletionJobCallCount()).To(Equal(1))
+				Expect(fakeActorV3.PollDeletionJobArgsForCall(0)).To(Equal("some-job-url"))
+
+				Expect(testUI.Out).To(Say("Deleting org some-org as some-user\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+				Expect(testUI.Err).To(Say("job-warning-1"))
+				Expect(testUI.Err).To(Say("job-warning-2"))
+			})
+
+			Context("when the org is targeted", func() {
+				BeforeEach(func() {
+					fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+				})
+
+				It("clears the targeted org and space from the config", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.ClearOrganizationAndSpaceCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the org does not exist", func() {
+				BeforeEach(func() {
+					fakeActorV3.DeleteOrganizationReturns("", v3action.Warnings{"delete-warning"}, v3action.OrganizationNotFoundError{Name: "some-org"})
+				})
+
+				It("displays that the org does not exist", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Org some-org does not exist\\."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("delete-warning"))
+					Expect(fakeActorV3.PollDeletionJobCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the deletion job fails", func() {
+				BeforeEach(func() {
+					fakeActorV3.PollDeletionJobStub = func(string) (<-chan v3action.Warnings, <-chan error) {
+						go func() {
+							defer close(warnings)
+							defer close(errs)
+							warnings <- v3action.Warnings{"job-warning-1"}
+							errs <- actionerror.DeletionFailedError{
+								JobGUID: "some-job-guid",
+								Reasons: []string{"some-reason", "some-other-reason"},
+							}
+						}()
+						return warnings, errs
+					}
+				})
+
+				It("returns a DeletionFailedError listing the reasons", func() {
+					Expect(executeErr).To(MatchError(translatableerror.DeletionFailedError{
+						Reasons: []string{"some-reason", "some-other-reason"},
+					}))
+					Expect(testUI.Err).To(Say("job-warning-1"))
+					Expect(testUI.Out).ToNot(Say("OK"))
+				})
+			})
+
+			Context("when --wait=false is provided", func() {
+				BeforeEach(func() {
+					cmd.Wait = flag.Wait{IsSet: true, Value: false}
+				})
+
+				It("starts the deletion and displays the job URL", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActorV3.PollDeletionJobCallCount()).To(Equal(0))
+					Expect(testUI.Out).To(Say("Deletion of org some-org has started\\. Follow its progress at: some-job-url"))
+					Expect(testUI.Out).To(Say("OK"))
+				})
+			})
+		})
+	})
 })
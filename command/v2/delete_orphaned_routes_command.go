@@ -1,9 +1,13 @@
 package v2
 
 import (
+	"strconv"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
@@ -15,9 +19,11 @@ type DeleteOrphanedRoutesActor interface {
 }
 
 type DeleteOrphanedRoutesCommand struct {
-	Force           bool        `short:"f" description:"Force deletion without confirmation"`
-	usage           interface{} `usage:"CF_NAME delete-orphaned-routes [-f]"`
-	relatedCommands interface{} `related_commands:"delete-route, routes"`
+	Force           bool          `short:"f" description:"Force deletion without confirmation"`
+	DryRun          bool          `long:"dry-run" description:"List the orphaned routes that would be deleted without deleting them"`
+	OlderThan       flag.Duration `long:"older-than" description:"Only delete orphaned routes last updated longer ago than DURATION, e.g. 72h"`
+	usage           interface{}   `usage:"CF_NAME delete-orphaned-routes [-f] [--dry-run] [--older-than DURATION]"`
+	relatedCommands interface{}   `related_commands:"delete-route, routes"`
 
 	UI          command.UI
 	Actor       DeleteOrphanedRoutesActor
@@ -50,17 +56,6 @@ func (cmd *DeleteOrphanedRoutesCommand) Execute(args []string) error {
 		return err
 	}
 
-	if !cmd.Force {
-		deleteOrphanedRoutes, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete orphaned routes?")
-		if promptErr != nil {
-			return promptErr
-		}
-
-		if !deleteOrphanedRoutes {
-			return nil
-		}
-	}
-
 	cmd.UI.DisplayTextWithFlavor("Getting routes as {{.CurrentUser}} ...", map[string]interface{}{
 		"CurrentUser": user.Name,
 	})
@@ -77,6 +72,26 @@ func (cmd *DeleteOrphanedRoutesCommand) Execute(args []string) error {
 		}
 	}
 
+	routes = cmd.filterByAge(routes)
+
+	if cmd.DryRun {
+		cmd.displayRoutes(routes)
+		return nil
+	}
+
+	if !cmd.Force && len(routes) > 0 {
+		deleteOrphanedRoutes, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete {{.Count}} orphaned routes?", map[string]interface{}{
+			"Count": len(routes),
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteOrphanedRoutes {
+			return nil
+		}
+	}
+
 	for _, route := range routes {
 		cmd.UI.DisplayText("Deleting route {{.Route}} ...", map[string]interface{}{
 			"Route": route.String(),
@@ -93,3 +108,49 @@ func (cmd *DeleteOrphanedRoutesCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// filterByAge drops the routes updated more recently than --older-than.
+func (cmd DeleteOrphanedRoutesCommand) filterByAge(routes []v2action.Route) []v2action.Route {
+	if !cmd.OlderThan.IsSet {
+		return routes
+	}
+
+	cutoff := time.Now().Add(-cmd.OlderThan.Duration)
+	var oldRoutes []v2action.Route
+	for _, route := range routes {
+		if route.LastUpdated().Before(cutoff) {
+			oldRoutes = append(oldRoutes, route)
+		}
+	}
+	return oldRoutes
+}
+
+func (cmd DeleteOrphanedRoutesCommand) displayRoutes(routes []v2action.Route) {
+	if len(routes) == 0 {
+		cmd.UI.DisplayText("No orphaned routes would be deleted.")
+		return
+	}
+
+	cmd.UI.DisplayText("The following {{.Count}} orphaned routes would be deleted:", map[string]interface{}{
+		"Count": len(routes),
+	})
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("host"),
+			cmd.UI.TranslateText("domain"),
+			cmd.UI.TranslateText("path"),
+			cmd.UI.TranslateText("port"),
+		},
+	}
+	for _, route := range routes {
+		var port string
+		if route.Port.IsSet {
+			port = strconv.Itoa(route.Port.Value)
+		}
+		table = append(table, []string{route.Host, route.Domain.Name, route.Path, port})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
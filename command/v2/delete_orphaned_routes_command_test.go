@@ -2,13 +2,16 @@ package v2_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -102,166 +105,208 @@ var _ = Describe("deleted-orphaned-routes Command", func() {
 						nil)
 				})
 
-				Context("when the '-f' flag is provided", func() {
-					BeforeEach(func() {
-						cmd.Force = true
-					})
+				Context("when there are orphaned routes", func() {
+					var routes []v2action.Route
 
-					It("does not prompt for user confirmation", func() {
-						Expect(executeErr).ToNot(HaveOccurred())
+					BeforeEach(func() {
+						routes = []v2action.Route{
+							{
+								GUID: "route-1-guid",
+								Host: "route-1",
+								Domain: v2action.Domain{
+									Name: "bosh-lite.com",
+								},
+								Path:      "/path",
+								CreatedAt: time.Now().Add(-72 * time.Hour),
+							},
+							{
+								GUID: "route-2-guid",
+								Host: "route-2",
+								Domain: v2action.Domain{
+									Name: "bosh-lite.com",
+								},
+								CreatedAt: time.Now().Add(-72 * time.Hour),
+								UpdatedAt: time.Now().Add(-time.Hour),
+							},
+							{
+								GUID: "route-3-guid",
+								Domain: v2action.Domain{
+									Name: "tcp.bosh-lite.com",
+								},
+								Port:      types.NullInt{Value: 1024, IsSet: true},
+								CreatedAt: time.Now().Add(-time.Hour),
+							},
+						}
 
-						Expect(testUI.Out).ToNot(Say("Really delete orphaned routes\\? \\[yN\\]:"))
+						fakeActor.GetOrphanedRoutesBySpaceReturns(routes, nil, nil)
 					})
-				})
 
-				Context("when the '-f' flag is not provided", func() {
-					Context("when user is prompted for confirmation", func() {
+					Context("when the '-f' flag is provided", func() {
 						BeforeEach(func() {
-							_, err := input.Write([]byte("\n"))
-							Expect(err).NotTo(HaveOccurred())
+							cmd.Force = true
 						})
 
-						It("displays the interactive prompt", func() {
+						It("does not prompt for user confirmation", func() {
 							Expect(executeErr).ToNot(HaveOccurred())
 
-							Expect(testUI.Out).To(Say("Really delete orphaned routes\\? \\[yN\\]:"))
+							Expect(testUI.Out).ToNot(Say("Really delete"))
+							Expect(fakeActor.DeleteRouteCallCount()).To(Equal(3))
 						})
 					})
 
-					Context("when the user inputs no", func() {
+					Context("when the '--dry-run' flag is provided", func() {
 						BeforeEach(func() {
-							_, err := input.Write([]byte("n\n"))
-							Expect(err).NotTo(HaveOccurred())
+							cmd.DryRun = true
 						})
 
-						It("does not delete orphaned routes", func() {
+						It("lists the routes that would be deleted without prompting or deleting them", func() {
 							Expect(executeErr).ToNot(HaveOccurred())
 
-							Expect(fakeActor.GetOrphanedRoutesBySpaceCallCount()).To(Equal(0))
+							Expect(testUI.Out).To(Say("Getting routes as some-user ...\n"))
+							Expect(testUI.Out).To(Say("The following 3 orphaned routes would be deleted:"))
+							Expect(testUI.Out).To(Say(`host\s+domain\s+path\s+port`))
+							Expect(testUI.Out).To(Say(`route-1\s+bosh-lite.com\s+/path`))
+							Expect(testUI.Out).To(Say(`route-2\s+bosh-lite.com`))
+							Expect(testUI.Out).To(Say(`tcp.bosh-lite.com\s+1024`))
+							Expect(testUI.Out).ToNot(Say("Really delete"))
+
 							Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
 						})
+
+						Context("when no routes match", func() {
+							BeforeEach(func() {
+								fakeActor.GetOrphanedRoutesBySpaceReturns(nil, nil, v2action.OrphanedRoutesNotFoundError{})
+							})
+
+							It("says that nothing would be deleted", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say("No orphaned routes would be deleted."))
+							})
+						})
 					})
 
-					Context("when the user input is invalid", func() {
+					Context("when the '--older-than' flag is provided", func() {
 						BeforeEach(func() {
-							_, err := input.Write([]byte("e\n"))
-							Expect(err).NotTo(HaveOccurred())
+							cmd.Force = true
+							cmd.OlderThan = flag.Duration{Duration: 2 * time.Hour, IsSet: true}
 						})
 
-						It("returns an error", func() {
-							Expect(executeErr).To(HaveOccurred())
+						It("only deletes routes last updated before the duration", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
 
-							Expect(fakeActor.GetOrphanedRoutesBySpaceCallCount()).To(Equal(0))
-							Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
+							Expect(fakeActor.DeleteRouteCallCount()).To(Equal(1))
+							Expect(fakeActor.DeleteRouteArgsForCall(0)).To(Equal("route-1-guid"))
+							Expect(testUI.Out).To(Say("Deleting route route-1.bosh-lite.com/path..."))
+							Expect(testUI.Out).To(Say("OK"))
+						})
+
+						Context("when combined with '--dry-run'", func() {
+							BeforeEach(func() {
+								cmd.DryRun = true
+							})
+
+							It("lists only the routes old enough to be deleted", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).To(Say("The following 1 orphaned routes would be deleted:"))
+								Expect(testUI.Out).To(Say(`route-1\s+bosh-lite.com\s+/path`))
+								Expect(testUI.Out).ToNot(Say("route-2"))
+								Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
+							})
 						})
 					})
 
-					Context("when the user inputs yes", func() {
-						var routes []v2action.Route
+					Context("when the '-f' flag is not provided", func() {
+						Context("when user is prompted for confirmation", func() {
+							BeforeEach(func() {
+								_, err := input.Write([]byte("\n"))
+								Expect(err).NotTo(HaveOccurred())
+							})
 
-						BeforeEach(func() {
-							_, err := input.Write([]byte("y\n"))
-							Expect(err).NotTo(HaveOccurred())
-
-							routes = []v2action.Route{
-								{
-									GUID: "route-1-guid",
-									Host: "route-1",
-									Domain: v2action.Domain{
-										Name: "bosh-lite.com",
-									},
-									Path: "/path",
-								},
-								{
-									GUID: "route-2-guid",
-									Host: "route-2",
-									Domain: v2action.Domain{
-										Name: "bosh-lite.com",
-									},
-								},
-							}
+							It("displays the interactive prompt with the number of routes", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
 
-							fakeActor.GetOrphanedRoutesBySpaceReturns(routes, nil, nil)
+								Expect(testUI.Out).To(Say("Really delete 3 orphaned routes\\? \\[yN\\]:"))
+							})
 						})
 
-						It("displays getting routes message", func() {
-							Expect(executeErr).ToNot(HaveOccurred())
+						Context("when the user inputs no", func() {
+							BeforeEach(func() {
+								_, err := input.Write([]byte("n\n"))
+								Expect(err).NotTo(HaveOccurred())
+							})
 
-							Expect(testUI.Out).To(Say("Getting routes as some-user ...\n"))
+							It("does not delete orphaned routes", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
+							})
 						})
 
-						It("deletes the routes and displays that they are deleted", func() {
-							Expect(executeErr).ToNot(HaveOccurred())
+						Context("when the user input is invalid", func() {
+							BeforeEach(func() {
+								_, err := input.Write([]byte("e\n"))
+								Expect(err).NotTo(HaveOccurred())
+							})
 
-							Expect(fakeActor.GetOrphanedRoutesBySpaceCallCount()).To(Equal(1))
-							Expect(fakeActor.GetOrphanedRoutesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
-							Expect(fakeActor.DeleteRouteCallCount()).To(Equal(2))
-							Expect(fakeActor.DeleteRouteArgsForCall(0)).To(Equal(routes[0].GUID))
-							Expect(fakeActor.DeleteRouteArgsForCall(1)).To(Equal(routes[1].GUID))
+							It("returns an error", func() {
+								Expect(executeErr).To(HaveOccurred())
 
-							Expect(testUI.Out).To(Say("Deleting route route-1.bosh-lite.com/path..."))
-							Expect(testUI.Out).To(Say("Deleting route route-2.bosh-lite.com..."))
-							Expect(testUI.Out).To(Say("OK"))
+								Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
+							})
 						})
 
-						Context("when there are warnings", func() {
+						Context("when the user inputs yes", func() {
 							BeforeEach(func() {
-								fakeActor.GetOrphanedRoutesBySpaceReturns(
-									[]v2action.Route{{GUID: "some-route-guid"}},
-									[]string{"foo", "bar"},
-									nil)
-								fakeActor.DeleteRouteReturns([]string{"baz"}, nil)
+								_, err := input.Write([]byte("y\n"))
+								Expect(err).NotTo(HaveOccurred())
 							})
 
-							It("displays the warnings", func() {
+							It("displays getting routes message", func() {
 								Expect(executeErr).ToNot(HaveOccurred())
 
-								Expect(testUI.Err).To(Say("foo"))
-								Expect(testUI.Err).To(Say("bar"))
-								Expect(testUI.Err).To(Say("baz"))
+								Expect(testUI.Out).To(Say("Getting routes as some-user ...\n"))
 							})
-						})
 
-						Context("when getting the routes returns an error", func() {
-							var expectedErr error
+							It("deletes the routes and displays that they are deleted", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
 
-							Context("when the error is a DomainNotFoundError", func() {
-								BeforeEach(func() {
-									fakeActor.GetOrphanedRoutesBySpaceReturns(
-										nil,
-										nil,
-										v2action.DomainNotFoundError{
-											Name: "some-domain",
-											GUID: "some-domain-guid",
-										},
-									)
-								})
+								Expect(fakeActor.GetOrphanedRoutesBySpaceCallCount()).To(Equal(1))
+								Expect(fakeActor.GetOrphanedRoutesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+								Expect(fakeActor.DeleteRouteCallCount()).To(Equal(3))
+								Expect(fakeActor.DeleteRouteArgsForCall(0)).To(Equal(routes[0].GUID))
+								Expect(fakeActor.DeleteRouteArgsForCall(1)).To(Equal(routes[1].GUID))
+								Expect(fakeActor.DeleteRouteArgsForCall(2)).To(Equal(routes[2].GUID))
 
-								It("returns translatableerror.DomainNotFoundError", func() {
-									Expect(executeErr).To(MatchError(translatableerror.DomainNotFoundError{
-										Name: "some-domain",
-										GUID: "some-domain-guid",
-									}))
-								})
+								Expect(testUI.Out).To(Say("Deleting route route-1.bosh-lite.com/path..."))
+								Expect(testUI.Out).To(Say("Deleting route route-2.bosh-lite.com..."))
+								Expect(testUI.Out).To(Say("OK"))
 							})
 
-							Context("when the error is an OrphanedRoutesNotFoundError", func() {
+							Context("when there are warnings", func() {
 								BeforeEach(func() {
-									expectedErr = v2action.OrphanedRoutesNotFoundError{}
-									fakeActor.GetOrphanedRoutesBySpaceReturns(nil, nil, expectedErr)
+									fakeActor.GetOrphanedRoutesBySpaceReturns(
+										[]v2action.Route{{GUID: "some-route-guid"}},
+										[]string{"foo", "bar"},
+										nil)
+									fakeActor.DeleteRouteReturns([]string{"baz"}, nil)
 								})
 
-								It("should not return an error and only display 'OK'", func() {
+								It("displays the warnings", func() {
 									Expect(executeErr).ToNot(HaveOccurred())
 
-									Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
+									Expect(testUI.Err).To(Say("foo"))
+									Expect(testUI.Err).To(Say("bar"))
+									Expect(testUI.Err).To(Say("baz"))
 								})
 							})
 
-							Context("when there is a generic error", func() {
+							Context("when deleting a route returns an error", func() {
+								var expectedErr error
+
 								BeforeEach(func() {
-									expectedErr = errors.New("getting orphaned routes error")
-									fakeActor.GetOrphanedRoutesBySpaceReturns(nil, nil, expectedErr)
+									expectedErr = errors.New("deleting route error")
+									fakeActor.DeleteRouteReturns(nil, expectedErr)
 								})
 
 								It("returns the error", func() {
@@ -269,22 +314,55 @@ var _ = Describe("deleted-orphaned-routes Command", func() {
 								})
 							})
 						})
+					})
+				})
 
-						Context("when deleting a route returns an error", func() {
-							var expectedErr error
+				Context("when getting the routes returns an error", func() {
+					var expectedErr error
 
-							BeforeEach(func() {
-								expectedErr = errors.New("deleting route error")
-								fakeActor.GetOrphanedRoutesBySpaceReturns(
-									[]v2action.Route{{GUID: "some-route-guid"}},
-									nil,
-									nil)
-								fakeActor.DeleteRouteReturns(nil, expectedErr)
-							})
+					Context("when the error is a DomainNotFoundError", func() {
+						BeforeEach(func() {
+							fakeActor.GetOrphanedRoutesBySpaceReturns(
+								nil,
+								nil,
+								v2action.DomainNotFoundError{
+									Name: "some-domain",
+									GUID: "some-domain-guid",
+								},
+							)
+						})
 
-							It("returns the error", func() {
-								Expect(executeErr).To(MatchError(expectedErr))
-							})
+						It("returns translatableerror.DomainNotFoundError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.DomainNotFoundError{
+								Name: "some-domain",
+								GUID: "some-domain-guid",
+							}))
+						})
+					})
+
+					Context("when the error is an OrphanedRoutesNotFoundError", func() {
+						BeforeEach(func() {
+							expectedErr = v2action.OrphanedRoutesNotFoundError{}
+							fakeActor.GetOrphanedRoutesBySpaceReturns(nil, nil, expectedErr)
+						})
+
+						It("should not prompt or return an error and only display 'OK'", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).ToNot(Say("Really delete"))
+							Expect(testUI.Out).To(Say("OK"))
+							Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when there is a generic error", func() {
+						BeforeEach(func() {
+							expectedErr = errors.New("getting orphaned routes error")
+							fakeActor.GetOrphanedRoutesBySpaceReturns(nil, nil, expectedErr)
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
 						})
 					})
 				})
@@ -3,9 +3,13 @@ package v2
 import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 )
 
 //go:generate counterfeiter . DeleteSpaceActor
@@ -14,16 +18,26 @@ type DeleteSpaceActor interface {
 	DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (v2action.Warnings, error)
 }
 
+//go:generate counterfeiter . DeleteSpaceActorV3
+
+type DeleteSpaceActorV3 interface {
+	CloudControllerAPIVersion() string
+	DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (string, v3action.Warnings, error)
+	PollDeletionJob(jobURL string) (<-chan v3action.Warnings, <-chan error)
+}
+
 type DeleteSpaceCommand struct {
 	RequiredArgs flag.Space  `positional-args:"yes"`
 	Force        bool        `short:"f" description:"Force deletion without confirmation"`
 	Org          string      `short:"o" description:"Delete space within specified org"`
-	usage        interface{} `usage:"CF_NAME delete-space SPACE [-o ORG] [-f]"`
+	Wait         flag.Wait   `long:"wait" optional:"true" optional-value:"true" description:"Wait for the deletion to finish, use --wait=false to return as soon as it has started"`
+	usage        interface{} `usage:"CF_NAME delete-space SPACE [-o ORG] [-f] [--wait=false]"`
 
 	Config      command.Config
 	UI          command.UI
 	SharedActor command.SharedActor
 	Actor       DeleteSpaceActor
+	ActorV3     DeleteSpaceActorV3
 }
 
 func (cmd *DeleteSpaceCommand) Setup(config command.Config, ui command.UI) error {
@@ -37,6 +51,15 @@ func (cmd *DeleteSpaceCommand) Setup(config command.Config, ui command.UI) error
 	}
 	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
 
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
 	return nil
 }
 
@@ -58,6 +81,11 @@ func (cmd DeleteSpaceCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	async, err := cmd.useAsyncDeletion()
+	if err != nil {
+		return err
+	}
+
 	user, err := cmd.Config.CurrentUser()
 	if err != nil {
 		return shared.HandleError(err)
@@ -84,10 +112,17 @@ func (cmd DeleteSpaceCommand) Execute(args []string) error {
 			"CurrentUser": user.Name,
 		})
 
-	warnings, err := cmd.Actor.DeleteSpaceByNameAndOrganizationName(cmd.RequiredArgs.Space, orgName)
-	cmd.UI.DisplayWarnings(warnings)
-	if err != nil {
-		return shared.HandleError(err)
+	if async {
+		err = cmd.deleteAsync(orgName)
+		if err != nil {
+			return err
+		}
+	} else {
+		warnings, err := cmd.Actor.DeleteSpaceByNameAndOrganizationName(cmd.RequiredArgs.Space, orgName)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
 	}
 
 	cmd.UI.DisplayOK()
@@ -101,3 +136,44 @@ func (cmd DeleteSpaceCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// useAsyncDeletion returns true when the space can be deleted through a v3
+// deletion job. --wait=false requires one.
+func (cmd DeleteSpaceCommand) useAsyncDeletion() (bool, error) {
+	var err error
+	if cmd.ActorV3 == nil {
+		err = translatableerror.MinimumAPIVersionNotMetError{
+			Command:        "Option '--wait=false'",
+			MinimumVersion: ccversion.MinVersionAsyncDeletionV3,
+		}
+	} else {
+		err = command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionAsyncDeletionV3, "Option '--wait=false'")
+	}
+
+	if err != nil {
+		if cmd.Wait.Enabled() {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (cmd DeleteSpaceCommand) deleteAsync(orgName string) error {
+	jobURL, warnings, err := cmd.ActorV3.DeleteSpaceByNameAndOrganizationName(cmd.RequiredArgs.Space, orgName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	if !cmd.Wait.Enabled() {
+		cmd.UI.DisplayText("Deletion of space {{.SpaceName}} has started. Follow its progress at: {{.JobURL}}", map[string]interface{}{
+			"SpaceName": cmd.RequiredArgs.Space,
+			"JobURL":    jobURL,
+		})
+		return nil
+	}
+
+	jobWarnings, jobErrs := cmd.ActorV3.PollDeletionJob(jobURL)
+	return sharedV3.PollDeletion(cmd.UI, jobWarnings, jobErrs)
+}
@@ -3,9 +3,13 @@ package v2_test
 import (
 	"errors"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
@@ -295,4 +299,124 @@ var _ = Describe("delete-space Command", func() {
 			})
 		})
 	})
+
+	Context("when the v3 actor is not available and --wait=false is provided", func() {
+		BeforeEach(func() {
+			cmd.Org = "some-org"
+			cmd.Force = true
+			cmd.Wait = flag.Wait{IsSet: true, Value: false}
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '--wait=false'",
+				MinimumVersion: ccversion.MinVersionAsyncDeletionV3,
+			}))
+			Expect(fakeActor.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the API supports asynchronous deletion", func() {
+		var (
+			fakeActorV3 *v2fakes.FakeDeleteSpaceActorV3
+			warnings    chan v3action.Warnings
+			errs        chan error
+		)
+
+		BeforeEach(func() {
+			fakeActorV3 = new(v2fakes.FakeDeleteSpaceActorV3)
+			fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionAsyncDeletionV3)
+			fakeActorV3.DeleteSpaceByNameAndOrganizationNameReturns("some-job-url", v3action.Warnings{"delete-warning"}, nil)
+			cmd.ActorV3 = fakeActorV3
+			cmd.Org = "some-org"
+			cmd.Force = true
+
+			warnings = make(chan v3action.Warnings)
+			errs = make(chan error)
+			fakeActorV3.PollDeletionJobStub = func(string) (<-chan v3action.Warnings, <-chan error) {
+				go func() {
+					defer close(warnings)
+					defer close(errs)
+					warnings <- v3action.Warnings{"job-warning-1"}
+					warnings <- v3action.Warnings{"job-warning-2"}
+				}()
+				return warnings, errs
+			}
+		})
+
+		It("deletes the space, streaming the job's warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(0))
+			Expect(fakeActorV3.DeleteSpaceByNameAndOrganizationNameCallCount()).To(Equal(1))
+			spaceArg, orgArg := fakeActorV3.DeleteSpaceByNameAndOrganizationNameArgsForCall(0)
+			Expect(spaceArg).To(Equal("some-space"))
+			Expect(orgArg).To(Equal("some-org"))
+			Expect(fakeActorV3.PollDeletionJobArgsForCall(0)).To(Equal("some-job-url"))
+
+			Expect(testUI.Out).To(Say("Deleting space some-space in org some-org as some-user\\.\\.\\."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("delete-warning"))
+			Expect(testUI.Err).To(Say("job-warning-1"))
+			Expect(testUI.Err).To(Say("job-warning-2"))
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeActorV3.DeleteSpaceByNameAndOrganizationNameReturns("", v3action.Warnings{"delete-warning"}, v3action.SpaceNotFoundError{Name: "some-space"})
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "some-space"}))
+				Expect(testUI.Err).To(Say("delete-warning"))
+				Expect(fakeActorV3.PollDeletionJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the deletion job fails", func() {
+			BeforeEach(func() {
+				fakeActorV3.PollDeletionJobStub = func(string) (<-chan v3action.Warnings, <-chan error) {
+					go func() {
+						defer close(warnings)
+						defer close(errs)
+						errs <- actionerror.DeletionFailedError{
+							JobGUID: "some-job-guid",
+							Reasons: []string{"some-reason"},
+						}
+					}()
+					return warnings, errs
+				}
+			})
+
+			It("returns a DeletionFailedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.DeletionFailedError{Reasons: []string{"some-reason"}}))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+
+		Context("when the space is targeted", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+				fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+			})
+
+			It("untargets the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when --wait=false is provided", func() {
+			BeforeEach(func() {
+				cmd.Wait = flag.Wait{IsSet: true, Value: false}
+			})
+
+			It("starts the deletion and displays the job URL", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActorV3.PollDeletionJobCallCount()).To(Equal(0))
+				Expect(testUI.Out).To(Say("Deletion of space some-space has started\\. Follow its progress at: some-job-url"))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+	})
 })
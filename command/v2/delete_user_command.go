@@ -11,7 +11,8 @@ import (
 type DeleteUserCommand struct {
 	RequiredArgs    flag.Username `positional-args:"yes"`
 	Force           bool          `short:"f" description:"Force deletion without confirmation"`
-	usage           interface{}   `usage:"CF_NAME delete-user USERNAME [-f]"`
+	Origin          string        `long:"origin" description:"Origin of the user to delete, required when users with the same username exist in several origins"`
+	usage           interface{}   `usage:"CF_NAME delete-user USERNAME [-f] [--origin ORIGIN]"`
 	relatedCommands interface{}   `related_commands:"org-users"`
 }
 
@@ -10,7 +10,8 @@ import (
 
 type DisableSSHCommand struct {
 	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME disable-ssh APP_NAME"`
+	Restart         bool         `long:"restart" description:"Restart running instances one at a time so the change takes effect without downtime"`
+	usage           interface{}  `usage:"CF_NAME disable-ssh APP_NAME [--restart]"`
 	relatedCommands interface{}  `related_commands:"disallow-space-ssh, space-ssh-allowed, ssh, ssh-enabled"`
 }
 
@@ -5,11 +5,14 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 )
 
 type DomainsCommand struct {
-	usage           interface{} `usage:"CF_NAME domains"`
-	relatedCommands interface{} `related_commands:"router-groups, create-route, routes"`
+	JSON            bool              `long:"json" description:"Output the domains as JSON"`
+	Output          flag.OutputFormat `long:"output" description:"Output format; only 'json' is supported. Includes shared organizations and router groups, sorted by name"`
+	usage           interface{}       `usage:"CF_NAME domains [--json | --output json]"`
+	relatedCommands interface{}       `related_commands:"router-groups, create-route, routes"`
 }
 
 func (DomainsCommand) Setup(config command.Config, ui command.UI) error {
@@ -10,7 +10,8 @@ import (
 
 type EnableSSHCommand struct {
 	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME enable-ssh APP_NAME"`
+	Restart         bool         `long:"restart" description:"Restart running instances one at a time so the change takes effect without downtime"`
+	usage           interface{}  `usage:"CF_NAME enable-ssh APP_NAME [--restart]"`
 	relatedCommands interface{}  `related_commands:"allow-space-ssh, space-ssh-allowed, ssh, ssh-enabled"`
 }
 
@@ -10,7 +10,8 @@ import (
 
 type EnvCommand struct {
 	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME env APP_NAME"`
+	Running         bool         `long:"running" description:"Compare the desired user-provided env variables with the ones last applied to running instances, and list the instances started before the app was last updated"`
+	usage           interface{}  `usage:"CF_NAME env APP_NAME [--running]"`
 	relatedCommands interface{}  `related_commands:"app, apps, set-env, unset-env, running-environment-variable-group, staging-environment-variable-group"`
 }
 
@@ -10,7 +10,8 @@ import (
 
 type FeatureFlagCommand struct {
 	RequiredArgs    flag.Feature `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME feature-flag FEATURE_NAME"`
+	JSON            bool         `long:"json" description:"Output the feature flag as JSON"`
+	usage           interface{}  `usage:"CF_NAME feature-flag FEATURE_NAME [--json]"`
 	relatedCommands interface{}  `related_commands:"disable-feature-flag, enable-feature-flag, feature-flags"`
 }
 
@@ -8,7 +8,8 @@ import (
 )
 
 type FeatureFlagsCommand struct {
-	usage           interface{} `usage:"CF_NAME feature-flags"`
+	JSON            bool        `long:"json" description:"Output the feature flags as JSON"`
+	usage           interface{} `usage:"CF_NAME feature-flags [--json]"`
 	relatedCommands interface{} `related_commands:"disable-feature-flag, enable-feature-flag"`
 }
 
@@ -9,14 +9,16 @@ import (
 
 type LoginCommand struct {
 	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
+	ClientCredentials bool        `long:"client-credentials" hidden:"true" description:"Use 'CF_NAME auth --client-credentials' to log in a service account"`
 	Organization      string      `short:"o" description:"Org"`
+	Origin            string      `long:"origin" description:"Origin of the identity provider to log in with, when UAA has more than one (e.g. ldap)"`
 	Password          string      `short:"p" description:"Password"`
 	Space             string      `short:"s" description:"Space"`
 	SkipSSLValidation bool        `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
 	SSO               bool        `long:"sso" description:"Prompt for a one-time passcode to login"`
-	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
+	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode; use '-' to read it from stdin"`
 	Username          string      `short:"u" description:"Username"`
-	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)"`
+	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE] [--origin ORIGIN]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login -a https://api.example.com --sso-passcode PASSCODE -o ORG -s SPACE (log in without any prompts)\n   CF_NAME login -u name@example.com -p pa55woRD --origin ldap (log in with the ldap identity provider)"`
 	relatedCommands   interface{} `related_commands:"api, auth, target"`
 }
 
@@ -1,13 +1,15 @@
 package v2
 
 import (
-	"github.com/cloudfoundry/noaa/consumer"
+	"encoding/json"
+	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 //go:generate counterfeiter . LogsActor
@@ -18,16 +20,18 @@ type LogsActor interface {
 }
 
 type LogsCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	RequiredArgs    flag.AppName            `positional-args:"yes"`
+	Recent          bool                    `long:"recent" description:"Dump recent logs instead of tailing"`
+	TimestampFormat flag.LogTimestampFormat `long:"timestamp-format" description:"Format of log line timestamps: rfc3339 or epoch with nanosecond precision, or none to omit them"`
+	JSON            bool                    `long:"json" description:"Output each log envelope as a line of JSON with its nanosecond timestamp"`
+	usage           interface{}             `usage:"CF_NAME logs APP_NAME [--recent] [--timestamp-format (rfc3339 | epoch | none)] [--json]"`
+	relatedCommands interface{}             `related_commands:"app, apps, ssh"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       LogsActor
-	NOAAClient  *consumer.Consumer
+	NOAAClient  v2action.NOAAClient
 }
 
 func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
@@ -57,6 +61,18 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if !cmd.JSON {
+		cmd.displayFlavorText(user)
+	}
+
+	if cmd.Recent {
+		return cmd.displayRecentLogs()
+	}
+
+	return cmd.streamLogs()
+}
+
+func (cmd LogsCommand) displayFlavorText(user configv3.User) {
 	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
 		map[string]interface{}{
 			"AppName":   cmd.RequiredArgs.AppName,
@@ -65,12 +81,6 @@ func (cmd LogsCommand) Execute(args []string) error {
 			"Username":  user.Name,
 		})
 	cmd.UI.DisplayNewline()
-
-	if cmd.Recent {
-		return cmd.displayRecentLogs()
-	}
-
-	return cmd.streamLogs()
 }
 
 func (cmd LogsCommand) displayRecentLogs() error {
@@ -82,11 +92,11 @@ func (cmd LogsCommand) displayRecentLogs() error {
 	)
 
 	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+		cmd.displayLogMessage(message)
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
-	return err
+	return shared.HandleError(err)
 }
 
 func (cmd LogsCommand) streamLogs() error {
@@ -99,7 +109,7 @@ func (cmd LogsCommand) streamLogs() error {
 
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
-		return err
+		return shared.HandleError(err)
 	}
 
 	var messagesClosed, errLogsClosed bool
@@ -111,7 +121,7 @@ func (cmd LogsCommand) streamLogs() error {
 				break
 			}
 
-			cmd.UI.DisplayLogMessage(message, true)
+			cmd.displayLogMessage(*message)
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
@@ -129,3 +139,33 @@ func (cmd LogsCommand) streamLogs() error {
 
 	return nil
 }
+
+// logEnvelopeJSON is the --json representation of a log message. The
+// timestamp is always in nanoseconds since the Unix epoch, regardless of
+// --timestamp-format.
+type logEnvelopeJSON struct {
+	Timestamp      int64  `json:"timestamp"`
+	SourceType     string `json:"source_type"`
+	SourceInstance string `json:"source_instance"`
+	MessageType    string `json:"message_type"`
+	Message        string `json:"message"`
+}
+
+func (cmd LogsCommand) displayLogMessage(message v2action.LogMessage) {
+	if !cmd.JSON {
+		cmd.UI.DisplayLogMessageWithTimestampFormat(message, cmd.TimestampFormat.Format)
+		return
+	}
+
+	output, err := json.Marshal(logEnvelopeJSON{
+		Timestamp:      message.Timestamp().UnixNano(),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		MessageType:    message.Type(),
+		Message:        message.Message(),
+	})
+	if err != nil {
+		return
+	}
+	fmt.Fprintln(cmd.UI.Writer(), string(output))
+}
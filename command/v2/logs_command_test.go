@@ -4,9 +4,11 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
@@ -107,6 +109,16 @@ var _ = Describe("logs command", func() {
 				})
 			})
 
+			Context("when logging is unavailable on the platform", func() {
+				BeforeEach(func() {
+					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(nil, nil, actionerror.LoggingUnavailableError{})
+				})
+
+				It("returns a LoggingUnavailableError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.LoggingUnavailableError{}))
+				})
+			})
+
 			Context("when the logs actor returns logs", func() {
 				BeforeEach(func() {
 					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
@@ -146,6 +158,32 @@ var _ = Describe("logs command", func() {
 					Expect(client).To(Equal(noaaClient))
 					Expect(config).To(Equal(fakeConfig))
 				})
+
+				Context("when --timestamp-format is provided", func() {
+					BeforeEach(func() {
+						cmd.TimestampFormat = flag.LogTimestampFormat{Format: "epoch"}
+					})
+
+					It("formats the timestamps accordingly", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).To(Say(`0\.000000000 \[app/1\] OUT i am message 1`))
+						Expect(testUI.Out).To(Say(`1\.000000000 \[another-app/2\] OUT i am message 2`))
+					})
+				})
+
+				Context("when --json is provided", func() {
+					BeforeEach(func() {
+						cmd.JSON = true
+						cmd.TimestampFormat = flag.LogTimestampFormat{Format: "none"}
+					})
+
+					It("outputs one JSON envelope per message with nanosecond timestamps and no flavor text", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("Retrieving logs"))
+						Expect(testUI.Out).To(Say(`{"timestamp":0,"source_type":"app","source_instance":"1","message_type":"OUT","message":"i am message 1"}\n`))
+						Expect(testUI.Out).To(Say(`{"timestamp":1000000000,"source_type":"another-app","source_instance":"2","message_type":"OUT","message":"i am message 2"}\n`))
+					})
+				})
 			})
 		})
 
@@ -169,6 +207,17 @@ var _ = Describe("logs command", func() {
 				})
 			})
 
+			Context("when logging is unavailable on the platform", func() {
+				BeforeEach(func() {
+					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceReturns(nil, nil, v2action.Warnings{"some-warning-1"}, actionerror.LoggingUnavailableError{})
+				})
+
+				It("returns a LoggingUnavailableError and all warnings", func() {
+					Expect(executeErr).To(MatchError(translatableerror.LoggingUnavailableError{}))
+					Expect(testUI.Err).To(Say("some-warning-1"))
+				})
+			})
+
 			Context("when the logs stream returns an error", func() {
 				var expectedErr error
 
@@ -14,7 +14,8 @@ type MapRouteCommand struct {
 	Path            string         `long:"path" description:"Path for the HTTP route"`
 	Port            int            `long:"port" description:"Port for the TCP route"`
 	RandomPort      bool           `long:"random-port" description:"Create a random port for the TCP route"`
-	usage           interface{}    `usage:"Map an HTTP route:\n      CF_NAME map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH]\n\n   Map a TCP route:\n      CF_NAME map-route APP_NAME DOMAIN (--port PORT | --random-port)\n\nEXAMPLES:\n   CF_NAME map-route my-app example.com                              # example.com\n   CF_NAME map-route my-app example.com --hostname myhost            # myhost.example.com\n   CF_NAME map-route my-app example.com --hostname myhost --path foo # myhost.example.com/foo\n   CF_NAME map-route my-app example.com --port 5000                  # example.com:5000"`
+	AppProtocol     string         `long:"app-protocol" description:"Protocol the app receives traffic on for an HTTP route: http1 or http2"`
+	usage           interface{}    `usage:"Map an HTTP route:\n      CF_NAME map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--app-protocol PROTOCOL]\n\n   Map a TCP route:\n      CF_NAME map-route APP_NAME DOMAIN (--port PORT | --random-port)\n\nEXAMPLES:\n   CF_NAME map-route my-app example.com                              # example.com\n   CF_NAME map-route my-app example.com --hostname myhost            # myhost.example.com\n   CF_NAME map-route my-app example.com --hostname myhost --path foo # myhost.example.com/foo\n   CF_NAME map-route my-app example.com --port 5000                  # example.com:5000\n   CF_NAME map-route my-app example.com --hostname myhost --app-protocol http2"`
 	relatedCommands interface{}    `related_commands:"create-route, routes"`
 }
 
@@ -9,7 +9,8 @@ import (
 
 type MarketplaceCommand struct {
 	ServicePlanInfo string      `short:"s" description:"Show plan details for a particular service offering"`
-	usage           interface{} `usage:"CF_NAME marketplace [-s SERVICE]"`
+	JSON            bool        `long:"json" description:"Output the service offerings and their plans as JSON"`
+	usage           interface{} `usage:"CF_NAME marketplace [-s SERVICE] [--json]"`
 	relatedCommands interface{} `related_commands:"create-service, services"`
 }
 
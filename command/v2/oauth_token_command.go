@@ -1,9 +1,15 @@
 package v2
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
@@ -11,10 +17,13 @@ import (
 
 type OauthTokenActor interface {
 	RefreshAccessToken(refreshToken string) (string, error)
+	DecodeAccessToken(accessToken string) (v2action.TokenClaims, error)
 }
 
 type OauthTokenCommand struct {
-	usage           interface{} `usage:"CF_NAME oauth-token"`
+	Decode          bool        `long:"decode" description:"Display the claims of the current access token instead of the token, without contacting UAA"`
+	JSON            bool        `long:"json" description:"Display the decoded claims as JSON (requires --decode)"`
+	usage           interface{} `usage:"CF_NAME oauth-token [--decode [--json]]"`
 	relatedCommands interface{} `related_commands:"curl"`
 
 	UI          command.UI
@@ -38,11 +47,22 @@ func (cmd *OauthTokenCommand) Setup(config command.Config, ui command.UI) error
 }
 
 func (cmd OauthTokenCommand) Execute(_ []string) error {
+	if cmd.JSON && !cmd.Decode {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--json",
+			Arg2: "--decode",
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
 	if err != nil {
 		return shared.HandleError(err)
 	}
 
+	if cmd.Decode {
+		return cmd.displayClaims()
+	}
+
 	accessToken, err := cmd.Actor.RefreshAccessToken(cmd.Config.RefreshToken())
 	if err != nil {
 		return shared.HandleError(err)
@@ -51,3 +71,64 @@ func (cmd OauthTokenCommand) Execute(_ []string) error {
 	cmd.UI.DisplayText(accessToken)
 	return nil
 }
+
+// displayClaims decodes the access token in the config rather than a
+// refreshed one, so that an expired token can still be inspected.
+func (cmd OauthTokenCommand) displayClaims() error {
+	claims, err := cmd.Actor.DecodeAccessToken(cmd.Config.AccessToken())
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	expiry := claims.Expiry()
+	expired := !expiry.IsZero() && expiry.Before(time.Now())
+	if expired {
+		cmd.UI.DisplayWarning("The access token expired at {{.Expiry}}. Run '{{.Command}}' to get a new one.", map[string]interface{}{
+			"Expiry":  expiry.UTC().Format(time.RFC3339),
+			"Command": fmt.Sprintf("%s oauth-token", cmd.Config.BinaryName()),
+		})
+	}
+
+	if cmd.JSON {
+		var expiresAt interface{}
+		if !expiry.IsZero() {
+			expiresAt = expiry.UTC().Format(time.RFC3339)
+		}
+		scopes := claims.Scopes
+		if scopes == nil {
+			scopes = []string{}
+		}
+
+		output, err := json.MarshalIndent(map[string]interface{}{
+			"user_name":  claims.UserName,
+			"user_id":    claims.UserID,
+			"client_id":  claims.ClientID,
+			"scopes":     scopes,
+			"issuer":     claims.Issuer,
+			"expires_at": expiresAt,
+			"expired":    expired,
+		}, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+		return err
+	}
+
+	var expires string
+	if !expiry.IsZero() {
+		expires = expiry.UTC().Format(time.RFC3339)
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("user name:"), claims.UserName},
+		{cmd.UI.TranslateText("user id:"), claims.UserID},
+		{cmd.UI.TranslateText("client id:"), claims.ClientID},
+		{cmd.UI.TranslateText("scopes:"), strings.Join(claims.Scopes, ", ")},
+		{cmd.UI.TranslateText("issuer:"), claims.Issuer},
+		{cmd.UI.TranslateText("expires:"), expires},
+	}, 3)
+
+	return nil
+}
@@ -1,9 +1,13 @@
 package v2_test
 
 import (
+	"encoding/json"
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
@@ -46,6 +50,17 @@ var _ = Describe("oauth-token command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when --json is provided without --decode", func() {
+		BeforeEach(func() {
+			cmd.JSON = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--json", Arg2: "--decode"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when checking the target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
@@ -98,5 +113,93 @@ var _ = Describe("oauth-token command", func() {
 				Expect(fakeActor.RefreshAccessTokenArgsForCall(0)).To(Equal("existing-refresh-token"))
 			})
 		})
+
+		Context("when --decode is provided", func() {
+			var claims v2action.TokenClaims
+
+			BeforeEach(func() {
+				cmd.Decode = true
+				fakeConfig.AccessTokenReturns("bearer some-access-token")
+				claims = v2action.TokenClaims{
+					UserName:  "some-user",
+					UserID:    "some-user-id",
+					ClientID:  "cf",
+					Scopes:    []string{"openid", "cloud_controller.read"},
+					Issuer:    "https://uaa.example.com/oauth/token",
+					ExpiresAt: time.Now().Add(time.Hour).Unix(),
+				}
+			})
+
+			JustBeforeEach(func() {
+				Expect(fakeActor.RefreshAccessTokenCallCount()).To(Equal(0))
+			})
+
+			Context("when the token can be decoded", func() {
+				BeforeEach(func() {
+					fakeActor.DecodeAccessTokenStub = func(string) (v2action.TokenClaims, error) {
+						return claims, nil
+					}
+				})
+
+				It("displays the claims of the current access token", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeActor.DecodeAccessTokenCallCount()).To(Equal(1))
+					Expect(fakeActor.DecodeAccessTokenArgsForCall(0)).To(Equal("bearer some-access-token"))
+
+					Expect(testUI.Out).To(Say(`user name:\s+some-user`))
+					Expect(testUI.Out).To(Say(`user id:\s+some-user-id`))
+					Expect(testUI.Out).To(Say(`client id:\s+cf`))
+					Expect(testUI.Out).To(Say(`scopes:\s+openid, cloud_controller.read`))
+					Expect(testUI.Out).To(Say(`issuer:\s+https://uaa.example.com/oauth/token`))
+					Expect(testUI.Out).To(Say(`expires:\s+%s`, claims.Expiry().UTC().Format(time.RFC3339)))
+					Expect(testUI.Err).ToNot(Say("expired"))
+				})
+
+				Context("when the token has expired", func() {
+					BeforeEach(func() {
+						claims.ExpiresAt = time.Now().Add(-time.Hour).Unix()
+					})
+
+					It("displays the claims with a warning", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Err).To(Say(`The access token expired at %s\. Run 'faceman oauth-token' to get a new one\.`, claims.Expiry().UTC().Format(time.RFC3339)))
+						Expect(testUI.Out).To(Say(`user name:\s+some-user`))
+					})
+				})
+
+				Context("when --json is provided", func() {
+					BeforeEach(func() {
+						cmd.JSON = true
+					})
+
+					It("displays the claims as JSON", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						var output map[string]interface{}
+						Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &output)).To(Succeed())
+						Expect(output).To(Equal(map[string]interface{}{
+							"user_name":  "some-user",
+							"user_id":    "some-user-id",
+							"client_id":  "cf",
+							"scopes":     []interface{}{"openid", "cloud_controller.read"},
+							"issuer":     "https://uaa.example.com/oauth/token",
+							"expires_at": claims.Expiry().UTC().Format(time.RFC3339),
+							"expired":    false,
+						}))
+					})
+				})
+			})
+
+			Context("when the token is not a JWT", func() {
+				BeforeEach(func() {
+					fakeActor.DecodeAccessTokenReturns(v2action.TokenClaims{}, uaa.TokenNotJWTError{})
+				})
+
+				It("returns a TokenNotJWTError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.TokenNotJWTError{}))
+				})
+			})
+		})
 	})
 })
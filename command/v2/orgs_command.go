@@ -1,21 +1,155 @@
 package v2
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/labelselector"
 )
 
+//go:generate counterfeiter . OrgsActor
+
+type OrgsActor interface {
+	CloudControllerAPIVersion() string
+	GetOrganizationsByLabelSelector(labelSelector string) ([]v3action.Organization, v3action.Warnings, error)
+}
+
 type OrgsCommand struct {
-	usage interface{} `usage:"CF_NAME orgs"`
+	Labels          string      `long:"labels" description:"Only list the orgs whose labels match the selector, e.g. 'env in (prod,staging)'"`
+	JSON            bool        `long:"json" description:"Output the orgs and their labels as JSON"`
+	usage           interface{} `usage:"CF_NAME orgs [--labels SELECTOR] [--json]\n\nEXAMPLES:\n   CF_NAME orgs --labels 'env=prod'\n   CF_NAME orgs --labels 'env in (prod,staging),!deprecated' --json"`
+	relatedCommands interface{} `related_commands:"labels, set-label"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       OrgsActor
 }
 
-func (OrgsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *OrgsCommand) Setup(config command.Config, ui command.UI) error {
+	if cmd.Labels == "" && !cmd.JSON {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config)
+
 	return nil
 }
 
-func (OrgsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd OrgsCommand) Execute(args []string) error {
+	if cmd.Labels == "" && !cmd.JSON {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	err := checkLabelSelector(cmd.Labels, cmd.Actor.CloudControllerAPIVersion())
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.JSON {
+		cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+	}
+
+	orgs, warnings, err := cmd.Actor.GetOrganizationsByLabelSelector(cmd.Labels)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	if cmd.JSON {
+		return cmd.displayOrgsJSON(orgs)
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(orgs) == 0 {
+		cmd.UI.DisplayText("No orgs found")
+		return nil
+	}
+
+	table := [][]string{{cmd.UI.TranslateText("name")}}
+	for _, org := range orgs {
+		table = append(table, []string{org.Name})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
 	return nil
 }
+
+func (cmd OrgsCommand) displayOrgsJSON(orgs []v3action.Organization) error {
+	orgsJSON := []map[string]interface{}{}
+	for _, org := range orgs {
+		orgsJSON = append(orgsJSON, map[string]interface{}{
+			"name":   org.Name,
+			"guid":   org.GUID,
+			"labels": labelsJSON((*v3action.Metadata)(org.Metadata)),
+		})
+	}
+
+	output, err := json.MarshalIndent(map[string]interface{}{"orgs": orgsJSON}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
+
+// checkLabelSelector validates a --labels selector before any request is
+// made, so that syntax errors point at the offending token instead of being
+// reported by the Cloud Controller.
+func checkLabelSelector(selector string, apiVersion string) error {
+	if selector == "" {
+		return nil
+	}
+
+	err := labelselector.Validate(selector)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	return command.MinimumAPIVersionCheck(apiVersion, ccversion.MinVersionMetadataV3, "Option '--labels'")
+}
+
+// labelsJSON returns the labels of a resource as a JSON object, which is
+// empty rather than null for resources without labels.
+func labelsJSON(metadata *v3action.Metadata) map[string]string {
+	labels := map[string]string{}
+	if metadata == nil {
+		return labels
+	}
+	for key, value := range metadata.Labels {
+		if value.IsSet {
+			labels[key] = value.Value
+		}
+	}
+	return labels
+}
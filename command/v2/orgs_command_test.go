@@ -0,0 +1,197 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("orgs Command", func() {
+	var (
+		cmd             OrgsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeOrgsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeOrgsActor)
+
+		cmd = OrgsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.Labels = "env in (prod,staging)"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the label selector is invalid", func() {
+		BeforeEach(func() {
+			cmd.Labels = "env in prod"
+		})
+
+		It("returns an InvalidLabelSelectorError pointing at the offending token", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidLabelSelectorError{
+				Selector: "env in prod",
+				Column:   7,
+				Reason:   "expected '(' after 'in'",
+			}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.GetOrganizationsByLabelSelectorCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the API does not support labels", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.62.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Option '--labels'",
+				CurrentVersion: "3.62.0",
+				MinimumVersion: ccversion.MinVersionMetadataV3,
+			}))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(targetedOrganizationRequired).To(BeFalse())
+			Expect(targetedSpaceRequired).To(BeFalse())
+		})
+	})
+
+	Context("when getting the orgs fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("get orgs error")
+			fakeActor.GetOrganizationsByLabelSelectorReturns(nil, v3action.Warnings{"warning-1"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	Context("when getting the orgs succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationsByLabelSelectorReturns(
+				[]v3action.Organization{
+					{
+						GUID: "org-guid-1",
+						Name: "org-1",
+						Metadata: &ccv3.Metadata{Labels: map[string]types.NullString{
+							"env": types.NewNullString("prod"),
+						}},
+					},
+					{GUID: "org-guid-2", Name: "org-2"},
+				},
+				v3action.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("lists the matching orgs", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetOrganizationsByLabelSelectorCallCount()).To(Equal(1))
+			Expect(fakeActor.GetOrganizationsByLabelSelectorArgsForCall(0)).To(Equal("env in (prod,staging)"))
+
+			Expect(testUI.Out).To(Say("Getting orgs as some-user..."))
+			Expect(testUI.Out).To(Say("name"))
+			Expect(testUI.Out).To(Say("org-1"))
+			Expect(testUI.Out).To(Say("org-2"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		Context("when --json is provided", func() {
+			BeforeEach(func() {
+				cmd.JSON = true
+			})
+
+			It("outputs the name, guid and labels of each org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				var output map[string]interface{}
+				Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &output)).To(Succeed())
+				Expect(output).To(Equal(map[string]interface{}{
+					"orgs": []interface{}{
+						map[string]interface{}{
+							"name":   "org-1",
+							"guid":   "org-guid-1",
+							"labels": map[string]interface{}{"env": "prod"},
+						},
+						map[string]interface{}{
+							"name":   "org-2",
+							"guid":   "org-guid-2",
+							"labels": map[string]interface{}{},
+						},
+					},
+				}))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+	})
+
+	Context("when no orgs match the selector", func() {
+		It("displays that no orgs were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No orgs found"))
+		})
+	})
+
+	Context("when only --json is provided", func() {
+		BeforeEach(func() {
+			cmd.Labels = ""
+			cmd.JSON = true
+			fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		})
+
+		It("lists all orgs without checking for label support", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetOrganizationsByLabelSelectorArgsForCall(0)).To(BeEmpty())
+			Expect(testUI.Out).To(Say(`"orgs": \[\]`))
+		})
+	})
+})
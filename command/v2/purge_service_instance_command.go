@@ -11,7 +11,9 @@ import (
 type PurgeServiceInstanceCommand struct {
 	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
 	Force           bool                 `short:"f" description:"Force deletion without confirmation"`
-	usage           interface{}          `usage:"CF_NAME purge-service-instance SERVICE_INSTANCE\n\nWARNING: This operation assumes that the service broker responsible for this service instance is no longer available or is not responding with a 200 or 410, and the service instance has been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service instance will be removed from Cloud Foundry, including service bindings and service keys."`
+	DryRun          bool                 `long:"dry-run" description:"List the records that would be removed without purging them"`
+	JSON            bool                 `long:"json" description:"Output the dry run report as JSON"`
+	usage           interface{}          `usage:"CF_NAME purge-service-instance SERVICE_INSTANCE [--dry-run [--json]]\n\nWARNING: This operation assumes that the service broker responsible for this service instance is no longer available or is not responding with a 200 or 410, and the service instance has been deleted, leaving orphan records in Cloud Foundry's database. All knowledge of the service instance will be removed from Cloud Foundry, including service bindings and service keys."`
 	relatedCommands interface{}          `related_commands:"delete-service, services, service-brokers"`
 }
 
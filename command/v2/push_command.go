@@ -9,32 +9,39 @@ import (
 )
 
 type PushCommand struct {
-	AppPorts             string                      `long:"app-ports" description:"Comma delimited list of ports the application may listen on" hidden:"true"` //TODO: Custom AppPorts flag
-	BuildpackName        string                      `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
-	StartupCommand       string                      `short:"c" description:"Startup command, set to null to reset to default start command"`
-	Domain               string                      `short:"d" description:"Domain (e.g. example.com)"`
-	DockerImage          string                      `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
-	DockerUsername       string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
-	PathToManifest       flag.PathWithExistenceCheck `short:"f" description:"Path to manifest"`
-	HealthCheckType      flag.HealthCheckType        `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
-	Hostname             string                      `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
-	NumInstances         int                         `short:"i" description:"Number of instances"`
-	DiskLimit            string                      `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	MemoryLimit          string                      `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	NoHostname           bool                        `long:"no-hostname" description:"Map the root domain to this app"`
-	NoManifest           bool                        `long:"no-manifest" description:"Ignore manifest file"`
-	NoRoute              bool                        `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
-	NoStart              bool                        `long:"no-start" description:"Do not start an app after pushing"`
-	DirectoryPath        flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
-	RandomRoute          bool                        `long:"random-route" description:"Create a random route for this app"`
-	RoutePath            string                      `long:"route-path" description:"Path for the route"`
-	Stack                string                      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
-	ApplicationStartTime int                         `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
-	usage                interface{}                 `usage:"cf push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
-	envCFStagingTimeout  interface{}                 `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
-	envCFStartupTimeout  interface{}                 `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
-	dockerPassword       interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	relatedCommands      interface{}                 `related_commands:"apps, create-app-manifest, logs, ssh, start"`
+	AppPorts                      string                        `long:"app-ports" description:"Comma delimited list of ports the application may listen on" hidden:"true"` //TODO: Custom AppPorts flag
+	BuildpackName                 string                        `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
+	StartupCommand                string                        `short:"c" description:"Startup command, set to null to reset to default start command"`
+	Domain                        string                        `short:"d" description:"Domain (e.g. example.com)"`
+	DockerImage                   string                        `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
+	DockerUsername                string                        `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	PathToManifest                flag.PathWithExistenceCheck   `short:"f" description:"Path to manifest"`
+	HealthCheckType               flag.HealthCheckType          `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
+	Hostname                      string                        `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
+	NumInstances                  int                           `short:"i" description:"Number of instances"`
+	DiskLimit                     string                        `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	MemoryLimit                   string                        `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	NoHostname                    bool                          `long:"no-hostname" description:"Map the root domain to this app"`
+	NoLogsOnFailure               bool                          `long:"no-logs-on-failure" description:"Do not display the recent logs and last crash of the app when it fails to stage or start"`
+	NoManifest                    bool                          `long:"no-manifest" description:"Ignore manifest file"`
+	NoRoute                       bool                          `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
+	NoStart                       bool                          `long:"no-start" description:"Do not start an app after pushing"`
+	DirectoryPath                 flag.AppPathOrURL             `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory, or an http(s) URL of a zip file or git+ URL of a repository (e.g. git+https://example.com/app.git#v1.0)"`
+	RandomRoute                   bool                          `long:"random-route" description:"Create a random route for this app"`
+	RoutePath                     string                        `long:"route-path" description:"Path for the route"`
+	SHA256                        string                        `long:"sha256" description:"Expected SHA-256 checksum of the zip file downloaded from the -p URL"`
+	Stack                         string                        `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	ApplicationStartTime          int                           `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	Vars                          []string                      `long:"var" description:"Variable key value pair for variable substitution (e.g. name=app1); can specify multiple times. Takes precedence over --vars-file and --vars-env"`
+	PathsToVarsFiles              []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times, later files take precedence over earlier ones and over --vars-env"`
+	VarsEnvPrefix                 string                        `long:"vars-env" description:"Resolve manifest variables not set with --var or --vars-file from environment variables; ((name)) reads PREFIX_name"`
+	WaitForHTTP                   string                        `long:"wait-for-http" description:"After the app is running, wait until a GET of this path on its first HTTP route returns a 2xx status (uses the startup timeout)"`
+	usage                         interface{}                   `usage:"cf push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH | -p URL [--sha256 CHECKSUM]] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH] [--vars-env PREFIX] [--wait-for-http STATUS_PATH]\n\n   cf push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH] [--vars-env PREFIX] [--wait-for-http STATUS_PATH]\n\n   cf push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH] [--vars-env PREFIX] [--wait-for-http STATUS_PATH]"`
+	envCFStagingTimeout           interface{}                   `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout           interface{}                   `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	dockerPassword                interface{}                   `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+	envCFStackDeprecationWarnings interface{}                   `environmentName:"CF_STACK_DEPRECATION_WARNINGS" environmentDescription:"Comma-separated list of stacks to warn about as deprecated"`
+	relatedCommands               interface{}                   `related_commands:"apps, create-app-manifest, logs, ssh, start"`
 }
 
 func (PushCommand) Setup(config command.Config, ui command.UI) error {
@@ -1,13 +1,18 @@
 package v2
 
 import (
-	"github.com/cloudfoundry/noaa/consumer"
+	"strings"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 )
 
 //go:generate counterfeiter . RestageActor
@@ -17,18 +22,33 @@ type RestageActor interface {
 	RestageApplication(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
 }
 
+//go:generate counterfeiter . RestageActorV3
+
+type RestageActorV3 interface {
+	CloudControllerAPIVersion() string
+	CreateDeployment(appGUID string, dropletGUID string) (string, v3action.Warnings, error)
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetApplicationProcessTypes(appGUID string) ([]string, v3action.Warnings, error)
+	GetNewestReadyPackageForApplication(app v3action.Application) (v3action.Package, v3action.Warnings, error)
+	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error)
+	PollDeployment(deploymentGUID string, warningsChannel chan<- v3action.Warnings) error
+	StagePackage(packageGUID string, appName string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
+}
+
 type RestageCommand struct {
-	RequiredArgs        flag.AppName `positional-args:"yes"`
-	usage               interface{}  `usage:"CF_NAME restage APP_NAME"`
-	relatedCommands     interface{}  `related_commands:"restart"`
-	envCFStagingTimeout interface{}  `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
-	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	RequiredArgs        flag.AppName            `positional-args:"yes"`
+	Strategy            flag.DeploymentStrategy `long:"strategy" description:"Deployment strategy; only 'rolling' is supported, which replaces instances one at a time without downtime"`
+	usage               interface{}             `usage:"CF_NAME restage APP_NAME [--strategy rolling]"`
+	relatedCommands     interface{}             `related_commands:"restart"`
+	envCFStagingTimeout interface{}             `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}             `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       RestageActor
-	NOAAClient  *consumer.Consumer
+	ActorV3     RestageActorV3
+	NOAAClient  v2action.NOAAClient
 }
 
 func (cmd *RestageCommand) Setup(config command.Config, ui command.UI) error {
@@ -44,6 +64,15 @@ func (cmd *RestageCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
 
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
 	return nil
 }
 
@@ -58,6 +87,13 @@ func (cmd RestageCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.Strategy.Name == flag.DeploymentStrategyRolling {
+		err = cmd.checkDeploymentsSupported()
+		if err != nil {
+			return err
+		}
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Restaging app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
 		map[string]interface{}{
 			"AppName":     cmd.RequiredArgs.AppName,
@@ -72,12 +108,23 @@ func (cmd RestageCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	messages, logErrs, appState, apiWarnings, errs := cmd.Actor.RestageApplication(app, cmd.NOAAClient, cmd.Config)
-	err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+	err = cmd.displayAffectedProcesses(app.GUID)
 	if err != nil {
 		return err
 	}
 
+	if cmd.Strategy.Name == flag.DeploymentStrategyRolling {
+		err = cmd.rollingRestage()
+		if err != nil {
+			return err
+		}
+	} else {
+		err = cmd.restage(app)
+		if err != nil {
+			return err
+		}
+	}
+
 	cmd.UI.DisplayNewline()
 	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
@@ -89,3 +136,127 @@ func (cmd RestageCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// restage stops the app, stages its package again and starts it with the new
+// droplet.
+func (cmd RestageCommand) restage(app v2action.Application) error {
+	if cmd.NOAAClient == nil {
+		cmd.UI.DisplayWarning("staging logs unavailable on this platform")
+	}
+
+	messages, logErrs, appState, apiWarnings, errs := cmd.Actor.RestageApplication(app, cmd.NOAAClient, cmd.Config)
+	return shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
+}
+
+// checkDeploymentsSupported returns an error when the targeted API cannot
+// create deployments, before anything about the app is changed.
+func (cmd RestageCommand) checkDeploymentsSupported() error {
+	if cmd.ActorV3 == nil {
+		return translatableerror.MinimumAPIVersionNotMetError{
+			Command:        "Option '--strategy rolling'",
+			MinimumVersion: ccversion.MinVersionDeploymentsV3,
+		}
+	}
+
+	return command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionDeploymentsV3, "Option '--strategy rolling'")
+}
+
+// rollingRestage stages the app's newest package into a new droplet and
+// rolls it out with a deployment, keeping the old instances running until
+// their replacements are up.
+func (cmd RestageCommand) rollingRestage() error {
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	app, warnings, err := cmd.ActorV3.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	pkg, warnings, err := cmd.ActorV3.GetNewestReadyPackageForApplication(app)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	logStream, logErrStream, warnings, err := cmd.ActorV3.GetStreamingLogsForApplicationByNameAndSpace(cmd.RequiredArgs.AppName, spaceGUID, cmd.NOAAClient)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+	case actionerror.LoggingUnavailableError:
+		cmd.UI.DisplayWarning("staging logs unavailable on this platform")
+	default:
+		return sharedV3.HandleError(err)
+	}
+
+	dropletStream, warningsStream, errStream := cmd.ActorV3.StagePackage(pkg.GUID, cmd.RequiredArgs.AppName)
+	droplet, err := sharedV3.PollStage(dropletStream, warningsStream, errStream, logStream, logErrStream, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Deploying the new droplet to app {{.AppName}} one instance at a time...", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+	})
+
+	deploymentGUID, warnings, err := cmd.ActorV3.CreateDeployment(app.GUID, droplet.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	pollWarnings := make(chan v3action.Warnings)
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case message := <-pollWarnings:
+				cmd.UI.DisplayWarnings(message)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = cmd.ActorV3.PollDeployment(deploymentGUID, pollWarnings)
+	done <- true
+
+	switch err.(type) {
+	case nil:
+		return nil
+	case v3action.DeploymentCanceledError:
+		return translatableerror.DeploymentCanceledError{AppName: cmd.RequiredArgs.AppName}
+	case v3action.StartupTimeoutError:
+		return translatableerror.StartupTimeoutError{
+			AppName:    cmd.RequiredArgs.AppName,
+			BinaryName: cmd.Config.BinaryName(),
+		}
+	default:
+		return sharedV3.HandleError(err)
+	}
+}
+
+// displayAffectedProcesses lists the processes that will run the new droplet.
+// Process types are only known to the V3 API, so nothing is displayed when it
+// is unavailable.
+func (cmd RestageCommand) displayAffectedProcesses(appGUID string) error {
+	if cmd.ActorV3 == nil || command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionV3) != nil {
+		return nil
+	}
+
+	processTypes, warnings, err := cmd.ActorV3.GetApplicationProcessTypes(appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	if len(processTypes) > 0 {
+		cmd.UI.DisplayText("Processes affected by the new droplet: {{.ProcessTypes}}", map[string]interface{}{
+			"ProcessTypes": strings.Join(processTypes, ", "),
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	return nil
+}
@@ -7,8 +7,12 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
@@ -164,6 +168,283 @@ var _ = Describe("Restage Command", func() {
 				Expect(config).To(Equal(fakeConfig))
 			})
 
+			Context("when logging is available", func() {
+				var fakeNOAAClient *v2actionfakes.FakeNOAAClient
+
+				BeforeEach(func() {
+					fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+					cmd.NOAAClient = fakeNOAAClient
+				})
+
+				It("streams the staging logs through the client", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					_, client, _ := fakeActor.RestageApplicationArgsForCall(0)
+					Expect(client).To(Equal(fakeNOAAClient))
+					Expect(testUI.Err).ToNot(Say("staging logs unavailable"))
+				})
+			})
+
+			Context("when logging is unavailable on the platform", func() {
+				BeforeEach(func() {
+					cmd.NOAAClient = nil
+				})
+
+				It("warns once and restages the app without a log client", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Err).To(Say("staging logs unavailable on this platform"))
+					Expect(testUI.Err).ToNot(Say("staging logs unavailable on this platform"))
+
+					_, client, _ := fakeActor.RestageApplicationArgsForCall(0)
+					Expect(client).To(BeNil())
+				})
+			})
+
+			It("does not list the affected processes when the V3 API is unavailable", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Processes affected by the new droplet"))
+			})
+
+			Context("when the V3 API is available", func() {
+				var fakeActorV3 *v2fakes.FakeRestageActorV3
+
+				BeforeEach(func() {
+					fakeActorV3 = new(v2fakes.FakeRestageActorV3)
+					fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+					cmd.ActorV3 = fakeActorV3
+				})
+
+				Context("when getting the process types succeeds", func() {
+					BeforeEach(func() {
+						fakeActorV3.GetApplicationProcessTypesReturns([]string{"web", "worker"}, v3action.Warnings{"process-warning"}, nil)
+					})
+
+					It("lists the affected processes before restaging", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Processes affected by the new droplet: web, worker"))
+						Expect(testUI.Err).To(Say("process-warning"))
+
+						Expect(fakeActorV3.GetApplicationProcessTypesCallCount()).To(Equal(1))
+						Expect(fakeActorV3.GetApplicationProcessTypesArgsForCall(0)).To(Equal("app-guid"))
+						Expect(fakeActor.RestageApplicationCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when getting the process types fails", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("process types error")
+						fakeActorV3.GetApplicationProcessTypesReturns(nil, v3action.Warnings{"process-warning"}, expectedErr)
+					})
+
+					It("returns the error without restaging", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(testUI.Err).To(Say("process-warning"))
+						Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the V3 API version is below the minimum", func() {
+					BeforeEach(func() {
+						fakeActorV3.CloudControllerAPIVersionReturns("3.0.0")
+					})
+
+					It("restages without listing the affected processes", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeActorV3.GetApplicationProcessTypesCallCount()).To(Equal(0))
+						Expect(fakeActor.RestageApplicationCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when --strategy rolling is passed", func() {
+				BeforeEach(func() {
+					cmd.Strategy = flag.DeploymentStrategy{Name: flag.DeploymentStrategyRolling}
+				})
+
+				Context("when the V3 API is unavailable", func() {
+					It("returns a MinimumAPIVersionNotMetError before restaging", func() {
+						Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+							Command:        "Option '--strategy rolling'",
+							MinimumVersion: ccversion.MinVersionDeploymentsV3,
+						}))
+						Expect(testUI.Out).ToNot(Say("Restaging app"))
+						Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the V3 API is available", func() {
+					var fakeActorV3 *v2fakes.FakeRestageActorV3
+
+					BeforeEach(func() {
+						fakeActorV3 = new(v2fakes.FakeRestageActorV3)
+						fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionDeploymentsV3)
+						cmd.ActorV3 = fakeActorV3
+
+						fakeActorV3.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "app-guid", Name: "some-app"}, v3action.Warnings{"get-app-warning"}, nil)
+						fakeActorV3.GetNewestReadyPackageForApplicationReturns(v3action.Package{GUID: "package-guid"}, v3action.Warnings{"get-package-warning"}, nil)
+
+						logDisplayed := make(chan bool)
+						fakeActorV3.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v3action.NOAAClient) (<-chan *v3action.LogMessage, <-chan error, v3action.Warnings, error) {
+							logStream := make(chan *v3action.LogMessage)
+							errStream := make(chan error)
+							go func() {
+								logStream <- v3action.NewLogMessage("Staging droplet", 1, time.Now(), v3action.StagingLog, "source-instance")
+								close(logDisplayed)
+							}()
+							return logStream, errStream, v3action.Warnings{"log-warning"}, nil
+						}
+						fakeActorV3.StagePackageStub = func(_ string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+							dropletStream := make(chan v3action.Droplet)
+							warningsStream := make(chan v3action.Warnings)
+							errStream := make(chan error)
+							go func() {
+								defer close(dropletStream)
+								defer close(warningsStream)
+								defer close(errStream)
+								<-logDisplayed
+								warningsStream <- v3action.Warnings{"stage-warning"}
+								dropletStream <- v3action.Droplet{GUID: "droplet-guid"}
+							}()
+							return dropletStream, warningsStream, errStream
+						}
+						fakeActorV3.CreateDeploymentReturns("deployment-guid", v3action.Warnings{"create-deployment-warning"}, nil)
+						fakeActorV3.PollDeploymentStub = func(_ string, warnings chan<- v3action.Warnings) error {
+							warnings <- v3action.Warnings{"poll-deployment-warning"}
+							return nil
+						}
+					})
+
+					It("stages the newest package and deploys the droplet without stopping the app", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Restaging app some-app in org some-org / space some-space as some-user..."))
+						Expect(testUI.Out).To(Say("Staging droplet"))
+						Expect(testUI.Out).To(Say("Deploying the new droplet to app some-app one instance at a time..."))
+
+						Expect(testUI.Err).To(Say("get-app-warning"))
+						Expect(testUI.Err).To(Say("get-package-warning"))
+						Expect(testUI.Err).To(Say("log-warning"))
+						Expect(testUI.Err).To(Say("stage-warning"))
+						Expect(testUI.Err).To(Say("create-deployment-warning"))
+						Expect(testUI.Err).To(Say("poll-deployment-warning"))
+
+						appName, spaceGUID := fakeActorV3.GetApplicationByNameAndSpaceArgsForCall(0)
+						Expect(appName).To(Equal("some-app"))
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+
+						Expect(fakeActorV3.GetNewestReadyPackageForApplicationArgsForCall(0).GUID).To(Equal("app-guid"))
+
+						packageGUID, _ := fakeActorV3.StagePackageArgsForCall(0)
+						Expect(packageGUID).To(Equal("package-guid"))
+
+						appGUID, dropletGUID := fakeActorV3.CreateDeploymentArgsForCall(0)
+						Expect(appGUID).To(Equal("app-guid"))
+						Expect(dropletGUID).To(Equal("droplet-guid"))
+
+						deploymentGUID, _ := fakeActorV3.PollDeploymentArgsForCall(0)
+						Expect(deploymentGUID).To(Equal("deployment-guid"))
+
+						Expect(fakeActor.RestageApplicationCallCount()).To(Equal(0))
+						Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(1))
+					})
+
+					Context("when logging is unavailable on the platform", func() {
+						BeforeEach(func() {
+							fakeActorV3.GetStreamingLogsForApplicationByNameAndSpaceReturns(nil, nil, v3action.Warnings{"log-warning"}, actionerror.LoggingUnavailableError{})
+							fakeActorV3.StagePackageStub = func(_ string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+								dropletStream := make(chan v3action.Droplet)
+								warningsStream := make(chan v3action.Warnings)
+								errStream := make(chan error)
+								go func() {
+									defer close(dropletStream)
+									defer close(warningsStream)
+									defer close(errStream)
+									dropletStream <- v3action.Droplet{GUID: "droplet-guid"}
+								}()
+								return dropletStream, warningsStream, errStream
+							}
+						})
+
+						It("warns and deploys the droplet without staging logs", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Err).To(Say("log-warning"))
+							Expect(testUI.Err).To(Say("staging logs unavailable on this platform"))
+
+							appGUID, dropletGUID := fakeActorV3.CreateDeploymentArgsForCall(0)
+							Expect(appGUID).To(Equal("app-guid"))
+							Expect(dropletGUID).To(Equal("droplet-guid"))
+						})
+					})
+
+					Context("when the API does not support deployments", func() {
+						BeforeEach(func() {
+							fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+						})
+
+						It("returns a MinimumAPIVersionNotMetError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+								Command:        "Option '--strategy rolling'",
+								CurrentVersion: ccversion.MinVersionV3,
+								MinimumVersion: ccversion.MinVersionDeploymentsV3,
+							}))
+							Expect(fakeActorV3.CreateDeploymentCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the app has no ready package", func() {
+						BeforeEach(func() {
+							fakeActorV3.GetNewestReadyPackageForApplicationReturns(v3action.Package{}, v3action.Warnings{"get-package-warning"}, v3action.NoReadyPackageError{AppName: "some-app"})
+						})
+
+						It("returns a NoReadyPackageError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.NoReadyPackageError{AppName: "some-app"}))
+							Expect(testUI.Err).To(Say("get-package-warning"))
+							Expect(fakeActorV3.StagePackageCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when creating the deployment fails", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("create deployment error")
+							fakeActorV3.CreateDeploymentReturns("", v3action.Warnings{"create-deployment-warning"}, expectedErr)
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(testUI.Err).To(Say("create-deployment-warning"))
+							Expect(fakeActorV3.PollDeploymentCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the deployment is canceled", func() {
+						BeforeEach(func() {
+							fakeActorV3.PollDeploymentReturns(v3action.DeploymentCanceledError{})
+						})
+
+						It("returns a DeploymentCanceledError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.DeploymentCanceledError{AppName: "some-app"}))
+						})
+					})
+
+					Context("when the deployment times out", func() {
+						BeforeEach(func() {
+							fakeActorV3.PollDeploymentReturns(v3action.StartupTimeoutError{})
+						})
+
+						It("returns a StartupTimeoutError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{AppName: "some-app", BinaryName: "faceman"}))
+						})
+					})
+				})
+			})
+
 			Context("when passed an appStarting message", func() {
 				BeforeEach(func() {
 					fakeActor.RestageApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
@@ -3,22 +3,34 @@ package v2
 import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
-	"github.com/cloudfoundry/noaa/consumer"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 )
 
 //go:generate counterfeiter . RestartActor
 
 type RestartActor interface {
 	AppActor
+	CloudControllerAPIVersion() string
 	RestartApplication(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
 }
 
+//go:generate counterfeiter . RestartActorV3
+
+type RestartActorV3 interface {
+	CloudControllerAPIVersion() string
+	RestartApplicationProcess(appGUID string, processType string, warnings chan<- v3action.Warnings) error
+}
+
 type RestartCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
-	usage               interface{}  `usage:"CF_NAME restart APP_NAME"`
+	ProcessType         string       `long:"process" description:"Restart only the instances of this process type, leaving the app's other processes running"`
+	usage               interface{}  `usage:"CF_NAME restart APP_NAME [--process PROCESS_TYPE]"`
 	relatedCommands     interface{}  `related_commands:"restage, restart-app-instance"`
 	envCFStagingTimeout interface{}  `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
@@ -27,7 +39,8 @@ type RestartCommand struct {
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       RestartActor
-	NOAAClient  *consumer.Consumer
+	ActorV3     RestartActorV3
+	NOAAClient  v2action.NOAAClient
 }
 
 func (cmd *RestartCommand) Setup(config command.Config, ui command.UI) error {
@@ -43,6 +56,15 @@ func (cmd *RestartCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
 
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
 	return nil
 }
 
@@ -52,11 +74,27 @@ func (cmd RestartCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.ProcessType != "" {
+		apiVersion := cmd.Actor.CloudControllerAPIVersion()
+		if cmd.ActorV3 != nil {
+			apiVersion = cmd.ActorV3.CloudControllerAPIVersion()
+		}
+
+		err = command.MinimumAPIVersionCheck(apiVersion, ccversion.MinVersionV3, "Option '--process'")
+		if err != nil {
+			return err
+		}
+	}
+
 	user, err := cmd.Config.CurrentUser()
 	if err != nil {
 		return shared.HandleError(err)
 	}
 
+	if cmd.ProcessType != "" {
+		return cmd.restartProcess(user.Name)
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Restarting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
 		map[string]interface{}{
 			"AppName":     cmd.RequiredArgs.AppName,
@@ -71,6 +109,10 @@ func (cmd RestartCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.NOAAClient == nil {
+		cmd.UI.DisplayWarning("staging logs unavailable on this platform")
+	}
+
 	messages, logErrs, appState, apiWarnings, errs := cmd.Actor.RestartApplication(app, cmd.NOAAClient, cmd.Config)
 	err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
 	if err != nil {
@@ -88,3 +130,50 @@ func (cmd RestartCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd RestartCommand) restartProcess(username string) error {
+	cmd.UI.DisplayTextWithFlavor("Restarting process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"ProcessType": cmd.ProcessType,
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": username,
+		})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	pollWarnings := make(chan v3action.Warnings)
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case message := <-pollWarnings:
+				cmd.UI.DisplayWarnings(message)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = cmd.ActorV3.RestartApplicationProcess(app.GUID, cmd.ProcessType, pollWarnings)
+	done <- true
+
+	if err != nil {
+		if _, ok := err.(v3action.StartupTimeoutError); ok {
+			return translatableerror.StartupTimeoutError{
+				AppName:    cmd.RequiredArgs.AppName,
+				BinaryName: cmd.Config.BinaryName(),
+			}
+		}
+		return sharedV3.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -7,7 +7,10 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
@@ -171,6 +174,39 @@ var _ = Describe("Restart Command", func() {
 					Expect(config).To(Equal(fakeConfig))
 				})
 
+				Context("when logging is available", func() {
+					var fakeNOAAClient *v2actionfakes.FakeNOAAClient
+
+					BeforeEach(func() {
+						fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+						cmd.NOAAClient = fakeNOAAClient
+					})
+
+					It("streams the staging logs through the client", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						_, client, _ := fakeActor.RestartApplicationArgsForCall(0)
+						Expect(client).To(Equal(fakeNOAAClient))
+						Expect(testUI.Err).ToNot(Say("staging logs unavailable"))
+					})
+				})
+
+				Context("when logging is unavailable on the platform", func() {
+					BeforeEach(func() {
+						cmd.NOAAClient = nil
+					})
+
+					It("warns once and starts the app without a log client", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Err).To(Say("staging logs unavailable on this platform"))
+						Expect(testUI.Err).ToNot(Say("staging logs unavailable on this platform"))
+
+						_, client, _ := fakeActor.RestartApplicationArgsForCall(0)
+						Expect(client).To(BeNil())
+					})
+				})
+
 				Context("when passed an appStarting message", func() {
 					BeforeEach(func() {
 						fakeActor.RestartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
@@ -610,6 +646,109 @@ var _ = Describe("Restart Command", func() {
 			})
 		})
 
+		Context("when the --process flag is provided", func() {
+			BeforeEach(func() {
+				cmd.ProcessType = "worker"
+				fakeActor.CloudControllerAPIVersionReturns("2.100.0")
+			})
+
+			Context("when the V3 API is unavailable", func() {
+				It("returns a minimum version error", func() {
+					Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+						Command:        "Option '--process'",
+						CurrentVersion: "2.100.0",
+						MinimumVersion: ccversion.MinVersionV3,
+					}))
+					Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the V3 API is available", func() {
+				var fakeActorV3 *v2fakes.FakeRestartActorV3
+
+				BeforeEach(func() {
+					fakeActorV3 = new(v2fakes.FakeRestartActorV3)
+					fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+					cmd.ActorV3 = fakeActorV3
+
+					fakeActor.GetApplicationByNameAndSpaceReturns(
+						v2action.Application{GUID: "some-app-guid"},
+						v2action.Warnings{"app-warning"},
+						nil,
+					)
+				})
+
+				Context("when the V3 API version is below the minimum", func() {
+					BeforeEach(func() {
+						fakeActorV3.CloudControllerAPIVersionReturns("3.0.0")
+					})
+
+					It("returns a minimum version error", func() {
+						Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+							Command:        "Option '--process'",
+							CurrentVersion: "3.0.0",
+							MinimumVersion: ccversion.MinVersionV3,
+						}))
+						Expect(fakeActorV3.RestartApplicationProcessCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the process restarts", func() {
+					BeforeEach(func() {
+						fakeActorV3.RestartApplicationProcessStub = func(appGUID string, processType string, warnings chan<- v3action.Warnings) error {
+							warnings <- v3action.Warnings{"restart-warning"}
+							return nil
+						}
+					})
+
+					It("restarts only the given process", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Restarting process worker of app some-app in org some-org / space some-space as some-user..."))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Err).To(Say("app-warning"))
+						Expect(testUI.Err).To(Say("restart-warning"))
+
+						Expect(fakeActorV3.RestartApplicationProcessCallCount()).To(Equal(1))
+						appGUID, processType, _ := fakeActorV3.RestartApplicationProcessArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(processType).To(Equal("worker"))
+
+						Expect(fakeActor.RestartApplicationCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the process type does not exist", func() {
+					BeforeEach(func() {
+						fakeActorV3.RestartApplicationProcessReturns(v3action.ProcessNotFoundError{
+							ProcessType:       "worker",
+							ValidProcessTypes: []string{"web", "clock"},
+						})
+					})
+
+					It("returns a ProcessNotFoundError listing the valid process types", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ProcessNotFoundError{
+							ProcessType:       "worker",
+							ValidProcessTypes: []string{"web", "clock"},
+						}))
+					})
+				})
+
+				Context("when the process times out starting", func() {
+					BeforeEach(func() {
+						fakeActorV3.RestartApplicationProcessReturns(v3action.StartupTimeoutError{})
+					})
+
+					It("returns a StartupTimeoutError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+							AppName:    "some-app",
+							BinaryName: binaryName,
+						}))
+					})
+				})
+			})
+		})
+
 		Context("when the app does *not* exists", func() {
 			BeforeEach(func() {
 				fakeActor.GetApplicationByNameAndSpaceReturns(
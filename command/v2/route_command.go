@@ -0,0 +1,27 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type RouteCommand struct {
+	RequiredArgs    flag.Domain `positional-args:"yes"`
+	Hostname        string      `long:"hostname" short:"n" description:"Hostname used to identify the HTTP route"`
+	Path            string      `long:"path" description:"Path used to identify the HTTP route"`
+	Port            int         `long:"port" description:"Port used to identify the TCP route"`
+	usage           interface{} `usage:"Display an HTTP route:\n      CF_NAME route DOMAIN [--hostname HOSTNAME] [--path PATH]\n\n   Display a TCP route:\n      CF_NAME route DOMAIN --port PORT\n\nEXAMPLES:\n   CF_NAME route example.com                              # example.com\n   CF_NAME route example.com --hostname myhost            # myhost.example.com\n   CF_NAME route example.com --hostname myhost --path foo # myhost.example.com/foo\n   CF_NAME route example.com --port 5000                  # example.com:5000"`
+	relatedCommands interface{} `related_commands:"map-route, routes"`
+}
+
+func (RouteCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (RouteCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
@@ -9,7 +9,9 @@ import (
 
 type RoutesCommand struct {
 	OrgLevel        bool        `long:"orglevel" description:"List all the routes for all spaces of current organization"`
-	usage           interface{} `usage:"CF_NAME routes [--orglevel]"`
+	OrgLevelAlias   bool        `long:"org-level" description:"Same as --orglevel"`
+	JSON            bool        `long:"json" description:"Output the routes as JSON"`
+	usage           interface{} `usage:"CF_NAME routes [--orglevel] [--json]"`
 	relatedCommands interface{} `related_commands:"check-route, domains, map-route, unmap-route"`
 }
 
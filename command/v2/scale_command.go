@@ -14,7 +14,8 @@ type ScaleCommand struct {
 	NumInstances    int          `short:"i" description:"Number of instances"`
 	DiskLimit       string       `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	MemoryLimit     string       `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	usage           interface{}  `usage:"CF_NAME scale APP_NAME [-i INSTANCES] [-k DISK] [-m MEMORY] [-f]"`
+	ProcessType     string       `long:"process" description:"App process to scale (Default: web)"`
+	usage           interface{}  `usage:"CF_NAME scale APP_NAME [--process PROCESS] [-i INSTANCES] [-k DISK] [-m MEMORY] [-f]"`
 	relatedCommands interface{}  `related_commands:"push"`
 }
 
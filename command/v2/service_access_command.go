@@ -11,7 +11,8 @@ type ServiceAccessCommand struct {
 	Broker          string      `short:"b" description:"Access for plans of a particular broker"`
 	Service         string      `short:"e" description:"Access for service name of a particular service offering"`
 	Organization    string      `short:"o" description:"Plans accessible by a particular organization"`
-	usage           interface{} `usage:"CF_NAME service-access [-b BROKER] [-e SERVICE] [-o ORG]"`
+	JSON            bool        `long:"json" description:"Output the service access settings as JSON"`
+	usage           interface{} `usage:"CF_NAME service-access [-b BROKER] [-e SERVICE] [-o ORG] [--json]"`
 	relatedCommands interface{} `related_commands:"marketplace, disable-service-access, enable-service-access, service-brokers"`
 }
 
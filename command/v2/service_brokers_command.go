@@ -8,7 +8,8 @@ import (
 )
 
 type ServiceBrokersCommand struct {
-	usage           interface{} `usage:"CF_NAME service-brokers"`
+	JSON            bool        `long:"json" description:"Output the service brokers as JSON"`
+	usage           interface{} `usage:"CF_NAME service-brokers [--json]"`
 	relatedCommands interface{} `related_commands:"delete-service-broker, disable-service-access, enable-service-access"`
 }
 
@@ -11,7 +11,9 @@ import (
 type ServiceCommand struct {
 	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
 	GUID            bool                 `long:"guid" description:"Retrieve and display the given service's guid.  All other output for the service is suppressed."`
-	usage           interface{}          `usage:"CF_NAME service SERVICE_INSTANCE"`
+	ShowCredentials bool                 `long:"show-credentials" description:"Display the credential values of a user-provided service instance"`
+	Force           bool                 `short:"f" description:"Force display of credentials without confirmation"`
+	usage           interface{}          `usage:"CF_NAME service SERVICE_INSTANCE [--guid] [--show-credentials [-f]]"`
 	relatedCommands interface{}          `related_commands:"bind-service, rename-service, update-service"`
 }
 
@@ -0,0 +1,132 @@
+package v2
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . ServiceUsageEventsActor
+
+type ServiceUsageEventsActor interface {
+	GetServiceUsageEvents(afterGUID string, since time.Time, eventFunc func(v2action.ServiceUsageEvent) error) (v2action.Warnings, error)
+}
+
+type ServiceUsageEventsCommand struct {
+	AfterGUID       string                 `long:"after-guid" description:"Only show events created after the event with this GUID"`
+	Since           flag.Timestamp         `long:"since" description:"Only show events created at or after this RFC3339 timestamp, e.g. 2017-06-30T15:04:05Z"`
+	Output          flag.EventOutputFormat `long:"output" description:"Output format; 'json' (one event per line) or 'csv'"`
+	Follow          bool                   `long:"follow" description:"Keep polling for new events"`
+	Interval        int                    `long:"interval" default:"30" description:"Number of seconds between polls when following"`
+	usage           interface{}            `usage:"CF_NAME service-usage-events [--after-guid GUID] [--since TIMESTAMP] [--output json|csv] [--follow [--interval SECONDS]]"`
+	relatedCommands interface{}            `related_commands:"app-usage-events"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ServiceUsageEventsActor
+}
+
+func (cmd *ServiceUsageEventsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ServiceUsageEventsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	printer := newUsageEventPrinter(cmd.UI, cmd.Output.Format, cmd.AfterGUID,
+		[]string{"guid", "created_at", "state", "org_guid", "space_guid", "space_name", "service_instance_guid", "service_instance_name", "service_instance_type", "service_plan_guid", "service_plan_name", "service_guid", "service_label", "service_broker_guid", "service_broker_name"},
+		[]string{"guid", "created at", "state", "service instance", "service", "plan", "space"},
+	)
+
+	err = streamUsageEvents(cmd.UI, printer, cmd.Follow, time.Duration(cmd.Interval)*time.Second, func(afterGUID string) (v2action.Warnings, error) {
+		return cmd.Actor.GetServiceUsageEvents(afterGUID, cmd.Since.Time, func(event v2action.ServiceUsageEvent) error {
+			return printer.Print(event.GUID, serviceUsageEventJSON(event), serviceUsageEventCSVRow(event), []string{
+				event.GUID,
+				cmd.UI.UserFriendlyDate(event.CreatedAt),
+				event.State,
+				event.ServiceInstanceName,
+				event.ServiceLabel,
+				event.ServicePlanName,
+				event.SpaceName,
+			})
+		})
+	})
+
+	return shared.HandleError(err)
+}
+
+type serviceUsageEventOutput struct {
+	GUID                string    `json:"guid"`
+	CreatedAt           time.Time `json:"created_at"`
+	State               string    `json:"state"`
+	OrgGUID             string    `json:"org_guid"`
+	SpaceGUID           string    `json:"space_guid"`
+	SpaceName           string    `json:"space_name"`
+	ServiceInstanceGUID string    `json:"service_instance_guid"`
+	ServiceInstanceName string    `json:"service_instance_name"`
+	ServiceInstanceType string    `json:"service_instance_type"`
+	ServicePlanGUID     string    `json:"service_plan_guid,omitempty"`
+	ServicePlanName     string    `json:"service_plan_name,omitempty"`
+	ServiceGUID         string    `json:"service_guid,omitempty"`
+	ServiceLabel        string    `json:"service_label,omitempty"`
+	ServiceBrokerGUID   string    `json:"service_broker_guid,omitempty"`
+	ServiceBrokerName   string    `json:"service_broker_name,omitempty"`
+}
+
+func serviceUsageEventJSON(event v2action.ServiceUsageEvent) serviceUsageEventOutput {
+	return serviceUsageEventOutput{
+		GUID:                event.GUID,
+		CreatedAt:           event.CreatedAt.UTC(),
+		State:               event.State,
+		OrgGUID:             event.OrgGUID,
+		SpaceGUID:           event.SpaceGUID,
+		SpaceName:           event.SpaceName,
+		ServiceInstanceGUID: event.ServiceInstanceGUID,
+		ServiceInstanceName: event.ServiceInstanceName,
+		ServiceInstanceType: event.ServiceInstanceType,
+		ServicePlanGUID:     event.ServicePlanGUID,
+		ServicePlanName:     event.ServicePlanName,
+		ServiceGUID:         event.ServiceGUID,
+		ServiceLabel:        event.ServiceLabel,
+		ServiceBrokerGUID:   event.ServiceBrokerGUID,
+		ServiceBrokerName:   event.ServiceBrokerName,
+	}
+}
+
+func serviceUsageEventCSVRow(event v2action.ServiceUsageEvent) []string {
+	return []string{
+		event.GUID,
+		event.CreatedAt.UTC().Format(time.RFC3339),
+		event.State,
+		event.OrgGUID,
+		event.SpaceGUID,
+		event.SpaceName,
+		event.ServiceInstanceGUID,
+		event.ServiceInstanceName,
+		event.ServiceInstanceType,
+		event.ServicePlanGUID,
+		event.ServicePlanName,
+		event.ServiceGUID,
+		event.ServiceLabel,
+		event.ServiceBrokerGUID,
+		event.ServiceBrokerName,
+	}
+}
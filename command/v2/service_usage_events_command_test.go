@@ -0,0 +1,92 @@
+package v2_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-usage-events Command", func() {
+	var (
+		cmd             ServiceUsageEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeServiceUsageEventsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeServiceUsageEventsActor)
+
+		cmd = ServiceUsageEventsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeActor.GetServiceUsageEventsStub = func(_ string, _ time.Time, eventFunc func(v2action.ServiceUsageEvent) error) (v2action.Warnings, error) {
+			err := eventFunc(v2action.ServiceUsageEvent{
+				GUID:                "event-guid-1",
+				CreatedAt:           time.Date(2017, 6, 30, 15, 4, 5, 0, time.UTC),
+				State:               "CREATED",
+				SpaceName:           "space-1",
+				ServiceInstanceName: "instance-1",
+				ServiceInstanceType: "managed_service_instance",
+				ServicePlanName:     "small",
+				ServiceLabel:        "mysql",
+			})
+			return v2action.Warnings{"event-warning"}, err
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when no output format is provided", func() {
+		It("displays the events in a table followed by the last GUID", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("guid\\s+created at\\s+state\\s+service instance\\s+service\\s+plan\\s+space"))
+			Expect(testUI.Out).To(Say("event-guid-1\\s+.*\\s+CREATED\\s+instance-1\\s+mysql\\s+small\\s+space-1"))
+			Expect(testUI.Out).To(Say("Last event GUID: event-guid-1"))
+			Expect(testUI.Err).To(Say("event-warning"))
+		})
+	})
+
+	Context("when the output format is json", func() {
+		BeforeEach(func() {
+			cmd.Output.Format = "json"
+		})
+
+		It("writes one JSON object per event", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`\{"guid":"event-guid-1","created_at":"2017-06-30T15:04:05Z","state":"CREATED",.*"service_instance_name":"instance-1","service_instance_type":"managed_service_instance","service_plan_name":"small","service_label":"mysql"\}\n`))
+			Expect(testUI.Err).To(Say("Last event GUID: event-guid-1"))
+		})
+	})
+
+	Context("when the output format is csv", func() {
+		BeforeEach(func() {
+			cmd.Output.Format = "csv"
+		})
+
+		It("writes a header followed by one row per event", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("guid,created_at,state,org_guid,space_guid,space_name,service_instance_guid,service_instance_name,service_instance_type,service_plan_guid,service_plan_name,service_guid,service_label,service_broker_guid,service_broker_name\n"))
+			Expect(testUI.Out).To(Say("event-guid-1,2017-06-30T15:04:05Z,CREATED,,,space-1,,instance-1,managed_service_instance,,small,,mysql,,\n"))
+		})
+	})
+})
@@ -8,7 +8,9 @@ import (
 )
 
 type ServicesCommand struct {
-	usage           interface{} `usage:"CF_NAME services"`
+	Fields          string      `long:"fields" description:"Comma-separated columns to display: name, service, plan, bound_apps, last_operation, upgrade_available, broker, updated_at"`
+	JSON            bool        `long:"json" description:"Output all fields of the service instances as JSON"`
+	usage           interface{} `usage:"CF_NAME services [--fields FIELD,...] [--json]\n\nEXAMPLES:\n   CF_NAME services --fields name,plan,broker,updated_at"`
 	relatedCommands interface{} `related_commands:"create-service, marketplace"`
 }
 
@@ -0,0 +1,470 @@
+package v2
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . SetupActor
+
+type SetupActor interface {
+	Authenticate(config v2action.Config, credentials map[string]string, grantType constant.GrantType) error
+	ClearOrganizationAndSpace(config v2action.Config)
+	GetLoginPrompts() (map[string]v2action.LoginPrompt, error)
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetOrganizations() ([]v2action.Organization, v2action.Warnings, error)
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	SetTarget(config v2action.Config, settings v2action.TargetSettings) (v2action.Warnings, error)
+}
+
+type SetupCommand struct {
+	API               string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
+	SkipSSLValidation bool        `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
+	Username          string      `short:"u" description:"Username, or client ID with --client-credentials"`
+	Password          string      `short:"p" description:"Password, or client secret with --client-credentials"`
+	ClientCredentials bool        `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
+	SSO               bool        `long:"sso" description:"Prompt for a one-time passcode to log in"`
+	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
+	Organization      string      `short:"o" description:"Org"`
+	Space             string      `short:"s" description:"Space"`
+	usage             interface{} `usage:"CF_NAME setup [-a API_URL] [--skip-ssl-validation] [-u USERNAME] [-p PASSWORD] [--sso | --sso-passcode PASSCODE | --client-credentials] [-o ORG] [-s SPACE]\n\n   Interactively sets the API endpoint, logs in and targets an org and space.\n   Every prompt is skipped when its value is given as a flag.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME setup (walk through every step)\n   CF_NAME setup -a api.example.com --sso\n   CF_NAME setup -a api.example.com -u my-client -p my-client-secret --client-credentials -o my-org -s my-space"`
+	relatedCommands   interface{} `related_commands:"api, auth, login, target"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  SetupActor
+
+	// NewTargetedActor returns an actor whose clients talk to the API endpoint
+	// currently set in the config. It is used once the endpoint step is done.
+	NewTargetedActor func() (SetupActor, error)
+}
+
+func (cmd *SetupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, false)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	cmd.NewTargetedActor = func() (SetupActor, error) {
+		ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+		if err != nil {
+			return nil, err
+		}
+		return v2action.NewActor(ccClient, uaaClient, config), nil
+	}
+
+	return nil
+}
+
+// Execute runs each step in turn. Every step leaves the config consistent on
+// its own, so being interrupted at any prompt keeps the steps already done and
+// nothing of the current one.
+func (cmd SetupCommand) Execute(args []string) error {
+	err := cmd.checkFlagCombinations()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.setupAPI()
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor, err = cmd.NewTargetedActor()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.authenticate()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.target()
+	if err != nil {
+		return err
+	}
+
+	return cmd.displaySummary()
+}
+
+func (cmd SetupCommand) checkFlagCombinations() error {
+	if cmd.ClientCredentials && (cmd.SSO || cmd.SSOPasscode != "") {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--client-credentials", "--sso", "--sso-passcode"},
+		}
+	}
+	if cmd.SSO && cmd.SSOPasscode != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--sso", "--sso-passcode"},
+		}
+	}
+	if (cmd.SSO || cmd.SSOPasscode != "") && (cmd.Username != "" || cmd.Password != "") {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--sso", "--sso-passcode", "-u", "-p"},
+		}
+	}
+	return nil
+}
+
+func (cmd SetupCommand) setupAPI() error {
+	apiURL := cmd.API
+	if apiURL == "" {
+		var err error
+		apiURL, err = cmd.UI.DisplayTextPrompt(cmd.Config.Target(), "API endpoint")
+		if err != nil {
+			return err
+		}
+	}
+	apiURL = processURL(apiURL)
+
+	cmd.UI.DisplayTextWithFlavor("Setting api endpoint to {{.Endpoint}}...", map[string]interface{}{
+		"Endpoint": apiURL,
+	})
+
+	skipSSLValidation := cmd.SkipSSLValidation
+	warnings, err := cmd.Actor.SetTarget(cmd.Config, v2action.TargetSettings{
+		URL:               apiURL,
+		SkipSSLValidation: skipSSLValidation,
+		DialTimeout:       cmd.Config.DialTimeout(),
+	})
+	cmd.UI.DisplayWarnings(warnings)
+
+	// The SSL prompt is only offered when the endpoint was entered
+	// interactively; with -a the command has to stay non-interactive.
+	if _, ok := err.(ccerror.UnverifiedServerError); ok && !skipSSLValidation && cmd.API == "" {
+		skipSSLValidation, err = cmd.UI.DisplayBoolPrompt(false, "The certificate of {{.Endpoint}} could not be verified. Skip SSL validation? Not recommended!", map[string]interface{}{
+			"Endpoint": apiURL,
+		})
+		if err != nil {
+			return err
+		}
+		if !skipSSLValidation {
+			return translatableerror.InvalidSSLCertError{API: apiURL}
+		}
+
+		warnings, err = cmd.Actor.SetTarget(cmd.Config, v2action.TargetSettings{
+			URL:               apiURL,
+			SkipSSLValidation: skipSSLValidation,
+			DialTimeout:       cmd.Config.DialTimeout(),
+		})
+		cmd.UI.DisplayWarnings(warnings)
+	}
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	// The previously targeted org and space belong to the previous endpoint,
+	// or are about to be replaced after logging in again.
+	cmd.Actor.ClearOrganizationAndSpace(cmd.Config)
+
+	if strings.HasPrefix(apiURL, "http:") {
+		cmd.UI.DisplayText("Warning: Insecure http API endpoint detected: secure https API endpoints are recommended")
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd SetupCommand) authenticate() error {
+	var (
+		credentials map[string]string
+		grantType   constant.GrantType
+		err         error
+	)
+
+	if cmd.ClientCredentials {
+		grantType = constant.GrantTypeClientCredentials
+		credentials, err = cmd.clientCredentials()
+	} else {
+		if cmd.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+			return translatableerror.PasswordGrantTypeLogoutRequiredError{BinaryName: cmd.Config.BinaryName()}
+		}
+		grantType = constant.GrantTypePassword
+		credentials, err = cmd.passwordCredentials()
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Authenticating...")
+	err = cmd.Actor.Authenticate(cmd.Config, credentials, grantType)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd SetupCommand) clientCredentials() (map[string]string, error) {
+	clientID := cmd.Username
+	if clientID == "" {
+		var err error
+		clientID, err = cmd.UI.DisplayTextPrompt("", "Client ID")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	clientSecret := cmd.Password
+	if clientSecret == "" {
+		var err error
+		clientSecret, err = cmd.UI.DisplayPasswordPrompt("Client secret")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return map[string]string{
+		"client_id":     clientID,
+		"client_secret": clientSecret,
+	}, nil
+}
+
+// passwordCredentials asks for the credentials advertised by UAA. SSO is used
+// when requested, or when UAA only offers a passcode.
+func (cmd SetupCommand) passwordCredentials() (map[string]string, error) {
+	prompts, err := cmd.Actor.GetLoginPrompts()
+	if err != nil {
+		return nil, shared.HandleError(err)
+	}
+
+	_, hasUsername := prompts["username"]
+	passcodePrompt, hasPasscode := prompts["passcode"]
+	useSSO := cmd.SSO || cmd.SSOPasscode != "" || (!hasUsername && hasPasscode && cmd.Username == "" && cmd.Password == "")
+
+	if useSSO {
+		passcode := cmd.SSOPasscode
+		if passcode == "" {
+			if !hasPasscode {
+				passcodePrompt = v2action.LoginPrompt{DisplayName: "Temporary Authentication Code"}
+			}
+			passcode, err = cmd.UI.DisplayPasswordPrompt(passcodePrompt.DisplayName)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return map[string]string{"passcode": passcode}, nil
+	}
+
+	credentials := map[string]string{
+		"username": cmd.Username,
+		"password": cmd.Password,
+	}
+
+	names := []string{"username", "password"}
+	for name := range prompts {
+		if name != "username" && name != "password" && name != "passcode" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names[2:])
+
+	for _, name := range names {
+		if credentials[name] != "" {
+			continue
+		}
+
+		prompt, ok := prompts[name]
+		if !ok {
+			prompt = v2action.LoginPrompt{DisplayName: strings.Title(name)}
+			if name == "password" {
+				prompt.Type = v2action.LoginPromptTypePassword
+			}
+		}
+
+		var value string
+		if prompt.Type == v2action.LoginPromptTypePassword {
+			value, err = cmd.UI.DisplayPasswordPrompt(prompt.DisplayName)
+		} else {
+			value, err = cmd.UI.DisplayTextPrompt("", prompt.DisplayName)
+		}
+		if err != nil {
+			return nil, err
+		}
+		credentials[name] = value
+	}
+
+	return credentials, nil
+}
+
+// target selects the org and space, and only then sets both in the config.
+func (cmd SetupCommand) target() error {
+	org, found, err := cmd.selectOrganization()
+	if err != nil || !found {
+		return err
+	}
+
+	space, spaceFound, err := cmd.selectSpace(org)
+	if err != nil {
+		return err
+	}
+
+	cmd.Config.SetOrganizationInformation(org.GUID, org.Name)
+	if spaceFound {
+		cmd.Config.SetSpaceInformation(space.GUID, space.Name, space.AllowSSH)
+	}
+	return nil
+}
+
+func (cmd SetupCommand) selectOrganization() (v2action.Organization, bool, error) {
+	if cmd.Organization != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return v2action.Organization{}, false, shared.HandleError(err)
+		}
+		return org, true, nil
+	}
+
+	orgs, warnings, err := cmd.Actor.GetOrganizations()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return v2action.Organization{}, false, shared.HandleError(err)
+	}
+
+	names := make([]string, len(orgs))
+	for i, org := range orgs {
+		names[i] = org.Name
+	}
+	i, err := cmd.choose("Org", names)
+	if err != nil || i < 0 {
+		return v2action.Organization{}, false, err
+	}
+	return orgs[i], true, nil
+}
+
+func (cmd SetupCommand) selectSpace(org v2action.Organization) (v2action.Space, bool, error) {
+	if cmd.Space != "" {
+		space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(org.GUID, cmd.Space)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return v2action.Space{}, false, shared.HandleError(err)
+		}
+		return space, true, nil
+	}
+
+	spaces, warnings, err := cmd.Actor.GetOrganizationSpaces(org.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return v2action.Space{}, false, shared.HandleError(err)
+	}
+	sort.Slice(spaces, func(i int, j int) bool { return spaces[i].Name < spaces[j].Name })
+
+	names := make([]string, len(spaces))
+	for i, space := range spaces {
+		names[i] = space.Name
+	}
+	i, err := cmd.choose("Space", names)
+	if err != nil || i < 0 {
+		return v2action.Space{}, false, err
+	}
+	return spaces[i], true, nil
+}
+
+// choose returns the index of the name picked by the user, or -1 when there
+// is nothing to pick from. A single name is picked without asking.
+func (cmd SetupCommand) choose(label string, names []string) (int, error) {
+	switch len(names) {
+	case 0:
+		return -1, nil
+	case 1:
+		return 0, nil
+	}
+
+	cmd.UI.DisplayText("Select {{.Label}}:", map[string]interface{}{
+		"Label": strings.ToLower(label),
+	})
+	for i, name := range names {
+		cmd.UI.DisplayText("{{.Number}}. {{.Name}}", map[string]interface{}{
+			"Number": i + 1,
+			"Name":   name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	for {
+		response, err := cmd.UI.DisplayTextPrompt("", label)
+		if err != nil {
+			return -1, err
+		}
+
+		if number, convErr := strconv.Atoi(response); convErr == nil && number >= 1 && number <= len(names) {
+			return number - 1, nil
+		}
+		for i, name := range names {
+			if name == response {
+				return i, nil
+			}
+		}
+
+		cmd.UI.DisplayWarning("Invalid selection: enter a number between 1 and {{.Count}} or a name from the list.", map[string]interface{}{
+			"Count": len(names),
+		})
+	}
+}
+
+func (cmd SetupCommand) displaySummary() error {
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgName := cmd.Config.TargetedOrganization().Name
+	spaceName := cmd.Config.TargetedSpace().Name
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("api endpoint:"), cmd.Config.Target()},
+		{cmd.UI.TranslateText("api version:"), cmd.Config.APIVersion()},
+		{cmd.UI.TranslateText("user:"), user.Name},
+		{cmd.UI.TranslateText("org:"), orgName},
+		{cmd.UI.TranslateText("space:"), spaceName},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	binaryName := cmd.Config.BinaryName()
+	var suggestions []string
+	switch {
+	case orgName == "":
+		suggestions = []string{
+			fmt.Sprintf("%s create-org ORG", binaryName),
+			fmt.Sprintf("%s target -o ORG", binaryName),
+		}
+	case spaceName == "":
+		suggestions = []string{
+			fmt.Sprintf("%s create-space SPACE", binaryName),
+			fmt.Sprintf("%s target -s SPACE", binaryName),
+		}
+	default:
+		suggestions = []string{
+			fmt.Sprintf("%s apps", binaryName),
+			fmt.Sprintf("%s push APP_NAME", binaryName),
+			fmt.Sprintf("%s marketplace", binaryName),
+		}
+	}
+
+	cmd.UI.DisplayText("Setup complete. Suggested next commands:")
+	for _, suggestion := range suggestions {
+		cmd.UI.DisplayText("   {{.Command}}", map[string]interface{}{
+			"Command": suggestion,
+		})
+	}
+	return nil
+}
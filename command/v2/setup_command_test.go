@@ -0,0 +1,521 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("setup Command", func() {
+	var (
+		cmd             SetupCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeActor       *v2fakes.FakeSetupActor
+		fakeConfig      *commandfakes.FakeConfig
+		newActorCallErr error
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeActor = new(v2fakes.FakeSetupActor)
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		newActorCallErr = nil
+
+		cmd = SetupCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+			NewTargetedActor: func() (SetupActor, error) {
+				return fakeActor, newActorCallErr
+			},
+		}
+
+		fakeActor.GetLoginPromptsReturns(map[string]v2action.LoginPrompt{
+			"username": {Type: "text", DisplayName: "Email"},
+			"password": {Type: "password", DisplayName: "Password"},
+			"passcode": {Type: "password", DisplayName: "Temporary Authentication Code"},
+		}, nil)
+		fakeActor.GetOrganizationsReturns([]v2action.Organization{
+			{GUID: "org-1-guid", Name: "org-1"},
+			{GUID: "org-2-guid", Name: "org-2"},
+		}, v2action.Warnings{"orgs-warning"}, nil)
+		fakeActor.GetOrganizationSpacesReturns([]v2action.Space{
+			{GUID: "space-2-guid", Name: "space-2"},
+			{GUID: "space-1-guid", Name: "space-1", AllowSSH: true},
+		}, v2action.Warnings{"spaces-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when every value is given as a flag", func() {
+		BeforeEach(func() {
+			cmd.API = "api.example.com"
+			cmd.SkipSSLValidation = true
+			cmd.Username = "some-user"
+			cmd.Password = "some-password"
+			cmd.Organization = "some-org"
+			cmd.Space = "some-space"
+
+			fakeActor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid", Name: "some-org"}, v2action.Warnings{"org-warning"}, nil)
+			fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "some-space-guid", Name: "some-space", AllowSSH: true}, v2action.Warnings{"space-warning"}, nil)
+
+			fakeConfig.TargetReturns("https://api.example.com")
+			fakeConfig.APIVersionReturns("2.100.0")
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+		})
+
+		It("sets the endpoint, logs in and targets without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+			_, settings := fakeActor.SetTargetArgsForCall(0)
+			Expect(settings.URL).To(Equal("https://api.example.com"))
+			Expect(settings.SkipSSLValidation).To(BeTrue())
+			Expect(fakeActor.ClearOrganizationAndSpaceCallCount()).To(Equal(1))
+
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			_, credentials, grantType := fakeActor.AuthenticateArgsForCall(0)
+			Expect(credentials).To(Equal(map[string]string{
+				"username": "some-user",
+				"password": "some-password",
+			}))
+			Expect(grantType).To(Equal(constant.GrantTypePassword))
+
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+			orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("some-space"))
+
+			Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+			guid, name := fakeConfig.SetOrganizationInformationArgsForCall(0)
+			Expect(guid).To(Equal("some-org-guid"))
+			Expect(name).To(Equal("some-org"))
+			Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(1))
+			guid, name, allowSSH := fakeConfig.SetSpaceInformationArgsForCall(0)
+			Expect(guid).To(Equal("some-space-guid"))
+			Expect(name).To(Equal("some-space"))
+			Expect(allowSSH).To(BeTrue())
+
+			Expect(testUI.Out).To(Say("Setting api endpoint to https://api.example.com\\.\\.\\."))
+			Expect(testUI.Out).To(Say("Authenticating\\.\\.\\."))
+			Expect(testUI.Out).To(Say("api endpoint:\\s+https://api.example.com"))
+			Expect(testUI.Out).To(Say("api version:\\s+2.100.0"))
+			Expect(testUI.Out).To(Say("user:\\s+some-user"))
+			Expect(testUI.Out).To(Say("org:\\s+some-org"))
+			Expect(testUI.Out).To(Say("space:\\s+some-space"))
+			Expect(testUI.Out).To(Say("Setup complete. Suggested next commands:"))
+			Expect(testUI.Out).To(Say("faceman apps"))
+			Expect(testUI.Out).To(Say("faceman push APP_NAME"))
+			Expect(testUI.Err).To(Say("org-warning"))
+			Expect(testUI.Err).To(Say("space-warning"))
+
+			Expect(fakeActor.GetLoginPromptsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetOrganizationsCallCount()).To(Equal(0))
+			Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when no flags are given", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.old.com")
+			_, err := input.Write([]byte("api.example.com\nsome-user\nsome-password\n2\nspace-1\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("prompts for every value", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("API endpoint \\(https://api.old.com\\):"))
+			_, settings := fakeActor.SetTargetArgsForCall(0)
+			Expect(settings.URL).To(Equal("https://api.example.com"))
+			Expect(settings.SkipSSLValidation).To(BeFalse())
+
+			Expect(testUI.Out).To(Say("Email:"))
+			Expect(testUI.Out).To(Say("Password:"))
+			_, credentials, _ := fakeActor.AuthenticateArgsForCall(0)
+			Expect(credentials).To(Equal(map[string]string{
+				"username": "some-user",
+				"password": "some-password",
+			}))
+
+			Expect(testUI.Out).To(Say("Select org:"))
+			Expect(testUI.Out).To(Say("1. org-1"))
+			Expect(testUI.Out).To(Say("2. org-2"))
+			Expect(testUI.Out).To(Say("Select space:"))
+			Expect(testUI.Out).To(Say("1. space-1"))
+			Expect(testUI.Out).To(Say("2. space-2"))
+			Expect(testUI.Err).To(Say("orgs-warning"))
+			Expect(testUI.Err).To(Say("spaces-warning"))
+
+			Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("org-2-guid"))
+			guid, name := fakeConfig.SetOrganizationInformationArgsForCall(0)
+			Expect(guid).To(Equal("org-2-guid"))
+			Expect(name).To(Equal("org-2"))
+			guid, name, allowSSH := fakeConfig.SetSpaceInformationArgsForCall(0)
+			Expect(guid).To(Equal("space-1-guid"))
+			Expect(name).To(Equal("space-1"))
+			Expect(allowSSH).To(BeTrue())
+		})
+	})
+
+	Context("when the org selection is invalid", func() {
+		BeforeEach(func() {
+			cmd.API = "api.example.com"
+			cmd.Username = "some-user"
+			cmd.Password = "some-password"
+			_, err := input.Write([]byte("3\norg-1\n"))
+			Expect(err).ToNot(HaveOccurred())
+			fakeActor.GetOrganizationSpacesReturns(nil, nil, nil)
+		})
+
+		It("asks again", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("Invalid selection: enter a number between 1 and 2 or a name from the list."))
+
+			guid, _ := fakeConfig.SetOrganizationInformationArgsForCall(0)
+			Expect(guid).To(Equal("org-1-guid"))
+			Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when there is only one org and one space", func() {
+		BeforeEach(func() {
+			cmd.API = "api.example.com"
+			cmd.Username = "some-user"
+			cmd.Password = "some-password"
+			fakeActor.GetOrganizationsReturns([]v2action.Organization{{GUID: "org-1-guid", Name: "org-1"}}, nil, nil)
+			fakeActor.GetOrganizationSpacesReturns([]v2action.Space{{GUID: "space-1-guid", Name: "space-1"}}, nil, nil)
+		})
+
+		It("targets them without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Select"))
+
+			guid, _ := fakeConfig.SetOrganizationInformationArgsForCall(0)
+			Expect(guid).To(Equal("org-1-guid"))
+			guid, _, _ = fakeConfig.SetSpaceInformationArgsForCall(0)
+			Expect(guid).To(Equal("space-1-guid"))
+		})
+	})
+
+	Context("when there are no orgs", func() {
+		BeforeEach(func() {
+			cmd.API = "api.example.com"
+			cmd.Username = "some-user"
+			cmd.Password = "some-password"
+			fakeActor.GetOrganizationsReturns(nil, nil, nil)
+		})
+
+		It("targets nothing and suggests creating an org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+			Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
+			Expect(testUI.Out).To(Say("faceman create-org ORG"))
+			Expect(testUI.Out).To(Say("faceman target -o ORG"))
+		})
+	})
+
+	Context("when the input ends while selecting the space", func() {
+		BeforeEach(func() {
+			cmd.API = "api.example.com"
+			cmd.Username = "some-user"
+			cmd.Password = "some-password"
+			_, err := input.Write([]byte("org-1\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the error without targeting the org", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+			Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("endpoint step", func() {
+		Context("when the certificate cannot be verified", func() {
+			BeforeEach(func() {
+				fakeActor.SetTargetReturnsOnCall(0, nil, ccerror.UnverifiedServerError{URL: "https://api.example.com"})
+				cmd.Username = "some-user"
+				cmd.Password = "some-password"
+				cmd.Organization = "some-org"
+				cmd.Space = "some-space"
+			})
+
+			Context("when the endpoint was entered interactively", func() {
+				Context("when the user skips SSL validation", func() {
+					BeforeEach(func() {
+						_, err := input.Write([]byte("api.example.com\ny\n"))
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("sets the endpoint without SSL validation", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("The certificate of https://api.example.com could not be verified. Skip SSL validation\\? Not recommended!"))
+
+						Expect(fakeActor.SetTargetCallCount()).To(Equal(2))
+						_, settings := fakeActor.SetTargetArgsForCall(1)
+						Expect(settings.SkipSSLValidation).To(BeTrue())
+					})
+				})
+
+				Context("when the user does not skip SSL validation", func() {
+					BeforeEach(func() {
+						_, err := input.Write([]byte("api.example.com\nn\n"))
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("returns an InvalidSSLCertError and leaves the config alone", func() {
+						Expect(executeErr).To(MatchError(translatableerror.InvalidSSLCertError{API: "https://api.example.com"}))
+						Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+						Expect(fakeActor.ClearOrganizationAndSpaceCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the endpoint was given with -a", func() {
+				BeforeEach(func() {
+					cmd.API = "api.example.com"
+				})
+
+				It("returns an InvalidSSLCertError without prompting", func() {
+					Expect(executeErr).To(MatchError(translatableerror.InvalidSSLCertError{API: "https://api.example.com"}))
+					Expect(testUI.Out).ToNot(Say("Skip SSL validation"))
+				})
+			})
+		})
+
+		Context("when creating the targeted actor fails", func() {
+			BeforeEach(func() {
+				cmd.API = "api.example.com"
+				newActorCallErr = errors.New("some-error")
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("authentication step", func() {
+		BeforeEach(func() {
+			cmd.API = "api.example.com"
+			cmd.Organization = "some-org"
+			cmd.Space = "some-space"
+		})
+
+		Context("when using client credentials", func() {
+			BeforeEach(func() {
+				cmd.ClientCredentials = true
+				_, err := input.Write([]byte("some-client\nsome-secret\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("prompts for the client ID and secret", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Client ID:"))
+				Expect(testUI.Out).To(Say("Client secret:"))
+
+				_, credentials, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{
+					"client_id":     "some-client",
+					"client_secret": "some-secret",
+				}))
+				Expect(grantType).To(Equal(constant.GrantTypeClientCredentials))
+				Expect(fakeActor.GetLoginPromptsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when using --sso", func() {
+			BeforeEach(func() {
+				cmd.SSO = true
+				_, err := input.Write([]byte("some-passcode\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("prompts for the passcode advertised by UAA", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Temporary Authentication Code:"))
+
+				_, credentials, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{"passcode": "some-passcode"}))
+				Expect(grantType).To(Equal(constant.GrantTypePassword))
+			})
+		})
+
+		Context("when using --sso-passcode", func() {
+			BeforeEach(func() {
+				cmd.SSOPasscode = "some-passcode"
+			})
+
+			It("authenticates with the passcode", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, credentials, _ := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{"passcode": "some-passcode"}))
+			})
+		})
+
+		Context("when UAA only advertises a passcode", func() {
+			BeforeEach(func() {
+				fakeActor.GetLoginPromptsReturns(map[string]v2action.LoginPrompt{
+					"passcode": {Type: "password", DisplayName: "One Time Code"},
+				}, nil)
+				_, err := input.Write([]byte("some-passcode\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("uses SSO", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("One Time Code:"))
+				_, credentials, _ := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{"passcode": "some-passcode"}))
+			})
+		})
+
+		Context("when UAA advertises additional prompts", func() {
+			BeforeEach(func() {
+				cmd.Username = "some-user"
+				cmd.Password = "some-password"
+				fakeActor.GetLoginPromptsReturns(map[string]v2action.LoginPrompt{
+					"username": {Type: "text", DisplayName: "Email"},
+					"password": {Type: "password", DisplayName: "Password"},
+					"mfaCode":  {Type: "password", DisplayName: "MFA Code"},
+				}, nil)
+				_, err := input.Write([]byte("123456\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("prompts for them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("MFA Code:"))
+				_, credentials, _ := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{
+					"username": "some-user",
+					"password": "some-password",
+					"mfaCode":  "123456",
+				}))
+			})
+		})
+
+		Context("when the user is logged in with client credentials", func() {
+			BeforeEach(func() {
+				cmd.Username = "some-user"
+				cmd.Password = "some-password"
+				fakeConfig.UAAGrantTypeReturns(string(constant.GrantTypeClientCredentials))
+			})
+
+			It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PasswordGrantTypeLogoutRequiredError{BinaryName: "faceman"}))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authentication fails", func() {
+			BeforeEach(func() {
+				cmd.Username = "some-user"
+				cmd.Password = "some-password"
+				fakeActor.AuthenticateReturns(errors.New("some-error"))
+			})
+
+			It("returns the error without targeting", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("target step", func() {
+		BeforeEach(func() {
+			cmd.API = "api.example.com"
+			cmd.Username = "some-user"
+			cmd.Password = "some-password"
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				cmd.Organization = "some-org"
+				fakeActor.GetOrganizationByNameReturns(v2action.Organization{}, nil, v2action.OrganizationNotFoundError{Name: "some-org"})
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				cmd.Organization = "some-org"
+				cmd.Space = "some-space"
+				fakeActor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid", Name: "some-org"}, nil, nil)
+				fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{}, nil, v2action.SpaceNotFoundError{Name: "some-space"})
+			})
+
+			It("returns a SpaceNotFoundError without targeting the org", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "some-space"}))
+				Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when --client-credentials and --sso are both given", func() {
+		BeforeEach(func() {
+			cmd.ClientCredentials = true
+			cmd.SSO = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--client-credentials", "--sso", "--sso-passcode"},
+			}))
+			Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --sso and --sso-passcode are both given", func() {
+		BeforeEach(func() {
+			cmd.SSO = true
+			cmd.SSOPasscode = "some-passcode"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--sso", "--sso-passcode"},
+			}))
+		})
+	})
+
+	Context("when --sso and -u are both given", func() {
+		BeforeEach(func() {
+			cmd.SSO = true
+			cmd.Username = "some-user"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--sso", "--sso-passcode", "-u", "-p"},
+			}))
+		})
+	})
+})
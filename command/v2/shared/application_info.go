@@ -24,7 +24,11 @@ func DisplayAppSummary(ui command.UI, appSummary v2action.ApplicationSummary, di
 
 	formattedRoutes := []string{}
 	for _, route := range appSummary.Routes {
-		formattedRoutes = append(formattedRoutes, route.String())
+		formattedRoute := route.String()
+		if route.Domain.Internal {
+			formattedRoute = fmt.Sprintf("%s %s", formattedRoute, ui.TranslateText("(internal)"))
+		}
+		formattedRoutes = append(formattedRoutes, formattedRoute)
 	}
 	routes := strings.Join(formattedRoutes, ", ")
 
@@ -34,10 +38,15 @@ func DisplayAppSummary(ui command.UI, appSummary v2action.ApplicationSummary, di
 		{ui.TranslateText("instances:"), instances},
 		{ui.TranslateText("usage:"), usage},
 		{ui.TranslateText("routes:"), routes},
-		{ui.TranslateText("last uploaded:"), ui.UserFriendlyDate(appSummary.PackageUpdatedAt)},
-		{ui.TranslateText("stack:"), appSummary.Stack.Name},
+		{ui.TranslateText("last uploaded:"), lastUploaded(ui, appSummary)},
 	}
 
+	if appSummary.LastUploadedBy != "" {
+		table = append(table, []string{ui.TranslateText("last uploaded by:"), appSummary.LastUploadedBy})
+	}
+
+	table = append(table, []string{ui.TranslateText("stack:"), appSummary.Stack.Name})
+
 	if appSummary.DockerImage == "" {
 		table = append(table, []string{ui.TranslateText("buildpack:"), appSummary.Application.CalculatedBuildpack()})
 	} else {
@@ -57,13 +66,22 @@ func DisplayAppSummary(ui command.UI, appSummary v2action.ApplicationSummary, di
 	ui.DisplayKeyValueTableForApp(table)
 	ui.DisplayNewline()
 
-	if len(appSummary.RunningInstances) == 0 {
+	if appSummary.NeverStaged() {
+		ui.DisplayText("This app has no droplet because it has never been staged. Push the app to upload its bits and stage it.")
+	} else if len(appSummary.RunningInstances) == 0 {
 		ui.DisplayText("There are no running instances of this app.")
 	} else {
 		displayAppInstances(ui, appSummary.RunningInstances)
 	}
 }
 
+func lastUploaded(ui command.UI, appSummary v2action.ApplicationSummary) string {
+	if appSummary.NeverStaged() {
+		return ui.TranslateText("never")
+	}
+	return ui.UserFriendlyDate(appSummary.PackageUpdatedAt)
+}
+
 func displayAppInstances(ui command.UI, instances []v2action.ApplicationInstanceWithStats) {
 	table := [][]string{
 		{
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/labelselector"
 	"code.cloudfoundry.org/cli/util/manifest"
 )
 
@@ -15,12 +16,16 @@ func HandleError(err error) error {
 	switch e := err.(type) {
 	case ccerror.APINotFoundError:
 		return translatableerror.APINotFoundError(e)
+	case ccerror.CloudControllerUnavailableError:
+		return translatableerror.CloudControllerUnavailableError{URL: e.URL}
 	case ccerror.RequestError:
 		return translatableerror.APIRequestError(e)
 	case ccerror.SSLValidationHostnameError:
 		return translatableerror.SSLCertError(e)
 	case ccerror.UnverifiedServerError:
 		return translatableerror.InvalidSSLCertError{API: e.URL}
+	case ccerror.FoundationMismatchError:
+		return translatableerror.FoundationMismatchError{}
 
 	case ccerror.JobFailedError:
 		return translatableerror.JobFailedError(e)
@@ -31,6 +36,11 @@ func HandleError(err error) error {
 		return translatableerror.BadCredentialsError{}
 	case uaa.InvalidAuthTokenError:
 		return translatableerror.InvalidRefreshTokenError{}
+	case uaa.TokenNotJWTError:
+		return translatableerror.TokenNotJWTError{}
+
+	case labelselector.SyntaxError:
+		return translatableerror.InvalidLabelSelectorError{Selector: e.Selector, Column: e.Column(), Reason: e.Reason}
 
 	case sharedaction.NotLoggedInError:
 		return translatableerror.NotLoggedInError(e)
@@ -65,6 +75,8 @@ func HandleError(err error) error {
 		return translatableerror.NoMatchingDomainError(e)
 	case actionerror.InvalidHTTPRouteSettings:
 		return translatableerror.PortNotAllowedWithHTTPDomainError(e)
+	case actionerror.LoggingUnavailableError:
+		return translatableerror.LoggingUnavailableError{}
 
 	case pushaction.AppNotFoundInManifestError:
 		return translatableerror.AppNotFoundInManifestError(e)
@@ -76,6 +88,12 @@ func HandleError(err error) error {
 		return translatableerror.FileNotFoundError(e)
 	case pushaction.MissingNameError:
 		return translatableerror.RequiredNameForPushError{}
+	case pushaction.InvalidStagingTimeoutError:
+		return translatableerror.InvalidStagingTimeoutError(e)
+	case pushaction.PropertyCombinationError:
+		return translatableerror.PropertyCombinationError(e)
+	case pushaction.SidecarMemoryExceedsProcessMemoryError:
+		return translatableerror.SidecarMemoryExceedsProcessMemoryError(e)
 	case pushaction.UploadFailedError:
 		return translatableerror.UploadFailedError{Err: HandleError(e.Err)}
 
@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/labelselector"
 	"code.cloudfoundry.org/cli/util/manifest"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -34,6 +35,10 @@ var _ = Describe("HandleError", func() {
 			ccerror.UnverifiedServerError{URL: "some-url"},
 			translatableerror.InvalidSSLCertError{API: "some-url"}),
 
+		Entry("ccerror.FoundationMismatchError -> FoundationMismatchError",
+			ccerror.FoundationMismatchError{Issuer: "some-issuer", UAAEndpoint: "some-uaa"},
+			translatableerror.FoundationMismatchError{}),
+
 		Entry("ccerror.SSLValidationHostnameError -> SSLCertErrorError",
 			ccerror.SSLValidationHostnameError{Message: "some-message"},
 			translatableerror.SSLCertError{Message: "some-message"}),
@@ -42,10 +47,18 @@ var _ = Describe("HandleError", func() {
 			ccerror.APINotFoundError{URL: "some-url"},
 			translatableerror.APINotFoundError{URL: "some-url"}),
 
+		Entry("ccerror.CloudControllerUnavailableError -> CloudControllerUnavailableError",
+			ccerror.CloudControllerUnavailableError{StatusCode: 503, URL: "some-url"},
+			translatableerror.CloudControllerUnavailableError{URL: "some-url"}),
+
 		Entry("v2action.ApplicationNotFoundError -> ApplicationNotFoundError",
 			actionerror.ApplicationNotFoundError{Name: "some-app"},
 			translatableerror.ApplicationNotFoundError{Name: "some-app"}),
 
+		Entry("actionerror.LoggingUnavailableError -> LoggingUnavailableError",
+			actionerror.LoggingUnavailableError{},
+			translatableerror.LoggingUnavailableError{}),
+
 		Entry("v2action.SecurityGroupNotFoundError -> SecurityGroupNotFoundError",
 			v2action.SecurityGroupNotFoundError{Name: "some-security-group"},
 			translatableerror.SecurityGroupNotFoundError{Name: "some-security-group"}),
@@ -126,6 +139,11 @@ var _ = Describe("HandleError", func() {
 			translatableerror.InvalidRefreshTokenError{},
 		),
 
+		Entry("uaa.TokenNotJWTError -> TokenNotJWTError",
+			uaa.TokenNotJWTError{},
+			translatableerror.TokenNotJWTError{},
+		),
+
 		Entry("pushaction.AppNotFoundInManifestError -> AppNotFoundInManifestError",
 			pushaction.AppNotFoundInManifestError{Name: "some-app"},
 			translatableerror.AppNotFoundInManifestError{Name: "some-app"},
@@ -151,6 +169,20 @@ var _ = Describe("HandleError", func() {
 			translatableerror.UploadFailedError{Err: translatableerror.NoDomainsFoundError{}},
 		),
 
+		Entry("pushaction.InvalidStagingTimeoutError -> InvalidStagingTimeoutError",
+			pushaction.InvalidStagingTimeoutError{AppName: "some-app", Timeout: -1},
+			translatableerror.InvalidStagingTimeoutError{AppName: "some-app", Timeout: -1},
+		),
+
+		Entry("pushaction.PropertyCombinationError -> PropertyCombinationError",
+			pushaction.PropertyCombinationError{AppName: "some-app", Properties: []string{"no-route", "routes"}},
+			translatableerror.PropertyCombinationError{AppName: "some-app", Properties: []string{"no-route", "routes"}},
+		),
+		Entry("pushaction.SidecarMemoryExceedsProcessMemoryError -> SidecarMemoryExceedsProcessMemoryError",
+			pushaction.SidecarMemoryExceedsProcessMemoryError{AppName: "some-app", SidecarName: "some-sidecar"},
+			translatableerror.SidecarMemoryExceedsProcessMemoryError{AppName: "some-app", SidecarName: "some-sidecar"},
+		),
+
 		Entry("pushaction.NonexistentAppPathError -> FileNotFoundError",
 			pushaction.NonexistentAppPathError{Path: "some-path"},
 			translatableerror.FileNotFoundError{Path: "some-path"},
@@ -166,6 +198,10 @@ var _ = Describe("HandleError", func() {
 			translatableerror.ManifestCreationError{Err: errors.New("some-error")},
 		),
 
+		Entry("labelselector.SyntaxError -> InvalidLabelSelectorError",
+			labelselector.SyntaxError{Selector: "env is prod", Position: 4, Token: "is", Reason: "some-reason"},
+			translatableerror.InvalidLabelSelectorError{Selector: "env is prod", Column: 4, Reason: "some-reason"}),
+
 		Entry("default case -> original error",
 			err,
 			err),
@@ -1,12 +1,16 @@
 package shared
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
 	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ratelimit"
 )
 
 // NewClients creates a new V2 Cloud Controller client and UAA client using the
@@ -14,19 +18,23 @@ import (
 func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Client, *uaa.Client, error) {
 	ccWrappers := []ccv2.ConnectionWrapper{}
 
+	var outputs []ccWrapper.RequestLoggerOutput
 	verbose, location := config.Verbose()
 	if verbose {
-		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+		outputs = append(outputs, ui.RequestLoggerTerminalDisplay())
 	}
-
 	if location != nil {
-		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+		outputs = append(outputs, ui.RequestLoggerFileWriter(location))
+	}
+	for _, output := range outputs {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(output))
 	}
 
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(2))
+	ccWrappers = append(ccWrappers, ccWrapper.NewRateLimit(ratelimit.Default, outputs...))
+	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequestWithBackoff(config.RetryCount(), time.Second, outputs...))
 
 	ccClient := ccv2.NewClient(ccv2.Config{
 		AppName:            config.BinaryName(),
@@ -47,6 +55,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 	}
 
 	_, err := ccClient.TargetCF(ccv2.TargetSettings{
+		CACert:            config.CACert(),
 		URL:               config.Target(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		DialTimeout:       config.DialTimeout(),
@@ -62,8 +71,10 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 	uaaClient := uaa.NewClient(uaa.Config{
 		AppName:           config.BinaryName(),
 		AppVersion:        config.BinaryVersion(),
+		CACert:            config.CACert(),
 		ClientID:          config.UAAOAuthClient(),
 		ClientSecret:      config.UAAOAuthClientSecret(),
+		GrantType:         constant.GrantType(config.UAAGrantType()),
 		DialTimeout:       config.DialTimeout(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 	})
@@ -86,6 +97,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 
 	uaaAuthWrapper.SetClient(uaaClient)
 	authWrapper.SetClient(uaaClient)
+	authWrapper.SetFoundationStore(config)
 
 	return ccClient, uaaClient, err
 }
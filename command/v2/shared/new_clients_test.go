@@ -1,9 +1,14 @@
 package shared_test
 
 import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
 	"runtime"
+	"sync"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2/shared"
@@ -12,6 +17,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/ghttp"
 )
 
 var _ = Describe("New Clients", func() {
@@ -73,4 +79,129 @@ var _ = Describe("New Clients", func() {
 			Expect(fakeConfig.SkipSSLValidationCallCount()).To(Equal(0))
 		})
 	})
+
+	Describe("token refreshes", func() {
+		var (
+			server      *Server
+			tokenLock   sync.Mutex
+			accessToken string
+			grantCount  int
+		)
+
+		// runCommand simulates a command invocation: it creates new clients and
+		// makes a few concurrent requests, as the actors do.
+		runCommand := func(requests ...func(*ccv2.Client) error) {
+			ccClient, _, err := NewClients(fakeConfig, testUI, true)
+			Expect(err).ToNot(HaveOccurred())
+
+			var wg sync.WaitGroup
+			for _, request := range requests {
+				wg.Add(1)
+				go func(request func(*ccv2.Client) error) {
+					defer wg.Done()
+					defer GinkgoRecover()
+					Expect(request(ccClient)).To(Succeed())
+				}(request)
+			}
+			wg.Wait()
+		}
+
+		getApps := func(client *ccv2.Client) error {
+			_, _, err := client.GetApplications()
+			return err
+		}
+		getOrgs := func(client *ccv2.Client) error {
+			_, _, err := client.GetOrganizations()
+			return err
+		}
+		getSpaces := func(client *ccv2.Client) error {
+			_, _, err := client.GetSpaces()
+			return err
+		}
+
+		tokenExpiringAt := func(expiresAt time.Time) string {
+			claims := fmt.Sprintf(`{"exp":%d}`, expiresAt.Unix())
+			return "bearer header." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature"
+		}
+
+		BeforeEach(func() {
+			server = NewServer()
+			grantCount = 0
+
+			fakeConfig.TargetReturns(server.URL())
+			fakeConfig.RefreshTokenReturns("some-refresh-token")
+			fakeConfig.AccessTokenStub = func() string {
+				tokenLock.Lock()
+				defer tokenLock.Unlock()
+				return accessToken
+			}
+			fakeConfig.SetAccessTokenStub = func(token string) {
+				tokenLock.Lock()
+				defer tokenLock.Unlock()
+				accessToken = token
+			}
+
+			server.RouteToHandler(http.MethodGet, "/v2/info", RespondWith(http.StatusOK,
+				fmt.Sprintf(`{"api_version":"2.100.0","authorization_endpoint":"%s"}`, server.URL())))
+			server.RouteToHandler(http.MethodGet, "/login", RespondWith(http.StatusOK,
+				fmt.Sprintf(`{"links":{"uaa":"%s"}}`, server.URL())))
+			server.RouteToHandler(http.MethodPost, "/oauth/token", func(w http.ResponseWriter, _ *http.Request) {
+				tokenLock.Lock()
+				grantCount++
+				tokenLock.Unlock()
+
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"access_token":"refreshed-token","refresh_token":"new-refresh-token","token_type":"bearer"}`)
+			})
+
+			for _, path := range []string{"/v2/apps", "/v2/organizations", "/v2/spaces"} {
+				server.RouteToHandler(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+					if r.Header.Get("Authorization") == "bearer revoked-token" {
+						w.WriteHeader(http.StatusUnauthorized)
+						fmt.Fprint(w, `{"code":1000,"description":"Invalid Auth Token","error_code":"CF-InvalidAuthToken"}`)
+						return
+					}
+					fmt.Fprint(w, `{"resources":[]}`)
+				})
+			}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		Context("when the token is valid for more than a minute", func() {
+			BeforeEach(func() {
+				accessToken = tokenExpiringAt(time.Now().Add(time.Hour))
+			})
+
+			It("never refreshes the token", func() {
+				runCommand(getApps)
+				runCommand(getOrgs, getSpaces)
+				Expect(grantCount).To(Equal(0))
+			})
+		})
+
+		Context("when the token is about to expire", func() {
+			BeforeEach(func() {
+				accessToken = tokenExpiringAt(time.Now().Add(30 * time.Second))
+			})
+
+			It("refreshes the token once", func() {
+				runCommand(getApps, getApps, getOrgs, getSpaces)
+				Expect(grantCount).To(Equal(1))
+			})
+		})
+
+		Context("when the token is rejected by concurrent requests", func() {
+			BeforeEach(func() {
+				accessToken = "bearer revoked-token"
+			})
+
+			It("refreshes the token once", func() {
+				runCommand(getApps, getApps, getOrgs, getSpaces)
+				Expect(grantCount).To(Equal(1))
+			})
+		})
+	})
 })
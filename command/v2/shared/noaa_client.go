@@ -2,12 +2,14 @@ package shared
 
 import (
 	"crypto/tls"
-	"net/http"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/noaabridge"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/cacert"
+	"code.cloudfoundry.org/cli/util/proxy"
 	"github.com/cloudfoundry/noaa/consumer"
 )
 
@@ -37,25 +39,19 @@ func (p DebugPrinter) Print(title string, dump string) {
 
 }
 
-// NewNOAAClient returns back a configured NOAA Client.
-func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) *consumer.Consumer {
-	client := consumer.New(
-		apiURL,
-		&tls.Config{
-			InsecureSkipVerify: config.SkipSSLValidation(),
-		},
-		http.ProxyFromEnvironment,
-	)
-	client.RefreshTokenFrom(noaabridge.NewTokenRefresher(uaaClient, config))
-	client.SetMaxRetryCount(5)
+// NewNOAAClient returns back a configured NOAA Client. It returns nil when
+// apiURL is empty, which is the case on platforms that do not advertise a
+// logging endpoint.
+func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) v2action.NOAAClient {
+	if apiURL == "" {
+		return nil
+	}
 
 	noaaDebugPrinter := DebugPrinter{}
 
 	// if verbose, set debug printer on noaa client
 	verbose, location := config.Verbose()
 
-	client.SetDebugPrinter(&noaaDebugPrinter)
-
 	if verbose {
 		noaaDebugPrinter.addOutput(ui.RequestLoggerTerminalDisplay())
 	}
@@ -63,5 +59,19 @@ func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client,
 		noaaDebugPrinter.addOutput(ui.RequestLoggerFileWriter(location))
 	}
 
+	client := consumer.New(
+		apiURL,
+		&tls.Config{
+			InsecureSkipVerify: config.SkipSSLValidation(),
+			RootCAs:            cacert.NewCertPool(config.CACert()),
+		},
+		proxy.FromEnvironment().ProxyFunc(func(message string) {
+			noaaDebugPrinter.Print("PROXY:", message)
+		}),
+	)
+	client.RefreshTokenFrom(noaabridge.NewTokenRefresher(uaaClient, config))
+	client.SetMaxRetryCount(5)
+	client.SetDebugPrinter(&noaaDebugPrinter)
+
 	return client
 }
@@ -0,0 +1,50 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/readiness"
+)
+
+// HTTPReadinessURL returns the URL that --wait-for-http polls: statusPath on
+// the app's first HTTP route. TCP routes are skipped because they are not
+// served over HTTP.
+func HTTPReadinessURL(appName string, routes v2action.Routes, statusPath string) (string, error) {
+	for _, route := range routes {
+		if !route.Port.IsSet {
+			return readiness.URL(route.String(), statusPath), nil
+		}
+	}
+
+	return "", translatableerror.NoHTTPRouteError{AppName: appName}
+}
+
+// PollHTTPReadiness GETs url until it returns a 2xx status or the startup
+// timeout elapses, displaying the status of every attempt.
+func PollHTTPReadiness(ui command.UI, config command.Config, url string) error {
+	ui.DisplayText("Waiting for {{.URL}} to return a 2xx status...", map[string]interface{}{
+		"URL": url,
+	})
+
+	poller := readiness.NewPoller(config.SkipSSLValidation(), config.PollingInterval(), config.StartupTimeout())
+	err := poller.Poll(url, func(attempt readiness.Attempt) {
+		if attempt.Err != nil {
+			ui.DisplayText("GET {{.URL}}: {{.Error}}", map[string]interface{}{
+				"URL":   attempt.URL,
+				"Error": attempt.Err.Error(),
+			})
+			return
+		}
+
+		ui.DisplayText("GET {{.URL}}: {{.StatusCode}}", map[string]interface{}{
+			"URL":        attempt.URL,
+			"StatusCode": attempt.StatusCode,
+		})
+	})
+	if timeoutErr, ok := err.(readiness.TimeoutError); ok {
+		return translatableerror.AppNotReadyError{URL: timeoutErr.URL, Timeout: timeoutErr.Timeout}
+	}
+
+	return err
+}
@@ -0,0 +1,93 @@
+package shared_test
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("HTTPReadinessURL", func() {
+	It("uses the first HTTP route", func() {
+		url, err := HTTPReadinessURL("some-app", v2action.Routes{
+			{Domain: v2action.Domain{Name: "tcp.example.com"}, Port: types.NullInt{Value: 1024, IsSet: true}},
+			{Host: "some-app", Domain: v2action.Domain{Name: "example.com"}, Path: "/api"},
+			{Host: "other", Domain: v2action.Domain{Name: "example.com"}},
+		}, "/ready")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(url).To(Equal("https://some-app.example.com/api/ready"))
+	})
+
+	It("returns a NoHTTPRouteError when the app only has TCP routes", func() {
+		_, err := HTTPReadinessURL("some-app", v2action.Routes{
+			{Domain: v2action.Domain{Name: "tcp.example.com"}, Port: types.NullInt{Value: 1024, IsSet: true}},
+		}, "/ready")
+		Expect(err).To(MatchError(translatableerror.NoHTTPRouteError{AppName: "some-app"}))
+	})
+})
+
+var _ = Describe("PollHTTPReadiness", func() {
+	var (
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		server     *ghttp.Server
+		url        string
+		err        error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.SkipSSLValidationReturns(true)
+		fakeConfig.PollingIntervalReturns(time.Millisecond)
+		fakeConfig.StartupTimeoutReturns(50 * time.Millisecond)
+
+		server = ghttp.NewTLSServer()
+		url = "https://" + strings.TrimPrefix(server.URL(), "https://") + "/ready"
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		err = PollHTTPReadiness(testUI, fakeConfig, url)
+	})
+
+	Context("when the app becomes ready", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(http.StatusServiceUnavailable, nil),
+				ghttp.RespondWith(http.StatusOK, nil),
+			)
+		})
+
+		It("displays the status of every attempt", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Waiting for %s to return a 2xx status...", url))
+			Expect(testUI.Out).To(Say("GET %s: 503", url))
+			Expect(testUI.Out).To(Say("GET %s: 200", url))
+		})
+	})
+
+	Context("when the app does not become ready in time", func() {
+		BeforeEach(func() {
+			server.RouteToHandler(http.MethodGet, "/ready", ghttp.RespondWith(http.StatusServiceUnavailable, nil))
+		})
+
+		It("returns an AppNotReadyError", func() {
+			Expect(err).To(MatchError(translatableerror.AppNotReadyError{URL: url, Timeout: 50 * time.Millisecond}))
+		})
+	})
+})
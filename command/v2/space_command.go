@@ -27,7 +27,7 @@ type SpaceActor interface {
 
 type SpaceActorV3 interface {
 	CloudControllerAPIVersion() string
-	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetSpaceIsolationSegment(spaceGUID string, orgGUID string) (v3action.SpaceIsolationSegment, v3action.Warnings, error)
 }
 
 type SpaceCommand struct {
@@ -191,17 +191,23 @@ func (cmd SpaceCommand) isolationSegmentRow(spaceSummary v2action.SpaceSummary)
 		return nil, nil
 	}
 
-	isolationSegmentName := ""
-	isolationSegment, v3Warnings, err := cmd.ActorV3.GetEffectiveIsolationSegmentBySpace(
-		spaceSummary.GUID, spaceSummary.OrgDefaultIsolationSegmentGUID)
+	isolationSegment, v3Warnings, err := cmd.ActorV3.GetSpaceIsolationSegment(
+		spaceSummary.GUID, cmd.Config.TargetedOrganization().GUID)
 	cmd.UI.DisplayWarnings(v3Warnings)
-	if err == nil {
-		isolationSegmentName = isolationSegment.Name
-	} else {
-		if _, ok := err.(v3action.NoRelationshipError); !ok {
-			return nil, err
-		}
+	if err != nil {
+		return nil, err
+	}
+
+	name := map[string]interface{}{"Name": isolationSegment.Name}
+	var isolationSegmentText string
+	switch isolationSegment.Source {
+	case v3action.IsolationSegmentAssignedToSpace:
+		isolationSegmentText = cmd.UI.TranslateText("{{.Name}} (assigned to space)", name)
+	case v3action.IsolationSegmentOrganizationDefault:
+		isolationSegmentText = cmd.UI.TranslateText("{{.Name}} (org default)", name)
+	default:
+		isolationSegmentText = cmd.UI.TranslateText("{{.Name}} (platform default)", name)
 	}
 
-	return []string{cmd.UI.TranslateText("isolation segment:"), isolationSegmentName}, nil
+	return []string{cmd.UI.TranslateText("isolation segment:"), isolationSegmentText}, nil
 }
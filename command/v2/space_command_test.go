@@ -191,9 +191,12 @@ var _ = Describe("space Command", func() {
 
 			Context("when there is a v3 API", func() {
 				BeforeEach(func() {
-					fakeActorV3.GetEffectiveIsolationSegmentBySpaceReturns(
-						v3action.IsolationSegment{
-							Name: "some-isolation-segment",
+					fakeActorV3.GetSpaceIsolationSegmentReturns(
+						v3action.SpaceIsolationSegment{
+							IsolationSegment: v3action.IsolationSegment{
+								Name: "some-isolation-segment",
+							},
+							Source: v3action.IsolationSegmentAssignedToSpace,
 						},
 						v3action.Warnings{"v3-warning-1", "v3-warning-2"},
 						nil,
@@ -208,7 +211,7 @@ var _ = Describe("space Command", func() {
 					Expect(testUI.Out).To(Say("org:\\s+some-org"))
 					Expect(testUI.Out).To(Say("apps:\\s+app1, app2, app3"))
 					Expect(testUI.Out).To(Say("services:\\s+service1, service2, service3"))
-					Expect(testUI.Out).To(Say("isolation segment:\\s+some-isolation-segment"))
+					Expect(testUI.Out).To(Say("isolation segment:\\s+some-isolation-segment \\(assigned to space\\)"))
 					Expect(testUI.Out).To(Say("space quota:\\s+some-space-quota"))
 					Expect(testUI.Out).To(Say("running security groups:\\s+public_networks, dns, load_balancer"))
 					Expect(testUI.Out).To(Say("staging security groups:\\s+staging-sec-1, staging-sec-2"))
@@ -224,10 +227,50 @@ var _ = Describe("space Command", func() {
 					Expect(orgGUID).To(Equal("some-org-guid"))
 					Expect(spaceName).To(Equal("some-space"))
 					Expect(includeStagingSecurityGroupRules).To(BeTrue())
-					Expect(fakeActorV3.GetEffectiveIsolationSegmentBySpaceCallCount()).To(Equal(1))
-					spaceGUID, orgDefaultIsolationSegmentGUID := fakeActorV3.GetEffectiveIsolationSegmentBySpaceArgsForCall(0)
+					Expect(fakeActorV3.GetSpaceIsolationSegmentCallCount()).To(Equal(1))
+					spaceGUID, orgGUID := fakeActorV3.GetSpaceIsolationSegmentArgsForCall(0)
 					Expect(spaceGUID).To(Equal("some-space-guid"))
-					Expect(orgDefaultIsolationSegmentGUID).To(Equal("some-org-default-isolation-segment-guid"))
+					Expect(orgGUID).To(Equal("some-org-guid"))
+				})
+
+				Context("when the space inherits the org's default isolation segment", func() {
+					BeforeEach(func() {
+						fakeActorV3.GetSpaceIsolationSegmentReturns(
+							v3action.SpaceIsolationSegment{
+								IsolationSegment: v3action.IsolationSegment{
+									Name: "some-default-isolation-segment",
+								},
+								Source: v3action.IsolationSegmentOrganizationDefault,
+							},
+							nil,
+							nil,
+						)
+					})
+
+					It("labels the isolation segment as the org default", func() {
+						Expect(executeErr).To(BeNil())
+						Expect(testUI.Out).To(Say("isolation segment:\\s+some-default-isolation-segment \\(org default\\)"))
+					})
+				})
+
+				Context("when neither the space nor the org has an isolation segment", func() {
+					BeforeEach(func() {
+						fakeActorV3.GetSpaceIsolationSegmentReturns(
+							v3action.SpaceIsolationSegment{
+								IsolationSegment: v3action.IsolationSegment{
+									Name: "shared",
+								},
+								Source: v3action.IsolationSegmentShared,
+							},
+							nil,
+							nil,
+						)
+					})
+
+					It("labels the shared isolation segment as the platform default", func() {
+						Expect(executeErr).To(BeNil())
+						Expect(testUI.Out).To(Say("isolation segment:\\s+shared \\(platform default\\)"))
+					})
 				})
 			})
 
@@ -246,7 +289,7 @@ var _ = Describe("space Command", func() {
 					Expect(orgGUID).To(Equal("some-org-guid"))
 					Expect(spaceName).To(Equal("some-space"))
 					Expect(includeStagingSecurityGroupRules).To(BeTrue())
-					Expect(fakeActorV3.GetEffectiveIsolationSegmentBySpaceCallCount()).To(Equal(0))
+					Expect(fakeActorV3.GetSpaceIsolationSegmentCallCount()).To(Equal(0))
 				})
 			})
 
@@ -265,7 +308,7 @@ var _ = Describe("space Command", func() {
 					Expect(orgGUID).To(Equal("some-org-guid"))
 					Expect(spaceName).To(Equal("some-space"))
 					Expect(includeStagingSecurityGroupRules).To(BeFalse())
-					Expect(fakeActorV3.GetEffectiveIsolationSegmentBySpaceCallCount()).To(Equal(0))
+					Expect(fakeActorV3.GetSpaceIsolationSegmentCallCount()).To(Equal(0))
 				})
 			})
 		})
@@ -329,8 +372,8 @@ var _ = Describe("space Command", func() {
 
 			BeforeEach(func() {
 				expectedErr = errors.New("get isolation segment error")
-				fakeActorV3.GetEffectiveIsolationSegmentBySpaceReturns(
-					v3action.IsolationSegment{},
+				fakeActorV3.GetSpaceIsolationSegmentReturns(
+					v3action.SpaceIsolationSegment{},
 					v3action.Warnings{"v3-warning-1", "v3-warning-2"},
 					expectedErr)
 			})
@@ -342,20 +385,6 @@ var _ = Describe("space Command", func() {
 				Expect(testUI.Err).To(Say("v3-warning-2"))
 			})
 		})
-
-		Context("a NoRelationshipError", func() {
-			BeforeEach(func() {
-				fakeActorV3.GetEffectiveIsolationSegmentBySpaceReturns(
-					v3action.IsolationSegment{},
-					v3action.Warnings{"v3-warning-1", "v3-warning-2"},
-					v3action.NoRelationshipError{})
-			})
-
-			It("does not fill in the isolation segment", func() {
-				Expect(executeErr).ToNot(HaveOccurred())
-				Expect(testUI.Out).To(Say("(?m)isolation segment:\\s*$"))
-			})
-		})
 	})
 
 	Context("when the --security-group-rules flag is provided", func() {
@@ -10,7 +10,8 @@ import (
 
 type SpaceQuotaCommand struct {
 	RequiredArgs flag.SpaceQuota `positional-args:"yes"`
-	usage        interface{}     `usage:"CF_NAME space-quota SPACE_QUOTA_NAME"`
+	Spaces       bool            `long:"spaces" description:"List the spaces the space quota is assigned to"`
+	usage        interface{}     `usage:"CF_NAME space-quota SPACE_QUOTA_NAME [--spaces]"`
 }
 
 func (SpaceQuotaCommand) Setup(config command.Config, ui command.UI) error {
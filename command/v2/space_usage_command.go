@@ -0,0 +1,192 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+//go:generate counterfeiter . SpaceUsageActor
+
+type SpaceUsageActor interface {
+	GetSpaceResourceUsage(spaceGUID string) ([]v2action.ApplicationResourceUsage, v2action.Warnings, error)
+}
+
+type SpaceUsageCommand struct {
+	SortBy          flag.SpaceUsageSort `long:"sort" choice:"memory" choice:"cpu" description:"Sort apps by memory used or average CPU, highest first (Default: name)"`
+	JSON            bool                `long:"json" description:"Output the usage of each app as JSON"`
+	usage           interface{}         `usage:"CF_NAME space-usage [--sort (memory | cpu)] [--json]"`
+	relatedCommands interface{}         `related_commands:"app, apps, scale, space"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SpaceUsageActor
+}
+
+func (cmd *SpaceUsageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd SpaceUsageCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.JSON {
+		cmd.UI.DisplayTextWithFlavor("Getting resource usage of apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+	}
+
+	usages, warnings, err := cmd.Actor.GetSpaceResourceUsage(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	for _, usage := range usages {
+		if usage.StatsError != nil {
+			cmd.UI.DisplayWarning("Unable to retrieve the instance stats of app {{.AppName}}: {{.Error}}", map[string]interface{}{
+				"AppName": usage.Name,
+				"Error":   usage.StatsError.Error(),
+			})
+		}
+	}
+
+	cmd.sortUsages(usages)
+
+	if cmd.JSON {
+		return cmd.displayUsageJSON(usages)
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(usages) == 0 {
+		cmd.UI.DisplayText("No apps found")
+		return nil
+	}
+	cmd.displayUsageTable(usages)
+
+	return nil
+}
+
+// sortUsages orders the apps by the --sort column, highest first, keeping
+// apps without stats last. The actor returns them sorted by name, which is
+// kept for ties.
+func (cmd SpaceUsageCommand) sortUsages(usages []v2action.ApplicationResourceUsage) {
+	var less func(a v2action.ApplicationResourceUsage, b v2action.ApplicationResourceUsage) bool
+	switch cmd.SortBy {
+	case "memory":
+		less = func(a v2action.ApplicationResourceUsage, b v2action.ApplicationResourceUsage) bool {
+			return a.MemoryUsed > b.MemoryUsed
+		}
+	case "cpu":
+		less = func(a v2action.ApplicationResourceUsage, b v2action.ApplicationResourceUsage) bool {
+			return a.AverageCPU > b.AverageCPU
+		}
+	default:
+		return
+	}
+
+	sort.SliceStable(usages, func(i int, j int) bool {
+		if usages[i].StatsAvailable != usages[j].StatsAvailable {
+			return usages[i].StatsAvailable
+		}
+		return less(usages[i], usages[j])
+	})
+}
+
+func (cmd SpaceUsageCommand) displayUsageTable(usages []v2action.ApplicationResourceUsage) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("requested state"),
+			cmd.UI.TranslateText("instances"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("disk"),
+			cmd.UI.TranslateText("avg cpu"),
+		},
+	}
+
+	for _, usage := range usages {
+		instances, memory, disk, cpu := "-", "-", "-", "-"
+		if usage.StatsAvailable {
+			instances = fmt.Sprintf("%d/%d", usage.RunningInstances, usage.Instances)
+			memory = fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(usage.MemoryUsed)), bytefmt.ByteSize(uint64(usage.MemoryAllocated)))
+			disk = fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(usage.DiskUsed)), bytefmt.ByteSize(uint64(usage.DiskAllocated)))
+			cpu = fmt.Sprintf("%.1f%%", usage.AverageCPU*100)
+		}
+
+		table = append(table, []string{
+			usage.Name,
+			strings.ToLower(string(usage.State)),
+			instances,
+			memory,
+			disk,
+			cpu,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+}
+
+func (cmd SpaceUsageCommand) displayUsageJSON(usages []v2action.ApplicationResourceUsage) error {
+	appsJSON := []map[string]interface{}{}
+	for _, usage := range usages {
+		appJSON := map[string]interface{}{
+			"name":                      usage.Name,
+			"guid":                      usage.GUID,
+			"requested_state":           strings.ToLower(string(usage.State)),
+			"instances":                 usage.Instances,
+			"running_instances":         nil,
+			"memory_used_in_bytes":      nil,
+			"memory_allocated_in_bytes": nil,
+			"disk_used_in_bytes":        nil,
+			"disk_allocated_in_bytes":   nil,
+			"average_cpu_percent":       nil,
+		}
+		if usage.StatsAvailable {
+			appJSON["running_instances"] = usage.RunningInstances
+			appJSON["memory_used_in_bytes"] = usage.MemoryUsed
+			appJSON["memory_allocated_in_bytes"] = usage.MemoryAllocated
+			appJSON["disk_used_in_bytes"] = usage.DiskUsed
+			appJSON["disk_allocated_in_bytes"] = usage.DiskAllocated
+			appJSON["average_cpu_percent"] = usage.AverageCPU * 100
+		}
+		appsJSON = append(appsJSON, appJSON)
+	}
+
+	output, err := json.MarshalIndent(map[string]interface{}{"apps": appsJSON}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
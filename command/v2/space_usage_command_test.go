@@ -0,0 +1,243 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("space-usage Command", func() {
+	var (
+		cmd             SpaceUsageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSpaceUsageActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSpaceUsageActor)
+
+		cmd = SpaceUsageCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+			fakeActor.GetSpaceResourceUsageReturns(
+				[]v2action.ApplicationResourceUsage{
+					{
+						Name:             "api",
+						GUID:             "api-guid",
+						State:            ccv2.ApplicationStarted,
+						Instances:        2,
+						RunningInstances: 2,
+						MemoryUsed:       512 * 1024 * 1024,
+						MemoryAllocated:  2048 * 1024 * 1024,
+						DiskUsed:         64 * 1024 * 1024,
+						DiskAllocated:    2048 * 1024 * 1024,
+						AverageCPU:       0.5,
+						StatsAvailable:   true,
+					},
+					{
+						Name:       "broken",
+						GUID:       "broken-guid",
+						State:      ccv2.ApplicationStarted,
+						Instances:  1,
+						StatsError: errors.New("stats are broken"),
+					},
+					{
+						Name:      "idle",
+						GUID:      "idle-guid",
+						State:     ccv2.ApplicationStopped,
+						Instances: 1,
+					},
+					{
+						Name:             "worker",
+						GUID:             "worker-guid",
+						State:            ccv2.ApplicationStarted,
+						Instances:        3,
+						RunningInstances: 1,
+						MemoryUsed:       1024 * 1024 * 1024,
+						MemoryAllocated:  3072 * 1024 * 1024,
+						DiskUsed:         128 * 1024 * 1024,
+						DiskAllocated:    3072 * 1024 * 1024,
+						AverageCPU:       0.1,
+						StatsAvailable:   true,
+					},
+				},
+				v2action.Warnings{"warning-1", "warning-2"},
+				nil)
+		})
+
+		It("displays the usage of every app sorted by name, with dashes for apps without stats", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetSpaceResourceUsageCallCount()).To(Equal(1))
+			Expect(fakeActor.GetSpaceResourceUsageArgsForCall(0)).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Getting resource usage of apps in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`name\s+requested state\s+instances\s+memory\s+disk\s+avg cpu`))
+			Expect(testUI.Out).To(Say("%s", `api\s+started\s+2/2\s+512M of 2G\s+64M of 2G\s+50\.0%`))
+			Expect(testUI.Out).To(Say(`broken\s+started\s+-\s+-\s+-\s+-`))
+			Expect(testUI.Out).To(Say(`idle\s+stopped\s+-\s+-\s+-\s+-`))
+			Expect(testUI.Out).To(Say("%s", `worker\s+started\s+1/3\s+1G of 3G\s+128M of 3G\s+10\.0%`))
+
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+			Expect(testUI.Err).To(Say("Unable to retrieve the instance stats of app broken: stats are broken"))
+		})
+
+		Context("when sorting by memory", func() {
+			BeforeEach(func() {
+				cmd.SortBy = "memory"
+			})
+
+			It("displays the apps using the most memory first and apps without stats last", func() {
+				Expect(testUI.Out).To(Say("worker"))
+				Expect(testUI.Out).To(Say("api"))
+				Expect(testUI.Out).To(Say("broken"))
+				Expect(testUI.Out).To(Say("idle"))
+			})
+		})
+
+		Context("when sorting by cpu", func() {
+			BeforeEach(func() {
+				cmd.SortBy = "cpu"
+			})
+
+			It("displays the apps using the most CPU first and apps without stats last", func() {
+				Expect(testUI.Out).To(Say("api"))
+				Expect(testUI.Out).To(Say("worker"))
+				Expect(testUI.Out).To(Say("broken"))
+				Expect(testUI.Out).To(Say("idle"))
+			})
+		})
+
+		Context("when the --json flag is provided", func() {
+			BeforeEach(func() {
+				cmd.JSON = true
+			})
+
+			It("displays the usage as JSON, with nulls for apps without stats", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Getting resource usage"))
+
+				var output struct {
+					Apps []map[string]interface{} `json:"apps"`
+				}
+				Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &output)).To(Succeed())
+				Expect(output.Apps).To(HaveLen(4))
+				Expect(output.Apps[0]).To(Equal(map[string]interface{}{
+					"name":                      "api",
+					"guid":                      "api-guid",
+					"requested_state":           "started",
+					"instances":                 float64(2),
+					"running_instances":         float64(2),
+					"memory_used_in_bytes":      float64(512 * 1024 * 1024),
+					"memory_allocated_in_bytes": float64(2048 * 1024 * 1024),
+					"disk_used_in_bytes":        float64(64 * 1024 * 1024),
+					"disk_allocated_in_bytes":   float64(2048 * 1024 * 1024),
+					"average_cpu_percent":       float64(50),
+				}))
+				Expect(output.Apps[2]).To(Equal(map[string]interface{}{
+					"name":                      "idle",
+					"guid":                      "idle-guid",
+					"requested_state":           "stopped",
+					"instances":                 float64(1),
+					"running_instances":         nil,
+					"memory_used_in_bytes":      nil,
+					"memory_allocated_in_bytes": nil,
+					"disk_used_in_bytes":        nil,
+					"disk_allocated_in_bytes":   nil,
+					"average_cpu_percent":       nil,
+				}))
+			})
+		})
+
+		Context("when the space has no apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceResourceUsageReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No apps found"))
+			})
+		})
+
+		Context("when getting the usage fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("apps are broken")
+				fakeActor.GetSpaceResourceUsageReturns(nil, v2action.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+
+		Context("when getting the current user fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("no user")
+				fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
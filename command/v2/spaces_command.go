@@ -1,22 +1,379 @@
 package v2
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
+	"sort"
+	"sync"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 )
 
+// spacesWorkerPoolSize is the number of spaces whose details are fetched
+// concurrently when listing spaces as JSON.
+const spacesWorkerPoolSize = 5
+
+//go:generate counterfeiter . SpacesActor
+
+type SpacesActor interface {
+	CloudControllerAPIVersion() string
+	GetOrganization(orgGUID string) (v2action.Organization, v2action.Warnings, error)
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetSpaceQuota(guid string) (v2action.SpaceQuota, v2action.Warnings, error)
+	GetSpaceRunningSecurityGroupsBySpace(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error)
+	GetSpaceStagingSecurityGroupsBySpace(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error)
+}
+
+//go:generate counterfeiter . SpacesActorV3
+
+type SpacesActorV3 interface {
+	CloudControllerAPIVersion() string
+	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetSpacesByOrganizationAndLabelSelector(orgGUID string, labelSelector string) ([]v3action.Space, v3action.Warnings, error)
+}
+
 type SpacesCommand struct {
-	usage           interface{} `usage:"CF_NAME spaces"`
-	relatedCommands interface{} `related_commands:"target"`
+	Output                  flag.OutputFormat `long:"output" description:"Output format; only 'json' is supported"`
+	IncludeQuota            bool              `long:"include-quota" description:"Include the assigned space quota of each space (requires --output json)"`
+	IncludeSecurityGroups   bool              `long:"include-security-groups" description:"Include the running and staging security groups bound to each space (requires --output json)"`
+	IncludeIsolationSegment bool              `long:"include-isolation-segment" description:"Include the isolation segment of each space (requires --output json)"`
+	Labels                  string            `long:"labels" description:"Only list the spaces whose labels match the selector, e.g. 'env in (prod,staging)'"`
+	usage                   interface{}       `usage:"CF_NAME spaces [--labels SELECTOR] [--output json [--include-quota] [--include-security-groups] [--include-isolation-segment]]\n\nEXAMPLES:\n   CF_NAME spaces --labels 'env=prod'\n   CF_NAME spaces --labels 'env in (prod,staging)' --output json"`
+	relatedCommands         interface{}       `related_commands:"target"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SpacesActor
+	ActorV3     SpacesActorV3
 }
 
-func (SpacesCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *SpacesCommand) Setup(config command.Config, ui command.UI) error {
+	if cmd.Output.Format == "" && cmd.Labels == "" {
+		return nil
+	}
+
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok || cmd.Labels != "" {
+			return err
+		}
+	} else {
+		cmd.ActorV3 = v3action.NewActor(ccClientV3, config)
+	}
+
 	return nil
 }
 
-func (SpacesCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd SpacesCommand) Execute(args []string) error {
+	if cmd.Output.Format == "" {
+		if includeFlag := cmd.includeFlag(); includeFlag != "" {
+			return translatableerror.RequiredFlagsError{
+				Arg1: includeFlag,
+				Arg2: "--output",
+			}
+		}
+
+		if cmd.Labels == "" {
+			oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+			return nil
+		}
+	}
+
+	if cmd.Labels != "" {
+		err := checkLabelSelector(cmd.Labels, cmd.ActorV3.CloudControllerAPIVersion())
+		if err != nil {
+			return err
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
+	if err == nil {
+		if cmd.Output.Format == "" {
+			err = cmd.displaySpacesTable()
+		} else {
+			err = cmd.displaySpacesJSON()
+		}
+	}
+
+	return shared.HandleError(err)
+}
+
+// displaySpacesTable lists the spaces matching --labels in the format of the
+// legacy spaces command.
+func (cmd SpacesCommand) displaySpacesTable() error {
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"CurrentUser": user.Name,
+	})
+
+	spaces, warnings, err := cmd.ActorV3.GetSpacesByOrganizationAndLabelSelector(cmd.Config.TargetedOrganization().GUID, cmd.Labels)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV3.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(spaces) == 0 {
+		cmd.UI.DisplayText("No spaces found")
+		return nil
+	}
+
+	table := [][]string{{cmd.UI.TranslateText("name")}}
+	for _, space := range spaces {
+		table = append(table, []string{space.Name})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
 	return nil
 }
+
+func (cmd SpacesCommand) includeFlag() string {
+	switch {
+	case cmd.IncludeQuota:
+		return "--include-quota"
+	case cmd.IncludeSecurityGroups:
+		return "--include-security-groups"
+	case cmd.IncludeIsolationSegment:
+		return "--include-isolation-segment"
+	default:
+		return ""
+	}
+}
+
+type spaceDetails struct {
+	fields   map[string]interface{}
+	warnings []string
+	err      error
+}
+
+func (cmd SpacesCommand) displaySpacesJSON() error {
+	orgGUID := cmd.Config.TargetedOrganization().GUID
+	var allWarnings []string
+
+	spaces, warnings, err := cmd.Actor.GetOrganizationSpaces(orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		cmd.UI.DisplayWarnings(allWarnings)
+		return err
+	}
+
+	// Labels are listed, and --labels applied, using the V3 spaces of the
+	// org; the other details are only available through V2.
+	includeLabels := cmd.ActorV3 != nil && command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionMetadataV3) == nil
+	spaceMetadata := map[string]*v3action.Metadata{}
+	if includeLabels {
+		labeledSpaces, warnings, err := cmd.ActorV3.GetSpacesByOrganizationAndLabelSelector(orgGUID, cmd.Labels)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			cmd.UI.DisplayWarnings(allWarnings)
+			return sharedV3.HandleError(err)
+		}
+
+		for _, space := range labeledSpaces {
+			spaceMetadata[space.GUID] = space.Metadata
+		}
+
+		if cmd.Labels != "" {
+			var matchingSpaces []v2action.Space
+			for _, space := range spaces {
+				if _, ok := spaceMetadata[space.GUID]; ok {
+					matchingSpaces = append(matchingSpaces, space)
+				}
+			}
+			spaces = matchingSpaces
+		}
+	}
+
+	includeStaging := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionLifecyleStagingV2) == nil
+
+	includeIsolationSegment := cmd.IncludeIsolationSegment
+	var orgDefaultIsolationSegmentGUID string
+	if includeIsolationSegment {
+		if cmd.ActorV3 == nil || command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), ccversion.MinVersionIsolationSegmentV3) != nil {
+			includeIsolationSegment = false
+			allWarnings = append(allWarnings, cmd.UI.TranslateText("Isolation segments are not supported by the targeted Cloud Controller."))
+		} else {
+			org, warnings, err := cmd.Actor.GetOrganization(orgGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				cmd.UI.DisplayWarnings(allWarnings)
+				return err
+			}
+			orgDefaultIsolationSegmentGUID = org.DefaultIsolationSegmentGUID
+		}
+	}
+
+	details := make([]spaceDetails, len(spaces))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < spacesWorkerPoolSize; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				details[index] = cmd.getSpaceDetails(spaces[index], includeStaging, includeIsolationSegment, orgDefaultIsolationSegmentGUID)
+			}
+		}()
+	}
+
+	for i := range spaces {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	spacesJSON := make([]map[string]interface{}, len(details))
+	for i, detail := range details {
+		allWarnings = append(allWarnings, detail.warnings...)
+		if detail.err != nil {
+			cmd.UI.DisplayWarnings(allWarnings)
+			return detail.err
+		}
+		if includeLabels {
+			detail.fields["labels"] = labelsJSON(spaceMetadata[spaces[i].GUID])
+		}
+		spacesJSON[i] = detail.fields
+	}
+
+	if allWarnings == nil {
+		allWarnings = []string{}
+	}
+
+	output, err := json.MarshalIndent(map[string]interface{}{
+		"spaces":   spacesJSON,
+		"warnings": allWarnings,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
+
+// getSpaceDetails fetches the requested details for a single space. Fields
+// the current user is not permitted to read are set to nil and reported as a
+// warning instead of failing the whole listing.
+func (cmd SpacesCommand) getSpaceDetails(space v2action.Space, includeStaging bool, includeIsolationSegment bool, orgDefaultIsolationSegmentGUID string) spaceDetails {
+	details := spaceDetails{
+		fields: map[string]interface{}{
+			"guid":      space.GUID,
+			"name":      space.Name,
+			"allow_ssh": space.AllowSSH,
+		},
+	}
+
+	if cmd.IncludeQuota {
+		var spaceQuotaName interface{} = ""
+		if space.SpaceQuotaDefinitionGUID != "" {
+			spaceQuota, warnings, err := cmd.Actor.GetSpaceQuota(space.SpaceQuotaDefinitionGUID)
+			details.warnings = append(details.warnings, warnings...)
+			spaceQuotaName = spaceQuota.Name
+			if err != nil {
+				if !cmd.handleForbidden(&details, space, "space quota", err) {
+					return details
+				}
+				spaceQuotaName = nil
+			}
+		}
+		details.fields["space_quota"] = spaceQuotaName
+	}
+
+	if cmd.IncludeSecurityGroups {
+		securityGroups, warnings, err := cmd.Actor.GetSpaceRunningSecurityGroupsBySpace(space.GUID)
+		details.warnings = append(details.warnings, warnings...)
+		details.fields["running_security_groups"] = securityGroupNames(securityGroups)
+		if err != nil {
+			if !cmd.handleForbidden(&details, space, "running security groups", err) {
+				return details
+			}
+			details.fields["running_security_groups"] = nil
+		}
+
+		details.fields["staging_security_groups"] = nil
+		if includeStaging {
+			securityGroups, warnings, err = cmd.Actor.GetSpaceStagingSecurityGroupsBySpace(space.GUID)
+			details.warnings = append(details.warnings, warnings...)
+			details.fields["staging_security_groups"] = securityGroupNames(securityGroups)
+			if err != nil {
+				if !cmd.handleForbidden(&details, space, "staging security groups", err) {
+					return details
+				}
+				details.fields["staging_security_groups"] = nil
+			}
+		}
+	}
+
+	if cmd.IncludeIsolationSegment {
+		details.fields["isolation_segment"] = nil
+		if includeIsolationSegment {
+			isolationSegment, warnings, err := cmd.ActorV3.GetEffectiveIsolationSegmentBySpace(space.GUID, orgDefaultIsolationSegmentGUID)
+			details.warnings = append(details.warnings, warnings...)
+			switch err.(type) {
+			case nil:
+				details.fields["isolation_segment"] = isolationSegment.Name
+			case v3action.NoRelationshipError:
+				details.fields["isolation_segment"] = ""
+			default:
+				if !cmd.handleForbidden(&details, space, "isolation segment", err) {
+					return details
+				}
+			}
+		}
+	}
+
+	return details
+}
+
+// handleForbidden records a warning for err and returns true when err is a
+// permission failure; otherwise it records err as fatal and returns false.
+func (cmd SpacesCommand) handleForbidden(details *spaceDetails, space v2action.Space, field string, err error) bool {
+	if _, ok := err.(ccerror.ForbiddenError); !ok {
+		details.err = err
+		return false
+	}
+
+	details.warnings = append(details.warnings, cmd.UI.TranslateText("Unable to retrieve {{.Field}} for space {{.SpaceName}}: {{.Error}}", map[string]interface{}{
+		"Field":     field,
+		"SpaceName": space.Name,
+		"Error":     err.Error(),
+	}))
+	return true
+}
+
+func securityGroupNames(securityGroups []v2action.SecurityGroup) []string {
+	names := make([]string, 0, len(securityGroups))
+	for _, securityGroup := range securityGroups {
+		names = append(names, securityGroup.Name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,434 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("spaces Command", func() {
+	var (
+		cmd             SpacesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSpacesActor
+		fakeActorV3     *v2fakes.FakeSpacesActorV3
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSpacesActor)
+		fakeActorV3 = new(v2fakes.FakeSpacesActorV3)
+
+		cmd = SpacesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV3:     fakeActorV3,
+		}
+		cmd.Output.Format = "json"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			GUID: "some-org-guid",
+			Name: "some-org",
+		})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionLifecyleStagingV2)
+		fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionIsolationSegmentV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	parseOutput := func() map[string]interface{} {
+		var output map[string]interface{}
+		err := json.Unmarshal(testUI.Out.(*Buffer).Contents(), &output)
+		Expect(err).ToNot(HaveOccurred())
+		return output
+	}
+
+	Context("when an include flag is provided without --output", func() {
+		BeforeEach(func() {
+			cmd.Output.Format = ""
+			cmd.IncludeQuota = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--include-quota",
+				Arg2: "--output",
+			}))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(
+				sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			config, targetedOrganizationRequired, targetedSpaceRequired := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(config).To(Equal(fakeConfig))
+			Expect(targetedOrganizationRequired).To(Equal(true))
+			Expect(targetedSpaceRequired).To(Equal(false))
+		})
+	})
+
+	Context("when getting the spaces fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("get spaces error")
+			fakeActor.GetOrganizationSpacesReturns(nil, v2action.Warnings{"warning-1"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+		})
+	})
+
+	Context("when getting the spaces succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationSpacesReturns(
+				[]v2action.Space{
+					{
+						GUID:                     "space-guid-1",
+						Name:                     "space-1",
+						AllowSSH:                 true,
+						SpaceQuotaDefinitionGUID: "quota-guid",
+					},
+					{
+						GUID: "space-guid-2",
+						Name: "space-2",
+					},
+				},
+				v2action.Warnings{"warning-1"},
+				nil)
+		})
+
+		Context("when no include flags are provided", func() {
+			It("outputs the guid, name and ssh flag of each space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(parseOutput()).To(Equal(map[string]interface{}{
+					"spaces": []interface{}{
+						map[string]interface{}{"guid": "space-guid-1", "name": "space-1", "allow_ssh": true},
+						map[string]interface{}{"guid": "space-guid-2", "name": "space-2", "allow_ssh": false},
+					},
+					"warnings": []interface{}{"warning-1"},
+				}))
+
+				Expect(fakeActor.GetSpaceQuotaCallCount()).To(Equal(0))
+				Expect(fakeActor.GetSpaceRunningSecurityGroupsBySpaceCallCount()).To(Equal(0))
+				Expect(fakeActorV3.GetEffectiveIsolationSegmentBySpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when all include flags are provided", func() {
+			BeforeEach(func() {
+				cmd.IncludeQuota = true
+				cmd.IncludeSecurityGroups = true
+				cmd.IncludeIsolationSegment = true
+
+				fakeActor.GetOrganizationReturns(
+					v2action.Organization{DefaultIsolationSegmentGUID: "default-iso-seg-guid"},
+					v2action.Warnings{"warning-2"},
+					nil)
+				fakeActor.GetSpaceQuotaReturns(
+					v2action.SpaceQuota{Name: "some-quota"},
+					v2action.Warnings{"warning-3"},
+					nil)
+				fakeActor.GetSpaceRunningSecurityGroupsBySpaceReturns(
+					[]v2action.SecurityGroup{{Name: "running-2"}, {Name: "running-1"}},
+					nil,
+					nil)
+				fakeActor.GetSpaceStagingSecurityGroupsBySpaceReturns(
+					[]v2action.SecurityGroup{{Name: "staging-1"}},
+					nil,
+					nil)
+				fakeActorV3.GetEffectiveIsolationSegmentBySpaceStub = func(spaceGUID string, _ string) (v3action.IsolationSegment, v3action.Warnings, error) {
+					if spaceGUID == "space-guid-1" {
+						return v3action.IsolationSegment{Name: "some-iso-seg"}, nil, nil
+					}
+					return v3action.IsolationSegment{}, nil, v3action.NoRelationshipError{}
+				}
+			})
+
+			It("outputs the requested details of each space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(parseOutput()).To(Equal(map[string]interface{}{
+					"spaces": []interface{}{
+						map[string]interface{}{
+							"guid":                    "space-guid-1",
+							"name":                    "space-1",
+							"allow_ssh":               true,
+							"space_quota":             "some-quota",
+							"running_security_groups": []interface{}{"running-1", "running-2"},
+							"staging_security_groups": []interface{}{"staging-1"},
+							"isolation_segment":       "some-iso-seg",
+						},
+						map[string]interface{}{
+							"guid":                    "space-guid-2",
+							"name":                    "space-2",
+							"allow_ssh":               false,
+							"space_quota":             "",
+							"running_security_groups": []interface{}{"running-1", "running-2"},
+							"staging_security_groups": []interface{}{"staging-1"},
+							"isolation_segment":       "",
+						},
+					},
+					"warnings": []interface{}{"warning-1", "warning-2", "warning-3"},
+				}))
+
+				Expect(fakeActor.GetOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(fakeActor.GetSpaceQuotaCallCount()).To(Equal(1))
+				Expect(fakeActor.GetSpaceQuotaArgsForCall(0)).To(Equal("quota-guid"))
+				Expect(fakeActor.GetSpaceRunningSecurityGroupsBySpaceCallCount()).To(Equal(2))
+				Expect(fakeActor.GetSpaceStagingSecurityGroupsBySpaceCallCount()).To(Equal(2))
+				Expect(fakeActorV3.GetEffectiveIsolationSegmentBySpaceCallCount()).To(Equal(2))
+				_, orgDefaultIsolationSegmentGUID := fakeActorV3.GetEffectiveIsolationSegmentBySpaceArgsForCall(0)
+				Expect(orgDefaultIsolationSegmentGUID).To(Equal("default-iso-seg-guid"))
+			})
+
+			Context("when the user is not permitted to read some details", func() {
+				BeforeEach(func() {
+					fakeActor.GetSpaceQuotaReturns(
+						v2action.SpaceQuota{},
+						nil,
+						ccerror.ForbiddenError{Message: "not authorized"})
+					fakeActor.GetSpaceStagingSecurityGroupsBySpaceStub = func(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error) {
+						if spaceGUID == "space-guid-2" {
+							return nil, nil, ccerror.ForbiddenError{Message: "not authorized"}
+						}
+						return []v2action.SecurityGroup{{Name: "staging-1"}}, nil, nil
+					}
+				})
+
+				It("sets the affected fields to null and reports warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					output := parseOutput()
+					spaces := output["spaces"].([]interface{})
+					Expect(spaces[0]).To(HaveKeyWithValue("space_quota", BeNil()))
+					Expect(spaces[0]).To(HaveKeyWithValue("staging_security_groups", []interface{}{"staging-1"}))
+					Expect(spaces[1]).To(HaveKeyWithValue("space_quota", ""))
+					Expect(spaces[1]).To(HaveKeyWithValue("staging_security_groups", BeNil()))
+					Expect(output["warnings"]).To(Equal([]interface{}{
+						"warning-1",
+						"warning-2",
+						"Unable to retrieve space quota for space space-1: not authorized",
+						"Unable to retrieve staging security groups for space space-2: not authorized",
+					}))
+				})
+			})
+
+			Context("when fetching a detail fails for another reason", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("security groups error")
+					fakeActor.GetSpaceRunningSecurityGroupsBySpaceReturns(nil, nil, expectedErr)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+				})
+			})
+
+			Context("when the API does not support staging security groups", func() {
+				BeforeEach(func() {
+					fakeActor.CloudControllerAPIVersionReturns("2.34.0")
+				})
+
+				It("outputs null staging security groups", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					spaces := parseOutput()["spaces"].([]interface{})
+					Expect(spaces[0]).To(HaveKeyWithValue("staging_security_groups", BeNil()))
+					Expect(fakeActor.GetSpaceStagingSecurityGroupsBySpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the API does not support isolation segments", func() {
+				BeforeEach(func() {
+					fakeActorV3.CloudControllerAPIVersionReturns("3.0.0")
+				})
+
+				It("outputs null isolation segments and a warning", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					output := parseOutput()
+					spaces := output["spaces"].([]interface{})
+					Expect(spaces[0]).To(HaveKeyWithValue("isolation_segment", BeNil()))
+					Expect(output["warnings"]).To(ContainElement("Isolation segments are not supported by the targeted Cloud Controller."))
+					Expect(fakeActorV3.GetEffectiveIsolationSegmentBySpaceCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the API supports labels", func() {
+			BeforeEach(func() {
+				fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+				fakeActorV3.GetSpacesByOrganizationAndLabelSelectorReturns(
+					[]v3action.Space{
+						{
+							GUID: "space-guid-1",
+							Name: "space-1",
+							Metadata: &v3action.Metadata{Labels: map[string]types.NullString{
+								"env": types.NewNullString("prod"),
+							}},
+						},
+						{GUID: "space-guid-2", Name: "space-2"},
+					},
+					v3action.Warnings{"warning-2"},
+					nil)
+			})
+
+			It("outputs the labels of each space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(parseOutput()).To(Equal(map[string]interface{}{
+					"spaces": []interface{}{
+						map[string]interface{}{"guid": "space-guid-1", "name": "space-1", "allow_ssh": true, "labels": map[string]interface{}{"env": "prod"}},
+						map[string]interface{}{"guid": "space-guid-2", "name": "space-2", "allow_ssh": false, "labels": map[string]interface{}{}},
+					},
+					"warnings": []interface{}{"warning-1", "warning-2"},
+				}))
+
+				orgGUID, labelSelector := fakeActorV3.GetSpacesByOrganizationAndLabelSelectorArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(labelSelector).To(BeEmpty())
+			})
+
+			Context("when --labels is provided", func() {
+				BeforeEach(func() {
+					cmd.Labels = "env=prod"
+					fakeActorV3.GetSpacesByOrganizationAndLabelSelectorReturns(
+						[]v3action.Space{{GUID: "space-guid-1", Name: "space-1"}},
+						nil,
+						nil)
+				})
+
+				It("only outputs the spaces matching the selector", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					spaces := parseOutput()["spaces"].([]interface{})
+					Expect(spaces).To(HaveLen(1))
+					Expect(spaces[0]).To(HaveKeyWithValue("guid", "space-guid-1"))
+
+					_, labelSelector := fakeActorV3.GetSpacesByOrganizationAndLabelSelectorArgsForCall(0)
+					Expect(labelSelector).To(Equal("env=prod"))
+				})
+			})
+
+			Context("when getting the labels fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get labels error")
+					fakeActorV3.GetSpacesByOrganizationAndLabelSelectorReturns(nil, v3action.Warnings{"warning-2"}, expectedErr)
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("warning-2"))
+				})
+			})
+		})
+	})
+
+	Context("when --labels is provided without --output", func() {
+		BeforeEach(func() {
+			cmd.Output.Format = ""
+			cmd.Labels = "env in (prod,staging)"
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+		})
+
+		Context("when the label selector is invalid", func() {
+			BeforeEach(func() {
+				cmd.Labels = "env in (prod"
+			})
+
+			It("returns an InvalidLabelSelectorError pointing at the offending token", func() {
+				Expect(executeErr).To(MatchError(translatableerror.InvalidLabelSelectorError{
+					Selector: "env in (prod",
+					Column:   12,
+					Reason:   "expected ',' or ')'",
+				}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the API does not support labels", func() {
+			BeforeEach(func() {
+				fakeActorV3.CloudControllerAPIVersionReturns(ccversion.MinVersionIsolationSegmentV3)
+			})
+
+			It("returns a MinimumAPIVersionNotMetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+					Command:        "Option '--labels'",
+					CurrentVersion: ccversion.MinVersionIsolationSegmentV3,
+					MinimumVersion: ccversion.MinVersionMetadataV3,
+				}))
+			})
+		})
+
+		Context("when spaces match the selector", func() {
+			BeforeEach(func() {
+				fakeActorV3.GetSpacesByOrganizationAndLabelSelectorReturns(
+					[]v3action.Space{{GUID: "space-guid-1", Name: "space-1"}},
+					v3action.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("lists the matching spaces", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Getting spaces in org some-org as some-user..."))
+				Expect(testUI.Out).To(Say("name"))
+				Expect(testUI.Out).To(Say("space-1"))
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when no spaces match the selector", func() {
+			It("displays that no spaces were found", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No spaces found"))
+			})
+		})
+	})
+})
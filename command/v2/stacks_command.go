@@ -8,7 +8,8 @@ import (
 )
 
 type StacksCommand struct {
-	usage           interface{} `usage:"CF_NAME stacks"`
+	JSON            bool        `long:"json" description:"Output the stacks as JSON"`
+	usage           interface{} `usage:"CF_NAME stacks [--json]"`
 	relatedCommands interface{} `related_commands:"app, push"`
 }
 
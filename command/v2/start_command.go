@@ -1,25 +1,43 @@
 package v2
 
 import (
-	"github.com/cloudfoundry/noaa/consumer"
+	"strconv"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+const (
+	// FailureLogLines is the number of staging and app log lines displayed
+	// when an app fails to stage or start.
+	FailureLogLines = 25
+
+	// FailureLogsTimeout caps how long fetching those log lines can delay the
+	// already failed command.
+	FailureLogsTimeout = 5 * time.Second
+)
+
 //go:generate counterfeiter . StartActor
 
 type StartActor interface {
 	AppActor
+	GetApplicationLastCrash(appGUID string) (v2action.ApplicationCrash, v2action.Warnings, error)
+	GetApplicationRoutes(applicationGUID string) (v2action.Routes, v2action.Warnings, error)
+	GetRecentLogsForApplication(appGUID string, client v2action.NOAAClient, timeout time.Duration) ([]v2action.LogMessage, error)
 	StartApplication(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
 }
 
 type StartCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
-	usage               interface{}  `usage:"CF_NAME start APP_NAME"`
+	WaitForHTTP         string       `long:"wait-for-http" description:"After the app is running, wait until a GET of this path on its first HTTP route returns a 2xx status (uses CF_STARTUP_TIMEOUT)"`
+	NoLogsOnFailure     bool         `long:"no-logs-on-failure" description:"Do not display the recent logs and last crash of the app when it fails to stage or start"`
+	usage               interface{}  `usage:"CF_NAME start APP_NAME [--wait-for-http STATUS_PATH] [--no-logs-on-failure]"`
 	envCFStagingTimeout interface{}  `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 	relatedCommands     interface{}  `related_commands:"apps, logs, scale, ssh, stop, restart, run-task"`
@@ -28,7 +46,7 @@ type StartCommand struct {
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       StartActor
-	NOAAClient  *consumer.Consumer
+	NOAAClient  v2action.NOAAClient
 }
 
 func (cmd *StartCommand) Setup(config command.Config, ui command.UI) error {
@@ -80,14 +98,43 @@ func (cmd StartCommand) Execute(args []string) error {
 		return nil
 	}
 
+	var readinessURL string
+	if cmd.WaitForHTTP != "" {
+		routes, routeWarnings, routesErr := cmd.Actor.GetApplicationRoutes(app.GUID)
+		cmd.UI.DisplayWarnings(routeWarnings)
+		if routesErr != nil {
+			return shared.HandleError(routesErr)
+		}
+
+		readinessURL, err = shared.HTTPReadinessURL(cmd.RequiredArgs.AppName, routes, cmd.WaitForHTTP)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.NOAAClient == nil {
+		cmd.UI.DisplayWarning("staging logs unavailable on this platform")
+	}
+
 	messages, logErrs, appState, apiWarnings, errs := cmd.Actor.StartApplication(app, cmd.NOAAClient, cmd.Config)
 	err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
 	if err != nil {
+		if !cmd.NoLogsOnFailure && isStartFailure(err) {
+			cmd.displayFailureDetails(app.GUID)
+		}
 		return err
 	}
 
 	cmd.UI.DisplayNewline()
 
+	if readinessURL != "" {
+		err = shared.PollHTTPReadiness(cmd.UI, cmd.Config, readinessURL)
+		if err != nil {
+			return err
+		}
+		cmd.UI.DisplayNewline()
+	}
+
 	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -98,3 +145,72 @@ func (cmd StartCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// displayFailureDetails displays the last staging and app log lines and the
+// most recent crash of the app, so that the cause of a failed start can be
+// seen without running further commands. Failing to get either is only
+// warned about.
+func (cmd StartCommand) displayFailureDetails(appGUID string) {
+	logMessages, err := cmd.Actor.GetRecentLogsForApplication(appGUID, cmd.NOAAClient, FailureLogsTimeout)
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to retrieve recent logs: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	} else {
+		var lines []v2action.LogMessage
+		for _, message := range logMessages {
+			if message.Staging() || strings.HasPrefix(message.SourceType(), "APP") {
+				lines = append(lines, message)
+			}
+		}
+		if len(lines) > FailureLogLines {
+			lines = lines[len(lines)-FailureLogLines:]
+		}
+
+		if len(lines) > 0 {
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayText("Last {{.Count}} lines of staging and app logs:", map[string]interface{}{
+				"Count": len(lines),
+			})
+			for _, line := range lines {
+				cmd.UI.DisplayLogMessage(line, true)
+			}
+		}
+	}
+
+	crash, warnings, err := cmd.Actor.GetApplicationLastCrash(appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Last crash:")
+		cmd.UI.DisplayKeyValueTable("", [][]string{
+			{cmd.UI.TranslateText("time:"), cmd.UI.UserFriendlyDate(crash.Timestamp)},
+			{cmd.UI.TranslateText("instance:"), strconv.Itoa(crash.Index)},
+			{cmd.UI.TranslateText("exit status:"), strconv.Itoa(crash.ExitStatus)},
+			{cmd.UI.TranslateText("exit description:"), crash.ExitDescription},
+			{cmd.UI.TranslateText("reason:"), crash.Reason},
+		}, 3)
+	case v2action.ApplicationCrashNotFoundError:
+	default:
+		cmd.UI.DisplayWarning("Unable to retrieve the last crash: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+
+	cmd.UI.DisplayNewline()
+}
+
+// isStartFailure returns true if the error returned by PollStart means that
+// the app failed to stage or start.
+func isStartFailure(err error) bool {
+	switch err.(type) {
+	case translatableerror.StagingFailedError,
+		translatableerror.StagingFailedNoAppDetectedError,
+		translatableerror.StagingTimeoutError,
+		translatableerror.UnsuccessfulStartError,
+		translatableerror.StartupTimeoutError:
+		return true
+	}
+	return false
+}
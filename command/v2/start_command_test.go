@@ -2,11 +2,15 @@ package v2_test
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -19,6 +23,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/ghttp"
 )
 
 var _ = Describe("Start Command", func() {
@@ -167,6 +172,104 @@ var _ = Describe("Start Command", func() {
 					Expect(config).To(Equal(fakeConfig))
 				})
 
+				Context("when logging is available", func() {
+					var fakeNOAAClient *v2actionfakes.FakeNOAAClient
+
+					BeforeEach(func() {
+						fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+						cmd.NOAAClient = fakeNOAAClient
+					})
+
+					It("streams the staging logs through the client", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						_, client, _ := fakeActor.StartApplicationArgsForCall(0)
+						Expect(client).To(Equal(fakeNOAAClient))
+						Expect(testUI.Err).ToNot(Say("staging logs unavailable"))
+					})
+				})
+
+				Context("when logging is unavailable on the platform", func() {
+					BeforeEach(func() {
+						cmd.NOAAClient = nil
+					})
+
+					It("warns once and starts the app without a log client", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Err).To(Say("staging logs unavailable on this platform"))
+						Expect(testUI.Err).ToNot(Say("staging logs unavailable on this platform"))
+
+						_, client, _ := fakeActor.StartApplicationArgsForCall(0)
+						Expect(client).To(BeNil())
+					})
+				})
+
+				Context("when --wait-for-http is passed", func() {
+					BeforeEach(func() {
+						cmd.WaitForHTTP = "/ready"
+					})
+
+					Context("when the app only has TCP routes", func() {
+						BeforeEach(func() {
+							fakeActor.GetApplicationRoutesReturns(
+								v2action.Routes{{Domain: v2action.Domain{Name: "tcp.example.com"}, Port: types.NullInt{Value: 1024, IsSet: true}}},
+								v2action.Warnings{"routes-warning"},
+								nil,
+							)
+						})
+
+						It("returns a NoHTTPRouteError without starting the app", func() {
+							Expect(executeErr).To(MatchError(translatableerror.NoHTTPRouteError{AppName: "some-app"}))
+							Expect(testUI.Err).To(Say("routes-warning"))
+
+							Expect(fakeActor.GetApplicationRoutesArgsForCall(0)).To(Equal("app-guid"))
+							Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the app has an HTTP route", func() {
+						var server *ghttp.Server
+
+						BeforeEach(func() {
+							server = ghttp.NewTLSServer()
+							server.AppendHandlers(
+								ghttp.CombineHandlers(
+									ghttp.VerifyRequest(http.MethodGet, "/ready"),
+									ghttp.RespondWith(http.StatusServiceUnavailable, nil),
+								),
+								ghttp.CombineHandlers(
+									ghttp.VerifyRequest(http.MethodGet, "/ready"),
+									ghttp.RespondWith(http.StatusOK, nil),
+								),
+							)
+
+							fakeConfig.SkipSSLValidationReturns(true)
+							fakeConfig.PollingIntervalReturns(time.Millisecond)
+							fakeConfig.StartupTimeoutReturns(time.Second)
+							fakeActor.GetApplicationRoutesReturns(
+								v2action.Routes{{Domain: v2action.Domain{Name: strings.TrimPrefix(server.URL(), "https://")}}},
+								nil,
+								nil,
+							)
+						})
+
+						AfterEach(func() {
+							server.Close()
+						})
+
+						It("waits for the route to return a 2xx status after the app starts", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+							Expect(server.ReceivedRequests()).To(HaveLen(2))
+
+							Expect(testUI.Out).To(Say("Waiting for %s/ready to return a 2xx status...", server.URL()))
+							Expect(testUI.Out).To(Say("GET %s/ready: 503", server.URL()))
+							Expect(testUI.Out).To(Say("GET %s/ready: 200", server.URL()))
+						})
+					})
+				})
+
 				Context("when passed an ApplicationStateStarting message", func() {
 					BeforeEach(func() {
 						fakeActor.StartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
@@ -486,6 +589,114 @@ var _ = Describe("Start Command", func() {
 							Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{AppName: "some-app", BinaryName: "faceman"}))
 						})
 					})
+
+					Context("when the app fails to start", func() {
+						BeforeEach(func() {
+							apiErr = actionerror.ApplicationInstanceCrashedError{Name: "some-app"}
+
+							logMessages := []v2action.LogMessage{
+								*v2action.NewLogMessage("router log", 1, time.Unix(0, 0), "RTR", "0"),
+								*v2action.NewLogMessage("staging log", 1, time.Unix(0, 0), "STG", "0"),
+							}
+							for i := 0; i < 30; i++ {
+								logMessages = append(logMessages, *v2action.NewLogMessage(fmt.Sprintf("app log %d", i), 1, time.Unix(0, 0), "APP/PROC/WEB", "0"))
+							}
+							fakeActor.GetRecentLogsForApplicationReturns(logMessages, nil)
+
+							fakeActor.GetApplicationLastCrashReturns(v2action.ApplicationCrash{
+								Timestamp:       time.Unix(0, 0),
+								Index:           1,
+								ExitStatus:      137,
+								ExitDescription: "APP/PROC/WEB: Exited with status 137",
+								Reason:          "CRASHED",
+							}, v2action.Warnings{"crash-warning"}, nil)
+						})
+
+						It("displays the last staging and app log lines and the last crash", func() {
+							Expect(executeErr).To(MatchError(translatableerror.UnsuccessfulStartError{AppName: "some-app", BinaryName: "faceman"}))
+
+							Expect(fakeActor.GetRecentLogsForApplicationCallCount()).To(Equal(1))
+							appGUID, _, timeout := fakeActor.GetRecentLogsForApplicationArgsForCall(0)
+							Expect(appGUID).To(Equal("app-guid"))
+							Expect(timeout).To(Equal(FailureLogsTimeout))
+
+							Expect(testUI.Out).To(Say("Last 25 lines of staging and app logs:"))
+							Expect(testUI.Out).ToNot(Say("router log"))
+							Expect(testUI.Out).ToNot(Say("staging log"))
+							Expect(testUI.Out).ToNot(Say("app log 4\\n"))
+							Expect(testUI.Out).To(Say("app log 5"))
+							Expect(testUI.Out).To(Say("app log 29"))
+
+							Expect(fakeActor.GetApplicationLastCrashArgsForCall(0)).To(Equal("app-guid"))
+							Expect(testUI.Out).To(Say("Last crash:"))
+							Expect(testUI.Out).To(Say("instance:\\s+1"))
+							Expect(testUI.Out).To(Say("exit status:\\s+137"))
+							Expect(testUI.Out).To(Say("exit description:\\s+APP/PROC/WEB: Exited with status 137"))
+							Expect(testUI.Out).To(Say("reason:\\s+CRASHED"))
+							Expect(testUI.Err).To(Say("crash-warning"))
+						})
+
+						Context("when there are fewer log lines", func() {
+							BeforeEach(func() {
+								fakeActor.GetRecentLogsForApplicationReturns([]v2action.LogMessage{
+									*v2action.NewLogMessage("staging log", 1, time.Unix(0, 0), "STG", "0"),
+									*v2action.NewLogMessage("app log", 1, time.Unix(0, 0), "APP/PROC/WEB", "0"),
+								}, nil)
+							})
+
+							It("displays all of them", func() {
+								Expect(testUI.Out).To(Say("Last 2 lines of staging and app logs:"))
+								Expect(testUI.Out).To(Say("staging log"))
+								Expect(testUI.Out).To(Say("app log"))
+							})
+						})
+
+						Context("when the logs cannot be retrieved", func() {
+							BeforeEach(func() {
+								fakeActor.GetRecentLogsForApplicationReturns(nil, v2action.NOAATimeoutError{})
+							})
+
+							It("warns and still displays the last crash", func() {
+								Expect(executeErr).To(MatchError(translatableerror.UnsuccessfulStartError{AppName: "some-app", BinaryName: "faceman"}))
+								Expect(testUI.Err).To(Say("Unable to retrieve recent logs: Timeout trying to connect to NOAA"))
+								Expect(testUI.Out).To(Say("Last crash:"))
+							})
+						})
+
+						Context("when the app has not crashed", func() {
+							BeforeEach(func() {
+								fakeActor.GetApplicationLastCrashReturns(v2action.ApplicationCrash{}, nil, v2action.ApplicationCrashNotFoundError{ApplicationGUID: "app-guid"})
+							})
+
+							It("does not display a crash", func() {
+								Expect(executeErr).To(HaveOccurred())
+								Expect(testUI.Out).ToNot(Say("Last crash:"))
+							})
+						})
+
+						Context("when --no-logs-on-failure is provided", func() {
+							BeforeEach(func() {
+								cmd.NoLogsOnFailure = true
+							})
+
+							It("does not display the logs or the last crash", func() {
+								Expect(executeErr).To(MatchError(translatableerror.UnsuccessfulStartError{AppName: "some-app", BinaryName: "faceman"}))
+								Expect(fakeActor.GetRecentLogsForApplicationCallCount()).To(Equal(0))
+								Expect(fakeActor.GetApplicationLastCrashCallCount()).To(Equal(0))
+							})
+						})
+					})
+
+					Context("when the error is not a start failure", func() {
+						BeforeEach(func() {
+							apiErr = errors.New("some-error")
+						})
+
+						It("does not display the logs or the last crash", func() {
+							Expect(executeErr).To(MatchError(apiErr))
+							Expect(fakeActor.GetRecentLogsForApplicationCallCount()).To(Equal(0))
+						})
+					})
 				})
 
 				Context("when the app finishes starting", func() {
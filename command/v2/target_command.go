@@ -1,6 +1,7 @@
 package v2
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -21,7 +22,8 @@ type TargetActor interface {
 type TargetCommand struct {
 	Organization    string      `short:"o" description:"Organization"`
 	Space           string      `short:"s" description:"Space"`
-	usage           interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]"`
+	JSON            bool        `long:"json" description:"Display the target as JSON"`
+	usage           interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE] [--json]"`
 	relatedCommands interface{} `related_commands:"create-org, create-space, login, orgs, spaces"`
 
 	UI          command.UI
@@ -57,34 +59,29 @@ func (cmd *TargetCommand) Execute(args []string) error {
 
 	user, err := cmd.Config.CurrentUser()
 	if err != nil {
-		cmd.clearTargets()
 		return shared.HandleError(err)
 	}
 
+	previous := cmd.currentTarget()
+
 	switch {
 	case cmd.Organization != "" && cmd.Space != "":
 		err = cmd.setOrgAndSpace()
-		if err != nil {
-			cmd.clearTargets()
-			return err
-		}
 	case cmd.Organization != "":
 		err = cmd.setOrg()
-		if err != nil {
-			cmd.clearTargets()
-			return err
-		}
-		err = cmd.autoTargetSpace(cmd.Config.TargetedOrganization().GUID)
-		if err != nil {
-			cmd.clearTargets()
-			return err
+		if err == nil {
+			err = cmd.autoTargetSpace(cmd.Config.TargetedOrganization().GUID)
 		}
 	case cmd.Space != "":
 		err = cmd.setSpace()
-		if err != nil {
-			cmd.clearTargets()
-			return err
-		}
+	}
+	if err != nil {
+		cmd.restoreTarget(previous)
+		return err
+	}
+
+	if cmd.JSON {
+		return cmd.displayTargetJSON(user)
 	}
 
 	cmd.displayTargetTable(user)
@@ -107,11 +104,37 @@ func (cmd *TargetCommand) Execute(args []string) error {
 	return nil
 }
 
-func (cmd TargetCommand) clearTargets() {
-	if cmd.Organization != "" {
+// previousTarget is the org and space targeted before the command changed anything.
+type previousTarget struct {
+	org   *configv3.Organization
+	space *configv3.Space
+}
+
+func (cmd TargetCommand) currentTarget() previousTarget {
+	var previous previousTarget
+	if cmd.Config.HasTargetedOrganization() {
+		org := cmd.Config.TargetedOrganization()
+		previous.org = &org
+	}
+	if cmd.Config.HasTargetedSpace() {
+		space := cmd.Config.TargetedSpace()
+		previous.space = &space
+	}
+	return previous
+}
+
+// restoreTarget puts back the previous org and space, so a failed target
+// never leaves the config half updated.
+func (cmd TargetCommand) restoreTarget(previous previousTarget) {
+	if previous.org != nil {
+		cmd.Config.SetOrganizationInformation(previous.org.GUID, previous.org.Name)
+	} else {
 		cmd.Config.UnsetOrganizationInformation()
-		cmd.Config.UnsetSpaceInformation()
-	} else if cmd.Space != "" {
+	}
+
+	if previous.space != nil {
+		cmd.Config.SetSpaceInformation(previous.space.GUID, previous.space.Name, previous.space.AllowSSH)
+	} else {
 		cmd.Config.UnsetSpaceInformation()
 	}
 }
@@ -203,5 +226,45 @@ func (cmd *TargetCommand) displayTargetTable(user configv3.User) {
 			cmd.UI.TranslateText("space:"), cmd.Config.TargetedSpace().Name,
 		})
 	}
+
+	if configDir := cmd.Config.CustomConfigDirectory(); configDir != "" {
+		table = append(table, []string{
+			cmd.UI.TranslateText("config dir:"), configDir,
+		})
+	}
 	cmd.UI.DisplayKeyValueTable("", table, 3)
 }
+
+// displayTargetJSON displays target information as JSON. Targets that are not
+// set are null.
+func (cmd *TargetCommand) displayTargetJSON(user configv3.User) error {
+	targetJSON := map[string]interface{}{
+		"api_endpoint": cmd.Config.Target(),
+		"api_version":  cmd.Config.APIVersion(),
+		"user":         user.Name,
+		"org":          nil,
+		"space":        nil,
+	}
+
+	if configDir := cmd.Config.CustomConfigDirectory(); configDir != "" {
+		targetJSON["config_dir"] = configDir
+	}
+
+	if cmd.Config.HasTargetedOrganization() {
+		org := cmd.Config.TargetedOrganization()
+		targetJSON["org"] = map[string]string{"guid": org.GUID, "name": org.Name}
+	}
+
+	if cmd.Config.HasTargetedSpace() {
+		space := cmd.Config.TargetedSpace()
+		targetJSON["space"] = map[string]string{"guid": space.GUID, "name": space.Name}
+	}
+
+	output, err := json.MarshalIndent(targetJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
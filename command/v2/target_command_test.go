@@ -29,6 +29,24 @@ var _ = Describe("target Command", func() {
 		executeErr      error
 	)
 
+	// stubTarget makes the fake config remember the targeted org and space,
+	// starting from the given ones. Empty GUIDs mean nothing is targeted.
+	stubTarget := func(org configv3.Organization, space configv3.Space) {
+		fakeConfig.HasTargetedOrganizationStub = func() bool { return org.GUID != "" }
+		fakeConfig.TargetedOrganizationStub = func() configv3.Organization { return org }
+		fakeConfig.SetOrganizationInformationStub = func(guid string, name string) {
+			org = configv3.Organization{GUID: guid, Name: name}
+		}
+		fakeConfig.UnsetOrganizationInformationStub = func() { org = configv3.Organization{} }
+
+		fakeConfig.HasTargetedSpaceStub = func() bool { return space.GUID != "" }
+		fakeConfig.TargetedSpaceStub = func() configv3.Space { return space }
+		fakeConfig.SetSpaceInformationStub = func(guid string, name string, allowSSH bool) {
+			space = configv3.Space{GUID: guid, Name: name, AllowSSH: allowSSH}
+		}
+		fakeConfig.UnsetSpaceInformationStub = func() { space = configv3.Space{} }
+	}
+
 	BeforeEach(func() {
 		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
 		fakeConfig = new(commandfakes.FakeConfig)
@@ -203,6 +221,74 @@ var _ = Describe("target Command", func() {
 							Expect(testUI.Out).To(Say("org:            some-org"))
 							Expect(testUI.Out).To(Say("space:          some-space"))
 						})
+
+						Context("when --json is passed", func() {
+							BeforeEach(func() {
+								cmd.JSON = true
+							})
+
+							It("displays the target as JSON", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+									"api_endpoint": "some-api-target",
+									"api_version": "1.2.3",
+									"user": "some-user",
+									"org": {"guid": "some-org-guid", "name": "some-org"},
+									"space": {"guid": "some-space-guid", "name": "some-space"}
+								}`))
+							})
+						})
+					})
+
+					Context("when nothing is targeted and --json is passed", func() {
+						BeforeEach(func() {
+							cmd.JSON = true
+						})
+
+						It("displays null for the org and space", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+								"api_endpoint": "some-api-target",
+								"api_version": "1.2.3",
+								"user": "some-user",
+								"org": null,
+								"space": null
+							}`))
+						})
+					})
+
+					Context("when the config directory is not the default one", func() {
+						BeforeEach(func() {
+							fakeConfig.CustomConfigDirectoryReturns("/some/home/.cf")
+						})
+
+						It("displays the config directory in effect", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("user:           some-user"))
+							Expect(testUI.Out).To(Say("config dir:     /some/home/.cf"))
+						})
+
+						Context("when --json is passed", func() {
+							BeforeEach(func() {
+								cmd.JSON = true
+							})
+
+							It("includes the config directory", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+									"api_endpoint": "some-api-target",
+									"api_version": "1.2.3",
+									"user": "some-user",
+									"org": null,
+									"space": null,
+									"config_dir": "/some/home/.cf"
+								}`))
+							})
+						})
 					})
 				})
 
@@ -248,22 +334,24 @@ var _ = Describe("target Command", func() {
 									v2action.SpaceNotFoundError{Name: "some-space"})
 							})
 
-							It("returns a SpaceNotFoundError and clears existing space", func() {
+							It("returns a SpaceNotFoundError and keeps the previous target", func() {
 								Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "some-space"}))
 
-								Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
+								Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+								orgGUID, _ := fakeConfig.SetOrganizationInformationArgsForCall(0)
+								Expect(orgGUID).To(Equal("some-org-guid"))
 								Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(0))
-								Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
 							})
 						})
 					})
 
 					Context("when no org is targeted", func() {
-						It("returns NoOrgTargeted error and clears existing space", func() {
+						It("returns NoOrgTargeted error and leaves nothing targeted", func() {
 							Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
 
+							Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
 							Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
-							Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(0))
+							Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
 							Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
 						})
 					})
@@ -282,7 +370,7 @@ var _ = Describe("target Command", func() {
 								v2action.OrganizationNotFoundError{Name: "some-org"})
 						})
 
-						It("displays all warnings,returns an org target error, and clears existing targets", func() {
+						It("displays all warnings, returns an org target error, and restores the previous target", func() {
 							Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
 
 							Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
@@ -317,7 +405,7 @@ var _ = Describe("target Command", func() {
 									err)
 							})
 
-							It("displays all warnings, returns a get org spaces error and clears existing targets", func() {
+							It("displays all warnings, returns a get org spaces error and restores the previous target", func() {
 								Expect(executeErr).To(MatchError(err))
 
 								Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(1))
@@ -328,13 +416,13 @@ var _ = Describe("target Command", func() {
 								Expect(testUI.Err).To(Say("warning-2"))
 								Expect(testUI.Err).To(Say("warning-3"))
 
-								Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(1))
+								Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(2))
 								orgGUID, orgName := fakeConfig.SetOrganizationInformationArgsForCall(0)
 								Expect(orgGUID).To(Equal("some-org-guid"))
 								Expect(orgName).To(Equal("some-org"))
 								Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
 
-								Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
+								Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(0))
 								Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(2))
 							})
 						})
@@ -448,15 +536,28 @@ var _ = Describe("target Command", func() {
 									err)
 							})
 
-							It("displays all warnings, returns the error, and clears existing targets", func() {
+							It("displays all warnings, returns the error, and restores the previous target", func() {
 								Expect(executeErr).To(MatchError(err))
 
 								Expect(testUI.Err).To(Say("warning-1"))
 								Expect(testUI.Err).To(Say("warning-2"))
 								Expect(testUI.Err).To(Say("warning-3"))
+							})
 
-								Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
-								Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(2))
+							Context("when a different org and space were targeted before", func() {
+								BeforeEach(func() {
+									stubTarget(
+										configv3.Organization{GUID: "previous-org-guid", Name: "previous-org"},
+										configv3.Space{GUID: "previous-space-guid", Name: "previous-space", AllowSSH: true},
+									)
+								})
+
+								It("targets the previous org and space again", func() {
+									Expect(executeErr).To(MatchError(err))
+
+									Expect(fakeConfig.TargetedOrganization()).To(Equal(configv3.Organization{GUID: "previous-org-guid", Name: "previous-org"}))
+									Expect(fakeConfig.TargetedSpace()).To(Equal(configv3.Space{GUID: "previous-space-guid", Name: "previous-space", AllowSSH: true}))
+								})
 							})
 						})
 					})
@@ -521,14 +622,27 @@ var _ = Describe("target Command", func() {
 									v2action.SpaceNotFoundError{Name: "some-space"})
 							})
 
-							It("returns an error and clears existing targets", func() {
+							It("returns an error and does not target the org", func() {
 								Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "some-space"}))
 
 								Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
 								Expect(fakeConfig.SetSpaceInformationCallCount()).To(Equal(0))
+							})
 
-								Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
-								Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+							Context("when an org and space were targeted before", func() {
+								BeforeEach(func() {
+									stubTarget(
+										configv3.Organization{GUID: "previous-org-guid", Name: "previous-org"},
+										configv3.Space{GUID: "previous-space-guid", Name: "previous-space", AllowSSH: true},
+									)
+								})
+
+								It("keeps the previous org and space targeted", func() {
+									Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "some-space"}))
+
+									Expect(fakeConfig.TargetedOrganization()).To(Equal(configv3.Organization{GUID: "previous-org-guid", Name: "previous-org"}))
+									Expect(fakeConfig.TargetedSpace()).To(Equal(configv3.Space{GUID: "previous-space-guid", Name: "previous-space", AllowSSH: true}))
+								})
 							})
 						})
 					})
@@ -541,7 +655,7 @@ var _ = Describe("target Command", func() {
 								v2action.OrganizationNotFoundError{Name: "some-org"})
 						})
 
-						It("returns an error and clears existing targets", func() {
+						It("returns an error and restores the previous target", func() {
 							Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
 
 							Expect(fakeConfig.SetOrganizationInformationCallCount()).To(Equal(0))
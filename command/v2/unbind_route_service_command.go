@@ -9,12 +9,13 @@ import (
 )
 
 type UnbindRouteServiceCommand struct {
-	RequiredArgs    flag.RouteServiceArgs `positional-args:"yes"`
-	Force           bool                  `short:"f" description:"Force unbinding without confirmation"`
-	Hostname        string                `long:"hostname" short:"n" description:"Hostname used in combination with DOMAIN to specify the route to unbind"`
-	Path            string                `long:"path" description:"Path used in combination with HOSTNAME and DOMAIN to specify the route to unbind"`
-	usage           interface{}           `usage:"CF_NAME unbind-route-service DOMAIN SERVICE_INSTANCE [--hostname HOSTNAME] [--path PATH] [-f]\n\nEXAMPLES:\n   CF_NAME unbind-route-service example.com myratelimiter --hostname myapp --path foo"`
-	relatedCommands interface{}           `related_commands:"delete-service, routes, services"`
+	RequiredArgs     flag.RouteServiceArgs `positional-args:"yes"`
+	ParametersAsJSON flag.Path             `short:"c" description:"Valid JSON object containing service-specific configuration parameters for unbinding, provided inline or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	Force            bool                  `short:"f" description:"Force unbinding without confirmation"`
+	Hostname         string                `long:"hostname" short:"n" description:"Hostname used in combination with DOMAIN to specify the route to unbind"`
+	Path             string                `long:"path" description:"Path used in combination with HOSTNAME and DOMAIN to specify the route to unbind"`
+	usage            interface{}           `usage:"CF_NAME unbind-route-service DOMAIN SERVICE_INSTANCE [--hostname HOSTNAME] [--path PATH] [-c PARAMETERS_AS_JSON] [-f]\n\nEXAMPLES:\n   CF_NAME unbind-route-service example.com myratelimiter --hostname myapp --path foo\n   CF_NAME unbind-route-service example.com myratelimiter -c '{\"valid\":\"json\"}'"`
+	relatedCommands  interface{}           `related_commands:"delete-service, routes, services"`
 }
 
 func (UnbindRouteServiceCommand) Setup(config command.Config, ui command.UI) error {
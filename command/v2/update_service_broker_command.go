@@ -9,9 +9,13 @@ import (
 )
 
 type UpdateServiceBrokerCommand struct {
-	RequiredArgs    flag.ServiceBrokerArgs `positional-args:"yes"`
-	usage           interface{}            `usage:"CF_NAME update-service-broker SERVICE_BROKER USERNAME PASSWORD URL"`
-	relatedCommands interface{}            `related_commands:"rename-service-broker, service-brokers"`
+	RequiredArgs    flag.UpdateServiceBrokerArgs `positional-args:"yes"`
+	DryRun          bool                         `long:"dry-run" description:"Show the changes to services and plans that updating the broker would make, without applying them"`
+	Username        string                       `long:"username" description:"Change the username the platform uses to authenticate with the broker"`
+	Password        bool                         `long:"password" description:"Prompt for a new password the platform uses to authenticate with the broker, read from stdin when it is not a terminal"`
+	URL             string                       `long:"url" description:"Change the URL of the broker"`
+	usage           interface{}                  `usage:"CF_NAME update-service-broker SERVICE_BROKER USERNAME PASSWORD URL [--dry-run]\n\n   Change only some of the broker's settings:\n\n   CF_NAME update-service-broker SERVICE_BROKER [--username USERNAME] [--password] [--url URL]\n\n   Preview catalog changes using the stored broker URL and username:\n\n   CF_NAME update-service-broker SERVICE_BROKER --dry-run\n\nEXAMPLES:\n   echo \"$BROKER_PASSWORD\" | CF_NAME update-service-broker my-broker --password\n   CF_NAME update-service-broker my-broker --url https://broker.example.com"`
+	relatedCommands interface{}                  `related_commands:"rename-service-broker, service-brokers"`
 }
 
 func (UpdateServiceBrokerCommand) Setup(config command.Config, ui command.UI) error {
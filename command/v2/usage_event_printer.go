@@ -0,0 +1,126 @@
+package v2
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// usageEventPrinter writes usage events as they are received. JSON and CSV
+// rows are written immediately so large result sets stream; table rows are
+// buffered until flush so that columns can be aligned.
+type usageEventPrinter struct {
+	UI     command.UI
+	Format string
+
+	csvHeader    []string
+	tableHeader  []string
+	csvWriter    *csv.Writer
+	tableRows    [][]string
+	wroteHeaders bool
+	lastGUID     string
+}
+
+func newUsageEventPrinter(ui command.UI, format string, afterGUID string, csvHeader []string, tableHeader []string) *usageEventPrinter {
+	return &usageEventPrinter{
+		UI:          ui,
+		Format:      format,
+		csvHeader:   csvHeader,
+		tableHeader: tableHeader,
+		lastGUID:    afterGUID,
+	}
+}
+
+// Print writes a single event. jsonEvent is marshalled for JSON output,
+// csvRow must match the CSV header and tableRow the table header.
+func (p *usageEventPrinter) Print(guid string, jsonEvent interface{}, csvRow []string, tableRow []string) error {
+	p.lastGUID = guid
+
+	switch p.Format {
+	case "json":
+		raw, err := json.Marshal(jsonEvent)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(p.UI.Writer(), string(raw))
+		return err
+	case "csv":
+		if p.csvWriter == nil {
+			p.csvWriter = csv.NewWriter(p.UI.Writer())
+		}
+		if !p.wroteHeaders {
+			p.wroteHeaders = true
+			if err := p.csvWriter.Write(p.csvHeader); err != nil {
+				return err
+			}
+		}
+		if err := p.csvWriter.Write(csvRow); err != nil {
+			return err
+		}
+		p.csvWriter.Flush()
+		return p.csvWriter.Error()
+	default:
+		p.tableRows = append(p.tableRows, tableRow)
+		return nil
+	}
+}
+
+// Flush displays any buffered table rows.
+func (p *usageEventPrinter) Flush() {
+	if len(p.tableRows) == 0 {
+		return
+	}
+
+	header := make([]string, 0, len(p.tableHeader))
+	for _, column := range p.tableHeader {
+		header = append(header, p.UI.TranslateText(column))
+	}
+	p.UI.DisplayTableWithHeader("", append([][]string{header}, p.tableRows...), 3)
+	p.tableRows = nil
+}
+
+// DisplayLastGUID displays the GUID of the last event printed so that
+// callers can resume from it with --after-guid. For JSON and CSV output it is
+// written to stderr to keep stdout parseable.
+func (p *usageEventPrinter) DisplayLastGUID() {
+	if p.lastGUID == "" {
+		return
+	}
+
+	if p.Format == "" {
+		p.UI.DisplayNewline()
+		p.UI.DisplayText("Last event GUID: {{.GUID}}", map[string]interface{}{"GUID": p.lastGUID})
+	} else {
+		p.UI.DisplayWarning("Last event GUID: {{.GUID}}", map[string]interface{}{"GUID": p.lastGUID})
+	}
+}
+
+// streamUsageEvents calls poll with the GUID of the last event printed. When
+// follow is set it keeps polling every interval, displaying the last GUID
+// after each poll that returned new events, until poll fails.
+func streamUsageEvents(ui command.UI, printer *usageEventPrinter, follow bool, interval time.Duration, poll func(afterGUID string) (v2action.Warnings, error)) error {
+	for {
+		previousGUID := printer.lastGUID
+		warnings, err := poll(previousGUID)
+		ui.DisplayWarnings(warnings)
+		printer.Flush()
+		if err != nil {
+			printer.DisplayLastGUID()
+			return err
+		}
+
+		if !follow {
+			printer.DisplayLastGUID()
+			return nil
+		}
+
+		if printer.lastGUID != previousGUID {
+			printer.DisplayLastGUID()
+		}
+		time.Sleep(interval)
+	}
+}
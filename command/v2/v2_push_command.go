@@ -3,18 +3,22 @@ package v2
 import (
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/progressbar"
-	"github.com/cloudfoundry/noaa/consumer"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -35,6 +39,13 @@ type V2PushActor interface {
 	ReadManifest(pathToManifest string) ([]manifest.Application, error)
 }
 
+//go:generate counterfeiter . V2PushSidecarActor
+
+type V2PushSidecarActor interface {
+	CloudControllerAPIVersion() string
+	ApplyApplicationManifest(spaceGUID string, rawManifest []byte) (v3action.Warnings, error)
+}
+
 type V2PushCommand struct {
 	OptionalArgs flag.OptionalAppName `positional-args:"yes"`
 	Buildpack    flag.Buildpack       `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
@@ -49,17 +60,18 @@ type V2PushCommand struct {
 	DiskQuota flag.Megabytes `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	Memory    flag.Megabytes `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	// NoHostname           bool                        `long:"no-hostname" description:"Map the root domain to this app"`
-	NoManifest bool `long:"no-manifest" description:"Ignore manifest file"`
-	// NoRoute              bool                        `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
-	NoStart bool                        `long:"no-start" description:"Do not start an app after pushing"`
-	AppPath flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
-	// RandomRoute          bool                        `long:"random-route" description:"Create a random route for this app"`
+	NoManifest  bool                        `long:"no-manifest" description:"Ignore manifest file"`
+	NoRoute     bool                        `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
+	NoStart     bool                        `long:"no-start" description:"Do not start an app after pushing"`
+	AppPath     flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	RandomRoute bool                        `long:"random-route" description:"Create a random route for this app"`
 	// RoutePath            string                      `long:"route-path" description:"Path for the route"`
-	StackName           string      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
-	HealthCheckTimeout  int         `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
-	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
-	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
-	dockerPassword      interface{} `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+	StackName                     string      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	HealthCheckTimeout            int         `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	envCFStagingTimeout           interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout           interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	dockerPassword                interface{} `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+	envCFStackDeprecationWarnings interface{} `environmentName:"CF_STACK_DEPRECATION_WARNINGS" environmentDescription:"Comma-separated list of stacks to warn about as deprecated"`
 
 	usage           interface{} `usage:"cf v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH]\n\n   cf v2-push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
 	relatedCommands interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
@@ -71,7 +83,8 @@ type V2PushCommand struct {
 	ProgressBar ProgressBar
 
 	RestartActor RestartActor
-	NOAAClient   *consumer.Consumer
+	SidecarActor V2PushSidecarActor
+	NOAAClient   v2action.NOAAClient
 }
 
 func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
@@ -89,7 +102,16 @@ func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
 
-	cmd.ProgressBar = progressbar.NewProgressBar()
+	ccClientV3, _, err := sharedV3.NewClients(config, ui, true)
+	if err != nil {
+		if _, ok := err.(translatableerror.V3APIDoesNotExistError); !ok {
+			return err
+		}
+	} else {
+		cmd.SidecarActor = v3action.NewActor(ccClientV3, config)
+	}
+
+	cmd.ProgressBar = progressbar.NewProgressBar(ui.Writer(), ui.AnimationsEnabled())
 	return nil
 }
 
@@ -127,6 +149,11 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	err = cmd.checkSidecarSupport(manifestApplications)
+	if err != nil {
+		return err
+	}
+
 	cmd.UI.DisplayText("Getting app info...")
 
 	log.Info("converting manifests to ApplicationConfigs")
@@ -155,9 +182,21 @@ func (cmd V2PushCommand) Execute(args []string) error {
 			log.Errorln("display changes:", err)
 			return shared.HandleError(err)
 		}
+		cmd.displayStackDeprecationWarning(appConfig)
+		if !cmd.NoStart {
+			timeouts := cmd.timeoutConfig(appConfig)
+			cmd.UI.DisplayText("Staging timeout: {{.StagingTimeout}}, startup timeout: {{.StartupTimeout}}", map[string]interface{}{
+				"StagingTimeout": timeouts.StagingTimeout(),
+				"StartupTimeout": timeouts.StartupTimeout(),
+			})
+		}
 		cmd.UI.DisplayNewline()
 	}
 
+	if !cmd.NoStart && cmd.NOAAClient == nil {
+		cmd.UI.DisplayWarning("staging logs unavailable on this platform")
+	}
+
 	for appNumber, appConfig := range appConfigs {
 		if appConfig.CreatingApplication() {
 			cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
@@ -176,8 +215,14 @@ func (cmd V2PushCommand) Execute(args []string) error {
 			return shared.HandleError(err)
 		}
 
+		err = cmd.applySidecars(manifestApplications, appConfig.DesiredApplication.Name)
+		if err != nil {
+			log.Errorln("applying sidecars:", err)
+			return err
+		}
+
 		if !cmd.NoStart {
-			messages, logErrs, appState, apiWarnings, errs := cmd.RestartActor.RestartApplication(updatedConfig.CurrentApplication.Application, cmd.NOAAClient, cmd.Config)
+			messages, logErrs, appState, apiWarnings, errs := cmd.RestartActor.RestartApplication(updatedConfig.CurrentApplication.Application, cmd.NOAAClient, cmd.timeoutConfig(appConfig))
 			err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appState, apiWarnings, errs)
 			if err != nil {
 				return err
@@ -201,6 +246,51 @@ func (cmd V2PushCommand) Execute(args []string) error {
 	return nil
 }
 
+// checkSidecarSupport fails the push before anything is changed when the
+// manifest configures sidecars the targeted API cannot run.
+func (cmd V2PushCommand) checkSidecarSupport(apps []manifest.Application) error {
+	for _, app := range apps {
+		if len(app.Sidecars) == 0 {
+			continue
+		}
+
+		if cmd.SidecarActor == nil {
+			return translatableerror.MinimumAPIVersionNotMetError{
+				Command:        "Manifest key 'sidecars'",
+				MinimumVersion: ccversion.MinVersionSidecarsV3,
+			}
+		}
+		return command.MinimumAPIVersionCheck(cmd.SidecarActor.CloudControllerAPIVersion(), ccversion.MinVersionSidecarsV3, "Manifest key 'sidecars'")
+	}
+	return nil
+}
+
+// applySidecars applies the sidecars of the named app from the manifest, so
+// they are in place before the app starts.
+func (cmd V2PushCommand) applySidecars(apps []manifest.Application, appName string) error {
+	for _, app := range apps {
+		if app.Name != appName || len(app.Sidecars) == 0 {
+			continue
+		}
+
+		cmd.UI.DisplayText("Applying sidecars for app {{.AppName}}...", map[string]interface{}{
+			"AppName": appName,
+		})
+
+		rawManifest, err := manifest.MarshalSidecars(app)
+		if err != nil {
+			return err
+		}
+
+		warnings, err := cmd.SidecarActor.ApplyApplicationManifest(cmd.Config.TargetedSpace().GUID, rawManifest)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return sharedV3.HandleError(err)
+		}
+	}
+	return nil
+}
+
 func (cmd V2PushCommand) GetCommandLineSettings() (pushaction.CommandLineSettings, error) {
 	err := cmd.validateArgs()
 	if err != nil {
@@ -225,7 +315,9 @@ func (cmd V2PushCommand) GetCommandLineSettings() (pushaction.CommandLineSetting
 		Instances:          cmd.Instances.NullInt,
 		Memory:             cmd.Memory.Value,
 		Name:               cmd.OptionalArgs.AppName,
+		NoRoute:            cmd.NoRoute,
 		ProvidedAppPath:    string(cmd.AppPath),
+		RandomRoute:        cmd.RandomRoute,
 		StackName:          cmd.StackName,
 	}
 
@@ -233,6 +325,60 @@ func (cmd V2PushCommand) GetCommandLineSettings() (pushaction.CommandLineSetting
 	return config, nil
 }
 
+// pushTimeoutConfig overrides the configured staging and startup timeouts with
+// the ones requested for a single application.
+type pushTimeoutConfig struct {
+	command.Config
+	stagingTimeout time.Duration
+	startupTimeout time.Duration
+}
+
+func (config pushTimeoutConfig) StagingTimeout() time.Duration {
+	return config.stagingTimeout
+}
+
+func (config pushTimeoutConfig) StartupTimeout() time.Duration {
+	return config.startupTimeout
+}
+
+// timeoutConfig returns the config used to wait on the given application. The
+// staging-timeout manifest attribute takes precedence over
+// $CF_STAGING_TIMEOUT, and -t (or the timeout manifest attribute) takes
+// precedence over $CF_STARTUP_TIMEOUT, matching the legacy push.
+func (cmd V2PushCommand) timeoutConfig(appConfig pushaction.ApplicationConfig) pushTimeoutConfig {
+	config := pushTimeoutConfig{
+		Config:         cmd.Config,
+		stagingTimeout: cmd.Config.StagingTimeout(),
+		startupTimeout: cmd.Config.StartupTimeout(),
+	}
+	if appConfig.StagingTimeout != 0 {
+		config.stagingTimeout = appConfig.StagingTimeout
+	}
+	if appConfig.StartupTimeout != 0 {
+		config.startupTimeout = appConfig.StartupTimeout
+	}
+	return config
+}
+
+// displayStackDeprecationWarning warns when the application will run on one of
+// the stacks listed in $CF_STACK_DEPRECATION_WARNINGS.
+func (cmd V2PushCommand) displayStackDeprecationWarning(appConfig pushaction.ApplicationConfig) {
+	stackName := appConfig.DesiredApplication.Stack.Name
+	if stackName == "" {
+		return
+	}
+
+	for _, deprecatedStack := range cmd.Config.DeprecatedStacks() {
+		if strings.EqualFold(stackName, deprecatedStack) {
+			cmd.UI.DisplayWarning("Stack {{.StackName}} is deprecated. Use -s or the stack manifest attribute to push {{.AppName}} to a supported stack.", map[string]interface{}{
+				"StackName": stackName,
+				"AppName":   appConfig.DesiredApplication.Name,
+			})
+			return
+		}
+	}
+}
+
 func (cmd V2PushCommand) findAndReadManifest(settings pushaction.CommandLineSettings) ([]manifest.Application, error) {
 	var pathToManifest string
 
@@ -325,6 +471,12 @@ func (cmd V2PushCommand) processEvent(user configv3.User, appConfig pushaction.A
 	switch event {
 	case pushaction.ConfiguringRoutes:
 		cmd.UI.DisplayText("Mapping routes...")
+	case pushaction.UnbindingRoutes:
+		for _, route := range appConfig.RoutesToUnbind() {
+			cmd.UI.DisplayText("Unmapping route {{.Route}}...", map[string]interface{}{
+				"Route": route.String(),
+			})
+		}
 	case pushaction.ConfiguringServices:
 		cmd.UI.DisplayText("Binding services...")
 	case pushaction.ResourceMatching:
@@ -366,6 +518,10 @@ func (cmd V2PushCommand) validateArgs() error {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{"-f", "--no-manifest"},
 		}
+	case cmd.NoRoute && cmd.RandomRoute:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--no-route", "--random-route"},
+		}
 	}
 
 	return nil
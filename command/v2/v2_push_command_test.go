@@ -11,7 +11,10 @@ import (
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -97,6 +100,8 @@ var _ = Describe("v2-push Command", func() {
 			fakeConfig.HasTargetedSpaceReturns(true)
 			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
 			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.StagingTimeoutReturns(15 * time.Minute)
+			fakeConfig.StartupTimeoutReturns(5 * time.Minute)
 		})
 
 		Context("when the push settings are valid", func() {
@@ -121,8 +126,8 @@ var _ = Describe("v2-push Command", func() {
 							CurrentApplication: pushaction.Application{Application: v2action.Application{Name: appName, State: ccv2.ApplicationStarted}},
 							DesiredApplication: pushaction.Application{Application: v2action.Application{Name: appName}},
 							CurrentRoutes: []v2action.Route{
-								{Host: "route1", Domain: v2action.Domain{Name: "example.com"}},
-								{Host: "route2", Domain: v2action.Domain{Name: "example.com"}},
+								{GUID: "route1-guid", Host: "route1", Domain: v2action.Domain{Name: "example.com"}},
+								{GUID: "route2-guid", Host: "route2", Domain: v2action.Domain{Name: "example.com"}},
 							},
 							DesiredRoutes: []v2action.Route{
 								{Host: "route3", Domain: v2action.Domain{Name: "example.com"}},
@@ -160,6 +165,8 @@ var _ = Describe("v2-push Command", func() {
 								Eventually(eventStream).Should(BeSent(pushaction.UpdatedApplication))
 								Eventually(eventStream).Should(BeSent(pushaction.ConfiguringRoutes))
 								Eventually(eventStream).Should(BeSent(pushaction.CreatedRoutes))
+								Eventually(eventStream).Should(BeSent(pushaction.UnbindingRoutes))
+								Eventually(eventStream).Should(BeSent(pushaction.UnboundRoutes))
 								Eventually(eventStream).Should(BeSent(pushaction.BoundRoutes))
 								Eventually(eventStream).Should(BeSent(pushaction.ConfiguringServices))
 								Eventually(eventStream).Should(BeSent(pushaction.BoundServices))
@@ -242,6 +249,57 @@ var _ = Describe("v2-push Command", func() {
 						fakeRestartActor.GetApplicationSummaryByNameAndSpaceReturns(applicationSummary, warnings, nil)
 					})
 
+					Context("when the manifest configures sidecars", func() {
+						var fakeSidecarActor *v2fakes.FakeV2PushSidecarActor
+
+						BeforeEach(func() {
+							appManifests[0].Sidecars = []manifest.Sidecar{
+								{Name: "auth-proxy", Command: "./proxy", ProcessTypes: []string{"web"}},
+							}
+
+							fakeSidecarActor = new(v2fakes.FakeV2PushSidecarActor)
+							fakeSidecarActor.CloudControllerAPIVersionReturns(ccversion.MinVersionSidecarsV3)
+							cmd.SidecarActor = fakeSidecarActor
+						})
+
+						Context("when applying the sidecars succeeds", func() {
+							BeforeEach(func() {
+								fakeSidecarActor.ApplyApplicationManifestReturns(v3action.Warnings{"sidecar-warning"}, nil)
+							})
+
+							It("applies the sidecars of the app and starts it", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say("Applying sidecars for app some-app\\.\\.\\."))
+								Expect(testUI.Err).To(Say("sidecar-warning"))
+
+								Expect(fakeSidecarActor.ApplyApplicationManifestCallCount()).To(Equal(1))
+								spaceGUID, rawManifest := fakeSidecarActor.ApplyApplicationManifestArgsForCall(0)
+								Expect(spaceGUID).To(Equal("some-space-guid"))
+								Expect(string(rawManifest)).To(Equal(`applications:
+- name: some-app
+  sidecars:
+  - name: auth-proxy
+    command: ./proxy
+    process_types:
+    - web
+`))
+								Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(1))
+							})
+						})
+
+						Context("when applying the sidecars fails", func() {
+							BeforeEach(func() {
+								fakeSidecarActor.ApplyApplicationManifestReturns(v3action.Warnings{"sidecar-warning"}, errors.New("sidecar-error"))
+							})
+
+							It("returns the error and does not start the app", func() {
+								Expect(executeErr).To(MatchError("sidecar-error"))
+								Expect(testUI.Err).To(Say("sidecar-warning"))
+								Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(0))
+							})
+						})
+					})
+
 					Context("when no manifest is provided", func() {
 						It("passes through the command line flags", func() {
 							Expect(executeErr).ToNot(HaveOccurred())
@@ -429,6 +487,8 @@ var _ = Describe("v2-push Command", func() {
 
 							Expect(testUI.Out).To(Say("Creating app with these attributes\\.\\.\\."))
 							Expect(testUI.Out).To(Say("Mapping routes\\.\\.\\."))
+							Expect(testUI.Out).To(Say("Unmapping route route1\\.example\\.com\\.\\.\\."))
+							Expect(testUI.Out).To(Say("Unmapping route route2\\.example\\.com\\.\\.\\."))
 							Expect(testUI.Out).To(Say("Binding services\\.\\.\\."))
 							Expect(testUI.Out).To(Say("Comparing local files to remote cache\\.\\.\\."))
 							Expect(testUI.Out).To(Say("Packaging files to upload\\.\\.\\."))
@@ -442,6 +502,75 @@ var _ = Describe("v2-push Command", func() {
 							Expect(testUI.Err).To(Say("apply-2"))
 						})
 
+						It("displays the effective timeouts before starting", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say("Staging timeout: 15m0s, startup timeout: 5m0s"))
+
+							_, _, config := fakeRestartActor.RestartApplicationArgsForCall(0)
+							Expect(config.StagingTimeout()).To(Equal(15 * time.Minute))
+							Expect(config.StartupTimeout()).To(Equal(5 * time.Minute))
+						})
+
+						Context("when the app config specifies timeouts", func() {
+							BeforeEach(func() {
+								appConfigs[0].StagingTimeout = 30 * time.Minute
+								appConfigs[0].StartupTimeout = 90 * time.Second
+							})
+
+							It("waits using the app's timeouts", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say("Staging timeout: 30m0s, startup timeout: 1m30s"))
+
+								_, _, config := fakeRestartActor.RestartApplicationArgsForCall(0)
+								Expect(config.StagingTimeout()).To(Equal(30 * time.Minute))
+								Expect(config.StartupTimeout()).To(Equal(90 * time.Second))
+							})
+						})
+
+						It("does not warn about the stack", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Err).ToNot(Say("is deprecated"))
+						})
+
+						Context("when the app's stack is deprecated", func() {
+							BeforeEach(func() {
+								appConfigs[0].DesiredApplication.Stack = v2action.Stack{Name: "cflinuxfs2"}
+								fakeConfig.DeprecatedStacksReturns([]string{"windows2012R2", "cflinuxfs2"})
+							})
+
+							It("warns that the stack is deprecated", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Err).To(Say("Stack cflinuxfs2 is deprecated\\. Use -s or the stack manifest attribute to push %s to a supported stack\\.", appName))
+							})
+						})
+
+						Context("when logging is unavailable on the platform", func() {
+							BeforeEach(func() {
+								cmd.NOAAClient = nil
+							})
+
+							It("warns once and starts the app without a log client", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Err).To(Say("staging logs unavailable on this platform"))
+								Expect(testUI.Err).ToNot(Say("staging logs unavailable on this platform"))
+
+								_, client, _ := fakeRestartActor.RestartApplicationArgsForCall(0)
+								Expect(client).To(BeNil())
+							})
+						})
+
+						Context("when logging is available", func() {
+							BeforeEach(func() {
+								cmd.NOAAClient = new(v2actionfakes.FakeNOAAClient)
+							})
+
+							It("does not warn about staging logs", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Err).ToNot(Say("staging logs unavailable"))
+							})
+						})
+
 						It("displays app staging logs", func() {
 							Expect(executeErr).ToNot(HaveOccurred())
 
@@ -564,6 +693,7 @@ var _ = Describe("v2-push Command", func() {
 								Expect(testUI.Out).To(Say("requested state:\\s+stopped"))
 
 								Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(0))
+								Expect(testUI.Err).ToNot(Say("staging logs unavailable"))
 							})
 						})
 					})
@@ -621,6 +751,45 @@ var _ = Describe("v2-push Command", func() {
 			})
 		})
 
+		Context("when the manifest configures sidecars the API does not support", func() {
+			BeforeEach(func() {
+				fakeActor.MergeAndValidateSettingsAndManifestsReturns([]manifest.Application{
+					{
+						Name:     appName,
+						Path:     pwd,
+						Sidecars: []manifest.Sidecar{{Name: "auth-proxy"}},
+					},
+				}, nil)
+			})
+
+			Context("when the v3 API is not available", func() {
+				It("returns a MinimumAPIVersionNotMetError before pushing", func() {
+					Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+						Command:        "Manifest key 'sidecars'",
+						MinimumVersion: ccversion.MinVersionSidecarsV3,
+					}))
+					Expect(fakeActor.ConvertToApplicationConfigsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the v3 API is too old", func() {
+				BeforeEach(func() {
+					fakeSidecarActor := new(v2fakes.FakeV2PushSidecarActor)
+					fakeSidecarActor.CloudControllerAPIVersionReturns("3.32.0")
+					cmd.SidecarActor = fakeSidecarActor
+				})
+
+				It("returns a MinimumAPIVersionNotMetError before pushing", func() {
+					Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+						Command:        "Manifest key 'sidecars'",
+						CurrentVersion: "3.32.0",
+						MinimumVersion: ccversion.MinVersionSidecarsV3,
+					}))
+					Expect(fakeActor.ConvertToApplicationConfigsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when the push settings are invalid", func() {
 			var expectedErr error
 
@@ -654,6 +823,7 @@ var _ = Describe("v2-push Command", func() {
 				cmd.HealthCheckType = flag.HealthCheckType{Type: "http"}
 				cmd.Instances = flag.Instances{NullInt: types.NullInt{Value: 12, IsSet: true}}
 				cmd.Memory = flag.Megabytes{NullUint64: types.NullUint64{Value: 100, IsSet: true}}
+				cmd.RandomRoute = true
 				cmd.StackName = "some-stack"
 			})
 
@@ -666,10 +836,35 @@ var _ = Describe("v2-push Command", func() {
 				Expect(settings.HealthCheckType).To(Equal("http"))
 				Expect(settings.Instances).To(Equal(types.NullInt{Value: 12, IsSet: true}))
 				Expect(settings.Memory).To(Equal(uint64(100)))
+				Expect(settings.RandomRoute).To(BeTrue())
 				Expect(settings.StackName).To(Equal("some-stack"))
 			})
 		})
 
+		Context("when the --no-route flag is given", func() {
+			BeforeEach(func() {
+				cmd.NoRoute = true
+			})
+
+			It("sets it on the command line settings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(settings.NoRoute).To(BeTrue())
+			})
+		})
+
+		Context("when the --no-route and --random-route flags are both given", func() {
+			BeforeEach(func() {
+				cmd.NoRoute = true
+				cmd.RandomRoute = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--no-route", "--random-route"},
+				}))
+			})
+		})
+
 		Context("when the -o and -p flags are both given", func() {
 			BeforeEach(func() {
 				cmd.DockerImage.Path = "some-docker-image"
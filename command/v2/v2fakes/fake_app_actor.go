@@ -41,6 +41,36 @@ type FakeAppActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetApplicationLastUploadEventStub        func(appGUID string) (v2action.Event, v2action.Warnings, error)
+	getApplicationLastUploadEventMutex       sync.RWMutex
+	getApplicationLastUploadEventArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationLastUploadEventReturns struct {
+		result1 v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationLastUploadEventReturnsOnCall map[int]struct {
+		result1 v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationInstanceStatsByApplicationStub        func(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error)
+	getApplicationInstanceStatsByApplicationMutex       sync.RWMutex
+	getApplicationInstanceStatsByApplicationArgsForCall []struct {
+		guid string
+	}
+	getApplicationInstanceStatsByApplicationReturns struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationInstanceStatsByApplicationReturnsOnCall map[int]struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -155,6 +185,114 @@ func (fake *FakeAppActor) GetApplicationSummaryByNameAndSpaceReturnsOnCall(i int
 	}{result1, result2, result3}
 }
 
+func (fake *FakeAppActor) GetApplicationLastUploadEvent(appGUID string) (v2action.Event, v2action.Warnings, error) {
+	fake.getApplicationLastUploadEventMutex.Lock()
+	ret, specificReturn := fake.getApplicationLastUploadEventReturnsOnCall[len(fake.getApplicationLastUploadEventArgsForCall)]
+	fake.getApplicationLastUploadEventArgsForCall = append(fake.getApplicationLastUploadEventArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationLastUploadEvent", []interface{}{appGUID})
+	fake.getApplicationLastUploadEventMutex.Unlock()
+	if fake.GetApplicationLastUploadEventStub != nil {
+		return fake.GetApplicationLastUploadEventStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationLastUploadEventReturns.result1, fake.getApplicationLastUploadEventReturns.result2, fake.getApplicationLastUploadEventReturns.result3
+}
+
+func (fake *FakeAppActor) GetApplicationLastUploadEventCallCount() int {
+	fake.getApplicationLastUploadEventMutex.RLock()
+	defer fake.getApplicationLastUploadEventMutex.RUnlock()
+	return len(fake.getApplicationLastUploadEventArgsForCall)
+}
+
+func (fake *FakeAppActor) GetApplicationLastUploadEventArgsForCall(i int) string {
+	fake.getApplicationLastUploadEventMutex.RLock()
+	defer fake.getApplicationLastUploadEventMutex.RUnlock()
+	return fake.getApplicationLastUploadEventArgsForCall[i].appGUID
+}
+
+func (fake *FakeAppActor) GetApplicationLastUploadEventReturns(result1 v2action.Event, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationLastUploadEventStub = nil
+	fake.getApplicationLastUploadEventReturns = struct {
+		result1 v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppActor) GetApplicationLastUploadEventReturnsOnCall(i int, result1 v2action.Event, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationLastUploadEventStub = nil
+	if fake.getApplicationLastUploadEventReturnsOnCall == nil {
+		fake.getApplicationLastUploadEventReturnsOnCall = make(map[int]struct {
+			result1 v2action.Event
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationLastUploadEventReturnsOnCall[i] = struct {
+		result1 v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppActor) GetApplicationInstanceStatsByApplication(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error) {
+	fake.getApplicationInstanceStatsByApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationInstanceStatsByApplicationReturnsOnCall[len(fake.getApplicationInstanceStatsByApplicationArgsForCall)]
+	fake.getApplicationInstanceStatsByApplicationArgsForCall = append(fake.getApplicationInstanceStatsByApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplicationInstanceStatsByApplication", []interface{}{guid})
+	fake.getApplicationInstanceStatsByApplicationMutex.Unlock()
+	if fake.GetApplicationInstanceStatsByApplicationStub != nil {
+		return fake.GetApplicationInstanceStatsByApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationInstanceStatsByApplicationReturns.result1, fake.getApplicationInstanceStatsByApplicationReturns.result2, fake.getApplicationInstanceStatsByApplicationReturns.result3
+}
+
+func (fake *FakeAppActor) GetApplicationInstanceStatsByApplicationCallCount() int {
+	fake.getApplicationInstanceStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstanceStatsByApplicationMutex.RUnlock()
+	return len(fake.getApplicationInstanceStatsByApplicationArgsForCall)
+}
+
+func (fake *FakeAppActor) GetApplicationInstanceStatsByApplicationArgsForCall(i int) string {
+	fake.getApplicationInstanceStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstanceStatsByApplicationMutex.RUnlock()
+	return fake.getApplicationInstanceStatsByApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeAppActor) GetApplicationInstanceStatsByApplicationReturns(result1 []v2action.ApplicationInstanceWithStats, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstanceStatsByApplicationStub = nil
+	fake.getApplicationInstanceStatsByApplicationReturns = struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppActor) GetApplicationInstanceStatsByApplicationReturnsOnCall(i int, result1 []v2action.ApplicationInstanceWithStats, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstanceStatsByApplicationStub = nil
+	if fake.getApplicationInstanceStatsByApplicationReturnsOnCall == nil {
+		fake.getApplicationInstanceStatsByApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ApplicationInstanceWithStats
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationInstanceStatsByApplicationReturnsOnCall[i] = struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeAppActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -162,6 +300,10 @@ func (fake *FakeAppActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationLastUploadEventMutex.RLock()
+	defer fake.getApplicationLastUploadEventMutex.RUnlock()
+	fake.getApplicationInstanceStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstanceStatsByApplicationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
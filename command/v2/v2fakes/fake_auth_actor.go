@@ -5,16 +5,17 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/command/v2"
 )
 
 type FakeAuthActor struct {
-	AuthenticateStub        func(config v2action.Config, username string, password string) error
+	AuthenticateStub        func(config v2action.Config, credentials map[string]string, grantType constant.GrantType) error
 	authenticateMutex       sync.RWMutex
 	authenticateArgsForCall []struct {
-		config   v2action.Config
-		username string
-		password string
+		config      v2action.Config
+		credentials map[string]string
+		grantType   constant.GrantType
 	}
 	authenticateReturns struct {
 		result1 error
@@ -26,18 +27,18 @@ type FakeAuthActor struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAuthActor) Authenticate(config v2action.Config, username string, password string) error {
+func (fake *FakeAuthActor) Authenticate(config v2action.Config, credentials map[string]string, grantType constant.GrantType) error {
 	fake.authenticateMutex.Lock()
 	ret, specificReturn := fake.authenticateReturnsOnCall[len(fake.authenticateArgsForCall)]
 	fake.authenticateArgsForCall = append(fake.authenticateArgsForCall, struct {
-		config   v2action.Config
-		username string
-		password string
-	}{config, username, password})
-	fake.recordInvocation("Authenticate", []interface{}{config, username, password})
+		config      v2action.Config
+		credentials map[string]string
+		grantType   constant.GrantType
+	}{config, credentials, grantType})
+	fake.recordInvocation("Authenticate", []interface{}{config, credentials, grantType})
 	fake.authenticateMutex.Unlock()
 	if fake.AuthenticateStub != nil {
-		return fake.AuthenticateStub(config, username, password)
+		return fake.AuthenticateStub(config, credentials, grantType)
 	}
 	if specificReturn {
 		return ret.result1
@@ -51,10 +52,10 @@ func (fake *FakeAuthActor) AuthenticateCallCount() int {
 	return len(fake.authenticateArgsForCall)
 }
 
-func (fake *FakeAuthActor) AuthenticateArgsForCall(i int) (v2action.Config, string, string) {
+func (fake *FakeAuthActor) AuthenticateArgsForCall(i int) (v2action.Config, map[string]string, constant.GrantType) {
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
-	return fake.authenticateArgsForCall[i].config, fake.authenticateArgsForCall[i].username, fake.authenticateArgsForCall[i].password
+	return fake.authenticateArgsForCall[i].config, fake.authenticateArgsForCall[i].credentials, fake.authenticateArgsForCall[i].grantType
 }
 
 func (fake *FakeAuthActor) AuthenticateReturns(result1 error) {
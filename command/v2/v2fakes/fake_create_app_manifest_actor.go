@@ -6,15 +6,17 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/manifest"
 )
 
 type FakeCreateAppManifestActor struct {
-	CreateApplicationManifestByNameAndSpaceStub        func(appName string, spaceGUID string, filePath string) (v2action.Warnings, error)
+	CreateApplicationManifestByNameAndSpaceStub        func(appName string, spaceGUID string, filePath string, processes []manifest.Process) (v2action.Warnings, error)
 	createApplicationManifestByNameAndSpaceMutex       sync.RWMutex
 	createApplicationManifestByNameAndSpaceArgsForCall []struct {
 		appName   string
 		spaceGUID string
 		filePath  string
+		processes []manifest.Process
 	}
 	createApplicationManifestByNameAndSpaceReturns struct {
 		result1 v2action.Warnings
@@ -24,22 +26,71 @@ type FakeCreateAppManifestActor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	GetApplicationManifestByNameAndSpaceStub        func(appName string, spaceGUID string) (manifest.Application, v2action.Warnings, error)
+	getApplicationManifestByNameAndSpaceMutex       sync.RWMutex
+	getApplicationManifestByNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getApplicationManifestByNameAndSpaceReturns struct {
+		result1 manifest.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationManifestByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 manifest.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationsBySpaceStub        func(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	WriteApplicationManifestsStub        func(manifestApps []manifest.Application, pathToFile string) error
+	writeApplicationManifestsMutex       sync.RWMutex
+	writeApplicationManifestsArgsForCall []struct {
+		manifestApps []manifest.Application
+		pathToFile   string
+	}
+	writeApplicationManifestsReturns struct {
+		result1 error
+	}
+	writeApplicationManifestsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeCreateAppManifestActor) CreateApplicationManifestByNameAndSpace(appName string, spaceGUID string, filePath string) (v2action.Warnings, error) {
+func (fake *FakeCreateAppManifestActor) CreateApplicationManifestByNameAndSpace(appName string, spaceGUID string, filePath string, processes []manifest.Process) (v2action.Warnings, error) {
+	var processesCopy []manifest.Process
+	if processes != nil {
+		processesCopy = make([]manifest.Process, len(processes))
+		copy(processesCopy, processes)
+	}
 	fake.createApplicationManifestByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.createApplicationManifestByNameAndSpaceReturnsOnCall[len(fake.createApplicationManifestByNameAndSpaceArgsForCall)]
 	fake.createApplicationManifestByNameAndSpaceArgsForCall = append(fake.createApplicationManifestByNameAndSpaceArgsForCall, struct {
 		appName   string
 		spaceGUID string
 		filePath  string
-	}{appName, spaceGUID, filePath})
-	fake.recordInvocation("CreateApplicationManifestByNameAndSpace", []interface{}{appName, spaceGUID, filePath})
+		processes []manifest.Process
+	}{appName, spaceGUID, filePath, processesCopy})
+	fake.recordInvocation("CreateApplicationManifestByNameAndSpace", []interface{}{appName, spaceGUID, filePath, processesCopy})
 	fake.createApplicationManifestByNameAndSpaceMutex.Unlock()
 	if fake.CreateApplicationManifestByNameAndSpaceStub != nil {
-		return fake.CreateApplicationManifestByNameAndSpaceStub(appName, spaceGUID, filePath)
+		return fake.CreateApplicationManifestByNameAndSpaceStub(appName, spaceGUID, filePath, processes)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -53,10 +104,10 @@ func (fake *FakeCreateAppManifestActor) CreateApplicationManifestByNameAndSpaceC
 	return len(fake.createApplicationManifestByNameAndSpaceArgsForCall)
 }
 
-func (fake *FakeCreateAppManifestActor) CreateApplicationManifestByNameAndSpaceArgsForCall(i int) (string, string, string) {
+func (fake *FakeCreateAppManifestActor) CreateApplicationManifestByNameAndSpaceArgsForCall(i int) (string, string, string, []manifest.Process) {
 	fake.createApplicationManifestByNameAndSpaceMutex.RLock()
 	defer fake.createApplicationManifestByNameAndSpaceMutex.RUnlock()
-	return fake.createApplicationManifestByNameAndSpaceArgsForCall[i].appName, fake.createApplicationManifestByNameAndSpaceArgsForCall[i].spaceGUID, fake.createApplicationManifestByNameAndSpaceArgsForCall[i].filePath
+	return fake.createApplicationManifestByNameAndSpaceArgsForCall[i].appName, fake.createApplicationManifestByNameAndSpaceArgsForCall[i].spaceGUID, fake.createApplicationManifestByNameAndSpaceArgsForCall[i].filePath, fake.createApplicationManifestByNameAndSpaceArgsForCall[i].processes
 }
 
 func (fake *FakeCreateAppManifestActor) CreateApplicationManifestByNameAndSpaceReturns(result1 v2action.Warnings, result2 error) {
@@ -81,11 +132,180 @@ func (fake *FakeCreateAppManifestActor) CreateApplicationManifestByNameAndSpaceR
 	}{result1, result2}
 }
 
+func (fake *FakeCreateAppManifestActor) GetApplicationManifestByNameAndSpace(appName string, spaceGUID string) (manifest.Application, v2action.Warnings, error) {
+	fake.getApplicationManifestByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationManifestByNameAndSpaceReturnsOnCall[len(fake.getApplicationManifestByNameAndSpaceArgsForCall)]
+	fake.getApplicationManifestByNameAndSpaceArgsForCall = append(fake.getApplicationManifestByNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetApplicationManifestByNameAndSpace", []interface{}{appName, spaceGUID})
+	fake.getApplicationManifestByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationManifestByNameAndSpaceStub != nil {
+		return fake.GetApplicationManifestByNameAndSpaceStub(appName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationManifestByNameAndSpaceReturns.result1, fake.getApplicationManifestByNameAndSpaceReturns.result2, fake.getApplicationManifestByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeCreateAppManifestActor) GetApplicationManifestByNameAndSpaceCallCount() int {
+	fake.getApplicationManifestByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationManifestByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationManifestByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeCreateAppManifestActor) GetApplicationManifestByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationManifestByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationManifestByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationManifestByNameAndSpaceArgsForCall[i].appName, fake.getApplicationManifestByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCreateAppManifestActor) GetApplicationManifestByNameAndSpaceReturns(result1 manifest.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationManifestByNameAndSpaceStub = nil
+	fake.getApplicationManifestByNameAndSpaceReturns = struct {
+		result1 manifest.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateAppManifestActor) GetApplicationManifestByNameAndSpaceReturnsOnCall(i int, result1 manifest.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationManifestByNameAndSpaceStub = nil
+	if fake.getApplicationManifestByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationManifestByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 manifest.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationManifestByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 manifest.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateAppManifestActor) GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{spaceGUID})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationsBySpaceReturns.result1, fake.getApplicationsBySpaceReturns.result2, fake.getApplicationsBySpaceReturns.result3
+}
+
+func (fake *FakeCreateAppManifestActor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeCreateAppManifestActor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return fake.getApplicationsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCreateAppManifestActor) GetApplicationsBySpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateAppManifestActor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCreateAppManifestActor) WriteApplicationManifests(manifestApps []manifest.Application, pathToFile string) error {
+	var manifestAppsCopy []manifest.Application
+	if manifestApps != nil {
+		manifestAppsCopy = make([]manifest.Application, len(manifestApps))
+		copy(manifestAppsCopy, manifestApps)
+	}
+	fake.writeApplicationManifestsMutex.Lock()
+	ret, specificReturn := fake.writeApplicationManifestsReturnsOnCall[len(fake.writeApplicationManifestsArgsForCall)]
+	fake.writeApplicationManifestsArgsForCall = append(fake.writeApplicationManifestsArgsForCall, struct {
+		manifestApps []manifest.Application
+		pathToFile   string
+	}{manifestAppsCopy, pathToFile})
+	fake.recordInvocation("WriteApplicationManifests", []interface{}{manifestAppsCopy, pathToFile})
+	fake.writeApplicationManifestsMutex.Unlock()
+	if fake.WriteApplicationManifestsStub != nil {
+		return fake.WriteApplicationManifestsStub(manifestApps, pathToFile)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.writeApplicationManifestsReturns.result1
+}
+
+func (fake *FakeCreateAppManifestActor) WriteApplicationManifestsCallCount() int {
+	fake.writeApplicationManifestsMutex.RLock()
+	defer fake.writeApplicationManifestsMutex.RUnlock()
+	return len(fake.writeApplicationManifestsArgsForCall)
+}
+
+func (fake *FakeCreateAppManifestActor) WriteApplicationManifestsArgsForCall(i int) ([]manifest.Application, string) {
+	fake.writeApplicationManifestsMutex.RLock()
+	defer fake.writeApplicationManifestsMutex.RUnlock()
+	return fake.writeApplicationManifestsArgsForCall[i].manifestApps, fake.writeApplicationManifestsArgsForCall[i].pathToFile
+}
+
+func (fake *FakeCreateAppManifestActor) WriteApplicationManifestsReturns(result1 error) {
+	fake.WriteApplicationManifestsStub = nil
+	fake.writeApplicationManifestsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCreateAppManifestActor) WriteApplicationManifestsReturnsOnCall(i int, result1 error) {
+	fake.WriteApplicationManifestsStub = nil
+	if fake.writeApplicationManifestsReturnsOnCall == nil {
+		fake.writeApplicationManifestsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeApplicationManifestsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeCreateAppManifestActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.createApplicationManifestByNameAndSpaceMutex.RLock()
 	defer fake.createApplicationManifestByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationManifestByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationManifestByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	fake.writeApplicationManifestsMutex.RLock()
+	defer fake.writeApplicationManifestsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
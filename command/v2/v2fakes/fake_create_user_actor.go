@@ -26,6 +26,19 @@ type FakeCreateUserActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetUserOriginsStub        func(username string) ([]string, error)
+	getUserOriginsMutex       sync.RWMutex
+	getUserOriginsArgsForCall []struct {
+		username string
+	}
+	getUserOriginsReturns struct {
+		result1 []string
+		result2 error
+	}
+	getUserOriginsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -86,11 +99,64 @@ func (fake *FakeCreateUserActor) CreateUserReturnsOnCall(i int, result1 v2action
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCreateUserActor) GetUserOrigins(username string) ([]string, error) {
+	fake.getUserOriginsMutex.Lock()
+	ret, specificReturn := fake.getUserOriginsReturnsOnCall[len(fake.getUserOriginsArgsForCall)]
+	fake.getUserOriginsArgsForCall = append(fake.getUserOriginsArgsForCall, struct {
+		username string
+	}{username})
+	fake.recordInvocation("GetUserOrigins", []interface{}{username})
+	fake.getUserOriginsMutex.Unlock()
+	if fake.GetUserOriginsStub != nil {
+		return fake.GetUserOriginsStub(username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getUserOriginsReturns.result1, fake.getUserOriginsReturns.result2
+}
+
+func (fake *FakeCreateUserActor) GetUserOriginsCallCount() int {
+	fake.getUserOriginsMutex.RLock()
+	defer fake.getUserOriginsMutex.RUnlock()
+	return len(fake.getUserOriginsArgsForCall)
+}
+
+func (fake *FakeCreateUserActor) GetUserOriginsArgsForCall(i int) string {
+	fake.getUserOriginsMutex.RLock()
+	defer fake.getUserOriginsMutex.RUnlock()
+	return fake.getUserOriginsArgsForCall[i].username
+}
+
+func (fake *FakeCreateUserActor) GetUserOriginsReturns(result1 []string, result2 error) {
+	fake.GetUserOriginsStub = nil
+	fake.getUserOriginsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreateUserActor) GetUserOriginsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.GetUserOriginsStub = nil
+	if fake.getUserOriginsReturnsOnCall == nil {
+		fake.getUserOriginsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.getUserOriginsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCreateUserActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.getUserOriginsMutex.RLock()
+	defer fake.getUserOriginsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
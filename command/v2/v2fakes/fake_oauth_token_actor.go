@@ -4,6 +4,7 @@ package v2fakes
 import (
 	"sync"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/v2"
 )
 
@@ -21,6 +22,19 @@ type FakeOauthTokenActor struct {
 		result1 string
 		result2 error
 	}
+	DecodeAccessTokenStub        func(accessToken string) (v2action.TokenClaims, error)
+	decodeAccessTokenMutex       sync.RWMutex
+	decodeAccessTokenArgsForCall []struct {
+		accessToken string
+	}
+	decodeAccessTokenReturns struct {
+		result1 v2action.TokenClaims
+		result2 error
+	}
+	decodeAccessTokenReturnsOnCall map[int]struct {
+		result1 v2action.TokenClaims
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -76,11 +90,64 @@ func (fake *FakeOauthTokenActor) RefreshAccessTokenReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeOauthTokenActor) DecodeAccessToken(accessToken string) (v2action.TokenClaims, error) {
+	fake.decodeAccessTokenMutex.Lock()
+	ret, specificReturn := fake.decodeAccessTokenReturnsOnCall[len(fake.decodeAccessTokenArgsForCall)]
+	fake.decodeAccessTokenArgsForCall = append(fake.decodeAccessTokenArgsForCall, struct {
+		accessToken string
+	}{accessToken})
+	fake.recordInvocation("DecodeAccessToken", []interface{}{accessToken})
+	fake.decodeAccessTokenMutex.Unlock()
+	if fake.DecodeAccessTokenStub != nil {
+		return fake.DecodeAccessTokenStub(accessToken)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.decodeAccessTokenReturns.result1, fake.decodeAccessTokenReturns.result2
+}
+
+func (fake *FakeOauthTokenActor) DecodeAccessTokenCallCount() int {
+	fake.decodeAccessTokenMutex.RLock()
+	defer fake.decodeAccessTokenMutex.RUnlock()
+	return len(fake.decodeAccessTokenArgsForCall)
+}
+
+func (fake *FakeOauthTokenActor) DecodeAccessTokenArgsForCall(i int) string {
+	fake.decodeAccessTokenMutex.RLock()
+	defer fake.decodeAccessTokenMutex.RUnlock()
+	return fake.decodeAccessTokenArgsForCall[i].accessToken
+}
+
+func (fake *FakeOauthTokenActor) DecodeAccessTokenReturns(result1 v2action.TokenClaims, result2 error) {
+	fake.DecodeAccessTokenStub = nil
+	fake.decodeAccessTokenReturns = struct {
+		result1 v2action.TokenClaims
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOauthTokenActor) DecodeAccessTokenReturnsOnCall(i int, result1 v2action.TokenClaims, result2 error) {
+	fake.DecodeAccessTokenStub = nil
+	if fake.decodeAccessTokenReturnsOnCall == nil {
+		fake.decodeAccessTokenReturnsOnCall = make(map[int]struct {
+			result1 v2action.TokenClaims
+			result2 error
+		})
+	}
+	fake.decodeAccessTokenReturnsOnCall[i] = struct {
+		result1 v2action.TokenClaims
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeOauthTokenActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.refreshAccessTokenMutex.RLock()
 	defer fake.refreshAccessTokenMutex.RUnlock()
+	fake.decodeAccessTokenMutex.RLock()
+	defer fake.decodeAccessTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
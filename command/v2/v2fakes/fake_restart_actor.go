@@ -62,6 +62,45 @@ type FakeRestartActor struct {
 		result4 <-chan string
 		result5 <-chan error
 	}
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetApplicationLastUploadEventStub        func(appGUID string) (v2action.Event, v2action.Warnings, error)
+	getApplicationLastUploadEventMutex       sync.RWMutex
+	getApplicationLastUploadEventArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationLastUploadEventReturns struct {
+		result1 v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationLastUploadEventReturnsOnCall map[int]struct {
+		result1 v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationInstanceStatsByApplicationStub        func(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error)
+	getApplicationInstanceStatsByApplicationMutex       sync.RWMutex
+	getApplicationInstanceStatsByApplicationArgsForCall []struct {
+		guid string
+	}
+	getApplicationInstanceStatsByApplicationReturns struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationInstanceStatsByApplicationReturnsOnCall map[int]struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -238,6 +277,154 @@ func (fake *FakeRestartActor) RestartApplicationReturnsOnCall(i int, result1 <-c
 	}{result1, result2, result3, result4, result5}
 }
 
+func (fake *FakeRestartActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerAPIVersionReturns.result1
+}
+
+func (fake *FakeRestartActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeRestartActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRestartActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRestartActor) GetApplicationLastUploadEvent(appGUID string) (v2action.Event, v2action.Warnings, error) {
+	fake.getApplicationLastUploadEventMutex.Lock()
+	ret, specificReturn := fake.getApplicationLastUploadEventReturnsOnCall[len(fake.getApplicationLastUploadEventArgsForCall)]
+	fake.getApplicationLastUploadEventArgsForCall = append(fake.getApplicationLastUploadEventArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationLastUploadEvent", []interface{}{appGUID})
+	fake.getApplicationLastUploadEventMutex.Unlock()
+	if fake.GetApplicationLastUploadEventStub != nil {
+		return fake.GetApplicationLastUploadEventStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationLastUploadEventReturns.result1, fake.getApplicationLastUploadEventReturns.result2, fake.getApplicationLastUploadEventReturns.result3
+}
+
+func (fake *FakeRestartActor) GetApplicationLastUploadEventCallCount() int {
+	fake.getApplicationLastUploadEventMutex.RLock()
+	defer fake.getApplicationLastUploadEventMutex.RUnlock()
+	return len(fake.getApplicationLastUploadEventArgsForCall)
+}
+
+func (fake *FakeRestartActor) GetApplicationLastUploadEventArgsForCall(i int) string {
+	fake.getApplicationLastUploadEventMutex.RLock()
+	defer fake.getApplicationLastUploadEventMutex.RUnlock()
+	return fake.getApplicationLastUploadEventArgsForCall[i].appGUID
+}
+
+func (fake *FakeRestartActor) GetApplicationLastUploadEventReturns(result1 v2action.Event, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationLastUploadEventStub = nil
+	fake.getApplicationLastUploadEventReturns = struct {
+		result1 v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRestartActor) GetApplicationLastUploadEventReturnsOnCall(i int, result1 v2action.Event, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationLastUploadEventStub = nil
+	if fake.getApplicationLastUploadEventReturnsOnCall == nil {
+		fake.getApplicationLastUploadEventReturnsOnCall = make(map[int]struct {
+			result1 v2action.Event
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationLastUploadEventReturnsOnCall[i] = struct {
+		result1 v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRestartActor) GetApplicationInstanceStatsByApplication(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error) {
+	fake.getApplicationInstanceStatsByApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationInstanceStatsByApplicationReturnsOnCall[len(fake.getApplicationInstanceStatsByApplicationArgsForCall)]
+	fake.getApplicationInstanceStatsByApplicationArgsForCall = append(fake.getApplicationInstanceStatsByApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplicationInstanceStatsByApplication", []interface{}{guid})
+	fake.getApplicationInstanceStatsByApplicationMutex.Unlock()
+	if fake.GetApplicationInstanceStatsByApplicationStub != nil {
+		return fake.GetApplicationInstanceStatsByApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationInstanceStatsByApplicationReturns.result1, fake.getApplicationInstanceStatsByApplicationReturns.result2, fake.getApplicationInstanceStatsByApplicationReturns.result3
+}
+
+func (fake *FakeRestartActor) GetApplicationInstanceStatsByApplicationCallCount() int {
+	fake.getApplicationInstanceStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstanceStatsByApplicationMutex.RUnlock()
+	return len(fake.getApplicationInstanceStatsByApplicationArgsForCall)
+}
+
+func (fake *FakeRestartActor) GetApplicationInstanceStatsByApplicationArgsForCall(i int) string {
+	fake.getApplicationInstanceStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstanceStatsByApplicationMutex.RUnlock()
+	return fake.getApplicationInstanceStatsByApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeRestartActor) GetApplicationInstanceStatsByApplicationReturns(result1 []v2action.ApplicationInstanceWithStats, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstanceStatsByApplicationStub = nil
+	fake.getApplicationInstanceStatsByApplicationReturns = struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRestartActor) GetApplicationInstanceStatsByApplicationReturnsOnCall(i int, result1 []v2action.ApplicationInstanceWithStats, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstanceStatsByApplicationStub = nil
+	if fake.getApplicationInstanceStatsByApplicationReturnsOnCall == nil {
+		fake.getApplicationInstanceStatsByApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ApplicationInstanceWithStats
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationInstanceStatsByApplicationReturnsOnCall[i] = struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeRestartActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -247,6 +434,12 @@ func (fake *FakeRestartActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
 	fake.restartApplicationMutex.RLock()
 	defer fake.restartApplicationMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.getApplicationLastUploadEventMutex.RLock()
+	defer fake.getApplicationLastUploadEventMutex.RUnlock()
+	fake.getApplicationInstanceStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstanceStatsByApplicationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -18,19 +18,19 @@ type FakeSpaceActorV3 struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
-	GetEffectiveIsolationSegmentBySpaceStub        func(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
-	getEffectiveIsolationSegmentBySpaceMutex       sync.RWMutex
-	getEffectiveIsolationSegmentBySpaceArgsForCall []struct {
-		spaceGUID                      string
-		orgDefaultIsolationSegmentGUID string
+	GetSpaceIsolationSegmentStub        func(spaceGUID string, orgGUID string) (v3action.SpaceIsolationSegment, v3action.Warnings, error)
+	getSpaceIsolationSegmentMutex       sync.RWMutex
+	getSpaceIsolationSegmentArgsForCall []struct {
+		spaceGUID string
+		orgGUID   string
 	}
-	getEffectiveIsolationSegmentBySpaceReturns struct {
-		result1 v3action.IsolationSegment
+	getSpaceIsolationSegmentReturns struct {
+		result1 v3action.SpaceIsolationSegment
 		result2 v3action.Warnings
 		result3 error
 	}
-	getEffectiveIsolationSegmentBySpaceReturnsOnCall map[int]struct {
-		result1 v3action.IsolationSegment
+	getSpaceIsolationSegmentReturnsOnCall map[int]struct {
+		result1 v3action.SpaceIsolationSegment
 		result2 v3action.Warnings
 		result3 error
 	}
@@ -78,56 +78,56 @@ func (fake *FakeSpaceActorV3) CloudControllerAPIVersionReturnsOnCall(i int, resu
 	}{result1}
 }
 
-func (fake *FakeSpaceActorV3) GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error) {
-	fake.getEffectiveIsolationSegmentBySpaceMutex.Lock()
-	ret, specificReturn := fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall[len(fake.getEffectiveIsolationSegmentBySpaceArgsForCall)]
-	fake.getEffectiveIsolationSegmentBySpaceArgsForCall = append(fake.getEffectiveIsolationSegmentBySpaceArgsForCall, struct {
-		spaceGUID                      string
-		orgDefaultIsolationSegmentGUID string
-	}{spaceGUID, orgDefaultIsolationSegmentGUID})
-	fake.recordInvocation("GetEffectiveIsolationSegmentBySpace", []interface{}{spaceGUID, orgDefaultIsolationSegmentGUID})
-	fake.getEffectiveIsolationSegmentBySpaceMutex.Unlock()
-	if fake.GetEffectiveIsolationSegmentBySpaceStub != nil {
-		return fake.GetEffectiveIsolationSegmentBySpaceStub(spaceGUID, orgDefaultIsolationSegmentGUID)
+func (fake *FakeSpaceActorV3) GetSpaceIsolationSegment(spaceGUID string, orgGUID string) (v3action.SpaceIsolationSegment, v3action.Warnings, error) {
+	fake.getSpaceIsolationSegmentMutex.Lock()
+	ret, specificReturn := fake.getSpaceIsolationSegmentReturnsOnCall[len(fake.getSpaceIsolationSegmentArgsForCall)]
+	fake.getSpaceIsolationSegmentArgsForCall = append(fake.getSpaceIsolationSegmentArgsForCall, struct {
+		spaceGUID string
+		orgGUID   string
+	}{spaceGUID, orgGUID})
+	fake.recordInvocation("GetSpaceIsolationSegment", []interface{}{spaceGUID, orgGUID})
+	fake.getSpaceIsolationSegmentMutex.Unlock()
+	if fake.GetSpaceIsolationSegmentStub != nil {
+		return fake.GetSpaceIsolationSegmentStub(spaceGUID, orgGUID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getEffectiveIsolationSegmentBySpaceReturns.result1, fake.getEffectiveIsolationSegmentBySpaceReturns.result2, fake.getEffectiveIsolationSegmentBySpaceReturns.result3
+	return fake.getSpaceIsolationSegmentReturns.result1, fake.getSpaceIsolationSegmentReturns.result2, fake.getSpaceIsolationSegmentReturns.result3
 }
 
-func (fake *FakeSpaceActorV3) GetEffectiveIsolationSegmentBySpaceCallCount() int {
-	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
-	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
-	return len(fake.getEffectiveIsolationSegmentBySpaceArgsForCall)
+func (fake *FakeSpaceActorV3) GetSpaceIsolationSegmentCallCount() int {
+	fake.getSpaceIsolationSegmentMutex.RLock()
+	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
+	return len(fake.getSpaceIsolationSegmentArgsForCall)
 }
 
-func (fake *FakeSpaceActorV3) GetEffectiveIsolationSegmentBySpaceArgsForCall(i int) (string, string) {
-	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
-	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
-	return fake.getEffectiveIsolationSegmentBySpaceArgsForCall[i].spaceGUID, fake.getEffectiveIsolationSegmentBySpaceArgsForCall[i].orgDefaultIsolationSegmentGUID
+func (fake *FakeSpaceActorV3) GetSpaceIsolationSegmentArgsForCall(i int) (string, string) {
+	fake.getSpaceIsolationSegmentMutex.RLock()
+	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
+	return fake.getSpaceIsolationSegmentArgsForCall[i].spaceGUID, fake.getSpaceIsolationSegmentArgsForCall[i].orgGUID
 }
 
-func (fake *FakeSpaceActorV3) GetEffectiveIsolationSegmentBySpaceReturns(result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
-	fake.GetEffectiveIsolationSegmentBySpaceStub = nil
-	fake.getEffectiveIsolationSegmentBySpaceReturns = struct {
-		result1 v3action.IsolationSegment
+func (fake *FakeSpaceActorV3) GetSpaceIsolationSegmentReturns(result1 v3action.SpaceIsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetSpaceIsolationSegmentStub = nil
+	fake.getSpaceIsolationSegmentReturns = struct {
+		result1 v3action.SpaceIsolationSegment
 		result2 v3action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeSpaceActorV3) GetEffectiveIsolationSegmentBySpaceReturnsOnCall(i int, result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
-	fake.GetEffectiveIsolationSegmentBySpaceStub = nil
-	if fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall == nil {
-		fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall = make(map[int]struct {
-			result1 v3action.IsolationSegment
+func (fake *FakeSpaceActorV3) GetSpaceIsolationSegmentReturnsOnCall(i int, result1 v3action.SpaceIsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetSpaceIsolationSegmentStub = nil
+	if fake.getSpaceIsolationSegmentReturnsOnCall == nil {
+		fake.getSpaceIsolationSegmentReturnsOnCall = make(map[int]struct {
+			result1 v3action.SpaceIsolationSegment
 			result2 v3action.Warnings
 			result3 error
 		})
 	}
-	fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall[i] = struct {
-		result1 v3action.IsolationSegment
+	fake.getSpaceIsolationSegmentReturnsOnCall[i] = struct {
+		result1 v3action.SpaceIsolationSegment
 		result2 v3action.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -138,8 +138,8 @@ func (fake *FakeSpaceActorV3) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
-	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
-	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	fake.getSpaceIsolationSegmentMutex.RLock()
+	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -3,6 +3,7 @@ package v2fakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command/v2"
@@ -62,6 +63,81 @@ type FakeStartActor struct {
 		result4 <-chan string
 		result5 <-chan error
 	}
+	GetApplicationRoutesStub        func(applicationGUID string) (v2action.Routes, v2action.Warnings, error)
+	getApplicationRoutesMutex       sync.RWMutex
+	getApplicationRoutesArgsForCall []struct {
+		applicationGUID string
+	}
+	getApplicationRoutesReturns struct {
+		result1 v2action.Routes
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationRoutesReturnsOnCall map[int]struct {
+		result1 v2action.Routes
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationLastUploadEventStub        func(appGUID string) (v2action.Event, v2action.Warnings, error)
+	getApplicationLastUploadEventMutex       sync.RWMutex
+	getApplicationLastUploadEventArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationLastUploadEventReturns struct {
+		result1 v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationLastUploadEventReturnsOnCall map[int]struct {
+		result1 v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationLastCrashStub        func(appGUID string) (v2action.ApplicationCrash, v2action.Warnings, error)
+	getApplicationLastCrashMutex       sync.RWMutex
+	getApplicationLastCrashArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationLastCrashReturns struct {
+		result1 v2action.ApplicationCrash
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationLastCrashReturnsOnCall map[int]struct {
+		result1 v2action.ApplicationCrash
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetRecentLogsForApplicationStub        func(appGUID string, client v2action.NOAAClient, timeout time.Duration) ([]v2action.LogMessage, error)
+	getRecentLogsForApplicationMutex       sync.RWMutex
+	getRecentLogsForApplicationArgsForCall []struct {
+		appGUID string
+		client  v2action.NOAAClient
+		timeout time.Duration
+	}
+	getRecentLogsForApplicationReturns struct {
+		result1 []v2action.LogMessage
+		result2 error
+	}
+	getRecentLogsForApplicationReturnsOnCall map[int]struct {
+		result1 []v2action.LogMessage
+		result2 error
+	}
+	GetApplicationInstanceStatsByApplicationStub        func(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error)
+	getApplicationInstanceStatsByApplicationMutex       sync.RWMutex
+	getApplicationInstanceStatsByApplicationArgsForCall []struct {
+		guid string
+	}
+	getApplicationInstanceStatsByApplicationReturns struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationInstanceStatsByApplicationReturnsOnCall map[int]struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -238,6 +314,275 @@ func (fake *FakeStartActor) StartApplicationReturnsOnCall(i int, result1 <-chan
 	}{result1, result2, result3, result4, result5}
 }
 
+func (fake *FakeStartActor) GetApplicationRoutes(applicationGUID string) (v2action.Routes, v2action.Warnings, error) {
+	fake.getApplicationRoutesMutex.Lock()
+	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
+	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
+		applicationGUID string
+	}{applicationGUID})
+	fake.recordInvocation("GetApplicationRoutes", []interface{}{applicationGUID})
+	fake.getApplicationRoutesMutex.Unlock()
+	if fake.GetApplicationRoutesStub != nil {
+		return fake.GetApplicationRoutesStub(applicationGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationRoutesReturns.result1, fake.getApplicationRoutesReturns.result2, fake.getApplicationRoutesReturns.result3
+}
+
+func (fake *FakeStartActor) GetApplicationRoutesCallCount() int {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return len(fake.getApplicationRoutesArgsForCall)
+}
+
+func (fake *FakeStartActor) GetApplicationRoutesArgsForCall(i int) string {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return fake.getApplicationRoutesArgsForCall[i].applicationGUID
+}
+
+func (fake *FakeStartActor) GetApplicationRoutesReturns(result1 v2action.Routes, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationRoutesStub = nil
+	fake.getApplicationRoutesReturns = struct {
+		result1 v2action.Routes
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStartActor) GetApplicationRoutesReturnsOnCall(i int, result1 v2action.Routes, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationRoutesStub = nil
+	if fake.getApplicationRoutesReturnsOnCall == nil {
+		fake.getApplicationRoutesReturnsOnCall = make(map[int]struct {
+			result1 v2action.Routes
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationRoutesReturnsOnCall[i] = struct {
+		result1 v2action.Routes
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStartActor) GetApplicationLastUploadEvent(appGUID string) (v2action.Event, v2action.Warnings, error) {
+	fake.getApplicationLastUploadEventMutex.Lock()
+	ret, specificReturn := fake.getApplicationLastUploadEventReturnsOnCall[len(fake.getApplicationLastUploadEventArgsForCall)]
+	fake.getApplicationLastUploadEventArgsForCall = append(fake.getApplicationLastUploadEventArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationLastUploadEvent", []interface{}{appGUID})
+	fake.getApplicationLastUploadEventMutex.Unlock()
+	if fake.GetApplicationLastUploadEventStub != nil {
+		return fake.GetApplicationLastUploadEventStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationLastUploadEventReturns.result1, fake.getApplicationLastUploadEventReturns.result2, fake.getApplicationLastUploadEventReturns.result3
+}
+
+func (fake *FakeStartActor) GetApplicationLastUploadEventCallCount() int {
+	fake.getApplicationLastUploadEventMutex.RLock()
+	defer fake.getApplicationLastUploadEventMutex.RUnlock()
+	return len(fake.getApplicationLastUploadEventArgsForCall)
+}
+
+func (fake *FakeStartActor) GetApplicationLastUploadEventArgsForCall(i int) string {
+	fake.getApplicationLastUploadEventMutex.RLock()
+	defer fake.getApplicationLastUploadEventMutex.RUnlock()
+	return fake.getApplicationLastUploadEventArgsForCall[i].appGUID
+}
+
+func (fake *FakeStartActor) GetApplicationLastUploadEventReturns(result1 v2action.Event, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationLastUploadEventStub = nil
+	fake.getApplicationLastUploadEventReturns = struct {
+		result1 v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStartActor) GetApplicationLastUploadEventReturnsOnCall(i int, result1 v2action.Event, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationLastUploadEventStub = nil
+	if fake.getApplicationLastUploadEventReturnsOnCall == nil {
+		fake.getApplicationLastUploadEventReturnsOnCall = make(map[int]struct {
+			result1 v2action.Event
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationLastUploadEventReturnsOnCall[i] = struct {
+		result1 v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStartActor) GetApplicationLastCrash(appGUID string) (v2action.ApplicationCrash, v2action.Warnings, error) {
+	fake.getApplicationLastCrashMutex.Lock()
+	ret, specificReturn := fake.getApplicationLastCrashReturnsOnCall[len(fake.getApplicationLastCrashArgsForCall)]
+	fake.getApplicationLastCrashArgsForCall = append(fake.getApplicationLastCrashArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationLastCrash", []interface{}{appGUID})
+	fake.getApplicationLastCrashMutex.Unlock()
+	if fake.GetApplicationLastCrashStub != nil {
+		return fake.GetApplicationLastCrashStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationLastCrashReturns.result1, fake.getApplicationLastCrashReturns.result2, fake.getApplicationLastCrashReturns.result3
+}
+
+func (fake *FakeStartActor) GetApplicationLastCrashCallCount() int {
+	fake.getApplicationLastCrashMutex.RLock()
+	defer fake.getApplicationLastCrashMutex.RUnlock()
+	return len(fake.getApplicationLastCrashArgsForCall)
+}
+
+func (fake *FakeStartActor) GetApplicationLastCrashArgsForCall(i int) string {
+	fake.getApplicationLastCrashMutex.RLock()
+	defer fake.getApplicationLastCrashMutex.RUnlock()
+	return fake.getApplicationLastCrashArgsForCall[i].appGUID
+}
+
+func (fake *FakeStartActor) GetApplicationLastCrashReturns(result1 v2action.ApplicationCrash, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationLastCrashStub = nil
+	fake.getApplicationLastCrashReturns = struct {
+		result1 v2action.ApplicationCrash
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStartActor) GetApplicationLastCrashReturnsOnCall(i int, result1 v2action.ApplicationCrash, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationLastCrashStub = nil
+	if fake.getApplicationLastCrashReturnsOnCall == nil {
+		fake.getApplicationLastCrashReturnsOnCall = make(map[int]struct {
+			result1 v2action.ApplicationCrash
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationLastCrashReturnsOnCall[i] = struct {
+		result1 v2action.ApplicationCrash
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStartActor) GetRecentLogsForApplication(appGUID string, client v2action.NOAAClient, timeout time.Duration) ([]v2action.LogMessage, error) {
+	fake.getRecentLogsForApplicationMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsForApplicationReturnsOnCall[len(fake.getRecentLogsForApplicationArgsForCall)]
+	fake.getRecentLogsForApplicationArgsForCall = append(fake.getRecentLogsForApplicationArgsForCall, struct {
+		appGUID string
+		client  v2action.NOAAClient
+		timeout time.Duration
+	}{appGUID, client, timeout})
+	fake.recordInvocation("GetRecentLogsForApplication", []interface{}{appGUID, client, timeout})
+	fake.getRecentLogsForApplicationMutex.Unlock()
+	if fake.GetRecentLogsForApplicationStub != nil {
+		return fake.GetRecentLogsForApplicationStub(appGUID, client, timeout)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getRecentLogsForApplicationReturns.result1, fake.getRecentLogsForApplicationReturns.result2
+}
+
+func (fake *FakeStartActor) GetRecentLogsForApplicationCallCount() int {
+	fake.getRecentLogsForApplicationMutex.RLock()
+	defer fake.getRecentLogsForApplicationMutex.RUnlock()
+	return len(fake.getRecentLogsForApplicationArgsForCall)
+}
+
+func (fake *FakeStartActor) GetRecentLogsForApplicationArgsForCall(i int) (string, v2action.NOAAClient, time.Duration) {
+	fake.getRecentLogsForApplicationMutex.RLock()
+	defer fake.getRecentLogsForApplicationMutex.RUnlock()
+	return fake.getRecentLogsForApplicationArgsForCall[i].appGUID, fake.getRecentLogsForApplicationArgsForCall[i].client, fake.getRecentLogsForApplicationArgsForCall[i].timeout
+}
+
+func (fake *FakeStartActor) GetRecentLogsForApplicationReturns(result1 []v2action.LogMessage, result2 error) {
+	fake.GetRecentLogsForApplicationStub = nil
+	fake.getRecentLogsForApplicationReturns = struct {
+		result1 []v2action.LogMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStartActor) GetRecentLogsForApplicationReturnsOnCall(i int, result1 []v2action.LogMessage, result2 error) {
+	fake.GetRecentLogsForApplicationStub = nil
+	if fake.getRecentLogsForApplicationReturnsOnCall == nil {
+		fake.getRecentLogsForApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v2action.LogMessage
+			result2 error
+		})
+	}
+	fake.getRecentLogsForApplicationReturnsOnCall[i] = struct {
+		result1 []v2action.LogMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStartActor) GetApplicationInstanceStatsByApplication(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error) {
+	fake.getApplicationInstanceStatsByApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationInstanceStatsByApplicationReturnsOnCall[len(fake.getApplicationInstanceStatsByApplicationArgsForCall)]
+	fake.getApplicationInstanceStatsByApplicationArgsForCall = append(fake.getApplicationInstanceStatsByApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplicationInstanceStatsByApplication", []interface{}{guid})
+	fake.getApplicationInstanceStatsByApplicationMutex.Unlock()
+	if fake.GetApplicationInstanceStatsByApplicationStub != nil {
+		return fake.GetApplicationInstanceStatsByApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationInstanceStatsByApplicationReturns.result1, fake.getApplicationInstanceStatsByApplicationReturns.result2, fake.getApplicationInstanceStatsByApplicationReturns.result3
+}
+
+func (fake *FakeStartActor) GetApplicationInstanceStatsByApplicationCallCount() int {
+	fake.getApplicationInstanceStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstanceStatsByApplicationMutex.RUnlock()
+	return len(fake.getApplicationInstanceStatsByApplicationArgsForCall)
+}
+
+func (fake *FakeStartActor) GetApplicationInstanceStatsByApplicationArgsForCall(i int) string {
+	fake.getApplicationInstanceStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstanceStatsByApplicationMutex.RUnlock()
+	return fake.getApplicationInstanceStatsByApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeStartActor) GetApplicationInstanceStatsByApplicationReturns(result1 []v2action.ApplicationInstanceWithStats, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstanceStatsByApplicationStub = nil
+	fake.getApplicationInstanceStatsByApplicationReturns = struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStartActor) GetApplicationInstanceStatsByApplicationReturnsOnCall(i int, result1 []v2action.ApplicationInstanceWithStats, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstanceStatsByApplicationStub = nil
+	if fake.getApplicationInstanceStatsByApplicationReturnsOnCall == nil {
+		fake.getApplicationInstanceStatsByApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ApplicationInstanceWithStats
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationInstanceStatsByApplicationReturnsOnCall[i] = struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeStartActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -247,6 +592,16 @@ func (fake *FakeStartActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationLastUploadEventMutex.RLock()
+	defer fake.getApplicationLastUploadEventMutex.RUnlock()
+	fake.getApplicationLastCrashMutex.RLock()
+	defer fake.getApplicationLastCrashMutex.RUnlock()
+	fake.getRecentLogsForApplicationMutex.RLock()
+	defer fake.getRecentLogsForApplicationMutex.RUnlock()
+	fake.getApplicationInstanceStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstanceStatsByApplicationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -116,9 +116,10 @@ var _ = Describe("add-network-policy Command", func() {
 				It("displays OK when no error occurs", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
 					Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(1))
-					passedSpaceGuid, passedSrcAppName, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
+					passedSpaceGuid, passedSrcAppName, passedDestSpaceGuid, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
 					Expect(passedSpaceGuid).To(Equal("some-space-guid"))
 					Expect(passedSrcAppName).To(Equal("some-app"))
+					Expect(passedDestSpaceGuid).To(Equal("some-space-guid"))
 					Expect(passedDestAppName).To(Equal("some-other-app"))
 					Expect(passedProtocol).To(Equal("tcp"))
 					Expect(passedStartPort).To(Equal(8080))
@@ -147,12 +148,67 @@ var _ = Describe("add-network-policy Command", func() {
 			})
 		})
 
+		Context("when the destination app is in another space", func() {
+			BeforeEach(func() {
+				cmd.DestinationSpace = "other-space"
+				fakeActor.GetSpaceGUIDByNameAndOrganizationNameReturns("other-space-guid", cfnetworkingaction.Warnings{"space-warning"}, nil)
+			})
+
+			It("looks up the space in the targeted org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetSpaceGUIDByNameAndOrganizationNameCallCount()).To(Equal(1))
+				spaceName, orgName := fakeActor.GetSpaceGUIDByNameAndOrganizationNameArgsForCall(0)
+				Expect(spaceName).To(Equal("other-space"))
+				Expect(orgName).To(Equal("some-org"))
+
+				passedSpaceGuid, _, passedDestSpaceGuid, _, _, _, _ := fakeActor.AddNetworkPolicyArgsForCall(0)
+				Expect(passedSpaceGuid).To(Equal("some-space-guid"))
+				Expect(passedDestSpaceGuid).To(Equal("other-space-guid"))
+				Expect(testUI.Err).To(Say("space-warning"))
+			})
+
+			Context("when the destination org is given", func() {
+				BeforeEach(func() {
+					cmd.DestinationOrg = "other-org"
+				})
+
+				It("looks up the space in that org", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					_, orgName := fakeActor.GetSpaceGUIDByNameAndOrganizationNameArgsForCall(0)
+					Expect(orgName).To(Equal("other-org"))
+				})
+			})
+
+			Context("when the space cannot be found", func() {
+				BeforeEach(func() {
+					fakeActor.GetSpaceGUIDByNameAndOrganizationNameReturns("", nil, v3action.SpaceNotFoundError{Name: "other-space"})
+				})
+
+				It("returns the error without changing policies", func() {
+					Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "other-space"}))
+					Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the destination org is given without the destination space", func() {
+			BeforeEach(func() {
+				cmd.DestinationOrg = "other-org"
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "-o", Arg2: "-s"}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("when both protocol and port are not specified", func() {
 			It("defaults protocol to 'tcp' and port to '8080'", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 
 				Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(1))
-				_, _, _, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
+				_, _, _, _, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
 				Expect(passedProtocol).To(Equal("tcp"))
 				Expect(passedStartPort).To(Equal(8080))
 				Expect(passedEndPort).To(Equal(8080))
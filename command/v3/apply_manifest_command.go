@@ -0,0 +1,185 @@
+package v3
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+//go:generate counterfeiter . ApplyManifestActor
+
+type ApplyManifestActor interface {
+	CloudControllerAPIVersion() string
+	ApplyApplicationManifest(spaceGUID string, rawManifest []byte) (v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . ApplyManifestActorV2
+
+type ApplyManifestActorV2 interface {
+	GetApplicationManifestByNameAndSpace(appName string, spaceGUID string) (manifest.Application, v2action.Warnings, error)
+}
+
+type ApplyManifestCommand struct {
+	PathToManifest  flag.PathWithExistenceCheck `short:"f" description:"Path to app manifest" required:"true"`
+	Force           bool                        `long:"force" description:"Apply the manifest without asking for confirmation"`
+	usage           interface{}                 `usage:"CF_NAME apply-manifest -f APP_MANIFEST_PATH [--force]"`
+	relatedCommands interface{}                 `related_commands:"create-app-manifest, push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyManifestActor
+	ActorV2     ApplyManifestActorV2
+}
+
+func (cmd *ApplyManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionApplyManifestV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.ActorV2 = v2action.NewActor(ccClientV2, uaaClientV2, config)
+
+	return nil
+}
+
+func (cmd ApplyManifestCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionApplyManifestV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	pathToManifest := string(cmd.PathToManifest)
+	apps, err := manifest.ReadAndMergeManifests(pathToManifest)
+	if err != nil {
+		return err
+	}
+
+	rawManifest, err := ioutil.ReadFile(pathToManifest)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	cmd.UI.DisplayTextWithFlavor("Comparing manifest {{.ManifestPath}} with the apps in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ManifestPath": pathToManifest,
+		"OrgName":      cmd.Config.TargetedOrganization().Name,
+		"SpaceName":    space.Name,
+		"CurrentUser":  user.Name,
+	})
+
+	var hasChanges bool
+	for _, app := range apps {
+		appHasChanges, diffErr := cmd.displayAppChanges(app, space.GUID)
+		if diffErr != nil {
+			return diffErr
+		}
+		hasChanges = hasChanges || appHasChanges
+	}
+
+	cmd.UI.DisplayNewline()
+	if !hasChanges {
+		cmd.UI.DisplayText("No changes to apply.")
+		return nil
+	}
+
+	if !cmd.Force {
+		apply, promptErr := cmd.UI.DisplayBoolPrompt(false, "Apply these changes?")
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !apply {
+			cmd.UI.DisplayText("Manifest not applied.")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying manifest {{.ManifestPath}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"ManifestPath": pathToManifest,
+		"OrgName":      cmd.Config.TargetedOrganization().Name,
+		"SpaceName":    space.Name,
+		"CurrentUser":  user.Name,
+	})
+
+	warnings, err := cmd.Actor.ApplyApplicationManifest(space.GUID, rawManifest)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// displayAppChanges prints how applying the manifest would change the app
+// and returns whether there is anything to change.
+func (cmd ApplyManifestCommand) displayAppChanges(desired manifest.Application, spaceGUID string) (bool, error) {
+	current, warnings, err := cmd.ActorV2.GetApplicationManifestByNameAndSpace(desired.Name, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+
+	cmd.UI.DisplayNewline()
+	switch err.(type) {
+	case nil:
+		cmd.UI.DisplayText("Updating app {{.AppName}}:", map[string]interface{}{
+			"AppName": desired.Name,
+		})
+	case actionerror.ApplicationNotFoundError:
+		current = manifest.Application{}
+		cmd.UI.DisplayText("Creating app {{.AppName}}:", map[string]interface{}{
+			"AppName": desired.Name,
+		})
+	default:
+		return false, sharedV2.HandleError(err)
+	}
+
+	changes := shared.GetManifestChanges(current, desired)
+	if !shared.ManifestHasChanges(changes) {
+		cmd.UI.DisplayText("No changes")
+		return false, nil
+	}
+
+	err = cmd.UI.DisplayChangesForPush(changes)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,252 @@
+package v3_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-manifest Command", func() {
+	var (
+		cmd             v3.ApplyManifestCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeApplyManifestActor
+		fakeActorV2     *v3fakes.FakeApplyManifestActorV2
+		binaryName      string
+		manifestPath    string
+		rawManifest     string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeApplyManifestActor)
+		fakeActorV2 = new(v3fakes.FakeApplyManifestActorV2)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionApplyManifestV3)
+
+		rawManifest = `applications:
+- name: some-app
+  memory: 1G
+  instances: 2
+- name: new-app
+  instances: 1
+`
+		manifestFile, err := ioutil.TempFile("", "apply-manifest-test-")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = manifestFile.WriteString(rawManifest)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifestFile.Close()).To(Succeed())
+		manifestPath = manifestFile.Name()
+
+		cmd = v3.ApplyManifestCommand{
+			PathToManifest: flag.PathWithExistenceCheck(manifestPath),
+			UI:             testUI,
+			Config:         fakeConfig,
+			SharedActor:    fakeSharedActor,
+			Actor:          fakeActor,
+			ActorV2:        fakeActorV2,
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(manifestPath)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionApplyManifestV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and a space and org are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+			fakeActorV2.GetApplicationManifestByNameAndSpaceStub = func(appName string, _ string) (manifest.Application, v2action.Warnings, error) {
+				if appName == "new-app" {
+					return manifest.Application{}, v2action.Warnings{"new-app-warning"}, actionerror.ApplicationNotFoundError{Name: appName}
+				}
+				return manifest.Application{
+					Name:      appName,
+					Memory:    types.NullByteSizeInMb{Value: 512, IsSet: true},
+					Instances: types.NullInt{Value: 2, IsSet: true},
+				}, v2action.Warnings{"some-app-warning"}, nil
+			}
+			fakeActor.ApplyApplicationManifestReturns(v3action.Warnings{"apply-warning"}, nil)
+		})
+
+		It("displays the changes for every app in the manifest", func() {
+			Expect(testUI.Out).To(Say("Comparing manifest %s with the apps in org some-org / space some-space as some-user...", manifestPath))
+			Expect(testUI.Out).To(Say("Updating app some-app:"))
+			Expect(testUI.Out).To(Say(`-\s+memory:\s+512M`))
+			Expect(testUI.Out).To(Say(`\+\s+memory:\s+1G`))
+			Expect(testUI.Out).To(Say("Creating app new-app:"))
+			Expect(testUI.Out).To(Say(`\+\s+name:\s+new-app`))
+			Expect(testUI.Out).To(Say(`\+\s+instances:\s+1`))
+
+			Expect(testUI.Err).To(Say("some-app-warning"))
+			Expect(testUI.Err).To(Say("new-app-warning"))
+
+			appName, spaceGUID := fakeActorV2.GetApplicationManifestByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+
+		Context("when the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("applies the manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Apply these changes\? \[yN\]`))
+				Expect(testUI.Out).To(Say("Applying manifest %s in org some-org / space some-space as some-user...", manifestPath))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+
+				Expect(fakeActor.ApplyApplicationManifestCallCount()).To(Equal(1))
+				spaceGUID, passedManifest := fakeActor.ApplyApplicationManifestArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(string(passedManifest)).To(Equal(rawManifest))
+			})
+
+			Context("when applying the manifest fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("apply error")
+					fakeActor.ApplyApplicationManifestReturns(v3action.Warnings{"apply-warning"}, expectedErr)
+				})
+
+				It("returns the error and displays the warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("apply-warning"))
+				})
+			})
+		})
+
+		Context("when the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not apply the manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Manifest not applied."))
+				Expect(fakeActor.ApplyApplicationManifestCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when --force is passed", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("applies the manifest without asking", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Apply these changes"))
+				Expect(fakeActor.ApplyApplicationManifestCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the manifest does not change anything", func() {
+			BeforeEach(func() {
+				fakeActorV2.GetApplicationManifestByNameAndSpaceStub = func(appName string, _ string) (manifest.Application, v2action.Warnings, error) {
+					app := manifest.Application{
+						Name:      appName,
+						Instances: types.NullInt{Value: 1, IsSet: true},
+						Memory:    types.NullByteSizeInMb{Value: 1024, IsSet: true},
+					}
+					if appName == "some-app" {
+						app.Instances.Value = 2
+					}
+					return app, nil, nil
+				}
+			})
+
+			It("displays no changes and does not apply the manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Updating app some-app:"))
+				Expect(testUI.Out).To(Say("No changes"))
+				Expect(testUI.Out).To(Say("Updating app new-app:"))
+				Expect(testUI.Out).To(Say("No changes"))
+				Expect(testUI.Out).To(Say("No changes to apply."))
+				Expect(testUI.Out).ToNot(Say("Apply these changes"))
+				Expect(fakeActor.ApplyApplicationManifestCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting an app's current settings fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get manifest error")
+				fakeActorV2.GetApplicationManifestByNameAndSpaceReturns(manifest.Application{}, v2action.Warnings{"get-warning"}, expectedErr)
+				fakeActorV2.GetApplicationManifestByNameAndSpaceStub = nil
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("get-warning"))
+				Expect(fakeActor.ApplyApplicationManifestCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,111 @@
+package v3
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . BuildActor
+
+type BuildActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationBuild(appName string, spaceGUID string, buildGUID string) (v3action.Build, v3action.Warnings, error)
+}
+
+type BuildCommand struct {
+	RequiredArgs    flag.AppBuild `positional-args:"yes"`
+	usage           interface{}   `usage:"CF_NAME build APP_NAME BUILD_GUID"`
+	relatedCommands interface{}   `related_commands:"builds, droplets, logs"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       BuildActor
+}
+
+func (cmd *BuildCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config)
+
+	return nil
+}
+
+func (cmd BuildCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting build {{.BuildGUID}} of app {{.AppName}} in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
+		"BuildGUID":    cmd.RequiredArgs.BuildGUID,
+		"AppName":      cmd.RequiredArgs.AppName,
+		"CurrentSpace": cmd.Config.TargetedSpace().Name,
+		"CurrentOrg":   cmd.Config.TargetedOrganization().Name,
+		"CurrentUser":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	build, warnings, err := cmd.Actor.GetApplicationBuild(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.BuildGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	created, err := time.Parse(time.RFC3339, build.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("guid:"), build.GUID},
+		{cmd.UI.TranslateText("state:"), cmd.UI.TranslateText(strings.ToLower(string(build.State)))},
+		{cmd.UI.TranslateText("created:"), cmd.UI.UserFriendlyDate(created)},
+		{cmd.UI.TranslateText("buildpacks:"), strings.Join(build.Buildpacks, ", ")},
+		{cmd.UI.TranslateText("droplet:"), build.DropletGUID},
+		{cmd.UI.TranslateText("error:"), build.Error},
+	}, 3)
+
+	if build.State != ccv3.BuildStateFailed {
+		return nil
+	}
+
+	tip, tipValues, ok := translatableerror.StagingFailureTip(build.ErrorCode(), cmd.Config.BinaryName(), cmd.RequiredArgs.AppName)
+	if ok {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText(tip, tipValues)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package v3_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("build Command", func() {
+	var (
+		cmd             v3.BuildCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeBuildActor
+		binaryName      string
+		executeErr      error
+		createdAt       string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeBuildActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.BuildCommand{
+			RequiredArgs: flag.AppBuild{AppName: "some-app", BuildGUID: "some-build-guid"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			Actor:        fakeActor,
+			SharedActor:  fakeSharedActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+		createdAt = "2017-08-16T00:18:24Z"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.GetApplicationBuildCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app does not have the build", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationBuildReturns(v3action.Build{}, v3action.Warnings{"warning-1"}, v3action.BuildNotFoundError{GUID: "some-build-guid"})
+		})
+
+		It("returns a BuildNotFoundError and prints warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.BuildNotFoundError{GUID: "some-build-guid"}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	Context("when the build staged", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationBuildReturns(v3action.Build{
+				GUID:        "some-build-guid",
+				State:       ccv3.BuildStateStaged,
+				CreatedAt:   createdAt,
+				Buildpacks:  []string{"ruby_buildpack"},
+				DropletGUID: "some-droplet-guid",
+			}, v3action.Warnings{"warning-1", "warning-2"}, nil)
+		})
+
+		It("displays the build and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			createdAtParsed, err := time.Parse(time.RFC3339, createdAt)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting build some-build-guid of app some-app in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Out).To(Say("guid:\\s+some-build-guid"))
+			Expect(testUI.Out).To(Say("state:\\s+staged"))
+			Expect(testUI.Out).To(Say("created:\\s+%s", testUI.UserFriendlyDate(createdAtParsed)))
+			Expect(testUI.Out).To(Say("buildpacks:\\s+ruby_buildpack"))
+			Expect(testUI.Out).To(Say("droplet:\\s+some-droplet-guid"))
+			Expect(testUI.Out).To(Say("error:"))
+			Expect(testUI.Out).ToNot(Say("TIP"))
+
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+
+			appName, spaceGUID, buildGUID := fakeActor.GetApplicationBuildArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(buildGUID).To(Equal("some-build-guid"))
+		})
+	})
+
+	Context("when the build failed", func() {
+		var buildError string
+
+		BeforeEach(func() {
+			buildError = "NoAppDetectedError - An app was not successfully detected by any available buildpack"
+			fakeActor.GetApplicationBuildStub = func(string, string, string) (v3action.Build, v3action.Warnings, error) {
+				return v3action.Build{
+					GUID:      "some-build-guid",
+					State:     ccv3.BuildStateFailed,
+					CreatedAt: createdAt,
+					Error:     buildError,
+				}, nil, nil
+			}
+		})
+
+		Context("because no buildpack detected the app", func() {
+			It("displays the full error and a tip to list the buildpacks", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("state:\\s+failed"))
+				Expect(testUI.Out).To(Say("error:\\s+NoAppDetectedError - An app was not successfully detected by any available buildpack"))
+				Expect(testUI.Out).To(Say("TIP: Use 'faceman buildpacks' to see a list of supported buildpacks\\."))
+			})
+		})
+
+		Context("because the buildpack failed to compile the app", func() {
+			BeforeEach(func() {
+				buildError = "BuildpackCompileFailed - App staging failed in the buildpack compile phase"
+			})
+
+			It("displays the full error and a tip to look at the logs", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("error:\\s+BuildpackCompileFailed - App staging failed in the buildpack compile phase"))
+				Expect(testUI.Out).To(Say("TIP: Use 'faceman logs some-app --recent' to see the output of the buildpack's compile step\\."))
+			})
+		})
+
+		Context("because of an error without a tip", func() {
+			BeforeEach(func() {
+				buildError = "StagingError - Staging error: staging failed"
+			})
+
+			It("displays the full error only", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("error:\\s+StagingError - Staging error: staging failed"))
+				Expect(testUI.Out).ToNot(Say("TIP"))
+			})
+		})
+	})
+})
@@ -0,0 +1,119 @@
+package v3
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . BuildsActor
+
+type BuildsActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationBuilds(appName string, spaceGUID string) ([]v3action.Build, v3action.Warnings, error)
+}
+
+type BuildsCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME builds APP_NAME"`
+	relatedCommands interface{}  `related_commands:"build, droplets, logs"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       BuildsActor
+}
+
+func (cmd *BuildsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config)
+
+	return nil
+}
+
+func (cmd BuildsCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Listing builds of app {{.AppName}} in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":      cmd.RequiredArgs.AppName,
+		"CurrentSpace": cmd.Config.TargetedSpace().Name,
+		"CurrentOrg":   cmd.Config.TargetedOrganization().Name,
+		"CurrentUser":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	builds, warnings, err := cmd.Actor.GetApplicationBuilds(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(builds) == 0 {
+		cmd.UI.DisplayText("No builds found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("guid"),
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("created"),
+			cmd.UI.TranslateText("buildpacks"),
+			cmd.UI.TranslateText("droplet"),
+			cmd.UI.TranslateText("error"),
+		},
+	}
+
+	for _, build := range builds {
+		t, err := time.Parse(time.RFC3339, build.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		table = append(table, []string{
+			build.GUID,
+			cmd.UI.TranslateText(strings.ToLower(string(build.State))),
+			cmd.UI.UserFriendlyDate(t),
+			strings.Join(build.Buildpacks, ", "),
+			build.DropletGUID,
+			build.ErrorCode(),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
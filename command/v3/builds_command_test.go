@@ -0,0 +1,189 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("builds Command", func() {
+	var (
+		cmd             v3.BuildsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeBuildsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeBuildsActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.BuildsCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			Actor:        fakeActor,
+			SharedActor:  fakeSharedActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is not logged in", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some current user error")
+			fakeConfig.CurrentUserReturns(configv3.User{}, expectedErr)
+		})
+
+		It("return an error", func() {
+			Expect(executeErr).To(Equal(expectedErr))
+		})
+	})
+
+	Context("when getting the application builds returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationBuildsReturns(nil, v3action.Warnings{"warning-1", "warning-2"}, ccerror.RequestError{})
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(Equal(translatableerror.APIRequestError{}))
+
+			Expect(testUI.Out).To(Say("Listing builds of app some-app in org some-org / space some-space as steve\\.\\.\\."))
+
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+		})
+	})
+
+	Context("when getting the application builds returns some builds", func() {
+		var createdAtOne, createdAtTwo string
+
+		BeforeEach(func() {
+			createdAtOne = "2017-08-16T00:18:24Z"
+			createdAtTwo = "2017-08-14T21:16:42Z"
+			builds := []v3action.Build{
+				{
+					GUID:        "some-build-guid-1",
+					State:       ccv3.BuildStateStaged,
+					CreatedAt:   createdAtOne,
+					Buildpacks:  []string{"ruby_buildpack", "nodejs_buildpack"},
+					DropletGUID: "some-droplet-guid",
+				},
+				{
+					GUID:      "some-build-guid-2",
+					State:     ccv3.BuildStateFailed,
+					CreatedAt: createdAtTwo,
+					Error:     "NoAppDetectedError - An app was not successfully detected by any available buildpack",
+				},
+			}
+			fakeActor.GetApplicationBuildsReturns(builds, v3action.Warnings{"warning-1", "warning-2"}, nil)
+		})
+
+		It("prints the application builds with the error code of failed builds and outputs warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Listing builds of app some-app in org some-org / space some-space as steve\\.\\.\\.\n"))
+			Expect(testUI.Out).To(Say("\n"))
+
+			createdAtOneParsed, err := time.Parse(time.RFC3339, createdAtOne)
+			Expect(err).ToNot(HaveOccurred())
+			createdAtTwoParsed, err := time.Parse(time.RFC3339, createdAtTwo)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("guid\\s+state\\s+created\\s+buildpacks\\s+droplet\\s+error\n"))
+			Expect(testUI.Out).To(Say("some-build-guid-1\\s+staged\\s+%s\\s+ruby_buildpack, nodejs_buildpack\\s+some-droplet-guid\\s*\n", testUI.UserFriendlyDate(createdAtOneParsed)))
+			Expect(testUI.Out).To(Say("some-build-guid-2\\s+failed\\s+%s\\s+NoAppDetectedError\n", testUI.UserFriendlyDate(createdAtTwoParsed)))
+
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+
+			Expect(fakeActor.GetApplicationBuildsCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationBuildsArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	Context("when getting the application builds returns no builds", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationBuildsReturns(nil, v3action.Warnings{"warning-1", "warning-2"}, nil)
+		})
+
+		It("displays there are no builds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Listing builds of app some-app in org some-org / space some-space as steve\\.\\.\\."))
+			Expect(testUI.Out).To(Say("No builds found"))
+
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+		})
+	})
+})
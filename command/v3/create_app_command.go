@@ -0,0 +1,105 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . CreateAppActor
+
+type CreateAppActor interface {
+	CloudControllerAPIVersion() string
+	CreateApplicationInSpace(app v3action.Application, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+}
+
+// CreateAppCommand creates an app without uploading any bits, so that env
+// variables and service bindings can be set up before its first push.
+type CreateAppCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	AppType         flag.AppType `long:"app-type" description:"App lifecycle type to stage and run the app (Default: buildpack)"`
+	usage           interface{}  `usage:"CF_NAME create-app APP_NAME [--app-type (buildpack | docker)]"`
+	relatedCommands interface{}  `related_commands:"app, apps, bind-service, push, set-env"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateAppActor
+}
+
+func (cmd *CreateAppCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd CreateAppCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}} in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":      cmd.RequiredArgs.AppName,
+		"CurrentSpace": cmd.Config.TargetedSpace().Name,
+		"CurrentOrg":   cmd.Config.TargetedOrganization().Name,
+		"CurrentUser":  user.Name,
+	})
+
+	lifecycleType := v3action.BuildpackAppLifecycleType
+	if cmd.AppType.Type == flag.AppTypeDocker {
+		lifecycleType = v3action.DockerAppLifecycleType
+	}
+
+	_, warnings, err := cmd.Actor.CreateApplicationInSpace(
+		v3action.Application{
+			Name:      cmd.RequiredArgs.AppName,
+			Lifecycle: v3action.AppLifecycle{Type: lifecycleType},
+		},
+		cmd.Config.TargetedSpace().GUID,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		switch err.(type) {
+		case v3action.ApplicationAlreadyExistsError:
+			cmd.UI.DisplayWarning("App {{.AppName}} already exists", map[string]interface{}{
+				"AppName": cmd.RequiredArgs.AppName,
+			})
+		default:
+			return shared.HandleError(err)
+		}
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
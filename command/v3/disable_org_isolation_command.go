@@ -17,12 +17,16 @@ import (
 
 type DisableOrgIsolationActor interface {
 	CloudControllerAPIVersion() string
+	GetIsolationSegmentByName(name string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetOrganizationsByNames(names []string) ([]v3action.Organization, v3action.Warnings, error)
+	RevokeIsolationSegmentFromOrganization(isolationSegment v3action.IsolationSegment, org v3action.Organization) (v3action.Warnings, error)
 	RevokeIsolationSegmentFromOrganizationByName(isolationSegmentName string, orgName string) (v3action.Warnings, error)
 }
 type DisableOrgIsolationCommand struct {
-	RequiredArgs    flag.OrgIsolationArgs `positional-args:"yes"`
-	usage           interface{}           `usage:"CF_NAME disable-org-isolation ORG_NAME SEGMENT_NAME"`
-	relatedCommands interface{}           `related_commands:"enable-org-isolation, isolation-segments"`
+	RequiredArgs    flag.OrgIsolationOrOrgsFileArgs `positional-args:"yes"`
+	OrgsFile        flag.PathWithExistenceCheck     `long:"orgs-file" description:"Revoke the entitlement of every org named in this file, one per line; lines starting with # are ignored"`
+	usage           interface{}                     `usage:"CF_NAME disable-org-isolation ORG_NAME SEGMENT_NAME\n   CF_NAME disable-org-isolation --orgs-file FILE SEGMENT_NAME\n\n   The entitlement of an org is not revoked while any of its spaces is assigned to the isolation segment."`
+	relatedCommands interface{}                     `related_commands:"enable-org-isolation, isolation-segments, reset-space-isolation-segment"`
 
 	UI          command.UI
 	Config      command.Config
@@ -49,7 +53,12 @@ func (cmd *DisableOrgIsolationCommand) Setup(config command.Config, ui command.U
 }
 
 func (cmd DisableOrgIsolationCommand) Execute(args []string) error {
-	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionIsolationSegmentV3)
+	orgName, segmentName, err := parseOrgIsolationArgs(cmd.RequiredArgs, cmd.OrgsFile)
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionIsolationSegmentV3)
 	if err != nil {
 		return err
 	}
@@ -63,13 +72,17 @@ func (cmd DisableOrgIsolationCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.OrgsFile != "" {
+		return cmd.disableOrgsFromFile(segmentName, user.Name)
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Removing entitlement to isolation segment {{.SegmentName}} from org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
-		"SegmentName": cmd.RequiredArgs.IsolationSegmentName,
-		"OrgName":     cmd.RequiredArgs.OrganizationName,
+		"SegmentName": segmentName,
+		"OrgName":     orgName,
 		"CurrentUser": user.Name,
 	})
 
-	warnings, err := cmd.Actor.RevokeIsolationSegmentFromOrganizationByName(cmd.RequiredArgs.IsolationSegmentName, cmd.RequiredArgs.OrganizationName)
+	warnings, err := cmd.Actor.RevokeIsolationSegmentFromOrganizationByName(segmentName, orgName)
 
 	cmd.UI.DisplayWarnings(warnings)
 
@@ -81,3 +94,40 @@ func (cmd DisableOrgIsolationCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// disableOrgsFromFile resolves every org named in the orgs file before
+// revoking any entitlement, so that a typo does not leave the orgs half done.
+func (cmd DisableOrgIsolationCommand) disableOrgsFromFile(segmentName string, userName string) error {
+	orgNames, err := readOrgsFile(string(cmd.OrgsFile))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Removing entitlement to isolation segment {{.SegmentName}} from {{.OrgCount}} orgs from {{.OrgsFile}} as {{.CurrentUser}}...", map[string]interface{}{
+		"SegmentName": segmentName,
+		"OrgCount":    len(orgNames),
+		"OrgsFile":    cmd.OrgsFile,
+		"CurrentUser": userName,
+	})
+
+	isolationSegment, warnings, err := cmd.Actor.GetIsolationSegmentByName(segmentName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	orgs, warnings, err := cmd.Actor.GetOrganizationsByNames(orgNames)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	errs := make([]error, len(orgs))
+	for i, org := range orgs {
+		warnings, err = cmd.Actor.RevokeIsolationSegmentFromOrganization(isolationSegment, org)
+		cmd.UI.DisplayWarnings(warnings)
+		errs[i] = shared.HandleError(err)
+	}
+
+	return displayOrgIsolationResults(cmd.UI, orgs, errs)
+}
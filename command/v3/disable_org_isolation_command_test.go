@@ -2,11 +2,14 @@ package v3_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
@@ -47,6 +50,8 @@ var _ = Describe("disable-org-isolation Command", func() {
 		fakeConfig.BinaryNameReturns(binaryName)
 		org = "org1"
 		isolationSegment = "segment1"
+		cmd.RequiredArgs.OrganizationName = org
+		cmd.RequiredArgs.IsolationSegmentName = isolationSegment
 		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionIsolationSegmentV3)
 	})
 
@@ -85,8 +90,6 @@ var _ = Describe("disable-org-isolation Command", func() {
 	Context("when user is logged in", func() {
 		BeforeEach(func() {
 			fakeConfig.CurrentUserReturns(configv3.User{Name: "admin"}, nil)
-			cmd.RequiredArgs.OrganizationName = org
-			cmd.RequiredArgs.IsolationSegmentName = isolationSegment
 		})
 
 		It("Outputs a mesaage", func() {
@@ -112,6 +115,24 @@ var _ = Describe("disable-org-isolation Command", func() {
 			})
 		})
 
+		Context("when spaces in the org are still assigned to the isolation segment", func() {
+			BeforeEach(func() {
+				fakeActor.RevokeIsolationSegmentFromOrganizationByNameReturns(nil, v3action.IsolationSegmentAssignedToSpacesError{
+					IsolationSegmentName: isolationSegment,
+					OrganizationName:     org,
+					SpaceNames:           []string{"space-a"},
+				})
+			})
+
+			It("returns an IsolationSegmentAssignedToSpacesError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IsolationSegmentAssignedToSpacesError{
+					IsolationSegmentName: isolationSegment,
+					OrganizationName:     org,
+					SpaceNames:           []string{"space-a"},
+				}))
+			})
+		})
+
 		Context("generic error while revoking segment isolation", func() {
 			var expectedErr error
 
@@ -127,4 +148,99 @@ var _ = Describe("disable-org-isolation Command", func() {
 			})
 		})
 	})
+
+	Context("when SEGMENT_NAME is missing", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.IsolationSegmentName = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "SEGMENT_NAME"}))
+		})
+	})
+
+	Context("when --orgs-file is provided", func() {
+		var orgsFile string
+
+		BeforeEach(func() {
+			file, err := ioutil.TempFile("", "orgs-file")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = file.WriteString("org-1\n# leaving org-3 alone\norg-2\n")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+			orgsFile = file.Name()
+
+			cmd.OrgsFile = flag.PathWithExistenceCheck(orgsFile)
+			cmd.RequiredArgs = flag.OrgIsolationOrOrgsFileArgs{OrganizationName: isolationSegment}
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "admin"}, nil)
+
+			fakeActor.GetIsolationSegmentByNameReturns(v3action.IsolationSegment{Name: isolationSegment, GUID: "segment-guid"}, v3action.Warnings{"get-segment-warning"}, nil)
+			fakeActor.GetOrganizationsByNamesReturns([]v3action.Organization{
+				{Name: "org-1", GUID: "org-guid-1"},
+				{Name: "org-2", GUID: "org-guid-2"},
+			}, v3action.Warnings{"get-orgs-warning"}, nil)
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(orgsFile)).To(Succeed())
+		})
+
+		Context("when every entitlement is revoked", func() {
+			BeforeEach(func() {
+				fakeActor.RevokeIsolationSegmentFromOrganizationReturns(v3action.Warnings{"revoke-warning"}, nil)
+			})
+
+			It("revokes the entitlement of each org named in the file and displays a summary", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Removing entitlement to isolation segment segment1 from 2 orgs from %s as admin...", orgsFile))
+				Expect(testUI.Out).To(Say(`org-1\s+OK`))
+				Expect(testUI.Out).To(Say(`org-2\s+OK`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("get-segment-warning"))
+				Expect(testUI.Err).To(Say("get-orgs-warning"))
+				Expect(testUI.Err).To(Say("revoke-warning"))
+
+				Expect(fakeActor.GetOrganizationsByNamesArgsForCall(0)).To(Equal([]string{"org-1", "org-2"}))
+				Expect(fakeActor.RevokeIsolationSegmentFromOrganizationCallCount()).To(Equal(2))
+				segment, revokedOrg := fakeActor.RevokeIsolationSegmentFromOrganizationArgsForCall(1)
+				Expect(segment.GUID).To(Equal("segment-guid"))
+				Expect(revokedOrg.GUID).To(Equal("org-guid-2"))
+				Expect(fakeActor.RevokeIsolationSegmentFromOrganizationByNameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when spaces in one of the orgs are still assigned to the isolation segment", func() {
+			BeforeEach(func() {
+				fakeActor.RevokeIsolationSegmentFromOrganizationStub = func(segment v3action.IsolationSegment, org v3action.Organization) (v3action.Warnings, error) {
+					if org.Name == "org-2" {
+						return nil, v3action.IsolationSegmentAssignedToSpacesError{
+							IsolationSegmentName: segment.Name,
+							OrganizationName:     org.Name,
+							SpaceNames:           []string{"space-a", "space-b"},
+						}
+					}
+					return nil, nil
+				}
+			})
+
+			It("reports the org as failed with its assigned spaces and returns an OrgIsolationFailedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.OrgIsolationFailedError{OrgNames: []string{"org-2"}}))
+
+				Expect(testUI.Out).To(Say(`org-1\s+OK`))
+				Expect(testUI.Out).To(Say(`org-2\s+FAILED\s+Cannot revoke org org-2's entitlement to isolation segment segment1 while it is assigned to these spaces: space-a, space-b`))
+			})
+		})
+
+		Context("when some of the orgs do not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationsByNamesReturns(nil, nil, v3action.OrganizationsNotFoundError{Names: []string{"org-1", "org-2"}})
+			})
+
+			It("returns an OrganizationsNotFoundError without revoking anything", func() {
+				Expect(executeErr).To(MatchError(translatableerror.OrganizationsNotFoundError{Names: []string{"org-1", "org-2"}}))
+				Expect(fakeActor.RevokeIsolationSegmentFromOrganizationCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
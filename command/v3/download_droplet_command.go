@@ -0,0 +1,118 @@
+package v3
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	pluginShared "code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . DownloadDropletActor
+
+type DownloadDropletActor interface {
+	CloudControllerAPIVersion() string
+	DownloadCurrentDropletByAppName(appName string, spaceGUID string, progressReader v3action.ProgressReader) ([]byte, string, v3action.Warnings, error)
+}
+
+type DownloadDropletCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Path            flag.Path    `short:"d" long:"path" description:"File or directory to write the droplet to (Default: droplet_DROPLET_GUID.tgz in the current directory)"`
+	usage           interface{}  `usage:"CF_NAME download-droplet APP_NAME [-d PATH]"`
+	relatedCommands interface{}  `related_commands:"droplets, set-droplet"`
+
+	UI             command.UI
+	Config         command.Config
+	SharedActor    command.SharedActor
+	Actor          DownloadDropletActor
+	ProgressReader v3action.ProgressReader
+}
+
+func (cmd *DownloadDropletCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+	cmd.ProgressReader = pluginShared.NewProgressBarProxyReader(ui.Writer(), ui.AnimationsEnabled())
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(ccClient, config)
+
+	return nil
+}
+
+func (cmd DownloadDropletCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Downloading current droplet for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	bits, dropletGUID, warnings, err := cmd.Actor.DownloadCurrentDropletByAppName(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.ProgressReader)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	path := cmd.dropletPath(dropletGUID)
+	err = ioutil.WriteFile(path, bits, 0644)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Droplet downloaded successfully at {{.FilePath}}", map[string]interface{}{
+		"FilePath": path,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// dropletPath returns the file the droplet is written to. When no path is
+// given, or the path is a directory, the droplet is named after its GUID.
+func (cmd DownloadDropletCommand) dropletPath(dropletGUID string) string {
+	fileName := fmt.Sprintf("droplet_%s.tgz", dropletGUID)
+
+	path := string(cmd.Path)
+	if path == "" {
+		return fileName
+	}
+
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return filepath.Join(path, fileName)
+	}
+
+	return path
+}
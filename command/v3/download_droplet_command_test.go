@@ -0,0 +1,162 @@
+package v3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("download-droplet Command", func() {
+	var (
+		cmd                v3.DownloadDropletCommand
+		testUI             *ui.UI
+		fakeConfig         *commandfakes.FakeConfig
+		fakeSharedActor    *commandfakes.FakeSharedActor
+		fakeActor          *v3fakes.FakeDownloadDropletActor
+		fakeProgressReader *cloudcontrollerfakes.FakeProgressReader
+		binaryName         string
+		executeErr         error
+		tempDir            string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeDownloadDropletActor)
+		fakeProgressReader = new(cloudcontrollerfakes.FakeProgressReader)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "download-droplet")
+		Expect(err).ToNot(HaveOccurred())
+
+		cmd = v3.DownloadDropletCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			Path:         flag.Path(tempDir),
+
+			UI:             testUI,
+			Config:         fakeConfig,
+			SharedActor:    fakeSharedActor,
+			Actor:          fakeActor,
+			ProgressReader: fakeProgressReader,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the app does not have a current droplet", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadCurrentDropletByAppNameReturns(nil, "", v3action.Warnings{"download-warning"}, v3action.DropletNotFoundError{AppName: "some-app"})
+		})
+
+		It("returns a DropletNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DropletNotFoundError{AppName: "some-app"}))
+			Expect(testUI.Err).To(Say("download-warning"))
+		})
+	})
+
+	Context("when the download is incomplete", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadCurrentDropletByAppNameReturns(nil, "", nil, ccerror.IncompleteDownloadError{ExpectedBytes: 42})
+		})
+
+		It("returns an IncompleteDownloadError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncompleteDownloadError{ExpectedBytes: 42}))
+		})
+	})
+
+	Context("when the droplet is downloaded", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadCurrentDropletByAppNameReturns([]byte("some-droplet-bits"), "some-droplet-guid", v3action.Warnings{"download-warning"}, nil)
+		})
+
+		Context("when the path is a directory", func() {
+			It("writes the droplet into the directory", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				dropletPath := filepath.Join(tempDir, "droplet_some-droplet-guid.tgz")
+				Expect(ioutil.ReadFile(dropletPath)).To(Equal([]byte("some-droplet-bits")))
+
+				Expect(testUI.Out).To(Say("Downloading current droplet for app some-app in org some-org / space some-space as steve\\.\\.\\."))
+				Expect(testUI.Out).To(Say("Droplet downloaded successfully at %s", dropletPath))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("download-warning"))
+
+				Expect(fakeActor.DownloadCurrentDropletByAppNameCallCount()).To(Equal(1))
+				appName, spaceGUID, progressReader := fakeActor.DownloadCurrentDropletByAppNameArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(progressReader).To(Equal(fakeProgressReader))
+			})
+		})
+
+		Context("when the path is a file", func() {
+			BeforeEach(func() {
+				cmd.Path = flag.Path(filepath.Join(tempDir, "my-droplet.tgz"))
+			})
+
+			It("writes the droplet to the file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(ioutil.ReadFile(filepath.Join(tempDir, "my-droplet.tgz"))).To(Equal([]byte("some-droplet-bits")))
+			})
+		})
+	})
+})
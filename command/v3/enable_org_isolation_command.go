@@ -17,13 +17,17 @@ import (
 
 type EnableOrgIsolationActor interface {
 	CloudControllerAPIVersion() string
+	EntitleIsolationSegmentToOrganization(isolationSegment v3action.IsolationSegment, org v3action.Organization) (v3action.Warnings, error)
 	EntitleIsolationSegmentToOrganizationByName(isolationSegmentName string, orgName string) (v3action.Warnings, error)
+	GetIsolationSegmentByName(name string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetOrganizationsByNames(names []string) ([]v3action.Organization, v3action.Warnings, error)
 }
 
 type EnableOrgIsolationCommand struct {
-	RequiredArgs    flag.OrgIsolationArgs `positional-args:"yes"`
-	usage           interface{}           `usage:"CF_NAME enable-org-isolation ORG_NAME SEGMENT_NAME"`
-	relatedCommands interface{}           `related_commands:"create-isolation-segment, isolation-segments, set-org-default-isolation-segment, set-space-isolation-segment"`
+	RequiredArgs    flag.OrgIsolationOrOrgsFileArgs `positional-args:"yes"`
+	OrgsFile        flag.PathWithExistenceCheck     `long:"orgs-file" description:"Entitle every org named in this file, one per line; lines starting with # are ignored"`
+	usage           interface{}                     `usage:"CF_NAME enable-org-isolation ORG_NAME SEGMENT_NAME\n   CF_NAME enable-org-isolation --orgs-file FILE SEGMENT_NAME"`
+	relatedCommands interface{}                     `related_commands:"create-isolation-segment, isolation-segments, set-org-default-isolation-segment, set-space-isolation-segment"`
 
 	UI          command.UI
 	Config      command.Config
@@ -50,7 +54,12 @@ func (cmd *EnableOrgIsolationCommand) Setup(config command.Config, ui command.UI
 }
 
 func (cmd EnableOrgIsolationCommand) Execute(args []string) error {
-	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionIsolationSegmentV3)
+	orgName, segmentName, err := parseOrgIsolationArgs(cmd.RequiredArgs, cmd.OrgsFile)
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionIsolationSegmentV3)
 	if err != nil {
 		return err
 	}
@@ -65,13 +74,17 @@ func (cmd EnableOrgIsolationCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.OrgsFile != "" {
+		return cmd.enableOrgsFromFile(segmentName, user.Name)
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Enabling isolation segment {{.SegmentName}} for org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
-		"SegmentName": cmd.RequiredArgs.IsolationSegmentName,
-		"OrgName":     cmd.RequiredArgs.OrganizationName,
+		"SegmentName": segmentName,
+		"OrgName":     orgName,
 		"CurrentUser": user.Name,
 	})
 
-	warnings, err := cmd.Actor.EntitleIsolationSegmentToOrganizationByName(cmd.RequiredArgs.IsolationSegmentName, cmd.RequiredArgs.OrganizationName)
+	warnings, err := cmd.Actor.EntitleIsolationSegmentToOrganizationByName(segmentName, orgName)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
@@ -81,3 +94,40 @@ func (cmd EnableOrgIsolationCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// enableOrgsFromFile resolves every org named in the orgs file before
+// entitling any of them, so that a typo does not leave the orgs half done.
+func (cmd EnableOrgIsolationCommand) enableOrgsFromFile(segmentName string, userName string) error {
+	orgNames, err := readOrgsFile(string(cmd.OrgsFile))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Enabling isolation segment {{.SegmentName}} for {{.OrgCount}} orgs from {{.OrgsFile}} as {{.CurrentUser}}...", map[string]interface{}{
+		"SegmentName": segmentName,
+		"OrgCount":    len(orgNames),
+		"OrgsFile":    cmd.OrgsFile,
+		"CurrentUser": userName,
+	})
+
+	isolationSegment, warnings, err := cmd.Actor.GetIsolationSegmentByName(segmentName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	orgs, warnings, err := cmd.Actor.GetOrganizationsByNames(orgNames)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	errs := make([]error, len(orgs))
+	for i, org := range orgs {
+		warnings, err = cmd.Actor.EntitleIsolationSegmentToOrganization(isolationSegment, org)
+		cmd.UI.DisplayWarnings(warnings)
+		errs[i] = shared.HandleError(err)
+	}
+
+	return displayOrgIsolationResults(cmd.UI, orgs, errs)
+}
@@ -2,11 +2,14 @@ package v3_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
@@ -47,6 +50,8 @@ var _ = Describe("enable-org-isolation Command", func() {
 		fakeConfig.BinaryNameReturns(binaryName)
 		org = "some-org"
 		isolationSegment = "segment1"
+		cmd.RequiredArgs.OrganizationName = org
+		cmd.RequiredArgs.IsolationSegmentName = isolationSegment
 		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionIsolationSegmentV3)
 	})
 
@@ -85,9 +90,6 @@ var _ = Describe("enable-org-isolation Command", func() {
 	Context("when the user is logged in", func() {
 		BeforeEach(func() {
 			fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
-
-			cmd.RequiredArgs.OrganizationName = org
-			cmd.RequiredArgs.IsolationSegmentName = isolationSegment
 		})
 
 		Context("when the enable is successful", func() {
@@ -157,4 +159,147 @@ var _ = Describe("enable-org-isolation Command", func() {
 
 		})
 	})
+
+	Context("when ORG_NAME is missing", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.OrgIsolationOrOrgsFileArgs{}
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "ORG_NAME"}))
+		})
+	})
+
+	Context("when SEGMENT_NAME is missing", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.IsolationSegmentName = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "SEGMENT_NAME"}))
+		})
+	})
+
+	Context("when --orgs-file is provided", func() {
+		var orgsFile string
+
+		BeforeEach(func() {
+			file, err := ioutil.TempFile("", "orgs-file")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = file.WriteString("# orgs moving to segment1\norg-1\n\n  org-2  \norg-1\n#org-3\n")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+			orgsFile = file.Name()
+
+			cmd.OrgsFile = flag.PathWithExistenceCheck(orgsFile)
+			cmd.RequiredArgs = flag.OrgIsolationOrOrgsFileArgs{OrganizationName: isolationSegment}
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+
+			fakeActor.GetIsolationSegmentByNameReturns(v3action.IsolationSegment{Name: isolationSegment, GUID: "segment-guid"}, v3action.Warnings{"get-segment-warning"}, nil)
+			fakeActor.GetOrganizationsByNamesReturns([]v3action.Organization{
+				{Name: "org-1", GUID: "org-guid-1"},
+				{Name: "org-2", GUID: "org-guid-2"},
+			}, v3action.Warnings{"get-orgs-warning"}, nil)
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(orgsFile)).To(Succeed())
+		})
+
+		Context("when every org is entitled", func() {
+			BeforeEach(func() {
+				fakeActor.EntitleIsolationSegmentToOrganizationReturns(v3action.Warnings{"entitle-warning"}, nil)
+			})
+
+			It("entitles each org named in the file and displays a summary", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Enabling isolation segment segment1 for 2 orgs from %s as banana...", orgsFile))
+				Expect(testUI.Out).To(Say(`org\s+status\s+error`))
+				Expect(testUI.Out).To(Say(`org-1\s+OK`))
+				Expect(testUI.Out).To(Say(`org-2\s+OK`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("get-segment-warning"))
+				Expect(testUI.Err).To(Say("get-orgs-warning"))
+				Expect(testUI.Err).To(Say("entitle-warning"))
+
+				Expect(fakeActor.GetIsolationSegmentByNameArgsForCall(0)).To(Equal(isolationSegment))
+				Expect(fakeActor.GetOrganizationsByNamesArgsForCall(0)).To(Equal([]string{"org-1", "org-2"}))
+
+				Expect(fakeActor.EntitleIsolationSegmentToOrganizationCallCount()).To(Equal(2))
+				segment, entitledOrg := fakeActor.EntitleIsolationSegmentToOrganizationArgsForCall(0)
+				Expect(segment.GUID).To(Equal("segment-guid"))
+				Expect(entitledOrg.GUID).To(Equal("org-guid-1"))
+				_, entitledOrg = fakeActor.EntitleIsolationSegmentToOrganizationArgsForCall(1)
+				Expect(entitledOrg.GUID).To(Equal("org-guid-2"))
+
+				Expect(fakeActor.EntitleIsolationSegmentToOrganizationByNameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when entitling some of the orgs fails", func() {
+			BeforeEach(func() {
+				fakeActor.EntitleIsolationSegmentToOrganizationStub = func(_ v3action.IsolationSegment, org v3action.Organization) (v3action.Warnings, error) {
+					if org.Name == "org-1" {
+						return nil, errors.New("some-entitle-error")
+					}
+					return nil, nil
+				}
+			})
+
+			It("still entitles the other orgs and returns an OrgIsolationFailedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.OrgIsolationFailedError{OrgNames: []string{"org-1"}}))
+
+				Expect(testUI.Out).To(Say(`org-1\s+FAILED\s+some-entitle-error`))
+				Expect(testUI.Out).To(Say(`org-2\s+OK`))
+				Expect(fakeActor.EntitleIsolationSegmentToOrganizationCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when some of the orgs do not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationsByNamesReturns(nil, v3action.Warnings{"get-orgs-warning"}, v3action.OrganizationsNotFoundError{Names: []string{"org-2"}})
+			})
+
+			It("returns an OrganizationsNotFoundError without entitling any org", func() {
+				Expect(executeErr).To(MatchError(translatableerror.OrganizationsNotFoundError{Names: []string{"org-2"}}))
+				Expect(testUI.Err).To(Say("get-orgs-warning"))
+				Expect(fakeActor.EntitleIsolationSegmentToOrganizationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the isolation segment does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetIsolationSegmentByNameReturns(v3action.IsolationSegment{}, nil, v3action.IsolationSegmentNotFoundError{Name: isolationSegment})
+			})
+
+			It("returns an IsolationSegmentNotFoundError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IsolationSegmentNotFoundError{Name: isolationSegment}))
+				Expect(fakeActor.GetOrganizationsByNamesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the file does not name any org", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(orgsFile, []byte("# nothing yet\n\n"), 0600)).To(Succeed())
+			})
+
+			It("returns a NoOrgsInFileError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoOrgsInFileError{Path: orgsFile}))
+				Expect(fakeActor.GetIsolationSegmentByNameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when ORG_NAME is also provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs = flag.OrgIsolationOrOrgsFileArgs{OrganizationName: org, IsolationSegmentName: isolationSegment}
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--orgs-file", "ORG_NAME"},
+				}))
+			})
+		})
+	})
 })
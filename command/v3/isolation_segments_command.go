@@ -1,6 +1,8 @@
 package v3
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -21,7 +23,8 @@ type IsolationSegmentsActor interface {
 }
 
 type IsolationSegmentsCommand struct {
-	usage           interface{} `usage:"CF_NAME isolation-segments"`
+	JSON            bool        `long:"json" description:"Output the isolation segments as JSON"`
+	usage           interface{} `usage:"CF_NAME isolation-segments [--json]"`
 	relatedCommands interface{} `related_commands:"enable-org-isolation, create-isolation-segment"`
 
 	UI          command.UI
@@ -59,6 +62,10 @@ func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.JSON {
+		return cmd.displayIsolationSegmentsJSON()
+	}
+
 	user, err := cmd.Config.CurrentUser()
 	if err != nil {
 		return err
@@ -96,3 +103,37 @@ func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 	cmd.UI.DisplayTableWithHeader("", table, 3)
 	return nil
 }
+
+// displayIsolationSegmentsJSON displays the isolation segments and the warnings
+// encountered while getting them as a single JSON document.
+func (cmd IsolationSegmentsCommand) displayIsolationSegmentsJSON() error {
+	summaries, warnings, err := cmd.Actor.GetIsolationSegmentSummaries()
+	if err != nil {
+		cmd.UI.DisplayWarnings(warnings)
+		return shared.HandleError(err)
+	}
+
+	isolationSegmentsJSON := []map[string]interface{}{}
+	for _, summary := range summaries {
+		isolationSegmentsJSON = append(isolationSegmentsJSON, map[string]interface{}{
+			"guid":          summary.GUID,
+			"name":          summary.Name,
+			"entitled_orgs": summary.EntitledOrgs,
+		})
+	}
+
+	if warnings == nil {
+		warnings = v3action.Warnings{}
+	}
+
+	output, err := json.MarshalIndent(map[string]interface{}{
+		"isolation_segments": isolationSegmentsJSON,
+		"warnings":           warnings,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
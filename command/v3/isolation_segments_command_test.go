@@ -121,6 +121,27 @@ var _ = Describe("isolation-segments Command", func() {
 
 					Expect(fakeActor.GetIsolationSegmentSummariesCallCount()).To(Equal(1))
 				})
+
+				Context("when --json is passed", func() {
+					BeforeEach(func() {
+						cmd.JSON = true
+					})
+
+					It("displays the isolation segments and warnings as JSON only", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out.(*Buffer).Contents()).To(MatchJSON(`{
+							"isolation_segments": [
+								{"guid": "", "name": "some-iso-1", "entitled_orgs": []},
+								{"guid": "", "name": "some-iso-2", "entitled_orgs": ["some-org-1"]},
+								{"guid": "", "name": "some-iso-3", "entitled_orgs": ["some-org-1", "some-org-2"]}
+							],
+							"warnings": ["warning-1", "warning-2"]
+						}`))
+						Expect(testUI.Err).NotTo(Say("warning"))
+						Expect(fakeConfig.CurrentUserCallCount()).To(Equal(0))
+					})
+				})
 			})
 
 			Context("when there are no isolation segments", func() {
@@ -163,6 +184,20 @@ var _ = Describe("isolation-segments Command", func() {
 				Expect(testUI.Err).To(Say("warning-1"))
 				Expect(testUI.Err).To(Say("warning-2"))
 			})
+
+			Context("when --json is passed", func() {
+				BeforeEach(func() {
+					cmd.JSON = true
+				})
+
+				It("displays warnings and returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedError))
+
+					Expect(testUI.Out).NotTo(Say("Getting isolation segments"))
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("warning-2"))
+				})
+			})
 		})
 	})
 })
@@ -0,0 +1,120 @@
+package v3
+
+import (
+	"net/http"
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+)
+
+//go:generate counterfeiter . LabelsActor
+
+type LabelsActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, v3action.Warnings, error)
+	GetOrganizationLabels(orgName string) (map[string]types.NullString, v3action.Warnings, error)
+	GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, v3action.Warnings, error)
+}
+
+type LabelsCommand struct {
+	RequiredArgs    flag.LabelsArgs `positional-args:"yes"`
+	usage           interface{}     `usage:"CF_NAME labels RESOURCE RESOURCE_NAME\n\nRESOURCES:\n   app\n   org\n   space\n\nEXAMPLES:\n   cf labels app dora\n   cf labels org business\n   cf labels space business_space"`
+	relatedCommands interface{}     `related_commands:"set-label, unset-label"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       LabelsActor
+}
+
+func (cmd *LabelsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionMetadataV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd LabelsCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionMetadataV3)
+	if err != nil {
+		return err
+	}
+
+	resourceType := cmd.RequiredArgs.ResourceType.Type
+	err = cmd.SharedActor.CheckTarget(cmd.Config, resourceType != flag.LabelResourceOrg, resourceType == flag.LabelResourceApp)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting labels for {{.ResourceType}} {{.ResourceName}} as {{.User}}...", map[string]interface{}{
+		"ResourceType": resourceType,
+		"ResourceName": cmd.RequiredArgs.ResourceName,
+		"User":         user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	var (
+		labels   map[string]types.NullString
+		warnings v3action.Warnings
+	)
+	switch resourceType {
+	case flag.LabelResourceApp:
+		labels, warnings, err = cmd.Actor.GetApplicationLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
+	case flag.LabelResourceOrg:
+		labels, warnings, err = cmd.Actor.GetOrganizationLabels(cmd.RequiredArgs.ResourceName)
+	case flag.LabelResourceSpace:
+		labels, warnings, err = cmd.Actor.GetSpaceLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(labels) == 0 {
+		cmd.UI.DisplayText("No labels found.")
+		return nil
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("key"),
+			cmd.UI.TranslateText("value"),
+		},
+	}
+	for _, key := range keys {
+		table = append(table, []string{key, labels[key].Value})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
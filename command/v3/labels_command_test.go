@@ -0,0 +1,119 @@
+package v3_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("labels Command", func() {
+	var (
+		cmd             v3.LabelsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeLabelsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeLabelsActor)
+
+		cmd = v3.LabelsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+
+		cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: flag.LabelResourceSpace}
+		cmd.RequiredArgs.ResourceName = "some-space"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("requires a targeted org for spaces", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when the space has labels", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceLabelsReturns(
+				map[string]types.NullString{
+					"tier": types.NewNullString("web"),
+					"env":  types.NewNullString("prod"),
+				},
+				v3action.Warnings{"some-warning"},
+				nil)
+		})
+
+		It("displays the labels sorted by key and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			spaceName, orgGUID := fakeActor.GetSpaceLabelsArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			Expect(testUI.Out).To(Say(`Getting labels for space some-space as banana\.\.\.`))
+			Expect(testUI.Out).To(Say(`key\s+value`))
+			Expect(testUI.Out).To(Say(`env\s+prod`))
+			Expect(testUI.Out).To(Say(`tier\s+web`))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	Context("when the space has no labels", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceLabelsReturns(map[string]types.NullString{}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No labels found\.`))
+		})
+	})
+
+	Context("when getting the labels of an org", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: flag.LabelResourceOrg}
+			cmd.RequiredArgs.ResourceName = "some-org"
+			fakeActor.GetOrganizationLabelsReturns(nil, v3action.Warnings{"some-warning"}, v3action.OrganizationNotFoundError{Name: "some-org"})
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
+			Expect(fakeActor.GetOrganizationLabelsArgsForCall(0)).To(Equal("some-org"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
@@ -101,9 +101,12 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 			cmd.UI.TranslateText("destination"),
 			cmd.UI.TranslateText("protocol"),
 			cmd.UI.TranslateText("ports"),
+			cmd.UI.TranslateText("destination space"),
+			cmd.UI.TranslateText("destination org"),
 		},
 	}
 
+	var hasHiddenDestinations bool
 	for _, policy := range policies {
 		var portEntry string
 		if policy.StartPort == policy.EndPort {
@@ -116,10 +119,20 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 			policy.DestinationName,
 			policy.Protocol,
 			portEntry,
+			policy.DestinationSpaceName,
+			policy.DestinationOrgName,
 		})
+		if policy.DestinationNotVisible {
+			hasHiddenDestinations = true
+		}
 	}
 
 	cmd.UI.DisplayTableWithHeader("", table, 3)
 
+	if hasHiddenDestinations {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Destination apps shown by GUID are in spaces you do not have access to.")
+	}
+
 	return nil
 }
@@ -116,14 +116,46 @@ var _ = Describe("network-policies Command", func() {
 
 				Expect(testUI.Out).To(Say(`Listing network policies in org some-org / space some-space as some-user\.\.\.`))
 				Expect(testUI.Out).To(Say("\n\n"))
-				Expect(testUI.Out).To(Say("source\\s+destination\\s+protocol\\s+ports"))
+				Expect(testUI.Out).To(Say("source\\s+destination\\s+protocol\\s+ports\\s+destination space\\s+destination org"))
 				Expect(testUI.Out).To(Say("app1\\s+app2\\s+tcp\\s+8080[^-]"))
 				Expect(testUI.Out).To(Say("app2\\s+app1\\s+udp\\s+1234-2345"))
+				Expect(testUI.Out).NotTo(Say("do not have access"))
 
 				Expect(testUI.Err).To(Say("some-warning-1"))
 				Expect(testUI.Err).To(Say("some-warning-2"))
 			})
 
+			Context("when policies point to apps in other spaces", func() {
+				BeforeEach(func() {
+					fakeActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+						{
+							SourceName:           "app1",
+							DestinationName:      "app3",
+							Protocol:             "tcp",
+							StartPort:            8080,
+							EndPort:              8080,
+							DestinationSpaceName: "other-space",
+							DestinationOrgName:   "other-org",
+						}, {
+							SourceName:            "app1",
+							DestinationName:       "hidden-app-guid",
+							Protocol:              "udp",
+							StartPort:             53,
+							EndPort:               53,
+							DestinationNotVisible: true,
+						},
+					}, nil, nil)
+				})
+
+				It("shows the space and org of the destination and notes the destinations that cannot be seen", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say("app1\\s+app3\\s+tcp\\s+8080\\s+other-space\\s+other-org"))
+					Expect(testUI.Out).To(Say("app1\\s+hidden-app-guid\\s+udp\\s+53"))
+					Expect(testUI.Out).To(Say("Destination apps shown by GUID are in spaces you do not have access to\\."))
+				})
+			})
+
 			Context("when a source app name is passed", func() {
 				BeforeEach(func() {
 					cmd.SourceApp = "some-app"
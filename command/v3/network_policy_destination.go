@@ -0,0 +1,43 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+// NetworkPolicyDestinationActor looks up the space of a network policy's
+// destination app.
+type NetworkPolicyDestinationActor interface {
+	GetSpaceGUIDByNameAndOrganizationName(spaceName string, orgName string) (string, cfnetworkingaction.Warnings, error)
+}
+
+// checkNetworkPolicyDestinationFlags returns an error if the destination org
+// is given without the destination space.
+func checkNetworkPolicyDestinationFlags(spaceName string, orgName string) error {
+	if orgName != "" && spaceName == "" {
+		return translatableerror.RequiredFlagsError{Arg1: "-o", Arg2: "-s"}
+	}
+	return nil
+}
+
+// networkPolicyDestinationSpaceGUID returns the GUID of the space given with
+// -s and -o, defaulting to the targeted org, or of the targeted space when no
+// space is given.
+func networkPolicyDestinationSpaceGUID(actor NetworkPolicyDestinationActor, config command.Config, ui command.UI, spaceName string, orgName string) (string, error) {
+	if spaceName == "" {
+		return config.TargetedSpace().GUID, nil
+	}
+
+	if orgName == "" {
+		orgName = config.TargetedOrganization().Name
+	}
+
+	spaceGUID, warnings, err := actor.GetSpaceGUIDByNameAndOrganizationName(spaceName, orgName)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		return "", shared.HandleError(err)
+	}
+	return spaceGUID, nil
+}
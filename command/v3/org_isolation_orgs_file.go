@@ -0,0 +1,104 @@
+package v3
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+// parseOrgIsolationArgs returns the org and isolation segment names given to
+// enable-org-isolation or disable-org-isolation. When orgsFile is set, only
+// SEGMENT_NAME is expected and the returned org name is empty.
+func parseOrgIsolationArgs(args flag.OrgIsolationOrOrgsFileArgs, orgsFile flag.PathWithExistenceCheck) (string, string, error) {
+	if orgsFile != "" {
+		if args.IsolationSegmentName != "" {
+			return "", "", translatableerror.ArgumentCombinationError{
+				Args: []string{"--orgs-file", "ORG_NAME"},
+			}
+		}
+		if args.OrganizationName == "" {
+			return "", "", translatableerror.RequiredArgumentError{ArgumentName: "SEGMENT_NAME"}
+		}
+		return "", args.OrganizationName, nil
+	}
+
+	if args.OrganizationName == "" {
+		return "", "", translatableerror.RequiredArgumentError{ArgumentName: "ORG_NAME"}
+	}
+	if args.IsolationSegmentName == "" {
+		return "", "", translatableerror.RequiredArgumentError{ArgumentName: "SEGMENT_NAME"}
+	}
+	return args.OrganizationName, args.IsolationSegmentName, nil
+}
+
+// readOrgsFile returns the org names listed in the file at path, one per
+// line, in order and without duplicates. Blank lines and lines starting with
+// # are ignored.
+func readOrgsFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var names []string
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if name == "" || strings.HasPrefix(name, "#") || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return nil, translatableerror.NoOrgsInFileError{Path: path}
+	}
+	return names, nil
+}
+
+// displayOrgIsolationResults displays whether the change to each org's
+// isolation segment entitlement succeeded, and returns an
+// OrgIsolationFailedError naming the orgs for which it failed.
+func displayOrgIsolationResults(commandUI command.UI, orgs []v3action.Organization, errs []error) error {
+	table := [][]string{
+		{
+			commandUI.TranslateText("org"),
+			commandUI.TranslateText("status"),
+			commandUI.TranslateText("error"),
+		},
+	}
+
+	var failedOrgNames []string
+	for i, org := range orgs {
+		if errs[i] == nil {
+			table = append(table, []string{org.Name, commandUI.TranslateText("OK"), ""})
+			continue
+		}
+
+		failedOrgNames = append(failedOrgNames, org.Name)
+		table = append(table, []string{org.Name, commandUI.TranslateText("FAILED"), shared.TranslatedErrorMessage(commandUI, errs[i])})
+	}
+
+	commandUI.DisplayNewline()
+	commandUI.DisplayTableWithHeader("", table, 3)
+
+	if len(failedOrgNames) > 0 {
+		return translatableerror.OrgIsolationFailedError{OrgNames: failedOrgNames}
+	}
+
+	commandUI.DisplayNewline()
+	commandUI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,225 @@
+package v3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+//go:generate counterfeiter . ProcessesActor
+
+type ProcessesActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationProcessSummariesByNameAndSpace(appName string, spaceGUID string) (v3action.ProcessSummaries, v3action.Warnings, error)
+}
+
+type ProcessesCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	JSON            bool         `long:"json" description:"Output the processes as JSON"`
+	usage           interface{}  `usage:"CF_NAME processes APP_NAME [--json]"`
+	relatedCommands interface{}  `related_commands:"v3-app, v3-scale, v3-get-health-check"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ProcessesActor
+}
+
+func (cmd *ProcessesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd ProcessesCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	if cmd.JSON {
+		return cmd.displayProcessesJSON(space.GUID)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting processes for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	summaries, warnings, err := cmd.Actor.GetApplicationProcessSummariesByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	for _, summary := range summaries {
+		cmd.displayProcess(summary)
+	}
+
+	return nil
+}
+
+func (cmd ProcessesCommand) displayProcess(summary v3action.ProcessSummary) {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithBold("{{.ProcessType}}:{{.HealthyInstanceCount}}/{{.TotalInstanceCount}}", map[string]interface{}{
+		"ProcessType":          summary.Type,
+		"HealthyInstanceCount": summary.HealthyInstanceCount(),
+		"TotalInstanceCount":   summary.TotalInstanceCount(),
+	})
+
+	processCommand := summary.Command
+	if processCommand == "" {
+		processCommand = "[hidden]"
+	}
+
+	var instances string
+	if summary.Instances.IsSet {
+		instances = strconv.Itoa(summary.Instances.Value)
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("command:"), processCommand},
+		{cmd.UI.TranslateText("instances:"), instances},
+		{cmd.UI.TranslateText("memory:"), megabytes(summary.MemoryInMB.Value, summary.MemoryInMB.IsSet)},
+		{cmd.UI.TranslateText("disk:"), megabytes(summary.DiskInMB.Value, summary.DiskInMB.IsSet)},
+		{cmd.UI.TranslateText("health check:"), summary.HealthCheck.Type},
+	}, 3)
+
+	if len(summary.InstanceDetails) == 0 {
+		return
+	}
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("since"),
+			cmd.UI.TranslateText("cpu"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("disk"),
+			cmd.UI.TranslateText("details"),
+		},
+	}
+
+	for _, instance := range summary.InstanceDetails {
+		table = append(table, []string{
+			fmt.Sprintf("#%d", instance.Index),
+			cmd.UI.TranslateText(strings.ToLower(instance.State)),
+			instance.StartTime().Local().Format("2006-01-02 15:04:05 PM"),
+			fmt.Sprintf("%.1f%%", instance.CPU*100),
+			cmd.UI.TranslateText("{{.MemUsage}} of {{.MemQuota}}", map[string]interface{}{
+				"MemUsage": bytefmt.ByteSize(instance.MemoryUsage),
+				"MemQuota": bytefmt.ByteSize(instance.MemoryQuota),
+			}),
+			cmd.UI.TranslateText("{{.DiskUsage}} of {{.DiskQuota}}", map[string]interface{}{
+				"DiskUsage": bytefmt.ByteSize(instance.DiskUsage),
+				"DiskQuota": bytefmt.ByteSize(instance.DiskQuota),
+			}),
+			instance.Details,
+		})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayInstancesTableForApp(table)
+}
+
+// displayProcessesJSON prints the processes and their instance stats, with
+// the warnings included in the document so the output stays parseable.
+func (cmd ProcessesCommand) displayProcessesJSON(spaceGUID string) error {
+	summaries, warnings, err := cmd.Actor.GetApplicationProcessSummariesByNameAndSpace(cmd.RequiredArgs.AppName, spaceGUID)
+	if err != nil {
+		cmd.UI.DisplayWarnings(warnings)
+		return shared.HandleError(err)
+	}
+
+	processesJSON := make([]map[string]interface{}, len(summaries))
+	for i, summary := range summaries {
+		instancesJSON := make([]map[string]interface{}, len(summary.InstanceDetails))
+		for j, instance := range summary.InstanceDetails {
+			instancesJSON[j] = map[string]interface{}{
+				"index":        instance.Index,
+				"state":        instance.State,
+				"uptime":       instance.Uptime,
+				"cpu":          instance.CPU,
+				"memory_usage": instance.MemoryUsage,
+				"memory_quota": instance.MemoryQuota,
+				"disk_usage":   instance.DiskUsage,
+				"disk_quota":   instance.DiskQuota,
+				"details":      instance.Details,
+			}
+		}
+
+		processesJSON[i] = map[string]interface{}{
+			"guid":              summary.GUID,
+			"type":              summary.Type,
+			"command":           summary.Command,
+			"instances":         summary.Instances.Value,
+			"memory_in_mb":      summary.MemoryInMB.Value,
+			"disk_in_mb":        summary.DiskInMB.Value,
+			"health_check_type": summary.HealthCheck.Type,
+			"instance_stats":    instancesJSON,
+		}
+	}
+
+	if warnings == nil {
+		warnings = v3action.Warnings{}
+	}
+
+	output, err := json.MarshalIndent(map[string]interface{}{
+		"processes": processesJSON,
+		"warnings":  warnings,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
+
+func megabytes(value uint64, isSet bool) string {
+	if !isSet {
+		return ""
+	}
+	return bytefmt.ByteSize(value * bytefmt.MEGABYTE)
+}
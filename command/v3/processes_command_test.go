@@ -0,0 +1,238 @@
+package v3_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("processes Command", func() {
+	var (
+		cmd             v3.ProcessesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeProcessesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeProcessesActor)
+
+		cmd = v3.ProcessesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app-name"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and a space and org are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when the app has processes", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationProcessSummariesByNameAndSpaceReturns(
+					v3action.ProcessSummaries{
+						{
+							Process: v3action.Process{
+								GUID:        "web-guid",
+								Type:        "web",
+								Command:     "bundle exec rackup",
+								Instances:   types.NullInt{Value: 2, IsSet: true},
+								MemoryInMB:  types.NullUint64{Value: 256, IsSet: true},
+								DiskInMB:    types.NullUint64{Value: 1024, IsSet: true},
+								HealthCheck: ccv3.ProcessHealthCheck{Type: "port"},
+							},
+							InstanceDetails: []v3action.Instance{
+								{Index: 0, State: "RUNNING", CPU: 0.01, MemoryUsage: 1000000, MemoryQuota: 33554432, DiskUsage: 2000000, DiskQuota: 33554432},
+								{Index: 1, State: "CRASHED", Details: "Exited with status 1"},
+							},
+						},
+						{
+							Process: v3action.Process{
+								GUID:        "worker-guid",
+								Type:        "worker",
+								Instances:   types.NullInt{Value: 0, IsSet: true},
+								MemoryInMB:  types.NullUint64{Value: 64, IsSet: true},
+								DiskInMB:    types.NullUint64{Value: 512, IsSet: true},
+								HealthCheck: ccv3.ProcessHealthCheck{Type: "process"},
+							},
+						},
+					},
+					v3action.Warnings{"get-processes-warning"},
+					nil)
+			})
+
+			It("displays each process with its settings and instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				appName, spaceGUID := fakeActor.GetApplicationProcessSummariesByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app-name"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(testUI.Out).To(Say("Getting processes for app some-app-name in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`web:1/2`))
+				Expect(testUI.Out).To(Say(`command:\s+bundle exec rackup`))
+				Expect(testUI.Out).To(Say(`instances:\s+2`))
+				Expect(testUI.Out).To(Say(`memory:\s+256M`))
+				Expect(testUI.Out).To(Say(`disk:\s+1G`))
+				Expect(testUI.Out).To(Say(`health check:\s+port`))
+				Expect(testUI.Out).To(Say(`state\s+since\s+cpu\s+memory\s+disk\s+details`))
+				Expect(testUI.Out).To(Say("%s", `#0\s+running\s+.*\s+1\.0%\s+976\.6K of 32M\s+1\.9M of 32M`))
+				Expect(testUI.Out).To(Say(`#1\s+crashed\s+.*Exited with status 1`))
+				Expect(testUI.Out).To(Say(`worker:0/0`))
+				Expect(testUI.Out).To(Say(`command:\s+\[hidden\]`))
+				Expect(testUI.Out).To(Say(`instances:\s+0`))
+				Expect(testUI.Out).To(Say(`health check:\s+process`))
+				Expect(testUI.Out).ToNot(Say(`state\s+since`))
+
+				Expect(testUI.Err).To(Say("get-processes-warning"))
+			})
+
+			Context("when --json is passed", func() {
+				BeforeEach(func() {
+					cmd.JSON = true
+				})
+
+				It("outputs the processes and the warnings as JSON", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					var output struct {
+						Processes []struct {
+							GUID            string `json:"guid"`
+							Type            string `json:"type"`
+							Command         string `json:"command"`
+							Instances       int    `json:"instances"`
+							MemoryInMB      uint64 `json:"memory_in_mb"`
+							DiskInMB        uint64 `json:"disk_in_mb"`
+							HealthCheckType string `json:"health_check_type"`
+							InstanceStats   []struct {
+								Index   int    `json:"index"`
+								State   string `json:"state"`
+								Details string `json:"details"`
+							} `json:"instance_stats"`
+						} `json:"processes"`
+						Warnings []string `json:"warnings"`
+					}
+					Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &output)).To(Succeed())
+
+					Expect(output.Warnings).To(ConsistOf("get-processes-warning"))
+					Expect(output.Processes).To(HaveLen(2))
+					Expect(output.Processes[0].GUID).To(Equal("web-guid"))
+					Expect(output.Processes[0].Type).To(Equal("web"))
+					Expect(output.Processes[0].Command).To(Equal("bundle exec rackup"))
+					Expect(output.Processes[0].Instances).To(Equal(2))
+					Expect(output.Processes[0].MemoryInMB).To(BeEquivalentTo(256))
+					Expect(output.Processes[0].DiskInMB).To(BeEquivalentTo(1024))
+					Expect(output.Processes[0].HealthCheckType).To(Equal("port"))
+					Expect(output.Processes[0].InstanceStats).To(HaveLen(2))
+					Expect(output.Processes[0].InstanceStats[1].Index).To(Equal(1))
+					Expect(output.Processes[0].InstanceStats[1].State).To(Equal("CRASHED"))
+					Expect(output.Processes[0].InstanceStats[1].Details).To(Equal("Exited with status 1"))
+					Expect(output.Processes[1].Type).To(Equal("worker"))
+					Expect(output.Processes[1].InstanceStats).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationProcessSummariesByNameAndSpaceReturns(
+					nil,
+					v3action.Warnings{"get-app-warning"},
+					v3action.ApplicationNotFoundError{Name: "some-app-name"})
+			})
+
+			It("returns an ApplicationNotFoundError and displays the warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(testUI.Err).To(Say("get-app-warning"))
+			})
+
+			Context("when --json is passed", func() {
+				BeforeEach(func() {
+					cmd.JSON = true
+				})
+
+				It("returns the error and displays the warnings", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app-name"}))
+					Expect(testUI.Err).To(Say("get-app-warning"))
+				})
+			})
+		})
+
+		Context("when getting the processes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get processes error")
+				fakeActor.GetApplicationProcessSummariesByNameAndSpaceReturns(nil, v3action.Warnings{"get-processes-warning"}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("get-processes-warning"))
+			})
+		})
+	})
+})
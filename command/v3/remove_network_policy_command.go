@@ -16,16 +16,19 @@ import (
 //go:generate counterfeiter . RemoveNetworkPolicyActor
 
 type RemoveNetworkPolicyActor interface {
-	RemoveNetworkPolicy(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	NetworkPolicyDestinationActor
+	RemoveNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
 }
 
 type RemoveNetworkPolicyCommand struct {
-	RequiredArgs   flag.RemoveNetworkPolicyArgs `positional-args:"yes"`
-	DestinationApp string                       `long:"destination-app" required:"true" description:"Name of app to connect to"`
-	Port           flag.NetworkPort             `long:"port" required:"true" description:"Port or range of ports that destination app is connected with"`
-	Protocol       flag.NetworkProtocol         `long:"protocol" required:"true" description:"Protocol that apps are connected with"`
-
-	usage           interface{} `usage:"CF_NAME remove-network-policy SOURCE_APP --destination-app DESTINATION_APP --protocol (tcp | udp) --port RANGE\n\nEXAMPLES:\n   CF_NAME remove-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME remove-network-policy frontend --destination-app backend --protocol tcp --port 8080-8090"`
+	RequiredArgs     flag.RemoveNetworkPolicyArgs `positional-args:"yes"`
+	DestinationApp   string                       `long:"destination-app" required:"true" description:"Name of app to connect to"`
+	DestinationOrg   string                       `short:"o" description:"The org of the destination app's space (Default: targeted org)"`
+	DestinationSpace string                       `short:"s" description:"The space of the destination app (Default: targeted space)"`
+	Port             flag.NetworkPort             `long:"port" required:"true" description:"Port or range of ports that destination app is connected with"`
+	Protocol         flag.NetworkProtocol         `long:"protocol" required:"true" description:"Protocol that apps are connected with"`
+
+	usage           interface{} `usage:"CF_NAME remove-network-policy SOURCE_APP --destination-app DESTINATION_APP [-s DESTINATION_SPACE_NAME [-o DESTINATION_ORG_NAME]] --protocol (tcp | udp) --port RANGE\n\nEXAMPLES:\n   CF_NAME remove-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME remove-network-policy frontend --destination-app backend --protocol tcp --port 8080-8090\n   CF_NAME remove-network-policy frontend --destination-app backend -s backend-space -o backend-org --protocol tcp --port 8080"`
 	relatedCommands interface{} `related_commands:"apps, network-policies"`
 
 	UI          command.UI
@@ -59,7 +62,12 @@ func (cmd *RemoveNetworkPolicyCommand) Setup(config command.Config, ui command.U
 }
 
 func (cmd RemoveNetworkPolicyCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	err := checkNetworkPolicyDestinationFlags(cmd.DestinationSpace, cmd.DestinationOrg)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
 	}
@@ -75,7 +83,12 @@ func (cmd RemoveNetworkPolicyCommand) Execute(args []string) error {
 		"User":       user.Name,
 	})
 
-	warnings, err := cmd.Actor.RemoveNetworkPolicy(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SourceApp, cmd.DestinationApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
+	destSpaceGUID, err := networkPolicyDestinationSpaceGUID(cmd.Actor, cmd.Config, cmd.UI, cmd.DestinationSpace, cmd.DestinationOrg)
+	if err != nil {
+		return err
+	}
+
+	warnings, err := cmd.Actor.RemoveNetworkPolicy(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SourceApp, destSpaceGUID, cmd.DestinationApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		switch err.(type) {
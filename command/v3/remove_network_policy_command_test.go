@@ -94,9 +94,10 @@ var _ = Describe("remove-network-policy Command", func() {
 			It("displays OK when no error occurs", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 				Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(1))
-				passedSpaceGuid, passedSrcAppName, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
+				passedSpaceGuid, passedSrcAppName, passedDestSpaceGuid, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
 				Expect(passedSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedSrcAppName).To(Equal("some-app"))
+				Expect(passedDestSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedDestAppName).To(Equal("some-other-app"))
 				Expect(passedProtocol).To(Equal("tcp"))
 				Expect(passedStartPort).To(Equal(8080))
@@ -109,6 +110,61 @@ var _ = Describe("remove-network-policy Command", func() {
 			})
 		})
 
+		Context("when the destination app is in another space", func() {
+			BeforeEach(func() {
+				cmd.DestinationSpace = "other-space"
+				fakeActor.GetSpaceGUIDByNameAndOrganizationNameReturns("other-space-guid", cfnetworkingaction.Warnings{"space-warning"}, nil)
+			})
+
+			It("looks up the space in the targeted org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetSpaceGUIDByNameAndOrganizationNameCallCount()).To(Equal(1))
+				spaceName, orgName := fakeActor.GetSpaceGUIDByNameAndOrganizationNameArgsForCall(0)
+				Expect(spaceName).To(Equal("other-space"))
+				Expect(orgName).To(Equal("some-org"))
+
+				passedSpaceGuid, _, passedDestSpaceGuid, _, _, _, _ := fakeActor.RemoveNetworkPolicyArgsForCall(0)
+				Expect(passedSpaceGuid).To(Equal("some-space-guid"))
+				Expect(passedDestSpaceGuid).To(Equal("other-space-guid"))
+				Expect(testUI.Err).To(Say("space-warning"))
+			})
+
+			Context("when the destination org is given", func() {
+				BeforeEach(func() {
+					cmd.DestinationOrg = "other-org"
+				})
+
+				It("looks up the space in that org", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					_, orgName := fakeActor.GetSpaceGUIDByNameAndOrganizationNameArgsForCall(0)
+					Expect(orgName).To(Equal("other-org"))
+				})
+			})
+
+			Context("when the space cannot be found", func() {
+				BeforeEach(func() {
+					fakeActor.GetSpaceGUIDByNameAndOrganizationNameReturns("", nil, v3action.SpaceNotFoundError{Name: "other-space"})
+				})
+
+				It("returns the error without changing policies", func() {
+					Expect(executeErr).To(MatchError(translatableerror.SpaceNotFoundError{Name: "other-space"}))
+					Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the destination org is given without the destination space", func() {
+			BeforeEach(func() {
+				cmd.DestinationOrg = "other-org"
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "-o", Arg2: "-s"}))
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("when the policy does not exist", func() {
 			BeforeEach(func() {
 				fakeActor.RemoveNetworkPolicyReturns(cfnetworkingaction.Warnings{"some-warning-1", "some-warning-2"}, cfnetworkingaction.PolicyDoesNotExistError{})
@@ -117,9 +173,10 @@ var _ = Describe("remove-network-policy Command", func() {
 			It("displays OK when no error occurs", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 				Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(1))
-				passedSpaceGuid, passedSrcAppName, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
+				passedSpaceGuid, passedSrcAppName, passedDestSpaceGuid, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
 				Expect(passedSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedSrcAppName).To(Equal("some-app"))
+				Expect(passedDestSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedDestAppName).To(Equal("some-other-app"))
 				Expect(passedProtocol).To(Equal("tcp"))
 				Expect(passedStartPort).To(Equal(8080))
@@ -3,6 +3,7 @@ package v3
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
@@ -18,6 +19,7 @@ import (
 
 type RunTaskActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetRunningTasksByNameAndApplication(name string, appGUID string) ([]v3action.Task, v3action.Warnings, error)
 	RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
 	CloudControllerAPIVersion() string
 }
@@ -27,7 +29,8 @@ type RunTaskCommand struct {
 	Disk            flag.Megabytes   `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	Memory          flag.Megabytes   `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	Name            string           `long:"name" description:"Name to give the task (generated if omitted)"`
-	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate"`
+	FailOnDuplicate bool             `long:"fail-on-duplicate" description:"Fail instead of warning when a running task of the app already has the name"`
+	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME [--fail-on-duplicate]]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate"`
 	relatedCommands interface{}      `related_commands:"logs, tasks, terminate-task"`
 
 	UI          command.UI
@@ -85,6 +88,13 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		"CurrentUser": user.Name,
 	})
 
+	if cmd.Name != "" {
+		err = cmd.checkRunningTaskNames(application.GUID)
+		if err != nil {
+			return err
+		}
+	}
+
 	inputTask := v3action.Task{
 		Command: cmd.RequiredArgs.Command,
 	}
@@ -115,3 +125,40 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// checkRunningTaskNames warns, or fails with --fail-on-duplicate, when the
+// app already has running tasks with the requested name, since such tasks can
+// no longer be told apart by name.
+func (cmd RunTaskCommand) checkRunningTaskNames(appGUID string) error {
+	tasks, warnings, err := cmd.Actor.GetRunningTasksByNameAndApplication(cmd.Name, appGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	sequenceIDs := make([]int, len(tasks))
+	for i, task := range tasks {
+		sequenceIDs[i] = task.SequenceID
+	}
+
+	if cmd.FailOnDuplicate {
+		return translatableerror.RunningTaskNameTakenError{
+			TaskName:    cmd.Name,
+			SequenceIDs: sequenceIDs,
+		}
+	}
+
+	ids := make([]string, len(sequenceIDs))
+	for i, id := range sequenceIDs {
+		ids[i] = fmt.Sprint(id)
+	}
+	cmd.UI.DisplayWarning("A task named {{.TaskName}} is already running, with task IDs: {{.SequenceIDs}}. Use the task ID to refer to a specific task.", map[string]interface{}{
+		"TaskName":    cmd.Name,
+		"SequenceIDs": strings.Join(ids, ", "),
+	})
+	return nil
+}
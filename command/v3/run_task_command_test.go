@@ -203,6 +203,67 @@ get-application-warning-3`))
 					})
 				})
 
+				Context("when running tasks already have the provided name", func() {
+					BeforeEach(func() {
+						cmd.Name = "some-task-name"
+						fakeActor.GetRunningTasksByNameAndApplicationReturns(
+							[]v3action.Task{{SequenceID: 1}, {SequenceID: 2}},
+							v3action.Warnings{"get-running-tasks-warning"},
+							nil)
+						fakeActor.RunTaskReturns(
+							v3action.Task{
+								Name:       "some-task-name",
+								SequenceID: 3,
+							},
+							nil,
+							nil)
+					})
+
+					It("warns about the duplicate name and creates the task", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.GetRunningTasksByNameAndApplicationCallCount()).To(Equal(1))
+						name, appGUID := fakeActor.GetRunningTasksByNameAndApplicationArgsForCall(0)
+						Expect(name).To(Equal("some-task-name"))
+						Expect(appGUID).To(Equal("some-app-guid"))
+
+						Expect(fakeActor.RunTaskCallCount()).To(Equal(1))
+						Expect(testUI.Err).To(Say("get-running-tasks-warning"))
+						Expect(testUI.Err).To(Say("A task named some-task-name is already running, with task IDs: 1, 2. Use the task ID to refer to a specific task."))
+						Expect(testUI.Out).To(Say("task id:     3"))
+					})
+
+					Context("when --fail-on-duplicate is provided", func() {
+						BeforeEach(func() {
+							cmd.FailOnDuplicate = true
+						})
+
+						It("returns a RunningTaskNameTakenError and does not create the task", func() {
+							Expect(executeErr).To(MatchError(translatableerror.RunningTaskNameTakenError{
+								TaskName:    "some-task-name",
+								SequenceIDs: []int{1, 2},
+							}))
+
+							Expect(testUI.Err).To(Say("get-running-tasks-warning"))
+							Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when looking up the running tasks fails", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("get running tasks error")
+							fakeActor.GetRunningTasksByNameAndApplicationReturns(nil, nil, expectedErr)
+						})
+
+						It("returns the error and does not create the task", func() {
+							Expect(executeErr).To(MatchError(expectedErr))
+							Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+						})
+					})
+				})
+
 				Context("when task disk space is provided", func() {
 					BeforeEach(func() {
 						cmd.Name = "some-task-name"
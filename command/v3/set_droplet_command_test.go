@@ -0,0 +1,190 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-droplet Command", func() {
+	var (
+		cmd             v3.SetDropletCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeSetDropletActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeSetDropletActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.SetDropletCommand{
+			RequiredArgs: flag.AppDroplet{AppName: "some-app", DropletGUID: "some-droplet-guid"},
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the app cannot be found", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, v3action.Warnings{"get-app-warning"}, v3action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the droplet is in an unusable state", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid", State: "STARTED"}, nil, nil)
+			fakeActor.SetApplicationDropletReturns(
+				v3action.Warnings{"set-droplet-warning"},
+				v3action.InvalidDropletStateError{GUID: "some-droplet-guid", State: v3action.DropletStateFailed},
+			)
+		})
+
+		It("returns an InvalidDropletStateError and does not restart the app", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidDropletStateError{GUID: "some-droplet-guid", State: "FAILED"}))
+			Expect(testUI.Err).To(Say("set-droplet-warning"))
+			Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+			Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the droplet is set", func() {
+		BeforeEach(func() {
+			fakeActor.SetApplicationDropletReturns(v3action.Warnings{"set-droplet-warning"}, nil)
+		})
+
+		Context("when the app is stopped", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid", State: "STOPPED"}, nil, nil)
+			})
+
+			It("sets the droplet without starting the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Setting app some-app to droplet some-droplet-guid in org some-org / space some-space as steve\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("set-droplet-warning"))
+
+				Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(1))
+				appName, spaceGUID, dropletGUID := fakeActor.SetApplicationDropletArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+
+				Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+				Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app is started", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid", State: "STARTED"}, nil, nil)
+				fakeActor.StopApplicationReturns(v3action.Warnings{"stop-warning"}, nil)
+				fakeActor.StartApplicationReturns(v3action.Application{}, v3action.Warnings{"start-warning"}, nil)
+			})
+
+			It("sets the droplet and restarts the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Setting app some-app to droplet some-droplet-guid in org some-org / space some-space as steve\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Stopping app some-app in org some-org / space some-space as steve\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Starting app some-app in org some-org / space some-space as steve\\.\\.\\."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(testUI.Err).To(Say("set-droplet-warning"))
+				Expect(testUI.Err).To(Say("stop-warning"))
+				Expect(testUI.Err).To(Say("start-warning"))
+
+				Expect(fakeActor.StopApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeActor.StartApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+
+			Context("when starting the app fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("start error")
+					fakeActor.StartApplicationReturns(v3action.Application{}, v3action.Warnings{"start-warning"}, expectedErr)
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("start-warning"))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,185 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-label Command", func() {
+	var (
+		cmd             v3.SetLabelCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeSetLabelActor
+		binaryName      string
+		executeErr      error
+		expectedLabels  map[string]types.NullString
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeSetLabelActor)
+
+		cmd = v3.SetLabelCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+
+		cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: flag.LabelResourceOrg}
+		cmd.RequiredArgs.ResourceName = "some-org"
+		cmd.RequiredArgs.Labels = []flag.Label{
+			{Key: "env", Value: "prod"},
+			{Key: "owner", Value: ""},
+		}
+		expectedLabels = map[string]types.NullString{
+			"env":   types.NewNullString("prod"),
+			"owner": types.NewNullString(""),
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.62.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "3.62.0",
+				MinimumVersion: ccversion.MinVersionMetadataV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when fetching the user fails", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some-error"))
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+
+	Context("when setting labels on an org", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateOrganizationLabelsByOrganizationNameReturns(v3action.Warnings{"some-warning"}, nil)
+		})
+
+		It("does not require a targeted org or space", func() {
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+
+		It("sets the labels and displays all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.UpdateOrganizationLabelsByOrganizationNameCallCount()).To(Equal(1))
+			orgName, labels := fakeActor.UpdateOrganizationLabelsByOrganizationNameArgsForCall(0)
+			Expect(orgName).To(Equal("some-org"))
+			Expect(labels).To(Equal(expectedLabels))
+
+			Expect(testUI.Out).To(Say(`Setting label\(s\) for org some-org as banana\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		Context("when the org does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateOrganizationLabelsByOrganizationNameReturns(
+					v3action.Warnings{"some-warning"},
+					v3action.OrganizationNotFoundError{Name: "some-org"})
+			})
+
+			It("returns an OrganizationNotFoundError and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(translatableerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(testUI.Err).To(Say("some-warning"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+
+	Context("when setting labels on a space", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: flag.LabelResourceSpace}
+			cmd.RequiredArgs.ResourceName = "some-space"
+		})
+
+		It("sets the labels on the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(fakeActor.UpdateSpaceLabelsBySpaceNameCallCount()).To(Equal(1))
+			spaceName, orgGUID, labels := fakeActor.UpdateSpaceLabelsBySpaceNameArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(labels).To(Equal(expectedLabels))
+
+			Expect(testUI.Out).To(Say(`Setting label\(s\) for space some-space as banana\.\.\.`))
+		})
+	})
+
+	Context("when setting labels on an app", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: flag.LabelResourceApp}
+			cmd.RequiredArgs.ResourceName = "some-app"
+		})
+
+		It("sets the labels on the app in the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+
+			Expect(fakeActor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(1))
+			appName, spaceGUID, labels := fakeActor.UpdateApplicationLabelsByApplicationNameArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(labels).To(Equal(expectedLabels))
+		})
+	})
+})
@@ -0,0 +1,146 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . ShareServiceActor
+
+type ShareServiceActor interface {
+	CloudControllerAPIVersion() string
+	ShareServiceInstanceToSpace(serviceInstanceGUID string, spaceGUID string) (v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . ShareServiceActorV2
+
+type ShareServiceActorV2 interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+type ShareServiceCommand struct {
+	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
+	SpaceName       string               `short:"s" required:"true" description:"Space to share the service instance into"`
+	OrgName         string               `short:"o" description:"Org of the other space (Default: targeted org)"`
+	usage           interface{}          `usage:"CF_NAME share-service SERVICE_INSTANCE -s OTHER_SPACE [-o OTHER_ORG]"`
+	relatedCommands interface{}          `related_commands:"bind-service, service, services, unshare-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ShareServiceActor
+	ActorV2     ShareServiceActorV2
+}
+
+func (cmd *ShareServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionShareServiceV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.ActorV2 = v2action.NewActor(ccClientV2, uaaClientV2, config)
+
+	return nil
+}
+
+func (cmd ShareServiceCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionShareServiceV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgName := cmd.OrgName
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Sharing service instance {{.ServiceInstanceName}} into org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":             orgName,
+		"SpaceName":           cmd.SpaceName,
+		"Username":            user.Name,
+	})
+
+	serviceInstance, warnings, err := cmd.ActorV2.GetServiceInstanceByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV2.HandleError(err)
+	}
+
+	space, err := getShareTargetSpace(cmd.UI, cmd.Config, cmd.ActorV2, cmd.OrgName, cmd.SpaceName)
+	if err != nil {
+		return err
+	}
+
+	v3Warnings, err := cmd.Actor.ShareServiceInstanceToSpace(serviceInstance.GUID, space.GUID)
+	cmd.UI.DisplayWarnings(v3Warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+type shareTargetActor interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+// getShareTargetSpace returns the space a service instance is shared into or
+// unshared from. The space is looked up in the targeted org unless orgName is
+// provided.
+func getShareTargetSpace(ui command.UI, config command.Config, actor shareTargetActor, orgName string, spaceName string) (v2action.Space, error) {
+	orgGUID := config.TargetedOrganization().GUID
+	if orgName != "" {
+		org, warnings, err := actor.GetOrganizationByName(orgName)
+		ui.DisplayWarnings(warnings)
+		if err != nil {
+			return v2action.Space{}, sharedV2.HandleError(err)
+		}
+		orgGUID = org.GUID
+	}
+
+	space, warnings, err := actor.GetSpaceByOrganizationAndName(orgGUID, spaceName)
+	ui.DisplayWarnings(warnings)
+	if err != nil {
+		return v2action.Space{}, sharedV2.HandleError(err)
+	}
+	return space, nil
+}
@@ -0,0 +1,206 @@
+package shared
+
+import (
+	"reflect"
+	"sort"
+
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// GetManifestChanges compares an app's current settings with the ones the
+// manifest asks for. Only settings present in the manifest are included,
+// because applying a manifest leaves everything else alone. Values are put in
+// a normal form first, so "1024M" and "1G" or routes listed in another order
+// are not reported as changes. For an app that does not exist yet, pass an
+// empty current application.
+func GetManifestChanges(current manifest.Application, desired manifest.Application) []ui.Change {
+	changes := []ui.Change{
+		{
+			Header:       "name:",
+			CurrentValue: current.Name,
+			NewValue:     desired.Name,
+		},
+	}
+
+	if desired.DockerImage != "" {
+		changes = append(changes,
+			ui.Change{
+				Header:       "docker image:",
+				CurrentValue: current.DockerImage,
+				NewValue:     desired.DockerImage,
+			})
+	}
+
+	if desired.DockerUsername != "" {
+		changes = append(changes,
+			ui.Change{
+				Header:       "docker username:",
+				CurrentValue: current.DockerUsername,
+				NewValue:     desired.DockerUsername,
+			})
+	}
+
+	if desired.Buildpack.IsSet {
+		changes = append(changes,
+			ui.Change{
+				Header:       "buildpack:",
+				CurrentValue: current.Buildpack.Value,
+				NewValue:     desired.Buildpack.Value,
+			})
+	}
+
+	if desired.Command.IsSet {
+		changes = append(changes,
+			ui.Change{
+				Header:       "command:",
+				CurrentValue: current.Command.Value,
+				NewValue:     desired.Command.Value,
+			})
+	}
+
+	if desired.DiskQuota.IsSet {
+		changes = append(changes,
+			ui.Change{
+				Header:       "disk quota:",
+				CurrentValue: current.DiskQuota.String(),
+				NewValue:     desired.DiskQuota.String(),
+			})
+	}
+
+	// The current settings leave out the defaults the same way
+	// create-app-manifest does, so fill them back in for existing apps.
+	currentHealthCheckType := current.HealthCheckType
+	currentHealthCheckHTTPEndpoint := current.HealthCheckHTTPEndpoint
+	if current.Name != "" {
+		if currentHealthCheckType == "" {
+			currentHealthCheckType = "port"
+		}
+		if currentHealthCheckType == "http" && currentHealthCheckHTTPEndpoint == "" {
+			currentHealthCheckHTTPEndpoint = "/"
+		}
+	}
+
+	if desired.HealthCheckHTTPEndpoint != "" {
+		changes = append(changes,
+			ui.Change{
+				Header:       "health check http endpoint:",
+				CurrentValue: currentHealthCheckHTTPEndpoint,
+				NewValue:     desired.HealthCheckHTTPEndpoint,
+			})
+	}
+
+	if desired.HealthCheckTimeout != 0 {
+		changes = append(changes,
+			ui.Change{
+				Header:       "health check timeout:",
+				CurrentValue: current.HealthCheckTimeout,
+				NewValue:     desired.HealthCheckTimeout,
+			})
+	}
+
+	if desired.HealthCheckType != "" {
+		changes = append(changes,
+			ui.Change{
+				Header:       "health check type:",
+				CurrentValue: currentHealthCheckType,
+				NewValue:     desired.HealthCheckType,
+			})
+	}
+
+	if desired.Instances.IsSet {
+		changes = append(changes,
+			ui.Change{
+				Header:       "instances:",
+				CurrentValue: current.Instances,
+				NewValue:     desired.Instances,
+			})
+	}
+
+	if desired.Memory.IsSet {
+		changes = append(changes,
+			ui.Change{
+				Header:       "memory:",
+				CurrentValue: current.Memory.String(),
+				NewValue:     desired.Memory.String(),
+			})
+	}
+
+	if desired.StackName != "" {
+		changes = append(changes,
+			ui.Change{
+				Header:       "stack:",
+				CurrentValue: current.StackName,
+				NewValue:     desired.StackName,
+			})
+	}
+
+	// Applying a manifest binds services, merges environment variables and
+	// maps routes, but never removes any. Only the current entries the
+	// manifest also lists are shown, so nothing appears to be removed.
+	if len(desired.Services) > 0 {
+		changes = append(changes,
+			ui.Change{
+				Header:       "services:",
+				CurrentValue: sortedIntersection(current.Services, desired.Services),
+				NewValue:     sortedIntersection(desired.Services, desired.Services),
+			})
+	}
+
+	if len(desired.EnvironmentVariables) > 0 {
+		currentEnv := map[string]string{}
+		for name := range desired.EnvironmentVariables {
+			if value, ok := current.EnvironmentVariables[name]; ok {
+				currentEnv[name] = value
+			}
+		}
+
+		changes = append(changes,
+			ui.Change{
+				Header:       "env:",
+				CurrentValue: currentEnv,
+				NewValue:     desired.EnvironmentVariables,
+			})
+	}
+
+	if len(desired.Routes) > 0 {
+		changes = append(changes,
+			ui.Change{
+				Header:       "routes:",
+				CurrentValue: sortedIntersection(current.Routes, desired.Routes),
+				NewValue:     sortedIntersection(desired.Routes, desired.Routes),
+			})
+	}
+
+	return changes
+}
+
+// ManifestHasChanges returns true if any of the changes would modify the app.
+func ManifestHasChanges(changes []ui.Change) bool {
+	for _, change := range changes {
+		if !reflect.DeepEqual(change.CurrentValue, change.NewValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedIntersection returns the sorted, de-duplicated values of list that
+// are also in filter. It never returns nil, so empty lists compare equal.
+func sortedIntersection(list []string, filter []string) []string {
+	keep := map[string]bool{}
+	for _, value := range filter {
+		keep[value] = true
+	}
+
+	values := []string{}
+	for _, value := range list {
+		if keep[value] {
+			values = append(values, value)
+			delete(keep, value)
+		}
+	}
+
+	sort.Strings(values)
+	return values
+}
@@ -0,0 +1,101 @@
+package shared_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetManifestChanges", func() {
+	var (
+		current manifest.Application
+		desired manifest.Application
+		changes []ui.Change
+	)
+
+	BeforeEach(func() {
+		current = manifest.Application{
+			Name:                 "some-app",
+			Instances:            types.NullInt{Value: 2, IsSet: true},
+			Memory:               types.NullByteSizeInMb{Value: 1024, IsSet: true},
+			DiskQuota:            types.NullByteSizeInMb{Value: 512, IsSet: true},
+			EnvironmentVariables: map[string]string{"FOO": "bar", "OTHER": "value"},
+			Routes:               []string{"b.example.com", "a.example.com", "c.example.com"},
+			Services:             []string{"some-db"},
+			StackName:            "cflinuxfs2",
+		}
+
+		desired = manifest.Application{Name: "some-app"}
+	})
+
+	JustBeforeEach(func() {
+		changes = GetManifestChanges(current, desired)
+	})
+
+	It("only includes the name when the manifest sets nothing else", func() {
+		Expect(changes).To(Equal([]ui.Change{
+			{Header: "name:", CurrentValue: "some-app", NewValue: "some-app"},
+		}))
+		Expect(ManifestHasChanges(changes)).To(BeFalse())
+	})
+
+	Context("when the manifest repeats the current settings in another form", func() {
+		BeforeEach(func() {
+			Expect(desired.Memory.ParseStringValue("1G")).To(Succeed())
+			Expect(desired.DiskQuota.ParseStringValue("512M")).To(Succeed())
+			desired.Instances = types.NullInt{Value: 2, IsSet: true}
+			desired.HealthCheckType = "port"
+			desired.EnvironmentVariables = map[string]string{"FOO": "bar"}
+			desired.Routes = []string{"c.example.com", "a.example.com"}
+			desired.Services = []string{"some-db"}
+		})
+
+		It("reports no changes", func() {
+			Expect(ManifestHasChanges(changes)).To(BeFalse())
+		})
+	})
+
+	Context("when the manifest changes settings", func() {
+		BeforeEach(func() {
+			Expect(desired.Memory.ParseStringValue("2G")).To(Succeed())
+			desired.Instances = types.NullInt{Value: 3, IsSet: true}
+			desired.HealthCheckType = "http"
+			desired.HealthCheckHTTPEndpoint = "/health"
+			desired.EnvironmentVariables = map[string]string{"FOO": "baz", "NEW": "value"}
+			desired.Routes = []string{"a.example.com", "d.example.com"}
+		})
+
+		It("returns the current and desired values of the changed settings", func() {
+			Expect(ManifestHasChanges(changes)).To(BeTrue())
+			Expect(changes).To(ConsistOf(
+				ui.Change{Header: "name:", CurrentValue: "some-app", NewValue: "some-app"},
+				ui.Change{Header: "health check http endpoint:", CurrentValue: "", NewValue: "/health"},
+				ui.Change{Header: "health check type:", CurrentValue: "port", NewValue: "http"},
+				ui.Change{Header: "instances:", CurrentValue: types.NullInt{Value: 2, IsSet: true}, NewValue: types.NullInt{Value: 3, IsSet: true}},
+				ui.Change{Header: "memory:", CurrentValue: "1G", NewValue: "2G"},
+				ui.Change{Header: "env:", CurrentValue: map[string]string{"FOO": "bar"}, NewValue: map[string]string{"FOO": "baz", "NEW": "value"}},
+				ui.Change{Header: "routes:", CurrentValue: []string{"a.example.com"}, NewValue: []string{"a.example.com", "d.example.com"}},
+			))
+		})
+	})
+
+	Context("when the app does not exist yet", func() {
+		BeforeEach(func() {
+			current = manifest.Application{}
+			desired.Instances = types.NullInt{Value: 1, IsSet: true}
+			desired.HealthCheckType = "port"
+		})
+
+		It("shows every setting as new", func() {
+			Expect(ManifestHasChanges(changes)).To(BeTrue())
+			Expect(changes).To(Equal([]ui.Change{
+				{Header: "name:", CurrentValue: "", NewValue: "some-app"},
+				{Header: "health check type:", CurrentValue: "", NewValue: "port"},
+				{Header: "instances:", CurrentValue: types.NullInt{}, NewValue: types.NullInt{Value: 1, IsSet: true}},
+			}))
+		})
+	})
+})
@@ -3,16 +3,22 @@ package shared
 import (
 	"strings"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/labelselector"
 )
 
 func HandleError(err error) error {
 	switch e := err.(type) {
 	case ccerror.APINotFoundError:
 		return translatableerror.APINotFoundError(e)
+	case ccerror.CloudControllerUnavailableError:
+		return translatableerror.CloudControllerUnavailableError{URL: e.URL}
+	case ccerror.IncompleteDownloadError:
+		return translatableerror.IncompleteDownloadError(e)
 	case ccerror.RequestError:
 		return translatableerror.APIRequestError(e)
 	case ccerror.SSLValidationHostnameError:
@@ -24,6 +30,13 @@ func HandleError(err error) error {
 		}
 	case ccerror.UnverifiedServerError:
 		return translatableerror.InvalidSSLCertError{API: e.URL}
+	case ccerror.FoundationMismatchError:
+		return translatableerror.FoundationMismatchError{}
+	case ccerror.JobTimeoutError:
+		return translatableerror.JobTimeoutError{JobGUID: e.JobGUID}
+
+	case labelselector.SyntaxError:
+		return translatableerror.InvalidLabelSelectorError{Selector: e.Selector, Column: e.Column(), Reason: e.Reason}
 
 	case sharedaction.NotLoggedInError:
 		return translatableerror.NotLoggedInError(e)
@@ -32,22 +45,45 @@ func HandleError(err error) error {
 	case sharedaction.NoSpaceTargetedError:
 		return translatableerror.NoSpaceTargetedError(e)
 
+	case actionerror.DeletionFailedError:
+		return translatableerror.DeletionFailedError{Reasons: e.Reasons}
+
 	case v3action.ApplicationNotFoundError:
 		return translatableerror.ApplicationNotFoundError(e)
 	case v3action.AssignDropletError:
 		return translatableerror.AssignDropletError(e)
+	case v3action.BuildNotFoundError:
+		return translatableerror.BuildNotFoundError(e)
+	case v3action.DropletNotFoundError:
+		return translatableerror.DropletNotFoundError(e)
 	case v3action.EmptyDirectoryError:
 		return translatableerror.EmptyDirectoryError(e)
+	case v3action.FeatureFlagDisabledError:
+		return translatableerror.FeatureFlagDisabledError(e)
+	case v3action.HTTPHealthCheckInvalidError:
+		return translatableerror.HTTPHealthCheckInvalidError{}
+	case v3action.InvalidDropletStateError:
+		return translatableerror.InvalidDropletStateError{GUID: e.GUID, State: string(e.State)}
+	case v3action.NoReadyPackageError:
+		return translatableerror.NoReadyPackageError(e)
+	case v3action.IsolationSegmentAssignedToSpacesError:
+		return translatableerror.IsolationSegmentAssignedToSpacesError(e)
 	case v3action.IsolationSegmentNotFoundError:
 		return translatableerror.IsolationSegmentNotFoundError(e)
 	case v3action.OrganizationNotFoundError:
 		return translatableerror.OrganizationNotFoundError(e)
+	case v3action.OrganizationsNotFoundError:
+		return translatableerror.OrganizationsNotFoundError(e)
 	case v3action.ProcessNotFoundError:
 		return translatableerror.ProcessNotFoundError(e)
 	case v3action.ProcessInstanceNotFoundError:
 		return translatableerror.ProcessInstanceNotFoundError(e)
+	case v3action.SpaceNotFoundError:
+		return translatableerror.SpaceNotFoundError(e)
 	case v3action.StagingTimeoutError:
 		return translatableerror.StagingTimeoutError(e)
+	case v3action.MultipleRunningTasksFoundError:
+		return translatableerror.TaskNameNotUniqueError{TaskName: e.Name, SequenceIDs: e.SequenceIDs}
 	case v3action.TaskWorkersUnavailableError:
 		return translatableerror.RunTaskError{Message: "Task workers are unavailable."}
 	}
@@ -4,11 +4,13 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/labelselector"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -32,6 +34,18 @@ var _ = Describe("HandleError", func() {
 			ccerror.UnverifiedServerError{URL: "some-url"},
 			translatableerror.InvalidSSLCertError{API: "some-url"}),
 
+		Entry("ccerror.FoundationMismatchError -> FoundationMismatchError",
+			ccerror.FoundationMismatchError{Issuer: "some-issuer", UAAEndpoint: "some-uaa"},
+			translatableerror.FoundationMismatchError{}),
+
+		Entry("ccerror.JobTimeoutError -> JobTimeoutError",
+			ccerror.JobTimeoutError{JobGUID: "some-job-guid"},
+			translatableerror.JobTimeoutError{JobGUID: "some-job-guid"}),
+
+		Entry("actionerror.DeletionFailedError -> DeletionFailedError",
+			actionerror.DeletionFailedError{JobGUID: "some-job-guid", Reasons: []string{"some-reason"}},
+			translatableerror.DeletionFailedError{Reasons: []string{"some-reason"}}),
+
 		Entry("ccerror.SSLValidationHostnameError -> SSLCertErrorError",
 			ccerror.SSLValidationHostnameError{Message: "some-message"},
 			translatableerror.SSLCertError{Message: "some-message"}),
@@ -53,10 +67,22 @@ var _ = Describe("HandleError", func() {
 			ccerror.APINotFoundError{URL: "some-url"},
 			translatableerror.APINotFoundError{URL: "some-url"}),
 
+		Entry("ccerror.CloudControllerUnavailableError -> CloudControllerUnavailableError",
+			ccerror.CloudControllerUnavailableError{StatusCode: 503, URL: "some-url"},
+			translatableerror.CloudControllerUnavailableError{URL: "some-url"}),
+
+		Entry("ccerror.IncompleteDownloadError -> IncompleteDownloadError",
+			ccerror.IncompleteDownloadError{ExpectedBytes: 42},
+			translatableerror.IncompleteDownloadError{ExpectedBytes: 42}),
+
 		Entry("v3action.ApplicationNotFoundError -> ApplicationNotFoundError",
 			v3action.ApplicationNotFoundError{Name: "some-app"},
 			translatableerror.ApplicationNotFoundError{Name: "some-app"}),
 
+		Entry("v3action.MultipleRunningTasksFoundError -> TaskNameNotUniqueError",
+			v3action.MultipleRunningTasksFoundError{Name: "some-task", SequenceIDs: []int{3, 5}},
+			translatableerror.TaskNameNotUniqueError{TaskName: "some-task", SequenceIDs: []int{3, 5}}),
+
 		Entry("v3action.TaskWorkersUnavailableError -> RunTaskError",
 			v3action.TaskWorkersUnavailableError{Message: "fooo: Banana Pants"},
 			translatableerror.RunTaskError{Message: "Task workers are unavailable."}),
@@ -77,10 +103,42 @@ var _ = Describe("HandleError", func() {
 			v3action.AssignDropletError{Message: "some-message"},
 			translatableerror.AssignDropletError{Message: "some-message"}),
 
+		Entry("v3action.BuildNotFoundError -> BuildNotFoundError",
+			v3action.BuildNotFoundError{GUID: "some-guid"},
+			translatableerror.BuildNotFoundError{GUID: "some-guid"}),
+
+		Entry("v3action.DropletNotFoundError -> DropletNotFoundError",
+			v3action.DropletNotFoundError{AppName: "some-app", GUID: "some-guid"},
+			translatableerror.DropletNotFoundError{AppName: "some-app", GUID: "some-guid"}),
+
+		Entry("v3action.FeatureFlagDisabledError -> FeatureFlagDisabledError",
+			v3action.FeatureFlagDisabledError{FeatureFlag: "some-feature-flag"},
+			translatableerror.FeatureFlagDisabledError{FeatureFlag: "some-feature-flag"}),
+
+		Entry("v3action.HTTPHealthCheckInvalidError -> HTTPHealthCheckInvalidError",
+			v3action.HTTPHealthCheckInvalidError{},
+			translatableerror.HTTPHealthCheckInvalidError{}),
+
+		Entry("v3action.InvalidDropletStateError -> InvalidDropletStateError",
+			v3action.InvalidDropletStateError{GUID: "some-guid", State: v3action.DropletStateExpired},
+			translatableerror.InvalidDropletStateError{GUID: "some-guid", State: "EXPIRED"}),
+
 		Entry("v3action.OrganizationNotFoundError -> OrgNotFoundError",
 			v3action.OrganizationNotFoundError{Name: "some-org"},
 			translatableerror.OrganizationNotFoundError{Name: "some-org"}),
 
+		Entry("v3action.OrganizationsNotFoundError -> OrganizationsNotFoundError",
+			v3action.OrganizationsNotFoundError{Names: []string{"some-org", "other-org"}},
+			translatableerror.OrganizationsNotFoundError{Names: []string{"some-org", "other-org"}}),
+
+		Entry("v3action.IsolationSegmentAssignedToSpacesError -> IsolationSegmentAssignedToSpacesError",
+			v3action.IsolationSegmentAssignedToSpacesError{IsolationSegmentName: "some-iso", OrganizationName: "some-org", SpaceNames: []string{"some-space"}},
+			translatableerror.IsolationSegmentAssignedToSpacesError{IsolationSegmentName: "some-iso", OrganizationName: "some-org", SpaceNames: []string{"some-space"}}),
+
+		Entry("v3action.NoReadyPackageError -> NoReadyPackageError",
+			v3action.NoReadyPackageError{AppName: "some-app"},
+			translatableerror.NoReadyPackageError{AppName: "some-app"}),
+
 		Entry("v3action.ProcessNotFoundError -> ProcessNotFoundError",
 			v3action.ProcessNotFoundError{ProcessType: "some-process-type"},
 			translatableerror.ProcessNotFoundError{ProcessType: "some-process-type"}),
@@ -89,6 +147,10 @@ var _ = Describe("HandleError", func() {
 			v3action.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42},
 			translatableerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42}),
 
+		Entry("v3action.SpaceNotFoundError -> SpaceNotFoundError",
+			v3action.SpaceNotFoundError{Name: "some-space"},
+			translatableerror.SpaceNotFoundError{Name: "some-space"}),
+
 		Entry("v3action.StagingTimeoutError -> StagingTimeoutError",
 			v3action.StagingTimeoutError{AppName: "some-app", Timeout: time.Nanosecond},
 			translatableerror.StagingTimeoutError{AppName: "some-app", Timeout: time.Nanosecond}),
@@ -97,6 +159,10 @@ var _ = Describe("HandleError", func() {
 			v3action.EmptyDirectoryError{Path: "some-path"},
 			translatableerror.EmptyDirectoryError{Path: "some-path"}),
 
+		Entry("labelselector.SyntaxError -> InvalidLabelSelectorError",
+			labelselector.SyntaxError{Selector: "env is prod", Position: 4, Token: "is", Reason: "some-reason"},
+			translatableerror.InvalidLabelSelectorError{Selector: "env is prod", Column: 4, Reason: "some-reason"}),
+
 		Entry("default case -> original error",
 			err,
 			err),
@@ -32,6 +32,7 @@ func NewNetworkingClient(apiURL string, config command.Config, uaaClient *uaa.Cl
 	return cfnetv1.NewClient(cfnetv1.Config{
 		AppName:           config.BinaryName(),
 		AppVersion:        config.BinaryVersion(),
+		CACert:            config.CACert(),
 		DialTimeout:       config.DialTimeout(),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		URL:               apiURL,
@@ -0,0 +1,66 @@
+package shared_test
+
+import (
+	"net"
+	"os"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("NewNOAAClient", func() {
+	var (
+		fakeConfig *commandfakes.FakeConfig
+		testUI     *ui.UI
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+	})
+
+	Context("when the logging endpoint is empty", func() {
+		It("returns no client", func() {
+			Expect(NewNOAAClient("", fakeConfig, nil, testUI)).To(BeNil())
+		})
+	})
+
+	Context("when the logging endpoint is set", func() {
+		It("returns a client", func() {
+			Expect(NewNOAAClient("wss://doppler.example.com:443", fakeConfig, nil, testUI)).ToNot(BeNil())
+		})
+
+		Context("when a proxy is configured and verbose is enabled", func() {
+			var proxyAddress string
+
+			BeforeEach(func() {
+				listener, err := net.Listen("tcp", "127.0.0.1:0")
+				Expect(err).ToNot(HaveOccurred())
+				proxyAddress = listener.Addr().String()
+				listener.Close()
+
+				os.Setenv("HTTPS_PROXY", "http://user:secret@"+proxyAddress)
+				fakeConfig.VerboseReturns(true, nil)
+			})
+
+			AfterEach(func() {
+				os.Unsetenv("HTTPS_PROXY")
+			})
+
+			It("connects through the proxy and traces it", func() {
+				client := NewNOAAClient("wss://doppler.example.com:443", fakeConfig, nil, testUI)
+				_, err := client.RecentLogs("some-app-guid", "some-token")
+				Expect(err).To(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("PROXY:"))
+				Expect(testUI.Out).To(Say("Connecting to doppler.example.com:443 through proxy %s", proxyAddress))
+				Expect(testUI.Out).ToNot(Say("secret"))
+			})
+		})
+	})
+})
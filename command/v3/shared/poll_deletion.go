@@ -0,0 +1,33 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// PollDeletion displays the warnings of a deletion job as they arrive and
+// returns once the job has finished, translating any error it failed with.
+func PollDeletion(ui command.UI, warnings <-chan v3action.Warnings, errs <-chan error) error {
+	var err error
+	for warnings != nil || errs != nil {
+		select {
+		case warning, ok := <-warnings:
+			if !ok {
+				warnings = nil
+				break
+			}
+			ui.DisplayWarnings(warning)
+		case jobErr, ok := <-errs:
+			if !ok {
+				errs = nil
+				break
+			}
+			err = jobErr
+		}
+	}
+
+	if err != nil {
+		return HandleError(err)
+	}
+	return nil
+}
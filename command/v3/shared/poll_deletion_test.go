@@ -0,0 +1,88 @@
+package shared_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("PollDeletion", func() {
+	var (
+		testUI         *ui.UI
+		warningsStream chan v3action.Warnings
+		errStream      chan error
+		executeErr     error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		warningsStream = make(chan v3action.Warnings)
+		errStream = make(chan error)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = PollDeletion(testUI, warningsStream, errStream)
+	})
+
+	Context("when the job succeeds", func() {
+		BeforeEach(func() {
+			go func() {
+				defer close(errStream)
+				defer close(warningsStream)
+				warningsStream <- v3action.Warnings{"warning-1"}
+				warningsStream <- v3action.Warnings{"warning-2", "warning-3"}
+			}()
+		})
+
+		It("displays the warnings as they arrive and returns no error", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+			Expect(testUI.Err).To(Say("warning-3"))
+		})
+	})
+
+	Context("when the deletion fails", func() {
+		BeforeEach(func() {
+			go func() {
+				defer close(errStream)
+				defer close(warningsStream)
+				warningsStream <- v3action.Warnings{"warning-1"}
+				errStream <- actionerror.DeletionFailedError{
+					JobGUID: "some-job-guid",
+					Reasons: []string{"some-reason"},
+				}
+			}()
+		})
+
+		It("displays the warnings and returns a translated error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.DeletionFailedError{Reasons: []string{"some-reason"}}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	Context("when any other error occurs", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			go func() {
+				defer close(errStream)
+				defer close(warningsStream)
+				errStream <- expectedErr
+			}()
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// TranslatedErrorMessage returns the message of err translated for display,
+// for errors that are reported as part of the output instead of failing the
+// command.
+func TranslatedErrorMessage(commandUI command.UI, err error) string {
+	translatableErr, ok := err.(translatableerror.TranslatableError)
+	if !ok {
+		return err.Error()
+	}
+
+	return translatableErr.Translate(func(template string, data ...interface{}) string {
+		if len(data) > 0 {
+			if values, ok := data[0].(map[string]interface{}); ok {
+				return commandUI.TranslateText(template, values)
+			}
+		}
+		return commandUI.TranslateText(template)
+	})
+}
@@ -0,0 +1,124 @@
+package v3
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+//go:generate counterfeiter . TaskActor
+
+type TaskActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetTaskBySequenceIDOrGUIDAndApplication(id string, appGUID string) (v3action.Task, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+type TaskCommand struct {
+	RequiredArgs    flag.TaskArgs `positional-args:"yes"`
+	usage           interface{}   `usage:"CF_NAME task APP_NAME TASK_ID\n\n   TASK_ID is either the task's sequence ID, as shown by 'CF_NAME tasks', or its GUID.\n\nEXAMPLES:\n   CF_NAME task my-app 3"`
+	relatedCommands interface{}   `related_commands:"logs, run-task, tasks, terminate-task"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       TaskActor
+}
+
+func (cmd *TaskCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionRunTaskV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd TaskCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionRunTaskV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting task {{.TaskID}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TaskID":      cmd.RequiredArgs.TaskID,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	task, warnings, err := cmd.Actor.GetTaskBySequenceIDOrGUIDAndApplication(cmd.RequiredArgs.TaskID, application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	startTime, err := time.Parse(time.RFC3339, task.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	if task.Command == "" {
+		task.Command = "[hidden]"
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("id:"), strconv.Itoa(task.SequenceID)},
+		{cmd.UI.TranslateText("guid:"), task.GUID},
+		{cmd.UI.TranslateText("name:"), task.Name},
+		{cmd.UI.TranslateText("state:"), cmd.UI.TranslateText(task.State)},
+		{cmd.UI.TranslateText("start time:"), startTime.Format(time.RFC1123)},
+		{cmd.UI.TranslateText("command:"), task.Command},
+		{cmd.UI.TranslateText("memory:"), bytefmt.ByteSize(bytefmt.MEGABYTE * task.MemoryInMB)},
+		{cmd.UI.TranslateText("disk:"), bytefmt.ByteSize(bytefmt.MEGABYTE * task.DiskInMB)},
+	}
+
+	if task.State == failedState && task.Result != nil {
+		table = append(table, []string{cmd.UI.TranslateText("failure reason:"), task.Result.FailureReason})
+	}
+
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+
+	return nil
+}
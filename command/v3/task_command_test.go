@@ -0,0 +1,181 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("task Command", func() {
+	var (
+		cmd             v3.TaskCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeTaskActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeTaskActor)
+
+		cmd = v3.TaskCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app-name"
+		cmd.RequiredArgs.TaskID = "3"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionRunTaskV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionRunTaskV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the user is logged in, and a space and org are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v3action.Application{GUID: "some-app-guid"},
+				v3action.Warnings{"get-application-warning"},
+				nil)
+		})
+
+		Context("when the task failed", func() {
+			BeforeEach(func() {
+				fakeActor.GetTaskBySequenceIDOrGUIDAndApplicationReturns(
+					v3action.Task{
+						GUID:       "some-task-guid",
+						SequenceID: 3,
+						Name:       "some-task-name",
+						State:      "FAILED",
+						CreatedAt:  "2016-11-08T22:26:02Z",
+						Command:    "some-command --with a-very-long-argument-that-tasks-would-truncate",
+						MemoryInMB: 512,
+						DiskInMB:   1024,
+						Result:     &ccv3.TaskResult{FailureReason: "Exited with status 1"},
+					},
+					v3action.Warnings{"get-task-warning"},
+					nil)
+			})
+
+			It("displays every detail of the task, including the failure reason", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				id, appGUID := fakeActor.GetTaskBySequenceIDOrGUIDAndApplicationArgsForCall(0)
+				Expect(id).To(Equal("3"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+
+				Expect(testUI.Out).To(Say("Getting task 3 for app some-app-name in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`id:\s+3`))
+				Expect(testUI.Out).To(Say(`guid:\s+some-task-guid`))
+				Expect(testUI.Out).To(Say(`name:\s+some-task-name`))
+				Expect(testUI.Out).To(Say(`state:\s+FAILED`))
+				Expect(testUI.Out).To(Say(`start time:\s+Tue, 08 Nov 2016 22:26:02 UTC`))
+				Expect(testUI.Out).To(Say(`command:\s+some-command --with a-very-long-argument-that-tasks-would-truncate`))
+				Expect(testUI.Out).To(Say(`memory:\s+512M`))
+				Expect(testUI.Out).To(Say(`disk:\s+1G`))
+				Expect(testUI.Out).To(Say(`failure reason:\s+Exited with status 1`))
+
+				Expect(testUI.Err).To(Say("get-application-warning"))
+				Expect(testUI.Err).To(Say("get-task-warning"))
+			})
+		})
+
+		Context("when the task succeeded", func() {
+			BeforeEach(func() {
+				fakeActor.GetTaskBySequenceIDOrGUIDAndApplicationReturns(
+					v3action.Task{
+						GUID:       "some-task-guid",
+						SequenceID: 3,
+						State:      "SUCCEEDED",
+						CreatedAt:  "2016-11-08T22:26:02Z",
+					},
+					nil,
+					nil)
+			})
+
+			It("does not display a failure reason and hides the command", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`command:\s+\[hidden\]`))
+				Expect(testUI.Out).ToNot(Say("failure reason"))
+			})
+		})
+
+		Context("when the task cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetTaskBySequenceIDOrGUIDAndApplicationReturns(
+					v3action.Task{},
+					v3action.Warnings{"get-task-warning"},
+					v3action.TaskNotFoundError{SequenceID: 3})
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(v3action.TaskNotFoundError{SequenceID: 3}))
+				Expect(testUI.Err).To(Say("get-task-warning"))
+			})
+		})
+
+		Context("when getting the app fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v3action.Application{},
+					v3action.Warnings{"get-application-warning"},
+					errors.New("get-app-error"))
+			})
+
+			It("returns the error without looking up the task", func() {
+				Expect(executeErr).To(MatchError("get-app-error"))
+				Expect(fakeActor.GetTaskBySequenceIDOrGUIDAndApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package v3
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
@@ -21,6 +23,7 @@ const (
 	cancelingState = "CANCELING"
 	pendingState   = "PENDING"
 	succeededState = "SUCCEEDED"
+	failedState    = "FAILED"
 )
 
 //go:generate counterfeiter . TasksActor
@@ -32,9 +35,10 @@ type TasksActor interface {
 }
 
 type TasksCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME tasks APP_NAME"`
-	relatedCommands interface{}  `related_commands:"apps, logs, run-task, terminate-task"`
+	RequiredArgs    flag.AppName      `positional-args:"yes"`
+	Output          flag.OutputFormat `long:"output" description:"Output format; only 'json' is supported"`
+	usage           interface{}       `usage:"CF_NAME tasks APP_NAME [--output json]"`
+	relatedCommands interface{}       `related_commands:"apps, logs, run-task, task, terminate-task"`
 
 	UI          command.UI
 	Config      command.Config
@@ -78,6 +82,10 @@ func (cmd TasksCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Output.Format != "" {
+		return cmd.displayTasksJSON(space.GUID)
+	}
+
 	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -132,3 +140,58 @@ func (cmd TasksCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// displayTasksJSON prints every field of the app's tasks, untruncated, with
+// the warnings included in the document so the output stays parseable.
+func (cmd TasksCommand) displayTasksJSON(spaceGUID string) error {
+	var allWarnings []string
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		cmd.UI.DisplayWarnings(allWarnings)
+		return shared.HandleError(err)
+	}
+
+	tasks, warnings, err := cmd.Actor.GetApplicationTasks(application.GUID, v3action.Descending)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		cmd.UI.DisplayWarnings(allWarnings)
+		return shared.HandleError(err)
+	}
+
+	tasksJSON := make([]map[string]interface{}, len(tasks))
+	for i, task := range tasks {
+		var failureReason string
+		if task.Result != nil {
+			failureReason = task.Result.FailureReason
+		}
+
+		tasksJSON[i] = map[string]interface{}{
+			"id":             task.SequenceID,
+			"guid":           task.GUID,
+			"name":           task.Name,
+			"state":          task.State,
+			"created_at":     task.CreatedAt,
+			"command":        task.Command,
+			"memory_in_mb":   task.MemoryInMB,
+			"disk_in_mb":     task.DiskInMB,
+			"failure_reason": failureReason,
+		}
+	}
+
+	if allWarnings == nil {
+		allWarnings = []string{}
+	}
+
+	output, err := json.MarshalIndent(map[string]interface{}{
+		"tasks":    tasksJSON,
+		"warnings": allWarnings,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
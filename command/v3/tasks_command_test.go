@@ -1,11 +1,13 @@
 package v3_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -215,6 +217,57 @@ get-tasks-warning-1`))
 					})
 				})
 
+				Context("when --output json is provided", func() {
+					BeforeEach(func() {
+						cmd.Output.Format = "json"
+						fakeActor.GetApplicationTasksReturns(
+							[]v3action.Task{
+								{
+									GUID:       "task-2-guid",
+									SequenceID: 2,
+									Name:       "task-2",
+									State:      "FAILED",
+									CreatedAt:  "2016-11-08T22:26:02Z",
+									Command:    "some-command --with a-very-long-argument",
+									MemoryInMB: 512,
+									DiskInMB:   1024,
+									Result:     &ccv3.TaskResult{FailureReason: "Exited with status 1"},
+								},
+							},
+							v3action.Warnings{"get-tasks-warning-1"},
+							nil)
+					})
+
+					It("outputs the untruncated tasks and warnings as JSON", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("Getting tasks"))
+
+						var output struct {
+							Tasks    []map[string]interface{} `json:"tasks"`
+							Warnings []string                 `json:"warnings"`
+						}
+						Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &output)).To(Succeed())
+
+						Expect(output.Tasks).To(ConsistOf(map[string]interface{}{
+							"id":             float64(2),
+							"guid":           "task-2-guid",
+							"name":           "task-2",
+							"state":          "FAILED",
+							"created_at":     "2016-11-08T22:26:02Z",
+							"command":        "some-command --with a-very-long-argument",
+							"memory_in_mb":   float64(512),
+							"disk_in_mb":     float64(1024),
+							"failure_reason": "Exited with status 1",
+						}))
+						Expect(output.Warnings).To(ConsistOf(
+							"get-application-warning-1",
+							"get-application-warning-2",
+							"get-tasks-warning-1",
+						))
+						Expect(testUI.Err).ToNot(Say("get-tasks-warning-1"))
+					})
+				})
+
 				Context("when there are no tasks associated with the application", func() {
 					BeforeEach(func() {
 						fakeActor.GetApplicationTasksReturns([]v3action.Task{}, nil, nil)
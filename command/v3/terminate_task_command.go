@@ -1,6 +1,7 @@
 package v3
 
 import (
+	"fmt"
 	"net/http"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -17,6 +18,7 @@ import (
 
 type TerminateTaskActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetRunningTaskByNameAndApplication(name string, appGUID string) (v3action.Task, v3action.Warnings, error)
 	GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (v3action.Task, v3action.Warnings, error)
 	TerminateTask(taskGUID string) (v3action.Task, v3action.Warnings, error)
 	CloudControllerAPIVersion() string
@@ -24,7 +26,7 @@ type TerminateTaskActor interface {
 
 type TerminateTaskCommand struct {
 	RequiredArgs    flag.TerminateTaskArgs `positional-args:"yes"`
-	usage           interface{}            `usage:"CF_NAME terminate-task APP_NAME TASK_ID\n\nEXAMPLES:\n   CF_NAME terminate-task my-app 3"`
+	usage           interface{}            `usage:"CF_NAME terminate-task APP_NAME (TASK_ID | TASK_NAME)\n\nEXAMPLES:\n   CF_NAME terminate-task my-app 3\n   CF_NAME terminate-task my-app migrate"`
 	relatedCommands interface{}            `related_commands:"tasks"`
 
 	UI          command.UI
@@ -52,15 +54,7 @@ func (cmd *TerminateTaskCommand) Setup(config command.Config, ui command.UI) err
 }
 
 func (cmd TerminateTaskCommand) Execute(args []string) error {
-	sequenceId, err := flag.ParseStringToInt(cmd.RequiredArgs.SequenceID)
-	if err != nil {
-		return translatableerror.ParseArgumentError{
-			ArgumentName: "TASK_ID",
-			ExpectedType: "integer",
-		}
-	}
-
-	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionRunTaskV3)
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionRunTaskV3)
 	if err != nil {
 		return err
 	}
@@ -83,15 +77,14 @@ func (cmd TerminateTaskCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	task, warnings, err := cmd.Actor.GetTaskBySequenceIDAndApplication(sequenceId, application.GUID)
-	cmd.UI.DisplayWarnings(warnings)
+	task, err := cmd.getTask(application.GUID)
 	if err != nil {
 		return shared.HandleError(err)
 	}
 
 	cmd.UI.DisplayTextWithFlavor("Terminating task {{.TaskSequenceID}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
 		map[string]interface{}{
-			"TaskSequenceID": cmd.RequiredArgs.SequenceID,
+			"TaskSequenceID": fmt.Sprint(task.SequenceID),
 			"AppName":        cmd.RequiredArgs.AppName,
 			"OrgName":        cmd.Config.TargetedOrganization().Name,
 			"SpaceName":      space.Name,
@@ -108,3 +101,23 @@ func (cmd TerminateTaskCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// getTask looks the task up by sequence ID, or by name when the argument is
+// not an integer. Only running tasks can be looked up by name, and the name
+// must identify exactly one of them.
+func (cmd TerminateTaskCommand) getTask(appGUID string) (v3action.Task, error) {
+	var (
+		task     v3action.Task
+		warnings v3action.Warnings
+	)
+
+	sequenceID, err := flag.ParseStringToInt(cmd.RequiredArgs.SequenceID)
+	if err == nil {
+		task, warnings, err = cmd.Actor.GetTaskBySequenceIDAndApplication(sequenceID, appGUID)
+	} else {
+		task, warnings, err = cmd.Actor.GetRunningTaskByNameAndApplication(cmd.RequiredArgs.SequenceID, appGUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+
+	return task, err
+}
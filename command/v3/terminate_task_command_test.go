@@ -67,19 +67,6 @@ var _ = Describe("terminate-task Command", func() {
 		})
 	})
 
-	Context("when the task id argument is not an integer", func() {
-		BeforeEach(func() {
-			cmd.RequiredArgs.SequenceID = "not-an-integer"
-		})
-
-		It("returns an ParseArgumentError", func() {
-			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
-				ArgumentName: "TASK_ID",
-				ExpectedType: "integer",
-			}))
-		})
-	})
-
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
@@ -138,7 +125,7 @@ var _ = Describe("terminate-task Command", func() {
 						v3action.Warnings{"get-application-warning"},
 						nil)
 					fakeActor.GetTaskBySequenceIDAndApplicationReturns(
-						v3action.Task{GUID: "some-task-guid"},
+						v3action.Task{GUID: "some-task-guid", SequenceID: 1},
 						v3action.Warnings{"get-task-warning"},
 						nil)
 					fakeActor.TerminateTaskReturns(
@@ -172,6 +159,75 @@ var _ = Describe("terminate-task Command", func() {
 				})
 			})
 
+			Context("when provided a task name instead of a sequence ID", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.SequenceID = "some-task-name"
+					fakeActor.GetApplicationByNameAndSpaceReturns(
+						v3action.Application{GUID: "some-app-guid"},
+						nil,
+						nil)
+				})
+
+				Context("when a single running task has the name", func() {
+					BeforeEach(func() {
+						fakeActor.GetRunningTaskByNameAndApplicationReturns(
+							v3action.Task{GUID: "some-task-guid", SequenceID: 4},
+							v3action.Warnings{"get-task-warning"},
+							nil)
+					})
+
+					It("terminates that task", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.GetTaskBySequenceIDAndApplicationCallCount()).To(Equal(0))
+						Expect(fakeActor.GetRunningTaskByNameAndApplicationCallCount()).To(Equal(1))
+						name, applicationGUID := fakeActor.GetRunningTaskByNameAndApplicationArgsForCall(0)
+						Expect(name).To(Equal("some-task-name"))
+						Expect(applicationGUID).To(Equal("some-app-guid"))
+
+						Expect(fakeActor.TerminateTaskCallCount()).To(Equal(1))
+						Expect(fakeActor.TerminateTaskArgsForCall(0)).To(Equal("some-task-guid"))
+
+						Expect(testUI.Err).To(Say("get-task-warning"))
+						Expect(testUI.Out).To(Say("Terminating task 4 of app some-app-name in org some-org / space some-space as some-user..."))
+						Expect(testUI.Out).To(Say("OK"))
+					})
+				})
+
+				Context("when several running tasks have the name", func() {
+					BeforeEach(func() {
+						fakeActor.GetRunningTaskByNameAndApplicationReturns(
+							v3action.Task{},
+							v3action.Warnings{"get-task-warning"},
+							v3action.MultipleRunningTasksFoundError{Name: "some-task-name", SequenceIDs: []int{2, 5}})
+					})
+
+					It("returns a TaskNameNotUniqueError and does not terminate anything", func() {
+						Expect(executeErr).To(MatchError(translatableerror.TaskNameNotUniqueError{
+							TaskName:    "some-task-name",
+							SequenceIDs: []int{2, 5},
+						}))
+
+						Expect(testUI.Err).To(Say("get-task-warning"))
+						Expect(fakeActor.TerminateTaskCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when no running task has the name", func() {
+					BeforeEach(func() {
+						fakeActor.GetRunningTaskByNameAndApplicationReturns(
+							v3action.Task{},
+							nil,
+							v3action.TaskNotFoundError{Name: "some-task-name"})
+					})
+
+					It("returns a TaskNotFoundError", func() {
+						Expect(executeErr).To(MatchError(v3action.TaskNotFoundError{Name: "some-task-name"}))
+						Expect(fakeActor.TerminateTaskCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			Context("when there are errors", func() {
 				Context("when the error is translatable", func() {
 					var (
@@ -0,0 +1,100 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/types"
+)
+
+//go:generate counterfeiter . UnsetLabelActor
+
+type UnsetLabelActor interface {
+	CloudControllerAPIVersion() string
+	UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (v3action.Warnings, error)
+	UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (v3action.Warnings, error)
+}
+
+type UnsetLabelCommand struct {
+	RequiredArgs    flag.UnsetLabelArgs `positional-args:"yes"`
+	usage           interface{}         `usage:"CF_NAME unset-label RESOURCE RESOURCE_NAME KEY...\n\nRESOURCES:\n   app\n   org\n   space\n\nEXAMPLES:\n   cf unset-label app dora env\n   cf unset-label org business pci public-facing\n   cf unset-label space business_space public-facing owner"`
+	relatedCommands interface{}         `related_commands:"labels, set-label"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UnsetLabelActor
+}
+
+func (cmd *UnsetLabelCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionMetadataV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd UnsetLabelCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionMetadataV3)
+	if err != nil {
+		return err
+	}
+
+	resourceType := cmd.RequiredArgs.ResourceType.Type
+	err = cmd.SharedActor.CheckTarget(cmd.Config, resourceType != flag.LabelResourceOrg, resourceType == flag.LabelResourceApp)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Removing label(s) for {{.ResourceType}} {{.ResourceName}} as {{.User}}...", map[string]interface{}{
+		"ResourceType": resourceType,
+		"ResourceName": cmd.RequiredArgs.ResourceName,
+		"User":         user.Name,
+	})
+
+	// A label without a value is removed by the Cloud Controller.
+	labels := map[string]types.NullString{}
+	for _, key := range cmd.RequiredArgs.LabelKeys {
+		labels[key] = types.NullString{}
+	}
+
+	var warnings v3action.Warnings
+	switch resourceType {
+	case flag.LabelResourceApp:
+		warnings, err = cmd.Actor.UpdateApplicationLabelsByApplicationName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID, labels)
+	case flag.LabelResourceOrg:
+		warnings, err = cmd.Actor.UpdateOrganizationLabelsByOrganizationName(cmd.RequiredArgs.ResourceName, labels)
+	case flag.LabelResourceSpace:
+		warnings, err = cmd.Actor.UpdateSpaceLabelsBySpaceName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID, labels)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
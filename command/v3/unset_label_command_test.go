@@ -0,0 +1,103 @@
+package v3_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unset-label Command", func() {
+	var (
+		cmd             v3.UnsetLabelCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUnsetLabelActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUnsetLabelActor)
+
+		cmd = v3.UnsetLabelCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionMetadataV3)
+
+		cmd.RequiredArgs.ResourceType = flag.LabelResource{Type: flag.LabelResourceApp}
+		cmd.RequiredArgs.ResourceName = "some-app"
+		cmd.RequiredArgs.LabelKeys = []string{"env", "owner"}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("3.62.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "3.62.0",
+				MinimumVersion: ccversion.MinVersionMetadataV3,
+			}))
+		})
+	})
+
+	Context("when the labels are removed", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v3action.Warnings{"some-warning"}, nil)
+		})
+
+		It("removes the labels by setting them to null and displays all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(1))
+			appName, spaceGUID, labels := fakeActor.UpdateApplicationLabelsByApplicationNameArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(labels).To(Equal(map[string]types.NullString{
+				"env":   {},
+				"owner": {},
+			}))
+
+			Expect(testUI.Out).To(Say(`Removing label\(s\) for app some-app as banana\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateApplicationLabelsByApplicationNameReturns(
+				v3action.Warnings{"some-warning"},
+				v3action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
@@ -0,0 +1,158 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . UnshareServiceActor
+
+type UnshareServiceActor interface {
+	CloudControllerAPIVersion() string
+	UnshareServiceInstanceFromSpace(serviceInstanceGUID string, spaceGUID string) (v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . UnshareServiceActorV2
+
+type UnshareServiceActorV2 interface {
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetServiceInstanceSharedTos(serviceInstanceGUID string) ([]v2action.ServiceInstanceSharedTo, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+type UnshareServiceCommand struct {
+	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
+	SpaceName       string               `short:"s" required:"true" description:"Space to unshare the service instance from"`
+	OrgName         string               `short:"o" description:"Org of the other space (Default: targeted org)"`
+	Force           bool                 `short:"f" description:"Force unshare even if apps in the other space are bound to the service instance"`
+	usage           interface{}          `usage:"CF_NAME unshare-service SERVICE_INSTANCE -s OTHER_SPACE [-o OTHER_ORG] [-f]"`
+	relatedCommands interface{}          `related_commands:"delete-service, service, services, share-service, unbind-service"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UnshareServiceActor
+	ActorV2     UnshareServiceActorV2
+}
+
+func (cmd *UnshareServiceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionShareServiceV3}
+		}
+
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.ActorV2 = v2action.NewActor(ccClientV2, uaaClientV2, config)
+
+	return nil
+}
+
+func (cmd UnshareServiceCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionShareServiceV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgName := cmd.OrgName
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Unsharing service instance {{.ServiceInstanceName}} from org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+		"OrgName":             orgName,
+		"SpaceName":           cmd.SpaceName,
+		"Username":            user.Name,
+	})
+
+	serviceInstance, warnings, err := cmd.ActorV2.GetServiceInstanceByNameAndSpace(cmd.RequiredArgs.ServiceInstance, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV2.HandleError(err)
+	}
+
+	space, err := getShareTargetSpace(cmd.UI, cmd.Config, cmd.ActorV2, cmd.OrgName, cmd.SpaceName)
+	if err != nil {
+		return err
+	}
+
+	sharedTos, warnings, err := cmd.ActorV2.GetServiceInstanceSharedTos(serviceInstance.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV2.HandleError(err)
+	}
+
+	sharedTo, isShared := findSharedTo(sharedTos, space.GUID)
+	if !isShared {
+		cmd.UI.DisplayWarning("Service instance {{.ServiceInstanceName}} is not shared with space {{.SpaceName}} in org {{.OrgName}}.", map[string]interface{}{
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstance,
+			"SpaceName":           cmd.SpaceName,
+			"OrgName":             orgName,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if sharedTo.BoundAppCount > 0 {
+		cmd.UI.DisplayWarning("WARNING: Unsharing this service instance will delete the bindings of {{.BoundAppCount}} app(s) in org {{.OrgName}} / space {{.SpaceName}}. This could cause those apps to stop working.", map[string]interface{}{
+			"BoundAppCount": sharedTo.BoundAppCount,
+			"OrgName":       sharedTo.OrganizationName,
+			"SpaceName":     sharedTo.SpaceName,
+		})
+		if !cmd.Force {
+			return translatableerror.UnshareServiceBoundAppsError{ServiceInstanceName: cmd.RequiredArgs.ServiceInstance}
+		}
+	}
+
+	v3Warnings, err := cmd.Actor.UnshareServiceInstanceFromSpace(serviceInstance.GUID, space.GUID)
+	cmd.UI.DisplayWarnings(v3Warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func findSharedTo(sharedTos []v2action.ServiceInstanceSharedTo, spaceGUID string) (v2action.ServiceInstanceSharedTo, bool) {
+	for _, sharedTo := range sharedTos {
+		if sharedTo.SpaceGUID == spaceGUID {
+			return sharedTo, true
+		}
+	}
+	return v2action.ServiceInstanceSharedTo{}, false
+}
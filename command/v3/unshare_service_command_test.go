@@ -0,0 +1,203 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unshare-service Command", func() {
+	var (
+		cmd             v3.UnshareServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUnshareServiceActor
+		fakeActorV2     *v3fakes.FakeUnshareServiceActorV2
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUnshareServiceActor)
+		fakeActorV2 = new(v3fakes.FakeUnshareServiceActorV2)
+
+		cmd = v3.UnshareServiceCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV2:     fakeActorV2,
+		}
+
+		cmd.RequiredArgs.ServiceInstance = "some-service-instance"
+		cmd.SpaceName = "other-space"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionShareServiceV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: ccversion.MinVersionShareServiceV3,
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the user is logged in and targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+			fakeActorV2.GetServiceInstanceByNameAndSpaceReturns(
+				v2action.ServiceInstance{Name: "some-service-instance", GUID: "some-service-instance-guid"},
+				v2action.Warnings{"service-instance-warning"},
+				nil)
+			fakeActorV2.GetSpaceByOrganizationAndNameReturns(
+				v2action.Space{Name: "other-space", GUID: "other-space-guid"},
+				v2action.Warnings{"space-warning"},
+				nil)
+		})
+
+		Context("when the service instance is not shared with the space", func() {
+			BeforeEach(func() {
+				fakeActorV2.GetServiceInstanceSharedTosReturns(
+					[]v2action.ServiceInstanceSharedTo{{SpaceGUID: "another-space-guid"}},
+					v2action.Warnings{"shared-to-warning"},
+					nil)
+			})
+
+			It("warns and does not unshare", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Unsharing service instance some-service-instance from org some-org / space other-space as some-user\.\.\.`))
+				Expect(testUI.Err).To(Say("shared-to-warning"))
+				Expect(testUI.Err).To(Say("Service instance some-service-instance is not shared with space other-space in org some-org."))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActorV2.GetServiceInstanceSharedTosArgsForCall(0)).To(Equal("some-service-instance-guid"))
+				Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when no apps in the space are bound to the service instance", func() {
+			BeforeEach(func() {
+				fakeActorV2.GetServiceInstanceSharedTosReturns(
+					[]v2action.ServiceInstanceSharedTo{{SpaceGUID: "other-space-guid", SpaceName: "other-space", OrganizationName: "some-org"}},
+					v2action.Warnings{"shared-to-warning"},
+					nil)
+				fakeActor.UnshareServiceInstanceFromSpaceReturns(v3action.Warnings{"unshare-warning"}, nil)
+			})
+
+			It("unshares the service instance without requiring -f", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("service-instance-warning"))
+				Expect(testUI.Err).To(Say("space-warning"))
+				Expect(testUI.Err).To(Say("shared-to-warning"))
+				Expect(testUI.Err).To(Say("unshare-warning"))
+				Expect(testUI.Err).NotTo(Say("WARNING"))
+
+				Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(1))
+				serviceInstanceGUID, spaceGUID := fakeActor.UnshareServiceInstanceFromSpaceArgsForCall(0)
+				Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+				Expect(spaceGUID).To(Equal("other-space-guid"))
+			})
+
+			Context("when service instance sharing is disabled", func() {
+				BeforeEach(func() {
+					fakeActor.UnshareServiceInstanceFromSpaceReturns(
+						v3action.Warnings{"unshare-warning"},
+						v3action.FeatureFlagDisabledError{FeatureFlag: "service_instance_sharing"})
+				})
+
+				It("returns a FeatureFlagDisabledError naming the feature flag", func() {
+					Expect(executeErr).To(MatchError(translatableerror.FeatureFlagDisabledError{FeatureFlag: "service_instance_sharing"}))
+				})
+			})
+		})
+
+		Context("when apps in the space are bound to the service instance", func() {
+			BeforeEach(func() {
+				fakeActorV2.GetServiceInstanceSharedTosReturns(
+					[]v2action.ServiceInstanceSharedTo{{SpaceGUID: "other-space-guid", SpaceName: "other-space", OrganizationName: "some-org", BoundAppCount: 2}},
+					nil,
+					nil)
+			})
+
+			Context("when -f is not provided", func() {
+				It("warns about the bindings and does not unshare", func() {
+					Expect(executeErr).To(MatchError(translatableerror.UnshareServiceBoundAppsError{ServiceInstanceName: "some-service-instance"}))
+					Expect(testUI.Err).To(Say(`WARNING: Unsharing this service instance will delete the bindings of 2 app\(s\) in org some-org / space other-space\.`))
+					Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when -f is provided", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+				})
+
+				It("warns about the bindings and unshares", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Err).To(Say("WARNING: Unsharing this service instance will delete the bindings of 2 app"))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when getting the shared-to spaces fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("shared-to-error")
+				fakeActorV2.GetServiceInstanceSharedTosReturns(nil, v2action.Warnings{"shared-to-warning"}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("shared-to-warning"))
+				Expect(fakeActor.UnshareServiceInstanceFromSpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
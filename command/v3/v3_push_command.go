@@ -3,11 +3,13 @@ package v3
 import (
 	"net/http"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
@@ -37,18 +39,23 @@ type V3PushActor interface {
 	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
 	StopApplication(appGUID string) (v3action.Warnings, error)
 	UpdateApplication(app v3action.Application) (v3action.Application, v3action.Warnings, error)
+	UpdateProcessHealthCheckByTypeAndApplication(processType string, appGUID string, healthCheck v3action.ProcessHealthCheck) (v3action.Warnings, error)
 }
 
 type V3PushCommand struct {
-	RequiredArgs   flag.AppName                `positional-args:"yes"`
-	Buildpacks     []string                    `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
-	DockerImage    flag.DockerImage            `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
-	DockerUsername string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
-	NoRoute        bool                        `long:"no-route" description:"Do not map a route to this app"`
-	AppPath        flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
-	dockerPassword interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-
-	usage               interface{} `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [--no-route]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [--no-route]"`
+	RequiredArgs                 flag.AppName                `positional-args:"yes"`
+	Buildpacks                   []string                    `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
+	DockerImage                  flag.DockerImage            `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
+	DockerUsername               string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	HealthCheckType              flag.HealthCheckType        `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
+	HealthCheckHTTPEndpoint      string                      `long:"endpoint" description:"Valid path on the app for an HTTP health check. Only used when the health check type is 'http'"`
+	HealthCheckTimeout           flag.HealthCheckTimeout     `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	HealthCheckInvocationTimeout flag.HealthCheckTimeout     `long:"invocation-timeout" description:"Time (in seconds) a single health check may take before it is considered failed"`
+	NoRoute                      bool                        `long:"no-route" description:"Do not map a route to this app"`
+	AppPath                      flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	dockerPassword               interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+
+	usage               interface{} `usage:"cf v3-push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [--no-route] [-u (process | port | http)] [--endpoint PATH] [-t HEALTH_TIMEOUT] [--invocation-timeout SECONDS]\n   cf v3-push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [--no-route] [-u (process | port | http)] [--endpoint PATH] [-t HEALTH_TIMEOUT] [--invocation-timeout SECONDS]"`
 	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -165,6 +172,13 @@ func (cmd V3PushCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	if cmd.healthCheckProvided() {
+		err = cmd.updateWebProcessHealthCheck(app.GUID, user.Name)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
 	if !cmd.NoRoute {
 		err = cmd.createAndBindRoutes(app)
 		if err != nil {
@@ -241,10 +255,19 @@ func (cmd V3PushCommand) validateArgs() error {
 		}
 	case cmd.DockerUsername != "" && cmd.Config.DockerPassword() == "":
 		return translatableerror.DockerPasswordNotSetError{}
+	case cmd.HealthCheckHTTPEndpoint != "" && cmd.HealthCheckType.Type != "" && cmd.HealthCheckType.Type != "http":
+		return translatableerror.HTTPHealthCheckInvalidError{}
 	}
 	return nil
 }
 
+func (cmd V3PushCommand) healthCheckProvided() bool {
+	return cmd.HealthCheckType.Type != "" ||
+		cmd.HealthCheckHTTPEndpoint != "" ||
+		cmd.HealthCheckTimeout.IsSet ||
+		cmd.HealthCheckInvocationTimeout.IsSet
+}
+
 func (cmd V3PushCommand) createApplication(userName string) (v3action.Application, error) {
 	appToCreate := v3action.Application{
 		Name: cmd.RequiredArgs.AppName,
@@ -371,7 +394,11 @@ func (cmd V3PushCommand) stagePackage(pkg v3action.Package, userName string) (st
 
 	logStream, logErrStream, logWarnings, logErr := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.NOAAClient)
 	cmd.UI.DisplayWarnings(logWarnings)
-	if logErr != nil {
+	switch logErr.(type) {
+	case nil:
+	case actionerror.LoggingUnavailableError:
+		cmd.UI.DisplayWarning("staging logs unavailable on this platform")
+	default:
 		return "", logErr
 	}
 
@@ -406,6 +433,39 @@ func (cmd V3PushCommand) setApplicationDroplet(dropletGUID string, userName stri
 	return nil
 }
 
+// updateWebProcessHealthCheck applies the health check flags to the web
+// process. It runs after the droplet is assigned, so the web process exists,
+// and before the app starts, so the first start uses the new settings.
+func (cmd V3PushCommand) updateWebProcessHealthCheck(appGUID string, userName string) error {
+	cmd.UI.DisplayTextWithFlavor("Updating health check for app {{.AppName}} process {{.ProcessType}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"ProcessType": constant.ProcessTypeWeb,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    userName,
+	})
+
+	healthCheckType := cmd.HealthCheckType.Type
+	if healthCheckType == "none" {
+		healthCheckType = "process"
+	}
+
+	warnings, err := cmd.Actor.UpdateProcessHealthCheckByTypeAndApplication(constant.ProcessTypeWeb, appGUID, v3action.ProcessHealthCheck{
+		HealthCheckType:   healthCheckType,
+		Endpoint:          cmd.HealthCheckHTTPEndpoint,
+		Timeout:           cmd.HealthCheckTimeout.NullUint64,
+		InvocationTimeout: cmd.HealthCheckInvocationTimeout.NullUint64,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
 func (cmd V3PushCommand) startApplication(appGUID string, userName string) error {
 	cmd.UI.DisplayTextWithFlavor("Starting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -172,6 +173,18 @@ var _ = Describe("v3-push Command", func() {
 			}),
 	)
 
+	Context("when an endpoint is given with a non-http health check type", func() {
+		BeforeEach(func() {
+			cmd.HealthCheckType.Type = "port"
+			cmd.HealthCheckHTTPEndpoint = "/health"
+		})
+
+		It("returns an HTTPHealthCheckInvalidError before pushing", func() {
+			Expect(executeErr).To(MatchError(translatableerror.HTTPHealthCheckInvalidError{}))
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
@@ -342,6 +355,37 @@ var _ = Describe("v3-push Command", func() {
 						})
 					})
 
+					Context("when logging is unavailable on the platform", func() {
+						var expectedErr error
+
+						BeforeEach(func() {
+							expectedErr = errors.New("any gibberish")
+							fakeActor.GetStreamingLogsForApplicationByNameAndSpaceReturns(nil, nil, v3action.Warnings{"some-logging-warning"}, actionerror.LoggingUnavailableError{})
+							fakeActor.StagePackageStub = func(packageGUID string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+								dropletStream := make(chan v3action.Droplet)
+								warningsStream := make(chan v3action.Warnings)
+								errorStream := make(chan error)
+
+								go func() {
+									defer close(dropletStream)
+									defer close(warningsStream)
+									defer close(errorStream)
+									errorStream <- expectedErr
+								}()
+
+								return dropletStream, warningsStream, errorStream
+							}
+						})
+
+						It("warns and stages the package without logs", func() {
+							Expect(executeErr).To(Equal(expectedErr))
+
+							Expect(testUI.Err).To(Say("some-logging-warning"))
+							Expect(testUI.Err).To(Say("staging logs unavailable on this platform"))
+							Expect(fakeActor.StagePackageCallCount()).To(Equal(1))
+						})
+					})
+
 					Context("when the logging does not error", func() {
 						var allLogsWritten chan bool
 
@@ -492,6 +536,64 @@ var _ = Describe("v3-push Command", func() {
 									Expect(dropletGUID).To(Equal("some-droplet-guid"))
 								})
 
+								It("does not update the web process health check", func() {
+									Expect(fakeActor.UpdateProcessHealthCheckByTypeAndApplicationCallCount()).To(Equal(0))
+									Expect(testUI.Out).ToNot(Say("Updating health check"))
+								})
+
+								Context("when health check flags are provided", func() {
+									BeforeEach(func() {
+										cmd.HealthCheckType.Type = "http"
+										cmd.HealthCheckHTTPEndpoint = "/health"
+										cmd.HealthCheckTimeout.NullUint64 = types.NullUint64{Value: 120, IsSet: true}
+										cmd.HealthCheckInvocationTimeout.NullUint64 = types.NullUint64{Value: 5, IsSet: true}
+										fakeActor.UpdateProcessHealthCheckByTypeAndApplicationReturns(v3action.Warnings{"health-check-warning"}, nil)
+									})
+
+									It("updates the web process after setting the droplet and before starting the app", func() {
+										Expect(testUI.Out).To(Say("Setting app some-app to droplet some-droplet-guid"))
+										Expect(testUI.Out).To(Say("Updating health check for app some-app process web in org some-org / space some-space as banana..."))
+										Expect(testUI.Err).To(Say("health-check-warning"))
+										Expect(testUI.Out).To(Say("OK"))
+										Expect(testUI.Out).To(Say("Starting app some-app"))
+
+										Expect(fakeActor.UpdateProcessHealthCheckByTypeAndApplicationCallCount()).To(Equal(1))
+										processType, appGUID, healthCheck := fakeActor.UpdateProcessHealthCheckByTypeAndApplicationArgsForCall(0)
+										Expect(processType).To(Equal("web"))
+										Expect(appGUID).To(Equal("some-app-guid"))
+										Expect(healthCheck).To(Equal(v3action.ProcessHealthCheck{
+											HealthCheckType:   "http",
+											Endpoint:          "/health",
+											Timeout:           types.NullUint64{Value: 120, IsSet: true},
+											InvocationTimeout: types.NullUint64{Value: 5, IsSet: true},
+										}))
+									})
+
+									Context("when the health check type is none", func() {
+										BeforeEach(func() {
+											cmd.HealthCheckType.Type = "none"
+											cmd.HealthCheckHTTPEndpoint = ""
+										})
+
+										It("sets the process health check type", func() {
+											_, _, healthCheck := fakeActor.UpdateProcessHealthCheckByTypeAndApplicationArgsForCall(0)
+											Expect(healthCheck.HealthCheckType).To(Equal("process"))
+										})
+									})
+
+									Context("when updating the health check fails", func() {
+										BeforeEach(func() {
+											fakeActor.UpdateProcessHealthCheckByTypeAndApplicationReturns(v3action.Warnings{"health-check-warning"}, v3action.HTTPHealthCheckInvalidError{})
+										})
+
+										It("returns the error without starting the app", func() {
+											Expect(executeErr).To(MatchError(translatableerror.HTTPHealthCheckInvalidError{}))
+											Expect(testUI.Err).To(Say("health-check-warning"))
+											Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+										})
+									})
+								})
+
 								Context("when --no-route flag is set to true", func() {
 									BeforeEach(func() {
 										cmd.NoRoute = true
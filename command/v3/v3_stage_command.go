@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -81,7 +82,11 @@ func (cmd V3StageCommand) Execute(args []string) error {
 
 	logStream, logErrStream, logWarnings, logErr := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.NOAAClient)
 	cmd.UI.DisplayWarnings(logWarnings)
-	if logErr != nil {
+	switch logErr.(type) {
+	case nil:
+	case actionerror.LoggingUnavailableError:
+		cmd.UI.DisplayWarning("staging logs unavailable on this platform")
+	default:
 		return shared.HandleError(logErr)
 	}
 
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -302,5 +303,38 @@ var _ = Describe("v3-stage Command", func() {
 				Expect(testUI.Err).To(Say("some-other-warning"))
 			})
 		})
+
+		Context("when logging is unavailable on the platform", func() {
+			BeforeEach(func() {
+				fakeActor.GetStreamingLogsForApplicationByNameAndSpaceReturns(nil, nil, v3action.Warnings{"some-warning"}, actionerror.LoggingUnavailableError{})
+				fakeActor.StagePackageStub = func(packageGUID string, _ string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+					dropletStream := make(chan v3action.Droplet)
+					warningsStream := make(chan v3action.Warnings)
+					errorStream := make(chan error)
+
+					go func() {
+						defer close(dropletStream)
+						defer close(warningsStream)
+						defer close(errorStream)
+						dropletStream <- v3action.Droplet{
+							GUID:      "some-droplet-guid",
+							CreatedAt: "2017-08-14T21:16:42Z",
+							State:     v3action.DropletStateStaged,
+						}
+					}()
+
+					return dropletStream, warningsStream, errorStream
+				}
+			})
+
+			It("warns and stages the package without logs", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("some-warning"))
+				Expect(testUI.Err).To(Say("staging logs unavailable on this platform"))
+				Expect(testUI.Out).To(Say("Package staged"))
+				Expect(testUI.Out).To(Say("droplet guid:\\s+some-droplet-guid"))
+			})
+		})
 	})
 })
@@ -9,15 +9,32 @@ import (
 )
 
 type FakeAddNetworkPolicyActor struct {
-	AddNetworkPolicyStub        func(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	GetSpaceGUIDByNameAndOrganizationNameStub        func(spaceName string, orgName string) (string, cfnetworkingaction.Warnings, error)
+	getSpaceGUIDByNameAndOrganizationNameMutex       sync.RWMutex
+	getSpaceGUIDByNameAndOrganizationNameArgsForCall []struct {
+		spaceName string
+		orgName   string
+	}
+	getSpaceGUIDByNameAndOrganizationNameReturns struct {
+		result1 string
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	getSpaceGUIDByNameAndOrganizationNameReturnsOnCall map[int]struct {
+		result1 string
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	AddNetworkPolicyStub        func(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
 	addNetworkPolicyMutex       sync.RWMutex
 	addNetworkPolicyArgsForCall []struct {
-		spaceGUID   string
-		srcAppName  string
-		destAppName string
-		protocol    string
-		startPort   int
-		endPort     int
+		srcSpaceGUID  string
+		srcAppName    string
+		destSpaceGUID string
+		destAppName   string
+		protocol      string
+		startPort     int
+		endPort       int
 	}
 	addNetworkPolicyReturns struct {
 		result1 cfnetworkingaction.Warnings
@@ -31,21 +48,77 @@ type FakeAddNetworkPolicyActor struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicy(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error) {
+func (fake *FakeAddNetworkPolicyActor) GetSpaceGUIDByNameAndOrganizationName(spaceName string, orgName string) (string, cfnetworkingaction.Warnings, error) {
+	fake.getSpaceGUIDByNameAndOrganizationNameMutex.Lock()
+	ret, specificReturn := fake.getSpaceGUIDByNameAndOrganizationNameReturnsOnCall[len(fake.getSpaceGUIDByNameAndOrganizationNameArgsForCall)]
+	fake.getSpaceGUIDByNameAndOrganizationNameArgsForCall = append(fake.getSpaceGUIDByNameAndOrganizationNameArgsForCall, struct {
+		spaceName string
+		orgName   string
+	}{spaceName, orgName})
+	fake.recordInvocation("GetSpaceGUIDByNameAndOrganizationName", []interface{}{spaceName, orgName})
+	fake.getSpaceGUIDByNameAndOrganizationNameMutex.Unlock()
+	if fake.GetSpaceGUIDByNameAndOrganizationNameStub != nil {
+		return fake.GetSpaceGUIDByNameAndOrganizationNameStub(spaceName, orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceGUIDByNameAndOrganizationNameReturns.result1, fake.getSpaceGUIDByNameAndOrganizationNameReturns.result2, fake.getSpaceGUIDByNameAndOrganizationNameReturns.result3
+}
+
+func (fake *FakeAddNetworkPolicyActor) GetSpaceGUIDByNameAndOrganizationNameCallCount() int {
+	fake.getSpaceGUIDByNameAndOrganizationNameMutex.RLock()
+	defer fake.getSpaceGUIDByNameAndOrganizationNameMutex.RUnlock()
+	return len(fake.getSpaceGUIDByNameAndOrganizationNameArgsForCall)
+}
+
+func (fake *FakeAddNetworkPolicyActor) GetSpaceGUIDByNameAndOrganizationNameArgsForCall(i int) (string, string) {
+	fake.getSpaceGUIDByNameAndOrganizationNameMutex.RLock()
+	defer fake.getSpaceGUIDByNameAndOrganizationNameMutex.RUnlock()
+	return fake.getSpaceGUIDByNameAndOrganizationNameArgsForCall[i].spaceName, fake.getSpaceGUIDByNameAndOrganizationNameArgsForCall[i].orgName
+}
+
+func (fake *FakeAddNetworkPolicyActor) GetSpaceGUIDByNameAndOrganizationNameReturns(result1 string, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.GetSpaceGUIDByNameAndOrganizationNameStub = nil
+	fake.getSpaceGUIDByNameAndOrganizationNameReturns = struct {
+		result1 string
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAddNetworkPolicyActor) GetSpaceGUIDByNameAndOrganizationNameReturnsOnCall(i int, result1 string, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.GetSpaceGUIDByNameAndOrganizationNameStub = nil
+	if fake.getSpaceGUIDByNameAndOrganizationNameReturnsOnCall == nil {
+		fake.getSpaceGUIDByNameAndOrganizationNameReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceGUIDByNameAndOrganizationNameReturnsOnCall[i] = struct {
+		result1 string
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error) {
 	fake.addNetworkPolicyMutex.Lock()
 	ret, specificReturn := fake.addNetworkPolicyReturnsOnCall[len(fake.addNetworkPolicyArgsForCall)]
 	fake.addNetworkPolicyArgsForCall = append(fake.addNetworkPolicyArgsForCall, struct {
-		spaceGUID   string
-		srcAppName  string
-		destAppName string
-		protocol    string
-		startPort   int
-		endPort     int
-	}{spaceGUID, srcAppName, destAppName, protocol, startPort, endPort})
-	fake.recordInvocation("AddNetworkPolicy", []interface{}{spaceGUID, srcAppName, destAppName, protocol, startPort, endPort})
+		srcSpaceGUID  string
+		srcAppName    string
+		destSpaceGUID string
+		destAppName   string
+		protocol      string
+		startPort     int
+		endPort       int
+	}{srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol, startPort, endPort})
+	fake.recordInvocation("AddNetworkPolicy", []interface{}{srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol, startPort, endPort})
 	fake.addNetworkPolicyMutex.Unlock()
 	if fake.AddNetworkPolicyStub != nil {
-		return fake.AddNetworkPolicyStub(spaceGUID, srcAppName, destAppName, protocol, startPort, endPort)
+		return fake.AddNetworkPolicyStub(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol, startPort, endPort)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -59,10 +132,10 @@ func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicyCallCount() int {
 	return len(fake.addNetworkPolicyArgsForCall)
 }
 
-func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicyArgsForCall(i int) (string, string, string, string, int, int) {
+func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicyArgsForCall(i int) (string, string, string, string, string, int, int) {
 	fake.addNetworkPolicyMutex.RLock()
 	defer fake.addNetworkPolicyMutex.RUnlock()
-	return fake.addNetworkPolicyArgsForCall[i].spaceGUID, fake.addNetworkPolicyArgsForCall[i].srcAppName, fake.addNetworkPolicyArgsForCall[i].destAppName, fake.addNetworkPolicyArgsForCall[i].protocol, fake.addNetworkPolicyArgsForCall[i].startPort, fake.addNetworkPolicyArgsForCall[i].endPort
+	return fake.addNetworkPolicyArgsForCall[i].srcSpaceGUID, fake.addNetworkPolicyArgsForCall[i].srcAppName, fake.addNetworkPolicyArgsForCall[i].destSpaceGUID, fake.addNetworkPolicyArgsForCall[i].destAppName, fake.addNetworkPolicyArgsForCall[i].protocol, fake.addNetworkPolicyArgsForCall[i].startPort, fake.addNetworkPolicyArgsForCall[i].endPort
 }
 
 func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicyReturns(result1 cfnetworkingaction.Warnings, result2 error) {
@@ -90,6 +163,8 @@ func (fake *FakeAddNetworkPolicyActor) AddNetworkPolicyReturnsOnCall(i int, resu
 func (fake *FakeAddNetworkPolicyActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getSpaceGUIDByNameAndOrganizationNameMutex.RLock()
+	defer fake.getSpaceGUIDByNameAndOrganizationNameMutex.RUnlock()
 	fake.addNetworkPolicyMutex.RLock()
 	defer fake.addNetworkPolicyMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
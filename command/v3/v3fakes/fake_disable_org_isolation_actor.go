@@ -32,6 +32,50 @@ type FakeDisableOrgIsolationActor struct {
 		result1 v3action.Warnings
 		result2 error
 	}
+	GetIsolationSegmentByNameStub        func(name string) (v3action.IsolationSegment, v3action.Warnings, error)
+	getIsolationSegmentByNameMutex       sync.RWMutex
+	getIsolationSegmentByNameArgsForCall []struct {
+		name string
+	}
+	getIsolationSegmentByNameReturns struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getIsolationSegmentByNameReturnsOnCall map[int]struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetOrganizationsByNamesStub        func(names []string) ([]v3action.Organization, v3action.Warnings, error)
+	getOrganizationsByNamesMutex       sync.RWMutex
+	getOrganizationsByNamesArgsForCall []struct {
+		names []string
+	}
+	getOrganizationsByNamesReturns struct {
+		result1 []v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}
+	getOrganizationsByNamesReturnsOnCall map[int]struct {
+		result1 []v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}
+	RevokeIsolationSegmentFromOrganizationStub        func(isolationSegment v3action.IsolationSegment, org v3action.Organization) (v3action.Warnings, error)
+	revokeIsolationSegmentFromOrganizationMutex       sync.RWMutex
+	revokeIsolationSegmentFromOrganizationArgsForCall []struct {
+		isolationSegment v3action.IsolationSegment
+		org              v3action.Organization
+	}
+	revokeIsolationSegmentFromOrganizationReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	revokeIsolationSegmentFromOrganizationReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -128,6 +172,171 @@ func (fake *FakeDisableOrgIsolationActor) RevokeIsolationSegmentFromOrganization
 	}{result1, result2}
 }
 
+func (fake *FakeDisableOrgIsolationActor) GetIsolationSegmentByName(name string) (v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getIsolationSegmentByNameMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentByNameReturnsOnCall[len(fake.getIsolationSegmentByNameArgsForCall)]
+	fake.getIsolationSegmentByNameArgsForCall = append(fake.getIsolationSegmentByNameArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetIsolationSegmentByName", []interface{}{name})
+	fake.getIsolationSegmentByNameMutex.Unlock()
+	if fake.GetIsolationSegmentByNameStub != nil {
+		return fake.GetIsolationSegmentByNameStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentByNameReturns.result1, fake.getIsolationSegmentByNameReturns.result2, fake.getIsolationSegmentByNameReturns.result3
+}
+
+func (fake *FakeDisableOrgIsolationActor) GetIsolationSegmentByNameCallCount() int {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	return len(fake.getIsolationSegmentByNameArgsForCall)
+}
+
+func (fake *FakeDisableOrgIsolationActor) GetIsolationSegmentByNameArgsForCall(i int) string {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	return fake.getIsolationSegmentByNameArgsForCall[i].name
+}
+
+func (fake *FakeDisableOrgIsolationActor) GetIsolationSegmentByNameReturns(result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentByNameStub = nil
+	fake.getIsolationSegmentByNameReturns = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDisableOrgIsolationActor) GetIsolationSegmentByNameReturnsOnCall(i int, result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentByNameStub = nil
+	if fake.getIsolationSegmentByNameReturnsOnCall == nil {
+		fake.getIsolationSegmentByNameReturnsOnCall = make(map[int]struct {
+			result1 v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentByNameReturnsOnCall[i] = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDisableOrgIsolationActor) GetOrganizationsByNames(names []string) ([]v3action.Organization, v3action.Warnings, error) {
+	var namesCopy []string
+	if names != nil {
+		namesCopy = make([]string, len(names))
+		copy(namesCopy, names)
+	}
+	fake.getOrganizationsByNamesMutex.Lock()
+	ret, specificReturn := fake.getOrganizationsByNamesReturnsOnCall[len(fake.getOrganizationsByNamesArgsForCall)]
+	fake.getOrganizationsByNamesArgsForCall = append(fake.getOrganizationsByNamesArgsForCall, struct {
+		names []string
+	}{namesCopy})
+	fake.recordInvocation("GetOrganizationsByNames", []interface{}{namesCopy})
+	fake.getOrganizationsByNamesMutex.Unlock()
+	if fake.GetOrganizationsByNamesStub != nil {
+		return fake.GetOrganizationsByNamesStub(names)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationsByNamesReturns.result1, fake.getOrganizationsByNamesReturns.result2, fake.getOrganizationsByNamesReturns.result3
+}
+
+func (fake *FakeDisableOrgIsolationActor) GetOrganizationsByNamesCallCount() int {
+	fake.getOrganizationsByNamesMutex.RLock()
+	defer fake.getOrganizationsByNamesMutex.RUnlock()
+	return len(fake.getOrganizationsByNamesArgsForCall)
+}
+
+func (fake *FakeDisableOrgIsolationActor) GetOrganizationsByNamesArgsForCall(i int) []string {
+	fake.getOrganizationsByNamesMutex.RLock()
+	defer fake.getOrganizationsByNamesMutex.RUnlock()
+	return fake.getOrganizationsByNamesArgsForCall[i].names
+}
+
+func (fake *FakeDisableOrgIsolationActor) GetOrganizationsByNamesReturns(result1 []v3action.Organization, result2 v3action.Warnings, result3 error) {
+	fake.GetOrganizationsByNamesStub = nil
+	fake.getOrganizationsByNamesReturns = struct {
+		result1 []v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDisableOrgIsolationActor) GetOrganizationsByNamesReturnsOnCall(i int, result1 []v3action.Organization, result2 v3action.Warnings, result3 error) {
+	fake.GetOrganizationsByNamesStub = nil
+	if fake.getOrganizationsByNamesReturnsOnCall == nil {
+		fake.getOrganizationsByNamesReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Organization
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationsByNamesReturnsOnCall[i] = struct {
+		result1 []v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDisableOrgIsolationActor) RevokeIsolationSegmentFromOrganization(isolationSegment v3action.IsolationSegment, org v3action.Organization) (v3action.Warnings, error) {
+	fake.revokeIsolationSegmentFromOrganizationMutex.Lock()
+	ret, specificReturn := fake.revokeIsolationSegmentFromOrganizationReturnsOnCall[len(fake.revokeIsolationSegmentFromOrganizationArgsForCall)]
+	fake.revokeIsolationSegmentFromOrganizationArgsForCall = append(fake.revokeIsolationSegmentFromOrganizationArgsForCall, struct {
+		isolationSegment v3action.IsolationSegment
+		org              v3action.Organization
+	}{isolationSegment, org})
+	fake.recordInvocation("RevokeIsolationSegmentFromOrganization", []interface{}{isolationSegment, org})
+	fake.revokeIsolationSegmentFromOrganizationMutex.Unlock()
+	if fake.RevokeIsolationSegmentFromOrganizationStub != nil {
+		return fake.RevokeIsolationSegmentFromOrganizationStub(isolationSegment, org)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.revokeIsolationSegmentFromOrganizationReturns.result1, fake.revokeIsolationSegmentFromOrganizationReturns.result2
+}
+
+func (fake *FakeDisableOrgIsolationActor) RevokeIsolationSegmentFromOrganizationCallCount() int {
+	fake.revokeIsolationSegmentFromOrganizationMutex.RLock()
+	defer fake.revokeIsolationSegmentFromOrganizationMutex.RUnlock()
+	return len(fake.revokeIsolationSegmentFromOrganizationArgsForCall)
+}
+
+func (fake *FakeDisableOrgIsolationActor) RevokeIsolationSegmentFromOrganizationArgsForCall(i int) (v3action.IsolationSegment, v3action.Organization) {
+	fake.revokeIsolationSegmentFromOrganizationMutex.RLock()
+	defer fake.revokeIsolationSegmentFromOrganizationMutex.RUnlock()
+	return fake.revokeIsolationSegmentFromOrganizationArgsForCall[i].isolationSegment, fake.revokeIsolationSegmentFromOrganizationArgsForCall[i].org
+}
+
+func (fake *FakeDisableOrgIsolationActor) RevokeIsolationSegmentFromOrganizationReturns(result1 v3action.Warnings, result2 error) {
+	fake.RevokeIsolationSegmentFromOrganizationStub = nil
+	fake.revokeIsolationSegmentFromOrganizationReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDisableOrgIsolationActor) RevokeIsolationSegmentFromOrganizationReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.RevokeIsolationSegmentFromOrganizationStub = nil
+	if fake.revokeIsolationSegmentFromOrganizationReturnsOnCall == nil {
+		fake.revokeIsolationSegmentFromOrganizationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.revokeIsolationSegmentFromOrganizationReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDisableOrgIsolationActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -135,6 +344,12 @@ func (fake *FakeDisableOrgIsolationActor) Invocations() map[string][][]interface
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.revokeIsolationSegmentFromOrganizationByNameMutex.RLock()
 	defer fake.revokeIsolationSegmentFromOrganizationByNameMutex.RUnlock()
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	fake.getOrganizationsByNamesMutex.RLock()
+	defer fake.getOrganizationsByNamesMutex.RUnlock()
+	fake.revokeIsolationSegmentFromOrganizationMutex.RLock()
+	defer fake.revokeIsolationSegmentFromOrganizationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
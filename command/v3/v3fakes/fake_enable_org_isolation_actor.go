@@ -32,6 +32,50 @@ type FakeEnableOrgIsolationActor struct {
 		result1 v3action.Warnings
 		result2 error
 	}
+	EntitleIsolationSegmentToOrganizationStub        func(isolationSegment v3action.IsolationSegment, org v3action.Organization) (v3action.Warnings, error)
+	entitleIsolationSegmentToOrganizationMutex       sync.RWMutex
+	entitleIsolationSegmentToOrganizationArgsForCall []struct {
+		isolationSegment v3action.IsolationSegment
+		org              v3action.Organization
+	}
+	entitleIsolationSegmentToOrganizationReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	entitleIsolationSegmentToOrganizationReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	GetIsolationSegmentByNameStub        func(name string) (v3action.IsolationSegment, v3action.Warnings, error)
+	getIsolationSegmentByNameMutex       sync.RWMutex
+	getIsolationSegmentByNameArgsForCall []struct {
+		name string
+	}
+	getIsolationSegmentByNameReturns struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getIsolationSegmentByNameReturnsOnCall map[int]struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetOrganizationsByNamesStub        func(names []string) ([]v3action.Organization, v3action.Warnings, error)
+	getOrganizationsByNamesMutex       sync.RWMutex
+	getOrganizationsByNamesArgsForCall []struct {
+		names []string
+	}
+	getOrganizationsByNamesReturns struct {
+		result1 []v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}
+	getOrganizationsByNamesReturnsOnCall map[int]struct {
+		result1 []v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -128,6 +172,171 @@ func (fake *FakeEnableOrgIsolationActor) EntitleIsolationSegmentToOrganizationBy
 	}{result1, result2}
 }
 
+func (fake *FakeEnableOrgIsolationActor) EntitleIsolationSegmentToOrganization(isolationSegment v3action.IsolationSegment, org v3action.Organization) (v3action.Warnings, error) {
+	fake.entitleIsolationSegmentToOrganizationMutex.Lock()
+	ret, specificReturn := fake.entitleIsolationSegmentToOrganizationReturnsOnCall[len(fake.entitleIsolationSegmentToOrganizationArgsForCall)]
+	fake.entitleIsolationSegmentToOrganizationArgsForCall = append(fake.entitleIsolationSegmentToOrganizationArgsForCall, struct {
+		isolationSegment v3action.IsolationSegment
+		org              v3action.Organization
+	}{isolationSegment, org})
+	fake.recordInvocation("EntitleIsolationSegmentToOrganization", []interface{}{isolationSegment, org})
+	fake.entitleIsolationSegmentToOrganizationMutex.Unlock()
+	if fake.EntitleIsolationSegmentToOrganizationStub != nil {
+		return fake.EntitleIsolationSegmentToOrganizationStub(isolationSegment, org)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.entitleIsolationSegmentToOrganizationReturns.result1, fake.entitleIsolationSegmentToOrganizationReturns.result2
+}
+
+func (fake *FakeEnableOrgIsolationActor) EntitleIsolationSegmentToOrganizationCallCount() int {
+	fake.entitleIsolationSegmentToOrganizationMutex.RLock()
+	defer fake.entitleIsolationSegmentToOrganizationMutex.RUnlock()
+	return len(fake.entitleIsolationSegmentToOrganizationArgsForCall)
+}
+
+func (fake *FakeEnableOrgIsolationActor) EntitleIsolationSegmentToOrganizationArgsForCall(i int) (v3action.IsolationSegment, v3action.Organization) {
+	fake.entitleIsolationSegmentToOrganizationMutex.RLock()
+	defer fake.entitleIsolationSegmentToOrganizationMutex.RUnlock()
+	return fake.entitleIsolationSegmentToOrganizationArgsForCall[i].isolationSegment, fake.entitleIsolationSegmentToOrganizationArgsForCall[i].org
+}
+
+func (fake *FakeEnableOrgIsolationActor) EntitleIsolationSegmentToOrganizationReturns(result1 v3action.Warnings, result2 error) {
+	fake.EntitleIsolationSegmentToOrganizationStub = nil
+	fake.entitleIsolationSegmentToOrganizationReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnableOrgIsolationActor) EntitleIsolationSegmentToOrganizationReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.EntitleIsolationSegmentToOrganizationStub = nil
+	if fake.entitleIsolationSegmentToOrganizationReturnsOnCall == nil {
+		fake.entitleIsolationSegmentToOrganizationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.entitleIsolationSegmentToOrganizationReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEnableOrgIsolationActor) GetIsolationSegmentByName(name string) (v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getIsolationSegmentByNameMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentByNameReturnsOnCall[len(fake.getIsolationSegmentByNameArgsForCall)]
+	fake.getIsolationSegmentByNameArgsForCall = append(fake.getIsolationSegmentByNameArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetIsolationSegmentByName", []interface{}{name})
+	fake.getIsolationSegmentByNameMutex.Unlock()
+	if fake.GetIsolationSegmentByNameStub != nil {
+		return fake.GetIsolationSegmentByNameStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentByNameReturns.result1, fake.getIsolationSegmentByNameReturns.result2, fake.getIsolationSegmentByNameReturns.result3
+}
+
+func (fake *FakeEnableOrgIsolationActor) GetIsolationSegmentByNameCallCount() int {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	return len(fake.getIsolationSegmentByNameArgsForCall)
+}
+
+func (fake *FakeEnableOrgIsolationActor) GetIsolationSegmentByNameArgsForCall(i int) string {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	return fake.getIsolationSegmentByNameArgsForCall[i].name
+}
+
+func (fake *FakeEnableOrgIsolationActor) GetIsolationSegmentByNameReturns(result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentByNameStub = nil
+	fake.getIsolationSegmentByNameReturns = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEnableOrgIsolationActor) GetIsolationSegmentByNameReturnsOnCall(i int, result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentByNameStub = nil
+	if fake.getIsolationSegmentByNameReturnsOnCall == nil {
+		fake.getIsolationSegmentByNameReturnsOnCall = make(map[int]struct {
+			result1 v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentByNameReturnsOnCall[i] = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEnableOrgIsolationActor) GetOrganizationsByNames(names []string) ([]v3action.Organization, v3action.Warnings, error) {
+	var namesCopy []string
+	if names != nil {
+		namesCopy = make([]string, len(names))
+		copy(namesCopy, names)
+	}
+	fake.getOrganizationsByNamesMutex.Lock()
+	ret, specificReturn := fake.getOrganizationsByNamesReturnsOnCall[len(fake.getOrganizationsByNamesArgsForCall)]
+	fake.getOrganizationsByNamesArgsForCall = append(fake.getOrganizationsByNamesArgsForCall, struct {
+		names []string
+	}{namesCopy})
+	fake.recordInvocation("GetOrganizationsByNames", []interface{}{namesCopy})
+	fake.getOrganizationsByNamesMutex.Unlock()
+	if fake.GetOrganizationsByNamesStub != nil {
+		return fake.GetOrganizationsByNamesStub(names)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationsByNamesReturns.result1, fake.getOrganizationsByNamesReturns.result2, fake.getOrganizationsByNamesReturns.result3
+}
+
+func (fake *FakeEnableOrgIsolationActor) GetOrganizationsByNamesCallCount() int {
+	fake.getOrganizationsByNamesMutex.RLock()
+	defer fake.getOrganizationsByNamesMutex.RUnlock()
+	return len(fake.getOrganizationsByNamesArgsForCall)
+}
+
+func (fake *FakeEnableOrgIsolationActor) GetOrganizationsByNamesArgsForCall(i int) []string {
+	fake.getOrganizationsByNamesMutex.RLock()
+	defer fake.getOrganizationsByNamesMutex.RUnlock()
+	return fake.getOrganizationsByNamesArgsForCall[i].names
+}
+
+func (fake *FakeEnableOrgIsolationActor) GetOrganizationsByNamesReturns(result1 []v3action.Organization, result2 v3action.Warnings, result3 error) {
+	fake.GetOrganizationsByNamesStub = nil
+	fake.getOrganizationsByNamesReturns = struct {
+		result1 []v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeEnableOrgIsolationActor) GetOrganizationsByNamesReturnsOnCall(i int, result1 []v3action.Organization, result2 v3action.Warnings, result3 error) {
+	fake.GetOrganizationsByNamesStub = nil
+	if fake.getOrganizationsByNamesReturnsOnCall == nil {
+		fake.getOrganizationsByNamesReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Organization
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationsByNamesReturnsOnCall[i] = struct {
+		result1 []v3action.Organization
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeEnableOrgIsolationActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -135,6 +344,12 @@ func (fake *FakeEnableOrgIsolationActor) Invocations() map[string][][]interface{
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
+	fake.entitleIsolationSegmentToOrganizationMutex.RLock()
+	defer fake.entitleIsolationSegmentToOrganizationMutex.RUnlock()
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	fake.getOrganizationsByNamesMutex.RLock()
+	defer fake.getOrganizationsByNamesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -50,6 +50,22 @@ type FakeRunTaskActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	GetRunningTasksByNameAndApplicationStub        func(name string, appGUID string) ([]v3action.Task, v3action.Warnings, error)
+	getRunningTasksByNameAndApplicationMutex       sync.RWMutex
+	getRunningTasksByNameAndApplicationArgsForCall []struct {
+		name    string
+		appGUID string
+	}
+	getRunningTasksByNameAndApplicationReturns struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	getRunningTasksByNameAndApplicationReturnsOnCall map[int]struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -204,6 +220,61 @@ func (fake *FakeRunTaskActor) CloudControllerAPIVersionReturnsOnCall(i int, resu
 	}{result1}
 }
 
+func (fake *FakeRunTaskActor) GetRunningTasksByNameAndApplication(name string, appGUID string) ([]v3action.Task, v3action.Warnings, error) {
+	fake.getRunningTasksByNameAndApplicationMutex.Lock()
+	ret, specificReturn := fake.getRunningTasksByNameAndApplicationReturnsOnCall[len(fake.getRunningTasksByNameAndApplicationArgsForCall)]
+	fake.getRunningTasksByNameAndApplicationArgsForCall = append(fake.getRunningTasksByNameAndApplicationArgsForCall, struct {
+		name    string
+		appGUID string
+	}{name, appGUID})
+	fake.recordInvocation("GetRunningTasksByNameAndApplication", []interface{}{name, appGUID})
+	fake.getRunningTasksByNameAndApplicationMutex.Unlock()
+	if fake.GetRunningTasksByNameAndApplicationStub != nil {
+		return fake.GetRunningTasksByNameAndApplicationStub(name, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRunningTasksByNameAndApplicationReturns.result1, fake.getRunningTasksByNameAndApplicationReturns.result2, fake.getRunningTasksByNameAndApplicationReturns.result3
+}
+
+func (fake *FakeRunTaskActor) GetRunningTasksByNameAndApplicationCallCount() int {
+	fake.getRunningTasksByNameAndApplicationMutex.RLock()
+	defer fake.getRunningTasksByNameAndApplicationMutex.RUnlock()
+	return len(fake.getRunningTasksByNameAndApplicationArgsForCall)
+}
+
+func (fake *FakeRunTaskActor) GetRunningTasksByNameAndApplicationArgsForCall(i int) (string, string) {
+	fake.getRunningTasksByNameAndApplicationMutex.RLock()
+	defer fake.getRunningTasksByNameAndApplicationMutex.RUnlock()
+	return fake.getRunningTasksByNameAndApplicationArgsForCall[i].name, fake.getRunningTasksByNameAndApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeRunTaskActor) GetRunningTasksByNameAndApplicationReturns(result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetRunningTasksByNameAndApplicationStub = nil
+	fake.getRunningTasksByNameAndApplicationReturns = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRunTaskActor) GetRunningTasksByNameAndApplicationReturnsOnCall(i int, result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetRunningTasksByNameAndApplicationStub = nil
+	if fake.getRunningTasksByNameAndApplicationReturnsOnCall == nil {
+		fake.getRunningTasksByNameAndApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getRunningTasksByNameAndApplicationReturnsOnCall[i] = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeRunTaskActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -213,6 +284,8 @@ func (fake *FakeRunTaskActor) Invocations() map[string][][]interface{} {
 	defer fake.runTaskMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.getRunningTasksByNameAndApplicationMutex.RLock()
+	defer fake.getRunningTasksByNameAndApplicationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -65,6 +65,22 @@ type FakeTerminateTaskActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	GetRunningTaskByNameAndApplicationStub        func(name string, appGUID string) (v3action.Task, v3action.Warnings, error)
+	getRunningTaskByNameAndApplicationMutex       sync.RWMutex
+	getRunningTaskByNameAndApplicationArgsForCall []struct {
+		name    string
+		appGUID string
+	}
+	getRunningTaskByNameAndApplicationReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	getRunningTaskByNameAndApplicationReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -273,6 +289,61 @@ func (fake *FakeTerminateTaskActor) CloudControllerAPIVersionReturnsOnCall(i int
 	}{result1}
 }
 
+func (fake *FakeTerminateTaskActor) GetRunningTaskByNameAndApplication(name string, appGUID string) (v3action.Task, v3action.Warnings, error) {
+	fake.getRunningTaskByNameAndApplicationMutex.Lock()
+	ret, specificReturn := fake.getRunningTaskByNameAndApplicationReturnsOnCall[len(fake.getRunningTaskByNameAndApplicationArgsForCall)]
+	fake.getRunningTaskByNameAndApplicationArgsForCall = append(fake.getRunningTaskByNameAndApplicationArgsForCall, struct {
+		name    string
+		appGUID string
+	}{name, appGUID})
+	fake.recordInvocation("GetRunningTaskByNameAndApplication", []interface{}{name, appGUID})
+	fake.getRunningTaskByNameAndApplicationMutex.Unlock()
+	if fake.GetRunningTaskByNameAndApplicationStub != nil {
+		return fake.GetRunningTaskByNameAndApplicationStub(name, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRunningTaskByNameAndApplicationReturns.result1, fake.getRunningTaskByNameAndApplicationReturns.result2, fake.getRunningTaskByNameAndApplicationReturns.result3
+}
+
+func (fake *FakeTerminateTaskActor) GetRunningTaskByNameAndApplicationCallCount() int {
+	fake.getRunningTaskByNameAndApplicationMutex.RLock()
+	defer fake.getRunningTaskByNameAndApplicationMutex.RUnlock()
+	return len(fake.getRunningTaskByNameAndApplicationArgsForCall)
+}
+
+func (fake *FakeTerminateTaskActor) GetRunningTaskByNameAndApplicationArgsForCall(i int) (string, string) {
+	fake.getRunningTaskByNameAndApplicationMutex.RLock()
+	defer fake.getRunningTaskByNameAndApplicationMutex.RUnlock()
+	return fake.getRunningTaskByNameAndApplicationArgsForCall[i].name, fake.getRunningTaskByNameAndApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeTerminateTaskActor) GetRunningTaskByNameAndApplicationReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetRunningTaskByNameAndApplicationStub = nil
+	fake.getRunningTaskByNameAndApplicationReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTerminateTaskActor) GetRunningTaskByNameAndApplicationReturnsOnCall(i int, result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetRunningTaskByNameAndApplicationStub = nil
+	if fake.getRunningTaskByNameAndApplicationReturnsOnCall == nil {
+		fake.getRunningTaskByNameAndApplicationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getRunningTaskByNameAndApplicationReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTerminateTaskActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -284,6 +355,8 @@ func (fake *FakeTerminateTaskActor) Invocations() map[string][][]interface{} {
 	defer fake.terminateTaskMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.getRunningTaskByNameAndApplicationMutex.RLock()
+	defer fake.getRunningTaskByNameAndApplicationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -189,6 +189,21 @@ type FakeV3PushActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	UpdateProcessHealthCheckByTypeAndApplicationStub        func(processType string, appGUID string, healthCheck v3action.ProcessHealthCheck) (v3action.Warnings, error)
+	updateProcessHealthCheckByTypeAndApplicationMutex       sync.RWMutex
+	updateProcessHealthCheckByTypeAndApplicationArgsForCall []struct {
+		processType string
+		appGUID     string
+		healthCheck v3action.ProcessHealthCheck
+	}
+	updateProcessHealthCheckByTypeAndApplicationReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	updateProcessHealthCheckByTypeAndApplicationReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -830,6 +845,59 @@ func (fake *FakeV3PushActor) UpdateApplicationReturnsOnCall(i int, result1 v3act
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3PushActor) UpdateProcessHealthCheckByTypeAndApplication(processType string, appGUID string, healthCheck v3action.ProcessHealthCheck) (v3action.Warnings, error) {
+	fake.updateProcessHealthCheckByTypeAndApplicationMutex.Lock()
+	ret, specificReturn := fake.updateProcessHealthCheckByTypeAndApplicationReturnsOnCall[len(fake.updateProcessHealthCheckByTypeAndApplicationArgsForCall)]
+	fake.updateProcessHealthCheckByTypeAndApplicationArgsForCall = append(fake.updateProcessHealthCheckByTypeAndApplicationArgsForCall, struct {
+		processType string
+		appGUID     string
+		healthCheck v3action.ProcessHealthCheck
+	}{processType, appGUID, healthCheck})
+	fake.recordInvocation("UpdateProcessHealthCheckByTypeAndApplication", []interface{}{processType, appGUID, healthCheck})
+	fake.updateProcessHealthCheckByTypeAndApplicationMutex.Unlock()
+	if fake.UpdateProcessHealthCheckByTypeAndApplicationStub != nil {
+		return fake.UpdateProcessHealthCheckByTypeAndApplicationStub(processType, appGUID, healthCheck)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateProcessHealthCheckByTypeAndApplicationReturns.result1, fake.updateProcessHealthCheckByTypeAndApplicationReturns.result2
+}
+
+func (fake *FakeV3PushActor) UpdateProcessHealthCheckByTypeAndApplicationCallCount() int {
+	fake.updateProcessHealthCheckByTypeAndApplicationMutex.RLock()
+	defer fake.updateProcessHealthCheckByTypeAndApplicationMutex.RUnlock()
+	return len(fake.updateProcessHealthCheckByTypeAndApplicationArgsForCall)
+}
+
+func (fake *FakeV3PushActor) UpdateProcessHealthCheckByTypeAndApplicationArgsForCall(i int) (string, string, v3action.ProcessHealthCheck) {
+	fake.updateProcessHealthCheckByTypeAndApplicationMutex.RLock()
+	defer fake.updateProcessHealthCheckByTypeAndApplicationMutex.RUnlock()
+	return fake.updateProcessHealthCheckByTypeAndApplicationArgsForCall[i].processType, fake.updateProcessHealthCheckByTypeAndApplicationArgsForCall[i].appGUID, fake.updateProcessHealthCheckByTypeAndApplicationArgsForCall[i].healthCheck
+}
+
+func (fake *FakeV3PushActor) UpdateProcessHealthCheckByTypeAndApplicationReturns(result1 v3action.Warnings, result2 error) {
+	fake.UpdateProcessHealthCheckByTypeAndApplicationStub = nil
+	fake.updateProcessHealthCheckByTypeAndApplicationReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3PushActor) UpdateProcessHealthCheckByTypeAndApplicationReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.UpdateProcessHealthCheckByTypeAndApplicationStub = nil
+	if fake.updateProcessHealthCheckByTypeAndApplicationReturnsOnCall == nil {
+		fake.updateProcessHealthCheckByTypeAndApplicationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.updateProcessHealthCheckByTypeAndApplicationReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3PushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -857,6 +925,8 @@ func (fake *FakeV3PushActor) Invocations() map[string][][]interface{} {
 	defer fake.stopApplicationMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateProcessHealthCheckByTypeAndApplicationMutex.RLock()
+	defer fake.updateProcessHealthCheckByTypeAndApplicationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,44 @@
+/**
+	* Writes the CF_HOME and CF_PLUGIN_HOME environment variables the plugin was
+	* started with to the file given as its argument.
+**/
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/plugin"
+)
+
+type EchoEnv struct {
+}
+
+func (c *EchoEnv) Run(cliConnection plugin.CliConnection, args []string) {
+	if args[0] == "echo-env" {
+		env := fmt.Sprintf("CF_HOME=%s\nCF_PLUGIN_HOME=%s\n", os.Getenv("CF_HOME"), os.Getenv("CF_PLUGIN_HOME"))
+		err := ioutil.WriteFile(args[1], []byte(env), 0600)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+}
+
+func (c *EchoEnv) GetMetadata() plugin.PluginMetadata {
+	return plugin.PluginMetadata{
+		Name: "EchoEnv",
+		Commands: []plugin.Command{
+			{
+				Name:     "echo-env",
+				HelpText: "Writes the CF_HOME and CF_PLUGIN_HOME the plugin sees to a file",
+			},
+		},
+	}
+}
+
+func main() {
+	plugin.Start(new(EchoEnv))
+}
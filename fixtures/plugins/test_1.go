@@ -67,6 +67,15 @@ func (c *Test1) Run(cliConnection plugin.CliConnection, args []string) {
 		fmt.Println("Is Logged In:", loggedIn)
 		isSSLDisabled, _ := cliConnection.IsSSLDisabled()
 		fmt.Println("Is SSL Disabled:", isSSLDisabled)
+
+		isVerbose, _ := cliConnection.IsVerbose()
+		fmt.Println("Is Verbose:", isVerbose)
+		isColorEnabled, _ := cliConnection.IsColorEnabled()
+		fmt.Println("Is Color Enabled:", isColorEnabled)
+		locale, _ := cliConnection.Locale()
+		fmt.Println("Locale:", locale)
+		traceDestination, _ := cliConnection.TraceDestination()
+		fmt.Println("Trace Destination:", traceDestination)
 	} else if args[0] == "test_1_cmd1" {
 		theFirstCmd()
 	} else if args[0] == "test_1_cmd2" {
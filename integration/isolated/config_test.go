@@ -24,16 +24,16 @@ var _ = Describe("Config", func() {
 			helpers.SetConfigContent(configDir, "")
 		})
 
-		It("displays json warning for a refactored command", func() {
+		It("treats the config file as missing for a refactored command", func() {
 			session := helpers.CF("api")
-			Eventually(session.Err).Should(Say("Warning: Error read/writing config: unexpected end of JSON input for %s\n", helpers.ConvertPathToRegularExpression(filepath.Join(configDir, "config.json"))))
 			Eventually(session).Should(Exit())
+			Expect(session.Err).ToNot(Say("Error read/writing config"))
 		})
 
-		It("displays json warning for an unrefactored command", func() {
+		It("treats the config file as missing for an unrefactored command", func() {
 			session := helpers.CF("curl", "/v2/info")
-			Eventually(session.Err).Should(Say("Warning: Error read/writing config: unexpected end of JSON input for %s\n", helpers.ConvertPathToRegularExpression(filepath.Join(configDir, "config.json"))))
 			Eventually(session).Should(Exit())
+			Expect(session.Err).ToNot(Say("Error read/writing config"))
 		})
 	})
 
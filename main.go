@@ -10,10 +10,10 @@ import (
 	"code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/common"
-	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/panichandler"
+	"code.cloudfoundry.org/cli/util/timings"
 	"code.cloudfoundry.org/cli/util/ui"
 	"github.com/jessevdk/go-flags"
 	log "github.com/sirupsen/logrus"
@@ -33,10 +33,27 @@ var ParseErr = errors.New("incorrect type for arg")
 
 func main() {
 	defer panichandler.HandlePanic()
-	parse(os.Args[1:])
+
+	// --config must take effect before any config is loaded, including by the
+	// legacy commands, which read their arguments from os.Args.
+	args, err := configv3.ApplyConfigFlag(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Incorrect Usage: %s\n\n", err.Error())
+		parse([]string{"help"})
+		os.Exit(1)
+	}
+	os.Args = append(os.Args[:1], args...)
+
+	parse(args)
 }
 
 func parse(args []string) {
+	// An explicit -h/--help always displays the command's help on stdout and
+	// exits 0, even when the other arguments would not parse.
+	if commandName, ok := common.Commands.HelpRequested(args); ok {
+		args = []string{"help", commandName}
+	}
+
 	parser := flags.NewParser(&common.Commands, flags.HelpFlag)
 	parser.CommandHandler = executionWrapper
 	extraArgs, err := parser.ParseArgs(args)
@@ -108,7 +125,7 @@ func parse(args []string) {
 			cmd.Main(os.Getenv("CF_TRACE"), os.Args)
 		case flags.ErrCommandRequired:
 			if common.Commands.VerboseOrVersion {
-				parse([]string{"version"})
+				parse([]string{"version", "--short"})
 			} else {
 				parse([]string{"help"})
 			}
@@ -143,12 +160,11 @@ func isOption(s string) bool {
 
 func executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig, configErr := configv3.LoadConfig(configv3.FlagOverride{
+		NoColor: common.Commands.NoColor,
 		Verbose: common.Commands.VerboseOrVersion,
 	})
 	if configErr != nil {
-		if _, ok := configErr.(translatableerror.EmptyConfigError); !ok {
-			return configErr
-		}
+		return configErr
 	}
 
 	commandUI, err := ui.NewUI(cfConfig)
@@ -156,30 +172,6 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		return err
 	}
 
-	// TODO: when the line in the old code under `cf` which calls
-	// configv3.LoadConfig() is finally removed, then we should replace the code
-	// path above with the following:
-	//
-	// var configErrTemplate string
-	// if configErr != nil {
-	// 	if ce, ok := configErr.(translatableerror.EmptyConfigError); ok {
-	// 		configErrTemplate = ce.Error()
-	// 	} else {
-	// 		return configErr
-	// 	}
-	// }
-
-	// commandUI, err := ui.NewUI(cfConfig)
-	// if err != nil {
-	// 	return err
-	// }
-
-	// if configErr != nil {
-	//   commandUI.DisplayWarning(configErrTemplate, map[string]interface{}{
-	//   	"FilePath": configv3.ConfigFilePath(),
-	//   })
-	// }
-
 	defer func() {
 		configWriteErr := configv3.WriteConfig(cfConfig)
 		if configWriteErr != nil {
@@ -187,6 +179,11 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		}
 	}()
 
+	defer func() {
+		verbose, _ := cfConfig.Verbose()
+		timings.Display(verbose)
+	}()
+
 	if extendedCmd, ok := cmd.(command.ExtendedCommander); ok {
 		log.SetOutput(os.Stderr)
 		log.SetLevel(log.Level(cfConfig.LogLevel()))
@@ -248,6 +248,16 @@ func (c *cliConnection) ApiVersion() (string, error) {
 	return result, err
 }
 
+func (c *cliConnection) IsMinApiVersion(version string) (bool, error) {
+	var result bool
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.IsMinApiVersion", version, &result)
+	})
+
+	return result, err
+}
+
 func (c *cliConnection) LoggregatorEndpoint() (string, error) {
 	var result string
 
@@ -278,6 +288,26 @@ func (c *cliConnection) AccessToken() (string, error) {
 	return result, err
 }
 
+func (c *cliConnection) AccessTokenWithRefresh(minValidSeconds int) (string, error) {
+	var result string
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.AccessTokenWithRefresh", minValidSeconds, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) RefreshAccessToken() (string, error) {
+	var result string
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.RefreshAccessToken", "", &result)
+	})
+
+	return result, err
+}
+
 func (c *cliConnection) GetApp(appName string) (plugin_models.GetAppModel, error) {
 	var result plugin_models.GetAppModel
 
@@ -288,6 +318,16 @@ func (c *cliConnection) GetApp(appName string) (plugin_models.GetAppModel, error
 	return result, err
 }
 
+func (c *cliConnection) GetAppV3(appName string) (plugin_models.GetAppV3Model, error) {
+	var result plugin_models.GetAppV3Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetAppV3", appName, &result)
+	})
+
+	return result, err
+}
+
 func (c *cliConnection) GetApps() ([]plugin_models.GetAppsModel, error) {
 	var result []plugin_models.GetAppsModel
 
@@ -381,3 +421,35 @@ func (c *cliConnection) GetService(serviceInstance string) (plugin_models.GetSer
 
 	return result, err
 }
+
+// getInvocationContext returns an error when the CLI running the plugin
+// predates the invocation context.
+func (c *cliConnection) getInvocationContext() (plugin_models.InvocationContext, error) {
+	var result plugin_models.InvocationContext
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetInvocationContext", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) IsVerbose() (bool, error) {
+	context, err := c.getInvocationContext()
+	return context.Verbose, err
+}
+
+func (c *cliConnection) IsColorEnabled() (bool, error) {
+	context, err := c.getInvocationContext()
+	return context.ColorEnabled, err
+}
+
+func (c *cliConnection) Locale() (string, error) {
+	context, err := c.getInvocationContext()
+	return context.Locale, err
+}
+
+func (c *cliConnection) TraceDestination() (string, error) {
+	context, err := c.getInvocationContext()
+	return context.TraceDestination, err
+}
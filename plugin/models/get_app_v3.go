@@ -0,0 +1,36 @@
+package plugin_models
+
+type GetAppV3Model struct {
+	Guid               string
+	Name               string
+	State              string
+	Lifecycle          GetAppV3_Lifecycle
+	Processes          []GetAppV3_Process
+	Sidecars           []GetAppV3_Sidecar
+	Routes             []GetApp_RouteSummary
+	CurrentDropletGuid string // empty when the app has not been staged
+}
+
+type GetAppV3_Lifecycle struct {
+	Type       string // "buildpack" or "docker"
+	Buildpacks []string
+	Stack      string
+}
+
+type GetAppV3_Process struct {
+	Guid                    string
+	Type                    string
+	Instances               int
+	Memory                  int64 // in Megabytes
+	DiskQuota               int64 // in Megabytes
+	HealthCheckType         string
+	HealthCheckHttpEndpoint string
+}
+
+type GetAppV3_Sidecar struct {
+	Guid         string
+	Name         string
+	Command      string
+	ProcessTypes []string
+	Memory       int64 // in Megabytes
+}
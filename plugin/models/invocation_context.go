@@ -0,0 +1,15 @@
+package plugin_models
+
+// InvocationContext describes the global settings the cf CLI was invoked with
+// when it dispatched the current plugin command.
+type InvocationContext struct {
+	// Verbose is true when the command was run with the global -v flag.
+	Verbose bool
+	// ColorEnabled is true when the CLI colorizes its own output.
+	ColorEnabled bool
+	// Locale is the configured locale, or empty when the default is used.
+	Locale string
+	// TraceDestination is the file API request diagnostics are written to,
+	// "stdout", or empty when tracing is disabled.
+	TraceDestination string
+}
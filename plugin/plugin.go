@@ -31,11 +31,24 @@ type CliConnection interface {
 	HasSpace() (bool, error)
 	ApiEndpoint() (string, error)
 	ApiVersion() (string, error)
+	// IsMinApiVersion returns true when the targeted Cloud Controller API
+	// version is at least the given semantic version. It requires
+	// TokenRefreshMinCliVersion.
+	IsMinApiVersion(string) (bool, error)
 	HasAPIEndpoint() (bool, error)
 	LoggregatorEndpoint() (string, error)
 	DopplerEndpoint() (string, error)
 	AccessToken() (string, error)
+	// AccessTokenWithRefresh returns the current access token when it is valid
+	// for at least the given number of seconds and refreshes it otherwise.
+	// RefreshAccessToken always refreshes it. Both require
+	// TokenRefreshMinCliVersion.
+	AccessTokenWithRefresh(int) (string, error)
+	RefreshAccessToken() (string, error)
 	GetApp(string) (plugin_models.GetAppModel, error)
+	// GetAppV3 returns the processes, sidecars and current droplet of an app
+	// in the targeted space. It requires GetAppV3MinCliVersion.
+	GetAppV3(string) (plugin_models.GetAppV3Model, error)
 	GetApps() ([]plugin_models.GetAppsModel, error)
 	GetOrgs() ([]plugin_models.GetOrgs_Model, error)
 	GetSpaces() ([]plugin_models.GetSpaces_Model, error)
@@ -45,6 +58,13 @@ type CliConnection interface {
 	GetService(string) (plugin_models.GetService_Model, error)
 	GetOrg(string) (plugin_models.GetOrg_Model, error)
 	GetSpace(string) (plugin_models.GetSpace_Model, error)
+	// IsVerbose, IsColorEnabled, Locale and TraceDestination describe how the
+	// CLI was invoked for the current command. They return an error when the
+	// plugin is run by a CLI that does not provide this information.
+	IsVerbose() (bool, error)
+	IsColorEnabled() (bool, error)
+	Locale() (string, error)
+	TraceDestination() (string, error)
 }
 
 type VersionType struct {
@@ -53,6 +73,14 @@ type VersionType struct {
 	Build int
 }
 
+// GetAppV3MinCliVersion is the first CLI version that provides GetAppV3.
+// Plugins that call it should set it as their MinCliVersion.
+var GetAppV3MinCliVersion = VersionType{Major: 6, Minor: 32, Build: 0}
+
+// TokenRefreshMinCliVersion is the first CLI version that provides
+// AccessTokenWithRefresh, RefreshAccessToken and IsMinApiVersion.
+var TokenRefreshMinCliVersion = VersionType{Major: 6, Minor: 32, Build: 0}
+
 type PluginMetadata struct {
 	Name          string
 	Version       VersionType
@@ -35,6 +35,12 @@ func (pluginDemo *PluginDemonstratingParams) Run(cliConnection plugin.CliConnect
 		os.Exit(1)
 	}
 
+	// Older CLIs do not provide the invocation context, in which case the
+	// plugin falls back to its own defaults.
+	if verbose, err := cliConnection.IsVerbose(); err == nil && verbose {
+		fmt.Println("Echoing", len(echoFlagSet.Args()), "arguments")
+	}
+
 	var itemToEcho string
 	for _, value := range echoFlagSet.Args() {
 		if *uppercase {
@@ -216,6 +216,68 @@ type FakeCliConnection struct {
 		result1 plugin_models.GetSpace_Model
 		result2 error
 	}
+	IsVerboseStub        func() (bool, error)
+	isVerboseMutex       sync.RWMutex
+	isVerboseArgsForCall []struct{}
+	isVerboseReturns     struct {
+		result1 bool
+		result2 error
+	}
+	IsColorEnabledStub        func() (bool, error)
+	isColorEnabledMutex       sync.RWMutex
+	isColorEnabledArgsForCall []struct{}
+	isColorEnabledReturns     struct {
+		result1 bool
+		result2 error
+	}
+	LocaleStub        func() (string, error)
+	localeMutex       sync.RWMutex
+	localeArgsForCall []struct{}
+	localeReturns     struct {
+		result1 string
+		result2 error
+	}
+	TraceDestinationStub        func() (string, error)
+	traceDestinationMutex       sync.RWMutex
+	traceDestinationArgsForCall []struct{}
+	traceDestinationReturns     struct {
+		result1 string
+		result2 error
+	}
+	GetAppV3Stub        func(arg1 string) (plugin_models.GetAppV3Model, error)
+	getAppV3Mutex       sync.RWMutex
+	getAppV3ArgsForCall []struct {
+		arg1 string
+	}
+	getAppV3Returns struct {
+		result1 plugin_models.GetAppV3Model
+		result2 error
+	}
+	IsMinApiVersionStub        func(arg1 string) (bool, error)
+	isMinApiVersionMutex       sync.RWMutex
+	isMinApiVersionArgsForCall []struct {
+		arg1 string
+	}
+	isMinApiVersionReturns struct {
+		result1 bool
+		result2 error
+	}
+	AccessTokenWithRefreshStub        func(arg1 int) (string, error)
+	accessTokenWithRefreshMutex       sync.RWMutex
+	accessTokenWithRefreshArgsForCall []struct {
+		arg1 int
+	}
+	accessTokenWithRefreshReturns struct {
+		result1 string
+		result2 error
+	}
+	RefreshAccessTokenStub        func() (string, error)
+	refreshAccessTokenMutex       sync.RWMutex
+	refreshAccessTokenArgsForCall []struct{}
+	refreshAccessTokenReturns     struct {
+		result1 string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -988,6 +1050,238 @@ func (fake *FakeCliConnection) GetSpaceReturns(result1 plugin_models.GetSpace_Mo
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) IsVerbose() (bool, error) {
+	fake.isVerboseMutex.Lock()
+	fake.isVerboseArgsForCall = append(fake.isVerboseArgsForCall, struct{}{})
+	fake.recordInvocation("IsVerbose", []interface{}{})
+	fake.isVerboseMutex.Unlock()
+	if fake.IsVerboseStub != nil {
+		return fake.IsVerboseStub()
+	} else {
+		return fake.isVerboseReturns.result1, fake.isVerboseReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) IsVerboseCallCount() int {
+	fake.isVerboseMutex.RLock()
+	defer fake.isVerboseMutex.RUnlock()
+	return len(fake.isVerboseArgsForCall)
+}
+
+func (fake *FakeCliConnection) IsVerboseReturns(result1 bool, result2 error) {
+	fake.IsVerboseStub = nil
+	fake.isVerboseReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) IsColorEnabled() (bool, error) {
+	fake.isColorEnabledMutex.Lock()
+	fake.isColorEnabledArgsForCall = append(fake.isColorEnabledArgsForCall, struct{}{})
+	fake.recordInvocation("IsColorEnabled", []interface{}{})
+	fake.isColorEnabledMutex.Unlock()
+	if fake.IsColorEnabledStub != nil {
+		return fake.IsColorEnabledStub()
+	} else {
+		return fake.isColorEnabledReturns.result1, fake.isColorEnabledReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) IsColorEnabledCallCount() int {
+	fake.isColorEnabledMutex.RLock()
+	defer fake.isColorEnabledMutex.RUnlock()
+	return len(fake.isColorEnabledArgsForCall)
+}
+
+func (fake *FakeCliConnection) IsColorEnabledReturns(result1 bool, result2 error) {
+	fake.IsColorEnabledStub = nil
+	fake.isColorEnabledReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) Locale() (string, error) {
+	fake.localeMutex.Lock()
+	fake.localeArgsForCall = append(fake.localeArgsForCall, struct{}{})
+	fake.recordInvocation("Locale", []interface{}{})
+	fake.localeMutex.Unlock()
+	if fake.LocaleStub != nil {
+		return fake.LocaleStub()
+	} else {
+		return fake.localeReturns.result1, fake.localeReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) LocaleCallCount() int {
+	fake.localeMutex.RLock()
+	defer fake.localeMutex.RUnlock()
+	return len(fake.localeArgsForCall)
+}
+
+func (fake *FakeCliConnection) LocaleReturns(result1 string, result2 error) {
+	fake.LocaleStub = nil
+	fake.localeReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) TraceDestination() (string, error) {
+	fake.traceDestinationMutex.Lock()
+	fake.traceDestinationArgsForCall = append(fake.traceDestinationArgsForCall, struct{}{})
+	fake.recordInvocation("TraceDestination", []interface{}{})
+	fake.traceDestinationMutex.Unlock()
+	if fake.TraceDestinationStub != nil {
+		return fake.TraceDestinationStub()
+	} else {
+		return fake.traceDestinationReturns.result1, fake.traceDestinationReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) TraceDestinationCallCount() int {
+	fake.traceDestinationMutex.RLock()
+	defer fake.traceDestinationMutex.RUnlock()
+	return len(fake.traceDestinationArgsForCall)
+}
+
+func (fake *FakeCliConnection) TraceDestinationReturns(result1 string, result2 error) {
+	fake.TraceDestinationStub = nil
+	fake.traceDestinationReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetAppV3(arg1 string) (plugin_models.GetAppV3Model, error) {
+	fake.getAppV3Mutex.Lock()
+	fake.getAppV3ArgsForCall = append(fake.getAppV3ArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetAppV3", []interface{}{arg1})
+	fake.getAppV3Mutex.Unlock()
+	if fake.GetAppV3Stub != nil {
+		return fake.GetAppV3Stub(arg1)
+	} else {
+		return fake.getAppV3Returns.result1, fake.getAppV3Returns.result2
+	}
+}
+
+func (fake *FakeCliConnection) GetAppV3CallCount() int {
+	fake.getAppV3Mutex.RLock()
+	defer fake.getAppV3Mutex.RUnlock()
+	return len(fake.getAppV3ArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetAppV3ArgsForCall(i int) string {
+	fake.getAppV3Mutex.RLock()
+	defer fake.getAppV3Mutex.RUnlock()
+	return fake.getAppV3ArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnection) GetAppV3Returns(result1 plugin_models.GetAppV3Model, result2 error) {
+	fake.GetAppV3Stub = nil
+	fake.getAppV3Returns = struct {
+		result1 plugin_models.GetAppV3Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) IsMinApiVersion(arg1 string) (bool, error) {
+	fake.isMinApiVersionMutex.Lock()
+	fake.isMinApiVersionArgsForCall = append(fake.isMinApiVersionArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("IsMinApiVersion", []interface{}{arg1})
+	fake.isMinApiVersionMutex.Unlock()
+	if fake.IsMinApiVersionStub != nil {
+		return fake.IsMinApiVersionStub(arg1)
+	} else {
+		return fake.isMinApiVersionReturns.result1, fake.isMinApiVersionReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) IsMinApiVersionCallCount() int {
+	fake.isMinApiVersionMutex.RLock()
+	defer fake.isMinApiVersionMutex.RUnlock()
+	return len(fake.isMinApiVersionArgsForCall)
+}
+
+func (fake *FakeCliConnection) IsMinApiVersionArgsForCall(i int) string {
+	fake.isMinApiVersionMutex.RLock()
+	defer fake.isMinApiVersionMutex.RUnlock()
+	return fake.isMinApiVersionArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnection) IsMinApiVersionReturns(result1 bool, result2 error) {
+	fake.IsMinApiVersionStub = nil
+	fake.isMinApiVersionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) AccessTokenWithRefresh(arg1 int) (string, error) {
+	fake.accessTokenWithRefreshMutex.Lock()
+	fake.accessTokenWithRefreshArgsForCall = append(fake.accessTokenWithRefreshArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("AccessTokenWithRefresh", []interface{}{arg1})
+	fake.accessTokenWithRefreshMutex.Unlock()
+	if fake.AccessTokenWithRefreshStub != nil {
+		return fake.AccessTokenWithRefreshStub(arg1)
+	} else {
+		return fake.accessTokenWithRefreshReturns.result1, fake.accessTokenWithRefreshReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) AccessTokenWithRefreshCallCount() int {
+	fake.accessTokenWithRefreshMutex.RLock()
+	defer fake.accessTokenWithRefreshMutex.RUnlock()
+	return len(fake.accessTokenWithRefreshArgsForCall)
+}
+
+func (fake *FakeCliConnection) AccessTokenWithRefreshArgsForCall(i int) int {
+	fake.accessTokenWithRefreshMutex.RLock()
+	defer fake.accessTokenWithRefreshMutex.RUnlock()
+	return fake.accessTokenWithRefreshArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnection) AccessTokenWithRefreshReturns(result1 string, result2 error) {
+	fake.AccessTokenWithRefreshStub = nil
+	fake.accessTokenWithRefreshReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) RefreshAccessToken() (string, error) {
+	fake.refreshAccessTokenMutex.Lock()
+	fake.refreshAccessTokenArgsForCall = append(fake.refreshAccessTokenArgsForCall, struct{}{})
+	fake.recordInvocation("RefreshAccessToken", []interface{}{})
+	fake.refreshAccessTokenMutex.Unlock()
+	if fake.RefreshAccessTokenStub != nil {
+		return fake.RefreshAccessTokenStub()
+	} else {
+		return fake.refreshAccessTokenReturns.result1, fake.refreshAccessTokenReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) RefreshAccessTokenCallCount() int {
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	return len(fake.refreshAccessTokenArgsForCall)
+}
+
+func (fake *FakeCliConnection) RefreshAccessTokenReturns(result1 string, result2 error) {
+	fake.RefreshAccessTokenStub = nil
+	fake.refreshAccessTokenReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1045,6 +1339,22 @@ func (fake *FakeCliConnection) Invocations() map[string][][]interface{} {
 	defer fake.getOrgMutex.RUnlock()
 	fake.getSpaceMutex.RLock()
 	defer fake.getSpaceMutex.RUnlock()
+	fake.isVerboseMutex.RLock()
+	defer fake.isVerboseMutex.RUnlock()
+	fake.isColorEnabledMutex.RLock()
+	defer fake.isColorEnabledMutex.RUnlock()
+	fake.localeMutex.RLock()
+	defer fake.localeMutex.RUnlock()
+	fake.traceDestinationMutex.RLock()
+	defer fake.traceDestinationMutex.RUnlock()
+	fake.getAppV3Mutex.RLock()
+	defer fake.getAppV3Mutex.RUnlock()
+	fake.isMinApiVersionMutex.RLock()
+	defer fake.isMinApiVersionMutex.RUnlock()
+	fake.accessTokenWithRefreshMutex.RLock()
+	defer fake.accessTokenWithRefreshMutex.RUnlock()
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"errors"
 	"os"
 	"strings"
 
@@ -22,6 +23,7 @@ import (
 	"io"
 
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/trace"
 )
@@ -47,6 +49,7 @@ type CliRpcCmd struct {
 	outputBucket         *bytes.Buffer
 	logger               trace.Printer
 	stdout               io.Writer
+	invocationContext    plugin_models.InvocationContext
 }
 
 //go:generate counterfeiter . TerminalOutputSwitch
@@ -95,6 +98,12 @@ func NewRpcService(
 	return rpcService, nil
 }
 
+// SetInvocationContext sets the context returned to plugins that ask how the
+// CLI was invoked. It must be called before the plugin is started.
+func (cli *CliRpcService) SetInvocationContext(context plugin_models.InvocationContext) {
+	cli.RpcCmd.invocationContext = context
+}
+
 func (cli *CliRpcService) Stop() {
 	close(cli.stopCh)
 	cli.listener.Close()
@@ -163,6 +172,11 @@ func (cmd *CliRpcCmd) SetPluginMetadata(pluginMetadata plugin.PluginMetadata, re
 	return nil
 }
 
+func (cmd *CliRpcCmd) GetInvocationContext(_ string, retVal *plugin_models.InvocationContext) error {
+	*retVal = cmd.invocationContext
+	return nil
+}
+
 func (cmd *CliRpcCmd) DisableTerminalOutput(disable bool, retVal *bool) error {
 	cmd.terminalOutputSwitch.DisableTerminalOutput(disable)
 	*retVal = true
@@ -304,6 +318,41 @@ func (cmd *CliRpcCmd) AccessToken(args string, retVal *string) error {
 	return nil
 }
 
+// AccessTokenWithRefresh returns the current access token when it is valid
+// for at least minValidSeconds more, and a refreshed token otherwise.
+func (cmd *CliRpcCmd) AccessTokenWithRefresh(minValidSeconds int, retVal *string) error {
+	token := cmd.cliConfig.AccessToken()
+	expiry := coreconfig.NewTokenInfo(token).Expiry
+	if expiry != 0 && time.Unix(expiry, 0).After(time.Now().Add(time.Duration(minValidSeconds)*time.Second)) {
+		*retVal = token
+		return nil
+	}
+
+	return cmd.RefreshAccessToken("", retVal)
+}
+
+func (cmd *CliRpcCmd) RefreshAccessToken(args string, retVal *string) error {
+	token, err := cmd.repoLocator.GetAuthenticationRepository().RefreshAuthToken()
+	if err != nil {
+		return err
+	}
+
+	*retVal = token
+
+	return nil
+}
+
+func (cmd *CliRpcCmd) IsMinApiVersion(passedVersion string, retVal *bool) error {
+	requiredVersion, err := semver.Make(passedVersion)
+	if err != nil {
+		return err
+	}
+
+	*retVal = cmd.cliConfig.IsMinAPIVersion(requiredVersion)
+
+	return nil
+}
+
 func (cmd *CliRpcCmd) GetApp(appName string, retVal *plugin_models.GetAppModel) error {
 	deps := commandregistry.NewDependency(cmd.stdout, cmd.logger, dialTimeout)
 
@@ -318,6 +367,94 @@ func (cmd *CliRpcCmd) GetApp(appName string, retVal *plugin_models.GetAppModel)
 	return cmd.newCmdRunner.Command([]string{"app", appName}, deps, true)
 }
 
+// GetAppV3 reads the app from the API directly, since no command displays
+// its v3 processes, sidecars and droplet.
+func (cmd *CliRpcCmd) GetAppV3(appName string, retVal *plugin_models.GetAppV3Model) error {
+	if !cmd.cliConfig.HasSpace() {
+		return errors.New("No space targeted, use 'cf target -s' to target a space.")
+	}
+
+	appRepo := cmd.repoLocator.GetApplicationRepository()
+	app, err := appRepo.Read(appName)
+	if err != nil {
+		return err
+	}
+
+	v3App, err := appRepo.GetV3App(app.GUID)
+	if err != nil {
+		return err
+	}
+
+	processes, err := appRepo.ListProcesses(app.GUID)
+	if err != nil {
+		return err
+	}
+
+	sidecars, err := appRepo.ListSidecars(app.GUID)
+	if err != nil {
+		return err
+	}
+
+	dropletGUID, err := appRepo.GetCurrentDropletGUID(app.GUID)
+	if err != nil {
+		return err
+	}
+
+	summary, err := cmd.repoLocator.GetAppSummaryRepository().GetSummary(app.GUID)
+	if err != nil {
+		return err
+	}
+
+	*retVal = plugin_models.GetAppV3Model{
+		Guid:  v3App.GUID,
+		Name:  v3App.Name,
+		State: v3App.State,
+		Lifecycle: plugin_models.GetAppV3_Lifecycle{
+			Type:       v3App.LifecycleType,
+			Buildpacks: v3App.Buildpacks,
+			Stack:      v3App.Stack,
+		},
+		CurrentDropletGuid: dropletGUID,
+	}
+
+	for _, process := range processes {
+		retVal.Processes = append(retVal.Processes, plugin_models.GetAppV3_Process{
+			Guid:                    process.GUID,
+			Type:                    process.Type,
+			Instances:               process.Instances,
+			Memory:                  process.MemoryInMB,
+			DiskQuota:               process.DiskInMB,
+			HealthCheckType:         process.HealthCheckType,
+			HealthCheckHttpEndpoint: process.HealthCheckHTTPEndpoint,
+		})
+	}
+
+	for _, sidecar := range sidecars {
+		retVal.Sidecars = append(retVal.Sidecars, plugin_models.GetAppV3_Sidecar{
+			Guid:         sidecar.GUID,
+			Name:         sidecar.Name,
+			Command:      sidecar.Command,
+			ProcessTypes: sidecar.ProcessTypes,
+			Memory:       sidecar.MemoryInMB,
+		})
+	}
+
+	for _, route := range summary.Routes {
+		retVal.Routes = append(retVal.Routes, plugin_models.GetApp_RouteSummary{
+			Guid: route.GUID,
+			Host: route.Host,
+			Domain: plugin_models.GetApp_DomainFields{
+				Guid: route.Domain.GUID,
+				Name: route.Domain.Name,
+			},
+			Path: route.Path,
+			Port: route.Port,
+		})
+	}
+
+	return nil
+}
+
 func (cmd *CliRpcCmd) GetApps(_ string, retVal *[]plugin_models.GetAppsModel) error {
 	deps := commandregistry.NewDependency(cmd.stdout, cmd.logger, dialTimeout)
 
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/applications/applicationsfakes"
 	"code.cloudfoundry.org/cli/cf/api/authentication/authenticationfakes"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
@@ -588,6 +590,47 @@ var _ = Describe("Server", func() {
 				})
 			})
 
+			Context(".GetInvocationContext", func() {
+				BeforeEach(func() {
+					rpcService, err = NewRpcService(nil, nil, config, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+					err := rpcService.Start()
+					Expect(err).ToNot(HaveOccurred())
+
+					pingCli(rpcService.Port())
+				})
+
+				It("returns an empty context when none has been set", func() {
+					client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+					Expect(err).ToNot(HaveOccurred())
+
+					var result plugin_models.InvocationContext
+					err = client.Call("CliRpcCmd.GetInvocationContext", "", &result)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(Equal(plugin_models.InvocationContext{}))
+				})
+
+				It("returns the context the plugin command was invoked with", func() {
+					rpcService.SetInvocationContext(plugin_models.InvocationContext{
+						Verbose:          true,
+						ColorEnabled:     true,
+						Locale:           "fr-FR",
+						TraceDestination: "/tmp/cf-trace.log",
+					})
+					client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+					Expect(err).ToNot(HaveOccurred())
+
+					var result plugin_models.InvocationContext
+					err = client.Call("CliRpcCmd.GetInvocationContext", "", &result)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(Equal(plugin_models.InvocationContext{
+						Verbose:          true,
+						ColorEnabled:     true,
+						Locale:           "fr-FR",
+						TraceDestination: "/tmp/cf-trace.log",
+					}))
+				})
+			})
+
 			Context(".IsLoggedIn", func() {
 				BeforeEach(func() {
 					rpcService, err = NewRpcService(nil, nil, config, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
@@ -742,6 +785,212 @@ var _ = Describe("Server", func() {
 				})
 			})
 
+			Context(".AccessTokenWithRefresh and .RefreshAccessToken", func() {
+				var authRepo *authenticationfakes.FakeRepository
+
+				BeforeEach(func() {
+					authRepo = new(authenticationfakes.FakeRepository)
+					authRepo.RefreshAuthTokenReturns("refreshed-token", nil)
+					locator := api.RepositoryLocator{}
+					locator = locator.SetAuthenticationRepository(authRepo)
+
+					rpcService, err = NewRpcService(nil, nil, config, locator, nil, nil, nil, rpc.DefaultServer)
+					err := rpcService.Start()
+					Expect(err).ToNot(HaveOccurred())
+
+					pingCli(rpcService.Port())
+
+					client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				setTokenExpiry := func(expiry time.Time) string {
+					token, err := testconfig.EncodeAccessToken(coreconfig.TokenInfo{Username: "my-user", Expiry: expiry.Unix()})
+					Expect(err).ToNot(HaveOccurred())
+					config.SetAccessToken(token)
+					return token
+				}
+
+				It("returns the current token when it is valid long enough", func() {
+					token := setTokenExpiry(time.Now().Add(10 * time.Minute))
+
+					var result string
+					err = client.Call("CliRpcCmd.AccessTokenWithRefresh", 60, &result)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(Equal(token))
+					Expect(authRepo.RefreshAuthTokenCallCount()).To(Equal(0))
+				})
+
+				It("refreshes the token when it expires within the given seconds", func() {
+					setTokenExpiry(time.Now().Add(30 * time.Second))
+
+					var result string
+					err = client.Call("CliRpcCmd.AccessTokenWithRefresh", 60, &result)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(Equal("refreshed-token"))
+					Expect(authRepo.RefreshAuthTokenCallCount()).To(Equal(1))
+				})
+
+				It("refreshes the token when its expiry is unknown", func() {
+					config.SetAccessToken("some-opaque-token")
+
+					var result string
+					err = client.Call("CliRpcCmd.AccessTokenWithRefresh", 60, &result)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(Equal("refreshed-token"))
+				})
+
+				It("always refreshes the token with RefreshAccessToken", func() {
+					setTokenExpiry(time.Now().Add(10 * time.Minute))
+
+					var result string
+					err = client.Call("CliRpcCmd.RefreshAccessToken", "", &result)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(Equal("refreshed-token"))
+					Expect(authRepo.RefreshAuthTokenCallCount()).To(Equal(1))
+				})
+
+				It("returns the error from refreshing the access token", func() {
+					authRepo.RefreshAuthTokenReturns("", errors.New("refresh error"))
+
+					var result string
+					err = client.Call("CliRpcCmd.RefreshAccessToken", "", &result)
+					Expect(err).To(MatchError("refresh error"))
+				})
+			})
+
+			Context(".IsMinApiVersion", func() {
+				BeforeEach(func() {
+					config.SetAPIVersion("2.75.0")
+					rpcService, err = NewRpcService(nil, nil, config, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+					err := rpcService.Start()
+					Expect(err).ToNot(HaveOccurred())
+
+					pingCli(rpcService.Port())
+
+					client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("compares the targeted API version with the given version", func() {
+					var result bool
+					err = client.Call("CliRpcCmd.IsMinApiVersion", "2.75.0", &result)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(BeTrue())
+
+					err = client.Call("CliRpcCmd.IsMinApiVersion", "2.76.0", &result)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(BeFalse())
+				})
+
+				It("returns an error when the given version is not a semantic version", func() {
+					var result bool
+					err = client.Call("CliRpcCmd.IsMinApiVersion", "not-a-version", &result)
+					Expect(err).To(HaveOccurred())
+				})
+			})
+
+			Context(".GetAppV3", func() {
+				var (
+					appRepo        *applicationsfakes.FakeRepository
+					appSummaryRepo *apifakes.FakeAppSummaryRepository
+				)
+
+				BeforeEach(func() {
+					appRepo = new(applicationsfakes.FakeRepository)
+					appRepo.ReadReturns(models.Application{ApplicationFields: models.ApplicationFields{GUID: "app-guid", Name: "my-app"}}, nil)
+					appRepo.GetV3AppReturns(models.V3Application{
+						GUID:          "app-guid",
+						Name:          "my-app",
+						State:         "STARTED",
+						LifecycleType: "buildpack",
+						Buildpacks:    []string{"ruby_buildpack"},
+						Stack:         "cflinuxfs2",
+					}, nil)
+					appRepo.ListProcessesReturns([]models.Process{
+						{GUID: "web-guid", Type: "web", Instances: 2, MemoryInMB: 512, DiskInMB: 1024, HealthCheckType: "http", HealthCheckHTTPEndpoint: "/health"},
+					}, nil)
+					appRepo.ListSidecarsReturns([]models.Sidecar{
+						{GUID: "sidecar-guid", Name: "auth", Command: "./auth", ProcessTypes: []string{"web"}, MemoryInMB: 64},
+					}, nil)
+					appRepo.GetCurrentDropletGUIDReturns("droplet-guid", nil)
+
+					appSummaryRepo = new(apifakes.FakeAppSummaryRepository)
+					appSummaryRepo.GetSummaryReturns(models.Application{
+						Routes: []models.RouteSummary{
+							{GUID: "route-guid", Host: "my-app", Domain: models.DomainFields{GUID: "domain-guid", Name: "example.com"}, Path: "/path"},
+						},
+					}, nil)
+
+					locator := api.RepositoryLocator{}
+					locator = locator.SetApplicationRepository(appRepo)
+					locator = locator.SetAppSummaryRepository(appSummaryRepo)
+
+					rpcService, err = NewRpcService(nil, nil, config, locator, nil, nil, nil, rpc.DefaultServer)
+					err := rpcService.Start()
+					Expect(err).ToNot(HaveOccurred())
+
+					pingCli(rpcService.Port())
+				})
+
+				It("returns the v3 details of the app", func() {
+					client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+					Expect(err).ToNot(HaveOccurred())
+
+					var result plugin_models.GetAppV3Model
+					err = client.Call("CliRpcCmd.GetAppV3", "my-app", &result)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(appRepo.ReadArgsForCall(0)).To(Equal("my-app"))
+					Expect(appRepo.GetV3AppArgsForCall(0)).To(Equal("app-guid"))
+					Expect(appSummaryRepo.GetSummaryArgsForCall(0)).To(Equal("app-guid"))
+					Expect(result).To(Equal(plugin_models.GetAppV3Model{
+						Guid:  "app-guid",
+						Name:  "my-app",
+						State: "STARTED",
+						Lifecycle: plugin_models.GetAppV3_Lifecycle{
+							Type:       "buildpack",
+							Buildpacks: []string{"ruby_buildpack"},
+							Stack:      "cflinuxfs2",
+						},
+						Processes: []plugin_models.GetAppV3_Process{
+							{Guid: "web-guid", Type: "web", Instances: 2, Memory: 512, DiskQuota: 1024, HealthCheckType: "http", HealthCheckHttpEndpoint: "/health"},
+						},
+						Sidecars: []plugin_models.GetAppV3_Sidecar{
+							{Guid: "sidecar-guid", Name: "auth", Command: "./auth", ProcessTypes: []string{"web"}, Memory: 64},
+						},
+						Routes: []plugin_models.GetApp_RouteSummary{
+							{Guid: "route-guid", Host: "my-app", Domain: plugin_models.GetApp_DomainFields{Guid: "domain-guid", Name: "example.com"}, Path: "/path"},
+						},
+						CurrentDropletGuid: "droplet-guid",
+					}))
+				})
+
+				It("returns the error when the app cannot be read", func() {
+					appRepo.ReadReturns(models.Application{}, errors.New("read error"))
+
+					client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+					Expect(err).ToNot(HaveOccurred())
+
+					var result plugin_models.GetAppV3Model
+					err = client.Call("CliRpcCmd.GetAppV3", "my-app", &result)
+					Expect(err).To(MatchError("read error"))
+					Expect(appRepo.GetV3AppCallCount()).To(Equal(0))
+				})
+
+				It("returns an error when no space is targeted", func() {
+					config.SetSpaceFields(models.SpaceFields{})
+
+					client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+					Expect(err).ToNot(HaveOccurred())
+
+					var result plugin_models.GetAppV3Model
+					err = client.Call("CliRpcCmd.GetAppV3", "my-app", &result)
+					Expect(err).To(MatchError("No space targeted, use 'cf target -s' to target a space."))
+					Expect(appRepo.ReadCallCount()).To(Equal(0))
+				})
+			})
+
 		})
 
 		Context("fail", func() {
@@ -5,6 +5,7 @@ import (
 	"os/exec"
 
 	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 func RunMethodIfExists(rpcService *CliRpcService, args []string, pluginList map[string]pluginconfig.PluginMetadata) bool {
@@ -19,6 +20,7 @@ func RunMethodIfExists(rpcService *CliRpcService, args []string, pluginList map[
 				pluginArgs := append([]string{rpcService.Port()}, args...)
 
 				cmd := exec.Command(metadata.Location, pluginArgs...)
+				cmd.Env = configv3.SubprocessEnvironment()
 				cmd.Stdout = os.Stdout
 				cmd.Stdin = os.Stdin
 				cmd.Stderr = os.Stderr
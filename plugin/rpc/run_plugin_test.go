@@ -0,0 +1,97 @@
+package rpc_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/rpc"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig"
+	"code.cloudfoundry.org/cli/plugin"
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RunMethodIfExists", func() {
+	var (
+		rpcService *CliRpcService
+		pluginList map[string]pluginconfig.PluginMetadata
+		tmpDir     string
+		workDir    string
+		outputFile string
+
+		oldDir        string
+		oldCFHome     string
+		oldPluginHome string
+	)
+
+	BeforeEach(func() {
+		rpc.DefaultServer = rpc.NewServer()
+
+		var err error
+		rpcService, err = NewRpcService(nil, nil, nil, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+		Expect(err).ToNot(HaveOccurred())
+
+		location, err := filepath.Abs(filepath.Join("..", "..", "fixtures", "plugins", "echo_env.exe"))
+		Expect(err).ToNot(HaveOccurred())
+		pluginList = map[string]pluginconfig.PluginMetadata{
+			"EchoEnv": {
+				Location: location,
+				Commands: []plugin.Command{{Name: "echo-env"}},
+			},
+		}
+
+		tmpDir, err = ioutil.TempDir("", "run-plugin")
+		Expect(err).ToNot(HaveOccurred())
+		outputFile = filepath.Join(tmpDir, "env")
+
+		oldDir, err = os.Getwd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.Chdir(tmpDir)).To(Succeed())
+		workDir, err = os.Getwd()
+		Expect(err).ToNot(HaveOccurred())
+
+		oldCFHome = os.Getenv("CF_HOME")
+		oldPluginHome = os.Getenv("CF_PLUGIN_HOME")
+	})
+
+	AfterEach(func() {
+		os.Setenv("CF_HOME", oldCFHome)
+		os.Setenv("CF_PLUGIN_HOME", oldPluginHome)
+		Expect(os.Chdir(oldDir)).To(Succeed())
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	It("starts the plugin with the CF_HOME and CF_PLUGIN_HOME overrides resolved to absolute paths", func() {
+		Expect(os.Setenv("CF_HOME", "home")).To(Succeed())
+		Expect(os.Setenv("CF_PLUGIN_HOME", filepath.Join("homes", "plugins"))).To(Succeed())
+
+		Expect(RunMethodIfExists(rpcService, []string{"echo-env", outputFile}, pluginList)).To(BeTrue())
+
+		env, err := ioutil.ReadFile(outputFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(env)).To(Equal(fmt.Sprintf("CF_HOME=%s\nCF_PLUGIN_HOME=%s\n",
+			filepath.Join(workDir, "home"),
+			filepath.Join(workDir, "homes", "plugins"),
+		)))
+	})
+
+	It("does not set overrides the CLI was not started with", func() {
+		Expect(os.Unsetenv("CF_HOME")).To(Succeed())
+		Expect(os.Unsetenv("CF_PLUGIN_HOME")).To(Succeed())
+
+		Expect(RunMethodIfExists(rpcService, []string{"echo-env", outputFile}, pluginList)).To(BeTrue())
+
+		env, err := ioutil.ReadFile(outputFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(env)).To(Equal("CF_HOME=\nCF_PLUGIN_HOME=\n"))
+	})
+
+	It("returns false when no plugin provides the command", func() {
+		Expect(RunMethodIfExists(rpcService, []string{"not-a-command"}, pluginList)).To(BeFalse())
+	})
+})
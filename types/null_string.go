@@ -0,0 +1,41 @@
+package types
+
+import "encoding/json"
+
+// NullString is a wrapper around string values that can be null or a string.
+// Use IsSet to check if the value is provided, instead of checking against "".
+type NullString struct {
+	IsSet bool
+	Value string
+}
+
+// NewNullString returns a set NullString with the given value.
+func NewNullString(value string) NullString {
+	return NullString{IsSet: true, Value: value}
+}
+
+func (n *NullString) UnmarshalJSON(rawJSON []byte) error {
+	var value *string
+	err := json.Unmarshal(rawJSON, &value)
+	if err != nil {
+		return err
+	}
+
+	if value == nil {
+		n.Value = ""
+		n.IsSet = false
+		return nil
+	}
+
+	n.Value = *value
+	n.IsSet = true
+
+	return nil
+}
+
+func (n NullString) MarshalJSON() ([]byte, error) {
+	if n.IsSet {
+		return json.Marshal(n.Value)
+	}
+	return []byte("null"), nil
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"encoding/json"
+
+	. "code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NullString", func() {
+	var nullString NullString
+
+	BeforeEach(func() {
+		nullString = NullString{}
+	})
+
+	Describe("UnmarshalJSON", func() {
+		Context("when null is provided", func() {
+			It("sets IsSet to false", func() {
+				err := json.Unmarshal([]byte("null"), &nullString)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullString).To(Equal(NullString{Value: "", IsSet: false}))
+			})
+		})
+
+		Context("when the empty string is provided", func() {
+			It("sets IsSet to true", func() {
+				err := json.Unmarshal([]byte(`""`), &nullString)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullString).To(Equal(NullString{Value: "", IsSet: true}))
+			})
+		})
+
+		Context("when a string is provided", func() {
+			It("stores the string and sets IsSet to true", func() {
+				err := json.Unmarshal([]byte(`"some-value"`), &nullString)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullString).To(Equal(NullString{Value: "some-value", IsSet: true}))
+			})
+		})
+
+		Context("when a non-string is provided", func() {
+			It("returns an error", func() {
+				err := json.Unmarshal([]byte("5"), &nullString)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("MarshalJSON", func() {
+		Context("when the string is set", func() {
+			It("returns the quoted string", func() {
+				data, err := json.Marshal(NewNullString(`some "value"`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(data)).To(Equal(`"some \"value\""`))
+			})
+		})
+
+		Context("when the string is not set", func() {
+			It("returns null", func() {
+				data, err := json.Marshal(nullString)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(data)).To(Equal("null"))
+			})
+		})
+	})
+})
@@ -0,0 +1,60 @@
+// Package cacert builds the certificate pools used to verify API endpoints
+// that are signed by a private certificate authority. The custom CA is
+// trusted in addition to the system's roots, never instead of them.
+package cacert
+
+import (
+	"crypto/x509"
+	"io/ioutil"
+)
+
+// InvalidCACertError is returned when a CA certificate contains no PEM
+// encoded certificates.
+type InvalidCACertError struct {
+	Path string
+}
+
+func (e InvalidCACertError) Error() string {
+	return "no PEM encoded certificates found in " + e.Path
+}
+
+// Load returns the PEM encoded certificates in the file at path.
+func Load(path string) ([]byte, error) {
+	caCert, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !x509.NewCertPool().AppendCertsFromPEM(caCert) {
+		return nil, InvalidCACertError{Path: path}
+	}
+
+	return caCert, nil
+}
+
+// NewCertPool returns the system certificate pool with the given PEM encoded
+// certificates added. When caCert is empty it returns nil, which tells
+// crypto/tls to use the system pool.
+func NewCertPool(caCert []byte) *x509.CertPool {
+	if len(caCert) == 0 {
+		return nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	pool.AppendCertsFromPEM(caCert)
+
+	return pool
+}
+
+// AppendToPool adds the given PEM encoded certificates to pool. A nil pool is
+// treated as the system pool.
+func AppendToPool(pool *x509.CertPool, caCert []byte) *x509.CertPool {
+	if pool == nil {
+		return NewCertPool(caCert)
+	}
+	pool.AppendCertsFromPEM(caCert)
+	return pool
+}
@@ -0,0 +1,13 @@
+package cacert_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestCACert(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CA Cert Suite")
+}
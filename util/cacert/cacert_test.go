@@ -0,0 +1,116 @@
+package cacert_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/cacert"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CA Cert", func() {
+	var (
+		server *httptest.Server
+		caCert []byte
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		caCert = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("Load", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "cacert")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		Context("when the file contains a PEM encoded certificate", func() {
+			It("returns the contents of the file", func() {
+				path := filepath.Join(tmpDir, "ca.pem")
+				Expect(ioutil.WriteFile(path, caCert, 0600)).To(Succeed())
+
+				loaded, err := Load(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(loaded).To(Equal(caCert))
+			})
+		})
+
+		Context("when the file does not contain a PEM encoded certificate", func() {
+			It("returns an InvalidCACertError", func() {
+				path := filepath.Join(tmpDir, "ca.pem")
+				Expect(ioutil.WriteFile(path, []byte("not a cert"), 0600)).To(Succeed())
+
+				_, err := Load(path)
+				Expect(err).To(MatchError(InvalidCACertError{Path: path}))
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			It("returns the error", func() {
+				_, err := Load(filepath.Join(tmpDir, "missing.pem"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("NewCertPool", func() {
+		get := func(trustCA bool) error {
+			tlsConfig := &tls.Config{}
+			if trustCA {
+				tlsConfig.RootCAs = NewCertPool(caCert)
+			}
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+			_, err := client.Get(server.URL)
+			return err
+		}
+
+		It("trusts servers signed by the given CA", func() {
+			Expect(get(true)).To(Succeed())
+		})
+
+		It("does not trust the server without the CA", func() {
+			Expect(get(false)).To(HaveOccurred())
+		})
+
+		Context("when no CA is given", func() {
+			It("returns nil so the system pool is used", func() {
+				Expect(NewCertPool(nil)).To(BeNil())
+			})
+		})
+	})
+
+	Describe("AppendToPool", func() {
+		It("adds the CA to an existing pool", func() {
+			pool := AppendToPool(x509.NewCertPool(), caCert)
+			Expect(pool.Subjects()).To(HaveLen(1))
+		})
+
+		Context("when the pool is nil", func() {
+			It("starts from the system pool", func() {
+				Expect(AppendToPool(nil, caCert)).ToNot(BeNil())
+			})
+		})
+	})
+})
@@ -51,4 +51,22 @@ var _ = Describe("Config", func() {
 
 		Entry("config=unset env=unset falls back to default", "", "", ColorEnabled),
 	)
+
+	Context("when the --no-color flag is passed", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{"ColorEnabled":"true"}`)
+			os.Setenv("CF_COLOR", "true")
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("CF_COLOR")
+		})
+
+		It("disables colors regardless of $CF_COLOR and the config", func() {
+			config, err := LoadConfig(FlagOverride{NoColor: true})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.ColorEnabled()).To(Equal(ColorDisabled))
+		})
+	})
 })
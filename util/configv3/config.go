@@ -13,9 +13,11 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/cloudfoundry/bytefmt"
 	"golang.org/x/crypto/ssh/terminal"
 
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/cacert"
+	"code.cloudfoundry.org/cli/util/lockedfile"
 	"code.cloudfoundry.org/cli/version"
 )
 
@@ -30,6 +32,10 @@ const (
 	// Developer Note: Due to bugs in using MaxInt64 during comparison, the above
 	// was chosen as a replacement.
 
+	// DefaultRetryCount is the default number of times an idempotent API
+	// request is retried after a transient failure.
+	DefaultRetryCount = 3
+
 	// DefaultPollingInterval is the time between consecutive polls of a status.
 	DefaultPollingInterval = 3 * time.Second
 
@@ -40,6 +46,10 @@ const (
 	DefaultStartupTimeout = 5 * time.Minute
 	// DefaultPingerThrottle = 5 * time.Second
 
+	// DefaultTraceMaxFiles is the default number of trace files kept, including
+	// the one being written to, when trace file rotation is enabled.
+	DefaultTraceMaxFiles = 5
+
 	// DefaultTarget is the default CFConfig value for Target.
 	DefaultTarget = ""
 
@@ -91,8 +101,6 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		},
 	}
 
-	var jsonError error
-
 	if _, err = os.Stat(configFilePath); err == nil || !os.IsNotExist(err) {
 		var file []byte
 		file, err = ioutil.ReadFile(configFilePath)
@@ -100,9 +108,9 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 			return nil, err
 		}
 
-		if len(file) == 0 {
-			jsonError = translatableerror.EmptyConfigError{FilePath: configFilePath}
-		} else {
+		// An empty config file is treated as missing. Older versions of the CLI
+		// could leave one behind when they were interrupted while writing it.
+		if len(file) > 0 {
 			var configFile CFConfig
 			err = json.Unmarshal(file, &configFile)
 			if err != nil {
@@ -112,6 +120,9 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		}
 	}
 
+	config.loadedAccessToken = config.ConfigFile.AccessToken
+	config.loadedRefreshToken = config.ConfigFile.RefreshToken
+
 	if config.ConfigFile.SSHOAuthClient == "" {
 		config.ConfigFile.SSHOAuthClient = DefaultSSHOAuthClient
 	}
@@ -122,20 +133,27 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		DockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
+		BinaryName:                 filepath.Base(os.Args[0]),
+		CFCACert:                   os.Getenv("CF_CA_CERT"),
+		CFColor:                    os.Getenv("CF_COLOR"),
+		CFDialTimeout:              os.Getenv("CF_DIAL_TIMEOUT"),
+		CFHome:                     os.Getenv("CF_HOME"),
+		CFLogLevel:                 os.Getenv("CF_LOG_LEVEL"),
+		CFPluginHome:               os.Getenv("CF_PLUGIN_HOME"),
+		CFRetryCount:               os.Getenv("CF_RETRY_COUNT"),
+		CFStackDeprecationWarnings: os.Getenv("CF_STACK_DEPRECATION_WARNINGS"),
+		CFStagingTimeout:           os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:           os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTrace:                    os.Getenv("CF_TRACE"),
+		CFTraceMaxFiles:            os.Getenv("CF_TRACE_MAX_FILES"),
+		CFTraceMaxSize:             os.Getenv("CF_TRACE_MAX_SIZE"),
+		CFTraceShowSecrets:         os.Getenv("CF_TRACE_SHOW_SECRETS"),
+		DockerPassword:             os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:               os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ForceTTY:                   os.Getenv("FORCE_TTY"),
+		HTTPSProxy:                 os.Getenv("https_proxy"),
+		Lang:                       os.Getenv("LANG"),
+		LCAll:                      os.Getenv("LC_ALL"),
 	}
 
 	pluginFilePath := filepath.Join(config.PluginHome(), "config.json")
@@ -188,18 +206,34 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		tty:              isTTY,
 	}
 
-	return &config, jsonError
+	return &config, nil
 }
 
+// removeOldTempConfigFiles removes the temp config files left behind by
+// writes that never finished. A concurrent WriteConfig holds the config lock
+// for as long as its temp file exists, so the lock is taken before looking
+// for them.
 func removeOldTempConfigFiles() error {
-	oldTempFileNames, err := filepath.Glob(filepath.Join(configDirectory(), "temp-config?*"))
+	tempFilePattern := filepath.Join(configDirectory(), "temp-config?*")
+	oldTempFileNames, err := filepath.Glob(tempFilePattern)
+	if err != nil || len(oldTempFileNames) == 0 {
+		return err
+	}
+
+	lock, err := lockedfile.Lock(ConfigFilePath())
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock()
+
+	oldTempFileNames, err = filepath.Glob(tempFilePattern)
 	if err != nil {
 		return err
 	}
 
 	for _, oldTempFileName := range oldTempFileNames {
 		err = os.Remove(oldTempFileName)
-		if err != nil {
+		if err != nil && !os.IsNotExist(err) {
 			return err
 		}
 	}
@@ -210,14 +244,29 @@ func removeOldTempConfigFiles() error {
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
+//
+// The config.json is replaced atomically while holding a lock shared with
+// other cf processes, so concurrent invocations never leave a partially
+// written file behind.
 func WriteConfig(c *Config) error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	dir := configDirectory()
+	err := os.MkdirAll(dir, 0700)
 	if err != nil {
 		return err
 	}
 
-	dir := configDirectory()
-	err = os.MkdirAll(dir, 0700)
+	lock, err := lockedfile.Lock(ConfigFilePath())
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock()
+
+	err = c.mergeSavedTokens()
+	if err != nil {
+		return err
+	}
+
+	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -243,7 +292,45 @@ func WriteConfig(c *Config) error {
 		return err
 	}
 
-	return os.Rename(tempConfigFileName, ConfigFilePath())
+	err = lockedfile.Rename(tempConfigFileName, ConfigFilePath())
+	if err != nil {
+		return err
+	}
+
+	c.loadedAccessToken = c.ConfigFile.AccessToken
+	c.loadedRefreshToken = c.ConfigFile.RefreshToken
+	return nil
+}
+
+// mergeSavedTokens takes the tokens that another cf process saved to the
+// config file since it was loaded, unless this process changed its tokens
+// itself. Otherwise a process that did not refresh its tokens would clobber
+// the refresh token just issued to a concurrent process. Tokens saved for a
+// different target are never taken.
+func (config *Config) mergeSavedTokens() error {
+	if config.ConfigFile.AccessToken != config.loadedAccessToken ||
+		config.ConfigFile.RefreshToken != config.loadedRefreshToken {
+		return nil
+	}
+
+	file, err := ioutil.ReadFile(ConfigFilePath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var savedConfig CFConfig
+	if len(file) == 0 || json.Unmarshal(file, &savedConfig) != nil {
+		return nil
+	}
+
+	if savedConfig.Target == config.ConfigFile.Target {
+		config.ConfigFile.AccessToken = savedConfig.AccessToken
+		config.ConfigFile.RefreshToken = savedConfig.RefreshToken
+	}
+	return nil
 }
 
 // catchSignal tries to catch SIGHUP, SIGINT, SIGKILL, SIGQUIT and SIGTERM, and
@@ -274,6 +361,11 @@ type Config struct {
 	detectedSettings detectedSettings
 
 	pluginsConfig PluginsConfig
+
+	// loadedAccessToken and loadedRefreshToken are the tokens in the config
+	// file when it was last loaded or written.
+	loadedAccessToken  string
+	loadedRefreshToken string
 }
 
 // CFConfig represents .cf/config.json
@@ -289,10 +381,14 @@ type CFConfig struct {
 	SSHOAuthClient           string             `json:"SSHOAuthClient"`
 	UAAOAuthClient           string             `json:"UAAOAuthClient"`
 	UAAOAuthClientSecret     string             `json:"UAAOAuthClientSecret"`
+	UAAGrantType             string             `json:"UAAGrantType"`
+	UAAIssuer                string             `json:"UAAIssuer"`
+	FoundationCheckDisabled  bool               `json:"FoundationCheckDisabled"`
 	RefreshToken             string             `json:"RefreshToken"`
 	TargetedOrganization     Organization       `json:"OrganizationFields"`
 	TargetedSpace            Space              `json:"SpaceFields"`
 	SkipSSLValidation        bool               `json:"SSLDisabled"`
+	CACert                   string             `json:"CACert,omitempty"`
 	AsyncTimeout             int                `json:"AsyncTimeout"`
 	Trace                    string             `json:"Trace"`
 	ColorEnabled             string             `json:"ColorEnabled"`
@@ -331,25 +427,32 @@ type Space struct {
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFDialTimeout    string
-	CFHome           string
-	CFLogLevel       string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	DockerPassword   string
-	Experimental     string
-	ForceTTY         string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
+	BinaryName                 string
+	CFCACert                   string
+	CFColor                    string
+	CFDialTimeout              string
+	CFHome                     string
+	CFLogLevel                 string
+	CFPluginHome               string
+	CFRetryCount               string
+	CFStackDeprecationWarnings string
+	CFStagingTimeout           string
+	CFStartupTimeout           string
+	CFTrace                    string
+	CFTraceMaxFiles            string
+	CFTraceMaxSize             string
+	CFTraceShowSecrets         string
+	DockerPassword             string
+	Experimental               string
+	ForceTTY                   string
+	HTTPSProxy                 string
+	Lang                       string
+	LCAll                      string
 }
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
+	NoColor bool
 	Verbose bool
 }
 
@@ -387,6 +490,36 @@ func (config *Config) SkipSSLValidation() bool {
 	return config.ConfigFile.SkipSSLValidation
 }
 
+// CACert returns the PEM encoded CA certificates trusted, in addition to the
+// system's, when connecting to the targeted API. The certificates are based
+// off of:
+//   1. The file at the $CF_CA_CERT environment variable if set and valid
+//   2. The certificates stored for the target by 'cf api --ca-cert'
+func (config *Config) CACert() []byte {
+	if caCert := config.CACertOverride(); caCert != nil {
+		return caCert
+	}
+
+	if config.ConfigFile.CACert == "" {
+		return nil
+	}
+	return []byte(config.ConfigFile.CACert)
+}
+
+// CACertOverride returns the PEM encoded CA certificates in the file at the
+// $CF_CA_CERT environment variable, or nil if it is unset or unreadable.
+func (config *Config) CACertOverride() []byte {
+	if config.ENV.CFCACert == "" {
+		return nil
+	}
+
+	caCert, err := cacert.Load(config.ENV.CFCACert)
+	if err != nil {
+		return nil
+	}
+	return caCert
+}
+
 // AccessToken returns the access token for making authenticated API calls
 func (config *Config) AccessToken() string {
 	return config.ConfigFile.AccessToken
@@ -413,6 +546,31 @@ func (config *Config) UAAOAuthClientSecret() string {
 	return config.ConfigFile.UAAOAuthClientSecret
 }
 
+// UAAGrantType returns the grant type used to obtain the current access
+// token. An empty grant type means the password grant.
+func (config *Config) UAAGrantType() string {
+	return config.ConfigFile.UAAGrantType
+}
+
+// UAAEndpoint returns the UAA endpoint that is obtained from hitting
+// <AuthorizationEndpoint>/login
+func (config *Config) UAAEndpoint() string {
+	return config.ConfigFile.UAAEndpoint
+}
+
+// UAAIssuer returns the issuer of the access token obtained at login. It
+// identifies the foundation the tokens belong to.
+func (config *Config) UAAIssuer() string {
+	return config.ConfigFile.UAAIssuer
+}
+
+// FoundationCheckDisabled returns true when commands should not check that
+// the targeted API endpoint still belongs to the foundation the tokens were
+// issued by.
+func (config *Config) FoundationCheckDisabled() bool {
+	return config.ConfigFile.FoundationCheckDisabled
+}
+
 // APIVersion returns the CC API Version
 func (config *Config) APIVersion() string {
 	return config.ConfigFile.APIVersion
@@ -495,6 +653,21 @@ func (config *Config) Experimental() bool {
 	return false
 }
 
+// ShowTraceSecrets returns whether or not request logging displays credentials
+// such as tokens and passwords. This is based off of:
+//   1. The $CF_TRACE_SHOW_SECRETS environment variable if set
+//   2. Defaults to false
+func (config *Config) ShowTraceSecrets() bool {
+	if config.ENV.CFTraceShowSecrets != "" {
+		envVal, err := strconv.ParseBool(config.ENV.CFTraceShowSecrets)
+		if err == nil {
+			return envVal
+		}
+	}
+
+	return false
+}
+
 // Verbose returns true if verbose should be displayed to terminal, in addition
 // a slice of full paths in which verbose text will appear. This is based off
 // of:
@@ -502,6 +675,9 @@ func (config *Config) Experimental() bool {
 //   - The $CF_TRACE enviroment variable if set (true/false/file path)
 //   - The '-v/--verbose' global flag
 //   - Defaults to false
+//
+// Both trace values can be a comma-separated list of targets, such as
+// "true,/tmp/cf-trace.log", to trace to the terminal and to files at once.
 func (config *Config) Verbose() (bool, []string) {
 	var (
 		verbose     bool
@@ -509,22 +685,14 @@ func (config *Config) Verbose() (bool, []string) {
 		filePath    []string
 	)
 	if config.ENV.CFTrace != "" {
-		envVal, err := strconv.ParseBool(config.ENV.CFTrace)
-		verbose = envVal
-		if err != nil {
-			filePath = []string{config.ENV.CFTrace}
-		} else {
-			envOverride = true
-		}
+		verbose, envOverride, filePath = parseTrace(config.ENV.CFTrace)
 	}
 	if config.ConfigFile.Trace != "" {
-		envVal, err := strconv.ParseBool(config.ConfigFile.Trace)
+		configVerbose, _, configFilePath := parseTrace(config.ConfigFile.Trace)
 		if !envOverride {
-			verbose = envVal || verbose
-		}
-		if err != nil {
-			filePath = append(filePath, config.ConfigFile.Trace)
+			verbose = configVerbose || verbose
 		}
+		filePath = append(filePath, configFilePath...)
 	}
 	verbose = config.Flags.Verbose || verbose
 
@@ -537,6 +705,61 @@ func (config *Config) Verbose() (bool, []string) {
 	return verbose, filePath
 }
 
+// parseTrace splits a comma-separated list of trace targets into whether
+// tracing to the terminal is enabled, whether any of the targets is a boolean
+// and the targets that are file paths.
+func parseTrace(value string) (bool, bool, []string) {
+	var (
+		verbose   bool
+		isBoolean bool
+		filePath  []string
+	)
+	for _, target := range strings.Split(value, ",") {
+		target = strings.TrimSpace(target)
+		if target == "" {
+			continue
+		}
+
+		targetVal, err := strconv.ParseBool(target)
+		if err != nil {
+			filePath = append(filePath, target)
+			continue
+		}
+		verbose = verbose || targetVal
+		isBoolean = true
+	}
+	return verbose, isBoolean, filePath
+}
+
+// TraceFileRotation returns the size in bytes a trace file may reach before
+// it is rotated, and the number of trace files to keep. This is based off of:
+//   1. The $CF_TRACE_MAX_SIZE environment variable, in megabytes unless a
+//      unit is given (e.g. 100, 100M, 1G)
+//   2. The $CF_TRACE_MAX_FILES environment variable if set, defaulting to 5
+// A size of 0, the default, means trace files are never rotated.
+func (config *Config) TraceFileRotation() (int64, int) {
+	if config.ENV.CFTraceMaxSize == "" {
+		return 0, 0
+	}
+
+	var maxSize uint64
+	if megabytes, err := strconv.ParseUint(config.ENV.CFTraceMaxSize, 10, 64); err == nil {
+		maxSize = megabytes * bytefmt.MEGABYTE
+	} else if bytes, err := bytefmt.ToBytes(config.ENV.CFTraceMaxSize); err == nil {
+		maxSize = bytes
+	}
+
+	maxFiles := DefaultTraceMaxFiles
+	if config.ENV.CFTraceMaxFiles != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFTraceMaxFiles)
+		if err == nil && envVal > 0 {
+			maxFiles = envVal
+		}
+	}
+
+	return int64(maxSize), maxFiles
+}
+
 // IsTTY returns true based off of:
 //   - The $FORCE_TTY is set to true/t/1
 //   - Detected from the STDOUT stream
@@ -600,6 +823,22 @@ func (config *Config) DialTimeout() time.Duration {
 	return DefaultDialTimeout
 }
 
+// RetryCount returns the number of times an idempotent API request is
+// retried after a transient failure. This is based off of:
+//   1. The $CF_RETRY_COUNT environment variable if set to a non-negative
+//      integer, where 0 disables retries
+//   2. Defaults to 3
+func (config *Config) RetryCount() int {
+	if config.ENV.CFRetryCount != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFRetryCount)
+		if err == nil && envVal >= 0 {
+			return envVal
+		}
+	}
+
+	return DefaultRetryCount
+}
+
 func (config *Config) BinaryVersion() string {
 	return version.VersionString()
 }
@@ -619,6 +858,19 @@ func (config *Config) DockerPassword() string {
 	return config.ENV.DockerPassword
 }
 
+// DeprecatedStacks returns the names of the stacks listed in the
+// $CF_STACK_DEPRECATION_WARNINGS environment variable, which is a comma
+// separated list.
+func (config *Config) DeprecatedStacks() []string {
+	var stacks []string
+	for _, stack := range strings.Split(config.ENV.CFStackDeprecationWarnings, ",") {
+		if stack = strings.TrimSpace(stack); stack != "" {
+			stacks = append(stacks, stack)
+		}
+	}
+	return stacks
+}
+
 // SetOrganizationInformation sets the currently targeted organization
 func (config *Config) SetOrganizationInformation(guid string, name string) {
 	config.ConfigFile.TargetedOrganization.GUID = guid
@@ -648,6 +900,11 @@ func (config *Config) SetTargetInformation(api string, apiVersion string, auth s
 	config.UnsetSpaceInformation()
 }
 
+// SetCACert sets the CA certificates trusted for the current target
+func (config *Config) SetCACert(caCert []byte) {
+	config.ConfigFile.CACert = string(caCert)
+}
+
 // SetTokenInformation sets the current token/user information
 func (config *Config) SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string) {
 	config.ConfigFile.AccessToken = accessToken
@@ -655,6 +912,24 @@ func (config *Config) SetTokenInformation(accessToken string, refreshToken strin
 	config.ConfigFile.SSHOAuthClient = sshOAuthClient
 }
 
+// SetUAAGrantType sets the grant type used to obtain the current access
+// token
+func (config *Config) SetUAAGrantType(grantType string) {
+	config.ConfigFile.UAAGrantType = grantType
+}
+
+// SetUAAIssuer sets the issuer of the access token obtained at login
+func (config *Config) SetUAAIssuer(issuer string) {
+	config.ConfigFile.UAAIssuer = issuer
+}
+
+// SetUAAClientCredentials sets the UAA client ID and secret used to
+// authenticate with UAA
+func (config *Config) SetUAAClientCredentials(client string, secret string) {
+	config.ConfigFile.UAAOAuthClient = client
+	config.ConfigFile.UAAOAuthClientSecret = secret
+}
+
 // SetAccessToken sets the current access token
 func (config *Config) SetAccessToken(accessToken string) {
 	config.ConfigFile.AccessToken = accessToken
@@ -0,0 +1,91 @@
+package configv3
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// ConfigBundleVersion is the version of the bundle format written by
+// ConfigBundle. When the format changes, bump it and teach
+// ParseConfigBundle to migrate bundles written by older versions.
+const ConfigBundleVersion = 1
+
+// ConfigBundle is a portable copy of the CLI settings, used to move a CLI
+// setup to another machine. It never contains tokens or plugin binaries.
+type ConfigBundle struct {
+	BundleVersion int             `json:"BundleVersion"`
+	Config        CFConfig        `json:"Config"`
+	Plugins       []BundledPlugin `json:"Plugins"`
+}
+
+// BundledPlugin records an installed plugin and the repository it can be
+// reinstalled from.
+type BundledPlugin struct {
+	Name           string        `json:"Name"`
+	Version        PluginVersion `json:"Version"`
+	RepositoryName string        `json:"RepositoryName,omitempty"`
+}
+
+// ConfigBundle returns the current settings and installed plugins with all
+// credentials removed.
+func (config *Config) ConfigBundle() ConfigBundle {
+	bundle := ConfigBundle{
+		BundleVersion: ConfigBundleVersion,
+		Config:        withoutCredentials(config.ConfigFile),
+		Plugins:       []BundledPlugin{},
+	}
+
+	for _, plugin := range config.Plugins() {
+		bundle.Plugins = append(bundle.Plugins, BundledPlugin{
+			Name:           plugin.Name,
+			Version:        plugin.Version,
+			RepositoryName: plugin.RepositoryName,
+		})
+	}
+
+	return bundle
+}
+
+// ApplyConfigBundle replaces the current settings with the ones in the
+// bundle. Existing tokens are discarded, so the user has to log in again.
+// Plugins are not installed by this method.
+func (config *Config) ApplyConfigBundle(bundle ConfigBundle) {
+	config.ConfigFile = withoutCredentials(bundle.Config)
+	config.ConfigFile.ConfigVersion = 3
+
+	if config.ConfigFile.SSHOAuthClient == "" {
+		config.ConfigFile.SSHOAuthClient = DefaultSSHOAuthClient
+	}
+
+	if config.ConfigFile.UAAOAuthClient == "" {
+		config.ConfigFile.UAAOAuthClient = DefaultUAAOAuthClient
+	}
+}
+
+// ParseConfigBundle reads a bundle written by ConfigBundle, migrating it from
+// older bundle versions when needed.
+func ParseConfigBundle(raw []byte) (ConfigBundle, error) {
+	var bundle ConfigBundle
+	err := json.Unmarshal(raw, &bundle)
+	if err != nil {
+		return ConfigBundle{}, err
+	}
+
+	switch bundle.BundleVersion {
+	case ConfigBundleVersion:
+		return bundle, nil
+	default:
+		return ConfigBundle{}, translatableerror.UnsupportedConfigBundleVersionError{
+			Version:          bundle.BundleVersion,
+			SupportedVersion: ConfigBundleVersion,
+		}
+	}
+}
+
+func withoutCredentials(configFile CFConfig) CFConfig {
+	configFile.AccessToken = ""
+	configFile.RefreshToken = ""
+	configFile.UAAOAuthClientSecret = ""
+	return configFile
+}
@@ -0,0 +1,141 @@
+package configv3_test
+
+import (
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConfigBundle", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Describe("ConfigBundle", func() {
+		var config *Config
+
+		BeforeEach(func() {
+			setConfig(homeDir, `{
+  "ConfigVersion": 3,
+  "Target": "https://api.example.com",
+  "AccessToken": "bearer some-access-token",
+  "RefreshToken": "some-refresh-token",
+  "UAAOAuthClient": "some-client",
+  "UAAOAuthClientSecret": "some-secret",
+  "Locale": "fr-FR",
+  "ColorEnabled": "false",
+  "PluginRepos": [{"Name": "some-repo", "URL": "https://repo.example.com"}]
+}`)
+			setPluginConfig(filepath.Join(homeDir, ".cf", "plugins"), `{
+  "Plugins": {
+    "from-repo": {
+      "Location": "/some/path/from-repo",
+      "Version": {"Major": 1, "Minor": 2, "Build": 3},
+      "RepositoryName": "some-repo"
+    },
+    "from-file": {
+      "Location": "/some/path/from-file",
+      "Version": {"Major": 0, "Minor": 0, "Build": 1}
+    }
+  }
+}`)
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("contains the settings without credentials", func() {
+			bundle := config.ConfigBundle()
+
+			Expect(bundle.BundleVersion).To(Equal(ConfigBundleVersion))
+			Expect(bundle.Config.Target).To(Equal("https://api.example.com"))
+			Expect(bundle.Config.Locale).To(Equal("fr-FR"))
+			Expect(bundle.Config.ColorEnabled).To(Equal("false"))
+			Expect(bundle.Config.UAAOAuthClient).To(Equal("some-client"))
+			Expect(bundle.Config.PluginRepositories).To(ConsistOf(PluginRepository{Name: "some-repo", URL: "https://repo.example.com"}))
+
+			Expect(bundle.Config.AccessToken).To(BeEmpty())
+			Expect(bundle.Config.RefreshToken).To(BeEmpty())
+			Expect(bundle.Config.UAAOAuthClientSecret).To(BeEmpty())
+		})
+
+		It("does not change the current config", func() {
+			config.ConfigBundle()
+			Expect(config.AccessToken()).To(Equal("bearer some-access-token"))
+			Expect(config.RefreshToken()).To(Equal("some-refresh-token"))
+		})
+
+		It("lists the installed plugins with their repositories", func() {
+			Expect(config.ConfigBundle().Plugins).To(Equal([]BundledPlugin{
+				{Name: "from-file", Version: PluginVersion{Build: 1}},
+				{Name: "from-repo", Version: PluginVersion{Major: 1, Minor: 2, Build: 3}, RepositoryName: "some-repo"},
+			}))
+		})
+	})
+
+	Describe("ApplyConfigBundle", func() {
+		It("replaces the settings and discards the current tokens", func() {
+			config := Config{
+				ConfigFile: CFConfig{
+					Target:       "https://api.old.example.com",
+					AccessToken:  "bearer old-access-token",
+					RefreshToken: "old-refresh-token",
+				},
+			}
+
+			config.ApplyConfigBundle(ConfigBundle{
+				BundleVersion: ConfigBundleVersion,
+				Config: CFConfig{
+					Target:      "https://api.example.com",
+					Locale:      "fr-FR",
+					AccessToken: "bearer smuggled-token",
+				},
+			})
+
+			Expect(config.Target()).To(Equal("https://api.example.com"))
+			Expect(config.Locale()).To(Equal("fr-FR"))
+			Expect(config.AccessToken()).To(BeEmpty())
+			Expect(config.RefreshToken()).To(BeEmpty())
+			Expect(config.ConfigFile.ConfigVersion).To(Equal(3))
+			Expect(config.SSHOAuthClient()).To(Equal(DefaultSSHOAuthClient))
+			Expect(config.UAAOAuthClient()).To(Equal(DefaultUAAOAuthClient))
+		})
+	})
+
+	Describe("ParseConfigBundle", func() {
+		It("reads a bundle of the current version", func() {
+			bundle, err := ParseConfigBundle([]byte(`{
+  "BundleVersion": 1,
+  "Config": {"Target": "https://api.example.com"},
+  "Plugins": [{"Name": "some-plugin", "RepositoryName": "some-repo"}]
+}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bundle.Config.Target).To(Equal("https://api.example.com"))
+			Expect(bundle.Plugins).To(ConsistOf(BundledPlugin{Name: "some-plugin", RepositoryName: "some-repo"}))
+		})
+
+		It("returns an error for unknown bundle versions", func() {
+			_, err := ParseConfigBundle([]byte(`{"BundleVersion": 99}`))
+			Expect(err).To(MatchError(translatableerror.UnsupportedConfigBundleVersionError{
+				Version:          99,
+				SupportedVersion: ConfigBundleVersion,
+			}))
+		})
+
+		It("returns an error for invalid JSON", func() {
+			_, err := ParseConfigBundle([]byte(`not json`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
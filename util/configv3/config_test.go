@@ -2,13 +2,15 @@ package configv3_test
 
 import (
 	"encoding/json"
+	"encoding/pem"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"time"
 
-	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/util/configv3"
+	testnet "code.cloudfoundry.org/cli/util/testhelpers/net"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -111,7 +113,7 @@ var _ = Describe("Config", func() {
 					setConfig(homeDir, "")
 				})
 
-				It("returns the default config with a json error", func() {
+				It("treats it as missing and returns the default config", func() {
 					defer os.Setenv("LANG", oldLang)
 					defer os.Setenv("LC_ALL", oldLCAll)
 
@@ -122,7 +124,7 @@ var _ = Describe("Config", func() {
 					Expect(os.Unsetenv("CF_CLI_EXPERIMENTAL")).ToNot(HaveOccurred())
 
 					config, err = LoadConfig()
-					Expect(err).To(Equal(translatableerror.EmptyConfigError{FilePath: filepath.Join(homeDir, ".cf", "config.json")}))
+					Expect(err).ToNot(HaveOccurred())
 
 					// then we reset the env variable
 					err = os.Setenv("CF_CLI_EXPERIMENTAL", envVal)
@@ -364,6 +366,63 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("CACert", func() {
+			var (
+				originalCACert string
+				caCert         []byte
+
+				config *Config
+			)
+
+			BeforeEach(func() {
+				originalCACert = os.Getenv("CF_CA_CERT")
+				Expect(os.Unsetenv("CF_CA_CERT")).To(Succeed())
+
+				caCert = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: testnet.MakeSelfSignedTLSCert().Certificate[0]})
+				rawConfig := fmt.Sprintf(`{ "CACert": %q }`, "stored-ca-cert")
+				setConfig(homeDir, rawConfig)
+			})
+
+			JustBeforeEach(func() {
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+			})
+
+			AfterEach(func() {
+				Expect(os.Setenv("CF_CA_CERT", originalCACert)).To(Succeed())
+			})
+
+			It("returns the CA cert stored in the config", func() {
+				Expect(config.CACert()).To(Equal([]byte("stored-ca-cert")))
+				Expect(config.CACertOverride()).To(BeNil())
+			})
+
+			Context("when $CF_CA_CERT points to a valid CA cert", func() {
+				BeforeEach(func() {
+					path := filepath.Join(homeDir, "ca.pem")
+					Expect(ioutil.WriteFile(path, caCert, 0600)).To(Succeed())
+					Expect(os.Setenv("CF_CA_CERT", path)).To(Succeed())
+				})
+
+				It("overrides the stored CA cert", func() {
+					Expect(config.CACert()).To(Equal(caCert))
+					Expect(config.CACertOverride()).To(Equal(caCert))
+				})
+			})
+
+			Context("when $CF_CA_CERT does not point to a valid CA cert", func() {
+				BeforeEach(func() {
+					Expect(os.Setenv("CF_CA_CERT", filepath.Join(homeDir, "does-not-exist.pem"))).To(Succeed())
+				})
+
+				It("falls back to the stored CA cert", func() {
+					Expect(config.CACert()).To(Equal([]byte("stored-ca-cert")))
+				})
+			})
+		})
+
 		Describe("AccessToken", func() {
 			var config *Config
 
@@ -481,6 +540,54 @@ var _ = Describe("Config", func() {
 			Entry("uses default value of false if an invalid environment value is set", "something-invalid", false),
 		)
 
+		DescribeTable("ShowTraceSecrets",
+			func(envVal string, expected bool) {
+				setConfig(homeDir, `{}`)
+
+				defer os.Unsetenv("CF_TRACE_SHOW_SECRETS")
+				if envVal == "" {
+					Expect(os.Unsetenv("CF_TRACE_SHOW_SECRETS")).ToNot(HaveOccurred())
+				} else {
+					Expect(os.Setenv("CF_TRACE_SHOW_SECRETS", envVal)).ToNot(HaveOccurred())
+				}
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+
+				Expect(config.ShowTraceSecrets()).To(Equal(expected))
+			},
+
+			Entry("uses default value of false if environment value is not set", "", false),
+			Entry("uses environment value if a valid environment value is set", "true", true),
+			Entry("uses default value of false if an invalid environment value is set", "something-invalid", false),
+		)
+
+		DescribeTable("TraceFileRotation",
+			func(maxSize string, maxFiles string, expectedSize int64, expectedFiles int) {
+				setConfig(homeDir, `{}`)
+
+				defer os.Unsetenv("CF_TRACE_MAX_SIZE")
+				defer os.Unsetenv("CF_TRACE_MAX_FILES")
+				Expect(os.Setenv("CF_TRACE_MAX_SIZE", maxSize)).ToNot(HaveOccurred())
+				Expect(os.Setenv("CF_TRACE_MAX_FILES", maxFiles)).ToNot(HaveOccurred())
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+
+				size, files := config.TraceFileRotation()
+				Expect(size).To(Equal(expectedSize))
+				Expect(files).To(Equal(expectedFiles))
+			},
+
+			Entry("disables rotation if CF_TRACE_MAX_SIZE is not set", "", "3", int64(0), 0),
+			Entry("reads the size in megabytes", "10", "", int64(10*1024*1024), DefaultTraceMaxFiles),
+			Entry("reads the size with a unit", "1G", "", int64(1024*1024*1024), DefaultTraceMaxFiles),
+			Entry("uses the number of files if CF_TRACE_MAX_FILES is set", "10", "3", int64(10*1024*1024), 3),
+			Entry("uses the default number of files if CF_TRACE_MAX_FILES is invalid", "10", "-1", int64(10*1024*1024), DefaultTraceMaxFiles),
+		)
+
 		Describe("BinaryName", func() {
 			It("returns the name used to invoke", func() {
 				config, err := LoadConfig()
@@ -621,6 +728,31 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		DescribeTable("RetryCount",
+			func(envVal string, expectedCount int) {
+				config := Config{ENV: EnvOverride{CFRetryCount: envVal}}
+				Expect(config.RetryCount()).To(Equal(expectedCount))
+			},
+
+			Entry("defaults to 3", "", DefaultRetryCount),
+			Entry("uses the environment variable", "5", 5),
+			Entry("disables retries with 0", "0", 0),
+			Entry("ignores negative values", "-1", DefaultRetryCount),
+			Entry("ignores invalid values", "banana", DefaultRetryCount),
+		)
+
+		Describe("DeprecatedStacks", func() {
+			It("returns the trimmed, non-empty stack names", func() {
+				config := Config{ENV: EnvOverride{CFStackDeprecationWarnings: "cflinuxfs2, windows2012R2,,"}}
+				Expect(config.DeprecatedStacks()).To(Equal([]string{"cflinuxfs2", "windows2012R2"}))
+			})
+
+			It("returns nothing when the environment variable is not set", func() {
+				config := Config{}
+				Expect(config.DeprecatedStacks()).To(BeEmpty())
+			})
+		})
+
 		Describe("BinaryVersion", func() {
 			It("returns back version.BinaryVersion", func() {
 				conf := Config{}
@@ -677,6 +809,66 @@ var _ = Describe("Config", func() {
 				Expect(writtenCFConfig.Target).To(Equal(config.ConfigFile.Target))
 				Expect(writtenCFConfig.ColorEnabled).To(Equal(config.ConfigFile.ColorEnabled))
 			})
+
+			It("does not leave temp files or a partially written config behind", func() {
+				Expect(WriteConfig(config)).To(Succeed())
+
+				tempFileNames, err := filepath.Glob(filepath.Join(homeDir, ".cf", "temp-config?*"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tempFileNames).To(BeEmpty())
+			})
+		})
+
+		Context("when another process saved new tokens since the config was loaded", func() {
+			var writtenCFConfig func() CFConfig
+
+			BeforeEach(func() {
+				setConfig(homeDir, `{"ConfigVersion": 3, "Target": "foo.com", "AccessToken": "old-access-token", "RefreshToken": "old-refresh-token"}`)
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				setConfig(homeDir, `{"ConfigVersion": 3, "Target": "foo.com", "AccessToken": "new-access-token", "RefreshToken": "new-refresh-token"}`)
+
+				writtenCFConfig = func() CFConfig {
+					file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+					Expect(err).ToNot(HaveOccurred())
+
+					var cfConfig CFConfig
+					Expect(json.Unmarshal(file, &cfConfig)).To(Succeed())
+					return cfConfig
+				}
+			})
+
+			It("keeps the saved tokens when this process did not change its tokens", func() {
+				config.SetOrganizationInformation("some-org-guid", "some-org")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				written := writtenCFConfig()
+				Expect(written.AccessToken).To(Equal("new-access-token"))
+				Expect(written.RefreshToken).To(Equal("new-refresh-token"))
+				Expect(written.TargetedOrganization.Name).To(Equal("some-org"))
+			})
+
+			It("writes its own tokens when this process changed them", func() {
+				config.SetTokenInformation("my-access-token", "my-refresh-token", "ssh-oauth-client")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				written := writtenCFConfig()
+				Expect(written.AccessToken).To(Equal("my-access-token"))
+				Expect(written.RefreshToken).To(Equal("my-refresh-token"))
+			})
+
+			It("does not take tokens saved for another target", func() {
+				setConfig(homeDir, `{"ConfigVersion": 3, "Target": "bar.com", "AccessToken": "bar-access-token", "RefreshToken": "bar-refresh-token"}`)
+				Expect(WriteConfig(config)).To(Succeed())
+
+				written := writtenCFConfig()
+				Expect(written.Target).To(Equal("foo.com"))
+				Expect(written.AccessToken).To(Equal("old-access-token"))
+				Expect(written.RefreshToken).To(Equal("old-refresh-token"))
+			})
 		})
 	})
 
@@ -733,6 +925,14 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("SetCACert", func() {
+			It("sets the CA cert", func() {
+				var config Config
+				config.SetCACert([]byte("I am the CA cert"))
+				Expect(config.ConfigFile.CACert).To(Equal("I am the CA cert"))
+			})
+		})
+
 		Describe("SetAccessToken", func() {
 			It("sets the authentication token information", func() {
 				var config Config
@@ -749,6 +949,23 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("SetUAAGrantType", func() {
+			It("sets the UAA grant type", func() {
+				var config Config
+				config.SetUAAGrantType("client_credentials")
+				Expect(config.ConfigFile.UAAGrantType).To(Equal("client_credentials"))
+			})
+		})
+
+		Describe("SetUAAClientCredentials", func() {
+			It("sets the UAA client ID and secret", func() {
+				var config Config
+				config.SetUAAClientCredentials("some-client", "some-secret")
+				Expect(config.ConfigFile.UAAOAuthClient).To(Equal("some-client"))
+				Expect(config.ConfigFile.UAAOAuthClientSecret).To(Equal("some-secret"))
+			})
+		})
+
 		Describe("SetOrganizationInformation", func() {
 			It("sets the organization GUID and name", func() {
 				config := Config{}
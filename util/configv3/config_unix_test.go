@@ -69,6 +69,10 @@ var _ = Describe("Verbose", func() {
 		Entry("CF_TRACE filepath, config trace true: enables verbose AND logging to file", "/foo/bar", "true", false, true, []string{"/foo/bar"}),
 		Entry("CF_TRACE filepath, config trace filepath: enables logging to file for BOTH paths", "/foo/bar", "/baz", false, false, []string{"/foo/bar", "/baz"}),
 		Entry("CF_TRACE filepath, config trace filepath, '-v': enables verbose AND logging to file for BOTH paths", "/foo/bar", "/baz", true, true, []string{"/foo/bar", "/baz"}),
+
+		Entry("CF_TRACE true and filepath: enables verbose AND logging to file", "true,/foo/bar", "", false, true, []string{"/foo/bar"}),
+		Entry("CF_TRACE false and filepaths, config trace true: enables logging to file for BOTH paths", "false, /foo/bar, /baz", "true", false, false, []string{"/foo/bar", "/baz"}),
+		Entry("CF_TRACE filepath, config trace true and filepath: enables verbose AND logging to file for BOTH paths", "/foo/bar", "true,/baz", false, true, []string{"/foo/bar", "/baz"}),
 	)
 
 	Context("relative paths (cannot be tested in DescribeTable)", func() {
@@ -93,3 +97,31 @@ var _ = Describe("Verbose", func() {
 		})
 	})
 })
+
+var _ = Describe("SubprocessEnvironment with UNIX paths", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+		os.Unsetenv("CF_PLUGIN_HOME")
+	})
+
+	It("passes absolute overrides on unchanged", func() {
+		Expect(os.Setenv("CF_PLUGIN_HOME", "/some/plugin/home")).To(Succeed())
+
+		env := SubprocessEnvironment()
+		Expect(env).To(ContainElement("CF_HOME=" + homeDir))
+		Expect(env).To(ContainElement("CF_PLUGIN_HOME=/some/plugin/home"))
+	})
+
+	It("treats differently cased variables as different variables", func() {
+		Expect(os.Setenv("cf_home", "other-home")).To(Succeed())
+		defer os.Unsetenv("cf_home")
+
+		Expect(SubprocessEnvironment()).To(ContainElement("cf_home=other-home"))
+	})
+})
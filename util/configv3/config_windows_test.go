@@ -69,6 +69,8 @@ var _ = Describe("Verbose", func() {
 		Entry("CF_TRACE filepath, config trace true: enables verbose AND logging to file", "C:\\foo\\bar", "true", false, true, []string{"C:\\foo\\bar"}),
 		Entry("CF_TRACE filepath, config trace filepath: enables logging to file for BOTH paths", "C:\\foo\\bar", "C:\\\\baz", false, false, []string{"C:\\foo\\bar", "C:\\baz"}),
 		Entry("CF_TRACE filepath, config trace filepath, '-v': enables verbose AND logging to file for BOTH paths", "C:\\foo\\bar", "C:\\\\baz", true, true, []string{"C:\\foo\\bar", "C:\\baz"}),
+
+		Entry("CF_TRACE true and filepath: enables verbose AND logging to file", "true,C:\\foo\\bar", "", false, true, []string{"C:\\foo\\bar"}),
 	)
 
 	Context("relative paths (cannot be tested in DescribeTable)", func() {
@@ -93,3 +95,40 @@ var _ = Describe("Verbose", func() {
 		})
 	})
 })
+
+var _ = Describe("SubprocessEnvironment with Windows paths", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+		os.Unsetenv("CF_PLUGIN_HOME")
+	})
+
+	It("passes absolute overrides on unchanged", func() {
+		Expect(os.Setenv("CF_PLUGIN_HOME", "C:\\some\\plugin\\home")).To(Succeed())
+
+		env := SubprocessEnvironment()
+		Expect(env).To(ContainElement("CF_HOME=" + homeDir))
+		Expect(env).To(ContainElement("CF_PLUGIN_HOME=C:\\some\\plugin\\home"))
+	})
+
+	It("resolves relative overrides using backslashes against the working directory", func() {
+		Expect(os.Setenv("CF_PLUGIN_HOME", "some\\plugin\\home")).To(Succeed())
+
+		cwd, err := os.Getwd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(SubprocessEnvironment()).To(ContainElement("CF_PLUGIN_HOME=" + filepath.Join(cwd, "some", "plugin", "home")))
+	})
+
+	It("replaces the overrides regardless of how their names are cased", func() {
+		Expect(os.Setenv("Cf_Plugin_Home", "some-plugin-home")).To(Succeed())
+
+		for _, variable := range SubprocessEnvironment() {
+			Expect(variable).ToNot(HavePrefix("Cf_Plugin_Home="))
+		}
+	})
+})
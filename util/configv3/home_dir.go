@@ -0,0 +1,109 @@
+package configv3
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// HomeDirectory returns the absolute path of the directory containing the
+// '.cf' directory. The directory is one of the following:
+//   1. $CF_HOME if set
+//   2. Defaults to the user's home directory (outlined in LoadConfig)
+func HomeDirectory() string {
+	return absolutePath(homeDirectory())
+}
+
+// PluginHomeDirectory returns the absolute path of the directory containing
+// the '.cf/plugins' directory. The directory is one of the following:
+//   1. $CF_PLUGIN_HOME if set
+//   2. Defaults to HomeDirectory
+func PluginHomeDirectory() string {
+	if pluginHome := os.Getenv("CF_PLUGIN_HOME"); pluginHome != "" {
+		return absolutePath(pluginHome)
+	}
+
+	return HomeDirectory()
+}
+
+// SubprocessEnvironment returns the environment plugins and other subprocesses
+// of the CLI are started with. It is the environment of the CLI with the
+// $CF_HOME and $CF_PLUGIN_HOME overrides resolved to absolute paths, so that
+// the subprocess, and any cf it runs in turn, reads the same config regardless
+// of its working directory.
+func SubprocessEnvironment() []string {
+	var overrides []string
+	if os.Getenv("CF_HOME") != "" {
+		overrides = append(overrides, "CF_HOME="+HomeDirectory())
+	}
+	if os.Getenv("CF_PLUGIN_HOME") != "" {
+		overrides = append(overrides, "CF_PLUGIN_HOME="+PluginHomeDirectory())
+	}
+
+	var env []string
+	for _, variable := range os.Environ() {
+		if !isHomeVariable(strings.SplitN(variable, "=", 2)[0]) {
+			env = append(env, variable)
+		}
+	}
+
+	return append(env, overrides...)
+}
+
+// ApplyConfigFlag handles the global --config DIR flag. When it is given
+// among the options before the command name, either as '--config DIR' or as
+// '--config=DIR', it is removed from args and $CF_HOME is set to the absolute
+// path of DIR for the rest of the invocation. The config loaders, plugin
+// discovery and the plugins themselves all follow $CF_HOME, so it must be
+// called before any of them run.
+func ApplyConfigFlag(args []string) ([]string, error) {
+	for i, arg := range args {
+		if !strings.HasPrefix(arg, "-") {
+			break
+		}
+
+		var dir string
+		remaining := args[i+1:]
+		switch {
+		case arg == "--config":
+			if len(remaining) == 0 || remaining[0] == "" {
+				return nil, errors.New("expected argument for flag `--config'")
+			}
+			dir, remaining = remaining[0], remaining[1:]
+		case strings.HasPrefix(arg, "--config="):
+			dir = strings.TrimPrefix(arg, "--config=")
+			if dir == "" {
+				return nil, errors.New("expected argument for flag `--config'")
+			}
+		default:
+			continue
+		}
+
+		err := os.Setenv("CF_HOME", absolutePath(dir))
+		if err != nil {
+			return nil, err
+		}
+		return append(append([]string{}, args[:i]...), remaining...), nil
+	}
+
+	return args, nil
+}
+
+// CustomConfigDirectory returns the absolute path of the '.cf' directory when
+// $CF_HOME, or the --config flag, moves it from its default location, and ""
+// otherwise.
+func (config *Config) CustomConfigDirectory() string {
+	if config.ENV.CFHome == "" {
+		return ""
+	}
+	return filepath.Join(absolutePath(config.ENV.CFHome), ".cf")
+}
+
+func absolutePath(path string) string {
+	absolute, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	return absolute
+}
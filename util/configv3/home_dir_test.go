@@ -0,0 +1,141 @@
+package configv3_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("home directories", func() {
+	var (
+		homeDir       string
+		oldPluginHome string
+		cwd           string
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+		oldPluginHome = os.Getenv("CF_PLUGIN_HOME")
+		Expect(os.Unsetenv("CF_PLUGIN_HOME")).To(Succeed())
+
+		var err error
+		cwd, err = os.Getwd()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+		Expect(os.Setenv("CF_PLUGIN_HOME", oldPluginHome)).To(Succeed())
+	})
+
+	Describe("HomeDirectory", func() {
+		It("returns $CF_HOME", func() {
+			Expect(HomeDirectory()).To(Equal(homeDir))
+		})
+
+		It("resolves a relative $CF_HOME against the working directory", func() {
+			Expect(os.Setenv("CF_HOME", "some-home")).To(Succeed())
+			Expect(HomeDirectory()).To(Equal(filepath.Join(cwd, "some-home")))
+			Expect(ConfigFilePath()).To(Equal(filepath.Join(cwd, "some-home", ".cf", "config.json")))
+		})
+	})
+
+	Describe("PluginHomeDirectory", func() {
+		It("defaults to the home directory", func() {
+			Expect(PluginHomeDirectory()).To(Equal(homeDir))
+		})
+
+		It("resolves a relative $CF_PLUGIN_HOME against the working directory", func() {
+			Expect(os.Setenv("CF_PLUGIN_HOME", "some-plugin-home")).To(Succeed())
+			Expect(PluginHomeDirectory()).To(Equal(filepath.Join(cwd, "some-plugin-home")))
+		})
+
+		It("agrees with the plugin home of the loaded config", func() {
+			Expect(os.Setenv("CF_PLUGIN_HOME", "some-plugin-home")).To(Succeed())
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.PluginHome()).To(Equal(filepath.Join(PluginHomeDirectory(), ".cf", "plugins")))
+		})
+	})
+
+	Describe("SubprocessEnvironment", func() {
+		It("passes on the environment with the overrides resolved to absolute paths", func() {
+			Expect(os.Setenv("CF_HOME", "some-home")).To(Succeed())
+			Expect(os.Setenv("CF_PLUGIN_HOME", "some-plugin-home")).To(Succeed())
+			Expect(os.Setenv("SOME_OTHER_VARIABLE", "some-value")).To(Succeed())
+			defer os.Unsetenv("SOME_OTHER_VARIABLE")
+
+			env := SubprocessEnvironment()
+			Expect(env).To(ContainElement("SOME_OTHER_VARIABLE=some-value"))
+			Expect(env).To(ContainElement("CF_HOME=" + filepath.Join(cwd, "some-home")))
+			Expect(env).To(ContainElement("CF_PLUGIN_HOME=" + filepath.Join(cwd, "some-plugin-home")))
+			Expect(env).ToNot(ContainElement("CF_HOME=some-home"))
+			Expect(env).ToNot(ContainElement("CF_PLUGIN_HOME=some-plugin-home"))
+		})
+
+		It("does not add overrides that are not set", func() {
+			Expect(os.Unsetenv("CF_HOME")).To(Succeed())
+
+			for _, variable := range SubprocessEnvironment() {
+				Expect(variable).ToNot(HavePrefix("CF_HOME="))
+				Expect(variable).ToNot(HavePrefix("CF_PLUGIN_HOME="))
+			}
+		})
+	})
+
+	Describe("ApplyConfigFlag", func() {
+		It("removes '--config DIR' before the command and makes DIR the home directory", func() {
+			args, err := ApplyConfigFlag([]string{"--config", "some-home", "target", "-o", "some-org"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(args).To(Equal([]string{"target", "-o", "some-org"}))
+			Expect(os.Getenv("CF_HOME")).To(Equal(filepath.Join(cwd, "some-home")))
+			Expect(HomeDirectory()).To(Equal(filepath.Join(cwd, "some-home")))
+		})
+
+		It("accepts '--config=DIR' among the other global options", func() {
+			args, err := ApplyConfigFlag([]string{"-v", "--config=some-home", "apps"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(args).To(Equal([]string{"-v", "apps"}))
+			Expect(os.Getenv("CF_HOME")).To(Equal(filepath.Join(cwd, "some-home")))
+		})
+
+		It("leaves a --config that follows the command name to the command", func() {
+			args, err := ApplyConfigFlag([]string{"some-plugin-command", "--config", "some-plugin-config"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(args).To(Equal([]string{"some-plugin-command", "--config", "some-plugin-config"}))
+			Expect(os.Getenv("CF_HOME")).To(Equal(homeDir))
+		})
+
+		It("returns an error when no directory is given", func() {
+			_, err := ApplyConfigFlag([]string{"--config"})
+			Expect(err).To(MatchError("expected argument for flag `--config'"))
+
+			_, err = ApplyConfigFlag([]string{"--config=", "apps"})
+			Expect(err).To(MatchError("expected argument for flag `--config'"))
+			Expect(os.Getenv("CF_HOME")).To(Equal(homeDir))
+		})
+	})
+
+	Describe("CustomConfigDirectory", func() {
+		It("returns the .cf directory under $CF_HOME", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.CustomConfigDirectory()).To(Equal(filepath.Join(homeDir, ".cf")))
+		})
+
+		It("resolves a relative $CF_HOME against the working directory", func() {
+			config := Config{ENV: EnvOverride{CFHome: "some-home"}}
+			Expect(config.CustomConfigDirectory()).To(Equal(filepath.Join(cwd, "some-home", ".cf")))
+		})
+
+		It("returns an empty string when $CF_HOME is not set", func() {
+			config := Config{}
+			Expect(config.CustomConfigDirectory()).To(BeEmpty())
+		})
+	})
+})
@@ -13,7 +13,7 @@ func ConfigFilePath() string {
 }
 
 func configDirectory() string {
-	return filepath.Join(homeDirectory(), ".cf")
+	return filepath.Join(HomeDirectory(), ".cf")
 }
 
 func homeDirectory() string {
@@ -26,3 +26,8 @@ func homeDirectory() string {
 	}
 	return homeDir
 }
+
+// isHomeVariable returns true if name is CF_HOME or CF_PLUGIN_HOME.
+func isHomeVariable(name string) bool {
+	return name == "CF_HOME" || name == "CF_PLUGIN_HOME"
+}
@@ -5,15 +5,16 @@ package configv3
 import (
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 // ConfigFilePath returns the location of the config file
 func ConfigFilePath() string {
-	return filepath.Join(homeDirectory(), ".cf", "config.json")
+	return filepath.Join(HomeDirectory(), ".cf", "config.json")
 }
 
 func configDirectory() string {
-	return filepath.Join(homeDirectory(), ".cf")
+	return filepath.Join(HomeDirectory(), ".cf")
 }
 
 // See: http://stackoverflow.com/questions/7922270/obtain-users-home-directory
@@ -30,3 +31,9 @@ func homeDirectory() string {
 	}
 	return homeDir
 }
+
+// isHomeVariable returns true if name is CF_HOME or CF_PLUGIN_HOME. Windows
+// environment variable names are case insensitive.
+func isHomeVariable(name string) bool {
+	return strings.EqualFold(name, "CF_HOME") || strings.EqualFold(name, "CF_PLUGIN_HOME")
+}
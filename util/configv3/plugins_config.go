@@ -23,6 +23,10 @@ type Plugin struct {
 	Location string          `json:"Location"`
 	Version  PluginVersion   `json:"Version"`
 	Commands []PluginCommand `json:"Commands"`
+
+	// RepositoryName is the plugin repository the plugin was installed from.
+	// It is empty for plugins installed from a local file or a URL.
+	RepositoryName string `json:"RepositoryName,omitempty"`
 }
 
 // PluginVersion is the plugin version information
@@ -100,10 +104,10 @@ func (c PluginCommand) CommandName() string {
 //   2. Defaults to the home directory (outlined in LoadConfig)/.cf/plugins
 func (config *Config) PluginHome() string {
 	if config.ENV.CFPluginHome != "" {
-		return filepath.Join(config.ENV.CFPluginHome, ".cf", "plugins")
+		return filepath.Join(absolutePath(config.ENV.CFPluginHome), ".cf", "plugins")
 	}
 
-	return filepath.Join(homeDirectory(), ".cf", "plugins")
+	return filepath.Join(HomeDirectory(), ".cf", "plugins")
 }
 
 // AddPlugin adds the specified plugin to PluginsConfig
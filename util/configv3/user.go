@@ -24,7 +24,15 @@ func decodeUserFromJWT(accessToken string) (User, error) {
 	}
 
 	claims := token.Claims()
+
+	// Tokens obtained with the client credentials grant belong to a client
+	// rather than a user.
+	name, ok := claims.Get("user_name").(string)
+	if !ok {
+		name, _ = claims.Get("client_id").(string)
+	}
+
 	return User{
-		Name: claims.Get("user_name").(string),
+		Name: name,
 	}, nil
 }
@@ -0,0 +1,267 @@
+// Package labelselector validates the label selectors passed to the Cloud
+// Controller, so that syntax errors are reported before any request is made
+// and point at the offending part of the selector.
+//
+// A selector is a comma separated list of requirements, all of which must
+// match:
+//
+//	key                        the label is set
+//	!key                       the label is not set
+//	key=value, key==value      the label has the value
+//	key!=value                 the label does not have the value
+//	key in (value1,value2)     the label has one of the values
+//	key notin (value1,value2)  the label has none of the values
+package labelselector
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxNameLength   = 63
+	maxPrefixLength = 253
+)
+
+var (
+	namePattern   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	prefixPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// SyntaxError is returned when a selector cannot be parsed.
+type SyntaxError struct {
+	Selector string
+	// Position is the byte offset of the offending token in Selector. It is
+	// the length of Selector when the selector ends too early.
+	Position int
+	// Token is the offending token; it is empty at the end of the selector.
+	Token  string
+	Reason string
+}
+
+func (e SyntaxError) Error() string {
+	return fmt.Sprintf("invalid label selector '%s': %s at position %d", e.Selector, e.Reason, e.Column())
+}
+
+// Column returns the position of the offending token in characters, for
+// pointing at it underneath the selector.
+func (e SyntaxError) Column() int {
+	return utf8.RuneCountInString(e.Selector[:e.Position])
+}
+
+type tokenKind int
+
+const (
+	identifierToken tokenKind = iota
+	notToken
+	equalsToken
+	notEqualsToken
+	openParenToken
+	closeParenToken
+	commaToken
+	endToken
+)
+
+type token struct {
+	kind     tokenKind
+	text     string
+	position int
+}
+
+// Validate returns a SyntaxError if the selector is not valid.
+func Validate(selector string) error {
+	tokens, err := tokenize(selector)
+	if err != nil {
+		return err
+	}
+
+	p := parser{selector: selector, tokens: tokens}
+	return p.parse()
+}
+
+func tokenize(selector string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(selector); {
+		char := selector[i]
+		switch {
+		case char == ' ' || char == '\t':
+			i++
+		case isIdentifierChar(char):
+			start := i
+			for i < len(selector) && isIdentifierChar(selector[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: identifierToken, text: selector[start:i], position: start})
+		case strings.HasPrefix(selector[i:], "!="):
+			tokens = append(tokens, token{kind: notEqualsToken, text: "!=", position: i})
+			i += 2
+		case strings.HasPrefix(selector[i:], "=="):
+			tokens = append(tokens, token{kind: equalsToken, text: "==", position: i})
+			i += 2
+		case char == '=':
+			tokens = append(tokens, token{kind: equalsToken, text: "=", position: i})
+			i++
+		case char == '!':
+			tokens = append(tokens, token{kind: notToken, text: "!", position: i})
+			i++
+		case char == '(':
+			tokens = append(tokens, token{kind: openParenToken, text: "(", position: i})
+			i++
+		case char == ')':
+			tokens = append(tokens, token{kind: closeParenToken, text: ")", position: i})
+			i++
+		case char == ',':
+			tokens = append(tokens, token{kind: commaToken, text: ",", position: i})
+			i++
+		default:
+			r, _ := utf8.DecodeRuneInString(selector[i:])
+			return nil, SyntaxError{
+				Selector: selector,
+				Position: i,
+				Token:    string(r),
+				Reason:   fmt.Sprintf("unexpected character '%c'", r),
+			}
+		}
+	}
+
+	return append(tokens, token{kind: endToken, position: len(selector)}), nil
+}
+
+func isIdentifierChar(char byte) bool {
+	return char >= 'a' && char <= 'z' ||
+		char >= 'A' && char <= 'Z' ||
+		char >= '0' && char <= '9' ||
+		strings.IndexByte("-_./", char) >= 0
+}
+
+type parser struct {
+	selector string
+	tokens   []token
+	current  int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.current]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.current]
+	if tok.kind != endToken {
+		p.current++
+	}
+	return tok
+}
+
+func (p *parser) fail(tok token, reason string) error {
+	return SyntaxError{
+		Selector: p.selector,
+		Position: tok.position,
+		Token:    tok.text,
+		Reason:   reason,
+	}
+}
+
+func (p *parser) parse() error {
+	for {
+		err := p.parseRequirement()
+		if err != nil {
+			return err
+		}
+
+		switch tok := p.next(); tok.kind {
+		case endToken:
+			return nil
+		case commaToken:
+			continue
+		default:
+			return p.fail(tok, "expected ',' or the end of the selector")
+		}
+	}
+}
+
+func (p *parser) parseRequirement() error {
+	tok := p.next()
+	if tok.kind == notToken {
+		return p.parseKey(p.next())
+	}
+
+	err := p.parseKey(tok)
+	if err != nil {
+		return err
+	}
+
+	switch operator := p.peek(); {
+	case operator.kind == equalsToken || operator.kind == notEqualsToken:
+		p.next()
+		switch value := p.peek(); value.kind {
+		case identifierToken:
+			return p.parseValue(p.next())
+		case commaToken, endToken:
+			return nil
+		default:
+			return p.fail(value, "expected a label value")
+		}
+	case operator.kind == identifierToken && (operator.text == "in" || operator.text == "notin"):
+		p.next()
+		return p.parseValueSet(operator.text)
+	case operator.kind == identifierToken:
+		return p.fail(operator, "expected an operator ('=', '==', '!=', 'in' or 'notin')")
+	default:
+		return nil
+	}
+}
+
+func (p *parser) parseValueSet(operator string) error {
+	if tok := p.next(); tok.kind != openParenToken {
+		return p.fail(tok, fmt.Sprintf("expected '(' after '%s'", operator))
+	}
+
+	for {
+		value := p.next()
+		if value.kind != identifierToken {
+			return p.fail(value, "expected a label value")
+		}
+		err := p.parseValue(value)
+		if err != nil {
+			return err
+		}
+
+		switch tok := p.next(); tok.kind {
+		case commaToken:
+			continue
+		case closeParenToken:
+			return nil
+		default:
+			return p.fail(tok, "expected ',' or ')'")
+		}
+	}
+}
+
+func (p *parser) parseKey(tok token) error {
+	if tok.kind != identifierToken {
+		return p.fail(tok, "expected a label key")
+	}
+
+	name := tok.text
+	if slash := strings.Index(name, "/"); slash >= 0 {
+		prefix := name[:slash]
+		name = name[slash+1:]
+		if len(prefix) > maxPrefixLength || !prefixPattern.MatchString(prefix) {
+			return p.fail(tok, fmt.Sprintf("'%s' is not a valid label key prefix", prefix))
+		}
+	}
+
+	if len(name) > maxNameLength || !namePattern.MatchString(name) {
+		return p.fail(tok, fmt.Sprintf("'%s' is not a valid label key", tok.text))
+	}
+	return nil
+}
+
+func (p *parser) parseValue(tok token) error {
+	if len(tok.text) > maxNameLength || !namePattern.MatchString(tok.text) {
+		return p.fail(tok, fmt.Sprintf("'%s' is not a valid label value", tok.text))
+	}
+	return nil
+}
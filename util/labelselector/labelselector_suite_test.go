@@ -0,0 +1,13 @@
+package labelselector_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLabelselector(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Labelselector Suite")
+}
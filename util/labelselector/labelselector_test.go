@@ -0,0 +1,94 @@
+package labelselector_test
+
+import (
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/labelselector"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validate", func() {
+	DescribeTable("valid selectors",
+		func(selector string) {
+			Expect(Validate(selector)).To(Succeed())
+		},
+		Entry("existence", "env"),
+		Entry("non-existence", "!env"),
+		Entry("equality", "env=prod"),
+		Entry("double equals", "env==prod"),
+		Entry("inequality", "env!=prod"),
+		Entry("empty value", "env="),
+		Entry("set membership", "env in (prod,staging)"),
+		Entry("set exclusion", "env notin (prod, staging)"),
+		Entry("prefixed key", "example.com/team=a-team_1.x"),
+		Entry("several requirements", "env in (prod,staging), !deprecated, tier=web"),
+	)
+
+	DescribeTable("invalid selectors",
+		func(selector string, marker string, token string, reason string) {
+			err := Validate(selector)
+			Expect(err).To(HaveOccurred())
+
+			syntaxErr, ok := err.(SyntaxError)
+			Expect(ok).To(BeTrue())
+			Expect(syntaxErr.Selector).To(Equal(selector))
+			Expect(syntaxErr.Column()).To(Equal(strings.Index(marker, "^")))
+			Expect(syntaxErr.Token).To(Equal(token))
+			Expect(syntaxErr.Reason).To(Equal(reason))
+		},
+		Entry("empty selector",
+			"",
+			"^", "", "expected a label key"),
+		Entry("missing parenthesis",
+			"env in prod",
+			"       ^", "prod", "expected '(' after 'in'"),
+		Entry("doubled comma in a set",
+			"env in (prod,,staging)",
+			"             ^", ",", "expected a label value"),
+		Entry("unterminated set",
+			"env in (prod,staging",
+			"                    ^", "", "expected ',' or ')'"),
+		Entry("empty set",
+			"env notin ()",
+			"           ^", ")", "expected a label value"),
+		Entry("unknown operator",
+			"env is prod",
+			"    ^", "is", "expected an operator ('=', '==', '!=', 'in' or 'notin')"),
+		Entry("unexpected character",
+			"env=prod;tier=web",
+			"        ^", ";", "unexpected character ';'"),
+		Entry("trailing comma",
+			"env=prod,",
+			"         ^", "", "expected a label key"),
+		Entry("operator after a negated key",
+			"!env=prod",
+			"    ^", "=", "expected ',' or the end of the selector"),
+		Entry("invalid key",
+			"-env=prod",
+			"^", "-env", "'-env' is not a valid label key"),
+		Entry("invalid key prefix",
+			"Example.com/team=a",
+			"^", "Example.com/team", "'Example.com' is not a valid label key prefix"),
+		Entry("invalid value",
+			"env=prod.",
+			"    ^", "prod.", "'prod.' is not a valid label value"),
+		Entry("value that is too long",
+			"env="+strings.Repeat("a", 64),
+			"    ^", strings.Repeat("a", 64), "'"+strings.Repeat("a", 64)+"' is not a valid label value"),
+	)
+
+	It("reports the position in characters", func() {
+		err := Validate("é=x;")
+		Expect(err).To(MatchError(SyntaxError{
+			Selector: "é=x;",
+			Position: 0,
+			Token:    "é",
+			Reason:   "unexpected character 'é'",
+		}))
+
+		err = Validate("a=é")
+		Expect(err).To(MatchError("invalid label selector 'a=é': unexpected character 'é' at position 2"))
+	})
+})
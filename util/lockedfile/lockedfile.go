@@ -0,0 +1,48 @@
+// Package lockedfile provides the cross-process locking and atomic file
+// replacement needed to safely rewrite files that concurrent cf invocations
+// share, such as the config file.
+package lockedfile
+
+import "os"
+
+// FileLock is an exclusive lock held on a lock file. The lock is shared with
+// other processes and is released when the process exits, even if Unlock is
+// never called.
+type FileLock struct {
+	file *os.File
+}
+
+// Lock blocks until it holds the exclusive lock guarding path. The lock is
+// taken on a separate path + ".lock" file so that path itself can be replaced
+// while the lock is held. The lock file is never removed, since removing it
+// would let two processes lock different files.
+func Lock(path string) (*FileLock, error) {
+	file, err := os.OpenFile(path+".lock", os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	err = lockFile(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return &FileLock{file: file}, nil
+}
+
+// Unlock releases the lock.
+func (l *FileLock) Unlock() error {
+	err := unlockFile(l.file)
+	closeErr := l.file.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
+
+// Rename atomically replaces newpath with oldpath, so readers of newpath see
+// either the old or the new contents and never a partially written file.
+func Rename(oldpath string, newpath string) error {
+	return rename(oldpath, newpath)
+}
@@ -0,0 +1,13 @@
+package lockedfile_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLockedFile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Locked File Suite")
+}
@@ -0,0 +1,75 @@
+package lockedfile_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/lockedfile"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("lockedfile", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "lockedfile")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(dir, "config.json")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("Lock", func() {
+		It("locks a separate lock file", func() {
+			lock, err := Lock(path)
+			Expect(err).ToNot(HaveOccurred())
+			defer lock.Unlock()
+
+			Expect(filepath.Join(dir, "config.json.lock")).To(BeAnExistingFile())
+			Expect(path).ToNot(BeAnExistingFile())
+		})
+
+		It("blocks until the holder of the lock unlocks it", func() {
+			lock, err := Lock(path)
+			Expect(err).ToNot(HaveOccurred())
+
+			locked := make(chan *FileLock)
+			go func() {
+				defer GinkgoRecover()
+				secondLock, lockErr := Lock(path)
+				Expect(lockErr).ToNot(HaveOccurred())
+				locked <- secondLock
+			}()
+
+			Consistently(locked).ShouldNot(Receive())
+
+			Expect(lock.Unlock()).To(Succeed())
+
+			var secondLock *FileLock
+			Eventually(locked).Should(Receive(&secondLock))
+			Expect(secondLock.Unlock()).To(Succeed())
+		})
+	})
+
+	Describe("Rename", func() {
+		It("replaces the existing file", func() {
+			Expect(ioutil.WriteFile(path, []byte("old"), 0600)).To(Succeed())
+			newPath := filepath.Join(dir, "temp-config")
+			Expect(ioutil.WriteFile(newPath, []byte("new"), 0600)).To(Succeed())
+
+			Expect(Rename(newPath, path)).To(Succeed())
+
+			Expect(ioutil.ReadFile(path)).To(Equal([]byte("new")))
+			Expect(newPath).ToNot(BeAnExistingFile())
+		})
+	})
+})
@@ -0,0 +1,65 @@
+// +build windows
+
+package lockedfile
+
+import (
+	"os"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+const (
+	lockfileExclusiveLock  = 0x2
+	errorSharingViolation  = syscall.Errno(32)
+	renameAttempts         = 50
+	renameAttemptsInterval = 20 * time.Millisecond
+)
+
+var (
+	kernel32         = syscall.NewLazyDLL("kernel32.dll")
+	procLockFileEx   = kernel32.NewProc("LockFileEx")
+	procUnlockFileEx = kernel32.NewProc("UnlockFileEx")
+)
+
+func lockFile(file *os.File) error {
+	var overlapped syscall.Overlapped
+	r1, _, err := procLockFileEx.Call(file.Fd(), lockfileExclusiveLock, 0, 1, 0, uintptr(unsafe.Pointer(&overlapped)))
+	if r1 == 0 {
+		return err
+	}
+	return nil
+}
+
+func unlockFile(file *os.File) error {
+	var overlapped syscall.Overlapped
+	r1, _, err := procUnlockFileEx.Call(file.Fd(), 0, 1, 0, uintptr(unsafe.Pointer(&overlapped)))
+	if r1 == 0 {
+		return err
+	}
+	return nil
+}
+
+// rename retries while newpath is open in another process. Unlike on UNIX,
+// Windows refuses to replace a file that another process is reading, which
+// happens whenever a concurrent cf invocation loads the config file.
+func rename(oldpath string, newpath string) error {
+	var err error
+	for attempt := 0; attempt < renameAttempts; attempt++ {
+		err = os.Rename(oldpath, newpath)
+		if !isSharingError(err) {
+			return err
+		}
+		time.Sleep(renameAttemptsInterval)
+	}
+	return err
+}
+
+func isSharingError(err error) bool {
+	linkErr, ok := err.(*os.LinkError)
+	if !ok {
+		return false
+	}
+	errno, ok := linkErr.Err.(syscall.Errno)
+	return ok && (errno == syscall.ERROR_ACCESS_DENIED || errno == errorSharingViolation)
+}
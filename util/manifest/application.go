@@ -19,23 +19,59 @@ type Application struct {
 	// guaranteed, although CLI only ships strings).
 	EnvironmentVariables    map[string]string
 	HealthCheckHTTPEndpoint string
+	// HealthCheckInvocationTimeout is the number of seconds a single health
+	// check may take before it is considered failed.
+	HealthCheckInvocationTimeout int
 	// HealthCheckType attribute defines the number of seconds that is allocated
 	// for starting an application.
 	HealthCheckTimeout int
 	HealthCheckType    string
 	Instances          types.NullInt
 	// Memory is the amount of memory in megabytes.
-	Memory    types.NullByteSizeInMb
-	Name      string
-	Path      string
-	Routes    []string
-	Services  []string
+	Memory types.NullByteSizeInMb
+	Name   string
+	// NoRoute removes all routes from the application.
+	NoRoute bool
+	Path    string
+	// Processes override the health check settings above for individual
+	// process types.
+	Processes []Process
+	// RandomRoute generates a random hostname for the default route.
+	RandomRoute bool
+	Routes      []string
+	Services    []string
+	// Sidecars run alongside the listed process types of the application.
+	Sidecars  []Sidecar
 	StackName string
+	// StagingTimeout is the number of minutes the CLI waits for the application
+	// to stage.
+	StagingTimeout int
+}
+
+// Process holds the health check settings of a single process type of an
+// application.
+type Process struct {
+	Type                         string
+	HealthCheckHTTPEndpoint      string
+	HealthCheckInvocationTimeout int
+	HealthCheckTimeout           int
+	HealthCheckType              string
+}
+
+// Sidecar is an additional process that runs next to the given process
+// types of an application.
+type Sidecar struct {
+	Name         string
+	Command      string
+	ProcessTypes []string
+	// Memory is the part of the process memory, in megabytes, reserved for
+	// the sidecar.
+	Memory types.NullByteSizeInMb
 }
 
 func (app Application) String() string {
 	return fmt.Sprintf(
-		"App Name: '%s', Buildpack IsSet: %t, Buildpack: '%s', Command IsSet: %t, Command: '%s', Disk Quota: '%s', Docker Image: '%s', Health Check HTTP Endpoint: '%s', Health Check Timeout: '%d', Health Check Type: '%s', Instances IsSet: %t, Instances: '%d', Memory: '%s', Path: '%s', Routes: [%s], Services: [%s], Stack Name: '%s'",
+		"App Name: '%s', Buildpack IsSet: %t, Buildpack: '%s', Command IsSet: %t, Command: '%s', Disk Quota: '%s', Docker Image: '%s', Health Check HTTP Endpoint: '%s', Health Check Invocation Timeout: '%d', Health Check Timeout: '%d', Health Check Type: '%s', Instances IsSet: %t, Instances: '%d', Memory: '%s', No Route: %t, Path: '%s', Processes: %d, Random Route: %t, Routes: [%s], Services: [%s], Sidecars: %d, Stack Name: '%s', Staging Timeout: '%d'",
 		app.Name,
 		app.Buildpack.IsSet,
 		app.Buildpack.Value,
@@ -44,31 +80,41 @@ func (app Application) String() string {
 		app.DiskQuota,
 		app.DockerImage,
 		app.HealthCheckHTTPEndpoint,
+		app.HealthCheckInvocationTimeout,
 		app.HealthCheckTimeout,
 		app.HealthCheckType,
 		app.Instances.IsSet,
 		app.Instances.Value,
 		app.Memory,
+		app.NoRoute,
 		app.Path,
+		len(app.Processes),
+		app.RandomRoute,
 		strings.Join(app.Routes, ", "),
 		strings.Join(app.Services, ", "),
+		len(app.Sidecars),
 		app.StackName,
+		app.StagingTimeout,
 	)
 }
 
 func (app Application) MarshalYAML() (interface{}, error) {
 	var m = rawManifestApplication{
-		Buildpack:               app.Buildpack.Value,
-		Command:                 app.Command.Value,
-		Docker:                  rawDockerInfo{Image: app.DockerImage, Username: app.DockerUsername},
-		EnvironmentVariables:    app.EnvironmentVariables,
-		HealthCheckHTTPEndpoint: app.HealthCheckHTTPEndpoint,
-		HealthCheckType:         app.HealthCheckType,
-		Name:                    app.Name,
-		Path:                    app.Path,
-		Services:                app.Services,
-		StackName:               app.StackName,
-		Timeout:                 app.HealthCheckTimeout,
+		Buildpack:                    app.Buildpack.Value,
+		Command:                      app.Command.Value,
+		Docker:                       rawDockerInfo{Image: app.DockerImage, Username: app.DockerUsername},
+		EnvironmentVariables:         app.EnvironmentVariables,
+		HealthCheckHTTPEndpoint:      app.HealthCheckHTTPEndpoint,
+		HealthCheckInvocationTimeout: app.HealthCheckInvocationTimeout,
+		HealthCheckType:              app.HealthCheckType,
+		Name:                         app.Name,
+		NoRoute:                      app.NoRoute,
+		Path:                         app.Path,
+		RandomRoute:                  app.RandomRoute,
+		Services:                     app.Services,
+		StackName:                    app.StackName,
+		StagingTimeout:               app.StagingTimeout,
+		Timeout:                      app.HealthCheckTimeout,
 	}
 	m.DiskQuota = app.DiskQuota.String()
 	m.Memory = app.Memory.String()
@@ -79,10 +125,32 @@ func (app Application) MarshalYAML() (interface{}, error) {
 	for _, route := range app.Routes {
 		m.Routes = append(m.Routes, rawManifestRoute{Route: route})
 	}
+	for _, process := range app.Processes {
+		m.Processes = append(m.Processes, rawManifestProcess{
+			Type:                         process.Type,
+			HealthCheckHTTPEndpoint:      process.HealthCheckHTTPEndpoint,
+			HealthCheckInvocationTimeout: process.HealthCheckInvocationTimeout,
+			HealthCheckType:              process.HealthCheckType,
+			Timeout:                      process.HealthCheckTimeout,
+		})
+	}
+	for _, sidecar := range app.Sidecars {
+		m.Sidecars = append(m.Sidecars, rawManifestSidecar{
+			Name:         sidecar.Name,
+			Command:      sidecar.Command,
+			ProcessTypes: sidecar.ProcessTypes,
+			Memory:       sidecar.Memory.String(),
+		})
+	}
 
 	return m, nil
 }
 
+// UnmarshalYAML decodes the application in two passes, once into the typed
+// raw representation and once into a map for existence checks. The decoder
+// resolves YAML merge keys ("<<: *defaults") before either pass sees the
+// node, so settings inherited through anchors are treated exactly like ones
+// written inline.
 func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) error {
 	var m rawManifestApplication
 
@@ -94,12 +162,16 @@ func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) erro
 	app.DockerImage = m.Docker.Image
 	app.DockerUsername = m.Docker.Username
 	app.HealthCheckHTTPEndpoint = m.HealthCheckHTTPEndpoint
+	app.HealthCheckInvocationTimeout = m.HealthCheckInvocationTimeout
 	app.HealthCheckType = m.HealthCheckType
 	app.Name = m.Name
+	app.NoRoute = m.NoRoute
 	app.Path = m.Path
+	app.RandomRoute = m.RandomRoute
 	app.Services = m.Services
 	app.StackName = m.StackName
 	app.HealthCheckTimeout = m.Timeout
+	app.StagingTimeout = m.StagingTimeout
 	app.EnvironmentVariables = m.EnvironmentVariables
 
 	app.Instances.ParseIntValue(m.Instances)
@@ -116,6 +188,28 @@ func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) erro
 		app.Routes = append(app.Routes, route.Route)
 	}
 
+	for _, process := range m.Processes {
+		app.Processes = append(app.Processes, Process{
+			Type:                         process.Type,
+			HealthCheckHTTPEndpoint:      process.HealthCheckHTTPEndpoint,
+			HealthCheckInvocationTimeout: process.HealthCheckInvocationTimeout,
+			HealthCheckTimeout:           process.Timeout,
+			HealthCheckType:              process.HealthCheckType,
+		})
+	}
+
+	for _, rawSidecar := range m.Sidecars {
+		sidecar := Sidecar{
+			Name:         rawSidecar.Name,
+			Command:      rawSidecar.Command,
+			ProcessTypes: rawSidecar.ProcessTypes,
+		}
+		if fmtErr := sidecar.Memory.ParseStringValue(rawSidecar.Memory); fmtErr != nil {
+			return fmtErr
+		}
+		app.Sidecars = append(app.Sidecars, sidecar)
+	}
+
 	// "null" values are identical to non-existant values in YAML. In order to
 	// detect if an explicit null is given, a manual existance check is required.
 	exists := map[string]interface{}{}
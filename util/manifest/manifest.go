@@ -45,10 +45,28 @@ func ReadAndMergeManifests(pathToManifest string) ([]Application, error) {
 	return manifest.Applications, err
 }
 
+// MarshalSidecars returns a manifest that only describes the name and the
+// sidecars of the provided application, so applying it leaves every other
+// setting of the app untouched.
+func MarshalSidecars(application Application) ([]byte, error) {
+	manifest := Manifest{Applications: []Application{{
+		Name:     application.Name,
+		Sidecars: application.Sidecars,
+	}}}
+	return yaml.Marshal(manifest)
+}
+
 // WriteApplicationManifest writes the provided application to the given
 // filepath. If the filepath does not exist, it will create it.
 func WriteApplicationManifest(application Application, filePath string) error {
-	manifest := Manifest{Applications: []Application{application}}
+	return WriteApplicationManifests([]Application{application}, filePath)
+}
+
+// WriteApplicationManifests writes the provided applications, in the given
+// order, to a single manifest at filePath. If the filepath does not exist, it
+// will create it.
+func WriteApplicationManifests(applications []Application, filePath string) error {
+	manifest := Manifest{Applications: applications}
 	manifestBytes, err := yaml.Marshal(manifest)
 	if err != nil {
 		return ManifestCreationError{Err: err}
@@ -47,6 +47,7 @@ applications:
     username: "some-docker-username"
   memory: 200M
   stack: "some-stack"
+  staging-timeout: 30
   timeout: 120
 - name: "app-2"
   buildpack: default
@@ -109,6 +110,7 @@ applications:
 						IsSet: true,
 					},
 					StackName:          "some-stack",
+					StagingTimeout:     30,
 					HealthCheckTimeout: 120,
 				},
 				Application{
@@ -154,6 +156,214 @@ applications:
 				},
 			))
 		})
+
+		Context("when the manifest uses anchors and merge keys", func() {
+			BeforeEach(func() {
+				manifest = `---
+defaults: &defaults
+  buildpack: some-buildpack
+  disk_quota: 512M
+  memory: 256M
+  env: &env
+    SHARED: shared-value
+    LEVEL: info
+applications:
+- name: app-1
+  <<: *defaults
+- name: app-2
+  <<: *defaults
+  memory: 1G
+- name: app-3
+  <<: *defaults
+  command: some-command
+  env:
+    <<: *env
+    LEVEL: debug
+`
+				Expect(ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)).To(Succeed())
+			})
+
+			It("applies the inherited settings to every app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				sharedBuildpack := types.FilteredString{IsSet: true, Value: "some-buildpack"}
+				sharedDisk := types.NullByteSizeInMb{IsSet: true, Value: 512}
+				Expect(apps).To(ConsistOf(
+					Application{
+						Name:                 "app-1",
+						Buildpack:            sharedBuildpack,
+						DiskQuota:            sharedDisk,
+						Memory:               types.NullByteSizeInMb{IsSet: true, Value: 256},
+						EnvironmentVariables: map[string]string{"SHARED": "shared-value", "LEVEL": "info"},
+					},
+					Application{
+						Name:                 "app-2",
+						Buildpack:            sharedBuildpack,
+						DiskQuota:            sharedDisk,
+						Memory:               types.NullByteSizeInMb{IsSet: true, Value: 1024},
+						EnvironmentVariables: map[string]string{"SHARED": "shared-value", "LEVEL": "info"},
+					},
+					Application{
+						Name:                 "app-3",
+						Buildpack:            sharedBuildpack,
+						Command:              types.FilteredString{IsSet: true, Value: "some-command"},
+						DiskQuota:            sharedDisk,
+						Memory:               types.NullByteSizeInMb{IsSet: true, Value: 256},
+						EnvironmentVariables: map[string]string{"SHARED": "shared-value", "LEVEL": "debug"},
+					},
+				))
+			})
+		})
+
+		Context("when the manifest has health check overrides for processes", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: app-1
+  health-check-type: http
+  health-check-invocation-timeout: 5
+  timeout: 120
+  processes:
+  - type: worker
+    health-check-type: process
+    timeout: 30
+  - type: api
+    health-check-type: http
+    health-check-http-endpoint: /health
+    health-check-invocation-timeout: 10
+`
+				Expect(ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)).To(Succeed())
+			})
+
+			It("reads the invocation timeout and the process overrides", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(
+					Application{
+						Name:                         "app-1",
+						HealthCheckType:              "http",
+						HealthCheckInvocationTimeout: 5,
+						HealthCheckTimeout:           120,
+						Processes: []Process{
+							{Type: "worker", HealthCheckType: "process", HealthCheckTimeout: 30},
+							{Type: "api", HealthCheckType: "http", HealthCheckHTTPEndpoint: "/health", HealthCheckInvocationTimeout: 10},
+						},
+					},
+				))
+			})
+		})
+
+		Context("when the manifest has sidecars", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: app-1
+  memory: 512M
+  sidecars:
+  - name: auth-proxy
+    command: ./proxy
+    process_types:
+    - web
+    - worker
+    memory: 64M
+  - name: log-shipper
+    command: ./ship
+    process_types:
+    - web
+`
+				Expect(ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)).To(Succeed())
+			})
+
+			It("reads the sidecars", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(
+					Application{
+						Name:   "app-1",
+						Memory: types.NullByteSizeInMb{IsSet: true, Value: 512},
+						Sidecars: []Sidecar{
+							{
+								Name:         "auth-proxy",
+								Command:      "./proxy",
+								ProcessTypes: []string{"web", "worker"},
+								Memory:       types.NullByteSizeInMb{IsSet: true, Value: 64},
+							},
+							{
+								Name:         "log-shipper",
+								Command:      "./ship",
+								ProcessTypes: []string{"web"},
+							},
+						},
+					},
+				))
+			})
+		})
+
+		Context("when the manifest sets route options per application", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: app-1
+  no-route: true
+- name: app-2
+  random-route: true
+- name: app-3
+`
+				Expect(ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)).To(Succeed())
+			})
+
+			It("reads no-route and random-route for each application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(
+					Application{Name: "app-1", NoRoute: true},
+					Application{Name: "app-2", RandomRoute: true},
+					Application{Name: "app-3"},
+				))
+			})
+		})
+
+		Context("when a sidecar memory is not a valid size", func() {
+			BeforeEach(func() {
+				manifest = `---
+applications:
+- name: app-1
+  sidecars:
+  - name: auth-proxy
+    memory: lots
+`
+				Expect(ioutil.WriteFile(pathToManifest, []byte(manifest), 0666)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("MarshalSidecars", func() {
+		It("only includes the name and the sidecars of the application", func() {
+			rawManifest, err := MarshalSidecars(Application{
+				Name:      "app-1",
+				Memory:    types.NullByteSizeInMb{IsSet: true, Value: 256},
+				StackName: "some-stack",
+				Sidecars: []Sidecar{
+					{
+						Name:         "auth-proxy",
+						Command:      "./proxy",
+						ProcessTypes: []string{"web"},
+						Memory:       types.NullByteSizeInMb{IsSet: true, Value: 64},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawManifest)).To(Equal(`applications:
+- name: app-1
+  sidecars:
+  - name: auth-proxy
+    command: ./proxy
+    process_types:
+    - web
+    memory: 64M
+`))
+		})
 	})
 
 	Describe("WriteApplicationManifest", func() {
@@ -218,6 +428,7 @@ applications:
 					Routes:             []string{"foo.bar.com", "baz.qux.com"},
 					Services:           []string{"service_1", "service_2"},
 					StackName:          "some-stack",
+					StagingTimeout:     30,
 					HealthCheckTimeout: 120,
 				}
 			})
@@ -250,11 +461,74 @@ applications:
   - service_1
   - service_2
   stack: some-stack
+  staging-timeout: 30
   timeout: 120
 `))
 			})
 		})
 
+		Context("when processes have custom health checks", func() {
+			BeforeEach(func() {
+				application = Application{
+					Name:                         "app-1",
+					HealthCheckInvocationTimeout: 5,
+					Processes: []Process{
+						{Type: "worker", HealthCheckType: "port", HealthCheckTimeout: 30},
+						{Type: "api", HealthCheckType: "http", HealthCheckHTTPEndpoint: "/health", HealthCheckInvocationTimeout: 10},
+					},
+				}
+			})
+
+			It("writes the processes block", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				manifestBytes, err := ioutil.ReadFile(filePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(manifestBytes)).To(Equal(`applications:
+- name: app-1
+  health-check-invocation-timeout: 5
+  processes:
+  - type: worker
+    health-check-type: port
+    timeout: 30
+  - type: api
+    health-check-http-endpoint: /health
+    health-check-invocation-timeout: 10
+    health-check-type: http
+`))
+			})
+		})
+
+		Context("when the application has sidecars", func() {
+			BeforeEach(func() {
+				application = Application{
+					Name: "app-1",
+					Sidecars: []Sidecar{
+						{
+							Name:         "auth-proxy",
+							Command:      "./proxy",
+							ProcessTypes: []string{"web"},
+							Memory:       types.NullByteSizeInMb{IsSet: true, Value: 64},
+						},
+					},
+				}
+			})
+
+			It("writes the sidecars block", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				manifestBytes, err := ioutil.ReadFile(filePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(manifestBytes)).To(Equal(`applications:
+- name: app-1
+  sidecars:
+  - name: auth-proxy
+    command: ./proxy
+    process_types:
+    - web
+    memory: 64M
+`))
+			})
+		})
+
 		Context("when some properties are not provided", func() {
 			BeforeEach(func() {
 				application = Application{
@@ -272,6 +546,37 @@ applications:
 			})
 		})
 
+		Context("when the application was read from a manifest using anchors", func() {
+			BeforeEach(func() {
+				sourcePath := filepath.Join(tmpDir, "source.yml")
+				Expect(ioutil.WriteFile(sourcePath, []byte(`---
+defaults: &defaults
+  memory: 256M
+  env:
+    SHARED: shared-value
+applications:
+- name: app-1
+  <<: *defaults
+`), 0666)).To(Succeed())
+
+				apps, err := ReadAndMergeManifests(sourcePath)
+				Expect(err).NotTo(HaveOccurred())
+				application = apps[0]
+			})
+
+			It("writes the inherited settings inline without anchors or merge keys", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				manifestBytes, err := ioutil.ReadFile(filePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(manifestBytes)).To(Equal(`applications:
+- name: app-1
+  env:
+    SHARED: shared-value
+  memory: 256M
+`))
+			})
+		})
+
 		Context("when the file is a relative path", func() {
 			var pwd string
 
@@ -323,4 +628,43 @@ applications:
 			})
 		})
 	})
+
+	Describe("WriteApplicationManifests", func() {
+		var (
+			tmpDir   string
+			filePath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "manifest-test-")
+			Expect(err).NotTo(HaveOccurred())
+			filePath = filepath.Join(tmpDir, "manifest.yml")
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpDir)
+		})
+
+		It("writes all the applications to a single manifest in the given order", func() {
+			err := WriteApplicationManifests([]Application{
+				{Name: "app-1", DockerImage: "some-docker-image"},
+				{Name: "app-2", Routes: []string{"foo.bar.com/some-path"}, Services: []string{"service_1"}},
+			}, filePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			manifestBytes, err := ioutil.ReadFile(filePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(manifestBytes)).To(Equal(`applications:
+- name: app-1
+  docker:
+    image: some-docker-image
+- name: app-2
+  routes:
+  - route: foo.bar.com/some-path
+  services:
+  - service_1
+`))
+		})
+	})
 })
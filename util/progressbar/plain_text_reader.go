@@ -0,0 +1,58 @@
+package progressbar
+
+import (
+	"fmt"
+	"io"
+)
+
+// PlainTextReader wraps a reader and, in place of an animated progress bar,
+// writes a line to its writer every time another tenth of the total size has
+// been read. It is used when the output is not a TTY or colors are disabled.
+type PlainTextReader struct {
+	reader io.Reader
+	writer io.Writer
+	label  string
+	total  int64
+
+	read          int64
+	lastDisplayed int64
+}
+
+// NewPlainTextReader returns a PlainTextReader reading from reader and
+// writing lines such as "<label> 40%..." to writer. Nothing is written when
+// total is unknown.
+func NewPlainTextReader(reader io.Reader, writer io.Writer, label string, total int64) *PlainTextReader {
+	return &PlainTextReader{
+		reader: reader,
+		writer: writer,
+		label:  label,
+		total:  total,
+	}
+}
+
+func (r *PlainTextReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+
+	if r.total > 0 {
+		percent := r.read * 100 / r.total
+		if percent > 100 {
+			percent = 100
+		}
+		percent -= percent % 10
+		if percent > r.lastDisplayed {
+			r.lastDisplayed = percent
+			fmt.Fprintf(r.writer, "%s %d%%...\n", r.label, percent)
+		}
+	}
+
+	return n, err
+}
+
+// Close closes the wrapped reader if it is an io.Closer.
+func (r *PlainTextReader) Close() error {
+	if closer, ok := r.reader.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
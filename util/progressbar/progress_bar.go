@@ -8,13 +8,20 @@ import (
 )
 
 type ProgressBar struct {
-	ready chan bool
-	bar   *pb.ProgressBar
+	ready    chan bool
+	bar      *pb.ProgressBar
+	writer   io.Writer
+	animated bool
 }
 
-func NewProgressBar() *ProgressBar {
+// NewProgressBar returns a ProgressBar. When animated is false, upload
+// progress is written to writer as plain text lines instead of an animated
+// bar.
+func NewProgressBar(writer io.Writer, animated bool) *ProgressBar {
 	return &ProgressBar{
-		ready: make(chan bool),
+		ready:    make(chan bool),
+		writer:   writer,
+		animated: animated,
 	}
 }
 
@@ -24,6 +31,10 @@ func (p *ProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64)
 		return nil
 	}
 
+	if !p.animated {
+		return NewPlainTextReader(reader, p.writer, "Uploaded", sizeOfFile)
+	}
+
 	p.bar = pb.New(int(sizeOfFile)).SetUnits(pb.U_BYTES)
 	p.bar.ShowTimeLeft = false
 	p.bar.Start()
@@ -35,6 +46,10 @@ func (p *ProgressBar) Ready() {
 }
 
 func (p *ProgressBar) Complete() {
+	if !p.animated {
+		return
+	}
+
 	// Adding sleep to ensure UI has finished drawing
 	time.Sleep(time.Second)
 }
@@ -0,0 +1,244 @@
+// Package proxy resolves the HTTP(S) proxy the CLI should use for a
+// connection from the standard proxy environment variables. It is used for
+// the connections that are not plain HTTP requests, such as log streaming
+// websockets and SSH, so that they honor the same settings as API requests.
+package proxy
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// ProxyConnectError is returned when a connection could not be established
+// through the configured proxy.
+type ProxyConnectError struct {
+	ProxyHost string
+	Err       error
+}
+
+func (e ProxyConnectError) Error() string {
+	return fmt.Sprintf("unable to connect through proxy %s: %s", e.ProxyHost, e.Err)
+}
+
+// Settings are the proxy environment variables the CLI honors.
+type Settings struct {
+	HTTPProxy  string
+	HTTPSProxy string
+	NoProxy    string
+}
+
+// FromEnvironment returns the Settings from the HTTP_PROXY, HTTPS_PROXY and
+// NO_PROXY environment variables, or their lowercase versions.
+func FromEnvironment() Settings {
+	return Settings{
+		HTTPProxy:  getEnvAny("HTTP_PROXY", "http_proxy"),
+		HTTPSProxy: getEnvAny("HTTPS_PROXY", "https_proxy"),
+		NoProxy:    getEnvAny("NO_PROXY", "no_proxy"),
+	}
+}
+
+// ProxyURL returns the proxy to use for target, or nil when target should be
+// connected to directly. http targets use HTTPProxy; all other schemes,
+// including the tcp targets of SSH connections, use HTTPSProxy.
+//
+// NoProxy is a comma separated list of hosts that are never proxied. Each
+// entry is either '*', an IP address, a CIDR range, or a domain name which
+// matches itself and its subdomains. A domain name with a leading '.' only
+// matches subdomains. Like net/http, localhost and loopback addresses are
+// never proxied.
+func (settings Settings) ProxyURL(target *url.URL) (*url.URL, error) {
+	proxy := settings.HTTPSProxy
+	if target.Scheme == "http" {
+		proxy = settings.HTTPProxy
+	}
+
+	if proxy == "" || !settings.useProxy(target.Hostname()) {
+		return nil, nil
+	}
+
+	proxyURL, err := url.Parse(proxy)
+	if err != nil || proxyURL.Host == "" {
+		// Like net/http, accept proxies given without a scheme.
+		if proxyURL, err = url.Parse("http://" + proxy); err != nil {
+			return nil, fmt.Errorf("invalid proxy address %q: %s", proxy, err)
+		}
+	}
+
+	return proxyURL, nil
+}
+
+// ProxyFunc returns a function suitable for http.Transport.Proxy. When trace
+// is not nil, it is called with a description of the proxy used for each
+// request.
+func (settings Settings) ProxyFunc(trace func(string)) func(*http.Request) (*url.URL, error) {
+	return func(request *http.Request) (*url.URL, error) {
+		proxyURL, err := settings.ProxyURL(request.URL)
+		if err == nil && trace != nil {
+			trace(TraceMessage(request.URL.Host, proxyURL))
+		}
+		return proxyURL, err
+	}
+}
+
+// TraceMessage returns a trace line stating which proxy, if any, is used to
+// connect to address. The proxy credentials are never included.
+func TraceMessage(address string, proxyURL *url.URL) string {
+	if proxyURL == nil {
+		return fmt.Sprintf("Connecting to %s directly", address)
+	}
+	return fmt.Sprintf("Connecting to %s through proxy %s", address, proxyURL.Host)
+}
+
+func (settings Settings) useProxy(host string) bool {
+	if host == "localhost" {
+		return false
+	}
+
+	ip := net.ParseIP(host)
+	if ip != nil && ip.IsLoopback() {
+		return false
+	}
+
+	for _, entry := range strings.Split(settings.NoProxy, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		switch {
+		case entry == "":
+			continue
+		case entry == "*":
+			return false
+		}
+
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			if ip != nil && network.Contains(ip) {
+				return false
+			}
+			continue
+		}
+
+		if entryIP := net.ParseIP(entry); entryIP != nil {
+			if ip != nil && entryIP.Equal(ip) {
+				return false
+			}
+			continue
+		}
+
+		host = strings.ToLower(host)
+		if strings.HasPrefix(entry, ".") {
+			if strings.HasSuffix(host, entry) {
+				return false
+			}
+			continue
+		}
+		if host == entry || strings.HasSuffix(host, "."+entry) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Dialer opens TCP connections through the proxy in Settings, using HTTP
+// CONNECT tunneling, or directly when no proxy applies.
+type Dialer struct {
+	Settings Settings
+	Timeout  time.Duration
+
+	// Trace, when not nil, is called with a description of the proxy used
+	// for each connection.
+	Trace func(string)
+}
+
+// Dial connects to address on network.
+func (dialer Dialer) Dial(network string, address string) (net.Conn, error) {
+	proxyURL, err := dialer.Settings.ProxyURL(&url.URL{Scheme: network, Host: address})
+	if err != nil {
+		return nil, err
+	}
+
+	if dialer.Trace != nil {
+		dialer.Trace(TraceMessage(address, proxyURL))
+	}
+
+	if proxyURL == nil {
+		return net.DialTimeout(network, address, dialer.Timeout)
+	}
+
+	conn, err := net.DialTimeout("tcp", proxyURL.Host, dialer.Timeout)
+	if err != nil {
+		return nil, ProxyConnectError{ProxyHost: proxyURL.Host, Err: err}
+	}
+
+	tunnel, err := connect(conn, proxyURL, address, dialer.Timeout)
+	if err != nil {
+		_ = conn.Close()
+		return nil, ProxyConnectError{ProxyHost: proxyURL.Host, Err: err}
+	}
+
+	return tunnel, nil
+}
+
+func connect(conn net.Conn, proxyURL *url.URL, address string, timeout time.Duration) (net.Conn, error) {
+	if timeout > 0 {
+		err := conn.SetDeadline(time.Now().Add(timeout))
+		if err != nil {
+			return nil, err
+		}
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	request := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: http.Header{},
+	}
+	if user := proxyURL.User; user != nil {
+		password, _ := user.Password()
+		credential := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		request.Header.Set("Proxy-Authorization", "Basic "+credential)
+	}
+
+	err := request.Write(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	response, err := http.ReadResponse(reader, request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		_ = response.Body.Close()
+		return nil, fmt.Errorf("proxy responded with %s", response.Status)
+	}
+
+	// Servers such as SSH speak first, so their greeting may already be
+	// buffered along with the proxy's response.
+	return &tunnelConn{Conn: conn, reader: reader}, nil
+}
+
+type tunnelConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (conn *tunnelConn) Read(p []byte) (int, error) {
+	return conn.reader.Read(p)
+}
+
+func getEnvAny(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,117 @@
+// Package ratelimit throttles API requests using the rate limit headers sent
+// by the Cloud Controller, so that commands making many requests slow down
+// before the limit is reached instead of failing with 429 Too Many Requests.
+package ratelimit
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultThreshold is the number of remaining requests below which
+	// requests are spread out over the rest of the rate limit window.
+	DefaultThreshold = 20
+
+	// MaxWait caps how long a single request is delayed. A request rejected
+	// with a 429 is not retried when the limit resets later than this.
+	MaxWait = time.Minute
+
+	// MaxRetries is the number of times a request rejected with a 429 is
+	// retried.
+	MaxRetries = 3
+)
+
+// Throttle tracks the rate limit reported by the most recent response. It is
+// safe for concurrent use.
+type Throttle struct {
+	Threshold int
+
+	mutex     sync.Mutex
+	known     bool
+	remaining int
+	reset     time.Time
+}
+
+// Default is the throttle shared by every API client in the process, as the
+// Cloud Controller limits requests per user rather than per connection.
+var Default = NewThrottle(DefaultThreshold)
+
+func NewThrottle(threshold int) *Throttle {
+	return &Throttle{Threshold: threshold}
+}
+
+// Update records the X-RateLimit-Remaining and X-RateLimit-Reset headers of
+// a response. Responses without them, such as those from the UAA, are
+// ignored.
+func (throttle *Throttle) Update(header http.Header) {
+	remaining, reset, ok := parseHeaders(header)
+	if !ok {
+		return
+	}
+
+	throttle.mutex.Lock()
+	defer throttle.mutex.Unlock()
+	throttle.known = true
+	throttle.remaining = remaining
+	throttle.reset = reset
+}
+
+// Delay returns how long to wait before making the next request. It is zero
+// until the remaining requests drop below the threshold; after that the
+// remaining requests are spread evenly over the time left until the reset.
+func (throttle *Throttle) Delay(now time.Time) time.Duration {
+	throttle.mutex.Lock()
+	defer throttle.mutex.Unlock()
+
+	if !throttle.known || throttle.remaining >= throttle.Threshold || !now.Before(throttle.reset) {
+		return 0
+	}
+
+	wait := throttle.reset.Sub(now) / time.Duration(throttle.remaining+1)
+	if wait > MaxWait {
+		wait = MaxWait
+	}
+	return wait
+}
+
+// RetryDelay returns how long to wait before retrying a request rejected
+// with a 429, using the Retry-After header if present and the
+// X-RateLimit-Reset header otherwise. It returns false when neither header
+// is present or the wait would exceed MaxWait.
+func RetryDelay(header http.Header, now time.Time) (time.Duration, bool) {
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil && seconds >= 0 {
+		wait = time.Duration(seconds) * time.Second
+	} else if _, reset, ok := parseHeaders(header); ok {
+		wait = reset.Sub(now)
+	} else {
+		return 0, false
+	}
+
+	if wait < 0 {
+		wait = 0
+	}
+	if wait > MaxWait {
+		return wait, false
+	}
+	return wait, true
+}
+
+// parseHeaders returns the remaining requests and the time at which the
+// limit resets, given in seconds since the epoch.
+func parseHeaders(header http.Header) (int, time.Time, bool) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil || remaining < 0 {
+		return 0, time.Time{}, false
+	}
+
+	reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+
+	return remaining, time.Unix(reset, 0), true
+}
@@ -0,0 +1,106 @@
+package ratelimit_test
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/ratelimit"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func rateLimitHeader(remaining int, reset time.Time) http.Header {
+	return http.Header{
+		"X-Ratelimit-Remaining": {strconv.Itoa(remaining)},
+		"X-Ratelimit-Reset":     {strconv.FormatInt(reset.Unix(), 10)},
+	}
+}
+
+var _ = Describe("Throttle", func() {
+	var (
+		now      time.Time
+		throttle *Throttle
+	)
+
+	BeforeEach(func() {
+		now = time.Unix(1000, 0)
+		throttle = NewThrottle(20)
+	})
+
+	Describe("Delay", func() {
+		It("does not delay before any rate limit is known", func() {
+			Expect(throttle.Delay(now)).To(BeZero())
+		})
+
+		It("does not delay while the remaining requests are above the threshold", func() {
+			throttle.Update(rateLimitHeader(20, now.Add(time.Minute)))
+			Expect(throttle.Delay(now)).To(BeZero())
+		})
+
+		It("spreads the remaining requests over the time left once below the threshold", func() {
+			throttle.Update(rateLimitHeader(9, now.Add(10*time.Second)))
+			Expect(throttle.Delay(now)).To(Equal(time.Second))
+		})
+
+		It("waits until the reset when no requests remain", func() {
+			throttle.Update(rateLimitHeader(0, now.Add(30*time.Second)))
+			Expect(throttle.Delay(now)).To(Equal(30 * time.Second))
+		})
+
+		It("caps the delay at MaxWait", func() {
+			throttle.Update(rateLimitHeader(0, now.Add(time.Hour)))
+			Expect(throttle.Delay(now)).To(Equal(MaxWait))
+		})
+
+		It("does not delay once the limit has reset", func() {
+			throttle.Update(rateLimitHeader(0, now.Add(-time.Second)))
+			Expect(throttle.Delay(now)).To(BeZero())
+		})
+
+		It("ignores responses without rate limit headers", func() {
+			throttle.Update(rateLimitHeader(0, now.Add(30*time.Second)))
+			throttle.Update(http.Header{"X-Ratelimit-Remaining": {"100"}})
+			Expect(throttle.Delay(now)).To(Equal(30 * time.Second))
+		})
+	})
+})
+
+var _ = Describe("RetryDelay", func() {
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Unix(1000, 0)
+	})
+
+	It("waits for the Retry-After header when present", func() {
+		header := rateLimitHeader(0, now.Add(30*time.Second))
+		header.Set("Retry-After", "5")
+
+		wait, ok := RetryDelay(header, now)
+		Expect(ok).To(BeTrue())
+		Expect(wait).To(Equal(5 * time.Second))
+	})
+
+	It("waits until the reset otherwise", func() {
+		wait, ok := RetryDelay(rateLimitHeader(0, now.Add(30*time.Second)), now)
+		Expect(ok).To(BeTrue())
+		Expect(wait).To(Equal(30 * time.Second))
+	})
+
+	It("does not wait when the reset has passed", func() {
+		wait, ok := RetryDelay(rateLimitHeader(0, now.Add(-time.Second)), now)
+		Expect(ok).To(BeTrue())
+		Expect(wait).To(BeZero())
+	})
+
+	It("does not retry when the reset is later than MaxWait", func() {
+		_, ok := RetryDelay(rateLimitHeader(0, now.Add(time.Hour)), now)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("does not retry without rate limit headers", func() {
+		_, ok := RetryDelay(http.Header{}, now)
+		Expect(ok).To(BeFalse())
+	})
+})
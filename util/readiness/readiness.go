@@ -0,0 +1,88 @@
+// Package readiness polls an app's public route until the app is able to
+// serve requests.
+package readiness
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Attempt is the result of a single GET against the app.
+type Attempt struct {
+	URL        string
+	StatusCode int
+	Err        error
+}
+
+// TimeoutError is returned when the app did not return a 2xx status before
+// the timeout.
+type TimeoutError struct {
+	URL     string
+	Timeout time.Duration
+}
+
+func (e TimeoutError) Error() string {
+	return fmt.Sprintf("%s did not return a 2xx status within %s", e.URL, e.Timeout)
+}
+
+// Poller repeatedly GETs a URL until it returns a 2xx status.
+type Poller struct {
+	Client   *http.Client
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// NewPoller returns a Poller that waits interval between attempts and gives
+// up after timeout.
+func NewPoller(skipSSLValidation bool, interval time.Duration, timeout time.Duration) Poller {
+	return Poller{
+		Client: &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: skipSSLValidation,
+				},
+				Proxy: http.ProxyFromEnvironment,
+			},
+		},
+		Interval: interval,
+		Timeout:  timeout,
+	}
+}
+
+// URL returns the https URL of statusPath on the given route, where route is
+// a host, domain and optional path such as "app.example.com/api".
+func URL(route string, statusPath string) string {
+	return "https://" + strings.TrimSuffix(route, "/") + "/" + strings.TrimPrefix(statusPath, "/")
+}
+
+// Poll GETs url until it returns a 2xx status, calling report after every
+// attempt. It returns a TimeoutError when the timeout elapses first.
+func (p Poller) Poll(url string, report func(Attempt)) error {
+	deadline := time.Now().Add(p.Timeout)
+	for {
+		attempt := p.get(url)
+		report(attempt)
+		if attempt.Err == nil && attempt.StatusCode >= 200 && attempt.StatusCode < 300 {
+			return nil
+		}
+
+		if time.Now().Add(p.Interval).After(deadline) {
+			return TimeoutError{URL: url, Timeout: p.Timeout}
+		}
+		time.Sleep(p.Interval)
+	}
+}
+
+func (p Poller) get(url string) Attempt {
+	response, err := p.Client.Get(url)
+	if err != nil {
+		return Attempt{URL: url, Err: err}
+	}
+	defer response.Body.Close()
+
+	return Attempt{URL: url, StatusCode: response.StatusCode}
+}
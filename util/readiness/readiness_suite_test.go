@@ -0,0 +1,13 @@
+package readiness_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestReadiness(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Readiness Suite")
+}
@@ -0,0 +1,92 @@
+package readiness_test
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/readiness"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Poller", func() {
+	var (
+		server   *ghttp.Server
+		poller   Poller
+		url      string
+		attempts []Attempt
+		pollErr  error
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewTLSServer()
+		poller = NewPoller(true, time.Millisecond, 100*time.Millisecond)
+		url = URL(strings.TrimPrefix(server.URL(), "https://"), "/ready")
+		attempts = nil
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		pollErr = poller.Poll(url, func(attempt Attempt) {
+			attempts = append(attempts, attempt)
+		})
+	})
+
+	Context("when the app becomes ready", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/ready"),
+					ghttp.RespondWith(http.StatusServiceUnavailable, nil),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/ready"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("reports every attempt and stops at the first 2xx", func() {
+			Expect(pollErr).ToNot(HaveOccurred())
+			Expect(attempts).To(HaveLen(2))
+			Expect(attempts[0].StatusCode).To(Equal(http.StatusServiceUnavailable))
+			Expect(attempts[1].StatusCode).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Context("when the app never becomes ready", func() {
+		BeforeEach(func() {
+			server.RouteToHandler(http.MethodGet, "/ready", ghttp.RespondWith(http.StatusServiceUnavailable, nil))
+		})
+
+		It("returns a TimeoutError", func() {
+			Expect(pollErr).To(MatchError(TimeoutError{URL: url, Timeout: 100 * time.Millisecond}))
+			Expect(len(attempts)).To(BeNumerically(">", 1))
+		})
+	})
+
+	Context("when SSL validation is not skipped", func() {
+		BeforeEach(func() {
+			poller = NewPoller(false, time.Millisecond, 0)
+		})
+
+		It("reports the certificate error", func() {
+			Expect(pollErr).To(BeAssignableToTypeOf(TimeoutError{}))
+			Expect(attempts).To(HaveLen(1))
+			Expect(attempts[0].Err).To(HaveOccurred())
+		})
+	})
+})
+
+var _ = Describe("URL", func() {
+	It("joins the route and the status path", func() {
+		Expect(URL("app.example.com", "ready")).To(Equal("https://app.example.com/ready"))
+		Expect(URL("app.example.com/api/", "/ready")).To(Equal("https://app.example.com/api/ready"))
+	})
+})
@@ -0,0 +1,154 @@
+// Package rotatingfile appends to a file that is rotated once it reaches a
+// maximum size, keeping a limited number of rotated files next to it. Trace
+// files use it so they do not grow forever.
+package rotatingfile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Options configures the rotation of a File.
+type Options struct {
+	// MaxSize is the size in bytes a file may reach before it is rotated. Zero
+	// disables rotation.
+	MaxSize int64
+
+	// MaxFiles is the number of files kept, including the one being written
+	// to. Rotated files are named after the file with a ".1", ".2", ...
+	// suffix, ".1" being the most recent.
+	MaxFiles int
+}
+
+// File is an append-only file that is rotated according to its Options. It
+// is safe for concurrent use.
+type File struct {
+	path    string
+	options Options
+
+	mutex sync.Mutex
+	file  *os.File
+}
+
+// Open opens, creating it and its directory if needed, the file at path for
+// appending.
+func Open(path string, options Options) (*File, error) {
+	err := os.MkdirAll(filepath.Dir(path), os.ModeDir|os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &File{
+		path:    path,
+		options: options,
+	}
+	err = file.open()
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// Write appends p to the file, rotating the file first when p would take it
+// past MaxSize. p is written with a single write, so entries written with one
+// call each stay whole even when several processes append to the same file.
+func (f *File) Write(p []byte) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.options.MaxSize > 0 {
+		err := f.rotateIfNeeded(int64(len(p)))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return f.file.Write(p)
+}
+
+// Close closes the file.
+func (f *File) Close() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.file.Close()
+}
+
+func (f *File) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	f.file = file
+	return nil
+}
+
+func (f *File) rotateIfNeeded(incomingSize int64) error {
+	openInfo, err := f.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	// Another process sharing the file may have rotated it already, in which
+	// case the file at path is the one to append to.
+	pathInfo, err := os.Stat(f.path)
+	if err != nil || !os.SameFile(openInfo, pathInfo) {
+		err = f.reopen()
+		if err != nil {
+			return err
+		}
+		pathInfo, err = f.file.Stat()
+		if err != nil {
+			return err
+		}
+	}
+
+	if pathInfo.Size() == 0 || pathInfo.Size()+incomingSize <= f.options.MaxSize {
+		return nil
+	}
+	return f.rotate()
+}
+
+func (f *File) reopen() error {
+	err := f.file.Close()
+	if err != nil {
+		return err
+	}
+	return f.open()
+}
+
+func (f *File) rotate() error {
+	err := f.file.Close()
+	if err != nil {
+		return err
+	}
+
+	maxFiles := f.options.MaxFiles
+	if maxFiles < 1 {
+		maxFiles = 1
+	}
+
+	err = os.Remove(f.rotatedPath(maxFiles - 1))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := maxFiles - 1; i > 0; i-- {
+		err = os.Rename(f.rotatedPath(i-1), f.rotatedPath(i))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return f.open()
+}
+
+// rotatedPath returns the path of the i-th most recent rotated file, the
+// file being written to being the 0th.
+func (f *File) rotatedPath(i int) string {
+	if i == 0 {
+		return f.path
+	}
+	return fmt.Sprintf("%s.%d", f.path, i)
+}
@@ -0,0 +1,148 @@
+package rotatingfile_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/util/rotatingfile"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("File", func() {
+	var (
+		tmpdir string
+		path   string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpdir, err = ioutil.TempDir("", "rotatingfile-test")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(tmpdir, "some-dir", "trace.log")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpdir)).To(Succeed())
+	})
+
+	readFile := func(path string) string {
+		contents, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		return string(contents)
+	}
+
+	write := func(file *File, text string) {
+		_, err := file.Write([]byte(text))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	Context("when rotation is disabled", func() {
+		It("creates the directory and appends to the file", func() {
+			Expect(ioutil.WriteFile(filepath.Join(tmpdir, "existing.log"), []byte("existing\n"), 0600)).To(Succeed())
+
+			file, err := Open(filepath.Join(tmpdir, "existing.log"), Options{})
+			Expect(err).ToNot(HaveOccurred())
+			write(file, "some-text\n")
+			Expect(file.Close()).To(Succeed())
+
+			Expect(readFile(filepath.Join(tmpdir, "existing.log"))).To(Equal("existing\nsome-text\n"))
+
+			file, err = Open(path, Options{})
+			Expect(err).ToNot(HaveOccurred())
+			write(file, strings.Repeat("a", 100))
+			Expect(file.Close()).To(Succeed())
+			Expect(readFile(path)).To(HaveLen(100))
+		})
+	})
+
+	Context("when the file would grow past the maximum size", func() {
+		It("rotates the file and keeps at most MaxFiles files", func() {
+			file, err := Open(path, Options{MaxSize: 10, MaxFiles: 3})
+			Expect(err).ToNot(HaveOccurred())
+			defer file.Close()
+
+			write(file, "first\n")
+			write(file, "second\n")
+			write(file, "third\n")
+			write(file, "fourth\n")
+
+			Expect(readFile(path)).To(Equal("fourth\n"))
+			Expect(readFile(path + ".1")).To(Equal("third\n"))
+			Expect(readFile(path + ".2")).To(Equal("second\n"))
+			_, err = os.Stat(path + ".3")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("does not rotate an empty file, even for an entry bigger than the maximum size", func() {
+			file, err := Open(path, Options{MaxSize: 10, MaxFiles: 3})
+			Expect(err).ToNot(HaveOccurred())
+			defer file.Close()
+
+			write(file, "some-entry-longer-than-10-bytes\n")
+
+			Expect(readFile(path)).To(Equal("some-entry-longer-than-10-bytes\n"))
+			_, err = os.Stat(path + ".1")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		Context("when MaxFiles is 1", func() {
+			It("starts the file over", func() {
+				file, err := Open(path, Options{MaxSize: 10, MaxFiles: 1})
+				Expect(err).ToNot(HaveOccurred())
+				defer file.Close()
+
+				write(file, "first\n")
+				write(file, "second\n")
+
+				Expect(readFile(path)).To(Equal("second\n"))
+				_, err = os.Stat(path + ".1")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Context("when another writer has rotated the file", func() {
+		It("appends to the new file", func() {
+			file, err := Open(path, Options{MaxSize: 100, MaxFiles: 2})
+			Expect(err).ToNot(HaveOccurred())
+			defer file.Close()
+			write(file, "first\n")
+
+			otherFile, err := Open(path, Options{MaxSize: 10, MaxFiles: 2})
+			Expect(err).ToNot(HaveOccurred())
+			write(otherFile, "second\n")
+			Expect(otherFile.Close()).To(Succeed())
+
+			write(file, "third\n")
+
+			Expect(readFile(path)).To(Equal("second\nthird\n"))
+			Expect(readFile(path + ".1")).To(Equal("first\n"))
+		})
+	})
+
+	Context("when writing concurrently", func() {
+		It("keeps every write whole", func() {
+			file, err := Open(path, Options{})
+			Expect(err).ToNot(HaveOccurred())
+			defer file.Close()
+
+			entry := strings.Repeat("x", 4096) + "\n"
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					write(file, entry)
+				}()
+			}
+			wg.Wait()
+
+			Expect(readFile(path)).To(Equal(strings.Repeat(entry, 20)))
+		})
+	})
+})
@@ -0,0 +1,122 @@
+package spellcheck
+
+import "sort"
+
+// MaxSuggestions is the most commands suggested for a mistyped command.
+const MaxSuggestions = 3
+
+// RankedCommandSuggester suggests the built-in and plugin commands, names
+// and aliases alike, closest to a mistyped command.
+type RankedCommandSuggester struct {
+	builtinCmds []string
+	pluginCmds  []string
+}
+
+func NewRankedCommandSuggester(builtinCmds []string, pluginCmds []string) RankedCommandSuggester {
+	return RankedCommandSuggester{
+		builtinCmds: builtinCmds,
+		pluginCmds:  pluginCmds,
+	}
+}
+
+type suggestion struct {
+	name     string
+	distance int
+	builtin  bool
+}
+
+// Recommend returns up to MaxSuggestions commands sorted by edit distance.
+// At the same distance built-in commands come before plugin commands, then
+// names are sorted alphabetically. The allowed distance grows with the
+// length of cmd, so that very short input is not matched against every
+// alias.
+func (s RankedCommandSuggester) Recommend(cmd string) []string {
+	maxDistance := len(cmd) / 3
+	if maxDistance > 2 {
+		maxDistance = 2
+	}
+
+	seen := map[string]bool{}
+	var suggestions []suggestion
+	add := func(names []string, builtin bool) {
+		for _, name := range names {
+			if name == "" || seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			distance := editDistance(cmd, name)
+			if distance > 0 && distance <= maxDistance {
+				suggestions = append(suggestions, suggestion{name: name, distance: distance, builtin: builtin})
+			}
+		}
+	}
+	add(s.builtinCmds, true)
+	add(s.pluginCmds, false)
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		switch {
+		case suggestions[i].distance != suggestions[j].distance:
+			return suggestions[i].distance < suggestions[j].distance
+		case suggestions[i].builtin != suggestions[j].builtin:
+			return suggestions[i].builtin
+		default:
+			return suggestions[i].name < suggestions[j].name
+		}
+	})
+
+	if len(suggestions) > MaxSuggestions {
+		suggestions = suggestions[:MaxSuggestions]
+	}
+
+	names := []string{}
+	for _, suggestion := range suggestions {
+		names = append(names, suggestion.name)
+	}
+	return names
+}
+
+// editDistance is the number of insertions, deletions, substitutions and
+// transpositions of adjacent characters needed to turn a into b, so that
+// swapping two letters, as in "psuh", counts as a single typo.
+func editDistance(a string, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	distances := make([][]int, len(ra)+1)
+	for i := range distances {
+		distances[i] = make([]int, len(rb)+1)
+		distances[i][0] = i
+	}
+	for j := range distances[0] {
+		distances[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			distances[i][j] = min(
+				distances[i-1][j]+1,
+				distances[i][j-1]+1,
+				distances[i-1][j-1]+cost,
+			)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				distances[i][j] = min(distances[i][j], distances[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return distances[len(ra)][len(rb)]
+}
+
+func min(values ...int) int {
+	minimum := values[0]
+	for _, value := range values[1:] {
+		if value < minimum {
+			minimum = value
+		}
+	}
+	return minimum
+}
@@ -26,3 +26,46 @@ var _ = Describe("Spellcheck", func() {
 		})
 	})
 })
+
+var _ = Describe("RankedCommandSuggester", func() {
+	var suggester RankedCommandSuggester
+
+	BeforeEach(func() {
+		suggester = NewRankedCommandSuggester(
+			[]string{"push", "p", "login", "logs", "logout", "apps", "a"},
+			[]string{"pash", "pusher", "blue-green-deploy", "bgd", "push"},
+		)
+	})
+
+	It("suggests commands with swapped letters", func() {
+		Expect(suggester.Recommend("psuh")).To(Equal([]string{"push"}))
+	})
+
+	It("suggests plugin commands and aliases", func() {
+		Expect(suggester.Recommend("blue-gren-deploy")).To(Equal([]string{"blue-green-deploy"}))
+		Expect(suggester.Recommend("bdg")).To(Equal([]string{"bgd"}))
+	})
+
+	It("lists built-in commands before plugin commands at the same distance", func() {
+		Expect(suggester.Recommend("pish")).To(Equal([]string{"push", "pash"}))
+	})
+
+	It("suggests at most three commands sorted by distance", func() {
+		suggester = NewRankedCommandSuggester([]string{"aaaaaa", "aaaabb", "aaaaab", "aaaaac"}, nil)
+		Expect(suggester.Recommend("aaaaaz")).To(Equal([]string{"aaaaaa", "aaaaab", "aaaaac"}))
+		Expect(suggester.Recommend("aaaabz")).To(Equal([]string{"aaaabb", "aaaaaa", "aaaaab"}))
+	})
+
+	It("does not suggest commands for very short input", func() {
+		Expect(suggester.Recommend("x")).To(BeEmpty())
+		Expect(suggester.Recommend("")).To(BeEmpty())
+	})
+
+	It("suggests commands whose names are one typo away", func() {
+		Expect(suggester.Recommend("logn")).To(Equal([]string{"login", "logs"}))
+	})
+
+	It("does not suggest commands that are not close", func() {
+		Expect(suggester.Recommend("zzz")).To(BeEmpty())
+	})
+})
@@ -370,6 +370,54 @@ type FakeHandlers struct {
 	getServiceReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetAppV3Stub        func(appName string, retVal *plugin_models.GetAppV3Model) error
+	getAppV3Mutex       sync.RWMutex
+	getAppV3ArgsForCall []struct {
+		appName string
+		retVal  *plugin_models.GetAppV3Model
+	}
+	getAppV3Returns struct {
+		result1 error
+	}
+	getAppV3ReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IsMinApiVersionStub        func(passedVersion string, retVal *bool) error
+	isMinApiVersionMutex       sync.RWMutex
+	isMinApiVersionArgsForCall []struct {
+		passedVersion string
+		retVal        *bool
+	}
+	isMinApiVersionReturns struct {
+		result1 error
+	}
+	isMinApiVersionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AccessTokenWithRefreshStub        func(minValidSeconds int, retVal *string) error
+	accessTokenWithRefreshMutex       sync.RWMutex
+	accessTokenWithRefreshArgsForCall []struct {
+		minValidSeconds int
+		retVal          *string
+	}
+	accessTokenWithRefreshReturns struct {
+		result1 error
+	}
+	accessTokenWithRefreshReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RefreshAccessTokenStub        func(args string, retVal *string) error
+	refreshAccessTokenMutex       sync.RWMutex
+	refreshAccessTokenArgsForCall []struct {
+		args   string
+		retVal *string
+	}
+	refreshAccessTokenReturns struct {
+		result1 error
+	}
+	refreshAccessTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1859,6 +1907,202 @@ func (fake *FakeHandlers) GetServiceReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetAppV3(appName string, retVal *plugin_models.GetAppV3Model) error {
+	fake.getAppV3Mutex.Lock()
+	ret, specificReturn := fake.getAppV3ReturnsOnCall[len(fake.getAppV3ArgsForCall)]
+	fake.getAppV3ArgsForCall = append(fake.getAppV3ArgsForCall, struct {
+		appName string
+		retVal  *plugin_models.GetAppV3Model
+	}{appName, retVal})
+	fake.recordInvocation("GetAppV3", []interface{}{appName, retVal})
+	fake.getAppV3Mutex.Unlock()
+	if fake.GetAppV3Stub != nil {
+		return fake.GetAppV3Stub(appName, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getAppV3Returns.result1
+}
+
+func (fake *FakeHandlers) GetAppV3CallCount() int {
+	fake.getAppV3Mutex.RLock()
+	defer fake.getAppV3Mutex.RUnlock()
+	return len(fake.getAppV3ArgsForCall)
+}
+
+func (fake *FakeHandlers) GetAppV3ArgsForCall(i int) (string, *plugin_models.GetAppV3Model) {
+	fake.getAppV3Mutex.RLock()
+	defer fake.getAppV3Mutex.RUnlock()
+	return fake.getAppV3ArgsForCall[i].appName, fake.getAppV3ArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetAppV3Returns(result1 error) {
+	fake.GetAppV3Stub = nil
+	fake.getAppV3Returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetAppV3ReturnsOnCall(i int, result1 error) {
+	fake.GetAppV3Stub = nil
+	if fake.getAppV3ReturnsOnCall == nil {
+		fake.getAppV3ReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getAppV3ReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) IsMinApiVersion(passedVersion string, retVal *bool) error {
+	fake.isMinApiVersionMutex.Lock()
+	ret, specificReturn := fake.isMinApiVersionReturnsOnCall[len(fake.isMinApiVersionArgsForCall)]
+	fake.isMinApiVersionArgsForCall = append(fake.isMinApiVersionArgsForCall, struct {
+		passedVersion string
+		retVal        *bool
+	}{passedVersion, retVal})
+	fake.recordInvocation("IsMinApiVersion", []interface{}{passedVersion, retVal})
+	fake.isMinApiVersionMutex.Unlock()
+	if fake.IsMinApiVersionStub != nil {
+		return fake.IsMinApiVersionStub(passedVersion, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isMinApiVersionReturns.result1
+}
+
+func (fake *FakeHandlers) IsMinApiVersionCallCount() int {
+	fake.isMinApiVersionMutex.RLock()
+	defer fake.isMinApiVersionMutex.RUnlock()
+	return len(fake.isMinApiVersionArgsForCall)
+}
+
+func (fake *FakeHandlers) IsMinApiVersionArgsForCall(i int) (string, *bool) {
+	fake.isMinApiVersionMutex.RLock()
+	defer fake.isMinApiVersionMutex.RUnlock()
+	return fake.isMinApiVersionArgsForCall[i].passedVersion, fake.isMinApiVersionArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) IsMinApiVersionReturns(result1 error) {
+	fake.IsMinApiVersionStub = nil
+	fake.isMinApiVersionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) IsMinApiVersionReturnsOnCall(i int, result1 error) {
+	fake.IsMinApiVersionStub = nil
+	if fake.isMinApiVersionReturnsOnCall == nil {
+		fake.isMinApiVersionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.isMinApiVersionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) AccessTokenWithRefresh(minValidSeconds int, retVal *string) error {
+	fake.accessTokenWithRefreshMutex.Lock()
+	ret, specificReturn := fake.accessTokenWithRefreshReturnsOnCall[len(fake.accessTokenWithRefreshArgsForCall)]
+	fake.accessTokenWithRefreshArgsForCall = append(fake.accessTokenWithRefreshArgsForCall, struct {
+		minValidSeconds int
+		retVal          *string
+	}{minValidSeconds, retVal})
+	fake.recordInvocation("AccessTokenWithRefresh", []interface{}{minValidSeconds, retVal})
+	fake.accessTokenWithRefreshMutex.Unlock()
+	if fake.AccessTokenWithRefreshStub != nil {
+		return fake.AccessTokenWithRefreshStub(minValidSeconds, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.accessTokenWithRefreshReturns.result1
+}
+
+func (fake *FakeHandlers) AccessTokenWithRefreshCallCount() int {
+	fake.accessTokenWithRefreshMutex.RLock()
+	defer fake.accessTokenWithRefreshMutex.RUnlock()
+	return len(fake.accessTokenWithRefreshArgsForCall)
+}
+
+func (fake *FakeHandlers) AccessTokenWithRefreshArgsForCall(i int) (int, *string) {
+	fake.accessTokenWithRefreshMutex.RLock()
+	defer fake.accessTokenWithRefreshMutex.RUnlock()
+	return fake.accessTokenWithRefreshArgsForCall[i].minValidSeconds, fake.accessTokenWithRefreshArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) AccessTokenWithRefreshReturns(result1 error) {
+	fake.AccessTokenWithRefreshStub = nil
+	fake.accessTokenWithRefreshReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) AccessTokenWithRefreshReturnsOnCall(i int, result1 error) {
+	fake.AccessTokenWithRefreshStub = nil
+	if fake.accessTokenWithRefreshReturnsOnCall == nil {
+		fake.accessTokenWithRefreshReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.accessTokenWithRefreshReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) RefreshAccessToken(args string, retVal *string) error {
+	fake.refreshAccessTokenMutex.Lock()
+	ret, specificReturn := fake.refreshAccessTokenReturnsOnCall[len(fake.refreshAccessTokenArgsForCall)]
+	fake.refreshAccessTokenArgsForCall = append(fake.refreshAccessTokenArgsForCall, struct {
+		args   string
+		retVal *string
+	}{args, retVal})
+	fake.recordInvocation("RefreshAccessToken", []interface{}{args, retVal})
+	fake.refreshAccessTokenMutex.Unlock()
+	if fake.RefreshAccessTokenStub != nil {
+		return fake.RefreshAccessTokenStub(args, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.refreshAccessTokenReturns.result1
+}
+
+func (fake *FakeHandlers) RefreshAccessTokenCallCount() int {
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	return len(fake.refreshAccessTokenArgsForCall)
+}
+
+func (fake *FakeHandlers) RefreshAccessTokenArgsForCall(i int) (string, *string) {
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
+	return fake.refreshAccessTokenArgsForCall[i].args, fake.refreshAccessTokenArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) RefreshAccessTokenReturns(result1 error) {
+	fake.RefreshAccessTokenStub = nil
+	fake.refreshAccessTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) RefreshAccessTokenReturnsOnCall(i int, result1 error) {
+	fake.RefreshAccessTokenStub = nil
+	if fake.refreshAccessTokenReturnsOnCall == nil {
+		fake.refreshAccessTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.refreshAccessTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1922,6 +2166,14 @@ func (fake *FakeHandlers) Invocations() map[string][][]interface{} {
 	defer fake.getSpaceMutex.RUnlock()
 	fake.getServiceMutex.RLock()
 	defer fake.getServiceMutex.RUnlock()
+	fake.getAppV3Mutex.RLock()
+	defer fake.getAppV3Mutex.RUnlock()
+	fake.isMinApiVersionMutex.RLock()
+	defer fake.isMinApiVersionMutex.RUnlock()
+	fake.accessTokenWithRefreshMutex.RLock()
+	defer fake.accessTokenWithRefreshMutex.RUnlock()
+	fake.refreshAccessTokenMutex.RLock()
+	defer fake.refreshAccessTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -33,10 +33,14 @@ type Handlers interface {
 	ApiEndpoint(args string, retVal *string) error
 	HasAPIEndpoint(args string, retVal *bool) error
 	ApiVersion(args string, retVal *string) error
+	IsMinApiVersion(passedVersion string, retVal *bool) error
 	LoggregatorEndpoint(args string, retVal *string) error
 	DopplerEndpoint(args string, retVal *string) error
 	AccessToken(args string, retVal *string) error
+	AccessTokenWithRefresh(minValidSeconds int, retVal *string) error
+	RefreshAccessToken(args string, retVal *string) error
 	GetApp(appName string, retVal *plugin_models.GetAppModel) error
+	GetAppV3(appName string, retVal *plugin_models.GetAppV3Model) error
 	GetApps(args string, retVal *[]plugin_models.GetAppsModel) error
 	GetOrgs(args string, retVal *[]plugin_models.GetOrgs_Model) error
 	GetSpaces(args string, retVal *[]plugin_models.GetSpaces_Model) error
@@ -0,0 +1,203 @@
+// Package timings records the API requests a command makes so that a summary
+// of where the time went can be displayed when the command finishes.
+// Recording is always on; only displaying the summary is opt-in.
+package timings
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// slowestRequestCount is the number of slowest requests listed in a summary.
+const slowestRequestCount = 3
+
+// Request is a single round trip to an API.
+type Request struct {
+	Method   string
+	Host     string
+	Path     string
+	Start    time.Time
+	Duration time.Duration
+}
+
+// Collector accumulates the requests made since the collector was created.
+// It is safe for concurrent use.
+type Collector struct {
+	start    time.Time
+	mutex    sync.Mutex
+	requests []Request
+}
+
+// Default is the collector shared by every connection in the process. It is
+// created when the process starts, so its wall time covers the whole command.
+var Default = NewCollector(time.Now())
+
+func NewCollector(start time.Time) *Collector {
+	return &Collector{start: start}
+}
+
+// Record adds a request to the collector.
+func (collector *Collector) Record(request Request) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	collector.requests = append(collector.requests, request)
+}
+
+// Summary totals the requests recorded up to now.
+func (collector *Collector) Summary(now time.Time) Summary {
+	collector.mutex.Lock()
+	requests := make([]Request, len(collector.requests))
+	copy(requests, collector.requests)
+	collector.mutex.Unlock()
+
+	summary := Summary{
+		WallTime:       now.Sub(collector.start),
+		RequestCount:   len(requests),
+		RequestsByHost: map[string]int{},
+	}
+	for _, request := range requests {
+		summary.RequestsByHost[request.Host]++
+	}
+
+	// Requests made in parallel overlap, so the API time is the length of
+	// the union of the request intervals rather than the sum of durations.
+	sort.Slice(requests, func(i, j int) bool { return requests[i].Start.Before(requests[j].Start) })
+	var intervalEnd time.Time
+	for _, request := range requests {
+		end := request.Start.Add(request.Duration)
+		switch {
+		case !request.Start.Before(intervalEnd):
+			summary.APITime += request.Duration
+			intervalEnd = end
+		case end.After(intervalEnd):
+			summary.APITime += end.Sub(intervalEnd)
+			intervalEnd = end
+		}
+	}
+
+	summary.LocalTime = summary.WallTime - summary.APITime
+	if summary.LocalTime < 0 {
+		summary.LocalTime = 0
+	}
+
+	sort.SliceStable(requests, func(i, j int) bool { return requests[i].Duration > requests[j].Duration })
+	if len(requests) > slowestRequestCount {
+		requests = requests[:slowestRequestCount]
+	}
+	summary.SlowestRequests = requests
+
+	return summary
+}
+
+// Summary is the totals displayed at the end of a command.
+type Summary struct {
+	WallTime        time.Duration
+	APITime         time.Duration
+	LocalTime       time.Duration
+	RequestCount    int
+	RequestsByHost  map[string]int
+	SlowestRequests []Request
+}
+
+// Write displays the summary as a footer.
+func (summary Summary) Write(writer io.Writer) error {
+	hosts := make([]string, 0, len(summary.RequestsByHost))
+	for host := range summary.RequestsByHost {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	lines := []string{
+		"",
+		"TIMINGS:",
+		fmt.Sprintf("  total time:  %s", round(summary.WallTime)),
+		fmt.Sprintf("  API time:    %s (%d requests)", round(summary.APITime), summary.RequestCount),
+		fmt.Sprintf("  local time:  %s", round(summary.LocalTime)),
+	}
+
+	if len(hosts) > 0 {
+		lines = append(lines, "  requests by host:")
+		for _, host := range hosts {
+			lines = append(lines, fmt.Sprintf("    %s: %d", host, summary.RequestsByHost[host]))
+		}
+	}
+
+	if len(summary.SlowestRequests) > 0 {
+		lines = append(lines, "  slowest requests:")
+		for _, request := range summary.SlowestRequests {
+			lines = append(lines, fmt.Sprintf("    %s  %s %s%s", round(request.Duration), request.Method, request.Host, request.Path))
+		}
+	}
+
+	for _, line := range lines {
+		_, err := fmt.Fprintln(writer, line)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func round(duration time.Duration) time.Duration {
+	return duration - duration%time.Millisecond
+}
+
+// Enabled reports whether the summary should be displayed: when the command
+// is verbose, or when CF_TIMINGS is set to a true value.
+func Enabled(verbose bool) bool {
+	if verbose {
+		return true
+	}
+	enabled, _ := strconv.ParseBool(os.Getenv("CF_TIMINGS"))
+	return enabled
+}
+
+// Display writes the summary of the Default collector to stderr, so that
+// the footer never mixes with output meant for other programs, when
+// Enabled(verbose) is true. Commands that made no requests, such as
+// 'cf -v', have nothing to summarize.
+func Display(verbose bool) {
+	if !Enabled(verbose) {
+		return
+	}
+
+	summary := Default.Summary(time.Now())
+	if summary.RequestCount == 0 {
+		return
+	}
+	_ = summary.Write(os.Stderr)
+}
+
+// Transport is an http.RoundTripper that records every round trip in a
+// collector.
+type Transport struct {
+	Base      http.RoundTripper
+	Collector *Collector
+}
+
+// NewTransport returns a Transport that records into the Default collector.
+func NewTransport(base http.RoundTripper) *Transport {
+	return &Transport{
+		Base:      base,
+		Collector: Default,
+	}
+}
+
+func (transport *Transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	start := time.Now()
+	response, err := transport.Base.RoundTrip(request)
+	transport.Collector.Record(Request{
+		Method:   request.Method,
+		Host:     request.URL.Host,
+		Path:     request.URL.Path,
+		Start:    start,
+		Duration: time.Since(start),
+	})
+	return response, err
+}
@@ -0,0 +1,13 @@
+package timings_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestTimings(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Timings Suite")
+}
@@ -0,0 +1,131 @@
+package timings_test
+
+import (
+	"net/http"
+	"os"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/timings"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Collector", func() {
+	var (
+		start     time.Time
+		collector *Collector
+	)
+
+	BeforeEach(func() {
+		start = time.Now()
+		collector = NewCollector(start)
+	})
+
+	Describe("Summary", func() {
+		var summary Summary
+
+		BeforeEach(func() {
+			collector.Record(Request{Method: "GET", Host: "api.example.com", Path: "/v2/apps", Start: start, Duration: 2 * time.Second})
+			collector.Record(Request{Method: "GET", Host: "api.example.com", Path: "/v2/spaces", Start: start.Add(time.Second), Duration: 2 * time.Second})
+			collector.Record(Request{Method: "POST", Host: "uaa.example.com", Path: "/oauth/token", Start: start.Add(5 * time.Second), Duration: time.Second})
+			collector.Record(Request{Method: "GET", Host: "api.example.com", Path: "/v2/info", Start: start.Add(7 * time.Second), Duration: 500 * time.Millisecond})
+		})
+
+		JustBeforeEach(func() {
+			summary = collector.Summary(start.Add(10 * time.Second))
+		})
+
+		It("totals the requests by host", func() {
+			Expect(summary.WallTime).To(Equal(10 * time.Second))
+			Expect(summary.RequestCount).To(Equal(4))
+			Expect(summary.RequestsByHost).To(Equal(map[string]int{
+				"api.example.com": 3,
+				"uaa.example.com": 1,
+			}))
+		})
+
+		It("does not count overlapping requests twice", func() {
+			Expect(summary.APITime).To(Equal(4500 * time.Millisecond))
+			Expect(summary.LocalTime).To(Equal(5500 * time.Millisecond))
+		})
+
+		It("lists the three slowest requests", func() {
+			Expect(summary.SlowestRequests).To(HaveLen(3))
+			Expect(summary.SlowestRequests[0].Path).To(Equal("/v2/apps"))
+			Expect(summary.SlowestRequests[1].Path).To(Equal("/v2/spaces"))
+			Expect(summary.SlowestRequests[2].Path).To(Equal("/oauth/token"))
+		})
+
+		It("writes the summary as a footer", func() {
+			buffer := NewBuffer()
+			Expect(summary.Write(buffer)).To(Succeed())
+
+			Expect(buffer).To(Say("TIMINGS:"))
+			Expect(buffer).To(Say(`total time:\s+10s`))
+			Expect(buffer).To(Say(`API time:\s+4.5s \(4 requests\)`))
+			Expect(buffer).To(Say(`local time:\s+5.5s`))
+			Expect(buffer).To(Say("requests by host:"))
+			Expect(buffer).To(Say("api.example.com: 3"))
+			Expect(buffer).To(Say("uaa.example.com: 1"))
+			Expect(buffer).To(Say("slowest requests:"))
+			Expect(buffer).To(Say("2s  GET api.example.com/v2/apps"))
+			Expect(buffer).To(Say("2s  GET api.example.com/v2/spaces"))
+			Expect(buffer).To(Say("1s  POST uaa.example.com/oauth/token"))
+		})
+	})
+})
+
+var _ = Describe("Transport", func() {
+	var (
+		server    *ghttp.Server
+		collector *Collector
+		client    *http.Client
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		server.AppendHandlers(ghttp.RespondWith(http.StatusOK, nil))
+
+		collector = NewCollector(time.Now())
+		transport := NewTransport(http.DefaultTransport)
+		transport.Collector = collector
+		client = &http.Client{Transport: transport}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("records every round trip", func() {
+		response, err := client.Get(server.URL() + "/v2/info?some=query")
+		Expect(err).ToNot(HaveOccurred())
+		response.Body.Close()
+
+		summary := collector.Summary(time.Now())
+		Expect(summary.RequestCount).To(Equal(1))
+		Expect(summary.SlowestRequests[0].Method).To(Equal("GET"))
+		Expect(summary.SlowestRequests[0].Host).To(Equal(server.Addr()))
+		Expect(summary.SlowestRequests[0].Path).To(Equal("/v2/info"))
+	})
+})
+
+var _ = Describe("Enabled", func() {
+	AfterEach(func() {
+		Expect(os.Unsetenv("CF_TIMINGS")).To(Succeed())
+	})
+
+	It("is enabled for verbose commands", func() {
+		Expect(Enabled(true)).To(BeTrue())
+	})
+
+	It("is disabled by default", func() {
+		Expect(Enabled(false)).To(BeFalse())
+	})
+
+	It("is enabled by CF_TIMINGS", func() {
+		Expect(os.Setenv("CF_TIMINGS", "1")).To(Succeed())
+		Expect(Enabled(false)).To(BeTrue())
+	})
+})
@@ -59,6 +59,22 @@ var _ = Describe("i18n", func() {
 				Entry("Chinese (Traditional and using Hong Kong terms)", "zh-HK", "\n已啟動應用程式\n"),
 			)
 
+			DescribeTable("translates the strings of the v2 and v3 commands",
+				func(locale string, expectedTranslation string) {
+					fakeConfig.LocaleReturns(locale)
+
+					translationFunc, err := GetTranslationFunc(fakeConfig)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(translationFunc("Select {{.Label}}:", map[string]interface{}{"Label": "org"})).To(Equal(expectedTranslation))
+				},
+
+				Entry("English", "en-US", "Select org:"),
+				Entry("French", "fr-FR", "Sélectionnez org :"),
+				Entry("Japanese", "ja-JP", "org を選択してください:"),
+				Entry("German, which is not translated yet", "de-DE", "Select org:"),
+			)
+
 			Context("when provided keys to iterpolate", func() {
 				BeforeEach(func() {
 					fakeConfig.LocaleReturns("fr-FR")
@@ -4,18 +4,23 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"os"
-	"path/filepath"
 	"regexp"
 	"sync"
 	"time"
+
+	"code.cloudfoundry.org/cli/util/rotatingfile"
 )
 
+// RequestLoggerFileWriter writes request logging to files. Everything
+// displayed between Start and Stop is buffered and written to each file with
+// a single write, so the entries of processes sharing a file do not
+// interleave.
 type RequestLoggerFileWriter struct {
 	ui            *UI
 	lock          *sync.Mutex
 	filePaths     []string
-	logFiles      []*os.File
+	logFiles      []*rotatingfile.File
+	entry         *bytes.Buffer
 	dumpSanitizer *regexp.Regexp
 }
 
@@ -24,33 +29,33 @@ func newRequestLoggerFileWriter(ui *UI, lock *sync.Mutex, filePaths []string) *R
 		ui:            ui,
 		lock:          lock,
 		filePaths:     filePaths,
-		logFiles:      []*os.File{},
+		logFiles:      []*rotatingfile.File{},
+		entry:         new(bytes.Buffer),
 		dumpSanitizer: regexp.MustCompile(tokenRegexp),
 	}
 }
 
-func (display *RequestLoggerFileWriter) DisplayBody([]byte) error {
-	for _, logFile := range display.logFiles {
-		_, err := logFile.WriteString(RedactedValue)
-		if err != nil {
-			return err
-		}
+func (display *RequestLoggerFileWriter) DisplayBody(body []byte) error {
+	output := RedactedValue
+	if display.ui.ShowTraceSecrets {
+		output = string(body)
 	}
+	display.entry.WriteString(output)
 	return nil
 }
 
 func (display *RequestLoggerFileWriter) DisplayDump(dump string) error {
-	sanitized := display.dumpSanitizer.ReplaceAllString(dump, RedactedValue)
-	for _, logFile := range display.logFiles {
-		_, err := logFile.WriteString(sanitized)
-		if err != nil {
-			return err
-		}
+	if !display.ui.ShowTraceSecrets {
+		dump = display.dumpSanitizer.ReplaceAllString(dump, RedactedValue)
 	}
+	display.entry.WriteString(dump)
 	return nil
 }
 
 func (display *RequestLoggerFileWriter) DisplayHeader(name string, value string) error {
+	if !display.ui.ShowTraceSecrets {
+		value = sanitizeHeader(name, value)
+	}
 	return display.DisplayMessage(fmt.Sprintf("%s: %s", name, value))
 }
 
@@ -63,9 +68,13 @@ func (display *RequestLoggerFileWriter) DisplayJSONBody(body []byte) error {
 		return nil
 	}
 
+	if display.ui.ShowTraceSecrets {
+		return display.DisplayMessage(string(body))
+	}
+
 	sanitized, err := SanitizeJSON(body)
 	if err != nil {
-		return display.DisplayMessage(string(body))
+		return display.DisplayMessage(sanitizeJSONText(string(body)))
 	}
 
 	buff := new(bytes.Buffer)
@@ -77,22 +86,12 @@ func (display *RequestLoggerFileWriter) DisplayJSONBody(body []byte) error {
 		return err
 	}
 
-	for _, logFile := range display.logFiles {
-		_, err = logFile.Write(buff.Bytes())
-		if err != nil {
-			return err
-		}
-	}
+	display.entry.Write(buff.Bytes())
 	return nil
 }
 
 func (display *RequestLoggerFileWriter) DisplayMessage(msg string) error {
-	for _, logFile := range display.logFiles {
-		_, err := logFile.WriteString(fmt.Sprintf("%s\n", msg))
-		if err != nil {
-			return err
-		}
-	}
+	fmt.Fprintf(display.entry, "%s\n", msg)
 	return nil
 }
 
@@ -114,13 +113,9 @@ func (display *RequestLoggerFileWriter) HandleInternalError(err error) {
 
 func (display *RequestLoggerFileWriter) Start() error {
 	display.lock.Lock()
+	display.entry.Reset()
 	for _, filePath := range display.filePaths {
-		err := os.MkdirAll(filepath.Dir(filePath), os.ModeDir|os.ModePerm)
-		if err != nil {
-			return err
-		}
-
-		logFile, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		logFile, err := rotatingfile.Open(filePath, display.ui.TraceFileRotation)
 		if err != nil {
 			return err
 		}
@@ -133,17 +128,19 @@ func (display *RequestLoggerFileWriter) Start() error {
 func (display *RequestLoggerFileWriter) Stop() error {
 	var err error
 
+	display.entry.WriteString("\n")
 	for _, logFile := range display.logFiles {
-		_, lastLineErr := logFile.WriteString("\n")
+		_, writeErr := logFile.Write(display.entry.Bytes())
 		closeErr := logFile.Close()
 		switch {
 		case closeErr != nil:
 			err = closeErr
-		case lastLineErr != nil:
-			err = lastLineErr
+		case writeErr != nil:
+			err = writeErr
 		}
 	}
-	display.logFiles = []*os.File{}
+	display.logFiles = []*rotatingfile.File{}
+	display.entry.Reset()
 	display.lock.Unlock()
 	return err
 }
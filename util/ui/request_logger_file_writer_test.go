@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"code.cloudfoundry.org/cli/util/rotatingfile"
 	. "code.cloudfoundry.org/cli/util/ui"
 
 	. "github.com/onsi/ginkgo"
@@ -129,6 +130,31 @@ Origin: wss://doppler.bosh-lite.com:443`
 				Expect(err).ToNot(HaveOccurred())
 				Expect(string(contents)).To(Equal("Header: Value\n\n"))
 			})
+
+			It("redacts the Authorization and Set-Cookie headers", func() {
+				Expect(display.DisplayHeader("Authorization", "bearer some-token")).To(Succeed())
+				Expect(display.DisplayHeader("Set-Cookie", "session=some-session")).To(Succeed())
+				Expect(display.Stop()).To(Succeed())
+
+				contents, err := ioutil.ReadFile(logFile1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("Authorization: [PRIVATE DATA HIDDEN]\nSet-Cookie: [PRIVATE DATA HIDDEN]\n\n"))
+			})
+
+			Context("when secrets are shown", func() {
+				BeforeEach(func() {
+					testUI.ShowTraceSecrets = true
+				})
+
+				It("writes the Authorization header", func() {
+					Expect(display.DisplayHeader("Authorization", "bearer some-token")).To(Succeed())
+					Expect(display.Stop()).To(Succeed())
+
+					contents, err := ioutil.ReadFile(logFile1)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(contents)).To(Equal("Authorization: bearer some-token\n\n"))
+				})
+			})
 		})
 
 		Describe("DisplayHost", func() {
@@ -318,6 +344,63 @@ Origin: wss://doppler.bosh-lite.com:443`
 		})
 	})
 
+	Describe("writing entries", func() {
+		var logFile string
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "request_logger")
+			Expect(err).ToNot(HaveOccurred())
+
+			logFile = filepath.Join(tmpdir, "trace.log")
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpdir)).NotTo(HaveOccurred())
+		})
+
+		writeEntry := func(message string) {
+			display = testUI.RequestLoggerFileWriter([]string{logFile})
+			Expect(display.Start()).To(Succeed())
+			Expect(display.DisplayMessage(message)).To(Succeed())
+			Expect(display.Stop()).To(Succeed())
+		}
+
+		It("only writes the entry once it is complete", func() {
+			display = testUI.RequestLoggerFileWriter([]string{logFile})
+			Expect(display.Start()).To(Succeed())
+			Expect(display.DisplayMessage("some-message")).To(Succeed())
+
+			contents, err := ioutil.ReadFile(logFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(contents).To(BeEmpty())
+
+			Expect(display.Stop()).To(Succeed())
+			contents, err = ioutil.ReadFile(logFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-message\n\n"))
+		})
+
+		Context("when trace file rotation is configured", func() {
+			BeforeEach(func() {
+				testUI.TraceFileRotation = rotatingfile.Options{MaxSize: 20, MaxFiles: 2}
+			})
+
+			It("rotates the file", func() {
+				writeEntry("first-entry")
+				writeEntry("second-entry")
+
+				contents, err := ioutil.ReadFile(logFile)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("second-entry\n\n"))
+
+				contents, err = ioutil.ReadFile(logFile + ".1")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("first-entry\n\n"))
+			})
+		})
+	})
+
 	Describe("when the log file path is invalid", func() {
 		var pathName string
 
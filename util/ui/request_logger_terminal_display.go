@@ -25,18 +25,27 @@ func newRequestLoggerTerminalDisplay(ui *UI, lock *sync.Mutex) *RequestLoggerTer
 	}
 }
 
-func (display *RequestLoggerTerminalDisplay) DisplayBody([]byte) error {
+func (display *RequestLoggerTerminalDisplay) DisplayBody(body []byte) error {
+	if display.ui.ShowTraceSecrets {
+		fmt.Fprintf(display.ui.Out, "%s\n", string(body))
+		return nil
+	}
 	fmt.Fprintf(display.ui.Out, "%s\n", RedactedValue)
 	return nil
 }
 
 func (display *RequestLoggerTerminalDisplay) DisplayDump(dump string) error {
-	sanitized := display.dumpSanitizer.ReplaceAllString(dump, RedactedValue)
-	fmt.Fprintf(display.ui.Out, "%s\n", sanitized)
+	if !display.ui.ShowTraceSecrets {
+		dump = display.dumpSanitizer.ReplaceAllString(dump, RedactedValue)
+	}
+	fmt.Fprintf(display.ui.Out, "%s\n", dump)
 	return nil
 }
 
 func (display *RequestLoggerTerminalDisplay) DisplayHeader(name string, value string) error {
+	if !display.ui.ShowTraceSecrets {
+		value = sanitizeHeader(name, value)
+	}
 	fmt.Fprintf(display.ui.Out, "%s: %s\n", display.ui.TranslateText(name), value)
 	return nil
 }
@@ -51,9 +60,14 @@ func (display *RequestLoggerTerminalDisplay) DisplayJSONBody(body []byte) error
 		return nil
 	}
 
+	if display.ui.ShowTraceSecrets {
+		fmt.Fprintf(display.ui.Out, "%s\n", string(body))
+		return nil
+	}
+
 	sanitized, err := SanitizeJSON(body)
 	if err != nil {
-		fmt.Fprintf(display.ui.Out, "%s\n", string(body))
+		fmt.Fprintf(display.ui.Out, "%s\n", sanitizeJSONText(string(body)))
 		return nil
 	}
 
@@ -79,6 +79,28 @@ Origin: wss://doppler.bosh-lite.com:443`
 
 			Expect(testUI.Out).To(Say("Header: Value"))
 		})
+
+		It("redacts the Authorization and Set-Cookie headers", func() {
+			Expect(display.DisplayHeader("Authorization", "bearer some-token")).To(Succeed())
+			Expect(display.DisplayHeader("Set-Cookie", "session=some-session")).To(Succeed())
+			Expect(display.Stop()).To(Succeed())
+
+			Expect(testUI.Out).To(Say("Authorization: \\[PRIVATE DATA HIDDEN\\]"))
+			Expect(testUI.Out).To(Say("Set-Cookie: \\[PRIVATE DATA HIDDEN\\]"))
+		})
+
+		Context("when secrets are shown", func() {
+			BeforeEach(func() {
+				testUI.ShowTraceSecrets = true
+			})
+
+			It("displays the Authorization header", func() {
+				Expect(display.DisplayHeader("Authorization", "bearer some-token")).To(Succeed())
+				Expect(display.Stop()).To(Succeed())
+
+				Expect(testUI.Out).To(Say("Authorization: bearer some-token"))
+			})
+		})
 	})
 
 	Describe("DisplayHost", func() {
@@ -137,6 +159,36 @@ Origin: wss://doppler.bosh-lite.com:443`
 				Expect(ok).To(BeTrue())
 				Expect(string(buff.Contents())).To(Equal(raw + "\n\n"))
 			})
+
+			It("redacts token and password values, including one cut off by the end of a chunk", func() {
+				raw := `{"name":"some-service","credentials":{"password":"some-password","uri":"some-uri"}}{"access_token":"some-tok`
+				err := display.DisplayJSONBody([]byte(raw))
+				Expect(err).ToNot(HaveOccurred())
+
+				err = display.Stop()
+				Expect(err).ToNot(HaveOccurred())
+
+				buff, ok := testUI.Out.(*Buffer)
+				Expect(ok).To(BeTrue())
+				Expect(string(buff.Contents())).To(Equal(`{"name":"some-service","credentials":{"password":"[PRIVATE DATA HIDDEN]","uri":"some-uri"}}{"access_token":"[PRIVATE DATA HIDDEN]"` + "\n\n"))
+			})
+		})
+
+		Context("when secrets are shown", func() {
+			BeforeEach(func() {
+				testUI.ShowTraceSecrets = true
+			})
+
+			It("displays the raw body", func() {
+				raw := `{"password":"some-password"}`
+				err := display.DisplayJSONBody([]byte(raw))
+				Expect(err).ToNot(HaveOccurred())
+
+				err = display.Stop()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`{"password":"some-password"}`))
+			})
 		})
 	})
 
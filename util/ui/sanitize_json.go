@@ -3,11 +3,20 @@ package ui
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"regexp"
+	"strings"
 )
 
 var keysToSanitize = regexp.MustCompile("(?i).*(?:token|password).*")
 
+// secretJSONPairs matches "key": "value" pairs with a key that looks like a
+// token or password in JSON text that cannot be decoded as a whole, such as a
+// chunk of a streamed body. A value cut off by the end of the chunk is matched
+// up to the end of the text.
+var secretJSONPairs = regexp.MustCompile(`(?i)"([^"]*(?:token|password)[^"]*)"(\s*:\s*)"(?:[^"\\]|\\.)*(?:"|\\?$)`)
+
 const tokenEndpoint = "token_endpoint"
 
 func SanitizeJSON(raw []byte) (map[string]interface{}, error) {
@@ -18,10 +27,34 @@ func SanitizeJSON(raw []byte) (map[string]interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	if decoder.More() {
+		return nil, errors.New("unexpected data after JSON value")
+	}
 
 	return iterateAndRedact(result), nil
 }
 
+// sanitizeJSONText redacts the values of token and password fields in JSON
+// text without decoding it.
+func sanitizeJSONText(text string) string {
+	return secretJSONPairs.ReplaceAllStringFunc(text, func(pair string) string {
+		match := secretJSONPairs.FindStringSubmatch(pair)
+		if match[1] == tokenEndpoint {
+			return pair
+		}
+		return fmt.Sprintf(`"%s"%s"%s"`, match[1], match[2], RedactedValue)
+	})
+}
+
+// sanitizeHeader redacts the values of headers that carry credentials.
+func sanitizeHeader(name string, value string) string {
+	switch strings.ToLower(name) {
+	case "authorization", "set-cookie":
+		return RedactedValue
+	}
+	return value
+}
+
 func iterateAndRedact(blob map[string]interface{}) map[string]interface{} {
 	for key, value := range blob {
 		switch v := value.(type) {
@@ -31,8 +64,23 @@ func iterateAndRedact(blob map[string]interface{}) map[string]interface{} {
 			}
 		case map[string]interface{}:
 			blob[key] = iterateAndRedact(v)
+		case []interface{}:
+			blob[key] = iterateAndRedactList(v)
 		}
 	}
 
 	return blob
 }
+
+func iterateAndRedactList(list []interface{}) []interface{} {
+	for i, value := range list {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			list[i] = iterateAndRedact(v)
+		case []interface{}:
+			list[i] = iterateAndRedactList(v)
+		}
+	}
+
+	return list
+}
@@ -37,4 +37,28 @@ var _ = Describe("SanitizeJSON", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(redacted).To(Equal(expected))
 	})
+
+	It("sanitizes json inside lists", func() {
+		raw := []byte(`{"resources": [{"credentials": {"password": "foo", "uri": "bar"}}]}`)
+
+		expected := map[string]interface{}{
+			"resources": []interface{}{
+				map[string]interface{}{
+					"credentials": map[string]interface{}{
+						"password": RedactedValue,
+						"uri":      "bar",
+					},
+				},
+			},
+		}
+
+		redacted, err := SanitizeJSON(raw)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(redacted).To(Equal(expected))
+	})
+
+	It("returns an error when data follows the JSON value", func() {
+		_, err := SanitizeJSON([]byte(`{"a": "b"}{"c": "d"}`))
+		Expect(err).To(HaveOccurred())
+	})
 })
@@ -15,6 +15,7 @@ import (
 
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/rotatingfile"
 	"github.com/fatih/color"
 	"github.com/lunixbochs/vtclean"
 	runewidth "github.com/mattn/go-runewidth"
@@ -24,6 +25,23 @@ import (
 // LogTimestampFormat is the timestamp formatting for log lines.
 const LogTimestampFormat = "2006-01-02T15:04:05.00-0700"
 
+// rfc3339NanoTimestampFormat is RFC3339 with the fractional seconds always
+// padded to nanosecond precision.
+const rfc3339NanoTimestampFormat = "2006-01-02T15:04:05.000000000Z07:00"
+
+// Timestamp formats accepted by DisplayLogMessageWithTimestampFormat.
+const (
+	// LogTimestampDefault formats timestamps with LogTimestampFormat.
+	LogTimestampDefault = ""
+	// LogTimestampRFC3339 formats timestamps as RFC3339 with nanoseconds.
+	LogTimestampRFC3339 = "rfc3339"
+	// LogTimestampEpoch formats timestamps as seconds since the Unix epoch
+	// with nanoseconds.
+	LogTimestampEpoch = "epoch"
+	// LogTimestampNone omits timestamps.
+	LogTimestampNone = "none"
+)
+
 //go:generate counterfeiter . Config
 
 // Config is the UI configuration.
@@ -36,6 +54,12 @@ type Config interface {
 	IsTTY() bool
 	// TerminalWidth returns the width of the terminal
 	TerminalWidth() int
+	// ShowTraceSecrets returns true when request logging should not redact
+	// credentials
+	ShowTraceSecrets() bool
+	// TraceFileRotation returns the size in bytes a trace file may reach
+	// before it is rotated, 0 to never rotate, and the number of files to keep
+	TraceFileRotation() (int64, int)
 }
 
 //go:generate counterfeiter . LogMessage
@@ -69,6 +93,14 @@ type UI struct {
 	TerminalWidth int
 
 	TimezoneLocation *time.Location
+
+	// ShowTraceSecrets disables the redaction of credentials in request
+	// logging.
+	ShowTraceSecrets bool
+
+	// TraceFileRotation configures the rotation of the files request logging
+	// writes to.
+	TraceFileRotation rotatingfile.Options
 }
 
 // NewUI will return a UI object where Out is set to STDOUT, In is set to
@@ -81,7 +113,7 @@ func NewUI(config Config) (*UI, error) {
 
 	location := time.Now().Location()
 
-	return &UI{
+	ui := &UI{
 		In:               os.Stdin,
 		Out:              color.Output,
 		Err:              os.Stderr,
@@ -92,7 +124,11 @@ func NewUI(config Config) (*UI, error) {
 		IsTTY:            config.IsTTY(),
 		TerminalWidth:    config.TerminalWidth(),
 		TimezoneLocation: location,
-	}, nil
+		ShowTraceSecrets: config.ShowTraceSecrets(),
+	}
+	ui.TraceFileRotation.MaxSize, ui.TraceFileRotation.MaxFiles = config.TraceFileRotation()
+
+	return ui, nil
 }
 
 // NewTestUI will return a UI object where Out, In, and Err are customizable,
@@ -130,6 +166,41 @@ func (ui *UI) DisplayBoolPrompt(defaultResponse bool, template string, templateV
 	return response, err
 }
 
+// DisplayPasswordPrompt outputs the prompt and waits for user input without
+// echoing it back. An empty response is not accepted.
+func (ui *UI) DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	var password interact.Password
+	interactivePrompt := interact.NewInteraction(ui.TranslateText(template, templateValues...))
+	interactivePrompt.Input = ui.In
+	interactivePrompt.Output = ui.Out
+	err := interactivePrompt.Resolve(interact.Required(&password))
+	return string(password), err
+}
+
+// DisplayTextPrompt outputs the prompt and waits for user input. A default
+// response can be set with defaultResponse; when it is empty, an empty
+// response is not accepted.
+func (ui *UI) DisplayTextPrompt(defaultResponse string, template string, templateValues ...map[string]interface{}) (string, error) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	response := defaultResponse
+	interactivePrompt := interact.NewInteraction(ui.TranslateText(template, templateValues...))
+	interactivePrompt.Input = ui.In
+	interactivePrompt.Output = ui.Out
+
+	var err error
+	if defaultResponse == "" {
+		err = interactivePrompt.Resolve(interact.Required(&response))
+	} else {
+		err = interactivePrompt.Resolve(&response)
+	}
+	return response, err
+}
+
 // DisplayError outputs the translated error message to ui.Err if the error
 // satisfies TranslatableError, otherwise it outputs the original error message
 // to ui.Err. It also outputs "FAILED" in bold red to ui.Out.
@@ -180,19 +251,31 @@ func (ui *UI) DisplayKeyValueTable(prefix string, table [][]string, padding int)
 
 // DisplayLogMessage formats and outputs a given log message.
 func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
+	ui.displayLogMessage(message, displayHeader, LogTimestampDefault)
+}
+
+// DisplayLogMessageWithTimestampFormat formats and outputs a given log
+// message with a header whose timestamp is formatted according to
+// timestampFormat, one of the LogTimestamp* constants.
+func (ui *UI) DisplayLogMessageWithTimestampFormat(message LogMessage, timestampFormat string) {
+	ui.displayLogMessage(message, true, timestampFormat)
+}
+
+func (ui *UI) displayLogMessage(message LogMessage, displayHeader bool, timestampFormat string) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
 	var header string
 	if displayHeader {
-		time := message.Timestamp().In(ui.TimezoneLocation).Format(LogTimestampFormat)
-
-		header = fmt.Sprintf("%s [%s/%s] %s ",
-			time,
+		header = fmt.Sprintf("[%s/%s] %s ",
 			message.SourceType(),
 			message.SourceInstance(),
 			message.Type(),
 		)
+
+		if timestamp := ui.formatLogTimestamp(message.Timestamp(), timestampFormat); timestamp != "" {
+			header = fmt.Sprintf("%s %s", timestamp, header)
+		}
 	}
 
 	for _, line := range strings.Split(message.Message(), "\n") {
@@ -204,6 +287,20 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 	}
 }
 
+func (ui *UI) formatLogTimestamp(timestamp time.Time, timestampFormat string) string {
+	switch timestampFormat {
+	case LogTimestampNone:
+		return ""
+	case LogTimestampRFC3339:
+		return timestamp.In(ui.TimezoneLocation).Format(rfc3339NanoTimestampFormat)
+	case LogTimestampEpoch:
+		nanoseconds := timestamp.UnixNano()
+		return fmt.Sprintf("%d.%09d", nanoseconds/int64(time.Second), nanoseconds%int64(time.Second))
+	default:
+		return timestamp.In(ui.TimezoneLocation).Format(LogTimestampFormat)
+	}
+}
+
 // DisplayNewline outputs a newline to UI.Out.
 func (ui *UI) DisplayNewline() {
 	ui.terminalLock.Lock()
@@ -345,6 +442,17 @@ func (ui *UI) UserFriendlyDate(input time.Time) string {
 	return input.Local().Format("Mon 02 Jan 15:04:05 MST 2006")
 }
 
+// AnimationsEnabled returns true when progress bars and other animations may
+// be displayed, which requires a TTY and colors not to be disabled.
+func (ui *UI) AnimationsEnabled() bool {
+	return ui.IsTTY && ui.colorEnabled != configv3.ColorDisabled
+}
+
+// Reader returns the input the user types or pipes to the CLI.
+func (ui *UI) Reader() io.Reader {
+	return ui.In
+}
+
 func (ui *UI) Writer() io.Writer {
 	return ui.Out
 }
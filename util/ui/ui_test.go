@@ -10,6 +10,7 @@ import (
 	. "code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/cli/util/ui/uifakes"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
 )
@@ -39,6 +40,23 @@ var _ = Describe("UI", func() {
 		Expect(ui.TimezoneLocation).To(Equal(location))
 	})
 
+	DescribeTable("AnimationsEnabled",
+		func(isTTY bool, colorSetting configv3.ColorSetting, expected bool) {
+			fakeConfig.IsTTYReturns(isTTY)
+			fakeConfig.ColorEnabledReturns(colorSetting)
+
+			var err error
+			ui, err = NewUI(fakeConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ui.AnimationsEnabled()).To(Equal(expected))
+		},
+		Entry("tty, colors enabled", true, configv3.ColorEnabled, true),
+		Entry("tty, colors auto", true, configv3.ColorAuto, true),
+		Entry("tty, colors disabled", true, configv3.ColorDisabled, false),
+		Entry("no tty, colors enabled", false, configv3.ColorEnabled, false),
+	)
+
 	Describe("DisplayBoolPrompt", func() {
 		var inBuffer *Buffer
 
@@ -111,6 +129,107 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayPasswordPrompt", func() {
+		var inBuffer *Buffer
+
+		BeforeEach(func() {
+			inBuffer = NewBuffer()
+			ui.In = inBuffer
+		})
+
+		It("displays the passed in string", func() {
+			_, _ = ui.DisplayPasswordPrompt("some-prompt")
+			Expect(ui.Out).To(Say("some-prompt: "))
+		})
+
+		Context("when the user enters a password", func() {
+			BeforeEach(func() {
+				_, err := inBuffer.Write([]byte("some-password\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the password without echoing it", func() {
+				response, err := ui.DisplayPasswordPrompt("some-prompt")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response).To(Equal("some-password"))
+				Expect(out).ToNot(Say("some-password"))
+			})
+		})
+
+		Context("when the user enters an empty password", func() {
+			BeforeEach(func() {
+				_, err := inBuffer.Write([]byte("\nsome-password\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("asks again", func() {
+				response, err := ui.DisplayPasswordPrompt("some-prompt")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response).To(Equal("some-password"))
+			})
+		})
+	})
+
+	Describe("DisplayTextPrompt", func() {
+		var inBuffer *Buffer
+
+		BeforeEach(func() {
+			inBuffer = NewBuffer()
+			ui.In = inBuffer
+		})
+
+		It("displays the passed in string and the default", func() {
+			_, _ = ui.DisplayTextPrompt("some-default", "some-prompt")
+			Expect(ui.Out).To(Say("some-prompt \\(some-default\\): "))
+		})
+
+		Context("when the user enters a response", func() {
+			BeforeEach(func() {
+				_, err := inBuffer.Write([]byte("some-response\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the response", func() {
+				response, err := ui.DisplayTextPrompt("some-default", "some-prompt")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response).To(Equal("some-response"))
+			})
+		})
+
+		Context("when the user chooses the default", func() {
+			BeforeEach(func() {
+				_, err := inBuffer.Write([]byte("\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the default", func() {
+				response, err := ui.DisplayTextPrompt("some-default", "some-prompt")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response).To(Equal("some-default"))
+			})
+		})
+
+		Context("when there is no default and the user enters an empty response", func() {
+			BeforeEach(func() {
+				_, err := inBuffer.Write([]byte("\nsome-response\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("asks again", func() {
+				response, err := ui.DisplayTextPrompt("", "some-prompt")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response).To(Equal("some-response"))
+			})
+		})
+
+		Context("when the input ends before a response is given", func() {
+			It("returns the error", func() {
+				_, err := ui.DisplayTextPrompt("", "some-prompt")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("DisplayError", func() {
 		Context("when passed a TranslatableError", func() {
 			var fakeTranslateErr *translatableerrorfakes.FakeTranslatableError
@@ -276,6 +395,34 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayLogMessageWithTimestampFormat", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			var err error
+			ui.TimezoneLocation, err = time.LoadLocation("America/Los_Angeles")
+			Expect(err).NotTo(HaveOccurred())
+
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a log message")
+			message.TypeReturns("OUT")
+			message.TimestampReturns(time.Unix(1468969692, 120000005))
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		DescribeTable("formats the timestamp",
+			func(timestampFormat string, expectedLine string) {
+				ui.DisplayLogMessageWithTimestampFormat(message, timestampFormat)
+				Expect(ui.Out).To(Say(expectedLine))
+			},
+			Entry("default", "", `   2016-07-19T16:08:12.12-0700 \[APP/PROC/WEB/12\] OUT This is a log message\n`),
+			Entry("rfc3339", "rfc3339", `   2016-07-19T16:08:12.120000005-07:00 \[APP/PROC/WEB/12\] OUT This is a log message\n`),
+			Entry("epoch", "epoch", `   1468969692.120000005 \[APP/PROC/WEB/12\] OUT This is a log message\n`),
+			Entry("none", "none", `   \[APP/PROC/WEB/12\] OUT This is a log message\n`),
+		)
+	})
+
 	Describe("DisplayNewline", func() {
 		It("displays a new line", func() {
 			ui.DisplayNewline()
@@ -45,6 +45,26 @@ type FakeConfig struct {
 	terminalWidthReturnsOnCall map[int]struct {
 		result1 int
 	}
+	ShowTraceSecretsStub        func() bool
+	showTraceSecretsMutex       sync.RWMutex
+	showTraceSecretsArgsForCall []struct{}
+	showTraceSecretsReturns     struct {
+		result1 bool
+	}
+	showTraceSecretsReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	TraceFileRotationStub        func() (int64, int)
+	traceFileRotationMutex       sync.RWMutex
+	traceFileRotationArgsForCall []struct{}
+	traceFileRotationReturns     struct {
+		result1 int64
+		result2 int
+	}
+	traceFileRotationReturnsOnCall map[int]struct {
+		result1 int64
+		result2 int
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -209,6 +229,89 @@ func (fake *FakeConfig) TerminalWidthReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ShowTraceSecrets() bool {
+	fake.showTraceSecretsMutex.Lock()
+	ret, specificReturn := fake.showTraceSecretsReturnsOnCall[len(fake.showTraceSecretsArgsForCall)]
+	fake.showTraceSecretsArgsForCall = append(fake.showTraceSecretsArgsForCall, struct{}{})
+	fake.recordInvocation("ShowTraceSecrets", []interface{}{})
+	fake.showTraceSecretsMutex.Unlock()
+	if fake.ShowTraceSecretsStub != nil {
+		return fake.ShowTraceSecretsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.showTraceSecretsReturns.result1
+}
+
+func (fake *FakeConfig) ShowTraceSecretsCallCount() int {
+	fake.showTraceSecretsMutex.RLock()
+	defer fake.showTraceSecretsMutex.RUnlock()
+	return len(fake.showTraceSecretsArgsForCall)
+}
+
+func (fake *FakeConfig) ShowTraceSecretsReturns(result1 bool) {
+	fake.ShowTraceSecretsStub = nil
+	fake.showTraceSecretsReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) ShowTraceSecretsReturnsOnCall(i int, result1 bool) {
+	fake.ShowTraceSecretsStub = nil
+	if fake.showTraceSecretsReturnsOnCall == nil {
+		fake.showTraceSecretsReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.showTraceSecretsReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) TraceFileRotation() (int64, int) {
+	fake.traceFileRotationMutex.Lock()
+	ret, specificReturn := fake.traceFileRotationReturnsOnCall[len(fake.traceFileRotationArgsForCall)]
+	fake.traceFileRotationArgsForCall = append(fake.traceFileRotationArgsForCall, struct{}{})
+	fake.recordInvocation("TraceFileRotation", []interface{}{})
+	fake.traceFileRotationMutex.Unlock()
+	if fake.TraceFileRotationStub != nil {
+		return fake.TraceFileRotationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.traceFileRotationReturns.result1, fake.traceFileRotationReturns.result2
+}
+
+func (fake *FakeConfig) TraceFileRotationCallCount() int {
+	fake.traceFileRotationMutex.RLock()
+	defer fake.traceFileRotationMutex.RUnlock()
+	return len(fake.traceFileRotationArgsForCall)
+}
+
+func (fake *FakeConfig) TraceFileRotationReturns(result1 int64, result2 int) {
+	fake.TraceFileRotationStub = nil
+	fake.traceFileRotationReturns = struct {
+		result1 int64
+		result2 int
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) TraceFileRotationReturnsOnCall(i int, result1 int64, result2 int) {
+	fake.TraceFileRotationStub = nil
+	if fake.traceFileRotationReturnsOnCall == nil {
+		fake.traceFileRotationReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 int
+		})
+	}
+	fake.traceFileRotationReturnsOnCall[i] = struct {
+		result1 int64
+		result2 int
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -220,6 +323,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.isTTYMutex.RUnlock()
 	fake.terminalWidthMutex.RLock()
 	defer fake.terminalWidthMutex.RUnlock()
+	fake.showTraceSecretsMutex.RLock()
+	defer fake.showTraceSecretsMutex.RUnlock()
+	fake.traceFileRotationMutex.RLock()
+	defer fake.traceFileRotationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,93 @@
+// Package updatecheck looks up the latest released version of the CLI.
+package updatecheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/blang/semver"
+)
+
+// DefaultReleaseURL is where the metadata of the latest CLI release is
+// published.
+const DefaultReleaseURL = "https://api.github.com/repos/cloudfoundry/cli/releases/latest"
+
+// DefaultTimeout is how long the check waits for the release metadata, so
+// that running offline does not hold up the CLI.
+const DefaultTimeout = 3 * time.Second
+
+// UnexpectedResponseError is returned when the release metadata cannot be
+// read.
+type UnexpectedResponseError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e UnexpectedResponseError) Error() string {
+	return fmt.Sprintf("%s returned status %d", e.URL, e.StatusCode)
+}
+
+// Checker fetches the release metadata from URL.
+type Checker struct {
+	Client *http.Client
+	URL    string
+}
+
+// NewChecker returns a Checker that gives up after timeout and honors the
+// proxy environment variables.
+func NewChecker(url string, timeout time.Duration) Checker {
+	return Checker{
+		Client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+			},
+		},
+		URL: url,
+	}
+}
+
+// LatestVersion returns the version of the latest release, without its "v"
+// prefix.
+func (c Checker) LatestVersion() (string, error) {
+	response, err := c.Client.Get(c.URL)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", UnexpectedResponseError{URL: c.URL, StatusCode: response.StatusCode}
+	}
+
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	err = json.NewDecoder(response.Body).Decode(&release)
+	if err != nil {
+		return "", err
+	}
+
+	latest := strings.TrimPrefix(release.TagName, "v")
+	if _, err = semver.Make(latest); err != nil {
+		return "", err
+	}
+	return latest, nil
+}
+
+// IsNewer returns true if latest is a later version than current. Versions
+// that cannot be parsed are never newer.
+func IsNewer(latest string, current string) bool {
+	latestVersion, err := semver.Make(latest)
+	if err != nil {
+		return false
+	}
+	currentVersion, err := semver.Make(current)
+	if err != nil {
+		return false
+	}
+	return latestVersion.GT(currentVersion)
+}
@@ -0,0 +1,13 @@
+package updatecheck_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestUpdatecheck(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Updatecheck Suite")
+}
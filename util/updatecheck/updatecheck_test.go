@@ -0,0 +1,93 @@
+package updatecheck_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/updatecheck"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Checker", func() {
+	var (
+		server  *ghttp.Server
+		checker Checker
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		checker = NewChecker(server.URL()+"/releases/latest", 100*time.Millisecond)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("LatestVersion", func() {
+		Context("when the release metadata is available", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/releases/latest"),
+					ghttp.RespondWith(http.StatusOK, `{"tag_name":"v6.40.1"}`),
+				))
+			})
+
+			It("returns the version without its prefix", func() {
+				latest, err := checker.LatestVersion()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(latest).To(Equal("6.40.1"))
+			})
+		})
+
+		Context("when the release metadata has an invalid version", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{"tag_name":"latest"}`))
+			})
+
+			It("returns an error", func() {
+				_, err := checker.LatestVersion()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("when the server returns an error status", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(ghttp.RespondWith(http.StatusForbidden, `{}`))
+			})
+
+			It("returns an UnexpectedResponseError", func() {
+				_, err := checker.LatestVersion()
+				Expect(err).To(MatchError(UnexpectedResponseError{URL: server.URL() + "/releases/latest", StatusCode: http.StatusForbidden}))
+			})
+		})
+
+		Context("when the server does not answer before the timeout", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(func(http.ResponseWriter, *http.Request) {
+					time.Sleep(500 * time.Millisecond)
+				})
+			})
+
+			It("returns an error", func() {
+				_, err := checker.LatestVersion()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
+var _ = Describe("IsNewer", func() {
+	It("compares semantic versions", func() {
+		Expect(IsNewer("6.40.1", "6.40.0")).To(BeTrue())
+		Expect(IsNewer("6.40.0", "6.40.0")).To(BeFalse())
+		Expect(IsNewer("6.39.0", "6.40.0")).To(BeFalse())
+	})
+
+	It("returns false for versions that cannot be parsed", func() {
+		Expect(IsNewer("latest", "6.40.0")).To(BeFalse())
+		Expect(IsNewer("6.40.0", "unknown")).To(BeFalse())
+	})
+})
@@ -29,3 +29,26 @@ func VersionString() string {
 
 	return versionString.String()
 }
+
+// BinaryVersion returns the semantic version of the binary, without its
+// build metadata.
+func BinaryVersion() string {
+	versionString, err := semver.Make(binaryVersion)
+	if err != nil {
+		return DefaultVersion
+	}
+	versionString.Build = nil
+	return versionString.String()
+}
+
+// BinarySHA returns the commit the binary was built from, or the empty string
+// when it was not set at build time.
+func BinarySHA() string {
+	return binarySHA
+}
+
+// BinaryBuildDate returns the date the binary was built on, or the empty
+// string when it was not set at build time.
+func BinaryBuildDate() string {
+	return binaryBuildDate
+}
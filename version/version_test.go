@@ -14,4 +14,21 @@ var _ = Describe("Version", func() {
 			})
 		})
 	})
+
+	Describe("BinaryVersion", func() {
+		Context("when passed no ldflags", func() {
+			It("returns the default version", func() {
+				Expect(version.BinaryVersion()).To(Equal("0.0.0-unknown-version"))
+			})
+		})
+	})
+
+	Describe("BinarySHA and BinaryBuildDate", func() {
+		Context("when passed no ldflags", func() {
+			It("returns the empty string", func() {
+				Expect(version.BinarySHA()).To(BeEmpty())
+				Expect(version.BinaryBuildDate()).To(BeEmpty())
+			})
+		})
+	})
 })